@@ -510,6 +510,7 @@ func New(
 		app.AccountKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	app.ObserverKeeper.SetEmissionsKeeper(app.EmissionsKeeper)
 
 	// Create Evm keepers
 	tracer := cast.ToString(appOpts.Get(srvflags.EVMTracer))
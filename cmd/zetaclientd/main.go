@@ -49,6 +49,16 @@ var (
 		Args:  cobra.ExactArgs(1),
 		RunE:  TSSGeneratePreParams,
 	}
+	TSSBackupCmd = &cobra.Command{
+		Use:   "backup --pubkey=<zetapub> --backup-password=<pass> --file=<path>",
+		Short: "Create an encrypted backup of the tss key share",
+		RunE:  TSSBackup,
+	}
+	TSSRestoreCmd = &cobra.Command{
+		Use:   "restore --backup-password=<pass> --file=<path> [--dry-run]",
+		Short: "Verify and restore the tss key share from a backup",
+		RunE:  TSSRestore,
+	}
 
 	RelayerCmd          = &cobra.Command{Use: "relayer", Short: "Relayer commands"}
 	RelayerImportKeyCmd = &cobra.Command{
@@ -82,6 +92,7 @@ func init() {
 	setupGlobalOptions()
 	setupInitializeConfigOptions()
 	setupRelayerOptions()
+	setupTSSBackupOptions()
 
 	// Define commands
 	RootCmd.AddCommand(VersionCmd)
@@ -91,6 +102,8 @@ func init() {
 	RootCmd.AddCommand(TSSCmd)
 	TSSCmd.AddCommand(TSSEncryptCmd)
 	TSSCmd.AddCommand(TSSGeneratePreParamsCmd)
+	TSSCmd.AddCommand(TSSBackupCmd)
+	TSSCmd.AddCommand(TSSRestoreCmd)
 
 	RootCmd.AddCommand(RelayerCmd)
 	RelayerCmd.AddCommand(RelayerImportKeyCmd)
@@ -125,7 +125,7 @@ func Start(_ *cobra.Command, _ []string) error {
 			return errors.Wrap(err, "unable to get TSS from zetacore client")
 		}
 
-		tssClient, err = dry.NewTSSClient(tss.TssPubkey)
+		tssClient, err = dry.NewTSSClient(tss.TssPubkey, tss.TssPubkeyEddsa)
 		if err != nil {
 			return errors.Wrap(err, "unable to create dry TSS client")
 		}
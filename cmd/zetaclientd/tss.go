@@ -12,8 +12,37 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/zeta-chain/node/pkg/crypto"
+	"github.com/zeta-chain/node/zetaclient/config"
+	"github.com/zeta-chain/node/zetaclient/tss/backup"
 )
 
+// tssBackupOptions is the struct that holds arguments for the tss backup & restore commands
+type tssBackupOptions struct {
+	tssPath        string
+	tssPassword    string
+	pubKey         string
+	backupPassword string
+	file           string
+	dryRun         bool
+}
+
+var tssBackupOpts tssBackupOptions
+
+func setupTSSBackupOptions() {
+	cfg := &tssBackupOpts
+
+	for _, cmd := range []*cobra.Command{TSSBackupCmd, TSSRestoreCmd} {
+		f := cmd.Flags()
+		f.StringVar(&cfg.tssPath, "tss-path", "~/.tss", "path to tss location")
+		f.StringVar(&cfg.tssPassword, "tss-password", "", "the password of the tss key share (empty if not encrypted)")
+		f.StringVar(&cfg.backupPassword, "backup-password", "", "the password to encrypt/decrypt the backup")
+		f.StringVar(&cfg.file, "file", "", "path to the backup file")
+		f.StringVar(&cfg.pubKey, "pubkey", "", "the TSS pubkey (zetapub...) as reported by zetacore")
+	}
+
+	TSSRestoreCmd.Flags().BoolVar(&cfg.dryRun, "dry-run", false, "only verify the backup, don't write the key share")
+}
+
 // TSSEncryptFile encrypts the given file with the given secret key
 func TSSEncryptFile(_ *cobra.Command, args []string) error {
 	var (
@@ -67,3 +96,135 @@ func TSSGeneratePreParams(_ *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// TSSBackup creates an encrypted backup of the key share of the given TSS pubkey.
+// The key share is verified against the pubkey before the backup is written.
+func TSSBackup(_ *cobra.Command, _ []string) error {
+	opts := tssBackupOpts
+
+	switch {
+	case opts.pubKey == "":
+		return errors.New("must provide the TSS pubkey")
+	case opts.backupPassword == "":
+		return errors.New("must provide a backup password")
+	case opts.file == "":
+		return errors.New("must provide the backup file path")
+	}
+
+	sharePath := tssKeySharePath(opts.tssPath, opts.pubKey)
+
+	// #nosec G304 -- this is a key share file
+	content, err := os.ReadFile(sharePath)
+	if err != nil {
+		return errors.Wrapf(err, "unable to read key share %s", sharePath)
+	}
+
+	share, err := backup.ParseShare(content, opts.tssPassword)
+	if err != nil {
+		return err
+	}
+
+	if err := share.Verify(opts.pubKey); err != nil {
+		return errors.Wrap(err, "key share self-check failed")
+	}
+
+	b, err := backup.New(share, opts.backupPassword)
+	if err != nil {
+		return errors.Wrap(err, "unable to create backup")
+	}
+
+	data, err := b.Bytes()
+	if err != nil {
+		return errors.Wrap(err, "unable to encode backup")
+	}
+
+	backupPath := filepath.Clean(opts.file)
+	if err := writeNewFile(backupPath, data); err != nil {
+		return errors.Wrap(err, "unable to write backup")
+	}
+
+	fmt.Printf("Key share of %s backed up to %s\n", b.PubKey, backupPath)
+	fmt.Printf("Fingerprint: %s\n", b.Fingerprint)
+
+	return nil
+}
+
+// TSSRestore restores a key share from an encrypted backup.
+// The key share is verified offline against the TSS pubkey and the backup fingerprint;
+// in dry-run mode nothing is written.
+func TSSRestore(_ *cobra.Command, _ []string) error {
+	opts := tssBackupOpts
+
+	switch {
+	case opts.backupPassword == "":
+		return errors.New("must provide a backup password")
+	case opts.file == "":
+		return errors.New("must provide the backup file path")
+	}
+
+	// #nosec G304 -- this is a backup file
+	content, err := os.ReadFile(filepath.Clean(opts.file))
+	if err != nil {
+		return errors.Wrap(err, "unable to read backup")
+	}
+
+	b, err := backup.Parse(content)
+	if err != nil {
+		return err
+	}
+
+	// optionally pin the backup to the expected pubkey (e.g. the current TSS on zetacore)
+	if opts.pubKey != "" && opts.pubKey != b.PubKey {
+		return fmt.Errorf("backup belongs to %s, not %s", b.PubKey, opts.pubKey)
+	}
+
+	share, err := b.Open(opts.backupPassword)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Key share of %s passed the self-check\n", b.PubKey)
+	fmt.Printf("Fingerprint: %s\n", b.Fingerprint)
+
+	if opts.dryRun {
+		return nil
+	}
+
+	data, err := share.Content(opts.tssPassword)
+	if err != nil {
+		return errors.Wrap(err, "unable to encrypt key share")
+	}
+
+	sharePath := tssKeySharePath(opts.tssPath, b.PubKey)
+	if err := writeNewFile(sharePath, data); err != nil {
+		return errors.Wrap(err, "unable to write key share")
+	}
+
+	fmt.Printf("Key share restored to %s\n", sharePath)
+
+	return nil
+}
+
+// tssKeySharePath returns the path of the key share file of the pubkey as go-tss expects it.
+func tssKeySharePath(tssPath, pubKey string) string {
+	return filepath.Join(config.GetPath(tssPath), fmt.Sprintf("localstate-%s.json", pubKey))
+}
+
+// writeNewFile writes the data to a new file, existing files are never overwritten.
+func writeNewFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
@@ -0,0 +1,269 @@
+package local
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/e2e/config"
+	"github.com/zeta-chain/node/e2e/e2etests"
+	"github.com/zeta-chain/node/e2e/load"
+	"github.com/zeta-chain/node/e2e/runner"
+)
+
+// load.go provides the routine generating sustained mixed traffic on the connected chains of the localnet
+// Note: the routine should not be used concurrently with other routines as it reuses the accounts of other routines
+
+const (
+	loadChainEVM     = "evm"
+	loadChainBitcoin = "bitcoin"
+	loadChainSolana  = "solana"
+	loadChainSui     = "sui"
+	loadChainTON     = "ton"
+)
+
+// loadTraffic is a traffic type of the load, sent by running an e2e test
+type loadTraffic struct {
+	test   string
+	weight int
+}
+
+// loadGroup is a group of traffic types sharing a runner
+type loadGroup struct {
+	name    string
+	runner  *runner.E2ERunner
+	traffic []loadTraffic
+}
+
+// loadOptions are the options of the load routine
+type loadOptions struct {
+	config           load.Config
+	chains           []string
+	reportPath       string
+	skipBitcoinSetup bool
+}
+
+// loadTestRoutine sends traffic on the chains at the configured rate, checks the custody accounting throughout
+// and writes the report of the load
+func loadTestRoutine(
+	conf config.Config,
+	deployerRunner *runner.E2ERunner,
+	verbose bool,
+	opts loadOptions,
+) func() error {
+	return func() (err error) {
+		logger := runner.NewLogger(verbose, color.FgHiBlue, "load")
+		logger.Print("🏃 starting load on %v", opts.chains)
+		startTime := time.Now()
+
+		var (
+			groups                 []loadGroup
+			checkSolana, checkSui  bool
+			newRunner              = loadRunnerInitializer(conf, deployerRunner, verbose)
+			initBitcoin            = !opts.skipBitcoinSetup
+			evmRunner, erc20Runner *runner.E2ERunner
+		)
+		for _, chain := range opts.chains {
+			switch chain {
+			case loadChainEVM:
+				if evmRunner, err = newRunner("load_eth", conf.AdditionalAccounts.UserEther); err != nil {
+					return err
+				}
+				if erc20Runner, err = newRunner("load_erc20", conf.AdditionalAccounts.UserERC20); err != nil {
+					return err
+				}
+				groups = append(groups,
+					loadGroup{name: "eth", runner: evmRunner, traffic: []loadTraffic{
+						{test: e2etests.TestETHDepositName, weight: 3},
+						{test: e2etests.TestETHWithdrawName, weight: 2},
+					}},
+					loadGroup{name: "erc20", runner: erc20Runner, traffic: []loadTraffic{
+						{test: e2etests.TestERC20DepositName, weight: 2},
+						{test: e2etests.TestERC20WithdrawName, weight: 1},
+					}},
+				)
+			case loadChainBitcoin:
+				btcRunner := initBitcoinRunner(
+					"load_btc",
+					conf.AdditionalAccounts.UserBitcoinDeposit,
+					conf,
+					deployerRunner,
+					color.FgYellow,
+					verbose,
+					initBitcoin,
+				)
+				if initBitcoin {
+					// mine 101 blocks to ensure the BTC rewards are spendable
+					_, err := deployerRunner.GenerateToAddressIfLocalBitcoin(101, deployerRunner.GetBtcAddress())
+					require.NoError(btcRunner, err)
+
+					// donate BTC to TSS to pay the outbound fees
+					deployerRunner.DonateBTC()
+				}
+				btcRunner.CtxCancel = func(error) {}
+				groups = append(groups, loadGroup{name: "btc", runner: btcRunner, traffic: []loadTraffic{
+					{test: e2etests.TestBitcoinDepositName, weight: 2},
+					{test: e2etests.TestBitcoinWithdrawSegWitName, weight: 1},
+				}})
+			case loadChainSolana:
+				solanaRunner, err := newRunner("load_sol", conf.AdditionalAccounts.UserSolana)
+				if err != nil {
+					return err
+				}
+				solanaRunner.SetupSolanaAccount()
+				checkSolana = true
+				groups = append(groups, loadGroup{name: "sol", runner: solanaRunner, traffic: []loadTraffic{
+					{test: e2etests.TestSolanaDepositName, weight: 2},
+					{test: e2etests.TestSolanaWithdrawName, weight: 1},
+				}})
+			case loadChainSui:
+				suiRunner, err := newRunner("load_sui", conf.AdditionalAccounts.UserSui)
+				if err != nil {
+					return err
+				}
+				suiSigner, err := suiRunner.Account.SuiSigner()
+				if err != nil {
+					return err
+				}
+				suiRunner.RequestSuiFromFaucet(conf.RPCs.SuiFaucet, suiSigner.Address())
+				suiRunner.SuiUpdateGatewayInfoAndTSS()
+				checkSui = true
+				groups = append(groups, loadGroup{name: "sui", runner: suiRunner, traffic: []loadTraffic{
+					{test: e2etests.TestSuiDepositName, weight: 2},
+					{test: e2etests.TestSuiWithdrawName, weight: 1},
+				}})
+			case loadChainTON:
+				tonRunner, err := newRunner("load_ton", conf.AdditionalAccounts.UserTON)
+				if err != nil {
+					return err
+				}
+				groups = append(groups, loadGroup{name: "ton", runner: tonRunner, traffic: []loadTraffic{
+					{test: e2etests.TestTONDepositName, weight: 2},
+					{test: e2etests.TestTONWithdrawName, weight: 1},
+				}})
+			default:
+				return fmt.Errorf("unknown load chain %q", chain)
+			}
+		}
+
+		traffic, err := loadTrafficFromGroups(groups)
+		if err != nil {
+			return err
+		}
+
+		// the custody checks are run with a dedicated runner so a violation doesn't stop the load
+		checkRunner, err := newRunner("load_accounting", conf.DefaultAccount)
+		if err != nil {
+			return err
+		}
+		custodyChecks := checkRunner.CustodyChecks(checkSolana, checkSui)
+		invariants := make([]load.Invariant, 0, len(custodyChecks))
+		for _, custodyCheck := range custodyChecks {
+			invariants = append(invariants, load.Invariant{
+				Name: custodyCheck.Name,
+				Check: func() error {
+					return checkRunner.RunCheck(custodyCheck.Check)
+				},
+			})
+		}
+
+		generator, err := load.NewGenerator(opts.config, traffic, invariants, logger)
+		if err != nil {
+			return err
+		}
+		report, err := generator.Run(deployerRunner.Ctx)
+		if err != nil {
+			return err
+		}
+		report.Version = deployerRunner.GetZetacoredVersion()
+
+		table, err := report.String()
+		if err != nil {
+			return err
+		}
+		logger.Print(" ---📈 Load Report ---")
+		logger.PrintNoPrefix("%s", table)
+
+		if opts.reportPath != "" {
+			if err := report.WriteFile(opts.reportPath); err != nil {
+				return err
+			}
+			logger.Print("📝 load report written to %s", opts.reportPath)
+		}
+
+		if err := report.Validate(); err != nil {
+			return fmt.Errorf("load failed: %w", err)
+		}
+
+		logger.Print("🍾 load completed in %s", time.Since(startTime).String())
+		return nil
+	}
+}
+
+// loadRunnerInitializer returns a function initializing the runners of the load
+// a failed CCTX is reported in the load report, it doesn't cancel the context of the other runners
+func loadRunnerInitializer(
+	conf config.Config,
+	deployerRunner *runner.E2ERunner,
+	verbose bool,
+) func(name string, account config.Account) (*runner.E2ERunner, error) {
+	return func(name string, account config.Account) (*runner.E2ERunner, error) {
+		r, err := initTestRunner(
+			name,
+			conf,
+			deployerRunner,
+			account,
+			runner.NewLogger(verbose, color.FgHiBlue, name),
+			runner.WithZetaTxServer(deployerRunner.ZetaTxServer),
+		)
+		if err != nil {
+			return nil, err
+		}
+		r.CtxCancel = func(error) {}
+		return r, nil
+	}
+}
+
+// loadTrafficFromGroups returns the traffic types of the groups
+// a deposit is run for each group before the load to fund the withdrawals
+func loadTrafficFromGroups(groups []loadGroup) ([]load.Traffic, error) {
+	var traffic []load.Traffic
+	for _, group := range groups {
+		tests, err := group.runner.GetE2ETestsToRunByName(e2etests.AllE2ETests, loadTestNames(group.traffic)...)
+		if err != nil {
+			return nil, err
+		}
+		if len(tests) != len(group.traffic) {
+			return nil, fmt.Errorf("load tests of group %s are filtered out", group.name)
+		}
+
+		group.runner.Logger.Print("💰 funding %s withdrawals", group.name)
+		if err := group.runner.RunE2ETest(tests[0]); err != nil {
+			return nil, fmt.Errorf("failed to fund %s withdrawals: %w", group.name, err)
+		}
+
+		for i, test := range tests {
+			r := group.runner
+			traffic = append(traffic, load.Traffic{
+				Name:   test.Name,
+				Group:  group.name,
+				Weight: group.traffic[i].weight,
+				Send: func() error {
+					return r.RunE2ETest(test)
+				},
+			})
+		}
+	}
+	return traffic, nil
+}
+
+// loadTestNames returns the names of the e2e tests of the traffic types
+func loadTestNames(traffic []loadTraffic) []string {
+	names := make([]string, 0, len(traffic))
+	for _, t := range traffic {
+		names = append(names, t.test)
+	}
+	return names
+}
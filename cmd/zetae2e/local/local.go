@@ -19,6 +19,7 @@ import (
 	zetae2econfig "github.com/zeta-chain/node/cmd/zetae2e/config"
 	"github.com/zeta-chain/node/e2e/config"
 	"github.com/zeta-chain/node/e2e/e2etests"
+	"github.com/zeta-chain/node/e2e/load"
 	"github.com/zeta-chain/node/e2e/runner"
 	"github.com/zeta-chain/node/e2e/txserver"
 	"github.com/zeta-chain/node/e2e/utils"
@@ -68,6 +69,12 @@ const (
 	flagV2ZETAFlows            = "v2-zeta-flows"
 	flagReceiptTimeout         = "receipt-timeout"
 	flagCctxTimeout            = "cctx-timeout"
+	flagScenarios              = "scenarios"
+	flagTestLoad               = "test-load"
+	flagLoadRate               = "load-rate"
+	flagLoadDuration           = "load-duration"
+	flagLoadChains             = "load-chains"
+	flagLoadReport             = "load-report"
 	previousVersion            = "v32.0.2"
 )
 
@@ -125,6 +132,16 @@ func NewLocalCmd() *cobra.Command {
 	cmd.Flags().Duration(flagTestTimeout, DefaultTestTimeout, "overall timeout for the e2e tests")
 	cmd.Flags().Duration(flagReceiptTimeout, DefaultReceiptTimeout, "timeout for waiting for transaction receipts")
 	cmd.Flags().Duration(flagCctxTimeout, DefaultCctxTimeout, "timeout for waiting for CCTX to reach desired status")
+	cmd.Flags().String(flagScenarios, "", "YAML file or directory of the fault injection scenarios to run after the tests")
+	cmd.Flags().Bool(flagTestLoad, false, "set to true to run the load test, regular tests will be skipped")
+	cmd.Flags().Float64(flagLoadRate, 1, "number of CCTXs per second sent by the load test")
+	cmd.Flags().Duration(flagLoadDuration, DefaultLoadDuration, "duration during which the load test sends CCTXs")
+	cmd.Flags().StringSlice(
+		flagLoadChains,
+		[]string{loadChainEVM, loadChainBitcoin},
+		"chains of the load test traffic: evm, bitcoin, solana, sui, ton",
+	)
+	cmd.Flags().String(flagLoadReport, "", "file to write the JSON report of the load test to")
 
 	cmd.AddCommand(NewGetZetaclientBootstrap())
 
@@ -169,6 +186,12 @@ func localE2ETest(cmd *cobra.Command, _ []string) {
 		testStaking            = must(cmd.Flags().GetBool(flagTestStaking))
 		testConnectorMigration = must(cmd.Flags().GetBool(flagTestConnectorMigration))
 		v2ZETAFlows            = must(cmd.Flags().GetBool(flagV2ZETAFlows))
+		scenariosPath          = must(cmd.Flags().GetString(flagScenarios))
+		testLoad               = must(cmd.Flags().GetBool(flagTestLoad))
+		loadRate               = must(cmd.Flags().GetFloat64(flagLoadRate))
+		loadDuration           = must(cmd.Flags().GetDuration(flagLoadDuration))
+		loadChains             = must(cmd.Flags().GetStringSlice(flagLoadChains))
+		loadReport             = must(cmd.Flags().GetString(flagLoadReport))
 
 		testStress        = testEthStress || testSolanaStress || testSuiStress || testZEVMStress
 		shouldSetupSolana = setupSolana || testSolana || testSolanaStress
@@ -200,6 +223,11 @@ func localE2ETest(cmd *cobra.Command, _ []string) {
 		skipRegular = true
 		timeouts = StressTestTimeouts(cmd, iterations)
 	}
+	if testLoad {
+		logger.Print("⚠️ load test enabled, regular tests will be skipped")
+		skipRegular = true
+		timeouts = LoadTestTimeouts(cmd, loadDuration)
+	}
 
 	logger.Print("⏱️  Test timeouts: TestTimeout=%s, ReceiptTimeout=%s, CctxTimeout=%s",
 		timeouts.TestTimeout, timeouts.ReceiptTimeout, timeouts.CctxTimeout)
@@ -439,6 +467,19 @@ func localE2ETest(cmd *cobra.Command, _ []string) {
 		)
 	}
 
+	// load test, sustained mixed traffic on the connected chains
+	if testLoad {
+		eg.Go(loadTestRoutine(conf, deployerRunner, verbose, loadOptions{
+			config: load.Config{
+				Rate:     loadRate,
+				Duration: loadDuration,
+			},
+			chains:           loadChains,
+			reportPath:       loadReport,
+			skipBitcoinSetup: skipBitcoinSetup,
+		}))
+	}
+
 	if testSolana {
 		if deployerRunner.SolanaClient == nil {
 			logger.Print("❌ solana client is nil, maybe solana rpc is not set")
@@ -627,7 +668,7 @@ func localE2ETest(cmd *cobra.Command, _ []string) {
 	// Default ballot maturity is set to 30 blocks.
 	// We can wait for 31 blocks to ensure that all ballots created during the test are matured, as emission rewards may be slashed for some observers based on their vote.
 	// This seems to be a problem only in performance tests where we are creating a lot of ballots in a short time. We do not need to slow down regular tests for this check as we expect all observers to vote correctly.
-	if testStress {
+	if testStress || testLoad {
 		deployerRunner.WaitForBlocks(31)
 	}
 
@@ -678,6 +719,15 @@ func localE2ETest(cmd *cobra.Command, _ []string) {
 
 	logger.Print("✅ e2e tests completed in %s", time.Since(testStartTime).String())
 
+	// run the fault injection scenarios once the tests are completed, the scenarios stop and partition zetaclients
+	if scenariosPath != "" {
+		if err := runScenarios(scenariosPath, conf, deployerRunner, verbose, testSolana, testSui, testTON); err != nil {
+			logger.Print("❌ %v", err)
+			logger.Print("❌ scenarios failed after %s", time.Since(testStartTime).String())
+			os.Exit(1)
+		}
+	}
+
 	if tssMigrationAddObs {
 		addNewObserver(deployerRunner)
 		triggerTSSMigration(deployerRunner, logger, verbose, conf, testSolana, testSui, testTON)
@@ -0,0 +1,118 @@
+package local
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/fatih/color"
+
+	"github.com/zeta-chain/node/e2e/config"
+	"github.com/zeta-chain/node/e2e/e2etests"
+	"github.com/zeta-chain/node/e2e/runner"
+	"github.com/zeta-chain/node/e2e/scenario"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+// scenarioNetwork is the localnet on which the scenarios are run
+type scenarioNetwork struct {
+	conf           config.Config
+	deployerRunner *runner.E2ERunner
+	logger         *runner.Logger
+	verbose        bool
+	testSolana     bool
+	testSui        bool
+	testTON        bool
+}
+
+var _ scenario.Network = (*scenarioNetwork)(nil)
+
+// RunTests implements scenario.Network
+// The tests are run with a dedicated runner so they can run in background while the TSS is rotated
+func (n *scenarioNetwork) RunTests(tests []runner.E2ETestRunConfig) error {
+	testRunner, err := initTestRunner(
+		"scenario",
+		n.conf,
+		n.deployerRunner,
+		n.conf.AdditionalAccounts.UserMisc,
+		runner.NewLogger(n.verbose, color.FgHiCyan, "scenario"),
+		runner.WithZetaTxServer(n.deployerRunner.ZetaTxServer),
+	)
+	if err != nil {
+		return err
+	}
+
+	testsToRun, err := testRunner.GetE2ETestsToRunByConfig(e2etests.AllE2ETests, tests)
+	if err != nil {
+		return err
+	}
+	return testRunner.RunE2ETests(testsToRun)
+}
+
+// RotateTSS implements scenario.Network
+func (n *scenarioNetwork) RotateTSS() error {
+	return rotateTSS(n.deployerRunner, n.logger, n.verbose, n.conf, n.testSolana, n.testSui, n.testTON)
+}
+
+// WaitForBlocks implements scenario.Network
+func (n *scenarioNetwork) WaitForBlocks(blocks int64) error {
+	n.deployerRunner.WaitForBlocks(blocks)
+	return nil
+}
+
+// Ballots implements scenario.Network
+func (n *scenarioNetwork) Ballots() ([]observertypes.Ballot, error) {
+	var (
+		ballots []observertypes.Ballot
+		nextKey []byte
+	)
+	for {
+		res, err := n.deployerRunner.ObserverClient.Ballots(n.deployerRunner.Ctx, &observertypes.QueryBallotsRequest{
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return nil, err
+		}
+		ballots = append(ballots, res.Ballots...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return ballots, nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
+
+// runScenarios runs the scenarios declared in the YAML file or directory at the given path
+func runScenarios(
+	path string,
+	conf config.Config,
+	deployerRunner *runner.E2ERunner,
+	verbose bool,
+	testSolana bool,
+	testSui bool,
+	testTON bool,
+) error {
+	scenarios, err := scenario.Load(path)
+	if err != nil {
+		return fmt.Errorf("failed to load scenarios: %w", err)
+	}
+
+	logger := runner.NewLogger(verbose, color.FgHiMagenta, "scenario")
+	logger.Print("🏃 starting %d scenarios", len(scenarios))
+	startTime := time.Now()
+
+	network := &scenarioNetwork{
+		conf:           conf,
+		deployerRunner: deployerRunner,
+		logger:         logger,
+		verbose:        verbose,
+		testSolana:     testSolana,
+		testSui:        testSui,
+		testTON:        testTON,
+	}
+	if err := scenario.NewRunner(scenario.SSHController{}, network, logger).RunAll(scenarios); err != nil {
+		return err
+	}
+
+	logger.Print("🍾 scenarios completed in %s", time.Since(startTime).String())
+	return nil
+}
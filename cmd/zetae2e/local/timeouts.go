@@ -13,6 +13,7 @@ const (
 	DefaultCctxTimeout    = 8 * time.Minute
 	StressReceiptTimeout  = 15 * time.Minute
 	StressCctxTimeout     = 15 * time.Minute
+	DefaultLoadDuration   = 10 * time.Minute
 )
 
 // TestTimeouts holds the various timeout values for E2E tests
@@ -62,3 +63,26 @@ func StressTestTimeouts(cmd *cobra.Command, iterations int) TestTimeouts {
 
 	return timeouts
 }
+
+// LoadTestTimeouts returns timeout values for load tests.
+// The overall TestTimeout leaves DefaultTestTimeout after the load duration to setup and drain the pending CCTXs.
+// Can be overridden by command flags.
+func LoadTestTimeouts(cmd *cobra.Command, loadDuration time.Duration) TestTimeouts {
+	timeouts := TestTimeouts{
+		TestTimeout:    loadDuration + DefaultTestTimeout,
+		ReceiptTimeout: StressReceiptTimeout,
+		CctxTimeout:    StressCctxTimeout,
+	}
+
+	if cmd.Flags().Changed(flagTestTimeout) {
+		timeouts.TestTimeout = must(cmd.Flags().GetDuration(flagTestTimeout))
+	}
+	if cmd.Flags().Changed(flagReceiptTimeout) {
+		timeouts.ReceiptTimeout = must(cmd.Flags().GetDuration(flagReceiptTimeout))
+	}
+	if cmd.Flags().Changed(flagCctxTimeout) {
+		timeouts.CctxTimeout = must(cmd.Flags().GetDuration(flagCctxTimeout))
+	}
+
+	return timeouts
+}
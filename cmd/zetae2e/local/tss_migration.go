@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/fatih/color"
-	"github.com/stretchr/testify/require"
 
 	"github.com/zeta-chain/node/e2e/config"
 	"github.com/zeta-chain/node/e2e/e2etests"
@@ -76,11 +75,32 @@ func triggerTSSMigration(
 	migrationStartTime := time.Now()
 	logger.Print("🏁 starting tss migration")
 
+	if err := rotateTSS(deployerRunner, logger, verbose, conf, testSolana, testSui, testTon); err != nil {
+		logger.Print("❌ %v", err)
+		logger.Print("❌ tss migration failed")
+		os.Exit(1)
+	}
+	logger.Print("✅ migration completed in %s ", time.Since(migrationStartTime).String())
+}
+
+// rotateTSS generates a new TSS, migrates the funds of the current TSS to it
+// and updates the TSS address of the contracts in the connected chains
+func rotateTSS(
+	deployerRunner *runner.E2ERunner,
+	logger *runner.Logger,
+	verbose bool,
+	conf config.Config,
+	testSolana bool,
+	testSui bool,
+	testTon bool,
+) error {
 	tssList, err := deployerRunner.ObserverClient.TssHistory(
 		deployerRunner.Ctx,
 		&observertypes.QueryTssHistoryRequest{},
 	)
-	require.NoError(deployerRunner, err)
+	if err != nil {
+		return fmt.Errorf("failed to query TSS history: %w", err)
+	}
 	// Increase this number to generate more than 1 TSS.
 	// The migration always happens to the latest one, this is set on zetacore directly
 	numberOfTssToGenerate := 1
@@ -94,25 +114,26 @@ func triggerTSSMigration(
 			deployerRunner.Ctx,
 			&crosschaintypes.QueryLastZetaHeightRequest{},
 		)
-		require.NoError(deployerRunner, err)
-		err = deployerRunner.ZetaTxServer.UpdateKeygen(response.Height)
-		require.NoError(deployerRunner, err)
+		if err != nil {
+			return fmt.Errorf("failed to query last zeta height: %w", err)
+		}
+		if err := deployerRunner.ZetaTxServer.UpdateKeygen(response.Height); err != nil {
+			return fmt.Errorf("failed to update keygen: %w", err)
+		}
 
 		// Generate new TSS
-		noError(
-			waitKeygenHeight(deployerRunner.Ctx, deployerRunner.CctxClient, deployerRunner.ObserverClient, logger, 0),
-		)
+		err = waitKeygenHeight(deployerRunner.Ctx, deployerRunner.CctxClient, deployerRunner.ObserverClient, logger, 0)
+		if err != nil {
+			return fmt.Errorf("failed to wait for keygen: %w", err)
+		}
 	}
 
 	// Run migration
 	// migrationRoutine runs migration e2e test , which migrates funds from the older TSS to the new one
 	// The zetaclient restarts required for this process are managed by the background workers in zetaclient (TSSListener)
 	fn := tssMigrationTestRoutine(conf, deployerRunner, verbose, expectedTssCount, e2etests.TestMigrateTSSName)
-
 	if err := fn(); err != nil {
-		logger.Print("❌ %v", err)
-		logger.Print("❌ tss migration failed")
-		os.Exit(1)
+		return err
 	}
 
 	// Update TSS address for contracts in connected chains
@@ -136,5 +157,5 @@ func triggerTSSMigration(
 			conf.RPCs.TONFaucet,
 		)
 	}
-	logger.Print("✅ migration completed in %s ", time.Since(migrationStartTime).String())
+	return nil
 }
@@ -0,0 +1,607 @@
+package cli
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/sui"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/gagliardetto/solana-go"
+	solrpc "github.com/gagliardetto/solana-go/rpc"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/tonkeeper/tongo/ton"
+	"github.com/tonkeeper/tongo/wallet"
+	"golang.org/x/crypto/ed25519"
+
+	"github.com/zeta-chain/node/cmd/zetatool/clients"
+	zetatoolcommon "github.com/zeta-chain/node/cmd/zetatool/common"
+	"github.com/zeta-chain/node/cmd/zetatool/config"
+	"github.com/zeta-chain/node/cmd/zetatool/inbound"
+	pkgchains "github.com/zeta-chain/node/pkg/chains"
+	suicontracts "github.com/zeta-chain/node/pkg/contracts/sui"
+	toncontracts "github.com/zeta-chain/node/pkg/contracts/ton"
+	"github.com/zeta-chain/node/pkg/rpc"
+	tonrpc "github.com/zeta-chain/node/zetaclient/chains/ton/rpc"
+)
+
+const (
+	// FlagOperation is the flag for the inbound operation
+	FlagOperation = "op"
+	// FlagReceiver is the flag for the receiver on ZEVM
+	FlagReceiver = "receiver"
+	// FlagAmount is the flag for the amount in the smallest unit of the gas token
+	FlagAmount = "amount"
+	// FlagPayload is the flag for the hex encoded payload of the call
+	FlagPayload = "payload"
+	// FlagRevertAddress is the flag for the revert address on the connected chain
+	FlagRevertAddress = "revert-address"
+	// FlagAbortAddress is the flag for the abort address on ZEVM
+	FlagAbortAddress = "abort-address"
+	// FlagCallOnRevert is the flag to call the revert address on revert
+	FlagCallOnRevert = "call-on-revert"
+	// FlagRevertMessage is the flag for the hex encoded revert message
+	FlagRevertMessage = "revert-message"
+	// FlagSender is the flag for the sender on the connected chain
+	FlagSender = "sender"
+	// FlagGateway is the flag to override the gateway, or the TSS address for Bitcoin
+	FlagGateway = "gateway"
+	// FlagInscription is the flag to send a Bitcoin memo as an inscription
+	FlagInscription = "inscription"
+	// FlagInternalKey is the flag for the hex encoded taproot internal key of a Bitcoin inscription
+	FlagInternalKey = "internal-key"
+	// FlagSuiCoin is the flag for the Sui coin object to deposit
+	FlagSuiCoin = "sui-coin"
+	// FlagFeeRate is the flag for the Bitcoin fee rate in sat/vB
+	FlagFeeRate = "fee-rate"
+	// FlagBroadcast is the flag to sign and broadcast the inbound
+	FlagBroadcast = "broadcast"
+
+	// EnvPrivateKey is the environment variable holding the private key of the sender
+	EnvPrivateKey = "ZETATOOL_PRIVATE_KEY"
+
+	// suiSplitGasBudget is the gas budget of the transaction splitting the deposited Sui coin
+	suiSplitGasBudget = "10000000"
+)
+
+// NewInboundCMD creates a new command to build and broadcast inbounds from non-EVM chains
+func NewInboundCMD() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inbound",
+		Short: "Build and broadcast test inbounds to ZetaChain",
+	}
+
+	cmd.AddCommand(newInboundBuildCMD())
+
+	return cmd
+}
+
+// newInboundBuildCMD creates a new command to build an inbound and optionally broadcast it
+func newInboundBuildCMD() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "build <chain>",
+		Short: "Build and broadcast an inbound to ZetaChain from a non-EVM chain",
+		Long: `Build an inbound transaction to ZetaChain from Bitcoin, Solana, Sui or TON.
+
+The inbound is validated against the parser of the observers before being printed as JSON, an
+inbound that would be ignored or rejected by the observers is never broadcasted. By default the
+gateway is read from the chain params on ZetaChain, and the TSS address is used for Bitcoin.
+
+The private key of the sender is read from the ` + EnvPrivateKey + ` environment variable:
+  - Bitcoin: WIF
+  - Solana: base58
+  - Sui: hex encoded secp256k1 key
+  - TON: hex encoded ed25519 seed of a V5R1 wallet
+
+When the key is set, the sender is derived from it. Signing and broadcasting requires --broadcast.
+
+The chain argument can be:
+  - A chain ID (e.g., 18333, 901)
+  - A chain name (e.g., btc_signet_testnet, solana_devnet)
+
+Examples:
+  zetatool inbound build btc_signet_testnet --op deposit --receiver 0x... --amount 10000 --sender tb1q...
+  zetatool inbound build solana_devnet --op call --receiver 0x... --payload 68656c6c6f --broadcast
+  zetatool inbound build sui_testnet --op deposit_and_call --receiver 0x... --amount 1000000 --broadcast`,
+		Args: cobra.ExactArgs(1),
+		RunE: runInbound,
+	}
+
+	cmd.Flags().String(FlagOperation, string(inbound.OpDeposit), "Operation: deposit, deposit_and_call or call")
+	cmd.Flags().String(FlagReceiver, "", "Receiver on ZEVM")
+	cmd.Flags().Uint64(FlagAmount, 0, "Amount in the smallest unit of the gas token of the chain")
+	cmd.Flags().String(FlagPayload, "", "Hex encoded payload of the call")
+	cmd.Flags().String(FlagRevertAddress, "", "Revert address on the connected chain")
+	cmd.Flags().String(FlagAbortAddress, "", "Abort address on ZEVM")
+	cmd.Flags().Bool(FlagCallOnRevert, false, "Call the revert address on revert")
+	cmd.Flags().String(FlagRevertMessage, "", "Hex encoded revert message")
+	cmd.Flags().String(FlagSender, "", "Sender on the connected chain, derived from the private key if not set")
+	cmd.Flags().String(FlagGateway, "", "Gateway, or TSS address for Bitcoin, read from ZetaChain if not set")
+	cmd.Flags().Bool(FlagInscription, false, "Send the Bitcoin memo as an inscription even if it fits an OP_RETURN")
+	cmd.Flags().String(FlagInternalKey, "", "Hex encoded taproot internal key of a Bitcoin inscription")
+	cmd.Flags().String(FlagSuiCoin, "", "Sui coin object to deposit, split from the sender coins if not set")
+	cmd.Flags().Int64(FlagFeeRate, 0, "Bitcoin fee rate in sat/vB, the recommended half hour fee if not set")
+	cmd.Flags().Bool(FlagBroadcast, false, "Sign and broadcast the inbound with the private key of the sender")
+
+	return cmd
+}
+
+// inboundFlags are the flags of the inbound command
+type inboundFlags struct {
+	params      inbound.Params
+	internalKey string
+	feeRate     int64
+	broadcast   bool
+}
+
+func runInbound(cmd *cobra.Command, args []string) error {
+	chainArg := args[0]
+
+	chain, err := zetatoolcommon.ResolveChain(chainArg)
+	if err != nil {
+		return fmt.Errorf("failed to resolve chain %q: %w", chainArg, err)
+	}
+
+	network := zetatoolcommon.NetworkTypeFromChain(chain)
+
+	configFile, err := cmd.Flags().GetString(config.FlagConfig)
+	if err != nil {
+		return fmt.Errorf("failed to read value for flag %s: %w", config.FlagConfig, err)
+	}
+
+	flags, err := readInboundFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := config.GetConfigByNetwork(network, configFile)
+	if err != nil {
+		return fmt.Errorf("failed to get config: %w", err)
+	}
+
+	ctx := context.Background()
+
+	sender, err := newInboundSender(chain, cfg, os.Getenv(EnvPrivateKey))
+	if err != nil {
+		return err
+	}
+
+	params := flags.params
+	if params.Sender == "" && sender != nil {
+		params.Sender = sender.address
+	}
+	if flags.broadcast && sender == nil {
+		return fmt.Errorf("%s must be set to broadcast", EnvPrivateKey)
+	}
+
+	if err := setInboundKeys(&params, flags, sender); err != nil {
+		return err
+	}
+
+	if params.Gateway == "" {
+		params.Gateway, err = getInboundGateway(ctx, cfg, chain)
+		if err != nil {
+			return err
+		}
+	}
+
+	if chain.Vm == pkgchains.Vm_mvm_sui && params.SuiCoinObjectID == "" && flags.broadcast {
+		params.SuiCoinObjectID, err = splitSuiCoin(ctx, cfg, sender, params.Amount)
+		if err != nil {
+			return err
+		}
+	}
+
+	payload, err := inbound.Build(chain, params)
+	if err != nil {
+		return err
+	}
+
+	// a call to the TON gateway only carries the fee of the gateway
+	if payload.TON != nil && params.Operation == inbound.OpCall {
+		payload.TON.Value, err = getTONCallFee(ctx, cfg, payload.TON.Gateway)
+		if err != nil {
+			return err
+		}
+	}
+
+	output, err := json.MarshalIndent(payload, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+	fmt.Println(string(output))
+
+	if !flags.broadcast {
+		return nil
+	}
+
+	return broadcastInbound(ctx, cfg, chain, payload, sender, flags.feeRate)
+}
+
+// readInboundFlags reads the flags of the inbound command
+func readInboundFlags(cmd *cobra.Command) (inboundFlags, error) {
+	var (
+		flags inboundFlags
+		err   error
+	)
+
+	stringFlags := map[string]*string{
+		FlagSender:        &flags.params.Sender,
+		FlagGateway:       &flags.params.Gateway,
+		FlagRevertAddress: &flags.params.RevertAddress,
+		FlagAbortAddress:  &flags.params.AbortAddress,
+		FlagInternalKey:   &flags.internalKey,
+		FlagSuiCoin:       &flags.params.SuiCoinObjectID,
+	}
+	for name, value := range stringFlags {
+		if *value, err = cmd.Flags().GetString(name); err != nil {
+			return flags, fmt.Errorf("failed to read value for flag %s: %w", name, err)
+		}
+	}
+
+	hexFlags := map[string]*[]byte{
+		FlagPayload:       &flags.params.Payload,
+		FlagRevertMessage: &flags.params.RevertMessage,
+	}
+	for name, value := range hexFlags {
+		encoded, err := cmd.Flags().GetString(name)
+		if err != nil {
+			return flags, fmt.Errorf("failed to read value for flag %s: %w", name, err)
+		}
+		if encoded == "" {
+			continue
+		}
+		if *value, err = hex.DecodeString(strings.TrimPrefix(encoded, "0x")); err != nil {
+			return flags, fmt.Errorf("invalid hex value for flag %s: %w", name, err)
+		}
+	}
+
+	boolFlags := map[string]*bool{
+		FlagCallOnRevert: &flags.params.CallOnRevert,
+		FlagInscription:  &flags.params.Bitcoin.Inscription,
+		FlagBroadcast:    &flags.broadcast,
+	}
+	for name, value := range boolFlags {
+		if *value, err = cmd.Flags().GetBool(name); err != nil {
+			return flags, fmt.Errorf("failed to read value for flag %s: %w", name, err)
+		}
+	}
+
+	operation, err := cmd.Flags().GetString(FlagOperation)
+	if err != nil {
+		return flags, fmt.Errorf("failed to read value for flag %s: %w", FlagOperation, err)
+	}
+	if flags.params.Operation, err = inbound.ParseOperation(operation); err != nil {
+		return flags, err
+	}
+
+	receiver, err := cmd.Flags().GetString(FlagReceiver)
+	if err != nil {
+		return flags, fmt.Errorf("failed to read value for flag %s: %w", FlagReceiver, err)
+	}
+	if !ethcommon.IsHexAddress(receiver) {
+		return flags, fmt.Errorf("invalid receiver %q", receiver)
+	}
+	flags.params.Receiver = ethcommon.HexToAddress(receiver)
+
+	if flags.params.Amount, err = cmd.Flags().GetUint64(FlagAmount); err != nil {
+		return flags, fmt.Errorf("failed to read value for flag %s: %w", FlagAmount, err)
+	}
+
+	if flags.feeRate, err = cmd.Flags().GetInt64(FlagFeeRate); err != nil {
+		return flags, fmt.Errorf("failed to read value for flag %s: %w", FlagFeeRate, err)
+	}
+
+	return flags, nil
+}
+
+// inboundSender is the private key of the sender decoded for its chain
+type inboundSender struct {
+	address string
+
+	bitcoin *btcec.PrivateKey
+	solana  solana.PrivateKey
+	sui     *suicontracts.SignerSecp256k1
+	ton     *wallet.Wallet
+}
+
+// newInboundSender decodes the private key of the sender for the chain, nil if the key is not set
+func newInboundSender(chain pkgchains.Chain, cfg *config.Config, key string) (*inboundSender, error) {
+	if key == "" {
+		return nil, nil
+	}
+
+	switch {
+	case chain.Consensus == pkgchains.Consensus_bitcoin:
+		wif, err := btcutil.DecodeWIF(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Bitcoin private key: %w", err)
+		}
+		params, err := pkgchains.BitcoinNetParamsFromChainID(chain.ChainId)
+		if err != nil {
+			return nil, err
+		}
+		address, err := btcutil.NewAddressWitnessPubKeyHash(
+			btcutil.Hash160(wif.PrivKey.PubKey().SerializeCompressed()),
+			params,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to derive Bitcoin address: %w", err)
+		}
+		return &inboundSender{address: address.EncodeAddress(), bitcoin: wif.PrivKey}, nil
+
+	case chain.Vm == pkgchains.Vm_svm:
+		privateKey, err := solana.PrivateKeyFromBase58(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Solana private key: %w", err)
+		}
+		return &inboundSender{address: privateKey.PublicKey().String(), solana: privateKey}, nil
+
+	case chain.Vm == pkgchains.Vm_mvm_sui:
+		privateKey, err := hex.DecodeString(strings.TrimPrefix(key, "0x"))
+		if err != nil || len(privateKey) != 32 {
+			return nil, fmt.Errorf("invalid Sui private key")
+		}
+		signer := suicontracts.NewSignerSecp256k1(privateKey)
+		return &inboundSender{address: signer.Address(), sui: signer}, nil
+
+	case chain.Vm == pkgchains.Vm_tvm:
+		seed, err := hex.DecodeString(strings.TrimPrefix(key, "0x"))
+		if err != nil || len(seed) != ed25519.SeedSize {
+			return nil, fmt.Errorf("invalid TON private key")
+		}
+		w, err := inbound.NewTONWallet(ed25519.NewKeyFromSeed(seed), tonrpc.New(cfg.TonRPC, chain.ChainId))
+		if err != nil {
+			return nil, err
+		}
+		return &inboundSender{address: w.GetAddress().ToRaw(), ton: w}, nil
+
+	default:
+		return nil, fmt.Errorf("inbound not supported on chain %d", chain.ChainId)
+	}
+}
+
+// setInboundKeys sets the taproot internal key of a Bitcoin inscription, the sender key by default
+func setInboundKeys(params *inbound.Params, flags inboundFlags, sender *inboundSender) error {
+	switch {
+	case flags.internalKey != "":
+		encoded, err := hex.DecodeString(strings.TrimPrefix(flags.internalKey, "0x"))
+		if err != nil {
+			return fmt.Errorf("invalid internal key: %w", err)
+		}
+		params.Bitcoin.InternalKey, err = btcec.ParsePubKey(encoded)
+		if err != nil {
+			return fmt.Errorf("invalid internal key: %w", err)
+		}
+	case sender != nil && sender.bitcoin != nil:
+		params.Bitcoin.InternalKey = sender.bitcoin.PubKey()
+	}
+	return nil
+}
+
+// getInboundGateway returns the TSS address for Bitcoin and the gateway of the chain params otherwise
+func getInboundGateway(ctx context.Context, cfg *config.Config, chain pkgchains.Chain) (string, error) {
+	zetacoreClient, err := rpc.NewCometBFTClients(cfg.ZetaChainRPC)
+	if err != nil {
+		return "", fmt.Errorf("failed to create zetacore client: %w", err)
+	}
+
+	if chain.Consensus == pkgchains.Consensus_bitcoin {
+		tssAddress, err := zetacoreClient.GetBTCTSSAddress(ctx, chain.ChainId)
+		if err != nil {
+			return "", err
+		}
+		return tssAddress, nil
+	}
+
+	chainParams, err := zetacoreClient.GetChainParamsForChainID(ctx, chain.ChainId)
+	if err != nil {
+		return "", fmt.Errorf("failed to get chain params for chain %d: %w", chain.ChainId, err)
+	}
+	if chainParams.GatewayAddress == "" {
+		return "", fmt.Errorf("no gateway set for chain %d", chain.ChainId)
+	}
+
+	return chainParams.GatewayAddress, nil
+}
+
+// getTONCallFee returns the fee charged by the TON gateway for a call
+func getTONCallFee(ctx context.Context, cfg *config.Config, gateway string) (uint64, error) {
+	gatewayID, err := ton.ParseAccountID(gateway)
+	if err != nil {
+		return 0, fmt.Errorf("invalid gateway address: %w", err)
+	}
+
+	fee, err := toncontracts.NewGateway(gatewayID).GetTxFee(ctx, tonrpc.New(cfg.TonRPC, 0), toncontracts.OpCall)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get TON gateway call fee: %w", err)
+	}
+
+	return fee.Uint64(), nil
+}
+
+// splitSuiCoin splits a coin of the sender holding exactly the deposited amount
+func splitSuiCoin(ctx context.Context, cfg *config.Config, sender *inboundSender, amount uint64) (string, error) {
+	client := sui.NewSuiClient(cfg.SuiRPC)
+
+	coins, err := client.SuiXGetCoins(ctx, models.SuiXGetCoinsRequest{
+		Owner:    sender.address,
+		CoinType: string(suicontracts.SUI),
+		Limit:    50,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get coins of %s: %w", sender.address, err)
+	}
+	if len(coins.Data) == 0 {
+		return "", fmt.Errorf("no SUI coin owned by %s", sender.address)
+	}
+
+	coinIDs := make([]string, 0, len(coins.Data))
+	for _, coin := range coins.Data {
+		coinIDs = append(coinIDs, coin.CoinObjectId)
+	}
+
+	tx, err := client.PaySui(ctx, models.PaySuiRequest{
+		Signer:      sender.address,
+		SuiObjectId: coinIDs,
+		Recipient:   []string{sender.address},
+		Amount:      []string{strconv.FormatUint(amount, 10)},
+		GasBudget:   suiSplitGasBudget,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create split transaction: %w", err)
+	}
+
+	resp, err := executeSuiTx(ctx, client, sender.sui, tx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, change := range resp.ObjectChanges {
+		if change.Type == "created" && strings.HasSuffix(change.ObjectType, "<"+string(suicontracts.SUI)+">") {
+			log.Info().Str("coin", change.ObjectId).Str("tx", resp.Digest).Msg("split SUI coin")
+			return change.ObjectId, nil
+		}
+	}
+
+	return "", fmt.Errorf("no coin created by split transaction %s", resp.Digest)
+}
+
+// executeSuiTx signs and executes a Sui transaction and checks its status
+func executeSuiTx(
+	ctx context.Context,
+	client sui.ISuiAPI,
+	signer *suicontracts.SignerSecp256k1,
+	tx models.TxnMetaData,
+) (models.SuiTransactionBlockResponse, error) {
+	signature, err := signer.SignTxBlock(tx)
+	if err != nil {
+		return models.SuiTransactionBlockResponse{}, fmt.Errorf("failed to sign transaction: %w", err)
+	}
+
+	resp, err := client.SuiExecuteTransactionBlock(ctx, models.SuiExecuteTransactionBlockRequest{
+		TxBytes:   tx.TxBytes,
+		Signature: []string{signature},
+		Options: models.SuiTransactionBlockOptions{
+			ShowEffects:       true,
+			ShowObjectChanges: true,
+		},
+		RequestType: "WaitForLocalExecution",
+	})
+	switch {
+	case err != nil:
+		return resp, fmt.Errorf("failed to execute transaction: %w", err)
+	case resp.Effects.Status.Status != "success":
+		return resp, fmt.Errorf("transaction %s failed: %s", resp.Digest, resp.Effects.Status.Error)
+	}
+
+	return resp, nil
+}
+
+// broadcastInbound signs the inbound with the key of the sender and broadcasts it to the chain
+func broadcastInbound(
+	ctx context.Context,
+	cfg *config.Config,
+	chain pkgchains.Chain,
+	payload *inbound.Payload,
+	sender *inboundSender,
+	feeRate int64,
+) error {
+	switch {
+	case payload.Bitcoin != nil:
+		return broadcastBitcoinInbound(ctx, chain, payload, sender.bitcoin, feeRate)
+
+	case payload.Solana != nil:
+		client := solrpc.New(cfg.SolanaRPC)
+		blockhash, err := client.GetLatestBlockhash(ctx, solrpc.CommitmentConfirmed)
+		if err != nil {
+			return fmt.Errorf("failed to get latest blockhash: %w", err)
+		}
+		tx, err := inbound.SignSolana(payload, blockhash.Value.Blockhash, sender.solana)
+		if err != nil {
+			return err
+		}
+		signature, err := client.SendTransaction(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to send transaction: %w", err)
+		}
+		log.Info().Str("tx", signature.String()).Msg("broadcasted Solana inbound")
+
+	case payload.Sui != nil:
+		client := sui.NewSuiClient(cfg.SuiRPC)
+		tx, err := client.MoveCall(ctx, payload.Sui.MoveCallRequest)
+		if err != nil {
+			return fmt.Errorf("failed to create move call: %w", err)
+		}
+		resp, err := executeSuiTx(ctx, client, sender.sui, tx)
+		if err != nil {
+			return err
+		}
+		log.Info().Str("tx", resp.Digest).Msg("broadcasted Sui inbound")
+
+	case payload.TON != nil:
+		if err := inbound.SendTON(ctx, payload, sender.ton); err != nil {
+			return fmt.Errorf("failed to send TON message: %w", err)
+		}
+		log.Info().Str("wallet", sender.address).Msg("sent TON inbound message")
+	}
+
+	return nil
+}
+
+// broadcastBitcoinInbound signs the inbound with the confirmed UTXOs of the sender and broadcasts it
+func broadcastBitcoinInbound(
+	ctx context.Context,
+	chain pkgchains.Chain,
+	payload *inbound.Payload,
+	key *btcec.PrivateKey,
+	feeRate int64,
+) error {
+	if feeRate == 0 {
+		rates, err := clients.GetBTCFeeRates(ctx, chain.ChainId)
+		if err != nil {
+			return err
+		}
+		feeRate = rates.HalfHourFee
+	}
+
+	fetched, err := clients.GetBTCUTXOs(ctx, payload.Sender, chain.ChainId)
+	if err != nil {
+		return err
+	}
+
+	utxos := make([]inbound.BitcoinUTXO, 0, len(fetched))
+	for _, utxo := range fetched {
+		if utxo.Status.Confirmed {
+			utxos = append(utxos, inbound.BitcoinUTXO{TxID: utxo.TxID, Vout: utxo.Vout, Value: utxo.Value})
+		}
+	}
+
+	txs, err := inbound.SignBitcoin(chain, payload, utxos, key, feeRate)
+	if err != nil {
+		return err
+	}
+
+	// the reveal transaction of an inscription spends the commit transaction, broadcast in order
+	for _, tx := range txs {
+		txHex, err := inbound.SerializeBitcoinTx(tx)
+		if err != nil {
+			return err
+		}
+		txID, err := clients.BroadcastBTCTx(ctx, txHex, chain.ChainId)
+		if err != nil {
+			return err
+		}
+		log.Info().Str("tx", txID).Msg("broadcasted Bitcoin inbound transaction")
+	}
+
+	return nil
+}
@@ -0,0 +1,333 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"os"
+	"strconv"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/protocol-contracts-evm/pkg/zrc20.sol"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/zeta-chain/node/cmd/zetatool/clients"
+	zetatoolcommon "github.com/zeta-chain/node/cmd/zetatool/common"
+	"github.com/zeta-chain/node/cmd/zetatool/config"
+	"github.com/zeta-chain/node/cmd/zetatool/solvency"
+	pkgchains "github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/rpc"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+const (
+	// FlagHeight is the flag to pin the ZetaChain height of the audit
+	FlagHeight = "height"
+	// FlagJSON is the flag to output the report as JSON
+	FlagJSON = "json"
+	// FlagRecord is the flag to record the RPC responses of the audit into a fixture file
+	FlagRecord = "record"
+	// FlagFixture is the flag to replay the audit offline from a fixture file
+	FlagFixture = "fixture"
+)
+
+// NewTSSSolvencyCMD creates a new command to audit the solvency of the TSS across all chains
+func NewTSSSolvencyCMD() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tss-solvency <chain>",
+		Short: "Compare the assets held by the TSS with the ZRC20 supply",
+		Long: `Audit the solvency of the TSS for every whitelisted asset of the supported chains.
+
+For each asset, the balance held on the connected chain by the TSS, the ERC20 custody or the gateway
+is compared with the ZRC20 total supply on ZEVM. The outbounds pending on ZetaChain are subtracted
+from the balance as they are no longer backed by ZRC20, the gas ZRC20 minted by the protocol into the
+ZETA/gas pools is subtracted from the supply as it was never deposited. ZetaChain and ZEVM are queried
+at the same height, connected chains are queried at their latest block.
+
+The non-gas assets of Sui and TON can't be audited, they are listed in the header of the report.
+
+The chain argument can be:
+  - A chain ID (e.g., 7000, 1, 56)
+  - A chain name (e.g., zeta_mainnet, eth_mainnet)
+
+The network type (mainnet/testnet/etc) is inferred from the chain.
+
+Examples:
+  zetatool tss-solvency zeta_mainnet
+  zetatool tss-solvency zeta_mainnet --height 10500000 --json
+  zetatool tss-solvency zeta_testnet --record testnet.json
+  zetatool tss-solvency zeta_testnet --fixture testnet.json`,
+		Args: cobra.ExactArgs(1),
+		RunE: getTSSSolvency,
+	}
+
+	cmd.Flags().Int64(FlagHeight, 0, "ZetaChain height of the audit, the latest height if not set")
+	cmd.Flags().Bool(FlagJSON, false, "Output the report as JSON")
+	cmd.Flags().String(FlagRecord, "", "Record the RPC responses into a fixture file")
+	cmd.Flags().String(FlagFixture, "", "Replay the audit from a fixture file without querying any RPC")
+
+	return cmd
+}
+
+func getTSSSolvency(cmd *cobra.Command, args []string) error {
+	chainArg := args[0]
+
+	chain, err := zetatoolcommon.ResolveChain(chainArg)
+	if err != nil {
+		return fmt.Errorf("failed to resolve chain %q: %w", chainArg, err)
+	}
+
+	network := zetatoolcommon.NetworkTypeFromChain(chain)
+
+	configFile, err := cmd.Flags().GetString(config.FlagConfig)
+	if err != nil {
+		return fmt.Errorf("failed to read value for flag %s: %w", config.FlagConfig, err)
+	}
+
+	height, err := cmd.Flags().GetInt64(FlagHeight)
+	if err != nil {
+		return fmt.Errorf("failed to read value for flag %s: %w", FlagHeight, err)
+	}
+
+	outputJSON, err := cmd.Flags().GetBool(FlagJSON)
+	if err != nil {
+		return fmt.Errorf("failed to read value for flag %s: %w", FlagJSON, err)
+	}
+
+	recordFile, err := cmd.Flags().GetString(FlagRecord)
+	if err != nil {
+		return fmt.Errorf("failed to read value for flag %s: %w", FlagRecord, err)
+	}
+
+	fixtureFile, err := cmd.Flags().GetString(FlagFixture)
+	if err != nil {
+		return fmt.Errorf("failed to read value for flag %s: %w", FlagFixture, err)
+	}
+
+	ctx := context.Background()
+
+	var source solvency.Source
+	if fixtureFile != "" {
+		fixture, err := solvency.LoadFixture(fixtureFile)
+		if err != nil {
+			return err
+		}
+		height = fixture.ZetaHeight
+		source = solvency.NewFixtureSource(fixture)
+	} else {
+		cfg, err := config.GetConfigByNetwork(network, configFile)
+		if err != nil {
+			return fmt.Errorf("failed to get config: %w", err)
+		}
+
+		rpcSource, err := newRPCSolvencySource(ctx, cfg, network, height)
+		if err != nil {
+			return err
+		}
+		height = rpcSource.height
+		source = rpcSource
+	}
+
+	var recorder *solvency.RecordingSource
+	if recordFile != "" {
+		recorder = solvency.NewRecordingSource(source, height)
+		source = recorder
+	}
+
+	report, err := solvency.Audit(ctx, source, height)
+	if err != nil {
+		return fmt.Errorf("failed to audit TSS solvency: %w", err)
+	}
+
+	if recorder != nil {
+		if err := recorder.Fixture().Save(recordFile); err != nil {
+			return err
+		}
+	}
+
+	if outputJSON {
+		return report.WriteJSON(os.Stdout)
+	}
+	report.WriteTable(os.Stdout)
+	return nil
+}
+
+// rpcSolvencySource is a solvency.Source querying ZetaChain, ZEVM and the connected chains
+type rpcSolvencySource struct {
+	cfg        *config.Config
+	network    string
+	height     int64
+	zetacore   rpc.Clients
+	zevmClient *ethclient.Client
+}
+
+var _ solvency.Source = (*rpcSolvencySource)(nil)
+
+// newRPCSolvencySource creates a source pinned at a ZetaChain height, the latest height is used if height is zero
+func newRPCSolvencySource(
+	ctx context.Context,
+	cfg *config.Config,
+	network string,
+	height int64,
+) (*rpcSolvencySource, error) {
+	if cfg.ZetaChainRPC == "" {
+		return nil, fmt.Errorf("ZetaChainRPC is not configured for network %s", network)
+	}
+	if cfg.ZEVMRPC == "" {
+		return nil, fmt.Errorf("ZEVMRPC is not configured for network %s", network)
+	}
+
+	zetacoreClient, err := rpc.NewCometBFTClients(cfg.ZetaChainRPC)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create zetacore client: %w", err)
+	}
+
+	zevmClient, err := ethclient.Dial(cfg.ZEVMRPC)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create zevm client: %w", err)
+	}
+
+	if height == 0 {
+		height, err = zetacoreClient.GetBlockHeight(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get zetachain height: %w", err)
+		}
+	}
+
+	return &rpcSolvencySource{
+		cfg:        cfg,
+		network:    network,
+		height:     height,
+		zetacore:   zetacoreClient,
+		zevmClient: zevmClient,
+	}, nil
+}
+
+// pinned returns a context querying zetacore at the pinned height
+func (s *rpcSolvencySource) pinned(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(s.height, 10))
+}
+
+// ForeignCoins implements solvency.Source
+func (s *rpcSolvencySource) ForeignCoins(ctx context.Context) ([]fungibletypes.ForeignCoins, error) {
+	res, err := s.zetacore.Fungible.ForeignCoinsAll(s.pinned(ctx), &fungibletypes.QueryAllForeignCoinsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return res.ForeignCoins, nil
+}
+
+// SupportedChains implements solvency.Source
+func (s *rpcSolvencySource) SupportedChains(ctx context.Context) ([]pkgchains.Chain, error) {
+	return s.zetacore.GetSupportedChains(s.pinned(ctx))
+}
+
+// ChainParams implements solvency.Source
+func (s *rpcSolvencySource) ChainParams(ctx context.Context, chainID int64) (*observertypes.ChainParams, error) {
+	return s.zetacore.GetChainParamsForChainID(s.pinned(ctx), chainID)
+}
+
+// TSSAddresses implements solvency.Source
+func (s *rpcSolvencySource) TSSAddresses(ctx context.Context) (*observertypes.QueryGetTssAddressResponse, error) {
+	btcChainID, err := clients.GetBTCChainID(s.network)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get BTC chain ID: %w", err)
+	}
+	req := &observertypes.QueryGetTssAddressRequest{BitcoinChainId: btcChainID}
+	return s.zetacore.Observer.GetTssAddress(s.pinned(ctx), req)
+}
+
+// PendingCctxs implements solvency.Source
+func (s *rpcSolvencySource) PendingCctxs(ctx context.Context, chainID int64) ([]*crosschaintypes.CrossChainTx, error) {
+	cctxs, totalPending, err := s.zetacore.ListPendingCCTX(s.pinned(ctx), chainID)
+	if err != nil {
+		return nil, err
+	}
+	if totalPending > uint64(len(cctxs)) {
+		log.Warn().
+			Int64("chain_id", chainID).
+			Uint64("total_pending", totalPending).
+			Int("listed", len(cctxs)).
+			Msg("not all pending cctxs are listed, the pending outbound amount is underestimated")
+	}
+	return cctxs, nil
+}
+
+// ZRC20TotalSupply implements solvency.Source
+func (s *rpcSolvencySource) ZRC20TotalSupply(ctx context.Context, zrc20Address string) (*big.Int, error) {
+	caller, err := zrc20.NewZRC20Caller(ethcommon.HexToAddress(zrc20Address), s.zevmClient)
+	if err != nil {
+		return nil, err
+	}
+	return caller.TotalSupply(&bind.CallOpts{Context: ctx, BlockNumber: big.NewInt(s.height)})
+}
+
+// Balance implements solvency.Source
+func (s *rpcSolvencySource) Balance(
+	ctx context.Context,
+	chain pkgchains.Chain,
+	asset, holder string,
+) (*big.Int, error) {
+	chainRPC := getRPCForChain(s.cfg, chain)
+	if chainRPC == "" && chain.Vm != pkgchains.Vm_no_vm {
+		return nil, fmt.Errorf("RPC not configured")
+	}
+
+	switch {
+	case chain.Vm == pkgchains.Vm_evm && asset == "":
+		return clients.GetEVMBalance(ctx, chainRPC, ethcommon.HexToAddress(holder))
+	case chain.Vm == pkgchains.Vm_evm:
+		client, err := ethclient.Dial(chainRPC)
+		if err != nil {
+			return nil, err
+		}
+		defer client.Close()
+
+		// balanceOf shares the same ABI for ZRC20 and ERC20 tokens
+		token, err := zrc20.NewZRC20Caller(ethcommon.HexToAddress(asset), client)
+		if err != nil {
+			return nil, err
+		}
+		return token.BalanceOf(&bind.CallOpts{Context: ctx}, ethcommon.HexToAddress(holder))
+	case chain.Vm == pkgchains.Vm_no_vm && asset == "":
+		// Skip Bitcoin for localnet (mempool.space doesn't support regtest)
+		if s.network == config.NetworkLocalnet {
+			return nil, fmt.Errorf("localnet not supported (uses regtest)")
+		}
+		balance, err := clients.GetBTCBalance(ctx, holder, chain.ChainId)
+		if err != nil {
+			return nil, err
+		}
+		return big.NewInt(int64(math.Round(balance * satoshisPerBTC))), nil
+	case chain.Vm == pkgchains.Vm_svm && asset == "":
+		balance, err := clients.GetSolanaGatewayBalance(ctx, chainRPC, holder)
+		if err != nil {
+			return nil, err
+		}
+		return new(big.Int).SetUint64(balance), nil
+	case chain.Vm == pkgchains.Vm_svm:
+		return clients.GetSolanaGatewaySPLBalance(ctx, chainRPC, holder, asset)
+	case chain.Vm == pkgchains.Vm_tvm && asset == "":
+		balance, err := clients.GetTONGatewayBalance(ctx, chainRPC, holder)
+		if err != nil {
+			return nil, err
+		}
+		return new(big.Int).SetUint64(balance), nil
+	case chain.Vm == pkgchains.Vm_mvm_sui && asset == "":
+		balance, err := clients.GetSuiBalance(ctx, chainRPC, holder)
+		if err != nil {
+			return nil, err
+		}
+		return new(big.Int).SetUint64(balance), nil
+	default:
+		return nil, fmt.Errorf("balance of asset %s not supported on chain %d", asset, chain.ChainId)
+	}
+}
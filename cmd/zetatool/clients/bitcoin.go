@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/btcsuite/btcd/btcjson"
@@ -21,12 +23,12 @@ import (
 )
 
 const (
-	mempoolAddressAPIMainnet  = "https://mempool.space/api/address/%s"
-	mempoolAddressAPITestnet3 = "https://mempool.space/testnet/api/address/%s"
-	mempoolAddressAPISignet   = "https://mempool.space/signet/api/address/%s"
-	mempoolAddressAPITestnet4 = "https://mempool.space/testnet4/api/address/%s"
-	satoshisPerBitcoin        = 100_000_000
-	httpClientTimeout         = 30 * time.Second
+	mempoolAPIMainnet  = "https://mempool.space/api"
+	mempoolAPITestnet3 = "https://mempool.space/testnet/api"
+	mempoolAPISignet   = "https://mempool.space/signet/api"
+	mempoolAPITestnet4 = "https://mempool.space/testnet4/api"
+	satoshisPerBitcoin = 100_000_000
+	httpClientTimeout  = 30 * time.Second
 )
 
 // BTCAddressStats represents the response from mempool.space address API
@@ -161,20 +163,123 @@ func GetBTCBalance(ctx context.Context, address string, chainID int64) (float64,
 
 // getMempoolAddressAPIURL returns the mempool.space address API URL for the given chain ID
 func getMempoolAddressAPIURL(chainID int64, address string) string {
+	apiURL := getMempoolAPIURL(chainID)
+	if apiURL == "" {
+		return ""
+	}
+	return apiURL + "/address/" + address
+}
+
+// getMempoolAPIURL returns the mempool.space API URL for the given chain ID
+func getMempoolAPIURL(chainID int64) string {
 	switch chainID {
 	case 8332: // Bitcoin mainnet
-		return fmt.Sprintf(mempoolAddressAPIMainnet, address)
+		return mempoolAPIMainnet
 	case 18332: // Bitcoin testnet3
-		return fmt.Sprintf(mempoolAddressAPITestnet3, address)
+		return mempoolAPITestnet3
 	case 18333: // Bitcoin signet
-		return fmt.Sprintf(mempoolAddressAPISignet, address)
+		return mempoolAPISignet
 	case 18334: // Bitcoin testnet4
-		return fmt.Sprintf(mempoolAddressAPITestnet4, address)
+		return mempoolAPITestnet4
 	default:
 		return ""
 	}
 }
 
+// BTCUTXO represents an unspent output returned by mempool.space address API
+type BTCUTXO struct {
+	TxID   string `json:"txid"`
+	Vout   uint32 `json:"vout"`
+	Value  int64  `json:"value"`
+	Status struct {
+		Confirmed bool `json:"confirmed"`
+	} `json:"status"`
+}
+
+// BTCFeeRates represents the response from mempool.space recommended fees API in sat/vB
+type BTCFeeRates struct {
+	FastestFee  int64 `json:"fastestFee"`
+	HalfHourFee int64 `json:"halfHourFee"`
+	HourFee     int64 `json:"hourFee"`
+	MinimumFee  int64 `json:"minimumFee"`
+}
+
+// GetBTCUTXOs fetches the unspent outputs of an address using mempool.space API
+func GetBTCUTXOs(ctx context.Context, address string, chainID int64) ([]BTCUTXO, error) {
+	var utxos []BTCUTXO
+	if err := getMempoolJSON(ctx, chainID, "/address/"+address+"/utxo", &utxos); err != nil {
+		return nil, fmt.Errorf("failed to fetch utxos: %w", err)
+	}
+	return utxos, nil
+}
+
+// GetBTCFeeRates fetches the recommended fee rates using mempool.space API
+func GetBTCFeeRates(ctx context.Context, chainID int64) (BTCFeeRates, error) {
+	var rates BTCFeeRates
+	if err := getMempoolJSON(ctx, chainID, "/v1/fees/recommended", &rates); err != nil {
+		return BTCFeeRates{}, fmt.Errorf("failed to fetch fee rates: %w", err)
+	}
+	return rates, nil
+}
+
+// BroadcastBTCTx broadcasts a hex encoded signed transaction using mempool.space API
+// Returns the transaction ID
+func BroadcastBTCTx(ctx context.Context, txHex string, chainID int64) (string, error) {
+	apiURL := getMempoolAPIURL(chainID)
+	if apiURL == "" {
+		return "", fmt.Errorf("unsupported Bitcoin chain ID: %d", chainID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, apiURL+"/tx", strings.NewReader(txHex))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+
+	btcClient := &http.Client{Timeout: httpClientTimeout}
+	resp, err := btcClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to broadcast transaction: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("mempool.space API returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return strings.TrimSpace(string(body)), nil
+}
+
+// getMempoolJSON decodes the response of a mempool.space API GET request
+func getMempoolJSON(ctx context.Context, chainID int64, path string, out any) error {
+	apiURL := getMempoolAPIURL(chainID)
+	if apiURL == "" {
+		return fmt.Errorf("unsupported Bitcoin chain ID: %d", chainID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	btcClient := &http.Client{Timeout: httpClientTimeout}
+	resp, err := btcClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("mempool.space API returned status %d", resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
 // GetBTCChainID returns the Bitcoin chain ID for the given network
 func GetBTCChainID(network string) (int64, error) {
 	switch network {
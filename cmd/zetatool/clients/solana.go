@@ -3,6 +3,7 @@ package clients
 import (
 	"context"
 	"fmt"
+	"math/big"
 
 	"github.com/gagliardetto/solana-go"
 	solrpc "github.com/gagliardetto/solana-go/rpc"
@@ -84,6 +85,46 @@ func GetSolanaGatewayBalance(ctx context.Context, rpcURL string, gatewayAddress
 	return result.Value, nil
 }
 
+// GetSolanaGatewaySPLBalance fetches the balance of an SPL token held by the associated token account of the gateway PDA
+func GetSolanaGatewaySPLBalance(
+	ctx context.Context,
+	rpcURL string,
+	gatewayAddress string,
+	mintAddress string,
+) (*big.Int, error) {
+	_, pda, err := contracts.ParseGatewayWithPDA(gatewayAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse gateway address: %w", err)
+	}
+
+	mint, err := solana.PublicKeyFromBase58(mintAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse mint address: %w", err)
+	}
+
+	pdaAta, _, err := solana.FindAssociatedTokenAddress(pda, mint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find gateway token account: %w", err)
+	}
+
+	client := solrpc.New(rpcURL)
+	if client == nil {
+		return nil, fmt.Errorf("failed to create solana rpc client")
+	}
+
+	result, err := client.GetTokenAccountBalance(ctx, pdaAta, solrpc.CommitmentFinalized)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token account balance: %w", err)
+	}
+
+	balance, ok := new(big.Int).SetString(result.Value.Amount, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid token account balance %q", result.Value.Amount)
+	}
+
+	return balance, nil
+}
+
 // FormatSolanaBalance converts lamports to SOL with 9 decimal places
 func FormatSolanaBalance(lamports uint64) string {
 	sol := float64(lamports) / float64(solana.LAMPORTS_PER_SOL)
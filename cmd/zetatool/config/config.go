@@ -27,6 +27,7 @@ const (
 func TestnetConfig() *Config {
 	return &Config{
 		ZetaChainRPC: "https://zetachain-athens.g.allthatnode.com/archive/tendermint",
+		ZEVMRPC:      "https://zetachain-athens-evm.blockpi.network/v1/rpc/public",
 		EthereumRPC:  "https://ethereum-sepolia-rpc.publicnode.com",
 		ZetaChainID:  chains.ZetaChainTestnet.ChainId,
 		BtcUser:      "",
@@ -49,6 +50,7 @@ func TestnetConfig() *Config {
 func DevnetConfig() *Config {
 	return &Config{
 		ZetaChainRPC: "",
+		ZEVMRPC:      "",
 		EthereumRPC:  "",
 		ZetaChainID:  chains.ZetaChainDevnet.ChainId,
 		BtcUser:      "",
@@ -67,6 +69,7 @@ func DevnetConfig() *Config {
 func MainnetConfig() *Config {
 	return &Config{
 		ZetaChainRPC: "https://zetachain-mainnet.g.allthatnode.com:443/archive/tendermint",
+		ZEVMRPC:      "https://zetachain-evm.blockpi.network/v1/rpc/public",
 		EthereumRPC:  "https://eth-mainnet.public.blastapi.io",
 		ZetaChainID:  chains.ZetaChainMainnet.ChainId,
 		BtcUser:      "",
@@ -90,6 +93,7 @@ func MainnetConfig() *Config {
 func PrivateNetConfig() *Config {
 	return &Config{
 		ZetaChainRPC: "http://127.0.0.1:26657",
+		ZEVMRPC:      "http://127.0.0.1:9545",
 		EthereumRPC:  "http://127.0.0.1:8545",
 		ZetaChainID:  chains.ZetaChainPrivnet.ChainId,
 		BtcUser:      "smoketest",
@@ -105,6 +109,7 @@ func PrivateNetConfig() *Config {
 // Config is a struct the defines the configuration fields used by zetatool
 type Config struct {
 	ZetaChainRPC string `json:"zeta_chain_rpc"`
+	ZEVMRPC      string `json:"zevm_rpc"`
 	ZetaChainID  int64  `json:"zeta_chain_id"`
 	EthereumRPC  string `json:"ethereum_rpc"`
 	BtcUser      string `json:"btc_user"`
@@ -0,0 +1,526 @@
+package inbound
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/mempool"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/memo"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+	"github.com/zeta-chain/node/zetaclient/chains/bitcoin/common"
+)
+
+const (
+	// BitcoinModeOpReturn carries the memo in an OP_RETURN output
+	BitcoinModeOpReturn = "op_return"
+
+	// BitcoinModeInscription carries the memo in the witness of a reveal transaction
+	BitcoinModeInscription = "inscription"
+
+	// bitcoinDustAmount is the minimum change output created by the sender
+	bitcoinDustAmount = 1000
+)
+
+// BitcoinParams are the parameters specific to a Bitcoin inbound
+type BitcoinParams struct {
+	// Inscription forces the memo into an inscription, memos larger than an OP_RETURN always use an inscription
+	Inscription bool
+
+	// InternalKey is the Taproot internal key of the inscription, it signs the reveal transaction
+	InternalKey *btcec.PublicKey
+}
+
+// BitcoinPayload is a Bitcoin inbound, the memo is sent to the TSS along with the amount and the depositor fee
+type BitcoinPayload struct {
+	Mode       string `json:"mode"`
+	TSSAddress string `json:"tss_address"`
+	Memo       string `json:"memo"`
+
+	// OpReturnScript is the OP_RETURN output following the TSS output
+	OpReturnScript string `json:"op_return_script,omitempty"`
+
+	// CommitAddress is the Taproot address funded by the commit transaction and spent by the reveal transaction
+	CommitAddress string `json:"commit_address,omitempty"`
+	LeafScript    string `json:"leaf_script,omitempty"`
+	ControlBlock  string `json:"control_block,omitempty"`
+}
+
+// BitcoinUTXO is an output spendable by the P2WPKH address of the sender
+type BitcoinUTXO struct {
+	TxID  string `json:"txid"`
+	Vout  uint32 `json:"vout"`
+	Value int64  `json:"value"`
+}
+
+// buildBitcoin encodes the inbound as a standard memo sent to the TSS address
+func buildBitcoin(chain chains.Chain, params Params) (*BitcoinPayload, error) {
+	net, err := chains.GetBTCChainParams(chain.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := decodeBitcoinAddress(params.Gateway, net); err != nil {
+		return nil, fmt.Errorf("invalid TSS address: %w", err)
+	}
+	if _, err := decodeBitcoinAddress(params.Sender, net); err != nil {
+		return nil, fmt.Errorf("invalid sender address: %w", err)
+	}
+	if params.RevertAddress != "" {
+		if _, err := btcutil.DecodeAddress(params.RevertAddress, net); err != nil {
+			return nil, fmt.Errorf("invalid revert address %q: %w", params.RevertAddress, err)
+		}
+	}
+
+	memoBytes, err := encodeBitcoinMemo(params)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &BitcoinPayload{
+		TSSAddress: params.Gateway,
+		Memo:       hex.EncodeToString(memoBytes),
+	}
+
+	if !params.Bitcoin.Inscription && len(memoBytes) <= txscript.MaxDataCarrierSize {
+		script, err := txscript.NullDataScript(memoBytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OP_RETURN script: %w", err)
+		}
+		payload.Mode = BitcoinModeOpReturn
+		payload.OpReturnScript = hex.EncodeToString(script)
+	} else {
+		if params.Bitcoin.InternalKey == nil {
+			return nil, fmt.Errorf("internal key is required for an inscription")
+		}
+		tapscript, err := newTapscript(params.Bitcoin.InternalKey, memoBytes, net)
+		if err != nil {
+			return nil, err
+		}
+		payload.Mode = BitcoinModeInscription
+		payload.CommitAddress = tapscript.address.EncodeAddress()
+		payload.LeafScript = hex.EncodeToString(tapscript.leaf.Script)
+		payload.ControlBlock = hex.EncodeToString(tapscript.controlBlock)
+	}
+
+	if err := validateBitcoin(payload, params); err != nil {
+		return nil, fmt.Errorf("payload rejected by the observer parser: %w", err)
+	}
+
+	return payload, nil
+}
+
+// encodeBitcoinMemo encodes the inbound as a standard memo
+func encodeBitcoinMemo(params Params) ([]byte, error) {
+	opCode := memo.OpCodeDeposit
+	switch params.Operation {
+	case OpDepositAndCall:
+		opCode = memo.OpCodeDepositAndCall
+	case OpCall:
+		opCode = memo.OpCodeCall
+	}
+
+	// the compact short format prefixes the variable length fields with a single byte
+	encodingFmt := memo.EncodingFmtCompactShort
+	if len(params.Payload) > 255 || len(params.RevertMessage) > 255 {
+		encodingFmt = memo.EncodingFmtCompactLong
+	}
+
+	m := &memo.InboundMemo{
+		Header: memo.Header{
+			Version:     0,
+			EncodingFmt: encodingFmt,
+			OpCode:      opCode,
+		},
+		FieldsV0: memo.FieldsV0{
+			Receiver: params.Receiver,
+			Payload:  params.Payload,
+			RevertOptions: crosschaintypes.RevertOptions{
+				RevertAddress: params.RevertAddress,
+				CallOnRevert:  params.CallOnRevert,
+				AbortAddress:  params.AbortAddress,
+				RevertMessage: params.RevertMessage,
+			},
+		},
+	}
+
+	memoBytes, err := m.EncodeToBytes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode memo: %w", err)
+	}
+	return memoBytes, nil
+}
+
+// validateBitcoin extracts the memo from the payload scripts and decodes it like the Bitcoin observer
+func validateBitcoin(payload *BitcoinPayload, params Params) error {
+	var (
+		memoBytes []byte
+		found     bool
+		err       error
+	)
+
+	switch payload.Mode {
+	case BitcoinModeOpReturn:
+		memoBytes, found, err = common.DecodeOpReturnMemo(payload.OpReturnScript)
+	case BitcoinModeInscription:
+		var leafScript []byte
+		leafScript, err = hex.DecodeString(payload.LeafScript)
+		if err != nil {
+			return fmt.Errorf("invalid leaf script: %w", err)
+		}
+		memoBytes, found, err = common.DecodeScript(leafScript)
+	default:
+		return fmt.Errorf("invalid mode %q", payload.Mode)
+	}
+	switch {
+	case err != nil:
+		return err
+	case !found:
+		return fmt.Errorf("memo not found")
+	}
+
+	m, isStandard, err := memo.DecodeFromBytes(memoBytes)
+	switch {
+	case err != nil:
+		return err
+	case !isStandard:
+		return fmt.Errorf("not a standard memo")
+	}
+
+	if m.RevertOptions.RevertAddress != params.RevertAddress {
+		return fmt.Errorf("parsed revert address %q, want %q", m.RevertOptions.RevertAddress, params.RevertAddress)
+	}
+
+	return checkParsed(params, m.Receiver.Hex(), params.Amount, m.Payload, m.OpCode != memo.OpCodeDeposit)
+}
+
+// SignBitcoin creates the signed transactions of a Bitcoin inbound spending the P2WPKH outputs of the sender
+// The TSS receives the amount plus the depositor fee at the given fee rate, a change is returned to the sender.
+// A single transaction is returned for an OP_RETURN memo, the commit and reveal transactions for an inscription.
+func SignBitcoin(
+	chain chains.Chain,
+	payload *Payload,
+	utxos []BitcoinUTXO,
+	key *btcec.PrivateKey,
+	feeRate int64,
+) ([]*wire.MsgTx, error) {
+	if payload.Bitcoin == nil {
+		return nil, fmt.Errorf("not a Bitcoin payload")
+	}
+	if feeRate <= 0 {
+		return nil, fmt.Errorf("invalid fee rate %d", feeRate)
+	}
+
+	net, err := chains.GetBTCChainParams(chain.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), net)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive sender address: %w", err)
+	}
+	if sender.EncodeAddress() != payload.Sender {
+		return nil, fmt.Errorf("key of %s does not match sender %s", sender.EncodeAddress(), payload.Sender)
+	}
+
+	tssAddress, err := decodeBitcoinAddress(payload.Bitcoin.TSSAddress, net)
+	if err != nil {
+		return nil, fmt.Errorf("invalid TSS address: %w", err)
+	}
+
+	// the observer deducts the depositor fee from the amount received by the TSS
+	// #nosec G115 always in range
+	tssAmount := int64(payload.Amount) + feeRate*int64(common.BtcOutboundBytesDepositor)
+
+	switch payload.Bitcoin.Mode {
+	case BitcoinModeOpReturn:
+		script, err := hex.DecodeString(payload.Bitcoin.OpReturnScript)
+		if err != nil {
+			return nil, fmt.Errorf("invalid OP_RETURN script: %w", err)
+		}
+
+		outputs := []*wire.TxOut{
+			wire.NewTxOut(tssAmount, mustPayToAddrScript(tssAddress)),
+			wire.NewTxOut(0, script),
+		}
+		tx, err := signP2WPKH(outputs, utxos, sender, key, feeRate)
+		if err != nil {
+			return nil, err
+		}
+		return []*wire.MsgTx{tx}, nil
+	case BitcoinModeInscription:
+		tapscript, err := parseTapscript(payload.Bitcoin, net)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(schnorr.SerializePubKey(tapscript.internalKey), schnorr.SerializePubKey(key.PubKey())) {
+			return nil, fmt.Errorf("key does not match the internal key of the inscription")
+		}
+
+		revealFee := tapscript.estimateRevealFee(tssAddress, tssAmount, feeRate)
+		outputs := []*wire.TxOut{wire.NewTxOut(tssAmount+revealFee, mustPayToAddrScript(tapscript.address))}
+		commitTx, err := signP2WPKH(outputs, utxos, sender, key, feeRate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign commit transaction: %w", err)
+		}
+
+		revealTx, err := tapscript.signReveal(key, commitTx, tssAddress, tssAmount)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign reveal transaction: %w", err)
+		}
+		return []*wire.MsgTx{commitTx, revealTx}, nil
+	default:
+		return nil, fmt.Errorf("invalid mode %q", payload.Bitcoin.Mode)
+	}
+}
+
+// SerializeBitcoinTx returns the hex encoding of a signed transaction
+func SerializeBitcoinTx(tx *wire.MsgTx) (string, error) {
+	var buf bytes.Buffer
+	if err := tx.Serialize(&buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf.Bytes()), nil
+}
+
+// signP2WPKH funds the outputs with the UTXOs of the sender and signs the transaction
+func signP2WPKH(
+	outputs []*wire.TxOut,
+	utxos []BitcoinUTXO,
+	sender btcutil.Address,
+	key *btcec.PrivateKey,
+	feeRate int64,
+) (*wire.MsgTx, error) {
+	var required int64
+	for _, out := range outputs {
+		required += out.Value
+	}
+
+	senderScript := mustPayToAddrScript(sender)
+
+	// the fee is first estimated without change and refined once the inputs are selected
+	tx := wire.NewMsgTx(wire.TxVersion)
+	for _, out := range outputs {
+		tx.AddTxOut(out)
+	}
+	tx.AddTxOut(wire.NewTxOut(0, senderScript))
+
+	var selected []BitcoinUTXO
+	var total int64
+	for _, utxo := range utxos {
+		hash, err := chainhash.NewHashFromStr(utxo.TxID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid utxo txid %q: %w", utxo.TxID, err)
+		}
+		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(hash, utxo.Vout), nil, nil))
+		selected = append(selected, utxo)
+		total += utxo.Value
+
+		if total >= required+estimateP2WPKHFee(tx, feeRate)+bitcoinDustAmount {
+			break
+		}
+	}
+
+	fee := estimateP2WPKHFee(tx, feeRate)
+	change := total - required - fee
+	if change < bitcoinDustAmount {
+		return nil, fmt.Errorf("insufficient funds: have %d satoshis, need %d", total, required+fee+bitcoinDustAmount)
+	}
+	tx.TxOut[len(tx.TxOut)-1].Value = change
+
+	prevOuts := txscript.NewMultiPrevOutFetcher(nil)
+	for i, utxo := range selected {
+		prevOuts.AddPrevOut(tx.TxIn[i].PreviousOutPoint, wire.NewTxOut(utxo.Value, senderScript))
+	}
+	sigHashes := txscript.NewTxSigHashes(tx, prevOuts)
+
+	for i, utxo := range selected {
+		witness, err := txscript.WitnessSignature(
+			tx,
+			sigHashes,
+			i,
+			utxo.Value,
+			senderScript,
+			txscript.SigHashAll,
+			key,
+			true,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign input %d: %w", i, err)
+		}
+		tx.TxIn[i].Witness = witness
+	}
+
+	return tx, nil
+}
+
+// estimateP2WPKHFee estimates the fee of a transaction spending P2WPKH inputs
+func estimateP2WPKHFee(tx *wire.MsgTx, feeRate int64) int64 {
+	txCopy := tx.Copy()
+
+	// a P2WPKH witness is a 72-byte signature and a 33-byte compressed public key
+	for _, in := range txCopy.TxIn {
+		in.Witness = wire.TxWitness{make([]byte, 72), make([]byte, 33)}
+	}
+
+	return mempool.GetTxVirtualSize(btcutil.NewTx(txCopy)) * feeRate
+}
+
+// tapscript is the Taproot script path carrying an inscription memo
+type tapscript struct {
+	internalKey  *btcec.PublicKey
+	leaf         txscript.TapLeaf
+	controlBlock []byte
+	address      *btcutil.AddressTaproot
+}
+
+// newTapscript creates the Taproot script path committing to a memo
+func newTapscript(internalKey *btcec.PublicKey, memoBytes []byte, net *chaincfg.Params) (*tapscript, error) {
+	builder := txscript.NewScriptBuilder()
+	builder.AddData(schnorr.SerializePubKey(internalKey))
+	builder.AddOp(txscript.OP_CHECKSIG)
+	builder.AddOp(txscript.OP_FALSE)
+	builder.AddOp(txscript.OP_IF)
+	for i := 0; i < len(memoBytes); i += txscript.MaxScriptElementSize {
+		builder.AddData(memoBytes[i:min(i+txscript.MaxScriptElementSize, len(memoBytes))])
+	}
+	builder.AddOp(txscript.OP_ENDIF)
+
+	leafScript, err := builder.Script()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create leaf script: %w", err)
+	}
+
+	return assembleTapscript(internalKey, leafScript, net)
+}
+
+// parseTapscript restores the Taproot script path of an inscription payload
+func parseTapscript(payload *BitcoinPayload, net *chaincfg.Params) (*tapscript, error) {
+	leafScript, err := hex.DecodeString(payload.LeafScript)
+	if err != nil {
+		return nil, fmt.Errorf("invalid leaf script: %w", err)
+	}
+	controlBlockBytes, err := hex.DecodeString(payload.ControlBlock)
+	if err != nil {
+		return nil, fmt.Errorf("invalid control block: %w", err)
+	}
+	controlBlock, err := txscript.ParseControlBlock(controlBlockBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid control block: %w", err)
+	}
+
+	s, err := assembleTapscript(controlBlock.InternalKey, leafScript, net)
+	if err != nil {
+		return nil, err
+	}
+	if s.address.EncodeAddress() != payload.CommitAddress {
+		return nil, fmt.Errorf("commit address %s does not match the leaf script", payload.CommitAddress)
+	}
+	return s, nil
+}
+
+// assembleTapscript computes the commit address and the control block of a single leaf Taproot tree
+func assembleTapscript(internalKey *btcec.PublicKey, leafScript []byte, net *chaincfg.Params) (*tapscript, error) {
+	leaf := txscript.NewBaseTapLeaf(leafScript)
+	tree := txscript.AssembleTaprootScriptTree(leaf)
+	root := tree.RootNode.TapHash()
+
+	outputKey := txscript.ComputeTaprootOutputKey(internalKey, root[:])
+	address, err := btcutil.NewAddressTaproot(schnorr.SerializePubKey(outputKey), net)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create commit address: %w", err)
+	}
+
+	controlBlock := tree.LeafMerkleProofs[0].ToControlBlock(internalKey)
+	controlBlockBytes, err := controlBlock.ToBytes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize control block: %w", err)
+	}
+
+	return &tapscript{
+		internalKey:  internalKey,
+		leaf:         leaf,
+		controlBlock: controlBlockBytes,
+		address:      address,
+	}, nil
+}
+
+// estimateRevealFee estimates the fee of the reveal transaction spending the commit output to the TSS
+func (s *tapscript) estimateRevealFee(to btcutil.Address, amount, feeRate int64) int64 {
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, nil, nil))
+	tx.AddTxOut(wire.NewTxOut(amount, mustPayToAddrScript(to)))
+
+	// a Schnorr signature is 64 bytes
+	tx.TxIn[0].Witness = wire.TxWitness{make([]byte, 64), s.leaf.Script, s.controlBlock}
+
+	return mempool.GetTxVirtualSize(btcutil.NewTx(tx)) * feeRate
+}
+
+// signReveal signs the reveal transaction spending the first output of the commit transaction
+func (s *tapscript) signReveal(
+	key *btcec.PrivateKey,
+	commitTx *wire.MsgTx,
+	to btcutil.Address,
+	amount int64,
+) (*wire.MsgTx, error) {
+	commitHash := commitTx.TxHash()
+	commitOut := commitTx.TxOut[0]
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&commitHash, 0), nil, nil))
+	tx.AddTxOut(wire.NewTxOut(amount, mustPayToAddrScript(to)))
+
+	prevOuts := txscript.NewCannedPrevOutputFetcher(commitOut.PkScript, commitOut.Value)
+	sigHash, err := txscript.CalcTapscriptSignaturehash(
+		txscript.NewTxSigHashes(tx, prevOuts),
+		txscript.SigHashDefault,
+		tx,
+		0,
+		prevOuts,
+		s.leaf,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := schnorr.Sign(key, sigHash)
+	if err != nil {
+		return nil, err
+	}
+	tx.TxIn[0].Witness = wire.TxWitness{sig.Serialize(), s.leaf.Script, s.controlBlock}
+
+	return tx, nil
+}
+
+// decodeBitcoinAddress decodes a P2WPKH address, the only type accepted by the observer for the TSS
+func decodeBitcoinAddress(address string, net *chaincfg.Params) (btcutil.Address, error) {
+	decoded, err := btcutil.DecodeAddress(address, net)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := decoded.(*btcutil.AddressWitnessPubKeyHash); !ok {
+		return nil, fmt.Errorf("%s is not a P2WPKH address", address)
+	}
+	return decoded, nil
+}
+
+// mustPayToAddrScript returns the output script of a decoded address
+func mustPayToAddrScript(address btcutil.Address) []byte {
+	script, err := txscript.PayToAddrScript(address)
+	if err != nil {
+		// unreachable: the address types are checked when decoding
+		panic(err)
+	}
+	return script
+}
@@ -0,0 +1,224 @@
+package inbound_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/cmd/zetatool/inbound"
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/memo"
+	"github.com/zeta-chain/node/zetaclient/chains/bitcoin/common"
+)
+
+const (
+	btcFeeRate = 10
+	utxoTxID   = "c6e4a1a12a0b3d2a7f6a1a8e5f5d0c3b2a19180706050403020100ffeeddccbb"
+	utxoValue  = 1_000_000
+)
+
+// btcKey returns a key and its P2WPKH address on the signet
+func btcKey(t *testing.T) (*btcec.PrivateKey, string) {
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	address, err := btcutil.NewAddressWitnessPubKeyHash(
+		btcutil.Hash160(key.PubKey().SerializeCompressed()),
+		&chaincfg.SigNetParams,
+	)
+	require.NoError(t, err)
+
+	return key, address.EncodeAddress()
+}
+
+// verifyInputs executes the scripts of the inputs spending the given outputs
+func verifyInputs(t *testing.T, tx *wire.MsgTx, prevOuts map[wire.OutPoint]*wire.TxOut) {
+	fetcher := txscript.NewMultiPrevOutFetcher(prevOuts)
+	sigHashes := txscript.NewTxSigHashes(tx, fetcher)
+
+	for i, in := range tx.TxIn {
+		prevOut := prevOuts[in.PreviousOutPoint]
+		require.NotNil(t, prevOut, "unknown input %d", i)
+
+		engine, err := txscript.NewEngine(
+			prevOut.PkScript,
+			tx,
+			i,
+			txscript.StandardVerifyFlags,
+			nil,
+			sigHashes,
+			prevOut.Value,
+			fetcher,
+		)
+		require.NoError(t, err)
+		require.NoError(t, engine.Execute(), "input %d", i)
+	}
+}
+
+func TestBuildBitcoin(t *testing.T) {
+	chain := chains.BitcoinSignetTestnet
+	senderKey, sender := btcKey(t)
+	_, tss := btcKey(t)
+
+	senderAddress, err := btcutil.DecodeAddress(sender, &chaincfg.SigNetParams)
+	require.NoError(t, err)
+	senderScript, err := txscript.PayToAddrScript(senderAddress)
+	require.NoError(t, err)
+
+	utxo := inbound.BitcoinUTXO{TxID: utxoTxID, Vout: 1, Value: utxoValue}
+	utxoHash, err := chainhash.NewHashFromStr(utxoTxID)
+	require.NoError(t, err)
+	utxoOutPoint := wire.OutPoint{Hash: *utxoHash, Index: 1}
+
+	// the observer deducts the depositor fee from the TSS output
+	depositorFee := int64(btcFeeRate * common.BtcOutboundBytesDepositor)
+
+	t.Run("should sign an OP_RETURN deposit_and_call", func(t *testing.T) {
+		params := inbound.Params{
+			Operation:     inbound.OpDepositAndCall,
+			Sender:        sender,
+			Gateway:       tss,
+			Receiver:      receiver,
+			Amount:        50_000,
+			Payload:       []byte("hi"),
+			RevertAddress: sender,
+		}
+
+		built, err := inbound.Build(chain, params)
+		require.NoError(t, err)
+		require.Equal(t, inbound.BitcoinModeOpReturn, built.Bitcoin.Mode)
+
+		txs, err := inbound.SignBitcoin(chain, built, []inbound.BitcoinUTXO{utxo}, senderKey, btcFeeRate)
+		require.NoError(t, err)
+		require.Len(t, txs, 1)
+
+		tx := txs[0]
+		require.Len(t, tx.TxOut, 3)
+		require.EqualValues(t, 50_000+depositorFee, tx.TxOut[0].Value)
+		require.Equal(t, built.Bitcoin.OpReturnScript, hex.EncodeToString(tx.TxOut[1].PkScript))
+		require.Equal(t, senderScript, tx.TxOut[2].PkScript)
+
+		verifyInputs(t, tx, map[wire.OutPoint]*wire.TxOut{utxoOutPoint: wire.NewTxOut(utxoValue, senderScript)})
+
+		decoded, found, err := common.DecodeOpReturnMemo(hex.EncodeToString(tx.TxOut[1].PkScript))
+		require.NoError(t, err)
+		require.True(t, found)
+		m, isStandard, err := memo.DecodeFromBytes(decoded)
+		require.NoError(t, err)
+		require.True(t, isStandard)
+		require.Equal(t, memo.OpCodeDepositAndCall, m.OpCode)
+		require.Equal(t, receiver, m.Receiver)
+		require.Equal(t, sender, m.RevertOptions.RevertAddress)
+	})
+
+	t.Run("should sign an inscription for a large memo", func(t *testing.T) {
+		params := inbound.Params{
+			Operation:    inbound.OpCall,
+			Sender:       sender,
+			Gateway:      tss,
+			Receiver:     receiver,
+			Payload:      []byte(strings.Repeat("z", 600)),
+			AbortAddress: abort,
+			Bitcoin:      inbound.BitcoinParams{InternalKey: senderKey.PubKey()},
+		}
+
+		built, err := inbound.Build(chain, params)
+		require.NoError(t, err)
+		require.Equal(t, inbound.BitcoinModeInscription, built.Bitcoin.Mode)
+
+		txs, err := inbound.SignBitcoin(chain, built, []inbound.BitcoinUTXO{utxo}, senderKey, btcFeeRate)
+		require.NoError(t, err)
+		require.Len(t, txs, 2)
+
+		commitTx, revealTx := txs[0], txs[1]
+		verifyInputs(t, commitTx, map[wire.OutPoint]*wire.TxOut{utxoOutPoint: wire.NewTxOut(utxoValue, senderScript)})
+
+		commitOutPoint := wire.OutPoint{Hash: commitTx.TxHash(), Index: 0}
+		verifyInputs(t, revealTx, map[wire.OutPoint]*wire.TxOut{commitOutPoint: commitTx.TxOut[0]})
+
+		require.Len(t, revealTx.TxOut, 1)
+		require.EqualValues(t, depositorFee, revealTx.TxOut[0].Value)
+
+		decoded, found, err := common.DecodeScript(revealTx.TxIn[0].Witness[1])
+		require.NoError(t, err)
+		require.True(t, found)
+		m, _, err := memo.DecodeFromBytes(decoded)
+		require.NoError(t, err)
+		require.Equal(t, memo.OpCodeCall, m.OpCode)
+		require.Equal(t, params.Payload, m.Payload)
+	})
+
+	t.Run("should force an inscription for a small memo", func(t *testing.T) {
+		built, err := inbound.Build(chain, inbound.Params{
+			Operation: inbound.OpDeposit,
+			Sender:    sender,
+			Gateway:   tss,
+			Receiver:  receiver,
+			Amount:    10_000,
+			Bitcoin:   inbound.BitcoinParams{Inscription: true, InternalKey: senderKey.PubKey()},
+		})
+		require.NoError(t, err)
+		require.Equal(t, inbound.BitcoinModeInscription, built.Bitcoin.Mode)
+		require.NotEmpty(t, built.Bitcoin.CommitAddress)
+	})
+
+	t.Run("should require an internal key for an inscription", func(t *testing.T) {
+		_, err := inbound.Build(chain, inbound.Params{
+			Operation: inbound.OpDeposit,
+			Sender:    sender,
+			Gateway:   tss,
+			Receiver:  receiver,
+			Amount:    10_000,
+			Bitcoin:   inbound.BitcoinParams{Inscription: true},
+		})
+		require.ErrorContains(t, err, "internal key is required")
+	})
+
+	t.Run("should reject a TSS address that is not P2WPKH", func(t *testing.T) {
+		_, err := inbound.Build(chain, inbound.Params{
+			Operation: inbound.OpDeposit,
+			Sender:    sender,
+			Gateway:   "tb1pqqqqp399et2xygdj5xreqhjjvcmzhxw4aywxecjdzew6hylgvsesf3hn0c",
+			Receiver:  receiver,
+			Amount:    10_000,
+		})
+		require.ErrorContains(t, err, "invalid TSS address")
+	})
+
+	t.Run("should fail with insufficient funds", func(t *testing.T) {
+		built, err := inbound.Build(chain, inbound.Params{
+			Operation: inbound.OpDeposit,
+			Sender:    sender,
+			Gateway:   tss,
+			Receiver:  receiver,
+			Amount:    utxoValue,
+		})
+		require.NoError(t, err)
+
+		_, err = inbound.SignBitcoin(chain, built, []inbound.BitcoinUTXO{utxo}, senderKey, btcFeeRate)
+		require.ErrorContains(t, err, "insufficient funds")
+	})
+
+	t.Run("should not sign with another key", func(t *testing.T) {
+		built, err := inbound.Build(chain, inbound.Params{
+			Operation: inbound.OpDeposit,
+			Sender:    sender,
+			Gateway:   tss,
+			Receiver:  receiver,
+			Amount:    10_000,
+		})
+		require.NoError(t, err)
+
+		otherKey, _ := btcKey(t)
+		_, err = inbound.SignBitcoin(chain, built, []inbound.BitcoinUTXO{utxo}, otherKey, btcFeeRate)
+		require.ErrorContains(t, err, "does not match sender")
+	})
+}
@@ -0,0 +1,181 @@
+// Package inbound builds inbound transactions to ZetaChain for the non-EVM connected chains
+// and validates them against the parsers used by the observers
+package inbound
+
+import (
+	"fmt"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/zeta-chain/node/pkg/chains"
+)
+
+// Operation is the inbound operation to perform on ZetaChain
+type Operation string
+
+const (
+	// OpDeposit deposits the amount to the receiver
+	OpDeposit Operation = "deposit"
+
+	// OpDepositAndCall deposits the amount to the receiver and calls it with the payload
+	OpDepositAndCall Operation = "deposit_and_call"
+
+	// OpCall calls the receiver with the payload without depositing any amount
+	OpCall Operation = "call"
+)
+
+// ParseOperation parses an operation from its name
+func ParseOperation(name string) (Operation, error) {
+	switch op := Operation(name); op {
+	case OpDeposit, OpDepositAndCall, OpCall:
+		return op, nil
+	default:
+		return "", fmt.Errorf("invalid operation %q, want one of %s, %s, %s", name, OpDeposit, OpDepositAndCall, OpCall)
+	}
+}
+
+// Params are the parameters of an inbound
+type Params struct {
+	Operation Operation
+
+	// Sender is the address of the account signing the inbound on the connected chain
+	Sender string
+
+	// Gateway is the address receiving the inbound on the connected chain:
+	// the TSS address for Bitcoin, the gateway address from the chain params otherwise
+	Gateway string
+
+	// Receiver is the ZEVM receiver of the inbound
+	Receiver ethcommon.Address
+
+	// Amount is the deposited amount in the smallest unit of the gas token
+	Amount uint64
+
+	// Payload is the data passed to the receiver for deposit_and_call and call
+	Payload []byte
+
+	RevertAddress string
+	AbortAddress  string
+	CallOnRevert  bool
+	RevertMessage []byte
+
+	// Bitcoin contains the parameters specific to Bitcoin
+	Bitcoin BitcoinParams
+
+	// SuiCoinObjectID is the ID of the coin object deposited on Sui, its balance is the deposited amount
+	SuiCoinObjectID string
+}
+
+// Payload is an inbound ready to be signed by the sender
+type Payload struct {
+	ChainID   int64     `json:"chain_id"`
+	Operation Operation `json:"operation"`
+	Sender    string    `json:"sender"`
+	Receiver  string    `json:"receiver"`
+	Amount    uint64    `json:"amount"`
+
+	Bitcoin *BitcoinPayload `json:"bitcoin,omitempty"`
+	Solana  *SolanaPayload  `json:"solana,omitempty"`
+	Sui     *SuiPayload     `json:"sui,omitempty"`
+	TON     *TONPayload     `json:"ton,omitempty"`
+}
+
+// Build builds the inbound payload for a connected chain
+// The payload is parsed with the parser of the chain observer before being returned
+func Build(chain chains.Chain, params Params) (*Payload, error) {
+	if err := params.validate(); err != nil {
+		return nil, err
+	}
+
+	payload := &Payload{
+		ChainID:   chain.ChainId,
+		Operation: params.Operation,
+		Sender:    params.Sender,
+		Receiver:  params.Receiver.Hex(),
+		Amount:    params.Amount,
+	}
+
+	var err error
+	switch {
+	case chain.Consensus == chains.Consensus_bitcoin:
+		payload.Bitcoin, err = buildBitcoin(chain, params)
+	case chain.Vm == chains.Vm_svm:
+		payload.Solana, err = buildSolana(params)
+	case chain.Vm == chains.Vm_mvm_sui:
+		payload.Sui, err = buildSui(params)
+	case chain.Vm == chains.Vm_tvm:
+		payload.TON, err = buildTON(params)
+	default:
+		return nil, fmt.Errorf("inbound not supported on chain %d", chain.ChainId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// validate checks the parameters that are common to all the chains
+func (p Params) validate() error {
+	switch {
+	case p.Sender == "":
+		return fmt.Errorf("sender is required")
+	case p.Gateway == "":
+		return fmt.Errorf("gateway is required")
+	case p.Receiver == (ethcommon.Address{}):
+		return fmt.Errorf("receiver is required")
+	}
+
+	switch p.Operation {
+	case OpDeposit:
+		if p.Amount == 0 {
+			return fmt.Errorf("amount is required for %s", p.Operation)
+		}
+		if len(p.Payload) > 0 {
+			return fmt.Errorf("payload is not allowed for %s", p.Operation)
+		}
+	case OpDepositAndCall:
+		if p.Amount == 0 {
+			return fmt.Errorf("amount is required for %s", p.Operation)
+		}
+	case OpCall:
+		if p.Amount != 0 {
+			return fmt.Errorf("amount is not allowed for %s", p.Operation)
+		}
+	default:
+		return fmt.Errorf("invalid operation %q", p.Operation)
+	}
+
+	return nil
+}
+
+// hasRevertOptions returns true if any revert option is set
+func (p Params) hasRevertOptions() bool {
+	return p.RevertAddress != "" || p.AbortAddress != "" || p.CallOnRevert || len(p.RevertMessage) > 0
+}
+
+// parseAbortAddress parses the optional ZEVM abort address
+func (p Params) parseAbortAddress() (ethcommon.Address, error) {
+	if p.AbortAddress == "" {
+		return ethcommon.Address{}, nil
+	}
+	if !ethcommon.IsHexAddress(p.AbortAddress) {
+		return ethcommon.Address{}, fmt.Errorf("invalid abort address %q", p.AbortAddress)
+	}
+	return ethcommon.HexToAddress(p.AbortAddress), nil
+}
+
+// checkParsed compares the inbound parsed by an observer with the requested parameters
+func checkParsed(params Params, receiver string, amount uint64, payload []byte, isCall bool) error {
+	switch {
+	case receiver != params.Receiver.Hex():
+		return fmt.Errorf("parsed receiver %s, want %s", receiver, params.Receiver.Hex())
+	case amount != params.Amount:
+		return fmt.Errorf("parsed amount %d, want %d", amount, params.Amount)
+	case string(payload) != string(params.Payload):
+		return fmt.Errorf("parsed payload %x, want %x", payload, params.Payload)
+	case isCall != (params.Operation != OpDeposit):
+		return fmt.Errorf("parsed cross-chain call flag %t for %s", isCall, params.Operation)
+	}
+	return nil
+}
@@ -0,0 +1,254 @@
+package inbound_test
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/ton"
+
+	"github.com/zeta-chain/node/cmd/zetatool/inbound"
+	"github.com/zeta-chain/node/pkg/chains"
+	suicontracts "github.com/zeta-chain/node/pkg/contracts/sui"
+)
+
+var (
+	receiver = ethcommon.HexToAddress("0x5e3eD6E3B4a9D7E1D4d9dFe8A1f1C7A4d2C3A8b9")
+	abort    = "0x735b14BB79463307AAcBED86DAf3322B1e6226aB"
+	payload  = []byte("hello zetachain")
+)
+
+func TestParseOperation(t *testing.T) {
+	for _, name := range []string{"deposit", "deposit_and_call", "call"} {
+		op, err := inbound.ParseOperation(name)
+		require.NoError(t, err)
+		require.EqualValues(t, name, op)
+	}
+
+	_, err := inbound.ParseOperation("withdraw")
+	require.ErrorContains(t, err, "invalid operation")
+}
+
+func TestBuild(t *testing.T) {
+	t.Run("should reject invalid params", func(t *testing.T) {
+		base := inbound.Params{
+			Operation: inbound.OpDeposit,
+			Sender:    "sender",
+			Gateway:   "gateway",
+			Receiver:  receiver,
+			Amount:    100,
+		}
+
+		tt := []struct {
+			name   string
+			modify func(p *inbound.Params)
+			errMsg string
+		}{
+			{name: "no sender", modify: func(p *inbound.Params) { p.Sender = "" }, errMsg: "sender is required"},
+			{name: "no gateway", modify: func(p *inbound.Params) { p.Gateway = "" }, errMsg: "gateway is required"},
+			{
+				name:   "no receiver",
+				modify: func(p *inbound.Params) { p.Receiver = ethcommon.Address{} },
+				errMsg: "receiver is required",
+			},
+			{name: "deposit without amount", modify: func(p *inbound.Params) { p.Amount = 0 }, errMsg: "amount is required"},
+			{
+				name:   "deposit with payload",
+				modify: func(p *inbound.Params) { p.Payload = payload },
+				errMsg: "payload is not allowed",
+			},
+			{
+				name:   "call with amount",
+				modify: func(p *inbound.Params) { p.Operation = inbound.OpCall },
+				errMsg: "amount is not allowed",
+			},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.name, func(t *testing.T) {
+				params := base
+				tc.modify(&params)
+				_, err := inbound.Build(chains.SolanaDevnet, params)
+				require.ErrorContains(t, err, tc.errMsg)
+			})
+		}
+	})
+
+	t.Run("should reject EVM chains", func(t *testing.T) {
+		_, err := inbound.Build(chains.Sepolia, inbound.Params{
+			Operation: inbound.OpDeposit,
+			Sender:    "0x735b14BB79463307AAcBED86DAf3322B1e6226aB",
+			Gateway:   "0x0c487a766110c85d301d96e33579c5b317fa4995",
+			Receiver:  receiver,
+			Amount:    100,
+		})
+		require.ErrorContains(t, err, "inbound not supported on chain")
+	})
+}
+
+func TestBuildSolana(t *testing.T) {
+	const gateway = "ZETAjseVjuFsxdRxo6MmTCvqFwb3ZHUx56Co3vCmGis"
+	key, err := solana.NewRandomPrivateKey()
+	require.NoError(t, err)
+
+	for _, op := range []inbound.Operation{inbound.OpDeposit, inbound.OpDepositAndCall, inbound.OpCall} {
+		t.Run(string(op), func(t *testing.T) {
+			params := inbound.Params{
+				Operation:     op,
+				Sender:        key.PublicKey().String(),
+				Gateway:       gateway,
+				Receiver:      receiver,
+				RevertAddress: key.PublicKey().String(),
+				AbortAddress:  abort,
+				CallOnRevert:  true,
+				RevertMessage: []byte("revert"),
+			}
+			if op != inbound.OpCall {
+				params.Amount = 1_000_000
+			}
+			if op != inbound.OpDeposit {
+				params.Payload = payload
+			}
+
+			built, err := inbound.Build(chains.SolanaDevnet, params)
+			require.NoError(t, err)
+			require.NotNil(t, built.Solana)
+			require.Equal(t, gateway, built.Solana.ProgramID)
+			require.True(t, built.Solana.Accounts[0].IsSigner)
+			if op == inbound.OpCall {
+				require.Len(t, built.Solana.Accounts, 1)
+			} else {
+				require.Len(t, built.Solana.Accounts, 3)
+			}
+
+			tx, err := inbound.SignSolana(built, solana.Hash{1}, key)
+			require.NoError(t, err)
+			require.NoError(t, tx.VerifySignatures())
+		})
+	}
+
+	t.Run("should not sign with another key", func(t *testing.T) {
+		built, err := inbound.Build(chains.SolanaDevnet, inbound.Params{
+			Operation: inbound.OpDeposit,
+			Sender:    key.PublicKey().String(),
+			Gateway:   gateway,
+			Receiver:  receiver,
+			Amount:    1_000_000,
+		})
+		require.NoError(t, err)
+
+		other, err := solana.NewRandomPrivateKey()
+		require.NoError(t, err)
+		_, err = inbound.SignSolana(built, solana.Hash{1}, other)
+		require.ErrorContains(t, err, "does not match sender")
+	})
+}
+
+func TestBuildSui(t *testing.T) {
+	var (
+		packageID = "0x" + strings.Repeat("1", 64)
+		objectID  = "0x" + strings.Repeat("2", 64)
+		coinID    = "0x" + strings.Repeat("3", 64)
+		sender    = suicontracts.NewSignerSecp256k1([]byte(strings.Repeat("k", 32))).Address()
+	)
+
+	params := inbound.Params{
+		Operation:       inbound.OpDepositAndCall,
+		Sender:          sender,
+		Gateway:         packageID + "," + objectID,
+		Receiver:        receiver,
+		Amount:          1_000_000,
+		Payload:         payload,
+		SuiCoinObjectID: coinID,
+	}
+
+	t.Run("should build a deposit_and_call move call", func(t *testing.T) {
+		built, err := inbound.Build(chains.SuiTestnet, params)
+		require.NoError(t, err)
+		require.Equal(t, models.MoveCallRequest{
+			Signer:          sender,
+			PackageObjectId: packageID,
+			Module:          "gateway",
+			Function:        "deposit_and_call",
+			TypeArguments:   []any{string(suicontracts.SUI)},
+			Arguments:       []any{objectID, coinID, receiver.Hex(), payload},
+			GasBudget:       "500000000",
+		}, built.Sui.MoveCallRequest)
+	})
+
+	t.Run("should build a deposit move call", func(t *testing.T) {
+		deposit := params
+		deposit.Operation = inbound.OpDeposit
+		deposit.Payload = nil
+
+		built, err := inbound.Build(chains.SuiTestnet, deposit)
+		require.NoError(t, err)
+		require.Equal(t, "deposit", built.Sui.Function)
+		require.Len(t, built.Sui.Arguments, 3)
+	})
+
+	t.Run("should reject call", func(t *testing.T) {
+		call := params
+		call.Operation = inbound.OpCall
+		call.Amount = 0
+
+		_, err := inbound.Build(chains.SuiTestnet, call)
+		require.ErrorContains(t, err, "not supported by the Sui gateway")
+	})
+
+	t.Run("should require a coin object", func(t *testing.T) {
+		noCoin := params
+		noCoin.SuiCoinObjectID = ""
+
+		_, err := inbound.Build(chains.SuiTestnet, noCoin)
+		require.ErrorContains(t, err, "coin object is required")
+	})
+}
+
+func TestBuildTON(t *testing.T) {
+	var (
+		gateway = ton.NewAccountID(0, [32]byte{1}).ToRaw()
+		sender  = ton.NewAccountID(0, [32]byte{2}).ToRaw()
+	)
+
+	for _, op := range []inbound.Operation{inbound.OpDeposit, inbound.OpDepositAndCall, inbound.OpCall} {
+		t.Run(string(op), func(t *testing.T) {
+			params := inbound.Params{
+				Operation: op,
+				Sender:    sender,
+				Gateway:   gateway,
+				Receiver:  receiver,
+			}
+			if op != inbound.OpCall {
+				params.Amount = 2_000_000_000
+			}
+			if op != inbound.OpDeposit {
+				params.Payload = payload
+			}
+
+			built, err := inbound.Build(chains.TONTestnet, params)
+			require.NoError(t, err)
+			require.Equal(t, gateway, built.TON.Gateway)
+			require.Equal(t, params.Amount, built.TON.Value)
+
+			_, err = base64.StdEncoding.DecodeString(built.TON.Body)
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("should reject revert options", func(t *testing.T) {
+		_, err := inbound.Build(chains.TONTestnet, inbound.Params{
+			Operation:    inbound.OpDeposit,
+			Sender:       sender,
+			Gateway:      gateway,
+			Receiver:     receiver,
+			Amount:       1,
+			AbortAddress: abort,
+		})
+		require.ErrorContains(t, err, "revert options are not supported")
+	})
+}
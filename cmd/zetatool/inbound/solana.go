@@ -0,0 +1,210 @@
+package inbound
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/near/borsh-go"
+
+	solanacontracts "github.com/zeta-chain/node/pkg/contracts/solana"
+)
+
+// SolanaAccount is an account of a Solana instruction
+type SolanaAccount struct {
+	PublicKey  string `json:"public_key"`
+	IsSigner   bool   `json:"is_signer"`
+	IsWritable bool   `json:"is_writable"`
+}
+
+// SolanaPayload is a Solana gateway instruction, the sender is the signer and fee payer
+type SolanaPayload struct {
+	ProgramID string          `json:"program_id"`
+	Accounts  []SolanaAccount `json:"accounts"`
+	Data      string          `json:"data"`
+}
+
+// buildSolana encodes the inbound as a gateway instruction
+func buildSolana(params Params) (*SolanaPayload, error) {
+	gatewayID, pda, err := solanacontracts.ParseGatewayWithPDA(params.Gateway)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gateway address: %w", err)
+	}
+
+	sender, err := solana.PublicKeyFromBase58(params.Sender)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address: %w", err)
+	}
+
+	revertOptions, err := solanaRevertOptions(params)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []byte
+	switch params.Operation {
+	case OpDeposit:
+		data, err = borsh.Serialize(solanacontracts.DepositInstructionParams{
+			Discriminator: solanacontracts.DiscriminatorDeposit,
+			Amount:        params.Amount,
+			Receiver:      params.Receiver,
+			RevertOptions: revertOptions,
+		})
+	case OpDepositAndCall:
+		data, err = borsh.Serialize(solanacontracts.DepositAndCallInstructionParams{
+			Discriminator: solanacontracts.DiscriminatorDepositAndCall,
+			Amount:        params.Amount,
+			Receiver:      params.Receiver,
+			Memo:          params.Payload,
+			RevertOptions: revertOptions,
+		})
+	case OpCall:
+		data, err = borsh.Serialize(solanacontracts.CallInstructionParams{
+			Discriminator: solanacontracts.DiscriminatorCall,
+			Receiver:      params.Receiver,
+			Memo:          params.Payload,
+			RevertOptions: revertOptions,
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize instruction: %w", err)
+	}
+
+	accounts := []SolanaAccount{{PublicKey: sender.String(), IsSigner: true, IsWritable: true}}
+	if params.Operation != OpCall {
+		accounts = append(accounts,
+			SolanaAccount{PublicKey: pda.String(), IsWritable: true},
+			SolanaAccount{PublicKey: solana.SystemProgramID.String()},
+		)
+	}
+
+	payload := &SolanaPayload{
+		ProgramID: gatewayID.String(),
+		Accounts:  accounts,
+		Data:      hex.EncodeToString(data),
+	}
+
+	if err := validateSolana(payload, params); err != nil {
+		return nil, fmt.Errorf("payload rejected by the observer parser: %w", err)
+	}
+
+	return payload, nil
+}
+
+// solanaRevertOptions returns the revert options of the instruction, nil if none is set
+func solanaRevertOptions(params Params) (*solanacontracts.RevertOptions, error) {
+	if !params.hasRevertOptions() {
+		return nil, nil
+	}
+
+	abortAddress, err := params.parseAbortAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	revertOptions := &solanacontracts.RevertOptions{
+		AbortAddress:  abortAddress,
+		CallOnRevert:  params.CallOnRevert,
+		RevertMessage: params.RevertMessage,
+	}
+	if params.RevertAddress != "" {
+		revertOptions.RevertAddress, err = solana.PublicKeyFromBase58(params.RevertAddress)
+		if err != nil {
+			return nil, fmt.Errorf("invalid revert address %q: %w", params.RevertAddress, err)
+		}
+	}
+
+	return revertOptions, nil
+}
+
+// validateSolana parses the instruction of an unsigned transaction like the Solana observer
+func validateSolana(payload *SolanaPayload, params Params) error {
+	tx, err := NewSolanaTransaction(payload, solana.Hash{})
+	if err != nil {
+		return err
+	}
+
+	instruction := tx.Message.Instructions[0]
+
+	var inbound *solanacontracts.Inbound
+	if params.Operation == OpCall {
+		inbound, err = solanacontracts.ParseInboundAsCall(tx, instruction, 0)
+	} else {
+		inbound, err = solanacontracts.ParseInboundAsDeposit(tx, instruction, 0)
+	}
+	switch {
+	case err != nil:
+		return err
+	case inbound == nil:
+		return fmt.Errorf("instruction not recognized as %s", params.Operation)
+	case inbound.Sender != params.Sender:
+		return fmt.Errorf("parsed sender %s, want %s", inbound.Sender, params.Sender)
+	}
+
+	return checkParsed(params, inbound.Receiver, inbound.Amount, inbound.Memo, inbound.IsCrossChainCall)
+}
+
+// NewSolanaTransaction creates the unsigned transaction of a Solana payload paid by its signer
+func NewSolanaTransaction(payload *SolanaPayload, recentBlockhash solana.Hash) (*solana.Transaction, error) {
+	programID, err := solana.PublicKeyFromBase58(payload.ProgramID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid program id: %w", err)
+	}
+
+	data, err := hex.DecodeString(payload.Data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid instruction data: %w", err)
+	}
+
+	if len(payload.Accounts) == 0 || !payload.Accounts[0].IsSigner {
+		return nil, fmt.Errorf("first account must be the signer")
+	}
+
+	accounts := make([]*solana.AccountMeta, 0, len(payload.Accounts))
+	for _, account := range payload.Accounts {
+		publicKey, err := solana.PublicKeyFromBase58(account.PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid account %q: %w", account.PublicKey, err)
+		}
+		accounts = append(accounts, solana.NewAccountMeta(publicKey, account.IsWritable, account.IsSigner))
+	}
+
+	instruction := solana.NewInstruction(programID, accounts, data)
+
+	return solana.NewTransaction(
+		[]solana.Instruction{instruction},
+		recentBlockhash,
+		solana.TransactionPayer(accounts[0].PublicKey),
+	)
+}
+
+// SignSolana creates the transaction of a Solana payload and signs it with the key of the sender
+func SignSolana(
+	payload *Payload,
+	recentBlockhash solana.Hash,
+	key solana.PrivateKey,
+) (*solana.Transaction, error) {
+	if payload.Solana == nil {
+		return nil, fmt.Errorf("not a Solana payload")
+	}
+	if key.PublicKey().String() != payload.Sender {
+		return nil, fmt.Errorf("key of %s does not match sender %s", key.PublicKey(), payload.Sender)
+	}
+
+	tx, err := NewSolanaTransaction(payload.Solana, recentBlockhash)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Sign(func(publicKey solana.PublicKey) *solana.PrivateKey {
+		if publicKey.Equals(key.PublicKey()) {
+			return &key
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign transaction: %w", err)
+	}
+
+	return tx, nil
+}
@@ -0,0 +1,115 @@
+package inbound
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+
+	"github.com/block-vision/sui-go-sdk/models"
+
+	suicontracts "github.com/zeta-chain/node/pkg/contracts/sui"
+)
+
+// suiGasBudget is the gas budget of the gateway move calls in MIST
+const suiGasBudget = "500000000"
+
+// SuiPayload is a move call to the Sui gateway depositing a whole coin object of the sender
+type SuiPayload struct {
+	models.MoveCallRequest
+}
+
+// buildSui encodes the inbound as a gateway move call
+func buildSui(params Params) (*SuiPayload, error) {
+	if params.Operation == OpCall {
+		return nil, fmt.Errorf("%s is not supported by the Sui gateway", params.Operation)
+	}
+	if params.hasRevertOptions() {
+		return nil, fmt.Errorf("revert options are not supported by the Sui gateway")
+	}
+	if params.SuiCoinObjectID == "" {
+		return nil, fmt.Errorf("coin object is required")
+	}
+
+	gw, err := suicontracts.NewGatewayFromPairID(params.Gateway)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gateway address: %w", err)
+	}
+	if err := suicontracts.ValidateAddress(params.Sender); err != nil {
+		return nil, fmt.Errorf("invalid sender address: %w", err)
+	}
+
+	function := "deposit"
+	arguments := []any{gw.ObjectID(), params.SuiCoinObjectID, params.Receiver.Hex()}
+	if params.Operation == OpDepositAndCall {
+		function = "deposit_and_call"
+		arguments = append(arguments, params.Payload)
+	}
+
+	payload := &SuiPayload{
+		MoveCallRequest: models.MoveCallRequest{
+			Signer:          params.Sender,
+			PackageObjectId: gw.PackageID(),
+			Module:          suicontracts.GatewayModule,
+			Function:        function,
+			TypeArguments:   []any{string(suicontracts.SUI)},
+			Arguments:       arguments,
+			GasBudget:       suiGasBudget,
+		},
+	}
+
+	if err := validateSui(gw, payload, params); err != nil {
+		return nil, fmt.Errorf("payload rejected by the observer parser: %w", err)
+	}
+
+	return payload, nil
+}
+
+// validateSui parses the event emitted by the gateway for the move call like the Sui observer
+func validateSui(gw *suicontracts.Gateway, payload *SuiPayload, params Params) error {
+	eventType := suicontracts.DepositEvent
+	parsedJSON := map[string]any{
+		"coin_type": string(suicontracts.SUI),
+		"amount":    strconv.FormatUint(params.Amount, 10),
+		"sender":    payload.Signer,
+		"receiver":  payload.Arguments[2],
+	}
+
+	if params.Operation == OpDepositAndCall {
+		eventType = suicontracts.DepositAndCallEvent
+
+		// the RPC receives the payload bytes as a base64 string that is stored as is in the event
+		encoded := base64.StdEncoding.EncodeToString(params.Payload)
+		emitted := make([]any, len(encoded))
+		for i := range encoded {
+			emitted[i] = float64(encoded[i])
+		}
+		parsedJSON["payload"] = emitted
+	}
+
+	event, err := gw.ParseEvent(models.SuiEventResponse{
+		Id:         models.EventId{TxDigest: "simulated", EventSeq: "0"},
+		PackageId:  payload.PackageObjectId,
+		Sender:     payload.Signer,
+		Type:       fmt.Sprintf("%s::%s::%s", payload.PackageObjectId, payload.Module, eventType),
+		ParsedJson: parsedJSON,
+	})
+	if err != nil {
+		return err
+	}
+
+	deposit, err := event.Deposit()
+	switch {
+	case err != nil:
+		return err
+	case !deposit.IsGas():
+		return fmt.Errorf("parsed coin type %s, want SUI", deposit.CoinType)
+	}
+
+	return checkParsed(
+		params,
+		deposit.Receiver.Hex(),
+		deposit.Amount.Uint64(),
+		deposit.Payload,
+		deposit.IsCrossChainCall,
+	)
+}
@@ -0,0 +1,232 @@
+package inbound
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/ton"
+	"github.com/tonkeeper/tongo/wallet"
+	"golang.org/x/crypto/ed25519"
+
+	toncontracts "github.com/zeta-chain/node/pkg/contracts/ton"
+	tonrpc "github.com/zeta-chain/node/zetaclient/chains/ton/rpc"
+)
+
+// tonSendMode pays the fees of the message separately from its value and ignores errors
+const tonSendMode = toncontracts.SendFlagSeparateFees + toncontracts.SendFlagIgnoreErrors
+
+// TONPayload is an internal message sent by the wallet of the sender to the TON gateway
+type TONPayload struct {
+	Gateway string `json:"gateway"`
+
+	// Value is the TON attached to the message: the deposited amount, or the gateway fee for a call
+	Value uint64 `json:"value"`
+
+	// Body is the base64 encoded BOC of the message body
+	Body string `json:"body"`
+}
+
+// buildTON encodes the inbound as the body of a message to the gateway
+func buildTON(params Params) (*TONPayload, error) {
+	if params.hasRevertOptions() {
+		return nil, fmt.Errorf("revert options are not supported by the TON gateway")
+	}
+
+	gatewayID, err := ton.ParseAccountID(params.Gateway)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gateway address: %w", err)
+	}
+
+	sender, err := ton.ParseAccountID(params.Sender)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address: %w", err)
+	}
+
+	deposit := toncontracts.Deposit{
+		Sender:    sender,
+		Amount:    math.NewUint(params.Amount),
+		Recipient: params.Receiver,
+	}
+
+	var body *boc.Cell
+	switch params.Operation {
+	case OpDeposit:
+		body, err = deposit.AsBody()
+	case OpDepositAndCall:
+		body, err = toncontracts.DepositAndCall{Deposit: deposit, CallData: params.Payload}.AsBody()
+	case OpCall:
+		body, err = toncontracts.Call{Sender: sender, Recipient: params.Receiver, CallData: params.Payload}.AsBody()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create message body: %w", err)
+	}
+
+	encoded, err := body.ToBocBase64()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode message body: %w", err)
+	}
+
+	payload := &TONPayload{
+		Gateway: gatewayID.ToRaw(),
+		Value:   params.Amount,
+		Body:    encoded,
+	}
+
+	if err := validateTON(gatewayID, sender, payload, params); err != nil {
+		return nil, fmt.Errorf("payload rejected by the observer parser: %w", err)
+	}
+
+	return payload, nil
+}
+
+// validateTON parses the gateway transaction processing the message like the TON observer
+func validateTON(gatewayID, sender ton.AccountID, payload *TONPayload, params Params) error {
+	body, err := boc.DeserializeSinglRootBase64(payload.Body)
+	if err != nil {
+		return fmt.Errorf("invalid message body: %w", err)
+	}
+
+	var tx ton.Transaction
+	tx.AccountAddr = gatewayID.Address
+	tx.Msgs.InMsg = tlb.Maybe[tlb.Ref[tlb.Message]]{Exists: true}
+	tx.Msgs.InMsg.Value.Value = tlb.Message{
+		Info: tlb.CommonMsgInfo{
+			SumType: "IntMsgInfo",
+			IntMsgInfo: &struct {
+				IhrDisabled bool
+				Bounce      bool
+				Bounced     bool
+				Src         tlb.MsgAddress
+				Dest        tlb.MsgAddress
+				Value       tlb.CurrencyCollection
+				IhrFee      tlb.Grams
+				FwdFee      tlb.Grams
+				CreatedLt   uint64
+				CreatedAt   uint32
+			}{
+				Bounce: true,
+				Src:    sender.ToMsgAddress(),
+				Dest:   gatewayID.ToMsgAddress(),
+				Value:  tlb.CurrencyCollection{Grams: tlb.Grams(payload.Value)},
+			},
+		},
+		Body: tlb.EitherRef[tlb.Any]{Value: tlb.Any(*body)},
+	}
+
+	// the gateway logs the deposited amount and the fee in its first out message
+	if params.Operation != OpCall {
+		depositLog := boc.NewCell()
+		if err := toncontracts.ErrCollect(
+			tlb.Grams(params.Amount).MarshalTLB(depositLog, nil),
+			tlb.Grams(0).MarshalTLB(depositLog, nil),
+		); err != nil {
+			return fmt.Errorf("failed to create deposit log: %w", err)
+		}
+
+		tx.Msgs.OutMsgs = tlb.NewHashmapE(
+			[]tlb.Uint15{0},
+			[]tlb.Ref[tlb.Message]{{Value: tlb.Message{
+				Body: tlb.EitherRef[tlb.Any]{IsRight: true, Value: tlb.Any(*depositLog)},
+			}}},
+		)
+		tx.OutMsgCnt = 1
+	}
+
+	parsed, err := toncontracts.NewGateway(gatewayID).ParseTransaction(tx)
+	if err != nil {
+		return err
+	}
+
+	var (
+		parsedSender ton.AccountID
+		receiver     string
+		amount       uint64
+		callData     []byte
+	)
+
+	switch params.Operation {
+	case OpDeposit:
+		deposit, err := parsed.Deposit()
+		if err != nil {
+			return err
+		}
+		parsedSender, receiver, amount = deposit.Sender, deposit.Recipient.Hex(), deposit.Amount.Uint64()
+	case OpDepositAndCall:
+		deposit, err := parsed.DepositAndCall()
+		if err != nil {
+			return err
+		}
+		parsedSender, receiver, amount = deposit.Sender, deposit.Recipient.Hex(), deposit.Amount.Uint64()
+		callData = deposit.CallData
+	case OpCall:
+		call, err := parsed.Call()
+		if err != nil {
+			return err
+		}
+		parsedSender, receiver, callData = call.Sender, call.Recipient.Hex(), call.CallData
+	}
+
+	if parsedSender != sender {
+		return fmt.Errorf("parsed sender %s, want %s", parsedSender.ToRaw(), sender.ToRaw())
+	}
+
+	return checkParsed(params, receiver, amount, callData, params.Operation != OpDeposit)
+}
+
+// tonBlockchain adapts the TON RPC client to the blockchain used by tongo wallets
+type tonBlockchain struct {
+	*tonrpc.Client
+}
+
+// GetAccountState implements the tongo wallet blockchain
+func (b tonBlockchain) GetAccountState(ctx context.Context, accountID ton.AccountID) (tlb.ShardAccount, error) {
+	state, err := b.Client.GetAccountState(ctx, accountID)
+	if err != nil {
+		return tlb.ShardAccount{}, err
+	}
+	return state.ToShardAccount(), nil
+}
+
+// NewTONWallet returns the V5R1 wallet of a private key sending messages through the TON RPC
+func NewTONWallet(key ed25519.PrivateKey, client *tonrpc.Client) (*wallet.Wallet, error) {
+	w, err := wallet.New(key, wallet.V5R1, tonBlockchain{Client: client})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create wallet: %w", err)
+	}
+	return &w, nil
+}
+
+// SendTON sends the message of a TON payload from the wallet of the sender
+func SendTON(ctx context.Context, payload *Payload, w *wallet.Wallet) error {
+	if payload.TON == nil {
+		return fmt.Errorf("not a TON payload")
+	}
+
+	sender, err := ton.ParseAccountID(payload.Sender)
+	if err != nil {
+		return fmt.Errorf("invalid sender address: %w", err)
+	}
+	if w.GetAddress() != sender {
+		return fmt.Errorf("wallet %s does not match sender %s", w.GetAddress().ToRaw(), sender.ToRaw())
+	}
+
+	gatewayID, err := ton.ParseAccountID(payload.TON.Gateway)
+	if err != nil {
+		return fmt.Errorf("invalid gateway address: %w", err)
+	}
+
+	body, err := boc.DeserializeSinglRootBase64(payload.TON.Body)
+	if err != nil {
+		return fmt.Errorf("invalid message body: %w", err)
+	}
+
+	return w.Send(ctx, wallet.Message{
+		Amount:  tlb.Coins(payload.TON.Value),
+		Address: gatewayID,
+		Body:    body,
+		Mode:    tonSendMode,
+	})
+}
@@ -20,6 +20,8 @@ func init() {
 	rootCmd.AddCommand(cli.NewTrackCCTXCMD())
 	rootCmd.AddCommand(cli.NewApplicationDBStatsCMD())
 	rootCmd.AddCommand(cli.NewTSSBalancesCMD())
+	rootCmd.AddCommand(cli.NewTSSSolvencyCMD())
+	rootCmd.AddCommand(cli.NewInboundCMD())
 	rootCmd.AddCommand(cli.NewListChainsCMD())
 	rootCmd.PersistentFlags().String(config.FlagConfig, "", "custom config file: --config filename.json")
 	rootCmd.PersistentFlags().
@@ -0,0 +1,248 @@
+package solvency
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+// Fixture contains the RPC responses of an audit, it allows replaying the audit offline
+type Fixture struct {
+	ZetaHeight      int64                                     `json:"zeta_height"`
+	ForeignCoins    []fungibletypes.ForeignCoins              `json:"foreign_coins"`
+	SupportedChains []chains.Chain                            `json:"supported_chains"`
+	ChainParams     map[int64]*observertypes.ChainParams      `json:"chain_params"`
+	TSSAddresses    *observertypes.QueryGetTssAddressResponse `json:"tss_addresses"`
+	PendingCctxs    map[int64][]*crosschaintypes.CrossChainTx `json:"pending_cctxs"`
+	ZRC20Supplies   map[string]string                         `json:"zrc20_supplies"`
+	Balances        map[string]string                         `json:"balances"`
+}
+
+// NewFixture returns an empty fixture for a ZetaChain height
+func NewFixture(zetaHeight int64) *Fixture {
+	return &Fixture{
+		ZetaHeight:    zetaHeight,
+		ChainParams:   make(map[int64]*observertypes.ChainParams),
+		PendingCctxs:  make(map[int64][]*crosschaintypes.CrossChainTx),
+		ZRC20Supplies: make(map[string]string),
+		Balances:      make(map[string]string),
+	}
+}
+
+// LoadFixture reads a fixture from a JSON file
+func LoadFixture(path string) (*Fixture, error) {
+	// #nosec G304 the path is provided by the user
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixture: %w", err)
+	}
+
+	fixture := NewFixture(0)
+	if err := json.Unmarshal(data, fixture); err != nil {
+		return nil, fmt.Errorf("failed to decode fixture: %w", err)
+	}
+	return fixture, nil
+}
+
+// Save writes the fixture to a JSON file
+func (f *Fixture) Save(path string) error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode fixture: %w", err)
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// balanceKey returns the key of a balance in the fixture
+func balanceKey(chainID int64, asset, holder string) string {
+	return fmt.Sprintf("%d/%s/%s", chainID, strings.ToLower(asset), holder)
+}
+
+// FixtureSource is a Source replaying the responses recorded in a fixture
+type FixtureSource struct {
+	fixture *Fixture
+}
+
+var _ Source = (*FixtureSource)(nil)
+
+// NewFixtureSource returns a Source replaying a fixture
+func NewFixtureSource(fixture *Fixture) *FixtureSource {
+	return &FixtureSource{fixture: fixture}
+}
+
+// ForeignCoins implements Source
+func (s *FixtureSource) ForeignCoins(_ context.Context) ([]fungibletypes.ForeignCoins, error) {
+	return s.fixture.ForeignCoins, nil
+}
+
+// SupportedChains implements Source
+func (s *FixtureSource) SupportedChains(_ context.Context) ([]chains.Chain, error) {
+	return s.fixture.SupportedChains, nil
+}
+
+// ChainParams implements Source
+func (s *FixtureSource) ChainParams(_ context.Context, chainID int64) (*observertypes.ChainParams, error) {
+	params, ok := s.fixture.ChainParams[chainID]
+	if !ok {
+		return nil, fmt.Errorf("chain params for chain %d not recorded", chainID)
+	}
+	return params, nil
+}
+
+// TSSAddresses implements Source
+func (s *FixtureSource) TSSAddresses(_ context.Context) (*observertypes.QueryGetTssAddressResponse, error) {
+	if s.fixture.TSSAddresses == nil {
+		return nil, fmt.Errorf("TSS addresses not recorded")
+	}
+	return s.fixture.TSSAddresses, nil
+}
+
+// PendingCctxs implements Source
+func (s *FixtureSource) PendingCctxs(_ context.Context, chainID int64) ([]*crosschaintypes.CrossChainTx, error) {
+	return s.fixture.PendingCctxs[chainID], nil
+}
+
+// ZRC20TotalSupply implements Source
+func (s *FixtureSource) ZRC20TotalSupply(_ context.Context, zrc20 string) (*big.Int, error) {
+	return parseRecordedAmount(s.fixture.ZRC20Supplies, strings.ToLower(zrc20))
+}
+
+// Balance implements Source
+func (s *FixtureSource) Balance(_ context.Context, chain chains.Chain, asset, holder string) (*big.Int, error) {
+	return parseRecordedAmount(s.fixture.Balances, balanceKey(chain.ChainId, asset, holder))
+}
+
+// parseRecordedAmount returns a recorded amount of a fixture
+func parseRecordedAmount(amounts map[string]string, key string) (*big.Int, error) {
+	recorded, ok := amounts[key]
+	if !ok {
+		return nil, fmt.Errorf("amount for %s not recorded", key)
+	}
+	amount, ok := new(big.Int).SetString(recorded, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount %q recorded for %s", recorded, key)
+	}
+	return amount, nil
+}
+
+// RecordingSource is a Source recording the responses of another source into a fixture
+type RecordingSource struct {
+	source  Source
+	mu      sync.Mutex
+	fixture *Fixture
+}
+
+var _ Source = (*RecordingSource)(nil)
+
+// NewRecordingSource returns a Source recording the responses of source at a ZetaChain height
+func NewRecordingSource(source Source, zetaHeight int64) *RecordingSource {
+	return &RecordingSource{
+		source:  source,
+		fixture: NewFixture(zetaHeight),
+	}
+}
+
+// Fixture returns the responses recorded so far
+func (s *RecordingSource) Fixture() *Fixture {
+	return s.fixture
+}
+
+// ForeignCoins implements Source
+func (s *RecordingSource) ForeignCoins(ctx context.Context) ([]fungibletypes.ForeignCoins, error) {
+	foreignCoins, err := s.source.ForeignCoins(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fixture.ForeignCoins = foreignCoins
+	return foreignCoins, nil
+}
+
+// SupportedChains implements Source
+func (s *RecordingSource) SupportedChains(ctx context.Context) ([]chains.Chain, error) {
+	supportedChains, err := s.source.SupportedChains(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fixture.SupportedChains = supportedChains
+	return supportedChains, nil
+}
+
+// ChainParams implements Source
+func (s *RecordingSource) ChainParams(ctx context.Context, chainID int64) (*observertypes.ChainParams, error) {
+	params, err := s.source.ChainParams(ctx, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fixture.ChainParams[chainID] = params
+	return params, nil
+}
+
+// TSSAddresses implements Source
+func (s *RecordingSource) TSSAddresses(ctx context.Context) (*observertypes.QueryGetTssAddressResponse, error) {
+	addresses, err := s.source.TSSAddresses(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fixture.TSSAddresses = addresses
+	return addresses, nil
+}
+
+// PendingCctxs implements Source
+func (s *RecordingSource) PendingCctxs(ctx context.Context, chainID int64) ([]*crosschaintypes.CrossChainTx, error) {
+	cctxs, err := s.source.PendingCctxs(ctx, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fixture.PendingCctxs[chainID] = cctxs
+	return cctxs, nil
+}
+
+// ZRC20TotalSupply implements Source
+func (s *RecordingSource) ZRC20TotalSupply(ctx context.Context, zrc20 string) (*big.Int, error) {
+	supply, err := s.source.ZRC20TotalSupply(ctx, zrc20)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fixture.ZRC20Supplies[strings.ToLower(zrc20)] = supply.String()
+	return supply, nil
+}
+
+// Balance implements Source
+func (s *RecordingSource) Balance(ctx context.Context, chain chains.Chain, asset, holder string) (*big.Int, error) {
+	balance, err := s.source.Balance(ctx, chain, asset, holder)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fixture.Balances[balanceKey(chain.ChainId, asset, holder)] = balance.String()
+	return balance, nil
+}
@@ -0,0 +1,93 @@
+package solvency
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+)
+
+// WriteJSON writes the report as indented JSON
+func (r *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// WriteTable writes the report as a table, amounts are formatted with the decimals of the asset
+func (r *Report) WriteTable(w io.Writer) {
+	t := table.NewWriter()
+	t.SetOutputMirror(w)
+	title := fmt.Sprintf("TSS solvency at ZetaChain height %d", r.ZetaHeight)
+	if len(r.Unsupported) > 0 {
+		title += fmt.Sprintf("\nUnsupported assets, not audited: %s", strings.Join(r.Unsupported, ", "))
+	}
+	t.SetTitle(title)
+	t.AppendHeader(table.Row{
+		"Chain",
+		"Chain ID",
+		"Asset",
+		"Holder",
+		"Balance",
+		"Pending Outbound",
+		"ZRC20 Supply",
+		"Pool Liquidity",
+		"Surplus/Deficit",
+	})
+
+	for _, a := range r.Assets {
+		holder := a.Holder
+		if len(holder) > 44 {
+			holder = holder[:20] + "..." + holder[len(holder)-20:]
+		}
+
+		if a.Error != "" {
+			t.AppendRow(table.Row{a.ChainName, a.ChainID, a.Symbol, holder, a.Error, "", "", "", ""})
+			continue
+		}
+
+		surplus := formatAmount(a.Surplus, a.Decimals)
+		if a.IsDeficit() {
+			surplus += " (deficit)"
+		}
+
+		t.AppendRow(table.Row{
+			a.ChainName,
+			a.ChainID,
+			a.Symbol,
+			holder,
+			formatAmount(a.Balance, a.Decimals),
+			formatAmount(a.PendingOutbound, a.Decimals),
+			formatAmount(a.ZRC20Supply, a.Decimals),
+			formatAmount(a.PoolLiquidity, a.Decimals),
+			surplus,
+		})
+	}
+
+	t.Render()
+}
+
+// formatAmount formats an integer amount in the smallest unit with the given decimals
+func formatAmount(amount string, decimals uint32) string {
+	value, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return amount
+	}
+
+	if decimals == 0 {
+		return value.String()
+	}
+
+	sign := ""
+	if value.Sign() < 0 {
+		sign = "-"
+		value.Abs(value)
+	}
+
+	divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	quotient, remainder := new(big.Int).QuoRem(value, divisor, new(big.Int))
+	return fmt.Sprintf("%s%s.%0*s", sign, quotient.String(), int(decimals), remainder.String())
+}
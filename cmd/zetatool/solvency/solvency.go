@@ -0,0 +1,269 @@
+// Package solvency audits the funds held by the TSS on the connected chains against the ZRC20 supply they back
+package solvency
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/coin"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+// Source provides the data required for the audit
+// The ZetaChain queries are expected to be answered at the height pinned by the source
+type Source interface {
+	// ForeignCoins returns the whitelisted foreign coins
+	ForeignCoins(ctx context.Context) ([]fungibletypes.ForeignCoins, error)
+
+	// SupportedChains returns the chains supported by ZetaChain
+	SupportedChains(ctx context.Context) ([]chains.Chain, error)
+
+	// ChainParams returns the chain params of a connected chain
+	ChainParams(ctx context.Context, chainID int64) (*observertypes.ChainParams, error)
+
+	// TSSAddresses returns the addresses of the current TSS
+	TSSAddresses(ctx context.Context) (*observertypes.QueryGetTssAddressResponse, error)
+
+	// PendingCctxs returns the CCTXs with a pending outbound to a connected chain
+	PendingCctxs(ctx context.Context, chainID int64) ([]*crosschaintypes.CrossChainTx, error)
+
+	// ZRC20TotalSupply returns the total supply of a ZRC20 on ZEVM
+	ZRC20TotalSupply(ctx context.Context, zrc20 string) (*big.Int, error)
+
+	// Balance returns the amount of an asset held by holder on a connected chain
+	// asset is empty for the gas token of the chain
+	Balance(ctx context.Context, chain chains.Chain, asset, holder string) (*big.Int, error)
+}
+
+// AssetReport is the solvency of a single whitelisted asset
+// Amounts are in the smallest unit of the asset
+type AssetReport struct {
+	ChainID   int64  `json:"chain_id"`
+	ChainName string `json:"chain_name"`
+	Symbol    string `json:"symbol"`
+	CoinType  string `json:"coin_type"`
+	Asset     string `json:"asset,omitempty"`
+	ZRC20     string `json:"zrc20"`
+	Decimals  uint32 `json:"decimals"`
+
+	// Holder is the address holding the asset on the connected chain: the TSS, the custody or the gateway
+	Holder string `json:"holder"`
+
+	Balance         string `json:"balance,omitempty"`
+	ZRC20Supply     string `json:"zrc20_supply,omitempty"`
+	PendingOutbound string `json:"pending_outbound,omitempty"`
+
+	// PoolLiquidity is the gas ZRC20 minted by the protocol into the ZETA/gas pool, it is not backed by the holder
+	PoolLiquidity string `json:"pool_liquidity,omitempty"`
+
+	// Surplus is balance - pending outbound - (ZRC20 supply - pool liquidity), a negative value is a deficit
+	Surplus string `json:"surplus,omitempty"`
+
+	Error string `json:"error,omitempty"`
+}
+
+// IsDeficit returns true if the asset is not fully backed by the holder balance
+func (a AssetReport) IsDeficit() bool {
+	return strings.HasPrefix(a.Surplus, "-")
+}
+
+// Report is the solvency of all the whitelisted assets at a ZetaChain height
+type Report struct {
+	ZetaHeight int64         `json:"zeta_height"`
+	Assets     []AssetReport `json:"assets"`
+
+	// Unsupported lists the whitelisted assets whose holder balance can't be audited
+	Unsupported []string `json:"unsupported,omitempty"`
+}
+
+// IsBalanceSupported returns false for the assets whose holder balance can't be queried by the audit:
+// the non-gas assets of Sui are held in the vaults of the gateway object and TON only supports its gas token
+func IsBalanceSupported(chain chains.Chain, coinType coin.CoinType) bool {
+	switch chain.Vm {
+	case chains.Vm_mvm_sui, chains.Vm_tvm:
+		return coinType == coin.CoinType_Gas
+	default:
+		return true
+	}
+}
+
+// Audit computes the solvency of every whitelisted asset of the supported connected chains
+// The outbounds pending at the pinned height are still held by the holder but no longer backed by ZRC20,
+// they are therefore subtracted from the balance before comparing it with the ZRC20 supply
+func Audit(ctx context.Context, source Source, zetaHeight int64) (*Report, error) {
+	supportedChains, err := source.SupportedChains(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get supported chains: %w", err)
+	}
+
+	foreignCoins, err := source.ForeignCoins(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get foreign coins: %w", err)
+	}
+
+	tssAddresses, err := source.TSSAddresses(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get TSS addresses: %w", err)
+	}
+
+	connectedChains := make(map[int64]chains.Chain)
+	for _, chain := range supportedChains {
+		if chain.IsExternal {
+			connectedChains[chain.ChainId] = chain
+		}
+	}
+
+	report := &Report{ZetaHeight: zetaHeight}
+	chainParams := make(map[int64]*observertypes.ChainParams)
+	pendingCctxs := make(map[int64][]*crosschaintypes.CrossChainTx)
+
+	for _, fc := range foreignCoins {
+		chain, ok := connectedChains[fc.ForeignChainId]
+		if !ok {
+			continue
+		}
+		if !IsBalanceSupported(chain, fc.CoinType) {
+			report.Unsupported = append(report.Unsupported, fmt.Sprintf("%s (%s)", fc.Symbol, chain.Name))
+			continue
+		}
+
+		asset := AssetReport{
+			ChainID:   chain.ChainId,
+			ChainName: chain.Name,
+			Symbol:    fc.Symbol,
+			CoinType:  fc.CoinType.String(),
+			Asset:     fc.Asset,
+			ZRC20:     fc.Zrc20ContractAddress,
+			Decimals:  fc.Decimals,
+		}
+
+		if _, ok := chainParams[chain.ChainId]; !ok {
+			params, err := source.ChainParams(ctx, chain.ChainId)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get chain params for chain %d: %w", chain.ChainId, err)
+			}
+			chainParams[chain.ChainId] = params
+
+			cctxs, err := source.PendingCctxs(ctx, chain.ChainId)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get pending cctxs for chain %d: %w", chain.ChainId, err)
+			}
+			pendingCctxs[chain.ChainId] = cctxs
+		}
+
+		auditAsset(ctx, source, &asset, chain, fc, chainParams[chain.ChainId], tssAddresses, pendingCctxs[chain.ChainId])
+		report.Assets = append(report.Assets, asset)
+	}
+
+	sort.SliceStable(report.Assets, func(i, j int) bool {
+		if report.Assets[i].ChainID != report.Assets[j].ChainID {
+			return report.Assets[i].ChainID < report.Assets[j].ChainID
+		}
+		return report.Assets[i].Symbol < report.Assets[j].Symbol
+	})
+	sort.Strings(report.Unsupported)
+
+	return report, nil
+}
+
+// auditAsset fills the amounts of an asset report, failures are recorded in the report rather than aborting the audit
+func auditAsset(
+	ctx context.Context,
+	source Source,
+	asset *AssetReport,
+	chain chains.Chain,
+	fc fungibletypes.ForeignCoins,
+	params *observertypes.ChainParams,
+	tssAddresses *observertypes.QueryGetTssAddressResponse,
+	cctxs []*crosschaintypes.CrossChainTx,
+) {
+	holder, err := HolderAddress(chain, fc, params, tssAddresses)
+	if err != nil {
+		asset.Error = err.Error()
+		return
+	}
+	asset.Holder = holder
+
+	pending := PendingOutboundAmount(cctxs, fc)
+	asset.PendingOutbound = pending.String()
+
+	supply, err := source.ZRC20TotalSupply(ctx, fc.Zrc20ContractAddress)
+	if err != nil {
+		asset.Error = fmt.Sprintf("failed to get ZRC20 supply: %s", err.Error())
+		return
+	}
+	asset.ZRC20Supply = supply.String()
+
+	// the pool liquidity minted with the gas ZRC20 is part of the supply but was never deposited
+	poolLiquidity := big.NewInt(0)
+	if fc.CoinType == coin.CoinType_Gas {
+		// #nosec G115 decimals are validated at the deployment of the coin
+		poolLiquidity = fungibletypes.GasCoinPoolLiquidity(uint8(fc.Decimals))
+	}
+	asset.PoolLiquidity = poolLiquidity.String()
+
+	balance, err := source.Balance(ctx, chain, fc.Asset, holder)
+	if err != nil {
+		asset.Error = fmt.Sprintf("failed to get balance: %s", err.Error())
+		return
+	}
+	asset.Balance = balance.String()
+
+	backedSupply := new(big.Int).Sub(supply, poolLiquidity)
+	surplus := new(big.Int).Sub(balance, pending)
+	asset.Surplus = surplus.Sub(surplus, backedSupply).String()
+}
+
+// HolderAddress returns the address holding the funds of a foreign coin on its connected chain
+func HolderAddress(
+	chain chains.Chain,
+	fc fungibletypes.ForeignCoins,
+	params *observertypes.ChainParams,
+	tssAddresses *observertypes.QueryGetTssAddressResponse,
+) (string, error) {
+	var holder string
+	switch {
+	case chain.Vm == chains.Vm_evm && fc.CoinType == coin.CoinType_Gas:
+		holder = tssAddresses.Eth
+	case chain.Vm == chains.Vm_evm && fc.CoinType == coin.CoinType_ERC20:
+		holder = params.Erc20CustodyContractAddress
+	case chain.Vm == chains.Vm_no_vm && fc.CoinType == coin.CoinType_Gas:
+		holder = tssAddresses.Btc
+	case chain.Vm == chains.Vm_mvm_sui && fc.CoinType == coin.CoinType_Gas:
+		holder = tssAddresses.Sui
+	case chain.Vm == chains.Vm_svm, chain.Vm == chains.Vm_tvm, chain.Vm == chains.Vm_mvm_sui:
+		holder = params.GatewayAddress
+	default:
+		return "", fmt.Errorf("coin type %s not supported on chain %d", fc.CoinType, chain.ChainId)
+	}
+
+	if holder == "" {
+		return "", fmt.Errorf("holder address not configured for %s on chain %d", fc.Symbol, chain.ChainId)
+	}
+	return holder, nil
+}
+
+// PendingOutboundAmount returns the total amount of a foreign coin in the pending outbounds to its chain
+func PendingOutboundAmount(cctxs []*crosschaintypes.CrossChainTx, fc fungibletypes.ForeignCoins) *big.Int {
+	total := big.NewInt(0)
+	for _, cctx := range cctxs {
+		if cctx.InboundParams == nil || cctx.InboundParams.CoinType != fc.CoinType {
+			continue
+		}
+		if !strings.EqualFold(cctx.InboundParams.Asset, fc.Asset) {
+			continue
+		}
+		outbound := cctx.GetCurrentOutboundParam()
+		if outbound.ReceiverChainId != fc.ForeignChainId || outbound.Amount.IsNil() {
+			continue
+		}
+		total.Add(total, outbound.Amount.BigInt())
+	}
+	return total
+}
@@ -0,0 +1,185 @@
+package solvency_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/cmd/zetatool/solvency"
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/coin"
+	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+func loadTestnetFixture(t *testing.T) *solvency.Fixture {
+	fixture, err := solvency.LoadFixture(filepath.Join("testdata", "testnet.json"))
+	require.NoError(t, err)
+	return fixture
+}
+
+func TestAudit(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should audit the assets of the supported chains from a fixture", func(t *testing.T) {
+		fixture := loadTestnetFixture(t)
+
+		report, err := solvency.Audit(ctx, solvency.NewFixtureSource(fixture), fixture.ZetaHeight)
+		require.NoError(t, err)
+		require.EqualValues(t, 11420000, report.ZetaHeight)
+
+		// BNB is skipped as BSC testnet is not a supported chain
+		require.Len(t, report.Assets, 4)
+
+		sol := report.Assets[0]
+		require.EqualValues(t, 901, sol.ChainID)
+		require.Equal(t, "ZETAjseVjuFsxdRxo6MmTCvqFwb3ZHUx56Co3vCmGis", sol.Holder)
+		require.Contains(t, sol.Error, "failed to get balance")
+		require.Empty(t, sol.Surplus)
+
+		// the gas ZRC20 minted into the ZETA/gas pool is not backed by the TSS
+		btc := report.Assets[1]
+		require.Equal(t, "sBTC.BTC", btc.Symbol)
+		require.Equal(t, "20000000", btc.PendingOutbound)
+		require.Equal(t, "10000000", btc.PoolLiquidity)
+		require.Equal(t, "40000000", btc.Surplus)
+
+		eth := report.Assets[2]
+		require.Equal(t, "ETH.ETHSEP", eth.Symbol)
+		require.Equal(t, "0x8531a5aB847ff5B22D855633C25ED1DA3255247e", eth.Holder)
+		require.Equal(t, "10000000000000000000", eth.Balance)
+		require.Equal(t, "500000000000000000", eth.PendingOutbound)
+		require.Equal(t, "9000000000000000000", eth.ZRC20Supply)
+		require.Equal(t, "100000000000000000", eth.PoolLiquidity)
+		require.Equal(t, "600000000000000000", eth.Surplus)
+		require.False(t, eth.IsDeficit())
+
+		usdc := report.Assets[3]
+		require.Equal(t, "USDC.SEP", usdc.Symbol)
+		require.Equal(t, "0x0000030Ec64DF25301d8414eE5a29588C4B0dE10", usdc.Holder)
+		require.Equal(t, "0", usdc.PendingOutbound)
+		require.Equal(t, "0", usdc.PoolLiquidity)
+		require.Equal(t, "-200000000", usdc.Surplus)
+		require.True(t, usdc.IsDeficit())
+	})
+
+	t.Run("should list the assets whose balance can't be audited", func(t *testing.T) {
+		fixture := loadTestnetFixture(t)
+		fixture.SupportedChains = append(fixture.SupportedChains, chains.SuiTestnet)
+		fixture.ForeignCoins = append(fixture.ForeignCoins, fungibletypes.ForeignCoins{
+			ForeignChainId: chains.SuiTestnet.ChainId,
+			Symbol:         "USDC.SUI",
+			CoinType:       coin.CoinType_ERC20,
+		})
+
+		report, err := solvency.Audit(ctx, solvency.NewFixtureSource(fixture), fixture.ZetaHeight)
+		require.NoError(t, err)
+		require.Len(t, report.Assets, 4)
+		require.Equal(t, []string{"USDC.SUI (sui_testnet)"}, report.Unsupported)
+
+		var buf bytes.Buffer
+		report.WriteTable(&buf)
+		require.Contains(t, buf.String(), "Unsupported assets, not audited: USDC.SUI (sui_testnet)")
+	})
+
+	t.Run("should replay a recorded audit", func(t *testing.T) {
+		fixture := loadTestnetFixture(t)
+		expected, err := solvency.Audit(ctx, solvency.NewFixtureSource(fixture), fixture.ZetaHeight)
+		require.NoError(t, err)
+
+		recorder := solvency.NewRecordingSource(solvency.NewFixtureSource(fixture), fixture.ZetaHeight)
+		_, err = solvency.Audit(ctx, recorder, fixture.ZetaHeight)
+		require.NoError(t, err)
+
+		path := filepath.Join(t.TempDir(), "recorded.json")
+		require.NoError(t, recorder.Fixture().Save(path))
+
+		recorded, err := solvency.LoadFixture(path)
+		require.NoError(t, err)
+
+		actual, err := solvency.Audit(ctx, solvency.NewFixtureSource(recorded), recorded.ZetaHeight)
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+	})
+}
+
+func TestReport(t *testing.T) {
+	fixture := loadTestnetFixture(t)
+	report, err := solvency.Audit(context.Background(), solvency.NewFixtureSource(fixture), fixture.ZetaHeight)
+	require.NoError(t, err)
+
+	t.Run("should write the report as JSON", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, report.WriteJSON(&buf))
+
+		var decoded solvency.Report
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+		require.Equal(t, *report, decoded)
+	})
+
+	t.Run("should write the report as a table", func(t *testing.T) {
+		var buf bytes.Buffer
+		report.WriteTable(&buf)
+
+		out := buf.String()
+		require.Contains(t, out, "TSS solvency at ZetaChain height 11420000")
+		require.Contains(t, out, "0.500000000000000000")
+		require.Contains(t, out, "-200.000000 (deficit)")
+		require.Contains(t, out, "0.40000000")
+	})
+}
+
+func TestIsBalanceSupported(t *testing.T) {
+	require.True(t, solvency.IsBalanceSupported(chains.Sepolia, coin.CoinType_ERC20))
+	require.True(t, solvency.IsBalanceSupported(chains.SolanaDevnet, coin.CoinType_ERC20))
+	require.True(t, solvency.IsBalanceSupported(chains.SuiTestnet, coin.CoinType_Gas))
+	require.False(t, solvency.IsBalanceSupported(chains.SuiTestnet, coin.CoinType_ERC20))
+	require.True(t, solvency.IsBalanceSupported(chains.TONTestnet, coin.CoinType_Gas))
+	require.False(t, solvency.IsBalanceSupported(chains.TONTestnet, coin.CoinType_ERC20))
+}
+
+func TestHolderAddress(t *testing.T) {
+	params := &observertypes.ChainParams{
+		Erc20CustodyContractAddress: "0xcustody",
+		GatewayAddress:              "gateway",
+	}
+	tss := &observertypes.QueryGetTssAddressResponse{Eth: "0xtss", Btc: "tb1tss", Sui: "0xsuitss"}
+
+	tt := []struct {
+		name        string
+		chain       chains.Chain
+		coinType    coin.CoinType
+		expected    string
+		expectError bool
+	}{
+		{name: "evm gas", chain: chains.Sepolia, coinType: coin.CoinType_Gas, expected: "0xtss"},
+		{name: "evm erc20", chain: chains.Sepolia, coinType: coin.CoinType_ERC20, expected: "0xcustody"},
+		{name: "bitcoin gas", chain: chains.BitcoinSignetTestnet, coinType: coin.CoinType_Gas, expected: "tb1tss"},
+		{name: "solana spl", chain: chains.SolanaDevnet, coinType: coin.CoinType_ERC20, expected: "gateway"},
+		{name: "sui gas", chain: chains.SuiTestnet, coinType: coin.CoinType_Gas, expected: "0xsuitss"},
+		{name: "bitcoin erc20", chain: chains.BitcoinSignetTestnet, coinType: coin.CoinType_ERC20, expectError: true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			fc := fungibletypes.ForeignCoins{ForeignChainId: tc.chain.ChainId, CoinType: tc.coinType}
+			holder, err := solvency.HolderAddress(tc.chain, fc, params, tss)
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, holder)
+		})
+	}
+
+	t.Run("should fail if the holder is not configured", func(t *testing.T) {
+		fc := fungibletypes.ForeignCoins{ForeignChainId: chains.Sepolia.ChainId, CoinType: coin.CoinType_ERC20}
+		_, err := solvency.HolderAddress(chains.Sepolia, fc, &observertypes.ChainParams{}, tss)
+		require.ErrorContains(t, err, "holder address not configured")
+	})
+}
@@ -0,0 +1,315 @@
+// Package load generates sustained mixed cross-chain traffic at a configured rate
+// and reports the throughput and the time to finalization of the CCTXs per traffic type.
+// The accounting invariants of the network are checked periodically while the traffic is sent.
+package load
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultCheckInterval is the default interval between two checks of the invariants
+	DefaultCheckInterval = 30 * time.Second
+
+	// DefaultMaxPending is the default number of traffic units that can be pending in a group
+	DefaultMaxPending = 10
+)
+
+// Traffic is a type of traffic sent by the load generator
+type Traffic struct {
+	// Name is the name of the traffic type, e.g. eth_deposit
+	Name string
+
+	// Group is the group of the traffic, the traffic of a group is sent sequentially
+	// it is used by the traffic types sharing an account
+	Group string
+
+	// Weight is the share of the traffic type in the mix
+	Weight int
+
+	// Send sends a traffic unit and returns once its CCTX is finalized
+	Send func() error
+}
+
+// Invariant is an invariant of the network checked while the traffic is sent
+type Invariant struct {
+	Name  string
+	Check func() error
+}
+
+// Config is the configuration of the load generator
+type Config struct {
+	// Rate is the number of traffic units scheduled per second
+	Rate float64
+
+	// Duration is the duration during which the traffic is scheduled
+	Duration time.Duration
+
+	// CheckInterval is the interval between two checks of the invariants
+	CheckInterval time.Duration
+
+	// MaxPending is the number of traffic units that can be pending in a group,
+	// the traffic scheduled for a group with MaxPending units pending is skipped
+	MaxPending int
+}
+
+// Validate checks the configuration is valid
+func (c Config) Validate() error {
+	switch {
+	case c.Rate <= 0:
+		return fmt.Errorf("invalid rate %f", c.Rate)
+	case c.Duration <= 0:
+		return fmt.Errorf("invalid duration %s", c.Duration)
+	case c.CheckInterval < 0:
+		return fmt.Errorf("invalid check interval %s", c.CheckInterval)
+	case c.MaxPending < 0:
+		return fmt.Errorf("invalid max pending %d", c.MaxPending)
+	}
+	return nil
+}
+
+// Logger logs the progress of the load generator
+type Logger interface {
+	Print(message string, args ...interface{})
+}
+
+// Generator sends the traffic and checks the invariants
+type Generator struct {
+	config     Config
+	traffic    []Traffic
+	invariants []Invariant
+	logger     Logger
+	scheduler  *scheduler
+
+	mu        sync.Mutex
+	stats     map[string]*trafficStats
+	checks    map[string]*invariantStats
+	startTime time.Time
+}
+
+// NewGenerator returns a new load generator
+func NewGenerator(config Config, traffic []Traffic, invariants []Invariant, logger Logger) (*Generator, error) {
+	if config.CheckInterval == 0 {
+		config.CheckInterval = DefaultCheckInterval
+	}
+	if config.MaxPending == 0 {
+		config.MaxPending = DefaultMaxPending
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	if len(traffic) == 0 {
+		return nil, errors.New("no traffic to send")
+	}
+
+	stats := make(map[string]*trafficStats, len(traffic))
+	weights := make([]int, 0, len(traffic))
+	for _, t := range traffic {
+		switch {
+		case t.Name == "":
+			return nil, errors.New("traffic name is empty")
+		case t.Weight <= 0:
+			return nil, fmt.Errorf("invalid weight %d for traffic %s", t.Weight, t.Name)
+		case t.Send == nil:
+			return nil, fmt.Errorf("no send function for traffic %s", t.Name)
+		}
+		if _, ok := stats[t.Name]; ok {
+			return nil, fmt.Errorf("traffic %s declared twice", t.Name)
+		}
+		stats[t.Name] = &trafficStats{}
+		weights = append(weights, t.Weight)
+	}
+
+	checks := make(map[string]*invariantStats, len(invariants))
+	for _, invariant := range invariants {
+		checks[invariant.Name] = &invariantStats{}
+	}
+
+	return &Generator{
+		config:     config,
+		traffic:    traffic,
+		invariants: invariants,
+		logger:     logger,
+		scheduler:  newScheduler(weights),
+		stats:      stats,
+		checks:     checks,
+	}, nil
+}
+
+// Run schedules the traffic for the configured duration, waits for the pending traffic and returns the report.
+// The invariants are checked periodically and once more after the pending traffic is finalized.
+func (g *Generator) Run(ctx context.Context) (Report, error) {
+	g.startTime = time.Now()
+	g.logger.Print("🏋️ sending %.2f CCTX/s for %s", g.config.Rate, g.config.Duration)
+
+	// start a worker per group
+	queues := make(map[string]chan int)
+	var workers sync.WaitGroup
+	for _, t := range g.traffic {
+		group := groupOf(t)
+		if _, ok := queues[group]; ok {
+			continue
+		}
+		queue := make(chan int, g.config.MaxPending)
+		queues[group] = queue
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			g.work(ctx, queue)
+		}()
+	}
+
+	// check the invariants periodically
+	checksCtx, stopChecks := context.WithCancel(ctx)
+	var checker sync.WaitGroup
+	checker.Add(1)
+	go func() {
+		defer checker.Done()
+		ticker := time.NewTicker(g.config.CheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-checksCtx.Done():
+				return
+			case <-ticker.C:
+				g.checkInvariants()
+			}
+		}
+	}()
+
+	g.schedule(ctx, queues)
+
+	// wait for the pending traffic
+	for _, queue := range queues {
+		close(queue)
+	}
+	workers.Wait()
+	stopChecks()
+	checker.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return g.report(time.Since(g.startTime)), fmt.Errorf("load interrupted: %w", err)
+	}
+	duration := time.Since(g.startTime)
+	g.checkInvariants()
+	return g.report(duration), nil
+}
+
+// schedule picks a traffic type at the configured rate until the duration is elapsed
+func (g *Generator) schedule(ctx context.Context, queues map[string]chan int) {
+	interval := time.Duration(float64(time.Second) / g.config.Rate)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	timer := time.NewTimer(g.config.Duration)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			return
+		case <-ticker.C:
+			i := g.scheduler.next()
+			t := g.traffic[i]
+			select {
+			case queues[groupOf(t)] <- i:
+				g.record(t.Name, func(s *trafficStats) { s.scheduled++ })
+			default:
+				g.record(t.Name, func(s *trafficStats) { s.skipped++ })
+			}
+		}
+	}
+}
+
+// work sends the traffic of a group sequentially
+func (g *Generator) work(ctx context.Context, queue <-chan int) {
+	for i := range queue {
+		if ctx.Err() != nil {
+			continue
+		}
+		t := g.traffic[i]
+		startTime := time.Now()
+		err := t.Send()
+		latency := time.Since(startTime)
+		if err != nil {
+			g.logger.Print("❌ %s failed after %s: %v", t.Name, latency, err)
+			g.record(t.Name, func(s *trafficStats) { s.failed++ })
+			continue
+		}
+		g.record(t.Name, func(s *trafficStats) { s.latencies = append(s.latencies, latency) })
+	}
+}
+
+// checkInvariants checks all the invariants and records the violations
+func (g *Generator) checkInvariants() {
+	for _, invariant := range g.invariants {
+		err := invariant.Check()
+		if err != nil {
+			g.logger.Print("❌ invariant %s violated: %v", invariant.Name, err)
+		}
+
+		g.mu.Lock()
+		s := g.checks[invariant.Name]
+		s.checks++
+		if err != nil {
+			s.violations = append(s.violations, Violation{
+				Elapsed: Duration(time.Since(g.startTime)),
+				Error:   err.Error(),
+			})
+		}
+		g.mu.Unlock()
+	}
+}
+
+// record updates the stats of a traffic type
+func (g *Generator) record(name string, update func(s *trafficStats)) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	update(g.stats[name])
+}
+
+// groupOf returns the group of the traffic, the traffic without group is its own group
+func groupOf(t Traffic) string {
+	if t.Group == "" {
+		return t.Name
+	}
+	return t.Group
+}
+
+// scheduler picks the traffic types with a smooth weighted round robin,
+// the sequence of picked traffic types is deterministic and spreads each type evenly
+type scheduler struct {
+	weights []int
+	current []int
+	total   int
+}
+
+func newScheduler(weights []int) *scheduler {
+	total := 0
+	for _, weight := range weights {
+		total += weight
+	}
+	return &scheduler{
+		weights: weights,
+		current: make([]int, len(weights)),
+		total:   total,
+	}
+}
+
+// next returns the index of the next traffic type
+func (s *scheduler) next() int {
+	best := 0
+	for i, weight := range s.weights {
+		s.current[i] += weight
+		if s.current[i] > s.current[best] {
+			best = i
+		}
+	}
+	s.current[best] -= s.total
+	return best
+}
@@ -0,0 +1,169 @@
+package load
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type noopLogger struct{}
+
+func (noopLogger) Print(_ string, _ ...interface{}) {}
+
+func TestScheduler(t *testing.T) {
+	s := newScheduler([]int{3, 1, 2})
+
+	counts := make([]int, 3)
+	sequence := make([]int, 0, 6)
+	for i := 0; i < 6; i++ {
+		next := s.next()
+		counts[next]++
+		sequence = append(sequence, next)
+	}
+
+	// each round of total weight picks each traffic type its weight times, spread evenly
+	require.Equal(t, []int{3, 1, 2}, counts)
+	require.Equal(t, []int{0, 2, 0, 1, 2, 0}, sequence)
+}
+
+func TestNewGenerator(t *testing.T) {
+	send := func() error { return nil }
+
+	tests := []struct {
+		name     string
+		config   Config
+		traffic  []Traffic
+		errorMsg string
+	}{
+		{
+			name:     "invalid rate",
+			config:   Config{Duration: time.Second},
+			traffic:  []Traffic{{Name: "eth_deposit", Weight: 1, Send: send}},
+			errorMsg: "invalid rate",
+		},
+		{
+			name:     "invalid duration",
+			config:   Config{Rate: 1},
+			traffic:  []Traffic{{Name: "eth_deposit", Weight: 1, Send: send}},
+			errorMsg: "invalid duration",
+		},
+		{
+			name:     "no traffic",
+			config:   Config{Rate: 1, Duration: time.Second},
+			errorMsg: "no traffic to send",
+		},
+		{
+			name:     "invalid weight",
+			config:   Config{Rate: 1, Duration: time.Second},
+			traffic:  []Traffic{{Name: "eth_deposit", Send: send}},
+			errorMsg: "invalid weight",
+		},
+		{
+			name:   "traffic declared twice",
+			config: Config{Rate: 1, Duration: time.Second},
+			traffic: []Traffic{
+				{Name: "eth_deposit", Weight: 1, Send: send},
+				{Name: "eth_deposit", Weight: 2, Send: send},
+			},
+			errorMsg: "declared twice",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewGenerator(tt.config, tt.traffic, nil, noopLogger{})
+			require.ErrorContains(t, err, tt.errorMsg)
+		})
+	}
+}
+
+func TestGenerator_Run(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		sent     = make(map[string]int)
+		checks   int
+		inflight = make(map[string]bool)
+	)
+	send := func(name, group string, err error) func() error {
+		return func() error {
+			mu.Lock()
+			// the traffic of a group is sent sequentially
+			require.False(t, inflight[group], "group %s already sending", group)
+			inflight[group] = true
+			sent[name]++
+			mu.Unlock()
+
+			time.Sleep(time.Millisecond)
+
+			mu.Lock()
+			inflight[group] = false
+			mu.Unlock()
+			return err
+		}
+	}
+
+	g, err := NewGenerator(
+		Config{Rate: 200, Duration: 200 * time.Millisecond, CheckInterval: 50 * time.Millisecond},
+		[]Traffic{
+			{Name: "eth_deposit", Group: "eth", Weight: 2, Send: send("eth_deposit", "eth", nil)},
+			{Name: "eth_withdraw", Group: "eth", Weight: 1, Send: send("eth_withdraw", "eth", nil)},
+			{Name: "sol_deposit", Weight: 1, Send: send("sol_deposit", "sol_deposit", errors.New("failed"))},
+		},
+		[]Invariant{{Name: "eth", Check: func() error {
+			mu.Lock()
+			defer mu.Unlock()
+			checks++
+			if checks == 2 {
+				return errors.New("violated")
+			}
+			return nil
+		}}},
+		noopLogger{},
+	)
+	require.NoError(t, err)
+
+	report, err := g.Run(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, report.Traffic, 3)
+	require.Equal(t, "eth_deposit", report.Traffic[0].Name)
+	require.NotZero(t, report.Scheduled)
+	require.Equal(t, report.Scheduled, report.Succeeded+report.Failed)
+	for _, traffic := range report.Traffic {
+		require.Equal(t, sent[traffic.Name], traffic.Succeeded+traffic.Failed)
+	}
+	require.Zero(t, report.Traffic[2].Succeeded)
+	require.Equal(t, report.Traffic[2].Scheduled, report.Traffic[2].Failed)
+	require.NotZero(t, report.Traffic[0].Latency.P50)
+	require.Greater(t, report.Throughput, 0.0)
+
+	// the invariants are checked periodically and at the end of the load
+	require.Len(t, report.Invariants, 1)
+	require.Equal(t, checks, report.Invariants[0].Checks)
+	require.GreaterOrEqual(t, report.Invariants[0].Checks, 2)
+	require.Len(t, report.Invariants[0].Violations, 1)
+	require.Equal(t, "violated", report.Invariants[0].Violations[0].Error)
+
+	require.ErrorContains(t, report.Validate(), "1 invariant violations")
+}
+
+func TestGenerator_RunInterrupted(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	g, err := NewGenerator(
+		Config{Rate: 100, Duration: time.Hour},
+		[]Traffic{{Name: "eth_deposit", Weight: 1, Send: func() error {
+			cancel()
+			return nil
+		}}},
+		nil,
+		noopLogger{},
+	)
+	require.NoError(t, err)
+
+	report, err := g.Run(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 1, report.Succeeded)
+}
@@ -0,0 +1,248 @@
+package load
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// Duration is a duration marshalled in JSON as a number of seconds
+type Duration time.Duration
+
+// MarshalJSON implements json.Marshaler
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).Seconds())
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (d *Duration) UnmarshalJSON(bz []byte) error {
+	var seconds float64
+	if err := json.Unmarshal(bz, &seconds); err != nil {
+		return err
+	}
+	*d = Duration(seconds * float64(time.Second))
+	return nil
+}
+
+// String returns the duration rounded to the millisecond
+func (d Duration) String() string {
+	return time.Duration(d).Round(time.Millisecond).String()
+}
+
+// Report is the machine-readable report of a load run, it can be compared between releases
+type Report struct {
+	// Version is the version of the network the load was run against
+	Version string `json:"version,omitempty"`
+
+	StartTime  time.Time `json:"start_time"`
+	Duration   Duration  `json:"duration_seconds"`
+	TargetRate float64   `json:"target_rate"`
+
+	// Throughput is the number of finalized traffic units per second
+	Throughput float64 `json:"throughput"`
+
+	Scheduled int `json:"scheduled"`
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+	Skipped   int `json:"skipped"`
+
+	Traffic    []TrafficReport   `json:"traffic"`
+	Invariants []InvariantReport `json:"invariants"`
+}
+
+// TrafficReport is the report of a traffic type
+type TrafficReport struct {
+	Name      string `json:"name"`
+	Scheduled int    `json:"scheduled"`
+	Succeeded int    `json:"succeeded"`
+	Failed    int    `json:"failed"`
+	Skipped   int    `json:"skipped"`
+
+	// Latency is the distribution of the time to finalization of the successful traffic units
+	Latency Latency `json:"latency_seconds"`
+}
+
+// Latency is a distribution of latencies
+type Latency struct {
+	Min  Duration `json:"min"`
+	Mean Duration `json:"mean"`
+	P50  Duration `json:"p50"`
+	P90  Duration `json:"p90"`
+	P99  Duration `json:"p99"`
+	Max  Duration `json:"max"`
+}
+
+// InvariantReport is the report of the checks of an invariant
+type InvariantReport struct {
+	Name       string      `json:"name"`
+	Checks     int         `json:"checks"`
+	Violations []Violation `json:"violations"`
+}
+
+// Violation is a violation of an invariant
+type Violation struct {
+	// Elapsed is the time elapsed since the start of the load when the violation was detected
+	Elapsed Duration `json:"elapsed_seconds"`
+	Error   string   `json:"error"`
+}
+
+// trafficStats are the stats of a traffic type
+type trafficStats struct {
+	scheduled int
+	skipped   int
+	failed    int
+	latencies []time.Duration
+}
+
+// invariantStats are the stats of the checks of an invariant
+type invariantStats struct {
+	checks     int
+	violations []Violation
+}
+
+// report returns the report of the load from the current stats
+func (g *Generator) report(duration time.Duration) Report {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	report := Report{
+		StartTime:  g.startTime.UTC(),
+		Duration:   Duration(duration),
+		TargetRate: g.config.Rate,
+		Traffic:    make([]TrafficReport, 0, len(g.traffic)),
+		Invariants: make([]InvariantReport, 0, len(g.invariants)),
+	}
+
+	for _, t := range g.traffic {
+		s := g.stats[t.Name]
+		report.Traffic = append(report.Traffic, TrafficReport{
+			Name:      t.Name,
+			Scheduled: s.scheduled,
+			Succeeded: len(s.latencies),
+			Failed:    s.failed,
+			Skipped:   s.skipped,
+			Latency:   newLatency(s.latencies),
+		})
+		report.Scheduled += s.scheduled
+		report.Succeeded += len(s.latencies)
+		report.Failed += s.failed
+		report.Skipped += s.skipped
+	}
+	if duration > 0 {
+		report.Throughput = float64(report.Succeeded) / duration.Seconds()
+	}
+
+	for _, invariant := range g.invariants {
+		s := g.checks[invariant.Name]
+		report.Invariants = append(report.Invariants, InvariantReport{
+			Name:       invariant.Name,
+			Checks:     s.checks,
+			Violations: append([]Violation{}, s.violations...),
+		})
+	}
+	return report
+}
+
+// newLatency returns the distribution of the latencies, the percentiles use the nearest-rank method
+func newLatency(latencies []time.Duration) Latency {
+	if len(latencies) == 0 {
+		return Latency{}
+	}
+	sorted := append([]time.Duration{}, latencies...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var total time.Duration
+	for _, latency := range sorted {
+		total += latency
+	}
+	percentile := func(p int) Duration {
+		// nearest rank: ceil(p/100 * n), 1-indexed
+		rank := (p*len(sorted) + 99) / 100
+		if rank < 1 {
+			rank = 1
+		}
+		return Duration(sorted[rank-1])
+	}
+
+	return Latency{
+		Min:  Duration(sorted[0]),
+		Mean: Duration(total / time.Duration(len(sorted))),
+		P50:  percentile(50),
+		P90:  percentile(90),
+		P99:  percentile(99),
+		Max:  Duration(sorted[len(sorted)-1]),
+	}
+}
+
+// Validate returns an error if traffic failed or an invariant was violated
+func (r Report) Validate() error {
+	var violations int
+	for _, invariant := range r.Invariants {
+		violations += len(invariant.Violations)
+	}
+	switch {
+	case violations > 0:
+		return fmt.Errorf("%d invariant violations", violations)
+	case r.Failed > 0:
+		return fmt.Errorf("%d failed CCTXs", r.Failed)
+	}
+	return nil
+}
+
+// String returns the report as a table
+func (r Report) String() (string, error) {
+	var b strings.Builder
+	writer := tabwriter.NewWriter(&b, 0, 4, 4, ' ', 0)
+	if _, err := fmt.Fprintln(writer, "Traffic\tScheduled\tSucceeded\tFailed\tSkipped\tP50\tP90\tP99\tMax"); err != nil {
+		return "", err
+	}
+	for _, t := range r.Traffic {
+		if _, err := fmt.Fprintf(
+			writer,
+			"%s\t%d\t%d\t%d\t%d\t%s\t%s\t%s\t%s\n",
+			t.Name,
+			t.Scheduled,
+			t.Succeeded,
+			t.Failed,
+			t.Skipped,
+			t.Latency.P50,
+			t.Latency.P90,
+			t.Latency.P99,
+			t.Latency.Max,
+		); err != nil {
+			return "", err
+		}
+	}
+	if _, err := fmt.Fprintf(writer, "\nThroughput: %.3f CCTX/s (target %.3f)\n", r.Throughput, r.TargetRate); err != nil {
+		return "", err
+	}
+	for _, invariant := range r.Invariants {
+		if _, err := fmt.Fprintf(
+			writer,
+			"Invariant %s: %d checks, %d violations\n",
+			invariant.Name,
+			invariant.Checks,
+			len(invariant.Violations),
+		); err != nil {
+			return "", err
+		}
+	}
+
+	if err := writer.Flush(); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// WriteFile writes the report as JSON to the file
+func (r Report) WriteFile(path string) error {
+	bz, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal report: %w", err)
+	}
+	return os.WriteFile(path, bz, 0o600)
+}
@@ -0,0 +1,82 @@
+package load
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLatency(t *testing.T) {
+	t.Run("no latency", func(t *testing.T) {
+		require.Equal(t, Latency{}, newLatency(nil))
+	})
+
+	t.Run("percentiles", func(t *testing.T) {
+		latencies := make([]time.Duration, 0, 100)
+		// unsorted latencies from 1s to 100s
+		for i := 100; i >= 1; i-- {
+			latencies = append(latencies, time.Duration(i)*time.Second)
+		}
+
+		latency := newLatency(latencies)
+		require.Equal(t, Duration(time.Second), latency.Min)
+		require.Equal(t, Duration(50500*time.Millisecond), latency.Mean)
+		require.Equal(t, Duration(50*time.Second), latency.P50)
+		require.Equal(t, Duration(90*time.Second), latency.P90)
+		require.Equal(t, Duration(99*time.Second), latency.P99)
+		require.Equal(t, Duration(100*time.Second), latency.Max)
+
+		// the latencies are not sorted in place
+		require.Equal(t, 100*time.Second, latencies[0])
+	})
+
+	t.Run("single latency", func(t *testing.T) {
+		latency := newLatency([]time.Duration{time.Second})
+		require.Equal(t, Duration(time.Second), latency.P50)
+		require.Equal(t, Duration(time.Second), latency.P99)
+	})
+}
+
+func TestReport_WriteFile(t *testing.T) {
+	report := Report{
+		Version:    "v38.0.0",
+		StartTime:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		Duration:   Duration(90 * time.Second),
+		TargetRate: 2,
+		Throughput: 1.5,
+		Scheduled:  3,
+		Succeeded:  2,
+		Failed:     1,
+		Traffic: []TrafficReport{{
+			Name:      "eth_deposit",
+			Scheduled: 3,
+			Succeeded: 2,
+			Failed:    1,
+			Latency:   Latency{P50: Duration(1500 * time.Millisecond)},
+		}},
+		Invariants: []InvariantReport{{Name: "eth", Checks: 3, Violations: []Violation{}}},
+	}
+
+	path := filepath.Join(t.TempDir(), "report.json")
+	require.NoError(t, report.WriteFile(path))
+
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"duration_seconds": 90`)
+	require.Contains(t, string(bz), `"p50": 1.5`)
+
+	var decoded Report
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+	require.Equal(t, report, decoded)
+
+	require.ErrorContains(t, decoded.Validate(), "1 failed CCTXs")
+
+	table, err := report.String()
+	require.NoError(t, err)
+	require.Contains(t, table, "eth_deposit")
+	require.Contains(t, table, "Invariant eth: 3 checks, 0 violations")
+}
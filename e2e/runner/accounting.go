@@ -1,6 +1,7 @@
 package runner
 
 import (
+	"fmt"
 	"math/big"
 
 	sdkmath "cosmossdk.io/math"
@@ -46,6 +47,41 @@ func (r *E2ERunner) VerifyAccounting(testLegacy bool) {
 	r.checkProtocolBalance()
 }
 
+// CustodyCheck is an accounting check of the funds held in custody for a ZRC20
+type CustodyCheck struct {
+	Name  string
+	Check func()
+}
+
+// CustodyChecks returns the accounting checks holding while CCTXs are in flight:
+// the funds are received in custody before the ZRC20 is minted and the ZRC20 is burnt before the funds are released.
+// The ZETA check is not included since the ZETA minted and locked are only equal once all CCTXs are finalized.
+func (r *E2ERunner) CustodyChecks(testSolana, testSui bool) []CustodyCheck {
+	checks := []CustodyCheck{
+		{Name: "eth", Check: r.checkETHTSSBalance},
+		{Name: "erc20", Check: r.checkERC20TSSBalance},
+		{Name: "btc", Check: r.CheckBTCTSSBalance},
+	}
+	if testSolana {
+		checks = append(checks, CustodyCheck{Name: "sol", Check: r.CheckSolanaTSSBalance})
+	}
+	if testSui {
+		checks = append(checks, CustodyCheck{Name: "sui", Check: r.CheckSUITSSBalance})
+	}
+	return checks
+}
+
+// RunCheck runs an accounting check and returns the failure as an error instead of stopping the runner
+func (r *E2ERunner) RunCheck(check func()) (err error) {
+	defer func() {
+		if recoverVal := recover(); recoverVal != nil {
+			err = fmt.Errorf("check failed: %v", recoverVal)
+		}
+	}()
+	check()
+	return nil
+}
+
 func (r *E2ERunner) checkProtocolBalance() {
 	balance := r.checkProtocolAddressBalance(config.BaseDenom)
 	require.True(r, balance.IsZero())
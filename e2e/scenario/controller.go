@@ -0,0 +1,101 @@
+package scenario
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+
+	"github.com/zeta-chain/node/e2e/utils"
+)
+
+// partitionMarker marks the entries of /etc/hosts added by the chain partitions
+const partitionMarker = "# scenario-partition"
+
+// Controller injects faults in the zetaclients of the localnet
+type Controller interface {
+	// StopZetaclient suspends zetaclientd, the supervisor doesn't restart a suspended process
+	StopZetaclient(zetaclient string) error
+
+	// StartZetaclient resumes a suspended zetaclientd
+	StartZetaclient(zetaclient string) error
+
+	// RestartZetaclient kills zetaclientd, the supervisor restarts it
+	RestartZetaclient(zetaclient string) error
+
+	// PartitionChain resolves the chain RPC host to an unreachable address from the zetaclient
+	PartitionChain(zetaclient, host string) error
+
+	// HealPartition restores the resolution of the chain RPC host from the zetaclient
+	HealPartition(zetaclient, host string) error
+
+	// ObserverAddress returns the observer address of the zetaclient
+	ObserverAddress(zetaclient string) (string, error)
+}
+
+// SSHController is a controller running commands on the zetaclient containers through ssh
+type SSHController struct{}
+
+var _ Controller = SSHController{}
+
+// StopZetaclient implements Controller
+func (SSHController) StopZetaclient(zetaclient string) error {
+	return runSSH(zetaclient, "killall -STOP zetaclientd")
+}
+
+// StartZetaclient implements Controller
+func (SSHController) StartZetaclient(zetaclient string) error {
+	return runSSH(zetaclient, "killall -CONT zetaclientd")
+}
+
+// RestartZetaclient implements Controller
+func (SSHController) RestartZetaclient(zetaclient string) error {
+	return runSSH(zetaclient, "killall zetaclientd")
+}
+
+// PartitionChain implements Controller
+// The entry added in /etc/hosts takes precedence over the Docker DNS,
+// zetaclientd is restarted to drop the connections already established with the RPC.
+func (SSHController) PartitionChain(zetaclient, host string) error {
+	entry := fmt.Sprintf("127.0.0.1 %s %s", host, partitionMarker)
+	return runSSH(zetaclient, fmt.Sprintf(
+		"%s && echo '%s' >> /tmp/hosts && cat /tmp/hosts > /etc/hosts && killall zetaclientd",
+		removeHostsEntry(host),
+		entry,
+	))
+}
+
+// HealPartition implements Controller
+func (SSHController) HealPartition(zetaclient, host string) error {
+	return runSSH(zetaclient, fmt.Sprintf(
+		"%s && cat /tmp/hosts > /etc/hosts && killall zetaclientd",
+		removeHostsEntry(host),
+	))
+}
+
+// ObserverAddress implements Controller
+func (SSHController) ObserverAddress(zetaclient string) (string, error) {
+	info, err := utils.FetchHotkeyAddress(zetaclient)
+	if err != nil {
+		return "", err
+	}
+	return info.ObserverAddress, nil
+}
+
+// removeHostsEntry returns the command writing /etc/hosts without the partition entry of the host to /tmp/hosts
+// /etc/hosts is bind mounted by Docker, it is overwritten in place and can't be edited with sed -i
+func removeHostsEntry(host string) string {
+	return fmt.Sprintf("{ grep -v -F ' %s %s' /etc/hosts > /tmp/hosts || true; }", host, partitionMarker)
+}
+
+// runSSH runs a command on the host through ssh
+func runSSH(host, command string) error {
+	// #nosec G204 the host and the command are validated when parsing the scenario
+	cmd := exec.Command("ssh", "-q", fmt.Sprintf("root@%s", host), command)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to run command: %s, stderr: %s, error: %w", cmd.String(), stderr.String(), err)
+	}
+	return nil
+}
@@ -0,0 +1,292 @@
+package scenario
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/zeta-chain/node/e2e/runner"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+// defaultBallotWaitBlocks is the default number of blocks the ballot assertions are retried for
+const defaultBallotWaitBlocks = 10
+
+// Network runs the e2e tests and the TSS rotations on the localnet and queries its state
+type Network interface {
+	// RunTests runs the e2e tests
+	RunTests(tests []runner.E2ETestRunConfig) error
+
+	// RotateTSS generates a new TSS, migrates the funds of the current TSS and updates the TSS address
+	RotateTSS() error
+
+	// WaitForBlocks waits for a number of ZetaChain blocks
+	WaitForBlocks(blocks int64) error
+
+	// Ballots returns the ballots of the observer module
+	Ballots() ([]observertypes.Ballot, error)
+}
+
+// Logger logs the progress of the scenarios
+type Logger interface {
+	Print(message string, args ...interface{})
+}
+
+// Runner runs scenarios against a network, injecting faults with the controller
+type Runner struct {
+	controller Controller
+	network    Network
+	logger     Logger
+
+	// observers caches the observer addresses of the zetaclients
+	observers map[string]string
+}
+
+// NewRunner returns a new scenario runner
+func NewRunner(controller Controller, network Network, logger Logger) *Runner {
+	return &Runner{
+		controller: controller,
+		network:    network,
+		logger:     logger,
+		observers:  make(map[string]string),
+	}
+}
+
+// faults are the faults injected in the zetaclients during a scenario
+type faults struct {
+	stopped     map[string]bool
+	partitioned map[[2]string]bool
+}
+
+// RunAll runs the scenarios in order and stops at the first failure
+func (r *Runner) RunAll(scenarios []Scenario) error {
+	for _, s := range scenarios {
+		if err := r.Run(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Run runs the steps of the scenario in order.
+// The zetaclients still stopped or partitioned at the end of the scenario are restored, even if the scenario failed.
+func (r *Runner) Run(s Scenario) (err error) {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	r.logger.Print("🎬 starting scenario %s", s.Name)
+
+	f := faults{
+		stopped:     make(map[string]bool),
+		partitioned: make(map[[2]string]bool),
+	}
+	defer func() {
+		err = errors.Join(err, r.restore(f))
+	}()
+
+	var background chan error
+	for i, step := range s.Steps {
+		r.logger.Print("▶️ scenario %s: step %d: %s", s.Name, i, step.Action)
+
+		var stepErr error
+		switch step.Action {
+		case ActionStopZetaclient:
+			stepErr = r.controller.StopZetaclient(step.Zetaclient)
+			if stepErr == nil {
+				f.stopped[step.Zetaclient] = true
+			}
+		case ActionStartZetaclient:
+			stepErr = r.controller.StartZetaclient(step.Zetaclient)
+			if stepErr == nil {
+				delete(f.stopped, step.Zetaclient)
+			}
+		case ActionRestartZetaclient:
+			stepErr = r.controller.RestartZetaclient(step.Zetaclient)
+		case ActionPartitionChain:
+			stepErr = r.controller.PartitionChain(step.Zetaclient, step.Host)
+			if stepErr == nil {
+				f.partitioned[[2]string{step.Zetaclient, step.Host}] = true
+			}
+		case ActionHealPartition:
+			stepErr = r.controller.HealPartition(step.Zetaclient, step.Host)
+			if stepErr == nil {
+				delete(f.partitioned, [2]string{step.Zetaclient, step.Host})
+			}
+		case ActionRunTests:
+			tests := step.TestRunConfigs()
+			if !step.Background {
+				stepErr = r.network.RunTests(tests)
+				break
+			}
+			background = make(chan error, 1)
+			go func() {
+				background <- r.network.RunTests(tests)
+			}()
+		case ActionWaitBackground:
+			stepErr = <-background
+			background = nil
+		case ActionRotateTSS:
+			stepErr = r.network.RotateTSS()
+		case ActionWaitBlocks:
+			stepErr = r.network.WaitForBlocks(step.Blocks)
+		case ActionAssertBallot:
+			stepErr = r.assertBallotWithin(*step.Ballot, defaultBallotWaitBlocks)
+		default:
+			stepErr = fmt.Errorf("unknown action %q", step.Action)
+		}
+		if stepErr != nil {
+			return fmt.Errorf("scenario %s: step %d (%s) failed: %w", s.Name, i, step.Action, stepErr)
+		}
+	}
+
+	r.logger.Print("✅ scenario %s completed in %s", s.Name, time.Since(startTime).String())
+	return nil
+}
+
+// restore resumes the stopped zetaclients and heals the partitions left by a scenario
+func (r *Runner) restore(f faults) error {
+	var errs []error
+
+	stopped := make([]string, 0, len(f.stopped))
+	for zetaclient := range f.stopped {
+		stopped = append(stopped, zetaclient)
+	}
+	sort.Strings(stopped)
+	for _, zetaclient := range stopped {
+		r.logger.Print("🔄 resuming zetaclient %s", zetaclient)
+		errs = append(errs, r.controller.StartZetaclient(zetaclient))
+	}
+
+	partitioned := make([][2]string, 0, len(f.partitioned))
+	for partition := range f.partitioned {
+		partitioned = append(partitioned, partition)
+	}
+	sort.Slice(partitioned, func(i, j int) bool {
+		if partitioned[i][0] != partitioned[j][0] {
+			return partitioned[i][0] < partitioned[j][0]
+		}
+		return partitioned[i][1] < partitioned[j][1]
+	})
+	for _, partition := range partitioned {
+		r.logger.Print("🔄 healing partition of %s from zetaclient %s", partition[1], partition[0])
+		errs = append(errs, r.controller.HealPartition(partition[0], partition[1]))
+	}
+
+	return errors.Join(errs...)
+}
+
+// assertBallotWithin retries the ballot assertion every block for the given number of blocks,
+// the votes of the observers can be received after the ballot is finalized
+func (r *Runner) assertBallotWithin(a BallotAssertion, blocks int64) error {
+	for i := int64(0); ; i++ {
+		err := r.assertBallot(a)
+		if err == nil || i >= blocks {
+			return err
+		}
+		if err := r.network.WaitForBlocks(1); err != nil {
+			return err
+		}
+	}
+}
+
+// assertBallot asserts on the outcome of a ballot
+func (r *Runner) assertBallot(a BallotAssertion) error {
+	ballots, err := r.network.Ballots()
+	if err != nil {
+		return fmt.Errorf("failed to query ballots: %w", err)
+	}
+	ballot, err := findBallot(ballots, a)
+	if err != nil {
+		return err
+	}
+
+	if expected := ballotStatuses[a.Status]; ballot.BallotStatus != expected {
+		return fmt.Errorf("ballot %s has status %s, expected %s", ballot.BallotIdentifier, ballot.BallotStatus, expected)
+	}
+
+	votes := make(map[string]observertypes.VoteType, len(ballot.VoterList))
+	count := 0
+	for i, voter := range ballot.VoterList {
+		vote := observertypes.VoteType_NotYetVoted
+		if i < len(ballot.Votes) {
+			vote = ballot.Votes[i]
+		}
+		votes[voter] = vote
+		if vote != observertypes.VoteType_NotYetVoted {
+			count++
+		}
+	}
+	if count < a.MinVotes {
+		return fmt.Errorf("ballot %s has %d votes, expected at least %d", ballot.BallotIdentifier, count, a.MinVotes)
+	}
+
+	for _, zetaclient := range a.Voted {
+		observer, err := r.observerAddress(zetaclient)
+		if err != nil {
+			return err
+		}
+		if vote, ok := votes[observer]; !ok || vote == observertypes.VoteType_NotYetVoted {
+			return fmt.Errorf("zetaclient %s (%s) didn't vote on ballot %s", zetaclient, observer, ballot.BallotIdentifier)
+		}
+	}
+	for _, zetaclient := range a.NotVoted {
+		observer, err := r.observerAddress(zetaclient)
+		if err != nil {
+			return err
+		}
+		if vote, ok := votes[observer]; ok && vote != observertypes.VoteType_NotYetVoted {
+			return fmt.Errorf("zetaclient %s (%s) voted on ballot %s", zetaclient, observer, ballot.BallotIdentifier)
+		}
+	}
+	return nil
+}
+
+// observerAddress returns the observer address of the zetaclient
+func (r *Runner) observerAddress(zetaclient string) (string, error) {
+	if observer, ok := r.observers[zetaclient]; ok {
+		return observer, nil
+	}
+	observer, err := r.controller.ObserverAddress(zetaclient)
+	if err != nil {
+		return "", fmt.Errorf("failed to get observer address of zetaclient %s: %w", zetaclient, err)
+	}
+	r.observers[zetaclient] = observer
+	return observer, nil
+}
+
+// findBallot returns the ballot with the identifier of the assertion,
+// or the latest ballot with the observation type of the assertion, ties being broken by the identifier
+func findBallot(ballots []observertypes.Ballot, a BallotAssertion) (observertypes.Ballot, error) {
+	if a.Identifier != "" {
+		for _, ballot := range ballots {
+			if ballot.BallotIdentifier == a.Identifier {
+				return ballot, nil
+			}
+		}
+		return observertypes.Ballot{}, fmt.Errorf("ballot %s not found", a.Identifier)
+	}
+
+	observationType := observationTypes[a.ObservationType]
+	var (
+		latest observertypes.Ballot
+		found  bool
+	)
+	for _, ballot := range ballots {
+		if ballot.ObservationType != observationType {
+			continue
+		}
+		if !found || ballot.BallotCreationHeight > latest.BallotCreationHeight ||
+			(ballot.BallotCreationHeight == latest.BallotCreationHeight &&
+				ballot.BallotIdentifier > latest.BallotIdentifier) {
+			latest = ballot
+			found = true
+		}
+	}
+	if !found {
+		return observertypes.Ballot{}, fmt.Errorf("no %s ballot found", a.ObservationType)
+	}
+	return latest, nil
+}
@@ -0,0 +1,229 @@
+package scenario
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/e2e/runner"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+// fakeEnv is a fake controller and network recording the actions
+type fakeEnv struct {
+	actions   []string
+	ballots   []observertypes.Ballot
+	failTests bool
+}
+
+func (e *fakeEnv) record(format string, args ...interface{}) {
+	e.actions = append(e.actions, fmt.Sprintf(format, args...))
+}
+
+func (e *fakeEnv) StopZetaclient(zetaclient string) error {
+	e.record("stop %s", zetaclient)
+	return nil
+}
+
+func (e *fakeEnv) StartZetaclient(zetaclient string) error {
+	e.record("start %s", zetaclient)
+	return nil
+}
+
+func (e *fakeEnv) RestartZetaclient(zetaclient string) error {
+	e.record("restart %s", zetaclient)
+	return nil
+}
+
+func (e *fakeEnv) PartitionChain(zetaclient, host string) error {
+	e.record("partition %s %s", zetaclient, host)
+	return nil
+}
+
+func (e *fakeEnv) HealPartition(zetaclient, host string) error {
+	e.record("heal %s %s", zetaclient, host)
+	return nil
+}
+
+func (e *fakeEnv) ObserverAddress(zetaclient string) (string, error) {
+	return "observer-" + zetaclient, nil
+}
+
+func (e *fakeEnv) RunTests(tests []runner.E2ETestRunConfig) error {
+	e.record("tests %v", tests)
+	if e.failTests {
+		return errors.New("tests failed")
+	}
+	return nil
+}
+
+func (e *fakeEnv) RotateTSS() error {
+	e.record("rotate")
+	return nil
+}
+
+func (e *fakeEnv) WaitForBlocks(blocks int64) error {
+	e.record("wait %d", blocks)
+	return nil
+}
+
+func (e *fakeEnv) Ballots() ([]observertypes.Ballot, error) {
+	return e.ballots, nil
+}
+
+type noopLogger struct{}
+
+func (noopLogger) Print(_ string, _ ...interface{}) {}
+
+func newTestRunner(env *fakeEnv) *Runner {
+	return NewRunner(env, env, noopLogger{})
+}
+
+// testBallots are an outbound ballot and two inbound ballots, the latest missing the vote of zetaclient3
+var testBallots = []observertypes.Ballot{
+	{
+		BallotIdentifier:     "outbound",
+		ObservationType:      observertypes.ObservationType_OutboundTx,
+		BallotStatus:         observertypes.BallotStatus_BallotFinalized_SuccessObservation,
+		BallotCreationHeight: 20,
+	},
+	{
+		BallotIdentifier: "inbound-old",
+		VoterList:        []string{"observer-zetaclient0", "observer-zetaclient3"},
+		Votes: []observertypes.VoteType{
+			observertypes.VoteType_SuccessObservation,
+			observertypes.VoteType_SuccessObservation,
+		},
+		ObservationType:      observertypes.ObservationType_InboundTx,
+		BallotStatus:         observertypes.BallotStatus_BallotFinalized_SuccessObservation,
+		BallotCreationHeight: 5,
+	},
+	{
+		BallotIdentifier: "inbound-latest",
+		VoterList:        []string{"observer-zetaclient0", "observer-zetaclient1", "observer-zetaclient3"},
+		Votes: []observertypes.VoteType{
+			observertypes.VoteType_SuccessObservation,
+			observertypes.VoteType_SuccessObservation,
+			observertypes.VoteType_NotYetVoted,
+		},
+		ObservationType:      observertypes.ObservationType_InboundTx,
+		BallotStatus:         observertypes.BallotStatus_BallotFinalized_SuccessObservation,
+		BallotCreationHeight: 10,
+	},
+}
+
+func TestRunner_Run(t *testing.T) {
+	t.Run("steps are run in order", func(t *testing.T) {
+		env := &fakeEnv{ballots: testBallots}
+		s, err := Parse([]byte(testScenario))
+		require.NoError(t, err)
+
+		require.NoError(t, newTestRunner(env).Run(s))
+		require.Equal(t, []string{
+			"stop zetaclient3",
+			"partition zetaclient2 eth",
+			"tests [{eth_deposit [100000]}]",
+			"rotate",
+			"start zetaclient3",
+			"heal zetaclient2 eth",
+			"wait 5",
+		}, env.actions)
+	})
+
+	t.Run("faults are restored when the scenario fails", func(t *testing.T) {
+		env := &fakeEnv{failTests: true}
+		s := Scenario{Name: "foo", Steps: []Step{
+			{Action: ActionPartitionChain, Zetaclient: "zetaclient1", Host: "bitcoin"},
+			{Action: ActionStopZetaclient, Zetaclient: "zetaclient3"},
+			{Action: ActionStopZetaclient, Zetaclient: "zetaclient2"},
+			{Action: ActionRunTests, Tests: []Test{{Name: "eth_deposit"}}},
+			{Action: ActionRotateTSS},
+		}}
+
+		err := newTestRunner(env).Run(s)
+		require.ErrorContains(t, err, "step 3 (run_tests) failed: tests failed")
+		require.Equal(t, []string{
+			"partition zetaclient1 bitcoin",
+			"stop zetaclient3",
+			"stop zetaclient2",
+			"tests [{eth_deposit []}]",
+			"start zetaclient2",
+			"start zetaclient3",
+			"heal zetaclient1 bitcoin",
+		}, env.actions)
+	})
+}
+
+func TestRunner_AssertBallot(t *testing.T) {
+	tests := []struct {
+		name      string
+		assertion BallotAssertion
+		errorMsg  string
+	}{
+		{
+			name: "latest inbound ballot",
+			assertion: BallotAssertion{
+				ObservationType: "inbound",
+				Status:          "success",
+				MinVotes:        2,
+				Voted:           []string{"zetaclient0", "zetaclient1"},
+				NotVoted:        []string{"zetaclient2", "zetaclient3"},
+			},
+		},
+		{
+			name: "ballot by identifier",
+			assertion: BallotAssertion{
+				Identifier: "inbound-old",
+				Status:     "success",
+				Voted:      []string{"zetaclient3"},
+			},
+		},
+		{
+			name:      "ballot not found",
+			assertion: BallotAssertion{Identifier: "foo", Status: "success"},
+			errorMsg:  "ballot foo not found",
+		},
+		{
+			name:      "no ballot of the observation type",
+			assertion: BallotAssertion{ObservationType: "keygen", Status: "success"},
+			errorMsg:  "no keygen ballot found",
+		},
+		{
+			name:      "wrong status",
+			assertion: BallotAssertion{ObservationType: "outbound", Status: "failure"},
+			errorMsg:  "has status BallotFinalized_SuccessObservation",
+		},
+		{
+			name:      "not enough votes",
+			assertion: BallotAssertion{ObservationType: "inbound", Status: "success", MinVotes: 3},
+			errorMsg:  "has 2 votes, expected at least 3",
+		},
+		{
+			name:      "zetaclient didn't vote",
+			assertion: BallotAssertion{ObservationType: "inbound", Status: "success", Voted: []string{"zetaclient3"}},
+			errorMsg:  "zetaclient zetaclient3 (observer-zetaclient3) didn't vote",
+		},
+		{
+			name:      "zetaclient voted",
+			assertion: BallotAssertion{ObservationType: "inbound", Status: "success", NotVoted: []string{"zetaclient1"}},
+			errorMsg:  "zetaclient zetaclient1 (observer-zetaclient1) voted",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := &fakeEnv{ballots: testBallots}
+
+			err := newTestRunner(env).assertBallotWithin(tt.assertion, 2)
+			if tt.errorMsg != "" {
+				require.ErrorContains(t, err, tt.errorMsg)
+				// the assertion is retried every block
+				require.Equal(t, []string{"wait 1", "wait 1"}, env.actions)
+				return
+			}
+			require.NoError(t, err)
+			require.Empty(t, env.actions)
+		})
+	}
+}
@@ -0,0 +1,314 @@
+// Package scenario defines deterministic multi-node e2e scenarios declared in YAML.
+// A scenario is a sequence of steps injecting faults in the zetaclients of the localnet (stop, restart, chain RPC partition),
+// rotating the TSS, running e2e tests and asserting on the outcome of the ballots.
+package scenario
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/zeta-chain/node/e2e/runner"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+// Action is the action performed by a scenario step
+type Action string
+
+const (
+	// ActionStopZetaclient suspends the zetaclientd process of a zetaclient
+	ActionStopZetaclient Action = "stop_zetaclient"
+
+	// ActionStartZetaclient resumes the zetaclientd process of a stopped zetaclient
+	ActionStartZetaclient Action = "start_zetaclient"
+
+	// ActionRestartZetaclient kills the zetaclientd process of a zetaclient, the supervisor restarts it
+	ActionRestartZetaclient Action = "restart_zetaclient"
+
+	// ActionPartitionChain makes the RPC of a chain unreachable from a zetaclient
+	ActionPartitionChain Action = "partition_chain"
+
+	// ActionHealPartition makes the RPC of a partitioned chain reachable again from a zetaclient
+	ActionHealPartition Action = "heal_partition"
+
+	// ActionRunTests runs e2e tests
+	ActionRunTests Action = "run_tests"
+
+	// ActionWaitBackground waits for the completion of the tests run in background
+	ActionWaitBackground Action = "wait_background"
+
+	// ActionRotateTSS generates a new TSS and migrates the funds of the current TSS to it
+	ActionRotateTSS Action = "rotate_tss"
+
+	// ActionAssertBallot asserts on the outcome of a ballot
+	ActionAssertBallot Action = "assert_ballot"
+
+	// ActionWaitBlocks waits for a number of ZetaChain blocks
+	ActionWaitBlocks Action = "wait_blocks"
+)
+
+// ballotStatuses maps the ballot statuses of the scenarios to the observer ballot statuses
+var ballotStatuses = map[string]observertypes.BallotStatus{
+	"success":     observertypes.BallotStatus_BallotFinalized_SuccessObservation,
+	"failure":     observertypes.BallotStatus_BallotFinalized_FailureObservation,
+	"in_progress": observertypes.BallotStatus_BallotInProgress,
+}
+
+// observationTypes maps the observation types of the scenarios to the observer observation types
+var observationTypes = map[string]observertypes.ObservationType{
+	"inbound":  observertypes.ObservationType_InboundTx,
+	"outbound": observertypes.ObservationType_OutboundTx,
+	"keygen":   observertypes.ObservationType_TSSKeyGen,
+}
+
+// Scenario is a named sequence of steps
+type Scenario struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	Steps       []Step `yaml:"steps"`
+}
+
+// Step is a step of a scenario, the fields used depend on the action
+type Step struct {
+	Action Action `yaml:"action"`
+
+	// Zetaclient is the host of the zetaclient targeted by the zetaclient and partition actions
+	Zetaclient string `yaml:"zetaclient,omitempty"`
+
+	// Host is the host of the chain RPC partitioned from the zetaclient (e.g. eth, bitcoin, solana)
+	Host string `yaml:"host,omitempty"`
+
+	// Tests are the e2e tests run by the run_tests action
+	Tests []Test `yaml:"tests,omitempty"`
+
+	// Background runs the tests without waiting for their completion, until the next wait_background step
+	Background bool `yaml:"background,omitempty"`
+
+	// Blocks is the number of blocks to wait for
+	Blocks int64 `yaml:"blocks,omitempty"`
+
+	// Ballot is the ballot assertion of the assert_ballot action
+	Ballot *BallotAssertion `yaml:"ballot,omitempty"`
+}
+
+// Test is an e2e test with its arguments
+type Test struct {
+	Name string   `yaml:"name"`
+	Args []string `yaml:"args,omitempty"`
+}
+
+// BallotAssertion is an assertion on the outcome of a ballot
+type BallotAssertion struct {
+	// Identifier is the identifier of the ballot,
+	// if empty the latest ballot with the observation type is asserted
+	Identifier string `yaml:"identifier,omitempty"`
+
+	// ObservationType is the observation type of the latest ballot: inbound, outbound or keygen
+	ObservationType string `yaml:"observation_type,omitempty"`
+
+	// Status is the expected status of the ballot: success, failure or in_progress
+	Status string `yaml:"status"`
+
+	// MinVotes is the minimum number of votes of the ballot
+	MinVotes int `yaml:"min_votes,omitempty"`
+
+	// Voted are the zetaclients that must have voted
+	Voted []string `yaml:"voted,omitempty"`
+
+	// NotVoted are the zetaclients that must not have voted
+	NotVoted []string `yaml:"not_voted,omitempty"`
+}
+
+// TestRunConfigs returns the run configs of the tests of the step
+func (s Step) TestRunConfigs() []runner.E2ETestRunConfig {
+	configs := make([]runner.E2ETestRunConfig, 0, len(s.Tests))
+	for _, test := range s.Tests {
+		args := test.Args
+		if args == nil {
+			args = []string{}
+		}
+		configs = append(configs, runner.E2ETestRunConfig{Name: test.Name, Args: args})
+	}
+	return configs
+}
+
+// Validate checks the scenario is well-formed
+func (s Scenario) Validate() error {
+	if s.Name == "" {
+		return errors.New("scenario name is empty")
+	}
+	if len(s.Steps) == 0 {
+		return fmt.Errorf("scenario %s has no step", s.Name)
+	}
+
+	background := false
+	for i, step := range s.Steps {
+		if err := step.Validate(); err != nil {
+			return fmt.Errorf("scenario %s: step %d (%s): %w", s.Name, i, step.Action, err)
+		}
+
+		// only one group of tests can run in background at a time
+		switch {
+		case step.Action == ActionRunTests && step.Background:
+			if background {
+				return fmt.Errorf("scenario %s: step %d: tests already running in background", s.Name, i)
+			}
+			background = true
+		case step.Action == ActionWaitBackground:
+			if !background {
+				return fmt.Errorf("scenario %s: step %d: no tests running in background", s.Name, i)
+			}
+			background = false
+		}
+	}
+	if background {
+		return fmt.Errorf("scenario %s: tests running in background are not waited for", s.Name)
+	}
+	return nil
+}
+
+// Validate checks the step is well-formed
+func (s Step) Validate() error {
+	switch s.Action {
+	case ActionStopZetaclient, ActionStartZetaclient, ActionRestartZetaclient:
+		if !isValidHost(s.Zetaclient) {
+			return fmt.Errorf("invalid zetaclient %q", s.Zetaclient)
+		}
+	case ActionPartitionChain, ActionHealPartition:
+		if !isValidHost(s.Zetaclient) {
+			return fmt.Errorf("invalid zetaclient %q", s.Zetaclient)
+		}
+		if !isValidHost(s.Host) {
+			return fmt.Errorf("invalid host %q", s.Host)
+		}
+	case ActionRunTests:
+		if len(s.Tests) == 0 {
+			return errors.New("no test to run")
+		}
+		for _, test := range s.Tests {
+			if test.Name == "" {
+				return errors.New("test name is empty")
+			}
+		}
+	case ActionWaitBackground, ActionRotateTSS:
+	case ActionWaitBlocks:
+		if s.Blocks <= 0 {
+			return fmt.Errorf("invalid number of blocks %d", s.Blocks)
+		}
+	case ActionAssertBallot:
+		if s.Ballot == nil {
+			return errors.New("ballot assertion is empty")
+		}
+		return s.Ballot.Validate()
+	default:
+		return fmt.Errorf("unknown action %q", s.Action)
+	}
+	return nil
+}
+
+// Validate checks the ballot assertion is well-formed
+func (a BallotAssertion) Validate() error {
+	if _, ok := ballotStatuses[a.Status]; !ok {
+		return fmt.Errorf("invalid ballot status %q", a.Status)
+	}
+	if a.Identifier == "" {
+		if _, ok := observationTypes[a.ObservationType]; !ok {
+			return fmt.Errorf("invalid observation type %q for the latest ballot", a.ObservationType)
+		}
+	}
+	if a.MinVotes < 0 {
+		return fmt.Errorf("invalid minimum number of votes %d", a.MinVotes)
+	}
+	zetaclients := make([]string, 0, len(a.Voted)+len(a.NotVoted))
+	zetaclients = append(append(zetaclients, a.Voted...), a.NotVoted...)
+	for _, zetaclient := range zetaclients {
+		if !isValidHost(zetaclient) {
+			return fmt.Errorf("invalid zetaclient %q", zetaclient)
+		}
+	}
+	for _, voted := range a.Voted {
+		for _, notVoted := range a.NotVoted {
+			if voted == notVoted {
+				return fmt.Errorf("zetaclient %s both voted and not voted", voted)
+			}
+		}
+	}
+	return nil
+}
+
+// isValidHost returns true if the host can be safely used in ssh commands and written in /etc/hosts
+func isValidHost(host string) bool {
+	if host == "" {
+		return false
+	}
+	for _, c := range host {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' && c != '.' {
+			return false
+		}
+	}
+	return true
+}
+
+// Parse parses and validates a scenario from YAML
+func Parse(bz []byte) (Scenario, error) {
+	var s Scenario
+	if err := yaml.Unmarshal(bz, &s); err != nil {
+		return Scenario{}, fmt.Errorf("failed to unmarshal scenario: %w", err)
+	}
+	if err := s.Validate(); err != nil {
+		return Scenario{}, err
+	}
+	return s, nil
+}
+
+// Load loads the scenarios of a YAML file, or of the YAML files of a directory sorted by file name
+func Load(path string) ([]Scenario, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []string{path}
+	if info.IsDir() {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+		files = files[:0]
+		for _, entry := range entries {
+			ext := filepath.Ext(entry.Name())
+			if entry.IsDir() || (ext != ".yml" && ext != ".yaml") {
+				continue
+			}
+			files = append(files, filepath.Join(path, entry.Name()))
+		}
+		sort.Strings(files)
+	}
+
+	scenarios := make([]Scenario, 0, len(files))
+	names := make(map[string]string, len(files))
+	for _, file := range files {
+		// #nosec G304 -- this is a scenario file
+		bz, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		s, err := Parse(bz)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		if other, ok := names[s.Name]; ok {
+			return nil, fmt.Errorf("%s: scenario %s already declared in %s", file, s.Name, other)
+		}
+		names[s.Name] = file
+		scenarios = append(scenarios, s)
+	}
+	if len(scenarios) == 0 {
+		return nil, fmt.Errorf("no scenario found in %s", path)
+	}
+	return scenarios, nil
+}
@@ -0,0 +1,198 @@
+package scenario
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/e2e/runner"
+)
+
+const testScenario = `
+name: observer-down
+description: an observer is down during a deposit
+steps:
+  - action: stop_zetaclient
+    zetaclient: zetaclient3
+  - action: partition_chain
+    zetaclient: zetaclient2
+    host: eth
+  - action: run_tests
+    background: true
+    tests:
+      - name: eth_deposit
+        args: ["100000"]
+  - action: rotate_tss
+  - action: wait_background
+  - action: assert_ballot
+    ballot:
+      observation_type: inbound
+      status: success
+      min_votes: 2
+      voted: [zetaclient0, zetaclient1]
+      not_voted: [zetaclient3]
+  - action: start_zetaclient
+    zetaclient: zetaclient3
+  - action: heal_partition
+    zetaclient: zetaclient2
+    host: eth
+  - action: wait_blocks
+    blocks: 5
+`
+
+func TestParse(t *testing.T) {
+	s, err := Parse([]byte(testScenario))
+	require.NoError(t, err)
+	require.Equal(t, "observer-down", s.Name)
+	require.Len(t, s.Steps, 10)
+	require.Equal(t, ActionStopZetaclient, s.Steps[0].Action)
+	require.Equal(t, "eth", s.Steps[1].Host)
+	require.True(t, s.Steps[2].Background)
+	require.Equal(t, []runner.E2ETestRunConfig{{Name: "eth_deposit", Args: []string{"100000"}}}, s.Steps[2].TestRunConfigs())
+	require.Equal(t, []string{"zetaclient3"}, s.Steps[5].Ballot.NotVoted)
+	require.EqualValues(t, 5, s.Steps[9].Blocks)
+}
+
+func TestScenario_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		scenario Scenario
+		errorMsg string
+	}{
+		{
+			name:     "no name",
+			scenario: Scenario{Steps: []Step{{Action: ActionRotateTSS}}},
+			errorMsg: "scenario name is empty",
+		},
+		{
+			name:     "no step",
+			scenario: Scenario{Name: "foo"},
+			errorMsg: "has no step",
+		},
+		{
+			name:     "unknown action",
+			scenario: Scenario{Name: "foo", Steps: []Step{{Action: "foo"}}},
+			errorMsg: "unknown action",
+		},
+		{
+			name:     "no zetaclient",
+			scenario: Scenario{Name: "foo", Steps: []Step{{Action: ActionStopZetaclient}}},
+			errorMsg: "invalid zetaclient",
+		},
+		{
+			name: "invalid host",
+			scenario: Scenario{Name: "foo", Steps: []Step{
+				{Action: ActionPartitionChain, Zetaclient: "zetaclient0", Host: "eth; reboot"},
+			}},
+			errorMsg: "invalid host",
+		},
+		{
+			name:     "no test",
+			scenario: Scenario{Name: "foo", Steps: []Step{{Action: ActionRunTests}}},
+			errorMsg: "no test to run",
+		},
+		{
+			name:     "no blocks",
+			scenario: Scenario{Name: "foo", Steps: []Step{{Action: ActionWaitBlocks}}},
+			errorMsg: "invalid number of blocks",
+		},
+		{
+			name: "invalid ballot status",
+			scenario: Scenario{Name: "foo", Steps: []Step{
+				{Action: ActionAssertBallot, Ballot: &BallotAssertion{ObservationType: "inbound", Status: "foo"}},
+			}},
+			errorMsg: "invalid ballot status",
+		},
+		{
+			name: "no observation type for latest ballot",
+			scenario: Scenario{Name: "foo", Steps: []Step{
+				{Action: ActionAssertBallot, Ballot: &BallotAssertion{Status: "success"}},
+			}},
+			errorMsg: "invalid observation type",
+		},
+		{
+			name: "zetaclient voted and not voted",
+			scenario: Scenario{Name: "foo", Steps: []Step{
+				{Action: ActionAssertBallot, Ballot: &BallotAssertion{
+					Identifier: "0x01",
+					Status:     "success",
+					Voted:      []string{"zetaclient0"},
+					NotVoted:   []string{"zetaclient0"},
+				}},
+			}},
+			errorMsg: "both voted and not voted",
+		},
+		{
+			name: "background tests not waited for",
+			scenario: Scenario{Name: "foo", Steps: []Step{
+				{Action: ActionRunTests, Background: true, Tests: []Test{{Name: "eth_deposit"}}},
+			}},
+			errorMsg: "are not waited for",
+		},
+		{
+			name: "background tests already running",
+			scenario: Scenario{Name: "foo", Steps: []Step{
+				{Action: ActionRunTests, Background: true, Tests: []Test{{Name: "eth_deposit"}}},
+				{Action: ActionRunTests, Background: true, Tests: []Test{{Name: "eth_deposit"}}},
+				{Action: ActionWaitBackground},
+			}},
+			errorMsg: "already running in background",
+		},
+		{
+			name:     "no background tests to wait for",
+			scenario: Scenario{Name: "foo", Steps: []Step{{Action: ActionWaitBackground}}},
+			errorMsg: "no tests running in background",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.scenario.Validate()
+			require.ErrorContains(t, err, tt.errorMsg)
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+
+	t.Run("no scenario", func(t *testing.T) {
+		_, err := Load(dir)
+		require.ErrorContains(t, err, "no scenario found")
+	})
+
+	t.Run("directory sorted by file name", func(t *testing.T) {
+		write("02_second.yml", "name: second\nsteps:\n  - action: rotate_tss\n")
+		first := write("01_first.yaml", "name: first\nsteps:\n  - action: wait_blocks\n    blocks: 1\n")
+		write("README.md", "not a scenario")
+
+		scenarios, err := Load(dir)
+		require.NoError(t, err)
+		require.Len(t, scenarios, 2)
+		require.Equal(t, "first", scenarios[0].Name)
+		require.Equal(t, "second", scenarios[1].Name)
+
+		scenarios, err = Load(first)
+		require.NoError(t, err)
+		require.Len(t, scenarios, 1)
+	})
+
+	t.Run("duplicated name", func(t *testing.T) {
+		write("03_duplicated.yml", "name: first\nsteps:\n  - action: rotate_tss\n")
+
+		_, err := Load(dir)
+		require.ErrorContains(t, err, "already declared")
+	})
+}
+
+func TestLoad_LocalnetScenarios(t *testing.T) {
+	scenarios, err := Load("../../contrib/localnet/scenarios")
+	require.NoError(t, err)
+	require.NotEmpty(t, scenarios)
+}
@@ -730,6 +730,7 @@ func (zts *ZetaTxServer) UpdateKeygen(height int64) error {
 	_, err := zts.BroadcastTx(zts.GetAccountName(0), observertypes.NewMsgUpdateKeygen(
 		zts.GetAccountAddress(0),
 		keygenHeight,
+		false,
 	))
 	return err
 }
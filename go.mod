@@ -7,6 +7,7 @@ toolchain go1.23.9
 require (
 	cosmossdk.io/errors v1.0.2
 	cosmossdk.io/math v1.5.3
+	filippo.io/edwards25519 v1.1.0
 	github.com/99designs/keyring v1.2.2
 	github.com/btcsuite/btcd v0.24.2
 	github.com/btcsuite/btcd/btcec/v2 v2.3.4
@@ -78,7 +79,6 @@ require (
 	cosmossdk.io/x/evidence v0.2.0
 	cosmossdk.io/x/tx v0.14.0
 	cosmossdk.io/x/upgrade v0.2.0
-	filippo.io/edwards25519 v1.1.0 // indirect
 	github.com/99designs/go-keychain v0.0.0-20191008050251-8e49817e8af4 // indirect
 	github.com/DataDog/zstd v1.5.7 // indirect
 	github.com/StackExchange/wmi v1.2.1 // indirect
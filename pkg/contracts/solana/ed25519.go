@@ -0,0 +1,114 @@
+package solana
+
+import (
+	"crypto/ed25519"
+	"encoding/binary"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/pkg/errors"
+)
+
+// Ed25519ProgramID is the ID of the native Solana program verifying ed25519 signatures.
+var Ed25519ProgramID = solana.MustPublicKeyFromBase58("Ed25519SigVerify111111111111111111111111111")
+
+const (
+	// ed25519 instruction layout: [num_signatures, padding, offsets (7 x u16), pubkey, signature, message]
+	ed25519HeaderSize    = 2
+	ed25519OffsetsSize   = 14
+	ed25519PubKeyOffset  = ed25519HeaderSize + ed25519OffsetsSize
+	ed25519SigOffset     = ed25519PubKeyOffset + ed25519.PublicKeySize
+	ed25519MessageOffset = ed25519SigOffset + ed25519.SignatureSize
+
+	// ed25519CurrentInstruction tells the program that the data is located in the instruction itself
+	ed25519CurrentInstruction = uint16(0xFFFF)
+)
+
+// NewEd25519VerifyInstruction creates a native ed25519 program instruction that verifies
+// the signature of the message. Native-signature gateway variants check that this instruction
+// precedes the gateway instruction (via the instructions sysvar) instead of recovering an ECDSA signer.
+func NewEd25519VerifyInstruction(
+	pubKey ed25519.PublicKey,
+	message []byte,
+	signature [64]byte,
+) (*solana.GenericInstruction, error) {
+	if len(pubKey) != ed25519.PublicKeySize {
+		return nil, errors.Errorf("invalid ed25519 pubkey size %d", len(pubKey))
+	}
+
+	data := make([]byte, ed25519MessageOffset+len(message))
+
+	// one signature, no padding
+	data[0] = 1
+
+	offsets := []uint16{
+		ed25519SigOffset,
+		ed25519CurrentInstruction,
+		ed25519PubKeyOffset,
+		ed25519CurrentInstruction,
+		ed25519MessageOffset,
+		// #nosec G115 messages are 32-byte hashes
+		uint16(len(message)),
+		ed25519CurrentInstruction,
+	}
+	for i, offset := range offsets {
+		binary.LittleEndian.PutUint16(data[ed25519HeaderSize+2*i:], offset)
+	}
+
+	copy(data[ed25519PubKeyOffset:], pubKey)
+	copy(data[ed25519SigOffset:], signature[:])
+	copy(data[ed25519MessageOffset:], message)
+
+	return solana.NewInstruction(Ed25519ProgramID, solana.AccountMetaSlice{}, data), nil
+}
+
+// Ed25519Verify contains the data of a native ed25519 program instruction with a single signature.
+type Ed25519Verify struct {
+	PubKey    ed25519.PublicKey
+	Signature [64]byte
+	Message   []byte
+}
+
+// Verify checks the ed25519 signature of the message.
+func (v Ed25519Verify) Verify() bool {
+	return ed25519.Verify(v.PubKey, v.Message, v.Signature[:])
+}
+
+// ParseEd25519VerifyInstruction parses the data of an ed25519 program instruction
+// created by NewEd25519VerifyInstruction.
+func ParseEd25519VerifyInstruction(data []byte) (Ed25519Verify, error) {
+	if len(data) < ed25519MessageOffset || data[0] != 1 {
+		return Ed25519Verify{}, errors.New("not a single-signature ed25519 instruction")
+	}
+
+	offset := func(i int) int {
+		return int(binary.LittleEndian.Uint16(data[ed25519HeaderSize+2*i:]))
+	}
+
+	var (
+		sigOffset    = offset(0)
+		pubKeyOffset = offset(2)
+		msgOffset    = offset(4)
+		msgSize      = offset(5)
+	)
+
+	for _, i := range []int{1, 3, 6} {
+		if offset(i) != int(ed25519CurrentInstruction) {
+			return Ed25519Verify{}, errors.New("ed25519 instruction references another instruction")
+		}
+	}
+
+	switch {
+	case sigOffset+ed25519.SignatureSize > len(data),
+		pubKeyOffset+ed25519.PublicKeySize > len(data),
+		msgOffset+msgSize > len(data):
+		return Ed25519Verify{}, errors.New("ed25519 instruction offsets are out of range")
+	}
+
+	v := Ed25519Verify{
+		PubKey:  ed25519.PublicKey(data[pubKeyOffset : pubKeyOffset+ed25519.PublicKeySize]),
+		Message: data[msgOffset : msgOffset+msgSize],
+	}
+	copy(v.Signature[:], data[sigOffset:])
+
+	return v, nil
+}
@@ -0,0 +1,71 @@
+package solana_test
+
+import (
+	"crypto/ed25519"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	contracts "github.com/zeta-chain/node/pkg/contracts/solana"
+)
+
+func Test_NewEd25519VerifyInstruction(t *testing.T) {
+	pubKey, privKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	message := getTestmessageHash()
+
+	var sig [64]byte
+	copy(sig[:], ed25519.Sign(privKey, message[:]))
+
+	t.Run("should create ed25519 verify instruction", func(t *testing.T) {
+		inst, err := contracts.NewEd25519VerifyInstruction(pubKey, message[:], sig)
+		require.NoError(t, err)
+		require.Equal(t, contracts.Ed25519ProgramID, inst.ProgramID())
+		require.Empty(t, inst.Accounts())
+
+		data, err := inst.Data()
+		require.NoError(t, err)
+		require.Len(t, data, 16+32+64+32)
+		require.Equal(t, byte(1), data[0])
+
+		offset := func(i int) int { return int(binary.LittleEndian.Uint16(data[2+2*i:])) }
+
+		sigOffset, pubKeyOffset, msgOffset, msgSize := offset(0), offset(2), offset(4), offset(5)
+		require.Equal(t, 0xFFFF, offset(1))
+		require.Equal(t, 0xFFFF, offset(3))
+		require.Equal(t, 0xFFFF, offset(6))
+
+		require.True(t, ed25519.Verify(
+			data[pubKeyOffset:pubKeyOffset+ed25519.PublicKeySize],
+			data[msgOffset:msgOffset+msgSize],
+			data[sigOffset:sigOffset+ed25519.SignatureSize],
+		))
+	})
+
+	t.Run("should parse ed25519 verify instruction", func(t *testing.T) {
+		inst, err := contracts.NewEd25519VerifyInstruction(pubKey, message[:], sig)
+		require.NoError(t, err)
+
+		data, err := inst.Data()
+		require.NoError(t, err)
+
+		verify, err := contracts.ParseEd25519VerifyInstruction(data)
+		require.NoError(t, err)
+		require.Equal(t, pubKey, verify.PubKey)
+		require.Equal(t, message[:], verify.Message)
+		require.Equal(t, sig, verify.Signature)
+		require.True(t, verify.Verify())
+	})
+
+	t.Run("should fail to parse truncated data", func(t *testing.T) {
+		_, err := contracts.ParseEd25519VerifyInstruction([]byte{1, 0, 1, 2})
+		require.ErrorContains(t, err, "not a single-signature ed25519 instruction")
+	})
+
+	t.Run("should fail on invalid pubkey", func(t *testing.T) {
+		_, err := contracts.NewEd25519VerifyInstruction(pubKey[:31], message[:], sig)
+		require.ErrorContains(t, err, "invalid ed25519 pubkey size")
+	})
+}
@@ -136,6 +136,9 @@ type OutboundInstruction interface {
 	// Signer returns the signer of the instruction
 	Signer() (common.Address, error)
 
+	// SignedHash returns the message hash signed by TSS
+	SignedHash() [32]byte
+
 	// GatewayNonce returns the nonce of the instruction
 	GatewayNonce() uint64
 
@@ -186,6 +189,11 @@ func (inst *IncrementNonceInstructionParams) Signer() (signer common.Address, er
 	return RecoverSigner(inst.MessageHash[:], signature[:])
 }
 
+// SignedHash returns the message hash signed by TSS
+func (inst *IncrementNonceInstructionParams) SignedHash() [32]byte {
+	return inst.MessageHash
+}
+
 // GatewayNonce returns the nonce of the instruction
 func (inst *IncrementNonceInstructionParams) GatewayNonce() uint64 {
 	return inst.Nonce
@@ -260,6 +268,11 @@ func (inst *WithdrawInstructionParams) Signer() (signer common.Address, err erro
 	return RecoverSigner(inst.MessageHash[:], signature[:])
 }
 
+// SignedHash returns the message hash signed by TSS
+func (inst *WithdrawInstructionParams) SignedHash() [32]byte {
+	return inst.MessageHash
+}
+
 // GatewayNonce returns the nonce of the instruction
 func (inst *WithdrawInstructionParams) GatewayNonce() uint64 {
 	return inst.Nonce
@@ -331,6 +344,11 @@ func (inst *ExecuteInstructionParams) Signer() (signer common.Address, err error
 	return RecoverSigner(inst.MessageHash[:], signature[:])
 }
 
+// SignedHash returns the message hash signed by TSS
+func (inst *ExecuteInstructionParams) SignedHash() [32]byte {
+	return inst.MessageHash
+}
+
 // GatewayNonce returns the nonce of the instruction
 func (inst *ExecuteInstructionParams) GatewayNonce() uint64 {
 	return inst.Nonce
@@ -402,6 +420,11 @@ func (inst *ExecuteRevertInstructionParams) Signer() (signer common.Address, err
 	return RecoverSigner(inst.MessageHash[:], signature[:])
 }
 
+// SignedHash returns the message hash signed by TSS
+func (inst *ExecuteRevertInstructionParams) SignedHash() [32]byte {
+	return inst.MessageHash
+}
+
 // GatewayNonce returns the nonce of the instruction
 func (inst *ExecuteRevertInstructionParams) GatewayNonce() uint64 {
 	return inst.Nonce
@@ -469,6 +492,11 @@ func (inst *WithdrawSPLInstructionParams) Signer() (signer common.Address, err e
 	return RecoverSigner(inst.MessageHash[:], signature[:])
 }
 
+// SignedHash returns the message hash signed by TSS
+func (inst *WithdrawSPLInstructionParams) SignedHash() [32]byte {
+	return inst.MessageHash
+}
+
 // GatewayNonce returns the nonce of the instruction
 func (inst *WithdrawSPLInstructionParams) GatewayNonce() uint64 {
 	return inst.Nonce
@@ -542,6 +570,11 @@ func (inst *ExecuteSPLInstructionParams) Signer() (signer common.Address, err er
 	return RecoverSigner(inst.MessageHash[:], signature[:])
 }
 
+// SignedHash returns the message hash signed by TSS
+func (inst *ExecuteSPLInstructionParams) SignedHash() [32]byte {
+	return inst.MessageHash
+}
+
 // GatewayNonce returns the nonce of the instruction
 func (inst *ExecuteSPLInstructionParams) GatewayNonce() uint64 {
 	return inst.Nonce
@@ -615,6 +648,11 @@ func (inst *ExecuteSPLRevertInstructionParams) Signer() (signer common.Address,
 	return RecoverSigner(inst.MessageHash[:], signature[:])
 }
 
+// SignedHash returns the message hash signed by TSS
+func (inst *ExecuteSPLRevertInstructionParams) SignedHash() [32]byte {
+	return inst.MessageHash
+}
+
 // GatewayNonce returns the nonce of the instruction
 func (inst *ExecuteSPLRevertInstructionParams) GatewayNonce() uint64 {
 	return inst.Nonce
@@ -690,6 +728,11 @@ func (inst *WhitelistInstructionParams) Signer() (signer common.Address, err err
 	return RecoverSigner(inst.MessageHash[:], signature[:])
 }
 
+// SignedHash returns the message hash signed by TSS
+func (inst *WhitelistInstructionParams) SignedHash() [32]byte {
+	return inst.MessageHash
+}
+
 // GatewayNonce returns the nonce of the instruction
 func (inst *WhitelistInstructionParams) GatewayNonce() uint64 {
 	return inst.Nonce
@@ -1,6 +1,7 @@
 package ton
 
 import (
+	"crypto/ed25519"
 	"errors"
 
 	"cosmossdk.io/math"
@@ -182,13 +183,19 @@ type Withdrawal struct {
 	Amount    math.Uint
 	Seqno     uint32
 	Sig       [65]byte
+
+	// SigEdDSA is set instead of Sig when the message is signed with the ed25519 TSS key
+	// for the native-signature gateway variant.
+	SigEdDSA [64]byte
 }
 
 // SetSignature sets signature to the withdrawal message.
 // Note that signature has the following order: [R, S, V (recovery ID)]
-func (w *Withdrawal) SetSignature(sig [65]byte) { copy(w.Sig[:], sig[:]) }
-func (w *Withdrawal) Signature() [65]byte       { return w.Sig }
-func (w *Withdrawal) emptySig() bool            { return w.Sig == [65]byte{} }
+func (w *Withdrawal) SetSignature(sig [65]byte)      { copy(w.Sig[:], sig[:]) }
+func (w *Withdrawal) Signature() [65]byte            { return w.Sig }
+func (w *Withdrawal) SetSignatureEdDSA(sig [64]byte) { copy(w.SigEdDSA[:], sig[:]) }
+func (w *Withdrawal) SignatureEdDSA() [64]byte       { return w.SigEdDSA }
+func (w *Withdrawal) emptySig() bool                 { return emptySig(w.Sig, w.SigEdDSA) }
 
 // Hash returns hash of the withdrawal message. (used for signing)
 func (w *Withdrawal) Hash() ([32]byte, error) {
@@ -216,6 +223,10 @@ func (w *Withdrawal) AsBody() (*boc.Cell, error) {
 		return nil, err
 	}
 
+	if w.SigEdDSA != [64]byte{} {
+		return messageToBodyEdDSA(payload, w.SigEdDSA)
+	}
+
 	return messageToBody(payload, w.Sig)
 }
 
@@ -242,11 +253,14 @@ type IncreaseSeqno struct {
 	Seqno      uint32
 	ReasonCode uint32
 	Sig        [65]byte
+	SigEdDSA   [64]byte
 }
 
-func (is *IncreaseSeqno) SetSignature(sig [65]byte) { copy(is.Sig[:], sig[:]) }
-func (is *IncreaseSeqno) Signature() [65]byte       { return is.Sig }
-func (is *IncreaseSeqno) emptySig() bool            { return is.Sig == [65]byte{} }
+func (is *IncreaseSeqno) SetSignature(sig [65]byte)      { copy(is.Sig[:], sig[:]) }
+func (is *IncreaseSeqno) Signature() [65]byte            { return is.Sig }
+func (is *IncreaseSeqno) SetSignatureEdDSA(sig [64]byte) { copy(is.SigEdDSA[:], sig[:]) }
+func (is *IncreaseSeqno) SignatureEdDSA() [64]byte       { return is.SigEdDSA }
+func (is *IncreaseSeqno) emptySig() bool                 { return emptySig(is.Sig, is.SigEdDSA) }
 
 func (is *IncreaseSeqno) Hash() ([32]byte, error) {
 	payload, err := is.payload()
@@ -273,6 +287,10 @@ func (is *IncreaseSeqno) AsBody() (*boc.Cell, error) {
 		return nil, err
 	}
 
+	if is.SigEdDSA != [64]byte{} {
+		return messageToBodyEdDSA(payload, is.SigEdDSA)
+	}
+
 	return messageToBody(payload, is.Sig)
 }
 
@@ -310,6 +328,15 @@ func deriveSigner(hash [32]byte, sig [65]byte) (eth.Address, error) {
 	return crypto.PubkeyToAddress(*pub), nil
 }
 
+// VerifyEdDSA checks that the ed25519 signature of the message hash belongs to pubKey.
+func VerifyEdDSA(hash [32]byte, sig [64]byte, pubKey ed25519.PublicKey) bool {
+	return len(pubKey) == ed25519.PublicKeySize && ed25519.Verify(pubKey, hash[:], sig[:])
+}
+
+func emptySig(sig [65]byte, sigEdDSA [64]byte) bool {
+	return sig == [65]byte{} && sigEdDSA == [64]byte{}
+}
+
 func messageToBody(payload *boc.Cell, sig [65]byte) (*boc.Cell, error) {
 	var (
 		body    = boc.NewCell()
@@ -330,6 +357,22 @@ func messageToBody(payload *boc.Cell, sig [65]byte) (*boc.Cell, error) {
 	return body, nil
 }
 
+// messageToBodyEdDSA encodes the message for the native-signature gateway variant
+// that checks ed25519 signatures with CHKSIGNU: 64 bytes of signature + cell_ref to payload.
+func messageToBodyEdDSA(payload *boc.Cell, sig [64]byte) (*boc.Cell, error) {
+	body := boc.NewCell()
+
+	err := ErrCollect(
+		body.WriteBytes(sig[:]),
+		body.AddRef(payload),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
 // Ton Virtual Machine (TVM) uses different order of signature params (v,r,s) instead of (r,s,v);
 // Let's split them as required.
 func splitSignature(sig [65]byte) (v byte, r [32]byte, s [32]byte) {
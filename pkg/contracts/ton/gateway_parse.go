@@ -235,22 +235,40 @@ func (gw *Gateway) parseOutbound(tx ton.Transaction) (*Transaction, error) {
 	}, nil
 }
 
-// external message is essentially a cell with 65 bytes of ECDSA sig + cell_ref to payload
-func parseExternalMessage(b *boc.Cell) ([65]byte, *boc.Cell, error) {
-	sig, err := b.ReadBytes(65)
-	if err != nil {
-		return [65]byte{}, nil, err
-	}
+// externalSig is the signature of an external message: either ECDSA (v, r, s) or ed25519.
+type externalSig struct {
+	ecdsa [65]byte
+	eddsa [64]byte
+}
 
-	var sigArray [65]byte
-	copy(sigArray[:], sig)
+// external message is essentially a cell with 65 bytes of ECDSA sig + cell_ref to payload.
+// The native-signature gateway variant uses 64 bytes of ed25519 sig instead.
+func parseExternalMessage(b *boc.Cell) (externalSig, *boc.Cell, error) {
+	var sig externalSig
+
+	switch bits := b.BitsAvailableForRead(); bits {
+	case len(sig.ecdsa) * 8:
+		raw, err := b.ReadBytes(len(sig.ecdsa))
+		if err != nil {
+			return sig, nil, err
+		}
+		copy(sig.ecdsa[:], raw)
+	case len(sig.eddsa) * 8:
+		raw, err := b.ReadBytes(len(sig.eddsa))
+		if err != nil {
+			return sig, nil, err
+		}
+		copy(sig.eddsa[:], raw)
+	default:
+		return sig, nil, errors.Errorf("unexpected signature size (%d bits)", bits)
+	}
 
 	payload, err := b.NextRef()
 
-	return sigArray, payload, err
+	return sig, payload, err
 }
 
-func parseWithdrawal(tx ton.Transaction, sig [65]byte, payload *boc.Cell) (Withdrawal, error) {
+func parseWithdrawal(tx ton.Transaction, sig externalSig, payload *boc.Cell) (Withdrawal, error) {
 	var (
 		recipient tlb.MsgAddress
 		amount    tlb.Coins
@@ -299,11 +317,12 @@ func parseWithdrawal(tx ton.Transaction, sig [65]byte, payload *boc.Cell) (Withd
 		Recipient: recipientAddr,
 		Amount:    math.NewUint(uint64(amount)),
 		Seqno:     seqno,
-		Sig:       shiftSignature(sig),
+		Sig:       shiftSignature(sig.ecdsa),
+		SigEdDSA:  sig.eddsa,
 	}, nil
 }
 
-func parseIncreaseSeqno(_ ton.Transaction, sig [65]byte, payload *boc.Cell) (IncreaseSeqno, error) {
+func parseIncreaseSeqno(_ ton.Transaction, sig externalSig, payload *boc.Cell) (IncreaseSeqno, error) {
 	reasonCode, err := payload.ReadUint(sizeSeqno)
 	if err != nil {
 		return IncreaseSeqno{}, errors.Wrap(err, "unable to read reason code")
@@ -318,7 +337,8 @@ func parseIncreaseSeqno(_ ton.Transaction, sig [65]byte, payload *boc.Cell) (Inc
 	return IncreaseSeqno{
 		Seqno:      uint32(seqno),
 		ReasonCode: uint32(reasonCode),
-		Sig:        shiftSignature(sig),
+		Sig:        shiftSignature(sig.ecdsa),
+		SigEdDSA:   sig.eddsa,
 	}, nil
 }
 
@@ -344,6 +364,10 @@ func exitCodeFromTx(tx ton.Transaction) int32 {
 
 // shiftSignature: shifts bytes: ECDSA sig has the following order: (v, r, s) but in EVM we have (r, s, v)
 func shiftSignature(sig [65]byte) [65]byte {
+	if sig == [65]byte{} {
+		return sig
+	}
+
 	var sigFlipped [65]byte
 
 	copy(sigFlipped[:64], sig[1:])
@@ -29,6 +29,9 @@ type ExternalMsg interface {
 	Signature() [65]byte
 	SetSignature(sig [65]byte)
 
+	SignatureEdDSA() [64]byte
+	SetSignatureEdDSA(sig [64]byte)
+
 	emptySig() bool
 }
 
@@ -2,6 +2,7 @@ package ton
 
 import (
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"embed"
 	"encoding/hex"
 	"encoding/json"
@@ -407,6 +408,39 @@ func TestWithdrawal(t *testing.T) {
 		assert.Equal(t, 0, s.Cmp(actualS))
 		assert.Equal(t, uint64(v), actualV)
 	})
+
+	t.Run("Sign EdDSA", func(t *testing.T) {
+		// ARRANGE
+		// Given an ed25519 key (simulates EdDSA TSS)
+		pubKey, privateKey, err := ed25519.GenerateKey(nil)
+		require.NoError(t, err)
+
+		// Given ed25519 signature that is set in the withdrawal
+		msg := &Withdrawal{Recipient: withdrawal.Recipient, Amount: withdrawal.Amount, Seqno: withdrawal.Seqno}
+
+		var sig [64]byte
+		copy(sig[:], ed25519.Sign(privateKey, hash[:]))
+
+		msg.SetSignatureEdDSA(sig)
+
+		// ACT
+		body, err := msg.AsBody()
+		require.NoError(t, err)
+
+		// ASSERT
+		require.False(t, msg.emptySig())
+		require.True(t, VerifyEdDSA(hash, msg.SignatureEdDSA(), pubKey))
+
+		// Ensure that the body contains the raw ed25519 signature and the payload ref
+		extSig, payload, err := parseExternalMessage(body)
+		require.NoError(t, err)
+		require.Equal(t, sig, extSig.eddsa)
+		require.Equal(t, [65]byte{}, extSig.ecdsa)
+
+		payloadHash, err := payload.Hash256()
+		require.NoError(t, err)
+		require.Equal(t, hash, payloadHash)
+	})
 }
 
 func TestFiltering(t *testing.T) {
@@ -1,6 +1,8 @@
 package ton
 
 import (
+	"crypto/ed25519"
+
 	"cosmossdk.io/math"
 	eth "github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
@@ -18,10 +20,24 @@ type Transaction struct {
 }
 
 // OutboundAuth contains the outbound seqno, signature and signer.
+// For outbounds signed with the ed25519 TSS key, SigEdDSA and Hash are set and Signer is empty.
 type OutboundAuth struct {
 	Seqno  uint32
 	Sig    [65]byte
 	Signer eth.Address
+
+	SigEdDSA [64]byte
+	Hash     [32]byte
+}
+
+// IsEdDSA returns true if the outbound is signed with an ed25519 key.
+func (a OutboundAuth) IsEdDSA() bool {
+	return a.SigEdDSA != [64]byte{}
+}
+
+// VerifyEdDSA returns true if the outbound is signed by the given ed25519 key.
+func (a OutboundAuth) VerifyEdDSA(pubKey ed25519.PublicKey) bool {
+	return a.IsEdDSA() && VerifyEdDSA(a.Hash, a.SigEdDSA, pubKey)
 }
 
 // IsInbound returns true if the transaction is inbound.
@@ -80,6 +96,10 @@ func (tx *Transaction) OutboundAuth() (OutboundAuth, error) {
 			return OutboundAuth{}, errors.Wrap(err, "unable to get withdrawal")
 		}
 
+		if w.SigEdDSA != [64]byte{} {
+			return outboundAuthEdDSA(&w, w.Seqno)
+		}
+
 		signer, err := w.Signer()
 		if err != nil {
 			return OutboundAuth{}, errors.Wrap(err, "unable to get signer")
@@ -98,6 +118,10 @@ func (tx *Transaction) OutboundAuth() (OutboundAuth, error) {
 			return OutboundAuth{}, errors.Wrap(err, "unable to get increase seqno")
 		}
 
+		if is.SigEdDSA != [64]byte{} {
+			return outboundAuthEdDSA(&is, is.Seqno)
+		}
+
 		signer, err := is.Signer()
 		if err != nil {
 			return OutboundAuth{}, errors.Wrap(err, "unable to get signer")
@@ -113,6 +137,19 @@ func (tx *Transaction) OutboundAuth() (OutboundAuth, error) {
 	return OutboundAuth{}, errors.Wrapf(ErrUnknownOp, "op %d", tx.Operation)
 }
 
+func outboundAuthEdDSA(msg ExternalMsg, seqno uint32) (OutboundAuth, error) {
+	hash, err := msg.Hash()
+	if err != nil {
+		return OutboundAuth{}, errors.Wrap(err, "unable to get hash")
+	}
+
+	return OutboundAuth{
+		Seqno:    seqno,
+		SigEdDSA: msg.SignatureEdDSA(),
+		Hash:     hash,
+	}, nil
+}
+
 func retrieveContent[T any](tx *Transaction) (T, error) {
 	typed, ok := tx.content.(T)
 	if !ok {
@@ -8,6 +8,7 @@ const (
 	ArgTypeBytes   ArgType = "bytes"
 	ArgTypeString  ArgType = "string"
 	ArgTypeAddress ArgType = "address"
+	ArgTypeUint64  ArgType = "uint64"
 )
 
 // CodecArg represents a codec argument
@@ -50,3 +51,13 @@ func ArgAbortAddress(arg interface{}) CodecArg {
 func ArgRevertMessage(arg interface{}) CodecArg {
 	return NewArg("revertMessage", ArgTypeBytes, arg)
 }
+
+// ArgReceivers wraps the encoded receiver splits in a CodecArg
+func ArgReceivers(arg interface{}) CodecArg {
+	return NewArg("receivers", ArgTypeBytes, arg)
+}
+
+// ArgDeadline wraps the deadline in a CodecArg
+func ArgDeadline(arg interface{}) CodecArg {
+	return NewArg("deadline", ArgTypeUint64, arg)
+}
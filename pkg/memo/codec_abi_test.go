@@ -59,6 +59,10 @@ func ABIPack(t *testing.T, args ...memo.CodecArg) []byte {
 		case memo.ArgTypeAddress: // left-pad for address
 			data := abiPad32(t, arg.Arg.(common.Address).Bytes(), true)
 			packedData = append(packedData, data...)
+
+		case memo.ArgTypeUint64: // left-pad for uint64
+			data := abiPad32(t, binary.BigEndian.AppendUint64(nil, arg.Arg.(uint64)), true)
+			packedData = append(packedData, data...)
 		}
 	}
 
@@ -133,6 +137,8 @@ func newArgInstance(v interface{}) interface{} {
 		return &[]byte{}
 	case string:
 		return new(string)
+	case uint64:
+		return new(uint64)
 	}
 	return nil
 }
@@ -146,6 +152,8 @@ func ensureArgEquality(t *testing.T, expected, actual interface{}) {
 		require.True(t, bytes.Equal(v, *actual.(*[]byte)))
 	case string:
 		require.Equal(t, v, *actual.(*string))
+	case uint64:
+		require.Equal(t, v, *actual.(*uint64))
 	default:
 		require.FailNow(t, "unexpected argument type", "Type: %T", v)
 	}
@@ -204,6 +212,13 @@ func Test_CodecABI_PackArgument(t *testing.T) {
 				memo.ArgRevertAddress(""),
 			},
 		},
+		{
+			name: "pack in the order of [address, uint64]",
+			args: []memo.CodecArg{
+				memo.ArgReceiver(argAddress),
+				memo.ArgDeadline(uint64(1735689600)),
+			},
+		},
 		{
 			name: "unable to parse unsupported ABI type",
 			args: []memo.CodecArg{
@@ -9,6 +9,9 @@ import (
 	"github.com/pkg/errors"
 )
 
+// sizeUint64 is the number of bytes used to encode an uint64
+const sizeUint64 = 8
+
 var _ Codec = (*CodecCompact)(nil)
 
 // CodecCompact is a coder/decoder for compact encoded memo fields
@@ -66,6 +69,12 @@ func (c *CodecCompact) PackArguments() ([]byte, error) {
 				return nil, errors.Wrapf(err, "failed to pack string argument: %s", arg.Name)
 			}
 			data = append(data, dataString...)
+		case ArgTypeUint64:
+			dataUint64, err := c.packUint64(arg.Arg)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to pack uint64 argument: %s", arg.Name)
+			}
+			data = append(data, dataUint64...)
 		default:
 			return nil, fmt.Errorf("unsupported argument (%s) type: %s", arg.Name, arg.Type)
 		}
@@ -98,6 +107,12 @@ func (c *CodecCompact) UnpackArguments(data []byte) error {
 				return errors.Wrapf(err, "failed to unpack string argument: %s", arg.Name)
 			}
 			offset += bytesRead
+		case ArgTypeUint64:
+			bytesRead, err := c.unpackUint64(data[offset:], arg.Arg)
+			if err != nil {
+				return errors.Wrapf(err, "failed to unpack uint64 argument: %s", arg.Name)
+			}
+			offset += bytesRead
 		default:
 			return fmt.Errorf("unsupported argument (%s) type: %s", arg.Name, arg.Type)
 		}
@@ -181,6 +196,17 @@ func (c *CodecCompact) packString(arg interface{}) ([]byte, error) {
 	return data, nil
 }
 
+// packUint64 packs argument of type 'uint64' as 8 bytes in little-endian order.
+func (c *CodecCompact) packUint64(arg interface{}) ([]byte, error) {
+	// type assertion
+	value, ok := arg.(uint64)
+	if !ok {
+		return nil, fmt.Errorf("argument is not of type uint64")
+	}
+
+	return binary.LittleEndian.AppendUint64(nil, value), nil
+}
+
 // unpackLength returns the length of the data encoded in the compact format
 func (c *CodecCompact) unpackLength(data []byte) (int, error) {
 	if len(data) < c.lenBytes {
@@ -264,3 +290,20 @@ func (c *CodecCompact) unpackString(data []byte, output interface{}) (int, error
 
 	return c.lenBytes + strLen, nil
 }
+
+// unpackUint64 unpacks argument of type 'uint64' and returns the number of bytes read.
+func (c *CodecCompact) unpackUint64(data []byte, output interface{}) (int, error) {
+	// type assertion
+	pUint64, ok := output.(*uint64)
+	if !ok {
+		return 0, fmt.Errorf("argument is not of type *uint64")
+	}
+
+	// ensure remaining data >= 8 bytes
+	if len(data) < sizeUint64 {
+		return 0, fmt.Errorf("expected uint64, got %d bytes", len(data))
+	}
+	*pUint64 = binary.LittleEndian.Uint64(data[:sizeUint64])
+
+	return sizeUint64, nil
+}
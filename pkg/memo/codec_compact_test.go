@@ -52,6 +52,8 @@ func CompactPack(encodingFmt memo.EncodingFormat, args ...memo.CodecArg) []byte
 			packedData = append(packedData, arg.Arg.(common.Address).Bytes()...)
 		case memo.ArgTypeString:
 			packedData = append(packedData, []byte(arg.Arg.(string))...)
+		case memo.ArgTypeUint64:
+			packedData = binary.LittleEndian.AppendUint64(packedData, arg.Arg.(uint64))
 		}
 	}
 
@@ -137,6 +139,15 @@ func Test_CodecCompact_PackArguments(t *testing.T) {
 			},
 			expectedLen: 2 + len([]byte(argString)) + 20 + 2 + len(argBytes),
 		},
+		{
+			name:      "pack arguments of [address, uint64] in compact-short format",
+			encodeFmt: memo.EncodingFmtCompactShort,
+			args: []memo.CodecArg{
+				memo.ArgReceiver(argAddress),
+				memo.ArgDeadline(uint64(1735689600)),
+			},
+			expectedLen: 20 + 8,
+		},
 		{
 			name:      "pack long string (> 255 bytes) with compact-long format",
 			encodeFmt: memo.EncodingFmtCompactLong,
@@ -194,6 +205,14 @@ func Test_CodecCompact_PackArguments(t *testing.T) {
 			},
 			errMsg: "argument is not of type string",
 		},
+		{
+			name:      "failed to pack uint64 argument if string is passed",
+			encodeFmt: memo.EncodingFmtCompactShort,
+			args: []memo.CodecArg{
+				memo.ArgDeadline(argString), // expect uint64 type, but passed string
+			},
+			errMsg: "argument is not of type uint64",
+		},
 		{
 			name:      "failed to pack unsupported argument type",
 			encodeFmt: memo.EncodingFmtCompactShort,
@@ -289,6 +308,28 @@ func Test_CodecCompact_UnpackArguments(t *testing.T) {
 				memo.ArgRevertAddress(""),
 			},
 		},
+		{
+			name:      "unpack arguments of [address, uint64] in compact-short format",
+			encodeFmt: memo.EncodingFmtCompactShort,
+			data: CompactPack(
+				memo.EncodingFmtCompactShort,
+				memo.ArgReceiver(argAddress),
+				memo.ArgDeadline(uint64(1735689600)),
+			),
+			expected: []memo.CodecArg{
+				memo.ArgReceiver(argAddress),
+				memo.ArgDeadline(uint64(1735689600)),
+			},
+		},
+		{
+			name:      "failed to unpack uint64 if data length < 8 bytes",
+			encodeFmt: memo.EncodingFmtCompactShort,
+			data:      []byte{0x01, 0x02, 0x03, 0x04, 0x05},
+			expected: []memo.CodecArg{
+				memo.ArgDeadline(uint64(0)),
+			},
+			errMsg: "expected uint64, got 5 bytes",
+		},
 		{
 			name:      "failed to unpack address if data length < 20 bytes",
 			encodeFmt: memo.EncodingFmtCompactShort,
@@ -68,6 +68,20 @@ func (f *FieldsV0) Unpack(encodingFmt EncodingFormat, dataFlags uint8, data []by
 
 // Validate checks if the fields are valid
 func (f *FieldsV0) Validate(opCode OpCode, dataFlags uint8) error {
+	if err := f.validateFields(opCode, dataFlags); err != nil {
+		return err
+	}
+
+	// reserved flags must be zero
+	if zetabits.GetBits(dataFlags, MaskFlagsReserved) != 0 {
+		return fmt.Errorf("reserved flags are not zero: %08b", dataFlags)
+	}
+
+	return nil
+}
+
+// validateFields checks if the fields V0 are valid, regardless of the reserved flags
+func (f *FieldsV0) validateFields(opCode OpCode, dataFlags uint8) error {
 	// must set receiver address flag
 	if !zetabits.IsBitSet(dataFlags, bitPosReceiver) {
 		return errors.New("must set receiver address flag")
@@ -99,11 +113,6 @@ func (f *FieldsV0) Validate(opCode OpCode, dataFlags uint8) error {
 		}
 	}
 
-	// reserved flags must be zero
-	if zetabits.GetBits(dataFlags, MaskFlagsReserved) != 0 {
-		return fmt.Errorf("reserved flags are not zero: %08b", dataFlags)
-	}
-
 	return nil
 }
 
@@ -146,6 +155,37 @@ func (f *FieldsV0) DataFlags() uint8 {
 
 // packFieldsV0 packs the memo fields for version 0
 func (f *FieldsV0) packFields(codec Codec, dataFlags uint8) ([]byte, error) {
+	// add arguments to the codec
+	f.addPackArguments(codec, dataFlags)
+
+	// pack the codec arguments into data
+	data, err := codec.PackArguments()
+	if err != nil { // never happens
+		return nil, errors.Wrap(err, "failed to pack arguments")
+	}
+
+	return data, nil
+}
+
+// unpackFields unpacks the memo fields for version 0
+func (f *FieldsV0) unpackFields(codec Codec, dataFlags byte, data []byte) error {
+	// add arguments to the codec
+	abortAddress := f.addUnpackArguments(codec, dataFlags)
+
+	// unpack the data (after flags) into codec arguments
+	err := codec.UnpackArguments(data)
+	if err != nil {
+		return errors.Wrap(err, "failed to unpack arguments")
+	}
+
+	// convert abort address to string
+	f.setAbortAddress(abortAddress)
+
+	return nil
+}
+
+// addPackArguments adds the fields V0 to the codec as arguments to pack
+func (f *FieldsV0) addPackArguments(codec Codec, dataFlags uint8) {
 	// add 'receiver' argument optionally
 	if zetabits.IsBitSet(dataFlags, bitPosReceiver) {
 		codec.AddArguments(ArgReceiver(f.Receiver))
@@ -171,18 +211,12 @@ func (f *FieldsV0) packFields(codec Codec, dataFlags uint8) ([]byte, error) {
 	if zetabits.IsBitSet(dataFlags, bitPosRevertMessage) {
 		codec.AddArguments(ArgRevertMessage(f.RevertOptions.RevertMessage))
 	}
-
-	// pack the codec arguments into data
-	data, err := codec.PackArguments()
-	if err != nil { // never happens
-		return nil, errors.Wrap(err, "failed to pack arguments")
-	}
-
-	return data, nil
 }
 
-// unpackFields unpacks the memo fields for version 0
-func (f *FieldsV0) unpackFields(codec Codec, dataFlags byte, data []byte) error {
+// addUnpackArguments adds the fields V0 to the codec as arguments to unpack into
+//
+// The abort address is unpacked into the returned address, see setAbortAddress.
+func (f *FieldsV0) addUnpackArguments(codec Codec, dataFlags uint8) *common.Address {
 	// add 'receiver' argument optionally
 	if zetabits.IsBitSet(dataFlags, bitPosReceiver) {
 		codec.AddArguments(ArgReceiver(&f.Receiver))
@@ -199,9 +233,9 @@ func (f *FieldsV0) unpackFields(codec Codec, dataFlags byte, data []byte) error
 	}
 
 	// add 'abortAddress' argument optionally
-	var abortAddress common.Address
+	abortAddress := &common.Address{}
 	if zetabits.IsBitSet(dataFlags, bitPosAbortAddress) {
-		codec.AddArguments(ArgAbortAddress(&abortAddress))
+		codec.AddArguments(ArgAbortAddress(abortAddress))
 	}
 
 	// set 'callOnRevert' flag
@@ -212,16 +246,12 @@ func (f *FieldsV0) unpackFields(codec Codec, dataFlags byte, data []byte) error
 		codec.AddArguments(ArgRevertMessage(&f.RevertOptions.RevertMessage))
 	}
 
-	// unpack the data (after flags) into codec arguments
-	err := codec.UnpackArguments(data)
-	if err != nil {
-		return errors.Wrap(err, "failed to unpack arguments")
-	}
+	return abortAddress
+}
 
-	// convert abort address to string
-	if !crypto.IsEmptyAddress(abortAddress) {
+// setAbortAddress sets the unpacked abort address into the revert options
+func (f *FieldsV0) setAbortAddress(abortAddress *common.Address) {
+	if !crypto.IsEmptyAddress(*abortAddress) {
 		f.RevertOptions.AbortAddress = abortAddress.Hex()
 	}
-
-	return nil
 }
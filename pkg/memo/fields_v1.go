@@ -0,0 +1,249 @@
+package memo
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/zeta-chain/node/pkg/crypto"
+	zetabits "github.com/zeta-chain/node/pkg/math/bits"
+)
+
+// Enum of the bit position of each memo fields added in V1
+// Note: these bits are the reserved flags in V0
+const (
+	bitPosReceivers uint8 = 6 // receivers
+	bitPosDeadline  uint8 = 7 // deadline
+)
+
+const (
+	// MaxReceivers is the maximum number of receiver splits allowed in a memo
+	MaxReceivers = 10
+
+	// receiverSplitSize is the size of an encoded receiver split: [20-byte address + 8-byte amount]
+	receiverSplitSize = common.AddressLength + sizeUint64
+)
+
+// ReceiverSplit represents a ZEVM receiver and the amount it receives
+type ReceiverSplit struct {
+	// Receiver is the ZEVM receiver address
+	Receiver common.Address
+
+	// Amount is the amount sent to the receiver, in the smallest unit of the inbound asset
+	// Note: it is taken out of the inbound amount, the memo receiver gets the remaining amount
+	Amount uint64
+}
+
+// FieldsV1 contains the data fields added in the inbound memo V1
+//
+// A V1 memo carries the FieldsV0 arguments followed by the FieldsV1 arguments,
+// all of them packed by the same codec.
+type FieldsV1 struct {
+	// Receivers is an optional list of ZEVM receivers with amount splits
+	Receivers []ReceiverSplit
+
+	// IsArbitraryCall tells whether the payload is an arbitrary call to the receiver
+	// Note: this field is carried by the header control bits rather than by the data flags
+	IsArbitraryCall bool
+
+	// Deadline is the unix timestamp (seconds) after which the cctx reverts, zero means no deadline
+	Deadline uint64
+}
+
+// IsEmpty returns true if none of the fields V1 is set
+func (f *FieldsV1) IsEmpty() bool {
+	return len(f.Receivers) == 0 && !f.IsArbitraryCall && f.Deadline == 0
+}
+
+// Pack encodes the memo fields V0 and V1
+func (f *FieldsV1) Pack(base *FieldsV0, opCode OpCode, encodingFmt EncodingFormat, dataFlags uint8) ([]byte, error) {
+	// validate fields
+	err := f.Validate(base, opCode, dataFlags)
+	if err != nil {
+		return nil, err
+	}
+
+	codec, err := GetCodec(encodingFmt)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get codec")
+	}
+
+	return f.packFields(base, codec, dataFlags)
+}
+
+// Unpack decodes the memo fields V0 and V1
+func (f *FieldsV1) Unpack(base *FieldsV0, encodingFmt EncodingFormat, dataFlags uint8, data []byte) error {
+	codec, err := GetCodec(encodingFmt)
+	if err != nil {
+		return errors.Wrap(err, "unable to get codec")
+	}
+
+	return f.unpackFields(base, codec, dataFlags, data)
+}
+
+// Validate checks if the fields V0 and V1 are valid
+func (f *FieldsV1) Validate(base *FieldsV0, opCode OpCode, dataFlags uint8) error {
+	// validate the fields V0, the reserved flags of V0 are used by V1
+	if err := base.validateFields(opCode, dataFlags); err != nil {
+		return err
+	}
+
+	// must provide the receivers if flag is set
+	if zetabits.IsBitSet(dataFlags, bitPosReceivers) {
+		if err := validateReceivers(base.Receiver, f.Receivers); err != nil {
+			return err
+		}
+
+		// splitting amount is not allowed for call operation
+		if opCode == OpCodeCall {
+			return errors.New("receivers are not allowed for call operation")
+		}
+	}
+
+	// must provide a non-zero deadline if flag is set
+	if zetabits.IsBitSet(dataFlags, bitPosDeadline) && f.Deadline == 0 {
+		return errors.New("deadline is zero")
+	}
+
+	// arbitrary call is not allowed for deposit operation
+	if opCode == OpCodeDeposit && f.IsArbitraryCall {
+		return errors.New("arbitrary call is not allowed for deposit operation")
+	}
+
+	return nil
+}
+
+// DataFlags build the data flags for the fields V1 only
+func (f *FieldsV1) DataFlags() uint8 {
+	var dataFlags uint8
+
+	// set 'receivers' flag if provided
+	if len(f.Receivers) > 0 {
+		zetabits.SetBit(&dataFlags, bitPosReceivers)
+	}
+
+	// set 'deadline' flag if provided
+	if f.Deadline > 0 {
+		zetabits.SetBit(&dataFlags, bitPosDeadline)
+	}
+
+	return dataFlags
+}
+
+// packFields packs the memo fields for version 1
+func (f *FieldsV1) packFields(base *FieldsV0, codec Codec, dataFlags uint8) ([]byte, error) {
+	// add V0 arguments
+	base.addPackArguments(codec, dataFlags)
+
+	// add 'receivers' argument optionally
+	if zetabits.IsBitSet(dataFlags, bitPosReceivers) {
+		codec.AddArguments(ArgReceivers(encodeReceivers(f.Receivers)))
+	}
+
+	// add 'deadline' argument optionally
+	if zetabits.IsBitSet(dataFlags, bitPosDeadline) {
+		codec.AddArguments(ArgDeadline(f.Deadline))
+	}
+
+	// pack the codec arguments into data
+	data, err := codec.PackArguments()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to pack arguments")
+	}
+
+	return data, nil
+}
+
+// unpackFields unpacks the memo fields for version 1
+func (f *FieldsV1) unpackFields(base *FieldsV0, codec Codec, dataFlags uint8, data []byte) error {
+	// add V0 arguments
+	abortAddress := base.addUnpackArguments(codec, dataFlags)
+
+	// add 'receivers' argument optionally
+	var receivers []byte
+	if zetabits.IsBitSet(dataFlags, bitPosReceivers) {
+		codec.AddArguments(ArgReceivers(&receivers))
+	}
+
+	// add 'deadline' argument optionally
+	if zetabits.IsBitSet(dataFlags, bitPosDeadline) {
+		codec.AddArguments(ArgDeadline(&f.Deadline))
+	}
+
+	// unpack the data (after flags) into codec arguments
+	err := codec.UnpackArguments(data)
+	if err != nil {
+		return errors.Wrap(err, "failed to unpack arguments")
+	}
+
+	// convert abort address to string
+	base.setAbortAddress(abortAddress)
+
+	// decode the receiver splits
+	if zetabits.IsBitSet(dataFlags, bitPosReceivers) {
+		f.Receivers, err = decodeReceivers(receivers)
+		if err != nil {
+			return errors.Wrap(err, "failed to decode receivers")
+		}
+	}
+
+	return nil
+}
+
+// validateReceivers checks if the receiver splits are valid
+// Each split is deposited by its own cctx, so the receivers must differ from each other and from the memo receiver.
+func validateReceivers(receiver common.Address, receivers []ReceiverSplit) error {
+	switch {
+	case len(receivers) == 0:
+		return errors.New("receivers are empty")
+	case len(receivers) > MaxReceivers:
+		return fmt.Errorf("too many receivers: %d, max %d", len(receivers), MaxReceivers)
+	}
+
+	seen := map[common.Address]bool{receiver: true}
+	for i, split := range receivers {
+		if crypto.IsEmptyAddress(split.Receiver) {
+			return fmt.Errorf("receiver %d address is empty", i)
+		}
+		if split.Amount == 0 {
+			return fmt.Errorf("receiver %d amount is zero", i)
+		}
+		if seen[split.Receiver] {
+			return fmt.Errorf("receiver %d address is duplicated", i)
+		}
+		seen[split.Receiver] = true
+	}
+
+	return nil
+}
+
+// encodeReceivers encodes the receiver splits as a list of [20-byte address + 8-byte little-endian amount]
+func encodeReceivers(receivers []ReceiverSplit) []byte {
+	data := make([]byte, 0, len(receivers)*receiverSplitSize)
+	for _, split := range receivers {
+		data = append(data, split.Receiver.Bytes()...)
+		data = binary.LittleEndian.AppendUint64(data, split.Amount)
+	}
+
+	return data
+}
+
+// decodeReceivers decodes the receiver splits encoded by encodeReceivers
+func decodeReceivers(data []byte) ([]ReceiverSplit, error) {
+	if len(data)%receiverSplitSize != 0 {
+		return nil, fmt.Errorf("invalid receivers data length: %d", len(data))
+	}
+
+	receivers := make([]ReceiverSplit, 0, len(data)/receiverSplitSize)
+	for offset := 0; offset < len(data); offset += receiverSplitSize {
+		split := data[offset : offset+receiverSplitSize]
+		receivers = append(receivers, ReceiverSplit{
+			Receiver: common.BytesToAddress(split[:common.AddressLength]),
+			Amount:   binary.LittleEndian.Uint64(split[common.AddressLength:]),
+		})
+	}
+
+	return receivers, nil
+}
@@ -0,0 +1,398 @@
+package memo_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/memo"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+)
+
+const (
+	// flagsAllFieldsSetV1 sets all fields: [V0 fields, receivers, deadline]
+	flagsAllFieldsSetV1 = 0b11111111
+)
+
+// ReceiversPack is a helper function to encode receiver splits as [20-byte address + 8-byte little-endian amount]
+func ReceiversPack(receivers ...memo.ReceiverSplit) []byte {
+	data := make([]byte, 0)
+	for _, split := range receivers {
+		data = append(data, split.Receiver.Bytes()...)
+		data = binary.LittleEndian.AppendUint64(data, split.Amount)
+	}
+	return data
+}
+
+func Test_V1_Pack(t *testing.T) {
+	// create sample fields
+	fAddress := common.HexToAddress("0xA029D053E13223E2442E28be80b3CeDA27ecbE31")
+	fBytes := []byte("here_s_some_bytes_field")
+	fString := "this_is_a_string_field"
+	fSplit := memo.ReceiverSplit{Receiver: common.HexToAddress("0x5EB0C6B6F1A1A1A1F7AF3C0E7DDEF1A0BA7C5E2E"), Amount: 1000}
+	fSplit2 := memo.ReceiverSplit{Receiver: common.HexToAddress("0x6F1A6D5C8B0E2E3A9B4D7C1F0E5A3B2C1D0E9F8A"), Amount: 2000}
+	fDeadline := uint64(1735689600)
+
+	tests := []struct {
+		name         string
+		opCode       memo.OpCode
+		encodeFmt    memo.EncodingFormat
+		dataFlags    uint8
+		base         memo.FieldsV0
+		fields       memo.FieldsV1
+		expectedData []byte
+		errMsg       string
+	}{
+		{
+			name:      "pack all fields with ABI encoding",
+			opCode:    memo.OpCodeDepositAndCall,
+			encodeFmt: memo.EncodingFmtABI,
+			dataFlags: flagsAllFieldsSetV1, // all fields are set
+			base: memo.FieldsV0{
+				Receiver: fAddress,
+				Payload:  fBytes,
+				RevertOptions: crosschaintypes.RevertOptions{
+					RevertAddress: fString,
+					CallOnRevert:  true,
+					AbortAddress:  fAddress.String(),
+					RevertMessage: fBytes,
+				},
+			},
+			fields: memo.FieldsV1{
+				Receivers: []memo.ReceiverSplit{fSplit},
+				Deadline:  fDeadline,
+			},
+			expectedData: ABIPack(t,
+				memo.ArgReceiver(fAddress),
+				memo.ArgPayload(fBytes),
+				memo.ArgRevertAddress(fString),
+				memo.ArgAbortAddress(fAddress),
+				memo.ArgRevertMessage(fBytes),
+				memo.ArgReceivers(ReceiversPack(fSplit)),
+				memo.ArgDeadline(fDeadline)),
+		},
+		{
+			name:      "pack all fields with compact encoding",
+			opCode:    memo.OpCodeDepositAndCall,
+			encodeFmt: memo.EncodingFmtCompactShort,
+			dataFlags: flagsAllFieldsSetV1, // all fields are set
+			base: memo.FieldsV0{
+				Receiver: fAddress,
+				Payload:  fBytes,
+				RevertOptions: crosschaintypes.RevertOptions{
+					RevertAddress: fString,
+					CallOnRevert:  true,
+					AbortAddress:  fAddress.String(),
+					RevertMessage: fBytes,
+				},
+			},
+			fields: memo.FieldsV1{
+				Receivers: []memo.ReceiverSplit{fSplit, fSplit2},
+				Deadline:  fDeadline,
+			},
+			expectedData: CompactPack(
+				memo.EncodingFmtCompactShort,
+				memo.ArgReceiver(fAddress),
+				memo.ArgPayload(fBytes),
+				memo.ArgRevertAddress(fString),
+				memo.ArgAbortAddress(fAddress),
+				memo.ArgRevertMessage(fBytes),
+				memo.ArgReceivers(ReceiversPack(fSplit, fSplit2)),
+				memo.ArgDeadline(fDeadline)),
+		},
+		{
+			name:      "fields validation failed due to zero deadline",
+			opCode:    memo.OpCodeDeposit,
+			encodeFmt: memo.EncodingFmtABI,
+			dataFlags: 0b10000001, // receiver and deadline flags are set
+			base: memo.FieldsV0{
+				Receiver: fAddress,
+			},
+			fields: memo.FieldsV1{},
+			errMsg: "deadline is zero",
+		},
+		{
+			name:      "unable to get codec on invalid encoding format",
+			opCode:    memo.OpCodeDeposit,
+			encodeFmt: 0x0F,
+			dataFlags: 0b00000001,
+			base: memo.FieldsV0{
+				Receiver: fAddress,
+			},
+			errMsg: "unable to get codec",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// pack the fields
+			data, err := tc.fields.Pack(&tc.base, tc.opCode, tc.encodeFmt, tc.dataFlags)
+
+			// validate the error message
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				require.Nil(t, data)
+				return
+			}
+
+			// compare the fields
+			require.NoError(t, err)
+			require.True(t, bytes.Equal(tc.expectedData, data))
+		})
+	}
+}
+
+func Test_V1_Unpack(t *testing.T) {
+	// create sample fields
+	fAddress := common.HexToAddress("0xA029D053E13223E2442E28be80b3CeDA27ecbE31")
+	fBytes := []byte("here_s_some_bytes_field")
+	fSplit := memo.ReceiverSplit{Receiver: common.HexToAddress("0x5EB0C6B6F1A1A1A1F7AF3C0E7DDEF1A0BA7C5E2E"), Amount: 1000}
+	fSplit2 := memo.ReceiverSplit{Receiver: common.HexToAddress("0x6F1A6D5C8B0E2E3A9B4D7C1F0E5A3B2C1D0E9F8A"), Amount: 2000}
+	fDeadline := uint64(1735689600)
+
+	tests := []struct {
+		name         string
+		encodeFmt    memo.EncodingFormat
+		dataFlags    byte
+		data         []byte
+		expectedBase memo.FieldsV0
+		expected     memo.FieldsV1
+		errMsg       string
+	}{
+		{
+			name:      "unpack fields with ABI encoding",
+			encodeFmt: memo.EncodingFmtABI,
+			dataFlags: 0b11001011, // receiver, payload, abort address, receivers and deadline are set
+			data: ABIPack(t,
+				memo.ArgReceiver(fAddress),
+				memo.ArgPayload(fBytes),
+				memo.ArgAbortAddress(fAddress),
+				memo.ArgReceivers(ReceiversPack(fSplit)),
+				memo.ArgDeadline(fDeadline)),
+			expectedBase: memo.FieldsV0{
+				Receiver: fAddress,
+				Payload:  fBytes,
+				RevertOptions: crosschaintypes.RevertOptions{
+					AbortAddress: fAddress.String(),
+				},
+			},
+			expected: memo.FieldsV1{
+				Receivers: []memo.ReceiverSplit{fSplit},
+				Deadline:  fDeadline,
+			},
+		},
+		{
+			name:      "unpack fields with compact encoding",
+			encodeFmt: memo.EncodingFmtCompactLong,
+			dataFlags: 0b01000001, // receiver and receivers are set
+			data: CompactPack(
+				memo.EncodingFmtCompactLong,
+				memo.ArgReceiver(fAddress),
+				memo.ArgReceivers(ReceiversPack(fSplit, fSplit2))),
+			expectedBase: memo.FieldsV0{
+				Receiver: fAddress,
+			},
+			expected: memo.FieldsV1{
+				Receivers: []memo.ReceiverSplit{fSplit, fSplit2},
+			},
+		},
+		{
+			name:      "failed to decode receivers with invalid length",
+			encodeFmt: memo.EncodingFmtCompactShort,
+			dataFlags: 0b01000001, // receiver and receivers are set
+			data: CompactPack(
+				memo.EncodingFmtCompactShort,
+				memo.ArgReceiver(fAddress),
+				memo.ArgReceivers([]byte{0x01, 0x02, 0x03})),
+			errMsg: "failed to decode receivers",
+		},
+		{
+			name:      "failed to unpack deadline with missing data",
+			encodeFmt: memo.EncodingFmtCompactShort,
+			dataFlags: 0b10000001, // receiver and deadline are set
+			data: CompactPack(
+				memo.EncodingFmtCompactShort,
+				memo.ArgReceiver(fAddress)),
+			errMsg: "failed to unpack arguments",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// unpack the fields
+			base := memo.FieldsV0{}
+			fields := memo.FieldsV1{}
+			err := fields.Unpack(&base, tc.encodeFmt, tc.dataFlags, tc.data)
+
+			// validate the error message
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+
+			// compare the fields
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedBase, base)
+			require.Equal(t, tc.expected, fields)
+		})
+	}
+}
+
+func Test_V1_Validate(t *testing.T) {
+	// create sample fields
+	fAddress := common.HexToAddress("0xA029D053E13223E2442E28be80b3CeDA27ecbE31")
+	fSplit := memo.ReceiverSplit{Receiver: common.HexToAddress("0x5EB0C6B6F1A1A1A1F7AF3C0E7DDEF1A0BA7C5E2E"), Amount: 1000}
+
+	tests := []struct {
+		name      string
+		opCode    memo.OpCode
+		dataFlags uint8
+		base      memo.FieldsV0
+		fields    memo.FieldsV1
+		errMsg    string
+	}{
+		{
+			name:      "valid fields",
+			opCode:    memo.OpCodeDepositAndCall,
+			dataFlags: 0b11000001, // receiver, receivers and deadline are set
+			base:      memo.FieldsV0{Receiver: fAddress},
+			fields: memo.FieldsV1{
+				Receivers:       []memo.ReceiverSplit{fSplit},
+				IsArbitraryCall: true,
+				Deadline:        1735689600,
+			},
+		},
+		{
+			name:      "invalid fields V0",
+			opCode:    memo.OpCodeDeposit,
+			dataFlags: 0b00000000, // receiver flag is not set
+			base:      memo.FieldsV0{Receiver: fAddress},
+			errMsg:    "must set receiver address flag",
+		},
+		{
+			name:      "receivers are empty",
+			opCode:    memo.OpCodeDeposit,
+			dataFlags: 0b01000001, // receiver and receivers are set
+			base:      memo.FieldsV0{Receiver: fAddress},
+			errMsg:    "receivers are empty",
+		},
+		{
+			name:      "too many receivers",
+			opCode:    memo.OpCodeDeposit,
+			dataFlags: 0b01000001, // receiver and receivers are set
+			base:      memo.FieldsV0{Receiver: fAddress},
+			fields: memo.FieldsV1{
+				Receivers: make([]memo.ReceiverSplit, memo.MaxReceivers+1),
+			},
+			errMsg: "too many receivers",
+		},
+		{
+			name:      "receiver split address is empty",
+			opCode:    memo.OpCodeDeposit,
+			dataFlags: 0b01000001, // receiver and receivers are set
+			base:      memo.FieldsV0{Receiver: fAddress},
+			fields: memo.FieldsV1{
+				Receivers: []memo.ReceiverSplit{fSplit, {Amount: 1}},
+			},
+			errMsg: "receiver 1 address is empty",
+		},
+		{
+			name:      "receiver split amount is zero",
+			opCode:    memo.OpCodeDeposit,
+			dataFlags: 0b01000001, // receiver and receivers are set
+			base:      memo.FieldsV0{Receiver: fAddress},
+			fields: memo.FieldsV1{
+				Receivers: []memo.ReceiverSplit{{Receiver: fAddress}},
+			},
+			errMsg: "receiver 0 amount is zero",
+		},
+		{
+			name:      "receiver split address is duplicated",
+			opCode:    memo.OpCodeDeposit,
+			dataFlags: 0b01000001, // receiver and receivers are set
+			base:      memo.FieldsV0{Receiver: fAddress},
+			fields: memo.FieldsV1{
+				Receivers: []memo.ReceiverSplit{fSplit, fSplit},
+			},
+			errMsg: "receiver 1 address is duplicated",
+		},
+		{
+			name:      "receiver split address is the memo receiver",
+			opCode:    memo.OpCodeDeposit,
+			dataFlags: 0b01000001, // receiver and receivers are set
+			base:      memo.FieldsV0{Receiver: fAddress},
+			fields: memo.FieldsV1{
+				Receivers: []memo.ReceiverSplit{{Receiver: fAddress, Amount: 1}},
+			},
+			errMsg: "receiver 0 address is duplicated",
+		},
+		{
+			name:      "receivers are not allowed for call operation",
+			opCode:    memo.OpCodeCall,
+			dataFlags: 0b01000001, // receiver and receivers are set
+			base:      memo.FieldsV0{Receiver: fAddress},
+			fields: memo.FieldsV1{
+				Receivers: []memo.ReceiverSplit{fSplit},
+			},
+			errMsg: "receivers are not allowed for call operation",
+		},
+		{
+			name:      "arbitrary call is not allowed for deposit operation",
+			opCode:    memo.OpCodeDeposit,
+			dataFlags: 0b00000001, // receiver flag is set
+			base:      memo.FieldsV0{Receiver: fAddress},
+			fields: memo.FieldsV1{
+				IsArbitraryCall: true,
+			},
+			errMsg: "arbitrary call is not allowed for deposit operation",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// validate the fields
+			err := tc.fields.Validate(&tc.base, tc.opCode, tc.dataFlags)
+
+			// validate the error message
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_V1_DataFlags(t *testing.T) {
+	fAddress := common.HexToAddress("0xA029D053E13223E2442E28be80b3CeDA27ecbE31")
+
+	tests := []struct {
+		name          string
+		fields        memo.FieldsV1
+		expectedFlags uint8
+	}{
+		{
+			name: "all fields set",
+			fields: memo.FieldsV1{
+				Receivers:       []memo.ReceiverSplit{{Receiver: fAddress, Amount: 1}},
+				IsArbitraryCall: true, // carried by the header control bits
+				Deadline:        1735689600,
+			},
+			expectedFlags: 0b11000000,
+		},
+		{
+			name:          "no fields set",
+			fields:        memo.FieldsV1{},
+			expectedFlags: 0b00000000,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectedFlags, tc.fields.DataFlags())
+		})
+	}
+}
@@ -28,6 +28,12 @@ const (
 
 	// maskCtrlReserved is the mask for reserved control bits (lower 4 bits)
 	maskCtrlReserved byte = 0b00001111
+
+	// ctrlBitPosArbitraryCall is the bit position of the 'arbitrary call' control bit (version 1 only)
+	ctrlBitPosArbitraryCall uint8 = 0
+
+	// maskCtrlReservedV1 is the mask for the control bits still reserved in version 1
+	maskCtrlReservedV1 byte = 0b00001110
 )
 
 // Enum for non-EVM chain inbound operation code (4 bits)
@@ -118,7 +124,7 @@ func (h *Header) DecodeFromBytes(data []byte) error {
 
 // Validate checks if the memo header is valid
 func (h *Header) Validate() error {
-	if h.Version != 0 {
+	if h.Version > version1 {
 		return fmt.Errorf("invalid memo version: %d", h.Version)
 	}
 
@@ -131,7 +137,12 @@ func (h *Header) Validate() error {
 	}
 
 	// reserved control bits must be zero
-	if h.Reserved != 0 {
+	// version 1 uses the lowest control bit as the 'arbitrary call' flag
+	reserved := h.Reserved
+	if h.Version == version1 {
+		reserved = zetabits.GetBits(h.Reserved, maskCtrlReservedV1)
+	}
+	if reserved != 0 {
 		return fmt.Errorf("reserved control bits are not zero: %d", h.Reserved)
 	}
 
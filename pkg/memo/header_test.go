@@ -39,7 +39,7 @@ func Test_Header_EncodeToBytes(t *testing.T) {
 		{
 			name: "header validation failed",
 			header: memo.Header{
-				Version: 1, // invalid version
+				Version: 2, // invalid version
 			},
 			errMsg: "invalid memo version",
 		},
@@ -128,7 +128,7 @@ func Test_Header_Validate(t *testing.T) {
 		{
 			name: "invalid version",
 			header: memo.Header{
-				Version: 1,
+				Version: 2,
 			},
 			errMsg: "invalid memo version",
 		},
@@ -153,6 +153,23 @@ func Test_Header_Validate(t *testing.T) {
 			},
 			errMsg: "reserved control bits are not zero",
 		},
+		{
+			name: "valid header version 1 with arbitrary call control bit",
+			header: memo.Header{
+				Version:     1,
+				EncodingFmt: memo.EncodingFmtABI,
+				OpCode:      memo.OpCodeCall,
+				Reserved:    0b0001,
+			},
+		},
+		{
+			name: "reserved field of version 1 is not zero",
+			header: memo.Header{
+				Version:  1,
+				Reserved: 0b0011,
+			},
+			errMsg: "reserved control bits are not zero",
+		},
 	}
 
 	for _, tt := range tests {
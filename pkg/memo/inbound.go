@@ -0,0 +1,194 @@
+package memo
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gagliardetto/solana-go"
+	"github.com/pkg/errors"
+	"github.com/tonkeeper/tongo/ton"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/contracts/sui"
+	"github.com/zeta-chain/node/pkg/crypto"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// AddressValidator validates an external chain address
+type AddressValidator func(address string) error
+
+// CarriesStandardMemo returns true if the payload of a gateway call is to be decoded as a standard memo.
+// Only the cross-chain calls without an explicit receiver carry a standard memo,
+// the payload of a call made to a receiver is always passed to the receiver as is.
+func CarriesStandardMemo(isCrossChainCall bool, receiver common.Address) bool {
+	return isCrossChainCall && crypto.IsEmptyAddress(receiver)
+}
+
+// DecodeStandardMemo tries to decode the given inbound payload as a standard memo.
+// The revert address (if any) carried by the memo is checked by the given chain-specific validator.
+//
+// Returns:
+//   - [memo, nil] if the payload is a valid standard memo.
+//   - [nil, nil] if the payload is not a standard memo, the caller should keep using the payload as is.
+//   - [nil, err] if the payload is a standard memo but contains improper data.
+func DecodeStandardMemo(payload []byte, validateRevertAddress AddressValidator) (*InboundMemo, error) {
+	memoStd, isStandardMemo, err := DecodeFromBytes(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "standard memo contains improper data")
+	}
+	if !isStandardMemo {
+		return nil, nil
+	}
+
+	// ensure the revert address is valid on the inbound chain
+	revertAddress := memoStd.RevertOptions.RevertAddress
+	if revertAddress != "" && validateRevertAddress != nil {
+		if err := validateRevertAddress(revertAddress); err != nil {
+			return nil, errors.Wrapf(err, "invalid revert address in memo: %s", revertAddress)
+		}
+	}
+
+	return memoStd, nil
+}
+
+// DecodeBitcoinMemo decodes the memo of a Bitcoin inbound as a standard memo,
+// or falls back to the legacy memo layout [20-byte receiver, payload] if it's not a standard memo.
+// It returns the standard memo (nil for a legacy memo), the receiver and the payload.
+func DecodeBitcoinMemo(
+	memoBytes []byte,
+	chainID int64,
+) (memoStd *InboundMemo, receiver common.Address, payload []byte, err error) {
+	// try to decode the standard memo as the preferred format
+	// note: err is guaranteed to be nil when 'isStandardMemo == false',
+	// so a non-nil error indicates the standard memo contains improper data
+	memoStd, isStandardMemo, err := DecodeFromBytes(memoBytes)
+	if err != nil {
+		return nil, common.Address{}, nil, errors.Wrap(err, "standard memo contains improper data")
+	}
+
+	if isStandardMemo {
+		// validate the content of the standard memo
+		if err := ValidateBitcoinRevertAddress(memoStd.RevertOptions.RevertAddress, chainID); err != nil {
+			return nil, common.Address{}, nil, errors.Wrap(err, "invalid standard memo for bitcoin")
+		}
+		receiver = memoStd.Receiver
+		payload = memoStd.Payload
+	} else {
+		// legacy memo, ensure the it is no less than ZEVM address length (20-byte receiver)
+		// checking upfront is to return more informative error message in the CCTX struct
+		if len(memoBytes) < common.AddressLength {
+			return nil, common.Address{}, nil, errors.New("legacy memo length must be at least 20 bytes")
+		}
+
+		receiver, payload, err = DecodeLegacyMemoHex(hex.EncodeToString(memoBytes))
+		if err != nil { // unreachable code
+			return nil, common.Address{}, nil, errors.Wrap(err, "invalid legacy memo")
+		}
+		memoStd = nil
+	}
+
+	// ensure the receiver is valid
+	if crypto.IsEmptyAddress(receiver) {
+		return nil, common.Address{}, nil, errors.New("got empty receiver address from memo")
+	}
+
+	return memoStd, receiver, payload, nil
+}
+
+// ValidateBitcoinRevertAddress ensures the revert address (if any) is a valid and supported BTC address
+func ValidateBitcoinRevertAddress(revertAddress string, chainID int64) error {
+	if revertAddress == "" {
+		return nil
+	}
+
+	btcAddress, err := chains.DecodeBtcAddress(revertAddress, chainID)
+	if err != nil {
+		return errors.Wrapf(err, "invalid revert address in memo: %s", revertAddress)
+	}
+	if !chains.IsBtcAddressSupported(btcAddress) {
+		return fmt.Errorf("unsupported revert address in memo: %s", revertAddress)
+	}
+
+	return nil
+}
+
+// RevertAddressValidator returns the validator of the revert addresses carried by the memos of the given chain.
+// It returns nil for the chains whose inbounds don't carry standard memos.
+func RevertAddressValidator(chain chains.Chain) AddressValidator {
+	switch chain.Network {
+	case chains.Network_btc:
+		return func(address string) error {
+			return ValidateBitcoinRevertAddress(address, chain.ChainId)
+		}
+	case chains.Network_solana:
+		return func(address string) error {
+			_, err := solana.PublicKeyFromBase58(address)
+			return err
+		}
+	case chains.Network_ton:
+		return func(address string) error {
+			_, err := ton.ParseAccountID(address)
+			return err
+		}
+	case chains.Network_sui:
+		return sui.ValidateAddress
+	default:
+		return nil
+	}
+}
+
+// StandardMemoRevertOptions returns the revert options carried by the standard memo
+//
+// Note: the 'RevertGasLimit' is not used for now for non-EVM chains.
+func StandardMemoRevertOptions(memoStd *InboundMemo) crosschaintypes.RevertOptions {
+	return crosschaintypes.RevertOptions{
+		RevertAddress:  memoStd.RevertOptions.RevertAddress,
+		CallOnRevert:   memoStd.RevertOptions.CallOnRevert,
+		AbortAddress:   memoStd.RevertOptions.AbortAddress,
+		RevertMessage:  memoStd.RevertOptions.RevertMessage,
+		RevertGasLimit: sdkmath.ZeroUint(),
+	}
+}
+
+// StandardMemoReceiverSplits returns the receiver splits carried by the standard memo
+func StandardMemoReceiverSplits(memoStd *InboundMemo) []crosschaintypes.ReceiverSplit {
+	var splits []crosschaintypes.ReceiverSplit
+	for _, split := range memoStd.Receivers {
+		splits = append(splits, crosschaintypes.ReceiverSplit{
+			Receiver: split.Receiver.Hex(),
+			Amount:   sdkmath.NewUint(split.Amount),
+		})
+	}
+	return splits
+}
+
+// StandardMemoVoteOptions returns the inbound vote options carried by the standard memo
+func StandardMemoVoteOptions(memoStd *InboundMemo) []crosschaintypes.InboundVoteOption {
+	return []crosschaintypes.InboundVoteOption{
+		crosschaintypes.WithRevertOptions(StandardMemoRevertOptions(memoStd)),
+		crosschaintypes.WithDeadline(memoStd.Deadline),
+		crosschaintypes.WithReceiverSplits(StandardMemoReceiverSplits(memoStd)),
+	}
+}
+
+// BitcoinMemoRevertOptions returns the revert options carried by the standard memo of a Bitcoin inbound
+//
+// Note: 'CallOnRevert' and 'RevertGasLimit' are irrelevant to bitcoin inbound.
+func BitcoinMemoRevertOptions(memoStd *InboundMemo) crosschaintypes.RevertOptions {
+	return crosschaintypes.RevertOptions{
+		RevertAddress: memoStd.RevertOptions.RevertAddress,
+		AbortAddress:  memoStd.RevertOptions.AbortAddress,
+		RevertMessage: memoStd.RevertOptions.RevertMessage,
+	}
+}
+
+// BitcoinMemoVoteOptions returns the inbound vote options carried by the standard memo of a Bitcoin inbound
+func BitcoinMemoVoteOptions(memoStd *InboundMemo) []crosschaintypes.InboundVoteOption {
+	return []crosschaintypes.InboundVoteOption{
+		crosschaintypes.WithRevertOptions(BitcoinMemoRevertOptions(memoStd)),
+		crosschaintypes.WithDeadline(memoStd.Deadline),
+		crosschaintypes.WithReceiverSplits(StandardMemoReceiverSplits(memoStd)),
+	}
+}
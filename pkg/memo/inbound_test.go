@@ -0,0 +1,294 @@
+package memo_test
+
+import (
+	"errors"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/memo"
+	"github.com/zeta-chain/node/testutil/sample"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func Test_CarriesStandardMemo(t *testing.T) {
+	t.Run("cross-chain call without receiver carries a standard memo", func(t *testing.T) {
+		require.True(t, memo.CarriesStandardMemo(true, ethcommon.Address{}))
+	})
+
+	t.Run("cross-chain call with an explicit receiver does not carry a standard memo", func(t *testing.T) {
+		require.False(t, memo.CarriesStandardMemo(true, sample.EthAddress()))
+	})
+
+	t.Run("deposit does not carry a standard memo", func(t *testing.T) {
+		require.False(t, memo.CarriesStandardMemo(false, ethcommon.Address{}))
+	})
+}
+
+func Test_DecodeStandardMemo(t *testing.T) {
+	receiver := sample.EthAddress()
+	payload := []byte("some payload")
+
+	// encodeMemo is a helper function to encode a standard memo
+	encodeMemo := func(t *testing.T, m memo.InboundMemo) []byte {
+		data, err := m.EncodeToBytes()
+		require.NoError(t, err)
+		return data
+	}
+
+	// validator accepting only the revert address "valid"
+	validator := func(address string) error {
+		if address != "valid" {
+			return errors.New("invalid address")
+		}
+		return nil
+	}
+
+	tests := []struct {
+		name     string
+		payload  []byte
+		expected *memo.InboundMemo
+		errMsg   string
+	}{
+		{
+			name:     "not a standard memo",
+			payload:  payload,
+			expected: nil,
+		},
+		{
+			name: "standard memo version 1",
+			payload: encodeMemo(t, memo.InboundMemo{
+				Header: memo.Header{
+					Version:     1,
+					EncodingFmt: memo.EncodingFmtCompactShort,
+					OpCode:      memo.OpCodeDepositAndCall,
+				},
+				FieldsV0: memo.FieldsV0{
+					Receiver:      receiver,
+					Payload:       payload,
+					RevertOptions: crosschaintypes.RevertOptions{RevertAddress: "valid"},
+				},
+				FieldsV1: memo.FieldsV1{IsArbitraryCall: true},
+			}),
+			expected: &memo.InboundMemo{
+				Header: memo.Header{
+					Version:     1,
+					EncodingFmt: memo.EncodingFmtCompactShort,
+					OpCode:      memo.OpCodeDepositAndCall,
+					Reserved:    0b0001,
+					DataFlags:   0b00000111,
+				},
+				FieldsV0: memo.FieldsV0{
+					Receiver:      receiver,
+					Payload:       payload,
+					RevertOptions: crosschaintypes.RevertOptions{RevertAddress: "valid"},
+				},
+				FieldsV1: memo.FieldsV1{IsArbitraryCall: true},
+			},
+		},
+		{
+			name: "invalid revert address",
+			payload: encodeMemo(t, memo.InboundMemo{
+				Header: memo.Header{
+					EncodingFmt: memo.EncodingFmtCompactShort,
+					OpCode:      memo.OpCodeDepositAndCall,
+				},
+				FieldsV0: memo.FieldsV0{
+					Receiver:      receiver,
+					RevertOptions: crosschaintypes.RevertOptions{RevertAddress: "invalid"},
+				},
+			}),
+			errMsg: "invalid revert address in memo",
+		},
+		{
+			name:    "standard memo with improper data",
+			payload: []byte{memo.Identifier, 0x01, 0x10, 0x01}, // receiver flag set but no data
+			errMsg:  "standard memo contains improper data",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			memoStd, err := memo.DecodeStandardMemo(tt.payload, validator)
+			if tt.errMsg != "" {
+				require.ErrorContains(t, err, tt.errMsg)
+				require.Nil(t, memoStd)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, memoStd)
+		})
+	}
+}
+
+func Test_StandardMemoVoteOptions(t *testing.T) {
+	splitReceiver := sample.EthAddress()
+	memoStd := &memo.InboundMemo{
+		FieldsV0: memo.FieldsV0{
+			RevertOptions: crosschaintypes.RevertOptions{
+				RevertAddress: "revert",
+				CallOnRevert:  true,
+				AbortAddress:  "abort",
+				RevertMessage: []byte("message"),
+			},
+		},
+		FieldsV1: memo.FieldsV1{
+			Receivers: []memo.ReceiverSplit{{Receiver: splitReceiver, Amount: 400}},
+			Deadline:  1_700_000_000,
+		},
+	}
+
+	msg := crosschaintypes.MsgVoteInbound{Receiver: sample.EthAddress().Hex(), Amount: sdkmath.NewUint(1000)}
+	for _, option := range memo.StandardMemoVoteOptions(memoStd) {
+		option(&msg)
+	}
+
+	require.Equal(t, crosschaintypes.RevertOptions{
+		RevertAddress:  "revert",
+		CallOnRevert:   true,
+		AbortAddress:   "abort",
+		RevertMessage:  []byte("message"),
+		RevertGasLimit: sdkmath.ZeroUint(),
+	}, msg.RevertOptions)
+	require.EqualValues(t, 1_700_000_000, msg.Deadline)
+	require.Equal(t, []crosschaintypes.ReceiverSplit{
+		{Receiver: splitReceiver.Hex(), Amount: sdkmath.NewUint(400)},
+	}, msg.ReceiverSplits)
+}
+
+func Test_StandardMemoReceiverSplits(t *testing.T) {
+	t.Run("no receiver splits", func(t *testing.T) {
+		require.Empty(t, memo.StandardMemoReceiverSplits(&memo.InboundMemo{}))
+	})
+
+	t.Run("receiver splits", func(t *testing.T) {
+		receiver1, receiver2 := sample.EthAddress(), sample.EthAddress()
+		memoStd := &memo.InboundMemo{
+			FieldsV1: memo.FieldsV1{
+				Receivers: []memo.ReceiverSplit{
+					{Receiver: receiver1, Amount: 1},
+					{Receiver: receiver2, Amount: 2},
+				},
+			},
+		}
+
+		require.Equal(t, []crosschaintypes.ReceiverSplit{
+			{Receiver: receiver1.Hex(), Amount: sdkmath.NewUint(1)},
+			{Receiver: receiver2.Hex(), Amount: sdkmath.NewUint(2)},
+		}, memo.StandardMemoReceiverSplits(memoStd))
+	})
+}
+
+func Test_BitcoinMemoVoteOptions(t *testing.T) {
+	memoStd := &memo.InboundMemo{
+		FieldsV0: memo.FieldsV0{
+			RevertOptions: crosschaintypes.RevertOptions{
+				RevertAddress: "revert",
+				CallOnRevert:  true,
+				AbortAddress:  "abort",
+				RevertMessage: []byte("message"),
+			},
+		},
+		FieldsV1: memo.FieldsV1{Deadline: 1_700_000_000},
+	}
+
+	msg := crosschaintypes.MsgVoteInbound{}
+	for _, option := range memo.BitcoinMemoVoteOptions(memoStd) {
+		option(&msg)
+	}
+
+	// 'CallOnRevert' is dropped for bitcoin inbound
+	require.Equal(t, crosschaintypes.RevertOptions{
+		RevertAddress: "revert",
+		AbortAddress:  "abort",
+		RevertMessage: []byte("message"),
+	}, msg.RevertOptions)
+	require.EqualValues(t, 1_700_000_000, msg.Deadline)
+}
+
+func Test_DecodeBitcoinMemo(t *testing.T) {
+	chainID := chains.BitcoinMainnet.ChainId
+	receiver := sample.EthAddress()
+	payload := []byte("some payload")
+
+	t.Run("decode standard memo", func(t *testing.T) {
+		data, err := (&memo.InboundMemo{
+			Header: memo.Header{
+				EncodingFmt: memo.EncodingFmtCompactShort,
+				OpCode:      memo.OpCodeDepositAndCall,
+			},
+			FieldsV0: memo.FieldsV0{
+				Receiver: receiver,
+				Payload:  payload,
+				RevertOptions: crosschaintypes.RevertOptions{
+					RevertAddress: "bc1qm24wp577nk8aacckv8np465z3dvmu7ry45el6y",
+				},
+			},
+		}).EncodeToBytes()
+		require.NoError(t, err)
+
+		memoStd, gotReceiver, gotPayload, err := memo.DecodeBitcoinMemo(data, chainID)
+		require.NoError(t, err)
+		require.NotNil(t, memoStd)
+		require.Equal(t, receiver, gotReceiver)
+		require.Equal(t, payload, gotPayload)
+	})
+
+	t.Run("fallback to legacy memo", func(t *testing.T) {
+		data := append(receiver.Bytes(), payload...)
+
+		memoStd, gotReceiver, gotPayload, err := memo.DecodeBitcoinMemo(data, chainID)
+		require.NoError(t, err)
+		require.Nil(t, memoStd)
+		require.Equal(t, receiver, gotReceiver)
+		require.Equal(t, payload, gotPayload)
+	})
+
+	t.Run("invalid revert address in standard memo", func(t *testing.T) {
+		data, err := (&memo.InboundMemo{
+			Header: memo.Header{
+				EncodingFmt: memo.EncodingFmtCompactShort,
+				OpCode:      memo.OpCodeDeposit,
+			},
+			FieldsV0: memo.FieldsV0{
+				Receiver:      receiver,
+				RevertOptions: crosschaintypes.RevertOptions{RevertAddress: "invalid"},
+			},
+		}).EncodeToBytes()
+		require.NoError(t, err)
+
+		_, _, _, err = memo.DecodeBitcoinMemo(data, chainID)
+		require.ErrorContains(t, err, "invalid standard memo for bitcoin")
+	})
+
+	t.Run("legacy memo too short", func(t *testing.T) {
+		_, _, _, err := memo.DecodeBitcoinMemo([]byte("short"), chainID)
+		require.ErrorContains(t, err, "legacy memo length must be at least 20 bytes")
+	})
+
+	t.Run("empty receiver in legacy memo", func(t *testing.T) {
+		_, _, _, err := memo.DecodeBitcoinMemo(make([]byte, 20), chainID)
+		require.ErrorContains(t, err, "got empty receiver address from memo")
+	})
+}
+
+func Test_RevertAddressValidator(t *testing.T) {
+	t.Run("no validator for EVM chains", func(t *testing.T) {
+		require.Nil(t, memo.RevertAddressValidator(chains.Ethereum))
+	})
+
+	t.Run("validate Bitcoin revert address", func(t *testing.T) {
+		validator := memo.RevertAddressValidator(chains.BitcoinMainnet)
+		require.NoError(t, validator("bc1qm24wp577nk8aacckv8np465z3dvmu7ry45el6y"))
+		require.Error(t, validator(sample.EthAddress().Hex()))
+	})
+
+	t.Run("validate Solana revert address", func(t *testing.T) {
+		validator := memo.RevertAddressValidator(chains.SolanaMainnet)
+		require.NoError(t, validator(sample.SolanaAddress(t)))
+		require.Error(t, validator(sample.EthAddress().Hex()))
+	})
+}
@@ -6,11 +6,16 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+
+	zetabits "github.com/zeta-chain/node/pkg/math/bits"
 )
 
 const (
-	// version0 is the latest version of the memo
+	// version0 is the initial version of the memo
 	version0 uint8 = 0
+
+	// version1 is the latest version of the memo
+	version1 uint8 = 1
 )
 
 // InboundMemo represents the inbound memo structure for non-EVM chains
@@ -18,9 +23,12 @@ type InboundMemo struct {
 	// Header contains the memo header
 	Header
 
-	// FieldsV0 contains the memo fields V0
-	// Note: add a FieldsV1 if breaking change is needed in the future
+	// FieldsV0 contains the memo fields V0, which are shared by all versions
 	FieldsV0
+
+	// FieldsV1 contains the memo fields added in V1
+	// Note: these fields are not allowed in a V0 memo
+	FieldsV1
 }
 
 // EncodeToBytes encodes a InboundMemo struct to raw bytes
@@ -31,6 +39,15 @@ type InboundMemo struct {
 func (m *InboundMemo) EncodeToBytes() ([]byte, error) {
 	// build fields flags
 	dataFlags := m.FieldsV0.DataFlags()
+	if m.Version == version1 {
+		dataFlags |= m.FieldsV1.DataFlags()
+
+		// the 'arbitrary call' flag is carried by the control bits
+		m.Header.Reserved = 0
+		if m.IsArbitraryCall {
+			zetabits.SetBit(&m.Header.Reserved, ctrlBitPosArbitraryCall)
+		}
+	}
 	m.Header.DataFlags = dataFlags
 
 	// encode head
@@ -43,7 +60,12 @@ func (m *InboundMemo) EncodeToBytes() ([]byte, error) {
 	var data []byte
 	switch m.Version {
 	case version0:
+		if !m.FieldsV1.IsEmpty() {
+			return nil, errors.New("fields V1 are not allowed in memo version 0")
+		}
 		data, err = m.FieldsV0.Pack(m.OpCode, m.EncodingFmt, dataFlags)
+	case version1:
+		data, err = m.FieldsV1.Pack(&m.FieldsV0, m.OpCode, m.EncodingFmt, dataFlags)
 	default:
 		return nil, fmt.Errorf("invalid memo version: %d", m.Version)
 	}
@@ -88,6 +110,21 @@ func DecodeFromBytes(data []byte) (*InboundMemo, bool, error) {
 		if err != nil {
 			return nil, true, errors.Wrap(err, "failed to validate memo FieldsV0")
 		}
+	case version1:
+		// the 'arbitrary call' flag is carried by the control bits
+		memo.IsArbitraryCall = zetabits.IsBitSet(memo.Header.Reserved, ctrlBitPosArbitraryCall)
+
+		// unpack fields
+		err = memo.FieldsV1.Unpack(&memo.FieldsV0, memo.EncodingFmt, memo.Header.DataFlags, data[HeaderSize:])
+		if err != nil {
+			return nil, true, errors.Wrap(err, "failed to unpack memo FieldsV1")
+		}
+
+		// validate fields
+		err = memo.FieldsV1.Validate(&memo.FieldsV0, memo.OpCode, memo.Header.DataFlags)
+		if err != nil {
+			return nil, true, errors.Wrap(err, "failed to validate memo FieldsV1")
+		}
 	default:
 		// unreachable code
 		// version is validated when decoding the header
@@ -16,6 +16,7 @@ import (
 func Test_Memo_EncodeToBytes(t *testing.T) {
 	// create sample fields
 	fAddress := common.HexToAddress("0xEA9808f0Ac504d1F521B5BbdfC33e6f1953757a7")
+	fSplitAddress := common.HexToAddress("0x5EB0C6B6F1A1A1A1F7AF3C0E7DDEF1A0BA7C5E2E")
 	fBytes := []byte("here_s_some_bytes_field")
 	fString := "this_is_a_string_field"
 
@@ -93,6 +94,85 @@ func Test_Memo_EncodeToBytes(t *testing.T) {
 				memo.ArgAbortAddress(fAddress),
 				memo.ArgRevertMessage(fBytes)),
 		},
+		{
+			name: "encode memo version 1 with ABI encoding",
+			memo: &memo.InboundMemo{
+				Header: memo.Header{
+					Version:     1,
+					EncodingFmt: memo.EncodingFmtABI,
+					OpCode:      memo.OpCodeDepositAndCall,
+				},
+				FieldsV0: memo.FieldsV0{
+					Receiver: fAddress,
+					Payload:  fBytes,
+				},
+				FieldsV1: memo.FieldsV1{
+					Receivers:       []memo.ReceiverSplit{{Receiver: fSplitAddress, Amount: 1000}},
+					IsArbitraryCall: true,
+					Deadline:        1735689600,
+				},
+			},
+			expectedHead: MakeHead(
+				1,
+				uint8(memo.EncodingFmtABI),
+				uint8(memo.OpCodeDepositAndCall),
+				0b0001,     // arbitrary call control bit is set
+				0b11000011, // receiver, payload, receivers and deadline are set
+			),
+			expectedData: ABIPack(t,
+				memo.ArgReceiver(fAddress),
+				memo.ArgPayload(fBytes),
+				memo.ArgReceivers(ReceiversPack(memo.ReceiverSplit{Receiver: fSplitAddress, Amount: 1000})),
+				memo.ArgDeadline(uint64(1735689600))),
+		},
+		{
+			name: "encode memo version 1 with compact encoding",
+			memo: &memo.InboundMemo{
+				Header: memo.Header{
+					Version:     1,
+					EncodingFmt: memo.EncodingFmtCompactLong,
+					OpCode:      memo.OpCodeDeposit,
+				},
+				FieldsV0: memo.FieldsV0{
+					Receiver: fAddress,
+					RevertOptions: crosschaintypes.RevertOptions{
+						AbortAddress: fAddress.String(),
+					},
+				},
+				FieldsV1: memo.FieldsV1{
+					Deadline: 1735689600,
+				},
+			},
+			expectedHead: MakeHead(
+				1,
+				uint8(memo.EncodingFmtCompactLong),
+				uint8(memo.OpCodeDeposit),
+				0,
+				0b10001001, // receiver, abort address and deadline are set
+			),
+			expectedData: CompactPack(
+				memo.EncodingFmtCompactLong,
+				memo.ArgReceiver(fAddress),
+				memo.ArgAbortAddress(fAddress),
+				memo.ArgDeadline(uint64(1735689600))),
+		},
+		{
+			name: "failed to encode fields V1 in memo version 0",
+			memo: &memo.InboundMemo{
+				Header: memo.Header{
+					Version:     0,
+					EncodingFmt: memo.EncodingFmtABI,
+					OpCode:      memo.OpCodeDeposit,
+				},
+				FieldsV0: memo.FieldsV0{
+					Receiver: fAddress,
+				},
+				FieldsV1: memo.FieldsV1{
+					Deadline: 1735689600,
+				},
+			},
+			errMsg: "fields V1 are not allowed in memo version 0",
+		},
 		{
 			name: "failed to encode memo header",
 			memo: &memo.InboundMemo{
@@ -106,7 +186,7 @@ func Test_Memo_EncodeToBytes(t *testing.T) {
 			name: "failed to encode if version is invalid",
 			memo: &memo.InboundMemo{
 				Header: memo.Header{
-					Version: 1,
+					Version: 2,
 				},
 			},
 			errMsg: "invalid memo version",
@@ -151,6 +231,7 @@ func Test_Memo_EncodeToBytes(t *testing.T) {
 func Test_Memo_DecodeFromBytes(t *testing.T) {
 	// create sample fields
 	fAddress := common.HexToAddress("0xEA9808f0Ac504d1F521B5BbdfC33e6f1953757a7")
+	fSplitAddress := common.HexToAddress("0x5EB0C6B6F1A1A1A1F7AF3C0E7DDEF1A0BA7C5E2E")
 	fBytes := []byte("here_s_some_bytes_field")
 	fString := "this_is_a_string_field"
 
@@ -233,6 +314,55 @@ func Test_Memo_DecodeFromBytes(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "decode memo version 1 with compact encoding",
+			head: MakeHead(
+				1,
+				uint8(memo.EncodingFmtCompactShort),
+				uint8(memo.OpCodeCall),
+				0b0001,     // arbitrary call control bit is set
+				0b10000011, // receiver, payload and deadline are set
+			),
+			data: CompactPack(
+				memo.EncodingFmtCompactShort,
+				memo.ArgReceiver(fAddress),
+				memo.ArgPayload(fBytes),
+				memo.ArgDeadline(uint64(1735689600))),
+			isStdMemo: true,
+			expectedMemo: memo.InboundMemo{
+				Header: memo.Header{
+					Version:     1,
+					EncodingFmt: memo.EncodingFmtCompactShort,
+					OpCode:      memo.OpCodeCall,
+					Reserved:    0b0001,
+					DataFlags:   0b10000011,
+				},
+				FieldsV0: memo.FieldsV0{
+					Receiver: fAddress,
+					Payload:  fBytes,
+				},
+				FieldsV1: memo.FieldsV1{
+					IsArbitraryCall: true,
+					Deadline:        1735689600,
+				},
+			},
+		},
+		{
+			name: "standard memo version 1, failed to validate fields",
+			head: MakeHead(
+				1,
+				uint8(memo.EncodingFmtCompactShort),
+				uint8(memo.OpCodeCall),
+				0,
+				0b01000001, // receiver and receivers are set
+			),
+			data: CompactPack(
+				memo.EncodingFmtCompactShort,
+				memo.ArgReceiver(fAddress),
+				memo.ArgReceivers(ReceiversPack(memo.ReceiverSplit{Receiver: fSplitAddress, Amount: 1000}))),
+			isStdMemo: true, // receivers are not allowed for call operation
+			errMsg:    "failed to validate memo FieldsV1",
+		},
 		{
 			name:      "not a standard memo, failed to decode memo header",
 			head:      MakeHead(0, uint8(memo.EncodingFmtABI), uint8(memo.OpCodeInvalid), 0, 0),
@@ -18,6 +18,7 @@ import (
 	"github.com/zeta-chain/node/rpc/namespaces/ethereum/personal"
 	"github.com/zeta-chain/node/rpc/namespaces/ethereum/txpool"
 	"github.com/zeta-chain/node/rpc/namespaces/ethereum/web3"
+	"github.com/zeta-chain/node/rpc/namespaces/zeta"
 )
 
 // RPC namespaces and API version
@@ -36,6 +37,10 @@ const (
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
 
+	// ZetaChain namespaces
+
+	ZetaNamespace = "zeta"
+
 	apiVersion = "1.0"
 )
 
@@ -159,6 +164,22 @@ func init() {
 				},
 			}
 		},
+		ZetaNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: ZetaNamespace,
+					Version:   apiVersion,
+					Service:   zeta.NewPublicAPI(ctx.Logger, clientCtx, evmBackend),
+					Public:    true,
+				},
+			}
+		},
 	}
 }
 
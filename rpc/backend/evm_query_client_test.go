@@ -64,6 +64,27 @@ func RegisterTraceTransactionError(queryClient *mocks.EVMQueryClient, msgEthTx *
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// RegisterTraceCall registers a trace of an unsigned call, the request is checked with the given matcher
+func RegisterTraceCall(
+	queryClient *mocks.EVMQueryClient,
+	matcher func(req *evmtypes.QueryTraceTxRequest) bool,
+	data []byte,
+) {
+	queryClient.On("TraceTx", rpc.ContextWithHeight(1), mock.MatchedBy(matcher)).
+		Return(&evmtypes.QueryTraceTxResponse{Data: data}, nil).Once()
+}
+
+// RegisterTraceSimulatedBlock registers a trace of the unsigned calls of a simulated block,
+// the request is checked with the given matcher
+func RegisterTraceSimulatedBlock(
+	queryClient *mocks.EVMQueryClient,
+	matcher func(req *evmtypes.QueryTraceBlockRequest) bool,
+	data []byte,
+) {
+	queryClient.On("TraceBlock", rpc.ContextWithHeight(1), mock.MatchedBy(matcher)).
+		Return(&evmtypes.QueryTraceBlockResponse{Data: data}, nil).Once()
+}
+
 // TraceBlock
 func RegisterTraceBlock(
 	queryClient *mocks.EVMQueryClient,
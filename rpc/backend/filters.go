@@ -4,6 +4,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+
+	rpctypes "github.com/zeta-chain/node/rpc/types"
 )
 
 // GetLogs returns all the logs from all the ethereum transactions in a block.
@@ -32,5 +34,17 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
 // by the chain indexer.
 func (b *Backend) BloomStatus() (uint64, uint64) {
+	if logIndexer := b.LogIndexer(); logIndexer != nil {
+		return logIndexer.BloomStatus()
+	}
 	return 4096, 0
 }
+
+// LogIndexer returns the EVM indexer if it indexes the logs of the blocks, nil otherwise
+func (b *Backend) LogIndexer() rpctypes.LogIndexer {
+	logIndexer, ok := b.Indexer.(rpctypes.LogIndexer)
+	if !ok {
+		return nil
+	}
+	return logIndexer
+}
@@ -0,0 +1,364 @@
+package backend
+
+import (
+	"encoding/json"
+	"math/big"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+
+	rpctypes "github.com/zeta-chain/node/rpc/types"
+)
+
+const (
+	// maxSimulateCalls is the maximum number of calls simulated by eth_simulateV1
+	// the calls of each simulated block are traced after replaying all the calls of the previous blocks
+	maxSimulateCalls = 100
+
+	// simulateBlockTimeIncrement is the default time increment in seconds between simulated blocks
+	simulateBlockTimeIncrement = 1
+
+	// simulateCallTracerConfig is the config of the call tracer used to get the result of simulated calls
+	simulateCallTracerConfig = `{"withLog":true}`
+
+	// simulateErrCodeReverted is the error code of a simulated call that reverted
+	simulateErrCodeReverted = 3
+
+	// simulateErrCodeVMError is the error code of a simulated call that failed with a VM error
+	simulateErrCodeVMError = -32015
+)
+
+var (
+	// transferAddress is the address of the logs added for the native value transfers when traceTransfers is set
+	transferAddress = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+
+	// transferTopic is the topic of the logs added for the native value transfers, it is the ERC20 Transfer event
+	transferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+)
+
+// callFrame is the result of the call tracer for a call and its subcalls
+type callFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to,omitempty"`
+	Value   *hexutil.Big    `json:"value,omitempty"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Output  hexutil.Bytes   `json:"output,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Revert  string          `json:"revertReason,omitempty"`
+	Logs    []callLog       `json:"logs,omitempty"`
+	Calls   []callFrame     `json:"calls,omitempty"`
+}
+
+// callLog is a log emitted in a call frame, position is the number of subcalls made before the log
+type callLog struct {
+	Address  common.Address `json:"address"`
+	Topics   []common.Hash  `json:"topics"`
+	Data     hexutil.Bytes  `json:"data"`
+	Position hexutil.Uint   `json:"position"`
+}
+
+// SimulateV1 simulates a series of calls in a series of blocks on top of the state of the given block,
+// each call is executed on top of the state changes of the previous calls.
+// The calls of a simulated block are traced with the block trace query after the calls of the previous blocks,
+// which are replayed with the time and gas limit of the simulated block.
+// State overrides and validation mode are not supported, the EVM queries only execute calls on top of the committed state.
+func (b *Backend) SimulateV1(
+	opts rpctypes.SimOpts,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+) ([]*rpctypes.SimBlockResult, error) {
+	if len(opts.BlockStateCalls) == 0 {
+		return nil, errors.New("empty input")
+	}
+	if opts.Validation {
+		return nil, errors.New("validation mode is not supported")
+	}
+	if err := checkSimulateCalls(opts.BlockStateCalls); err != nil {
+		return nil, err
+	}
+
+	blk, err := b.blockForCall(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	blockMaxGas, err := b.blockMaxGas(blk.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	var baseFee *hexutil.Big
+	if blockRes, err := b.TendermintBlockResultByNumber(&blk.Block.Height); err == nil {
+		if fee, err := b.BaseFee(blockRes); err == nil && fee != nil {
+			baseFee = (*hexutil.Big)(fee)
+		}
+	}
+
+	var (
+		results   = make([]*rpctypes.SimBlockResult, 0, len(opts.BlockStateCalls))
+		executed  = make([]*evmtypes.MsgEthereumTx, 0, maxSimulateCalls)
+		sent      = make(map[common.Address]uint64)
+		timestamp = uint64(blk.Block.Time.Unix()) // #nosec G115 -- timestamp in range
+	)
+	for i, simBlock := range opts.BlockStateCalls {
+		number := uint64(blk.Block.Height) + uint64(i) + 1 // #nosec G115 -- height in range
+		timestamp += simulateBlockTimeIncrement
+
+		// the time and gas limit of the simulated block are applied to the traced calls
+		overrides := rpctypes.BlockOverrides{}
+		if simBlock.BlockOverrides != nil {
+			overrides = *simBlock.BlockOverrides
+		}
+		if overrides.Number != nil {
+			if overrides.Number.ToInt().Uint64() != number {
+				return nil, errors.Errorf(
+					"block number override %d is not supported, simulated block number is %d",
+					overrides.Number.ToInt().Uint64(),
+					number,
+				)
+			}
+			overrides.Number = nil
+		}
+		if overrides.Time != nil {
+			timestamp = uint64(*overrides.Time)
+		}
+		overrides.Time = (*hexutil.Uint64)(&timestamp)
+
+		gasLimit := uint64(blockMaxGas) // #nosec G115 -- max gas in range
+		if overrides.GasLimit != nil {
+			gasLimit = uint64(*overrides.GasLimit)
+		}
+
+		result := &rpctypes.SimBlockResult{
+			Number:        hexutil.Uint64(number),
+			Timestamp:     hexutil.Uint64(timestamp),
+			GasLimit:      hexutil.Uint64(gasLimit),
+			BaseFeePerGas: baseFee,
+			Transactions:  make([]interface{}, 0, len(simBlock.Calls)),
+			Calls:         make([]rpctypes.SimCallResult, 0, len(simBlock.Calls)),
+		}
+
+		// the nonce of each call follows the calls of the same sender already simulated
+		msgs := make([]*evmtypes.MsgEthereumTx, 0, len(simBlock.Calls))
+		for _, call := range simBlock.Calls {
+			from := call.GetFrom()
+			ethMsg, err := b.callArgsToMsg(call, blk.Block.Height, sent[from])
+			if err != nil {
+				return nil, err
+			}
+			sent[from]++
+			msgs = append(msgs, ethMsg)
+		}
+
+		frames, err := b.traceSimulatedBlock(blk, executed, msgs, &overrides)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, frame := range frames {
+			callResult := frame.simCallResult(opts.TraceTransfers)
+			result.GasUsed += callResult.GasUsed
+			result.Calls = append(result.Calls, callResult)
+
+			tx, err := simulatedTransaction(msgs[i], opts.ReturnFullTransactions, baseFee, b.EvmChainID)
+			if err != nil {
+				return nil, err
+			}
+			result.Transactions = append(result.Transactions, tx)
+		}
+
+		executed = append(executed, msgs...)
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// checkSimulateCalls checks the simulated calls can be executed with the trace query
+func checkSimulateCalls(blocks []rpctypes.SimBlock) error {
+	count := 0
+	for _, block := range blocks {
+		if !block.StateOverrides.IsEmpty() {
+			return errStateOverridesNotSupported
+		}
+
+		count += len(block.Calls)
+		if count > maxSimulateCalls {
+			return errors.Errorf("too many calls, maximum is %d", maxSimulateCalls)
+		}
+	}
+	return nil
+}
+
+// traceSimulatedBlock traces the calls of a simulated block with the call tracer,
+// the calls of the previous simulated blocks are executed first and their traces are discarded
+func (b *Backend) traceSimulatedBlock(
+	blk *tmrpctypes.ResultBlock,
+	executed []*evmtypes.MsgEthereumTx,
+	msgs []*evmtypes.MsgEthereumTx,
+	overrides *rpctypes.BlockOverrides,
+) ([]callFrame, error) {
+	if len(msgs) == 0 {
+		return []callFrame{}, nil
+	}
+
+	// the block trace query executes each unsigned call with its own sender and commits its state changes
+	txs := make([]*evmtypes.MsgEthereumTx, 0, len(executed)+len(msgs))
+	txs = append(txs, executed...)
+	txs = append(txs, msgs...)
+
+	traceTxRequest, err := b.traceCallRequest(blk, msgs[0], nil, overrides)
+	if err != nil {
+		return nil, err
+	}
+	traceBlockRequest := &evmtypes.QueryTraceBlockRequest{
+		Txs: txs,
+		TraceConfig: &evmtypes.TraceConfig{
+			Tracer:           "callTracer",
+			TracerJsonConfig: simulateCallTracerConfig,
+		},
+		BlockNumber:     traceTxRequest.BlockNumber,
+		BlockTime:       traceTxRequest.BlockTime,
+		BlockHash:       traceTxRequest.BlockHash,
+		ProposerAddress: traceTxRequest.ProposerAddress,
+		ChainId:         traceTxRequest.ChainId,
+		BlockMaxGas:     traceTxRequest.BlockMaxGas,
+	}
+
+	traceResult, err := b.QueryClient.TraceBlock(rpctypes.ContextWithHeight(blk.Block.Height), traceBlockRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []struct {
+		Result json.RawMessage `json:"result"`
+		Error  string          `json:"error"`
+	}
+	if err := json.Unmarshal(traceResult.Data, &results); err != nil {
+		return nil, errors.Wrap(err, "failed to decode block trace")
+	}
+	if len(results) != len(txs) {
+		return nil, errors.Errorf("expected %d call traces, got %d", len(txs), len(results))
+	}
+
+	frames := make([]callFrame, 0, len(msgs))
+	for i, res := range results[len(executed):] {
+		if res.Error != "" {
+			return nil, errors.Errorf("failed to simulate call %d: %s", i, res.Error)
+		}
+
+		var frame callFrame
+		if err := json.Unmarshal(res.Result, &frame); err != nil {
+			return nil, errors.Wrap(err, "failed to decode call trace")
+		}
+		frames = append(frames, frame)
+	}
+	return frames, nil
+}
+
+// simulatedTransaction returns the hash of the simulated call, or the call as a transaction if fullTx is set
+func simulatedTransaction(
+	msg *evmtypes.MsgEthereumTx,
+	fullTx bool,
+	baseFee *hexutil.Big,
+	chainID *big.Int,
+) (interface{}, error) {
+	if !fullTx {
+		return msg.AsTransaction().Hash(), nil
+	}
+
+	var fee *big.Int
+	if baseFee != nil {
+		fee = baseFee.ToInt()
+	}
+	return rpctypes.NewRPCTransaction(msg, common.Hash{}, 0, 0, fee, chainID)
+}
+
+// simCallResult returns the result of the simulated call from its call frame
+func (f *callFrame) simCallResult(traceTransfers bool) rpctypes.SimCallResult {
+	result := rpctypes.SimCallResult{
+		ReturnValue: f.Output,
+		Logs:        f.flattenLogs(traceTransfers, nil),
+		GasUsed:     f.GasUsed,
+		Status:      hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+	}
+	if result.ReturnValue == nil {
+		result.ReturnValue = hexutil.Bytes{}
+	}
+
+	if f.Error != "" {
+		result.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+		result.Logs = []*ethtypes.Log{}
+		result.Error = &rpctypes.SimCallError{
+			Code:    simulateErrCodeVMError,
+			Message: f.Error,
+		}
+		if f.Error == "execution reverted" {
+			result.Error.Code = simulateErrCodeReverted
+			result.Error.Data = f.Output.String()
+			if f.Revert != "" {
+				result.Error.Message = f.Error + ": " + f.Revert
+			}
+		}
+	}
+
+	return result
+}
+
+// flattenLogs returns the logs of the call frame and its subcalls in the order of execution
+// if traceTransfers is set, a Transfer log is added for the native value transferred by each call
+func (f *callFrame) flattenLogs(traceTransfers bool, logs []*ethtypes.Log) []*ethtypes.Log {
+	if logs == nil {
+		logs = []*ethtypes.Log{}
+	}
+
+	// the logs of a failed call are reverted
+	if f.Error != "" {
+		return logs
+	}
+
+	if traceTransfers && f.Value != nil && f.Value.ToInt().Sign() > 0 && f.Type != "DELEGATECALL" {
+		to := common.Address{}
+		if f.To != nil {
+			to = *f.To
+		}
+		logs = append(logs, &ethtypes.Log{
+			Address: transferAddress,
+			Topics: []common.Hash{
+				transferTopic,
+				common.BytesToHash(f.From.Bytes()),
+				common.BytesToHash(to.Bytes()),
+			},
+			Data: common.BigToHash(f.Value.ToInt()).Bytes(),
+		})
+	}
+
+	// the logs emitted before a subcall have a position lower or equal to the index of the subcall
+	logIndex := 0
+	for i := range f.Calls {
+		for logIndex < len(f.Logs) && int(f.Logs[logIndex].Position) <= i {
+			logs = append(logs, f.Logs[logIndex].toEthLog())
+			logIndex++
+		}
+		logs = f.Calls[i].flattenLogs(traceTransfers, logs)
+	}
+	for ; logIndex < len(f.Logs); logIndex++ {
+		logs = append(logs, f.Logs[logIndex].toEthLog())
+	}
+
+	return logs
+}
+
+// toEthLog converts the log of a call frame to an ethereum log
+func (l callLog) toEthLog() *ethtypes.Log {
+	return &ethtypes.Log{
+		Address: l.Address,
+		Topics:  l.Topics,
+		Data:    l.Data,
+	}
+}
@@ -0,0 +1,286 @@
+package backend
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/zeta-chain/node/rpc/backend/mocks"
+	rpctypes "github.com/zeta-chain/node/rpc/types"
+)
+
+func (s *TestSuite) TestSimulateV1() {
+	var (
+		nonce    = hexutil.Uint64(1)
+		to       = common.HexToAddress("0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7")
+		other    = common.HexToAddress("0x0a7C2B3e7f4eC0fE4e6b7F5AAB1b0f9C4aF2e1b3")
+		blockNum = rpctypes.BlockNumber(1)
+		call     = evmtypes.TransactionArgs{From: &s.from, To: &to, Nonce: &nonce}
+	)
+
+	successTrace := []byte(`{
+		"type": "CALL",
+		"from": "` + s.from.Hex() + `",
+		"to": "` + to.Hex() + `",
+		"value": "0x1",
+		"gasUsed": "0x5208",
+		"output": "0x01",
+		"logs": [{"address": "` + to.Hex() + `", "topics": [], "data": "0x01", "position": "0x0"}]
+	}`)
+	revertTrace := []byte(`{
+		"type": "CALL",
+		"from": "` + s.from.Hex() + `",
+		"to": "` + to.Hex() + `",
+		"gasUsed": "0x6000",
+		"output": "0x02",
+		"error": "execution reverted",
+		"revertReason": "failed"
+	}`)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		opts         rpctypes.SimOpts
+		expErr       string
+		expResult    func(res []*rpctypes.SimBlockResult)
+	}{
+		{
+			name:         "fail - empty input",
+			registerMock: func() {},
+			opts:         rpctypes.SimOpts{},
+			expErr:       "empty input",
+		},
+		{
+			name:         "fail - validation mode is not supported",
+			registerMock: func() {},
+			opts: rpctypes.SimOpts{
+				BlockStateCalls: []rpctypes.SimBlock{{Calls: []evmtypes.TransactionArgs{call}}},
+				Validation:      true,
+			},
+			expErr: "validation mode is not supported",
+		},
+		{
+			name:         "fail - state overrides are not supported",
+			registerMock: func() {},
+			opts: rpctypes.SimOpts{
+				BlockStateCalls: []rpctypes.SimBlock{{
+					StateOverrides: &rpctypes.StateOverride{to: rpctypes.OverrideAccount{}},
+					Calls:          []evmtypes.TransactionArgs{call},
+				}},
+			},
+			expErr: "state overrides are not supported",
+		},
+		{
+			name:         "fail - too many calls",
+			registerMock: func() {},
+			opts: rpctypes.SimOpts{
+				BlockStateCalls: []rpctypes.SimBlock{
+					{Calls: make([]evmtypes.TransactionArgs, maxSimulateCalls+1)},
+				},
+			},
+			expErr: "too many calls",
+		},
+		{
+			name: "fail - block number override not matching the simulated block",
+			registerMock: func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, nil)
+				s.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				_, err = RegisterBlockResults(client, 1)
+				s.Require().NoError(err)
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBaseFee(queryClient, sdkmath.NewInt(1000000000))
+			},
+			opts: rpctypes.SimOpts{
+				BlockStateCalls: []rpctypes.SimBlock{{
+					BlockOverrides: &rpctypes.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(5))},
+					Calls:          []evmtypes.TransactionArgs{call},
+				}},
+			},
+			expErr: "block number override 5 is not supported",
+		},
+		{
+			name: "pass - calls simulated in consecutive blocks",
+			registerMock: func() {
+				var (
+					queryClient = s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+					client      = s.backend.ClientCtx.Client.(*mocks.Client)
+				)
+				_, err := RegisterBlock(client, 1, nil)
+				s.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				_, err = RegisterBlockResults(client, 1)
+				s.Require().NoError(err)
+				RegisterBaseFee(queryClient, sdkmath.NewInt(1000000000))
+
+				// the calls of the second block are traced after the call of the first one
+				RegisterTraceSimulatedBlock(queryClient, func(req *evmtypes.QueryTraceBlockRequest) bool {
+					return len(req.Txs) == 1 && req.BlockNumber == 2
+				}, blockTrace(successTrace))
+				RegisterTraceSimulatedBlock(queryClient, func(req *evmtypes.QueryTraceBlockRequest) bool {
+					return len(req.Txs) == 2 && req.BlockNumber == 2
+				}, blockTrace(successTrace, revertTrace))
+			},
+			opts: rpctypes.SimOpts{
+				BlockStateCalls: []rpctypes.SimBlock{
+					{Calls: []evmtypes.TransactionArgs{call}},
+					{
+						BlockOverrides: &rpctypes.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(3))},
+						Calls:          []evmtypes.TransactionArgs{call},
+					},
+				},
+				TraceTransfers: true,
+			},
+			expResult: func(res []*rpctypes.SimBlockResult) {
+				s.Require().Len(res, 2)
+
+				s.Require().EqualValues(2, res[0].Number)
+				s.Require().EqualValues(0x5208, res[0].GasUsed)
+				s.Require().EqualValues(1000000000, res[0].BaseFeePerGas.ToInt().Int64())
+				s.Require().Len(res[0].Calls, 1)
+				s.Require().Len(res[0].Transactions, 1)
+				s.Require().IsType(common.Hash{}, res[0].Transactions[0])
+				s.Require().EqualValues(ethtypes.ReceiptStatusSuccessful, res[0].Calls[0].Status)
+				s.Require().Equal(hexutil.Bytes{0x01}, res[0].Calls[0].ReturnValue)
+				s.Require().Nil(res[0].Calls[0].Error)
+				s.Require().Len(res[0].Calls[0].Logs, 2)
+				s.Require().Equal(transferAddress, res[0].Calls[0].Logs[0].Address)
+				s.Require().Equal(to, res[0].Calls[0].Logs[1].Address)
+
+				s.Require().EqualValues(3, res[1].Number)
+				s.Require().EqualValues(res[0].Timestamp+1, res[1].Timestamp)
+				s.Require().Len(res[1].Calls, 1)
+				s.Require().EqualValues(ethtypes.ReceiptStatusFailed, res[1].Calls[0].Status)
+				s.Require().Empty(res[1].Calls[0].Logs)
+				s.Require().Equal(&rpctypes.SimCallError{
+					Code:    simulateErrCodeReverted,
+					Message: "execution reverted: failed",
+					Data:    "0x02",
+				}, res[1].Calls[0].Error)
+			},
+		},
+		{
+			name: "pass - calls from different senders with full transactions",
+			registerMock: func() {
+				var (
+					queryClient = s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+					client      = s.backend.ClientCtx.Client.(*mocks.Client)
+				)
+				_, err := RegisterBlock(client, 1, nil)
+				s.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				_, err = RegisterBlockResults(client, 1)
+				s.Require().NoError(err)
+				RegisterBaseFee(queryClient, sdkmath.NewInt(1000000000))
+
+				// each call is executed with its own sender
+				RegisterTraceSimulatedBlock(queryClient, func(req *evmtypes.QueryTraceBlockRequest) bool {
+					return len(req.Txs) == 2 &&
+						common.BytesToAddress(req.Txs[0].From) == s.from &&
+						common.BytesToAddress(req.Txs[1].From) == other
+				}, blockTrace(successTrace, successTrace))
+			},
+			opts: rpctypes.SimOpts{
+				BlockStateCalls: []rpctypes.SimBlock{{
+					Calls: []evmtypes.TransactionArgs{call, {From: &other, To: &to, Nonce: &nonce}},
+				}},
+				ReturnFullTransactions: true,
+			},
+			expResult: func(res []*rpctypes.SimBlockResult) {
+				s.Require().Len(res, 1)
+				s.Require().Len(res[0].Calls, 2)
+				s.Require().EqualValues(2*0x5208, res[0].GasUsed)
+				s.Require().Len(res[0].Transactions, 2)
+
+				tx, ok := res[0].Transactions[1].(*rpctypes.RPCTransaction)
+				s.Require().True(ok)
+				s.Require().Equal(other, tx.From)
+				s.Require().Equal(&to, tx.To)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			res, err := s.backend.SimulateV1(tc.opts, rpctypes.BlockNumberOrHash{BlockNumber: &blockNum})
+			if tc.expErr != "" {
+				s.Require().ErrorContains(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+			tc.expResult(res)
+		})
+	}
+}
+
+// blockTrace returns the result of a block trace query made of the given call traces
+func blockTrace(traces ...[]byte) []byte {
+	results := make([]string, 0, len(traces))
+	for _, trace := range traces {
+		results = append(results, `{"result": `+string(trace)+`}`)
+	}
+	return []byte("[" + strings.Join(results, ",") + "]")
+}
+
+func (s *TestSuite) TestCallFrameFlattenLogs() {
+	var (
+		addr1 = common.HexToAddress("0x1")
+		addr2 = common.HexToAddress("0x2")
+		addr3 = common.HexToAddress("0x3")
+	)
+
+	frame := callFrame{
+		Type: "CALL",
+		From: s.from,
+		To:   &addr1,
+		Logs: []callLog{
+			{Address: addr1, Data: hexutil.Bytes{0x01}, Position: 0},
+			{Address: addr1, Data: hexutil.Bytes{0x02}, Position: 2},
+		},
+		Calls: []callFrame{
+			{
+				Type:  "CALL",
+				From:  addr1,
+				To:    &addr2,
+				Value: (*hexutil.Big)(big.NewInt(10)),
+				Logs:  []callLog{{Address: addr2, Position: 0}},
+			},
+			{
+				Type:  "CALL",
+				From:  addr1,
+				To:    &addr3,
+				Error: "execution reverted",
+				Logs:  []callLog{{Address: addr3, Position: 0}},
+			},
+		},
+	}
+
+	s.Run("logs are ordered by execution and reverted logs are skipped", func() {
+		logs := frame.flattenLogs(false, nil)
+		s.Require().Len(logs, 3)
+		s.Require().Equal(hexutil.Bytes{0x01}, hexutil.Bytes(logs[0].Data))
+		s.Require().Equal(addr2, logs[1].Address)
+		s.Require().Equal(hexutil.Bytes{0x02}, hexutil.Bytes(logs[2].Data))
+	})
+
+	s.Run("transfer logs are added for value transfers", func() {
+		logs := frame.flattenLogs(true, nil)
+		s.Require().Len(logs, 4)
+		s.Require().Equal(transferAddress, logs[1].Address)
+		s.Require().Equal(
+			[]common.Hash{transferTopic, common.BytesToHash(addr1.Bytes()), common.BytesToHash(addr2.Bytes())},
+			logs[1].Topics,
+		)
+		s.Require().Equal(common.BigToHash(big.NewInt(10)).Bytes(), logs[1].Data)
+	})
+}
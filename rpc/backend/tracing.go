@@ -3,12 +3,15 @@ package backend
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/evm/server/config"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 
 	rpctypes "github.com/zeta-chain/node/rpc/types"
@@ -89,6 +92,13 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *rpctypes.TraceConfi
 		// 0 is a special value in `ContextWithHeight`
 		contextHeight = 1
 	}
+
+	// the transaction query executes the unsigned predecessors with the sender of the traced transaction,
+	// the block query is used instead if a synthetic tx of another sender must be replayed
+	if hasUnsignedPredecessorOfOtherSender(predecessors, ethMsg) {
+		return b.traceTxInBlock(contextHeight, &traceTxRequest)
+	}
+
 	traceResult, err := b.QueryClient.TraceTx(rpctypes.ContextWithHeight(contextHeight), &traceTxRequest)
 	if err != nil {
 		return nil, err
@@ -174,6 +184,257 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 	return decodedResults, nil
 }
 
+// hasUnsignedPredecessorOfOtherSender returns true if an unsigned predecessor, such as a synthetic tx
+// executed by the protocol, is not sent by the sender of the traced transaction
+func hasUnsignedPredecessorOfOtherSender(
+	predecessors []*evmtypes.MsgEthereumTx,
+	traced *evmtypes.MsgEthereumTx,
+) bool {
+	tracedFrom := common.BytesToAddress(traced.From)
+	for _, predecessor := range predecessors {
+		if isUnsignedMsg(predecessor) && common.BytesToAddress(predecessor.From) != tracedFrom {
+			return true
+		}
+	}
+	return false
+}
+
+// traceTxInBlock traces a transaction with the block trace query, which executes each unsigned tx with its own sender.
+// The predecessors are traced as well and only the trace of the transaction is returned.
+func (b *Backend) traceTxInBlock(contextHeight int64, req *evmtypes.QueryTraceTxRequest) (interface{}, error) {
+	txs := make([]*evmtypes.MsgEthereumTx, 0, len(req.Predecessors)+1)
+	txs = append(txs, req.Predecessors...)
+	txs = append(txs, req.Msg)
+
+	traceBlockRequest := &evmtypes.QueryTraceBlockRequest{
+		Txs:             txs,
+		TraceConfig:     req.TraceConfig,
+		BlockNumber:     req.BlockNumber,
+		BlockTime:       req.BlockTime,
+		BlockHash:       req.BlockHash,
+		ProposerAddress: req.ProposerAddress,
+		ChainId:         req.ChainId,
+		BlockMaxGas:     req.BlockMaxGas,
+	}
+
+	res, err := b.QueryClient.TraceBlock(rpctypes.ContextWithHeight(contextHeight), traceBlockRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*evmtypes.TxTraceResult
+	if err := json.Unmarshal(res.Data, &results); err != nil {
+		return nil, err
+	}
+	if len(results) != len(traceBlockRequest.Txs) {
+		return nil, fmt.Errorf("expected %d traces, got %d", len(traceBlockRequest.Txs), len(results))
+	}
+
+	traced := results[len(results)-1]
+	if traced.Error != "" {
+		return nil, errors.New(traced.Error)
+	}
+	return traced.Result, nil
+}
+
+// isUnsignedMsg returns true if the transaction has no signature, this is the case of synthetic txs
+func isUnsignedMsg(msg *evmtypes.MsgEthereumTx) bool {
+	v, r, s := msg.AsTransaction().RawSignatureValues()
+	return (v == nil || v.Sign() == 0) && (r == nil || r.Sign() == 0) && (s == nil || s.Sign() == 0)
+}
+
+// errStateOverridesNotSupported is returned when a call is traced or simulated with state overrides,
+// the EVM queries of the node only execute calls on top of the committed state and have no way to override it
+var errStateOverridesNotSupported = errors.New("state overrides are not supported")
+
+// TraceCall returns the structured logs created during the execution of a call that is not sent to the network,
+// the call is executed on top of the state of the given block.
+// State overrides are rejected, the EVM trace query can't apply them to the state of the block.
+func (b *Backend) TraceCall(
+	args evmtypes.TransactionArgs,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	callConfig *rpctypes.TraceCallConfig,
+) (interface{}, error) {
+	var (
+		traceConfig    *rpctypes.TraceConfig
+		blockOverrides *rpctypes.BlockOverrides
+	)
+	if callConfig != nil {
+		if !callConfig.StateOverrides.IsEmpty() {
+			return nil, errStateOverridesNotSupported
+		}
+		traceConfig = &callConfig.TraceConfig
+		blockOverrides = callConfig.BlockOverrides
+	}
+
+	blk, err := b.blockForCall(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	ethMsg, err := b.callArgsToMsg(args, blk.Block.Height, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	traceTxRequest, err := b.traceCallRequest(blk, ethMsg, nil, blockOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	traceTxRequest.TraceConfig, err = toEVMTraceConfig(traceConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	traceResult, err := b.QueryClient.TraceTx(rpctypes.ContextWithHeight(blk.Block.Height), traceTxRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response format is unknown due to custom tracer config param
+	var decodedResult interface{}
+	if err := json.Unmarshal(traceResult.Data, &decodedResult); err != nil {
+		return nil, err
+	}
+
+	return decodedResult, nil
+}
+
+// blockForCall returns the block on top of which a call is executed
+func (b *Backend) blockForCall(blockNrOrHash rpctypes.BlockNumberOrHash) (*tmrpctypes.ResultBlock, error) {
+	blockNr, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	blk, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil || blk == nil || blk.Block == nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+	return blk, nil
+}
+
+// callArgsToMsg converts the arguments of a call to an unsigned MsgEthereumTx executed on top of the state of the given height
+// nonceOffset is the number of calls of the sender executed before this call
+func (b *Backend) callArgsToMsg(
+	args evmtypes.TransactionArgs,
+	height int64,
+	nonceOffset uint64,
+) (*evmtypes.MsgEthereumTx, error) {
+	if args.Nonce == nil {
+		nonce, err := b.getAccountNonce(args.GetFrom(), false, height, b.Logger)
+		if err != nil {
+			return nil, err
+		}
+		nonce += nonceOffset
+		args.Nonce = (*hexutil.Uint64)(&nonce)
+	}
+
+	// the gas of the call is capped to the global gas cap
+	gasCap := b.RPCGasCap()
+	if gasCap == 0 {
+		gasCap = config.DefaultGasCap
+	}
+	if args.Gas == nil || uint64(*args.Gas) > gasCap {
+		gas := hexutil.Uint64(gasCap)
+		args.Gas = &gas
+	}
+
+	if args.ChainID == nil {
+		args.ChainID = (*hexutil.Big)(b.EvmChainID)
+	}
+
+	// the trace queries execute an unsigned call with the sender set in the message
+	if args.From == nil {
+		from := args.GetFrom()
+		args.From = &from
+	}
+
+	ethMsg := args.ToTransaction()
+	if ethMsg == nil {
+		return nil, errors.New("failed to convert call arguments to transaction")
+	}
+	return ethMsg, nil
+}
+
+// traceCallRequest returns the request to trace an unsigned call on top of the state of the given block
+// the call is executed as a transaction of the next block, after the predecessors
+func (b *Backend) traceCallRequest(
+	blk *tmrpctypes.ResultBlock,
+	ethMsg *evmtypes.MsgEthereumTx,
+	predecessors []*evmtypes.MsgEthereumTx,
+	overrides *rpctypes.BlockOverrides,
+) (*evmtypes.QueryTraceTxRequest, error) {
+	if err := checkBlockOverrides(overrides); err != nil {
+		return nil, err
+	}
+
+	blockMaxGas, err := b.blockMaxGas(blk.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &evmtypes.QueryTraceTxRequest{
+		Msg:             ethMsg,
+		Predecessors:    predecessors,
+		BlockNumber:     blk.Block.Height + 1,
+		BlockTime:       blk.Block.Time,
+		BlockHash:       common.Bytes2Hex(blk.BlockID.Hash),
+		ProposerAddress: sdk.ConsAddress(blk.Block.ProposerAddress),
+		ChainId:         b.EvmChainID.Int64(),
+		BlockMaxGas:     blockMaxGas,
+	}
+
+	if overrides != nil {
+		if overrides.Time != nil {
+			req.BlockTime = time.Unix(int64(*overrides.Time), 0).UTC() // #nosec G115 -- timestamp in range
+		}
+		if overrides.GasLimit != nil {
+			req.BlockMaxGas = int64(*overrides.GasLimit) // #nosec G115 -- gas limit in range
+		}
+	}
+
+	return req, nil
+}
+
+// blockMaxGas returns the max gas of a block from the consensus params at the given height
+func (b *Backend) blockMaxGas(height int64) (int64, error) {
+	nc, ok := b.ClientCtx.Client.(tmrpcclient.NetworkClient)
+	if !ok {
+		return 0, errors.New("invalid rpc client")
+	}
+
+	cp, err := nc.ConsensusParams(b.Ctx, &height)
+	if err != nil {
+		return 0, err
+	}
+	return cp.ConsensusParams.Block.MaxGas, nil
+}
+
+// checkBlockOverrides returns an error if the block overrides contain a field that can't be applied to a traced call
+// only the time and the gas limit of the block can be overridden
+func checkBlockOverrides(overrides *rpctypes.BlockOverrides) error {
+	switch {
+	case overrides == nil:
+		return nil
+	case overrides.Number != nil:
+		return errors.New("block number override is not supported")
+	case overrides.Difficulty != nil:
+		return errors.New("block difficulty override is not supported")
+	case overrides.FeeRecipient != nil:
+		return errors.New("block fee recipient override is not supported")
+	case overrides.PrevRandao != nil:
+		return errors.New("block prevRandao override is not supported")
+	case overrides.BaseFeePerGas != nil:
+		return errors.New("block base fee override is not supported")
+	case overrides.BlobBaseFee != nil:
+		return errors.New("block blob base fee override is not supported")
+	}
+	return nil
+}
+
 // toEVMTraceConfig converts rpctypes.TraceConfig to evmtypes.TraceConfig
 func toEVMTraceConfig(config *rpctypes.TraceConfig) (*evmtypes.TraceConfig, error) {
 	if config == nil {
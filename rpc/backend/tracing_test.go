@@ -2,6 +2,7 @@ package backend
 
 import (
 	"fmt"
+	"math/big"
 
 	"cosmossdk.io/log"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -13,6 +14,7 @@ import (
 	"github.com/cosmos/evm/indexer"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/zeta-chain/node/rpc/backend/mocks"
@@ -61,7 +63,12 @@ func (s *TestSuite) TestTraceTransaction() {
 		{
 			"fail - tx not found",
 			txHash,
-			func() {},
+			func() {
+				// the tx is searched in the tendermint indexer if not found in the EVM indexer
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				query := fmt.Sprintf("%s.%s='%s'", evmtypes.TypeMsgEthereumTx, evmtypes.AttributeKeyEthereumTxHash, txHash.Hex())
+				RegisterTxSearchEmpty(client, query)
+			},
 			&types.Block{Header: types.Header{Height: 1}, Data: types.Data{Txs: []types.Tx{}}},
 			[]*abci.ExecTxResult{
 				{
@@ -329,3 +336,131 @@ func (s *TestSuite) TestTraceBlock() {
 		})
 	}
 }
+
+func (s *TestSuite) TestTraceCall() {
+	var (
+		nonce     = hexutil.Uint64(1)
+		to        = common.HexToAddress("0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7")
+		blockNum  = rpctypes.BlockNumber(1)
+		args      = evmtypes.TransactionArgs{From: &s.from, To: &to, Nonce: &nonce}
+		blockTime = hexutil.Uint64(1700000000)
+		gasLimit  = hexutil.Uint64(1000000)
+	)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		config       *rpctypes.TraceCallConfig
+		expResult    interface{}
+		expErr       string
+	}{
+		{
+			name:         "fail - state overrides are not supported",
+			registerMock: func() {},
+			config: &rpctypes.TraceCallConfig{
+				StateOverrides: &rpctypes.StateOverride{to: rpctypes.OverrideAccount{}},
+			},
+			expErr: "state overrides are not supported",
+		},
+		{
+			name: "fail - block not found",
+			registerMock: func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			expErr: "header not found",
+		},
+		{
+			name: "fail - block number override is not supported",
+			registerMock: func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, nil)
+				s.Require().NoError(err)
+			},
+			config: &rpctypes.TraceCallConfig{
+				BlockOverrides: &rpctypes.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(2))},
+			},
+			expErr: "block number override is not supported",
+		},
+		{
+			name: "pass - call traced",
+			registerMock: func() {
+				var (
+					queryClient = s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+					client      = s.backend.ClientCtx.Client.(*mocks.Client)
+				)
+				_, err := RegisterBlock(client, 1, nil)
+				s.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				RegisterTraceCall(queryClient, func(req *evmtypes.QueryTraceTxRequest) bool {
+					return req.BlockNumber == 2 && len(req.Predecessors) == 0 && req.Msg.AsTransaction().Nonce() == 1
+				}, []byte(`{"test": "hello"}`))
+			},
+			expResult: map[string]interface{}{"test": "hello"},
+		},
+		{
+			name: "pass - call traced with block overrides",
+			registerMock: func() {
+				var (
+					queryClient = s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+					client      = s.backend.ClientCtx.Client.(*mocks.Client)
+				)
+				_, err := RegisterBlock(client, 1, nil)
+				s.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				RegisterTraceCall(queryClient, func(req *evmtypes.QueryTraceTxRequest) bool {
+					return req.BlockTime.Unix() == int64(blockTime) && req.BlockMaxGas == int64(gasLimit)
+				}, []byte(`{"test": "hello"}`))
+			},
+			config: &rpctypes.TraceCallConfig{
+				BlockOverrides: &rpctypes.BlockOverrides{Time: &blockTime, GasLimit: &gasLimit},
+			},
+			expResult: map[string]interface{}{"test": "hello"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			res, err := s.backend.TraceCall(args, rpctypes.BlockNumberOrHash{BlockNumber: &blockNum}, tc.config)
+			if tc.expErr != "" {
+				s.Require().ErrorContains(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expResult, res)
+		})
+	}
+}
+
+func (s *TestSuite) TestHasUnsignedPredecessorOfOtherSender() {
+	var (
+		sender = common.HexToAddress("0x1")
+		other  = common.HexToAddress("0x2")
+		to     = common.HexToAddress("0x3")
+	)
+
+	unsignedMsg := func(from common.Address) *evmtypes.MsgEthereumTx {
+		msg := &evmtypes.MsgEthereumTx{}
+		err := msg.FromEthereumTx(ethtypes.NewTx(&ethtypes.LegacyTx{
+			To: &to,
+			V:  big.NewInt(0),
+			R:  big.NewInt(0),
+			S:  big.NewInt(0),
+		}))
+		s.Require().NoError(err)
+		msg.From = from.Bytes()
+		return msg
+	}
+
+	traced := unsignedMsg(sender)
+
+	s.Require().False(hasUnsignedPredecessorOfOtherSender(nil, traced))
+	s.Require().False(hasUnsignedPredecessorOfOtherSender([]*evmtypes.MsgEthereumTx{unsignedMsg(sender)}, traced))
+	s.Require().True(hasUnsignedPredecessorOfOtherSender(
+		[]*evmtypes.MsgEthereumTx{unsignedMsg(sender), unsignedMsg(other)},
+		traced,
+	))
+}
@@ -348,9 +348,51 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		}
 	}
 
+	// link the synthetic tx executed by the protocol to its CCTX and inbound
+	if additional.HasCCTXOrigin() {
+		receipt["cctxIndex"] = additional.CctxIndex
+		receipt["inboundChainId"] = (*hexutil.Big)(big.NewInt(additional.InboundChainID))
+		receipt["inboundHash"] = additional.InboundHash
+	}
+
 	return receipt, nil
 }
 
+// GetBlockReceipts returns the receipts of all the transactions of the block identified by number or hash.
+// A nil list is returned if the block doesn't exist.
+func (b *Backend) GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil || resBlock == nil || resBlock.Block == nil {
+		b.Logger.Debug("block not found", "height", blockNum, "error", err)
+		return nil, nil
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, _ := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+	receipts := make([]map[string]interface{}, 0, len(msgs))
+	for _, msg := range msgs {
+		receipt, err := b.GetTransactionReceipt(common.HexToHash(msg.Hash))
+		if err != nil {
+			return nil, err
+		}
+		if receipt == nil {
+			return nil, fmt.Errorf("receipt not found for transaction %s", msg.Hash)
+		}
+		receipts = append(receipts, receipt)
+	}
+
+	return receipts, nil
+}
+
 // GetTransactionLogs returns the transaction logs identified by hash.
 func (b *Backend) GetTransactionLogs(hash common.Hash) ([]*ethtypes.Log, error) {
 	hexTx := hash.Hex()
@@ -431,10 +473,14 @@ func (b *Backend) GetTransactionByBlockNumberAndIndex(
 func (b *Backend) GetTxByEthHash(hash common.Hash) (*types.TxResult, *rpctypes.TxResultAdditionalFields, error) {
 	if b.Indexer != nil {
 		txRes, err := b.Indexer.GetByTxHash(hash)
-		if err != nil {
+		switch {
+		case err == nil:
+			return txRes, nil, nil
+		case !isIndexerTxNotFound(err):
 			return nil, nil, err
 		}
-		return txRes, nil, nil
+		// the synthetic txs executed by the protocol are not indexed by the EVM indexer
+		b.Logger.Debug("tx not found in indexer", "hash", hash.Hex())
 	}
 
 	// fallback to tendermint tx indexer
@@ -746,10 +746,15 @@ func (s *TestSuite) TestGetTransactionReceipt() {
 		expErr       error
 	}{
 		{
-			name:         "success - tx not found",
-			registerMock: func() {},
-			block:        &types.Block{Header: types.Header{Height: 1}, Data: types.Data{Txs: []types.Tx{txBz}}},
-			tx:           msgEthereumTx2,
+			name: "success - tx not found",
+			registerMock: func() {
+				// the tx is searched in the tendermint indexer if not found in the EVM indexer
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				query := fmt.Sprintf("%s.%s='%s'", evmtypes.TypeMsgEthereumTx, evmtypes.AttributeKeyEthereumTxHash, common.HexToHash(msgEthereumTx2.Hash).Hex())
+				RegisterTxSearchEmpty(client, query)
+			},
+			block: &types.Block{Header: types.Header{Height: 1}, Data: types.Data{Txs: []types.Tx{txBz}}},
+			tx:    msgEthereumTx2,
 			blockResult: []*abci.ExecTxResult{
 				{
 					Code: 0,
@@ -955,3 +960,102 @@ func (s *TestSuite) TestGetGasUsed() {
 		})
 	}
 }
+
+func (s *TestSuite) TestGetBlockReceipts() {
+	msgEthereumTx, _ := s.buildEthereumTx()
+	txHash := msgEthereumTx.AsTransaction().Hash()
+	txBz := s.signAndEncodeEthTx(msgEthereumTx)
+	signedTxHash := common.HexToHash(msgEthereumTx.Hash)
+
+	blockNum := rpctypes.BlockNumber(1)
+	block := &types.Block{Header: types.Header{Height: 1}, Data: types.Data{Txs: []types.Tx{txBz}}}
+	blockResult := []*abci.ExecTxResult{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+				}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expReceipts  int
+		expErr       error
+	}{
+		{
+			name: "success - block not found",
+			registerMock: func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			expReceipts: 0,
+		},
+		{
+			name: "fail - block result error",
+			registerMock: func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, txBz)
+				s.Require().NoError(err)
+				RegisterBlockResultsError(client, 1)
+			},
+			expErr: fmt.Errorf("failed to fetch block result from Tendermint 1: invalid request"),
+		},
+		{
+			name: "success - empty block",
+			registerMock: func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, nil)
+				s.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 1)
+				s.Require().NoError(err)
+			},
+			expReceipts: 0,
+		},
+		{
+			name: "happy path",
+			registerMock: func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, txBz)
+				s.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 1)
+				s.Require().NoError(err)
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBaseFee(queryClient, sdkmath.NewInt(1000000000))
+			},
+			expReceipts: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			tc.registerMock()
+
+			db := dbm.NewMemDB()
+			s.backend.Indexer = indexer.NewKVIndexer(db, log.NewNopLogger(), s.backend.ClientCtx)
+			err := s.backend.Indexer.IndexBlock(block, blockResult)
+			s.Require().NoError(err)
+
+			receipts, err := s.backend.GetBlockReceipts(rpctypes.BlockNumberOrHash{BlockNumber: &blockNum})
+			if tc.expErr != nil {
+				s.Require().ErrorContains(err, tc.expErr.Error())
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Len(receipts, tc.expReceipts)
+			for _, receipt := range receipts {
+				s.Require().Equal(signedTxHash, receipt["transactionHash"])
+				s.Require().Equal(hexutil.Uint64(1), receipt["blockNumber"])
+			}
+		})
+	}
+}
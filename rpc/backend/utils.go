@@ -384,6 +384,41 @@ func GetLogsFromBlockResults(blockRes *cmtrpctypes.ResultBlockResults) ([][]*eth
 	return blockLogs, nil
 }
 
+// GetProtocolDepositLogsFromBlockResults returns the list of event logs from the tendermint block result response
+// that are emitted by the synthetic txs executed by the protocol for the deposits of CCTXs
+func GetProtocolDepositLogsFromBlockResults(blockRes *cmtrpctypes.ResultBlockResults) ([][]*ethtypes.Log, error) {
+	blockLogs, err := GetLogsFromBlockResults(blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	depositTxs := make(map[common.Hash]struct{})
+	for _, txResult := range blockRes.TxsResults {
+		txs, err := types.ParseTxResult(txResult, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, tx := range txs.Txs {
+			if tx.Type == types.CosmosEVMTxType && tx.CctxIndex != "" {
+				depositTxs[tx.Hash] = struct{}{}
+			}
+		}
+	}
+
+	depositLogs := make([][]*ethtypes.Log, 0, len(blockLogs))
+	for _, txLogs := range blockLogs {
+		filtered := make([]*ethtypes.Log, 0, len(txLogs))
+		for _, log := range txLogs {
+			if _, ok := depositTxs[log.TxHash]; ok {
+				filtered = append(filtered, log)
+			}
+		}
+		depositLogs = append(depositLogs, filtered)
+	}
+
+	return depositLogs, nil
+}
+
 // needsReindexing checks if logs in the block need re-indexing.Returns true if:
 //  1. TxIndex values are not strictly increasing across transaction groups
 //  2. Any log Index values are duplicated
@@ -456,3 +491,9 @@ func GetHexProofs(proof *crypto.ProofOps) []string {
 	}
 	return proofs
 }
+
+// isIndexerTxNotFound returns true if the error of the EVM indexer means the tx is not indexed,
+// the KV indexer doesn't return a typed error for a missing tx
+func isIndexerTxNotFound(err error) bool {
+	return err != nil && strings.HasPrefix(err.Error(), "tx not found")
+}
@@ -2,6 +2,7 @@ package backend
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -452,3 +453,11 @@ func (s *TestSuite) TestGetLogsFromBlockResults_IndexUniqueness() {
 		}
 	}
 }
+
+func (s *TestSuite) TestIsIndexerTxNotFound() {
+	hash := common.HexToHash("0x1")
+
+	s.Require().False(isIndexerTxNotFound(nil))
+	s.Require().True(isIndexerTxNotFound(fmt.Errorf("tx not found, hash: %s", hash.Hex())))
+	s.Require().False(isIndexerTxNotFound(errors.New("GetByTxHash: leveldb: closed")))
+}
@@ -0,0 +1,149 @@
+package indexer
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/bitutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+// sectionGenerator rotates the blooms of the blocks of a section into bloom bits:
+// for each of the bits of the bloom, a bit vector with one bit per block of the section
+type sectionGenerator struct {
+	sectionSize uint64
+	bits        [ethtypes.BloomBitLength][]byte
+}
+
+// newSectionGenerator returns a generator for a section of the given number of blocks
+func newSectionGenerator(sectionSize uint64) (*sectionGenerator, error) {
+	if sectionSize == 0 || sectionSize%8 != 0 {
+		return nil, errors.Errorf("section size %d must be a non-zero multiple of 8", sectionSize)
+	}
+
+	g := &sectionGenerator{sectionSize: sectionSize}
+	for i := range g.bits {
+		g.bits[i] = make([]byte, sectionSize/8)
+	}
+	return g, nil
+}
+
+// addBloom sets the bloom of the block at the given index in the section
+func (g *sectionGenerator) addBloom(index uint64, bloom ethtypes.Bloom) error {
+	if index >= g.sectionSize {
+		return errors.Errorf("block index %d out of section of size %d", index, g.sectionSize)
+	}
+
+	byteIndex := index / 8
+	bitMask := byte(1) << byte(7-index%8)
+	for i := 0; i < ethtypes.BloomBitLength; i++ {
+		bloomByteIndex := ethtypes.BloomByteLength - 1 - i/8
+		bloomBitMask := byte(1) << byte(i%8)
+		if bloom[bloomByteIndex]&bloomBitMask != 0 {
+			g.bits[i][byteIndex] |= bitMask
+		}
+	}
+	return nil
+}
+
+// bitset returns the compressed bit vector of the given bloom bit
+func (g *sectionGenerator) bitset(bit uint) []byte {
+	// the bit vector of a bit not set in the section is compressed to nil, which can't be stored
+	bz := bitutil.CompressBytes(g.bits[bit])
+	if bz == nil {
+		return []byte{}
+	}
+	return bz
+}
+
+// bloomIndexes returns the three bloom bits set for the given data
+func bloomIndexes(data []byte) [3]uint {
+	hash := crypto.Keccak256(data)
+
+	var idxs [3]uint
+	for i := range idxs {
+		idxs[i] = (uint(hash[2*i])<<8 | uint(hash[2*i+1])) & (ethtypes.BloomBitLength - 1)
+	}
+	return idxs
+}
+
+// filterClauses returns the bloom indexes of the filter clauses, the logs must match one key of each clause.
+// Wildcard clauses are skipped.
+func filterClauses(addresses []common.Address, topics [][]common.Hash) [][][3]uint {
+	clauses := make([][][3]uint, 0, len(topics)+1)
+	if len(addresses) > 0 {
+		clause := make([][3]uint, len(addresses))
+		for i, address := range addresses {
+			clause[i] = bloomIndexes(address.Bytes())
+		}
+		clauses = append(clauses, clause)
+	}
+
+	for _, topicList := range topics {
+		if len(topicList) == 0 {
+			continue
+		}
+		clause := make([][3]uint, len(topicList))
+		for i, topic := range topicList {
+			clause[i] = bloomIndexes(topic.Bytes())
+		}
+		clauses = append(clauses, clause)
+	}
+	return clauses
+}
+
+// bloomMatches returns true if the bloom of a block may contain logs matching the filter clauses
+func bloomMatches(bloom ethtypes.Bloom, clauses [][][3]uint) bool {
+	isSet := func(bit uint) bool {
+		return bloom[ethtypes.BloomByteLength-1-bit/8]&(byte(1)<<byte(bit%8)) != 0
+	}
+
+	for _, clause := range clauses {
+		matched := false
+		for _, idxs := range clause {
+			if isSet(idxs[0]) && isSet(idxs[1]) && isSet(idxs[2]) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// matchSection returns the bit vector of the blocks of a section that may contain logs matching the filter clauses,
+// bitset returns the decompressed bit vector of a bloom bit for the section
+func matchSection(
+	sectionSize uint64,
+	clauses [][][3]uint,
+	bitset func(bit uint) ([]byte, error),
+) ([]byte, error) {
+	// all the blocks match if there is no clause
+	result := make([]byte, sectionSize/8)
+	for i := range result {
+		result[i] = 0xff
+	}
+
+	for _, clause := range clauses {
+		clauseResult := make([]byte, sectionSize/8)
+		for _, idxs := range clause {
+			keyResult := make([]byte, sectionSize/8)
+			for i, bit := range idxs {
+				bits, err := bitset(bit)
+				if err != nil {
+					return nil, err
+				}
+				if i == 0 {
+					copy(keyResult, bits)
+					continue
+				}
+				bitutil.ANDBytes(keyResult, keyResult, bits)
+			}
+			bitutil.ORBytes(clauseResult, clauseResult, keyResult)
+		}
+		bitutil.ANDBytes(result, result, clauseResult)
+	}
+	return result, nil
+}
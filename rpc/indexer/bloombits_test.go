@@ -0,0 +1,74 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/bitutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSectionGenerator(t *testing.T) {
+	_, err := newSectionGenerator(0)
+	require.Error(t, err)
+
+	_, err = newSectionGenerator(12)
+	require.Error(t, err)
+
+	g, err := newSectionGenerator(16)
+	require.NoError(t, err)
+	require.Error(t, g.addBloom(16, ethtypes.Bloom{}))
+}
+
+func TestMatchSection(t *testing.T) {
+	var (
+		addresses = []common.Address{common.HexToAddress("0x01"), common.HexToAddress("0x02")}
+		topics    = []common.Hash{common.HexToHash("0x03"), common.HexToHash("0x04")}
+	)
+
+	// the blocks of the section emit logs from the different addresses and topics
+	blooms := make([]ethtypes.Bloom, 16)
+	for i := range blooms {
+		if i%3 == 0 {
+			blooms[i].Add(addresses[i%2].Bytes())
+			blooms[i].Add(topics[(i/2)%2].Bytes())
+		}
+	}
+
+	g, err := newSectionGenerator(16)
+	require.NoError(t, err)
+	for i, bloom := range blooms {
+		require.NoError(t, g.addBloom(uint64(i), bloom))
+	}
+	bitset := func(bit uint) ([]byte, error) {
+		return bitutil.DecompressBytes(g.bitset(bit), 2)
+	}
+
+	tests := []struct {
+		name      string
+		addresses []common.Address
+		topics    [][]common.Hash
+	}{
+		{name: "no criteria"},
+		{name: "one address", addresses: addresses[:1]},
+		{name: "any address", addresses: addresses},
+		{name: "one topic", topics: [][]common.Hash{topics[1:]}},
+		{name: "wildcard topic", topics: [][]common.Hash{nil, topics[1:]}},
+		{name: "address and topic", addresses: addresses[1:], topics: [][]common.Hash{topics[:1]}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clauses := filterClauses(tt.addresses, tt.topics)
+
+			bits, err := matchSection(16, clauses, bitset)
+			require.NoError(t, err)
+
+			// the bloom bits match the blocks whose bloom matches
+			for i, bloom := range blooms {
+				matched := bits[i/8]&(byte(1)<<byte(7-i%8)) != 0
+				require.Equal(t, bloomMatches(bloom, clauses), matched, "block %d", i)
+			}
+		})
+	}
+}
@@ -0,0 +1,425 @@
+// Package indexer implements an EVM indexer storing the txs and the logs of the blocks with a bloom bits index,
+// in a database separate from the node. The logs are served from the indexer for the indexed blocks, even if the
+// block results were pruned from the node.
+package indexer
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/evm/indexer"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/bitutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/zeta-chain/node/rpc/backend"
+	rpctypes "github.com/zeta-chain/node/rpc/types"
+)
+
+const (
+	// KeyPrefixBlockLogs is the prefix of the logs of the indexed blocks
+	// the prefixes 1 and 2 are used by the tx indexer
+	KeyPrefixBlockLogs = 3
+
+	// KeyPrefixBlockBloom is the prefix of the blooms of the indexed blocks
+	KeyPrefixBlockBloom = 4
+
+	// KeyPrefixBloomBits is the prefix of the bloom bits of the processed sections
+	KeyPrefixBloomBits = 5
+
+	// KeyPrefixSection is the prefix of the processed sections
+	KeyPrefixSection = 6
+
+	// BloomBitsSectionSize is the number of blocks of a bloom bits section
+	BloomBitsSectionSize = 4096
+)
+
+var _ rpctypes.LogIndexer = &BloomBitsIndexer{}
+
+// BloomBitsIndexer indexes the eth txs of the blocks with the KV indexer, and the logs of the blocks with their bloom.
+// Once all the blocks of a section are indexed, the blooms of the section are rotated into bloom bits,
+// as go-ethereum does, so logs can be filtered over wide ranges without checking the bloom of each block.
+type BloomBitsIndexer struct {
+	*indexer.KVIndexer
+
+	db          dbm.DB
+	logger      log.Logger
+	sectionSize uint64
+}
+
+// NewBloomBitsIndexer creates the BloomBitsIndexer
+func NewBloomBitsIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *BloomBitsIndexer {
+	return &BloomBitsIndexer{
+		KVIndexer:   indexer.NewKVIndexer(db, logger, clientCtx),
+		db:          db,
+		logger:      logger,
+		sectionSize: BloomBitsSectionSize,
+	}
+}
+
+// IndexBlock indexes the eth txs and the logs of a block,
+// the bloom bits of the section of the block are generated once all the blocks of the section are indexed
+func (idx *BloomBitsIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
+	if err := idx.indexBlock(block, txResults); err != nil {
+		return err
+	}
+
+	section := idx.section(block.Height)
+	processed, err := idx.isSectionProcessed(section)
+	if err != nil || processed {
+		return err
+	}
+	return idx.processSection(section)
+}
+
+// ReindexBlock indexes again the eth txs and the logs of a block,
+// the bloom bits of the section of the block are discarded until the section is processed again with ProcessSections
+func (idx *BloomBitsIndexer) ReindexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
+	if err := idx.db.Delete(sectionKey(idx.section(block.Height))); err != nil {
+		return errors.Wrapf(err, "failed to discard section of block %d", block.Height)
+	}
+	return idx.indexBlock(block, txResults)
+}
+
+// ProcessSections generates the bloom bits of the sections of the blocks in [from, to] whose blocks are all indexed
+func (idx *BloomBitsIndexer) ProcessSections(from, to int64) error {
+	for section := idx.section(from); section <= idx.section(to); section++ {
+		if err := idx.processSection(section); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
+func (idx *BloomBitsIndexer) LastIndexedBlock() (int64, error) {
+	it, err := idx.db.ReverseIterator([]byte{KeyPrefixBlockBloom}, []byte{KeyPrefixBlockBloom + 1})
+	if err != nil {
+		return 0, errors.Wrap(err, "LastIndexedBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return heightFromKey(it.Key()), nil
+}
+
+// FirstIndexedBlock returns the first indexed block number, returns -1 if db is empty
+func (idx *BloomBitsIndexer) FirstIndexedBlock() (int64, error) {
+	it, err := idx.db.Iterator([]byte{KeyPrefixBlockBloom}, []byte{KeyPrefixBlockBloom + 1})
+	if err != nil {
+		return 0, errors.Wrap(err, "FirstIndexedBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return heightFromKey(it.Key()), nil
+}
+
+// IsIndexed returns true if all the blocks in [from, to] are indexed
+func (idx *BloomBitsIndexer) IsIndexed(from, to int64) (bool, error) {
+	// the chain starts at block 1
+	if from < 1 {
+		from = 1
+	}
+	if to < from {
+		return true, nil
+	}
+
+	it, err := idx.db.Iterator(blockBloomKey(from), blockBloomKey(to+1))
+	if err != nil {
+		return false, errors.Wrap(err, "IsIndexed")
+	}
+	defer it.Close()
+
+	expected := from
+	for ; it.Valid(); it.Next() {
+		if heightFromKey(it.Key()) != expected {
+			return false, nil
+		}
+		expected++
+	}
+	return expected == to+1, nil
+}
+
+// BlockLogs returns the logs of an indexed block grouped by tx, nil if the block is not indexed
+func (idx *BloomBitsIndexer) BlockLogs(height int64) ([][]*ethtypes.Log, error) {
+	indexed, err := idx.db.Has(blockBloomKey(height))
+	if err != nil {
+		return nil, errors.Wrapf(err, "BlockLogs %d", height)
+	}
+	if !indexed {
+		return nil, nil
+	}
+
+	bz, err := idx.db.Get(blockLogsKey(height))
+	if err != nil {
+		return nil, errors.Wrapf(err, "BlockLogs %d", height)
+	}
+
+	// the logs are not stored for blocks without logs
+	blockLogs := [][]*ethtypes.Log{}
+	if len(bz) == 0 {
+		return blockLogs, nil
+	}
+	if err := json.Unmarshal(bz, &blockLogs); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode logs of block %d", height)
+	}
+	return blockLogs, nil
+}
+
+// FilterBlocks returns the indexed blocks in [from, to] that may contain logs matching the addresses and topics,
+// the bloom bits are used for the processed sections and the bloom of each block for the other sections
+func (idx *BloomBitsIndexer) FilterBlocks(
+	from, to int64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+) ([]int64, error) {
+	if from < 1 {
+		from = 1
+	}
+
+	var (
+		clauses = filterClauses(addresses, topics)
+		heights = make([]int64, 0)
+	)
+	for section := idx.section(from); section <= idx.section(to); section++ {
+		sectionFirst, sectionLast := idx.sectionRange(section)
+		first, last := max(from, sectionFirst), min(to, sectionLast)
+
+		processed, err := idx.isSectionProcessed(section)
+		if err != nil {
+			return nil, err
+		}
+
+		if !processed {
+			matching, err := idx.filterBlockBlooms(first, last, clauses)
+			if err != nil {
+				return nil, err
+			}
+			heights = append(heights, matching...)
+			continue
+		}
+
+		bits, err := matchSection(idx.sectionSize, clauses, idx.bitsetGetter(section))
+		if err != nil {
+			return nil, err
+		}
+		for height := first; height <= last; height++ {
+			index := uint64(height - sectionFirst) // #nosec G115 -- height in section
+			if bits[index/8]&(byte(1)<<byte(7-index%8)) != 0 {
+				heights = append(heights, height)
+			}
+		}
+	}
+	return heights, nil
+}
+
+// BloomStatus returns the number of blocks of a bloom bits section and the number of processed sections
+func (idx *BloomBitsIndexer) BloomStatus() (uint64, uint64) {
+	it, err := dbm.IteratePrefix(idx.db, []byte{KeyPrefixSection})
+	if err != nil {
+		idx.logger.Error("failed to iterate the processed sections", "err", err)
+		return idx.sectionSize, 0
+	}
+	defer it.Close()
+
+	var sections uint64
+	for ; it.Valid(); it.Next() {
+		sections++
+	}
+	return idx.sectionSize, sections
+}
+
+// indexBlock indexes the eth txs of a block with the KV indexer, then its logs and its bloom
+func (idx *BloomBitsIndexer) indexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
+	if err := idx.KVIndexer.IndexBlock(block, txResults); err != nil {
+		return err
+	}
+
+	blockLogs, err := backend.GetLogsFromBlockResults(&tmrpctypes.ResultBlockResults{
+		Height:     block.Height,
+		TxsResults: txResults,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get logs of block %d", block.Height)
+	}
+
+	var (
+		bloom   ethtypes.Bloom
+		hasLogs bool
+	)
+	for _, txLogs := range blockLogs {
+		for _, log := range txLogs {
+			hasLogs = true
+			bloom.Add(log.Address.Bytes())
+			for _, topic := range log.Topics {
+				bloom.Add(topic.Bytes())
+			}
+		}
+	}
+
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+
+	if hasLogs {
+		bz, err := json.Marshal(blockLogs)
+		if err != nil {
+			return errors.Wrapf(err, "failed to encode logs of block %d", block.Height)
+		}
+		if err := batch.Set(blockLogsKey(block.Height), bz); err != nil {
+			return err
+		}
+	} else if err := batch.Delete(blockLogsKey(block.Height)); err != nil {
+		return err
+	}
+	if err := batch.Set(blockBloomKey(block.Height), bloom.Bytes()); err != nil {
+		return err
+	}
+
+	if err := batch.Write(); err != nil {
+		return errors.Wrapf(err, "IndexBlock %d, write batch", block.Height)
+	}
+	return nil
+}
+
+// processSection generates the bloom bits of a section if all its blocks are indexed
+func (idx *BloomBitsIndexer) processSection(section uint64) error {
+	first, last := idx.sectionRange(section)
+
+	// the last block is checked first to not iterate over the section for each new block
+	hasLast, err := idx.db.Has(blockBloomKey(last))
+	if err != nil || !hasLast {
+		return err
+	}
+	indexed, err := idx.IsIndexed(first, last)
+	if err != nil || !indexed {
+		return err
+	}
+
+	generator, err := newSectionGenerator(idx.sectionSize)
+	if err != nil {
+		return err
+	}
+
+	it, err := idx.db.Iterator(blockBloomKey(first), blockBloomKey(last+1))
+	if err != nil {
+		return errors.Wrapf(err, "failed to iterate blooms of section %d", section)
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		index := uint64(heightFromKey(it.Key()) - first) // #nosec G115 -- height in section
+		if err := generator.addBloom(index, ethtypes.BytesToBloom(it.Value())); err != nil {
+			return err
+		}
+	}
+
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+	for bit := uint(0); bit < ethtypes.BloomBitLength; bit++ {
+		if err := batch.Set(bloomBitsKey(bit, section), generator.bitset(bit)); err != nil {
+			return err
+		}
+	}
+	if err := batch.Set(sectionKey(section), []byte{1}); err != nil {
+		return err
+	}
+
+	if err := batch.Write(); err != nil {
+		return errors.Wrapf(err, "failed to write bloom bits of section %d", section)
+	}
+	idx.logger.Debug("processed bloom bits section", "section", section, "first", first, "last", last)
+	return nil
+}
+
+// filterBlockBlooms returns the indexed blocks in [from, to] whose bloom matches the filter clauses
+func (idx *BloomBitsIndexer) filterBlockBlooms(from, to int64, clauses [][][3]uint) ([]int64, error) {
+	it, err := idx.db.Iterator(blockBloomKey(from), blockBloomKey(to+1))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate block blooms")
+	}
+	defer it.Close()
+
+	heights := make([]int64, 0)
+	for ; it.Valid(); it.Next() {
+		if bloomMatches(ethtypes.BytesToBloom(it.Value()), clauses) {
+			heights = append(heights, heightFromKey(it.Key()))
+		}
+	}
+	return heights, nil
+}
+
+// bitsetGetter returns a function getting the decompressed bit vectors of the bloom bits of a section
+func (idx *BloomBitsIndexer) bitsetGetter(section uint64) func(bit uint) ([]byte, error) {
+	cache := make(map[uint][]byte)
+	return func(bit uint) ([]byte, error) {
+		if bits, ok := cache[bit]; ok {
+			return bits, nil
+		}
+
+		bz, err := idx.db.Get(bloomBitsKey(bit, section))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get bloom bit %d of section %d", bit, section)
+		}
+		bits, err := bitutil.DecompressBytes(bz, int(idx.sectionSize/8)) // #nosec G115 -- section size in range
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decompress bloom bit %d of section %d", bit, section)
+		}
+		cache[bit] = bits
+		return bits, nil
+	}
+}
+
+// isSectionProcessed returns true if the bloom bits of the section are generated
+func (idx *BloomBitsIndexer) isSectionProcessed(section uint64) (bool, error) {
+	processed, err := idx.db.Has(sectionKey(section))
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to check section %d", section)
+	}
+	return processed, nil
+}
+
+// section returns the section of a block
+func (idx *BloomBitsIndexer) section(height int64) uint64 {
+	return uint64(height) / idx.sectionSize // #nosec G115 -- height is positive
+}
+
+// sectionRange returns the first and the last block of a section
+func (idx *BloomBitsIndexer) sectionRange(section uint64) (int64, int64) {
+	first := int64(section * idx.sectionSize) // #nosec G115 -- height in range
+	return first, first + int64(idx.sectionSize) - 1
+}
+
+// blockLogsKey returns the key for db entry: `block number -> logs of the block`
+func blockLogsKey(height int64) []byte {
+	return append([]byte{KeyPrefixBlockLogs}, sdk.Uint64ToBigEndian(uint64(height))...) // #nosec G115
+}
+
+// blockBloomKey returns the key for db entry: `block number -> bloom of the block`
+func blockBloomKey(height int64) []byte {
+	return append([]byte{KeyPrefixBlockBloom}, sdk.Uint64ToBigEndian(uint64(height))...) // #nosec G115
+}
+
+// bloomBitsKey returns the key for db entry: `(bloom bit, section) -> compressed bit vector`
+func bloomBitsKey(bit uint, section uint64) []byte {
+	key := []byte{KeyPrefixBloomBits, byte(bit >> 8), byte(bit)}
+	return append(key, sdk.Uint64ToBigEndian(section)...)
+}
+
+// sectionKey returns the key for db entry: `section -> processed`
+func sectionKey(section uint64) []byte {
+	return append([]byte{KeyPrefixSection}, sdk.Uint64ToBigEndian(section)...)
+}
+
+// heightFromKey returns the block number of a block logs or bloom key
+func heightFromKey(key []byte) int64 {
+	return int64(sdk.BigEndianToUint64(key[1:])) // #nosec G115 -- height in range
+}
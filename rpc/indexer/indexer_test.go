@@ -0,0 +1,175 @@
+package indexer
+
+import (
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	addrA  = common.HexToAddress("0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7")
+	addrB  = common.HexToAddress("0x0a7C2B3e7f4eC0fE4e6b7F5AAB1b0f9C4aF2e1b3")
+	topic1 = common.HexToHash("0x01")
+	topic2 = common.HexToHash("0x02")
+)
+
+// newTestIndexer returns an indexer with sections of 8 blocks
+func newTestIndexer(t *testing.T) *BloomBitsIndexer {
+	idx := NewBloomBitsIndexer(dbm.NewMemDB(), log.NewNopLogger(), client.Context{})
+	idx.sectionSize = 8
+	return idx
+}
+
+// testBlock returns a block with the tx results emitting a log for each of the given addresses
+func testBlock(t *testing.T, height int64, logs map[common.Address]common.Hash) (*cmttypes.Block, []*abci.ExecTxResult) {
+	txResults := make([]*abci.ExecTxResult, 0, len(logs))
+	for address, topic := range logs {
+		bz, err := json.Marshal(&evmtypes.Log{
+			Address:     address.Hex(),
+			Topics:      []string{topic.Hex()},
+			BlockNumber: uint64(height),
+			TxHash:      common.BigToHash(common.Big1).Hex(),
+		})
+		require.NoError(t, err)
+
+		txResults = append(txResults, &abci.ExecTxResult{
+			Events: []abci.Event{{
+				Type:       evmtypes.EventTypeTxLog,
+				Attributes: []abci.EventAttribute{{Key: evmtypes.AttributeKeyTxLog, Value: string(bz)}},
+			}},
+		})
+	}
+	return &cmttypes.Block{Header: cmttypes.Header{Height: height}}, txResults
+}
+
+func TestBloomBitsIndexer(t *testing.T) {
+	// index the blocks 1 to 16, the sections 0 and 1 are processed
+	setup := func(t *testing.T) *BloomBitsIndexer {
+		idx := newTestIndexer(t)
+		for height := int64(1); height <= 16; height++ {
+			var logs map[common.Address]common.Hash
+			switch height {
+			case 3, 13:
+				logs = map[common.Address]common.Hash{addrA: topic1}
+			case 10:
+				logs = map[common.Address]common.Hash{addrB: topic2}
+			}
+			require.NoError(t, idx.IndexBlock(testBlock(t, height, logs)))
+		}
+		return idx
+	}
+
+	t.Run("indexed blocks", func(t *testing.T) {
+		idx := setup(t)
+
+		first, err := idx.FirstIndexedBlock()
+		require.NoError(t, err)
+		require.EqualValues(t, 1, first)
+		last, err := idx.LastIndexedBlock()
+		require.NoError(t, err)
+		require.EqualValues(t, 16, last)
+
+		indexed, err := idx.IsIndexed(0, 16)
+		require.NoError(t, err)
+		require.True(t, indexed)
+		indexed, err = idx.IsIndexed(10, 17)
+		require.NoError(t, err)
+		require.False(t, indexed)
+
+		sectionSize, sections := idx.BloomStatus()
+		require.EqualValues(t, 8, sectionSize)
+		require.EqualValues(t, 2, sections)
+	})
+
+	t.Run("empty indexer", func(t *testing.T) {
+		idx := newTestIndexer(t)
+
+		last, err := idx.LastIndexedBlock()
+		require.NoError(t, err)
+		require.EqualValues(t, -1, last)
+
+		indexed, err := idx.IsIndexed(1, 1)
+		require.NoError(t, err)
+		require.False(t, indexed)
+
+		logs, err := idx.BlockLogs(1)
+		require.NoError(t, err)
+		require.Nil(t, logs)
+	})
+
+	t.Run("block logs", func(t *testing.T) {
+		idx := setup(t)
+
+		logs, err := idx.BlockLogs(10)
+		require.NoError(t, err)
+		require.Len(t, logs, 1)
+		require.Len(t, logs[0], 1)
+		require.Equal(t, addrB, logs[0][0].Address)
+		require.Equal(t, topic2, logs[0][0].Topics[0])
+
+		logs, err = idx.BlockLogs(11)
+		require.NoError(t, err)
+		require.NotNil(t, logs)
+		require.Empty(t, logs)
+
+		logs, err = idx.BlockLogs(100)
+		require.NoError(t, err)
+		require.Nil(t, logs)
+	})
+
+	t.Run("filter blocks with bloom bits and block blooms", func(t *testing.T) {
+		idx := setup(t)
+
+		// block 17 is in a section not processed
+		require.NoError(t, idx.IndexBlock(testBlock(t, 17, map[common.Address]common.Hash{addrA: topic2})))
+
+		heights, err := idx.FilterBlocks(1, 17, []common.Address{addrA}, nil)
+		require.NoError(t, err)
+		require.Equal(t, []int64{3, 13, 17}, heights)
+
+		heights, err = idx.FilterBlocks(1, 17, nil, [][]common.Hash{{topic2}})
+		require.NoError(t, err)
+		require.Equal(t, []int64{10, 17}, heights)
+
+		heights, err = idx.FilterBlocks(4, 17, []common.Address{addrA, addrB}, [][]common.Hash{{topic1, topic2}})
+		require.NoError(t, err)
+		require.Equal(t, []int64{10, 13, 17}, heights)
+
+		heights, err = idx.FilterBlocks(1, 17, []common.Address{addrB}, [][]common.Hash{{topic1}})
+		require.NoError(t, err)
+		require.Empty(t, heights)
+
+		heights, err = idx.FilterBlocks(6, 9, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, []int64{6, 7, 8, 9}, heights)
+	})
+
+	t.Run("reindex block discards its section until processed", func(t *testing.T) {
+		idx := setup(t)
+
+		// block 3 is reindexed without logs
+		require.NoError(t, idx.ReindexBlock(testBlock(t, 3, nil)))
+		_, sections := idx.BloomStatus()
+		require.EqualValues(t, 1, sections)
+
+		heights, err := idx.FilterBlocks(1, 16, []common.Address{addrA}, nil)
+		require.NoError(t, err)
+		require.Equal(t, []int64{13}, heights)
+
+		require.NoError(t, idx.ProcessSections(3, 3))
+		_, sections = idx.BloomStatus()
+		require.EqualValues(t, 2, sections)
+
+		heights, err = idx.FilterBlocks(1, 16, []common.Address{addrA}, nil)
+		require.NoError(t, err)
+		require.Equal(t, []int64{13}, heights)
+	})
+}
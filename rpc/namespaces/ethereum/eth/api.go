@@ -46,7 +46,7 @@ type EthereumAPI interface {
 		blockNum rpctypes.BlockNumber,
 		idx hexutil.Uint,
 	) (*rpctypes.RPCTransaction, error)
-	// eth_getBlockReceipts
+	GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error)
 
 	// Writing Transactions
 	//
@@ -79,6 +79,7 @@ type EthereumAPI interface {
 		blockNrOrHash rpctypes.BlockNumberOrHash,
 		override *rpctypes.StateOverride,
 	) (hexutil.Bytes, error)
+	SimulateV1(opts rpctypes.SimOpts, blockNrOrHash *rpctypes.BlockNumberOrHash) ([]*rpctypes.SimBlockResult, error)
 
 	// Chain Information
 	//
@@ -170,12 +171,11 @@ func (e *PublicAPI) GetBlockByHash(hash common.Hash, fullTx bool) (map[string]in
 	return e.backend.GetBlockByHash(hash, fullTx)
 }
 
-// TODO https://github.com/zeta-chain/node/issues/4079
 // GetBlockReceipts returns the block receipts for the given block hash or number or tag.
-// func (e *PublicAPI) GetBlockReceipts(ctx context.Context, blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error) {
-// 	e.logger.Debug("eth_getBlockReceipts", "block number or hash", blockNrOrHash)
-// 	return e.backend.GetBlockReceipts(blockNrOrHash)
-// }
+func (e *PublicAPI) GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	e.logger.Debug("eth_getBlockReceipts", "block number or hash", blockNrOrHash)
+	return e.backend.GetBlockReceipts(blockNrOrHash)
+}
 
 ///////////////////////////////////////////////////////////////////////////////
 ///                           Read Txs					                            ///
@@ -322,6 +322,23 @@ func (e *PublicAPI) Call(args evmtypes.TransactionArgs,
 	return (hexutil.Bytes)(data.Ret), nil
 }
 
+// SimulateV1 simulates a series of calls in a series of blocks on top of the state of the given block.
+// The latest block is used if no block is given.
+// Unlike geth, state overrides and validation mode are not supported and return an error.
+func (e *PublicAPI) SimulateV1(
+	opts rpctypes.SimOpts,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+) ([]*rpctypes.SimBlockResult, error) {
+	e.logger.Debug("eth_simulateV1", "blocks", len(opts.BlockStateCalls), "block number or hash", blockNrOrHash)
+
+	latest := rpctypes.EthLatestBlockNumber
+	blockNrOrHashValue := rpctypes.BlockNumberOrHash{BlockNumber: &latest}
+	if blockNrOrHash != nil {
+		blockNrOrHashValue = *blockNrOrHash
+	}
+	return e.backend.SimulateV1(opts, blockNrOrHashValue)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 ///                           Event Logs													          ///
 ///////////////////////////////////////////////////////////////////////////////
@@ -34,7 +34,7 @@ type FilterAPI interface {
 	GetFilterChanges(id rpc.ID) (interface{}, error)
 	GetFilterLogs(ctx context.Context, id rpc.ID) ([]*ethtypes.Log, error)
 	UninstallFilter(id rpc.ID) bool
-	GetLogs(ctx context.Context, crit filters.FilterCriteria) ([]*ethtypes.Log, error)
+	GetLogs(ctx context.Context, crit FilterCriteria) ([]*ethtypes.Log, error)
 }
 
 // Backend defines the methods requided by the PublicFilterAPI backend
@@ -49,6 +49,7 @@ type Backend interface {
 	BlockBloom(blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
 
 	BloomStatus() (uint64, uint64)
+	LogIndexer() types.LogIndexer
 
 	RPCFilterCap() int32
 	RPCLogsCap() int32
@@ -540,13 +541,14 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 }
 
 // GetLogs returns logs matching the given argument that are stored within the state.
+// The logs can be restricted to the deposits executed by the protocol with the protocolDepositsOnly criteria.
 //
 // https://github.com/ethereum/wiki/wiki/JSON-RPC#eth_getlogs
-func (api *PublicFilterAPI) GetLogs(ctx context.Context, crit filters.FilterCriteria) ([]*ethtypes.Log, error) {
+func (api *PublicFilterAPI) GetLogs(ctx context.Context, crit FilterCriteria) ([]*ethtypes.Log, error) {
 	var filter *Filter
 	if crit.BlockHash != nil {
 		// Block filter requested, construct a single-shot filter
-		filter = NewBlockFilter(api.logger, api.backend, crit)
+		filter = NewBlockFilter(api.logger, api.backend, crit.FilterCriteria)
 	} else {
 		// Convert the RPC block numbers into internal representations
 		begin := rpc.LatestBlockNumber.Int64()
@@ -565,6 +567,7 @@ func (api *PublicFilterAPI) GetLogs(ctx context.Context, crit filters.FilterCrit
 		// Construct the range filter
 		filter = NewRangeFilter(api.logger, api.backend, begin, end, crit.Addresses, crit.Topics)
 	}
+	filter.protocolDepositsOnly = crit.ProtocolDepositsOnly
 
 	// Run the filter and return all the logs
 	logs, err := filter.Logs(ctx, int(api.backend.RPCLogsCap()), int64(api.backend.RPCBlockRangeCap()))
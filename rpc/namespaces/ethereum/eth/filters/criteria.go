@@ -0,0 +1,31 @@
+package filters
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/eth/filters"
+)
+
+// FilterCriteria extends the criteria of eth_getLogs with the filters specific to ZetaChain
+type FilterCriteria struct {
+	filters.FilterCriteria
+
+	// ProtocolDepositsOnly selects the logs emitted by the synthetic txs executed by the protocol for the deposits of CCTXs
+	ProtocolDepositsOnly bool `json:"protocolDepositsOnly"`
+}
+
+// UnmarshalJSON sets *c fields from the given JSON, the standard fields are decoded with the go-ethereum criteria
+func (c *FilterCriteria) UnmarshalJSON(data []byte) error {
+	if err := c.FilterCriteria.UnmarshalJSON(data); err != nil {
+		return err
+	}
+
+	var extra struct {
+		ProtocolDepositsOnly bool `json:"protocolDepositsOnly"`
+	}
+	if err := json.Unmarshal(data, &extra); err != nil {
+		return err
+	}
+	c.ProtocolDepositsOnly = extra.ProtocolDepositsOnly
+	return nil
+}
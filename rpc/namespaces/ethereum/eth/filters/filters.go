@@ -33,6 +33,9 @@ type Filter struct {
 	criteria filters.FilterCriteria
 
 	bloomFilters [][]BloomIV // Filter the system is matching for
+
+	// protocolDepositsOnly restricts the logs to the synthetic txs executed by the protocol for deposits
+	protocolDepositsOnly bool
 }
 
 // NewBlockFilter creates a new filter which directly inspects the contents of
@@ -183,6 +186,18 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
 	}
 
+	// serve the logs from the log indexer if it indexed the whole range,
+	// the logs of protocol deposits are filtered from the block results
+	if logIndexer := f.backend.LogIndexer(); logIndexer != nil && !f.protocolDepositsOnly {
+		indexed, err := logIndexer.IsIndexed(int64(from), int64(to)) // #nosec G115 -- block numbers in range
+		if err != nil {
+			return nil, fmt.Errorf("failed to check indexed blocks: %w", err)
+		}
+		if indexed {
+			return f.indexedLogs(logIndexer, int64(from), int64(to), logLimit) // #nosec G115 -- block numbers in range
+		}
+	}
+
 	for height := from; height <= to; height++ {
 		h := int64(height) //#nosec G115
 		blockRes, err := f.backend.TendermintBlockResultByNumber(&h)
@@ -216,7 +231,12 @@ func (f *Filter) blockLogs(blockRes *tmrpctypes.ResultBlockResults, bloom ethtyp
 		return []*ethtypes.Log{}, nil
 	}
 
-	logsList, err := backend.GetLogsFromBlockResults(blockRes)
+	getLogs := backend.GetLogsFromBlockResults
+	if f.protocolDepositsOnly {
+		getLogs = backend.GetProtocolDepositLogsFromBlockResults
+	}
+
+	logsList, err := getLogs(blockRes)
 	if err != nil {
 		return []*ethtypes.Log{}, errors.Wrapf(err, "failed to fetch logs block number %d", blockRes.Height)
 	}
@@ -234,6 +254,35 @@ func (f *Filter) blockLogs(blockRes *tmrpctypes.ResultBlockResults, bloom ethtyp
 	return logs, nil
 }
 
+// indexedLogs returns the logs matching the filter criteria within the blocks of the log indexer.
+func (f *Filter) indexedLogs(logIndexer types.LogIndexer, from, to int64, logLimit int) ([]*ethtypes.Log, error) {
+	heights, err := logIndexer.FilterBlocks(from, to, f.criteria.Addresses, f.criteria.Topics)
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter indexed blocks: %w", err)
+	}
+
+	logs := []*ethtypes.Log{}
+	for _, height := range heights {
+		logsList, err := logIndexer.BlockLogs(height)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch indexed logs of block %d: %w", height, err)
+		}
+
+		unfiltered := make([]*ethtypes.Log, 0)
+		for _, txLogs := range logsList {
+			unfiltered = append(unfiltered, txLogs...)
+		}
+		filtered := FilterLogs(unfiltered, nil, nil, f.criteria.Addresses, f.criteria.Topics)
+
+		// check logs limit
+		if len(logs)+len(filtered) > logLimit {
+			return nil, fmt.Errorf("query returned more than %d results", logLimit)
+		}
+		logs = append(logs, filtered...)
+	}
+	return logs, nil
+}
+
 func createBloomFilters(filters [][][]byte, logger log.Logger) [][]BloomIV {
 	bloomFilters := make([][]BloomIV, 0)
 	for _, filter := range filters {
@@ -0,0 +1,148 @@
+package zeta
+
+import (
+	"context"
+	"encoding/json"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+// Backend defines the methods required by the zeta API from the EVM backend
+type Backend interface {
+	GetTransactionLogs(hash common.Hash) ([]*ethtypes.Log, error)
+}
+
+// PublicAPI is the zeta_ prefixed set of APIs exposing the cross-chain data of ZetaChain on the ZEVM JSON-RPC endpoint.
+// CCTXs and pending nonces are returned with the same JSON encoding as the Cosmos REST endpoints.
+type PublicAPI struct {
+	logger           log.Logger
+	clientCtx        client.Context
+	backend          Backend
+	crosschainClient crosschaintypes.QueryClient
+	observerClient   observertypes.QueryClient
+	authorityClient  authoritytypes.QueryClient
+}
+
+// NewPublicAPI creates an instance of the public zeta API.
+func NewPublicAPI(logger log.Logger, clientCtx client.Context, backend Backend) *PublicAPI {
+	return &PublicAPI{
+		logger:           logger.With("api", "zeta"),
+		clientCtx:        clientCtx,
+		backend:          backend,
+		crosschainClient: crosschaintypes.NewQueryClient(clientCtx),
+		observerClient:   observertypes.NewQueryClient(clientCtx),
+		authorityClient:  authoritytypes.NewQueryClient(clientCtx),
+	}
+}
+
+// GetCctx returns the CCTX with the given index.
+func (api *PublicAPI) GetCctx(ctx context.Context, index string) (json.RawMessage, error) {
+	api.logger.Debug("zeta_getCctx", "index", index)
+
+	res, err := api.crosschainClient.Cctx(ctx, &crosschaintypes.QueryGetCctxRequest{Index: index})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get cctx %s", index)
+	}
+	return api.clientCtx.Codec.MarshalJSON(res.CrossChainTx)
+}
+
+// GetCctxByInboundHash returns the CCTXs created from the given inbound hash.
+// An empty list is returned if no CCTX exists for the inbound hash.
+func (api *PublicAPI) GetCctxByInboundHash(ctx context.Context, inboundHash string) ([]json.RawMessage, error) {
+	api.logger.Debug("zeta_getCctxByInboundHash", "hash", inboundHash)
+
+	cctxs, err := api.cctxsByInboundHash(ctx, inboundHash)
+	if err != nil {
+		return nil, err
+	}
+	return api.marshalCctxs(cctxs)
+}
+
+// GetCctxsByZevmTx returns the CCTXs created from the withdraw and call events emitted by the given ZEVM transaction.
+// These CCTXs are the outbounds triggered by the transaction on the connected chains.
+func (api *PublicAPI) GetCctxsByZevmTx(ctx context.Context, hash common.Hash) ([]json.RawMessage, error) {
+	api.logger.Debug("zeta_getCctxsByZevmTx", "hash", hash.Hex())
+
+	// ensure the transaction exists on ZEVM
+	if _, err := api.backend.GetTransactionLogs(hash); err != nil {
+		return nil, errors.Wrapf(err, "failed to get logs of transaction %s", hash.Hex())
+	}
+
+	// the CCTXs created from the events of a ZEVM transaction use the transaction hash as inbound hash
+	cctxs, err := api.cctxsByInboundHash(ctx, hash.Hex())
+	if err != nil {
+		return nil, err
+	}
+
+	additionalChains, err := api.additionalChains(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return api.marshalCctxs(FilterZEVMInbounds(cctxs, additionalChains))
+}
+
+// GetPendingNonces returns the range of outbound nonces that are pending for the given chain.
+func (api *PublicAPI) GetPendingNonces(ctx context.Context, chainID int64) (json.RawMessage, error) {
+	api.logger.Debug("zeta_getPendingNonces", "chain_id", chainID)
+
+	res, err := api.observerClient.PendingNoncesByChain(ctx, &observertypes.QueryPendingNoncesByChainRequest{
+		ChainId: chainID,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get pending nonces for chain %d", chainID)
+	}
+	return api.clientCtx.Codec.MarshalJSON(&res.PendingNonces)
+}
+
+// cctxsByInboundHash returns the CCTXs created from the given inbound hash, an empty list is returned if none exists
+func (api *PublicAPI) cctxsByInboundHash(
+	ctx context.Context,
+	inboundHash string,
+) ([]crosschaintypes.CrossChainTx, error) {
+	res, err := api.crosschainClient.InboundHashToCctxData(ctx, &crosschaintypes.QueryInboundHashToCctxDataRequest{
+		InboundHash: inboundHash,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
+			return []crosschaintypes.CrossChainTx{}, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get cctxs for inbound hash %s", inboundHash)
+	}
+	return res.CrossChainTxs, nil
+}
+
+// additionalChains returns the chains added by the authority on top of the default chain list
+func (api *PublicAPI) additionalChains(ctx context.Context) ([]chains.Chain, error) {
+	res, err := api.authorityClient.ChainInfo(ctx, &authoritytypes.QueryGetChainInfoRequest{})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
+			return []chains.Chain{}, nil
+		}
+		return nil, errors.Wrap(err, "failed to get additional chains")
+	}
+	return res.ChainInfo.Chains, nil
+}
+
+// marshalCctxs encodes the CCTXs with the codec of the client context
+func (api *PublicAPI) marshalCctxs(cctxs []crosschaintypes.CrossChainTx) ([]json.RawMessage, error) {
+	res := make([]json.RawMessage, 0, len(cctxs))
+	for i := range cctxs {
+		bz, err := api.clientCtx.Codec.MarshalJSON(&cctxs[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal cctx %s", cctxs[i].Index)
+		}
+		res = append(res, bz)
+	}
+	return res, nil
+}
@@ -0,0 +1,238 @@
+package zeta
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/testutil/sample"
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+type backendStub struct {
+	err error
+}
+
+func (b backendStub) GetTransactionLogs(common.Hash) ([]*ethtypes.Log, error) {
+	return nil, b.err
+}
+
+type crosschainClientStub struct {
+	crosschaintypes.QueryClient
+	cctxs map[string]crosschaintypes.CrossChainTx
+}
+
+func (c crosschainClientStub) Cctx(
+	_ context.Context,
+	req *crosschaintypes.QueryGetCctxRequest,
+	_ ...grpc.CallOption,
+) (*crosschaintypes.QueryGetCctxResponse, error) {
+	cctx, ok := c.cctxs[req.Index]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return &crosschaintypes.QueryGetCctxResponse{CrossChainTx: &cctx}, nil
+}
+
+func (c crosschainClientStub) InboundHashToCctxData(
+	_ context.Context,
+	req *crosschaintypes.QueryInboundHashToCctxDataRequest,
+	_ ...grpc.CallOption,
+) (*crosschaintypes.QueryInboundHashToCctxDataResponse, error) {
+	var res []crosschaintypes.CrossChainTx
+	for _, cctx := range c.cctxs {
+		if cctx.InboundParams.ObservedHash == req.InboundHash {
+			res = append(res, cctx)
+		}
+	}
+	if len(res) == 0 {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return &crosschaintypes.QueryInboundHashToCctxDataResponse{CrossChainTxs: res}, nil
+}
+
+type observerClientStub struct {
+	observertypes.QueryClient
+	pendingNonces map[int64]observertypes.PendingNonces
+}
+
+func (c observerClientStub) PendingNoncesByChain(
+	_ context.Context,
+	req *observertypes.QueryPendingNoncesByChainRequest,
+	_ ...grpc.CallOption,
+) (*observertypes.QueryPendingNoncesByChainResponse, error) {
+	pendingNonces, ok := c.pendingNonces[req.ChainId]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return &observertypes.QueryPendingNoncesByChainResponse{PendingNonces: pendingNonces}, nil
+}
+
+type authorityClientStub struct {
+	authoritytypes.QueryClient
+	chains []chains.Chain
+}
+
+func (c authorityClientStub) ChainInfo(
+	context.Context,
+	*authoritytypes.QueryGetChainInfoRequest,
+	...grpc.CallOption,
+) (*authoritytypes.QueryGetChainInfoResponse, error) {
+	if c.chains == nil {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return &authoritytypes.QueryGetChainInfoResponse{
+		ChainInfo: authoritytypes.ChainInfo{Chains: c.chains},
+	}, nil
+}
+
+func newTestAPI(
+	backend Backend,
+	cctxs []crosschaintypes.CrossChainTx,
+	pendingNonces []observertypes.PendingNonces,
+	additionalChains []chains.Chain,
+) *PublicAPI {
+	cctxsByIndex := make(map[string]crosschaintypes.CrossChainTx, len(cctxs))
+	for _, cctx := range cctxs {
+		cctxsByIndex[cctx.Index] = cctx
+	}
+	pendingNoncesByChain := make(map[int64]observertypes.PendingNonces, len(pendingNonces))
+	for _, pn := range pendingNonces {
+		pendingNoncesByChain[pn.ChainId] = pn
+	}
+
+	return &PublicAPI{
+		logger:           log.NewNopLogger(),
+		clientCtx:        client.Context{Codec: codec.NewProtoCodec(codectypes.NewInterfaceRegistry())},
+		backend:          backend,
+		crosschainClient: crosschainClientStub{cctxs: cctxsByIndex},
+		observerClient:   observerClientStub{pendingNonces: pendingNoncesByChain},
+		authorityClient:  authorityClientStub{chains: additionalChains},
+	}
+}
+
+// unmarshalCctxs decodes the CCTXs returned by the API
+func unmarshalCctxs(t *testing.T, api *PublicAPI, res []json.RawMessage) []crosschaintypes.CrossChainTx {
+	cctxs := make([]crosschaintypes.CrossChainTx, len(res))
+	for i, bz := range res {
+		require.NoError(t, api.clientCtx.Codec.UnmarshalJSON(bz, &cctxs[i]))
+	}
+	return cctxs
+}
+
+func TestPublicAPI_GetCctx(t *testing.T) {
+	cctx := sample.CrossChainTx(t, "foo")
+	api := newTestAPI(backendStub{}, []crosschaintypes.CrossChainTx{*cctx}, nil, nil)
+
+	t.Run("returns the cctx", func(t *testing.T) {
+		res, err := api.GetCctx(context.Background(), cctx.Index)
+		require.NoError(t, err)
+
+		var got crosschaintypes.CrossChainTx
+		require.NoError(t, api.clientCtx.Codec.UnmarshalJSON(res, &got))
+		require.Equal(t, cctx.Index, got.Index)
+	})
+
+	t.Run("returns an error if the cctx doesn't exist", func(t *testing.T) {
+		_, err := api.GetCctx(context.Background(), "0x1")
+		require.ErrorContains(t, err, "failed to get cctx 0x1")
+	})
+}
+
+func TestPublicAPI_GetCctxByInboundHash(t *testing.T) {
+	cctx := sample.CrossChainTx(t, "foo")
+	api := newTestAPI(backendStub{}, []crosschaintypes.CrossChainTx{*cctx}, nil, nil)
+
+	t.Run("returns the cctxs of the inbound", func(t *testing.T) {
+		res, err := api.GetCctxByInboundHash(context.Background(), cctx.InboundParams.ObservedHash)
+		require.NoError(t, err)
+
+		cctxs := unmarshalCctxs(t, api, res)
+		require.Len(t, cctxs, 1)
+		require.Equal(t, cctx.Index, cctxs[0].Index)
+	})
+
+	t.Run("returns an empty list if no cctx exists for the inbound", func(t *testing.T) {
+		res, err := api.GetCctxByInboundHash(context.Background(), "0x1")
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		require.Empty(t, res)
+	})
+}
+
+func TestPublicAPI_GetCctxsByZevmTx(t *testing.T) {
+	txHash := sample.Hash()
+
+	fromZEVM := sample.CrossChainTx(t, "foo")
+	fromZEVM.InboundParams.SenderChainId = chains.ZetaChainMainnet.ChainId
+	fromZEVM.InboundParams.ObservedHash = txHash.Hex()
+
+	fromAdditionalZetaChain := sample.CrossChainTx(t, "bar")
+	fromAdditionalZetaChain.InboundParams.SenderChainId = 9999
+	fromAdditionalZetaChain.InboundParams.ObservedHash = txHash.Hex()
+
+	additionalZetaChain := sample.Chain(9999)
+	additionalZetaChain.Network = chains.Network_zeta
+
+	cctxs := []crosschaintypes.CrossChainTx{*fromZEVM, *fromAdditionalZetaChain}
+
+	t.Run("returns the cctxs created by the zevm tx", func(t *testing.T) {
+		api := newTestAPI(backendStub{}, cctxs, nil, nil)
+
+		res, err := api.GetCctxsByZevmTx(context.Background(), txHash)
+		require.NoError(t, err)
+
+		got := unmarshalCctxs(t, api, res)
+		require.Len(t, got, 1)
+		require.Equal(t, fromZEVM.Index, got[0].Index)
+	})
+
+	t.Run("uses the additional chains of the authority", func(t *testing.T) {
+		api := newTestAPI(backendStub{}, cctxs, nil, []chains.Chain{additionalZetaChain})
+
+		res, err := api.GetCctxsByZevmTx(context.Background(), txHash)
+		require.NoError(t, err)
+		require.Len(t, unmarshalCctxs(t, api, res), 2)
+	})
+
+	t.Run("returns an error if the tx doesn't exist", func(t *testing.T) {
+		api := newTestAPI(backendStub{err: errors.New("not found")}, cctxs, nil, nil)
+
+		_, err := api.GetCctxsByZevmTx(context.Background(), txHash)
+		require.ErrorContains(t, err, "failed to get logs of transaction")
+	})
+}
+
+func TestPublicAPI_GetPendingNonces(t *testing.T) {
+	pendingNonces := sample.PendingNoncesList(t, "foo", 1)[0]
+	api := newTestAPI(backendStub{}, nil, []observertypes.PendingNonces{pendingNonces}, nil)
+
+	t.Run("returns the pending nonces of the chain", func(t *testing.T) {
+		res, err := api.GetPendingNonces(context.Background(), pendingNonces.ChainId)
+		require.NoError(t, err)
+
+		var got observertypes.PendingNonces
+		require.NoError(t, api.clientCtx.Codec.UnmarshalJSON(res, &got))
+		require.Equal(t, pendingNonces, got)
+	})
+
+	t.Run("returns an error if the chain has no pending nonces", func(t *testing.T) {
+		_, err := api.GetPendingNonces(context.Background(), pendingNonces.ChainId+1)
+		require.ErrorContains(t, err, "failed to get pending nonces")
+	})
+}
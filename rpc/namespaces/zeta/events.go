@@ -0,0 +1,111 @@
+package zeta
+
+import (
+	"encoding/json"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/pkg/errors"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+)
+
+const (
+	// crosschainEventPrefix is the prefix of the typed events emitted by the crosschain module
+	crosschainEventPrefix = "zetachain.zetacore.crosschain.Event"
+
+	// cctxIndexAttribute is the attribute of the crosschain typed events containing the CCTX index
+	cctxIndexAttribute = "cctx_index"
+)
+
+// CctxCriteria defines the criteria of a zeta_subscribeCctx subscription
+// An empty criteria matches the updates of all CCTXs
+type CctxCriteria struct {
+	CctxIndex   string `json:"cctxIndex"`
+	InboundHash string `json:"inboundHash"`
+}
+
+// ParseCctxCriteria parses the optional criteria parameter of a zeta_subscribeCctx subscription
+func ParseCctxCriteria(param interface{}) (CctxCriteria, error) {
+	var crit CctxCriteria
+	if param == nil {
+		return crit, nil
+	}
+
+	bz, err := json.Marshal(param)
+	if err != nil {
+		return crit, errors.Wrap(err, "invalid criteria")
+	}
+	if err := json.Unmarshal(bz, &crit); err != nil {
+		return crit, errors.Wrap(err, "invalid criteria")
+	}
+	return crit, nil
+}
+
+// MatchesIndex returns true if a CCTX with the given index can match the criteria
+// it allows to skip the query of the CCTXs that are not subscribed
+func (c CctxCriteria) MatchesIndex(index string) bool {
+	return c.CctxIndex == "" || strings.EqualFold(c.CctxIndex, index)
+}
+
+// Matches returns true if the CCTX matches the criteria
+func (c CctxCriteria) Matches(cctx crosschaintypes.CrossChainTx) bool {
+	if !c.MatchesIndex(cctx.Index) {
+		return false
+	}
+	if c.InboundHash != "" {
+		if cctx.InboundParams == nil || !strings.EqualFold(c.InboundHash, cctx.InboundParams.ObservedHash) {
+			return false
+		}
+	}
+	return true
+}
+
+// CctxIndexesFromEvents returns the indexes of the CCTXs updated by a transaction from its events
+// the indexes are returned without duplicates in the order of the events
+func CctxIndexesFromEvents(events []abci.Event) []string {
+	var (
+		indexes []string
+		seen    = make(map[string]struct{})
+	)
+	for _, event := range events {
+		if !strings.HasPrefix(event.Type, crosschainEventPrefix) {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key != cctxIndexAttribute {
+				continue
+			}
+
+			// typed events attributes are JSON encoded
+			var index string
+			if err := json.Unmarshal([]byte(attr.Value), &index); err != nil {
+				index = attr.Value
+			}
+			if index == "" {
+				continue
+			}
+			if _, ok := seen[index]; !ok {
+				seen[index] = struct{}{}
+				indexes = append(indexes, index)
+			}
+		}
+	}
+	return indexes
+}
+
+// FilterZEVMInbounds returns the CCTXs whose inbound is a ZEVM transaction
+// additionalChains are the chains added by the authority on top of the default chain list
+func FilterZEVMInbounds(
+	cctxs []crosschaintypes.CrossChainTx,
+	additionalChains []chains.Chain,
+) []crosschaintypes.CrossChainTx {
+	filtered := make([]crosschaintypes.CrossChainTx, 0, len(cctxs))
+	for _, cctx := range cctxs {
+		if cctx.InboundParams != nil && chains.IsZetaChain(cctx.InboundParams.SenderChainId, additionalChains) {
+			filtered = append(filtered, cctx)
+		}
+	}
+	return filtered
+}
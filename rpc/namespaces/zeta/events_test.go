@@ -0,0 +1,99 @@
+package zeta_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/rpc/namespaces/zeta"
+	"github.com/zeta-chain/node/testutil/sample"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestCctxIndexesFromEvents(t *testing.T) {
+	typedEvent := func(event *crosschaintypes.EventOutboundSuccess) abci.Event {
+		e, err := sdk.TypedEventToEvent(event)
+		require.NoError(t, err)
+		return abci.Event(e)
+	}
+
+	t.Run("returns the cctx indexes from crosschain events without duplicates", func(t *testing.T) {
+		events := []abci.Event{
+			typedEvent(&crosschaintypes.EventOutboundSuccess{CctxIndex: "0x1"}),
+			{
+				Type: sdk.EventTypeMessage,
+				Attributes: []abci.EventAttribute{
+					{Key: "cctx_index", Value: "0x3"},
+				},
+			},
+			typedEvent(&crosschaintypes.EventOutboundSuccess{CctxIndex: "0x2"}),
+			typedEvent(&crosschaintypes.EventOutboundSuccess{CctxIndex: "0x1"}),
+		}
+
+		require.Equal(t, []string{"0x1", "0x2"}, zeta.CctxIndexesFromEvents(events))
+	})
+
+	t.Run("returns empty list if no crosschain event", func(t *testing.T) {
+		require.Empty(t, zeta.CctxIndexesFromEvents(nil))
+	})
+}
+
+func TestParseCctxCriteria(t *testing.T) {
+	t.Run("empty criteria if no param", func(t *testing.T) {
+		crit, err := zeta.ParseCctxCriteria(nil)
+		require.NoError(t, err)
+		require.Equal(t, zeta.CctxCriteria{}, crit)
+	})
+
+	t.Run("parse criteria", func(t *testing.T) {
+		crit, err := zeta.ParseCctxCriteria(map[string]interface{}{
+			"cctxIndex":   "0x1",
+			"inboundHash": "0x2",
+		})
+		require.NoError(t, err)
+		require.Equal(t, zeta.CctxCriteria{CctxIndex: "0x1", InboundHash: "0x2"}, crit)
+	})
+
+	t.Run("fail if invalid criteria", func(t *testing.T) {
+		_, err := zeta.ParseCctxCriteria("foo")
+		require.Error(t, err)
+	})
+}
+
+func TestCctxCriteria_Matches(t *testing.T) {
+	cctx := sample.CrossChainTx(t, "foo")
+
+	require.True(t, zeta.CctxCriteria{}.Matches(*cctx))
+	require.True(t, zeta.CctxCriteria{CctxIndex: cctx.Index}.Matches(*cctx))
+	require.True(t, zeta.CctxCriteria{InboundHash: cctx.InboundParams.ObservedHash}.Matches(*cctx))
+	require.False(t, zeta.CctxCriteria{CctxIndex: "0x1"}.Matches(*cctx))
+	require.False(t, zeta.CctxCriteria{InboundHash: "0x1"}.Matches(*cctx))
+}
+
+func TestFilterZEVMInbounds(t *testing.T) {
+	fromZEVM := sample.CrossChainTx(t, "foo")
+	fromZEVM.InboundParams.SenderChainId = chains.ZetaChainMainnet.ChainId
+	fromEthereum := sample.CrossChainTx(t, "bar")
+	fromEthereum.InboundParams.SenderChainId = chains.Ethereum.ChainId
+
+	fromAdditionalZetaChain := sample.CrossChainTx(t, "baz")
+	fromAdditionalZetaChain.InboundParams.SenderChainId = 9999
+
+	additionalZetaChain := sample.Chain(9999)
+	additionalZetaChain.Network = chains.Network_zeta
+
+	cctxs := []crosschaintypes.CrossChainTx{*fromZEVM, *fromEthereum, *fromAdditionalZetaChain}
+
+	t.Run("default chains", func(t *testing.T) {
+		filtered := zeta.FilterZEVMInbounds(cctxs, nil)
+		require.Equal(t, []crosschaintypes.CrossChainTx{*fromZEVM}, filtered)
+	})
+
+	t.Run("additional chains", func(t *testing.T) {
+		filtered := zeta.FilterZEVMInbounds(cctxs, []chains.Chain{additionalZetaChain})
+		require.Equal(t, []crosschaintypes.CrossChainTx{*fromZEVM, *fromAdditionalZetaChain}, filtered)
+	})
+}
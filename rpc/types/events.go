@@ -24,9 +24,12 @@ import (
 type EventFormat int
 
 const (
-	AttributeKeyTxNonce    = "txNonce"
-	AttributeKeyTxData     = "txData"
-	AttributeKeyTxGasLimit = "txGasLimit"
+	AttributeKeyTxNonce        = "txNonce"
+	AttributeKeyTxData         = "txData"
+	AttributeKeyTxGasLimit     = "txGasLimit"
+	AttributeKeyCctxIndex      = "cctxIndex"
+	AttributeKeyInboundChainID = "inboundChainId"
+	AttributeKeyInboundHash    = "inboundHash"
 )
 
 const (
@@ -81,6 +84,10 @@ type ParsedTx struct {
 	Sender    common.Address
 	Nonce     uint64
 	Data      []byte
+	// CCTX at the origin of synthetic txs executed by the protocol
+	CctxIndex      string
+	InboundChainID int64
+	InboundHash    string
 }
 
 // NewParsedTx initialize a ParsedTx
@@ -238,6 +245,10 @@ func ParseTxIndexerResult(
 				GasLimit:  parsedTx.GasLimit,
 				Data:      parsedTx.Data,
 				Nonce:     parsedTx.Nonce,
+
+				CctxIndex:      parsedTx.CctxIndex,
+				InboundChainID: parsedTx.InboundChainID,
+				InboundHash:    parsedTx.InboundHash,
 			}, nil
 	}
 	return &types.TxResult{
@@ -291,6 +302,10 @@ func ParseTxBlockResult(
 				GasLimit:  parsedTx.GasLimit,
 				Data:      parsedTx.Data,
 				Nonce:     parsedTx.Nonce,
+
+				CctxIndex:      parsedTx.CctxIndex,
+				InboundChainID: parsedTx.InboundChainID,
+				InboundHash:    parsedTx.InboundHash,
 			}, nil
 	}
 	return &types.TxResult{
@@ -429,6 +444,16 @@ func fillTxAttribute(tx *ParsedTx, key string, value string) error {
 			return err
 		}
 		tx.Data = hexBytes
+	case AttributeKeyCctxIndex:
+		tx.CctxIndex = value
+	case AttributeKeyInboundChainID:
+		chainID, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		tx.InboundChainID = chainID
+	case AttributeKeyInboundHash:
+		tx.InboundHash = value
 	}
 	return nil
 }
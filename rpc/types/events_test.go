@@ -134,6 +134,44 @@ func TestParseTxResult(t *testing.T) {
 				},
 			},
 		},
+		{
+			"synthetic deposit tx with cctx origin",
+			abci.ExecTxResult{
+				GasUsed: 21000,
+				Events: []abci.Event{
+					{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "0"},
+						{Key: "amount", Value: "1000"},
+						{Key: "txGasUsed", Value: "30000"},
+						{Key: "txHash", Value: "14A84ED06282645EFBF080E0B7ED80D8D8D6A36337668A12B5F229F81CDD3F57"},
+						{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+						{Key: "sender", Value: address},
+						{Key: "txType", Value: "88"},
+						{Key: "cctxIndex", Value: "0xcctx"},
+						{Key: "inboundChainId", Value: "11155111"},
+						{Key: "inboundHash", Value: "0xinbound"},
+					}},
+				},
+			},
+			[]*ParsedTx{
+				{
+					MsgIndex:       0,
+					Hash:           txHash,
+					EthTxIndex:     0,
+					GasUsed:        30000,
+					Failed:         false,
+					TxHash:         "14A84ED06282645EFBF080E0B7ED80D8D8D6A36337668A12B5F229F81CDD3F57",
+					Type:           CosmosEVMTxType,
+					Amount:         big.NewInt(1000),
+					Recipient:      common.HexToAddress("0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"),
+					Sender:         common.HexToAddress(address),
+					CctxIndex:      "0xcctx",
+					InboundChainID: 11155111,
+					InboundHash:    "0xinbound",
+				},
+			},
+		},
 		{
 			"format 1 events, failed",
 			abci.ExecTxResult{
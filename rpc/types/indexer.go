@@ -0,0 +1,22 @@
+package types
+
+import (
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// LogIndexer is an EVM tx indexer that also indexes the logs of the blocks in its own database.
+// The logs of the indexed blocks can be queried even if the block results were pruned from the node.
+type LogIndexer interface {
+	cosmosevmtypes.EVMTxIndexer
+
+	// IsIndexed returns true if all the blocks in [from, to] are indexed
+	IsIndexed(from, to int64) (bool, error)
+	// BlockLogs returns the logs of an indexed block grouped by tx, nil if the block is not indexed
+	BlockLogs(height int64) ([][]*ethtypes.Log, error)
+	// FilterBlocks returns the indexed blocks in [from, to] that may contain logs matching the addresses and topics
+	FilterBlocks(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, error)
+	// BloomStatus returns the number of blocks of a bloom bits section and the number of processed sections
+	BloomStatus() (uint64, uint64)
+}
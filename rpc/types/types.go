@@ -42,6 +42,16 @@ type TxResultAdditionalFields struct {
 	GasLimit  *uint64        `json:"gasLimit"`
 	Nonce     uint64         `json:"nonce"`
 	Data      []byte         `json:"data"`
+
+	// CCTX at the origin of synthetic txs executed by the protocol
+	CctxIndex      string `json:"cctxIndex"`
+	InboundChainID int64  `json:"inboundChainId"`
+	InboundHash    string `json:"inboundHash"`
+}
+
+// HasCCTXOrigin returns true if the synthetic tx was executed by the protocol for a CCTX
+func (f *TxResultAdditionalFields) HasCCTXOrigin() bool {
+	return f != nil && f.CctxIndex != ""
 }
 
 // RPCTransaction represents a transaction that will serialize to the RPC representation of a transaction
@@ -65,6 +75,11 @@ type RPCTransaction struct {
 	V                *hexutil.Big         `json:"v"`
 	R                *hexutil.Big         `json:"r"`
 	S                *hexutil.Big         `json:"s"`
+
+	// CCTX at the origin of synthetic txs executed by the protocol
+	CctxIndex      *string      `json:"cctxIndex,omitempty"`
+	InboundChainID *hexutil.Big `json:"inboundChainId,omitempty"`
+	InboundHash    *string      `json:"inboundHash,omitempty"`
 }
 
 // StateOverride is the collection of overridden accounts.
@@ -106,3 +121,72 @@ type TraceConfig struct {
 	evmtypes.TraceConfig
 	TracerConfig interface{} `json:"tracerConfig"`
 }
+
+// TraceCallConfig is the config of debug_traceCall, it extends TraceConfig with the block overrides of the traced call
+// StateOverrides is only decoded to reject the calls setting it, unlike geth the state of the block can't be overridden
+type TraceCallConfig struct {
+	TraceConfig
+	StateOverrides *StateOverride  `json:"stateOverrides"`
+	BlockOverrides *BlockOverrides `json:"blockOverrides"`
+}
+
+// IsEmpty returns true if no account is overridden
+func (s *StateOverride) IsEmpty() bool {
+	return s == nil || len(*s) == 0
+}
+
+// BlockOverrides is a set of header fields to override during the execution of a message call
+// Copied from geth since it is registered under an internal pkg.
+type BlockOverrides struct {
+	Number        *hexutil.Big    `json:"number"`
+	Difficulty    *hexutil.Big    `json:"difficulty"`
+	Time          *hexutil.Uint64 `json:"time"`
+	GasLimit      *hexutil.Uint64 `json:"gasLimit"`
+	FeeRecipient  *common.Address `json:"feeRecipient"`
+	PrevRandao    *common.Hash    `json:"prevRandao"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas"`
+	BlobBaseFee   *hexutil.Big    `json:"blobBaseFee"`
+}
+
+// SimOpts are the inputs of eth_simulateV1
+type SimOpts struct {
+	BlockStateCalls        []SimBlock `json:"blockStateCalls"`
+	TraceTransfers         bool       `json:"traceTransfers"`
+	Validation             bool       `json:"validation"`
+	ReturnFullTransactions bool       `json:"returnFullTransactions"`
+}
+
+// SimBlock is a batch of calls simulated in the same block
+// StateOverrides is only decoded to reject the blocks setting it, unlike geth the state of the block can't be overridden
+type SimBlock struct {
+	BlockOverrides *BlockOverrides            `json:"blockOverrides"`
+	StateOverrides *StateOverride             `json:"stateOverrides"`
+	Calls          []evmtypes.TransactionArgs `json:"calls"`
+}
+
+// SimBlockResult is the result of the simulation of a block
+type SimBlockResult struct {
+	Number        hexutil.Uint64  `json:"number"`
+	Timestamp     hexutil.Uint64  `json:"timestamp"`
+	GasLimit      hexutil.Uint64  `json:"gasLimit"`
+	GasUsed       hexutil.Uint64  `json:"gasUsed"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas"`
+	Transactions  []interface{}   `json:"transactions"`
+	Calls         []SimCallResult `json:"calls"`
+}
+
+// SimCallResult is the result of the simulation of a call
+type SimCallResult struct {
+	ReturnValue hexutil.Bytes   `json:"returnData"`
+	Logs        []*ethtypes.Log `json:"logs"`
+	GasUsed     hexutil.Uint64  `json:"gasUsed"`
+	Status      hexutil.Uint64  `json:"status"`
+	Error       *SimCallError   `json:"error,omitempty"`
+}
+
+// SimCallError is the error of a simulated call that failed
+type SimCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
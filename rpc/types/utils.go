@@ -263,6 +263,11 @@ func NewRPCTransactionFromIncompleteMsg(
 		result.BlockNumber = (*hexutil.Big)(new(big.Int).SetUint64(blockNumber))
 		result.TransactionIndex = (*hexutil.Uint64)(&index)
 	}
+	if txAdditional.HasCCTXOrigin() {
+		result.CctxIndex = &txAdditional.CctxIndex
+		result.InboundChainID = (*hexutil.Big)(big.NewInt(txAdditional.InboundChainID))
+		result.InboundHash = &txAdditional.InboundHash
+	}
 	return result, nil
 }
 
@@ -32,11 +32,18 @@ import (
 
 	"github.com/zeta-chain/node/rpc/ethereum/pubsub"
 	rpcfilters "github.com/zeta-chain/node/rpc/namespaces/ethereum/eth/filters"
+	"github.com/zeta-chain/node/rpc/namespaces/zeta"
 	"github.com/zeta-chain/node/rpc/types"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
 )
 
 const (
 	maxMessageSize = 1 << 20 // 1 MiB is the max message size for the websocket server
+
+	// zeta_ prefixed subscription methods, the subscriptions are notified with the zeta_subscription method
+	zetaSubscribeCctxMethod   = "zeta_subscribeCctx"
+	zetaUnsubscribeCctxMethod = "zeta_unsubscribeCctx"
+	zetaSubscriptionMethod    = "zeta_subscription"
 )
 
 type WebsocketsServer interface {
@@ -321,14 +328,22 @@ readLoop:
 		}
 
 		switch method {
-		case "eth_subscribe":
-			params, ok := s.getParamsAndCheckValid(msg, wsConn)
-			if !ok {
-				continue
+		case "eth_subscribe", zetaSubscribeCctxMethod:
+			var (
+				subID   = rpc.NewID()
+				unsubFn pubsub.UnsubscribeFunc
+			)
+			if method == zetaSubscribeCctxMethod {
+				// the criteria of the cctx subscription are optional
+				params, _ := msg["params"].([]interface{})
+				unsubFn, err = s.api.subscribeCctx(wsConn, subID, params)
+			} else {
+				params, ok := s.getParamsAndCheckValid(msg, wsConn)
+				if !ok {
+					continue
+				}
+				unsubFn, err = s.api.subscribe(wsConn, subID, params)
 			}
-
-			subID := rpc.NewID()
-			unsubFn, err := s.api.subscribe(wsConn, subID, params)
 			if err != nil {
 				s.sendErrResponse(wsConn, err.Error())
 				continue
@@ -345,7 +360,7 @@ readLoop:
 				s.logger.Error("error writing subscription response", "error", err.Error())
 				break readLoop
 			}
-		case "eth_unsubscribe":
+		case "eth_unsubscribe", zetaUnsubscribeCctxMethod:
 			params, ok := s.getParamsAndCheckValid(msg, wsConn)
 			if !ok {
 				continue
@@ -781,6 +796,106 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 	return unsubFn, nil
 }
 
+// subscribeCctx subscribes to the updates of the CCTXs matching the optional criteria in params
+// the updated CCTXs are read from the crosschain events of the committed transactions
+//
+// Note: only the updates made by transactions are pushed, the status changes made outside of a transaction
+// during BeginBlock/EndBlock (e.g. aborts or expiries) are not notified and must be polled with zeta_getCctx
+func (api *pubSubAPI) subscribeCctx(
+	wsConn *wsConn,
+	subID rpc.ID,
+	params []interface{},
+) (pubsub.UnsubscribeFunc, error) {
+	var extra interface{}
+	if len(params) > 0 {
+		extra = params[0]
+	}
+	crit, err := zeta.ParseCctxCriteria(extra)
+	if err != nil {
+		api.logger.Debug("invalid criteria", "type", fmt.Sprintf("%T", extra))
+		return nil, err
+	}
+
+	sub, unsubFn, err := api.events.SubscribePendingTxs()
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating cctx subscription")
+	}
+
+	queryClient := crosschaintypes.NewQueryClient(api.clientCtx)
+
+	go func() {
+		txsCh := sub.Event()
+		errCh := sub.Err()
+		for {
+			select {
+			case ev, ok := <-txsCh:
+				if !ok {
+					return
+				}
+
+				data, ok := ev.Data.(cmttypes.EventDataTx)
+				if !ok {
+					api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
+					continue
+				}
+
+				for _, index := range zeta.CctxIndexesFromEvents(data.Result.Events) {
+					if !crit.MatchesIndex(index) {
+						continue
+					}
+
+					res, err := queryClient.Cctx(context.Background(), &crosschaintypes.QueryGetCctxRequest{Index: index})
+					if err != nil {
+						api.logger.Debug("failed to query cctx", "index", index, "error", err.Error())
+						continue
+					}
+					if res.CrossChainTx == nil || !crit.Matches(*res.CrossChainTx) {
+						continue
+					}
+
+					cctx, err := api.clientCtx.Codec.MarshalJSON(res.CrossChainTx)
+					if err != nil {
+						api.logger.Debug("failed to marshal cctx", "index", index, "error", err.Error())
+						continue
+					}
+
+					// write to ws conn
+					notification := &SubscriptionNotification{
+						Jsonrpc: "2.0",
+						Method:  zetaSubscriptionMethod,
+						Params: &SubscriptionResult{
+							Subscription: subID,
+							Result:       json.RawMessage(cctx),
+						},
+					}
+
+					err = wsConn.WriteJSON(notification)
+					if err != nil {
+						api.logger.Debug("error writing cctx, will drop peer", "error", err.Error())
+
+						try(func() {
+							if err != websocket.ErrCloseSent {
+								_ = wsConn.Close() // #nosec G703
+							}
+						}, api.logger, "closing websocket peer sub")
+
+						// the peer is gone, stop listening to the events
+						unsubFn()
+						return
+					}
+				}
+			case err, ok := <-errCh:
+				if !ok {
+					return
+				}
+				api.logger.Debug("dropping Cctx WebSocket subscription", "subscription-id", subID, "error", err.Error())
+			}
+		}
+	}()
+
+	return unsubFn, nil
+}
+
 func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (pubsub.UnsubscribeFunc, error) {
 	return nil, errors.New("syncing subscription is not implemented")
 }
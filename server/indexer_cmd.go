@@ -10,6 +10,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/evm/indexer"
 	"github.com/spf13/cobra"
+
+	rpcindexer "github.com/zeta-chain/node/rpc/indexer"
+)
+
+const (
+	flagFrom = "from"
+	flagTo   = "to"
 )
 
 // NewIndexTxCmd creates a new Cobra command to index historical Ethereum transactions.
@@ -48,19 +55,10 @@ func NewIndexTxCmd() *cobra.Command {
 			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
 
 			// open local tendermint db, because the local rpc won't be available.
-			tmdb, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "blockstore", Config: cfg})
-			if err != nil {
-				return err
-			}
-			blockStore := cmtstore.NewBlockStore(tmdb)
-
-			stateDB, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "state", Config: cfg})
+			blockStore, stateStore, err := openBlockStores(cfg)
 			if err != nil {
 				return err
 			}
-			stateStore := sm.NewStore(stateDB, sm.StoreOptions{
-				DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
-			})
 
 			indexBlock := func(height int64) error {
 				blk := blockStore.LoadBlock(height)
@@ -116,3 +114,111 @@ func NewIndexTxCmd() *cobra.Command {
 	}
 	return cmd
 }
+
+// NewIndexCmd creates a new Cobra command to manage the EVM indexer indexing the logs with bloom bits.
+func NewIndexCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "index",
+		Short: "Manage the EVM indexer indexing the logs with bloom bits",
+	}
+	cmd.AddCommand(newIndexRebuildCmd())
+	return cmd
+}
+
+// newIndexRebuildCmd creates a new Cobra command to rebuild the EVM log indexer for a range of blocks.
+func newIndexRebuildCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebuild",
+		Short: "Rebuild the EVM log indexer for a range of blocks",
+		Long: `Rebuild the EVM log indexer (json-rpc.indexer-backend = "bloombits") for a range of blocks.
+The txs and the logs of the blocks are indexed again from the local block store and block results,
+then the bloom bits of the sections in the range are generated again.
+
+The node must be stopped, the block results of the range must not be pruned from the node.
+Once indexed, the logs of the range are served by eth_getLogs even if the block results are pruned.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from, err := cmd.Flags().GetInt64(flagFrom)
+			if err != nil {
+				return err
+			}
+			to, err := cmd.Flags().GetInt64(flagTo)
+			if err != nil {
+				return err
+			}
+
+			cfg := serverCtx.Config
+			logger := serverCtx.Logger
+			idxDB, err := OpenLogIndexerDB(cfg.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				logger.Error("failed to open evm log indexer DB", "error", err.Error())
+				return err
+			}
+			defer idxDB.Close()
+			idxer := rpcindexer.NewBloomBitsIndexer(idxDB, logger.With("module", "evmlogindex"), clientCtx)
+
+			// open local tendermint db, because the local rpc won't be available.
+			blockStore, stateStore, err := openBlockStores(cfg)
+			if err != nil {
+				return err
+			}
+
+			if to == 0 {
+				to = blockStore.Height()
+			}
+			if from < 1 || from > to || to > blockStore.Height() {
+				return fmt.Errorf(
+					"invalid range [%d, %d], expect: 1 <= from <= to <= %d",
+					from,
+					to,
+					blockStore.Height(),
+				)
+			}
+
+			for height := from; height <= to; height++ {
+				blk := blockStore.LoadBlock(height)
+				if blk == nil {
+					return fmt.Errorf("block not found %d", height)
+				}
+				resBlk, err := stateStore.LoadFinalizeBlockResponse(height)
+				if err != nil {
+					return fmt.Errorf("failed to load block results %d: %w", height, err)
+				}
+				if err := idxer.ReindexBlock(blk, resBlk.TxResults); err != nil {
+					return err
+				}
+				fmt.Println(height)
+			}
+
+			return idxer.ProcessSections(from, to)
+		},
+	}
+
+	cmd.Flags().Int64(flagFrom, 1, "First block of the range to index")
+	cmd.Flags().Int64(flagTo, 0, "Last block of the range to index, the latest block of the node if 0")
+	return cmd
+}
+
+// openBlockStores opens the local block store and state store of the node
+func openBlockStores(cfg *cmtconfig.Config) (*cmtstore.BlockStore, sm.Store, error) {
+	tmdb, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return nil, nil, err
+	}
+	blockStore := cmtstore.NewBlockStore(tmdb)
+
+	stateDB, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		return nil, nil, err
+	}
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+	})
+	return blockStore, stateStore, nil
+}
@@ -14,6 +14,17 @@ const (
 	ServiceName = "EVMIndexerService"
 
 	NewBlockWaitTimeout = 60 * time.Second
+
+	// FlagJSONRPCIndexerBackend defines the backend of the custom tx indexer for json-rpc,
+	// it can also be set in the json-rpc section of app.toml
+	FlagJSONRPCIndexerBackend = "json-rpc.indexer-backend"
+
+	// IndexerBackendKV indexes the eth txs in a kv database
+	IndexerBackendKV = "kv"
+
+	// IndexerBackendBloomBits indexes the eth txs and the logs with bloom bits in a separate database,
+	// the logs of the indexed blocks are served even if the block results were pruned
+	IndexerBackendBloomBits = "bloombits"
 )
 
 // EVMIndexerService indexes transactions for json-rpc service.
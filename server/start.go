@@ -224,6 +224,8 @@ which accepts a path for the resulting pprof file.
 
 	//nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().
+		String(FlagJSONRPCIndexerBackend, IndexerBackendKV, "Sets the backend of the custom tx indexer for json-rpc (kv|bloombits)")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 
 	cmd.Flags().
@@ -54,6 +54,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	rpcindexer "github.com/zeta-chain/node/rpc/indexer"
 	ethdebug "github.com/zeta-chain/node/rpc/namespaces/ethereum/debug"
 )
 
@@ -91,8 +92,9 @@ func AddCommands(
 		version.NewVersionCommand(),
 		server.NewRollbackCmd(appCreator, defaultNodeHome),
 
-		// custom tx indexer command
+		// custom tx indexer commands
 		NewIndexTxCmd(),
+		NewIndexCmd(),
 	)
 }
 
@@ -403,13 +405,11 @@ func startInProcess(
 
 	var evmIndexer cosmosevmtypes.EVMTxIndexer
 	if config.JSONRPC.EnableIndexer {
-		indexDB, err := OpenIndexerDB(cfg.RootDir, server.GetAppDBBackend(svrCtx.Viper))
+		idxLogger := svrCtx.Logger.With("indexer", "evm")
+		evmIndexer, err = OpenEVMIndexer(svrCtx, clientCtx, idxLogger)
 		if err != nil {
-			return fmt.Errorf("failed to open evm indexer DB: %w", err)
+			return err
 		}
-
-		idxLogger := svrCtx.Logger.With("indexer", "evm")
-		evmIndexer = indexer.NewKVIndexer(indexDB, idxLogger, clientCtx)
 		indexerService := NewEVMIndexerService(evmIndexer, clientCtx.Client.(rpcclient.Client))
 		indexerService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: idxLogger})
 
@@ -531,6 +531,46 @@ func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error)
 	return dbm.NewDB("evmindexer", backendType, dataDir)
 }
 
+// OpenLogIndexerDB opens the database of the EVM indexer indexing the logs with bloom bits
+func OpenLogIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("evmlogindexer", backendType, dataDir)
+}
+
+// OpenEVMIndexer opens the EVM indexer of the backend set in the configuration
+func OpenEVMIndexer(
+	svrCtx *server.Context,
+	clientCtx client.Context,
+	logger log.Logger,
+) (cosmosevmtypes.EVMTxIndexer, error) {
+	var (
+		rootDir     = svrCtx.Config.RootDir
+		backendType = server.GetAppDBBackend(svrCtx.Viper)
+	)
+
+	switch indexerBackend := svrCtx.Viper.GetString(FlagJSONRPCIndexerBackend); indexerBackend {
+	case "", IndexerBackendKV:
+		indexDB, err := OpenIndexerDB(rootDir, backendType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open evm indexer DB: %w", err)
+		}
+		return indexer.NewKVIndexer(indexDB, logger, clientCtx), nil
+	case IndexerBackendBloomBits:
+		indexDB, err := OpenLogIndexerDB(rootDir, backendType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open evm log indexer DB: %w", err)
+		}
+		return rpcindexer.NewBloomBitsIndexer(indexDB, logger, clientCtx), nil
+	default:
+		return nil, fmt.Errorf(
+			"unknown indexer backend %s, expect: %s|%s",
+			indexerBackend,
+			IndexerBackendKV,
+			IndexerBackendBloomBits,
+		)
+	}
+}
+
 func openTraceWriter(traceWriterFile string) (w io.WriteCloser, err error) {
 	if traceWriterFile == "" {
 		return
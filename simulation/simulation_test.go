@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
 	cosmossimutils "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	cosmossim "github.com/cosmos/cosmos-sdk/types/simulation"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -30,7 +31,9 @@ import (
 
 	"github.com/zeta-chain/node/app"
 	zetasimulation "github.com/zeta-chain/node/simulation"
+	crosschainkeeper "github.com/zeta-chain/node/x/crosschain/keeper"
 	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+	fungiblekeeper "github.com/zeta-chain/node/x/fungible/keeper"
 	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
 	observertypes "github.com/zeta-chain/node/x/observer/types"
 )
@@ -246,6 +249,9 @@ func TestFullAppSimulation(t *testing.T) {
 	)
 	require.NoError(t, simErr)
 
+	// check the accounting of the foreign coins is consistent at the end of the simulation
+	requireForeignCoinsInvariants(t, simApp, simApp.NewContext(true))
+
 	// check export works as expected
 	exported, err := simApp.ExportAppStateAndValidators(false, nil, nil)
 	require.NoError(t, err)
@@ -386,6 +392,9 @@ func TestAppImportExport(t *testing.T) {
 	newSimApp.ModuleManager().InitGenesis(ctxNewSimApp, newSimApp.AppCodec(), genesisState)
 	newSimApp.StoreConsensusParams(ctxNewSimApp, exported.ConsensusParams)
 
+	// the accounting of the foreign coins must be consistent in the imported state
+	requireForeignCoinsInvariants(t, newSimApp, ctxNewSimApp)
+
 	t.Log("comparing stores")
 
 	// The ordering of the keys is not important, we compare the same prefix for both simulations
@@ -585,4 +594,15 @@ func TestAppSimulationAfterImport(t *testing.T) {
 		simApp.AppCodec(),
 	)
 	require.NoError(t, simErr)
+
+	requireForeignCoinsInvariants(t, newSimApp, newSimApp.NewContext(true))
+}
+
+// requireForeignCoinsInvariants checks the invariants of the fungible and crosschain modules on the accounting of the
+// foreign coins
+func requireForeignCoinsInvariants(t *testing.T, simApp *app.App, ctx sdk.Context) {
+	msg, broken := fungiblekeeper.AllInvariants(simApp.FungibleKeeper)(ctx)
+	require.False(t, broken, msg)
+	msg, broken = crosschainkeeper.AllInvariants(simApp.CrosschainKeeper)(ctx)
+	require.False(t, broken, msg)
 }
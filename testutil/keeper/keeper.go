@@ -594,6 +594,7 @@ func NewSDKKeepersWithKeys(
 		authKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	observerKeeper.SetEmissionsKeeper(emissionsKeeper)
 	paramsKeeper := paramskeeper.NewKeeper(
 		cdc,
 		fungibletypes.Amino,
@@ -789,6 +789,36 @@ func (_m *CrosschainFungibleKeeper) ZETARevertAndCallContract(ctx types.Context,
 	return r0, r1
 }
 
+// ZRC20CirculatingSupply provides a mock function with given fields: ctx, foreignCoin
+func (_m *CrosschainFungibleKeeper) ZRC20CirculatingSupply(ctx types.Context, foreignCoin fungibletypes.ForeignCoins) (*big.Int, error) {
+	ret := _m.Called(ctx, foreignCoin)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ZRC20CirculatingSupply")
+	}
+
+	var r0 *big.Int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context, fungibletypes.ForeignCoins) (*big.Int, error)); ok {
+		return rf(ctx, foreignCoin)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, fungibletypes.ForeignCoins) *big.Int); ok {
+		r0 = rf(ctx, foreignCoin)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, fungibletypes.ForeignCoins) error); ok {
+		r1 = rf(ctx, foreignCoin)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ZRC20DepositAndCallContract provides a mock function with given fields: ctx, from, to, amount, senderChainID, data, coinType, asset, protocolContractVersion, isCrossChainCall
 func (_m *CrosschainFungibleKeeper) ZRC20DepositAndCallContract(ctx types.Context, from []byte, to common.Address, amount *big.Int, senderChainID int64, data []byte, coinType coin.CoinType, asset string, protocolContractVersion crosschaintypes.ProtocolContractVersion, isCrossChainCall bool) (*vmtypes.MsgEthereumTxResponse, bool, error) {
 	ret := _m.Called(ctx, from, to, amount, senderChainID, data, coinType, asset, protocolContractVersion, isCrossChainCall)
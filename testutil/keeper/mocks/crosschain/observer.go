@@ -698,6 +698,24 @@ func (_m *CrosschainObserverKeeper) IsInboundEnabled(ctx types.Context) bool {
 	return r0
 }
 
+// IsParentRevertInheritanceEnabled provides a mock function with given fields: ctx
+func (_m *CrosschainObserverKeeper) IsParentRevertInheritanceEnabled(ctx types.Context) bool {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsParentRevertInheritanceEnabled")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.Context) bool); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // IsV2ZetaEnabled provides a mock function with given fields: ctx
 func (_m *CrosschainObserverKeeper) IsV2ZetaEnabled(ctx types.Context) bool {
 	ret := _m.Called(ctx)
@@ -87,6 +87,26 @@ func (_m *EmissionObserverKeeper) GetBallot(ctx types.Context, index string) (ob
 	return r0, r1
 }
 
+// GetBlameStats provides a mock function with given fields: ctx, startHeight, endHeight
+func (_m *EmissionObserverKeeper) GetBlameStats(ctx types.Context, startHeight int64, endHeight int64) []observertypes.NodeBlameStats {
+	ret := _m.Called(ctx, startHeight, endHeight)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlameStats")
+	}
+
+	var r0 []observertypes.NodeBlameStats
+	if rf, ok := ret.Get(0).(func(types.Context, int64, int64) []observertypes.NodeBlameStats); ok {
+		r0 = rf(ctx, startHeight, endHeight)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]observertypes.NodeBlameStats)
+		}
+	}
+
+	return r0
+}
+
 // GetMaturedBallots provides a mock function with given fields: ctx, maturityBlocks
 func (_m *EmissionObserverKeeper) GetMaturedBallots(ctx types.Context, maturityBlocks int64) (observertypes.BallotListForHeight, bool) {
 	ret := _m.Called(ctx, maturityBlocks)
@@ -143,6 +163,24 @@ func (_m *EmissionObserverKeeper) GetNodeAccount(ctx types.Context, address stri
 	return r0, r1
 }
 
+// GetLivenessParams provides a mock function with given fields: ctx
+func (_m *EmissionObserverKeeper) GetLivenessParams(ctx types.Context) observertypes.LivenessParams {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLivenessParams")
+	}
+
+	var r0 observertypes.LivenessParams
+	if rf, ok := ret.Get(0).(func(types.Context) observertypes.LivenessParams); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(observertypes.LivenessParams)
+	}
+
+	return r0
+}
+
 // GetObserverSet provides a mock function with given fields: ctx
 func (_m *EmissionObserverKeeper) GetObserverSet(ctx types.Context) (observertypes.ObserverSet, bool) {
 	ret := _m.Called(ctx)
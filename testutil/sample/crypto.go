@@ -17,6 +17,7 @@ import (
 	"github.com/coming-chat/go-sui/v2/account"
 	"github.com/coming-chat/go-sui/v2/sui_types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdksecp256k1 "github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	secp "github.com/decred/dcrd/dcrec/secp256k1/v4"
@@ -62,6 +63,15 @@ func PubKeyString() string {
 	return pubkey.String()
 }
 
+// Secp256k1PubKeyString returns a sample bech32 secp256k1 public key string
+func Secp256k1PubKeyString() string {
+	s, err := cosmos.Bech32ifyPubKey(cosmos.Bech32PubKeyTypeAccPub, sdksecp256k1.GenPrivKey().PubKey())
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
 func PubkeyStringFromRand(r *rand.Rand) (string, error) {
 	priKey, err := Ed25519PrivateKeyFromRand(r)
 	if err != nil {
@@ -342,6 +342,43 @@ func OperationalFlags() types.OperationalFlags {
 	}
 }
 
+func LivenessParams() types.LivenessParams {
+	return types.LivenessParams{
+		Enabled:              true,
+		Window:               100,
+		MinVotedBallots:      50,
+		JailDuration:         600,
+		BallotMaturityBlocks: 10,
+	}
+}
+
+func ObserverSetChange(t *testing.T) *types.ObserverSetChange {
+	return &types.ObserverSetChange{
+		Additions: []types.ObserverSetAddition{
+			{
+				ObserverAddress:         AccAddress(),
+				ZetaclientGranteePubkey: PubKeyString(),
+			},
+		},
+		Removals:         []string{AccAddress()},
+		ProposalHeight:   100,
+		KeygenHeight:     100 + types.ObserverSetChangeKeygenDelay,
+		ActivationHeight: 1000,
+		Status:           types.ObserverSetChangeStatus_AwaitingActivation,
+		TssPubkey:        Tss().TssPubkey,
+		PreviousKeygen:   *Keygen(t),
+	}
+}
+
+func ObserverSigningInfo() types.ObserverSigningInfo {
+	info := types.NewObserverSigningInfo(AccAddress(), 100, 100)
+	info.IndexOffset = 10
+	info.MissedBallotsCounter = 2
+	info.SetMissedBallot(3, 100, true)
+	info.SetMissedBallot(7, 100, true)
+	return info
+}
+
 func ConfirmationParams(r *rand.Rand) types.ConfirmationParams {
 	randInboundCount := Uint64InRangeFromRand(r, 2, 200)
 	randOutboundCount := Uint64InRangeFromRand(r, 2, 200)
@@ -13,7 +13,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file zetachain/zetacore/crosschain/cross_chain_tx.proto.
  */
 export const file_zetachain_zetacore_crosschain_cross_chain_tx: GenFile = /*@__PURE__*/
-  fileDesc("CjJ6ZXRhY2hhaW4vemV0YWNvcmUvY3Jvc3NjaGFpbi9jcm9zc19jaGFpbl90eC5wcm90bxIdemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4iywQKDUluYm91bmRQYXJhbXMSDgoGc2VuZGVyGAEgASgJEhcKD3NlbmRlcl9jaGFpbl9pZBgCIAEoAxIRCgl0eF9vcmlnaW4YAyABKAkSOAoJY29pbl90eXBlGAQgASgOMiUuemV0YWNoYWluLnpldGFjb3JlLnBrZy5jb2luLkNvaW5UeXBlEg0KBWFzc2V0GAUgASgJEi4KBmFtb3VudBgGIAEoCUIeyN4fANreHxZjb3Ntb3NzZGsuaW8vbWF0aC5VaW50EhUKDW9ic2VydmVkX2hhc2gYByABKAkSIAoYb2JzZXJ2ZWRfZXh0ZXJuYWxfaGVpZ2h0GAggASgEEhQKDGJhbGxvdF9pbmRleBgJIAEoCRIdChVmaW5hbGl6ZWRfemV0YV9oZWlnaHQYCiABKAQSUwoWdHhfZmluYWxpemF0aW9uX3N0YXR1cxgLIAEoDjIzLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlR4RmluYWxpemF0aW9uU3RhdHVzEhsKE2lzX2Nyb3NzX2NoYWluX2NhbGwYDCABKAgSPAoGc3RhdHVzGBQgASgOMiwuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uSW5ib3VuZFN0YXR1cxJKChFjb25maXJtYXRpb25fbW9kZRgVIAEoDjIvLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLkNvbmZpcm1hdGlvbk1vZGUSFQoNZXJyb3JfbWVzc2FnZRgWIAEoCUoECA0QFCJNCg5aZXRhQWNjb3VudGluZxI7ChNhYm9ydGVkX3pldGFfYW1vdW50GAEgASgJQh7I3h8A2t4fFmNvc21vc3Nkay5pby9tYXRoLlVpbnQiOwoLQ2FsbE9wdGlvbnMSEQoJZ2FzX2xpbWl0GAEgASgEEhkKEWlzX2FyYml0cmFyeV9jYWxsGAIgASgIIuIFCg5PdXRib3VuZFBhcmFtcxIQCghyZWNlaXZlchgBIAEoCRIYChByZWNlaXZlcl9jaGFpbklkGAIgASgDEjgKCWNvaW5fdHlwZRgDIAEoDjIlLnpldGFjaGFpbi56ZXRhY29yZS5wa2cuY29pbi5Db2luVHlwZRIuCgZhbW91bnQYBCABKAlCHsjeHwDa3h8WY29zbW9zc2RrLmlvL21hdGguVWludBIRCgl0c3Nfbm9uY2UYBSABKAQSEQoJZ2FzX2xpbWl0GAYgASgEEhEKCWdhc19wcmljZRgHIAEoCRIYChBnYXNfcHJpb3JpdHlfZmVlGBcgASgJEgwKBGhhc2gYCCABKAkSFAoMYmFsbG90X2luZGV4GAkgASgJEiAKGG9ic2VydmVkX2V4dGVybmFsX2hlaWdodBgKIAEoBBIQCghnYXNfdXNlZBgUIAEoBBI6ChNlZmZlY3RpdmVfZ2FzX3ByaWNlGBUgASgJQh3I3h8A2t4fFWNvc21vc3Nkay5pby9tYXRoLkludBIbChNlZmZlY3RpdmVfZ2FzX2xpbWl0GBYgASgEEhIKCnRzc19wdWJrZXkYCyABKAkSUwoWdHhfZmluYWxpemF0aW9uX3N0YXR1cxgMIAEoDjIzLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlR4RmluYWxpemF0aW9uU3RhdHVzEkAKDGNhbGxfb3B0aW9ucxgYIAEoCzIqLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLkNhbGxPcHRpb25zEkoKEWNvbmZpcm1hdGlvbl9tb2RlGBkgASgOMi8uemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uQ29uZmlybWF0aW9uTW9kZRI5ChF1c2VyX2dhc19mZWVfcGFpZBgaIAEoCUIeyN4fANreHxZjb3Ntb3NzZGsuaW8vbWF0aC5VaW50SgQIDRAUIv8BCgZTdGF0dXMSOQoGc3RhdHVzGAEgASgOMikuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uQ2N0eFN0YXR1cxIWCg5zdGF0dXNfbWVzc2FnZRgCIAEoCRIVCg1lcnJvcl9tZXNzYWdlGAYgASgJEhwKFGxhc3RVcGRhdGVfdGltZXN0YW1wGAMgASgDEhcKD2lzQWJvcnRSZWZ1bmRlZBgEIAEoCBIZChFjcmVhdGVkX3RpbWVzdGFtcBgFIAEoAxIcChRlcnJvcl9tZXNzYWdlX3JldmVydBgHIAEoCRIbChNlcnJvcl9tZXNzYWdlX2Fib3J0GAggASgJIqgBCg1SZXZlcnRPcHRpb25zEhYKDnJldmVydF9hZGRyZXNzGAEgASgJEhYKDmNhbGxfb25fcmV2ZXJ0GAIgASgIEhUKDWFib3J0X2FkZHJlc3MYAyABKAkSFgoOcmV2ZXJ0X21lc3NhZ2UYBCABKAwSOAoQcmV2ZXJ0X2dhc19saW1pdBgFIAEoCUIeyN4fANreHxZjb3Ntb3NzZGsuaW8vbWF0aC5VaW50Iv8DCgxDcm9zc0NoYWluVHgSDwoHY3JlYXRvchgBIAEoCRINCgVpbmRleBgCIAEoCRJFCgl6ZXRhX2ZlZXMYBSABKAlCMsjeHwDa3h8WY29zbW9zc2RrLmlvL21hdGguVWludPLeHxB5YW1sOiJ6ZXRhX2ZlZXMiEhcKD3JlbGF5ZWRfbWVzc2FnZRgGIAEoCRI6CgtjY3R4X3N0YXR1cxgIIAEoCzIlLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlN0YXR1cxJECg5pbmJvdW5kX3BhcmFtcxgJIAEoCzIsLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLkluYm91bmRQYXJhbXMSRgoPb3V0Ym91bmRfcGFyYW1zGAogAygLMi0uemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uT3V0Ym91bmRQYXJhbXMSWQoZcHJvdG9jb2xfY29udHJhY3RfdmVyc2lvbhgLIAEoDjI2LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlByb3RvY29sQ29udHJhY3RWZXJzaW9uEkoKDnJldmVydF9vcHRpb25zGAwgASgLMiwuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUmV2ZXJ0T3B0aW9uc0IEyN4fACp8CgpDY3R4U3RhdHVzEhIKDlBlbmRpbmdJbmJvdW5kEAASEwoPUGVuZGluZ091dGJvdW5kEAESEQoNT3V0Ym91bmRNaW5lZBADEhEKDVBlbmRpbmdSZXZlcnQQBBIMCghSZXZlcnRlZBAFEgsKB0Fib3J0ZWQQBhoEqKQeASpLChRUeEZpbmFsaXphdGlvblN0YXR1cxIQCgxOb3RGaW5hbGl6ZWQQABINCglGaW5hbGl6ZWQQARIMCghFeGVjdXRlZBACGgSopB4BKiwKEENvbmZpcm1hdGlvbk1vZGUSCAoEU0FGRRAAEggKBEZBU1QQARoEqKQeASqTAQoNSW5ib3VuZFN0YXR1cxILCgdTVUNDRVNTEAASHgoaSU5TVUZGSUNJRU5UX0RFUE9TSVRPUl9GRUUQARIcChhJTlZBTElEX1JFQ0VJVkVSX0FERFJFU1MQAhIQCgxJTlZBTElEX01FTU8QAxIfChtFWENFU1NJVkVfTk9BU1NFVENBTExfRlVORFMQBBoEqKQeASovChdQcm90b2NvbENvbnRyYWN0VmVyc2lvbhIGCgJWMRAAEgYKAlYyEAEaBKikHgFC+wEKIWNvbS56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbkIRQ3Jvc3NDaGFpblR4UHJvdG9QAVotZ2l0aHViLmNvbS96ZXRhLWNoYWluL25vZGUveC9jcm9zc2NoYWluL3R5cGVzogIDWlpDqgIdWmV0YWNoYWluLlpldGFjb3JlLkNyb3NzY2hhaW7KAh1aZXRhY2hhaW5cWmV0YWNvcmVcQ3Jvc3NjaGFpbuICKVpldGFjaGFpblxaZXRhY29yZVxDcm9zc2NoYWluXEdQQk1ldGFkYXRh6gIfWmV0YWNoYWluOjpaZXRhY29yZTo6Q3Jvc3NjaGFpbmIGcHJvdG8z", [file_gogoproto_gogo, file_zetachain_zetacore_pkg_coin_coin]);
+  fileDesc("CjJ6ZXRhY2hhaW4vemV0YWNvcmUvY3Jvc3NjaGFpbi9jcm9zc19jaGFpbl90eC5wcm90bxIdemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4i3QQKDUluYm91bmRQYXJhbXMSDgoGc2VuZGVyGAEgASgJEhcKD3NlbmRlcl9jaGFpbl9pZBgCIAEoAxIRCgl0eF9vcmlnaW4YAyABKAkSOAoJY29pbl90eXBlGAQgASgOMiUuemV0YWNoYWluLnpldGFjb3JlLnBrZy5jb2luLkNvaW5UeXBlEg0KBWFzc2V0GAUgASgJEi4KBmFtb3VudBgGIAEoCUIeyN4fANreHxZjb3Ntb3NzZGsuaW8vbWF0aC5VaW50EhUKDW9ic2VydmVkX2hhc2gYByABKAkSIAoYb2JzZXJ2ZWRfZXh0ZXJuYWxfaGVpZ2h0GAggASgEEhQKDGJhbGxvdF9pbmRleBgJIAEoCRIdChVmaW5hbGl6ZWRfemV0YV9oZWlnaHQYCiABKAQSUwoWdHhfZmluYWxpemF0aW9uX3N0YXR1cxgLIAEoDjIzLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlR4RmluYWxpemF0aW9uU3RhdHVzEhsKE2lzX2Nyb3NzX2NoYWluX2NhbGwYDCABKAgSPAoGc3RhdHVzGBQgASgOMiwuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uSW5ib3VuZFN0YXR1cxJKChFjb25maXJtYXRpb25fbW9kZRgVIAEoDjIvLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLkNvbmZpcm1hdGlvbk1vZGUSFQoNZXJyb3JfbWVzc2FnZRgWIAEoCRIQCghkZWFkbGluZRgXIAEoBEoECA0QFCJNCg5aZXRhQWNjb3VudGluZxI7ChNhYm9ydGVkX3pldGFfYW1vdW50GAEgASgJQh7I3h8A2t4fFmNvc21vc3Nkay5pby9tYXRoLlVpbnQiOwoLQ2FsbE9wdGlvbnMSEQoJZ2FzX2xpbWl0GAEgASgEEhkKEWlzX2FyYml0cmFyeV9jYWxsGAIgASgIIvYFCg5PdXRib3VuZFBhcmFtcxIQCghyZWNlaXZlchgBIAEoCRIYChByZWNlaXZlcl9jaGFpbklkGAIgASgDEjgKCWNvaW5fdHlwZRgDIAEoDjIlLnpldGFjaGFpbi56ZXRhY29yZS5wa2cuY29pbi5Db2luVHlwZRIuCgZhbW91bnQYBCABKAlCHsjeHwDa3h8WY29zbW9zc2RrLmlvL21hdGguVWludBIRCgl0c3Nfbm9uY2UYBSABKAQSEQoJZ2FzX2xpbWl0GAYgASgEEhEKCWdhc19wcmljZRgHIAEoCRIYChBnYXNfcHJpb3JpdHlfZmVlGBcgASgJEgwKBGhhc2gYCCABKAkSFAoMYmFsbG90X2luZGV4GAkgASgJEiAKGG9ic2VydmVkX2V4dGVybmFsX2hlaWdodBgKIAEoBBIQCghnYXNfdXNlZBgUIAEoBBI6ChNlZmZlY3RpdmVfZ2FzX3ByaWNlGBUgASgJQh3I3h8A2t4fFWNvc21vc3Nkay5pby9tYXRoLkludBIbChNlZmZlY3RpdmVfZ2FzX2xpbWl0GBYgASgEEhIKCnRzc19wdWJrZXkYCyABKAkSUwoWdHhfZmluYWxpemF0aW9uX3N0YXR1cxgMIAEoDjIzLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlR4RmluYWxpemF0aW9uU3RhdHVzEkAKDGNhbGxfb3B0aW9ucxgYIAEoCzIqLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLkNhbGxPcHRpb25zEkoKEWNvbmZpcm1hdGlvbl9tb2RlGBkgASgOMi8uemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uQ29uZmlybWF0aW9uTW9kZRI5ChF1c2VyX2dhc19mZWVfcGFpZBgaIAEoCUIeyN4fANreHxZjb3Ntb3NzZGsuaW8vbWF0aC5VaW50EhIKCmlzX2V4cGlyZWQYGyABKAhKBAgNEBQi/wEKBlN0YXR1cxI5CgZzdGF0dXMYASABKA4yKS56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5DY3R4U3RhdHVzEhYKDnN0YXR1c19tZXNzYWdlGAIgASgJEhUKDWVycm9yX21lc3NhZ2UYBiABKAkSHAoUbGFzdFVwZGF0ZV90aW1lc3RhbXAYAyABKAMSFwoPaXNBYm9ydFJlZnVuZGVkGAQgASgIEhkKEWNyZWF0ZWRfdGltZXN0YW1wGAUgASgDEhwKFGVycm9yX21lc3NhZ2VfcmV2ZXJ0GAcgASgJEhsKE2Vycm9yX21lc3NhZ2VfYWJvcnQYCCABKAkiqAEKDVJldmVydE9wdGlvbnMSFgoOcmV2ZXJ0X2FkZHJlc3MYASABKAkSFgoOY2FsbF9vbl9yZXZlcnQYAiABKAgSFQoNYWJvcnRfYWRkcmVzcxgDIAEoCRIWCg5yZXZlcnRfbWVzc2FnZRgEIAEoDBI4ChByZXZlcnRfZ2FzX2xpbWl0GAUgASgJQh7I3h8A2t4fFmNvc21vc3Nkay5pby9tYXRoLlVpbnQilQQKDENyb3NzQ2hhaW5UeBIPCgdjcmVhdG9yGAEgASgJEg0KBWluZGV4GAIgASgJEkUKCXpldGFfZmVlcxgFIAEoCUIyyN4fANreHxZjb3Ntb3NzZGsuaW8vbWF0aC5VaW508t4fEHlhbWw6InpldGFfZmVlcyISFwoPcmVsYXllZF9tZXNzYWdlGAYgASgJEjoKC2NjdHhfc3RhdHVzGAggASgLMiUuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uU3RhdHVzEkQKDmluYm91bmRfcGFyYW1zGAkgASgLMiwuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uSW5ib3VuZFBhcmFtcxJGCg9vdXRib3VuZF9wYXJhbXMYCiADKAsyLS56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5PdXRib3VuZFBhcmFtcxJZChlwcm90b2NvbF9jb250cmFjdF92ZXJzaW9uGAsgASgOMjYuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUHJvdG9jb2xDb250cmFjdFZlcnNpb24SSgoOcmV2ZXJ0X29wdGlvbnMYDCABKAsyLC56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5SZXZlcnRPcHRpb25zQgTI3h8AEhQKDHBhcmVudF9pbmRleBgNIAEoCSp8CgpDY3R4U3RhdHVzEhIKDlBlbmRpbmdJbmJvdW5kEAASEwoPUGVuZGluZ091dGJvdW5kEAESEQoNT3V0Ym91bmRNaW5lZBADEhEKDVBlbmRpbmdSZXZlcnQQBBIMCghSZXZlcnRlZBAFEgsKB0Fib3J0ZWQQBhoEqKQeASpLChRUeEZpbmFsaXphdGlvblN0YXR1cxIQCgxOb3RGaW5hbGl6ZWQQABINCglGaW5hbGl6ZWQQARIMCghFeGVjdXRlZBACGgSopB4BKiwKEENvbmZpcm1hdGlvbk1vZGUSCAoEU0FGRRAAEggKBEZBU1QQARoEqKQeASqTAQoNSW5ib3VuZFN0YXR1cxILCgdTVUNDRVNTEAASHgoaSU5TVUZGSUNJRU5UX0RFUE9TSVRPUl9GRUUQARIcChhJTlZBTElEX1JFQ0VJVkVSX0FERFJFU1MQAhIQCgxJTlZBTElEX01FTU8QAxIfChtFWENFU1NJVkVfTk9BU1NFVENBTExfRlVORFMQBBoEqKQeASovChdQcm90b2NvbENvbnRyYWN0VmVyc2lvbhIGCgJWMRAAEgYKAlYyEAEaBKikHgFC+wEKIWNvbS56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbkIRQ3Jvc3NDaGFpblR4UHJvdG9QAVotZ2l0aHViLmNvbS96ZXRhLWNoYWluL25vZGUveC9jcm9zc2NoYWluL3R5cGVzogIDWlpDqgIdWmV0YWNoYWluLlpldGFjb3JlLkNyb3NzY2hhaW7KAh1aZXRhY2hhaW5cWmV0YWNvcmVcQ3Jvc3NjaGFpbuICKVpldGFjaGFpblxaZXRhY29yZVxDcm9zc2NoYWluXEdQQk1ldGFkYXRh6gIfWmV0YWNoYWluOjpaZXRhY29yZTo6Q3Jvc3NjaGFpbmIGcHJvdG8z", [file_gogoproto_gogo, file_zetachain_zetacore_pkg_coin_coin]);
 
 /**
  * @generated from message zetachain.zetacore.crosschain.InboundParams
@@ -111,6 +111,14 @@ export type InboundParams = Message<"zetachain.zetacore.crosschain.InboundParams
    * @generated from field: string error_message = 22;
    */
   errorMessage: string;
+
+  /**
+   * deadline is the unix timestamp (seconds) after which the inbound must not
+   * be executed, the CCTX is reverted instead. zero means no deadline
+   *
+   * @generated from field: uint64 deadline = 23;
+   */
+  deadline: bigint;
 };
 
 /**
@@ -269,6 +277,14 @@ export type OutboundParams = Message<"zetachain.zetacore.crosschain.OutboundPara
    * @generated from field: string user_gas_fee_paid = 26;
    */
   userGasFeePaid: string;
+
+  /**
+   * is_expired is set when the inbound deadline passed while the outbound was
+   * pending, the observers then cancel the outbound and the CCTX is reverted
+   *
+   * @generated from field: bool is_expired = 27;
+   */
+  isExpired: boolean;
 };
 
 /**
@@ -432,6 +448,14 @@ export type CrossChainTx = Message<"zetachain.zetacore.crosschain.CrossChainTx">
    * @generated from field: zetachain.zetacore.crosschain.RevertOptions revert_options = 12;
    */
   revertOptions?: RevertOptions;
+
+  /**
+   * index of the cctx whose ZEVM execution created this cctx
+   * empty if the cctx was created from an inbound on a connected chain
+   *
+   * @generated from field: string parent_index = 13;
+   */
+  parentIndex: string;
 };
 
 /**
@@ -11,7 +11,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file zetachain/zetacore/crosschain/events.proto.
  */
 export const file_zetachain_zetacore_crosschain_events: GenFile = /*@__PURE__*/
-  fileDesc("Cip6ZXRhY2hhaW4vemV0YWNvcmUvY3Jvc3NjaGFpbi9ldmVudHMucHJvdG8SHXpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluIrsCChVFdmVudEluYm91bmRGaW5hbGl6ZWQSFAoMbXNnX3R5cGVfdXJsGAEgASgJEhIKCmNjdHhfaW5kZXgYAiABKAkSDgoGc2VuZGVyGAMgASgJEhAKCHR4X29yZ2luGAQgASgJEg0KBWFzc2V0GAUgASgJEhQKDGluYm91bmRfaGFzaBgGIAEoCRIcChRpbmJvdW5kX2Jsb2NrX2hlaWdodBgHIAEoCRIQCghyZWNlaXZlchgIIAEoCRIWCg5yZWNlaXZlcl9jaGFpbhgJIAEoCRIOCgZhbW91bnQYCiABKAkSFwoPcmVsYXllZF9tZXNzYWdlGAsgASgJEhIKCm5ld19zdGF0dXMYDCABKAkSFgoOc3RhdHVzX21lc3NhZ2UYDSABKAkSFAoMc2VuZGVyX2NoYWluGA4gASgJIs0BChdFdmVudFpyY1dpdGhkcmF3Q3JlYXRlZBIUCgxtc2dfdHlwZV91cmwYASABKAkSEgoKY2N0eF9pbmRleBgCIAEoCRIOCgZzZW5kZXIYAyABKAkSFAoMc2VuZGVyX2NoYWluGAQgASgJEhQKDGluYm91bmRfaGFzaBgFIAEoCRIQCghyZWNlaXZlchgGIAEoCRIWCg5yZWNlaXZlcl9jaGFpbhgHIAEoCRIOCgZhbW91bnQYCCABKAkSEgoKbmV3X3N0YXR1cxgJIAEoCSJ+ChhFdmVudFpldGFXaXRoZHJhd0NyZWF0ZWQSFAoMbXNnX3R5cGVfdXJsGAEgASgJEhIKCmNjdHhfaW5kZXgYAiABKAkSDgoGc2VuZGVyGAMgASgJEhQKDGluYm91bmRfaGFzaBgEIAEoCRISCgpuZXdfc3RhdHVzGAUgASgJIoABChRFdmVudE91dGJvdW5kRmFpbHVyZRIUCgxtc2dfdHlwZV91cmwYASABKAkSEgoKY2N0eF9pbmRleBgCIAEoCRISCgpvbGRfc3RhdHVzGAMgASgJEhIKCm5ld19zdGF0dXMYBCABKAkSFgoOdmFsdWVfcmVjZWl2ZWQYBSABKAkigAEKFEV2ZW50T3V0Ym91bmRTdWNjZXNzEhQKDG1zZ190eXBlX3VybBgBIAEoCRISCgpjY3R4X2luZGV4GAIgASgJEhIKCm9sZF9zdGF0dXMYAyABKAkSEgoKbmV3X3N0YXR1cxgEIAEoCRIWCg52YWx1ZV9yZWNlaXZlZBgFIAEoCSJlChpFdmVudENDVFhHYXNQcmljZUluY3JlYXNlZBISCgpjY3R4X2luZGV4GAEgASgJEhoKEmdhc19wcmljZV9pbmNyZWFzZRgCIAEoCRIXCg9hZGRpdGlvbmFsX2ZlZXMYAyABKAkiSgoTRXZlbnRBc3NldFdoaXRlbGlzdBIcChR3aGl0ZWxpc3RfY2N0eF9pbmRleBgBIAEoCRIVCg16cmMyMF9hZGRyZXNzGAIgASgJInkKH0V2ZW50RVJDMjBDdXN0b2R5RnVuZHNNaWdyYXRpb24SGwoTbmV3X2N1c3RvZHlfYWRkcmVzcxgBIAEoCRIVCg1lcmMyMF9hZGRyZXNzGAIgASgJEg4KBmFtb3VudBgDIAEoCRISCgpjY3R4X2luZGV4GAQgASgJIk8KGEV2ZW50RVJDMjBDdXN0b2R5UGF1c2luZxIQCghjaGFpbl9pZBgBIAEoAxINCgVwYXVzZRgCIAEoCBISCgpjY3R4X2luZGV4GAMgASgJIkwKHUV2ZW50SW5ib3VuZFByb2Nlc3NpbmdGYWlsdXJlEhQKDGluYm91bmRfaGFzaBgBIAEoCRIVCg1lcnJvcl9tZXNzYWdlGAIgASgJQvUBCiFjb20uemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW5CC0V2ZW50c1Byb3RvUAFaLWdpdGh1Yi5jb20vemV0YS1jaGFpbi9ub2RlL3gvY3Jvc3NjaGFpbi90eXBlc6ICA1paQ6oCHVpldGFjaGFpbi5aZXRhY29yZS5Dcm9zc2NoYWluygIdWmV0YWNoYWluXFpldGFjb3JlXENyb3NzY2hhaW7iAilaZXRhY2hhaW5cWmV0YWNvcmVcQ3Jvc3NjaGFpblxHUEJNZXRhZGF0YeoCH1pldGFjaGFpbjo6WmV0YWNvcmU6OkNyb3NzY2hhaW5iBnByb3RvMw", [file_gogoproto_gogo]);
+  fileDesc("Cip6ZXRhY2hhaW4vemV0YWNvcmUvY3Jvc3NjaGFpbi9ldmVudHMucHJvdG8SHXpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluIrsCChVFdmVudEluYm91bmRGaW5hbGl6ZWQSFAoMbXNnX3R5cGVfdXJsGAEgASgJEhIKCmNjdHhfaW5kZXgYAiABKAkSDgoGc2VuZGVyGAMgASgJEhAKCHR4X29yZ2luGAQgASgJEg0KBWFzc2V0GAUgASgJEhQKDGluYm91bmRfaGFzaBgGIAEoCRIcChRpbmJvdW5kX2Jsb2NrX2hlaWdodBgHIAEoCRIQCghyZWNlaXZlchgIIAEoCRIWCg5yZWNlaXZlcl9jaGFpbhgJIAEoCRIOCgZhbW91bnQYCiABKAkSFwoPcmVsYXllZF9tZXNzYWdlGAsgASgJEhIKCm5ld19zdGF0dXMYDCABKAkSFgoOc3RhdHVzX21lc3NhZ2UYDSABKAkSFAoMc2VuZGVyX2NoYWluGA4gASgJIs0BChdFdmVudFpyY1dpdGhkcmF3Q3JlYXRlZBIUCgxtc2dfdHlwZV91cmwYASABKAkSEgoKY2N0eF9pbmRleBgCIAEoCRIOCgZzZW5kZXIYAyABKAkSFAoMc2VuZGVyX2NoYWluGAQgASgJEhQKDGluYm91bmRfaGFzaBgFIAEoCRIQCghyZWNlaXZlchgGIAEoCRIWCg5yZWNlaXZlcl9jaGFpbhgHIAEoCRIOCgZhbW91bnQYCCABKAkSEgoKbmV3X3N0YXR1cxgJIAEoCSJ+ChhFdmVudFpldGFXaXRoZHJhd0NyZWF0ZWQSFAoMbXNnX3R5cGVfdXJsGAEgASgJEhIKCmNjdHhfaW5kZXgYAiABKAkSDgoGc2VuZGVyGAMgASgJEhQKDGluYm91bmRfaGFzaBgEIAEoCRISCgpuZXdfc3RhdHVzGAUgASgJIoABChRFdmVudE91dGJvdW5kRmFpbHVyZRIUCgxtc2dfdHlwZV91cmwYASABKAkSEgoKY2N0eF9pbmRleBgCIAEoCRISCgpvbGRfc3RhdHVzGAMgASgJEhIKCm5ld19zdGF0dXMYBCABKAkSFgoOdmFsdWVfcmVjZWl2ZWQYBSABKAkigAEKFEV2ZW50T3V0Ym91bmRTdWNjZXNzEhQKDG1zZ190eXBlX3VybBgBIAEoCRISCgpjY3R4X2luZGV4GAIgASgJEhIKCm9sZF9zdGF0dXMYAyABKAkSEgoKbmV3X3N0YXR1cxgEIAEoCRIWCg52YWx1ZV9yZWNlaXZlZBgFIAEoCSJlChpFdmVudENDVFhHYXNQcmljZUluY3JlYXNlZBISCgpjY3R4X2luZGV4GAEgASgJEhoKEmdhc19wcmljZV9pbmNyZWFzZRgCIAEoCRIXCg9hZGRpdGlvbmFsX2ZlZXMYAyABKAkiSgoTRXZlbnRBc3NldFdoaXRlbGlzdBIcChR3aGl0ZWxpc3RfY2N0eF9pbmRleBgBIAEoCRIVCg16cmMyMF9hZGRyZXNzGAIgASgJInkKH0V2ZW50RVJDMjBDdXN0b2R5RnVuZHNNaWdyYXRpb24SGwoTbmV3X2N1c3RvZHlfYWRkcmVzcxgBIAEoCRIVCg1lcmMyMF9hZGRyZXNzGAIgASgJEg4KBmFtb3VudBgDIAEoCRISCgpjY3R4X2luZGV4GAQgASgJIk8KGEV2ZW50RVJDMjBDdXN0b2R5UGF1c2luZxIQCghjaGFpbl9pZBgBIAEoAxINCgVwYXVzZRgCIAEoCBISCgpjY3R4X2luZGV4GAMgASgJIkwKHUV2ZW50SW5ib3VuZFByb2Nlc3NpbmdGYWlsdXJlEhQKDGluYm91bmRfaGFzaBgBIAEoCRIVCg1lcnJvcl9tZXNzYWdlGAIgASgJInkKE0V2ZW50SW5ib3VuZEV4cGlyZWQSEgoKY2N0eF9pbmRleBgBIAEoCRIUCgxpbmJvdW5kX2hhc2gYAiABKAkSEAoIZGVhZGxpbmUYAyABKAQSEgoKYmxvY2tfdGltZRgEIAEoAxISCgpuZXdfc3RhdHVzGAUgASgJQvUBCiFjb20uemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW5CC0V2ZW50c1Byb3RvUAFaLWdpdGh1Yi5jb20vemV0YS1jaGFpbi9ub2RlL3gvY3Jvc3NjaGFpbi90eXBlc6ICA1paQ6oCHVpldGFjaGFpbi5aZXRhY29yZS5Dcm9zc2NoYWluygIdWmV0YWNoYWluXFpldGFjb3JlXENyb3NzY2hhaW7iAilaZXRhY2hhaW5cWmV0YWNvcmVcQ3Jvc3NjaGFpblxHUEJNZXRhZGF0YeoCH1pldGFjaGFpbjo6WmV0YWNvcmU6OkNyb3NzY2hhaW5iBnByb3RvMw", [file_gogoproto_gogo]);
 
 /**
  * @generated from message zetachain.zetacore.crosschain.EventInboundFinalized
@@ -393,3 +393,40 @@ export type EventInboundProcessingFailure = Message<"zetachain.zetacore.crosscha
 export const EventInboundProcessingFailureSchema: GenMessage<EventInboundProcessingFailure> = /*@__PURE__*/
   messageDesc(file_zetachain_zetacore_crosschain_events, 9);
 
+/**
+ * @generated from message zetachain.zetacore.crosschain.EventInboundExpired
+ */
+export type EventInboundExpired = Message<"zetachain.zetacore.crosschain.EventInboundExpired"> & {
+  /**
+   * @generated from field: string cctx_index = 1;
+   */
+  cctxIndex: string;
+
+  /**
+   * @generated from field: string inbound_hash = 2;
+   */
+  inboundHash: string;
+
+  /**
+   * @generated from field: uint64 deadline = 3;
+   */
+  deadline: bigint;
+
+  /**
+   * @generated from field: int64 block_time = 4;
+   */
+  blockTime: bigint;
+
+  /**
+   * @generated from field: string new_status = 5;
+   */
+  newStatus: string;
+};
+
+/**
+ * Describes the message zetachain.zetacore.crosschain.EventInboundExpired.
+ * Use `create(EventInboundExpiredSchema)` to create a new message.
+ */
+export const EventInboundExpiredSchema: GenMessage<EventInboundExpired> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_crosschain_events, 10);
+
@@ -6,7 +6,7 @@ import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegen
 import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import type { PageRequest, PageResponse } from "../../../cosmos/base/query/v1beta1/pagination_pb";
 import { file_cosmos_base_query_v1beta1_pagination } from "../../../cosmos/base/query/v1beta1/pagination_pb";
-import type { CrossChainTx } from "./cross_chain_tx_pb";
+import type { CctxStatus, CrossChainTx } from "./cross_chain_tx_pb";
 import { file_zetachain_zetacore_crosschain_cross_chain_tx } from "./cross_chain_tx_pb";
 import type { GasPrice } from "./gas_price_pb";
 import { file_zetachain_zetacore_crosschain_gas_price } from "./gas_price_pb";
@@ -18,6 +18,8 @@ import type { OutboundTracker } from "./outbound_tracker_pb";
 import { file_zetachain_zetacore_crosschain_outbound_tracker } from "./outbound_tracker_pb";
 import type { RateLimiterFlags } from "./rate_limiter_flags_pb";
 import { file_zetachain_zetacore_crosschain_rate_limiter_flags } from "./rate_limiter_flags_pb";
+import type { CoinType } from "../pkg/coin/coin_pb";
+import { file_zetachain_zetacore_pkg_coin_coin } from "../pkg/coin/coin_pb";
 import { file_gogoproto_gogo } from "../../../gogoproto/gogo_pb";
 import { file_google_api_annotations } from "../../../google/api/annotations_pb";
 import { file_cosmos_msg_v1_msg } from "../../../cosmos/msg/v1/msg_pb";
@@ -27,7 +29,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file zetachain/zetacore/crosschain/query.proto.
  */
 export const file_zetachain_zetacore_crosschain_query: GenFile = /*@__PURE__*/
-  fileDesc("Cil6ZXRhY2hhaW4vemV0YWNvcmUvY3Jvc3NjaGFpbi9xdWVyeS5wcm90bxIdemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4iHAoaUXVlcnlaZXRhQWNjb3VudGluZ1JlcXVlc3QiOgobUXVlcnlaZXRhQWNjb3VudGluZ1Jlc3BvbnNlEhsKE2Fib3J0ZWRfemV0YV9hbW91bnQYASABKAkiQAoeUXVlcnlHZXRPdXRib3VuZFRyYWNrZXJSZXF1ZXN0Eg8KB2NoYWluSUQYASABKAMSDQoFbm9uY2UYAiABKAQicAofUXVlcnlHZXRPdXRib3VuZFRyYWNrZXJSZXNwb25zZRJNCg9vdXRib3VuZFRyYWNrZXIYASABKAsyLi56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5PdXRib3VuZFRyYWNrZXJCBMjeHwAiXAoeUXVlcnlBbGxPdXRib3VuZFRyYWNrZXJSZXF1ZXN0EjoKCnBhZ2luYXRpb24YASABKAsyJi5jb3Ntb3MuYmFzZS5xdWVyeS52MWJldGExLlBhZ2VSZXF1ZXN0Iq0BCh9RdWVyeUFsbE91dGJvdW5kVHJhY2tlclJlc3BvbnNlEk0KD291dGJvdW5kVHJhY2tlchgBIAMoCzIuLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLk91dGJvdW5kVHJhY2tlckIEyN4fABI7CgpwYWdpbmF0aW9uGAIgASgLMicuY29zbW9zLmJhc2UucXVlcnkudjFiZXRhMS5QYWdlUmVzcG9uc2UicgolUXVlcnlBbGxPdXRib3VuZFRyYWNrZXJCeUNoYWluUmVxdWVzdBINCgVjaGFpbhgBIAEoAxI6CgpwYWdpbmF0aW9uGAIgASgLMiYuY29zbW9zLmJhc2UucXVlcnkudjFiZXRhMS5QYWdlUmVxdWVzdCK0AQomUXVlcnlBbGxPdXRib3VuZFRyYWNrZXJCeUNoYWluUmVzcG9uc2USTQoPb3V0Ym91bmRUcmFja2VyGAEgAygLMi4uemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uT3V0Ym91bmRUcmFja2VyQgTI3h8AEjsKCnBhZ2luYXRpb24YAiABKAsyJy5jb3Ntb3MuYmFzZS5xdWVyeS52MWJldGExLlBhZ2VSZXNwb25zZSJ0CiRRdWVyeUFsbEluYm91bmRUcmFja2VyQnlDaGFpblJlcXVlc3QSEAoIY2hhaW5faWQYASABKAMSOgoKcGFnaW5hdGlvbhgCIAEoCzImLmNvc21vcy5iYXNlLnF1ZXJ5LnYxYmV0YTEuUGFnZVJlcXVlc3QisQEKJVF1ZXJ5QWxsSW5ib3VuZFRyYWNrZXJCeUNoYWluUmVzcG9uc2USSwoOaW5ib3VuZFRyYWNrZXIYASADKAsyLS56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5JbmJvdW5kVHJhY2tlckIEyN4fABI7CgpwYWdpbmF0aW9uGAIgASgLMicuY29zbW9zLmJhc2UucXVlcnkudjFiZXRhMS5QYWdlUmVzcG9uc2UiXAoeUXVlcnlBbGxJbmJvdW5kVHJhY2tlcnNSZXF1ZXN0EjoKCnBhZ2luYXRpb24YASABKAsyJi5jb3Ntb3MuYmFzZS5xdWVyeS52MWJldGExLlBhZ2VSZXF1ZXN0IqsBCh9RdWVyeUFsbEluYm91bmRUcmFja2Vyc1Jlc3BvbnNlEksKDmluYm91bmRUcmFja2VyGAEgAygLMi0uemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uSW5ib3VuZFRyYWNrZXJCBMjeHwASOwoKcGFnaW5hdGlvbhgCIAEoCzInLmNvc21vcy5iYXNlLnF1ZXJ5LnYxYmV0YTEuUGFnZVJlc3BvbnNlIjcKIFF1ZXJ5R2V0SW5ib3VuZEhhc2hUb0NjdHhSZXF1ZXN0EhMKC2luYm91bmRIYXNoGAEgASgJInYKIVF1ZXJ5R2V0SW5ib3VuZEhhc2hUb0NjdHhSZXNwb25zZRJRChFpbmJvdW5kSGFzaFRvQ2N0eBgBIAEoCzIwLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLkluYm91bmRIYXNoVG9DY3R4QgTI3h8AIjgKIVF1ZXJ5SW5ib3VuZEhhc2hUb0NjdHhEYXRhUmVxdWVzdBITCgtpbmJvdW5kSGFzaBgBIAEoCSJuCiJRdWVyeUluYm91bmRIYXNoVG9DY3R4RGF0YVJlc3BvbnNlEkgKDUNyb3NzQ2hhaW5UeHMYASADKAsyKy56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5Dcm9zc0NoYWluVHhCBMjeHwAiXgogUXVlcnlBbGxJbmJvdW5kSGFzaFRvQ2N0eFJlcXVlc3QSOgoKcGFnaW5hdGlvbhgBIAEoCzImLmNvc21vcy5iYXNlLnF1ZXJ5LnYxYmV0YTEuUGFnZVJlcXVlc3QiswEKIVF1ZXJ5QWxsSW5ib3VuZEhhc2hUb0NjdHhSZXNwb25zZRJRChFpbmJvdW5kSGFzaFRvQ2N0eBgBIAMoCzIwLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLkluYm91bmRIYXNoVG9DY3R4QgTI3h8AEjsKCnBhZ2luYXRpb24YAiABKAsyJy5jb3Ntb3MuYmFzZS5xdWVyeS52MWJldGExLlBhZ2VSZXNwb25zZSIoChdRdWVyeUdldEdhc1ByaWNlUmVxdWVzdBINCgVpbmRleBgBIAEoCSJVChhRdWVyeUdldEdhc1ByaWNlUmVzcG9uc2USOQoIR2FzUHJpY2UYASABKAsyJy56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5HYXNQcmljZSJVChdRdWVyeUFsbEdhc1ByaWNlUmVxdWVzdBI6CgpwYWdpbmF0aW9uGAEgASgLMiYuY29zbW9zLmJhc2UucXVlcnkudjFiZXRhMS5QYWdlUmVxdWVzdCKSAQoYUXVlcnlBbGxHYXNQcmljZVJlc3BvbnNlEjkKCEdhc1ByaWNlGAEgAygLMicuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uR2FzUHJpY2USOwoKcGFnaW5hdGlvbhgCIAEoCzInLmNvc21vcy5iYXNlLnF1ZXJ5LnYxYmV0YTEuUGFnZVJlc3BvbnNlIiQKE1F1ZXJ5R2V0Q2N0eFJlcXVlc3QSDQoFaW5kZXgYASABKAkiPAoaUXVlcnlHZXRDY3R4QnlOb25jZVJlcXVlc3QSDwoHY2hhaW5JRBgBIAEoAxINCgVub25jZRgCIAEoBCJZChRRdWVyeUdldENjdHhSZXNwb25zZRJBCgxDcm9zc0NoYWluVHgYASABKAsyKy56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5Dcm9zc0NoYWluVHgiZAoTUXVlcnlBbGxDY3R4UmVxdWVzdBI6CgpwYWdpbmF0aW9uGAEgASgLMiYuY29zbW9zLmJhc2UucXVlcnkudjFiZXRhMS5QYWdlUmVxdWVzdBIRCgl1bm9yZGVyZWQYAiABKAgilgEKFFF1ZXJ5QWxsQ2N0eFJlc3BvbnNlEkEKDENyb3NzQ2hhaW5UeBgBIAMoCzIrLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLkNyb3NzQ2hhaW5UeBI7CgpwYWdpbmF0aW9uGAIgASgLMicuY29zbW9zLmJhc2UucXVlcnkudjFiZXRhMS5QYWdlUmVzcG9uc2UiPgobUXVlcnlMaXN0UGVuZGluZ0NjdHhSZXF1ZXN0EhAKCGNoYWluX2lkGAEgASgDEg0KBWxpbWl0GAIgASgNIncKHFF1ZXJ5TGlzdFBlbmRpbmdDY3R4UmVzcG9uc2USQQoMQ3Jvc3NDaGFpblR4GAEgAygLMisuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uQ3Jvc3NDaGFpblR4EhQKDHRvdGFsUGVuZGluZxgCIAEoBCI9ChxRdWVyeVJhdGVMaW1pdGVySW5wdXRSZXF1ZXN0Eg0KBWxpbWl0GAEgASgNEg4KBndpbmRvdxgCIAEoAyKoAgodUXVlcnlSYXRlTGltaXRlcklucHV0UmVzcG9uc2USDgoGaGVpZ2h0GAEgASgDEkEKDGNjdHhzX21pc3NlZBgCIAMoCzIrLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLkNyb3NzQ2hhaW5UeBJCCg1jY3R4c19wZW5kaW5nGAMgAygLMisuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uQ3Jvc3NDaGFpblR4EhUKDXRvdGFsX3BlbmRpbmcYBCABKAQSGAoQcGFzdF9jY3R4c192YWx1ZRgFIAEoCRIbChNwZW5kaW5nX2NjdHhzX3ZhbHVlGAYgASgJEiIKGmxvd2VzdF9wZW5kaW5nX2NjdHhfaGVpZ2h0GAcgASgDIjsKKlF1ZXJ5TGlzdFBlbmRpbmdDY3R4V2l0aGluUmF0ZUxpbWl0UmVxdWVzdBINCgVsaW1pdBgBIAEoDSLmAQorUXVlcnlMaXN0UGVuZGluZ0NjdHhXaXRoaW5SYXRlTGltaXRSZXNwb25zZRJDCg5jcm9zc19jaGFpbl90eBgBIAMoCzIrLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLkNyb3NzQ2hhaW5UeBIVCg10b3RhbF9wZW5kaW5nGAIgASgEEh8KF2N1cnJlbnRfd2l0aGRyYXdfd2luZG93GAMgASgDEh0KFWN1cnJlbnRfd2l0aGRyYXdfcmF0ZRgEIAEoCRIbChNyYXRlX2xpbWl0X2V4Y2VlZGVkGAUgASgIIhwKGlF1ZXJ5TGFzdFpldGFIZWlnaHRSZXF1ZXN0Ii0KG1F1ZXJ5TGFzdFpldGFIZWlnaHRSZXNwb25zZRIOCgZIZWlnaHQYASABKAMiQQocUXVlcnlDb252ZXJ0R2FzVG9aZXRhUmVxdWVzdBIPCgdjaGFpbklkGAEgASgDEhAKCGdhc0xpbWl0GAIgASgJIm4KHVF1ZXJ5Q29udmVydEdhc1RvWmV0YVJlc3BvbnNlEhkKEW91dGJvdW5kR2FzSW5aZXRhGAEgASgJEhkKEXByb3RvY29sRmVlSW5aZXRhGAIgASgJEhcKD1pldGFCbG9ja0hlaWdodBgDIAEoBCInCiVRdWVyeU1lc3NhZ2VQYXNzaW5nUHJvdG9jb2xGZWVSZXF1ZXN0IjsKJlF1ZXJ5TWVzc2FnZVBhc3NpbmdQcm90b2NvbEZlZVJlc3BvbnNlEhEKCWZlZUluWmV0YRgBIAEoCSIeChxRdWVyeVJhdGVMaW1pdGVyRmxhZ3NSZXF1ZXN0InAKHVF1ZXJ5UmF0ZUxpbWl0ZXJGbGFnc1Jlc3BvbnNlEk8KEHJhdGVMaW1pdGVyRmxhZ3MYASABKAsyLy56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5SYXRlTGltaXRlckZsYWdzQgTI3h8AIj8KGlF1ZXJ5SW5ib3VuZFRyYWNrZXJSZXF1ZXN0EhAKCGNoYWluX2lkGAEgASgDEg8KB3R4X2hhc2gYAiABKAkiawobUXVlcnlJbmJvdW5kVHJhY2tlclJlc3BvbnNlEkwKD2luYm91bmRfdHJhY2tlchgBIAEoCzItLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLkluYm91bmRUcmFja2VyQgTI3h8AMo4iCgVRdWVyeRLSAQoPT3V0Ym91bmRUcmFja2VyEj0uemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUXVlcnlHZXRPdXRib3VuZFRyYWNrZXJSZXF1ZXN0Gj4uemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUXVlcnlHZXRPdXRib3VuZFRyYWNrZXJSZXNwb25zZSJAgtPkkwI6EjgvemV0YS1jaGFpbi9jcm9zc2NoYWluL291dGJvdW5kVHJhY2tlci97Y2hhaW5JRH0ve25vbmNlfRLDAQoST3V0Ym91bmRUcmFja2VyQWxsEj0uemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUXVlcnlBbGxPdXRib3VuZFRyYWNrZXJSZXF1ZXN0Gj4uemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUXVlcnlBbGxPdXRib3VuZFRyYWNrZXJSZXNwb25zZSIugtPkkwIoEiYvemV0YS1jaGFpbi9jcm9zc2NoYWluL291dGJvdW5kVHJhY2tlchLnAQoZT3V0Ym91bmRUcmFja2VyQWxsQnlDaGFpbhJELnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5QWxsT3V0Ym91bmRUcmFja2VyQnlDaGFpblJlcXVlc3QaRS56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeUFsbE91dGJvdW5kVHJhY2tlckJ5Q2hhaW5SZXNwb25zZSI9gtPkkwI3EjUvemV0YS1jaGFpbi9jcm9zc2NoYWluL291dGJvdW5kVHJhY2tlckJ5Q2hhaW4ve2NoYWlufRLmAQoYSW5ib3VuZFRyYWNrZXJBbGxCeUNoYWluEkMuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUXVlcnlBbGxJbmJvdW5kVHJhY2tlckJ5Q2hhaW5SZXF1ZXN0GkQuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUXVlcnlBbGxJbmJvdW5kVHJhY2tlckJ5Q2hhaW5SZXNwb25zZSI/gtPkkwI5EjcvemV0YS1jaGFpbi9jcm9zc2NoYWluL2luYm91bmRUcmFja2VyQnlDaGFpbi97Y2hhaW5faWR9EsIBChFJbmJvdW5kVHJhY2tlckFsbBI9LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5QWxsSW5ib3VuZFRyYWNrZXJzUmVxdWVzdBo+LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5QWxsSW5ib3VuZFRyYWNrZXJzUmVzcG9uc2UiLoLT5JMCKBImL3pldGEtY2hhaW4vY3Jvc3NjaGFpbi9pbmJvdW5kVHJhY2tlcnMSywEKDkluYm91bmRUcmFja2VyEjkuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUXVlcnlJbmJvdW5kVHJhY2tlclJlcXVlc3QaOi56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeUluYm91bmRUcmFja2VyUmVzcG9uc2UiQoLT5JMCPBI6L3pldGEtY2hhaW4vY3Jvc3NjaGFpbi9pbmJvdW5kVHJhY2tlci97Y2hhaW5faWR9L3t0eF9oYXNofRLWAQoRSW5ib3VuZEhhc2hUb0NjdHgSPy56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeUdldEluYm91bmRIYXNoVG9DY3R4UmVxdWVzdBpALnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5R2V0SW5ib3VuZEhhc2hUb0NjdHhSZXNwb25zZSI+gtPkkwI4EjYvemV0YS1jaGFpbi9jcm9zc2NoYWluL2luYm91bmRIYXNoVG9DY3R4L3tpbmJvdW5kSGFzaH0S4AEKFUluYm91bmRIYXNoVG9DY3R4RGF0YRJALnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5SW5ib3VuZEhhc2hUb0NjdHhEYXRhUmVxdWVzdBpBLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5SW5ib3VuZEhhc2hUb0NjdHhEYXRhUmVzcG9uc2UiQoLT5JMCPBI6L3pldGEtY2hhaW4vY3Jvc3NjaGFpbi9pbmJvdW5kSGFzaFRvQ2N0eERhdGEve2luYm91bmRIYXNofRLLAQoUSW5ib3VuZEhhc2hUb0NjdHhBbGwSPy56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeUFsbEluYm91bmRIYXNoVG9DY3R4UmVxdWVzdBpALnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5QWxsSW5ib3VuZEhhc2hUb0NjdHhSZXNwb25zZSIwgtPkkwIqEigvemV0YS1jaGFpbi9jcm9zc2NoYWluL2luYm91bmRIYXNoVG9DY3R4EqwBCghHYXNQcmljZRI2LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5R2V0R2FzUHJpY2VSZXF1ZXN0GjcuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUXVlcnlHZXRHYXNQcmljZVJlc3BvbnNlIi+C0+STAikSJy96ZXRhLWNoYWluL2Nyb3NzY2hhaW4vZ2FzUHJpY2Uve2luZGV4fRKnAQoLR2FzUHJpY2VBbGwSNi56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeUFsbEdhc1ByaWNlUmVxdWVzdBo3LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5QWxsR2FzUHJpY2VSZXNwb25zZSIngtPkkwIhEh8vemV0YS1jaGFpbi9jcm9zc2NoYWluL2dhc1ByaWNlEr4BChBDb252ZXJ0R2FzVG9aZXRhEjsuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUXVlcnlDb252ZXJ0R2FzVG9aZXRhUmVxdWVzdBo8LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5Q29udmVydEdhc1RvWmV0YVJlc3BvbnNlIi+C0+STAikSJy96ZXRhLWNoYWluL2Nyb3NzY2hhaW4vY29udmVydEdhc1RvWmV0YRLGAQoLUHJvdG9jb2xGZWUSRC56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeU1lc3NhZ2VQYXNzaW5nUHJvdG9jb2xGZWVSZXF1ZXN0GkUuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUXVlcnlNZXNzYWdlUGFzc2luZ1Byb3RvY29sRmVlUmVzcG9uc2UiKoLT5JMCJBIiL3pldGEtY2hhaW4vY3Jvc3NjaGFpbi9wcm90b2NvbEZlZRKcAQoEQ2N0eBIyLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5R2V0Q2N0eFJlcXVlc3QaMy56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeUdldENjdHhSZXNwb25zZSIrgtPkkwIlEiMvemV0YS1jaGFpbi9jcm9zc2NoYWluL2NjdHgve2luZGV4fRK0AQoLQ2N0eEJ5Tm9uY2USOS56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeUdldENjdHhCeU5vbmNlUmVxdWVzdBozLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5R2V0Q2N0eFJlc3BvbnNlIjWC0+STAi8SLS96ZXRhLWNoYWluL2Nyb3NzY2hhaW4vY2N0eC97Y2hhaW5JRH0ve25vbmNlfRKXAQoHQ2N0eEFsbBIyLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5QWxsQ2N0eFJlcXVlc3QaMy56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeUFsbENjdHhSZXNwb25zZSIjgtPkkwIdEhsvemV0YS1jaGFpbi9jcm9zc2NoYWluL2NjdHgStgEKD0xpc3RQZW5kaW5nQ2N0eBI6LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5TGlzdFBlbmRpbmdDY3R4UmVxdWVzdBo7LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5TGlzdFBlbmRpbmdDY3R4UmVzcG9uc2UiKoLT5JMCJBIiL3pldGEtY2hhaW4vY3Jvc3NjaGFpbi9wZW5kaW5nQ2N0eBLyAQoeTGlzdFBlbmRpbmdDY3R4V2l0aGluUmF0ZUxpbWl0EkkuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUXVlcnlMaXN0UGVuZGluZ0NjdHhXaXRoaW5SYXRlTGltaXRSZXF1ZXN0GkouemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUXVlcnlMaXN0UGVuZGluZ0NjdHhXaXRoaW5SYXRlTGltaXRSZXNwb25zZSI5gtPkkwIzEjEvemV0YS1jaGFpbi9jcm9zc2NoYWluL3BlbmRpbmdDY3R4V2l0aGluUmF0ZUxpbWl0ErYBCg5aZXRhQWNjb3VudGluZxI5LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5WmV0YUFjY291bnRpbmdSZXF1ZXN0GjouemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUXVlcnlaZXRhQWNjb3VudGluZ1Jlc3BvbnNlIi2C0+STAicSJS96ZXRhLWNoYWluL2Nyb3NzY2hhaW4vemV0YUFjY291bnRpbmcStgEKDkxhc3RaZXRhSGVpZ2h0EjkuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUXVlcnlMYXN0WmV0YUhlaWdodFJlcXVlc3QaOi56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeUxhc3RaZXRhSGVpZ2h0UmVzcG9uc2UiLYLT5JMCJxIlL3pldGEtY2hhaW4vY3Jvc3NjaGFpbi9sYXN0WmV0YUhlaWdodBK+AQoQUmF0ZUxpbWl0ZXJGbGFncxI7LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5UmF0ZUxpbWl0ZXJGbGFnc1JlcXVlc3QaPC56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeVJhdGVMaW1pdGVyRmxhZ3NSZXNwb25zZSIvgtPkkwIpEicvemV0YS1jaGFpbi9jcm9zc2NoYWluL3JhdGVMaW1pdGVyRmxhZ3MSvgEKEFJhdGVMaW1pdGVySW5wdXQSOy56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeVJhdGVMaW1pdGVySW5wdXRSZXF1ZXN0GjwuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUXVlcnlSYXRlTGltaXRlcklucHV0UmVzcG9uc2UiL4LT5JMCKRInL3pldGEtY2hhaW4vY3Jvc3NjaGFpbi9yYXRlTGltaXRlcklucHV0GgWA57AqAUL0AQohY29tLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluQgpRdWVyeVByb3RvUAFaLWdpdGh1Yi5jb20vemV0YS1jaGFpbi9ub2RlL3gvY3Jvc3NjaGFpbi90eXBlc6ICA1paQ6oCHVpldGFjaGFpbi5aZXRhY29yZS5Dcm9zc2NoYWluygIdWmV0YWNoYWluXFpldGFjb3JlXENyb3NzY2hhaW7iAilaZXRhY2hhaW5cWmV0YWNvcmVcQ3Jvc3NjaGFpblxHUEJNZXRhZGF0YeoCH1pldGFjaGFpbjo6WmV0YWNvcmU6OkNyb3NzY2hhaW5iBnByb3RvMw", [file_cosmos_base_query_v1beta1_pagination, file_zetachain_zetacore_crosschain_cross_chain_tx, file_zetachain_zetacore_crosschain_gas_price, file_zetachain_zetacore_crosschain_inbound_hash_to_cctx, file_zetachain_zetacore_crosschain_inbound_tracker, file_zetachain_zetacore_crosschain_outbound_tracker, file_zetachain_zetacore_crosschain_rate_limiter_flags, file_gogoproto_gogo, file_google_api_annotations, file_cosmos_msg_v1_msg]);
+  fileDesc("Cil6ZXRhY2hhaW4vemV0YWNvcmUvY3Jvc3NjaGFpbi9xdWVyeS5wcm90bxIdemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4iHAoaUXVlcnlaZXRhQWNjb3VudGluZ1JlcXVlc3QiOgobUXVlcnlaZXRhQWNjb3VudGluZ1Jlc3BvbnNlEhsKE2Fib3J0ZWRfemV0YV9hbW91bnQYASABKAkiQAoeUXVlcnlHZXRPdXRib3VuZFRyYWNrZXJSZXF1ZXN0Eg8KB2NoYWluSUQYASABKAMSDQoFbm9uY2UYAiABKAQicAofUXVlcnlHZXRPdXRib3VuZFRyYWNrZXJSZXNwb25zZRJNCg9vdXRib3VuZFRyYWNrZXIYASABKAsyLi56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5PdXRib3VuZFRyYWNrZXJCBMjeHwAiXAoeUXVlcnlBbGxPdXRib3VuZFRyYWNrZXJSZXF1ZXN0EjoKCnBhZ2luYXRpb24YASABKAsyJi5jb3Ntb3MuYmFzZS5xdWVyeS52MWJldGExLlBhZ2VSZXF1ZXN0Iq0BCh9RdWVyeUFsbE91dGJvdW5kVHJhY2tlclJlc3BvbnNlEk0KD291dGJvdW5kVHJhY2tlchgBIAMoCzIuLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLk91dGJvdW5kVHJhY2tlckIEyN4fABI7CgpwYWdpbmF0aW9uGAIgASgLMicuY29zbW9zLmJhc2UucXVlcnkudjFiZXRhMS5QYWdlUmVzcG9uc2UicgolUXVlcnlBbGxPdXRib3VuZFRyYWNrZXJCeUNoYWluUmVxdWVzdBINCgVjaGFpbhgBIAEoAxI6CgpwYWdpbmF0aW9uGAIgASgLMiYuY29zbW9zLmJhc2UucXVlcnkudjFiZXRhMS5QYWdlUmVxdWVzdCK0AQomUXVlcnlBbGxPdXRib3VuZFRyYWNrZXJCeUNoYWluUmVzcG9uc2USTQoPb3V0Ym91bmRUcmFja2VyGAEgAygLMi4uemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uT3V0Ym91bmRUcmFja2VyQgTI3h8AEjsKCnBhZ2luYXRpb24YAiABKAsyJy5jb3Ntb3MuYmFzZS5xdWVyeS52MWJldGExLlBhZ2VSZXNwb25zZSJ0CiRRdWVyeUFsbEluYm91bmRUcmFja2VyQnlDaGFpblJlcXVlc3QSEAoIY2hhaW5faWQYASABKAMSOgoKcGFnaW5hdGlvbhgCIAEoCzImLmNvc21vcy5iYXNlLnF1ZXJ5LnYxYmV0YTEuUGFnZVJlcXVlc3QisQEKJVF1ZXJ5QWxsSW5ib3VuZFRyYWNrZXJCeUNoYWluUmVzcG9uc2USSwoOaW5ib3VuZFRyYWNrZXIYASADKAsyLS56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5JbmJvdW5kVHJhY2tlckIEyN4fABI7CgpwYWdpbmF0aW9uGAIgASgLMicuY29zbW9zLmJhc2UucXVlcnkudjFiZXRhMS5QYWdlUmVzcG9uc2UiXAoeUXVlcnlBbGxJbmJvdW5kVHJhY2tlcnNSZXF1ZXN0EjoKCnBhZ2luYXRpb24YASABKAsyJi5jb3Ntb3MuYmFzZS5xdWVyeS52MWJldGExLlBhZ2VSZXF1ZXN0IqsBCh9RdWVyeUFsbEluYm91bmRUcmFja2Vyc1Jlc3BvbnNlEksKDmluYm91bmRUcmFja2VyGAEgAygLMi0uemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uSW5ib3VuZFRyYWNrZXJCBMjeHwASOwoKcGFnaW5hdGlvbhgCIAEoCzInLmNvc21vcy5iYXNlLnF1ZXJ5LnYxYmV0YTEuUGFnZVJlc3BvbnNlIjcKIFF1ZXJ5R2V0SW5ib3VuZEhhc2hUb0NjdHhSZXF1ZXN0EhMKC2luYm91bmRIYXNoGAEgASgJInYKIVF1ZXJ5R2V0SW5ib3VuZEhhc2hUb0NjdHhSZXNwb25zZRJRChFpbmJvdW5kSGFzaFRvQ2N0eBgBIAEoCzIwLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLkluYm91bmRIYXNoVG9DY3R4QgTI3h8AIjgKIVF1ZXJ5SW5ib3VuZEhhc2hUb0NjdHhEYXRhUmVxdWVzdBITCgtpbmJvdW5kSGFzaBgBIAEoCSJuCiJRdWVyeUluYm91bmRIYXNoVG9DY3R4RGF0YVJlc3BvbnNlEkgKDUNyb3NzQ2hhaW5UeHMYASADKAsyKy56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5Dcm9zc0NoYWluVHhCBMjeHwAiXgogUXVlcnlBbGxJbmJvdW5kSGFzaFRvQ2N0eFJlcXVlc3QSOgoKcGFnaW5hdGlvbhgBIAEoCzImLmNvc21vcy5iYXNlLnF1ZXJ5LnYxYmV0YTEuUGFnZVJlcXVlc3QiswEKIVF1ZXJ5QWxsSW5ib3VuZEhhc2hUb0NjdHhSZXNwb25zZRJRChFpbmJvdW5kSGFzaFRvQ2N0eBgBIAMoCzIwLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLkluYm91bmRIYXNoVG9DY3R4QgTI3h8AEjsKCnBhZ2luYXRpb24YAiABKAsyJy5jb3Ntb3MuYmFzZS5xdWVyeS52MWJldGExLlBhZ2VSZXNwb25zZSIoChdRdWVyeUdldEdhc1ByaWNlUmVxdWVzdBINCgVpbmRleBgBIAEoCSJVChhRdWVyeUdldEdhc1ByaWNlUmVzcG9uc2USOQoIR2FzUHJpY2UYASABKAsyJy56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5HYXNQcmljZSJVChdRdWVyeUFsbEdhc1ByaWNlUmVxdWVzdBI6CgpwYWdpbmF0aW9uGAEgASgLMiYuY29zbW9zLmJhc2UucXVlcnkudjFiZXRhMS5QYWdlUmVxdWVzdCKSAQoYUXVlcnlBbGxHYXNQcmljZVJlc3BvbnNlEjkKCEdhc1ByaWNlGAEgAygLMicuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uR2FzUHJpY2USOwoKcGFnaW5hdGlvbhgCIAEoCzInLmNvc21vcy5iYXNlLnF1ZXJ5LnYxYmV0YTEuUGFnZVJlc3BvbnNlIiQKE1F1ZXJ5R2V0Q2N0eFJlcXVlc3QSDQoFaW5kZXgYASABKAkiPAoaUXVlcnlHZXRDY3R4QnlOb25jZVJlcXVlc3QSDwoHY2hhaW5JRBgBIAEoAxINCgVub25jZRgCIAEoBCJZChRRdWVyeUdldENjdHhSZXNwb25zZRJBCgxDcm9zc0NoYWluVHgYASABKAsyKy56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5Dcm9zc0NoYWluVHgiZAoTUXVlcnlBbGxDY3R4UmVxdWVzdBI6CgpwYWdpbmF0aW9uGAEgASgLMiYuY29zbW9zLmJhc2UucXVlcnkudjFiZXRhMS5QYWdlUmVxdWVzdBIRCgl1bm9yZGVyZWQYAiABKAgilgEKFFF1ZXJ5QWxsQ2N0eFJlc3BvbnNlEkEKDENyb3NzQ2hhaW5UeBgBIAMoCzIrLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLkNyb3NzQ2hhaW5UeBI7CgpwYWdpbmF0aW9uGAIgASgLMicuY29zbW9zLmJhc2UucXVlcnkudjFiZXRhMS5QYWdlUmVzcG9uc2UiPgobUXVlcnlMaXN0UGVuZGluZ0NjdHhSZXF1ZXN0EhAKCGNoYWluX2lkGAEgASgDEg0KBWxpbWl0GAIgASgNIncKHFF1ZXJ5TGlzdFBlbmRpbmdDY3R4UmVzcG9uc2USQQoMQ3Jvc3NDaGFpblR4GAEgAygLMisuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uQ3Jvc3NDaGFpblR4EhQKDHRvdGFsUGVuZGluZxgCIAEoBCI9ChxRdWVyeVJhdGVMaW1pdGVySW5wdXRSZXF1ZXN0Eg0KBWxpbWl0GAEgASgNEg4KBndpbmRvdxgCIAEoAyKoAgodUXVlcnlSYXRlTGltaXRlcklucHV0UmVzcG9uc2USDgoGaGVpZ2h0GAEgASgDEkEKDGNjdHhzX21pc3NlZBgCIAMoCzIrLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLkNyb3NzQ2hhaW5UeBJCCg1jY3R4c19wZW5kaW5nGAMgAygLMisuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uQ3Jvc3NDaGFpblR4EhUKDXRvdGFsX3BlbmRpbmcYBCABKAQSGAoQcGFzdF9jY3R4c192YWx1ZRgFIAEoCRIbChNwZW5kaW5nX2NjdHhzX3ZhbHVlGAYgASgJEiIKGmxvd2VzdF9wZW5kaW5nX2NjdHhfaGVpZ2h0GAcgASgDIjsKKlF1ZXJ5TGlzdFBlbmRpbmdDY3R4V2l0aGluUmF0ZUxpbWl0UmVxdWVzdBINCgVsaW1pdBgBIAEoDSLmAQorUXVlcnlMaXN0UGVuZGluZ0NjdHhXaXRoaW5SYXRlTGltaXRSZXNwb25zZRJDCg5jcm9zc19jaGFpbl90eBgBIAMoCzIrLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLkNyb3NzQ2hhaW5UeBIVCg10b3RhbF9wZW5kaW5nGAIgASgEEh8KF2N1cnJlbnRfd2l0aGRyYXdfd2luZG93GAMgASgDEh0KFWN1cnJlbnRfd2l0aGRyYXdfcmF0ZRgEIAEoCRIbChNyYXRlX2xpbWl0X2V4Y2VlZGVkGAUgASgIIhwKGlF1ZXJ5TGFzdFpldGFIZWlnaHRSZXF1ZXN0Ii0KG1F1ZXJ5TGFzdFpldGFIZWlnaHRSZXNwb25zZRIOCgZIZWlnaHQYASABKAMiQQocUXVlcnlDb252ZXJ0R2FzVG9aZXRhUmVxdWVzdBIPCgdjaGFpbklkGAEgASgDEhAKCGdhc0xpbWl0GAIgASgJIm4KHVF1ZXJ5Q29udmVydEdhc1RvWmV0YVJlc3BvbnNlEhkKEW91dGJvdW5kR2FzSW5aZXRhGAEgASgJEhkKEXByb3RvY29sRmVlSW5aZXRhGAIgASgJEhcKD1pldGFCbG9ja0hlaWdodBgDIAEoBCInCiVRdWVyeU1lc3NhZ2VQYXNzaW5nUHJvdG9jb2xGZWVSZXF1ZXN0IjsKJlF1ZXJ5TWVzc2FnZVBhc3NpbmdQcm90b2NvbEZlZVJlc3BvbnNlEhEKCWZlZUluWmV0YRgBIAEoCSIeChxRdWVyeVJhdGVMaW1pdGVyRmxhZ3NSZXF1ZXN0InAKHVF1ZXJ5UmF0ZUxpbWl0ZXJGbGFnc1Jlc3BvbnNlEk8KEHJhdGVMaW1pdGVyRmxhZ3MYASABKAsyLy56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5SYXRlTGltaXRlckZsYWdzQgTI3h8AIj8KGlF1ZXJ5SW5ib3VuZFRyYWNrZXJSZXF1ZXN0EhAKCGNoYWluX2lkGAEgASgDEg8KB3R4X2hhc2gYAiABKAkiawobUXVlcnlJbmJvdW5kVHJhY2tlclJlc3BvbnNlEkwKD2luYm91bmRfdHJhY2tlchgBIAEoCzItLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLkluYm91bmRUcmFja2VyQgTI3h8AIt8BChtRdWVyeVNpbXVsYXRlSW5ib3VuZFJlcXVlc3QSFwoPc2VuZGVyX2NoYWluX2lkGAEgASgDEg4KBnNlbmRlchgCIAEoCRIQCghyZWNlaXZlchgDIAEoCRI4Cgljb2luX3R5cGUYBCABKA4yJS56ZXRhY2hhaW4uemV0YWNvcmUucGtnLmNvaW4uQ29pblR5cGUSDQoFYXNzZXQYBSABKAkSDgoGYW1vdW50GAYgASgJEg8KB21lc3NhZ2UYByABKAkSGwoTaXNfY3Jvc3NfY2hhaW5fY2FsbBgIIAEoCCKuAgocUXVlcnlTaW11bGF0ZUluYm91bmRSZXNwb25zZRJDCg5jcm9zc19jaGFpbl90eBgBIAEoCzIrLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLkNyb3NzQ2hhaW5UeBI5CgZzdGF0dXMYAiABKA4yKS56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5DY3R4U3RhdHVzEhUKDXJldmVydF9yZWFzb24YAyABKAkSEAoIZ2FzX3VzZWQYBCABKAQSFAoMd2l0aGRyYXdfZmVlGAUgASgJEk8KFHJlY2VpdmVyX3NwbGl0X2NjdHhzGAYgAygLMisuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uQ3Jvc3NDaGFpblR4QgTI3h8AIiwKFFF1ZXJ5Q2N0eFRyZWVSZXF1ZXN0EhQKDGluYm91bmRfaGFzaBgBIAEoCSJhChVRdWVyeUNjdHhUcmVlUmVzcG9uc2USSAoNQ3Jvc3NDaGFpblR4cxgBIAMoCzIrLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLkNyb3NzQ2hhaW5UeEIEyN4fADL7JAoFUXVlcnkS0gEKD091dGJvdW5kVHJhY2tlchI9LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5R2V0T3V0Ym91bmRUcmFja2VyUmVxdWVzdBo+LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5R2V0T3V0Ym91bmRUcmFja2VyUmVzcG9uc2UiQILT5JMCOhI4L3pldGEtY2hhaW4vY3Jvc3NjaGFpbi9vdXRib3VuZFRyYWNrZXIve2NoYWluSUR9L3tub25jZX0SwwEKEk91dGJvdW5kVHJhY2tlckFsbBI9LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5QWxsT3V0Ym91bmRUcmFja2VyUmVxdWVzdBo+LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5QWxsT3V0Ym91bmRUcmFja2VyUmVzcG9uc2UiLoLT5JMCKBImL3pldGEtY2hhaW4vY3Jvc3NjaGFpbi9vdXRib3VuZFRyYWNrZXIS5wEKGU91dGJvdW5kVHJhY2tlckFsbEJ5Q2hhaW4SRC56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeUFsbE91dGJvdW5kVHJhY2tlckJ5Q2hhaW5SZXF1ZXN0GkUuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUXVlcnlBbGxPdXRib3VuZFRyYWNrZXJCeUNoYWluUmVzcG9uc2UiPYLT5JMCNxI1L3pldGEtY2hhaW4vY3Jvc3NjaGFpbi9vdXRib3VuZFRyYWNrZXJCeUNoYWluL3tjaGFpbn0S5gEKGEluYm91bmRUcmFja2VyQWxsQnlDaGFpbhJDLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5QWxsSW5ib3VuZFRyYWNrZXJCeUNoYWluUmVxdWVzdBpELnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5QWxsSW5ib3VuZFRyYWNrZXJCeUNoYWluUmVzcG9uc2UiP4LT5JMCORI3L3pldGEtY2hhaW4vY3Jvc3NjaGFpbi9pbmJvdW5kVHJhY2tlckJ5Q2hhaW4ve2NoYWluX2lkfRLCAQoRSW5ib3VuZFRyYWNrZXJBbGwSPS56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeUFsbEluYm91bmRUcmFja2Vyc1JlcXVlc3QaPi56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeUFsbEluYm91bmRUcmFja2Vyc1Jlc3BvbnNlIi6C0+STAigSJi96ZXRhLWNoYWluL2Nyb3NzY2hhaW4vaW5ib3VuZFRyYWNrZXJzEssBCg5JbmJvdW5kVHJhY2tlchI5LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5SW5ib3VuZFRyYWNrZXJSZXF1ZXN0GjouemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUXVlcnlJbmJvdW5kVHJhY2tlclJlc3BvbnNlIkKC0+STAjwSOi96ZXRhLWNoYWluL2Nyb3NzY2hhaW4vaW5ib3VuZFRyYWNrZXIve2NoYWluX2lkfS97dHhfaGFzaH0S1gEKEUluYm91bmRIYXNoVG9DY3R4Ej8uemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUXVlcnlHZXRJbmJvdW5kSGFzaFRvQ2N0eFJlcXVlc3QaQC56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeUdldEluYm91bmRIYXNoVG9DY3R4UmVzcG9uc2UiPoLT5JMCOBI2L3pldGEtY2hhaW4vY3Jvc3NjaGFpbi9pbmJvdW5kSGFzaFRvQ2N0eC97aW5ib3VuZEhhc2h9EuABChVJbmJvdW5kSGFzaFRvQ2N0eERhdGESQC56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeUluYm91bmRIYXNoVG9DY3R4RGF0YVJlcXVlc3QaQS56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeUluYm91bmRIYXNoVG9DY3R4RGF0YVJlc3BvbnNlIkKC0+STAjwSOi96ZXRhLWNoYWluL2Nyb3NzY2hhaW4vaW5ib3VuZEhhc2hUb0NjdHhEYXRhL3tpbmJvdW5kSGFzaH0SywEKFEluYm91bmRIYXNoVG9DY3R4QWxsEj8uemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUXVlcnlBbGxJbmJvdW5kSGFzaFRvQ2N0eFJlcXVlc3QaQC56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeUFsbEluYm91bmRIYXNoVG9DY3R4UmVzcG9uc2UiMILT5JMCKhIoL3pldGEtY2hhaW4vY3Jvc3NjaGFpbi9pbmJvdW5kSGFzaFRvQ2N0eBKsAQoIR2FzUHJpY2USNi56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeUdldEdhc1ByaWNlUmVxdWVzdBo3LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5R2V0R2FzUHJpY2VSZXNwb25zZSIvgtPkkwIpEicvemV0YS1jaGFpbi9jcm9zc2NoYWluL2dhc1ByaWNlL3tpbmRleH0SpwEKC0dhc1ByaWNlQWxsEjYuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUXVlcnlBbGxHYXNQcmljZVJlcXVlc3QaNy56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeUFsbEdhc1ByaWNlUmVzcG9uc2UiJ4LT5JMCIRIfL3pldGEtY2hhaW4vY3Jvc3NjaGFpbi9nYXNQcmljZRK+AQoQQ29udmVydEdhc1RvWmV0YRI7LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5Q29udmVydEdhc1RvWmV0YVJlcXVlc3QaPC56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeUNvbnZlcnRHYXNUb1pldGFSZXNwb25zZSIvgtPkkwIpEicvemV0YS1jaGFpbi9jcm9zc2NoYWluL2NvbnZlcnRHYXNUb1pldGESxgEKC1Byb3RvY29sRmVlEkQuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUXVlcnlNZXNzYWdlUGFzc2luZ1Byb3RvY29sRmVlUmVxdWVzdBpFLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5TWVzc2FnZVBhc3NpbmdQcm90b2NvbEZlZVJlc3BvbnNlIiqC0+STAiQSIi96ZXRhLWNoYWluL2Nyb3NzY2hhaW4vcHJvdG9jb2xGZWUSnAEKBENjdHgSMi56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeUdldENjdHhSZXF1ZXN0GjMuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUXVlcnlHZXRDY3R4UmVzcG9uc2UiK4LT5JMCJRIjL3pldGEtY2hhaW4vY3Jvc3NjaGFpbi9jY3R4L3tpbmRleH0StAEKC0NjdHhCeU5vbmNlEjkuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUXVlcnlHZXRDY3R4QnlOb25jZVJlcXVlc3QaMy56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeUdldENjdHhSZXNwb25zZSI1gtPkkwIvEi0vemV0YS1jaGFpbi9jcm9zc2NoYWluL2NjdHgve2NoYWluSUR9L3tub25jZX0SlwEKB0NjdHhBbGwSMi56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeUFsbENjdHhSZXF1ZXN0GjMuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUXVlcnlBbGxDY3R4UmVzcG9uc2UiI4LT5JMCHRIbL3pldGEtY2hhaW4vY3Jvc3NjaGFpbi9jY3R4ErYBCg9MaXN0UGVuZGluZ0NjdHgSOi56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeUxpc3RQZW5kaW5nQ2N0eFJlcXVlc3QaOy56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeUxpc3RQZW5kaW5nQ2N0eFJlc3BvbnNlIiqC0+STAiQSIi96ZXRhLWNoYWluL2Nyb3NzY2hhaW4vcGVuZGluZ0NjdHgS8gEKHkxpc3RQZW5kaW5nQ2N0eFdpdGhpblJhdGVMaW1pdBJJLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5TGlzdFBlbmRpbmdDY3R4V2l0aGluUmF0ZUxpbWl0UmVxdWVzdBpKLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5TGlzdFBlbmRpbmdDY3R4V2l0aGluUmF0ZUxpbWl0UmVzcG9uc2UiOYLT5JMCMxIxL3pldGEtY2hhaW4vY3Jvc3NjaGFpbi9wZW5kaW5nQ2N0eFdpdGhpblJhdGVMaW1pdBK2AQoOWmV0YUFjY291bnRpbmcSOS56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeVpldGFBY2NvdW50aW5nUmVxdWVzdBo6LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5WmV0YUFjY291bnRpbmdSZXNwb25zZSItgtPkkwInEiUvemV0YS1jaGFpbi9jcm9zc2NoYWluL3pldGFBY2NvdW50aW5nErYBCg5MYXN0WmV0YUhlaWdodBI5LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5TGFzdFpldGFIZWlnaHRSZXF1ZXN0GjouemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUXVlcnlMYXN0WmV0YUhlaWdodFJlc3BvbnNlIi2C0+STAicSJS96ZXRhLWNoYWluL2Nyb3NzY2hhaW4vbGFzdFpldGFIZWlnaHQSvgEKEFJhdGVMaW1pdGVyRmxhZ3MSOy56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeVJhdGVMaW1pdGVyRmxhZ3NSZXF1ZXN0GjwuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUXVlcnlSYXRlTGltaXRlckZsYWdzUmVzcG9uc2UiL4LT5JMCKRInL3pldGEtY2hhaW4vY3Jvc3NjaGFpbi9yYXRlTGltaXRlckZsYWdzEr4BChBSYXRlTGltaXRlcklucHV0EjsuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUXVlcnlSYXRlTGltaXRlcklucHV0UmVxdWVzdBo8LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5UmF0ZUxpbWl0ZXJJbnB1dFJlc3BvbnNlIi+C0+STAikSJy96ZXRhLWNoYWluL2Nyb3NzY2hhaW4vcmF0ZUxpbWl0ZXJJbnB1dBK6AQoPU2ltdWxhdGVJbmJvdW5kEjouemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUXVlcnlTaW11bGF0ZUluYm91bmRSZXF1ZXN0GjsuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUXVlcnlTaW11bGF0ZUluYm91bmRSZXNwb25zZSIugtPkkwIoEiYvemV0YS1jaGFpbi9jcm9zc2NoYWluL3NpbXVsYXRlSW5ib3VuZBKtAQoIQ2N0eFRyZWUSMy56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5RdWVyeUNjdHhUcmVlUmVxdWVzdBo0LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlF1ZXJ5Q2N0eFRyZWVSZXNwb25zZSI2gtPkkwIwEi4vemV0YS1jaGFpbi9jcm9zc2NoYWluL2NjdHhUcmVlL3tpbmJvdW5kX2hhc2h9GgWA57AqAUL0AQohY29tLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluQgpRdWVyeVByb3RvUAFaLWdpdGh1Yi5jb20vemV0YS1jaGFpbi9ub2RlL3gvY3Jvc3NjaGFpbi90eXBlc6ICA1paQ6oCHVpldGFjaGFpbi5aZXRhY29yZS5Dcm9zc2NoYWluygIdWmV0YWNoYWluXFpldGFjb3JlXENyb3NzY2hhaW7iAilaZXRhY2hhaW5cWmV0YWNvcmVcQ3Jvc3NjaGFpblxHUEJNZXRhZGF0YeoCH1pldGFjaGFpbjo6WmV0YWNvcmU6OkNyb3NzY2hhaW5iBnByb3RvMw", [file_cosmos_base_query_v1beta1_pagination, file_zetachain_zetacore_crosschain_cross_chain_tx, file_zetachain_zetacore_crosschain_gas_price, file_zetachain_zetacore_crosschain_inbound_hash_to_cctx, file_zetachain_zetacore_crosschain_inbound_tracker, file_zetachain_zetacore_crosschain_outbound_tracker, file_zetachain_zetacore_crosschain_rate_limiter_flags, file_zetachain_zetacore_pkg_coin_coin, file_gogoproto_gogo, file_google_api_annotations, file_cosmos_msg_v1_msg]);
 
 /**
  * @generated from message zetachain.zetacore.crosschain.QueryZetaAccountingRequest
@@ -892,6 +894,144 @@ export type QueryInboundTrackerResponse = Message<"zetachain.zetacore.crosschain
 export const QueryInboundTrackerResponseSchema: GenMessage<QueryInboundTrackerResponse> = /*@__PURE__*/
   messageDesc(file_zetachain_zetacore_crosschain_query, 42);
 
+/**
+ * @generated from message zetachain.zetacore.crosschain.QuerySimulateInboundRequest
+ */
+export type QuerySimulateInboundRequest = Message<"zetachain.zetacore.crosschain.QuerySimulateInboundRequest"> & {
+  /**
+   * @generated from field: int64 sender_chain_id = 1;
+   */
+  senderChainId: bigint;
+
+  /**
+   * @generated from field: string sender = 2;
+   */
+  sender: string;
+
+  /**
+   * @generated from field: string receiver = 3;
+   */
+  receiver: string;
+
+  /**
+   * @generated from field: zetachain.zetacore.pkg.coin.CoinType coin_type = 4;
+   */
+  coinType: CoinType;
+
+  /**
+   * @generated from field: string asset = 5;
+   */
+  asset: string;
+
+  /**
+   * @generated from field: string amount = 6;
+   */
+  amount: string;
+
+  /**
+   * hex encoded message of the inbound, it can be a standard memo
+   *
+   * @generated from field: string message = 7;
+   */
+  message: string;
+
+  /**
+   * @generated from field: bool is_cross_chain_call = 8;
+   */
+  isCrossChainCall: boolean;
+};
+
+/**
+ * Describes the message zetachain.zetacore.crosschain.QuerySimulateInboundRequest.
+ * Use `create(QuerySimulateInboundRequestSchema)` to create a new message.
+ */
+export const QuerySimulateInboundRequestSchema: GenMessage<QuerySimulateInboundRequest> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_crosschain_query, 43);
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.QuerySimulateInboundResponse
+ */
+export type QuerySimulateInboundResponse = Message<"zetachain.zetacore.crosschain.QuerySimulateInboundResponse"> & {
+  /**
+   * @generated from field: zetachain.zetacore.crosschain.CrossChainTx cross_chain_tx = 1;
+   */
+  crossChainTx?: CrossChainTx;
+
+  /**
+   * @generated from field: zetachain.zetacore.crosschain.CctxStatus status = 2;
+   */
+  status: CctxStatus;
+
+  /**
+   * @generated from field: string revert_reason = 3;
+   */
+  revertReason: string;
+
+  /**
+   * gas used by the ZEVM calls
+   *
+   * @generated from field: uint64 gas_used = 4;
+   */
+  gasUsed: bigint;
+
+  /**
+   * fee to withdraw the gas asset to the sender chain, paid by a revert
+   *
+   * @generated from field: string withdraw_fee = 5;
+   */
+  withdrawFee: string;
+
+  /**
+   * child cctxs depositing the receiver splits carried by the message
+   *
+   * @generated from field: repeated zetachain.zetacore.crosschain.CrossChainTx receiver_split_cctxs = 6;
+   */
+  receiverSplitCctxs: CrossChainTx[];
+};
+
+/**
+ * Describes the message zetachain.zetacore.crosschain.QuerySimulateInboundResponse.
+ * Use `create(QuerySimulateInboundResponseSchema)` to create a new message.
+ */
+export const QuerySimulateInboundResponseSchema: GenMessage<QuerySimulateInboundResponse> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_crosschain_query, 44);
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryCctxTreeRequest
+ */
+export type QueryCctxTreeRequest = Message<"zetachain.zetacore.crosschain.QueryCctxTreeRequest"> & {
+  /**
+   * @generated from field: string inbound_hash = 1;
+   */
+  inboundHash: string;
+};
+
+/**
+ * Describes the message zetachain.zetacore.crosschain.QueryCctxTreeRequest.
+ * Use `create(QueryCctxTreeRequestSchema)` to create a new message.
+ */
+export const QueryCctxTreeRequestSchema: GenMessage<QueryCctxTreeRequest> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_crosschain_query, 45);
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryCctxTreeResponse
+ */
+export type QueryCctxTreeResponse = Message<"zetachain.zetacore.crosschain.QueryCctxTreeResponse"> & {
+  /**
+   * cctxs of the tree, parents are always listed before their children
+   *
+   * @generated from field: repeated zetachain.zetacore.crosschain.CrossChainTx CrossChainTxs = 1;
+   */
+  CrossChainTxs: CrossChainTx[];
+};
+
+/**
+ * Describes the message zetachain.zetacore.crosschain.QueryCctxTreeResponse.
+ * Use `create(QueryCctxTreeResponseSchema)` to create a new message.
+ */
+export const QueryCctxTreeResponseSchema: GenMessage<QueryCctxTreeResponse> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_crosschain_query, 46);
+
 /**
  * Query defines the gRPC querier service.
  *
@@ -1104,6 +1244,28 @@ export const Query: GenService<{
     input: typeof QueryRateLimiterInputRequestSchema;
     output: typeof QueryRateLimiterInputResponseSchema;
   },
+  /**
+   * Simulates the processing of an inbound on ZetaChain without changing the
+   * state.
+   *
+   * @generated from rpc zetachain.zetacore.crosschain.Query.SimulateInbound
+   */
+  simulateInbound: {
+    methodKind: "unary";
+    input: typeof QuerySimulateInboundRequestSchema;
+    output: typeof QuerySimulateInboundResponseSchema;
+  },
+  /**
+   * Queries the tree of cctxs created from an inbound, including the cctxs
+   * created from the ZEVM execution of other cctxs of the tree.
+   *
+   * @generated from rpc zetachain.zetacore.crosschain.Query.CctxTree
+   */
+  cctxTree: {
+    methodKind: "unary";
+    input: typeof QueryCctxTreeRequestSchema;
+    output: typeof QueryCctxTreeResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_zetachain_zetacore_crosschain_query, 0);
 
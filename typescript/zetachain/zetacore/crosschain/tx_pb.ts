@@ -22,7 +22,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file zetachain/zetacore/crosschain/tx.proto.
  */
 export const file_zetachain_zetacore_crosschain_tx: GenFile = /*@__PURE__*/
-  fileDesc("CiZ6ZXRhY2hhaW4vemV0YWNvcmUvY3Jvc3NjaGFpbi90eC5wcm90bxIdemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4idQoSTXNnTWlncmF0ZVRzc0Z1bmRzEg8KB2NyZWF0b3IYASABKAkSEAoIY2hhaW5faWQYAiABKAMSLgoGYW1vdW50GAMgASgJQh7I3h8A2t4fFmNvc21vc3Nkay5pby9tYXRoLlVpbnQ6DILnsCoHY3JlYXRvciIcChpNc2dNaWdyYXRlVHNzRnVuZHNSZXNwb25zZSJIChNNc2dVcGRhdGVUc3NBZGRyZXNzEg8KB2NyZWF0b3IYASABKAkSEgoKdHNzX3B1YmtleRgCIAEoCToMguewKgdjcmVhdG9yIh0KG01zZ1VwZGF0ZVRzc0FkZHJlc3NSZXNwb25zZSL5AQoUTXNnQWRkSW5ib3VuZFRyYWNrZXISDwoHY3JlYXRvchgBIAEoCRIQCghjaGFpbl9pZBgCIAEoAxIPCgd0eF9oYXNoGAMgASgJEjgKCWNvaW5fdHlwZRgEIAEoDjIlLnpldGFjaGFpbi56ZXRhY29yZS5wa2cuY29pbi5Db2luVHlwZRI3CgVwcm9vZhgFIAEoCzIkLnpldGFjaGFpbi56ZXRhY29yZS5wa2cucHJvb2ZzLlByb29mQgIYARIWCgpibG9ja19oYXNoGAYgASgJQgIYARIUCgh0eF9pbmRleBgHIAEoA0ICGAE6DILnsCoHY3JlYXRvciIeChxNc2dBZGRJbmJvdW5kVHJhY2tlclJlc3BvbnNlIlsKF01zZ1JlbW92ZUluYm91bmRUcmFja2VyEg8KB2NyZWF0b3IYASABKAkSEAoIY2hhaW5faWQYAiABKAMSDwoHdHhfaGFzaBgDIAEoCToMguewKgdjcmVhdG9yIiEKH01zZ1JlbW92ZUluYm91bmRUcmFja2VyUmVzcG9uc2Ui1QEKEU1zZ1doaXRlbGlzdEFzc2V0Eg8KB2NyZWF0b3IYASABKAkSFQoNYXNzZXRfYWRkcmVzcxgCIAEoCRIQCghjaGFpbl9pZBgDIAEoAxIMCgRuYW1lGAQgASgJEg4KBnN5bWJvbBgFIAEoCRIQCghkZWNpbWFscxgGIAEoDRIRCglnYXNfbGltaXQYByABKAMSNQoNbGlxdWlkaXR5X2NhcBgIIAEoCUIeyN4fANreHxZjb3Ntb3NzZGsuaW8vbWF0aC5VaW50OgyC57AqB2NyZWF0b3IiRgoZTXNnV2hpdGVsaXN0QXNzZXRSZXNwb25zZRIVCg16cmMyMF9hZGRyZXNzGAEgASgJEhIKCmNjdHhfaW5kZXgYAiABKAkizwEKFU1zZ0FkZE91dGJvdW5kVHJhY2tlchIPCgdjcmVhdG9yGAEgASgJEhAKCGNoYWluX2lkGAIgASgDEg0KBW5vbmNlGAMgASgEEg8KB3R4X2hhc2gYBCABKAkSNwoFcHJvb2YYBSABKAsyJC56ZXRhY2hhaW4uemV0YWNvcmUucGtnLnByb29mcy5Qcm9vZkICGAESFgoKYmxvY2tfaGFzaBgGIAEoCUICGAESFAoIdHhfaW5kZXgYByABKANCAhgBOgyC57AqB2NyZWF0b3IiMwodTXNnQWRkT3V0Ym91bmRUcmFja2VyUmVzcG9uc2USEgoKaXNfcmVtb3ZlZBgBIAEoCCJaChhNc2dSZW1vdmVPdXRib3VuZFRyYWNrZXISDwoHY3JlYXRvchgBIAEoCRIQCghjaGFpbl9pZBgCIAEoAxINCgVub25jZRgDIAEoBDoMguewKgdjcmVhdG9yIiIKIE1zZ1JlbW92ZU91dGJvdW5kVHJhY2tlclJlc3BvbnNlIpEBCg9Nc2dWb3RlR2FzUHJpY2USDwoHY3JlYXRvchgBIAEoCRIQCghjaGFpbl9pZBgCIAEoAxINCgVwcmljZRgDIAEoBBIUCgxwcmlvcml0eV9mZWUYBiABKAQSFAoMYmxvY2tfbnVtYmVyGAQgASgEEhIKBnN1cHBseRgFIAEoCUICGAE6DILnsCoHY3JlYXRvciIZChdNc2dWb3RlR2FzUHJpY2VSZXNwb25zZSL1BAoPTXNnVm90ZU91dGJvdW5kEg8KB2NyZWF0b3IYASABKAkSEQoJY2N0eF9oYXNoGAIgASgJEh4KFm9ic2VydmVkX291dGJvdW5kX2hhc2gYAyABKAkSJgoeb2JzZXJ2ZWRfb3V0Ym91bmRfYmxvY2tfaGVpZ2h0GAQgASgEEiIKGm9ic2VydmVkX291dGJvdW5kX2dhc191c2VkGAogASgEEkwKJW9ic2VydmVkX291dGJvdW5kX2VmZmVjdGl2ZV9nYXNfcHJpY2UYCyABKAlCHcjeHwDa3h8VY29zbW9zc2RrLmlvL21hdGguSW50Ei0KJW9ic2VydmVkX291dGJvdW5kX2VmZmVjdGl2ZV9nYXNfbGltaXQYDCABKAQSTwoOdmFsdWVfcmVjZWl2ZWQYBSABKAlCN8jeHwDa3h8WY29zbW9zc2RrLmlvL21hdGguVWludPLeHxV5YW1sOiJ2YWx1ZV9yZWNlaXZlZCISPAoGc3RhdHVzGAYgASgOMiwuemV0YWNoYWluLnpldGFjb3JlLnBrZy5jaGFpbnMuUmVjZWl2ZVN0YXR1cxIWCg5vdXRib3VuZF9jaGFpbhgHIAEoAxIaChJvdXRib3VuZF90c3Nfbm9uY2UYCCABKAQSOAoJY29pbl90eXBlGAkgASgOMiUuemV0YWNoYWluLnpldGFjb3JlLnBrZy5jb2luLkNvaW5UeXBlEkoKEWNvbmZpcm1hdGlvbl9tb2RlGA0gASgOMi8uemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uQ29uZmlybWF0aW9uTW9kZToMguewKgdjcmVhdG9yIhkKF01zZ1ZvdGVPdXRib3VuZFJlc3BvbnNlIqIGCg5Nc2dWb3RlSW5ib3VuZBIPCgdjcmVhdG9yGAEgASgJEg4KBnNlbmRlchgCIAEoCRIXCg9zZW5kZXJfY2hhaW5faWQYAyABKAMSEAoIcmVjZWl2ZXIYBCABKAkSFgoOcmVjZWl2ZXJfY2hhaW4YBSABKAMSLgoGYW1vdW50GAYgASgJQh7I3h8A2t4fFmNvc21vc3Nkay5pby9tYXRoLlVpbnQSDwoHbWVzc2FnZRgIIAEoCRIUCgxpbmJvdW5kX2hhc2gYCSABKAkSHAoUaW5ib3VuZF9ibG9ja19oZWlnaHQYCiABKAQSEQoJZ2FzX2xpbWl0GAsgASgEEjgKCWNvaW5fdHlwZRgMIAEoDjIlLnpldGFjaGFpbi56ZXRhY29yZS5wa2cuY29pbi5Db2luVHlwZRIRCgl0eF9vcmlnaW4YDSABKAkSDQoFYXNzZXQYDiABKAkSEwoLZXZlbnRfaW5kZXgYDyABKAQSWQoZcHJvdG9jb2xfY29udHJhY3RfdmVyc2lvbhgQIAEoDjI2LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlByb3RvY29sQ29udHJhY3RWZXJzaW9uEkoKDnJldmVydF9vcHRpb25zGBEgASgLMiwuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUmV2ZXJ0T3B0aW9uc0IEyN4fABJACgxjYWxsX29wdGlvbnMYEiABKAsyKi56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5DYWxsT3B0aW9ucxIbChNpc19jcm9zc19jaGFpbl9jYWxsGBMgASgIEjwKBnN0YXR1cxgUIAEoDjIsLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLkluYm91bmRTdGF0dXMSSgoRY29uZmlybWF0aW9uX21vZGUYFSABKA4yLy56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5Db25maXJtYXRpb25Nb2RlEhUKDWVycm9yX21lc3NhZ2UYFiABKAk6DILnsCoHY3JlYXRvciIYChZNc2dWb3RlSW5ib3VuZFJlc3BvbnNlIkYKEU1zZ0Fib3J0U3R1Y2tDQ1RYEg8KB2NyZWF0b3IYASABKAkSEgoKY2N0eF9pbmRleBgCIAEoCToMguewKgdjcmVhdG9yIhsKGU1zZ0Fib3J0U3R1Y2tDQ1RYUmVzcG9uc2UiYQoUTXNnUmVmdW5kQWJvcnRlZENDVFgSDwoHY3JlYXRvchgBIAEoCRISCgpjY3R4X2luZGV4GAIgASgJEhYKDnJlZnVuZF9hZGRyZXNzGAMgASgJOgyC57AqB2NyZWF0b3IiHgocTXNnUmVmdW5kQWJvcnRlZENDVFhSZXNwb25zZSKNAQoZTXNnVXBkYXRlUmF0ZUxpbWl0ZXJGbGFncxIPCgdjcmVhdG9yGAEgASgJElEKEnJhdGVfbGltaXRlcl9mbGFncxgCIAEoCzIvLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlJhdGVMaW1pdGVyRmxhZ3NCBMjeHwA6DILnsCoHY3JlYXRvciIjCiFNc2dVcGRhdGVSYXRlTGltaXRlckZsYWdzUmVzcG9uc2Uyyg0KA01zZxKIAQoSQWRkT3V0Ym91bmRUcmFja2VyEjQuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uTXNnQWRkT3V0Ym91bmRUcmFja2VyGjwuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uTXNnQWRkT3V0Ym91bmRUcmFja2VyUmVzcG9uc2UShQEKEUFkZEluYm91bmRUcmFja2VyEjMuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uTXNnQWRkSW5ib3VuZFRyYWNrZXIaOy56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5Nc2dBZGRJbmJvdW5kVHJhY2tlclJlc3BvbnNlEo4BChRSZW1vdmVJbmJvdW5kVHJhY2tlchI2LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLk1zZ1JlbW92ZUluYm91bmRUcmFja2VyGj4uemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uTXNnUmVtb3ZlSW5ib3VuZFRyYWNrZXJSZXNwb25zZRKRAQoVUmVtb3ZlT3V0Ym91bmRUcmFja2VyEjcuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uTXNnUmVtb3ZlT3V0Ym91bmRUcmFja2VyGj8uemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uTXNnUmVtb3ZlT3V0Ym91bmRUcmFja2VyUmVzcG9uc2USdgoMVm90ZUdhc1ByaWNlEi4uemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uTXNnVm90ZUdhc1ByaWNlGjYuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uTXNnVm90ZUdhc1ByaWNlUmVzcG9uc2USdgoMVm90ZU91dGJvdW5kEi4uemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uTXNnVm90ZU91dGJvdW5kGjYuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uTXNnVm90ZU91dGJvdW5kUmVzcG9uc2UScwoLVm90ZUluYm91bmQSLS56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5Nc2dWb3RlSW5ib3VuZBo1LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLk1zZ1ZvdGVJbmJvdW5kUmVzcG9uc2USfAoOV2hpdGVsaXN0QXNzZXQSMC56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5Nc2dXaGl0ZWxpc3RBc3NldBo4LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLk1zZ1doaXRlbGlzdEFzc2V0UmVzcG9uc2USggEKEFVwZGF0ZVRzc0FkZHJlc3MSMi56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5Nc2dVcGRhdGVUc3NBZGRyZXNzGjouemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uTXNnVXBkYXRlVHNzQWRkcmVzc1Jlc3BvbnNlEn8KD01pZ3JhdGVUc3NGdW5kcxIxLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLk1zZ01pZ3JhdGVUc3NGdW5kcxo5LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLk1zZ01pZ3JhdGVUc3NGdW5kc1Jlc3BvbnNlEnwKDkFib3J0U3R1Y2tDQ1RYEjAuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uTXNnQWJvcnRTdHVja0NDVFgaOC56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5Nc2dBYm9ydFN0dWNrQ0NUWFJlc3BvbnNlEoUBChFSZWZ1bmRBYm9ydGVkQ0NUWBIzLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLk1zZ1JlZnVuZEFib3J0ZWRDQ1RYGjsuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uTXNnUmVmdW5kQWJvcnRlZENDVFhSZXNwb25zZRKUAQoWVXBkYXRlUmF0ZUxpbWl0ZXJGbGFncxI4LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLk1zZ1VwZGF0ZVJhdGVMaW1pdGVyRmxhZ3MaQC56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5Nc2dVcGRhdGVSYXRlTGltaXRlckZsYWdzUmVzcG9uc2UaBYDnsCoBQvEBCiFjb20uemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW5CB1R4UHJvdG9QAVotZ2l0aHViLmNvbS96ZXRhLWNoYWluL25vZGUveC9jcm9zc2NoYWluL3R5cGVzogIDWlpDqgIdWmV0YWNoYWluLlpldGFjb3JlLkNyb3NzY2hhaW7KAh1aZXRhY2hhaW5cWmV0YWNvcmVcQ3Jvc3NjaGFpbuICKVpldGFjaGFpblxaZXRhY29yZVxDcm9zc2NoYWluXEdQQk1ldGFkYXRh6gIfWmV0YWNoYWluOjpaZXRhY29yZTo6Q3Jvc3NjaGFpbmIGcHJvdG8z", [file_gogoproto_gogo, file_zetachain_zetacore_pkg_chains_chains, file_zetachain_zetacore_pkg_coin_coin, file_zetachain_zetacore_pkg_proofs_proofs, file_zetachain_zetacore_crosschain_rate_limiter_flags, file_zetachain_zetacore_crosschain_cross_chain_tx, file_cosmos_msg_v1_msg]);
+  fileDesc("CiZ6ZXRhY2hhaW4vemV0YWNvcmUvY3Jvc3NjaGFpbi90eC5wcm90bxIdemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4idQoSTXNnTWlncmF0ZVRzc0Z1bmRzEg8KB2NyZWF0b3IYASABKAkSEAoIY2hhaW5faWQYAiABKAMSLgoGYW1vdW50GAMgASgJQh7I3h8A2t4fFmNvc21vc3Nkay5pby9tYXRoLlVpbnQ6DILnsCoHY3JlYXRvciIcChpNc2dNaWdyYXRlVHNzRnVuZHNSZXNwb25zZSJIChNNc2dVcGRhdGVUc3NBZGRyZXNzEg8KB2NyZWF0b3IYASABKAkSEgoKdHNzX3B1YmtleRgCIAEoCToMguewKgdjcmVhdG9yIh0KG01zZ1VwZGF0ZVRzc0FkZHJlc3NSZXNwb25zZSL5AQoUTXNnQWRkSW5ib3VuZFRyYWNrZXISDwoHY3JlYXRvchgBIAEoCRIQCghjaGFpbl9pZBgCIAEoAxIPCgd0eF9oYXNoGAMgASgJEjgKCWNvaW5fdHlwZRgEIAEoDjIlLnpldGFjaGFpbi56ZXRhY29yZS5wa2cuY29pbi5Db2luVHlwZRI3CgVwcm9vZhgFIAEoCzIkLnpldGFjaGFpbi56ZXRhY29yZS5wa2cucHJvb2ZzLlByb29mQgIYARIWCgpibG9ja19oYXNoGAYgASgJQgIYARIUCgh0eF9pbmRleBgHIAEoA0ICGAE6DILnsCoHY3JlYXRvciIeChxNc2dBZGRJbmJvdW5kVHJhY2tlclJlc3BvbnNlIlsKF01zZ1JlbW92ZUluYm91bmRUcmFja2VyEg8KB2NyZWF0b3IYASABKAkSEAoIY2hhaW5faWQYAiABKAMSDwoHdHhfaGFzaBgDIAEoCToMguewKgdjcmVhdG9yIiEKH01zZ1JlbW92ZUluYm91bmRUcmFja2VyUmVzcG9uc2Ui1QEKEU1zZ1doaXRlbGlzdEFzc2V0Eg8KB2NyZWF0b3IYASABKAkSFQoNYXNzZXRfYWRkcmVzcxgCIAEoCRIQCghjaGFpbl9pZBgDIAEoAxIMCgRuYW1lGAQgASgJEg4KBnN5bWJvbBgFIAEoCRIQCghkZWNpbWFscxgGIAEoDRIRCglnYXNfbGltaXQYByABKAMSNQoNbGlxdWlkaXR5X2NhcBgIIAEoCUIeyN4fANreHxZjb3Ntb3NzZGsuaW8vbWF0aC5VaW50OgyC57AqB2NyZWF0b3IiRgoZTXNnV2hpdGVsaXN0QXNzZXRSZXNwb25zZRIVCg16cmMyMF9hZGRyZXNzGAEgASgJEhIKCmNjdHhfaW5kZXgYAiABKAkizwEKFU1zZ0FkZE91dGJvdW5kVHJhY2tlchIPCgdjcmVhdG9yGAEgASgJEhAKCGNoYWluX2lkGAIgASgDEg0KBW5vbmNlGAMgASgEEg8KB3R4X2hhc2gYBCABKAkSNwoFcHJvb2YYBSABKAsyJC56ZXRhY2hhaW4uemV0YWNvcmUucGtnLnByb29mcy5Qcm9vZkICGAESFgoKYmxvY2tfaGFzaBgGIAEoCUICGAESFAoIdHhfaW5kZXgYByABKANCAhgBOgyC57AqB2NyZWF0b3IiMwodTXNnQWRkT3V0Ym91bmRUcmFja2VyUmVzcG9uc2USEgoKaXNfcmVtb3ZlZBgBIAEoCCJaChhNc2dSZW1vdmVPdXRib3VuZFRyYWNrZXISDwoHY3JlYXRvchgBIAEoCRIQCghjaGFpbl9pZBgCIAEoAxINCgVub25jZRgDIAEoBDoMguewKgdjcmVhdG9yIiIKIE1zZ1JlbW92ZU91dGJvdW5kVHJhY2tlclJlc3BvbnNlIpEBCg9Nc2dWb3RlR2FzUHJpY2USDwoHY3JlYXRvchgBIAEoCRIQCghjaGFpbl9pZBgCIAEoAxINCgVwcmljZRgDIAEoBBIUCgxwcmlvcml0eV9mZWUYBiABKAQSFAoMYmxvY2tfbnVtYmVyGAQgASgEEhIKBnN1cHBseRgFIAEoCUICGAE6DILnsCoHY3JlYXRvciIZChdNc2dWb3RlR2FzUHJpY2VSZXNwb25zZSL1BAoPTXNnVm90ZU91dGJvdW5kEg8KB2NyZWF0b3IYASABKAkSEQoJY2N0eF9oYXNoGAIgASgJEh4KFm9ic2VydmVkX291dGJvdW5kX2hhc2gYAyABKAkSJgoeb2JzZXJ2ZWRfb3V0Ym91bmRfYmxvY2tfaGVpZ2h0GAQgASgEEiIKGm9ic2VydmVkX291dGJvdW5kX2dhc191c2VkGAogASgEEkwKJW9ic2VydmVkX291dGJvdW5kX2VmZmVjdGl2ZV9nYXNfcHJpY2UYCyABKAlCHcjeHwDa3h8VY29zbW9zc2RrLmlvL21hdGguSW50Ei0KJW9ic2VydmVkX291dGJvdW5kX2VmZmVjdGl2ZV9nYXNfbGltaXQYDCABKAQSTwoOdmFsdWVfcmVjZWl2ZWQYBSABKAlCN8jeHwDa3h8WY29zbW9zc2RrLmlvL21hdGguVWludPLeHxV5YW1sOiJ2YWx1ZV9yZWNlaXZlZCISPAoGc3RhdHVzGAYgASgOMiwuemV0YWNoYWluLnpldGFjb3JlLnBrZy5jaGFpbnMuUmVjZWl2ZVN0YXR1cxIWCg5vdXRib3VuZF9jaGFpbhgHIAEoAxIaChJvdXRib3VuZF90c3Nfbm9uY2UYCCABKAQSOAoJY29pbl90eXBlGAkgASgOMiUuemV0YWNoYWluLnpldGFjb3JlLnBrZy5jb2luLkNvaW5UeXBlEkoKEWNvbmZpcm1hdGlvbl9tb2RlGA0gASgOMi8uemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uQ29uZmlybWF0aW9uTW9kZToMguewKgdjcmVhdG9yIhkKF01zZ1ZvdGVPdXRib3VuZFJlc3BvbnNlIoEHCg5Nc2dWb3RlSW5ib3VuZBIPCgdjcmVhdG9yGAEgASgJEg4KBnNlbmRlchgCIAEoCRIXCg9zZW5kZXJfY2hhaW5faWQYAyABKAMSEAoIcmVjZWl2ZXIYBCABKAkSFgoOcmVjZWl2ZXJfY2hhaW4YBSABKAMSLgoGYW1vdW50GAYgASgJQh7I3h8A2t4fFmNvc21vc3Nkay5pby9tYXRoLlVpbnQSDwoHbWVzc2FnZRgIIAEoCRIUCgxpbmJvdW5kX2hhc2gYCSABKAkSHAoUaW5ib3VuZF9ibG9ja19oZWlnaHQYCiABKAQSEQoJZ2FzX2xpbWl0GAsgASgEEjgKCWNvaW5fdHlwZRgMIAEoDjIlLnpldGFjaGFpbi56ZXRhY29yZS5wa2cuY29pbi5Db2luVHlwZRIRCgl0eF9vcmlnaW4YDSABKAkSDQoFYXNzZXQYDiABKAkSEwoLZXZlbnRfaW5kZXgYDyABKAQSWQoZcHJvdG9jb2xfY29udHJhY3RfdmVyc2lvbhgQIAEoDjI2LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLlByb3RvY29sQ29udHJhY3RWZXJzaW9uEkoKDnJldmVydF9vcHRpb25zGBEgASgLMiwuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUmV2ZXJ0T3B0aW9uc0IEyN4fABJACgxjYWxsX29wdGlvbnMYEiABKAsyKi56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5DYWxsT3B0aW9ucxIbChNpc19jcm9zc19jaGFpbl9jYWxsGBMgASgIEjwKBnN0YXR1cxgUIAEoDjIsLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLkluYm91bmRTdGF0dXMSSgoRY29uZmlybWF0aW9uX21vZGUYFSABKA4yLy56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5Db25maXJtYXRpb25Nb2RlEhUKDWVycm9yX21lc3NhZ2UYFiABKAkSEAoIZGVhZGxpbmUYFyABKAQSSwoPcmVjZWl2ZXJfc3BsaXRzGBggAygLMiwuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uUmVjZWl2ZXJTcGxpdEIEyN4fADoMguewKgdjcmVhdG9yIlEKDVJlY2VpdmVyU3BsaXQSEAoIcmVjZWl2ZXIYASABKAkSLgoGYW1vdW50GAIgASgJQh7I3h8A2t4fFmNvc21vc3Nkay5pby9tYXRoLlVpbnQiGAoWTXNnVm90ZUluYm91bmRSZXNwb25zZSJGChFNc2dBYm9ydFN0dWNrQ0NUWBIPCgdjcmVhdG9yGAEgASgJEhIKCmNjdHhfaW5kZXgYAiABKAk6DILnsCoHY3JlYXRvciIbChlNc2dBYm9ydFN0dWNrQ0NUWFJlc3BvbnNlImEKFE1zZ1JlZnVuZEFib3J0ZWRDQ1RYEg8KB2NyZWF0b3IYASABKAkSEgoKY2N0eF9pbmRleBgCIAEoCRIWCg5yZWZ1bmRfYWRkcmVzcxgDIAEoCToMguewKgdjcmVhdG9yIh4KHE1zZ1JlZnVuZEFib3J0ZWRDQ1RYUmVzcG9uc2UijQEKGU1zZ1VwZGF0ZVJhdGVMaW1pdGVyRmxhZ3MSDwoHY3JlYXRvchgBIAEoCRJRChJyYXRlX2xpbWl0ZXJfZmxhZ3MYAiABKAsyLy56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5SYXRlTGltaXRlckZsYWdzQgTI3h8AOgyC57AqB2NyZWF0b3IiIwohTXNnVXBkYXRlUmF0ZUxpbWl0ZXJGbGFnc1Jlc3BvbnNlMsoNCgNNc2cSiAEKEkFkZE91dGJvdW5kVHJhY2tlchI0LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLk1zZ0FkZE91dGJvdW5kVHJhY2tlcho8LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLk1zZ0FkZE91dGJvdW5kVHJhY2tlclJlc3BvbnNlEoUBChFBZGRJbmJvdW5kVHJhY2tlchIzLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLk1zZ0FkZEluYm91bmRUcmFja2VyGjsuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uTXNnQWRkSW5ib3VuZFRyYWNrZXJSZXNwb25zZRKOAQoUUmVtb3ZlSW5ib3VuZFRyYWNrZXISNi56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5Nc2dSZW1vdmVJbmJvdW5kVHJhY2tlcho+LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLk1zZ1JlbW92ZUluYm91bmRUcmFja2VyUmVzcG9uc2USkQEKFVJlbW92ZU91dGJvdW5kVHJhY2tlchI3LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLk1zZ1JlbW92ZU91dGJvdW5kVHJhY2tlcho/LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLk1zZ1JlbW92ZU91dGJvdW5kVHJhY2tlclJlc3BvbnNlEnYKDFZvdGVHYXNQcmljZRIuLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLk1zZ1ZvdGVHYXNQcmljZRo2LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLk1zZ1ZvdGVHYXNQcmljZVJlc3BvbnNlEnYKDFZvdGVPdXRib3VuZBIuLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLk1zZ1ZvdGVPdXRib3VuZBo2LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLk1zZ1ZvdGVPdXRib3VuZFJlc3BvbnNlEnMKC1ZvdGVJbmJvdW5kEi0uemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uTXNnVm90ZUluYm91bmQaNS56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5Nc2dWb3RlSW5ib3VuZFJlc3BvbnNlEnwKDldoaXRlbGlzdEFzc2V0EjAuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uTXNnV2hpdGVsaXN0QXNzZXQaOC56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5Nc2dXaGl0ZWxpc3RBc3NldFJlc3BvbnNlEoIBChBVcGRhdGVUc3NBZGRyZXNzEjIuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uTXNnVXBkYXRlVHNzQWRkcmVzcxo6LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLk1zZ1VwZGF0ZVRzc0FkZHJlc3NSZXNwb25zZRJ/Cg9NaWdyYXRlVHNzRnVuZHMSMS56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5Nc2dNaWdyYXRlVHNzRnVuZHMaOS56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5Nc2dNaWdyYXRlVHNzRnVuZHNSZXNwb25zZRJ8Cg5BYm9ydFN0dWNrQ0NUWBIwLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLk1zZ0Fib3J0U3R1Y2tDQ1RYGjguemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uTXNnQWJvcnRTdHVja0NDVFhSZXNwb25zZRKFAQoRUmVmdW5kQWJvcnRlZENDVFgSMy56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5Nc2dSZWZ1bmRBYm9ydGVkQ0NUWBo7LnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluLk1zZ1JlZnVuZEFib3J0ZWRDQ1RYUmVzcG9uc2USlAEKFlVwZGF0ZVJhdGVMaW1pdGVyRmxhZ3MSOC56ZXRhY2hhaW4uemV0YWNvcmUuY3Jvc3NjaGFpbi5Nc2dVcGRhdGVSYXRlTGltaXRlckZsYWdzGkAuemV0YWNoYWluLnpldGFjb3JlLmNyb3NzY2hhaW4uTXNnVXBkYXRlUmF0ZUxpbWl0ZXJGbGFnc1Jlc3BvbnNlGgWA57AqAULxAQohY29tLnpldGFjaGFpbi56ZXRhY29yZS5jcm9zc2NoYWluQgdUeFByb3RvUAFaLWdpdGh1Yi5jb20vemV0YS1jaGFpbi9ub2RlL3gvY3Jvc3NjaGFpbi90eXBlc6ICA1paQ6oCHVpldGFjaGFpbi5aZXRhY29yZS5Dcm9zc2NoYWluygIdWmV0YWNoYWluXFpldGFjb3JlXENyb3NzY2hhaW7iAilaZXRhY2hhaW5cWmV0YWNvcmVcQ3Jvc3NjaGFpblxHUEJNZXRhZGF0YeoCH1pldGFjaGFpbjo6WmV0YWNvcmU6OkNyb3NzY2hhaW5iBnByb3RvMw", [file_gogoproto_gogo, file_zetachain_zetacore_pkg_chains_chains, file_zetachain_zetacore_pkg_coin_coin, file_zetachain_zetacore_pkg_proofs_proofs, file_zetachain_zetacore_crosschain_rate_limiter_flags, file_zetachain_zetacore_crosschain_cross_chain_tx, file_cosmos_msg_v1_msg]);
 
 /**
  * @generated from message zetachain.zetacore.crosschain.MsgMigrateTssFunds
@@ -668,6 +668,23 @@ export type MsgVoteInbound = Message<"zetachain.zetacore.crosschain.MsgVoteInbou
    * @generated from field: string error_message = 22;
    */
   errorMessage: string;
+
+  /**
+   * deadline is the unix timestamp (seconds) after which the inbound must not
+   * be executed, the CCTX is reverted instead. zero means no deadline
+   *
+   * @generated from field: uint64 deadline = 23;
+   */
+  deadline: bigint;
+
+  /**
+   * receiver_splits are parts of the amount deposited to other receivers on
+   * ZetaChain, each through a child CCTX. The receiver gets the remaining
+   * amount
+   *
+   * @generated from field: repeated zetachain.zetacore.crosschain.ReceiverSplit receiver_splits = 24;
+   */
+  receiverSplits: ReceiverSplit[];
 };
 
 /**
@@ -677,6 +694,31 @@ export type MsgVoteInbound = Message<"zetachain.zetacore.crosschain.MsgVoteInbou
 export const MsgVoteInboundSchema: GenMessage<MsgVoteInbound> = /*@__PURE__*/
   messageDesc(file_zetachain_zetacore_crosschain_tx, 18);
 
+/**
+ * ReceiverSplit is a part of the amount of an inbound deposited to another
+ * receiver than the inbound receiver
+ *
+ * @generated from message zetachain.zetacore.crosschain.ReceiverSplit
+ */
+export type ReceiverSplit = Message<"zetachain.zetacore.crosschain.ReceiverSplit"> & {
+  /**
+   * @generated from field: string receiver = 1;
+   */
+  receiver: string;
+
+  /**
+   * @generated from field: string amount = 2;
+   */
+  amount: string;
+};
+
+/**
+ * Describes the message zetachain.zetacore.crosschain.ReceiverSplit.
+ * Use `create(ReceiverSplitSchema)` to create a new message.
+ */
+export const ReceiverSplitSchema: GenMessage<ReceiverSplit> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_crosschain_tx, 19);
+
 /**
  * @generated from message zetachain.zetacore.crosschain.MsgVoteInboundResponse
  */
@@ -688,7 +730,7 @@ export type MsgVoteInboundResponse = Message<"zetachain.zetacore.crosschain.MsgV
  * Use `create(MsgVoteInboundResponseSchema)` to create a new message.
  */
 export const MsgVoteInboundResponseSchema: GenMessage<MsgVoteInboundResponse> = /*@__PURE__*/
-  messageDesc(file_zetachain_zetacore_crosschain_tx, 19);
+  messageDesc(file_zetachain_zetacore_crosschain_tx, 20);
 
 /**
  * @generated from message zetachain.zetacore.crosschain.MsgAbortStuckCCTX
@@ -710,7 +752,7 @@ export type MsgAbortStuckCCTX = Message<"zetachain.zetacore.crosschain.MsgAbortS
  * Use `create(MsgAbortStuckCCTXSchema)` to create a new message.
  */
 export const MsgAbortStuckCCTXSchema: GenMessage<MsgAbortStuckCCTX> = /*@__PURE__*/
-  messageDesc(file_zetachain_zetacore_crosschain_tx, 20);
+  messageDesc(file_zetachain_zetacore_crosschain_tx, 21);
 
 /**
  * @generated from message zetachain.zetacore.crosschain.MsgAbortStuckCCTXResponse
@@ -723,7 +765,7 @@ export type MsgAbortStuckCCTXResponse = Message<"zetachain.zetacore.crosschain.M
  * Use `create(MsgAbortStuckCCTXResponseSchema)` to create a new message.
  */
 export const MsgAbortStuckCCTXResponseSchema: GenMessage<MsgAbortStuckCCTXResponse> = /*@__PURE__*/
-  messageDesc(file_zetachain_zetacore_crosschain_tx, 21);
+  messageDesc(file_zetachain_zetacore_crosschain_tx, 22);
 
 /**
  * @generated from message zetachain.zetacore.crosschain.MsgRefundAbortedCCTX
@@ -752,7 +794,7 @@ export type MsgRefundAbortedCCTX = Message<"zetachain.zetacore.crosschain.MsgRef
  * Use `create(MsgRefundAbortedCCTXSchema)` to create a new message.
  */
 export const MsgRefundAbortedCCTXSchema: GenMessage<MsgRefundAbortedCCTX> = /*@__PURE__*/
-  messageDesc(file_zetachain_zetacore_crosschain_tx, 22);
+  messageDesc(file_zetachain_zetacore_crosschain_tx, 23);
 
 /**
  * @generated from message zetachain.zetacore.crosschain.MsgRefundAbortedCCTXResponse
@@ -765,7 +807,7 @@ export type MsgRefundAbortedCCTXResponse = Message<"zetachain.zetacore.crosschai
  * Use `create(MsgRefundAbortedCCTXResponseSchema)` to create a new message.
  */
 export const MsgRefundAbortedCCTXResponseSchema: GenMessage<MsgRefundAbortedCCTXResponse> = /*@__PURE__*/
-  messageDesc(file_zetachain_zetacore_crosschain_tx, 23);
+  messageDesc(file_zetachain_zetacore_crosschain_tx, 24);
 
 /**
  * @generated from message zetachain.zetacore.crosschain.MsgUpdateRateLimiterFlags
@@ -787,7 +829,7 @@ export type MsgUpdateRateLimiterFlags = Message<"zetachain.zetacore.crosschain.M
  * Use `create(MsgUpdateRateLimiterFlagsSchema)` to create a new message.
  */
 export const MsgUpdateRateLimiterFlagsSchema: GenMessage<MsgUpdateRateLimiterFlags> = /*@__PURE__*/
-  messageDesc(file_zetachain_zetacore_crosschain_tx, 24);
+  messageDesc(file_zetachain_zetacore_crosschain_tx, 25);
 
 /**
  * @generated from message zetachain.zetacore.crosschain.MsgUpdateRateLimiterFlagsResponse
@@ -800,7 +842,7 @@ export type MsgUpdateRateLimiterFlagsResponse = Message<"zetachain.zetacore.cros
  * Use `create(MsgUpdateRateLimiterFlagsResponseSchema)` to create a new message.
  */
 export const MsgUpdateRateLimiterFlagsResponseSchema: GenMessage<MsgUpdateRateLimiterFlagsResponse> = /*@__PURE__*/
-  messageDesc(file_zetachain_zetacore_crosschain_tx, 25);
+  messageDesc(file_zetachain_zetacore_crosschain_tx, 26);
 
 /**
  * Msg defines the Msg service.
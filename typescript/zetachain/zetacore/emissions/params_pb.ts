@@ -11,7 +11,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file zetachain/zetacore/emissions/params.proto.
  */
 export const file_zetachain_zetacore_emissions_params: GenFile = /*@__PURE__*/
-  fileDesc("Cil6ZXRhY2hhaW4vemV0YWNvcmUvZW1pc3Npb25zL3BhcmFtcy5wcm90bxIcemV0YWNoYWluLnpldGFjb3JlLmVtaXNzaW9ucyLfAgoGUGFyYW1zEiUKHXZhbGlkYXRvcl9lbWlzc2lvbl9wZXJjZW50YWdlGAUgASgJEiQKHG9ic2VydmVyX2VtaXNzaW9uX3BlcmNlbnRhZ2UYBiABKAkSJgoedHNzX3NpZ25lcl9lbWlzc2lvbl9wZXJjZW50YWdlGAcgASgJEjwKFW9ic2VydmVyX3NsYXNoX2Ftb3VudBgJIAEoCUIdyN4fANreHxVjb3Ntb3NzZGsuaW8vbWF0aC5JbnQSHgoWYmFsbG90X21hdHVyaXR5X2Jsb2NrcxgKIAEoAxJAChNibG9ja19yZXdhcmRfYW1vdW50GAsgASgJQiPI3h8A2t4fG2Nvc21vc3Nkay5pby9tYXRoLkxlZ2FjeURlYxIuCiZwZW5kaW5nX2JhbGxvdHNfZGVsZXRpb25fYnVmZmVyX2Jsb2NrcxgMIAEoAzoEmKAfAEoECAEQBUoECAgQCSLuAgoMTGVnYWN5UGFyYW1zEhcKD21heF9ib25kX2ZhY3RvchgBIAEoCRIXCg9taW5fYm9uZF9mYWN0b3IYAiABKAkSFgoOYXZnX2Jsb2NrX3RpbWUYAyABKAkSGQoRdGFyZ2V0X2JvbmRfcmF0aW8YBCABKAkSJQoddmFsaWRhdG9yX2VtaXNzaW9uX3BlcmNlbnRhZ2UYBSABKAkSJAocb2JzZXJ2ZXJfZW1pc3Npb25fcGVyY2VudGFnZRgGIAEoCRImCh50c3Nfc2lnbmVyX2VtaXNzaW9uX3BlcmNlbnRhZ2UYByABKAkSIAoYZHVyYXRpb25fZmFjdG9yX2NvbnN0YW50GAggASgJEjwKFW9ic2VydmVyX3NsYXNoX2Ftb3VudBgJIAEoCUIdyN4fANreHxVjb3Ntb3NzZGsuaW8vbWF0aC5JbnQSHgoWYmFsbG90X21hdHVyaXR5X2Jsb2NrcxgKIAEoAzoEmKAfAELvAQogY29tLnpldGFjaGFpbi56ZXRhY29yZS5lbWlzc2lvbnNCC1BhcmFtc1Byb3RvUAFaLGdpdGh1Yi5jb20vemV0YS1jaGFpbi9ub2RlL3gvZW1pc3Npb25zL3R5cGVzogIDWlpFqgIcWmV0YWNoYWluLlpldGFjb3JlLkVtaXNzaW9uc8oCHFpldGFjaGFpblxaZXRhY29yZVxFbWlzc2lvbnPiAihaZXRhY2hhaW5cWmV0YWNvcmVcRW1pc3Npb25zXEdQQk1ldGFkYXRh6gIeWmV0YWNoYWluOjpaZXRhY29yZTo6RW1pc3Npb25zYgZwcm90bzM", [file_gogoproto_gogo]);
+  fileDesc("Cil6ZXRhY2hhaW4vemV0YWNvcmUvZW1pc3Npb25zL3BhcmFtcy5wcm90bxIcemV0YWNoYWluLnpldGFjb3JlLmVtaXNzaW9ucyKaAwoGUGFyYW1zEiUKHXZhbGlkYXRvcl9lbWlzc2lvbl9wZXJjZW50YWdlGAUgASgJEiQKHG9ic2VydmVyX2VtaXNzaW9uX3BlcmNlbnRhZ2UYBiABKAkSJgoedHNzX3NpZ25lcl9lbWlzc2lvbl9wZXJjZW50YWdlGAcgASgJEjwKFW9ic2VydmVyX3NsYXNoX2Ftb3VudBgJIAEoCUIdyN4fANreHxVjb3Ntb3NzZGsuaW8vbWF0aC5JbnQSHgoWYmFsbG90X21hdHVyaXR5X2Jsb2NrcxgKIAEoAxJAChNibG9ja19yZXdhcmRfYW1vdW50GAsgASgJQiPI3h8A2t4fG2Nvc21vc3Nkay5pby9tYXRoLkxlZ2FjeURlYxIuCiZwZW5kaW5nX2JhbGxvdHNfZGVsZXRpb25fYnVmZmVyX2Jsb2NrcxgMIAEoAxIdChVibGFtZV9zbGFzaF90aHJlc2hvbGQYDSABKAQSGgoSYmxhbWVfc2xhc2hfd2luZG93GA4gASgDOgSYoB8ASgQIARAFSgQICBAJIu4CCgxMZWdhY3lQYXJhbXMSFwoPbWF4X2JvbmRfZmFjdG9yGAEgASgJEhcKD21pbl9ib25kX2ZhY3RvchgCIAEoCRIWCg5hdmdfYmxvY2tfdGltZRgDIAEoCRIZChF0YXJnZXRfYm9uZF9yYXRpbxgEIAEoCRIlCh12YWxpZGF0b3JfZW1pc3Npb25fcGVyY2VudGFnZRgFIAEoCRIkChxvYnNlcnZlcl9lbWlzc2lvbl9wZXJjZW50YWdlGAYgASgJEiYKHnRzc19zaWduZXJfZW1pc3Npb25fcGVyY2VudGFnZRgHIAEoCRIgChhkdXJhdGlvbl9mYWN0b3JfY29uc3RhbnQYCCABKAkSPAoVb2JzZXJ2ZXJfc2xhc2hfYW1vdW50GAkgASgJQh3I3h8A2t4fFWNvc21vc3Nkay5pby9tYXRoLkludBIeChZiYWxsb3RfbWF0dXJpdHlfYmxvY2tzGAogASgDOgSYoB8AQu8BCiBjb20uemV0YWNoYWluLnpldGFjb3JlLmVtaXNzaW9uc0ILUGFyYW1zUHJvdG9QAVosZ2l0aHViLmNvbS96ZXRhLWNoYWluL25vZGUveC9lbWlzc2lvbnMvdHlwZXOiAgNaWkWqAhxaZXRhY2hhaW4uWmV0YWNvcmUuRW1pc3Npb25zygIcWmV0YWNoYWluXFpldGFjb3JlXEVtaXNzaW9uc+ICKFpldGFjaGFpblxaZXRhY29yZVxFbWlzc2lvbnNcR1BCTWV0YWRhdGHqAh5aZXRhY2hhaW46OlpldGFjb3JlOjpFbWlzc2lvbnNiBnByb3RvMw", [file_gogoproto_gogo]);
 
 /**
  * Params defines the parameters for the module.
@@ -61,6 +61,22 @@ export type Params = Message<"zetachain.zetacore.emissions.Params"> & {
    * @generated from field: int64 pending_ballots_deletion_buffer_blocks = 12;
    */
   pendingBallotsDeletionBufferBlocks: bigint;
+
+  /**
+   * Observers blamed at least this many times for failed TSS ceremonies
+   * within a blame slash window are slashed ObserverSlashAmount, 0 disables it
+   *
+   * @generated from field: uint64 blame_slash_threshold = 13;
+   */
+  blameSlashThreshold: bigint;
+
+  /**
+   * Number of blocks in a blame slash window, offenders are evaluated
+   * once at the end of each window
+   *
+   * @generated from field: int64 blame_slash_window = 14;
+   */
+  blameSlashWindow: bigint;
 };
 
 /**
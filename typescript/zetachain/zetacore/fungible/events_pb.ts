@@ -5,6 +5,8 @@
 import type { GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
 import { fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
 import { file_zetachain_zetacore_fungible_tx } from "./tx_pb";
+import type { ZRC20PauseDirection } from "./foreign_coins_pb";
+import { file_zetachain_zetacore_fungible_foreign_coins } from "./foreign_coins_pb";
 import { file_gogoproto_gogo } from "../../../gogoproto/gogo_pb";
 import type { CoinType } from "../pkg/coin/coin_pb";
 import { file_zetachain_zetacore_pkg_coin_coin } from "../pkg/coin/coin_pb";
@@ -14,7 +16,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file zetachain/zetacore/fungible/events.proto.
  */
 export const file_zetachain_zetacore_fungible_events: GenFile = /*@__PURE__*/
-  fileDesc("Cih6ZXRhY2hhaW4vemV0YWNvcmUvZnVuZ2libGUvZXZlbnRzLnByb3RvEht6ZXRhY2hhaW4uemV0YWNvcmUuZnVuZ2libGUifgoaRXZlbnRTeXN0ZW1Db250cmFjdFVwZGF0ZWQSFAoMbXNnX3R5cGVfdXJsGAEgASgJEhwKFG5ld19jb250cmFjdF9hZGRyZXNzGAIgASgJEhwKFG9sZF9jb250cmFjdF9hZGRyZXNzGAMgASgJEg4KBnNpZ25lchgEIAEoCSLaAQoSRXZlbnRaUkMyMERlcGxveWVkEhQKDG1zZ190eXBlX3VybBgBIAEoCRIQCghjaGFpbl9pZBgCIAEoAxIQCghjb250cmFjdBgDIAEoCRIMCgRuYW1lGAQgASgJEg4KBnN5bWJvbBgFIAEoCRIQCghkZWNpbWFscxgGIAEoAxI4Cgljb2luX3R5cGUYByABKA4yJS56ZXRhY2hhaW4uemV0YWNvcmUucGtnLmNvaW4uQ29pblR5cGUSDQoFZXJjMjAYCCABKAkSEQoJZ2FzX2xpbWl0GAkgASgDIokCChxFdmVudFpSQzIwV2l0aGRyYXdGZWVVcGRhdGVkEhQKDG1zZ190eXBlX3VybBgBIAEoCRIQCghjaGFpbl9pZBgCIAEoAxI4Cgljb2luX3R5cGUYAyABKA4yJS56ZXRhY2hhaW4uemV0YWNvcmUucGtnLmNvaW4uQ29pblR5cGUSFQoNenJjMjBfYWRkcmVzcxgEIAEoCRIYChBvbGRfd2l0aGRyYXdfZmVlGAUgASgJEhgKEG5ld193aXRoZHJhd19mZWUYBiABKAkSDgoGc2lnbmVyGAcgASgJEhUKDW9sZF9nYXNfbGltaXQYCCABKAkSFQoNbmV3X2dhc19saW1pdBgJIAEoCSJRChBFdmVudFpSQzIwUGF1c2VkEhQKDG1zZ190eXBlX3VybBgBIAEoCRIXCg96cmMyMF9hZGRyZXNzZXMYAiADKAkSDgoGc2lnbmVyGAMgASgJIlMKEkV2ZW50WlJDMjBVbnBhdXNlZBIUCgxtc2dfdHlwZV91cmwYASABKAkSFwoPenJjMjBfYWRkcmVzc2VzGAIgAygJEg4KBnNpZ25lchgDIAEoCSK7AQocRXZlbnRTeXN0ZW1Db250cmFjdHNEZXBsb3llZBIUCgxtc2dfdHlwZV91cmwYASABKAkSGgoSdW5pc3dhcF92Ml9mYWN0b3J5GAIgASgJEg0KBXd6ZXRhGAMgASgJEhkKEXVuaXN3YXBfdjJfcm91dGVyGAQgASgJEhYKDmNvbm5lY3Rvcl96ZXZtGAUgASgJEhcKD3N5c3RlbV9jb250cmFjdBgGIAEoCRIOCgZzaWduZXIYByABKAkijAEKFEV2ZW50Qnl0ZWNvZGVVcGRhdGVkEhQKDG1zZ190eXBlX3VybBgBIAEoCRIYChBjb250cmFjdF9hZGRyZXNzGAIgASgJEhkKEW5ld19ieXRlY29kZV9oYXNoGAMgASgJEhkKEW9sZF9ieXRlY29kZV9oYXNoGAQgASgJEg4KBnNpZ25lchgFIAEoCSJ/ChtFdmVudEdhdGV3YXlDb250cmFjdFVwZGF0ZWQSFAoMbXNnX3R5cGVfdXJsGAEgASgJEhwKFG5ld19jb250cmFjdF9hZGRyZXNzGAIgASgJEhwKFG9sZF9jb250cmFjdF9hZGRyZXNzGAMgASgJEg4KBnNpZ25lchgEIAEoCSJxChtFdmVudEdhdGV3YXlHYXNMaW1pdFVwZGF0ZWQSFAoMbXNnX3R5cGVfdXJsGAEgASgJEhUKDW5ld19nYXNfbGltaXQYAiABKAQSFQoNb2xkX2dhc19saW1pdBgDIAEoBBIOCgZzaWduZXIYBCABKAlC6QEKH2NvbS56ZXRhY2hhaW4uemV0YWNvcmUuZnVuZ2libGVCC0V2ZW50c1Byb3RvUAFaK2dpdGh1Yi5jb20vemV0YS1jaGFpbi9ub2RlL3gvZnVuZ2libGUvdHlwZXOiAgNaWkaqAhtaZXRhY2hhaW4uWmV0YWNvcmUuRnVuZ2libGXKAhtaZXRhY2hhaW5cWmV0YWNvcmVcRnVuZ2libGXiAidaZXRhY2hhaW5cWmV0YWNvcmVcRnVuZ2libGVcR1BCTWV0YWRhdGHqAh1aZXRhY2hhaW46OlpldGFjb3JlOjpGdW5naWJsZWIGcHJvdG8z", [file_zetachain_zetacore_fungible_tx, file_gogoproto_gogo, file_zetachain_zetacore_pkg_coin_coin]);
+  fileDesc("Cih6ZXRhY2hhaW4vemV0YWNvcmUvZnVuZ2libGUvZXZlbnRzLnByb3RvEht6ZXRhY2hhaW4uemV0YWNvcmUuZnVuZ2libGUifgoaRXZlbnRTeXN0ZW1Db250cmFjdFVwZGF0ZWQSFAoMbXNnX3R5cGVfdXJsGAEgASgJEhwKFG5ld19jb250cmFjdF9hZGRyZXNzGAIgASgJEhwKFG9sZF9jb250cmFjdF9hZGRyZXNzGAMgASgJEg4KBnNpZ25lchgEIAEoCSLaAQoSRXZlbnRaUkMyMERlcGxveWVkEhQKDG1zZ190eXBlX3VybBgBIAEoCRIQCghjaGFpbl9pZBgCIAEoAxIQCghjb250cmFjdBgDIAEoCRIMCgRuYW1lGAQgASgJEg4KBnN5bWJvbBgFIAEoCRIQCghkZWNpbWFscxgGIAEoAxI4Cgljb2luX3R5cGUYByABKA4yJS56ZXRhY2hhaW4uemV0YWNvcmUucGtnLmNvaW4uQ29pblR5cGUSDQoFZXJjMjAYCCABKAkSEQoJZ2FzX2xpbWl0GAkgASgDIokCChxFdmVudFpSQzIwV2l0aGRyYXdGZWVVcGRhdGVkEhQKDG1zZ190eXBlX3VybBgBIAEoCRIQCghjaGFpbl9pZBgCIAEoAxI4Cgljb2luX3R5cGUYAyABKA4yJS56ZXRhY2hhaW4uemV0YWNvcmUucGtnLmNvaW4uQ29pblR5cGUSFQoNenJjMjBfYWRkcmVzcxgEIAEoCRIYChBvbGRfd2l0aGRyYXdfZmVlGAUgASgJEhgKEG5ld193aXRoZHJhd19mZWUYBiABKAkSDgoGc2lnbmVyGAcgASgJEhUKDW9sZF9nYXNfbGltaXQYCCABKAkSFQoNbmV3X2dhc19saW1pdBgJIAEoCSKWAQoQRXZlbnRaUkMyMFBhdXNlZBIUCgxtc2dfdHlwZV91cmwYASABKAkSFwoPenJjMjBfYWRkcmVzc2VzGAIgAygJEg4KBnNpZ25lchgDIAEoCRJDCglkaXJlY3Rpb24YBCABKA4yMC56ZXRhY2hhaW4uemV0YWNvcmUuZnVuZ2libGUuWlJDMjBQYXVzZURpcmVjdGlvbiKYAQoSRXZlbnRaUkMyMFVucGF1c2VkEhQKDG1zZ190eXBlX3VybBgBIAEoCRIXCg96cmMyMF9hZGRyZXNzZXMYAiADKAkSDgoGc2lnbmVyGAMgASgJEkMKCWRpcmVjdGlvbhgEIAEoDjIwLnpldGFjaGFpbi56ZXRhY29yZS5mdW5naWJsZS5aUkMyMFBhdXNlRGlyZWN0aW9uIrsBChxFdmVudFN5c3RlbUNvbnRyYWN0c0RlcGxveWVkEhQKDG1zZ190eXBlX3VybBgBIAEoCRIaChJ1bmlzd2FwX3YyX2ZhY3RvcnkYAiABKAkSDQoFd3pldGEYAyABKAkSGQoRdW5pc3dhcF92Ml9yb3V0ZXIYBCABKAkSFgoOY29ubmVjdG9yX3pldm0YBSABKAkSFwoPc3lzdGVtX2NvbnRyYWN0GAYgASgJEg4KBnNpZ25lchgHIAEoCSKMAQoURXZlbnRCeXRlY29kZVVwZGF0ZWQSFAoMbXNnX3R5cGVfdXJsGAEgASgJEhgKEGNvbnRyYWN0X2FkZHJlc3MYAiABKAkSGQoRbmV3X2J5dGVjb2RlX2hhc2gYAyABKAkSGQoRb2xkX2J5dGVjb2RlX2hhc2gYBCABKAkSDgoGc2lnbmVyGAUgASgJIn8KG0V2ZW50R2F0ZXdheUNvbnRyYWN0VXBkYXRlZBIUCgxtc2dfdHlwZV91cmwYASABKAkSHAoUbmV3X2NvbnRyYWN0X2FkZHJlc3MYAiABKAkSHAoUb2xkX2NvbnRyYWN0X2FkZHJlc3MYAyABKAkSDgoGc2lnbmVyGAQgASgJInEKG0V2ZW50R2F0ZXdheUdhc0xpbWl0VXBkYXRlZBIUCgxtc2dfdHlwZV91cmwYASABKAkSFQoNbmV3X2dhc19saW1pdBgCIAEoBBIVCg1vbGRfZ2FzX2xpbWl0GAMgASgEEg4KBnNpZ25lchgEIAEoCULpAQofY29tLnpldGFjaGFpbi56ZXRhY29yZS5mdW5naWJsZUILRXZlbnRzUHJvdG9QAVorZ2l0aHViLmNvbS96ZXRhLWNoYWluL25vZGUveC9mdW5naWJsZS90eXBlc6ICA1paRqoCG1pldGFjaGFpbi5aZXRhY29yZS5GdW5naWJsZcoCG1pldGFjaGFpblxaZXRhY29yZVxGdW5naWJsZeICJ1pldGFjaGFpblxaZXRhY29yZVxGdW5naWJsZVxHUEJNZXRhZGF0YeoCHVpldGFjaGFpbjo6WmV0YWNvcmU6OkZ1bmdpYmxlYgZwcm90bzM", [file_zetachain_zetacore_fungible_tx, file_zetachain_zetacore_fungible_foreign_coins, file_gogoproto_gogo, file_zetachain_zetacore_pkg_coin_coin]);
 
 /**
  * @generated from message zetachain.zetacore.fungible.EventSystemContractUpdated
@@ -180,6 +182,11 @@ export type EventZRC20Paused = Message<"zetachain.zetacore.fungible.EventZRC20Pa
    * @generated from field: string signer = 3;
    */
   signer: string;
+
+  /**
+   * @generated from field: zetachain.zetacore.fungible.ZRC20PauseDirection direction = 4;
+   */
+  direction: ZRC20PauseDirection;
 };
 
 /**
@@ -207,6 +214,11 @@ export type EventZRC20Unpaused = Message<"zetachain.zetacore.fungible.EventZRC20
    * @generated from field: string signer = 3;
    */
   signer: string;
+
+  /**
+   * @generated from field: zetachain.zetacore.fungible.ZRC20PauseDirection direction = 4;
+   */
+  direction: ZRC20PauseDirection;
 };
 
 /**
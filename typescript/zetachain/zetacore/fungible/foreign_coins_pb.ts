@@ -2,8 +2,8 @@
 // @generated from file zetachain/zetacore/fungible/foreign_coins.proto (package zetachain.zetacore.fungible, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
 import { file_gogoproto_gogo } from "../../../gogoproto/gogo_pb";
 import type { CoinType } from "../pkg/coin/coin_pb";
 import { file_zetachain_zetacore_pkg_coin_coin } from "../pkg/coin/coin_pb";
@@ -13,7 +13,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file zetachain/zetacore/fungible/foreign_coins.proto.
  */
 export const file_zetachain_zetacore_fungible_foreign_coins: GenFile = /*@__PURE__*/
-  fileDesc("Ci96ZXRhY2hhaW4vemV0YWNvcmUvZnVuZ2libGUvZm9yZWlnbl9jb2lucy5wcm90bxIbemV0YWNoYWluLnpldGFjb3JlLmZ1bmdpYmxlIp8CCgxGb3JlaWduQ29pbnMSHgoWenJjMjBfY29udHJhY3RfYWRkcmVzcxgCIAEoCRINCgVhc3NldBgDIAEoCRIYChBmb3JlaWduX2NoYWluX2lkGAQgASgDEhAKCGRlY2ltYWxzGAUgASgNEgwKBG5hbWUYBiABKAkSDgoGc3ltYm9sGAcgASgJEjgKCWNvaW5fdHlwZRgIIAEoDjIlLnpldGFjaGFpbi56ZXRhY29yZS5wa2cuY29pbi5Db2luVHlwZRIVCglnYXNfbGltaXQYCSABKARCAhgBEg4KBnBhdXNlZBgKIAEoCBI1Cg1saXF1aWRpdHlfY2FwGAsgASgJQh7I3h8A2t4fFmNvc21vc3Nkay5pby9tYXRoLlVpbnRC7wEKH2NvbS56ZXRhY2hhaW4uemV0YWNvcmUuZnVuZ2libGVCEUZvcmVpZ25Db2luc1Byb3RvUAFaK2dpdGh1Yi5jb20vemV0YS1jaGFpbi9ub2RlL3gvZnVuZ2libGUvdHlwZXOiAgNaWkaqAhtaZXRhY2hhaW4uWmV0YWNvcmUuRnVuZ2libGXKAhtaZXRhY2hhaW5cWmV0YWNvcmVcRnVuZ2libGXiAidaZXRhY2hhaW5cWmV0YWNvcmVcRnVuZ2libGVcR1BCTWV0YWRhdGHqAh1aZXRhY2hhaW46OlpldGFjb3JlOjpGdW5naWJsZWIGcHJvdG8z", [file_gogoproto_gogo, file_zetachain_zetacore_pkg_coin_coin]);
+  fileDesc("Ci96ZXRhY2hhaW4vemV0YWNvcmUvZnVuZ2libGUvZm9yZWlnbl9jb2lucy5wcm90bxIbemV0YWNoYWluLnpldGFjb3JlLmZ1bmdpYmxlItQCCgxGb3JlaWduQ29pbnMSHgoWenJjMjBfY29udHJhY3RfYWRkcmVzcxgCIAEoCRINCgVhc3NldBgDIAEoCRIYChBmb3JlaWduX2NoYWluX2lkGAQgASgDEhAKCGRlY2ltYWxzGAUgASgNEgwKBG5hbWUYBiABKAkSDgoGc3ltYm9sGAcgASgJEjgKCWNvaW5fdHlwZRgIIAEoDjIlLnpldGFjaGFpbi56ZXRhY29yZS5wa2cuY29pbi5Db2luVHlwZRIVCglnYXNfbGltaXQYCSABKARCAhgBEg4KBnBhdXNlZBgKIAEoCBI1Cg1saXF1aWRpdHlfY2FwGAsgASgJQh7I3h8A2t4fFmNvc21vc3Nkay5pby9tYXRoLlVpbnQSFwoPZGVwb3NpdHNfcGF1c2VkGAwgASgIEhoKEndpdGhkcmF3YWxzX3BhdXNlZBgNIAEoCCpOChNaUkMyMFBhdXNlRGlyZWN0aW9uEhIKDkFMTF9ESVJFQ1RJT05TEAASDAoIREVQT1NJVFMQARIPCgtXSVRIRFJBV0FMUxACGgSopB4BQu8BCh9jb20uemV0YWNoYWluLnpldGFjb3JlLmZ1bmdpYmxlQhFGb3JlaWduQ29pbnNQcm90b1ABWitnaXRodWIuY29tL3pldGEtY2hhaW4vbm9kZS94L2Z1bmdpYmxlL3R5cGVzogIDWlpGqgIbWmV0YWNoYWluLlpldGFjb3JlLkZ1bmdpYmxlygIbWmV0YWNoYWluXFpldGFjb3JlXEZ1bmdpYmxl4gInWmV0YWNoYWluXFpldGFjb3JlXEZ1bmdpYmxlXEdQQk1ldGFkYXRh6gIdWmV0YWNoYWluOjpaZXRhY29yZTo6RnVuZ2libGViBnByb3RvMw", [file_gogoproto_gogo, file_zetachain_zetacore_pkg_coin_coin]);
 
 /**
  * @generated from message zetachain.zetacore.fungible.ForeignCoins
@@ -75,6 +75,20 @@ export type ForeignCoins = Message<"zetachain.zetacore.fungible.ForeignCoins"> &
    * @generated from field: string liquidity_cap = 11;
    */
   liquidityCap: string;
+
+  /**
+   * deposits from the foreign chain are paused, the inbounds are reverted
+   *
+   * @generated from field: bool deposits_paused = 12;
+   */
+  depositsPaused: boolean;
+
+  /**
+   * withdrawals to the foreign chain are paused
+   *
+   * @generated from field: bool withdrawals_paused = 13;
+   */
+  withdrawalsPaused: boolean;
 };
 
 /**
@@ -84,3 +98,37 @@ export type ForeignCoins = Message<"zetachain.zetacore.fungible.ForeignCoins"> &
 export const ForeignCoinsSchema: GenMessage<ForeignCoins> = /*@__PURE__*/
   messageDesc(file_zetachain_zetacore_fungible_foreign_coins, 0);
 
+
+/**
+ * ZRC20PauseDirection represents the transfers paused or unpaused for a ZRC20
+ *
+ * @generated from enum zetachain.zetacore.fungible.ZRC20PauseDirection
+ */
+export enum ZRC20PauseDirection {
+  /**
+   * all the transfers, including the transfers on ZEVM
+   *
+   * @generated from enum value: ALL_DIRECTIONS = 0;
+   */
+  ALL_DIRECTIONS = 0,
+
+  /**
+   * deposits from the foreign chain only
+   *
+   * @generated from enum value: DEPOSITS = 1;
+   */
+  DEPOSITS = 1,
+
+  /**
+   * withdrawals to the foreign chain only
+   *
+   * @generated from enum value: WITHDRAWALS = 2;
+   */
+  WITHDRAWALS = 2,
+}
+
+/**
+ * Describes the enum zetachain.zetacore.fungible.ZRC20PauseDirection.
+ */
+export const ZRC20PauseDirectionSchema: GenEnum<ZRC20PauseDirection> = /*@__PURE__*/
+  enumDesc(file_zetachain_zetacore_fungible_foreign_coins, 0);
@@ -8,13 +8,15 @@ import { file_gogoproto_gogo } from "../../../gogoproto/gogo_pb";
 import type { CoinType } from "../pkg/coin/coin_pb";
 import { file_zetachain_zetacore_pkg_coin_coin } from "../pkg/coin/coin_pb";
 import { file_cosmos_msg_v1_msg } from "../../../cosmos/msg/v1/msg_pb";
+import type { ZRC20PauseDirection } from "./foreign_coins_pb";
+import { file_zetachain_zetacore_fungible_foreign_coins } from "./foreign_coins_pb";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file zetachain/zetacore/fungible/tx.proto.
  */
 export const file_zetachain_zetacore_fungible_tx: GenFile = /*@__PURE__*/
-  fileDesc("CiR6ZXRhY2hhaW4vemV0YWNvcmUvZnVuZ2libGUvdHgucHJvdG8SG3pldGFjaGFpbi56ZXRhY29yZS5mdW5naWJsZSJQChhNc2dVcGRhdGVHYXRld2F5R2FzTGltaXQSDwoHY3JlYXRvchgBIAEoCRIVCg1uZXdfZ2FzX2xpbWl0GAIgASgEOgyC57AqB2NyZWF0b3IiIgogTXNnVXBkYXRlR2F0ZXdheUdhc0xpbWl0UmVzcG9uc2UiOQoYTXNnRGVwbG95U3lzdGVtQ29udHJhY3RzEg8KB2NyZWF0b3IYASABKAk6DILnsCoHY3JlYXRvciKTAQogTXNnRGVwbG95U3lzdGVtQ29udHJhY3RzUmVzcG9uc2USGAoQdW5pc3dhcFYyRmFjdG9yeRgBIAEoCRINCgV3emV0YRgCIAEoCRIXCg91bmlzd2FwVjJSb3V0ZXIYAyABKAkSFQoNY29ubmVjdG9yWkVWTRgEIAEoCRIWCg5zeXN0ZW1Db250cmFjdBgFIAEoCSLCAQoZTXNnVXBkYXRlWlJDMjBXaXRoZHJhd0ZlZRIPCgdjcmVhdG9yGAEgASgJEhUKDXpyYzIwX2FkZHJlc3MYAiABKAkSOAoQbmV3X3dpdGhkcmF3X2ZlZRgGIAEoCUIeyN4fANreHxZjb3Ntb3NzZGsuaW8vbWF0aC5VaW50EjUKDW5ld19nYXNfbGltaXQYByABKAlCHsjeHwDa3h8WY29zbW9zc2RrLmlvL21hdGguVWludDoMguewKgdjcmVhdG9yIiMKIU1zZ1VwZGF0ZVpSQzIwV2l0aGRyYXdGZWVSZXNwb25zZSJdChdNc2dVcGRhdGVTeXN0ZW1Db250cmFjdBIPCgdjcmVhdG9yGAEgASgJEiMKG25ld19zeXN0ZW1fY29udHJhY3RfYWRkcmVzcxgCIAEoCToMguewKgdjcmVhdG9yIiEKH01zZ1VwZGF0ZVN5c3RlbUNvbnRyYWN0UmVzcG9uc2UimAIKGk1zZ0RlcGxveUZ1bmdpYmxlQ29pblpSQzIwEg8KB2NyZWF0b3IYASABKAkSDQoFRVJDMjAYAiABKAkSGAoQZm9yZWlnbl9jaGFpbl9pZBgDIAEoAxIQCghkZWNpbWFscxgEIAEoDRIMCgRuYW1lGAUgASgJEg4KBnN5bWJvbBgGIAEoCRI4Cgljb2luX3R5cGUYByABKA4yJS56ZXRhY2hhaW4uemV0YWNvcmUucGtnLmNvaW4uQ29pblR5cGUSEQoJZ2FzX2xpbWl0GAggASgDEjUKDWxpcXVpZGl0eV9jYXAYCSABKAlCHsjeHwHa3h8WY29zbW9zc2RrLmlvL21hdGguVWludDoMguewKgdjcmVhdG9yIjUKIk1zZ0RlcGxveUZ1bmdpYmxlQ29pblpSQzIwUmVzcG9uc2USDwoHYWRkcmVzcxgBIAEoCSJMChRNc2dSZW1vdmVGb3JlaWduQ29pbhIPCgdjcmVhdG9yGAEgASgJEhUKDXpyYzIwX2FkZHJlc3MYAiABKAk6DILnsCoHY3JlYXRvciIeChxNc2dSZW1vdmVGb3JlaWduQ29pblJlc3BvbnNlImsKGU1zZ1VwZGF0ZUNvbnRyYWN0Qnl0ZWNvZGUSDwoHY3JlYXRvchgBIAEoCRIYChBjb250cmFjdF9hZGRyZXNzGAIgASgJEhUKDW5ld19jb2RlX2hhc2gYAyABKAk6DILnsCoHY3JlYXRvciIjCiFNc2dVcGRhdGVDb250cmFjdEJ5dGVjb2RlUmVzcG9uc2UiiQEKGk1zZ1VwZGF0ZVpSQzIwTGlxdWlkaXR5Q2FwEg8KB2NyZWF0b3IYASABKAkSFQoNenJjMjBfYWRkcmVzcxgCIAEoCRI1Cg1saXF1aWRpdHlfY2FwGAMgASgJQh7I3h8A2t4fFmNvc21vc3Nkay5pby9tYXRoLlVpbnQ6DILnsCoHY3JlYXRvciIkCiJNc2dVcGRhdGVaUkMyMExpcXVpZGl0eUNhcFJlc3BvbnNlIkcKDU1zZ1BhdXNlWlJDMjASDwoHY3JlYXRvchgBIAEoCRIXCg96cmMyMF9hZGRyZXNzZXMYAiADKAk6DILnsCoHY3JlYXRvciIXChVNc2dQYXVzZVpSQzIwUmVzcG9uc2UiSQoPTXNnVW5wYXVzZVpSQzIwEg8KB2NyZWF0b3IYASABKAkSFwoPenJjMjBfYWRkcmVzc2VzGAIgAygJOgyC57AqB2NyZWF0b3IiGQoXTXNnVW5wYXVzZVpSQzIwUmVzcG9uc2UiXwoYTXNnVXBkYXRlR2F0ZXdheUNvbnRyYWN0Eg8KB2NyZWF0b3IYASABKAkSJAocbmV3X2dhdGV3YXlfY29udHJhY3RfYWRkcmVzcxgCIAEoCToMguewKgdjcmVhdG9yIiIKIE1zZ1VwZGF0ZUdhdGV3YXlDb250cmFjdFJlc3BvbnNlImgKEk1zZ1VwZGF0ZVpSQzIwTmFtZRIPCgdjcmVhdG9yGAEgASgJEhUKDXpyYzIwX2FkZHJlc3MYAiABKAkSDAoEbmFtZRgDIAEoCRIOCgZzeW1ib2wYBCABKAk6DILnsCoHY3JlYXRvciIcChpNc2dVcGRhdGVaUkMyME5hbWVSZXNwb25zZSJSChpNc2dCdXJuRnVuZ2libGVNb2R1bGVBc3NldBIPCgdjcmVhdG9yGAEgASgJEhUKDXpyYzIwX2FkZHJlc3MYAiABKAk6DILnsCoHY3JlYXRvciIkCiJNc2dCdXJuRnVuZ2libGVNb2R1bGVBc3NldFJlc3BvbnNlMpQOCgNNc2cSjQEKFURlcGxveVN5c3RlbUNvbnRyYWN0cxI1LnpldGFjaGFpbi56ZXRhY29yZS5mdW5naWJsZS5Nc2dEZXBsb3lTeXN0ZW1Db250cmFjdHMaPS56ZXRhY2hhaW4uemV0YWNvcmUuZnVuZ2libGUuTXNnRGVwbG95U3lzdGVtQ29udHJhY3RzUmVzcG9uc2USkwEKF0RlcGxveUZ1bmdpYmxlQ29pblpSQzIwEjcuemV0YWNoYWluLnpldGFjb3JlLmZ1bmdpYmxlLk1zZ0RlcGxveUZ1bmdpYmxlQ29pblpSQzIwGj8uemV0YWNoYWluLnpldGFjb3JlLmZ1bmdpYmxlLk1zZ0RlcGxveUZ1bmdpYmxlQ29pblpSQzIwUmVzcG9uc2USgQEKEVJlbW92ZUZvcmVpZ25Db2luEjEuemV0YWNoYWluLnpldGFjb3JlLmZ1bmdpYmxlLk1zZ1JlbW92ZUZvcmVpZ25Db2luGjkuemV0YWNoYWluLnpldGFjb3JlLmZ1bmdpYmxlLk1zZ1JlbW92ZUZvcmVpZ25Db2luUmVzcG9uc2USigEKFFVwZGF0ZVN5c3RlbUNvbnRyYWN0EjQuemV0YWNoYWluLnpldGFjb3JlLmZ1bmdpYmxlLk1zZ1VwZGF0ZVN5c3RlbUNvbnRyYWN0GjwuemV0YWNoYWluLnpldGFjb3JlLmZ1bmdpYmxlLk1zZ1VwZGF0ZVN5c3RlbUNvbnRyYWN0UmVzcG9uc2USkAEKFlVwZGF0ZUNvbnRyYWN0Qnl0ZWNvZGUSNi56ZXRhY2hhaW4uemV0YWNvcmUuZnVuZ2libGUuTXNnVXBkYXRlQ29udHJhY3RCeXRlY29kZRo+LnpldGFjaGFpbi56ZXRhY29yZS5mdW5naWJsZS5Nc2dVcGRhdGVDb250cmFjdEJ5dGVjb2RlUmVzcG9uc2USkAEKFlVwZGF0ZVpSQzIwV2l0aGRyYXdGZWUSNi56ZXRhY2hhaW4uemV0YWNvcmUuZnVuZ2libGUuTXNnVXBkYXRlWlJDMjBXaXRoZHJhd0ZlZRo+LnpldGFjaGFpbi56ZXRhY29yZS5mdW5naWJsZS5Nc2dVcGRhdGVaUkMyMFdpdGhkcmF3RmVlUmVzcG9uc2USkwEKF1VwZGF0ZVpSQzIwTGlxdWlkaXR5Q2FwEjcuemV0YWNoYWluLnpldGFjb3JlLmZ1bmdpYmxlLk1zZ1VwZGF0ZVpSQzIwTGlxdWlkaXR5Q2FwGj8uemV0YWNoYWluLnpldGFjb3JlLmZ1bmdpYmxlLk1zZ1VwZGF0ZVpSQzIwTGlxdWlkaXR5Q2FwUmVzcG9uc2USbAoKUGF1c2VaUkMyMBIqLnpldGFjaGFpbi56ZXRhY29yZS5mdW5naWJsZS5Nc2dQYXVzZVpSQzIwGjIuemV0YWNoYWluLnpldGFjb3JlLmZ1bmdpYmxlLk1zZ1BhdXNlWlJDMjBSZXNwb25zZRJyCgxVbnBhdXNlWlJDMjASLC56ZXRhY2hhaW4uemV0YWNvcmUuZnVuZ2libGUuTXNnVW5wYXVzZVpSQzIwGjQuemV0YWNoYWluLnpldGFjb3JlLmZ1bmdpYmxlLk1zZ1VucGF1c2VaUkMyMFJlc3BvbnNlEo0BChVVcGRhdGVHYXRld2F5Q29udHJhY3QSNS56ZXRhY2hhaW4uemV0YWNvcmUuZnVuZ2libGUuTXNnVXBkYXRlR2F0ZXdheUNvbnRyYWN0Gj0uemV0YWNoYWluLnpldGFjb3JlLmZ1bmdpYmxlLk1zZ1VwZGF0ZUdhdGV3YXlDb250cmFjdFJlc3BvbnNlEnsKD1VwZGF0ZVpSQzIwTmFtZRIvLnpldGFjaGFpbi56ZXRhY29yZS5mdW5naWJsZS5Nc2dVcGRhdGVaUkMyME5hbWUaNy56ZXRhY2hhaW4uemV0YWNvcmUuZnVuZ2libGUuTXNnVXBkYXRlWlJDMjBOYW1lUmVzcG9uc2USkwEKF0J1cm5GdW5naWJsZU1vZHVsZUFzc2V0EjcuemV0YWNoYWluLnpldGFjb3JlLmZ1bmdpYmxlLk1zZ0J1cm5GdW5naWJsZU1vZHVsZUFzc2V0Gj8uemV0YWNoYWluLnpldGFjb3JlLmZ1bmdpYmxlLk1zZ0J1cm5GdW5naWJsZU1vZHVsZUFzc2V0UmVzcG9uc2USjQEKFVVwZGF0ZUdhdGV3YXlHYXNMaW1pdBI1LnpldGFjaGFpbi56ZXRhY29yZS5mdW5naWJsZS5Nc2dVcGRhdGVHYXRld2F5R2FzTGltaXQaPS56ZXRhY2hhaW4uemV0YWNvcmUuZnVuZ2libGUuTXNnVXBkYXRlR2F0ZXdheUdhc0xpbWl0UmVzcG9uc2UaBYDnsCoBQuUBCh9jb20uemV0YWNoYWluLnpldGFjb3JlLmZ1bmdpYmxlQgdUeFByb3RvUAFaK2dpdGh1Yi5jb20vemV0YS1jaGFpbi9ub2RlL3gvZnVuZ2libGUvdHlwZXOiAgNaWkaqAhtaZXRhY2hhaW4uWmV0YWNvcmUuRnVuZ2libGXKAhtaZXRhY2hhaW5cWmV0YWNvcmVcRnVuZ2libGXiAidaZXRhY2hhaW5cWmV0YWNvcmVcRnVuZ2libGVcR1BCTWV0YWRhdGHqAh1aZXRhY2hhaW46OlpldGFjb3JlOjpGdW5naWJsZWIGcHJvdG8z", [file_gogoproto_gogo, file_zetachain_zetacore_pkg_coin_coin, file_cosmos_msg_v1_msg]);
+  fileDesc("CiR6ZXRhY2hhaW4vemV0YWNvcmUvZnVuZ2libGUvdHgucHJvdG8SG3pldGFjaGFpbi56ZXRhY29yZS5mdW5naWJsZSJQChhNc2dVcGRhdGVHYXRld2F5R2FzTGltaXQSDwoHY3JlYXRvchgBIAEoCRIVCg1uZXdfZ2FzX2xpbWl0GAIgASgEOgyC57AqB2NyZWF0b3IiIgogTXNnVXBkYXRlR2F0ZXdheUdhc0xpbWl0UmVzcG9uc2UiOQoYTXNnRGVwbG95U3lzdGVtQ29udHJhY3RzEg8KB2NyZWF0b3IYASABKAk6DILnsCoHY3JlYXRvciKTAQogTXNnRGVwbG95U3lzdGVtQ29udHJhY3RzUmVzcG9uc2USGAoQdW5pc3dhcFYyRmFjdG9yeRgBIAEoCRINCgV3emV0YRgCIAEoCRIXCg91bmlzd2FwVjJSb3V0ZXIYAyABKAkSFQoNY29ubmVjdG9yWkVWTRgEIAEoCRIWCg5zeXN0ZW1Db250cmFjdBgFIAEoCSLCAQoZTXNnVXBkYXRlWlJDMjBXaXRoZHJhd0ZlZRIPCgdjcmVhdG9yGAEgASgJEhUKDXpyYzIwX2FkZHJlc3MYAiABKAkSOAoQbmV3X3dpdGhkcmF3X2ZlZRgGIAEoCUIeyN4fANreHxZjb3Ntb3NzZGsuaW8vbWF0aC5VaW50EjUKDW5ld19nYXNfbGltaXQYByABKAlCHsjeHwDa3h8WY29zbW9zc2RrLmlvL21hdGguVWludDoMguewKgdjcmVhdG9yIiMKIU1zZ1VwZGF0ZVpSQzIwV2l0aGRyYXdGZWVSZXNwb25zZSJdChdNc2dVcGRhdGVTeXN0ZW1Db250cmFjdBIPCgdjcmVhdG9yGAEgASgJEiMKG25ld19zeXN0ZW1fY29udHJhY3RfYWRkcmVzcxgCIAEoCToMguewKgdjcmVhdG9yIiEKH01zZ1VwZGF0ZVN5c3RlbUNvbnRyYWN0UmVzcG9uc2UimAIKGk1zZ0RlcGxveUZ1bmdpYmxlQ29pblpSQzIwEg8KB2NyZWF0b3IYASABKAkSDQoFRVJDMjAYAiABKAkSGAoQZm9yZWlnbl9jaGFpbl9pZBgDIAEoAxIQCghkZWNpbWFscxgEIAEoDRIMCgRuYW1lGAUgASgJEg4KBnN5bWJvbBgGIAEoCRI4Cgljb2luX3R5cGUYByABKA4yJS56ZXRhY2hhaW4uemV0YWNvcmUucGtnLmNvaW4uQ29pblR5cGUSEQoJZ2FzX2xpbWl0GAggASgDEjUKDWxpcXVpZGl0eV9jYXAYCSABKAlCHsjeHwHa3h8WY29zbW9zc2RrLmlvL21hdGguVWludDoMguewKgdjcmVhdG9yIjUKIk1zZ0RlcGxveUZ1bmdpYmxlQ29pblpSQzIwUmVzcG9uc2USDwoHYWRkcmVzcxgBIAEoCSJMChRNc2dSZW1vdmVGb3JlaWduQ29pbhIPCgdjcmVhdG9yGAEgASgJEhUKDXpyYzIwX2FkZHJlc3MYAiABKAk6DILnsCoHY3JlYXRvciIeChxNc2dSZW1vdmVGb3JlaWduQ29pblJlc3BvbnNlImsKGU1zZ1VwZGF0ZUNvbnRyYWN0Qnl0ZWNvZGUSDwoHY3JlYXRvchgBIAEoCRIYChBjb250cmFjdF9hZGRyZXNzGAIgASgJEhUKDW5ld19jb2RlX2hhc2gYAyABKAk6DILnsCoHY3JlYXRvciIjCiFNc2dVcGRhdGVDb250cmFjdEJ5dGVjb2RlUmVzcG9uc2UiiQEKGk1zZ1VwZGF0ZVpSQzIwTGlxdWlkaXR5Q2FwEg8KB2NyZWF0b3IYASABKAkSFQoNenJjMjBfYWRkcmVzcxgCIAEoCRI1Cg1saXF1aWRpdHlfY2FwGAMgASgJQh7I3h8A2t4fFmNvc21vc3Nkay5pby9tYXRoLlVpbnQ6DILnsCoHY3JlYXRvciIkCiJNc2dVcGRhdGVaUkMyMExpcXVpZGl0eUNhcFJlc3BvbnNlIowBCg1Nc2dQYXVzZVpSQzIwEg8KB2NyZWF0b3IYASABKAkSFwoPenJjMjBfYWRkcmVzc2VzGAIgAygJEkMKCWRpcmVjdGlvbhgDIAEoDjIwLnpldGFjaGFpbi56ZXRhY29yZS5mdW5naWJsZS5aUkMyMFBhdXNlRGlyZWN0aW9uOgyC57AqB2NyZWF0b3IiFwoVTXNnUGF1c2VaUkMyMFJlc3BvbnNlIo4BCg9Nc2dVbnBhdXNlWlJDMjASDwoHY3JlYXRvchgBIAEoCRIXCg96cmMyMF9hZGRyZXNzZXMYAiADKAkSQwoJZGlyZWN0aW9uGAMgASgOMjAuemV0YWNoYWluLnpldGFjb3JlLmZ1bmdpYmxlLlpSQzIwUGF1c2VEaXJlY3Rpb246DILnsCoHY3JlYXRvciIZChdNc2dVbnBhdXNlWlJDMjBSZXNwb25zZSJfChhNc2dVcGRhdGVHYXRld2F5Q29udHJhY3QSDwoHY3JlYXRvchgBIAEoCRIkChxuZXdfZ2F0ZXdheV9jb250cmFjdF9hZGRyZXNzGAIgASgJOgyC57AqB2NyZWF0b3IiIgogTXNnVXBkYXRlR2F0ZXdheUNvbnRyYWN0UmVzcG9uc2UiaAoSTXNnVXBkYXRlWlJDMjBOYW1lEg8KB2NyZWF0b3IYASABKAkSFQoNenJjMjBfYWRkcmVzcxgCIAEoCRIMCgRuYW1lGAMgASgJEg4KBnN5bWJvbBgEIAEoCToMguewKgdjcmVhdG9yIhwKGk1zZ1VwZGF0ZVpSQzIwTmFtZVJlc3BvbnNlIlIKGk1zZ0J1cm5GdW5naWJsZU1vZHVsZUFzc2V0Eg8KB2NyZWF0b3IYASABKAkSFQoNenJjMjBfYWRkcmVzcxgCIAEoCToMguewKgdjcmVhdG9yIiQKIk1zZ0J1cm5GdW5naWJsZU1vZHVsZUFzc2V0UmVzcG9uc2UylA4KA01zZxKNAQoVRGVwbG95U3lzdGVtQ29udHJhY3RzEjUuemV0YWNoYWluLnpldGFjb3JlLmZ1bmdpYmxlLk1zZ0RlcGxveVN5c3RlbUNvbnRyYWN0cxo9LnpldGFjaGFpbi56ZXRhY29yZS5mdW5naWJsZS5Nc2dEZXBsb3lTeXN0ZW1Db250cmFjdHNSZXNwb25zZRKTAQoXRGVwbG95RnVuZ2libGVDb2luWlJDMjASNy56ZXRhY2hhaW4uemV0YWNvcmUuZnVuZ2libGUuTXNnRGVwbG95RnVuZ2libGVDb2luWlJDMjAaPy56ZXRhY2hhaW4uemV0YWNvcmUuZnVuZ2libGUuTXNnRGVwbG95RnVuZ2libGVDb2luWlJDMjBSZXNwb25zZRKBAQoRUmVtb3ZlRm9yZWlnbkNvaW4SMS56ZXRhY2hhaW4uemV0YWNvcmUuZnVuZ2libGUuTXNnUmVtb3ZlRm9yZWlnbkNvaW4aOS56ZXRhY2hhaW4uemV0YWNvcmUuZnVuZ2libGUuTXNnUmVtb3ZlRm9yZWlnbkNvaW5SZXNwb25zZRKKAQoUVXBkYXRlU3lzdGVtQ29udHJhY3QSNC56ZXRhY2hhaW4uemV0YWNvcmUuZnVuZ2libGUuTXNnVXBkYXRlU3lzdGVtQ29udHJhY3QaPC56ZXRhY2hhaW4uemV0YWNvcmUuZnVuZ2libGUuTXNnVXBkYXRlU3lzdGVtQ29udHJhY3RSZXNwb25zZRKQAQoWVXBkYXRlQ29udHJhY3RCeXRlY29kZRI2LnpldGFjaGFpbi56ZXRhY29yZS5mdW5naWJsZS5Nc2dVcGRhdGVDb250cmFjdEJ5dGVjb2RlGj4uemV0YWNoYWluLnpldGFjb3JlLmZ1bmdpYmxlLk1zZ1VwZGF0ZUNvbnRyYWN0Qnl0ZWNvZGVSZXNwb25zZRKQAQoWVXBkYXRlWlJDMjBXaXRoZHJhd0ZlZRI2LnpldGFjaGFpbi56ZXRhY29yZS5mdW5naWJsZS5Nc2dVcGRhdGVaUkMyMFdpdGhkcmF3RmVlGj4uemV0YWNoYWluLnpldGFjb3JlLmZ1bmdpYmxlLk1zZ1VwZGF0ZVpSQzIwV2l0aGRyYXdGZWVSZXNwb25zZRKTAQoXVXBkYXRlWlJDMjBMaXF1aWRpdHlDYXASNy56ZXRhY2hhaW4uemV0YWNvcmUuZnVuZ2libGUuTXNnVXBkYXRlWlJDMjBMaXF1aWRpdHlDYXAaPy56ZXRhY2hhaW4uemV0YWNvcmUuZnVuZ2libGUuTXNnVXBkYXRlWlJDMjBMaXF1aWRpdHlDYXBSZXNwb25zZRJsCgpQYXVzZVpSQzIwEiouemV0YWNoYWluLnpldGFjb3JlLmZ1bmdpYmxlLk1zZ1BhdXNlWlJDMjAaMi56ZXRhY2hhaW4uemV0YWNvcmUuZnVuZ2libGUuTXNnUGF1c2VaUkMyMFJlc3BvbnNlEnIKDFVucGF1c2VaUkMyMBIsLnpldGFjaGFpbi56ZXRhY29yZS5mdW5naWJsZS5Nc2dVbnBhdXNlWlJDMjAaNC56ZXRhY2hhaW4uemV0YWNvcmUuZnVuZ2libGUuTXNnVW5wYXVzZVpSQzIwUmVzcG9uc2USjQEKFVVwZGF0ZUdhdGV3YXlDb250cmFjdBI1LnpldGFjaGFpbi56ZXRhY29yZS5mdW5naWJsZS5Nc2dVcGRhdGVHYXRld2F5Q29udHJhY3QaPS56ZXRhY2hhaW4uemV0YWNvcmUuZnVuZ2libGUuTXNnVXBkYXRlR2F0ZXdheUNvbnRyYWN0UmVzcG9uc2USewoPVXBkYXRlWlJDMjBOYW1lEi8uemV0YWNoYWluLnpldGFjb3JlLmZ1bmdpYmxlLk1zZ1VwZGF0ZVpSQzIwTmFtZRo3LnpldGFjaGFpbi56ZXRhY29yZS5mdW5naWJsZS5Nc2dVcGRhdGVaUkMyME5hbWVSZXNwb25zZRKTAQoXQnVybkZ1bmdpYmxlTW9kdWxlQXNzZXQSNy56ZXRhY2hhaW4uemV0YWNvcmUuZnVuZ2libGUuTXNnQnVybkZ1bmdpYmxlTW9kdWxlQXNzZXQaPy56ZXRhY2hhaW4uemV0YWNvcmUuZnVuZ2libGUuTXNnQnVybkZ1bmdpYmxlTW9kdWxlQXNzZXRSZXNwb25zZRKNAQoVVXBkYXRlR2F0ZXdheUdhc0xpbWl0EjUuemV0YWNoYWluLnpldGFjb3JlLmZ1bmdpYmxlLk1zZ1VwZGF0ZUdhdGV3YXlHYXNMaW1pdBo9LnpldGFjaGFpbi56ZXRhY29yZS5mdW5naWJsZS5Nc2dVcGRhdGVHYXRld2F5R2FzTGltaXRSZXNwb25zZRoFgOewKgFC5QEKH2NvbS56ZXRhY2hhaW4uemV0YWNvcmUuZnVuZ2libGVCB1R4UHJvdG9QAVorZ2l0aHViLmNvbS96ZXRhLWNoYWluL25vZGUveC9mdW5naWJsZS90eXBlc6ICA1paRqoCG1pldGFjaGFpbi5aZXRhY29yZS5GdW5naWJsZcoCG1pldGFjaGFpblxaZXRhY29yZVxGdW5naWJsZeICJ1pldGFjaGFpblxaZXRhY29yZVxGdW5naWJsZVxHUEJNZXRhZGF0YeoCHVpldGFjaGFpbjo6WmV0YWNvcmU6OkZ1bmdpYmxlYgZwcm90bzM", [file_gogoproto_gogo, file_zetachain_zetacore_pkg_coin_coin, file_cosmos_msg_v1_msg, file_zetachain_zetacore_fungible_foreign_coins]);
 
 /**
  * @generated from message zetachain.zetacore.fungible.MsgUpdateGatewayGasLimit
@@ -389,6 +391,10 @@ export type MsgPauseZRC20 = Message<"zetachain.zetacore.fungible.MsgPauseZRC20">
    * @generated from field: repeated string zrc20_addresses = 2;
    */
   zrc20Addresses: string[];
+  /**
+   * @generated from field: zetachain.zetacore.fungible.ZRC20PauseDirection direction = 3;
+   */
+  direction: ZRC20PauseDirection;
 };
 
 /**
@@ -424,6 +430,10 @@ export type MsgUnpauseZRC20 = Message<"zetachain.zetacore.fungible.MsgUnpauseZRC
    * @generated from field: repeated string zrc20_addresses = 2;
    */
   zrc20Addresses: string[];
+  /**
+   * @generated from field: zetachain.zetacore.fungible.ZRC20PauseDirection direction = 3;
+   */
+  direction: ZRC20PauseDirection;
 };
 
 /**
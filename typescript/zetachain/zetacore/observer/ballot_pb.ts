@@ -13,7 +13,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file zetachain/zetacore/observer/ballot.proto.
  */
 export const file_zetachain_zetacore_observer_ballot: GenFile = /*@__PURE__*/
-  fileDesc("Cih6ZXRhY2hhaW4vemV0YWNvcmUvb2JzZXJ2ZXIvYmFsbG90LnByb3RvEht6ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIi4wIKBkJhbGxvdBIZChFiYWxsb3RfaWRlbnRpZmllchgCIAEoCRISCgp2b3Rlcl9saXN0GAMgAygJEjQKBXZvdGVzGAQgAygOMiUuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlZvdGVUeXBlEkYKEG9ic2VydmF0aW9uX3R5cGUYBSABKA4yLC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuT2JzZXJ2YXRpb25UeXBlEj0KEGJhbGxvdF90aHJlc2hvbGQYBiABKAlCI8jeHwDa3h8bY29zbW9zc2RrLmlvL21hdGguTGVnYWN5RGVjEkAKDWJhbGxvdF9zdGF0dXMYByABKA4yKS56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuQmFsbG90U3RhdHVzEh4KFmJhbGxvdF9jcmVhdGlvbl9oZWlnaHQYCCABKANKBAgBEAJSBWluZGV4IkEKE0JhbGxvdExpc3RGb3JIZWlnaHQSDgoGaGVpZ2h0GAEgASgDEhoKEmJhbGxvdHNfaW5kZXhfbGlzdBgCIAMoCSpRCghWb3RlVHlwZRIWChJTdWNjZXNzT2JzZXJ2YXRpb24QABIWChJGYWlsdXJlT2JzZXJ2YXRpb24QARIPCgtOb3RZZXRWb3RlZBACGgSopB4BKnoKDEJhbGxvdFN0YXR1cxImCiJCYWxsb3RGaW5hbGl6ZWRfU3VjY2Vzc09ic2VydmF0aW9uEAASJgoiQmFsbG90RmluYWxpemVkX0ZhaWx1cmVPYnNlcnZhdGlvbhABEhQKEEJhbGxvdEluUHJvZ3Jlc3MQAhoEqKQeAULpAQofY29tLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlckILQmFsbG90UHJvdG9QAVorZ2l0aHViLmNvbS96ZXRhLWNoYWluL25vZGUveC9vYnNlcnZlci90eXBlc6ICA1paT6oCG1pldGFjaGFpbi5aZXRhY29yZS5PYnNlcnZlcsoCG1pldGFjaGFpblxaZXRhY29yZVxPYnNlcnZlcuICJ1pldGFjaGFpblxaZXRhY29yZVxPYnNlcnZlclxHUEJNZXRhZGF0YeoCHVpldGFjaGFpbjo6WmV0YWNvcmU6Ok9ic2VydmVyYgZwcm90bzM", [file_gogoproto_gogo, file_zetachain_zetacore_observer_observer]);
+  fileDesc("Cih6ZXRhY2hhaW4vemV0YWNvcmUvb2JzZXJ2ZXIvYmFsbG90LnByb3RvEht6ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIimQMKBkJhbGxvdBIZChFiYWxsb3RfaWRlbnRpZmllchgCIAEoCRISCgp2b3Rlcl9saXN0GAMgAygJEjQKBXZvdGVzGAQgAygOMiUuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlZvdGVUeXBlEkYKEG9ic2VydmF0aW9uX3R5cGUYBSABKA4yLC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuT2JzZXJ2YXRpb25UeXBlEj0KEGJhbGxvdF90aHJlc2hvbGQYBiABKAlCI8jeHwDa3h8bY29zbW9zc2RrLmlvL21hdGguTGVnYWN5RGVjEkAKDWJhbGxvdF9zdGF0dXMYByABKA4yKS56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuQmFsbG90U3RhdHVzEh4KFmJhbGxvdF9jcmVhdGlvbl9oZWlnaHQYCCABKAMSNAoNdm90ZXJfd2VpZ2h0cxgJIAMoCUIdyN4fANreHxVjb3Ntb3NzZGsuaW8vbWF0aC5JbnRKBAgBEAJSBWluZGV4IkEKE0JhbGxvdExpc3RGb3JIZWlnaHQSDgoGaGVpZ2h0GAEgASgDEhoKEmJhbGxvdHNfaW5kZXhfbGlzdBgCIAMoCSpRCghWb3RlVHlwZRIWChJTdWNjZXNzT2JzZXJ2YXRpb24QABIWChJGYWlsdXJlT2JzZXJ2YXRpb24QARIPCgtOb3RZZXRWb3RlZBACGgSopB4BKnoKDEJhbGxvdFN0YXR1cxImCiJCYWxsb3RGaW5hbGl6ZWRfU3VjY2Vzc09ic2VydmF0aW9uEAASJgoiQmFsbG90RmluYWxpemVkX0ZhaWx1cmVPYnNlcnZhdGlvbhABEhQKEEJhbGxvdEluUHJvZ3Jlc3MQAhoEqKQeAULpAQofY29tLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlckILQmFsbG90UHJvdG9QAVorZ2l0aHViLmNvbS96ZXRhLWNoYWluL25vZGUveC9vYnNlcnZlci90eXBlc6ICA1paT6oCG1pldGFjaGFpbi5aZXRhY29yZS5PYnNlcnZlcsoCG1pldGFjaGFpblxaZXRhY29yZVxPYnNlcnZlcuICJ1pldGFjaGFpblxaZXRhY29yZVxPYnNlcnZlclxHUEJNZXRhZGF0YeoCHVpldGFjaGFpbjo6WmV0YWNvcmU6Ok9ic2VydmVyYgZwcm90bzM", [file_gogoproto_gogo, file_zetachain_zetacore_observer_observer]);
 
 /**
  * https://github.com/zeta-chain/node/issues/939
@@ -55,6 +55,15 @@ export type Ballot = Message<"zetachain.zetacore.observer.Ballot"> & {
    * @generated from field: int64 ballot_creation_height = 8;
    */
   ballotCreationHeight: bigint;
+
+  /**
+   * weight of the vote of each voter in the order of voter_list, set if the
+   * ballot is created with stake weighted voting, each vote counts as one
+   * otherwise
+   *
+   * @generated from field: repeated string voter_weights = 9;
+   */
+  voterWeights: string[];
 };
 
 /**
@@ -4,6 +4,7 @@
 
 import type { GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
 import { fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_gogoproto_gogo } from "../../../gogoproto/gogo_pb";
 import { file_zetachain_zetacore_observer_observer } from "./observer_pb";
 import type { Message } from "@bufbuild/protobuf";
 
@@ -11,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file zetachain/zetacore/observer/blame.proto.
  */
 export const file_zetachain_zetacore_observer_blame: GenFile = /*@__PURE__*/
-  fileDesc("Cid6ZXRhY2hhaW4vemV0YWNvcmUvb2JzZXJ2ZXIvYmxhbWUucHJvdG8SG3pldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlciJECgROb2RlEg8KB3B1Yl9rZXkYASABKAkSEgoKYmxhbWVfZGF0YRgCIAEoDBIXCg9ibGFtZV9zaWduYXR1cmUYAyABKAwiYAoFQmxhbWUSDQoFaW5kZXgYASABKAkSFgoOZmFpbHVyZV9yZWFzb24YAiABKAkSMAoFbm9kZXMYAyADKAsyIS56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTm9kZULoAQofY29tLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlckIKQmxhbWVQcm90b1ABWitnaXRodWIuY29tL3pldGEtY2hhaW4vbm9kZS94L29ic2VydmVyL3R5cGVzogIDWlpPqgIbWmV0YWNoYWluLlpldGFjb3JlLk9ic2VydmVyygIbWmV0YWNoYWluXFpldGFjb3JlXE9ic2VydmVy4gInWmV0YWNoYWluXFpldGFjb3JlXE9ic2VydmVyXEdQQk1ldGFkYXRh6gIdWmV0YWNoYWluOjpaZXRhY29yZTo6T2JzZXJ2ZXJiBnByb3RvMw", [file_zetachain_zetacore_observer_observer]);
+  fileDesc("Cid6ZXRhY2hhaW4vemV0YWNvcmUvb2JzZXJ2ZXIvYmxhbWUucHJvdG8SG3pldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlciJECgROb2RlEg8KB3B1Yl9rZXkYASABKAkSEgoKYmxhbWVfZGF0YRgCIAEoDBIXCg9ibGFtZV9zaWduYXR1cmUYAyABKAwiYAoFQmxhbWUSDQoFaW5kZXgYASABKAkSFgoOZmFpbHVyZV9yZWFzb24YAiABKAkSMAoFbm9kZXMYAyADKAsyIS56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTm9kZSJtCgpCbGFtZUV2ZW50EhMKC2JsYW1lX2luZGV4GAEgASgJEhAKCGNoYWluX2lkGAIgASgDEhYKDmZhaWx1cmVfcmVhc29uGAMgASgJEhAKCHB1Yl9rZXlzGAQgAygJEg4KBmhlaWdodBgFIAEoAyJFCgpCbGFtZUNvdW50EhAKCGNoYWluX2lkGAEgASgDEhYKDmZhaWx1cmVfcmVhc29uGAIgASgJEg0KBWNvdW50GAMgASgEIoEBCg5Ob2RlQmxhbWVTdGF0cxIPCgdwdWJfa2V5GAEgASgJEhAKCG9wZXJhdG9yGAIgASgJEg0KBXRvdGFsGAMgASgEEj0KBmNvdW50cxgEIAMoCzInLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5CbGFtZUNvdW50QgTI3h8AQugBCh9jb20uemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyQgpCbGFtZVByb3RvUAFaK2dpdGh1Yi5jb20vemV0YS1jaGFpbi9ub2RlL3gvb2JzZXJ2ZXIvdHlwZXOiAgNaWk+qAhtaZXRhY2hhaW4uWmV0YWNvcmUuT2JzZXJ2ZXLKAhtaZXRhY2hhaW5cWmV0YWNvcmVcT2JzZXJ2ZXLiAidaZXRhY2hhaW5cWmV0YWNvcmVcT2JzZXJ2ZXJcR1BCTWV0YWRhdGHqAh1aZXRhY2hhaW46OlpldGFjb3JlOjpPYnNlcnZlcmIGcHJvdG8z", [file_gogoproto_gogo, file_zetachain_zetacore_observer_observer]);
 
 /**
  * @generated from message zetachain.zetacore.observer.Node
@@ -67,3 +68,108 @@ export type Blame = Message<"zetachain.zetacore.observer.Blame"> & {
 export const BlameSchema: GenMessage<Blame> = /*@__PURE__*/
   messageDesc(file_zetachain_zetacore_observer_blame, 1);
 
+/**
+ * BlameEvent is a finalized blame indexed by the height it was finalized at,
+ * it's used to aggregate blame statistics over a window of blocks
+ *
+ * @generated from message zetachain.zetacore.observer.BlameEvent
+ */
+export type BlameEvent = Message<"zetachain.zetacore.observer.BlameEvent"> & {
+  /**
+   * @generated from field: string blame_index = 1;
+   */
+  blameIndex: string;
+
+  /**
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: string failure_reason = 3;
+   */
+  failureReason: string;
+
+  /**
+   * @generated from field: repeated string pub_keys = 4;
+   */
+  pubKeys: string[];
+
+  /**
+   * @generated from field: int64 height = 5;
+   */
+  height: bigint;
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.BlameEvent.
+ * Use `create(BlameEventSchema)` to create a new message.
+ */
+export const BlameEventSchema: GenMessage<BlameEvent> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_blame, 2);
+
+/**
+ * BlameCount is the number of blames of a node for a chain and failure reason
+ *
+ * @generated from message zetachain.zetacore.observer.BlameCount
+ */
+export type BlameCount = Message<"zetachain.zetacore.observer.BlameCount"> & {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: string failure_reason = 2;
+   */
+  failureReason: string;
+
+  /**
+   * @generated from field: uint64 count = 3;
+   */
+  count: bigint;
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.BlameCount.
+ * Use `create(BlameCountSchema)` to create a new message.
+ */
+export const BlameCountSchema: GenMessage<BlameCount> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_blame, 3);
+
+/**
+ * NodeBlameStats aggregates the blames of a node over a window of blocks
+ *
+ * @generated from message zetachain.zetacore.observer.NodeBlameStats
+ */
+export type NodeBlameStats = Message<"zetachain.zetacore.observer.NodeBlameStats"> & {
+  /**
+   * @generated from field: string pub_key = 1;
+   */
+  pubKey: string;
+
+  /**
+   * operator of the node account with this pubkey, empty if there is none
+   *
+   * @generated from field: string operator = 2;
+   */
+  operator: string;
+
+  /**
+   * @generated from field: uint64 total = 3;
+   */
+  total: bigint;
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.BlameCount counts = 4;
+   */
+  counts: BlameCount[];
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.NodeBlameStats.
+ * Use `create(NodeBlameStatsSchema)` to create a new message.
+ */
+export const NodeBlameStatsSchema: GenMessage<NodeBlameStats> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_blame, 4);
+
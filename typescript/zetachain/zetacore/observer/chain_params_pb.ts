@@ -2,8 +2,8 @@
 // @generated from file zetachain/zetacore/observer/chain_params.proto (package zetachain.zetacore.observer, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
 import { file_gogoproto_gogo } from "../../../gogoproto/gogo_pb";
 import type { ConfirmationParams } from "./confirmation_params_pb";
 import { file_zetachain_zetacore_observer_confirmation_params } from "./confirmation_params_pb";
@@ -13,7 +13,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file zetachain/zetacore/observer/chain_params.proto.
  */
 export const file_zetachain_zetacore_observer_chain_params: GenFile = /*@__PURE__*/
-  fileDesc("Ci56ZXRhY2hhaW4vemV0YWNvcmUvb2JzZXJ2ZXIvY2hhaW5fcGFyYW1zLnByb3RvEht6ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIiUQoPQ2hhaW5QYXJhbXNMaXN0Ej4KDGNoYWluX3BhcmFtcxgBIAMoCzIoLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5DaGFpblBhcmFtcyLhBQoLQ2hhaW5QYXJhbXMSEAoIY2hhaW5faWQYCyABKAMSGAoQZ2FzX3ByaWNlX3RpY2tlchgCIAEoBBIWCg5pbmJvdW5kX3RpY2tlchgDIAEoBBIXCg9vdXRib3VuZF90aWNrZXIYBCABKAQSGQoRd2F0Y2hfdXR4b190aWNrZXIYBSABKAQSIwobemV0YV90b2tlbl9jb250cmFjdF9hZGRyZXNzGAggASgJEiIKGmNvbm5lY3Rvcl9jb250cmFjdF9hZGRyZXNzGAkgASgJEiYKHmVyYzIwX2N1c3RvZHlfY29udHJhY3RfYWRkcmVzcxgKIAEoCRIiChpvdXRib3VuZF9zY2hlZHVsZV9pbnRlcnZhbBgMIAEoAxIjChtvdXRib3VuZF9zY2hlZHVsZV9sb29rYWhlYWQYDSABKAMSPQoQYmFsbG90X3RocmVzaG9sZBgOIAEoCUIjyN4fANreHxtjb3Ntb3NzZGsuaW8vbWF0aC5MZWdhY3lEZWMSRAoXbWluX29ic2VydmVyX2RlbGVnYXRpb24YDyABKAlCI8jeHwDa3h8bY29zbW9zc2RrLmlvL21hdGguTGVnYWN5RGVjEhQKDGlzX3N1cHBvcnRlZBgQIAEoCBIXCg9nYXRld2F5X2FkZHJlc3MYESABKAkSTAoTY29uZmlybWF0aW9uX3BhcmFtcxgSIAEoCzIvLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Db25maXJtYXRpb25QYXJhbXMSHgoWZGlzYWJsZV90c3NfYmxvY2tfc2NhbhgTIAEoCBJBChRnYXNfcHJpY2VfbXVsdGlwbGllchgUIAEoCUIjyN4fANreHxtjb3Ntb3NzZGsuaW8vbWF0aC5MZWdhY3lEZWMSIQoZc3RhYmlsaXR5X3Bvb2xfcGVyY2VudGFnZRgVIAEoBEoECAEQAlISY29uZmlybWF0aW9uX2NvdW50Qu4BCh9jb20uemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyQhBDaGFpblBhcmFtc1Byb3RvUAFaK2dpdGh1Yi5jb20vemV0YS1jaGFpbi9ub2RlL3gvb2JzZXJ2ZXIvdHlwZXOiAgNaWk+qAhtaZXRhY2hhaW4uWmV0YWNvcmUuT2JzZXJ2ZXLKAhtaZXRhY2hhaW5cWmV0YWNvcmVcT2JzZXJ2ZXLiAidaZXRhY2hhaW5cWmV0YWNvcmVcT2JzZXJ2ZXJcR1BCTWV0YWRhdGHqAh1aZXRhY2hhaW46OlpldGFjb3JlOjpPYnNlcnZlcmIGcHJvdG8z", [file_gogoproto_gogo, file_zetachain_zetacore_observer_confirmation_params]);
+  fileDesc("Ci56ZXRhY2hhaW4vemV0YWNvcmUvb2JzZXJ2ZXIvY2hhaW5fcGFyYW1zLnByb3RvEht6ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIiUQoPQ2hhaW5QYXJhbXNMaXN0Ej4KDGNoYWluX3BhcmFtcxgBIAMoCzIoLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5DaGFpblBhcmFtcyKABwoLQ2hhaW5QYXJhbXMSEAoIY2hhaW5faWQYCyABKAMSGAoQZ2FzX3ByaWNlX3RpY2tlchgCIAEoBBIWCg5pbmJvdW5kX3RpY2tlchgDIAEoBBIXCg9vdXRib3VuZF90aWNrZXIYBCABKAQSGQoRd2F0Y2hfdXR4b190aWNrZXIYBSABKAQSIwobemV0YV90b2tlbl9jb250cmFjdF9hZGRyZXNzGAggASgJEiIKGmNvbm5lY3Rvcl9jb250cmFjdF9hZGRyZXNzGAkgASgJEiYKHmVyYzIwX2N1c3RvZHlfY29udHJhY3RfYWRkcmVzcxgKIAEoCRIiChpvdXRib3VuZF9zY2hlZHVsZV9pbnRlcnZhbBgMIAEoAxIjChtvdXRib3VuZF9zY2hlZHVsZV9sb29rYWhlYWQYDSABKAMSPQoQYmFsbG90X3RocmVzaG9sZBgOIAEoCUIjyN4fANreHxtjb3Ntb3NzZGsuaW8vbWF0aC5MZWdhY3lEZWMSRAoXbWluX29ic2VydmVyX2RlbGVnYXRpb24YDyABKAlCI8jeHwDa3h8bY29zbW9zc2RrLmlvL21hdGguTGVnYWN5RGVjEhQKDGlzX3N1cHBvcnRlZBgQIAEoCBIXCg9nYXRld2F5X2FkZHJlc3MYESABKAkSTAoTY29uZmlybWF0aW9uX3BhcmFtcxgSIAEoCzIvLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Db25maXJtYXRpb25QYXJhbXMSHgoWZGlzYWJsZV90c3NfYmxvY2tfc2NhbhgTIAEoCBJBChRnYXNfcHJpY2VfbXVsdGlwbGllchgUIAEoCUIjyN4fANreHxtjb3Ntb3NzZGsuaW8vbWF0aC5MZWdhY3lEZWMSIQoZc3RhYmlsaXR5X3Bvb2xfcGVyY2VudGFnZRgVIAEoBBJGChBzaWduYXR1cmVfc2NoZW1lGBYgASgOMiwuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlNpZ25hdHVyZVNjaGVtZRIdChVzdGFrZV93ZWlnaHRlZF92b3RpbmcYFyABKAgSIwobbWF4X3ZvdGVyX3dlaWdodF9wZXJjZW50YWdlGBggASgEEhEKCW9ic2VydmVycxgZIAMoCUoECAEQAlISY29uZmlybWF0aW9uX2NvdW50KicKD1NpZ25hdHVyZVNjaGVtZRIJCgVFQ0RTQRAAEgkKBUVERFNBEAFC7gEKH2NvbS56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXJCEENoYWluUGFyYW1zUHJvdG9QAVorZ2l0aHViLmNvbS96ZXRhLWNoYWluL25vZGUveC9vYnNlcnZlci90eXBlc6ICA1paT6oCG1pldGFjaGFpbi5aZXRhY29yZS5PYnNlcnZlcsoCG1pldGFjaGFpblxaZXRhY29yZVxPYnNlcnZlcuICJ1pldGFjaGFpblxaZXRhY29yZVxPYnNlcnZlclxHUEJNZXRhZGF0YeoCHVpldGFjaGFpbjo6WmV0YWNvcmU6Ok9ic2VydmVyYgZwcm90bzM", [file_gogoproto_gogo, file_zetachain_zetacore_observer_confirmation_params]);
 
 /**
  * @generated from message zetachain.zetacore.observer.ChainParamsList
@@ -135,6 +135,44 @@ export type ChainParams = Message<"zetachain.zetacore.observer.ChainParams"> & {
    * @generated from field: uint64 stability_pool_percentage = 21;
    */
   stabilityPoolPercentage: bigint;
+
+  /**
+   * The TSS key signing the outbounds, EDDSA requires a gateway variant
+   * verifying ed25519 signatures and is only supported on Solana and TON
+   *
+   * @generated from field: zetachain.zetacore.observer.SignatureScheme signature_scheme = 22;
+   */
+  signatureScheme: SignatureScheme;
+
+  /**
+   * Weight the votes of the observers on the ballots of the chain by their
+   * bonded self delegation instead of counting one vote per observer
+   *
+   * @generated from field: bool stake_weighted_voting = 23;
+   */
+  stakeWeightedVoting: boolean;
+
+  /**
+   * Maximum weight of an observer as a percentage of the total weight of the
+   * ballot voters when stake weighted voting is enabled, 0 means no cap.
+   * The value should be between 0 and 100.
+   *
+   * @generated from field: uint64 max_voter_weight_percentage = 24;
+   */
+  maxVoterWeightPercentage: bigint;
+
+  /**
+   * Observers eligible to vote on the ballots of the chain, the ballot
+   * threshold is computed over this subset of the observer set.
+   * All the observers of the observer set are eligible if empty.
+   * The observers are removed from the subset when they leave the observer set.
+   * The other observers skip the observation of the chain but still sign its
+   * outbounds (TSS keysign) and track them, so they still need an RPC
+   * endpoint for the chain.
+   *
+   * @generated from field: repeated string observers = 25;
+   */
+  observers: string[];
 };
 
 /**
@@ -144,3 +182,31 @@ export type ChainParams = Message<"zetachain.zetacore.observer.ChainParams"> & {
 export const ChainParamsSchema: GenMessage<ChainParams> = /*@__PURE__*/
   messageDesc(file_zetachain_zetacore_observer_chain_params, 1);
 
+
+/**
+ * SignatureScheme is the TSS key signing the outbounds of a chain
+ *
+ * @generated from enum zetachain.zetacore.observer.SignatureScheme
+ */
+export enum SignatureScheme {
+  /**
+   * secp256k1 key, the signature is verified by the gateway
+   *
+   * @generated from enum value: ECDSA = 0;
+   */
+  ECDSA = 0,
+
+  /**
+   * ed25519 key, the signature is verified natively by the chain
+   *
+   * @generated from enum value: EDDSA = 1;
+   */
+  EDDSA = 1,
+}
+
+/**
+ * Describes the enum zetachain.zetacore.observer.SignatureScheme.
+ */
+export const SignatureSchemeSchema: GenEnum<SignatureScheme> = /*@__PURE__*/
+  enumDesc(file_zetachain_zetacore_observer_chain_params, 0);
+
@@ -13,7 +13,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file zetachain/zetacore/observer/crosschain_flags.proto.
  */
 export const file_zetachain_zetacore_observer_crosschain_flags: GenFile = /*@__PURE__*/
-  fileDesc("CjJ6ZXRhY2hhaW4vemV0YWNvcmUvb2JzZXJ2ZXIvY3Jvc3NjaGFpbl9mbGFncy5wcm90bxIbemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyIv4BChVHYXNQcmljZUluY3JlYXNlRmxhZ3MSEwoLZXBvY2hMZW5ndGgYASABKAMSOgoNcmV0cnlJbnRlcnZhbBgCIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbkIIyN4fAJjfHwESHwoXZ2FzUHJpY2VJbmNyZWFzZVBlcmNlbnQYAyABKA0SGwoTZ2FzUHJpY2VJbmNyZWFzZU1heBgEIAEoDRIXCg9tYXhQZW5kaW5nQ2N0eHMYBSABKA0SPQoQcmV0cnlJbnRlcnZhbEJUQxgGIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbkIIyN4fAJjfHwEisgEKD0Nyb3NzY2hhaW5GbGFncxIYChBpc0luYm91bmRFbmFibGVkGAEgASgIEhkKEWlzT3V0Ym91bmRFbmFibGVkGAIgASgIElEKFWdhc1ByaWNlSW5jcmVhc2VGbGFncxgDIAEoCzIyLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5HYXNQcmljZUluY3JlYXNlRmxhZ3MSFwoPaXNWMlpldGFFbmFibGVkGAQgASgIIp8BChVMZWdhY3lDcm9zc2NoYWluRmxhZ3MSGAoQaXNJbmJvdW5kRW5hYmxlZBgBIAEoCBIZChFpc091dGJvdW5kRW5hYmxlZBgCIAEoCBJRChVnYXNQcmljZUluY3JlYXNlRmxhZ3MYAyABKAsyMi56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuR2FzUHJpY2VJbmNyZWFzZUZsYWdzQvIBCh9jb20uemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyQhRDcm9zc2NoYWluRmxhZ3NQcm90b1ABWitnaXRodWIuY29tL3pldGEtY2hhaW4vbm9kZS94L29ic2VydmVyL3R5cGVzogIDWlpPqgIbWmV0YWNoYWluLlpldGFjb3JlLk9ic2VydmVyygIbWmV0YWNoYWluXFpldGFjb3JlXE9ic2VydmVy4gInWmV0YWNoYWluXFpldGFjb3JlXE9ic2VydmVyXEdQQk1ldGFkYXRh6gIdWmV0YWNoYWluOjpaZXRhY29yZTo6T2JzZXJ2ZXJiBnByb3RvMw", [file_gogoproto_gogo, file_google_protobuf_duration]);
+  fileDesc("CjJ6ZXRhY2hhaW4vemV0YWNvcmUvb2JzZXJ2ZXIvY3Jvc3NjaGFpbl9mbGFncy5wcm90bxIbemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyIv4BChVHYXNQcmljZUluY3JlYXNlRmxhZ3MSEwoLZXBvY2hMZW5ndGgYASABKAMSOgoNcmV0cnlJbnRlcnZhbBgCIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbkIIyN4fAJjfHwESHwoXZ2FzUHJpY2VJbmNyZWFzZVBlcmNlbnQYAyABKA0SGwoTZ2FzUHJpY2VJbmNyZWFzZU1heBgEIAEoDRIXCg9tYXhQZW5kaW5nQ2N0eHMYBSABKA0SPQoQcmV0cnlJbnRlcnZhbEJUQxgGIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbkIIyN4fAJjfHwEi3AEKD0Nyb3NzY2hhaW5GbGFncxIYChBpc0luYm91bmRFbmFibGVkGAEgASgIEhkKEWlzT3V0Ym91bmRFbmFibGVkGAIgASgIElEKFWdhc1ByaWNlSW5jcmVhc2VGbGFncxgDIAEoCzIyLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5HYXNQcmljZUluY3JlYXNlRmxhZ3MSFwoPaXNWMlpldGFFbmFibGVkGAQgASgIEigKIGlzUGFyZW50UmV2ZXJ0SW5oZXJpdGFuY2VFbmFibGVkGAUgASgIIp8BChVMZWdhY3lDcm9zc2NoYWluRmxhZ3MSGAoQaXNJbmJvdW5kRW5hYmxlZBgBIAEoCBIZChFpc091dGJvdW5kRW5hYmxlZBgCIAEoCBJRChVnYXNQcmljZUluY3JlYXNlRmxhZ3MYAyABKAsyMi56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuR2FzUHJpY2VJbmNyZWFzZUZsYWdzQvIBCh9jb20uemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyQhRDcm9zc2NoYWluRmxhZ3NQcm90b1ABWitnaXRodWIuY29tL3pldGEtY2hhaW4vbm9kZS94L29ic2VydmVyL3R5cGVzogIDWlpPqgIbWmV0YWNoYWluLlpldGFjb3JlLk9ic2VydmVyygIbWmV0YWNoYWluXFpldGFjb3JlXE9ic2VydmVy4gInWmV0YWNoYWluXFpldGFjb3JlXE9ic2VydmVyXEdQQk1ldGFkYXRh6gIdWmV0YWNoYWluOjpaZXRhY29yZTo6T2JzZXJ2ZXJiBnByb3RvMw", [file_gogoproto_gogo, file_google_protobuf_duration]);
 
 /**
  * @generated from message zetachain.zetacore.observer.GasPriceIncreaseFlags
@@ -88,6 +88,15 @@ export type CrosschainFlags = Message<"zetachain.zetacore.observer.CrosschainFla
    * @generated from field: bool isV2ZetaEnabled = 4;
    */
   isV2ZetaEnabled: boolean;
+
+  /**
+   * Enables a failed withdraw created by the ZEVM execution of another cctx,
+   * without revert options, to be reverted with the revert options of the
+   * parent cctx instead of refunding the calling contract
+   *
+   * @generated from field: bool isParentRevertInheritanceEnabled = 5;
+   */
+  isParentRevertInheritanceEnabled: boolean;
 };
 
 /**
@@ -14,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file zetachain/zetacore/observer/events.proto.
  */
 export const file_zetachain_zetacore_observer_events: GenFile = /*@__PURE__*/
-  fileDesc("Cih6ZXRhY2hhaW4vemV0YWNvcmUvb2JzZXJ2ZXIvZXZlbnRzLnByb3RvEht6ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIijwEKEkV2ZW50QmFsbG90Q3JlYXRlZBIUCgxtc2dfdHlwZV91cmwYASABKAkSGQoRYmFsbG90X2lkZW50aWZpZXIYAiABKAkSGAoQb2JzZXJ2YXRpb25faGFzaBgDIAEoCRIZChFvYnNlcnZhdGlvbl9jaGFpbhgEIAEoCRITCgtiYWxsb3RfdHlwZRgFIAEoCSJdChdFdmVudEtleWdlbkJsb2NrVXBkYXRlZBIUCgxtc2dfdHlwZV91cmwYASABKAkSFAoMa2V5Z2VuX2Jsb2NrGAIgASgJEhYKDmtleWdlbl9wdWJrZXlzGAMgASgJIrEBChVFdmVudE5ld09ic2VydmVyQWRkZWQSFAoMbXNnX3R5cGVfdXJsGAEgASgJEhgKEG9ic2VydmVyX2FkZHJlc3MYAiABKAkSIgoaemV0YWNsaWVudF9ncmFudGVlX2FkZHJlc3MYAyABKAkSIQoZemV0YWNsaWVudF9ncmFudGVlX3B1YmtleRgEIAEoCRIhChlvYnNlcnZlcl9sYXN0X2Jsb2NrX2NvdW50GAUgASgEIl4KEUV2ZW50Q0NUWERpc2FibGVkEhQKDG1zZ190eXBlX3VybBgBIAEoCRIYChBpc0luYm91bmRFbmFibGVkGAIgASgIEhkKEWlzT3V0Ym91bmRFbmFibGVkGAMgASgIIl0KEEV2ZW50Q0NUWEVuYWJsZWQSFAoMbXNnX3R5cGVfdXJsGAEgASgJEhgKEGlzSW5ib3VuZEVuYWJsZWQYAiABKAgSGQoRaXNPdXRib3VuZEVuYWJsZWQYAyABKAgijAEKIUV2ZW50R2FzUHJpY2VJbmNyZWFzZUZsYWdzVXBkYXRlZBIUCgxtc2dfdHlwZV91cmwYASABKAkSUQoVZ2FzUHJpY2VJbmNyZWFzZUZsYWdzGAIgASgLMjIuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLkdhc1ByaWNlSW5jcmVhc2VGbGFnc0LpAQofY29tLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlckILRXZlbnRzUHJvdG9QAVorZ2l0aHViLmNvbS96ZXRhLWNoYWluL25vZGUveC9vYnNlcnZlci90eXBlc6ICA1paT6oCG1pldGFjaGFpbi5aZXRhY29yZS5PYnNlcnZlcsoCG1pldGFjaGFpblxaZXRhY29yZVxPYnNlcnZlcuICJ1pldGFjaGFpblxaZXRhY29yZVxPYnNlcnZlclxHUEJNZXRhZGF0YeoCHVpldGFjaGFpbjo6WmV0YWNvcmU6Ok9ic2VydmVyYgZwcm90bzM", [file_gogoproto_gogo, file_zetachain_zetacore_observer_crosschain_flags, file_zetachain_zetacore_observer_observer]);
+  fileDesc("Cih6ZXRhY2hhaW4vemV0YWNvcmUvb2JzZXJ2ZXIvZXZlbnRzLnByb3RvEht6ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIijwEKEkV2ZW50QmFsbG90Q3JlYXRlZBIUCgxtc2dfdHlwZV91cmwYASABKAkSGQoRYmFsbG90X2lkZW50aWZpZXIYAiABKAkSGAoQb2JzZXJ2YXRpb25faGFzaBgDIAEoCRIZChFvYnNlcnZhdGlvbl9jaGFpbhgEIAEoCRITCgtiYWxsb3RfdHlwZRgFIAEoCSJdChdFdmVudEtleWdlbkJsb2NrVXBkYXRlZBIUCgxtc2dfdHlwZV91cmwYASABKAkSFAoMa2V5Z2VuX2Jsb2NrGAIgASgJEhYKDmtleWdlbl9wdWJrZXlzGAMgASgJIrEBChVFdmVudE5ld09ic2VydmVyQWRkZWQSFAoMbXNnX3R5cGVfdXJsGAEgASgJEhgKEG9ic2VydmVyX2FkZHJlc3MYAiABKAkSIgoaemV0YWNsaWVudF9ncmFudGVlX2FkZHJlc3MYAyABKAkSIQoZemV0YWNsaWVudF9ncmFudGVlX3B1YmtleRgEIAEoCRIhChlvYnNlcnZlcl9sYXN0X2Jsb2NrX2NvdW50GAUgASgEIl4KEUV2ZW50Q0NUWERpc2FibGVkEhQKDG1zZ190eXBlX3VybBgBIAEoCRIYChBpc0luYm91bmRFbmFibGVkGAIgASgIEhkKEWlzT3V0Ym91bmRFbmFibGVkGAMgASgIIl0KEEV2ZW50Q0NUWEVuYWJsZWQSFAoMbXNnX3R5cGVfdXJsGAEgASgJEhgKEGlzSW5ib3VuZEVuYWJsZWQYAiABKAgSGQoRaXNPdXRib3VuZEVuYWJsZWQYAyABKAgijAEKIUV2ZW50R2FzUHJpY2VJbmNyZWFzZUZsYWdzVXBkYXRlZBIUCgxtc2dfdHlwZV91cmwYASABKAkSUQoVZ2FzUHJpY2VJbmNyZWFzZUZsYWdzGAIgASgLMjIuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLkdhc1ByaWNlSW5jcmVhc2VGbGFncyJ1Ch1FdmVudE9ic2VydmVyU2V0Q2hhbmdlVXBkYXRlZBIOCgZzdGF0dXMYASABKAkSFQoNa2V5Z2VuX2hlaWdodBgCIAEoAxIZChFhY3RpdmF0aW9uX2hlaWdodBgDIAEoAxISCgp0c3NfcHVia2V5GAQgASgJImQKFUV2ZW50T2JzZXJ2ZXJMaXZlbmVzcxIYChBvYnNlcnZlcl9hZGRyZXNzGAEgASgJEhkKEWJhbGxvdF9pZGVudGlmaWVyGAIgASgJEhYKDm1pc3NlZF9iYWxsb3RzGAMgASgDIl0KE0V2ZW50T2JzZXJ2ZXJKYWlsZWQSGAoQb2JzZXJ2ZXJfYWRkcmVzcxgBIAEoCRIWCg5taXNzZWRfYmFsbG90cxgCIAEoAxIUCgxqYWlsZWRfdW50aWwYAyABKAMiMQoVRXZlbnRPYnNlcnZlclVuamFpbGVkEhgKEG9ic2VydmVyX2FkZHJlc3MYASABKAlC6QEKH2NvbS56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXJCC0V2ZW50c1Byb3RvUAFaK2dpdGh1Yi5jb20vemV0YS1jaGFpbi9ub2RlL3gvb2JzZXJ2ZXIvdHlwZXOiAgNaWk+qAhtaZXRhY2hhaW4uWmV0YWNvcmUuT2JzZXJ2ZXLKAhtaZXRhY2hhaW5cWmV0YWNvcmVcT2JzZXJ2ZXLiAidaZXRhY2hhaW5cWmV0YWNvcmVcT2JzZXJ2ZXJcR1BCTWV0YWRhdGHqAh1aZXRhY2hhaW46OlpldGFjb3JlOjpPYnNlcnZlcmIGcHJvdG8z", [file_gogoproto_gogo, file_zetachain_zetacore_observer_crosschain_flags, file_zetachain_zetacore_observer_observer]);
 
 /**
  * @generated from message zetachain.zetacore.observer.EventBallotCreated
@@ -193,3 +193,106 @@ export type EventGasPriceIncreaseFlagsUpdated = Message<"zetachain.zetacore.obse
 export const EventGasPriceIncreaseFlagsUpdatedSchema: GenMessage<EventGasPriceIncreaseFlagsUpdated> = /*@__PURE__*/
   messageDesc(file_zetachain_zetacore_observer_events, 5);
 
+/**
+ * @generated from message zetachain.zetacore.observer.EventObserverSetChangeUpdated
+ */
+export type EventObserverSetChangeUpdated = Message<"zetachain.zetacore.observer.EventObserverSetChangeUpdated"> & {
+  /**
+   * @generated from field: string status = 1;
+   */
+  status: string;
+
+  /**
+   * @generated from field: int64 keygen_height = 2;
+   */
+  keygenHeight: bigint;
+
+  /**
+   * @generated from field: int64 activation_height = 3;
+   */
+  activationHeight: bigint;
+
+  /**
+   * @generated from field: string tss_pubkey = 4;
+   */
+  tssPubkey: string;
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.EventObserverSetChangeUpdated.
+ * Use `create(EventObserverSetChangeUpdatedSchema)` to create a new message.
+ */
+export const EventObserverSetChangeUpdatedSchema: GenMessage<EventObserverSetChangeUpdated> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_events, 6);
+
+/**
+ * @generated from message zetachain.zetacore.observer.EventObserverLiveness
+ */
+export type EventObserverLiveness = Message<"zetachain.zetacore.observer.EventObserverLiveness"> & {
+  /**
+   * @generated from field: string observer_address = 1;
+   */
+  observerAddress: string;
+
+  /**
+   * @generated from field: string ballot_identifier = 2;
+   */
+  ballotIdentifier: string;
+
+  /**
+   * @generated from field: int64 missed_ballots = 3;
+   */
+  missedBallots: bigint;
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.EventObserverLiveness.
+ * Use `create(EventObserverLivenessSchema)` to create a new message.
+ */
+export const EventObserverLivenessSchema: GenMessage<EventObserverLiveness> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_events, 7);
+
+/**
+ * @generated from message zetachain.zetacore.observer.EventObserverJailed
+ */
+export type EventObserverJailed = Message<"zetachain.zetacore.observer.EventObserverJailed"> & {
+  /**
+   * @generated from field: string observer_address = 1;
+   */
+  observerAddress: string;
+
+  /**
+   * @generated from field: int64 missed_ballots = 2;
+   */
+  missedBallots: bigint;
+
+  /**
+   * @generated from field: int64 jailed_until = 3;
+   */
+  jailedUntil: bigint;
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.EventObserverJailed.
+ * Use `create(EventObserverJailedSchema)` to create a new message.
+ */
+export const EventObserverJailedSchema: GenMessage<EventObserverJailed> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_events, 8);
+
+/**
+ * @generated from message zetachain.zetacore.observer.EventObserverUnjailed
+ */
+export type EventObserverUnjailed = Message<"zetachain.zetacore.observer.EventObserverUnjailed"> & {
+  /**
+   * @generated from field: string observer_address = 1;
+   */
+  observerAddress: string;
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.EventObserverUnjailed.
+ * Use `create(EventObserverUnjailedSchema)` to create a new message.
+ */
+export const EventObserverUnjailedSchema: GenMessage<EventObserverUnjailed> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_events, 9);
+
@@ -15,12 +15,16 @@ import type { CrosschainFlags } from "./crosschain_flags_pb";
 import { file_zetachain_zetacore_observer_crosschain_flags } from "./crosschain_flags_pb";
 import type { Keygen } from "./keygen_pb";
 import { file_zetachain_zetacore_observer_keygen } from "./keygen_pb";
+import type { LivenessParams, ObserverSigningInfo } from "./liveness_pb";
+import { file_zetachain_zetacore_observer_liveness } from "./liveness_pb";
 import type { NodeAccount } from "./node_account_pb";
 import { file_zetachain_zetacore_observer_node_account } from "./node_account_pb";
 import type { NonceToCctx } from "./nonce_to_cctx_pb";
 import { file_zetachain_zetacore_observer_nonce_to_cctx } from "./nonce_to_cctx_pb";
 import type { LastObserverCount, ObserverSet } from "./observer_pb";
 import { file_zetachain_zetacore_observer_observer } from "./observer_pb";
+import type { ObserverSetChange } from "./observer_set_change_pb";
+import { file_zetachain_zetacore_observer_observer_set_change } from "./observer_set_change_pb";
 import type { ChainParamsList } from "./chain_params_pb";
 import { file_zetachain_zetacore_observer_chain_params } from "./chain_params_pb";
 import type { PendingNonces } from "./pending_nonces_pb";
@@ -37,7 +41,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file zetachain/zetacore/observer/genesis.proto.
  */
 export const file_zetachain_zetacore_observer_genesis: GenFile = /*@__PURE__*/
-  fileDesc("Cil6ZXRhY2hhaW4vemV0YWNvcmUvb2JzZXJ2ZXIvZ2VuZXNpcy5wcm90bxIbemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyIpYICgxHZW5lc2lzU3RhdGUSNAoHYmFsbG90cxgBIAMoCzIjLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5CYWxsb3QSQQoJb2JzZXJ2ZXJzGAIgASgLMiguemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk9ic2VydmVyU2V0QgTI3h8AEkEKD25vZGVBY2NvdW50TGlzdBgDIAMoCzIoLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Ob2RlQWNjb3VudBJGChBjcm9zc2NoYWluX2ZsYWdzGAQgASgLMiwuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLkNyb3NzY2hhaW5GbGFncxIzCgZrZXlnZW4YBiABKAsyIy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuS2V5Z2VuEksKE2xhc3Rfb2JzZXJ2ZXJfY291bnQYByABKAsyLi56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTGFzdE9ic2VydmVyQ291bnQSTQoRY2hhaW5fcGFyYW1zX2xpc3QYCCABKAsyLC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuQ2hhaW5QYXJhbXNMaXN0QgTI3h8AEi0KA3RzcxgJIAEoCzIgLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5UU1MSOwoLdHNzX2hpc3RvcnkYCiADKAsyIC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuVFNTQgTI3h8AElIKEnRzc19mdW5kX21pZ3JhdG9ycxgLIAMoCzIwLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Uc3NGdW5kTWlncmF0b3JJbmZvQgTI3h8AEjwKCmJsYW1lX2xpc3QYDCADKAsyIi56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuQmxhbWVCBMjeHwASSAoOcGVuZGluZ19ub25jZXMYDSADKAsyKi56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUGVuZGluZ05vbmNlc0IEyN4fABJECgxjaGFpbl9ub25jZXMYDiADKAsyKC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuQ2hhaW5Ob25jZXNCBMjeHwASRQoNbm9uY2VfdG9fY2N0eBgPIAMoCzIoLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Ob25jZVRvQ2N0eEIEyN4fABJOChFvcGVyYXRpb25hbF9mbGFncxgQIAEoCzItLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5PcGVyYXRpb25hbEZsYWdzQgTI3h8ASgQIBRAGUgZwYXJhbXNC6gEKH2NvbS56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXJCDEdlbmVzaXNQcm90b1ABWitnaXRodWIuY29tL3pldGEtY2hhaW4vbm9kZS94L29ic2VydmVyL3R5cGVzogIDWlpPqgIbWmV0YWNoYWluLlpldGFjb3JlLk9ic2VydmVyygIbWmV0YWNoYWluXFpldGFjb3JlXE9ic2VydmVy4gInWmV0YWNoYWluXFpldGFjb3JlXE9ic2VydmVyXEdQQk1ldGFkYXRh6gIdWmV0YWNoYWluOjpaZXRhY29yZTo6T2JzZXJ2ZXJiBnByb3RvMw", [file_gogoproto_gogo, file_zetachain_zetacore_observer_ballot, file_zetachain_zetacore_observer_blame, file_zetachain_zetacore_observer_chain_nonces, file_zetachain_zetacore_observer_crosschain_flags, file_zetachain_zetacore_observer_keygen, file_zetachain_zetacore_observer_node_account, file_zetachain_zetacore_observer_nonce_to_cctx, file_zetachain_zetacore_observer_observer, file_zetachain_zetacore_observer_chain_params, file_zetachain_zetacore_observer_pending_nonces, file_zetachain_zetacore_observer_tss, file_zetachain_zetacore_observer_tss_funds_migrator, file_zetachain_zetacore_observer_operational]);
+  fileDesc("Cil6ZXRhY2hhaW4vemV0YWNvcmUvb2JzZXJ2ZXIvZ2VuZXNpcy5wcm90bxIbemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyIocKCgxHZW5lc2lzU3RhdGUSNAoHYmFsbG90cxgBIAMoCzIjLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5CYWxsb3QSQQoJb2JzZXJ2ZXJzGAIgASgLMiguemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk9ic2VydmVyU2V0QgTI3h8AEkEKD25vZGVBY2NvdW50TGlzdBgDIAMoCzIoLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Ob2RlQWNjb3VudBJGChBjcm9zc2NoYWluX2ZsYWdzGAQgASgLMiwuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLkNyb3NzY2hhaW5GbGFncxIzCgZrZXlnZW4YBiABKAsyIy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuS2V5Z2VuEksKE2xhc3Rfb2JzZXJ2ZXJfY291bnQYByABKAsyLi56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTGFzdE9ic2VydmVyQ291bnQSTQoRY2hhaW5fcGFyYW1zX2xpc3QYCCABKAsyLC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuQ2hhaW5QYXJhbXNMaXN0QgTI3h8AEi0KA3RzcxgJIAEoCzIgLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5UU1MSOwoLdHNzX2hpc3RvcnkYCiADKAsyIC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuVFNTQgTI3h8AElIKEnRzc19mdW5kX21pZ3JhdG9ycxgLIAMoCzIwLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Uc3NGdW5kTWlncmF0b3JJbmZvQgTI3h8AEjwKCmJsYW1lX2xpc3QYDCADKAsyIi56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuQmxhbWVCBMjeHwASSAoOcGVuZGluZ19ub25jZXMYDSADKAsyKi56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUGVuZGluZ05vbmNlc0IEyN4fABJECgxjaGFpbl9ub25jZXMYDiADKAsyKC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuQ2hhaW5Ob25jZXNCBMjeHwASRQoNbm9uY2VfdG9fY2N0eBgPIAMoCzIoLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Ob25jZVRvQ2N0eEIEyN4fABJOChFvcGVyYXRpb25hbF9mbGFncxgQIAEoCzItLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5PcGVyYXRpb25hbEZsYWdzQgTI3h8AEksKE29ic2VydmVyX3NldF9jaGFuZ2UYESABKAsyLi56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuT2JzZXJ2ZXJTZXRDaGFuZ2USVgoWb2JzZXJ2ZXJfc2lnbmluZ19pbmZvcxgSIAMoCzIwLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5PYnNlcnZlclNpZ25pbmdJbmZvQgTI3h8AEkoKD2xpdmVuZXNzX3BhcmFtcxgTIAEoCzIrLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5MaXZlbmVzc1BhcmFtc0IEyN4fAEoECAUQBlIGcGFyYW1zQuoBCh9jb20uemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyQgxHZW5lc2lzUHJvdG9QAVorZ2l0aHViLmNvbS96ZXRhLWNoYWluL25vZGUveC9vYnNlcnZlci90eXBlc6ICA1paT6oCG1pldGFjaGFpbi5aZXRhY29yZS5PYnNlcnZlcsoCG1pldGFjaGFpblxaZXRhY29yZVxPYnNlcnZlcuICJ1pldGFjaGFpblxaZXRhY29yZVxPYnNlcnZlclxHUEJNZXRhZGF0YeoCHVpldGFjaGFpbjo6WmV0YWNvcmU6Ok9ic2VydmVyYgZwcm90bzM", [file_gogoproto_gogo, file_zetachain_zetacore_observer_ballot, file_zetachain_zetacore_observer_blame, file_zetachain_zetacore_observer_chain_nonces, file_zetachain_zetacore_observer_crosschain_flags, file_zetachain_zetacore_observer_keygen, file_zetachain_zetacore_observer_liveness, file_zetachain_zetacore_observer_node_account, file_zetachain_zetacore_observer_nonce_to_cctx, file_zetachain_zetacore_observer_observer, file_zetachain_zetacore_observer_observer_set_change, file_zetachain_zetacore_observer_chain_params, file_zetachain_zetacore_observer_pending_nonces, file_zetachain_zetacore_observer_tss, file_zetachain_zetacore_observer_tss_funds_migrator, file_zetachain_zetacore_observer_operational]);
 
 /**
  * @generated from message zetachain.zetacore.observer.GenesisState
@@ -117,6 +121,21 @@ export type GenesisState = Message<"zetachain.zetacore.observer.GenesisState"> &
    * @generated from field: zetachain.zetacore.observer.OperationalFlags operational_flags = 16;
    */
   operationalFlags?: OperationalFlags;
+
+  /**
+   * @generated from field: zetachain.zetacore.observer.ObserverSetChange observer_set_change = 17;
+   */
+  observerSetChange?: ObserverSetChange;
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.ObserverSigningInfo observer_signing_infos = 18;
+   */
+  observerSigningInfos: ObserverSigningInfo[];
+
+  /**
+   * @generated from field: zetachain.zetacore.observer.LivenessParams liveness_params = 19;
+   */
+  livenessParams?: LivenessParams;
 };
 
 /**
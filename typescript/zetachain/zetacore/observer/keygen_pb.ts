@@ -11,7 +11,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file zetachain/zetacore/observer/keygen.proto.
  */
 export const file_zetachain_zetacore_observer_keygen: GenFile = /*@__PURE__*/
-  fileDesc("Cih6ZXRhY2hhaW4vemV0YWNvcmUvb2JzZXJ2ZXIva2V5Z2VuLnByb3RvEht6ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIicAoGS2V5Z2VuEjkKBnN0YXR1cxgCIAEoDjIpLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5LZXlnZW5TdGF0dXMSFgoOZ3JhbnRlZVB1YmtleXMYAyADKAkSEwoLYmxvY2tOdW1iZXIYBCABKAMqTAoMS2V5Z2VuU3RhdHVzEhEKDVBlbmRpbmdLZXlnZW4QABIRCg1LZXlHZW5TdWNjZXNzEAESEAoMS2V5R2VuRmFpbGVkEAMaBKikHgFC6QEKH2NvbS56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXJCC0tleWdlblByb3RvUAFaK2dpdGh1Yi5jb20vemV0YS1jaGFpbi9ub2RlL3gvb2JzZXJ2ZXIvdHlwZXOiAgNaWk+qAhtaZXRhY2hhaW4uWmV0YWNvcmUuT2JzZXJ2ZXLKAhtaZXRhY2hhaW5cWmV0YWNvcmVcT2JzZXJ2ZXLiAidaZXRhY2hhaW5cWmV0YWNvcmVcT2JzZXJ2ZXJcR1BCTWV0YWRhdGHqAh1aZXRhY2hhaW46OlpldGFjb3JlOjpPYnNlcnZlcmIGcHJvdG8z", [file_gogoproto_gogo]);
+  fileDesc("Cih6ZXRhY2hhaW4vemV0YWNvcmUvb2JzZXJ2ZXIva2V5Z2VuLnByb3RvEht6ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIifwoGS2V5Z2VuEjkKBnN0YXR1cxgCIAEoDjIpLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5LZXlnZW5TdGF0dXMSFgoOZ3JhbnRlZVB1YmtleXMYAyADKAkSEwoLYmxvY2tOdW1iZXIYBCABKAMSDQoFZWRkc2EYBSABKAgqTAoMS2V5Z2VuU3RhdHVzEhEKDVBlbmRpbmdLZXlnZW4QABIRCg1LZXlHZW5TdWNjZXNzEAESEAoMS2V5R2VuRmFpbGVkEAMaBKikHgFC6QEKH2NvbS56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXJCC0tleWdlblByb3RvUAFaK2dpdGh1Yi5jb20vemV0YS1jaGFpbi9ub2RlL3gvb2JzZXJ2ZXIvdHlwZXOiAgNaWk+qAhtaZXRhY2hhaW4uWmV0YWNvcmUuT2JzZXJ2ZXLKAhtaZXRhY2hhaW5cWmV0YWNvcmVcT2JzZXJ2ZXLiAidaZXRhY2hhaW5cWmV0YWNvcmVcT2JzZXJ2ZXJcR1BCTWV0YWRhdGHqAh1aZXRhY2hhaW46OlpldGFjb3JlOjpPYnNlcnZlcmIGcHJvdG8z", [file_gogoproto_gogo]);
 
 /**
  * @generated from message zetachain.zetacore.observer.Keygen
@@ -35,6 +35,13 @@ export type Keygen = Message<"zetachain.zetacore.observer.Keygen"> & {
    * @generated from field: int64 blockNumber = 4;
    */
   blockNumber: bigint;
+
+  /**
+   * generate an ed25519 key alongside the secp256k1 key
+   *
+   * @generated from field: bool eddsa = 5;
+   */
+  eddsa: boolean;
 };
 
 /**
@@ -0,0 +1,131 @@
+// @generated by protoc-gen-es v2.6.3 with parameter "target=ts"
+// @generated from file zetachain/zetacore/observer/liveness.proto (package zetachain.zetacore.observer, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file zetachain/zetacore/observer/liveness.proto.
+ */
+export const file_zetachain_zetacore_observer_liveness: GenFile = /*@__PURE__*/
+  fileDesc("Cip6ZXRhY2hhaW4vemV0YWNvcmUvb2JzZXJ2ZXIvbGl2ZW5lc3MucHJvdG8SG3pldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlciLAAQoTT2JzZXJ2ZXJTaWduaW5nSW5mbxIYChBvYnNlcnZlcl9hZGRyZXNzGAEgASgJEhQKDHN0YXJ0X2hlaWdodBgCIAEoAxIUCgxpbmRleF9vZmZzZXQYAyABKAMSHgoWbWlzc2VkX2JhbGxvdHNfY291bnRlchgEIAEoAxIdChVtaXNzZWRfYmFsbG90c19iaXRtYXAYBSABKAwSDgoGamFpbGVkGAYgASgIEhQKDGphaWxlZF91bnRpbBgHIAEoAyKDAQoOTGl2ZW5lc3NQYXJhbXMSDwoHZW5hYmxlZBgBIAEoCBIOCgZ3aW5kb3cYAiABKAMSGQoRbWluX3ZvdGVkX2JhbGxvdHMYAyABKAMSFQoNamFpbF9kdXJhdGlvbhgEIAEoAxIeChZiYWxsb3RfbWF0dXJpdHlfYmxvY2tzGAUgASgDQusBCh9jb20uemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyQg1MaXZlbmVzc1Byb3RvUAFaK2dpdGh1Yi5jb20vemV0YS1jaGFpbi9ub2RlL3gvb2JzZXJ2ZXIvdHlwZXOiAgNaWk+qAhtaZXRhY2hhaW4uWmV0YWNvcmUuT2JzZXJ2ZXLKAhtaZXRhY2hhaW5cWmV0YWNvcmVcT2JzZXJ2ZXLiAidaZXRhY2hhaW5cWmV0YWNvcmVcT2JzZXJ2ZXJcR1BCTWV0YWRhdGHqAh1aZXRhY2hhaW46OlpldGFjb3JlOjpPYnNlcnZlcmIGcHJvdG8z");
+
+/**
+ * ObserverSigningInfo tracks the participation of an observer in the ballots
+ * it is a voter of, over a sliding window of ballots
+ *
+ * @generated from message zetachain.zetacore.observer.ObserverSigningInfo
+ */
+export type ObserverSigningInfo = Message<"zetachain.zetacore.observer.ObserverSigningInfo"> & {
+  /**
+   * @generated from field: string observer_address = 1;
+   */
+  observerAddress: string;
+
+  /**
+   * height at which the tracking of the current window started
+   *
+   * @generated from field: int64 start_height = 2;
+   */
+  startHeight: bigint;
+
+  /**
+   * number of ballots tracked since the start height, the position of a
+   * ballot in the window is index_offset modulo the window size
+   *
+   * @generated from field: int64 index_offset = 3;
+   */
+  indexOffset: bigint;
+
+  /**
+   * number of ballots missed in the current window
+   *
+   * @generated from field: int64 missed_ballots_counter = 4;
+   */
+  missedBallotsCounter: bigint;
+
+  /**
+   * bit array of the ballots missed in the current window
+   *
+   * @generated from field: bytes missed_ballots_bitmap = 5;
+   */
+  missedBallotsBitmap: Uint8Array;
+
+  /**
+   * @generated from field: bool jailed = 6;
+   */
+  jailed: boolean;
+
+  /**
+   * height from which the observer can be unjailed
+   *
+   * @generated from field: int64 jailed_until = 7;
+   */
+  jailedUntil: bigint;
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.ObserverSigningInfo.
+ * Use `create(ObserverSigningInfoSchema)` to create a new message.
+ */
+export const ObserverSigningInfoSchema: GenMessage<ObserverSigningInfo> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_liveness, 0);
+
+/**
+ * LivenessParams are the parameters of the tracking of the participation of
+ * the observers in the ballots. The tracking and the jailing of the observers
+ * are disabled by default.
+ *
+ * @generated from message zetachain.zetacore.observer.LivenessParams
+ */
+export type LivenessParams = Message<"zetachain.zetacore.observer.LivenessParams"> & {
+  /**
+   * enables the tracking of the participation and the jailing of the
+   * observers missing too many ballots
+   *
+   * @generated from field: bool enabled = 1;
+   */
+  enabled: boolean;
+
+  /**
+   * number of ballots over which the participation of an observer is tracked
+   *
+   * @generated from field: int64 window = 2;
+   */
+  window: bigint;
+
+  /**
+   * minimum number of ballots an observer must vote on in the window, the
+   * observer is jailed if it misses more than window - min_voted_ballots
+   * ballots
+   *
+   * @generated from field: int64 min_voted_ballots = 3;
+   */
+  minVotedBallots: bigint;
+
+  /**
+   * number of blocks an observer is jailed for before it can be unjailed
+   *
+   * @generated from field: int64 jail_duration = 4;
+   */
+  jailDuration: bigint;
+
+  /**
+   * number of blocks after the creation of a ballot at which the
+   * participation of the voters is tracked, it can't exceed the emissions
+   * ballot maturity blocks since the matured ballots are deleted
+   *
+   * @generated from field: int64 ballot_maturity_blocks = 5;
+   */
+  ballotMaturityBlocks: bigint;
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.LivenessParams.
+ * Use `create(LivenessParamsSchema)` to create a new message.
+ */
+export const LivenessParamsSchema: GenMessage<LivenessParams> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_liveness, 1);
+
@@ -0,0 +1,144 @@
+// @generated by protoc-gen-es v2.6.3 with parameter "target=ts"
+// @generated from file zetachain/zetacore/observer/observer_set_change.proto (package zetachain.zetacore.observer, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_gogoproto_gogo } from "../../../gogoproto/gogo_pb";
+import type { Keygen } from "./keygen_pb";
+import { file_zetachain_zetacore_observer_keygen } from "./keygen_pb";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file zetachain/zetacore/observer/observer_set_change.proto.
+ */
+export const file_zetachain_zetacore_observer_observer_set_change: GenFile = /*@__PURE__*/
+  fileDesc("CjV6ZXRhY2hhaW4vemV0YWNvcmUvb2JzZXJ2ZXIvb2JzZXJ2ZXJfc2V0X2NoYW5nZS5wcm90bxIbemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyIlIKE09ic2VydmVyU2V0QWRkaXRpb24SGAoQb2JzZXJ2ZXJfYWRkcmVzcxgBIAEoCRIhChl6ZXRhY2xpZW50X2dyYW50ZWVfcHVia2V5GAIgASgJItkCChFPYnNlcnZlclNldENoYW5nZRJJCglhZGRpdGlvbnMYASADKAsyMC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuT2JzZXJ2ZXJTZXRBZGRpdGlvbkIEyN4fABIQCghyZW1vdmFscxgCIAMoCRIXCg9wcm9wb3NhbF9oZWlnaHQYAyABKAMSFQoNa2V5Z2VuX2hlaWdodBgEIAEoAxIZChFhY3RpdmF0aW9uX2hlaWdodBgFIAEoAxJECgZzdGF0dXMYBiABKA4yNC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuT2JzZXJ2ZXJTZXRDaGFuZ2VTdGF0dXMSEgoKdHNzX3B1YmtleRgHIAEoCRJCCg9wcmV2aW91c19rZXlnZW4YCCABKAsyIy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuS2V5Z2VuQgTI3h8AKmkKF09ic2VydmVyU2V0Q2hhbmdlU3RhdHVzEhEKDUtleWdlblBlbmRpbmcQABIWChJBd2FpdGluZ0FjdGl2YXRpb24QARINCglBY3RpdmF0ZWQQAhIOCgpSb2xsZWRCYWNrEAMaBKikHgFC9AEKH2NvbS56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXJCFk9ic2VydmVyU2V0Q2hhbmdlUHJvdG9QAVorZ2l0aHViLmNvbS96ZXRhLWNoYWluL25vZGUveC9vYnNlcnZlci90eXBlc6ICA1paT6oCG1pldGFjaGFpbi5aZXRhY29yZS5PYnNlcnZlcsoCG1pldGFjaGFpblxaZXRhY29yZVxPYnNlcnZlcuICJ1pldGFjaGFpblxaZXRhY29yZVxPYnNlcnZlclxHUEJNZXRhZGF0YeoCHVpldGFjaGFpbjo6WmV0YWNvcmU6Ok9ic2VydmVyYgZwcm90bzM", [file_gogoproto_gogo, file_zetachain_zetacore_observer_keygen]);
+
+/**
+ * ObserverSetAddition is an observer added by an observer set change
+ *
+ * @generated from message zetachain.zetacore.observer.ObserverSetAddition
+ */
+export type ObserverSetAddition = Message<"zetachain.zetacore.observer.ObserverSetAddition"> & {
+  /**
+   * @generated from field: string observer_address = 1;
+   */
+  observerAddress: string;
+
+  /**
+   * @generated from field: string zetaclient_grantee_pubkey = 2;
+   */
+  zetaclientGranteePubkey: string;
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.ObserverSetAddition.
+ * Use `create(ObserverSetAdditionSchema)` to create a new message.
+ */
+export const ObserverSetAdditionSchema: GenMessage<ObserverSetAddition> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_observer_set_change, 0);
+
+/**
+ * ObserverSetChange is a staged change of the observer set.
+ * A keygen for the new observer set is scheduled when the change is proposed,
+ * and the observer set is only updated once the TSS generated by this keygen
+ * is the current TSS and the activation height is reached.
+ *
+ * @generated from message zetachain.zetacore.observer.ObserverSetChange
+ */
+export type ObserverSetChange = Message<"zetachain.zetacore.observer.ObserverSetChange"> & {
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.ObserverSetAddition additions = 1;
+   */
+  additions: ObserverSetAddition[];
+
+  /**
+   * @generated from field: repeated string removals = 2;
+   */
+  removals: string[];
+
+  /**
+   * @generated from field: int64 proposal_height = 3;
+   */
+  proposalHeight: bigint;
+
+  /**
+   * @generated from field: int64 keygen_height = 4;
+   */
+  keygenHeight: bigint;
+
+  /**
+   * @generated from field: int64 activation_height = 5;
+   */
+  activationHeight: bigint;
+
+  /**
+   * @generated from field: zetachain.zetacore.observer.ObserverSetChangeStatus status = 6;
+   */
+  status: ObserverSetChangeStatus;
+
+  /**
+   * public key of the TSS generated for the new observer set
+   *
+   * @generated from field: string tss_pubkey = 7;
+   */
+  tssPubkey: string;
+
+  /**
+   * keygen replaced by the keygen for the new observer set, restored on
+   * rollback
+   *
+   * @generated from field: zetachain.zetacore.observer.Keygen previous_keygen = 8;
+   */
+  previousKeygen?: Keygen;
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.ObserverSetChange.
+ * Use `create(ObserverSetChangeSchema)` to create a new message.
+ */
+export const ObserverSetChangeSchema: GenMessage<ObserverSetChange> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_observer_set_change, 1);
+
+/**
+ * @generated from enum zetachain.zetacore.observer.ObserverSetChangeStatus
+ */
+export enum ObserverSetChangeStatus {
+  /**
+   * keygen for the new observer set is scheduled or running
+   *
+   * @generated from enum value: KeygenPending = 0;
+   */
+  KeygenPending = 0,
+
+  /**
+   * keygen succeeded, waiting for the activation height and the new TSS to
+   * become the current TSS
+   *
+   * @generated from enum value: AwaitingActivation = 1;
+   */
+  AwaitingActivation = 1,
+
+  /**
+   * the new observer set is active
+   *
+   * @generated from enum value: Activated = 2;
+   */
+  Activated = 2,
+
+  /**
+   * keygen failed or did not complete before the activation height, or the
+   * change was cancelled
+   *
+   * @generated from enum value: RolledBack = 3;
+   */
+  RolledBack = 3,
+}
+
+/**
+ * Describes the enum zetachain.zetacore.observer.ObserverSetChangeStatus.
+ */
+export const ObserverSetChangeStatusSchema: GenEnum<ObserverSetChangeStatus> = /*@__PURE__*/
+  enumDesc(file_zetachain_zetacore_observer_observer_set_change, 0);
+
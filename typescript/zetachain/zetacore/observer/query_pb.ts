@@ -10,7 +10,7 @@ import { file_gogoproto_gogo } from "../../../gogoproto/gogo_pb";
 import { file_google_api_annotations } from "../../../google/api/annotations_pb";
 import type { Ballot, BallotListForHeight, BallotStatus, VoteType } from "./ballot_pb";
 import { file_zetachain_zetacore_observer_ballot } from "./ballot_pb";
-import type { Blame } from "./blame_pb";
+import type { Blame, NodeBlameStats } from "./blame_pb";
 import { file_zetachain_zetacore_observer_blame } from "./blame_pb";
 import type { ChainNonces } from "./chain_nonces_pb";
 import { file_zetachain_zetacore_observer_chain_nonces } from "./chain_nonces_pb";
@@ -18,10 +18,14 @@ import type { CrosschainFlags } from "./crosschain_flags_pb";
 import { file_zetachain_zetacore_observer_crosschain_flags } from "./crosschain_flags_pb";
 import type { Keygen } from "./keygen_pb";
 import { file_zetachain_zetacore_observer_keygen } from "./keygen_pb";
+import type { LivenessParams, ObserverSigningInfo } from "./liveness_pb";
+import { file_zetachain_zetacore_observer_liveness } from "./liveness_pb";
 import type { NodeAccount } from "./node_account_pb";
 import { file_zetachain_zetacore_observer_node_account } from "./node_account_pb";
 import type { LastObserverCount, ObservationType } from "./observer_pb";
 import { file_zetachain_zetacore_observer_observer } from "./observer_pb";
+import type { ObserverSetChange } from "./observer_set_change_pb";
+import { file_zetachain_zetacore_observer_observer_set_change } from "./observer_set_change_pb";
 import type { ChainParams, ChainParamsList } from "./chain_params_pb";
 import { file_zetachain_zetacore_observer_chain_params } from "./chain_params_pb";
 import type { PendingNonces } from "./pending_nonces_pb";
@@ -42,7 +46,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file zetachain/zetacore/observer/query.proto.
  */
 export const file_zetachain_zetacore_observer_query: GenFile = /*@__PURE__*/
-  fileDesc("Cid6ZXRhY2hhaW4vemV0YWNvcmUvb2JzZXJ2ZXIvcXVlcnkucHJvdG8SG3pldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlciJRChNRdWVyeUJhbGxvdHNSZXF1ZXN0EjoKCnBhZ2luYXRpb24YASABKAsyJi5jb3Ntb3MuYmFzZS5xdWVyeS52MWJldGExLlBhZ2VSZXF1ZXN0Io8BChRRdWVyeUJhbGxvdHNSZXNwb25zZRI6CgdiYWxsb3RzGAEgAygLMiMuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLkJhbGxvdEIEyN4fABI7CgpwYWdpbmF0aW9uGAIgASgLMicuY29zbW9zLmJhc2UucXVlcnkudjFiZXRhMS5QYWdlUmVzcG9uc2UiHgocUXVlcnlPcGVyYXRpb25hbEZsYWdzUmVxdWVzdCJvCh1RdWVyeU9wZXJhdGlvbmFsRmxhZ3NSZXNwb25zZRJOChFvcGVyYXRpb25hbF9mbGFncxgBIAEoCzItLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5PcGVyYXRpb25hbEZsYWdzQgTI3h8AIiUKI1F1ZXJ5VHNzRnVuZHNNaWdyYXRvckluZm9BbGxSZXF1ZXN0InsKJFF1ZXJ5VHNzRnVuZHNNaWdyYXRvckluZm9BbGxSZXNwb25zZRJTChN0c3NfZnVuZHNfbWlncmF0b3JzGAEgAygLMjAuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlRzc0Z1bmRNaWdyYXRvckluZm9CBMjeHwAiNAogUXVlcnlUc3NGdW5kc01pZ3JhdG9ySW5mb1JlcXVlc3QSEAoIY2hhaW5faWQYASABKAMidwohUXVlcnlUc3NGdW5kc01pZ3JhdG9ySW5mb1Jlc3BvbnNlElIKEnRzc19mdW5kc19taWdyYXRvchgBIAEoCzIwLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Uc3NGdW5kTWlncmF0b3JJbmZvQgTI3h8AIi4KGlF1ZXJ5R2V0Q2hhaW5Ob25jZXNSZXF1ZXN0EhAKCGNoYWluX2lkGAEgASgDImIKG1F1ZXJ5R2V0Q2hhaW5Ob25jZXNSZXNwb25zZRJDCgtDaGFpbk5vbmNlcxgBIAEoCzIoLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5DaGFpbk5vbmNlc0IEyN4fACJYChpRdWVyeUFsbENoYWluTm9uY2VzUmVxdWVzdBI6CgpwYWdpbmF0aW9uGAEgASgLMiYuY29zbW9zLmJhc2UucXVlcnkudjFiZXRhMS5QYWdlUmVxdWVzdCKfAQobUXVlcnlBbGxDaGFpbk5vbmNlc1Jlc3BvbnNlEkMKC0NoYWluTm9uY2VzGAEgAygLMiguemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLkNoYWluTm9uY2VzQgTI3h8AEjsKCnBhZ2luYXRpb24YAiABKAsyJy5jb3Ntb3MuYmFzZS5xdWVyeS52MWJldGExLlBhZ2VSZXNwb25zZSJaChxRdWVyeUFsbFBlbmRpbmdOb25jZXNSZXF1ZXN0EjoKCnBhZ2luYXRpb24YASABKAsyJi5jb3Ntb3MuYmFzZS5xdWVyeS52MWJldGExLlBhZ2VSZXF1ZXN0IqYBCh1RdWVyeUFsbFBlbmRpbmdOb25jZXNSZXNwb25zZRJICg5wZW5kaW5nX25vbmNlcxgBIAMoCzIqLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5QZW5kaW5nTm9uY2VzQgTI3h8AEjsKCnBhZ2luYXRpb24YAiABKAsyJy5jb3Ntb3MuYmFzZS5xdWVyeS52MWJldGExLlBhZ2VSZXNwb25zZSI0CiBRdWVyeVBlbmRpbmdOb25jZXNCeUNoYWluUmVxdWVzdBIQCghjaGFpbl9pZBgBIAEoAyJtCiFRdWVyeVBlbmRpbmdOb25jZXNCeUNoYWluUmVzcG9uc2USSAoOcGVuZGluZ19ub25jZXMYASABKAsyKi56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUGVuZGluZ05vbmNlc0IEyN4fACIUChJRdWVyeUdldFRTU1JlcXVlc3QiSgoTUXVlcnlHZXRUU1NSZXNwb25zZRIzCgNUU1MYASABKAsyIC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuVFNTQgTI3h8AIjUKGVF1ZXJ5R2V0VHNzQWRkcmVzc1JlcXVlc3QSGAoQYml0Y29pbl9jaGFpbl9pZBgCIAEoAyJDChpRdWVyeUdldFRzc0FkZHJlc3NSZXNwb25zZRILCgNldGgYASABKAkSCwoDYnRjGAIgASgJEgsKA3N1aRgDIAEoCSJlCipRdWVyeUdldFRzc0FkZHJlc3NCeUZpbmFsaXplZEhlaWdodFJlcXVlc3QSHQoVZmluYWxpemVkX3pldGFfaGVpZ2h0GAEgASgDEhgKEGJpdGNvaW5fY2hhaW5faWQYAiABKAMiVAorUXVlcnlHZXRUc3NBZGRyZXNzQnlGaW5hbGl6ZWRIZWlnaHRSZXNwb25zZRILCgNldGgYASABKAkSCwoDYnRjGAIgASgJEgsKA3N1aRgDIAEoCSJUChZRdWVyeVRzc0hpc3RvcnlSZXF1ZXN0EjoKCnBhZ2luYXRpb24YASABKAsyJi5jb3Ntb3MuYmFzZS5xdWVyeS52MWJldGExLlBhZ2VSZXF1ZXN0IpABChdRdWVyeVRzc0hpc3RvcnlSZXNwb25zZRI4Cgh0c3NfbGlzdBgBIAMoCzIgLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5UU1NCBMjeHwASOwoKcGFnaW5hdGlvbhgCIAEoCzInLmNvc21vcy5iYXNlLnF1ZXJ5LnYxYmV0YTEuUGFnZVJlc3BvbnNlIkgKFFF1ZXJ5SGFzVm90ZWRSZXF1ZXN0EhkKEWJhbGxvdF9pZGVudGlmaWVyGAEgASgJEhUKDXZvdGVyX2FkZHJlc3MYAiABKAkiKgoVUXVlcnlIYXNWb3RlZFJlc3BvbnNlEhEKCWhhc192b3RlZBgBIAEoCCI7Ch5RdWVyeUJhbGxvdEJ5SWRlbnRpZmllclJlcXVlc3QSGQoRYmFsbG90X2lkZW50aWZpZXIYASABKAkiXAoJVm90ZXJMaXN0EhUKDXZvdGVyX2FkZHJlc3MYASABKAkSOAoJdm90ZV90eXBlGAIgASgOMiUuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlZvdGVUeXBlIv4BCh9RdWVyeUJhbGxvdEJ5SWRlbnRpZmllclJlc3BvbnNlEhkKEWJhbGxvdF9pZGVudGlmaWVyGAEgASgJEjYKBnZvdGVycxgCIAMoCzImLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Wb3Rlckxpc3QSRgoQb2JzZXJ2YXRpb25fdHlwZRgDIAEoDjIsLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5PYnNlcnZhdGlvblR5cGUSQAoNYmFsbG90X3N0YXR1cxgEIAEoDjIpLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5CYWxsb3RTdGF0dXMiEgoQUXVlcnlPYnNlcnZlclNldCItChhRdWVyeU9ic2VydmVyU2V0UmVzcG9uc2USEQoJb2JzZXJ2ZXJzGAEgAygJIhYKFFF1ZXJ5U3VwcG9ydGVkQ2hhaW5zIloKHFF1ZXJ5U3VwcG9ydGVkQ2hhaW5zUmVzcG9uc2USOgoGY2hhaW5zGAEgAygLMiQuemV0YWNoYWluLnpldGFjb3JlLnBrZy5jaGFpbnMuQ2hhaW5CBMjeHwAiNgoiUXVlcnlHZXRDaGFpblBhcmFtc0ZvckNoYWluUmVxdWVzdBIQCghjaGFpbl9pZBgBIAEoAyJlCiNRdWVyeUdldENoYWluUGFyYW1zRm9yQ2hhaW5SZXNwb25zZRI+CgxjaGFpbl9wYXJhbXMYASABKAsyKC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuQ2hhaW5QYXJhbXMiHAoaUXVlcnlHZXRDaGFpblBhcmFtc1JlcXVlc3QiYQobUXVlcnlHZXRDaGFpblBhcmFtc1Jlc3BvbnNlEkIKDGNoYWluX3BhcmFtcxgBIAEoCzIsLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5DaGFpblBhcmFtc0xpc3QiKwoaUXVlcnlHZXROb2RlQWNjb3VudFJlcXVlc3QSDQoFaW5kZXgYASABKAkiXQobUXVlcnlHZXROb2RlQWNjb3VudFJlc3BvbnNlEj4KDG5vZGVfYWNjb3VudBgBIAEoCzIoLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Ob2RlQWNjb3VudCJYChpRdWVyeUFsbE5vZGVBY2NvdW50UmVxdWVzdBI6CgpwYWdpbmF0aW9uGAEgASgLMiYuY29zbW9zLmJhc2UucXVlcnkudjFiZXRhMS5QYWdlUmVxdWVzdCKZAQobUXVlcnlBbGxOb2RlQWNjb3VudFJlc3BvbnNlEj0KC05vZGVBY2NvdW50GAEgAygLMiguemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk5vZGVBY2NvdW50EjsKCnBhZ2luYXRpb24YAiABKAsyJy5jb3Ntb3MuYmFzZS5xdWVyeS52MWJldGExLlBhZ2VSZXNwb25zZSIgCh5RdWVyeUdldENyb3NzY2hhaW5GbGFnc1JlcXVlc3QibwofUXVlcnlHZXRDcm9zc2NoYWluRmxhZ3NSZXNwb25zZRJMChBjcm9zc2NoYWluX2ZsYWdzGAEgASgLMiwuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLkNyb3NzY2hhaW5GbGFnc0IEyN4fACIXChVRdWVyeUdldEtleWdlblJlcXVlc3QiTQoWUXVlcnlHZXRLZXlnZW5SZXNwb25zZRIzCgZrZXlnZW4YASABKAsyIy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuS2V5Z2VuIh8KHVF1ZXJ5U2hvd09ic2VydmVyQ291bnRSZXF1ZXN0Im0KHlF1ZXJ5U2hvd09ic2VydmVyQ291bnRSZXNwb25zZRJLChNsYXN0X29ic2VydmVyX2NvdW50GAEgASgLMi4uemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLkxhc3RPYnNlcnZlckNvdW50IjkKHVF1ZXJ5QmxhbWVCeUlkZW50aWZpZXJSZXF1ZXN0EhgKEGJsYW1lX2lkZW50aWZpZXIYASABKAkiWAoeUXVlcnlCbGFtZUJ5SWRlbnRpZmllclJlc3BvbnNlEjYKCmJsYW1lX2luZm8YASABKAsyIi56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuQmxhbWUiWQobUXVlcnlBbGxCbGFtZVJlY29yZHNSZXF1ZXN0EjoKCnBhZ2luYXRpb24YASABKAsyJi5jb3Ntb3MuYmFzZS5xdWVyeS52MWJldGExLlBhZ2VSZXF1ZXN0IpkBChxRdWVyeUFsbEJsYW1lUmVjb3Jkc1Jlc3BvbnNlEjwKCmJsYW1lX2luZm8YASADKAsyIi56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuQmxhbWVCBMjeHwASOwoKcGFnaW5hdGlvbhgCIAEoCzInLmNvc21vcy5iYXNlLnF1ZXJ5LnYxYmV0YTEuUGFnZVJlc3BvbnNlIkMKIFF1ZXJ5QmxhbWVCeUNoYWluQW5kTm9uY2VSZXF1ZXN0EhAKCGNoYWluX2lkGAEgASgDEg0KBW5vbmNlGAIgASgDIlsKIVF1ZXJ5QmxhbWVCeUNoYWluQW5kTm9uY2VSZXNwb25zZRI2CgpibGFtZV9pbmZvGAEgAygLMiIuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLkJsYW1lIjEKH1F1ZXJ5QmFsbG90TGlzdEZvckhlaWdodFJlcXVlc3QSDgoGaGVpZ2h0GAEgASgDIm8KIFF1ZXJ5QmFsbG90TGlzdEZvckhlaWdodFJlc3BvbnNlEksKC2JhbGxvdF9saXN0GAEgASgLMjAuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLkJhbGxvdExpc3RGb3JIZWlnaHRCBMjeHwAyzygKBVF1ZXJ5Er0BCghIYXNWb3RlZBIxLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeUhhc1ZvdGVkUmVxdWVzdBoyLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeUhhc1ZvdGVkUmVzcG9uc2UiSoLT5JMCRBJCL3pldGEtY2hhaW4vb2JzZXJ2ZXIvaGFzX3ZvdGVkL3tiYWxsb3RfaWRlbnRpZmllcn0ve3ZvdGVyX2FkZHJlc3N9EtYBChJCYWxsb3RCeUlkZW50aWZpZXISOy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlCYWxsb3RCeUlkZW50aWZpZXJSZXF1ZXN0GjwuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5QmFsbG90QnlJZGVudGlmaWVyUmVzcG9uc2UiRYLT5JMCPxI9L3pldGEtY2hhaW4vb2JzZXJ2ZXIvYmFsbG90X2J5X2lkZW50aWZpZXIve2JhbGxvdF9pZGVudGlmaWVyfRLQAQoTQmFsbG90TGlzdEZvckhlaWdodBI8LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeUJhbGxvdExpc3RGb3JIZWlnaHRSZXF1ZXN0Gj0uemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5QmFsbG90TGlzdEZvckhlaWdodFJlc3BvbnNlIjyC0+STAjYSNC96ZXRhLWNoYWluL29ic2VydmVyL2JhbGxvdF9saXN0X2Zvcl9oZWlnaHQve2hlaWdodH0SngEKC09ic2VydmVyU2V0Ei0uemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5T2JzZXJ2ZXJTZXQaNS56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlPYnNlcnZlclNldFJlc3BvbnNlIimC0+STAiMSIS96ZXRhLWNoYWluL29ic2VydmVyL29ic2VydmVyX3NldBKtAQoPU3VwcG9ydGVkQ2hhaW5zEjEuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5U3VwcG9ydGVkQ2hhaW5zGjkuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5U3VwcG9ydGVkQ2hhaW5zUmVzcG9uc2UiLILT5JMCJhIkL3pldGEtY2hhaW4vb2JzZXJ2ZXIvc3VwcG9ydGVkQ2hhaW5zEt8BChZHZXRDaGFpblBhcmFtc0ZvckNoYWluEj8uemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5R2V0Q2hhaW5QYXJhbXNGb3JDaGFpblJlcXVlc3QaQC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlHZXRDaGFpblBhcmFtc0ZvckNoYWluUmVzcG9uc2UiQoLT5JMCPBI6L3pldGEtY2hhaW4vb2JzZXJ2ZXIvZ2V0X2NoYWluX3BhcmFtc19mb3JfY2hhaW4ve2NoYWluX2lkfRKyAQoOR2V0Q2hhaW5QYXJhbXMSNy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlHZXRDaGFpblBhcmFtc1JlcXVlc3QaOC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlHZXRDaGFpblBhcmFtc1Jlc3BvbnNlIi2C0+STAicSJS96ZXRhLWNoYWluL29ic2VydmVyL2dldF9jaGFpbl9wYXJhbXMSsgEKC05vZGVBY2NvdW50EjcuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5R2V0Tm9kZUFjY291bnRSZXF1ZXN0GjguemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5R2V0Tm9kZUFjY291bnRSZXNwb25zZSIwgtPkkwIqEigvemV0YS1jaGFpbi9vYnNlcnZlci9ub2RlQWNjb3VudC97aW5kZXh9Eq0BCg5Ob2RlQWNjb3VudEFsbBI3LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeUFsbE5vZGVBY2NvdW50UmVxdWVzdBo4LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeUFsbE5vZGVBY2NvdW50UmVzcG9uc2UiKILT5JMCIhIgL3pldGEtY2hhaW4vb2JzZXJ2ZXIvbm9kZUFjY291bnQSuwEKD0Nyb3NzY2hhaW5GbGFncxI7LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeUdldENyb3NzY2hhaW5GbGFnc1JlcXVlc3QaPC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlHZXRDcm9zc2NoYWluRmxhZ3NSZXNwb25zZSItgtPkkwInEiUvemV0YS1jaGFpbi9vYnNlcnZlci9jcm9zc2NoYWluX2ZsYWdzEpYBCgZLZXlnZW4SMi56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlHZXRLZXlnZW5SZXF1ZXN0GjMuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5R2V0S2V5Z2VuUmVzcG9uc2UiI4LT5JMCHRIbL3pldGEtY2hhaW4vb2JzZXJ2ZXIva2V5Z2VuEscBChFTaG93T2JzZXJ2ZXJDb3VudBI6LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeVNob3dPYnNlcnZlckNvdW50UmVxdWVzdBo7LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeVNob3dPYnNlcnZlckNvdW50UmVzcG9uc2UiOYLT5JMCMxIxL3pldGEtY2hhaW4vemV0YWNvcmUvb2JzZXJ2ZXIvc2hvd19vYnNlcnZlcl9jb3VudBLRAQoRQmxhbWVCeUlkZW50aWZpZXISOi56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlCbGFtZUJ5SWRlbnRpZmllclJlcXVlc3QaOy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlCbGFtZUJ5SWRlbnRpZmllclJlc3BvbnNlIkOC0+STAj0SOy96ZXRhLWNoYWluL29ic2VydmVyL2JsYW1lX2J5X2lkZW50aWZpZXIve2JsYW1lX2lkZW50aWZpZXJ9Er0BChJHZXRBbGxCbGFtZVJlY29yZHMSOC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlBbGxCbGFtZVJlY29yZHNSZXF1ZXN0GjkuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5QWxsQmxhbWVSZWNvcmRzUmVzcG9uc2UiMoLT5JMCLBIqL3pldGEtY2hhaW4vb2JzZXJ2ZXIvZ2V0X2FsbF9ibGFtZV9yZWNvcmRzEuABChVCbGFtZXNCeUNoYWluQW5kTm9uY2USPS56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlCbGFtZUJ5Q2hhaW5BbmROb25jZVJlcXVlc3QaPi56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlCbGFtZUJ5Q2hhaW5BbmROb25jZVJlc3BvbnNlIkiC0+STAkISQC96ZXRhLWNoYWluL29ic2VydmVyL2JsYW1lX2J5X2NoYWluX2FuZF9ub25jZS97Y2hhaW5faWR9L3tub25jZX0SwQEKDUdldFRzc0FkZHJlc3MSNi56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlHZXRUc3NBZGRyZXNzUmVxdWVzdBo3LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeUdldFRzc0FkZHJlc3NSZXNwb25zZSI/gtPkkwI5EjcvemV0YS1jaGFpbi9vYnNlcnZlci9nZXRfdHNzX2FkZHJlc3Mve2JpdGNvaW5fY2hhaW5faWR9EpcCCh5HZXRUc3NBZGRyZXNzQnlGaW5hbGl6ZWRIZWlnaHQSRy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlHZXRUc3NBZGRyZXNzQnlGaW5hbGl6ZWRIZWlnaHRSZXF1ZXN0GkguemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5R2V0VHNzQWRkcmVzc0J5RmluYWxpemVkSGVpZ2h0UmVzcG9uc2UiYoLT5JMCXBJaL3pldGEtY2hhaW4vb2JzZXJ2ZXIvZ2V0X3Rzc19hZGRyZXNzX2hpc3RvcmljYWwve2ZpbmFsaXplZF96ZXRhX2hlaWdodH0ve2JpdGNvaW5fY2hhaW5faWR9EooBCgNUU1MSLy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlHZXRUU1NSZXF1ZXN0GjAuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5R2V0VFNTUmVzcG9uc2UiIILT5JMCGhIYL3pldGEtY2hhaW4vb2JzZXJ2ZXIvVFNTEqABCgpUc3NIaXN0b3J5EjMuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5VHNzSGlzdG9yeVJlcXVlc3QaNC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlUc3NIaXN0b3J5UmVzcG9uc2UiJ4LT5JMCIRIfL3pldGEtY2hhaW4vb2JzZXJ2ZXIvdHNzSGlzdG9yeRK1AQoQUGVuZGluZ05vbmNlc0FsbBI5LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeUFsbFBlbmRpbmdOb25jZXNSZXF1ZXN0GjouemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5QWxsUGVuZGluZ05vbmNlc1Jlc3BvbnNlIiqC0+STAiQSIi96ZXRhLWNoYWluL29ic2VydmVyL3BlbmRpbmdOb25jZXMSzAEKFFBlbmRpbmdOb25jZXNCeUNoYWluEj0uemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5UGVuZGluZ05vbmNlc0J5Q2hhaW5SZXF1ZXN0Gj4uemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5UGVuZGluZ05vbmNlc0J5Q2hhaW5SZXNwb25zZSI1gtPkkwIvEi0vemV0YS1jaGFpbi9vYnNlcnZlci9wZW5kaW5nTm9uY2VzL3tjaGFpbl9pZH0StQEKC0NoYWluTm9uY2VzEjcuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5R2V0Q2hhaW5Ob25jZXNSZXF1ZXN0GjguemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5R2V0Q2hhaW5Ob25jZXNSZXNwb25zZSIzgtPkkwItEisvemV0YS1jaGFpbi9vYnNlcnZlci9jaGFpbk5vbmNlcy97Y2hhaW5faWR9Eq0BCg5DaGFpbk5vbmNlc0FsbBI3LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeUFsbENoYWluTm9uY2VzUmVxdWVzdBo4LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeUFsbENoYWluTm9uY2VzUmVzcG9uc2UiKILT5JMCIhIgL3pldGEtY2hhaW4vb2JzZXJ2ZXIvY2hhaW5Ob25jZXMSxwEKFFRzc0Z1bmRzTWlncmF0b3JJbmZvEj0uemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5VHNzRnVuZHNNaWdyYXRvckluZm9SZXF1ZXN0Gj4uemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5VHNzRnVuZHNNaWdyYXRvckluZm9SZXNwb25zZSIwgtPkkwIqEigvemV0YS1jaGFpbi9vYnNlcnZlci9nZXRUc3NGdW5kc01pZ3JhdG9yEtQBChdUc3NGdW5kc01pZ3JhdG9ySW5mb0FsbBJALnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeVRzc0Z1bmRzTWlncmF0b3JJbmZvQWxsUmVxdWVzdBpBLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeVRzc0Z1bmRzTWlncmF0b3JJbmZvQWxsUmVzcG9uc2UiNILT5JMCLhIsL3pldGEtY2hhaW4vb2JzZXJ2ZXIvZ2V0QWxsVHNzRnVuZHNNaWdyYXRvcnMSuAEKEE9wZXJhdGlvbmFsRmxhZ3MSOS56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlPcGVyYXRpb25hbEZsYWdzUmVxdWVzdBo6LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeU9wZXJhdGlvbmFsRmxhZ3NSZXNwb25zZSItgtPkkwInEiUvemV0YS1jaGFpbi9vYnNlcnZlci9vcGVyYXRpb25hbEZsYWdzEpQBCgdCYWxsb3RzEjAuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5QmFsbG90c1JlcXVlc3QaMS56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlCYWxsb3RzUmVzcG9uc2UiJILT5JMCHhIcL3pldGEtY2hhaW4vb2JzZXJ2ZXIvYmFsbG90cxoFgOewKgFC6AEKH2NvbS56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXJCClF1ZXJ5UHJvdG9QAVorZ2l0aHViLmNvbS96ZXRhLWNoYWluL25vZGUveC9vYnNlcnZlci90eXBlc6ICA1paT6oCG1pldGFjaGFpbi5aZXRhY29yZS5PYnNlcnZlcsoCG1pldGFjaGFpblxaZXRhY29yZVxPYnNlcnZlcuICJ1pldGFjaGFpblxaZXRhY29yZVxPYnNlcnZlclxHUEJNZXRhZGF0YeoCHVpldGFjaGFpbjo6WmV0YWNvcmU6Ok9ic2VydmVyYgZwcm90bzM", [file_cosmos_base_query_v1beta1_pagination, file_gogoproto_gogo, file_google_api_annotations, file_zetachain_zetacore_observer_ballot, file_zetachain_zetacore_observer_blame, file_zetachain_zetacore_observer_chain_nonces, file_zetachain_zetacore_observer_crosschain_flags, file_zetachain_zetacore_observer_keygen, file_zetachain_zetacore_observer_node_account, file_zetachain_zetacore_observer_observer, file_zetachain_zetacore_observer_chain_params, file_zetachain_zetacore_observer_pending_nonces, file_zetachain_zetacore_observer_tss, file_zetachain_zetacore_observer_operational, file_zetachain_zetacore_pkg_chains_chains, file_zetachain_zetacore_pkg_proofs_proofs, file_zetachain_zetacore_observer_tss_funds_migrator, file_cosmos_msg_v1_msg]);
+  fileDesc("Cid6ZXRhY2hhaW4vemV0YWNvcmUvb2JzZXJ2ZXIvcXVlcnkucHJvdG8SG3pldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlciJRChNRdWVyeUJhbGxvdHNSZXF1ZXN0EjoKCnBhZ2luYXRpb24YASABKAsyJi5jb3Ntb3MuYmFzZS5xdWVyeS52MWJldGExLlBhZ2VSZXF1ZXN0Io8BChRRdWVyeUJhbGxvdHNSZXNwb25zZRI6CgdiYWxsb3RzGAEgAygLMiMuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLkJhbGxvdEIEyN4fABI7CgpwYWdpbmF0aW9uGAIgASgLMicuY29zbW9zLmJhc2UucXVlcnkudjFiZXRhMS5QYWdlUmVzcG9uc2UiHgocUXVlcnlPcGVyYXRpb25hbEZsYWdzUmVxdWVzdCJvCh1RdWVyeU9wZXJhdGlvbmFsRmxhZ3NSZXNwb25zZRJOChFvcGVyYXRpb25hbF9mbGFncxgBIAEoCzItLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5PcGVyYXRpb25hbEZsYWdzQgTI3h8AIiUKI1F1ZXJ5VHNzRnVuZHNNaWdyYXRvckluZm9BbGxSZXF1ZXN0InsKJFF1ZXJ5VHNzRnVuZHNNaWdyYXRvckluZm9BbGxSZXNwb25zZRJTChN0c3NfZnVuZHNfbWlncmF0b3JzGAEgAygLMjAuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlRzc0Z1bmRNaWdyYXRvckluZm9CBMjeHwAiNAogUXVlcnlUc3NGdW5kc01pZ3JhdG9ySW5mb1JlcXVlc3QSEAoIY2hhaW5faWQYASABKAMidwohUXVlcnlUc3NGdW5kc01pZ3JhdG9ySW5mb1Jlc3BvbnNlElIKEnRzc19mdW5kc19taWdyYXRvchgBIAEoCzIwLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Uc3NGdW5kTWlncmF0b3JJbmZvQgTI3h8AIi4KGlF1ZXJ5R2V0Q2hhaW5Ob25jZXNSZXF1ZXN0EhAKCGNoYWluX2lkGAEgASgDImIKG1F1ZXJ5R2V0Q2hhaW5Ob25jZXNSZXNwb25zZRJDCgtDaGFpbk5vbmNlcxgBIAEoCzIoLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5DaGFpbk5vbmNlc0IEyN4fACJYChpRdWVyeUFsbENoYWluTm9uY2VzUmVxdWVzdBI6CgpwYWdpbmF0aW9uGAEgASgLMiYuY29zbW9zLmJhc2UucXVlcnkudjFiZXRhMS5QYWdlUmVxdWVzdCKfAQobUXVlcnlBbGxDaGFpbk5vbmNlc1Jlc3BvbnNlEkMKC0NoYWluTm9uY2VzGAEgAygLMiguemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLkNoYWluTm9uY2VzQgTI3h8AEjsKCnBhZ2luYXRpb24YAiABKAsyJy5jb3Ntb3MuYmFzZS5xdWVyeS52MWJldGExLlBhZ2VSZXNwb25zZSJaChxRdWVyeUFsbFBlbmRpbmdOb25jZXNSZXF1ZXN0EjoKCnBhZ2luYXRpb24YASABKAsyJi5jb3Ntb3MuYmFzZS5xdWVyeS52MWJldGExLlBhZ2VSZXF1ZXN0IqYBCh1RdWVyeUFsbFBlbmRpbmdOb25jZXNSZXNwb25zZRJICg5wZW5kaW5nX25vbmNlcxgBIAMoCzIqLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5QZW5kaW5nTm9uY2VzQgTI3h8AEjsKCnBhZ2luYXRpb24YAiABKAsyJy5jb3Ntb3MuYmFzZS5xdWVyeS52MWJldGExLlBhZ2VSZXNwb25zZSI0CiBRdWVyeVBlbmRpbmdOb25jZXNCeUNoYWluUmVxdWVzdBIQCghjaGFpbl9pZBgBIAEoAyJtCiFRdWVyeVBlbmRpbmdOb25jZXNCeUNoYWluUmVzcG9uc2USSAoOcGVuZGluZ19ub25jZXMYASABKAsyKi56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUGVuZGluZ05vbmNlc0IEyN4fACIUChJRdWVyeUdldFRTU1JlcXVlc3QiSgoTUXVlcnlHZXRUU1NSZXNwb25zZRIzCgNUU1MYASABKAsyIC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuVFNTQgTI3h8AIjUKGVF1ZXJ5R2V0VHNzQWRkcmVzc1JlcXVlc3QSGAoQYml0Y29pbl9jaGFpbl9pZBgCIAEoAyJDChpRdWVyeUdldFRzc0FkZHJlc3NSZXNwb25zZRILCgNldGgYASABKAkSCwoDYnRjGAIgASgJEgsKA3N1aRgDIAEoCSJlCipRdWVyeUdldFRzc0FkZHJlc3NCeUZpbmFsaXplZEhlaWdodFJlcXVlc3QSHQoVZmluYWxpemVkX3pldGFfaGVpZ2h0GAEgASgDEhgKEGJpdGNvaW5fY2hhaW5faWQYAiABKAMiVAorUXVlcnlHZXRUc3NBZGRyZXNzQnlGaW5hbGl6ZWRIZWlnaHRSZXNwb25zZRILCgNldGgYASABKAkSCwoDYnRjGAIgASgJEgsKA3N1aRgDIAEoCSJUChZRdWVyeVRzc0hpc3RvcnlSZXF1ZXN0EjoKCnBhZ2luYXRpb24YASABKAsyJi5jb3Ntb3MuYmFzZS5xdWVyeS52MWJldGExLlBhZ2VSZXF1ZXN0IpABChdRdWVyeVRzc0hpc3RvcnlSZXNwb25zZRI4Cgh0c3NfbGlzdBgBIAMoCzIgLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5UU1NCBMjeHwASOwoKcGFnaW5hdGlvbhgCIAEoCzInLmNvc21vcy5iYXNlLnF1ZXJ5LnYxYmV0YTEuUGFnZVJlc3BvbnNlIkgKFFF1ZXJ5SGFzVm90ZWRSZXF1ZXN0EhkKEWJhbGxvdF9pZGVudGlmaWVyGAEgASgJEhUKDXZvdGVyX2FkZHJlc3MYAiABKAkiKgoVUXVlcnlIYXNWb3RlZFJlc3BvbnNlEhEKCWhhc192b3RlZBgBIAEoCCI7Ch5RdWVyeUJhbGxvdEJ5SWRlbnRpZmllclJlcXVlc3QSGQoRYmFsbG90X2lkZW50aWZpZXIYASABKAkiXAoJVm90ZXJMaXN0EhUKDXZvdGVyX2FkZHJlc3MYASABKAkSOAoJdm90ZV90eXBlGAIgASgOMiUuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlZvdGVUeXBlIv4BCh9RdWVyeUJhbGxvdEJ5SWRlbnRpZmllclJlc3BvbnNlEhkKEWJhbGxvdF9pZGVudGlmaWVyGAEgASgJEjYKBnZvdGVycxgCIAMoCzImLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Wb3Rlckxpc3QSRgoQb2JzZXJ2YXRpb25fdHlwZRgDIAEoDjIsLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5PYnNlcnZhdGlvblR5cGUSQAoNYmFsbG90X3N0YXR1cxgEIAEoDjIpLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5CYWxsb3RTdGF0dXMiEgoQUXVlcnlPYnNlcnZlclNldCItChhRdWVyeU9ic2VydmVyU2V0UmVzcG9uc2USEQoJb2JzZXJ2ZXJzGAEgAygJIhYKFFF1ZXJ5U3VwcG9ydGVkQ2hhaW5zIloKHFF1ZXJ5U3VwcG9ydGVkQ2hhaW5zUmVzcG9uc2USOgoGY2hhaW5zGAEgAygLMiQuemV0YWNoYWluLnpldGFjb3JlLnBrZy5jaGFpbnMuQ2hhaW5CBMjeHwAiNgoiUXVlcnlHZXRDaGFpblBhcmFtc0ZvckNoYWluUmVxdWVzdBIQCghjaGFpbl9pZBgBIAEoAyJlCiNRdWVyeUdldENoYWluUGFyYW1zRm9yQ2hhaW5SZXNwb25zZRI+CgxjaGFpbl9wYXJhbXMYASABKAsyKC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuQ2hhaW5QYXJhbXMiHAoaUXVlcnlHZXRDaGFpblBhcmFtc1JlcXVlc3QiYQobUXVlcnlHZXRDaGFpblBhcmFtc1Jlc3BvbnNlEkIKDGNoYWluX3BhcmFtcxgBIAEoCzIsLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5DaGFpblBhcmFtc0xpc3QiKwoaUXVlcnlHZXROb2RlQWNjb3VudFJlcXVlc3QSDQoFaW5kZXgYASABKAkiXQobUXVlcnlHZXROb2RlQWNjb3VudFJlc3BvbnNlEj4KDG5vZGVfYWNjb3VudBgBIAEoCzIoLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Ob2RlQWNjb3VudCJYChpRdWVyeUFsbE5vZGVBY2NvdW50UmVxdWVzdBI6CgpwYWdpbmF0aW9uGAEgASgLMiYuY29zbW9zLmJhc2UucXVlcnkudjFiZXRhMS5QYWdlUmVxdWVzdCKZAQobUXVlcnlBbGxOb2RlQWNjb3VudFJlc3BvbnNlEj0KC05vZGVBY2NvdW50GAEgAygLMiguemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk5vZGVBY2NvdW50EjsKCnBhZ2luYXRpb24YAiABKAsyJy5jb3Ntb3MuYmFzZS5xdWVyeS52MWJldGExLlBhZ2VSZXNwb25zZSIgCh5RdWVyeUdldENyb3NzY2hhaW5GbGFnc1JlcXVlc3QibwofUXVlcnlHZXRDcm9zc2NoYWluRmxhZ3NSZXNwb25zZRJMChBjcm9zc2NoYWluX2ZsYWdzGAEgASgLMiwuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLkNyb3NzY2hhaW5GbGFnc0IEyN4fACIXChVRdWVyeUdldEtleWdlblJlcXVlc3QiTQoWUXVlcnlHZXRLZXlnZW5SZXNwb25zZRIzCgZrZXlnZW4YASABKAsyIy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuS2V5Z2VuIh8KHVF1ZXJ5U2hvd09ic2VydmVyQ291bnRSZXF1ZXN0Im0KHlF1ZXJ5U2hvd09ic2VydmVyQ291bnRSZXNwb25zZRJLChNsYXN0X29ic2VydmVyX2NvdW50GAEgASgLMi4uemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLkxhc3RPYnNlcnZlckNvdW50IjkKHVF1ZXJ5QmxhbWVCeUlkZW50aWZpZXJSZXF1ZXN0EhgKEGJsYW1lX2lkZW50aWZpZXIYASABKAkiWAoeUXVlcnlCbGFtZUJ5SWRlbnRpZmllclJlc3BvbnNlEjYKCmJsYW1lX2luZm8YASABKAsyIi56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuQmxhbWUiWQobUXVlcnlBbGxCbGFtZVJlY29yZHNSZXF1ZXN0EjoKCnBhZ2luYXRpb24YASABKAsyJi5jb3Ntb3MuYmFzZS5xdWVyeS52MWJldGExLlBhZ2VSZXF1ZXN0IpkBChxRdWVyeUFsbEJsYW1lUmVjb3Jkc1Jlc3BvbnNlEjwKCmJsYW1lX2luZm8YASADKAsyIi56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuQmxhbWVCBMjeHwASOwoKcGFnaW5hdGlvbhgCIAEoCzInLmNvc21vcy5iYXNlLnF1ZXJ5LnYxYmV0YTEuUGFnZVJlc3BvbnNlIkMKIFF1ZXJ5QmxhbWVCeUNoYWluQW5kTm9uY2VSZXF1ZXN0EhAKCGNoYWluX2lkGAEgASgDEg0KBW5vbmNlGAIgASgDIlsKIVF1ZXJ5QmxhbWVCeUNoYWluQW5kTm9uY2VSZXNwb25zZRI2CgpibGFtZV9pbmZvGAEgAygLMiIuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLkJsYW1lIjkKFlF1ZXJ5QmxhbWVTdGF0c1JlcXVlc3QSDgoGd2luZG93GAEgASgDEg8KB3B1Yl9rZXkYAiABKAkihQEKF1F1ZXJ5QmxhbWVTdGF0c1Jlc3BvbnNlEhQKDHN0YXJ0X2hlaWdodBgBIAEoAxISCgplbmRfaGVpZ2h0GAIgASgDEkAKBXN0YXRzGAMgAygLMisuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk5vZGVCbGFtZVN0YXRzQgTI3h8AIjEKH1F1ZXJ5QmFsbG90TGlzdEZvckhlaWdodFJlcXVlc3QSDgoGaGVpZ2h0GAEgASgDIm8KIFF1ZXJ5QmFsbG90TGlzdEZvckhlaWdodFJlc3BvbnNlEksKC2JhbGxvdF9saXN0GAEgASgLMjAuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLkJhbGxvdExpc3RGb3JIZWlnaHRCBMjeHwAiHwodUXVlcnlPYnNlcnZlclNldENoYW5nZVJlcXVlc3QicwoeUXVlcnlPYnNlcnZlclNldENoYW5nZVJlc3BvbnNlElEKE29ic2VydmVyX3NldF9jaGFuZ2UYASABKAsyLi56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuT2JzZXJ2ZXJTZXRDaGFuZ2VCBMjeHwAiOwofUXVlcnlPYnNlcnZlclNpZ25pbmdJbmZvUmVxdWVzdBIYChBvYnNlcnZlcl9hZGRyZXNzGAEgASgJInAKIFF1ZXJ5T2JzZXJ2ZXJTaWduaW5nSW5mb1Jlc3BvbnNlEkwKDHNpZ25pbmdfaW5mbxgBIAEoCzIwLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5PYnNlcnZlclNpZ25pbmdJbmZvQgTI3h8AIl4KIFF1ZXJ5T2JzZXJ2ZXJTaWduaW5nSW5mb3NSZXF1ZXN0EjoKCnBhZ2luYXRpb24YASABKAsyJi5jb3Ntb3MuYmFzZS5xdWVyeS52MWJldGExLlBhZ2VSZXF1ZXN0Iq8BCiFRdWVyeU9ic2VydmVyU2lnbmluZ0luZm9zUmVzcG9uc2USTQoNc2lnbmluZ19pbmZvcxgBIAMoCzIwLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5PYnNlcnZlclNpZ25pbmdJbmZvQgTI3h8AEjsKCnBhZ2luYXRpb24YAiABKAsyJy5jb3Ntb3MuYmFzZS5xdWVyeS52MWJldGExLlBhZ2VSZXNwb25zZSIcChpRdWVyeUxpdmVuZXNzUGFyYW1zUmVxdWVzdCJpChtRdWVyeUxpdmVuZXNzUGFyYW1zUmVzcG9uc2USSgoPbGl2ZW5lc3NfcGFyYW1zGAEgASgLMisuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLkxpdmVuZXNzUGFyYW1zQgTI3h8AMv8vCgVRdWVyeRK9AQoISGFzVm90ZWQSMS56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlIYXNWb3RlZFJlcXVlc3QaMi56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlIYXNWb3RlZFJlc3BvbnNlIkqC0+STAkQSQi96ZXRhLWNoYWluL29ic2VydmVyL2hhc192b3RlZC97YmFsbG90X2lkZW50aWZpZXJ9L3t2b3Rlcl9hZGRyZXNzfRLWAQoSQmFsbG90QnlJZGVudGlmaWVyEjsuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5QmFsbG90QnlJZGVudGlmaWVyUmVxdWVzdBo8LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeUJhbGxvdEJ5SWRlbnRpZmllclJlc3BvbnNlIkWC0+STAj8SPS96ZXRhLWNoYWluL29ic2VydmVyL2JhbGxvdF9ieV9pZGVudGlmaWVyL3tiYWxsb3RfaWRlbnRpZmllcn0S0AEKE0JhbGxvdExpc3RGb3JIZWlnaHQSPC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlCYWxsb3RMaXN0Rm9ySGVpZ2h0UmVxdWVzdBo9LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeUJhbGxvdExpc3RGb3JIZWlnaHRSZXNwb25zZSI8gtPkkwI2EjQvemV0YS1jaGFpbi9vYnNlcnZlci9iYWxsb3RfbGlzdF9mb3JfaGVpZ2h0L3toZWlnaHR9Ep4BCgtPYnNlcnZlclNldBItLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeU9ic2VydmVyU2V0GjUuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5T2JzZXJ2ZXJTZXRSZXNwb25zZSIpgtPkkwIjEiEvemV0YS1jaGFpbi9vYnNlcnZlci9vYnNlcnZlcl9zZXQSrQEKD1N1cHBvcnRlZENoYWlucxIxLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeVN1cHBvcnRlZENoYWlucxo5LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeVN1cHBvcnRlZENoYWluc1Jlc3BvbnNlIiyC0+STAiYSJC96ZXRhLWNoYWluL29ic2VydmVyL3N1cHBvcnRlZENoYWlucxLfAQoWR2V0Q2hhaW5QYXJhbXNGb3JDaGFpbhI/LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeUdldENoYWluUGFyYW1zRm9yQ2hhaW5SZXF1ZXN0GkAuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5R2V0Q2hhaW5QYXJhbXNGb3JDaGFpblJlc3BvbnNlIkKC0+STAjwSOi96ZXRhLWNoYWluL29ic2VydmVyL2dldF9jaGFpbl9wYXJhbXNfZm9yX2NoYWluL3tjaGFpbl9pZH0SsgEKDkdldENoYWluUGFyYW1zEjcuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5R2V0Q2hhaW5QYXJhbXNSZXF1ZXN0GjguemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5R2V0Q2hhaW5QYXJhbXNSZXNwb25zZSItgtPkkwInEiUvemV0YS1jaGFpbi9vYnNlcnZlci9nZXRfY2hhaW5fcGFyYW1zErIBCgtOb2RlQWNjb3VudBI3LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeUdldE5vZGVBY2NvdW50UmVxdWVzdBo4LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeUdldE5vZGVBY2NvdW50UmVzcG9uc2UiMILT5JMCKhIoL3pldGEtY2hhaW4vb2JzZXJ2ZXIvbm9kZUFjY291bnQve2luZGV4fRKtAQoOTm9kZUFjY291bnRBbGwSNy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlBbGxOb2RlQWNjb3VudFJlcXVlc3QaOC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlBbGxOb2RlQWNjb3VudFJlc3BvbnNlIiiC0+STAiISIC96ZXRhLWNoYWluL29ic2VydmVyL25vZGVBY2NvdW50ErsBCg9Dcm9zc2NoYWluRmxhZ3MSOy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlHZXRDcm9zc2NoYWluRmxhZ3NSZXF1ZXN0GjwuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5R2V0Q3Jvc3NjaGFpbkZsYWdzUmVzcG9uc2UiLYLT5JMCJxIlL3pldGEtY2hhaW4vb2JzZXJ2ZXIvY3Jvc3NjaGFpbl9mbGFncxKWAQoGS2V5Z2VuEjIuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5R2V0S2V5Z2VuUmVxdWVzdBozLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeUdldEtleWdlblJlc3BvbnNlIiOC0+STAh0SGy96ZXRhLWNoYWluL29ic2VydmVyL2tleWdlbhLHAQoRU2hvd09ic2VydmVyQ291bnQSOi56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlTaG93T2JzZXJ2ZXJDb3VudFJlcXVlc3QaOy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlTaG93T2JzZXJ2ZXJDb3VudFJlc3BvbnNlIjmC0+STAjMSMS96ZXRhLWNoYWluL3pldGFjb3JlL29ic2VydmVyL3Nob3dfb2JzZXJ2ZXJfY291bnQS0QEKEUJsYW1lQnlJZGVudGlmaWVyEjouemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5QmxhbWVCeUlkZW50aWZpZXJSZXF1ZXN0GjsuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5QmxhbWVCeUlkZW50aWZpZXJSZXNwb25zZSJDgtPkkwI9EjsvemV0YS1jaGFpbi9vYnNlcnZlci9ibGFtZV9ieV9pZGVudGlmaWVyL3tibGFtZV9pZGVudGlmaWVyfRK9AQoSR2V0QWxsQmxhbWVSZWNvcmRzEjguemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5QWxsQmxhbWVSZWNvcmRzUmVxdWVzdBo5LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeUFsbEJsYW1lUmVjb3Jkc1Jlc3BvbnNlIjKC0+STAiwSKi96ZXRhLWNoYWluL29ic2VydmVyL2dldF9hbGxfYmxhbWVfcmVjb3JkcxLgAQoVQmxhbWVzQnlDaGFpbkFuZE5vbmNlEj0uemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5QmxhbWVCeUNoYWluQW5kTm9uY2VSZXF1ZXN0Gj4uemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5QmxhbWVCeUNoYWluQW5kTm9uY2VSZXNwb25zZSJIgtPkkwJCEkAvemV0YS1jaGFpbi9vYnNlcnZlci9ibGFtZV9ieV9jaGFpbl9hbmRfbm9uY2Uve2NoYWluX2lkfS97bm9uY2V9EqEBCgpCbGFtZVN0YXRzEjMuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5QmxhbWVTdGF0c1JlcXVlc3QaNC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlCbGFtZVN0YXRzUmVzcG9uc2UiKILT5JMCIhIgL3pldGEtY2hhaW4vb2JzZXJ2ZXIvYmxhbWVfc3RhdHMSwQEKDUdldFRzc0FkZHJlc3MSNi56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlHZXRUc3NBZGRyZXNzUmVxdWVzdBo3LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeUdldFRzc0FkZHJlc3NSZXNwb25zZSI/gtPkkwI5EjcvemV0YS1jaGFpbi9vYnNlcnZlci9nZXRfdHNzX2FkZHJlc3Mve2JpdGNvaW5fY2hhaW5faWR9EpcCCh5HZXRUc3NBZGRyZXNzQnlGaW5hbGl6ZWRIZWlnaHQSRy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlHZXRUc3NBZGRyZXNzQnlGaW5hbGl6ZWRIZWlnaHRSZXF1ZXN0GkguemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5R2V0VHNzQWRkcmVzc0J5RmluYWxpemVkSGVpZ2h0UmVzcG9uc2UiYoLT5JMCXBJaL3pldGEtY2hhaW4vb2JzZXJ2ZXIvZ2V0X3Rzc19hZGRyZXNzX2hpc3RvcmljYWwve2ZpbmFsaXplZF96ZXRhX2hlaWdodH0ve2JpdGNvaW5fY2hhaW5faWR9EooBCgNUU1MSLy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlHZXRUU1NSZXF1ZXN0GjAuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5R2V0VFNTUmVzcG9uc2UiIILT5JMCGhIYL3pldGEtY2hhaW4vb2JzZXJ2ZXIvVFNTEqABCgpUc3NIaXN0b3J5EjMuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5VHNzSGlzdG9yeVJlcXVlc3QaNC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlUc3NIaXN0b3J5UmVzcG9uc2UiJ4LT5JMCIRIfL3pldGEtY2hhaW4vb2JzZXJ2ZXIvdHNzSGlzdG9yeRK1AQoQUGVuZGluZ05vbmNlc0FsbBI5LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeUFsbFBlbmRpbmdOb25jZXNSZXF1ZXN0GjouemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5QWxsUGVuZGluZ05vbmNlc1Jlc3BvbnNlIiqC0+STAiQSIi96ZXRhLWNoYWluL29ic2VydmVyL3BlbmRpbmdOb25jZXMSzAEKFFBlbmRpbmdOb25jZXNCeUNoYWluEj0uemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5UGVuZGluZ05vbmNlc0J5Q2hhaW5SZXF1ZXN0Gj4uemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5UGVuZGluZ05vbmNlc0J5Q2hhaW5SZXNwb25zZSI1gtPkkwIvEi0vemV0YS1jaGFpbi9vYnNlcnZlci9wZW5kaW5nTm9uY2VzL3tjaGFpbl9pZH0StQEKC0NoYWluTm9uY2VzEjcuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5R2V0Q2hhaW5Ob25jZXNSZXF1ZXN0GjguemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5R2V0Q2hhaW5Ob25jZXNSZXNwb25zZSIzgtPkkwItEisvemV0YS1jaGFpbi9vYnNlcnZlci9jaGFpbk5vbmNlcy97Y2hhaW5faWR9Eq0BCg5DaGFpbk5vbmNlc0FsbBI3LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeUFsbENoYWluTm9uY2VzUmVxdWVzdBo4LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeUFsbENoYWluTm9uY2VzUmVzcG9uc2UiKILT5JMCIhIgL3pldGEtY2hhaW4vb2JzZXJ2ZXIvY2hhaW5Ob25jZXMSxwEKFFRzc0Z1bmRzTWlncmF0b3JJbmZvEj0uemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5VHNzRnVuZHNNaWdyYXRvckluZm9SZXF1ZXN0Gj4uemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5VHNzRnVuZHNNaWdyYXRvckluZm9SZXNwb25zZSIwgtPkkwIqEigvemV0YS1jaGFpbi9vYnNlcnZlci9nZXRUc3NGdW5kc01pZ3JhdG9yEtQBChdUc3NGdW5kc01pZ3JhdG9ySW5mb0FsbBJALnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeVRzc0Z1bmRzTWlncmF0b3JJbmZvQWxsUmVxdWVzdBpBLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeVRzc0Z1bmRzTWlncmF0b3JJbmZvQWxsUmVzcG9uc2UiNILT5JMCLhIsL3pldGEtY2hhaW4vb2JzZXJ2ZXIvZ2V0QWxsVHNzRnVuZHNNaWdyYXRvcnMSuAEKEE9wZXJhdGlvbmFsRmxhZ3MSOS56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlPcGVyYXRpb25hbEZsYWdzUmVxdWVzdBo6LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeU9wZXJhdGlvbmFsRmxhZ3NSZXNwb25zZSItgtPkkwInEiUvemV0YS1jaGFpbi9vYnNlcnZlci9vcGVyYXRpb25hbEZsYWdzEpQBCgdCYWxsb3RzEjAuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLlF1ZXJ5QmFsbG90c1JlcXVlc3QaMS56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlCYWxsb3RzUmVzcG9uc2UiJILT5JMCHhIcL3pldGEtY2hhaW4vb2JzZXJ2ZXIvYmFsbG90cxK+AQoRT2JzZXJ2ZXJTZXRDaGFuZ2USOi56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlPYnNlcnZlclNldENoYW5nZVJlcXVlc3QaOy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlPYnNlcnZlclNldENoYW5nZVJlc3BvbnNlIjCC0+STAioSKC96ZXRhLWNoYWluL29ic2VydmVyL29ic2VydmVyX3NldF9jaGFuZ2US0AEKE09ic2VydmVyU2lnbmluZ0luZm8SPC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlPYnNlcnZlclNpZ25pbmdJbmZvUmVxdWVzdBo9LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeU9ic2VydmVyU2lnbmluZ0luZm9SZXNwb25zZSI8gtPkkwI2EjQvemV0YS1jaGFpbi9vYnNlcnZlci9zaWduaW5nX2luZm8ve29ic2VydmVyX2FkZHJlc3N9EsEBChRPYnNlcnZlclNpZ25pbmdJbmZvcxI9LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeU9ic2VydmVyU2lnbmluZ0luZm9zUmVxdWVzdBo+LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5RdWVyeU9ic2VydmVyU2lnbmluZ0luZm9zUmVzcG9uc2UiKoLT5JMCJBIiL3pldGEtY2hhaW4vb2JzZXJ2ZXIvc2lnbmluZ19pbmZvcxKxAQoOTGl2ZW5lc3NQYXJhbXMSNy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlMaXZlbmVzc1BhcmFtc1JlcXVlc3QaOC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuUXVlcnlMaXZlbmVzc1BhcmFtc1Jlc3BvbnNlIiyC0+STAiYSJC96ZXRhLWNoYWluL29ic2VydmVyL2xpdmVuZXNzX3BhcmFtcxoFgOewKgFC6AEKH2NvbS56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXJCClF1ZXJ5UHJvdG9QAVorZ2l0aHViLmNvbS96ZXRhLWNoYWluL25vZGUveC9vYnNlcnZlci90eXBlc6ICA1paT6oCG1pldGFjaGFpbi5aZXRhY29yZS5PYnNlcnZlcsoCG1pldGFjaGFpblxaZXRhY29yZVxPYnNlcnZlcuICJ1pldGFjaGFpblxaZXRhY29yZVxPYnNlcnZlclxHUEJNZXRhZGF0YeoCHVpldGFjaGFpbjo6WmV0YWNvcmU6Ok9ic2VydmVyYgZwcm90bzM", [file_cosmos_base_query_v1beta1_pagination, file_gogoproto_gogo, file_google_api_annotations, file_zetachain_zetacore_observer_ballot, file_zetachain_zetacore_observer_blame, file_zetachain_zetacore_observer_chain_nonces, file_zetachain_zetacore_observer_crosschain_flags, file_zetachain_zetacore_observer_keygen, file_zetachain_zetacore_observer_liveness, file_zetachain_zetacore_observer_node_account, file_zetachain_zetacore_observer_observer, file_zetachain_zetacore_observer_observer_set_change, file_zetachain_zetacore_observer_chain_params, file_zetachain_zetacore_observer_pending_nonces, file_zetachain_zetacore_observer_tss, file_zetachain_zetacore_observer_operational, file_zetachain_zetacore_pkg_chains_chains, file_zetachain_zetacore_pkg_proofs_proofs, file_zetachain_zetacore_observer_tss_funds_migrator, file_cosmos_msg_v1_msg]);
 
 /**
  * @generated from message zetachain.zetacore.observer.QueryBallotsRequest
@@ -994,6 +998,59 @@ export type QueryBlameByChainAndNonceResponse = Message<"zetachain.zetacore.obse
 export const QueryBlameByChainAndNonceResponseSchema: GenMessage<QueryBlameByChainAndNonceResponse> = /*@__PURE__*/
   messageDesc(file_zetachain_zetacore_observer_query, 52);
 
+/**
+ * @generated from message zetachain.zetacore.observer.QueryBlameStatsRequest
+ */
+export type QueryBlameStatsRequest = Message<"zetachain.zetacore.observer.QueryBlameStatsRequest"> & {
+  /**
+   * number of blocks to aggregate, ending at the current block
+   *
+   * @generated from field: int64 window = 1;
+   */
+  window: bigint;
+
+  /**
+   * optional node pubkey to return the statistics for
+   *
+   * @generated from field: string pub_key = 2;
+   */
+  pubKey: string;
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.QueryBlameStatsRequest.
+ * Use `create(QueryBlameStatsRequestSchema)` to create a new message.
+ */
+export const QueryBlameStatsRequestSchema: GenMessage<QueryBlameStatsRequest> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_query, 53);
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryBlameStatsResponse
+ */
+export type QueryBlameStatsResponse = Message<"zetachain.zetacore.observer.QueryBlameStatsResponse"> & {
+  /**
+   * @generated from field: int64 start_height = 1;
+   */
+  startHeight: bigint;
+
+  /**
+   * @generated from field: int64 end_height = 2;
+   */
+  endHeight: bigint;
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.NodeBlameStats stats = 3;
+   */
+  stats: NodeBlameStats[];
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.QueryBlameStatsResponse.
+ * Use `create(QueryBlameStatsResponseSchema)` to create a new message.
+ */
+export const QueryBlameStatsResponseSchema: GenMessage<QueryBlameStatsResponse> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_query, 54);
+
 /**
  * @generated from message zetachain.zetacore.observer.QueryBallotListForHeightRequest
  */
@@ -1009,7 +1066,7 @@ export type QueryBallotListForHeightRequest = Message<"zetachain.zetacore.observ
  * Use `create(QueryBallotListForHeightRequestSchema)` to create a new message.
  */
 export const QueryBallotListForHeightRequestSchema: GenMessage<QueryBallotListForHeightRequest> = /*@__PURE__*/
-  messageDesc(file_zetachain_zetacore_observer_query, 53);
+  messageDesc(file_zetachain_zetacore_observer_query, 55);
 
 /**
  * @generated from message zetachain.zetacore.observer.QueryBallotListForHeightResponse
@@ -1026,7 +1083,140 @@ export type QueryBallotListForHeightResponse = Message<"zetachain.zetacore.obser
  * Use `create(QueryBallotListForHeightResponseSchema)` to create a new message.
  */
 export const QueryBallotListForHeightResponseSchema: GenMessage<QueryBallotListForHeightResponse> = /*@__PURE__*/
-  messageDesc(file_zetachain_zetacore_observer_query, 54);
+  messageDesc(file_zetachain_zetacore_observer_query, 56);
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryObserverSetChangeRequest
+ */
+export type QueryObserverSetChangeRequest = Message<"zetachain.zetacore.observer.QueryObserverSetChangeRequest"> & {
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.QueryObserverSetChangeRequest.
+ * Use `create(QueryObserverSetChangeRequestSchema)` to create a new message.
+ */
+export const QueryObserverSetChangeRequestSchema: GenMessage<QueryObserverSetChangeRequest> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_query, 57);
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryObserverSetChangeResponse
+ */
+export type QueryObserverSetChangeResponse = Message<"zetachain.zetacore.observer.QueryObserverSetChangeResponse"> & {
+  /**
+   * @generated from field: zetachain.zetacore.observer.ObserverSetChange observer_set_change = 1;
+   */
+  observerSetChange?: ObserverSetChange;
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.QueryObserverSetChangeResponse.
+ * Use `create(QueryObserverSetChangeResponseSchema)` to create a new message.
+ */
+export const QueryObserverSetChangeResponseSchema: GenMessage<QueryObserverSetChangeResponse> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_query, 58);
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryObserverSigningInfoRequest
+ */
+export type QueryObserverSigningInfoRequest = Message<"zetachain.zetacore.observer.QueryObserverSigningInfoRequest"> & {
+  /**
+   * @generated from field: string observer_address = 1;
+   */
+  observerAddress: string;
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.QueryObserverSigningInfoRequest.
+ * Use `create(QueryObserverSigningInfoRequestSchema)` to create a new message.
+ */
+export const QueryObserverSigningInfoRequestSchema: GenMessage<QueryObserverSigningInfoRequest> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_query, 59);
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryObserverSigningInfoResponse
+ */
+export type QueryObserverSigningInfoResponse = Message<"zetachain.zetacore.observer.QueryObserverSigningInfoResponse"> & {
+  /**
+   * @generated from field: zetachain.zetacore.observer.ObserverSigningInfo signing_info = 1;
+   */
+  signingInfo?: ObserverSigningInfo;
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.QueryObserverSigningInfoResponse.
+ * Use `create(QueryObserverSigningInfoResponseSchema)` to create a new message.
+ */
+export const QueryObserverSigningInfoResponseSchema: GenMessage<QueryObserverSigningInfoResponse> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_query, 60);
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryObserverSigningInfosRequest
+ */
+export type QueryObserverSigningInfosRequest = Message<"zetachain.zetacore.observer.QueryObserverSigningInfosRequest"> & {
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageRequest pagination = 1;
+   */
+  pagination?: PageRequest;
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.QueryObserverSigningInfosRequest.
+ * Use `create(QueryObserverSigningInfosRequestSchema)` to create a new message.
+ */
+export const QueryObserverSigningInfosRequestSchema: GenMessage<QueryObserverSigningInfosRequest> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_query, 61);
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryObserverSigningInfosResponse
+ */
+export type QueryObserverSigningInfosResponse = Message<"zetachain.zetacore.observer.QueryObserverSigningInfosResponse"> & {
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.ObserverSigningInfo signing_infos = 1;
+   */
+  signingInfos: ObserverSigningInfo[];
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageResponse pagination = 2;
+   */
+  pagination?: PageResponse;
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.QueryObserverSigningInfosResponse.
+ * Use `create(QueryObserverSigningInfosResponseSchema)` to create a new message.
+ */
+export const QueryObserverSigningInfosResponseSchema: GenMessage<QueryObserverSigningInfosResponse> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_query, 62);
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryLivenessParamsRequest
+ */
+export type QueryLivenessParamsRequest = Message<"zetachain.zetacore.observer.QueryLivenessParamsRequest"> & {
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.QueryLivenessParamsRequest.
+ * Use `create(QueryLivenessParamsRequestSchema)` to create a new message.
+ */
+export const QueryLivenessParamsRequestSchema: GenMessage<QueryLivenessParamsRequest> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_query, 63);
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryLivenessParamsResponse
+ */
+export type QueryLivenessParamsResponse = Message<"zetachain.zetacore.observer.QueryLivenessParamsResponse"> & {
+  /**
+   * @generated from field: zetachain.zetacore.observer.LivenessParams liveness_params = 1;
+   */
+  livenessParams?: LivenessParams;
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.QueryLivenessParamsResponse.
+ * Use `create(QueryLivenessParamsResponseSchema)` to create a new message.
+ */
+export const QueryLivenessParamsResponseSchema: GenMessage<QueryLivenessParamsResponse> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_query, 64);
 
 /**
  * Query defines the gRPC querier service.
@@ -1178,6 +1368,16 @@ export const Query: GenService<{
     input: typeof QueryBlameByChainAndNonceRequestSchema;
     output: typeof QueryBlameByChainAndNonceResponseSchema;
   },
+  /**
+   * Queries blame statistics per node over a window of recent blocks.
+   *
+   * @generated from rpc zetachain.zetacore.observer.Query.BlameStats
+   */
+  blameStats: {
+    methodKind: "unary";
+    input: typeof QueryBlameStatsRequestSchema;
+    output: typeof QueryBlameStatsResponseSchema;
+  },
   /**
    * Queries a list of GetTssAddress items.
    *
@@ -1290,6 +1490,46 @@ export const Query: GenService<{
     input: typeof QueryBallotsRequestSchema;
     output: typeof QueryBallotsResponseSchema;
   },
+  /**
+   * Queries the last staged observer set change.
+   *
+   * @generated from rpc zetachain.zetacore.observer.Query.ObserverSetChange
+   */
+  observerSetChange: {
+    methodKind: "unary";
+    input: typeof QueryObserverSetChangeRequestSchema;
+    output: typeof QueryObserverSetChangeResponseSchema;
+  },
+  /**
+   * Queries the ballot signing info of an observer.
+   *
+   * @generated from rpc zetachain.zetacore.observer.Query.ObserverSigningInfo
+   */
+  observerSigningInfo: {
+    methodKind: "unary";
+    input: typeof QueryObserverSigningInfoRequestSchema;
+    output: typeof QueryObserverSigningInfoResponseSchema;
+  },
+  /**
+   * Queries the ballot signing info of all observers.
+   *
+   * @generated from rpc zetachain.zetacore.observer.Query.ObserverSigningInfos
+   */
+  observerSigningInfos: {
+    methodKind: "unary";
+    input: typeof QueryObserverSigningInfosRequestSchema;
+    output: typeof QueryObserverSigningInfosResponseSchema;
+  },
+  /**
+   * Queries the liveness params.
+   *
+   * @generated from rpc zetachain.zetacore.observer.Query.LivenessParams
+   */
+  livenessParams: {
+    methodKind: "unary";
+    input: typeof QueryLivenessParamsRequestSchema;
+    output: typeof QueryLivenessParamsResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_zetachain_zetacore_observer_query, 0);
 
@@ -11,7 +11,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file zetachain/zetacore/observer/tss.proto.
  */
 export const file_zetachain_zetacore_observer_tss: GenFile = /*@__PURE__*/
-  fileDesc("CiV6ZXRhY2hhaW4vemV0YWNvcmUvb2JzZXJ2ZXIvdHNzLnByb3RvEht6ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIijQEKA1RTUxISCgp0c3NfcHVia2V5GAMgASgJEhwKFHRzc19wYXJ0aWNpcGFudF9saXN0GAQgAygJEh0KFW9wZXJhdG9yX2FkZHJlc3NfbGlzdBgFIAMoCRIbChNmaW5hbGl6ZWRaZXRhSGVpZ2h0GAYgASgDEhgKEGtleUdlblpldGFIZWlnaHQYByABKANC5gEKH2NvbS56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXJCCFRzc1Byb3RvUAFaK2dpdGh1Yi5jb20vemV0YS1jaGFpbi9ub2RlL3gvb2JzZXJ2ZXIvdHlwZXOiAgNaWk+qAhtaZXRhY2hhaW4uWmV0YWNvcmUuT2JzZXJ2ZXLKAhtaZXRhY2hhaW5cWmV0YWNvcmVcT2JzZXJ2ZXLiAidaZXRhY2hhaW5cWmV0YWNvcmVcT2JzZXJ2ZXJcR1BCTWV0YWRhdGHqAh1aZXRhY2hhaW46OlpldGFjb3JlOjpPYnNlcnZlcmIGcHJvdG8z", [file_gogoproto_gogo]);
+  fileDesc("CiV6ZXRhY2hhaW4vemV0YWNvcmUvb2JzZXJ2ZXIvdHNzLnByb3RvEht6ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIipwEKA1RTUxISCgp0c3NfcHVia2V5GAMgASgJEhwKFHRzc19wYXJ0aWNpcGFudF9saXN0GAQgAygJEh0KFW9wZXJhdG9yX2FkZHJlc3NfbGlzdBgFIAMoCRIbChNmaW5hbGl6ZWRaZXRhSGVpZ2h0GAYgASgDEhgKEGtleUdlblpldGFIZWlnaHQYByABKAMSGAoQdHNzX3B1YmtleV9lZGRzYRgIIAEoCULmAQofY29tLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlckIIVHNzUHJvdG9QAVorZ2l0aHViLmNvbS96ZXRhLWNoYWluL25vZGUveC9vYnNlcnZlci90eXBlc6ICA1paT6oCG1pldGFjaGFpbi5aZXRhY29yZS5PYnNlcnZlcsoCG1pldGFjaGFpblxaZXRhY29yZVxPYnNlcnZlcuICJ1pldGFjaGFpblxaZXRhY29yZVxPYnNlcnZlclxHUEJNZXRhZGF0YeoCHVpldGFjaGFpbjo6WmV0YWNvcmU6Ok9ic2VydmVyYgZwcm90bzM", [file_gogoproto_gogo]);
 
 /**
  * @generated from message zetachain.zetacore.observer.TSS
@@ -41,6 +41,13 @@ export type TSS = Message<"zetachain.zetacore.observer.TSS"> & {
    * @generated from field: int64 keyGenZetaHeight = 7;
    */
   keyGenZetaHeight: bigint;
+
+  /**
+   * ed25519 public key generated alongside the secp256k1 key, empty if none
+   *
+   * @generated from field: string tss_pubkey_eddsa = 8;
+   */
+  tssPubkeyEddsa: string;
 };
 
 /**
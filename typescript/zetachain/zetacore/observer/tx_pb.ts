@@ -11,12 +11,16 @@ import type { GasPriceIncreaseFlags } from "./crosschain_flags_pb";
 import { file_zetachain_zetacore_observer_crosschain_flags } from "./crosschain_flags_pb";
 import type { ObserverUpdateReason } from "./observer_pb";
 import { file_zetachain_zetacore_observer_observer } from "./observer_pb";
+import type { ObserverSetAddition } from "./observer_set_change_pb";
+import { file_zetachain_zetacore_observer_observer_set_change } from "./observer_set_change_pb";
 import type { ChainParams } from "./chain_params_pb";
 import { file_zetachain_zetacore_observer_chain_params } from "./chain_params_pb";
 import { file_zetachain_zetacore_observer_pending_nonces } from "./pending_nonces_pb";
 import { file_zetachain_zetacore_observer_tss } from "./tss_pb";
 import type { OperationalFlags } from "./operational_pb";
 import { file_zetachain_zetacore_observer_operational } from "./operational_pb";
+import type { LivenessParams } from "./liveness_pb";
+import { file_zetachain_zetacore_observer_liveness } from "./liveness_pb";
 import type { ConfirmationParams } from "./confirmation_params_pb";
 import { file_zetachain_zetacore_observer_confirmation_params } from "./confirmation_params_pb";
 import type { ReceiveStatus } from "../pkg/chains/chains_pb";
@@ -30,7 +34,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file zetachain/zetacore/observer/tx.proto.
  */
 export const file_zetachain_zetacore_observer_tx: GenFile = /*@__PURE__*/
-  fileDesc("CiR6ZXRhY2hhaW4vemV0YWNvcmUvb2JzZXJ2ZXIvdHgucHJvdG8SG3pldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlciK4AQoRTXNnVXBkYXRlT2JzZXJ2ZXISDwoHY3JlYXRvchgBIAEoCRIcChRvbGRfb2JzZXJ2ZXJfYWRkcmVzcxgCIAEoCRIcChRuZXdfb2JzZXJ2ZXJfYWRkcmVzcxgDIAEoCRJICg11cGRhdGVfcmVhc29uGAQgASgOMjEuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk9ic2VydmVyVXBkYXRlUmVhc29uOgyC57AqB2NyZWF0b3IiGwoZTXNnVXBkYXRlT2JzZXJ2ZXJSZXNwb25zZSKqAQoSTXNnVm90ZUJsb2NrSGVhZGVyEg8KB2NyZWF0b3IYASABKAkSEAoIY2hhaW5faWQYAiABKAMSEgoKYmxvY2tfaGFzaBgDIAEoDBIOCgZoZWlnaHQYBCABKAMSPwoGaGVhZGVyGAUgASgLMikuemV0YWNoYWluLnpldGFjb3JlLnBrZy5wcm9vZnMuSGVhZGVyRGF0YUIEyN4fADoMguewKgdjcmVhdG9yIkwKGk1zZ1ZvdGVCbG9ja0hlYWRlclJlc3BvbnNlEhYKDmJhbGxvdF9jcmVhdGVkGAEgASgIEhYKDnZvdGVfZmluYWxpemVkGAIgASgIInQKFE1zZ1VwZGF0ZUNoYWluUGFyYW1zEg8KB2NyZWF0b3IYASABKAkSPQoLY2hhaW5QYXJhbXMYAiABKAsyKC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuQ2hhaW5QYXJhbXM6DILnsCoHY3JlYXRvciIeChxNc2dVcGRhdGVDaGFpblBhcmFtc1Jlc3BvbnNlIvUCCh9Nc2dVcGRhdGVPcGVyYXRpb25hbENoYWluUGFyYW1zEg8KB2NyZWF0b3IYASABKAkSEAoIY2hhaW5faWQYAiABKAMSGAoQZ2FzX3ByaWNlX3RpY2tlchgDIAEoBBIWCg5pbmJvdW5kX3RpY2tlchgEIAEoBBIXCg9vdXRib3VuZF90aWNrZXIYBSABKAQSGQoRd2F0Y2hfdXR4b190aWNrZXIYBiABKAQSIgoab3V0Ym91bmRfc2NoZWR1bGVfaW50ZXJ2YWwYByABKAMSIwobb3V0Ym91bmRfc2NoZWR1bGVfbG9va2FoZWFkGAggASgDElIKE2NvbmZpcm1hdGlvbl9wYXJhbXMYCSABKAsyLy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuQ29uZmlybWF0aW9uUGFyYW1zQgTI3h8AEh4KFmRpc2FibGVfdHNzX2Jsb2NrX3NjYW4YCiABKAg6DILnsCoHY3JlYXRvciIpCidNc2dVcGRhdGVPcGVyYXRpb25hbENoYWluUGFyYW1zUmVzcG9uc2UiRwoUTXNnUmVtb3ZlQ2hhaW5QYXJhbXMSDwoHY3JlYXRvchgBIAEoCRIQCghjaGFpbl9pZBgCIAEoAzoMguewKgdjcmVhdG9yIh4KHE1zZ1JlbW92ZUNoYWluUGFyYW1zUmVzcG9uc2UiiwEKDk1zZ0FkZE9ic2VydmVyEg8KB2NyZWF0b3IYASABKAkSGAoQb2JzZXJ2ZXJfYWRkcmVzcxgCIAEoCRIhChl6ZXRhY2xpZW50X2dyYW50ZWVfcHVia2V5GAMgASgJEh0KFWFkZF9ub2RlX2FjY291bnRfb25seRgEIAEoCDoMguewKgdjcmVhdG9yIhgKFk1zZ0FkZE9ic2VydmVyUmVzcG9uc2UiTAoRTXNnUmVtb3ZlT2JzZXJ2ZXISDwoHY3JlYXRvchgBIAEoCRIYChBvYnNlcnZlcl9hZGRyZXNzGAIgASgJOgyC57AqB2NyZWF0b3IiGwoZTXNnUmVtb3ZlT2JzZXJ2ZXJSZXNwb25zZSJ9CgxNc2dWb3RlQmxhbWUSDwoHY3JlYXRvchgBIAEoCRIQCghjaGFpbl9pZBgCIAEoAxI8CgpibGFtZV9pbmZvGAMgASgLMiIuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLkJsYW1lQgTI3h8AOgyC57AqB2NyZWF0b3IiFgoUTXNnVm90ZUJsYW1lUmVzcG9uc2UiPwoPTXNnVXBkYXRlS2V5Z2VuEg8KB2NyZWF0b3IYASABKAkSDQoFYmxvY2sYAiABKAM6DILnsCoHY3JlYXRvciIZChdNc2dVcGRhdGVLZXlnZW5SZXNwb25zZSJ5ChNNc2dSZXNldENoYWluTm9uY2VzEg8KB2NyZWF0b3IYASABKAkSEAoIY2hhaW5faWQYAiABKAMSFwoPY2hhaW5fbm9uY2VfbG93GAMgASgDEhgKEGNoYWluX25vbmNlX2hpZ2gYBCABKAM6DILnsCoHY3JlYXRvciIdChtNc2dSZXNldENoYWluTm9uY2VzUmVzcG9uc2UimQEKCk1zZ1ZvdGVUU1MSDwoHY3JlYXRvchgBIAEoCRISCgp0c3NfcHVia2V5GAIgASgJEhoKEmtleWdlbl96ZXRhX2hlaWdodBgDIAEoAxI8CgZzdGF0dXMYBCABKA4yLC56ZXRhY2hhaW4uemV0YWNvcmUucGtnLmNoYWlucy5SZWNlaXZlU3RhdHVzOgyC57AqB2NyZWF0b3IiXAoSTXNnVm90ZVRTU1Jlc3BvbnNlEhYKDmJhbGxvdF9jcmVhdGVkGAEgASgIEhYKDnZvdGVfZmluYWxpemVkGAIgASgIEhYKDmtleWdlbl9zdWNjZXNzGAMgASgIIl0KDU1zZ0VuYWJsZUNDVFgSDwoHY3JlYXRvchgBIAEoCRIVCg1lbmFibGVJbmJvdW5kGAIgASgIEhYKDmVuYWJsZU91dGJvdW5kGAMgASgIOgyC57AqB2NyZWF0b3IiFwoVTXNnRW5hYmxlQ0NUWFJlc3BvbnNlImAKDk1zZ0Rpc2FibGVDQ1RYEg8KB2NyZWF0b3IYASABKAkSFgoOZGlzYWJsZUluYm91bmQYAiABKAgSFwoPZGlzYWJsZU91dGJvdW5kGAMgASgIOgyC57AqB2NyZWF0b3IiGAoWTXNnRGlzYWJsZUNDVFhSZXNwb25zZSKYAQoeTXNnVXBkYXRlR2FzUHJpY2VJbmNyZWFzZUZsYWdzEg8KB2NyZWF0b3IYASABKAkSVwoVZ2FzUHJpY2VJbmNyZWFzZUZsYWdzGAIgASgLMjIuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLkdhc1ByaWNlSW5jcmVhc2VGbGFnc0IEyN4fADoMguewKgdjcmVhdG9yIigKJk1zZ1VwZGF0ZUdhc1ByaWNlSW5jcmVhc2VGbGFnc1Jlc3BvbnNlIooBChlNc2dVcGRhdGVPcGVyYXRpb25hbEZsYWdzEg8KB2NyZWF0b3IYASABKAkSTgoRb3BlcmF0aW9uYWxfZmxhZ3MYAiABKAsyLS56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuT3BlcmF0aW9uYWxGbGFnc0IEyN4fADoMguewKgdjcmVhdG9yIiMKIU1zZ1VwZGF0ZU9wZXJhdGlvbmFsRmxhZ3NSZXNwb25zZSJNChpNc2dEaXNhYmxlRmFzdENvbmZpcm1hdGlvbhIPCgdjcmVhdG9yGAEgASgJEhAKCGNoYWluX2lkGAIgASgDOgyC57AqB2NyZWF0b3IiJAoiTXNnRGlzYWJsZUZhc3RDb25maXJtYXRpb25SZXNwb25zZSJOChRNc2dVcGRhdGVWMlpldGFGbG93cxIPCgdjcmVhdG9yGAEgASgJEhcKD2lzVjJaZXRhRW5hYmxlZBgCIAEoCDoMguewKgdjcmVhdG9yIh4KHE1zZ1VwZGF0ZVYyWmV0YUZsb3dzUmVzcG9uc2UyjREKA01zZxJvCgtBZGRPYnNlcnZlchIrLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dBZGRPYnNlcnZlchozLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dBZGRPYnNlcnZlclJlc3BvbnNlEngKDlJlbW92ZU9ic2VydmVyEi4uemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk1zZ1JlbW92ZU9ic2VydmVyGjYuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk1zZ1JlbW92ZU9ic2VydmVyUmVzcG9uc2USeAoOVXBkYXRlT2JzZXJ2ZXISLi56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTXNnVXBkYXRlT2JzZXJ2ZXIaNi56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTXNnVXBkYXRlT2JzZXJ2ZXJSZXNwb25zZRKBAQoRVXBkYXRlQ2hhaW5QYXJhbXMSMS56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTXNnVXBkYXRlQ2hhaW5QYXJhbXMaOS56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTXNnVXBkYXRlQ2hhaW5QYXJhbXNSZXNwb25zZRKBAQoRUmVtb3ZlQ2hhaW5QYXJhbXMSMS56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTXNnUmVtb3ZlQ2hhaW5QYXJhbXMaOS56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTXNnUmVtb3ZlQ2hhaW5QYXJhbXNSZXNwb25zZRJpCglWb3RlQmxhbWUSKS56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTXNnVm90ZUJsYW1lGjEuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk1zZ1ZvdGVCbGFtZVJlc3BvbnNlEnIKDFVwZGF0ZUtleWdlbhIsLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dVcGRhdGVLZXlnZW4aNC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTXNnVXBkYXRlS2V5Z2VuUmVzcG9uc2USewoPVm90ZUJsb2NrSGVhZGVyEi8uemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk1zZ1ZvdGVCbG9ja0hlYWRlcho3LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dWb3RlQmxvY2tIZWFkZXJSZXNwb25zZRJ+ChBSZXNldENoYWluTm9uY2VzEjAuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk1zZ1Jlc2V0Q2hhaW5Ob25jZXMaOC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTXNnUmVzZXRDaGFpbk5vbmNlc1Jlc3BvbnNlEmMKB1ZvdGVUU1MSJy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTXNnVm90ZVRTUxovLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dWb3RlVFNTUmVzcG9uc2USbAoKRW5hYmxlQ0NUWBIqLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dFbmFibGVDQ1RYGjIuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk1zZ0VuYWJsZUNDVFhSZXNwb25zZRJvCgtEaXNhYmxlQ0NUWBIrLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dEaXNhYmxlQ0NUWBozLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dEaXNhYmxlQ0NUWFJlc3BvbnNlEpMBChdEaXNhYmxlRmFzdENvbmZpcm1hdGlvbhI3LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dEaXNhYmxlRmFzdENvbmZpcm1hdGlvbho/LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dEaXNhYmxlRmFzdENvbmZpcm1hdGlvblJlc3BvbnNlEp8BChtVcGRhdGVHYXNQcmljZUluY3JlYXNlRmxhZ3MSOy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTXNnVXBkYXRlR2FzUHJpY2VJbmNyZWFzZUZsYWdzGkMuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk1zZ1VwZGF0ZUdhc1ByaWNlSW5jcmVhc2VGbGFnc1Jlc3BvbnNlEpABChZVcGRhdGVPcGVyYXRpb25hbEZsYWdzEjYuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk1zZ1VwZGF0ZU9wZXJhdGlvbmFsRmxhZ3MaPi56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTXNnVXBkYXRlT3BlcmF0aW9uYWxGbGFnc1Jlc3BvbnNlEqIBChxVcGRhdGVPcGVyYXRpb25hbENoYWluUGFyYW1zEjwuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk1zZ1VwZGF0ZU9wZXJhdGlvbmFsQ2hhaW5QYXJhbXMaRC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTXNnVXBkYXRlT3BlcmF0aW9uYWxDaGFpblBhcmFtc1Jlc3BvbnNlEoEBChFVcGRhdGVWMlpldGFGbG93cxIxLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dVcGRhdGVWMlpldGFGbG93cxo5LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dVcGRhdGVWMlpldGFGbG93c1Jlc3BvbnNlGgWA57AqAULlAQofY29tLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlckIHVHhQcm90b1ABWitnaXRodWIuY29tL3pldGEtY2hhaW4vbm9kZS94L29ic2VydmVyL3R5cGVzogIDWlpPqgIbWmV0YWNoYWluLlpldGFjb3JlLk9ic2VydmVyygIbWmV0YWNoYWluXFpldGFjb3JlXE9ic2VydmVy4gInWmV0YWNoYWluXFpldGFjb3JlXE9ic2VydmVyXEdQQk1ldGFkYXRh6gIdWmV0YWNoYWluOjpaZXRhY29yZTo6T2JzZXJ2ZXJiBnByb3RvMw", [file_gogoproto_gogo, file_zetachain_zetacore_observer_blame, file_zetachain_zetacore_observer_crosschain_flags, file_zetachain_zetacore_observer_observer, file_zetachain_zetacore_observer_chain_params, file_zetachain_zetacore_observer_pending_nonces, file_zetachain_zetacore_observer_tss, file_zetachain_zetacore_observer_operational, file_zetachain_zetacore_observer_confirmation_params, file_zetachain_zetacore_pkg_chains_chains, file_zetachain_zetacore_pkg_proofs_proofs, file_cosmos_msg_v1_msg]);
+  fileDesc("CiR6ZXRhY2hhaW4vemV0YWNvcmUvb2JzZXJ2ZXIvdHgucHJvdG8SG3pldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlciK4AQoRTXNnVXBkYXRlT2JzZXJ2ZXISDwoHY3JlYXRvchgBIAEoCRIcChRvbGRfb2JzZXJ2ZXJfYWRkcmVzcxgCIAEoCRIcChRuZXdfb2JzZXJ2ZXJfYWRkcmVzcxgDIAEoCRJICg11cGRhdGVfcmVhc29uGAQgASgOMjEuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk9ic2VydmVyVXBkYXRlUmVhc29uOgyC57AqB2NyZWF0b3IiGwoZTXNnVXBkYXRlT2JzZXJ2ZXJSZXNwb25zZSKqAQoSTXNnVm90ZUJsb2NrSGVhZGVyEg8KB2NyZWF0b3IYASABKAkSEAoIY2hhaW5faWQYAiABKAMSEgoKYmxvY2tfaGFzaBgDIAEoDBIOCgZoZWlnaHQYBCABKAMSPwoGaGVhZGVyGAUgASgLMikuemV0YWNoYWluLnpldGFjb3JlLnBrZy5wcm9vZnMuSGVhZGVyRGF0YUIEyN4fADoMguewKgdjcmVhdG9yIkwKGk1zZ1ZvdGVCbG9ja0hlYWRlclJlc3BvbnNlEhYKDmJhbGxvdF9jcmVhdGVkGAEgASgIEhYKDnZvdGVfZmluYWxpemVkGAIgASgIInQKFE1zZ1VwZGF0ZUNoYWluUGFyYW1zEg8KB2NyZWF0b3IYASABKAkSPQoLY2hhaW5QYXJhbXMYAiABKAsyKC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuQ2hhaW5QYXJhbXM6DILnsCoHY3JlYXRvciIeChxNc2dVcGRhdGVDaGFpblBhcmFtc1Jlc3BvbnNlIvUCCh9Nc2dVcGRhdGVPcGVyYXRpb25hbENoYWluUGFyYW1zEg8KB2NyZWF0b3IYASABKAkSEAoIY2hhaW5faWQYAiABKAMSGAoQZ2FzX3ByaWNlX3RpY2tlchgDIAEoBBIWCg5pbmJvdW5kX3RpY2tlchgEIAEoBBIXCg9vdXRib3VuZF90aWNrZXIYBSABKAQSGQoRd2F0Y2hfdXR4b190aWNrZXIYBiABKAQSIgoab3V0Ym91bmRfc2NoZWR1bGVfaW50ZXJ2YWwYByABKAMSIwobb3V0Ym91bmRfc2NoZWR1bGVfbG9va2FoZWFkGAggASgDElIKE2NvbmZpcm1hdGlvbl9wYXJhbXMYCSABKAsyLy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuQ29uZmlybWF0aW9uUGFyYW1zQgTI3h8AEh4KFmRpc2FibGVfdHNzX2Jsb2NrX3NjYW4YCiABKAg6DILnsCoHY3JlYXRvciIpCidNc2dVcGRhdGVPcGVyYXRpb25hbENoYWluUGFyYW1zUmVzcG9uc2UiRwoUTXNnUmVtb3ZlQ2hhaW5QYXJhbXMSDwoHY3JlYXRvchgBIAEoCRIQCghjaGFpbl9pZBgCIAEoAzoMguewKgdjcmVhdG9yIh4KHE1zZ1JlbW92ZUNoYWluUGFyYW1zUmVzcG9uc2UiiwEKDk1zZ0FkZE9ic2VydmVyEg8KB2NyZWF0b3IYASABKAkSGAoQb2JzZXJ2ZXJfYWRkcmVzcxgCIAEoCRIhChl6ZXRhY2xpZW50X2dyYW50ZWVfcHVia2V5GAMgASgJEh0KFWFkZF9ub2RlX2FjY291bnRfb25seRgEIAEoCDoMguewKgdjcmVhdG9yIhgKFk1zZ0FkZE9ic2VydmVyUmVzcG9uc2UiTAoRTXNnUmVtb3ZlT2JzZXJ2ZXISDwoHY3JlYXRvchgBIAEoCRIYChBvYnNlcnZlcl9hZGRyZXNzGAIgASgJOgyC57AqB2NyZWF0b3IiGwoZTXNnUmVtb3ZlT2JzZXJ2ZXJSZXNwb25zZSJ9CgxNc2dWb3RlQmxhbWUSDwoHY3JlYXRvchgBIAEoCRIQCghjaGFpbl9pZBgCIAEoAxI8CgpibGFtZV9pbmZvGAMgASgLMiIuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLkJsYW1lQgTI3h8AOgyC57AqB2NyZWF0b3IiFgoUTXNnVm90ZUJsYW1lUmVzcG9uc2UiTgoPTXNnVXBkYXRlS2V5Z2VuEg8KB2NyZWF0b3IYASABKAkSDQoFYmxvY2sYAiABKAMSDQoFZWRkc2EYAyABKAg6DILnsCoHY3JlYXRvciIZChdNc2dVcGRhdGVLZXlnZW5SZXNwb25zZSJ5ChNNc2dSZXNldENoYWluTm9uY2VzEg8KB2NyZWF0b3IYASABKAkSEAoIY2hhaW5faWQYAiABKAMSFwoPY2hhaW5fbm9uY2VfbG93GAMgASgDEhgKEGNoYWluX25vbmNlX2hpZ2gYBCABKAM6DILnsCoHY3JlYXRvciIdChtNc2dSZXNldENoYWluTm9uY2VzUmVzcG9uc2UiswEKCk1zZ1ZvdGVUU1MSDwoHY3JlYXRvchgBIAEoCRISCgp0c3NfcHVia2V5GAIgASgJEhoKEmtleWdlbl96ZXRhX2hlaWdodBgDIAEoAxI8CgZzdGF0dXMYBCABKA4yLC56ZXRhY2hhaW4uemV0YWNvcmUucGtnLmNoYWlucy5SZWNlaXZlU3RhdHVzEhgKEHRzc19wdWJrZXlfZWRkc2EYBSABKAk6DILnsCoHY3JlYXRvciJcChJNc2dWb3RlVFNTUmVzcG9uc2USFgoOYmFsbG90X2NyZWF0ZWQYASABKAgSFgoOdm90ZV9maW5hbGl6ZWQYAiABKAgSFgoOa2V5Z2VuX3N1Y2Nlc3MYAyABKAgiXQoNTXNnRW5hYmxlQ0NUWBIPCgdjcmVhdG9yGAEgASgJEhUKDWVuYWJsZUluYm91bmQYAiABKAgSFgoOZW5hYmxlT3V0Ym91bmQYAyABKAg6DILnsCoHY3JlYXRvciIXChVNc2dFbmFibGVDQ1RYUmVzcG9uc2UiYAoOTXNnRGlzYWJsZUNDVFgSDwoHY3JlYXRvchgBIAEoCRIWCg5kaXNhYmxlSW5ib3VuZBgCIAEoCBIXCg9kaXNhYmxlT3V0Ym91bmQYAyABKAg6DILnsCoHY3JlYXRvciIYChZNc2dEaXNhYmxlQ0NUWFJlc3BvbnNlIpgBCh5Nc2dVcGRhdGVHYXNQcmljZUluY3JlYXNlRmxhZ3MSDwoHY3JlYXRvchgBIAEoCRJXChVnYXNQcmljZUluY3JlYXNlRmxhZ3MYAiABKAsyMi56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuR2FzUHJpY2VJbmNyZWFzZUZsYWdzQgTI3h8AOgyC57AqB2NyZWF0b3IiKAomTXNnVXBkYXRlR2FzUHJpY2VJbmNyZWFzZUZsYWdzUmVzcG9uc2UiigEKGU1zZ1VwZGF0ZU9wZXJhdGlvbmFsRmxhZ3MSDwoHY3JlYXRvchgBIAEoCRJOChFvcGVyYXRpb25hbF9mbGFncxgCIAEoCzItLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5PcGVyYXRpb25hbEZsYWdzQgTI3h8AOgyC57AqB2NyZWF0b3IiIwohTXNnVXBkYXRlT3BlcmF0aW9uYWxGbGFnc1Jlc3BvbnNlIk0KGk1zZ0Rpc2FibGVGYXN0Q29uZmlybWF0aW9uEg8KB2NyZWF0b3IYASABKAkSEAoIY2hhaW5faWQYAiABKAM6DILnsCoHY3JlYXRvciIkCiJNc2dEaXNhYmxlRmFzdENvbmZpcm1hdGlvblJlc3BvbnNlIk4KFE1zZ1VwZGF0ZVYyWmV0YUZsb3dzEg8KB2NyZWF0b3IYASABKAkSFwoPaXNWMlpldGFFbmFibGVkGAIgASgIOgyC57AqB2NyZWF0b3IiHgocTXNnVXBkYXRlVjJaZXRhRmxvd3NSZXNwb25zZSLDAQobTXNnUHJvcG9zZU9ic2VydmVyU2V0Q2hhbmdlEg8KB2NyZWF0b3IYASABKAkSSQoJYWRkaXRpb25zGAIgAygLMjAuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk9ic2VydmVyU2V0QWRkaXRpb25CBMjeHwASEAoIcmVtb3ZhbHMYAyADKAkSGQoRYWN0aXZhdGlvbl9oZWlnaHQYBCABKAMSDQoFZWRkc2EYBSABKAg6DILnsCoHY3JlYXRvciIlCiNNc2dQcm9wb3NlT2JzZXJ2ZXJTZXRDaGFuZ2VSZXNwb25zZSIyChFNc2dVbmphaWxPYnNlcnZlchIPCgdjcmVhdG9yGAEgASgJOgyC57AqB2NyZWF0b3IiGwoZTXNnVW5qYWlsT2JzZXJ2ZXJSZXNwb25zZSJrCiBNc2dVcGRhdGVQYXJlbnRSZXZlcnRJbmhlcml0YW5jZRIPCgdjcmVhdG9yGAEgASgJEigKIGlzUGFyZW50UmV2ZXJ0SW5oZXJpdGFuY2VFbmFibGVkGAIgASgIOgyC57AqB2NyZWF0b3IiKgooTXNnVXBkYXRlUGFyZW50UmV2ZXJ0SW5oZXJpdGFuY2VSZXNwb25zZSI7ChpNc2dDYW5jZWxPYnNlcnZlclNldENoYW5nZRIPCgdjcmVhdG9yGAEgASgJOgyC57AqB2NyZWF0b3IiJAoiTXNnQ2FuY2VsT2JzZXJ2ZXJTZXRDaGFuZ2VSZXNwb25zZSKEAQoXTXNnVXBkYXRlTGl2ZW5lc3NQYXJhbXMSDwoHY3JlYXRvchgBIAEoCRJKCg9saXZlbmVzc19wYXJhbXMYAiABKAsyKy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTGl2ZW5lc3NQYXJhbXNCBMjeHwA6DILnsCoHY3JlYXRvciIhCh9Nc2dVcGRhdGVMaXZlbmVzc1BhcmFtc1Jlc3BvbnNlMusWCgNNc2cSbwoLQWRkT2JzZXJ2ZXISKy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTXNnQWRkT2JzZXJ2ZXIaMy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTXNnQWRkT2JzZXJ2ZXJSZXNwb25zZRJ4Cg5SZW1vdmVPYnNlcnZlchIuLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dSZW1vdmVPYnNlcnZlcho2LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dSZW1vdmVPYnNlcnZlclJlc3BvbnNlEngKDlVwZGF0ZU9ic2VydmVyEi4uemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk1zZ1VwZGF0ZU9ic2VydmVyGjYuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk1zZ1VwZGF0ZU9ic2VydmVyUmVzcG9uc2USgQEKEVVwZGF0ZUNoYWluUGFyYW1zEjEuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk1zZ1VwZGF0ZUNoYWluUGFyYW1zGjkuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk1zZ1VwZGF0ZUNoYWluUGFyYW1zUmVzcG9uc2USgQEKEVJlbW92ZUNoYWluUGFyYW1zEjEuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk1zZ1JlbW92ZUNoYWluUGFyYW1zGjkuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk1zZ1JlbW92ZUNoYWluUGFyYW1zUmVzcG9uc2USaQoJVm90ZUJsYW1lEikuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk1zZ1ZvdGVCbGFtZRoxLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dWb3RlQmxhbWVSZXNwb25zZRJyCgxVcGRhdGVLZXlnZW4SLC56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTXNnVXBkYXRlS2V5Z2VuGjQuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk1zZ1VwZGF0ZUtleWdlblJlc3BvbnNlEnsKD1ZvdGVCbG9ja0hlYWRlchIvLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dWb3RlQmxvY2tIZWFkZXIaNy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTXNnVm90ZUJsb2NrSGVhZGVyUmVzcG9uc2USfgoQUmVzZXRDaGFpbk5vbmNlcxIwLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dSZXNldENoYWluTm9uY2VzGjguemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk1zZ1Jlc2V0Q2hhaW5Ob25jZXNSZXNwb25zZRJjCgdWb3RlVFNTEicuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk1zZ1ZvdGVUU1MaLy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTXNnVm90ZVRTU1Jlc3BvbnNlEmwKCkVuYWJsZUNDVFgSKi56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTXNnRW5hYmxlQ0NUWBoyLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dFbmFibGVDQ1RYUmVzcG9uc2USbwoLRGlzYWJsZUNDVFgSKy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTXNnRGlzYWJsZUNDVFgaMy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTXNnRGlzYWJsZUNDVFhSZXNwb25zZRKTAQoXRGlzYWJsZUZhc3RDb25maXJtYXRpb24SNy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTXNnRGlzYWJsZUZhc3RDb25maXJtYXRpb24aPy56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTXNnRGlzYWJsZUZhc3RDb25maXJtYXRpb25SZXNwb25zZRKfAQobVXBkYXRlR2FzUHJpY2VJbmNyZWFzZUZsYWdzEjsuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk1zZ1VwZGF0ZUdhc1ByaWNlSW5jcmVhc2VGbGFncxpDLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dVcGRhdGVHYXNQcmljZUluY3JlYXNlRmxhZ3NSZXNwb25zZRKQAQoWVXBkYXRlT3BlcmF0aW9uYWxGbGFncxI2LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dVcGRhdGVPcGVyYXRpb25hbEZsYWdzGj4uemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk1zZ1VwZGF0ZU9wZXJhdGlvbmFsRmxhZ3NSZXNwb25zZRKiAQocVXBkYXRlT3BlcmF0aW9uYWxDaGFpblBhcmFtcxI8LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dVcGRhdGVPcGVyYXRpb25hbENoYWluUGFyYW1zGkQuemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk1zZ1VwZGF0ZU9wZXJhdGlvbmFsQ2hhaW5QYXJhbXNSZXNwb25zZRKBAQoRVXBkYXRlVjJaZXRhRmxvd3MSMS56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTXNnVXBkYXRlVjJaZXRhRmxvd3MaOS56ZXRhY2hhaW4uemV0YWNvcmUub2JzZXJ2ZXIuTXNnVXBkYXRlVjJaZXRhRmxvd3NSZXNwb25zZRKWAQoYUHJvcG9zZU9ic2VydmVyU2V0Q2hhbmdlEjguemV0YWNoYWluLnpldGFjb3JlLm9ic2VydmVyLk1zZ1Byb3Bvc2VPYnNlcnZlclNldENoYW5nZRpALnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dQcm9wb3NlT2JzZXJ2ZXJTZXRDaGFuZ2VSZXNwb25zZRJ4Cg5VbmphaWxPYnNlcnZlchIuLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dVbmphaWxPYnNlcnZlcho2LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dVbmphaWxPYnNlcnZlclJlc3BvbnNlEqUBCh1VcGRhdGVQYXJlbnRSZXZlcnRJbmhlcml0YW5jZRI9LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dVcGRhdGVQYXJlbnRSZXZlcnRJbmhlcml0YW5jZRpFLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dVcGRhdGVQYXJlbnRSZXZlcnRJbmhlcml0YW5jZVJlc3BvbnNlEpMBChdDYW5jZWxPYnNlcnZlclNldENoYW5nZRI3LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dDYW5jZWxPYnNlcnZlclNldENoYW5nZRo/LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dDYW5jZWxPYnNlcnZlclNldENoYW5nZVJlc3BvbnNlEooBChRVcGRhdGVMaXZlbmVzc1BhcmFtcxI0LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dVcGRhdGVMaXZlbmVzc1BhcmFtcxo8LnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlci5Nc2dVcGRhdGVMaXZlbmVzc1BhcmFtc1Jlc3BvbnNlGgWA57AqAULlAQofY29tLnpldGFjaGFpbi56ZXRhY29yZS5vYnNlcnZlckIHVHhQcm90b1ABWitnaXRodWIuY29tL3pldGEtY2hhaW4vbm9kZS94L29ic2VydmVyL3R5cGVzogIDWlpPqgIbWmV0YWNoYWluLlpldGFjb3JlLk9ic2VydmVyygIbWmV0YWNoYWluXFpldGFjb3JlXE9ic2VydmVy4gInWmV0YWNoYWluXFpldGFjb3JlXE9ic2VydmVyXEdQQk1ldGFkYXRh6gIdWmV0YWNoYWluOjpaZXRhY29yZTo6T2JzZXJ2ZXJiBnByb3RvMw", [file_gogoproto_gogo, file_zetachain_zetacore_observer_blame, file_zetachain_zetacore_observer_crosschain_flags, file_zetachain_zetacore_observer_observer, file_zetachain_zetacore_observer_observer_set_change, file_zetachain_zetacore_observer_chain_params, file_zetachain_zetacore_observer_pending_nonces, file_zetachain_zetacore_observer_tss, file_zetachain_zetacore_observer_operational, file_zetachain_zetacore_observer_liveness, file_zetachain_zetacore_observer_confirmation_params, file_zetachain_zetacore_pkg_chains_chains, file_zetachain_zetacore_pkg_proofs_proofs, file_cosmos_msg_v1_msg]);
 
 /**
  * @generated from message zetachain.zetacore.observer.MsgUpdateObserver
@@ -414,6 +418,13 @@ export type MsgUpdateKeygen = Message<"zetachain.zetacore.observer.MsgUpdateKeyg
    * @generated from field: int64 block = 2;
    */
   block: bigint;
+
+  /**
+   * generate an ed25519 key alongside the secp256k1 key
+   *
+   * @generated from field: bool eddsa = 3;
+   */
+  eddsa: boolean;
 };
 
 /**
@@ -504,6 +515,13 @@ export type MsgVoteTSS = Message<"zetachain.zetacore.observer.MsgVoteTSS"> & {
    * @generated from field: zetachain.zetacore.pkg.chains.ReceiveStatus status = 4;
    */
   status: ReceiveStatus;
+
+  /**
+   * ed25519 public key, required if the keygen generates an ed25519 key
+   *
+   * @generated from field: string tss_pubkey_eddsa = 5;
+   */
+  tssPubkeyEddsa: string;
 };
 
 /**
@@ -765,6 +783,208 @@ export type MsgUpdateV2ZetaFlowsResponse = Message<"zetachain.zetacore.observer.
 export const MsgUpdateV2ZetaFlowsResponseSchema: GenMessage<MsgUpdateV2ZetaFlowsResponse> = /*@__PURE__*/
   messageDesc(file_zetachain_zetacore_observer_tx, 33);
 
+/**
+ * MsgProposeObserverSetChange stages a change of the observer set that is
+ * activated once the keygen for the new observer set succeeded and the funds
+ * are migrated to the new TSS.
+ * The fund migration is manual: the admin has to migrate the funds
+ * (MsgMigrateTssFunds) and update the TSS address (MsgUpdateTssAddress),
+ * otherwise the change stays awaiting activation until it is cancelled
+ * (MsgCancelObserverSetChange).
+ *
+ * @generated from message zetachain.zetacore.observer.MsgProposeObserverSetChange
+ */
+export type MsgProposeObserverSetChange = Message<"zetachain.zetacore.observer.MsgProposeObserverSetChange"> & {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.ObserverSetAddition additions = 2;
+   */
+  additions: ObserverSetAddition[];
+
+  /**
+   * @generated from field: repeated string removals = 3;
+   */
+  removals: string[];
+
+  /**
+   * @generated from field: int64 activation_height = 4;
+   */
+  activationHeight: bigint;
+
+  /**
+   * generate an ed25519 key alongside the secp256k1 key
+   *
+   * @generated from field: bool eddsa = 5;
+   */
+  eddsa: boolean;
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.MsgProposeObserverSetChange.
+ * Use `create(MsgProposeObserverSetChangeSchema)` to create a new message.
+ */
+export const MsgProposeObserverSetChangeSchema: GenMessage<MsgProposeObserverSetChange> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_tx, 34);
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgProposeObserverSetChangeResponse
+ */
+export type MsgProposeObserverSetChangeResponse = Message<"zetachain.zetacore.observer.MsgProposeObserverSetChangeResponse"> & {
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.MsgProposeObserverSetChangeResponse.
+ * Use `create(MsgProposeObserverSetChangeResponseSchema)` to create a new message.
+ */
+export const MsgProposeObserverSetChangeResponseSchema: GenMessage<MsgProposeObserverSetChangeResponse> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_tx, 35);
+
+/**
+ * MsgUnjailObserver unjails an observer jailed for missing ballots, once the
+ * jail period is over
+ *
+ * @generated from message zetachain.zetacore.observer.MsgUnjailObserver
+ */
+export type MsgUnjailObserver = Message<"zetachain.zetacore.observer.MsgUnjailObserver"> & {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.MsgUnjailObserver.
+ * Use `create(MsgUnjailObserverSchema)` to create a new message.
+ */
+export const MsgUnjailObserverSchema: GenMessage<MsgUnjailObserver> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_tx, 36);
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgUnjailObserverResponse
+ */
+export type MsgUnjailObserverResponse = Message<"zetachain.zetacore.observer.MsgUnjailObserverResponse"> & {
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.MsgUnjailObserverResponse.
+ * Use `create(MsgUnjailObserverResponseSchema)` to create a new message.
+ */
+export const MsgUnjailObserverResponseSchema: GenMessage<MsgUnjailObserverResponse> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_tx, 37);
+
+/**
+ * MsgUpdateParentRevertInheritance updates the isParentRevertInheritanceEnabled
+ * crosschain flag
+ *
+ * @generated from message zetachain.zetacore.observer.MsgUpdateParentRevertInheritance
+ */
+export type MsgUpdateParentRevertInheritance = Message<"zetachain.zetacore.observer.MsgUpdateParentRevertInheritance"> & {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: bool isParentRevertInheritanceEnabled = 2;
+   */
+  isParentRevertInheritanceEnabled: boolean;
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.MsgUpdateParentRevertInheritance.
+ * Use `create(MsgUpdateParentRevertInheritanceSchema)` to create a new message.
+ */
+export const MsgUpdateParentRevertInheritanceSchema: GenMessage<MsgUpdateParentRevertInheritance> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_tx, 38);
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgUpdateParentRevertInheritanceResponse
+ */
+export type MsgUpdateParentRevertInheritanceResponse = Message<"zetachain.zetacore.observer.MsgUpdateParentRevertInheritanceResponse"> & {
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.MsgUpdateParentRevertInheritanceResponse.
+ * Use `create(MsgUpdateParentRevertInheritanceResponseSchema)` to create a new message.
+ */
+export const MsgUpdateParentRevertInheritanceResponseSchema: GenMessage<MsgUpdateParentRevertInheritanceResponse> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_tx, 39);
+
+/**
+ * MsgCancelObserverSetChange rolls back the observer set change in progress,
+ * as long as the TSS generated for the new observer set is not the current TSS
+ *
+ * @generated from message zetachain.zetacore.observer.MsgCancelObserverSetChange
+ */
+export type MsgCancelObserverSetChange = Message<"zetachain.zetacore.observer.MsgCancelObserverSetChange"> & {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.MsgCancelObserverSetChange.
+ * Use `create(MsgCancelObserverSetChangeSchema)` to create a new message.
+ */
+export const MsgCancelObserverSetChangeSchema: GenMessage<MsgCancelObserverSetChange> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_tx, 40);
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgCancelObserverSetChangeResponse
+ */
+export type MsgCancelObserverSetChangeResponse = Message<"zetachain.zetacore.observer.MsgCancelObserverSetChangeResponse"> & {
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.MsgCancelObserverSetChangeResponse.
+ * Use `create(MsgCancelObserverSetChangeResponseSchema)` to create a new message.
+ */
+export const MsgCancelObserverSetChangeResponseSchema: GenMessage<MsgCancelObserverSetChangeResponse> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_tx, 41);
+
+/**
+ * MsgUpdateLivenessParams updates the parameters of the tracking of the
+ * participation of the observers in the ballots
+ *
+ * @generated from message zetachain.zetacore.observer.MsgUpdateLivenessParams
+ */
+export type MsgUpdateLivenessParams = Message<"zetachain.zetacore.observer.MsgUpdateLivenessParams"> & {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: zetachain.zetacore.observer.LivenessParams liveness_params = 2;
+   */
+  livenessParams?: LivenessParams;
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.MsgUpdateLivenessParams.
+ * Use `create(MsgUpdateLivenessParamsSchema)` to create a new message.
+ */
+export const MsgUpdateLivenessParamsSchema: GenMessage<MsgUpdateLivenessParams> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_tx, 42);
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgUpdateLivenessParamsResponse
+ */
+export type MsgUpdateLivenessParamsResponse = Message<"zetachain.zetacore.observer.MsgUpdateLivenessParamsResponse"> & {
+};
+
+/**
+ * Describes the message zetachain.zetacore.observer.MsgUpdateLivenessParamsResponse.
+ * Use `create(MsgUpdateLivenessParamsResponseSchema)` to create a new message.
+ */
+export const MsgUpdateLivenessParamsResponseSchema: GenMessage<MsgUpdateLivenessParamsResponse> = /*@__PURE__*/
+  messageDesc(file_zetachain_zetacore_observer_tx, 43);
+
 /**
  * Msg defines the Msg service.
  *
@@ -907,6 +1127,46 @@ export const Msg: GenService<{
     input: typeof MsgUpdateV2ZetaFlowsSchema;
     output: typeof MsgUpdateV2ZetaFlowsResponseSchema;
   },
+  /**
+   * @generated from rpc zetachain.zetacore.observer.Msg.ProposeObserverSetChange
+   */
+  proposeObserverSetChange: {
+    methodKind: "unary";
+    input: typeof MsgProposeObserverSetChangeSchema;
+    output: typeof MsgProposeObserverSetChangeResponseSchema;
+  },
+  /**
+   * @generated from rpc zetachain.zetacore.observer.Msg.UnjailObserver
+   */
+  unjailObserver: {
+    methodKind: "unary";
+    input: typeof MsgUnjailObserverSchema;
+    output: typeof MsgUnjailObserverResponseSchema;
+  },
+  /**
+   * @generated from rpc zetachain.zetacore.observer.Msg.UpdateParentRevertInheritance
+   */
+  updateParentRevertInheritance: {
+    methodKind: "unary";
+    input: typeof MsgUpdateParentRevertInheritanceSchema;
+    output: typeof MsgUpdateParentRevertInheritanceResponseSchema;
+  },
+  /**
+   * @generated from rpc zetachain.zetacore.observer.Msg.CancelObserverSetChange
+   */
+  cancelObserverSetChange: {
+    methodKind: "unary";
+    input: typeof MsgCancelObserverSetChangeSchema;
+    output: typeof MsgCancelObserverSetChangeResponseSchema;
+  },
+  /**
+   * @generated from rpc zetachain.zetacore.observer.Msg.UpdateLivenessParams
+   */
+  updateLivenessParams: {
+    methodKind: "unary";
+    input: typeof MsgUpdateLivenessParamsSchema;
+    output: typeof MsgUpdateLivenessParamsResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_zetachain_zetacore_observer_tx, 0);
 
@@ -6,6 +6,7 @@ import (
 	v4 "github.com/zeta-chain/node/x/authority/migrations/v4"
 	v5 "github.com/zeta-chain/node/x/authority/migrations/v5"
 	v6 "github.com/zeta-chain/node/x/authority/migrations/v6"
+	v7 "github.com/zeta-chain/node/x/authority/migrations/v7"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -34,3 +35,8 @@ func (m Migrator) Migrate4to5(ctx sdk.Context) error {
 func (m Migrator) Migrate5to6(ctx sdk.Context) error {
 	return v6.MigrateStore(ctx, m.authorityKeeper)
 }
+
+// Migrate6to7 migrates the authority store from consensus version 6 to 7
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v7.MigrateStore(ctx, m.authorityKeeper)
+}
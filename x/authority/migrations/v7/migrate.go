@@ -0,0 +1,56 @@
+package v7
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/node/x/authority/types"
+)
+
+type authorityKeeper interface {
+	SetAuthorizationList(ctx sdk.Context, list types.AuthorizationList)
+	GetAuthorizationList(ctx sdk.Context) (val types.AuthorizationList, found bool)
+}
+
+// MigrateStore migrates the authority module state from the consensus version 6 to 7
+func MigrateStore(
+	ctx sdk.Context,
+	keeper authorityKeeper,
+) error {
+	var (
+		authorizationList                     = types.DefaultAuthorizationsList()
+		proposeObserverSetChangeAuthorization = types.Authorization{
+			MsgUrl:           "/zetachain.zetacore.observer.MsgProposeObserverSetChange",
+			AuthorizedPolicy: types.PolicyType_groupAdmin,
+		}
+		updateParentRevertInheritanceAuthorization = types.Authorization{
+			MsgUrl:           "/zetachain.zetacore.observer.MsgUpdateParentRevertInheritance",
+			AuthorizedPolicy: types.PolicyType_groupOperational,
+		}
+		cancelObserverSetChangeAuthorization = types.Authorization{
+			MsgUrl:           "/zetachain.zetacore.observer.MsgCancelObserverSetChange",
+			AuthorizedPolicy: types.PolicyType_groupAdmin,
+		}
+		updateLivenessParamsAuthorization = types.Authorization{
+			MsgUrl:           "/zetachain.zetacore.observer.MsgUpdateLivenessParams",
+			AuthorizedPolicy: types.PolicyType_groupAdmin,
+		}
+	)
+
+	al, found := keeper.GetAuthorizationList(ctx)
+	if found {
+		authorizationList = al
+	}
+
+	authorizationList.SetAuthorization(proposeObserverSetChangeAuthorization)
+	authorizationList.SetAuthorization(updateParentRevertInheritanceAuthorization)
+	authorizationList.SetAuthorization(cancelObserverSetChangeAuthorization)
+	authorizationList.SetAuthorization(updateLivenessParamsAuthorization)
+
+	// Validate the authorization list
+	err := authorizationList.Validate()
+	if err != nil {
+		return err
+	}
+	keeper.SetAuthorizationList(ctx, authorizationList)
+	return nil
+}
@@ -20,7 +20,7 @@ import (
 	"github.com/zeta-chain/node/x/authority/types"
 )
 
-const consensusVersion = 6
+const consensusVersion = 7
 
 var (
 	_ module.AppModule      = AppModule{}
@@ -134,6 +134,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6); err != nil {
 		panic(err)
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 6, m.Migrate6to7); err != nil {
+		panic(err)
+	}
 }
 
 // RegisterInvariants registers the authority module's invariants.
@@ -23,6 +23,7 @@ var (
 		"/zetachain.zetacore.observer.MsgUpdateOperationalFlags",
 		"/zetachain.zetacore.observer.MsgUpdateOperationalChainParams",
 		"/zetachain.zetacore.observer.MsgUpdateV2ZetaFlows",
+		"/zetachain.zetacore.observer.MsgUpdateParentRevertInheritance",
 	}
 	// AdminPolicyMessages keeps track of the message URLs that can, by default, only be executed by admin policy address
 	AdminPolicyMessages = []string{
@@ -46,6 +47,9 @@ var (
 		"/zetachain.zetacore.lightclient.MsgEnableHeaderVerification",
 		"/zetachain.zetacore.observer.MsgUpdateChainParams",
 		"/zetachain.zetacore.fungible.MsgBurnFungibleModuleAsset",
+		"/zetachain.zetacore.observer.MsgProposeObserverSetChange",
+		"/zetachain.zetacore.observer.MsgCancelObserverSetChange",
+		"/zetachain.zetacore.observer.MsgUpdateLivenessParams",
 	}
 	// EmergencyPolicyMessages keeps track of the message URLs that can, by default, only be executed by emergency policy address
 	EmergencyPolicyMessages = []string{
@@ -402,6 +402,7 @@ func TestDefaultAuthorizationsList(t *testing.T) {
 			sdk.MsgTypeURL(&observertypes.MsgUpdateOperationalFlags{}),
 			sdk.MsgTypeURL(&observertypes.MsgUpdateOperationalChainParams{}),
 			sdk.MsgTypeURL(&observertypes.MsgUpdateV2ZetaFlows{}),
+			sdk.MsgTypeURL(&observertypes.MsgUpdateParentRevertInheritance{}),
 		}
 
 		// EmergencyPolicyMessageList is a list of messages that can be authorized by the emergency policy
@@ -439,6 +440,9 @@ func TestDefaultAuthorizationsList(t *testing.T) {
 			sdk.MsgTypeURL(&lightclienttypes.MsgEnableHeaderVerification{}),
 			sdk.MsgTypeURL(&observertypes.MsgUpdateChainParams{}),
 			sdk.MsgTypeURL(&fungibletypes.MsgBurnFungibleModuleAsset{}),
+			sdk.MsgTypeURL(&observertypes.MsgProposeObserverSetChange{}),
+			sdk.MsgTypeURL(&observertypes.MsgCancelObserverSetChange{}),
+			sdk.MsgTypeURL(&observertypes.MsgUpdateLivenessParams{}),
 		}
 		defaultList := types.DefaultAuthorizationsList()
 		for _, msgUrl := range OperationalPolicyMessageList {
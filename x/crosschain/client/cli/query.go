@@ -30,6 +30,7 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdShowSend(),
 		CmdLastZetaHeight(),
 		CmdInboundHashToCctxData(),
+		CmdCctxTree(),
 		CmdListInboundHashToCctx(),
 		CmdShowInboundHashToCctx(),
 
@@ -41,6 +42,7 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdListPendingCCTXWithinRateLimit(),
 
 		CmdShowUpdateRateLimiterFlags(),
+		CmdSimulateInbound(),
 	)
 
 	return cmd
@@ -102,3 +102,33 @@ func CmdInboundHashToCctxData() *cobra.Command {
 
 	return cmd
 }
+
+func CmdCctxTree() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cctx-tree [inbound-hash]",
+		Short: "query the tree of cctxs created from a inbound hash",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argInboundHash := args[0]
+
+			params := &types.QueryCctxTreeRequest{
+				InboundHash: argInboundHash,
+			}
+
+			res, err := queryClient.CctxTree(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
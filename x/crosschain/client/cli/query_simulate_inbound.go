@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/zeta-chain/node/pkg/coin"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+const flagIsCrossChainCall = "is-cross-chain-call"
+
+func CmdSimulateInbound() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-inbound [senderChainID] [sender] [receiver] [coinType] [asset] [amount] [message]",
+		Short: "simulate the processing of an inbound on ZetaChain without changing the state",
+		Example: `zetacored query crosschain simulate-inbound 8453 0xfa233D806C8EB69548F3c4bC0ABb46FaD4e2EB26 ` +
+			`0xfa233D806C8EB69548F3c4bC0ABb46FaD4e2EB26 Gas 0x0000000000000000000000000000000000000000 1000000 "" ` +
+			`--is-cross-chain-call=false`,
+		Args: cobra.ExactArgs(7),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			senderChainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			coinType, ok := coin.CoinType_value[args[3]]
+			if !ok {
+				return fmt.Errorf("wrong coin type %s", args[3])
+			}
+
+			isCrossChainCall, err := cmd.Flags().GetBool(flagIsCrossChainCall)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SimulateInbound(context.Background(), &types.QuerySimulateInboundRequest{
+				SenderChainId:    senderChainID,
+				Sender:           args[1],
+				Receiver:         args[2],
+				CoinType:         coin.CoinType(coinType),
+				Asset:            args[4],
+				Amount:           args[5],
+				Message:          args[6],
+				IsCrossChainCall: isCrossChainCall,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flagIsCrossChainCall, false, "whether the inbound calls the receiver contract")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
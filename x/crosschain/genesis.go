@@ -36,10 +36,14 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 	// Set the cross-chain transactions only,
 	// We don't need to call SaveCCTXUpdate as the other fields are being set already
+	// The links between parent and child cctxs are not exported and are set from the cctxs
 	for _, elem := range genState.CrossChainTxs {
 		if elem != nil {
 			cctx := *elem
 			k.SetCrossChainTx(ctx, cctx)
+			if cctx.ParentIndex != "" {
+				k.SetCctxChild(ctx, cctx.ParentIndex, cctx.Index)
+			}
 		}
 	}
 
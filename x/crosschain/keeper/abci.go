@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/zeta-chain/node/pkg/chains"
+	cctxerror "github.com/zeta-chain/node/pkg/errors"
 	mathpkg "github.com/zeta-chain/node/pkg/math"
 	"github.com/zeta-chain/node/x/crosschain/types"
 	observertypes "github.com/zeta-chain/node/x/observer/types"
@@ -17,6 +18,9 @@ import (
 const (
 	// RemainingFeesToStabilityPoolPercent is the percentage of remaining fees used to fund the gas stability pool
 	RemainingFeesToStabilityPoolPercent = 95
+
+	// OutboundExpiryCheckInterval is the interval in blocks at which the pending outbounds are checked for expiry
+	OutboundExpiryCheckInterval = 10
 )
 
 // CheckAndUpdateCCTXGasPriceFunc is a function type for checking and updating the gas price of a cctx
@@ -105,6 +109,60 @@ IterateChains:
 	return cctxCount, gasPriceIncreaseFlags
 }
 
+// IterateAndExpirePendingOutbounds expires the pending outbounds of the cctxs whose inbound deadline is passed.
+// A pending outbound may already be signed with its TSS nonce so the cctx can't be reverted right away,
+// the observers cancel the expired outbound instead and vote it as failed, which reverts the cctx.
+// An outbound executed before the cancellation is observed and processed as usual.
+// The function returns the number of outbounds expired.
+func (k Keeper) IterateAndExpirePendingOutbounds(ctx sdk.Context, supportedChains []chains.Chain) int {
+	if ctx.BlockHeight()%OutboundExpiryCheckInterval != 0 {
+		return 0
+	}
+
+	additionalChains := k.GetAuthorityKeeper().GetAdditionalChainList(ctx)
+
+	cctxCount := 0
+	for _, chain := range supportedChains {
+		if chains.IsZetaChain(chain.ChainId, additionalChains) {
+			continue
+		}
+
+		res, err := k.ListPendingCctx(ctx, &types.QueryListPendingCctxRequest{
+			ChainId: chain.ChainId,
+		})
+		if err != nil {
+			ctx.Logger().Info("OutboundExpiry: fetching pending cctx failed",
+				"chainID", chain.ChainId,
+				"err", err.Error(),
+			)
+			continue
+		}
+
+		for _, pendingCctx := range res.CrossChainTx {
+			if pendingCctx == nil ||
+				pendingCctx.CctxStatus.Status != types.CctxStatus_PendingOutbound ||
+				pendingCctx.GetCurrentOutboundParam().IsExpired ||
+				!pendingCctx.InboundParams.IsExpired(ctx.BlockTime()) {
+				continue
+			}
+
+			pendingCctx.GetCurrentOutboundParam().IsExpired = true
+			pendingCctx.CctxStatus.UpdateErrorMessages(types.StatusMessages{
+				StatusMessage: "inbound deadline passed, cancelling outbound",
+				ErrorMessageOutbound: cctxerror.NewCCTXErrorJSONMessage(
+					"outbound expired",
+					inboundExpiredError(ctx, pendingCctx),
+				),
+			})
+			k.SetCrossChainTx(ctx, *pendingCctx)
+			EmitEventInboundExpired(ctx, pendingCctx)
+			cctxCount++
+		}
+	}
+
+	return cctxCount
+}
+
 // CheckAndUpdateCCTXGasPrice checks if the retry interval is reached and updates the gas price if so
 // The function returns the gas price increase and the additional fees paid from the gas stability pool
 func CheckAndUpdateCCTXGasPrice(
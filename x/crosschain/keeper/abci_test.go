@@ -770,3 +770,52 @@ func mkCustomCCTX(t *testing.T, time time.Time, chainID int64, gasPrice string,
 		},
 	}
 }
+
+func TestKeeper_IterateAndExpirePendingOutbounds(t *testing.T) {
+	k, ctx, _, zk := testkeeper.CrosschainKeeper(t)
+
+	supportedChains := []chains.Chain{
+		{ChainId: chains.Ethereum.ChainId},
+		{ChainId: chains.ZetaChainMainnet.ChainId},
+	}
+
+	// set pending cctx
+	tss := sample.Tss()
+	zk.ObserverKeeper.SetTSS(ctx, tss)
+	cctxs := createCctxWithNonceRange(t, ctx, *k, 10, 13, chains.Ethereum.ChainId, tss, zk)
+	zetaCctxs := createCctxWithNonceRange(t, ctx, *k, 20, 21, chains.ZetaChainMainnet.ChainId, tss, zk)
+
+	// the first cctx is expired, the second one is not yet, the third one has no deadline
+	blockTime := time.Unix(1_000_000, 0)
+	setDeadline := func(cctx *types.CrossChainTx, deadline uint64) {
+		cctx.InboundParams.Deadline = deadline
+		k.SetCrossChainTx(ctx, *cctx)
+	}
+	setDeadline(cctxs[0], 999_999)
+	setDeadline(cctxs[1], 1_000_001)
+	setDeadline(zetaCctxs[0], 1)
+
+	// test that no cctx is expired when the check interval is not reached
+	ctx = ctx.WithBlockTime(blockTime).WithBlockHeight(keeper.OutboundExpiryCheckInterval + 1)
+	require.Equal(t, 0, k.IterateAndExpirePendingOutbounds(ctx, supportedChains))
+
+	// test that the outbound of the expired cctx is expired when the check interval is reached
+	ctx = ctx.WithBlockHeight(keeper.OutboundExpiryCheckInterval * 2)
+	require.Equal(t, 1, k.IterateAndExpirePendingOutbounds(ctx, supportedChains))
+
+	cctx, found := k.GetCrossChainTx(ctx, cctxs[0].Index)
+	require.True(t, found)
+	require.True(t, cctx.GetCurrentOutboundParam().IsExpired)
+	require.Equal(t, types.CctxStatus_PendingOutbound, cctx.CctxStatus.Status)
+	require.Contains(t, cctx.CctxStatus.ErrorMessage, "outbound expired")
+
+	for _, index := range []string{cctxs[1].Index, cctxs[2].Index, zetaCctxs[0].Index} {
+		cctx, found = k.GetCrossChainTx(ctx, index)
+		require.True(t, found)
+		require.False(t, cctx.GetCurrentOutboundParam().IsExpired)
+	}
+
+	// test that an expired outbound is not expired again
+	ctx = ctx.WithBlockHeight(keeper.OutboundExpiryCheckInterval * 3)
+	require.Equal(t, 0, k.IterateAndExpirePendingOutbounds(ctx, supportedChains))
+}
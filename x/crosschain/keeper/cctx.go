@@ -28,6 +28,8 @@ import (
 // Zeta-accounting is updated aborted cctxs of cointtype zeta.When a cctx is aborted it means that `GetAbortedAmount`
 //of zeta is locked and cannot be used.
 
+// 5. Link the cctx to its parent cctx if it was created from the ZEVM execution of another cctx
+
 func (k Keeper) SaveCCTXUpdate(
 	ctx sdk.Context,
 	cctx types.CrossChainTx,
@@ -37,6 +39,9 @@ func (k Keeper) SaveCCTXUpdate(
 	k.SetCrossChainTx(ctx, cctx)
 	k.updateInboundHashToCCTX(ctx, cctx)
 	k.updateZetaAccounting(ctx, cctx)
+	if cctx.ParentIndex != "" {
+		k.SetCctxChild(ctx, cctx.ParentIndex, cctx.Index)
+	}
 }
 
 // updateInboundHashToCCTX updates the mapping between an inbound hash and a cctx index.
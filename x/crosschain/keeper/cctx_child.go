@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// SetCctxChild links a child cctx to its parent cctx
+func (k Keeper) SetCctxChild(ctx sdk.Context, parentIndex, childIndex string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CctxChildKeyPrefix))
+	store.Set(types.CctxChildKey(parentIndex, childIndex), []byte{1})
+}
+
+// GetCctxChildIndexes returns the indexes of the children of a cctx
+func (k Keeper) GetCctxChildIndexes(ctx sdk.Context, parentIndex string) (list []string) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append(types.KeyPrefix(types.CctxChildKeyPrefix), types.CctxChildrenKey(parentIndex)...),
+	)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, string(iterator.Key()))
+	}
+	return
+}
+
+// GetCctxTree returns the tree of cctxs the given cctx belongs to
+// The tree is returned from its root, parents are always listed before their children
+func (k Keeper) GetCctxTree(ctx sdk.Context, index string) ([]types.CrossChainTx, bool) {
+	root, found := k.GetCrossChainTx(ctx, index)
+	if !found {
+		return nil, false
+	}
+
+	// walk up to the root of the tree
+	for root.ParentIndex != "" {
+		parent, found := k.GetCrossChainTx(ctx, root.ParentIndex)
+		if !found {
+			break
+		}
+		root = parent
+	}
+
+	// walk down the tree breadth first
+	tree := []types.CrossChainTx{root}
+	for i := 0; i < len(tree); i++ {
+		for _, childIndex := range k.GetCctxChildIndexes(ctx, tree[i].Index) {
+			child, found := k.GetCrossChainTx(ctx, childIndex)
+			if !found {
+				continue
+			}
+			tree = append(tree, child)
+		}
+	}
+
+	return tree, true
+}
@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestKeeper_GetCctxChildIndexes(t *testing.T) {
+	t.Run("should return the children of a cctx", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		parentIndex := sample.ZetaIndex(t)
+		otherIndex := sample.ZetaIndex(t)
+		childIndexes := []string{sample.ZetaIndex(t), sample.ZetaIndex(t)}
+
+		for _, childIndex := range childIndexes {
+			k.SetCctxChild(ctx, parentIndex, childIndex)
+		}
+		k.SetCctxChild(ctx, otherIndex, sample.ZetaIndex(t))
+
+		require.ElementsMatch(t, childIndexes, k.GetCctxChildIndexes(ctx, parentIndex))
+		require.Len(t, k.GetCctxChildIndexes(ctx, otherIndex), 1)
+	})
+
+	t.Run("should return nothing if the cctx has no children", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		require.Empty(t, k.GetCctxChildIndexes(ctx, sample.ZetaIndex(t)))
+	})
+}
+
+func TestKeeper_GetCctxTree(t *testing.T) {
+	t.Run("should return the tree from any cctx of the tree", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+
+		root := sample.CrossChainTx(t, "root")
+		child1 := sample.CrossChainTx(t, "child1")
+		child1.ParentIndex = root.Index
+		child2 := sample.CrossChainTx(t, "child2")
+		child2.ParentIndex = root.Index
+		grandchild := sample.CrossChainTx(t, "grandchild")
+		grandchild.ParentIndex = child1.Index
+
+		// root -> (child1 -> grandchild, child2)
+		for _, cctx := range []*types.CrossChainTx{root, child1, child2, grandchild} {
+			k.SetCrossChainTx(ctx, *cctx)
+			if cctx.ParentIndex != "" {
+				k.SetCctxChild(ctx, cctx.ParentIndex, cctx.Index)
+			}
+		}
+
+		for _, cctx := range []*types.CrossChainTx{root, child1, child2, grandchild} {
+			tree, found := k.GetCctxTree(ctx, cctx.Index)
+			require.True(t, found)
+			require.Len(t, tree, 4)
+			require.Equal(t, root.Index, tree[0].Index)
+			require.ElementsMatch(t, []string{child1.Index, child2.Index}, []string{tree[1].Index, tree[2].Index})
+			require.Equal(t, grandchild.Index, tree[3].Index)
+		}
+	})
+
+	t.Run("should return a single cctx if the cctx has no parent and no children", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		cctx := sample.CrossChainTx(t, "cctx")
+		k.SetCrossChainTx(ctx, *cctx)
+
+		tree, found := k.GetCctxTree(ctx, cctx.Index)
+		require.True(t, found)
+		require.Len(t, tree, 1)
+	})
+
+	t.Run("should return false if the cctx doesn't exist", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		_, found := k.GetCctxTree(ctx, sample.ZetaIndex(t))
+		require.False(t, found)
+	})
+}
@@ -5,8 +5,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/coin"
 	"github.com/zeta-chain/node/pkg/crypto"
 	"github.com/zeta-chain/node/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
 	observertypes "github.com/zeta-chain/node/x/observer/types"
 )
 
@@ -30,12 +32,23 @@ func (k Keeper) ValidateInbound(
 		return nil, observertypes.ErrInboundDisabled
 	}
 
+	// Do not process the withdrawal of a ZRC20 whose withdrawals are paused
+	if err := k.checkWithdrawalPaused(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	// create a new CCTX from the inbound message. The status of the new CCTX is set to PendingInbound.
 	cctx, err := types.NewCCTX(ctx, *msg, tss.TssPubkey)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create new CCTX")
 	}
 
+	// link the cctx to its parent if it is created from the ZEVM execution of another cctx
+	inCctxIndex, isChild := ctx.Value(InCCTXIndexKey).(string)
+	if isChild {
+		cctx.ParentIndex = inCctxIndex
+	}
+
 	// Initiate outbound, the process function manages the state commit and cctx status change.
 	// If the process fails, the changes to the evm state are rolled back.
 	_, err = k.InitiateOutbound(ctx, InitiateOutboundConfig{
@@ -46,8 +59,7 @@ func (k Keeper) ValidateInbound(
 		return nil, errors.Wrap(err, "failed to initiate outbound")
 	}
 
-	inCctxIndex, ok := ctx.Value(InCCTXIndexKey).(string)
-	if ok {
+	if isChild {
 		cctx.InboundParams.ObservedHash = inCctxIndex
 	}
 	k.SaveCCTXUpdate(ctx, cctx, tss.TssPubkey)
@@ -55,6 +67,72 @@ func (k Keeper) ValidateInbound(
 	return &cctx, nil
 }
 
+// ValidateInboundReceiverSplits creates a child CCTX of the inbound CCTX for each receiver split of the inbound.
+// Each child deposits its split to its receiver on ZetaChain, it is processed independently of the inbound CCTX
+// and of the other splits: if its deposit fails, only the split is reverted to the sender.
+func (k Keeper) ValidateInboundReceiverSplits(
+	ctx sdk.Context,
+	msg *types.MsgVoteInbound,
+	parentIndex string,
+) ([]*types.CrossChainTx, error) {
+	if len(msg.ReceiverSplits) == 0 {
+		return nil, nil
+	}
+
+	tss, tssFound := k.zetaObserverKeeper.GetTSS(ctx)
+	if !tssFound {
+		return nil, types.ErrCannotFindTSSKeys
+	}
+
+	cctxs := make([]*types.CrossChainTx, 0, len(msg.ReceiverSplits))
+	for _, splitMsg := range msg.ReceiverSplitMsgs() {
+		cctx, err := types.NewCCTX(ctx, splitMsg, tss.TssPubkey)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create receiver split CCTX")
+		}
+		cctx.ParentIndex = parentIndex
+
+		_, err = k.InitiateOutbound(ctx, InitiateOutboundConfig{
+			CCTX:         &cctx,
+			ShouldPayGas: true,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to initiate outbound of receiver split %s", splitMsg.Receiver)
+		}
+		k.SaveCCTXUpdate(ctx, cctx, tss.TssPubkey)
+
+		cctxs = append(cctxs, &cctx)
+	}
+
+	return cctxs, nil
+}
+
+// checkWithdrawalPaused returns an error if the inbound is a withdrawal from ZEVM of a ZRC20 whose withdrawals are paused
+// Calls without asset are not affected, neither are the reverts of withdrawals that are processed as deposits on ZEVM
+func (k Keeper) checkWithdrawalPaused(ctx sdk.Context, msg *types.MsgVoteInbound) error {
+	if !chains.IsZetaChain(msg.SenderChainId, k.GetAuthorityKeeper().GetAdditionalChainList(ctx)) {
+		return nil
+	}
+
+	var (
+		foreignCoin fungibletypes.ForeignCoins
+		found       bool
+	)
+	switch msg.CoinType {
+	case coin.CoinType_Gas:
+		foreignCoin, found = k.fungibleKeeper.GetGasCoinForForeignCoin(ctx, msg.ReceiverChain)
+	case coin.CoinType_ERC20:
+		foreignCoin, found = k.fungibleKeeper.GetForeignCoinFromAsset(ctx, msg.Asset, msg.ReceiverChain)
+	default:
+		return nil
+	}
+
+	if found && foreignCoin.IsWithdrawalPaused() {
+		return errors.Wrapf(fungibletypes.ErrPausedZRC20Withdrawals, "zrc20 %s", foreignCoin.Zrc20ContractAddress)
+	}
+	return nil
+}
+
 // CheckIfTSSMigrationTransfer checks if the sender is a TSS address and returns an error if it is.
 // If the sender is an older TSS address, this means that it is a migration transfer, and we do not need to treat this as a deposit and process the CCTX
 func (k Keeper) CheckIfTSSMigrationTransfer(ctx sdk.Context, msg *types.MsgVoteInbound) error {
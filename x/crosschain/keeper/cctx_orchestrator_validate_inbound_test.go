@@ -12,7 +12,9 @@ import (
 	"github.com/zeta-chain/node/pkg/crypto"
 	keepertest "github.com/zeta-chain/node/testutil/keeper"
 	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/keeper"
 	"github.com/zeta-chain/node/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
 	observerTypes "github.com/zeta-chain/node/x/observer/types"
 )
 
@@ -93,6 +95,85 @@ func TestKeeper_ValidateInbound(t *testing.T) {
 		require.Len(t, k.GetAllCrossChainTx(ctx), 1)
 	})
 
+	t.Run("successfully link the cctx to its parent cctx", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t,
+			keepertest.CrosschainMockOptions{
+				UseObserverMock:  true,
+				UseFungibleMock:  true,
+				UseAuthorityMock: true,
+			})
+
+		// Setup mock data
+		observerMock := keepertest.GetCrosschainObserverMock(t, k)
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		receiver := sample.EthAddress()
+		creator := sample.AccAddress()
+		amount := sdkmath.NewUint(42)
+		message := "test"
+		inboundBlockHeight := uint64(420)
+		inboundHash := sample.Hash()
+		gasLimit := uint64(100)
+		asset := "test-asset"
+		eventIndex := uint64(1)
+		cointType := coin.CoinType_ERC20
+		tss := sample.Tss()
+		receiverChain := chains.Goerli
+		senderChain := chains.Goerli
+		sender := sample.EthAddress()
+		tssList := sample.TssList(3)
+
+		// Set up mocks for CheckIfTSSMigrationTransfer
+		observerMock.On("GetAllTSS", mock.Anything).Return(tssList)
+		observerMock.On("GetSupportedChainFromChainID", mock.Anything, senderChain.ChainId).Return(senderChain, true)
+		authorityMock.On("GetAdditionalChainList", mock.Anything).Return([]chains.Chain{})
+		// setup Mocks for GetTSS
+		observerMock.On("GetTSS", mock.Anything).Return(tss, true)
+		// setup Mocks for IsInboundEnabled
+		observerMock.On("IsInboundEnabled", mock.Anything).Return(true)
+		// setup mocks for Initiate Outbound
+		observerMock.On("GetChainNonces", mock.Anything, mock.Anything).
+			Return(observerTypes.ChainNonces{Nonce: 1}, true)
+		observerMock.On("GetPendingNonces", mock.Anything, mock.Anything, mock.Anything).
+			Return(observerTypes.PendingNonces{NonceHigh: 1}, true)
+		observerMock.On("SetChainNonces", mock.Anything, mock.Anything).Return(nil)
+		observerMock.On("SetPendingNonces", mock.Anything, mock.Anything).Return(nil)
+		// setup Mocks for SaveCCTXUpdate
+		observerMock.On("SetNonceToCctx", mock.Anything, mock.Anything).Return(nil)
+
+		k.SetGasPrice(ctx, types.GasPrice{
+			ChainId:     senderChain.ChainId,
+			MedianIndex: 0,
+			Prices:      []uint64{100},
+		})
+
+		// call InitiateOutbound
+		msg := types.MsgVoteInbound{
+			Creator:            creator,
+			Sender:             sender.String(),
+			SenderChainId:      senderChain.ChainId,
+			Receiver:           receiver.String(),
+			ReceiverChain:      receiverChain.ChainId,
+			Amount:             amount,
+			Message:            message,
+			InboundHash:        inboundHash.String(),
+			InboundBlockHeight: inboundBlockHeight,
+			CallOptions: &types.CallOptions{
+				GasLimit: gasLimit,
+			},
+			CoinType:   cointType,
+			TxOrigin:   sender.String(),
+			Asset:      asset,
+			EventIndex: eventIndex,
+		}
+
+		parentIndex := sample.ZetaIndex(t)
+		cctx, err := k.ValidateInbound(ctx.WithValue(keeper.InCCTXIndexKey, parentIndex), &msg, false)
+		require.NoError(t, err)
+		require.Equal(t, parentIndex, cctx.ParentIndex)
+		require.Equal(t, parentIndex, cctx.InboundParams.ObservedHash)
+		require.Equal(t, []string{cctx.Index}, k.GetCctxChildIndexes(ctx, parentIndex))
+	})
+
 	t.Run("fail if tss not found", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t,
 			keepertest.CrosschainMockOptions{
@@ -287,6 +368,58 @@ func TestKeeper_ValidateInbound(t *testing.T) {
 		require.ErrorIs(t, err, observerTypes.ErrInboundDisabled)
 	})
 
+	t.Run("fail if withdrawals of the zrc20 are paused", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t,
+			keepertest.CrosschainMockOptions{
+				UseObserverMock:  true,
+				UseFungibleMock:  true,
+				UseAuthorityMock: true,
+			})
+
+		// Setup mock data
+		observerMock := keepertest.GetCrosschainObserverMock(t, k)
+		fungibleMock := keepertest.GetCrosschainFungibleMock(t, k)
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		tss := sample.Tss()
+		receiverChain := chains.Goerli
+		senderChain := chains.ZetaChainMainnet
+		sender := sample.EthAddress()
+
+		// Set up mocks for CheckIfTSSMigrationTransfer
+		observerMock.On("GetAllTSS", ctx).Return(sample.TssList(3))
+		observerMock.On("GetSupportedChainFromChainID", mock.Anything, senderChain.ChainId).Return(senderChain, true)
+		authorityMock.On("GetAdditionalChainList", ctx).Return([]chains.Chain{})
+		// setup Mocks for GetTSS
+		observerMock.On("GetTSS", mock.Anything).Return(tss, true)
+		// setup Mocks for IsInboundEnabled
+		observerMock.On("IsInboundEnabled", ctx).Return(true)
+		// setup Mocks for the withdrawal paused check
+		foreignCoin := sample.ForeignCoins(t, sample.EthAddress().Hex())
+		foreignCoin.WithdrawalsPaused = true
+		fungibleMock.On("GetGasCoinForForeignCoin", ctx, receiverChain.ChainId).Return(foreignCoin, true)
+
+		msg := types.MsgVoteInbound{
+			Creator:            sample.AccAddress(),
+			Sender:             sender.String(),
+			SenderChainId:      senderChain.ChainId,
+			Receiver:           sample.EthAddress().String(),
+			ReceiverChain:      receiverChain.ChainId,
+			Amount:             sdkmath.NewUint(42),
+			InboundHash:        sample.Hash().String(),
+			InboundBlockHeight: 420,
+			CallOptions: &types.CallOptions{
+				GasLimit: 100,
+			},
+			CoinType:   coin.CoinType_Gas,
+			TxOrigin:   sender.String(),
+			EventIndex: 1,
+		}
+
+		_, err := k.ValidateInbound(ctx, &msg, false)
+		require.ErrorIs(t, err, fungibletypes.ErrPausedZRC20Withdrawals)
+		fungibleMock.AssertExpectations(t)
+	})
+
 	t.Run("fails when CheckIfTSSMigrationTransfer fails", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t,
 			keepertest.CrosschainMockOptions{
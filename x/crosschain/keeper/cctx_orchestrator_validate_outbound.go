@@ -417,6 +417,10 @@ func (k Keeper) processFailedOutboundV2(ctx sdk.Context, cctx *types.CrossChainT
 		//  get the chain ID of the connected chain
 		chainID := cctx.GetCurrentOutboundParam().ReceiverChainId
 
+		// a cctx created from the ZEVM execution of another cctx without revert options is reverted
+		// with the revert options of its parent if enabled
+		k.inheritParentRevertOptions(ctx, cctx)
+
 		// add revert outbound
 		if err := cctx.AddRevertOutbound(fungiblekeeper.ZEVMGasLimitDepositAndCall.Uint64()); err != nil {
 			// Return err to save the failed outbound ad set to aborted
@@ -501,3 +505,37 @@ func (k Keeper) processFailedOutboundV2(ctx sdk.Context, cctx *types.CrossChainT
 
 	return nil
 }
+
+// inheritParentRevertOptions sets the revert and abort addresses of a child cctx from its parent
+// if the child has none, this allows the original sender of the parent inbound to be refunded instead of the
+// universal app that initiated the child. The inheritance is disabled unless enabled by the crosschain flags.
+//
+// The revert of the child is processed on ZEVM, the refund is therefore made to an EVM address on ZEVM and not on the
+// source chain of the parent: the parent revert address is used if it's an EVM address, otherwise the parent sender.
+// A parent initiated from a non-EVM chain has neither, the child is then reverted to the universal app as usual.
+func (k Keeper) inheritParentRevertOptions(ctx sdk.Context, cctx *types.CrossChainTx) {
+	if cctx.ParentIndex == "" || !k.zetaObserverKeeper.IsParentRevertInheritanceEnabled(ctx) {
+		return
+	}
+	_, hasRevertAddress := cctx.RevertOptions.GetEVMRevertAddress()
+	_, hasAbortAddress := cctx.RevertOptions.GetEVMAbortAddress()
+	if hasRevertAddress || hasAbortAddress || cctx.RevertOptions.CallOnRevert {
+		return
+	}
+
+	parent, found := k.GetCrossChainTx(ctx, cctx.ParentIndex)
+	if !found {
+		return
+	}
+
+	_, parentHasRevertAddress := parent.RevertOptions.GetEVMRevertAddress()
+	switch {
+	case parentHasRevertAddress && ethcommon.IsHexAddress(parent.RevertOptions.RevertAddress):
+		cctx.RevertOptions.RevertAddress = parent.RevertOptions.RevertAddress
+	case ethcommon.IsHexAddress(parent.InboundParams.Sender):
+		cctx.RevertOptions.RevertAddress = parent.InboundParams.Sender
+	default:
+		return
+	}
+	cctx.RevertOptions.AbortAddress = parent.RevertOptions.AbortAddress
+}
@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	cosmoserror "cosmossdk.io/errors"
+	"github.com/btcsuite/btcd/chaincfg"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/evm/x/vm/statedb"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 	ethcommon "github.com/ethereum/go-ethereum/common"
@@ -17,8 +19,10 @@ import (
 	"github.com/zeta-chain/node/e2e/contracts/dapp"
 	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/pkg/coin"
+	"github.com/zeta-chain/node/pkg/constant"
 	keepertest "github.com/zeta-chain/node/testutil/keeper"
 	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/keeper"
 	"github.com/zeta-chain/node/x/crosschain/types"
 	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
 	observertypes "github.com/zeta-chain/node/x/observer/types"
@@ -793,3 +797,96 @@ func TestKeeper_ValidateOutboundObservers(t *testing.T) {
 		)
 	})
 }
+
+func TestKeeper_InheritParentRevertOptions(t *testing.T) {
+	// setupParentAndChild sets a parent cctx and returns a child cctx without revert options
+	// the parent revert inheritance is enabled
+	setupParentAndChild := func(t *testing.T) (*keeper.Keeper, sdk.Context, *types.CrossChainTx, *types.CrossChainTx) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		zk.ObserverKeeper.SetCrosschainFlags(ctx, observertypes.CrosschainFlags{IsParentRevertInheritanceEnabled: true})
+		parent := sample.CrossChainTx(t, "parent")
+		parent.InboundParams.Sender = sample.EthAddress().Hex()
+		parent.RevertOptions = types.RevertOptions{
+			RevertAddress: sample.EthAddress().Hex(),
+			AbortAddress:  sample.EthAddress().Hex(),
+		}
+		k.SetCrossChainTx(ctx, *parent)
+
+		child := sample.CrossChainTx(t, "child")
+		child.ParentIndex = parent.Index
+		child.RevertOptions = types.RevertOptions{
+			RevertAddress: constant.EVMZeroAddress,
+			AbortAddress:  constant.EVMZeroAddress,
+		}
+		return k, ctx, parent, child
+	}
+
+	t.Run("should inherit the revert options of the parent", func(t *testing.T) {
+		k, ctx, parent, child := setupParentAndChild(t)
+
+		k.InheritParentRevertOptions(ctx, child)
+		require.Equal(t, parent.RevertOptions.RevertAddress, child.RevertOptions.RevertAddress)
+		require.Equal(t, parent.RevertOptions.AbortAddress, child.RevertOptions.AbortAddress)
+	})
+
+	t.Run("should use the parent sender if the parent revert address is not an EVM address", func(t *testing.T) {
+		k, ctx, parent, child := setupParentAndChild(t)
+		parent.RevertOptions.RevertAddress = sample.BTCAddressP2WPKH(t, sample.Rand(), &chaincfg.MainNetParams).
+			String()
+		k.SetCrossChainTx(ctx, *parent)
+
+		k.InheritParentRevertOptions(ctx, child)
+		require.Equal(t, parent.InboundParams.Sender, child.RevertOptions.RevertAddress)
+		require.Equal(t, parent.RevertOptions.AbortAddress, child.RevertOptions.AbortAddress)
+	})
+
+	t.Run("should not change the revert options if the inheritance is disabled", func(t *testing.T) {
+		// the universal app that initiated the child keeps being refunded
+		k, ctx, _, child := setupParentAndChild(t)
+		k.GetObserverKeeper().SetCrosschainFlags(ctx, *observertypes.DefaultCrosschainFlags())
+
+		k.InheritParentRevertOptions(ctx, child)
+		require.Equal(t, constant.EVMZeroAddress, child.RevertOptions.RevertAddress)
+		require.Equal(t, constant.EVMZeroAddress, child.RevertOptions.AbortAddress)
+	})
+
+	t.Run("should not change the revert options if the parent is from a non-EVM chain", func(t *testing.T) {
+		k, ctx, parent, child := setupParentAndChild(t)
+		btcAddress := sample.BTCAddressP2WPKH(t, sample.Rand(), &chaincfg.MainNetParams).String()
+		parent.InboundParams.Sender = btcAddress
+		parent.RevertOptions.RevertAddress = btcAddress
+		k.SetCrossChainTx(ctx, *parent)
+
+		k.InheritParentRevertOptions(ctx, child)
+		require.Equal(t, constant.EVMZeroAddress, child.RevertOptions.RevertAddress)
+		require.Equal(t, constant.EVMZeroAddress, child.RevertOptions.AbortAddress)
+	})
+
+	t.Run("should not change the revert options if the child has some", func(t *testing.T) {
+		k, ctx, _, child := setupParentAndChild(t)
+		revertAddress := sample.EthAddress().Hex()
+		child.RevertOptions.RevertAddress = revertAddress
+
+		k.InheritParentRevertOptions(ctx, child)
+		require.Equal(t, revertAddress, child.RevertOptions.RevertAddress)
+		require.Equal(t, constant.EVMZeroAddress, child.RevertOptions.AbortAddress)
+	})
+
+	t.Run("should not change the revert options if the cctx has no parent", func(t *testing.T) {
+		k, ctx, _, child := setupParentAndChild(t)
+		child.ParentIndex = ""
+
+		k.InheritParentRevertOptions(ctx, child)
+		require.Equal(t, constant.EVMZeroAddress, child.RevertOptions.RevertAddress)
+		require.Equal(t, constant.EVMZeroAddress, child.RevertOptions.AbortAddress)
+	})
+
+	t.Run("should not change the revert options if the parent is not found", func(t *testing.T) {
+		k, ctx, _, child := setupParentAndChild(t)
+		child.ParentIndex = sample.ZetaIndex(t)
+
+		k.InheritParentRevertOptions(ctx, child)
+		require.Equal(t, constant.EVMZeroAddress, child.RevertOptions.RevertAddress)
+		require.Equal(t, constant.EVMZeroAddress, child.RevertOptions.AbortAddress)
+	})
+}
@@ -401,6 +401,7 @@ func Test_NewCCTX(t *testing.T) {
 			ConfirmationMode:        types.ConfirmationMode_FAST,
 			Status:                  types.InboundStatus_INSUFFICIENT_DEPOSITOR_FEE,
 			ErrorMessage:            "deposited amount is less than depositor fee",
+			Deadline:                1_700_000_000,
 		}
 		cctx, err := types.NewCCTX(ctx, msg, tss.TssPubkey)
 		require.NoError(t, err)
@@ -424,6 +425,7 @@ func Test_NewCCTX(t *testing.T) {
 		require.Equal(t, types.ConfirmationMode_SAFE, cctx.GetCurrentOutboundParam().ConfirmationMode)
 		require.Equal(t, types.InboundStatus_INSUFFICIENT_DEPOSITOR_FEE, cctx.GetInboundParams().Status)
 		require.Equal(t, "deposited amount is less than depositor fee", cctx.GetInboundParams().ErrorMessage)
+		require.EqualValues(t, 1_700_000_000, cctx.GetInboundParams().Deadline)
 	})
 
 	t.Run("should return an error if the cctx is invalid", func(t *testing.T) {
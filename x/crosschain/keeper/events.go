@@ -29,6 +29,19 @@ func EmitEventInboundFinalized(ctx sdk.Context, cctx *types.CrossChainTx) {
 	}
 }
 
+func EmitEventInboundExpired(ctx sdk.Context, cctx *types.CrossChainTx) {
+	err := ctx.EventManager().EmitTypedEvents(&types.EventInboundExpired{
+		CctxIndex:   cctx.Index,
+		InboundHash: cctx.InboundParams.ObservedHash,
+		Deadline:    cctx.InboundParams.Deadline,
+		BlockTime:   ctx.BlockTime().Unix(),
+		NewStatus:   cctx.CctxStatus.Status.String(),
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventInboundExpired :", err)
+	}
+}
+
 func EmitZRCWithdrawCreated(ctx sdk.Context, cctx types.CrossChainTx) {
 	err := ctx.EventManager().EmitTypedEvents(&types.EventZrcWithdrawCreated{
 		MsgTypeUrl:  "/zetachain.zetacore.crosschain.internal.ZRCWithdrawCreated",
@@ -33,6 +33,13 @@ func (k Keeper) HandleEVMDeposit(ctx sdk.Context, cctx *types.CrossChainTx) (boo
 	inboundSenderChainID := cctx.GetInboundParams().SenderChainId
 	inboundCoinType := cctx.InboundParams.CoinType
 
+	// the synthetic ZEVM txs of the deposit are linked to the CCTX and its inbound
+	ctx = fungibletypes.WithCCTXOrigin(ctx, fungibletypes.CCTXOrigin{
+		CctxIndex:      cctx.Index,
+		InboundChainID: inboundSenderChainID,
+		InboundHash:    cctx.InboundParams.ObservedHash,
+	})
+
 	if len(ctx.TxBytes()) > 0 {
 		// add event for tendermint transaction hash format
 		hash := tmbytes.HexBytes(tmtypes.Tx(ctx.TxBytes()).Hash())
@@ -173,9 +180,10 @@ func (k Keeper) HandleEVMDeposit(ctx sdk.Context, cctx *types.CrossChainTx) (boo
 }
 
 // errShouldRevertCctx returns true if the cctx should revert from the error of the deposit
-// we revert the cctx if a non-contract is tried to be called, if the liquidity cap is reached, or if the zrc20 is paused
+// we revert the cctx if a non-contract is tried to be called, if the liquidity cap is reached, or if the zrc20 or its deposits are paused
 func errShouldRevertCctx(err error) bool {
 	return errors.Is(err, fungibletypes.ErrForeignCoinCapReached) ||
 		errors.Is(err, fungibletypes.ErrCallNonContract) ||
-		errors.Is(err, fungibletypes.ErrPausedZRC20)
+		errors.Is(err, fungibletypes.ErrPausedZRC20) ||
+		errors.Is(err, fungibletypes.ErrPausedZRC20Deposits)
 }
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/mock"
@@ -30,13 +31,13 @@ func TestMsgServer_HandleEVMDeposit(t *testing.T) {
 		amount := big.NewInt(42)
 		sender := sample.EthAddress()
 		senderChainId := int64(0)
+		cctx := sample.CrossChainTx(t, "foo")
 
-		// expect DepositCoinZeta to be called
-		fungibleMock.On("LegacyZETADepositAndCallContract", ctx, ethcommon.HexToAddress(sender.String()), receiver, senderChainId, amount, mock.Anything, mock.Anything).
+		// expect DepositCoinZeta to be called with the cctx as origin of the synthetic tx
+		fungibleMock.On("LegacyZETADepositAndCallContract", ctxWithCCTXOrigin(cctx), ethcommon.HexToAddress(sender.String()), receiver, senderChainId, amount, mock.Anything, mock.Anything).
 			Return(nil, nil)
 
 		// call HandleEVMDeposit
-		cctx := sample.CrossChainTx(t, "foo")
 		cctx.GetCurrentOutboundParam().Receiver = receiver.String()
 		cctx.GetInboundParams().Amount = math.NewUintFromBigInt(amount)
 		cctx.GetInboundParams().CoinType = coin.CoinType_Zeta
@@ -64,7 +65,7 @@ func TestMsgServer_HandleEVMDeposit(t *testing.T) {
 		cctx := sample.CrossChainTx(t, "foo")
 		// expect DepositCoinZeta to be called
 		errDeposit := errors.New("deposit failed")
-		fungibleMock.On("LegacyZETADepositAndCallContract", ctx, ethcommon.HexToAddress(sender.String()), receiver, senderChainId, amount, mock.Anything, mock.Anything).
+		fungibleMock.On("LegacyZETADepositAndCallContract", ctxWithCCTXOrigin(cctx), ethcommon.HexToAddress(sender.String()), receiver, senderChainId, amount, mock.Anything, mock.Anything).
 			Return(nil, errDeposit)
 
 		// call HandleEVMDeposit
@@ -93,12 +94,13 @@ func TestMsgServer_HandleEVMDeposit(t *testing.T) {
 		fungibleMock := keepertest.GetCrosschainFungibleMock(t, k)
 		receiver := sample.EthAddress()
 		amount := big.NewInt(42)
+		cctx := sample.CrossChainTx(t, "foo")
 
-		// expect DepositCoinZeta to be called
+		// expect DepositCoinZeta to be called with the cctx as origin of the synthetic tx
 		// ZRC20DepositAndCallContract(ctx, from, to, msg.Amount.BigInt(), senderChain, msg.Message, contract, data, msg.FungibleTokenCoinType, msg.Asset)
 		fungibleMock.On(
 			"ZRC20DepositAndCallContract",
-			mock.Anything,
+			ctxWithCCTXOrigin(cctx),
 			mock.Anything,
 			receiver,
 			amount,
@@ -111,7 +113,6 @@ func TestMsgServer_HandleEVMDeposit(t *testing.T) {
 		).Return(&evmtypes.MsgEthereumTxResponse{}, false, nil)
 
 		// call HandleEVMDeposit
-		cctx := sample.CrossChainTx(t, "foo")
 		cctx.GetCurrentOutboundParam().Receiver = receiver.String()
 		cctx.GetInboundParams().Amount = math.NewUintFromBigInt(amount)
 		cctx.GetInboundParams().CoinType = coin.CoinType_ERC20
@@ -628,3 +629,12 @@ func TestMsgServer_HandleEVMDeposit(t *testing.T) {
 	// TODO: add test cases for testing logs process
 	// https://github.com/zeta-chain/node/issues/1207
 }
+
+// ctxWithCCTXOrigin matches a context containing the cctx as origin of the synthetic txs
+func ctxWithCCTXOrigin(cctx *types.CrossChainTx) interface{} {
+	return mock.MatchedBy(func(ctx sdk.Context) bool {
+		origin, ok := fungibletypes.CCTXOriginFromContext(ctx)
+		return ok && origin.CctxIndex == cctx.Index && origin.InboundHash == cctx.InboundParams.ObservedHash &&
+			origin.InboundChainID == cctx.InboundParams.SenderChainId
+	})
+}
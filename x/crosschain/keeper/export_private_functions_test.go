@@ -47,3 +47,7 @@ func (k Keeper) GetERC20InboundDetails(
 ) (InboundDetails, error) {
 	return k.getZRC20InboundDetails(ctx, zrc20, callEvent)
 }
+
+func (k Keeper) InheritParentRevertOptions(ctx sdk.Context, cctx *types.CrossChainTx) {
+	k.inheritParentRevertOptions(ctx, cctx)
+}
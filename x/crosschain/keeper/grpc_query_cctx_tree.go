@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// CctxTree queries the tree of cctxs created from an inbound
+// If the inbound created several cctxs, the trees of all of them are returned
+func (k Keeper) CctxTree(c context.Context, req *types.QueryCctxTreeRequest) (*types.QueryCctxTreeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	inboundHashToCctx, found := k.GetInboundHashToCctx(ctx, req.InboundHash)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	var (
+		cctxs   []types.CrossChainTx
+		visited = make(map[string]bool)
+	)
+	for _, cctxIndex := range inboundHashToCctx.CctxIndex {
+		if visited[cctxIndex] {
+			continue
+		}
+
+		tree, found := k.GetCctxTree(ctx, cctxIndex)
+		if !found {
+			// This is an internal error because the cctx should always exist from the index
+			return nil, status.Errorf(codes.Internal, "cctx indexed %s doesn't exist", cctxIndex)
+		}
+		for _, cctx := range tree {
+			if !visited[cctx.Index] {
+				visited[cctx.Index] = true
+				cctxs = append(cctxs, cctx)
+			}
+		}
+	}
+
+	return &types.QueryCctxTreeResponse{CrossChainTxs: cctxs}, nil
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestKeeper_CctxTree(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		res, err := k.CctxTree(ctx, nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+
+	t.Run("should error if inbound hash not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		res, err := k.CctxTree(ctx, &types.QueryCctxTreeRequest{InboundHash: sample.Hash().String()})
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+
+	t.Run("should error if a cctx of the inbound hash doesn't exist", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		inboundHash := sample.Hash().String()
+		k.SetInboundHashToCctx(ctx, types.InboundHashToCctx{
+			InboundHash: inboundHash,
+			CctxIndex:   []string{sample.ZetaIndex(t)},
+		})
+
+		res, err := k.CctxTree(ctx, &types.QueryCctxTreeRequest{InboundHash: inboundHash})
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+
+	t.Run("should return the cctxs created from the inbound", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		inboundHash := sample.Hash().String()
+
+		// the inbound created two cctxs, the first one created a child cctx
+		cctx1 := sample.CrossChainTx(t, "cctx1")
+		cctx2 := sample.CrossChainTx(t, "cctx2")
+		child := sample.CrossChainTx(t, "child")
+		child.ParentIndex = cctx1.Index
+		for _, cctx := range []*types.CrossChainTx{cctx1, cctx2, child} {
+			k.SetCrossChainTx(ctx, *cctx)
+		}
+		k.SetCctxChild(ctx, cctx1.Index, child.Index)
+		k.SetInboundHashToCctx(ctx, types.InboundHashToCctx{
+			InboundHash: inboundHash,
+			CctxIndex:   []string{cctx1.Index, cctx2.Index},
+		})
+
+		res, err := k.CctxTree(ctx, &types.QueryCctxTreeRequest{InboundHash: inboundHash})
+		require.NoError(t, err)
+		require.Len(t, res.CrossChainTxs, 3)
+		require.Equal(t, cctx1.Index, res.CrossChainTxs[0].Index)
+		require.Equal(t, child.Index, res.CrossChainTxs[1].Index)
+		require.Equal(t, cctx2.Index, res.CrossChainTxs[2].Index)
+	})
+
+	t.Run("should return the whole tree from the inbound hash of a child cctx", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+
+		parent := sample.CrossChainTx(t, "parent")
+		child := sample.CrossChainTx(t, "child")
+		child.ParentIndex = parent.Index
+		child.InboundParams.ObservedHash = parent.Index
+		k.SetCrossChainTx(ctx, *parent)
+		k.SetCrossChainTx(ctx, *child)
+		k.SetCctxChild(ctx, parent.Index, child.Index)
+		k.SetInboundHashToCctx(ctx, types.InboundHashToCctx{
+			InboundHash: parent.Index,
+			CctxIndex:   []string{child.Index},
+		})
+
+		res, err := k.CctxTree(ctx, &types.QueryCctxTreeRequest{InboundHash: parent.Index})
+		require.NoError(t, err)
+		require.Len(t, res.CrossChainTxs, 2)
+		require.Equal(t, parent.Index, res.CrossChainTxs[0].Index)
+		require.Equal(t, child.Index, res.CrossChainTxs[1].Index)
+	})
+}
@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/memo"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+// simulatedInboundHash is the inbound hash used for simulated inbounds, they are never observed
+const simulatedInboundHash = "simulated"
+
+// SimulateInbound processes a would-be inbound against a cached context that is never committed.
+// It returns the resulting CCTX with its status, the gas used by the ZEVM calls and the withdraw fee
+// of the sender chain, which is the fee paid if the CCTX reverts. The child CCTXs depositing the
+// receiver splits of the message, if any, are returned as well.
+func (k Keeper) SimulateInbound(
+	goCtx context.Context,
+	req *types.QuerySimulateInboundRequest,
+) (*types.QuerySimulateInboundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	msg, err := k.newSimulatedInboundVote(ctx, req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// process the inbound against a cached context that is never committed
+	// a new event manager collects the events of the ZEVM calls to get the gas used
+	tmpCtx, _ := ctx.CacheContext()
+	tmpCtx = tmpCtx.WithEventManager(sdk.NewEventManager())
+
+	cctx, err := k.ValidateInbound(tmpCtx, msg, true)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	splitCctxs, err := k.ValidateInboundReceiverSplits(tmpCtx, msg, cctx.Index)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	res := &types.QuerySimulateInboundResponse{
+		CrossChainTx: cctx,
+		Status:       cctx.CctxStatus.Status,
+		RevertReason: cctx.CctxStatus.ErrorMessage,
+		GasUsed:      gasUsedFromEvents(tmpCtx.EventManager().Events()),
+		WithdrawFee:  sdkmath.ZeroUint().String(),
+	}
+
+	for _, splitCctx := range splitCctxs {
+		res.ReceiverSplitCctxs = append(res.ReceiverSplitCctxs, *splitCctx)
+	}
+
+	// the withdraw fee is left to zero if the sender chain has no gas asset on ZEVM
+	if gasParams, err := k.ChainGasParams(ctx, req.SenderChainId); err == nil {
+		res.WithdrawFee = gasParams.GasLimit.Mul(gasParams.GasPrice).Add(gasParams.ProtocolFlatFee).String()
+	}
+
+	return res, nil
+}
+
+// newSimulatedInboundVote builds the inbound vote of a simulated inbound
+// The message is decoded the way the observers of the sender chain decode it:
+//   - EVM chains: the message is passed as is
+//   - Bitcoin: the message is decoded as a standard memo or a legacy memo
+//   - other chains: a cross-chain call without receiver is decoded as a standard memo if possible
+func (k Keeper) newSimulatedInboundVote(
+	ctx sdk.Context,
+	req *types.QuerySimulateInboundRequest,
+) (*types.MsgVoteInbound, error) {
+	senderChain, found := k.zetaObserverKeeper.GetSupportedChainFromChainID(ctx, req.SenderChainId)
+	if !found {
+		return nil, observertypes.ErrSupportedChains.Wrapf("chain not found for chainID %d", req.SenderChainId)
+	}
+
+	zetaChain, err := chains.ZetaChainFromCosmosChainID(ctx.ChainID())
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := sdkmath.ParseUint(req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := hex.DecodeString(req.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	inbound := simulatedInbound{
+		receiver:         req.Receiver,
+		payload:          payload,
+		isCrossChainCall: req.IsCrossChainCall,
+		status:           types.InboundStatus_SUCCESS,
+	}
+
+	switch {
+	case senderChain.IsEVMChain():
+		// EVM gateway events carry no memo
+	case senderChain.IsBitcoinChain():
+		inbound.decodeBitcoinMemo(senderChain.ChainId)
+	case memo.CarriesStandardMemo(req.IsCrossChainCall, ethcommon.HexToAddress(req.Receiver)):
+		inbound.decodeStandardMemo(memo.RevertAddressValidator(senderChain))
+	}
+	inbound.options = append(inbound.options, types.WithCrossChainCall(inbound.isCrossChainCall))
+
+	return types.NewMsgVoteInbound(
+		"",
+		req.Sender,
+		req.SenderChainId,
+		req.Sender,
+		inbound.receiver,
+		zetaChain.ChainId,
+		amount,
+		hex.EncodeToString(inbound.payload),
+		simulatedInboundHash,
+		0,
+		0,
+		req.CoinType,
+		req.Asset,
+		0,
+		types.ProtocolContractVersion_V2,
+		inbound.isArbitraryCall,
+		inbound.status,
+		types.ConfirmationMode_SAFE,
+		inbound.options...,
+	), nil
+}
+
+// simulatedInbound is the content of a simulated inbound once its memo is decoded
+type simulatedInbound struct {
+	receiver         string
+	payload          []byte
+	isArbitraryCall  bool
+	isCrossChainCall bool
+	status           types.InboundStatus
+	options          []types.InboundVoteOption
+}
+
+// decodeBitcoinMemo decodes the payload as the memo of a Bitcoin inbound, as the Bitcoin observers do
+func (inbound *simulatedInbound) decodeBitcoinMemo(chainID int64) {
+	memoStd, receiver, payload, err := memo.DecodeBitcoinMemo(inbound.payload, chainID)
+	if err != nil {
+		inbound.setInvalidMemo(err)
+		return
+	}
+
+	inbound.receiver = receiver.Hex()
+	inbound.payload = payload
+	if memoStd == nil {
+		// non-empty payload is considered as a cross-chain call for legacy memo
+		inbound.isCrossChainCall = len(payload) > 0
+		return
+	}
+
+	inbound.isArbitraryCall = memoStd.IsArbitraryCall
+	inbound.isCrossChainCall = memoStd.OpCode == memo.OpCodeCall || memoStd.OpCode == memo.OpCodeDepositAndCall
+	inbound.options = append(inbound.options, memo.BitcoinMemoVoteOptions(memoStd)...)
+}
+
+// decodeStandardMemo decodes the payload as a standard memo if possible, as the Solana, TON and Sui observers do
+func (inbound *simulatedInbound) decodeStandardMemo(validateRevertAddress memo.AddressValidator) {
+	memoStd, err := memo.DecodeStandardMemo(inbound.payload, validateRevertAddress)
+	switch {
+	case err != nil:
+		inbound.setInvalidMemo(err)
+	case memoStd != nil:
+		inbound.receiver = memoStd.Receiver.Hex()
+		inbound.payload = memoStd.Payload
+		inbound.isArbitraryCall = memoStd.IsArbitraryCall
+		inbound.options = append(inbound.options, memo.StandardMemoVoteOptions(memoStd)...)
+	}
+}
+
+// setInvalidMemo marks the inbound as carrying an invalid memo
+func (inbound *simulatedInbound) setInvalidMemo(err error) {
+	inbound.status = types.InboundStatus_INVALID_MEMO
+	inbound.options = append(inbound.options, types.WithErrorMessage(err.Error()))
+}
+
+// gasUsedFromEvents sums the gas used by the ZEVM calls that emitted the given events
+func gasUsedFromEvents(events sdk.Events) uint64 {
+	var gasUsed uint64
+	for _, event := range events {
+		if event.Type != evmtypes.EventTypeEthereumTx {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key != evmtypes.AttributeKeyTxGasUsed {
+				continue
+			}
+			if value, err := strconv.ParseUint(attr.Value, 10, 64); err == nil {
+				gasUsed += value
+			}
+		}
+	}
+
+	return gasUsed
+}
@@ -0,0 +1,219 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/chaincfg"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/coin"
+	"github.com/zeta-chain/node/pkg/memo"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/keeper"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestKeeper_SimulateInbound(t *testing.T) {
+	type simulation struct {
+		k     *keeper.Keeper
+		ctx   sdk.Context
+		zk    keepertest.ZetaKeepers
+		zrc20 ethcommon.Address
+	}
+
+	// setupSimulation sets up the state needed to process a gas deposit from the given supported chain
+	setupSimulation := func(t *testing.T, chainID int64) (simulation, int64) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+		setSupportedChain(ctx, zk, chainID)
+
+		deploySystemContracts(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper)
+		zrc20 := setupGasCoin(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper, chainID, "foobar", "foobar")
+		_, err := zk.FungibleKeeper.UpdateZRC20ProtocolFlatFee(ctx, zrc20, big.NewInt(withdrawFee))
+		require.NoError(t, err)
+
+		// remove the liquidity cap of the gas coin
+		fc, found := zk.FungibleKeeper.GetForeignCoins(ctx, zrc20.Hex())
+		require.True(t, found)
+		fc.LiquidityCap = sdkmath.ZeroUint()
+		zk.FungibleKeeper.SetForeignCoins(ctx, fc)
+		k.SetGasPrice(ctx, types.GasPrice{
+			ChainId:     chainID,
+			MedianIndex: 0,
+			Prices:      []uint64{gasPrice},
+		})
+
+		tss := sample.Tss()
+		zk.ObserverKeeper.SetTSS(ctx, tss)
+		zk.ObserverKeeper.SetChainNonces(ctx, observertypes.ChainNonces{ChainId: chainID, Nonce: 1})
+		zk.ObserverKeeper.SetPendingNonces(ctx, observertypes.PendingNonces{
+			ChainId:   chainID,
+			NonceLow:  1,
+			NonceHigh: 1,
+			Tss:       tss.TssPubkey,
+		})
+		zk.ObserverKeeper.SetCrosschainFlags(ctx, observertypes.CrosschainFlags{IsInboundEnabled: true})
+
+		return simulation{k: k, ctx: ctx, zk: zk, zrc20: zrc20}, chainID
+	}
+
+	t.Run("should simulate a deposit without changing the state", func(t *testing.T) {
+		s, chainID := setupSimulation(t, getValidEthChainID())
+		receiver := sample.EthAddress()
+
+		res, err := s.k.SimulateInbound(s.ctx, &types.QuerySimulateInboundRequest{
+			SenderChainId: chainID,
+			Sender:        sample.EthAddress().Hex(),
+			Receiver:      receiver.Hex(),
+			CoinType:      coin.CoinType_Gas,
+			Amount:        "1000000",
+		})
+		require.NoError(t, err)
+		require.Equal(t, types.CctxStatus_OutboundMined, res.Status)
+		require.Empty(t, res.RevertReason)
+		require.NotZero(t, res.GasUsed)
+
+		// total fees must be 21000*2+1000=43000
+		require.Equal(t, "43000", res.WithdrawFee)
+
+		// no cctx is stored and the receiver doesn't get the deposit
+		require.Empty(t, s.k.GetAllCrossChainTx(s.ctx))
+		balance, err := s.zk.FungibleKeeper.BalanceOfZRC4(s.ctx, s.zrc20, receiver)
+		require.NoError(t, err)
+		require.Zero(t, balance.Sign())
+	})
+
+	t.Run("should pass the message of an EVM inbound as is", func(t *testing.T) {
+		s, chainID := setupSimulation(t, getValidEthChainID())
+		receiver := sample.EthAddress()
+
+		// EVM gateway events carry no memo, the payload must not be decoded
+		invalidMemo := []byte{memo.Identifier, 0x01, 0x10, 0x01}
+
+		res, err := s.k.SimulateInbound(s.ctx, &types.QuerySimulateInboundRequest{
+			SenderChainId: chainID,
+			Sender:        sample.EthAddress().Hex(),
+			Receiver:      receiver.Hex(),
+			CoinType:      coin.CoinType_Gas,
+			Amount:        "1000000",
+			Message:       hex.EncodeToString(invalidMemo),
+		})
+		require.NoError(t, err)
+		require.Equal(t, types.InboundStatus_SUCCESS, res.CrossChainTx.InboundParams.Status)
+		require.Equal(t, receiver.Hex(), res.CrossChainTx.GetCurrentOutboundParam().Receiver)
+		require.Equal(t, hex.EncodeToString(invalidMemo), res.CrossChainTx.RelayedMessage)
+		require.Empty(t, s.k.GetAllCrossChainTx(s.ctx))
+	})
+
+	t.Run("should simulate the revert of a Bitcoin inbound with an invalid memo", func(t *testing.T) {
+		s, chainID := setupSimulation(t, getValidBtcChainID())
+
+		// memo with the receiver flag set but no data
+		invalidMemo := []byte{memo.Identifier, 0x01, 0x10, 0x01}
+
+		res, err := s.k.SimulateInbound(s.ctx, &types.QuerySimulateInboundRequest{
+			SenderChainId: chainID,
+			Sender:        sample.BTCAddressP2WPKH(t, sample.Rand(), &chaincfg.RegressionNetParams).EncodeAddress(),
+			CoinType:      coin.CoinType_Gas,
+			Amount:        "1000000",
+			Message:       hex.EncodeToString(invalidMemo),
+		})
+		require.NoError(t, err)
+		require.Equal(t, types.CctxStatus_PendingRevert, res.Status)
+		require.Contains(t, res.RevertReason, "standard memo contains improper data")
+		require.Equal(t, types.InboundStatus_INVALID_MEMO, res.CrossChainTx.InboundParams.Status)
+		require.Empty(t, s.k.GetAllCrossChainTx(s.ctx))
+	})
+
+	t.Run("should decode the legacy memo of a Bitcoin inbound", func(t *testing.T) {
+		s, chainID := setupSimulation(t, getValidBtcChainID())
+		receiver := sample.EthAddress()
+
+		res, err := s.k.SimulateInbound(s.ctx, &types.QuerySimulateInboundRequest{
+			SenderChainId: chainID,
+			Sender:        sample.BTCAddressP2WPKH(t, sample.Rand(), &chaincfg.RegressionNetParams).EncodeAddress(),
+			CoinType:      coin.CoinType_Gas,
+			Amount:        "1000000",
+			Message:       hex.EncodeToString(receiver.Bytes()),
+		})
+		require.NoError(t, err)
+		require.Equal(t, types.CctxStatus_OutboundMined, res.Status)
+		require.Equal(t, types.InboundStatus_SUCCESS, res.CrossChainTx.InboundParams.Status)
+		require.Equal(t, receiver.Hex(), res.CrossChainTx.GetCurrentOutboundParam().Receiver)
+		require.False(t, res.CrossChainTx.InboundParams.IsCrossChainCall)
+	})
+
+	t.Run("should reject the invalid revert address of a Solana standard memo", func(t *testing.T) {
+		s, chainID := setupSimulation(t, getValidSolanaChainID())
+
+		memoStd := memo.InboundMemo{
+			Header: memo.Header{
+				Version:     0,
+				EncodingFmt: memo.EncodingFmtCompactShort,
+				OpCode:      memo.OpCodeDepositAndCall,
+			},
+			FieldsV0: memo.FieldsV0{
+				Receiver:      sample.EthAddress(),
+				Payload:       []byte("payload"),
+				RevertOptions: types.RevertOptions{RevertAddress: sample.EthAddress().Hex()},
+			},
+		}
+		data, err := memoStd.EncodeToBytes()
+		require.NoError(t, err)
+
+		// the memo is only decoded for a cross-chain call without receiver
+		res, err := s.k.SimulateInbound(s.ctx, &types.QuerySimulateInboundRequest{
+			SenderChainId:    chainID,
+			Sender:           sample.SolanaAddress(t),
+			CoinType:         coin.CoinType_Gas,
+			Amount:           "1000000",
+			Message:          hex.EncodeToString(data),
+			IsCrossChainCall: true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, types.InboundStatus_INVALID_MEMO, res.CrossChainTx.InboundParams.Status)
+		require.Contains(t, res.RevertReason, "invalid revert address in memo")
+	})
+
+	t.Run("should fail if the request is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		_, err := k.SimulateInbound(ctx, nil)
+		require.ErrorContains(t, err, "invalid request")
+	})
+
+	t.Run("should fail if the sender chain is not supported", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		_, err := k.SimulateInbound(ctx, &types.QuerySimulateInboundRequest{
+			SenderChainId: 999999,
+			Amount:        "1000000",
+		})
+		require.ErrorContains(t, err, "chain not found")
+	})
+
+	t.Run("should fail if the amount is invalid", func(t *testing.T) {
+		s, chainID := setupSimulation(t, getValidEthChainID())
+		_, err := s.k.SimulateInbound(s.ctx, &types.QuerySimulateInboundRequest{
+			SenderChainId: chainID,
+			Amount:        "invalid",
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("should fail if the message is not hex encoded", func(t *testing.T) {
+		s, chainID := setupSimulation(t, getValidEthChainID())
+		_, err := s.k.SimulateInbound(s.ctx, &types.QuerySimulateInboundRequest{
+			SenderChainId: chainID,
+			Amount:        "1000000",
+			Message:       "not hex",
+		})
+		require.Error(t, err)
+	})
+}
@@ -39,5 +39,32 @@ func (k Keeper) InitiateOutbound(ctx sdk.Context, config InitiateOutboundConfig)
 	}
 
 	config.CCTX.SetPendingOutbound(types.StatusMessages{StatusMessage: "initiating outbound"})
+
+	// an inbound finalized after its deadline is not executed, the CCTX is reverted instead
+	if config.CCTX.InboundParams.Status == types.InboundStatus_SUCCESS &&
+		config.CCTX.InboundParams.IsExpired(ctx.BlockTime()) {
+		return k.processExpiredInbound(ctx, config.CCTX), nil
+	}
+
 	return cctxGateway.InitiateOutbound(ctx, config)
 }
+
+// processExpiredInbound reverts the CCTX of an inbound that exceeded its deadline.
+// The CCTX is aborted if the revert can't be created, e.g. the amount doesn't cover the revert fee.
+// It returns the new CCTX status.
+func (k Keeper) processExpiredInbound(ctx sdk.Context, cctx *types.CrossChainTx) types.CctxStatus {
+	newCCTXStatus := k.ValidateOutboundZEVM(ctx, cctx, inboundExpiredError(ctx, cctx), true)
+	EmitEventInboundExpired(ctx, cctx)
+
+	return newCCTXStatus
+}
+
+// inboundExpiredError returns the error describing why the inbound of the CCTX is expired
+func inboundExpiredError(ctx sdk.Context, cctx *types.CrossChainTx) error {
+	return cosmoserrors.Wrapf(
+		types.ErrInboundExpired,
+		"deadline %d, block time %d",
+		cctx.InboundParams.Deadline,
+		ctx.BlockTime().Unix(),
+	)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/mock"
@@ -461,6 +462,74 @@ func TestKeeper_InitiateOutboundProcessCrosschainMsgPassing(t *testing.T) {
 	})
 }
 
+func TestKeeper_InitiateOutboundExpiredInbound(t *testing.T) {
+	blockTime := time.Unix(1_700_000_000, 0)
+
+	t.Run("revert expired inbound without executing the deposit", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseFungibleMock: true,
+			UseObserverMock: true,
+		})
+		ctx = ctx.WithBlockTime(blockTime)
+
+		// Setup mock data
+		fungibleMock := keepertest.GetCrosschainFungibleMock(t, k)
+		observerMock := keepertest.GetCrosschainObserverMock(t, k)
+		receiver := sample.EthAddress()
+		amount := big.NewInt(42)
+		senderChain := getValidEthChain()
+		asset := ""
+
+		// Setup expected calls, HandleEVMDeposit is not expected to be called
+		keepertest.MockGetRevertGasLimitForERC20(fungibleMock, asset, senderChain, 100)
+		keepertest.MockPayGasAndUpdateCCTX(fungibleMock, observerMock, ctx, *k, senderChain, asset)
+		updatedNonce := keepertest.MockUpdateNonce(observerMock, senderChain)
+
+		// call InitiateOutbound
+		cctx := GetERC20Cctx(t, receiver, senderChain, asset, amount)
+		cctx.GetCurrentOutboundParam().ReceiverChainId = chains.ZetaChainPrivnet.ChainId
+		cctx.InboundParams.Deadline = uint64(blockTime.Unix()) - 1
+		newStatus, err := k.InitiateOutbound(ctx, keeper.InitiateOutboundConfig{CCTX: cctx, ShouldPayGas: true})
+		require.NoError(t, err)
+		require.Equal(t, types.CctxStatus_PendingRevert, cctx.CctxStatus.Status)
+		require.Equal(t, types.CctxStatus_PendingRevert, newStatus)
+		require.Contains(t, cctx.CctxStatus.ErrorMessage, types.ErrInboundExpired.Error())
+		require.Equal(t, updatedNonce, cctx.GetCurrentOutboundParam().TssNonce)
+		fungibleMock.AssertNotCalled(t, "ZRC20DepositAndCallContract")
+	})
+
+	t.Run("abort expired inbound if the revert fails", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseFungibleMock: true,
+			UseObserverMock: true,
+		})
+		ctx = ctx.WithBlockTime(blockTime)
+
+		// Setup mock data
+		fungibleMock := keepertest.GetCrosschainFungibleMock(t, k)
+		observerMock := keepertest.GetCrosschainObserverMock(t, k)
+		receiver := sample.EthAddress()
+		amount := big.NewInt(42)
+		senderChain := getValidEthChain()
+		asset := ""
+
+		// mock unsuccessful GetRevertGasLimit for ERC20
+		keepertest.MockGetSupportedChainFromChainID(observerMock, senderChain)
+		fungibleMock.On("GetForeignCoinFromAsset", mock.Anything, asset, senderChain.ChainId).
+			Return(fungibletypes.ForeignCoins{}, false)
+
+		// call InitiateOutbound
+		cctx := GetERC20Cctx(t, receiver, senderChain, asset, amount)
+		cctx.GetCurrentOutboundParam().ReceiverChainId = chains.ZetaChainPrivnet.ChainId
+		cctx.InboundParams.Deadline = uint64(blockTime.Unix()) - 1
+		newStatus, err := k.InitiateOutbound(ctx, keeper.InitiateOutboundConfig{CCTX: cctx, ShouldPayGas: true})
+		require.NoError(t, err)
+		require.Equal(t, types.CctxStatus_Aborted, cctx.CctxStatus.Status)
+		require.Equal(t, types.CctxStatus_Aborted, newStatus)
+		require.Contains(t, cctx.CctxStatus.ErrorMessage, types.ErrInboundExpired.Error())
+	})
+}
+
 func TestKeeper_InitiateOutboundFailures(t *testing.T) {
 	t.Run("should fail if chain info can not be found for receiver chain id", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/node/pkg/coin"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+const (
+	// ZRC20CustodyInvariantName is the name of the invariant checking the TSS custody covers the ZRC20
+	ZRC20CustodyInvariantName = "zrc20-custody"
+)
+
+// RegisterInvariants registers the crosschain module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, ZRC20CustodyInvariantName, ZRC20CustodyInvariant(k))
+}
+
+// AllInvariants runs all invariants of the crosschain module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return ZRC20CustodyInvariant(k)(ctx)
+	}
+}
+
+// ZRC20CustodyInvariant checks that, for each foreign coin, the amount deposited minus the amount withdrawn tracked
+// by the CCTXs covers the circulating ZRC20 supply plus the pending outbounds plus the aborted amounts not refunded
+//
+// The tracked custody exceeds the claims by the fees collected by the protocol: the gas fees of the withdrawals
+// are held by the fungible module and the fees of the reverts are deducted from the reverted amount.
+// The ZRC20 minted by the protocol without a deposit (pool liquidity, gas stability pool) are not part of the
+// circulating supply, a ZRC20 minted outside these flows breaks the invariant.
+// ZETA is not a foreign coin, the aborted ZETA is tracked by the ZetaAccounting.
+func ZRC20CustodyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		// the EVM queries are run in a cached context to not write state
+		cacheCtx, _ := ctx.CacheContext()
+		accountings := k.getCustodyAccountings(cacheCtx)
+
+		var (
+			msg    string
+			broken int
+		)
+		for _, fc := range k.fungibleKeeper.GetAllForeignCoins(cacheCtx) {
+			if fc.CoinType != coin.CoinType_Gas && fc.CoinType != coin.CoinType_ERC20 {
+				continue
+			}
+			accounting := accountings.get(fc.ForeignChainId, fc.CoinType, fc.Asset)
+
+			circulating, err := k.fungibleKeeper.ZRC20CirculatingSupply(cacheCtx, fc)
+			if err != nil {
+				broken++
+				msg += fmt.Sprintf("\tcan't get circulating supply of %s: %s\n", fc.Zrc20ContractAddress, err.Error())
+				continue
+			}
+
+			custody := new(big.Int).Sub(accounting.deposited, accounting.withdrawn)
+			claims := new(big.Int).Add(circulating, accounting.pending)
+			claims.Add(claims, accounting.abortedUnrefunded)
+			if claims.Cmp(custody) > 0 {
+				broken++
+				msg += fmt.Sprintf(
+					"\t%s (chain %d): deposited %s - withdrawn %s < circulating %s + pending %s + aborted %s\n",
+					fc.Zrc20ContractAddress,
+					fc.ForeignChainId,
+					accounting.deposited,
+					accounting.withdrawn,
+					circulating,
+					accounting.pending,
+					accounting.abortedUnrefunded,
+				)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName,
+			ZRC20CustodyInvariantName,
+			fmt.Sprintf("%d foreign coins with uncovered ZRC20 found\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// custodyAccounting is the accounting of the custody of a foreign coin tracked by the CCTXs
+type custodyAccounting struct {
+	deposited         *big.Int
+	withdrawn         *big.Int
+	pending           *big.Int
+	abortedUnrefunded *big.Int
+}
+
+// custodyKey identifies a foreign coin from the CCTXs, the asset is empty for gas coins
+type custodyKey struct {
+	chainID int64
+	asset   string
+}
+
+// custodyAccountings maps the foreign coins to their accounting
+type custodyAccountings map[custodyKey]*custodyAccounting
+
+func newCustodyKey(chainID int64, coinType coin.CoinType, asset string) custodyKey {
+	if coinType == coin.CoinType_Gas {
+		return custodyKey{chainID: chainID}
+	}
+	return custodyKey{chainID: chainID, asset: strings.ToLower(asset)}
+}
+
+// get returns the accounting of the foreign coin, a coin without CCTX has an empty accounting
+func (a custodyAccountings) get(chainID int64, coinType coin.CoinType, asset string) *custodyAccounting {
+	key := newCustodyKey(chainID, coinType, asset)
+	accounting, found := a[key]
+	if !found {
+		accounting = &custodyAccounting{
+			deposited:         big.NewInt(0),
+			withdrawn:         big.NewInt(0),
+			pending:           big.NewInt(0),
+			abortedUnrefunded: big.NewInt(0),
+		}
+		a[key] = accounting
+	}
+	return accounting
+}
+
+// add adds the amounts of the CCTX to the accounting of its foreign coin
+// - an inbound from the foreign chain is deposited
+// - a current outbound to the foreign chain is withdrawn once mined, and pending until then
+// - the aborted amount is counted until refunded
+func (a custodyAccountings) add(cctx types.CrossChainTx) {
+	if cctx.InboundParams == nil || cctx.CctxStatus == nil {
+		return
+	}
+	coinType := cctx.InboundParams.CoinType
+	if coinType != coin.CoinType_Gas && coinType != coin.CoinType_ERC20 {
+		return
+	}
+	chainID, outgoing, err := cctx.GetConnectedChainID()
+	if err != nil {
+		return
+	}
+	accounting := a.get(chainID, coinType, cctx.InboundParams.Asset)
+
+	if !outgoing && !cctx.InboundParams.Amount.IsNil() {
+		accounting.deposited.Add(accounting.deposited, cctx.InboundParams.Amount.BigInt())
+	}
+
+	outbound := cctx.GetCurrentOutboundParam()
+	if outbound.ReceiverChainId == chainID && !outbound.Amount.IsNil() {
+		switch cctx.CctxStatus.Status {
+		case types.CctxStatus_OutboundMined, types.CctxStatus_Reverted:
+			accounting.withdrawn.Add(accounting.withdrawn, outbound.Amount.BigInt())
+		case types.CctxStatus_PendingOutbound, types.CctxStatus_PendingRevert:
+			accounting.pending.Add(accounting.pending, outbound.Amount.BigInt())
+		}
+	}
+
+	if cctx.CctxStatus.Status == types.CctxStatus_Aborted && !cctx.CctxStatus.IsAbortRefunded {
+		// same amount as GetAbortedAmount, the amounts of legacy CCTXs can be nil
+		amount := cctx.InboundParams.Amount
+		if !outbound.Amount.IsNil() && !outbound.Amount.IsZero() {
+			amount = outbound.Amount
+		}
+		if !amount.IsNil() {
+			accounting.abortedUnrefunded.Add(accounting.abortedUnrefunded, amount.BigInt())
+		}
+	}
+}
+
+// getCustodyAccountings returns the accounting of the foreign coins from all the CCTXs
+func (k Keeper) getCustodyAccountings(ctx sdk.Context) custodyAccountings {
+	accountings := make(custodyAccountings)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CCTXKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var cctx types.CrossChainTx
+		k.cdc.MustUnmarshal(iterator.Value(), &cctx)
+		accountings.add(cctx)
+	}
+
+	return accountings
+}
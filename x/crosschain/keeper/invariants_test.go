@@ -0,0 +1,197 @@
+package keeper_test
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/coin"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/keeper"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
+)
+
+// custodyCCTX returns a CCTX moving an amount of a foreign coin between the chain and ZetaChain
+func custodyCCTX(
+	t *testing.T,
+	index string,
+	senderChainID int64,
+	coinType coin.CoinType,
+	asset string,
+	amount uint64,
+	status types.CctxStatus,
+	outbounds ...*types.OutboundParams,
+) types.CrossChainTx {
+	cctx := sample.CrossChainTx(t, index)
+	cctx.InboundParams.SenderChainId = senderChainID
+	cctx.InboundParams.CoinType = coinType
+	cctx.InboundParams.Asset = asset
+	cctx.InboundParams.Amount = sdkmath.NewUint(amount)
+	cctx.OutboundParams = outbounds
+	cctx.CctxStatus.Status = status
+	cctx.CctxStatus.IsAbortRefunded = false
+	return *cctx
+}
+
+// custodyOutbound returns the outbound params of an amount to the receiver chain
+func custodyOutbound(receiverChainID int64, amount uint64) *types.OutboundParams {
+	return &types.OutboundParams{
+		ReceiverChainId: receiverChainID,
+		Amount:          sdkmath.NewUint(amount),
+		CallOptions:     &types.CallOptions{},
+	}
+}
+
+func TestKeeper_ZRC20CustodyInvariant(t *testing.T) {
+	ethChainID := chains.GoerliLocalnet.ChainId
+	zetaChainID := chains.ZetaChainPrivnet.ChainId
+	erc20Asset := sample.EthAddress().Hex()
+
+	gasCoin := sample.ForeignCoins(t, sample.EthAddress().Hex())
+	gasCoin.ForeignChainId = ethChainID
+	gasCoin.CoinType = coin.CoinType_Gas
+	gasCoin.Asset = ""
+
+	erc20Coin := sample.ForeignCoins(t, sample.EthAddress().Hex())
+	erc20Coin.ForeignChainId = ethChainID
+	erc20Coin.CoinType = coin.CoinType_ERC20
+	erc20Coin.Asset = erc20Asset
+
+	// setCustodyCCTXs sets CCTXs for the gas coin tracking:
+	// deposited 115 (100 mined, 10 aborted, 5 aborted and refunded), withdrawn 30, pending 20 and aborted 10
+	// the expected circulating supply is 100 - 30 - 20 + 5 = 55
+	setCustodyCCTXs := func(t *testing.T, k *keeper.Keeper, ctx sdk.Context) {
+		abortedRefunded := custodyCCTX(t, "4", ethChainID, coin.CoinType_Gas, "", 5, types.CctxStatus_Aborted,
+			custodyOutbound(zetaChainID, 5))
+		abortedRefunded.CctxStatus.IsAbortRefunded = true
+
+		for _, cctx := range []types.CrossChainTx{
+			custodyCCTX(t, "0", ethChainID, coin.CoinType_Gas, "", 100, types.CctxStatus_OutboundMined,
+				custodyOutbound(zetaChainID, 100)),
+			custodyCCTX(t, "1", zetaChainID, coin.CoinType_Gas, "", 30, types.CctxStatus_OutboundMined,
+				custodyOutbound(ethChainID, 30)),
+			custodyCCTX(t, "2", zetaChainID, coin.CoinType_Gas, "", 20, types.CctxStatus_PendingOutbound,
+				custodyOutbound(ethChainID, 20)),
+			custodyCCTX(t, "3", ethChainID, coin.CoinType_Gas, "", 10, types.CctxStatus_Aborted,
+				custodyOutbound(zetaChainID, 10)),
+			abortedRefunded,
+			// ZETA and commands are not tracked
+			custodyCCTX(t, "5", ethChainID, coin.CoinType_Zeta, "", 1000, types.CctxStatus_OutboundMined,
+				custodyOutbound(zetaChainID, 1000)),
+			custodyCCTX(t, "6", zetaChainID, coin.CoinType_Cmd, "", 0, types.CctxStatus_OutboundMined,
+				custodyOutbound(ethChainID, 1000)),
+		} {
+			k.SetCrossChainTx(ctx, cctx)
+		}
+	}
+
+	t.Run("should not break if the custody covers the circulating supply, pending and aborted amounts", func(t *testing.T) {
+		// arrange
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseFungibleMock: true,
+		})
+		fungibleMock := keepertest.GetCrosschainFungibleMock(t, k)
+		setCustodyCCTXs(t, k, ctx)
+
+		fungibleMock.On("GetAllForeignCoins", mock.Anything).Return([]fungibletypes.ForeignCoins{gasCoin})
+		fungibleMock.On("ZRC20CirculatingSupply", mock.Anything, gasCoin).Return(big.NewInt(55), nil)
+
+		// act
+		msg, broken := keeper.ZRC20CustodyInvariant(*k)(ctx)
+
+		// assert
+		require.False(t, broken, msg)
+	})
+
+	t.Run("should break if the circulating supply exceeds the custody", func(t *testing.T) {
+		// arrange
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseFungibleMock: true,
+		})
+		fungibleMock := keepertest.GetCrosschainFungibleMock(t, k)
+		setCustodyCCTXs(t, k, ctx)
+
+		fungibleMock.On("GetAllForeignCoins", mock.Anything).Return([]fungibletypes.ForeignCoins{gasCoin})
+		fungibleMock.On("ZRC20CirculatingSupply", mock.Anything, gasCoin).Return(big.NewInt(56), nil)
+
+		// act
+		msg, broken := keeper.ZRC20CustodyInvariant(*k)(ctx)
+
+		// assert
+		require.True(t, broken)
+		require.Contains(t, msg, gasCoin.Zrc20ContractAddress)
+		require.Contains(t, msg, "deposited 115 - withdrawn 30 < circulating 56 + pending 20 + aborted 10")
+	})
+
+	t.Run("should not break if the fees of a revert are kept in custody", func(t *testing.T) {
+		// arrange
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseFungibleMock: true,
+		})
+		fungibleMock := keepertest.GetCrosschainFungibleMock(t, k)
+		k.SetCrossChainTx(ctx, custodyCCTX(t, "0", ethChainID, coin.CoinType_Gas, "", 50, types.CctxStatus_Reverted,
+			custodyOutbound(zetaChainID, 50), custodyOutbound(ethChainID, 45)))
+
+		fungibleMock.On("GetAllForeignCoins", mock.Anything).Return([]fungibletypes.ForeignCoins{gasCoin})
+		fungibleMock.On("ZRC20CirculatingSupply", mock.Anything, gasCoin).Return(big.NewInt(5), nil)
+
+		// act
+		msg, broken := keeper.ZRC20CustodyInvariant(*k)(ctx)
+
+		// assert
+		require.False(t, broken, msg)
+	})
+
+	t.Run("should track the ERC20 coins by asset", func(t *testing.T) {
+		// arrange
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseFungibleMock: true,
+		})
+		fungibleMock := keepertest.GetCrosschainFungibleMock(t, k)
+		k.SetCrossChainTx(ctx, custodyCCTX(t, "0", ethChainID, coin.CoinType_ERC20, strings.ToLower(erc20Asset), 70,
+			types.CctxStatus_OutboundMined, custodyOutbound(zetaChainID, 70)))
+		k.SetCrossChainTx(ctx, custodyCCTX(t, "1", ethChainID, coin.CoinType_Gas, "", 40,
+			types.CctxStatus_OutboundMined, custodyOutbound(zetaChainID, 40)))
+
+		fungibleMock.On("GetAllForeignCoins", mock.Anything).
+			Return([]fungibletypes.ForeignCoins{gasCoin, erc20Coin})
+		fungibleMock.On("ZRC20CirculatingSupply", mock.Anything, gasCoin).Return(big.NewInt(40), nil)
+		fungibleMock.On("ZRC20CirculatingSupply", mock.Anything, erc20Coin).Return(big.NewInt(71), nil)
+
+		// act
+		msg, broken := keeper.ZRC20CustodyInvariant(*k)(ctx)
+
+		// assert
+		require.True(t, broken)
+		require.Contains(t, msg, "1 foreign coins with uncovered ZRC20 found")
+		require.Contains(t, msg, erc20Coin.Zrc20ContractAddress)
+		require.NotContains(t, msg, gasCoin.Zrc20ContractAddress)
+	})
+
+	t.Run("should break if the circulating supply can't be queried", func(t *testing.T) {
+		// arrange
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseFungibleMock: true,
+		})
+		fungibleMock := keepertest.GetCrosschainFungibleMock(t, k)
+
+		fungibleMock.On("GetAllForeignCoins", mock.Anything).Return([]fungibletypes.ForeignCoins{gasCoin})
+		fungibleMock.On("ZRC20CirculatingSupply", mock.Anything, gasCoin).Return(nil, errors.New("query failed"))
+
+		// act
+		msg, broken := keeper.ZRC20CustodyInvariant(*k)(ctx)
+
+		// assert
+		require.True(t, broken)
+		require.Contains(t, msg, "query failed")
+	})
+}
@@ -28,6 +28,10 @@ const voteInboundID = "Vote Inbound"
 // Omnichain contract address and arguments are passed as part of the message.
 // If everything is successful, the CCTX status is changed to `OutboundMined`.
 //
+// If the inbound has receiver splits, the CCTX deposits the remaining amount to
+// the receiver and a child CCTX is created for each split, depositing the split
+// amount to the split receiver on ZetaChain.
+//
 // If the receiver chain is a connected chain, the `FinalizeInbound` method is
 // called to prepare the CCTX to be processed as an outbound transaction. To
 // cover the outbound transaction fee, the required amount of tokens submitted
@@ -112,6 +116,15 @@ func (k msgServer) VoteInbound(
 	// Save the inbound CCTX to the store. This is called irrespective of the status of the CCTX or the outcome of the process function.
 	k.SaveObservedInboundInformation(ctx, cctx, msg.EventIndex)
 
+	// The receiver splits of the inbound are deposited by child CCTXs of the inbound CCTX
+	splitCctxs, err := k.ValidateInboundReceiverSplits(ctx, msg, cctx.Index)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to validate inbound receiver splits")
+	}
+	for _, splitCctx := range splitCctxs {
+		k.SaveObservedInboundInformation(ctx, splitCctx, msg.EventIndex)
+	}
+
 	return &types.MsgVoteInboundResponse{}, nil
 }
 
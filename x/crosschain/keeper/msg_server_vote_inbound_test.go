@@ -99,6 +99,77 @@ func TestKeeper_VoteInbound(t *testing.T) {
 		require.Equal(t, cctx.InboundParams.TxFinalizationStatus, types.TxFinalizationStatus_Executed)
 	})
 
+	t.Run("successfully vote on evm deposit with receiver splits", func(t *testing.T) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		validatorList := setObservers(t, k, ctx, zk)
+
+		to, from := chains.GoerliLocalnet.ChainId, chains.ZetaChainPrivnet.ChainId
+		supportedChains := zk.ObserverKeeper.GetSupportedChains(ctx)
+		for _, chain := range supportedChains {
+			if chains.IsEthereumChain(chain.ChainId, []chains.Chain{}) {
+				from = chain.ChainId
+			}
+			if chains.IsZetaChain(chain.ChainId, []chains.Chain{}) {
+				to = chain.ChainId
+			}
+		}
+
+		msg := sample.InboundVote(0, from, to)
+		msg.ReceiverSplits = []types.ReceiverSplit{
+			{Receiver: sample.EthAddress().Hex(), Amount: sdkmath.NewUint(1000)},
+			{Receiver: sample.EthAddress().Hex(), Amount: sdkmath.NewUint(2000)},
+		}
+		zk.ObserverKeeper.SetTSS(ctx, sample.Tss())
+
+		for _, receiver := range []string{msg.Receiver, msg.ReceiverSplits[0].Receiver, msg.ReceiverSplits[1].Receiver} {
+			err := sdkk.EvmKeeper.SetAccount(ctx, ethcommon.HexToAddress(receiver), statedb.Account{
+				Nonce:    0,
+				Balance:  uint256.NewInt(0),
+				CodeHash: crypto.Keccak256(nil),
+			})
+			require.NoError(t, err)
+		}
+		for _, validatorAddr := range validatorList {
+			msg.Creator = validatorAddr
+			_, err := msgServer.VoteInbound(
+				ctx,
+				&msg,
+			)
+			require.NoError(t, err)
+		}
+
+		// the inbound cctx deposits the remaining amount to the receiver
+		cctx, found := k.GetCrossChainTx(ctx, msg.Digest())
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_OutboundMined, cctx.CctxStatus.Status)
+		require.Equal(t, msg.Amount.Sub(sdkmath.NewUint(3000)), cctx.InboundParams.Amount)
+
+		// a child cctx deposits each split to its receiver
+		childIndexes := k.GetCctxChildIndexes(ctx, cctx.Index)
+		require.Len(t, childIndexes, 2)
+		for _, split := range msg.ReceiverSplits {
+			var child types.CrossChainTx
+			for _, childIndex := range childIndexes {
+				c, found := k.GetCrossChainTx(ctx, childIndex)
+				require.True(t, found)
+				if c.GetCurrentOutboundParam().Receiver == split.Receiver {
+					child = c
+				}
+			}
+			require.Equal(t, cctx.Index, child.ParentIndex)
+			require.Equal(t, split.Amount, child.InboundParams.Amount)
+			require.Equal(t, msg.InboundHash, child.InboundParams.ObservedHash)
+			require.Equal(t, types.CctxStatus_OutboundMined, child.CctxStatus.Status)
+			require.Equal(t, types.TxFinalizationStatus_Executed, child.InboundParams.TxFinalizationStatus)
+		}
+
+		// the inbound hash resolves to the inbound cctx and its children
+		inboundHashToCctx, found := k.GetInboundHashToCctx(ctx, msg.InboundHash)
+		require.True(t, found)
+		require.Len(t, inboundHashToCctx.CctxIndex, 3)
+	})
+
 	t.Run("prevent double event submission", func(t *testing.T) {
 		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
 
@@ -136,7 +136,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 }
 
 // RegisterInvariants registers the crosschain module's invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // InitGenesis performs the crosschain module's genesis initialization It returns
 // no validator updates.
@@ -172,6 +174,9 @@ func (am AppModule) BeginBlock(c context.Context) error {
 	// error is logged in the function
 	am.keeper.IterateAndUpdateCCTXGasPrice(ctx, supportedChains, keeper.CheckAndUpdateCCTXGasPrice)
 
+	// expire the pending outbounds of the cctxs whose inbound deadline is passed
+	am.keeper.IterateAndExpirePendingOutbounds(ctx, supportedChains)
+
 	return nil
 }
 
@@ -0,0 +1,132 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/gas"
+	"github.com/zeta-chain/node/testutil/sample"
+	zetasimulation "github.com/zeta-chain/node/testutil/simulation"
+	"github.com/zeta-chain/node/x/crosschain/keeper"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// SimulateMsgMigrateTssFunds generates a MsgMigrateTssFunds with random values and delivers it
+// The migration requires a new TSS and inbound to be disabled, the state is set directly as a keygen and the
+// emergency policy would do before the migration
+func SimulateMsgMigrateTssFunds(k keeper.Keeper) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accounts []simtypes.Account, _ string,
+	) (OperationMsg simtypes.OperationMsg, futureOps []simtypes.FutureOperation, err error) {
+		policyAccount, err := zetasimulation.GetPolicyAccount(ctx, k.GetAuthorityKeeper(), accounts)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgMigrateTssFunds, err.Error()), nil, nil
+		}
+
+		authAccount := k.GetAuthKeeper().GetAccount(ctx, policyAccount.Address)
+		spendable := k.GetBankKeeper().SpendableCoins(ctx, authAccount.GetAddress())
+
+		tss, found := k.GetObserverKeeper().GetTSS(ctx)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgMigrateTssFunds, "no TSS found"), nil, nil
+		}
+
+		// Pick a chain supporting tss migration without pending outbounds
+		var migrationChains []chains.Chain
+		for _, chain := range k.TSSFundsMigrationChains(ctx) {
+			pendingNonces, found := k.GetObserverKeeper().GetPendingNonces(ctx, tss.TssPubkey, chain.ChainId)
+			if found && pendingNonces.NonceLow == pendingNonces.NonceHigh {
+				migrationChains = append(migrationChains, chain)
+			}
+		}
+		if len(migrationChains) == 0 {
+			return simtypes.NoOpMsg(
+				types.ModuleName,
+				TypeMsgMigrateTssFunds,
+				"no chains without pending nonces found which support tss migration",
+			), nil, nil
+		}
+		chainID := zetasimulation.GetRandomChainID(r, migrationChains)
+
+		medianGasPrice, _, found := k.GetMedianGasValues(ctx, chainID)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgMigrateTssFunds, "no gas price found"), nil, nil
+		}
+
+		// The amount must cover the fee of the migration outbound on EVM chains
+		amount := sdkmath.NewUint(uint64(r.Int63n(1_000_000_000) + 1))
+		if chains.IsEVMChain(chainID, k.GetAuthorityKeeper().GetAdditionalChainList(ctx)) {
+			gasPrice, err := gas.MultiplyGasPrice(medianGasPrice, types.TssMigrationGasMultiplierEVM)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, TypeMsgMigrateTssFunds, err.Error()), nil, nil
+			}
+			amount = amount.Add(sdkmath.NewUint(gas.EVMSend).
+				Mul(gasPrice).
+				Add(sdkmath.NewUintFromString(types.TSSMigrationBufferAmountEVM)))
+		}
+
+		// Generate a new TSS if the current TSS is the latest one
+		tssHistory := k.GetObserverKeeper().GetAllTSS(ctx)
+		latestTss := tss
+		for _, t := range tssHistory {
+			if t.FinalizedZetaHeight > latestTss.FinalizedZetaHeight {
+				latestTss = t
+			}
+		}
+		if latestTss.TssPubkey == tss.TssPubkey {
+			newTss, err := sample.TSSFromRand(r)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, TypeMsgMigrateTssFunds, err.Error()), nil, nil
+			}
+			newTss.FinalizedZetaHeight = tss.FinalizedZetaHeight + 10
+			newTss.KeyGenZetaHeight = tss.KeyGenZetaHeight + 10
+			k.GetObserverKeeper().SetTSSHistory(ctx, newTss)
+		}
+
+		// Inbound must be disabled for the migration, it is enabled again by MsgEnableCCTX
+		flags, found := k.GetObserverKeeper().GetCrosschainFlags(ctx)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgMigrateTssFunds, "crosschain flags not found"), nil, nil
+		}
+		flags.IsInboundEnabled = false
+		k.GetObserverKeeper().SetCrosschainFlags(ctx, flags)
+
+		msg := types.MsgMigrateTssFunds{
+			Creator: policyAccount.Address.String(),
+			ChainId: chainID,
+			Amount:  amount,
+		}
+
+		err = msg.ValidateBasic()
+		if err != nil {
+			return simtypes.NoOpMsg(
+				types.ModuleName,
+				TypeMsgMigrateTssFunds,
+				"unable to validate MsgMigrateTssFunds msg",
+			), nil, err
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             &msg,
+			Context:         ctx,
+			SimAccount:      policyAccount,
+			AccountKeeper:   k.GetAuthKeeper(),
+			Bankkeeper:      k.GetBankKeeper(),
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		// A migration can still be rejected if a previous migration of the chain is pending
+		return zetasimulation.GenAndDeliverTxWithRandFees(txCtx, false)
+	}
+}
@@ -0,0 +1,72 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	zetasimulation "github.com/zeta-chain/node/testutil/simulation"
+	"github.com/zeta-chain/node/x/crosschain/keeper"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// SimulateMsgRemoveInboundTracker generates a MsgRemoveInboundTracker with random values
+func SimulateMsgRemoveInboundTracker(k keeper.Keeper) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accounts []simtypes.Account, _ string,
+	) (OperationMsg simtypes.OperationMsg, futureOps []simtypes.FutureOperation, err error) {
+		policyAccount, err := zetasimulation.GetPolicyAccount(ctx, k.GetAuthorityKeeper(), accounts)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgRemoveInboundTracker, err.Error()), nil, nil
+		}
+
+		authAccount := k.GetAuthKeeper().GetAccount(ctx, policyAccount.Address)
+		spendable := k.GetBankKeeper().SpendableCoins(ctx, authAccount.GetAddress())
+
+		trackers := k.GetAllInboundTracker(ctx)
+
+		if len(trackers) == 0 {
+			return simtypes.NoOpMsg(
+				types.ModuleName,
+				TypeMsgRemoveInboundTracker,
+				"no inbound trackers found",
+			), nil, nil
+		}
+
+		randomTracker := trackers[r.Intn(len(trackers))]
+
+		msg := types.MsgRemoveInboundTracker{
+			ChainId: randomTracker.ChainId,
+			TxHash:  randomTracker.TxHash,
+			Creator: policyAccount.Address.String(),
+		}
+
+		err = msg.ValidateBasic()
+		if err != nil {
+			return simtypes.NoOpMsg(
+				types.ModuleName,
+				TypeMsgRemoveInboundTracker,
+				"unable to validate MsgRemoveInboundTracker",
+			), nil, err
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             &msg,
+			Context:         ctx,
+			SimAccount:      policyAccount,
+			AccountKeeper:   k.GetAuthKeeper(),
+			Bankkeeper:      k.GetBankKeeper(),
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return zetasimulation.GenAndDeliverTxWithRandFees(txCtx, true)
+	}
+}
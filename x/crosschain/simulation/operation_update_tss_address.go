@@ -47,7 +47,7 @@ func SimulateMsgUpdateTssAddress(k keeper.Keeper) simtypes.Operation {
 		}
 
 		// Pick any cctx with status OutboundMined, and use its index for the migration
-		// We set the fund migrator directly as we are not simulating MsgMigrateTssFunds
+		// We set the fund migrator of all the chains directly as MsgMigrateTssFunds migrates a single chain
 		minedCCTX := types.CrossChainTx{}
 		foundMined := false
 		for _, cctx := range cctxList {
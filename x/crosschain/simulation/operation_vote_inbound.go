@@ -63,12 +63,13 @@ func SimulateVoteInbound(k keeper.Keeper) simtypes.Operation {
 		accs []simtypes.Account,
 		chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		to, from := chains.GoerliLocalnet.ChainId, chains.ZetaChainPrivnet.ChainId
-		supportedChains := k.GetObserverKeeper().GetSupportedChains(ctx)
-		for _, chain := range supportedChains {
-			if chains.IsEthereumChain(chain.ChainId, []chains.Chain{}) {
-				from = chain.ChainId
-			}
+		// The inbound is observed on a random external chain
+		externalChain, err := zetasimulation.GetExternalChain(ctx, k.GetObserverKeeper(), r)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgVoteInbound, err.Error()), nil, nil
+		}
+		from, to := externalChain.ChainId, chains.ZetaChainPrivnet.ChainId
+		for _, chain := range k.GetObserverKeeper().GetSupportedChains(ctx) {
 			if chains.IsZetaChain(chain.ChainId, []chains.Chain{}) {
 				to = chain.ChainId
 			}
@@ -76,7 +77,7 @@ func SimulateVoteInbound(k keeper.Keeper) simtypes.Operation {
 
 		asset, err := zetasimulation.GetAsset(ctx, k.GetFungibleKeeper(), from)
 		if err != nil {
-			return simtypes.NoOpMsg(types.ModuleName, TypeMsgVoteInbound, "unable to get asset"), nil, err
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgVoteInbound, "unable to get asset"), nil, nil
 		}
 
 		// Generate a random inbound vote , coin type is randomly selected
@@ -5,6 +5,7 @@ import (
 	"math"
 	"math/rand"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,6 +16,7 @@ import (
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/coin"
 	"github.com/zeta-chain/node/testutil/sample"
 	zetasimulation "github.com/zeta-chain/node/testutil/simulation"
 	"github.com/zeta-chain/node/x/crosschain/keeper"
@@ -56,13 +58,9 @@ func operationSimulateVoteOutbound(
 }
 
 // SimulateVoteOutbound generates a MsgVoteOutbound with random values and delivers it.
-//It also schedules future operations for subsequent votes.
-
+// It also schedules future operations for subsequent votes.
 func SimulateVoteOutbound(k keeper.Keeper) simtypes.Operation {
-	defaultVote := chains.ReceiveStatus_success
-	alternativeVote := chains.ReceiveStatus_failed
-	observerVotesTransitionMatrix, statePercentageArray, curNumVotesState := zetasimulation.ObserverVotesSimulationMatrix()
-	ballotVotesTransitionMatrix, yesVotePercentageArray, ballotVotesState := zetasimulation.OutboundVoteStatusSimulationMatrix()
+	ballots := newOutboundBallotSimulation()
 	return func(
 		r *rand.Rand,
 		app *baseapp.BaseApp,
@@ -98,20 +96,11 @@ func SimulateVoteOutbound(k keeper.Keeper) simtypes.Operation {
 		}
 
 		// Generate a new cctx and save it , which can be used to finalize the outbound
+		// The ZRC20 of a withdrawal would have to be burnt on ZEVM, the CCTXs of foreign coins are therefore reverted
+		// deposits whose amount is held by the TSS, this keeps the custody of the foreign coins consistent
 		cctx := sample.CCTXfromRand(r, creator, index, to, from, tss.TssPubkey, asset)
-		msg := types.MsgVoteOutbound{
-			CctxHash:                          cctx.Index,
-			OutboundTssNonce:                  cctx.GetCurrentOutboundParam().TssNonce,
-			OutboundChain:                     cctx.GetCurrentOutboundParam().ReceiverChainId,
-			Status:                            defaultVote,
-			Creator:                           cctx.Creator,
-			ObservedOutboundHash:              ethcommon.BytesToHash(sample.EthAddressFromRand(r).Bytes()).String(),
-			ValueReceived:                     cctx.GetCurrentOutboundParam().Amount,
-			ObservedOutboundBlockHeight:       cctx.GetCurrentOutboundParam().ObservedExternalHeight,
-			ObservedOutboundEffectiveGasPrice: cctx.GetCurrentOutboundParam().EffectiveGasPrice,
-			ObservedOutboundGasUsed:           cctx.GetCurrentOutboundParam().GasUsed,
-			CoinType:                          cctx.InboundParams.CoinType,
-			ConfirmationMode:                  cctx.GetCurrentOutboundParam().ConfirmationMode,
+		if cctx.InboundParams.CoinType != coin.CoinType_Zeta {
+			revertDepositFromRand(r, &cctx, from)
 		}
 
 		err = k.SetObserverOutboundInfo(ctx, to, &cctx)
@@ -122,118 +111,238 @@ func SimulateVoteOutbound(k keeper.Keeper) simtypes.Operation {
 				"unable to set observer outbound info",
 			), nil, err
 		}
-
-		msg.OutboundTssNonce = cctx.GetCurrentOutboundParam().TssNonce
 		k.SaveCCTXUpdate(ctx, cctx, tss.TssPubkey)
 
-		// Pick a random observer to create the ballot
-		// If this returns an error, it is likely that the entire observer set has been removed
-		simAccount, firstVoter, _, err := zetasimulation.GetRandomAccountAndObserver(
-			r,
-			ctx,
-			k.GetObserverKeeper(),
-			accs,
-		)
-		if err != nil {
-			return simtypes.OperationMsg{}, nil, nil
-		}
-
-		txGen := moduletestutil.MakeTestEncodingConfig().TxConfig
-		account := k.GetAuthKeeper().GetAccount(ctx, simAccount.Address)
-		firstMsg := msg
-		firstMsg.Creator = firstVoter
-
-		// THe first vote should always create a new ballot
-		_, found = k.GetObserverKeeper().GetBallot(ctx, firstMsg.Digest())
-		if found {
-			return simtypes.NoOpMsg(types.ModuleName, TypeMsgVoteOutbound, "ballot already exists"), nil, nil
-		}
+		msg := outboundVoteFromCCTX(r, cctx, chains.ReceiveStatus_success)
+		msg.Creator = cctx.Creator
+		return ballots.vote(r, app, ctx, k, accs, chainID, msg)
+	}
+}
 
-		err = firstMsg.ValidateBasic()
+// SimulateVoteOutboundPendingCCTX votes on the outbound of a pending CCTX of a random external chain to finalize it.
+// A failed outbound reverts the CCTX and a failed revert aborts it.
+// It also schedules future operations for subsequent votes.
+func SimulateVoteOutboundPendingCCTX(k keeper.Keeper) simtypes.Operation {
+	ballots := newOutboundBallotSimulation()
+	return func(
+		r *rand.Rand,
+		app *baseapp.BaseApp,
+		ctx sdk.Context,
+		accs []simtypes.Account,
+		chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		externalChain, err := zetasimulation.GetExternalChain(ctx, k.GetObserverKeeper(), r)
 		if err != nil {
-			return simtypes.NoOpMsg(
-				types.ModuleName,
-				TypeMsgVoteOutbound,
-				"unable to validate first outbound vote",
-			), nil, err
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgVoteOutbound, err.Error()), nil, nil
 		}
 
-		tx, err := simtestutil.GenSignedMockTx(
-			r,
-			txGen,
-			[]sdk.Msg{&firstMsg},
-			sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)},
-			simtestutil.DefaultGenTxGas,
-			chainID,
-			[]uint64{account.GetAccountNumber()},
-			[]uint64{account.GetSequence()},
-			simAccount.PrivKey,
-		)
+		res, err := k.ListPendingCctx(ctx, &types.QueryListPendingCctxRequest{
+			ChainId: externalChain.ChainId,
+			Limit:   10,
+		})
 		if err != nil {
-			return simtypes.NoOpMsg(types.ModuleName, TypeMsgVoteOutbound, "unable to generate mock tx"), nil, err
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgVoteOutbound, "unable to list pending cctxs"), nil, nil
+		}
+		if len(res.CrossChainTx) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgVoteOutbound, "no pending cctx found"), nil, nil
 		}
+		cctx := res.CrossChainTx[r.Intn(len(res.CrossChainTx))]
 
-		// We can return error here as we can guarantee that the first vote will be successful.
-		// Since we query the observer set before adding votes
-		_, _, err = app.SimDeliver(txGen.TxEncoder(), tx)
-		if err != nil {
-			return simtypes.NoOpMsg(types.ModuleName, TypeMsgVoteOutbound, "unable to deliver tx"), nil, err
+		// The first vote decides the observation of most of the ballots
+		status := chains.ReceiveStatus_success
+		if r.Intn(2) == 0 {
+			status = chains.ReceiveStatus_failed
 		}
+		msg := outboundVoteFromCCTX(r, *cctx, status)
+		return ballots.vote(r, app, ctx, k, accs, chainID, msg)
+	}
+}
 
-		opMsg := zetasimulation.OperationMessage(&msg)
+// revertDepositFromRand turns a synthetic CCTX into a deposit from the receiver chain of its outbound to ZetaChain,
+// pending the revert of the deposit amount minus a random fee
+func revertDepositFromRand(r *rand.Rand, cctx *types.CrossChainTx, zetaChainID int64) {
+	revertOutbound := cctx.GetCurrentOutboundParam()
+	depositAmount := cctx.InboundParams.Amount
 
-		// Add subsequent votes
-		observerSet, found := k.GetObserverKeeper().GetObserverSet(ctx)
-		if !found {
-			return simtypes.NoOpMsg(types.ModuleName, TypeMsgVoteOutbound, "observer set not found"), nil, nil
-		}
+	depositOutbound := *revertOutbound
+	depositOutbound.ReceiverChainId = zetaChainID
+	depositOutbound.Receiver = cctx.InboundParams.Sender
+	depositOutbound.Amount = depositAmount
 
-		// 1) Schedule operations for votes
-		// 1.1) first pick a number of people to vote.
-		curNumVotesState = observerVotesTransitionMatrix.NextState(r, curNumVotesState)
-		numVotes := int(math.Ceil(float64(len(observerSet.ObserverList)) * statePercentageArray[curNumVotesState]))
+	cctx.InboundParams.SenderChainId = revertOutbound.ReceiverChainId
+	revertOutbound.Receiver = cctx.InboundParams.Sender
+	revertOutbound.Amount = depositAmount.Sub(depositAmount.QuoUint64(uint64(r.Intn(90) + 10)))
 
-		// 1.2) select who votes
-		whoVotes := r.Perm(len(observerSet.ObserverList))
-		whoVotes = whoVotes[:numVotes]
+	cctx.OutboundParams = []*types.OutboundParams{&depositOutbound, revertOutbound}
+	cctx.CctxStatus.Status = types.CctxStatus_PendingRevert
+}
 
-		var fops []simtypes.FutureOperation
+// outboundVoteFromCCTX returns a vote observing the current outbound of the CCTX with the given status
+func outboundVoteFromCCTX(r *rand.Rand, cctx types.CrossChainTx, status chains.ReceiveStatus) types.MsgVoteOutbound {
+	outbound := cctx.GetCurrentOutboundParam()
+	return types.MsgVoteOutbound{
+		CctxHash:                          cctx.Index,
+		OutboundTssNonce:                  outbound.TssNonce,
+		OutboundChain:                     outbound.ReceiverChainId,
+		Status:                            status,
+		ObservedOutboundHash:              ethcommon.BytesToHash(sample.EthAddressFromRand(r).Bytes()).String(),
+		ValueReceived:                     outbound.Amount,
+		ObservedOutboundBlockHeight:       r.Uint64(),
+		ObservedOutboundEffectiveGasPrice: sdkmath.NewInt(r.Int63()),
+		ObservedOutboundGasUsed:           outbound.GasUsed,
+		CoinType:                          cctx.InboundParams.CoinType,
+		ConfirmationMode:                  outbound.ConfirmationMode,
+	}
+}
 
-		ballotVotesState = ballotVotesTransitionMatrix.NextState(r, ballotVotesState)
-		yesVotePercentage := yesVotePercentageArray[ballotVotesState]
-		numberOfYesVotes := int(math.Ceil(float64(numVotes) * yesVotePercentage))
-		vote := defaultVote
+// outboundBallotSimulation simulates the votes of the observer set on outbound ballots.
+// The number of observers voting and the share of votes for the observed status follow transition matrices
+// and the other votes are for the opposite status.
+type outboundBallotSimulation struct {
+	observerVotesTransitionMatrix simtypes.TransitionMatrix
+	statePercentageArray          []float64
+	curNumVotesState              int
+	ballotVotesTransitionMatrix   simtypes.TransitionMatrix
+	yesVotePercentageArray        []float64
+	ballotVotesState              int
+}
 
-		for voteCount, observerIdx := range whoVotes {
-			if voteCount == numberOfYesVotes {
-				vote = alternativeVote
-			}
-			observerAddress := observerSet.ObserverList[observerIdx]
-			// firstVoter has already voted.
-			if observerAddress == firstVoter {
-				continue
-			}
-			observerAccount, err := zetasimulation.GetObserverAccount(observerAddress, accs)
-			if err != nil {
-				continue
-			}
-			// 1.3) schedule the vote
-			votingMsg := msg
-			votingMsg.Creator = observerAddress
-			votingMsg.Status = vote
-
-			e := votingMsg.ValidateBasic()
-			if e != nil {
-				return simtypes.NoOpMsg(types.ModuleName, TypeMsgVoteOutbound, "unable to validate voting msg"), nil, e
-			}
+func newOutboundBallotSimulation() *outboundBallotSimulation {
+	observerVotesTransitionMatrix, statePercentageArray, curNumVotesState := zetasimulation.ObserverVotesSimulationMatrix()
+	ballotVotesTransitionMatrix, yesVotePercentageArray, ballotVotesState := zetasimulation.OutboundVoteStatusSimulationMatrix()
+	return &outboundBallotSimulation{
+		observerVotesTransitionMatrix: observerVotesTransitionMatrix,
+		statePercentageArray:          statePercentageArray,
+		curNumVotesState:              curNumVotesState,
+		ballotVotesTransitionMatrix:   ballotVotesTransitionMatrix,
+		yesVotePercentageArray:        yesVotePercentageArray,
+		ballotVotesState:              ballotVotesState,
+	}
+}
+
+// vote delivers the first vote creating the ballot of the outbound and schedules the votes of the other observers
+func (s *outboundBallotSimulation) vote(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	k keeper.Keeper,
+	accs []simtypes.Account,
+	chainID string,
+	msg types.MsgVoteOutbound,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	defaultVote := msg.Status
+	alternativeVote := chains.ReceiveStatus_failed
+	if defaultVote == chains.ReceiveStatus_failed {
+		alternativeVote = chains.ReceiveStatus_success
+	}
+
+	// Pick a random observer to create the ballot
+	// If this returns an error, it is likely that the entire observer set has been removed
+	simAccount, firstVoter, _, err := zetasimulation.GetRandomAccountAndObserver(
+		r,
+		ctx,
+		k.GetObserverKeeper(),
+		accs,
+	)
+	if err != nil {
+		return simtypes.OperationMsg{}, nil, nil
+	}
+
+	txGen := moduletestutil.MakeTestEncodingConfig().TxConfig
+	account := k.GetAuthKeeper().GetAccount(ctx, simAccount.Address)
+	firstMsg := msg
+	firstMsg.Creator = firstVoter
+
+	// THe first vote should always create a new ballot
+	_, found := k.GetObserverKeeper().GetBallot(ctx, firstMsg.Digest())
+	if found {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgVoteOutbound, "ballot already exists"), nil, nil
+	}
+
+	err = firstMsg.ValidateBasic()
+	if err != nil {
+		return simtypes.NoOpMsg(
+			types.ModuleName,
+			TypeMsgVoteOutbound,
+			"unable to validate first outbound vote",
+		), nil, err
+	}
 
-			fops = append(fops, simtypes.FutureOperation{
-				// Submit all subsequent votes in the next block.
-				// We can consider adding a random block height between 1 and ballot maturity blocks in the future.
-				BlockHeight: int(ctx.BlockHeight() + 1),
-				Op:          operationSimulateVoteOutbound(k, votingMsg, observerAccount),
-			})
+	tx, err := simtestutil.GenSignedMockTx(
+		r,
+		txGen,
+		[]sdk.Msg{&firstMsg},
+		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)},
+		simtestutil.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		simAccount.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgVoteOutbound, "unable to generate mock tx"), nil, err
+	}
+
+	// We can return error here as we can guarantee that the first vote will be successful.
+	// Since we query the observer set before adding votes
+	_, _, err = app.SimDeliver(txGen.TxEncoder(), tx)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgVoteOutbound, "unable to deliver tx"), nil, err
+	}
+
+	opMsg := zetasimulation.OperationMessage(&msg)
+
+	// Add subsequent votes
+	observerSet, found := k.GetObserverKeeper().GetObserverSet(ctx)
+	if !found {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgVoteOutbound, "observer set not found"), nil, nil
+	}
+
+	// 1) Schedule operations for votes
+	// 1.1) first pick a number of people to vote.
+	s.curNumVotesState = s.observerVotesTransitionMatrix.NextState(r, s.curNumVotesState)
+	numVotes := int(math.Ceil(float64(len(observerSet.ObserverList)) * s.statePercentageArray[s.curNumVotesState]))
+
+	// 1.2) select who votes
+	whoVotes := r.Perm(len(observerSet.ObserverList))
+	whoVotes = whoVotes[:numVotes]
+
+	var fops []simtypes.FutureOperation
+
+	s.ballotVotesState = s.ballotVotesTransitionMatrix.NextState(r, s.ballotVotesState)
+	yesVotePercentage := s.yesVotePercentageArray[s.ballotVotesState]
+	numberOfYesVotes := int(math.Ceil(float64(numVotes) * yesVotePercentage))
+	vote := defaultVote
+
+	for voteCount, observerIdx := range whoVotes {
+		if voteCount == numberOfYesVotes {
+			vote = alternativeVote
+		}
+		observerAddress := observerSet.ObserverList[observerIdx]
+		// firstVoter has already voted.
+		if observerAddress == firstVoter {
+			continue
+		}
+		observerAccount, err := zetasimulation.GetObserverAccount(observerAddress, accs)
+		if err != nil {
+			continue
+		}
+		// 1.3) schedule the vote
+		votingMsg := msg
+		votingMsg.Creator = observerAddress
+		votingMsg.Status = vote
+
+		e := votingMsg.ValidateBasic()
+		if e != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgVoteOutbound, "unable to validate voting msg"), nil, e
 		}
-		return opMsg, fops, nil
+
+		fops = append(fops, simtypes.FutureOperation{
+			// Submit all subsequent votes in the next block.
+			// We can consider adding a random block height between 1 and ballot maturity blocks in the future.
+			BlockHeight: int(ctx.BlockHeight() + 1),
+			Op:          operationSimulateVoteOutbound(k, votingMsg, observerAccount),
+		})
 	}
+	return opMsg, fops, nil
 }
@@ -15,6 +15,7 @@ var (
 	TypeMsgAddOutboundTracker     = sdk.MsgTypeURL(&types.MsgAddOutboundTracker{})
 	TypeMsgAddInboundTracker      = sdk.MsgTypeURL(&types.MsgAddInboundTracker{})
 	TypeMsgRemoveOutboundTracker  = sdk.MsgTypeURL(&types.MsgRemoveOutboundTracker{})
+	TypeMsgRemoveInboundTracker   = sdk.MsgTypeURL(&types.MsgRemoveInboundTracker{})
 	TypeMsgVoteGasPrice           = sdk.MsgTypeURL(&types.MsgVoteGasPrice{})
 	TypeMsgVoteOutbound           = sdk.MsgTypeURL(&types.MsgVoteOutbound{})
 	TypeMsgVoteInbound            = sdk.MsgTypeURL(&types.MsgVoteInbound{})
@@ -42,8 +43,10 @@ const (
 	DefaultWeightAddOutboundTracker     = 10
 	DefaultWeightAddInboundTracker      = 10
 	DefaultWeightRemoveOutboundTracker  = 10
+	DefaultWeightRemoveInboundTracker   = 10
 	DefaultWeightVoteGasPrice           = 50
 	DefaultWeightVoteOutbound           = 10
+	DefaultWeightVoteOutboundPending    = 10
 	DefaultWeightVoteInbound            = 10
 	DefaultWeightWhitelistAsset         = 10
 	DefaultWeightMigrateTssFunds        = 1
@@ -55,8 +58,10 @@ const (
 	OpWeightMsgAddOutboundTracker  = "op_weight_msg_add_outbound_tracker"      // #nosec G101 not a hardcoded credential
 	OpWeightAddInboundTracker      = "op_weight_msg_add_inbound_tracker"       // #nosec G101 not a hardcoded credential
 	OpWeightRemoveOutboundTracker  = "op_weight_msg_remove_outbound_tracker"   // #nosec G101 not a hardcoded credential
+	OpWeightRemoveInboundTracker   = "op_weight_msg_remove_inbound_tracker"    // #nosec G101 not a hardcoded credential
 	OpWeightVoteGasPrice           = "op_weight_msg_vote_gas_price"            // #nosec G101 not a hardcoded credential
 	OpWeightVoteOutbound           = "op_weight_msg_vote_outbound"             // #nosec G101 not a hardcoded credential
+	OpWeightVoteOutboundPending    = "op_weight_msg_vote_outbound_pending"     // #nosec G101 not a hardcoded credential
 	OpWeightVoteInbound            = "op_weight_msg_vote_inbound"              // #nosec G101 not a hardcoded credential
 	OpWeightWhitelistAsset         = "op_weight_msg_whitelist_asset"           // #nosec G101 not a hardcoded credential
 	OpWeightMigrateTssFunds        = "op_weight_msg_migrate_tss_funds"         // #nosec G101 not a hardcoded credential
@@ -72,8 +77,10 @@ func WeightedOperations(
 		weightAddOutboundTracker     int
 		weightAddInboundTracker      int
 		weightRemoveOutboundTracker  int
+		weightRemoveInboundTracker   int
 		weightVoteGasPrice           int
 		weightVoteOutbound           int
+		weightVoteOutboundPending    int
 		weightVoteInbound            int
 		weightWhitelistAsset         int
 		weightMigrateTssFunds        int
@@ -101,6 +108,12 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(OpWeightRemoveInboundTracker, &weightRemoveInboundTracker, nil,
+		func(_ *rand.Rand) {
+			weightRemoveInboundTracker = DefaultWeightRemoveInboundTracker
+		},
+	)
+
 	appParams.GetOrGenerate(OpWeightVoteGasPrice, &weightVoteGasPrice, nil,
 		func(_ *rand.Rand) {
 			weightVoteGasPrice = DefaultWeightVoteGasPrice
@@ -113,6 +126,12 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(OpWeightVoteOutboundPending, &weightVoteOutboundPending, nil,
+		func(_ *rand.Rand) {
+			weightVoteOutboundPending = DefaultWeightVoteOutboundPending
+		},
+	)
+
 	appParams.GetOrGenerate(OpWeightVoteInbound, &weightVoteInbound, nil,
 		func(_ *rand.Rand) {
 			weightVoteInbound = DefaultWeightVoteInbound
@@ -155,9 +174,6 @@ func WeightedOperations(
 		},
 	)
 
-	// TODO : Add the new test for MsgRemoveInboundTracker
-	// https://github.com/zeta-chain/node/issues/3479
-
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightVoteGasPrice,
@@ -171,6 +187,10 @@ func WeightedOperations(
 			weightVoteOutbound,
 			SimulateVoteOutbound(k),
 		),
+		simulation.NewWeightedOperation(
+			weightVoteOutboundPending,
+			SimulateVoteOutboundPendingCCTX(k),
+		),
 		simulation.NewWeightedOperation(
 			weightAddInboundTracker,
 			SimulateMsgAddInboundTracker(k),
@@ -183,6 +203,10 @@ func WeightedOperations(
 			weightRemoveOutboundTracker,
 			SimulateMsgRemoveOutboundTracker(k),
 		),
+		simulation.NewWeightedOperation(
+			weightRemoveInboundTracker,
+			SimulateMsgRemoveInboundTracker(k),
+		),
 		simulation.NewWeightedOperation(
 			weightWhitelistAsset,
 			SimulateMsgWhitelistAsset(k),
@@ -203,5 +227,9 @@ func WeightedOperations(
 			weightUpdateTssAddress,
 			SimulateMsgUpdateTssAddress(k),
 		),
+		simulation.NewWeightedOperation(
+			weightMigrateTssFunds,
+			SimulateMsgMigrateTssFunds(k),
+		),
 	}
 }
@@ -291,6 +291,7 @@ func (m CrossChainTx) IsWithdrawAndCall() bool {
 
 // NewCCTX creates a new CCTX from a MsgVoteInbound message and a TSS pubkey.
 // It also validates the created cctx
+// The amounts of the receiver splits of the message are not part of the CCTX, they are deposited by child CCTXs.
 func NewCCTX(ctx sdk.Context, msg MsgVoteInbound, tssPubkey string) (CrossChainTx, error) {
 	index := msg.Digest()
 
@@ -302,7 +303,7 @@ func NewCCTX(ctx sdk.Context, msg MsgVoteInbound, tssPubkey string) (CrossChainT
 		SenderChainId:          msg.SenderChainId,
 		TxOrigin:               msg.TxOrigin,
 		Asset:                  msg.Asset,
-		Amount:                 msg.Amount,
+		Amount:                 msg.ReceiverAmount(),
 		ObservedHash:           msg.InboundHash,
 		ObservedExternalHeight: msg.InboundBlockHeight,
 		FinalizedZetaHeight:    0,
@@ -312,6 +313,7 @@ func NewCCTX(ctx sdk.Context, msg MsgVoteInbound, tssPubkey string) (CrossChainT
 		Status:                 msg.Status,
 		ConfirmationMode:       msg.ConfirmationMode,
 		ErrorMessage:           msg.ErrorMessage,
+		Deadline:               msg.Deadline,
 	}
 
 	outboundParams := &OutboundParams{
@@ -205,6 +205,11 @@ type InboundParams struct {
 	// error_message carries information about the error that caused non-SUCCESS
 	// status of inbound observation
 	ErrorMessage string `protobuf:"bytes,22,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// deadline is the unix timestamp (seconds) after which the inbound must not
+	// be executed, the CCTX is reverted instead. zero means no deadline
+	// the deadline is carried by the standard memo of non-EVM inbounds, the EVM
+	// gateway events don't carry one and EVM inbounds have no deadline
+	Deadline uint64 `protobuf:"varint,23,opt,name=deadline,proto3" json:"deadline,omitempty"`
 }
 
 func (m *InboundParams) Reset()         { *m = InboundParams{} }
@@ -338,6 +343,13 @@ func (m *InboundParams) GetErrorMessage() string {
 	return ""
 }
 
+func (m *InboundParams) GetDeadline() uint64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
 type ZetaAccounting struct {
 	// aborted_zeta_amount stores the total aborted amount for cctx of coin-type
 	// ZETA
@@ -454,6 +466,9 @@ type OutboundParams struct {
 	ConfirmationMode ConfirmationMode `protobuf:"varint,25,opt,name=confirmation_mode,json=confirmationMode,proto3,enum=zetachain.zetacore.crosschain.ConfirmationMode" json:"confirmation_mode,omitempty"`
 	// This field tracks the original gas fee paid by the user.
 	UserGasFeePaid cosmossdk_io_math.Uint `protobuf:"bytes,26,opt,name=user_gas_fee_paid,json=userGasFeePaid,proto3,customtype=cosmossdk.io/math.Uint" json:"user_gas_fee_paid"`
+	// is_expired is set when the inbound deadline passed while the outbound was
+	// pending, the observers then cancel the outbound and the CCTX is reverted
+	IsExpired bool `protobuf:"varint,27,opt,name=is_expired,json=isExpired,proto3" json:"is_expired,omitempty"`
 }
 
 func (m *OutboundParams) Reset()         { *m = OutboundParams{} }
@@ -601,6 +616,13 @@ func (m *OutboundParams) GetConfirmationMode() ConfirmationMode {
 	return ConfirmationMode_SAFE
 }
 
+func (m *OutboundParams) GetIsExpired() bool {
+	if m != nil {
+		return m.IsExpired
+	}
+	return false
+}
+
 type Status struct {
 	Status CctxStatus `protobuf:"varint,1,opt,name=status,proto3,enum=zetachain.zetacore.crosschain.CctxStatus" json:"status,omitempty"`
 	// status_message carries information about the status transitions:
@@ -789,6 +811,9 @@ type CrossChainTx struct {
 	OutboundParams          []*OutboundParams       `protobuf:"bytes,10,rep,name=outbound_params,json=outboundParams,proto3" json:"outbound_params,omitempty"`
 	ProtocolContractVersion ProtocolContractVersion `protobuf:"varint,11,opt,name=protocol_contract_version,json=protocolContractVersion,proto3,enum=zetachain.zetacore.crosschain.ProtocolContractVersion" json:"protocol_contract_version,omitempty"`
 	RevertOptions           RevertOptions           `protobuf:"bytes,12,opt,name=revert_options,json=revertOptions,proto3" json:"revert_options"`
+	// index of the cctx whose ZEVM execution created this cctx
+	// empty if the cctx was created from an inbound on a connected chain
+	ParentIndex string `protobuf:"bytes,13,opt,name=parent_index,json=parentIndex,proto3" json:"parent_index,omitempty"`
 }
 
 func (m *CrossChainTx) Reset()         { *m = CrossChainTx{} }
@@ -880,6 +905,13 @@ func (m *CrossChainTx) GetRevertOptions() RevertOptions {
 	return RevertOptions{}
 }
 
+func (m *CrossChainTx) GetParentIndex() string {
+	if m != nil {
+		return m.ParentIndex
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("zetachain.zetacore.crosschain.CctxStatus", CctxStatus_name, CctxStatus_value)
 	proto.RegisterEnum("zetachain.zetacore.crosschain.TxFinalizationStatus", TxFinalizationStatus_name, TxFinalizationStatus_value)
@@ -900,110 +932,113 @@ func init() {
 }
 
 var fileDescriptor_d4c1966807fb5cb2 = []byte{
-	// 1634 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x6f, 0x1b, 0xb9,
-	0x15, 0xd7, 0xd8, 0xb2, 0x2c, 0x3f, 0x7d, 0x78, 0x4c, 0x3b, 0xce, 0xc4, 0xdb, 0x28, 0xae, 0xb7,
-	0xd9, 0x6a, 0xd3, 0x8d, 0xdc, 0xf5, 0x02, 0x8b, 0xa2, 0x37, 0x45, 0x96, 0x12, 0xb5, 0xb1, 0x65,
-	0x8c, 0x64, 0xa3, 0x5d, 0x14, 0x60, 0xa9, 0x19, 0x4a, 0x22, 0x22, 0x0d, 0x85, 0x21, 0x65, 0xc8,
-	0x8b, 0xde, 0xfa, 0x0f, 0x14, 0xe8, 0xb9, 0xb7, 0x1e, 0xfa, 0xa7, 0xec, 0x71, 0x8f, 0x45, 0x0f,
-	0x41, 0x91, 0x1c, 0x7a, 0xdf, 0x73, 0x0f, 0x05, 0x3f, 0x46, 0x1f, 0x8e, 0x6b, 0xbb, 0x45, 0x4e,
-	0x22, 0xdf, 0xe3, 0xfb, 0xf1, 0xe9, 0xf1, 0xfd, 0x7e, 0xe4, 0xc0, 0xd1, 0xb7, 0x54, 0x92, 0x60,
-	0x40, 0x58, 0x74, 0xa8, 0x47, 0x3c, 0xa6, 0x87, 0x41, 0xcc, 0x85, 0x30, 0x36, 0x3d, 0xc4, 0x7a,
-	0x8c, 0xe5, 0xb4, 0x32, 0x8e, 0xb9, 0xe4, 0xe8, 0xf1, 0x2c, 0xa6, 0x92, 0xc4, 0x54, 0xe6, 0x31,
-	0x7b, 0x3b, 0x7d, 0xde, 0xe7, 0x7a, 0xe5, 0xa1, 0x1a, 0x99, 0xa0, 0xbd, 0xcf, 0x6e, 0xd8, 0x68,
-	0xfc, 0xa6, 0x7f, 0x18, 0x70, 0xb5, 0x0d, 0x67, 0x91, 0x59, 0x77, 0xf0, 0x97, 0x0c, 0x14, 0x9a,
-	0x51, 0x97, 0x4f, 0xa2, 0xf0, 0x8c, 0xc4, 0x64, 0x24, 0xd0, 0x2e, 0x64, 0x04, 0x8d, 0x42, 0x1a,
-	0x7b, 0xce, 0xbe, 0x53, 0xde, 0xf0, 0xed, 0x0c, 0x7d, 0x06, 0x9b, 0x66, 0x64, 0xf3, 0x63, 0xa1,
-	0xb7, 0xb2, 0xef, 0x94, 0x57, 0xfd, 0x82, 0x31, 0xd7, 0x94, 0xb5, 0x19, 0xa2, 0x4f, 0x60, 0x43,
-	0x4e, 0x31, 0x8f, 0x59, 0x9f, 0x45, 0xde, 0xaa, 0x86, 0xc8, 0xca, 0x69, 0x4b, 0xcf, 0xd1, 0x0b,
-	0xd8, 0x50, 0x9b, 0x63, 0x79, 0x35, 0xa6, 0x5e, 0x7a, 0xdf, 0x29, 0x17, 0x8f, 0x9e, 0x56, 0x6e,
-	0xf8, 0x7f, 0xe3, 0x37, 0xfd, 0x8a, 0xce, 0xb2, 0xc6, 0x59, 0xd4, 0xb9, 0x1a, 0x53, 0x3f, 0x1b,
-	0xd8, 0x11, 0xda, 0x81, 0x35, 0x22, 0x04, 0x95, 0xde, 0x9a, 0x06, 0x37, 0x13, 0xf4, 0x35, 0x64,
-	0xc8, 0x88, 0x4f, 0x22, 0xe9, 0x65, 0x94, 0xf9, 0x45, 0xe9, 0xbb, 0xb7, 0x4f, 0x52, 0xff, 0x78,
-	0xfb, 0x64, 0x37, 0xe0, 0x62, 0xc4, 0x85, 0x08, 0xdf, 0x54, 0x18, 0x3f, 0x1c, 0x11, 0x39, 0xa8,
-	0x9c, 0xb3, 0x48, 0xfa, 0x76, 0x35, 0xfa, 0x14, 0x0a, 0xbc, 0x2b, 0x68, 0x7c, 0x49, 0x43, 0x3c,
-	0x20, 0x62, 0xe0, 0xad, 0x6b, 0xd4, 0x7c, 0x62, 0x7c, 0x45, 0xc4, 0x00, 0xfd, 0x02, 0xbc, 0xd9,
-	0x22, 0x3a, 0x95, 0x34, 0x8e, 0xc8, 0x10, 0x0f, 0x28, 0xeb, 0x0f, 0xa4, 0x97, 0xdd, 0x77, 0xca,
-	0x69, 0x7f, 0x37, 0xf1, 0xd7, 0xad, 0xfb, 0x95, 0xf6, 0xa2, 0x1f, 0x43, 0xbe, 0x4b, 0x86, 0x43,
-	0x2e, 0x31, 0x8b, 0x42, 0x3a, 0xf5, 0x36, 0x34, 0x7a, 0xce, 0xd8, 0x9a, 0xca, 0x84, 0x8e, 0xe0,
-	0x41, 0x8f, 0x45, 0x64, 0xc8, 0xbe, 0xa5, 0x21, 0x56, 0x15, 0x48, 0x90, 0x41, 0x23, 0x6f, 0xcf,
-	0x9c, 0xdf, 0x50, 0x49, 0x2c, 0x2c, 0x83, 0x5d, 0x39, 0xc5, 0xd6, 0x43, 0x24, 0xe3, 0x11, 0x16,
-	0x92, 0xc8, 0x89, 0xf0, 0x72, 0xba, 0xa8, 0x5f, 0x55, 0x6e, 0x6d, 0x9a, 0x4a, 0x67, 0xda, 0x58,
-	0x88, 0x6d, 0xeb, 0x50, 0x7f, 0x47, 0xde, 0x60, 0x45, 0xcf, 0x61, 0x9b, 0x09, 0xbc, 0xd8, 0x99,
-	0x01, 0x19, 0x0e, 0xbd, 0xfc, 0xbe, 0x53, 0xce, 0xfa, 0x2e, 0x13, 0x35, 0xe5, 0xd1, 0x87, 0x5f,
-	0x23, 0xc3, 0x21, 0x3a, 0x86, 0x8c, 0xcd, 0x64, 0x47, 0x67, 0xf2, 0xc5, 0x1d, 0x99, 0xd8, 0xe6,
-	0xb3, 0x29, 0xd8, 0x58, 0xf4, 0x3b, 0xd8, 0x0a, 0x78, 0xd4, 0x63, 0xf1, 0xc8, 0xfc, 0xb9, 0x11,
-	0x0f, 0xa9, 0xf7, 0x40, 0x03, 0x1e, 0xde, 0x01, 0x58, 0x5b, 0x88, 0x3b, 0xe1, 0x21, 0xf5, 0xdd,
-	0xe0, 0x9a, 0x45, 0x9d, 0x39, 0x8d, 0x63, 0x1e, 0xe3, 0x11, 0x15, 0x82, 0xf4, 0xa9, 0xb7, 0x6b,
-	0xce, 0x5c, 0x1b, 0x4f, 0x8c, 0xed, 0x57, 0xe9, 0x6c, 0xc1, 0xdd, 0x39, 0xf8, 0x3d, 0x14, 0x55,
-	0xd9, 0xab, 0x41, 0xa0, 0xba, 0x85, 0x45, 0x7d, 0x74, 0x0a, 0xdb, 0xa4, 0xcb, 0x63, 0x99, 0x1c,
-	0x96, 0xed, 0x3a, 0xe7, 0x5e, 0x5d, 0xb7, 0x65, 0x43, 0x35, 0xa6, 0x0e, 0x3c, 0xb8, 0x80, 0x9c,
-	0x2a, 0x5c, 0x6b, 0xac, 0xd2, 0x13, 0x8a, 0x3e, 0x7d, 0x22, 0xf0, 0x90, 0x8d, 0x98, 0x01, 0x4d,
-	0xfb, 0xd9, 0x3e, 0x11, 0xaf, 0xd5, 0x1c, 0x3d, 0x83, 0x2d, 0x26, 0x30, 0x89, 0xbb, 0x4c, 0xc6,
-	0x24, 0xbe, 0x32, 0x27, 0xb1, 0xa2, 0x4f, 0x62, 0x93, 0x89, 0x6a, 0x62, 0x57, 0x78, 0x07, 0xff,
-	0x5a, 0x87, 0x62, 0x6b, 0x22, 0x17, 0xa9, 0xbd, 0x07, 0xd9, 0x98, 0x06, 0x94, 0x5d, 0xce, 0xc8,
-	0x3d, 0x9b, 0xa3, 0xcf, 0xc1, 0x4d, 0xc6, 0xe6, 0x98, 0x9b, 0x09, 0xbf, 0x37, 0x13, 0x7b, 0xc2,
-	0xf0, 0x25, 0x12, 0xaf, 0xfe, 0x7f, 0x24, 0x9e, 0xd3, 0x35, 0xfd, 0x3f, 0xd1, 0x55, 0xa9, 0x8b,
-	0x10, 0x38, 0xe2, 0x51, 0x40, 0xb5, 0x00, 0xa4, 0xfd, 0xac, 0x14, 0xe2, 0x54, 0xcd, 0x97, 0x6b,
-	0x97, 0xb9, 0x56, 0x3b, 0xeb, 0x1c, 0xc7, 0x2c, 0xa0, 0x96, 0xe4, 0xca, 0x79, 0xa6, 0xe6, 0xa8,
-	0x0c, 0xae, 0x75, 0xf2, 0x98, 0xc9, 0x2b, 0xdc, 0xa3, 0xd4, 0x7b, 0xa8, 0xd7, 0x14, 0xcd, 0x1a,
-	0x6d, 0x6e, 0x50, 0x8a, 0x10, 0xa4, 0xb5, 0x4c, 0x64, 0xb5, 0x57, 0x8f, 0xef, 0x43, 0xf2, 0xdb,
-	0x14, 0x04, 0x6e, 0x55, 0x90, 0x47, 0xa0, 0xd2, 0xc4, 0x13, 0x41, 0x43, 0x4d, 0xa9, 0xb4, 0xbf,
-	0xde, 0x27, 0xe2, 0x5c, 0xd0, 0x10, 0x9d, 0xc0, 0x36, 0xed, 0xf5, 0x68, 0x20, 0xd9, 0x25, 0xc5,
-	0xf3, 0x3f, 0xf7, 0x40, 0x57, 0xf4, 0xb1, 0xad, 0xe8, 0x83, 0x0f, 0x2b, 0xda, 0x54, 0x9d, 0x38,
-	0x8b, 0x7c, 0x99, 0x14, 0xa1, 0x72, 0x1d, 0xce, 0x14, 0x72, 0x57, 0x6f, 0xba, 0xb4, 0xde, 0x54,
-	0xf4, 0x31, 0x80, 0x3a, 0x8b, 0xf1, 0xa4, 0xfb, 0x86, 0x5e, 0x69, 0xe1, 0xd9, 0xf0, 0xd5, 0xe9,
-	0x9c, 0x69, 0xc3, 0x2d, 0x1a, 0x95, 0xff, 0xd8, 0x1a, 0x75, 0x02, 0x79, 0x45, 0x05, 0xcc, 0x0d,
-	0x89, 0x3c, 0x6f, 0xdf, 0x29, 0xe7, 0x8e, 0x9e, 0xdd, 0xa5, 0x14, 0x73, 0xda, 0xf9, 0xb9, 0x60,
-	0x81, 0x83, 0x37, 0xaa, 0xcf, 0xa3, 0x8f, 0xa5, 0x3e, 0x4d, 0xd8, 0x9a, 0x08, 0x1a, 0xeb, 0x0a,
-	0xf7, 0x28, 0xc5, 0x63, 0xc2, 0x42, 0x6f, 0xef, 0x5e, 0x2c, 0x28, 0xaa, 0xc0, 0x97, 0x44, 0x34,
-	0x28, 0x3d, 0x23, 0x2c, 0xb4, 0x1a, 0xf5, 0xc7, 0x55, 0xc8, 0xd8, 0x42, 0x54, 0x67, 0xea, 0xeb,
-	0xe8, 0x74, 0x3f, 0xbf, 0x2b, 0xdd, 0x40, 0x4e, 0xaf, 0x49, 0xef, 0x53, 0x28, 0x9a, 0xd1, 0x4c,
-	0x1d, 0x57, 0xf4, 0xc9, 0x16, 0x8c, 0xd5, 0xca, 0xe3, 0x87, 0x1a, 0x9a, 0xf9, 0x50, 0x43, 0xd1,
-	0x97, 0xb0, 0x33, 0x24, 0x42, 0x9e, 0x8f, 0x43, 0x22, 0x29, 0x96, 0x6c, 0x44, 0x85, 0x24, 0xa3,
-	0xb1, 0x16, 0x8d, 0x55, 0x7f, 0x7b, 0xee, 0xeb, 0x24, 0x2e, 0x54, 0x06, 0xa5, 0x64, 0x4a, 0x25,
-	0x7d, 0xda, 0x9b, 0x44, 0x21, 0x0d, 0xb5, 0x42, 0x18, 0x81, 0x5b, 0x34, 0xa3, 0x9f, 0xc1, 0x56,
-	0x10, 0x53, 0xa2, 0x84, 0x78, 0x8e, 0xbc, 0xa6, 0x91, 0x5d, 0xeb, 0x98, 0xc3, 0xfe, 0x1c, 0x76,
-	0x96, 0xd2, 0xc5, 0x31, 0xbd, 0xa4, 0xb1, 0xb4, 0x42, 0x80, 0x16, 0xb3, 0xf6, 0xb5, 0x47, 0xb3,
-	0x61, 0x29, 0x42, 0x4b, 0xb7, 0xe5, 0xfd, 0xd6, 0x62, 0x80, 0x4e, 0xeb, 0xe0, 0x07, 0x07, 0x0a,
-	0x26, 0x34, 0x69, 0xa3, 0xa7, 0x50, 0x34, 0xbb, 0x60, 0x12, 0x86, 0x31, 0x15, 0xc2, 0x8a, 0x6e,
-	0xc1, 0x58, 0xab, 0xc6, 0x88, 0x7e, 0x02, 0x45, 0xd3, 0xbc, 0x51, 0x92, 0x94, 0x51, 0x74, 0xdd,
-	0xd2, 0xad, 0xc8, 0xa6, 0xf3, 0x29, 0x14, 0x74, 0x02, 0x33, 0x2c, 0xf3, 0xb4, 0xca, 0x6b, 0x63,
-	0x02, 0x35, 0xdf, 0x31, 0x39, 0x15, 0x55, 0xbb, 0x7c, 0xb2, 0x63, 0x72, 0x2c, 0xaf, 0x94, 0xd6,
-	0xeb, 0x65, 0x73, 0x96, 0xaf, 0xdd, 0xaf, 0x01, 0x4d, 0x5c, 0x22, 0x01, 0x07, 0xff, 0x4e, 0x43,
-	0x7e, 0xfe, 0x00, 0xe8, 0x4c, 0x91, 0x07, 0xeb, 0xba, 0xf6, 0x3c, 0xb9, 0x61, 0x92, 0xa9, 0x7a,
-	0xb6, 0x19, 0x75, 0x34, 0xed, 0x64, 0x26, 0xa8, 0x05, 0x1b, 0xfa, 0x16, 0xed, 0x51, 0x2a, 0x6c,
-	0x0e, 0x47, 0xb7, 0xe7, 0xf0, 0xc3, 0xdb, 0x27, 0xee, 0x15, 0x19, 0x0d, 0x7f, 0x79, 0x30, 0x0b,
-	0x3c, 0xf0, 0xb3, 0x6a, 0xdc, 0xa0, 0x54, 0xa0, 0x9f, 0xc2, 0x66, 0x4c, 0x87, 0xe4, 0x8a, 0x86,
-	0xd7, 0x3a, 0xb3, 0x68, 0xcd, 0x49, 0x11, 0x1a, 0x90, 0x0b, 0x02, 0x39, 0x4d, 0x34, 0x29, 0xab,
-	0x25, 0xe3, 0xe9, 0x1d, 0x7c, 0xb1, 0x5c, 0x81, 0x60, 0xc6, 0x1b, 0xd4, 0x86, 0x22, 0x33, 0x6f,
-	0x18, 0x3c, 0xd6, 0xd7, 0xac, 0x96, 0xff, 0xdc, 0x7d, 0x1f, 0x3e, 0xe6, 0x6a, 0xf6, 0x0b, 0x6c,
-	0xe9, 0x11, 0x7e, 0x01, 0x9b, 0xdc, 0xde, 0xdd, 0x09, 0x2a, 0xec, 0xaf, 0x96, 0x73, 0x47, 0xcf,
-	0xef, 0x40, 0x5d, 0xbe, 0xf1, 0xfd, 0x22, 0x5f, 0x7e, 0x01, 0xc4, 0xf0, 0x48, 0xbf, 0xfb, 0x03,
-	0x3e, 0xc4, 0x01, 0x8f, 0x64, 0x4c, 0x02, 0x89, 0x2f, 0x69, 0x2c, 0x18, 0x8f, 0xec, 0xd3, 0xf1,
-	0xeb, 0x3b, 0x76, 0x38, 0xb3, 0xf1, 0x35, 0x1b, 0x7e, 0x61, 0xa2, 0xfd, 0x87, 0xe3, 0x9b, 0x1d,
-	0xe8, 0xb7, 0xb3, 0xa6, 0x4c, 0xe4, 0x39, 0x7f, 0xaf, 0x02, 0x2d, 0x91, 0xe9, 0x45, 0x5a, 0x75,
-	0x45, 0xd2, 0xc8, 0xd6, 0xf8, 0xec, 0x0f, 0x00, 0x73, 0x05, 0x43, 0x08, 0x8a, 0x67, 0x34, 0x0a,
-	0x59, 0xd4, 0xb7, 0xb5, 0x75, 0x53, 0x68, 0x1b, 0x36, 0xad, 0x2d, 0xa9, 0x8c, 0xeb, 0xa0, 0x2d,
-	0x28, 0x24, 0xb3, 0x13, 0x16, 0xd1, 0xd0, 0x5d, 0x55, 0x26, 0xbb, 0xce, 0x6c, 0xeb, 0xa6, 0x51,
-	0x1e, 0xb2, 0x66, 0x4c, 0x43, 0x77, 0x0d, 0xe5, 0x60, 0xbd, 0x6a, 0xde, 0x6e, 0x6e, 0x66, 0x2f,
-	0xfd, 0xb7, 0xbf, 0x96, 0x9c, 0x67, 0xbf, 0x86, 0x9d, 0x9b, 0xee, 0x28, 0xe4, 0x42, 0xfe, 0x94,
-	0xcb, 0x46, 0xf2, 0x6c, 0x77, 0x53, 0xa8, 0x00, 0x1b, 0xf3, 0xa9, 0xa3, 0x90, 0xeb, 0x53, 0x1a,
-	0x4c, 0x14, 0xd8, 0x8a, 0x05, 0xfb, 0x02, 0xdc, 0xeb, 0x77, 0x07, 0xca, 0x42, 0xba, 0x5d, 0x6d,
-	0xd4, 0xdd, 0x94, 0x1a, 0x35, 0xaa, 0xed, 0x8e, 0xeb, 0xd8, 0xd5, 0x7f, 0x76, 0x66, 0x9f, 0x6d,
-	0x76, 0xd3, 0x1c, 0xac, 0xb7, 0xcf, 0x6b, 0xb5, 0x7a, 0xbb, 0xed, 0xa6, 0x50, 0x09, 0xf6, 0x9a,
-	0xa7, 0xed, 0xf3, 0x46, 0xa3, 0x59, 0x6b, 0xd6, 0x4f, 0x3b, 0xf8, 0xb8, 0x7e, 0xd6, 0x6a, 0x37,
-	0x3b, 0x2d, 0x1f, 0x37, 0xea, 0x75, 0xd7, 0x41, 0x3f, 0x02, 0xaf, 0x79, 0x7a, 0x51, 0x7d, 0xdd,
-	0x3c, 0xc6, 0x7e, 0xbd, 0x56, 0x6f, 0x5e, 0xd4, 0x7d, 0x5c, 0x3d, 0x3e, 0xf6, 0x55, 0xf4, 0x8a,
-	0xca, 0x3f, 0xf1, 0x9e, 0xd4, 0x4f, 0x5a, 0xee, 0x2a, 0x7a, 0x02, 0x9f, 0xd4, 0x7f, 0xa3, 0xb0,
-	0x9b, 0x17, 0x75, 0x7c, 0xda, 0xaa, 0xb6, 0xdb, 0xf5, 0x4e, 0xad, 0xfa, 0xfa, 0x35, 0x6e, 0x9c,
-	0x9f, 0x1e, 0xb7, 0xdd, 0xb4, 0xcd, 0xea, 0x10, 0x1e, 0xfe, 0x97, 0xee, 0x40, 0x19, 0x58, 0xb9,
-	0xf8, 0xd2, 0x4d, 0xe9, 0xdf, 0xa3, 0xe4, 0x6f, 0xbc, 0x78, 0xf9, 0xdd, 0xbb, 0x92, 0xf3, 0xfd,
-	0xbb, 0x92, 0xf3, 0xcf, 0x77, 0x25, 0xe7, 0x4f, 0xef, 0x4b, 0xa9, 0xef, 0xdf, 0x97, 0x52, 0x7f,
-	0x7f, 0x5f, 0x4a, 0x7d, 0xf3, 0xbc, 0xcf, 0xe4, 0x60, 0xd2, 0xad, 0x04, 0x7c, 0xa4, 0x3f, 0x60,
-	0x9f, 0x9b, 0x6f, 0xd9, 0x88, 0x87, 0xf4, 0x70, 0xba, 0xf8, 0xc9, 0xac, 0x1e, 0xa1, 0xa2, 0x9b,
-	0xd1, 0xcd, 0xf7, 0xd5, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x40, 0x7f, 0x36, 0x81, 0x60, 0x0f,
-	0x00, 0x00,
+	// 1684 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdd, 0x6e, 0x1b, 0xc7,
+	0x15, 0xd6, 0x4a, 0x14, 0x45, 0x1e, 0xfe, 0x68, 0x35, 0x92, 0xe5, 0xb5, 0x5c, 0xd3, 0x8a, 0x52,
+	0xa7, 0x8c, 0x1b, 0x53, 0x8d, 0x02, 0x04, 0x45, 0xef, 0x68, 0x8a, 0xb4, 0xd9, 0x5a, 0xa2, 0xb0,
+	0x94, 0x84, 0x36, 0x28, 0x30, 0x1d, 0xed, 0x8e, 0xa8, 0x81, 0xc9, 0x1d, 0x62, 0x67, 0x28, 0x50,
+	0x41, 0xef, 0x0a, 0xf4, 0xba, 0x40, 0x1f, 0xa0, 0x37, 0xbd, 0xe8, 0x2b, 0xf4, 0x0d, 0x72, 0x99,
+	0xcb, 0xa2, 0x17, 0x46, 0x61, 0xbf, 0x41, 0x9e, 0xa0, 0x98, 0x3f, 0xfe, 0x28, 0xaa, 0xa4, 0x06,
+	0xb9, 0xda, 0x99, 0xef, 0xcc, 0x39, 0x73, 0xf6, 0xfc, 0x7c, 0x33, 0x03, 0x7b, 0x5f, 0x53, 0x49,
+	0xa2, 0x0b, 0xc2, 0x92, 0x5d, 0x3d, 0xe2, 0x29, 0xdd, 0x8d, 0x52, 0x2e, 0x84, 0xc1, 0xf4, 0x10,
+	0xeb, 0x31, 0x96, 0xe3, 0xda, 0x30, 0xe5, 0x92, 0xa3, 0x27, 0x13, 0x9d, 0x9a, 0xd3, 0xa9, 0x4d,
+	0x75, 0xb6, 0x36, 0x7a, 0xbc, 0xc7, 0xf5, 0xca, 0x5d, 0x35, 0x32, 0x4a, 0x5b, 0x9f, 0xdc, 0xb0,
+	0xd1, 0xf0, 0x6d, 0x6f, 0x37, 0xe2, 0x6a, 0x1b, 0xce, 0x12, 0xb3, 0x6e, 0xe7, 0x9f, 0x59, 0x28,
+	0xb5, 0x93, 0x33, 0x3e, 0x4a, 0xe2, 0x23, 0x92, 0x92, 0x81, 0x40, 0x9b, 0x90, 0x15, 0x34, 0x89,
+	0x69, 0x1a, 0x78, 0xdb, 0x5e, 0x35, 0x1f, 0xda, 0x19, 0xfa, 0x04, 0x56, 0xcd, 0xc8, 0xfa, 0xc7,
+	0xe2, 0x60, 0x71, 0xdb, 0xab, 0x2e, 0x85, 0x25, 0x03, 0x37, 0x14, 0xda, 0x8e, 0xd1, 0x63, 0xc8,
+	0xcb, 0x31, 0xe6, 0x29, 0xeb, 0xb1, 0x24, 0x58, 0xd2, 0x26, 0x72, 0x72, 0xdc, 0xd1, 0x73, 0xf4,
+	0x12, 0xf2, 0x6a, 0x73, 0x2c, 0xaf, 0x86, 0x34, 0xc8, 0x6c, 0x7b, 0xd5, 0xf2, 0xde, 0xb3, 0xda,
+	0x0d, 0xff, 0x37, 0x7c, 0xdb, 0xab, 0x69, 0x2f, 0x1b, 0x9c, 0x25, 0xc7, 0x57, 0x43, 0x1a, 0xe6,
+	0x22, 0x3b, 0x42, 0x1b, 0xb0, 0x4c, 0x84, 0xa0, 0x32, 0x58, 0xd6, 0xc6, 0xcd, 0x04, 0x7d, 0x09,
+	0x59, 0x32, 0xe0, 0xa3, 0x44, 0x06, 0x59, 0x05, 0xbf, 0xac, 0x7c, 0xf3, 0xee, 0xe9, 0xc2, 0xbf,
+	0xdf, 0x3d, 0xdd, 0x8c, 0xb8, 0x18, 0x70, 0x21, 0xe2, 0xb7, 0x35, 0xc6, 0x77, 0x07, 0x44, 0x5e,
+	0xd4, 0x4e, 0x58, 0x22, 0x43, 0xbb, 0x1a, 0x7d, 0x0c, 0x25, 0x7e, 0x26, 0x68, 0x7a, 0x49, 0x63,
+	0x7c, 0x41, 0xc4, 0x45, 0xb0, 0xa2, 0xad, 0x16, 0x1d, 0xf8, 0x9a, 0x88, 0x0b, 0xf4, 0x4b, 0x08,
+	0x26, 0x8b, 0xe8, 0x58, 0xd2, 0x34, 0x21, 0x7d, 0x7c, 0x41, 0x59, 0xef, 0x42, 0x06, 0xb9, 0x6d,
+	0xaf, 0x9a, 0x09, 0x37, 0x9d, 0xbc, 0x69, 0xc5, 0xaf, 0xb5, 0x14, 0x7d, 0x04, 0xc5, 0x33, 0xd2,
+	0xef, 0x73, 0x89, 0x59, 0x12, 0xd3, 0x71, 0x90, 0xd7, 0xd6, 0x0b, 0x06, 0x6b, 0x2b, 0x08, 0xed,
+	0xc1, 0x83, 0x73, 0x96, 0x90, 0x3e, 0xfb, 0x9a, 0xc6, 0x58, 0x45, 0xc0, 0x59, 0x06, 0x6d, 0x79,
+	0x7d, 0x22, 0xfc, 0x8a, 0x4a, 0x62, 0xcd, 0x32, 0xd8, 0x94, 0x63, 0x6c, 0x25, 0x44, 0x32, 0x9e,
+	0x60, 0x21, 0x89, 0x1c, 0x89, 0xa0, 0xa0, 0x83, 0xfa, 0x45, 0xed, 0xd6, 0xa2, 0xa9, 0x1d, 0x8f,
+	0x5b, 0x33, 0xba, 0x5d, 0xad, 0x1a, 0x6e, 0xc8, 0x1b, 0x50, 0xf4, 0x02, 0xd6, 0x99, 0xc0, 0xb3,
+	0x95, 0x19, 0x91, 0x7e, 0x3f, 0x28, 0x6e, 0x7b, 0xd5, 0x5c, 0xe8, 0x33, 0xd1, 0x50, 0x12, 0x9d,
+	0xfc, 0x06, 0xe9, 0xf7, 0xd1, 0x3e, 0x64, 0xad, 0x27, 0x1b, 0xda, 0x93, 0xcf, 0xee, 0xf0, 0xc4,
+	0x16, 0x9f, 0x75, 0xc1, 0xea, 0xa2, 0xdf, 0xc3, 0x5a, 0xc4, 0x93, 0x73, 0x96, 0x0e, 0xcc, 0xcf,
+	0x0d, 0x78, 0x4c, 0x83, 0x07, 0xda, 0xe0, 0xee, 0x1d, 0x06, 0x1b, 0x33, 0x7a, 0x07, 0x3c, 0xa6,
+	0xa1, 0x1f, 0x5d, 0x43, 0x54, 0xce, 0x69, 0x9a, 0xf2, 0x14, 0x0f, 0xa8, 0x10, 0xa4, 0x47, 0x83,
+	0x4d, 0x93, 0x73, 0x0d, 0x1e, 0x18, 0x0c, 0x6d, 0x41, 0x2e, 0xa6, 0x24, 0xee, 0xb3, 0x84, 0x06,
+	0x0f, 0x75, 0x26, 0x26, 0xf3, 0x5f, 0x67, 0x72, 0x25, 0x7f, 0x63, 0xe7, 0x0f, 0x50, 0x56, 0x29,
+	0xa9, 0x47, 0x91, 0xaa, 0x24, 0x96, 0xf4, 0xd0, 0x21, 0xac, 0x93, 0x33, 0x9e, 0x4a, 0x97, 0x48,
+	0x5b, 0x91, 0xde, 0xbd, 0x2a, 0x72, 0xcd, 0xaa, 0x6a, 0x9b, 0x5a, 0x71, 0xe7, 0x14, 0x0a, 0x2a,
+	0xa8, 0x9d, 0xa1, 0x72, 0x5d, 0xa8, 0xd6, 0xea, 0x11, 0x81, 0xfb, 0x6c, 0xc0, 0x8c, 0xd1, 0x4c,
+	0x98, 0xeb, 0x11, 0xf1, 0x46, 0xcd, 0xd1, 0x73, 0x58, 0x63, 0x02, 0x93, 0xf4, 0x8c, 0xc9, 0x94,
+	0xa4, 0x57, 0x26, 0x4b, 0x8b, 0x3a, 0x4b, 0xab, 0x4c, 0xd4, 0x1d, 0xae, 0xec, 0xed, 0xfc, 0x39,
+	0x07, 0xe5, 0xce, 0x48, 0xce, 0xb6, 0xfd, 0x16, 0xe4, 0x52, 0x1a, 0x51, 0x76, 0x39, 0x69, 0xfc,
+	0xc9, 0x1c, 0x7d, 0x0a, 0xbe, 0x1b, 0x9b, 0x12, 0x68, 0xbb, 0xde, 0x5f, 0x75, 0xb8, 0xeb, 0xfe,
+	0xb9, 0x06, 0x5f, 0xfa, 0x61, 0x0d, 0x3e, 0x6d, 0xe5, 0xcc, 0xff, 0xd5, 0xca, 0x8a, 0x79, 0x84,
+	0xc0, 0x09, 0x4f, 0x22, 0xaa, 0xc9, 0x21, 0x13, 0xe6, 0xa4, 0x10, 0x87, 0x6a, 0x3e, 0x1f, 0xbb,
+	0xec, 0xb5, 0xd8, 0x59, 0xe1, 0x30, 0x65, 0x11, 0xb5, 0x04, 0xa0, 0x84, 0x47, 0x6a, 0x8e, 0xaa,
+	0xe0, 0x5b, 0x21, 0x4f, 0x99, 0xbc, 0xc2, 0xe7, 0xd4, 0x14, 0x44, 0x3e, 0x2c, 0x9b, 0x35, 0x1a,
+	0x6e, 0x51, 0x8a, 0x10, 0x64, 0x34, 0x85, 0xe4, 0xb4, 0x54, 0x8f, 0xef, 0x43, 0x00, 0xb7, 0xb1,
+	0x0b, 0xdc, 0xca, 0x2e, 0x8f, 0x40, 0xb9, 0x89, 0x47, 0x82, 0xc6, 0xba, 0xdd, 0x32, 0xe1, 0x4a,
+	0x8f, 0x88, 0x13, 0x41, 0x63, 0x74, 0x00, 0xeb, 0xf4, 0xfc, 0x9c, 0x46, 0x92, 0x5d, 0x52, 0x3c,
+	0xfd, 0xb9, 0x07, 0x3a, 0xa2, 0x4f, 0x6c, 0x44, 0x1f, 0x7c, 0x3f, 0xa2, 0x6d, 0x55, 0x89, 0x13,
+	0xcd, 0x57, 0x2e, 0x08, 0xb5, 0xeb, 0xe6, 0x4c, 0x20, 0x37, 0xf5, 0xa6, 0x73, 0xeb, 0x4d, 0x44,
+	0x9f, 0x00, 0xa8, 0x5c, 0x0c, 0x47, 0x67, 0x6f, 0xe9, 0x95, 0x26, 0xa5, 0x7c, 0xa8, 0xb2, 0x73,
+	0xa4, 0x81, 0x5b, 0xf8, 0xab, 0xf8, 0x63, 0xf3, 0xd7, 0x01, 0x14, 0x55, 0x2b, 0x60, 0x6e, 0x9a,
+	0x28, 0x08, 0xb6, 0xbd, 0x6a, 0x61, 0xef, 0xf9, 0x5d, 0x2c, 0x32, 0x6d, 0xbb, 0xb0, 0x10, 0xcd,
+	0xf4, 0xe0, 0x8d, 0xcc, 0xf4, 0xe8, 0xc7, 0x62, 0xa6, 0x36, 0xac, 0x8d, 0x04, 0x4d, 0x75, 0x84,
+	0xcf, 0x29, 0xc5, 0x43, 0xc2, 0xe2, 0x60, 0xeb, 0x5e, 0x5d, 0x50, 0x56, 0x8a, 0xaf, 0x88, 0x68,
+	0x51, 0x7a, 0x44, 0x58, 0xac, 0x32, 0xc0, 0x04, 0xa6, 0xe3, 0x21, 0x4b, 0x69, 0x1c, 0x3c, 0xd6,
+	0x44, 0x90, 0x67, 0xa2, 0x69, 0x00, 0x4b, 0x61, 0x7f, 0x5a, 0x82, 0xac, 0x8d, 0x53, 0x7d, 0x42,
+	0xdc, 0x9e, 0xfe, 0x9b, 0x4f, 0xef, 0xfa, 0x9b, 0x48, 0x8e, 0xaf, 0xb1, 0xf6, 0x33, 0x28, 0x9b,
+	0xd1, 0x84, 0x58, 0x17, 0x75, 0xe2, 0x4b, 0x06, 0x75, 0xcc, 0xfa, 0x3d, 0xfa, 0xcd, 0xde, 0x40,
+	0xbf, 0x9f, 0xc3, 0x46, 0x9f, 0x08, 0x79, 0x32, 0x8c, 0x89, 0xa4, 0x58, 0xb2, 0x01, 0x15, 0x92,
+	0x0c, 0x86, 0x9a, 0x53, 0x96, 0xc2, 0xf5, 0xa9, 0xec, 0xd8, 0x89, 0x50, 0x15, 0x14, 0xd1, 0x29,
+	0x12, 0x0d, 0xe9, 0xf9, 0x28, 0x89, 0x69, 0xac, 0x09, 0xc4, 0xf0, 0xdf, 0x2c, 0x8c, 0x7e, 0x0e,
+	0x6b, 0x51, 0x4a, 0x89, 0xe2, 0xe9, 0xa9, 0xe5, 0x65, 0x6d, 0xd9, 0xb7, 0x82, 0xa9, 0xd9, 0x5f,
+	0xc0, 0xc6, 0x9c, 0xbb, 0x38, 0xa5, 0x97, 0x34, 0x95, 0x96, 0x27, 0xd0, 0xac, 0xd7, 0xa1, 0x96,
+	0xe8, 0x66, 0x99, 0xd3, 0xd0, 0xcc, 0x6e, 0x69, 0x61, 0x6d, 0x56, 0x41, 0xbb, 0xb5, 0xf3, 0x9d,
+	0x07, 0x25, 0xa3, 0xea, 0xaa, 0xec, 0x19, 0x94, 0xcd, 0x2e, 0x98, 0xc4, 0x71, 0x4a, 0x85, 0xb0,
+	0x9c, 0x5c, 0x32, 0x68, 0xdd, 0x80, 0xe8, 0xa7, 0x50, 0x36, 0xb5, 0x9d, 0x38, 0xa7, 0x0c, 0xe1,
+	0xeb, 0x8a, 0xef, 0x24, 0xd6, 0x9d, 0x8f, 0xa1, 0xa4, 0x1d, 0x98, 0xd8, 0x32, 0xb7, 0xb2, 0xa2,
+	0x06, 0x9d, 0xa9, 0xe9, 0x8e, 0x2e, 0x2b, 0x2a, 0x76, 0x45, 0xb7, 0xa3, 0x4b, 0xcb, 0x6b, 0x75,
+	0x14, 0xe8, 0x65, 0x53, 0x12, 0x58, 0xbe, 0x5f, 0x7d, 0x1a, 0x3d, 0xc7, 0x10, 0x3b, 0x7f, 0x5b,
+	0x86, 0xe2, 0xf4, 0xee, 0x70, 0x3c, 0x46, 0x01, 0xac, 0xe8, 0xd8, 0x73, 0x77, 0x00, 0xb9, 0xa9,
+	0xba, 0xf1, 0x19, 0xf2, 0x34, 0xe5, 0x64, 0x26, 0xa8, 0x03, 0x79, 0x7d, 0xc8, 0x9e, 0x53, 0x2a,
+	0xac, 0x0f, 0x7b, 0xb7, 0xfb, 0xf0, 0xdd, 0xbb, 0xa7, 0xfe, 0x15, 0x19, 0xf4, 0x7f, 0xb5, 0x33,
+	0x51, 0xdc, 0x09, 0x73, 0x6a, 0xdc, 0xa2, 0x54, 0xa0, 0x9f, 0xc1, 0x6a, 0x4a, 0xfb, 0xe4, 0x8a,
+	0xc6, 0xd7, 0x2a, 0xb3, 0x6c, 0x61, 0x17, 0x84, 0x16, 0x14, 0xa2, 0x48, 0x8e, 0x1d, 0x65, 0xe5,
+	0x34, 0xa3, 0x3c, 0xbb, 0xa3, 0x5f, 0x6c, 0xaf, 0x40, 0x34, 0xe9, 0x1b, 0xd4, 0x85, 0x32, 0x33,
+	0xd7, 0x1f, 0x3c, 0xd4, 0xa7, 0xb0, 0x3e, 0x1d, 0x0a, 0xf7, 0xbd, 0x33, 0x99, 0x93, 0x3b, 0x2c,
+	0xb1, 0xb9, 0xfb, 0xfb, 0x29, 0xac, 0x72, 0x7b, 0xb4, 0x3b, 0xab, 0xb0, 0xbd, 0x54, 0x2d, 0xec,
+	0xbd, 0xb8, 0xc3, 0xea, 0xfc, 0x85, 0x20, 0x2c, 0xf3, 0xf9, 0x0b, 0x42, 0x0a, 0x8f, 0xf4, 0x93,
+	0x21, 0xe2, 0x7d, 0x1c, 0xf1, 0x44, 0xa6, 0x24, 0x92, 0xf8, 0x92, 0xa6, 0x82, 0xf1, 0xc4, 0xde,
+	0x3a, 0xbf, 0xbc, 0x63, 0x87, 0x23, 0xab, 0xdf, 0xb0, 0xea, 0xa7, 0x46, 0x3b, 0x7c, 0x38, 0xbc,
+	0x59, 0x80, 0x7e, 0x37, 0x29, 0x4a, 0xc7, 0xde, 0xc5, 0x7b, 0x05, 0x68, 0xae, 0x99, 0x5e, 0x66,
+	0x54, 0x55, 0xb8, 0x42, 0x76, 0x1d, 0xf6, 0x11, 0x14, 0x87, 0x24, 0xa5, 0x89, 0x3b, 0x97, 0x4b,
+	0xe6, 0x5c, 0x36, 0x98, 0x3e, 0x97, 0x9f, 0xff, 0x11, 0x60, 0x4a, 0x72, 0x08, 0x41, 0xf9, 0x88,
+	0x26, 0x31, 0x4b, 0x7a, 0x36, 0xfc, 0xfe, 0x02, 0x5a, 0x87, 0x55, 0x8b, 0xb9, 0xe0, 0xf9, 0x1e,
+	0x5a, 0x83, 0x92, 0x9b, 0x1d, 0xb0, 0x84, 0xc6, 0xfe, 0x92, 0x82, 0xec, 0x3a, 0xe3, 0x99, 0x9f,
+	0x41, 0x45, 0xc8, 0x99, 0x31, 0x8d, 0xfd, 0x65, 0x54, 0x80, 0x95, 0xba, 0xb9, 0xfd, 0xf9, 0xd9,
+	0xad, 0xcc, 0x3f, 0xfe, 0x5e, 0xf1, 0x9e, 0xff, 0x06, 0x36, 0x6e, 0x3a, 0xe5, 0x90, 0x0f, 0xc5,
+	0x43, 0x2e, 0x5b, 0xee, 0x51, 0xe0, 0x2f, 0xa0, 0x12, 0xe4, 0xa7, 0x53, 0x4f, 0x59, 0x6e, 0x8e,
+	0x69, 0x34, 0x52, 0xc6, 0x16, 0xad, 0xb1, 0xcf, 0xc0, 0xbf, 0x7e, 0xfa, 0xa0, 0x1c, 0x64, 0xba,
+	0xf5, 0x56, 0xd3, 0x5f, 0x50, 0xa3, 0x56, 0xbd, 0x7b, 0xec, 0x7b, 0x76, 0xf5, 0x5f, 0xbd, 0xc9,
+	0xa3, 0xd0, 0x6e, 0x5a, 0x80, 0x95, 0xee, 0x49, 0xa3, 0xd1, 0xec, 0x76, 0xfd, 0x05, 0x54, 0x81,
+	0xad, 0xf6, 0x61, 0xf7, 0xa4, 0xd5, 0x6a, 0x37, 0xda, 0xcd, 0xc3, 0x63, 0xbc, 0xdf, 0x3c, 0xea,
+	0x74, 0xdb, 0xc7, 0x9d, 0x10, 0xb7, 0x9a, 0x4d, 0xdf, 0x43, 0x3f, 0x81, 0xa0, 0x7d, 0x78, 0x5a,
+	0x7f, 0xd3, 0xde, 0xc7, 0x61, 0xb3, 0xd1, 0x6c, 0x9f, 0x36, 0x43, 0x5c, 0xdf, 0xdf, 0x0f, 0x95,
+	0xf6, 0xa2, 0xf2, 0xdf, 0x49, 0x0f, 0x9a, 0x07, 0x1d, 0x7f, 0x09, 0x3d, 0x85, 0xc7, 0xcd, 0xdf,
+	0x2a, 0xdb, 0xed, 0xd3, 0x26, 0x3e, 0xec, 0xd4, 0xbb, 0xdd, 0xe6, 0x71, 0xa3, 0xfe, 0xe6, 0x0d,
+	0x6e, 0x9d, 0x1c, 0xee, 0x77, 0xfd, 0x8c, 0xf5, 0x6a, 0x17, 0x1e, 0xfe, 0x8f, 0x02, 0x42, 0x59,
+	0x58, 0x3c, 0xfd, 0xdc, 0x5f, 0xd0, 0xdf, 0x3d, 0xf7, 0x1b, 0x2f, 0x5f, 0x7d, 0xf3, 0xbe, 0xe2,
+	0x7d, 0xfb, 0xbe, 0xe2, 0xfd, 0xe7, 0x7d, 0xc5, 0xfb, 0xcb, 0x87, 0xca, 0xc2, 0xb7, 0x1f, 0x2a,
+	0x0b, 0xff, 0xfa, 0x50, 0x59, 0xf8, 0xea, 0x45, 0x8f, 0xc9, 0x8b, 0xd1, 0x59, 0x2d, 0xe2, 0x03,
+	0xfd, 0x3c, 0x7e, 0x61, 0x5e, 0xca, 0x09, 0x8f, 0xe9, 0xee, 0x78, 0xf6, 0x41, 0xae, 0xae, 0xb1,
+	0xe2, 0x2c, 0xab, 0xeb, 0xf3, 0x8b, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xbe, 0x23, 0x2b, 0x52,
+	0xbe, 0x0f, 0x00, 0x00,
 }
 
 func (m *InboundParams) Marshal() (dAtA []byte, err error) {
@@ -1026,6 +1061,13 @@ func (m *InboundParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Deadline != 0 {
+		i = encodeVarintCrossChainTx(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
 	if len(m.ErrorMessage) > 0 {
 		i -= len(m.ErrorMessage)
 		copy(dAtA[i:], m.ErrorMessage)
@@ -1223,6 +1265,18 @@ func (m *OutboundParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IsExpired {
+		i--
+		if m.IsExpired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
 	{
 		size := m.UserGasFeePaid.Size()
 		i -= size
@@ -1529,6 +1583,13 @@ func (m *CrossChainTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ParentIndex) > 0 {
+		i -= len(m.ParentIndex)
+		copy(dAtA[i:], m.ParentIndex)
+		i = encodeVarintCrossChainTx(dAtA, i, uint64(len(m.ParentIndex)))
+		i--
+		dAtA[i] = 0x6a
+	}
 	{
 		size, err := m.RevertOptions.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1683,6 +1744,9 @@ func (m *InboundParams) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovCrossChainTx(uint64(l))
 	}
+	if m.Deadline != 0 {
+		n += 2 + sovCrossChainTx(uint64(m.Deadline))
+	}
 	return n
 }
 
@@ -1779,6 +1843,9 @@ func (m *OutboundParams) Size() (n int) {
 	}
 	l = m.UserGasFeePaid.Size()
 	n += 2 + l + sovCrossChainTx(uint64(l))
+	if m.IsExpired {
+		n += 3
+	}
 	return n
 }
 
@@ -1884,6 +1951,10 @@ func (m *CrossChainTx) Size() (n int) {
 	}
 	l = m.RevertOptions.Size()
 	n += 1 + l + sovCrossChainTx(uint64(l))
+	l = len(m.ParentIndex)
+	if l > 0 {
+		n += 1 + l + sovCrossChainTx(uint64(l))
+	}
 	return n
 }
 
@@ -2301,6 +2372,25 @@ func (m *InboundParams) Unmarshal(dAtA []byte) error {
 			}
 			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCrossChainTx(dAtA[iNdEx:])
@@ -3025,6 +3115,26 @@ func (m *OutboundParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsExpired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsExpired = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCrossChainTx(dAtA[iNdEx:])
@@ -3820,6 +3930,38 @@ func (m *CrossChainTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrossChainTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrossChainTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCrossChainTx(dAtA[iNdEx:])
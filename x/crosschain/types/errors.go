@@ -67,4 +67,5 @@ var (
 		1164,
 		"ZETA deposits and withdraws through gateway are currently disabled",
 	)
+	ErrInboundExpired = errorsmod.Register(ModuleName, 1165, "inbound deadline exceeded")
 )
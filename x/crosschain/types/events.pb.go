@@ -799,6 +799,82 @@ func (m *EventInboundProcessingFailure) GetErrorMessage() string {
 	return ""
 }
 
+type EventInboundExpired struct {
+	CctxIndex   string `protobuf:"bytes,1,opt,name=cctx_index,json=cctxIndex,proto3" json:"cctx_index,omitempty"`
+	InboundHash string `protobuf:"bytes,2,opt,name=inbound_hash,json=inboundHash,proto3" json:"inbound_hash,omitempty"`
+	Deadline    uint64 `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	BlockTime   int64  `protobuf:"varint,4,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+	NewStatus   string `protobuf:"bytes,5,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
+}
+
+func (m *EventInboundExpired) Reset()         { *m = EventInboundExpired{} }
+func (m *EventInboundExpired) String() string { return proto.CompactTextString(m) }
+func (*EventInboundExpired) ProtoMessage()    {}
+func (*EventInboundExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd08b628129fa2e1, []int{10}
+}
+func (m *EventInboundExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventInboundExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventInboundExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventInboundExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventInboundExpired.Merge(m, src)
+}
+func (m *EventInboundExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventInboundExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventInboundExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventInboundExpired proto.InternalMessageInfo
+
+func (m *EventInboundExpired) GetCctxIndex() string {
+	if m != nil {
+		return m.CctxIndex
+	}
+	return ""
+}
+
+func (m *EventInboundExpired) GetInboundHash() string {
+	if m != nil {
+		return m.InboundHash
+	}
+	return ""
+}
+
+func (m *EventInboundExpired) GetDeadline() uint64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+func (m *EventInboundExpired) GetBlockTime() int64 {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
+func (m *EventInboundExpired) GetNewStatus() string {
+	if m != nil {
+		return m.NewStatus
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventInboundFinalized)(nil), "zetachain.zetacore.crosschain.EventInboundFinalized")
 	proto.RegisterType((*EventZrcWithdrawCreated)(nil), "zetachain.zetacore.crosschain.EventZrcWithdrawCreated")
@@ -810,6 +886,7 @@ func init() {
 	proto.RegisterType((*EventERC20CustodyFundsMigration)(nil), "zetachain.zetacore.crosschain.EventERC20CustodyFundsMigration")
 	proto.RegisterType((*EventERC20CustodyPausing)(nil), "zetachain.zetacore.crosschain.EventERC20CustodyPausing")
 	proto.RegisterType((*EventInboundProcessingFailure)(nil), "zetachain.zetacore.crosschain.EventInboundProcessingFailure")
+	proto.RegisterType((*EventInboundExpired)(nil), "zetachain.zetacore.crosschain.EventInboundExpired")
 }
 
 func init() {
@@ -817,59 +894,63 @@ func init() {
 }
 
 var fileDescriptor_dd08b628129fa2e1 = []byte{
-	// 831 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcd, 0x8e, 0x1b, 0x45,
-	0x10, 0xde, 0x89, 0xbd, 0x6b, 0xbb, 0x63, 0x1b, 0x98, 0x18, 0x18, 0x56, 0xb2, 0x49, 0x8c, 0x10,
-	0x08, 0x11, 0x7b, 0x15, 0x9e, 0x20, 0xb1, 0xb2, 0x89, 0x0f, 0x51, 0x56, 0x4e, 0x50, 0x50, 0x2e,
-	0xad, 0xf6, 0x74, 0x31, 0xd3, 0x30, 0xee, 0xb6, 0xba, 0x7b, 0xd6, 0xf6, 0x3e, 0x05, 0xe2, 0x3d,
-	0xb8, 0x20, 0x71, 0xe3, 0x01, 0x38, 0xe6, 0xc8, 0x11, 0xad, 0x5f, 0x04, 0xf5, 0xcf, 0x4c, 0xec,
-	0xf1, 0x0a, 0x0e, 0x08, 0x24, 0x6e, 0x53, 0x5f, 0x55, 0x57, 0x7d, 0xfd, 0xd5, 0x54, 0x77, 0xa3,
-	0x2f, 0xae, 0x40, 0x93, 0x38, 0x25, 0x8c, 0x8f, 0xed, 0x97, 0x90, 0x30, 0x8e, 0xa5, 0x50, 0xca,
-	0x61, 0x70, 0x09, 0x5c, 0xab, 0xd1, 0x52, 0x0a, 0x2d, 0xc2, 0x7e, 0x19, 0x3b, 0x2a, 0x62, 0x47,
-	0x6f, 0x63, 0x4f, 0x7b, 0x89, 0x48, 0x84, 0x8d, 0x1c, 0x9b, 0x2f, 0xb7, 0x68, 0xb8, 0xad, 0xa1,
-	0xf7, 0x1f, 0x9b, 0x2c, 0x53, 0x3e, 0x17, 0x39, 0xa7, 0xe7, 0x8c, 0x93, 0x8c, 0x5d, 0x01, 0x0d,
-	0xef, 0xa2, 0xf6, 0x42, 0x25, 0x58, 0x6f, 0x96, 0x80, 0x73, 0x99, 0x45, 0xc1, 0xdd, 0xe0, 0xf3,
-	0xd6, 0x0c, 0x2d, 0x54, 0xf2, 0x72, 0xb3, 0x84, 0xaf, 0x65, 0x16, 0xf6, 0x11, 0x8a, 0x63, 0xbd,
-	0xc6, 0x8c, 0x53, 0x58, 0x47, 0xb7, 0xac, 0xbf, 0x65, 0x90, 0xa9, 0x01, 0xc2, 0x0f, 0xd0, 0x89,
-	0x02, 0x4e, 0x41, 0x46, 0x35, 0xeb, 0xf2, 0x56, 0xf8, 0x11, 0x6a, 0xea, 0x35, 0x16, 0x32, 0x61,
-	0x3c, 0xaa, 0x5b, 0x4f, 0x43, 0xaf, 0x9f, 0x1b, 0x33, 0xec, 0xa1, 0x63, 0xa2, 0x14, 0xe8, 0xe8,
-	0xd8, 0xe2, 0xce, 0x08, 0xef, 0xa1, 0x36, 0x73, 0xec, 0x70, 0x4a, 0x54, 0x1a, 0x9d, 0x58, 0xe7,
-	0x6d, 0x8f, 0x3d, 0x25, 0x2a, 0x0d, 0xcf, 0x50, 0xaf, 0x08, 0x99, 0x67, 0x22, 0xfe, 0x1e, 0xa7,
-	0xc0, 0x92, 0x54, 0x47, 0x0d, 0x1b, 0x1a, 0x7a, 0xdf, 0x23, 0xe3, 0x7a, 0x6a, 0x3d, 0xe1, 0x29,
-	0x6a, 0x4a, 0x88, 0x81, 0x5d, 0x82, 0x8c, 0x9a, 0x36, 0xaa, 0xb4, 0xc3, 0x4f, 0x51, 0xb7, 0xf8,
-	0xc6, 0x56, 0xbc, 0xa8, 0x65, 0x23, 0x3a, 0x05, 0x3a, 0x31, 0xa0, 0xd9, 0x20, 0x59, 0x88, 0x9c,
-	0xeb, 0x08, 0xb9, 0x0d, 0x3a, 0x2b, 0xfc, 0x0c, 0xbd, 0x23, 0x21, 0x23, 0x1b, 0xa0, 0x78, 0x01,
-	0x4a, 0x91, 0x04, 0xa2, 0xdb, 0x36, 0xa0, 0xeb, 0xe1, 0x67, 0x0e, 0x35, 0x02, 0x72, 0x58, 0x61,
-	0xa5, 0x89, 0xce, 0x55, 0xd4, 0x76, 0x02, 0x72, 0x58, 0xbd, 0xb0, 0x80, 0xa1, 0xe1, 0x5c, 0x65,
-	0x9a, 0x8e, 0xa3, 0xe1, 0xd0, 0x22, 0xcb, 0x3d, 0xd4, 0x76, 0xca, 0x7a, 0xae, 0x5d, 0x27, 0x8f,
-	0xc3, 0x2c, 0xd3, 0xe1, 0xcf, 0xb7, 0xd0, 0x87, 0xb6, 0xcb, 0xaf, 0x65, 0xfc, 0x8a, 0xe9, 0x94,
-	0x4a, 0xb2, 0x9a, 0x48, 0x20, 0xfa, 0xdf, 0xec, 0x73, 0x95, 0x57, 0xfd, 0x80, 0xd7, 0x41, 0x67,
-	0x8f, 0x0f, 0x3b, 0xbb, 0xdb, 0xa7, 0x93, 0xbf, 0xed, 0x53, 0xe3, 0xaf, 0xfb, 0xd4, 0xdc, 0xeb,
-	0xd3, 0xbe, 0xfc, 0xad, 0x8a, 0xfc, 0xc3, 0x5f, 0x02, 0x14, 0x39, 0xd1, 0x40, 0x93, 0xff, 0x52,
-	0xb5, 0x3d, 0x49, 0xea, 0x87, 0x92, 0xec, 0xf3, 0x3e, 0xae, 0xf2, 0xfe, 0x35, 0x40, 0x3d, 0xcb,
-	0xfb, 0x79, 0xae, 0xdd, 0x4c, 0x13, 0x96, 0xe5, 0x12, 0xfe, 0x39, 0xe7, 0x3e, 0x42, 0x22, 0xa3,
-	0x45, 0x61, 0xc7, 0xbb, 0x25, 0x32, 0xea, 0xff, 0xd7, 0x7d, 0x5e, 0xf5, 0x1b, 0x7e, 0xe7, 0x4b,
-	0x92, 0xe5, 0x80, 0x7d, 0x77, 0xa8, 0xa7, 0xde, 0xb1, 0xe8, 0xcc, 0x83, 0x87, 0xf4, 0x5f, 0xe4,
-	0x71, 0x0c, 0x4a, 0xfd, 0x4f, 0xe8, 0xff, 0x18, 0xa0, 0x53, 0x4b, 0x7f, 0x32, 0x79, 0xf9, 0xcd,
-	0x13, 0xa2, 0x2e, 0x24, 0x8b, 0x61, 0xca, 0x63, 0x09, 0x44, 0x01, 0xad, 0x50, 0x0c, 0xaa, 0x14,
-	0xbf, 0x44, 0x61, 0x42, 0x14, 0x5e, 0x9a, 0x45, 0x98, 0xf9, 0x55, 0x7e, 0x27, 0xef, 0x26, 0x95,
-	0x6c, 0xe6, 0xa0, 0x21, 0x94, 0x32, 0xcd, 0x04, 0x27, 0x19, 0xfe, 0x16, 0xa0, 0xd8, 0x55, 0xf7,
-	0x2d, 0x7c, 0x0e, 0xa0, 0x86, 0x19, 0xba, 0x63, 0x39, 0x3d, 0x34, 0xe7, 0xe9, 0xab, 0x94, 0x69,
-	0xc8, 0x98, 0xd2, 0xe6, 0xd4, 0x5c, 0x15, 0x06, 0x3e, 0xa0, 0x15, 0x96, 0xbe, 0x49, 0xc9, 0xef,
-	0x13, 0xd4, 0xb9, 0x92, 0xf1, 0x83, 0x33, 0x4c, 0x28, 0x95, 0xa0, 0x94, 0xa7, 0xd6, 0xb6, 0xe0,
-	0x43, 0x87, 0x0d, 0x7f, 0x0a, 0xd0, 0xc7, 0xb6, 0xdc, 0xe3, 0xd9, 0xe4, 0xc1, 0xd9, 0x24, 0x57,
-	0x5a, 0xd0, 0xcd, 0x79, 0xce, 0xa9, 0x7a, 0xc6, 0x12, 0x49, 0x0c, 0xaf, 0x70, 0x84, 0xee, 0x18,
-	0xb1, 0x63, 0xe7, 0x2c, 0xd3, 0xb9, 0xca, 0xef, 0x71, 0x58, 0xf9, 0x65, 0x3e, 0xa7, 0x29, 0x0c,
-	0x37, 0x15, 0x86, 0x9d, 0xc2, 0x3b, 0x83, 0x5e, 0xab, 0x0e, 0xfa, 0xce, 0xee, 0xea, 0x15, 0xd1,
-	0x87, 0xdf, 0xf9, 0x39, 0xdf, 0xa5, 0x7b, 0x41, 0x72, 0xc5, 0x78, 0x62, 0x2e, 0x2b, 0x7b, 0xb2,
-	0x60, 0x46, 0x2d, 0xb9, 0xda, 0xac, 0x61, 0xed, 0x29, 0x35, 0x97, 0xd5, 0x92, 0xe4, 0xbe, 0x3d,
-	0xcd, 0x99, 0x33, 0x2a, 0xb5, 0x6a, 0xd5, 0x5a, 0x09, 0xea, 0xef, 0x5e, 0xb7, 0x17, 0x52, 0x98,
-	0x7f, 0x9b, 0xf1, 0xa4, 0x18, 0xd2, 0xea, 0xfc, 0x07, 0x87, 0xf3, 0x6f, 0xb5, 0x90, 0x42, 0x96,
-	0xd7, 0x42, 0xa9, 0x85, 0x14, 0xd2, 0xdf, 0x0a, 0x8f, 0x9e, 0xfc, 0x76, 0x3d, 0x08, 0xde, 0x5c,
-	0x0f, 0x82, 0x3f, 0xae, 0x07, 0xc1, 0x0f, 0xdb, 0xc1, 0xd1, 0x9b, 0xed, 0xe0, 0xe8, 0xf7, 0xed,
-	0xe0, 0xe8, 0xf5, 0xfd, 0x84, 0xe9, 0x34, 0x9f, 0x8f, 0x62, 0xb1, 0xb0, 0x8f, 0x8a, 0xfb, 0xee,
-	0x2d, 0xc1, 0x05, 0x85, 0xf1, 0x7a, 0xf7, 0x75, 0x61, 0x26, 0x4d, 0xcd, 0x4f, 0xec, 0x43, 0xe1,
-	0xab, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xaa, 0xb7, 0x8c, 0x2e, 0x8b, 0x08, 0x00, 0x00,
+	// 881 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcd, 0x6e, 0x23, 0x45,
+	0x10, 0xce, 0xc4, 0x4e, 0x62, 0xf7, 0x3a, 0x01, 0x66, 0x0d, 0x0c, 0x91, 0x6c, 0x76, 0x8d, 0x10,
+	0x08, 0xb1, 0x76, 0xb4, 0x3c, 0xc1, 0xae, 0x95, 0xec, 0xfa, 0xb0, 0xda, 0xc8, 0x1b, 0xb4, 0x68,
+	0x2f, 0xad, 0xf6, 0x74, 0x31, 0xd3, 0x30, 0xee, 0xb6, 0xba, 0x7b, 0x62, 0x3b, 0x4f, 0x81, 0x78,
+	0x0f, 0x0e, 0x20, 0x71, 0xe3, 0x01, 0x38, 0xee, 0x91, 0x23, 0x8a, 0x5f, 0x04, 0xf5, 0xcf, 0x4c,
+	0xec, 0x71, 0x44, 0x0e, 0x08, 0x24, 0x6e, 0x53, 0x5f, 0xd5, 0x74, 0x7d, 0xfd, 0x55, 0x57, 0x75,
+	0xa3, 0x2f, 0xae, 0x40, 0x93, 0x38, 0x25, 0x8c, 0x0f, 0xec, 0x97, 0x90, 0x30, 0x88, 0xa5, 0x50,
+	0xca, 0x61, 0x70, 0x09, 0x5c, 0xab, 0xfe, 0x4c, 0x0a, 0x2d, 0xc2, 0x4e, 0x19, 0xdb, 0x2f, 0x62,
+	0xfb, 0x37, 0xb1, 0xc7, 0xed, 0x44, 0x24, 0xc2, 0x46, 0x0e, 0xcc, 0x97, 0xfb, 0xa9, 0xb7, 0xaa,
+	0xa1, 0xf7, 0x4f, 0xcd, 0x2a, 0x23, 0x3e, 0x11, 0x39, 0xa7, 0x67, 0x8c, 0x93, 0x8c, 0x5d, 0x01,
+	0x0d, 0x1f, 0xa0, 0xd6, 0x54, 0x25, 0x58, 0x2f, 0x67, 0x80, 0x73, 0x99, 0x45, 0xc1, 0x83, 0xe0,
+	0xf3, 0xe6, 0x18, 0x4d, 0x55, 0x72, 0xb1, 0x9c, 0xc1, 0xd7, 0x32, 0x0b, 0x3b, 0x08, 0xc5, 0xb1,
+	0x5e, 0x60, 0xc6, 0x29, 0x2c, 0xa2, 0x5d, 0xeb, 0x6f, 0x1a, 0x64, 0x64, 0x80, 0xf0, 0x03, 0xb4,
+	0xaf, 0x80, 0x53, 0x90, 0x51, 0xcd, 0xba, 0xbc, 0x15, 0x7e, 0x84, 0x1a, 0x7a, 0x81, 0x85, 0x4c,
+	0x18, 0x8f, 0xea, 0xd6, 0x73, 0xa0, 0x17, 0x2f, 0x8d, 0x19, 0xb6, 0xd1, 0x1e, 0x51, 0x0a, 0x74,
+	0xb4, 0x67, 0x71, 0x67, 0x84, 0x0f, 0x51, 0x8b, 0x39, 0x76, 0x38, 0x25, 0x2a, 0x8d, 0xf6, 0xad,
+	0xf3, 0x9e, 0xc7, 0x9e, 0x13, 0x95, 0x86, 0x27, 0xa8, 0x5d, 0x84, 0x4c, 0x32, 0x11, 0x7f, 0x8f,
+	0x53, 0x60, 0x49, 0xaa, 0xa3, 0x03, 0x1b, 0x1a, 0x7a, 0xdf, 0x53, 0xe3, 0x7a, 0x6e, 0x3d, 0xe1,
+	0x31, 0x6a, 0x48, 0x88, 0x81, 0x5d, 0x82, 0x8c, 0x1a, 0x36, 0xaa, 0xb4, 0xc3, 0x4f, 0xd1, 0x51,
+	0xf1, 0x8d, 0xad, 0x78, 0x51, 0xd3, 0x46, 0x1c, 0x16, 0xe8, 0xd0, 0x80, 0x66, 0x83, 0x64, 0x2a,
+	0x72, 0xae, 0x23, 0xe4, 0x36, 0xe8, 0xac, 0xf0, 0x33, 0xf4, 0x8e, 0x84, 0x8c, 0x2c, 0x81, 0xe2,
+	0x29, 0x28, 0x45, 0x12, 0x88, 0xee, 0xd9, 0x80, 0x23, 0x0f, 0xbf, 0x70, 0xa8, 0x11, 0x90, 0xc3,
+	0x1c, 0x2b, 0x4d, 0x74, 0xae, 0xa2, 0x96, 0x13, 0x90, 0xc3, 0xfc, 0x95, 0x05, 0x0c, 0x0d, 0xe7,
+	0x2a, 0x97, 0x39, 0x74, 0x34, 0x1c, 0x5a, 0xac, 0xf2, 0x10, 0xb5, 0x9c, 0xb2, 0x9e, 0xeb, 0x91,
+	0x93, 0xc7, 0x61, 0x96, 0x69, 0xef, 0x97, 0x5d, 0xf4, 0xa1, 0xad, 0xf2, 0x1b, 0x19, 0xbf, 0x66,
+	0x3a, 0xa5, 0x92, 0xcc, 0x87, 0x12, 0x88, 0xfe, 0x37, 0xeb, 0x5c, 0xe5, 0x55, 0xdf, 0xe2, 0xb5,
+	0x55, 0xd9, 0xbd, 0xed, 0xca, 0xae, 0xd7, 0x69, 0xff, 0xce, 0x3a, 0x1d, 0xfc, 0x7d, 0x9d, 0x1a,
+	0x1b, 0x75, 0xda, 0x94, 0xbf, 0x59, 0x91, 0xbf, 0xf7, 0x6b, 0x80, 0x22, 0x27, 0x1a, 0x68, 0xf2,
+	0x5f, 0xaa, 0xb6, 0x21, 0x49, 0x7d, 0x5b, 0x92, 0x4d, 0xde, 0x7b, 0x55, 0xde, 0xbf, 0x05, 0xa8,
+	0x6d, 0x79, 0xbf, 0xcc, 0xb5, 0xeb, 0x69, 0xc2, 0xb2, 0x5c, 0xc2, 0x3f, 0xe7, 0xdc, 0x41, 0x48,
+	0x64, 0xb4, 0x48, 0xec, 0x78, 0x37, 0x45, 0x46, 0xfd, 0x79, 0xdd, 0xe4, 0x55, 0xbf, 0xe5, 0x38,
+	0x5f, 0x92, 0x2c, 0x07, 0xec, 0xab, 0x43, 0x3d, 0xf5, 0x43, 0x8b, 0x8e, 0x3d, 0xb8, 0x4d, 0xff,
+	0x55, 0x1e, 0xc7, 0xa0, 0xd4, 0xff, 0x84, 0xfe, 0x8f, 0x01, 0x3a, 0xb6, 0xf4, 0x87, 0xc3, 0x8b,
+	0x6f, 0x9e, 0x11, 0x75, 0x2e, 0x59, 0x0c, 0x23, 0x1e, 0x4b, 0x20, 0x0a, 0x68, 0x85, 0x62, 0x50,
+	0xa5, 0xf8, 0x25, 0x0a, 0x13, 0xa2, 0xf0, 0xcc, 0xfc, 0x84, 0x99, 0xff, 0xcb, 0xef, 0xe4, 0xdd,
+	0xa4, 0xb2, 0x9a, 0x19, 0x34, 0x84, 0x52, 0xa6, 0x99, 0xe0, 0x24, 0xc3, 0xdf, 0x02, 0x14, 0xbb,
+	0x3a, 0xba, 0x81, 0xcf, 0x00, 0x54, 0x2f, 0x43, 0xf7, 0x2d, 0xa7, 0x27, 0x66, 0x9e, 0xbe, 0x4e,
+	0x99, 0x86, 0x8c, 0x29, 0x6d, 0xa6, 0xe6, 0xbc, 0x30, 0xf0, 0x16, 0xad, 0xb0, 0xf4, 0x0d, 0x4b,
+	0x7e, 0x9f, 0xa0, 0xc3, 0x2b, 0x19, 0x3f, 0x3e, 0xc1, 0x84, 0x52, 0x09, 0x4a, 0x79, 0x6a, 0x2d,
+	0x0b, 0x3e, 0x71, 0x58, 0xef, 0xa7, 0x00, 0x7d, 0x6c, 0xd3, 0x9d, 0x8e, 0x87, 0x8f, 0x4f, 0x86,
+	0xb9, 0xd2, 0x82, 0x2e, 0xcf, 0x72, 0x4e, 0xd5, 0x0b, 0x96, 0x48, 0x62, 0x78, 0x85, 0x7d, 0x74,
+	0xdf, 0x88, 0x1d, 0x3b, 0x67, 0xb9, 0x9c, 0xcb, 0xfc, 0x1e, 0x87, 0xb9, 0xff, 0xcd, 0xaf, 0x69,
+	0x12, 0xc3, 0x6d, 0x89, 0x61, 0x2d, 0xf1, 0x5a, 0xa3, 0xd7, 0xaa, 0x8d, 0xbe, 0xb6, 0xbb, 0x7a,
+	0x45, 0xf4, 0xde, 0x77, 0xbe, 0xcf, 0xd7, 0xe9, 0x9e, 0x93, 0x5c, 0x31, 0x9e, 0x98, 0xcb, 0xca,
+	0x4e, 0x16, 0xcc, 0xa8, 0x25, 0x57, 0x1b, 0x1f, 0x58, 0x7b, 0x44, 0xcd, 0x65, 0x35, 0x23, 0xb9,
+	0x2f, 0x4f, 0x63, 0xec, 0x8c, 0x4a, 0xae, 0x5a, 0x35, 0x57, 0x82, 0x3a, 0xeb, 0xd7, 0xed, 0xb9,
+	0x14, 0xe6, 0x6c, 0x33, 0x9e, 0x14, 0x4d, 0x5a, 0xed, 0xff, 0x60, 0xbb, 0xff, 0xad, 0x16, 0x52,
+	0xc8, 0xf2, 0x5a, 0x28, 0xb5, 0x90, 0x42, 0xfa, 0x5b, 0xa1, 0xf7, 0x73, 0xe0, 0x6b, 0xee, 0x33,
+	0x9d, 0x2e, 0x66, 0x4c, 0xde, 0x7d, 0x00, 0xab, 0xe9, 0x77, 0x6f, 0x9d, 0xc8, 0x14, 0x08, 0xcd,
+	0x18, 0x07, 0xbb, 0xbf, 0xfa, 0xb8, 0xb4, 0xcd, 0xea, 0xee, 0xfe, 0xd5, 0x6c, 0x0a, 0x56, 0xe9,
+	0xda, 0xb8, 0x69, 0x91, 0x0b, 0x36, 0x85, 0x3b, 0x26, 0xd7, 0xd3, 0x67, 0xbf, 0x5f, 0x77, 0x83,
+	0xb7, 0xd7, 0xdd, 0xe0, 0xcf, 0xeb, 0x6e, 0xf0, 0xc3, 0xaa, 0xbb, 0xf3, 0x76, 0xd5, 0xdd, 0xf9,
+	0x63, 0xd5, 0xdd, 0x79, 0xf3, 0x28, 0x61, 0x3a, 0xcd, 0x27, 0xfd, 0x58, 0x4c, 0xed, 0x43, 0xe8,
+	0x91, 0x7b, 0xff, 0x70, 0x41, 0x61, 0xb0, 0x58, 0x7f, 0x11, 0x99, 0xe9, 0xa0, 0x26, 0xfb, 0xf6,
+	0x71, 0xf3, 0xd5, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x51, 0xbe, 0x1b, 0xf1, 0x3f, 0x09, 0x00,
+	0x00,
 }
 
 func (m *EventInboundFinalized) Marshal() (dAtA []byte, err error) {
@@ -1467,6 +1548,60 @@ func (m *EventInboundProcessingFailure) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *EventInboundExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventInboundExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventInboundExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewStatus) > 0 {
+		i -= len(m.NewStatus)
+		copy(dAtA[i:], m.NewStatus)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewStatus)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BlockTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Deadline != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.InboundHash) > 0 {
+		i -= len(m.InboundHash)
+		copy(dAtA[i:], m.InboundHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.InboundHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CctxIndex) > 0 {
+		i -= len(m.CctxIndex)
+		copy(dAtA[i:], m.CctxIndex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CctxIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1774,6 +1909,33 @@ func (m *EventInboundProcessingFailure) Size() (n int) {
 	return n
 }
 
+func (m *EventInboundExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.InboundHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovEvents(uint64(m.Deadline))
+	}
+	if m.BlockTime != 0 {
+		n += 1 + sovEvents(uint64(m.BlockTime))
+	}
+	l = len(m.NewStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3919,6 +4081,190 @@ func (m *EventInboundProcessingFailure) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventInboundExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventInboundExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventInboundExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			m.BlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -44,6 +44,7 @@ type ObserverKeeper interface {
 	SetNodeAccount(ctx sdk.Context, nodeAccount observertypes.NodeAccount)
 	IsInboundEnabled(ctx sdk.Context) (found bool)
 	IsV2ZetaEnabled(ctx sdk.Context) bool
+	IsParentRevertInheritanceEnabled(ctx sdk.Context) bool
 	GetCrosschainFlags(ctx sdk.Context) (val observertypes.CrosschainFlags, found bool)
 	GetKeygen(ctx sdk.Context) (val observertypes.Keygen, found bool)
 	SetKeygen(ctx sdk.Context, keygen observertypes.Keygen)
@@ -219,6 +220,7 @@ type FungibleKeeper interface {
 	FundGasStabilityPool(ctx sdk.Context, chainID int64, amount *big.Int) error
 	DepositChainGasToken(ctx sdk.Context, chainID int64, amount *big.Int, receiver ethcommon.Address) error
 	WithdrawFromGasStabilityPool(ctx sdk.Context, chainID int64, amount *big.Int) error
+	ZRC20CirculatingSupply(ctx sdk.Context, foreignCoin fungibletypes.ForeignCoins) (*big.Int, error)
 	LegacyZETADepositAndCallContract(ctx sdk.Context,
 		sender ethcommon.Address,
 		to ethcommon.Address,
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 )
 
 func (m InboundParams) Validate() error {
@@ -36,3 +37,13 @@ func (m InboundParams) Validate() error {
 	//}
 	return nil
 }
+
+// IsExpired returns true if the inbound has a deadline and the given block time is past it
+func (m InboundParams) IsExpired(blockTime time.Time) bool {
+	if m.Deadline == 0 {
+		return false
+	}
+
+	// #nosec G115 block time is always positive
+	return uint64(blockTime.Unix()) > m.Deadline
+}
@@ -3,6 +3,7 @@ package types_test
 import (
 	"math/rand"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
@@ -44,3 +45,20 @@ func TestInboundTxParams_Validate(t *testing.T) {
 	//inboundParams.BallotIndex = "12"
 	//require.ErrorContains(t, inboundParams.Validate(), "invalid index length 2")
 }
+
+func TestInboundParams_IsExpired(t *testing.T) {
+	blockTime := time.Unix(1_700_000_000, 0)
+
+	inboundParams := sample.InboundParams(rand.New(rand.NewSource(42)))
+	inboundParams.Deadline = 0
+	require.False(t, inboundParams.IsExpired(blockTime), "no deadline")
+
+	inboundParams.Deadline = 1_700_000_001
+	require.False(t, inboundParams.IsExpired(blockTime), "deadline not reached")
+
+	inboundParams.Deadline = 1_700_000_000
+	require.False(t, inboundParams.IsExpired(blockTime), "deadline is inclusive")
+
+	inboundParams.Deadline = 1_699_999_999
+	require.True(t, inboundParams.IsExpired(blockTime), "deadline exceeded")
+}
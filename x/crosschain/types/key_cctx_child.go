@@ -0,0 +1,21 @@
+package types
+
+const (
+	// CctxChildKeyPrefix is the prefix to retrieve the children of a cctx
+	CctxChildKeyPrefix = "CctxChild/value/"
+)
+
+// CctxChildrenKey returns the store key prefix to retrieve the children of a cctx
+func CctxChildrenKey(parentIndex string) []byte {
+	parentIndexBytes := []byte(parentIndex)
+	key := make([]byte, 0, len(parentIndexBytes)+1)
+	key = append(key, parentIndexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// CctxChildKey returns the store key to retrieve a child of a cctx from the parent and child indexes
+func CctxChildKey(parentIndex, childIndex string) []byte {
+	return append(CctxChildrenKey(parentIndex), []byte(childIndex)...)
+}
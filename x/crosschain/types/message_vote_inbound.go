@@ -1,15 +1,20 @@
 package types
 
 import (
+	"fmt"
+
 	cosmoserrors "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
 	"github.com/zeta-chain/protocol-contracts-evm/pkg/gatewayevm.sol"
 	"github.com/zeta-chain/protocol-contracts-evm/pkg/gatewayzevm.sol"
 
 	"github.com/zeta-chain/node/pkg/authz"
+	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/pkg/coin"
 	"github.com/zeta-chain/node/pkg/contracts/solana"
 )
@@ -20,6 +25,9 @@ import (
 // https://github.com/zeta-chain/node/issues/862
 const MaxMessageLength = 10240
 
+// MaxReceiverSplits is the maximum number of receiver splits of an inbound
+const MaxReceiverSplits = 10
+
 // InboundVoteOption is a function that sets some option on the inbound vote message
 type InboundVoteOption func(*MsgVoteInbound)
 
@@ -68,6 +76,32 @@ func WithErrorMessage(errorMessage string) InboundVoteOption {
 	}
 }
 
+// WithDeadline sets the deadline (unix timestamp in seconds) for the inbound vote message
+func WithDeadline(deadline uint64) InboundVoteOption {
+	return func(msg *MsgVoteInbound) {
+		msg.Deadline = deadline
+	}
+}
+
+// WithReceiverSplits sets the receiver splits for the inbound vote message
+// The splits are dropped if the inbound is not successful, the inbound is then reverted as a whole.
+// If the splits are invalid for the inbound (e.g. they exceed its amount), the inbound is voted as an invalid memo
+// so it is reverted to the sender.
+func WithReceiverSplits(splits []ReceiverSplit) InboundVoteOption {
+	return func(msg *MsgVoteInbound) {
+		if len(splits) == 0 || msg.Status != InboundStatus_SUCCESS {
+			return
+		}
+
+		if err := ValidateReceiverSplits(msg.Receiver, msg.Amount, splits); err != nil {
+			msg.Status = InboundStatus_INVALID_MEMO
+			msg.ErrorMessage = errors.Wrap(err, "invalid receiver splits").Error()
+			return
+		}
+		msg.ReceiverSplits = splits
+	}
+}
+
 var _ sdk.Msg = &MsgVoteInbound{}
 
 func NewMsgVoteInbound(
@@ -162,9 +196,90 @@ func (msg *MsgVoteInbound) ValidateBasic() error {
 		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidRequest, "message is too long: %d", len(msg.Message))
 	}
 
+	if len(msg.ReceiverSplits) > 0 {
+		if err := msg.validateReceiverSplits(); err != nil {
+			return cosmoserrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+
 	return nil
 }
 
+// validateReceiverSplits checks the receiver splits can be deposited on ZetaChain by the inbound
+func (msg *MsgVoteInbound) validateReceiverSplits() error {
+	switch {
+	case msg.Status != InboundStatus_SUCCESS:
+		return errors.New("receiver splits are only allowed for a successful inbound")
+	case !msg.CoinType.IsAsset():
+		return fmt.Errorf("receiver splits are not allowed for coin type %s", msg.CoinType)
+	case !chains.IsZetaChain(msg.ReceiverChain, nil):
+		return fmt.Errorf("receiver splits are only allowed for ZetaChain, got chain %d", msg.ReceiverChain)
+	}
+
+	return ValidateReceiverSplits(msg.Receiver, msg.Amount, msg.ReceiverSplits)
+}
+
+// ValidateReceiverSplits checks the receiver splits of an inbound of the given receiver and amount
+// Each split is deposited by its own cctx, the receivers must therefore be distinct ZEVM addresses
+// that differ from the inbound receiver, and the splits can't exceed the inbound amount.
+func ValidateReceiverSplits(receiver string, amount math.Uint, splits []ReceiverSplit) error {
+	if len(splits) > MaxReceiverSplits {
+		return fmt.Errorf("too many receiver splits: %d, max %d", len(splits), MaxReceiverSplits)
+	}
+
+	total := math.ZeroUint()
+	seen := map[ethcommon.Address]bool{ethcommon.HexToAddress(receiver): true}
+	for i, split := range splits {
+		if !ethcommon.IsHexAddress(split.Receiver) || ethcommon.HexToAddress(split.Receiver) == (ethcommon.Address{}) {
+			return fmt.Errorf("receiver split %d has an invalid receiver: %s", i, split.Receiver)
+		}
+		if split.Amount.IsNil() || split.Amount.IsZero() {
+			return fmt.Errorf("receiver split %d amount is zero", i)
+		}
+
+		address := ethcommon.HexToAddress(split.Receiver)
+		if seen[address] {
+			return fmt.Errorf("receiver split %d receiver is duplicated: %s", i, split.Receiver)
+		}
+		seen[address] = true
+		total = total.Add(split.Amount)
+	}
+
+	if total.GT(amount) {
+		return fmt.Errorf("receiver splits total %s exceeds the inbound amount %s", total, amount)
+	}
+
+	return nil
+}
+
+// ReceiverAmount returns the amount deposited to the receiver of the inbound
+// The receiver splits are deposited to their receivers, the receiver gets the remaining amount.
+func (msg *MsgVoteInbound) ReceiverAmount() math.Uint {
+	amount := msg.Amount
+	for _, split := range msg.ReceiverSplits {
+		amount = amount.Sub(split.Amount)
+	}
+	return amount
+}
+
+// ReceiverSplitMsgs returns an inbound message for each receiver split of the inbound
+// The message of a split is a plain deposit of the split amount to the split receiver,
+// it keeps the revert options of the inbound so the split is refunded to the sender on failure.
+func (msg *MsgVoteInbound) ReceiverSplitMsgs() []MsgVoteInbound {
+	msgs := make([]MsgVoteInbound, 0, len(msg.ReceiverSplits))
+	for _, split := range msg.ReceiverSplits {
+		splitMsg := *msg
+		splitMsg.Receiver = split.Receiver
+		splitMsg.Amount = split.Amount
+		splitMsg.Message = ""
+		splitMsg.IsCrossChainCall = false
+		splitMsg.CallOptions = &CallOptions{GasLimit: msg.CallOptions.GetGasLimit()}
+		splitMsg.ReceiverSplits = nil
+		msgs = append(msgs, splitMsg)
+	}
+	return msgs
+}
+
 func (msg *MsgVoteInbound) Digest() string {
 	m := *msg
 	m.Creator = ""
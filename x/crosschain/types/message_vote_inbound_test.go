@@ -13,6 +13,7 @@ import (
 	"github.com/zeta-chain/protocol-contracts-evm/pkg/gatewayzevm.sol"
 
 	"github.com/zeta-chain/node/pkg/authz"
+	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/pkg/coin"
 	"github.com/zeta-chain/node/testutil/sample"
 	"github.com/zeta-chain/node/x/crosschain/types"
@@ -336,6 +337,92 @@ func TestNewMsgVoteInbound(t *testing.T) {
 		require.Equal(t, expectedConfirmationMode, msg.ConfirmationMode)
 		require.Equal(t, expectedErrMessage, msg.ErrorMessage)
 	})
+
+	t.Run("can set deadline", func(t *testing.T) {
+		msg := types.NewMsgVoteInbound(
+			sample.AccAddress(),
+			sample.AccAddress(),
+			42,
+			sample.String(),
+			sample.String(),
+			42,
+			math.NewUint(42),
+			sample.String(),
+			sample.String(),
+			42,
+			42,
+			coin.CoinType_Zeta,
+			sample.String(),
+			42,
+			types.ProtocolContractVersion_V2,
+			false,
+			types.InboundStatus_SUCCESS,
+			types.ConfirmationMode_SAFE,
+			types.WithDeadline(1_700_000_000),
+		)
+		require.EqualValues(t, 1_700_000_000, msg.Deadline)
+	})
+
+	t.Run("can set receiver splits", func(t *testing.T) {
+		splits := []types.ReceiverSplit{
+			{Receiver: sample.EthAddress().Hex(), Amount: math.NewUint(10)},
+			{Receiver: sample.EthAddress().Hex(), Amount: math.NewUint(20)},
+		}
+		msg := newReceiverSplitsMsg(math.NewUint(42), types.InboundStatus_SUCCESS, types.WithReceiverSplits(splits))
+		require.Equal(t, splits, msg.ReceiverSplits)
+		require.Equal(t, types.InboundStatus_SUCCESS, msg.Status)
+	})
+
+	t.Run("receiver splits exceeding the amount are voted as an invalid memo", func(t *testing.T) {
+		splits := []types.ReceiverSplit{
+			{Receiver: sample.EthAddress().Hex(), Amount: math.NewUint(30)},
+			{Receiver: sample.EthAddress().Hex(), Amount: math.NewUint(20)},
+		}
+		msg := newReceiverSplitsMsg(math.NewUint(42), types.InboundStatus_SUCCESS, types.WithReceiverSplits(splits))
+		require.Empty(t, msg.ReceiverSplits)
+		require.Equal(t, types.InboundStatus_INVALID_MEMO, msg.Status)
+		require.Contains(t, msg.ErrorMessage, "invalid receiver splits")
+	})
+
+	t.Run("receiver splits are dropped for a non successful inbound", func(t *testing.T) {
+		splits := []types.ReceiverSplit{{Receiver: sample.EthAddress().Hex(), Amount: math.NewUint(10)}}
+		msg := newReceiverSplitsMsg(
+			math.NewUint(42),
+			types.InboundStatus_INSUFFICIENT_DEPOSITOR_FEE,
+			types.WithReceiverSplits(splits),
+		)
+		require.Empty(t, msg.ReceiverSplits)
+		require.Equal(t, types.InboundStatus_INSUFFICIENT_DEPOSITOR_FEE, msg.Status)
+	})
+}
+
+// newReceiverSplitsMsg creates an inbound vote of a gas token deposit to ZetaChain
+func newReceiverSplitsMsg(
+	amount math.Uint,
+	status types.InboundStatus,
+	options ...types.InboundVoteOption,
+) *types.MsgVoteInbound {
+	return types.NewMsgVoteInbound(
+		sample.AccAddress(),
+		sample.EthAddress().Hex(),
+		chains.BitcoinMainnet.ChainId,
+		sample.EthAddress().Hex(),
+		sample.EthAddress().Hex(),
+		chains.ZetaChainMainnet.ChainId,
+		amount,
+		"",
+		sample.Hash().Hex(),
+		42,
+		0,
+		coin.CoinType_Gas,
+		"",
+		0,
+		types.ProtocolContractVersion_V2,
+		false,
+		status,
+		types.ConfirmationMode_SAFE,
+		options...,
+	)
 }
 
 func TestMsgVoteInbound_ValidateBasic(t *testing.T) {
@@ -465,6 +552,112 @@ func TestMsgVoteInbound_ValidateBasic(t *testing.T) {
 			),
 			err: sdkerrors.ErrInvalidRequest,
 		},
+		{
+			name: "valid receiver splits",
+			msg: func() *types.MsgVoteInbound {
+				msg := newReceiverSplitsMsg(math.NewUint(42), types.InboundStatus_SUCCESS)
+				msg.ReceiverSplits = []types.ReceiverSplit{
+					{Receiver: sample.EthAddress().Hex(), Amount: math.NewUint(20)},
+					{Receiver: sample.EthAddress().Hex(), Amount: math.NewUint(22)},
+				}
+				return msg
+			}(),
+		},
+		{
+			name: "receiver splits exceed the amount",
+			msg: func() *types.MsgVoteInbound {
+				msg := newReceiverSplitsMsg(math.NewUint(42), types.InboundStatus_SUCCESS)
+				msg.ReceiverSplits = []types.ReceiverSplit{
+					{Receiver: sample.EthAddress().Hex(), Amount: math.NewUint(20)},
+					{Receiver: sample.EthAddress().Hex(), Amount: math.NewUint(23)},
+				}
+				return msg
+			}(),
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "receiver split with duplicated receiver",
+			msg: func() *types.MsgVoteInbound {
+				msg := newReceiverSplitsMsg(math.NewUint(42), types.InboundStatus_SUCCESS)
+				receiver := sample.EthAddress().Hex()
+				msg.ReceiverSplits = []types.ReceiverSplit{
+					{Receiver: receiver, Amount: math.NewUint(1)},
+					{Receiver: receiver, Amount: math.NewUint(1)},
+				}
+				return msg
+			}(),
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "receiver split to the inbound receiver",
+			msg: func() *types.MsgVoteInbound {
+				msg := newReceiverSplitsMsg(math.NewUint(42), types.InboundStatus_SUCCESS)
+				msg.ReceiverSplits = []types.ReceiverSplit{{Receiver: msg.Receiver, Amount: math.NewUint(1)}}
+				return msg
+			}(),
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "receiver split with invalid receiver",
+			msg: func() *types.MsgVoteInbound {
+				msg := newReceiverSplitsMsg(math.NewUint(42), types.InboundStatus_SUCCESS)
+				msg.ReceiverSplits = []types.ReceiverSplit{{Receiver: "invalid", Amount: math.NewUint(1)}}
+				return msg
+			}(),
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "receiver split with zero amount",
+			msg: func() *types.MsgVoteInbound {
+				msg := newReceiverSplitsMsg(math.NewUint(42), types.InboundStatus_SUCCESS)
+				msg.ReceiverSplits = []types.ReceiverSplit{{Receiver: sample.EthAddress().Hex(), Amount: math.ZeroUint()}}
+				return msg
+			}(),
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "too many receiver splits",
+			msg: func() *types.MsgVoteInbound {
+				msg := newReceiverSplitsMsg(math.NewUint(42), types.InboundStatus_SUCCESS)
+				for range types.MaxReceiverSplits + 1 {
+					msg.ReceiverSplits = append(msg.ReceiverSplits, types.ReceiverSplit{
+						Receiver: sample.EthAddress().Hex(),
+						Amount:   math.NewUint(1),
+					})
+				}
+				return msg
+			}(),
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "receiver splits to a connected chain",
+			msg: func() *types.MsgVoteInbound {
+				msg := newReceiverSplitsMsg(math.NewUint(42), types.InboundStatus_SUCCESS)
+				msg.ReceiverChain = chains.Ethereum.ChainId
+				msg.ReceiverSplits = []types.ReceiverSplit{{Receiver: sample.EthAddress().Hex(), Amount: math.NewUint(1)}}
+				return msg
+			}(),
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "receiver splits of a non successful inbound",
+			msg: func() *types.MsgVoteInbound {
+				msg := newReceiverSplitsMsg(math.NewUint(42), types.InboundStatus_INVALID_MEMO)
+				msg.ReceiverSplits = []types.ReceiverSplit{{Receiver: sample.EthAddress().Hex(), Amount: math.NewUint(1)}}
+				return msg
+			}(),
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "receiver splits of a call without asset",
+			msg: func() *types.MsgVoteInbound {
+				msg := newReceiverSplitsMsg(math.NewUint(42), types.InboundStatus_SUCCESS)
+				msg.CoinType = coin.CoinType_NoAssetCall
+				msg.ReceiverSplits = []types.ReceiverSplit{{Receiver: sample.EthAddress().Hex(), Amount: math.NewUint(1)}}
+				return msg
+			}(),
+			err: sdkerrors.ErrInvalidRequest,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -629,6 +822,56 @@ func TestMsgVoteInbound_Digest(t *testing.T) {
 	msg.ErrorMessage = "a sample error message"
 	hash2 = msg.Digest()
 	require.Equal(t, hash, hash2, "error message should not change hash")
+
+	// deadline used
+	msg = getMsg()
+	msg.Deadline = 1_700_000_000
+	hash2 = msg.Digest()
+	require.NotEqual(t, hash, hash2, "deadline should change hash")
+}
+
+func TestMsgVoteInbound_ReceiverSplits(t *testing.T) {
+	t.Run("receiver gets the whole amount without splits", func(t *testing.T) {
+		msg := newReceiverSplitsMsg(math.NewUint(42), types.InboundStatus_SUCCESS)
+		require.Equal(t, math.NewUint(42), msg.ReceiverAmount())
+		require.Empty(t, msg.ReceiverSplitMsgs())
+	})
+
+	t.Run("receiver gets the remaining amount of the splits", func(t *testing.T) {
+		splits := []types.ReceiverSplit{
+			{Receiver: sample.EthAddress().Hex(), Amount: math.NewUint(10)},
+			{Receiver: sample.EthAddress().Hex(), Amount: math.NewUint(20)},
+		}
+		msg := newReceiverSplitsMsg(
+			math.NewUint(42),
+			types.InboundStatus_SUCCESS,
+			types.WithCrossChainCall(true),
+			types.WithDeadline(1_700_000_000),
+			types.WithReceiverSplits(splits),
+		)
+		msg.Message = "deadbeef"
+		require.Equal(t, math.NewUint(12), msg.ReceiverAmount())
+
+		splitMsgs := msg.ReceiverSplitMsgs()
+		require.Len(t, splitMsgs, 2)
+		for i, splitMsg := range splitMsgs {
+			// the split is a plain deposit of the split amount
+			require.Equal(t, splits[i].Receiver, splitMsg.Receiver)
+			require.Equal(t, splits[i].Amount, splitMsg.Amount)
+			require.Equal(t, splits[i].Amount, splitMsg.ReceiverAmount())
+			require.Empty(t, splitMsg.Message)
+			require.False(t, splitMsg.IsCrossChainCall)
+			require.Empty(t, splitMsg.ReceiverSplits)
+
+			// the split keeps the inbound and its revert options
+			require.Equal(t, msg.InboundHash, splitMsg.InboundHash)
+			require.Equal(t, msg.EventIndex, splitMsg.EventIndex)
+			require.Equal(t, msg.RevertOptions, splitMsg.RevertOptions)
+			require.Equal(t, msg.Deadline, splitMsg.Deadline)
+			require.NotEqual(t, msg.Digest(), splitMsg.Digest())
+		}
+		require.NotEqual(t, splitMsgs[0].Digest(), splitMsgs[1].Digest())
+	})
 }
 
 func TestMsgVoteInbound_EligibleForFastConfirmation(t *testing.T) {
@@ -11,6 +11,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	coin "github.com/zeta-chain/node/pkg/coin"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -2137,6 +2138,283 @@ func (m *QueryInboundTrackerResponse) GetInboundTracker() InboundTracker {
 	return InboundTracker{}
 }
 
+type QuerySimulateInboundRequest struct {
+	SenderChainId int64         `protobuf:"varint,1,opt,name=sender_chain_id,json=senderChainId,proto3" json:"sender_chain_id,omitempty"`
+	Sender        string        `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver      string        `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	CoinType      coin.CoinType `protobuf:"varint,4,opt,name=coin_type,json=coinType,proto3,enum=zetachain.zetacore.pkg.coin.CoinType" json:"coin_type,omitempty"`
+	Asset         string        `protobuf:"bytes,5,opt,name=asset,proto3" json:"asset,omitempty"`
+	Amount        string        `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// hex encoded message of the inbound, it can be a standard memo
+	Message          string `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	IsCrossChainCall bool   `protobuf:"varint,8,opt,name=is_cross_chain_call,json=isCrossChainCall,proto3" json:"is_cross_chain_call,omitempty"`
+}
+
+func (m *QuerySimulateInboundRequest) Reset()         { *m = QuerySimulateInboundRequest{} }
+func (m *QuerySimulateInboundRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateInboundRequest) ProtoMessage()    {}
+func (*QuerySimulateInboundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d00cb546ea76908b, []int{43}
+}
+func (m *QuerySimulateInboundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateInboundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateInboundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateInboundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateInboundRequest.Merge(m, src)
+}
+func (m *QuerySimulateInboundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateInboundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateInboundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateInboundRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateInboundRequest) GetSenderChainId() int64 {
+	if m != nil {
+		return m.SenderChainId
+	}
+	return 0
+}
+
+func (m *QuerySimulateInboundRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *QuerySimulateInboundRequest) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *QuerySimulateInboundRequest) GetCoinType() coin.CoinType {
+	if m != nil {
+		return m.CoinType
+	}
+	return coin.CoinType_Zeta
+}
+
+func (m *QuerySimulateInboundRequest) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *QuerySimulateInboundRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *QuerySimulateInboundRequest) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *QuerySimulateInboundRequest) GetIsCrossChainCall() bool {
+	if m != nil {
+		return m.IsCrossChainCall
+	}
+	return false
+}
+
+type QuerySimulateInboundResponse struct {
+	CrossChainTx *CrossChainTx `protobuf:"bytes,1,opt,name=cross_chain_tx,json=crossChainTx,proto3" json:"cross_chain_tx,omitempty"`
+	Status       CctxStatus    `protobuf:"varint,2,opt,name=status,proto3,enum=zetachain.zetacore.crosschain.CctxStatus" json:"status,omitempty"`
+	RevertReason string        `protobuf:"bytes,3,opt,name=revert_reason,json=revertReason,proto3" json:"revert_reason,omitempty"`
+	// gas used by the ZEVM calls
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// fee to withdraw the gas asset to the sender chain, paid by a revert
+	WithdrawFee string `protobuf:"bytes,5,opt,name=withdraw_fee,json=withdrawFee,proto3" json:"withdraw_fee,omitempty"`
+	// child cctxs depositing the receiver splits carried by the message
+	ReceiverSplitCctxs []CrossChainTx `protobuf:"bytes,6,rep,name=receiver_split_cctxs,json=receiverSplitCctxs,proto3" json:"receiver_split_cctxs"`
+}
+
+func (m *QuerySimulateInboundResponse) Reset()         { *m = QuerySimulateInboundResponse{} }
+func (m *QuerySimulateInboundResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateInboundResponse) ProtoMessage()    {}
+func (*QuerySimulateInboundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d00cb546ea76908b, []int{44}
+}
+func (m *QuerySimulateInboundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateInboundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateInboundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateInboundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateInboundResponse.Merge(m, src)
+}
+func (m *QuerySimulateInboundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateInboundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateInboundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateInboundResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateInboundResponse) GetCrossChainTx() *CrossChainTx {
+	if m != nil {
+		return m.CrossChainTx
+	}
+	return nil
+}
+
+func (m *QuerySimulateInboundResponse) GetStatus() CctxStatus {
+	if m != nil {
+		return m.Status
+	}
+	return CctxStatus_PendingInbound
+}
+
+func (m *QuerySimulateInboundResponse) GetRevertReason() string {
+	if m != nil {
+		return m.RevertReason
+	}
+	return ""
+}
+
+func (m *QuerySimulateInboundResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *QuerySimulateInboundResponse) GetWithdrawFee() string {
+	if m != nil {
+		return m.WithdrawFee
+	}
+	return ""
+}
+
+func (m *QuerySimulateInboundResponse) GetReceiverSplitCctxs() []CrossChainTx {
+	if m != nil {
+		return m.ReceiverSplitCctxs
+	}
+	return nil
+}
+
+type QueryCctxTreeRequest struct {
+	InboundHash string `protobuf:"bytes,1,opt,name=inbound_hash,json=inboundHash,proto3" json:"inbound_hash,omitempty"`
+}
+
+func (m *QueryCctxTreeRequest) Reset()         { *m = QueryCctxTreeRequest{} }
+func (m *QueryCctxTreeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCctxTreeRequest) ProtoMessage()    {}
+func (*QueryCctxTreeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d00cb546ea76908b, []int{45}
+}
+func (m *QueryCctxTreeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCctxTreeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCctxTreeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCctxTreeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCctxTreeRequest.Merge(m, src)
+}
+func (m *QueryCctxTreeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCctxTreeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCctxTreeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCctxTreeRequest proto.InternalMessageInfo
+
+func (m *QueryCctxTreeRequest) GetInboundHash() string {
+	if m != nil {
+		return m.InboundHash
+	}
+	return ""
+}
+
+type QueryCctxTreeResponse struct {
+	// cctxs of the tree, parents are always listed before their children
+	CrossChainTxs []CrossChainTx `protobuf:"bytes,1,rep,name=CrossChainTxs,proto3" json:"CrossChainTxs"`
+}
+
+func (m *QueryCctxTreeResponse) Reset()         { *m = QueryCctxTreeResponse{} }
+func (m *QueryCctxTreeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCctxTreeResponse) ProtoMessage()    {}
+func (*QueryCctxTreeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d00cb546ea76908b, []int{46}
+}
+func (m *QueryCctxTreeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCctxTreeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCctxTreeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCctxTreeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCctxTreeResponse.Merge(m, src)
+}
+func (m *QueryCctxTreeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCctxTreeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCctxTreeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCctxTreeResponse proto.InternalMessageInfo
+
+func (m *QueryCctxTreeResponse) GetCrossChainTxs() []CrossChainTx {
+	if m != nil {
+		return m.CrossChainTxs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryZetaAccountingRequest)(nil), "zetachain.zetacore.crosschain.QueryZetaAccountingRequest")
 	proto.RegisterType((*QueryZetaAccountingResponse)(nil), "zetachain.zetacore.crosschain.QueryZetaAccountingResponse")
@@ -2181,6 +2459,10 @@ func init() {
 	proto.RegisterType((*QueryRateLimiterFlagsResponse)(nil), "zetachain.zetacore.crosschain.QueryRateLimiterFlagsResponse")
 	proto.RegisterType((*QueryInboundTrackerRequest)(nil), "zetachain.zetacore.crosschain.QueryInboundTrackerRequest")
 	proto.RegisterType((*QueryInboundTrackerResponse)(nil), "zetachain.zetacore.crosschain.QueryInboundTrackerResponse")
+	proto.RegisterType((*QuerySimulateInboundRequest)(nil), "zetachain.zetacore.crosschain.QuerySimulateInboundRequest")
+	proto.RegisterType((*QuerySimulateInboundResponse)(nil), "zetachain.zetacore.crosschain.QuerySimulateInboundResponse")
+	proto.RegisterType((*QueryCctxTreeRequest)(nil), "zetachain.zetacore.crosschain.QueryCctxTreeRequest")
+	proto.RegisterType((*QueryCctxTreeResponse)(nil), "zetachain.zetacore.crosschain.QueryCctxTreeResponse")
 }
 
 func init() {
@@ -2188,140 +2470,163 @@ func init() {
 }
 
 var fileDescriptor_d00cb546ea76908b = []byte{
-	// 2126 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xdb, 0x6f, 0x1c, 0x57,
-	0x19, 0xcf, 0x78, 0xe3, 0xc4, 0xfe, 0x9c, 0xd8, 0xf1, 0x89, 0x53, 0xbb, 0x5b, 0x67, 0x93, 0x4e,
-	0x9a, 0xd8, 0x75, 0xf0, 0x6e, 0xe3, 0x10, 0xe7, 0xd6, 0x36, 0xf1, 0x25, 0x71, 0x8c, 0x9c, 0xd4,
-	0x5d, 0x59, 0x44, 0x0a, 0x88, 0xd1, 0xf1, 0xec, 0xe9, 0xec, 0xd0, 0xf1, 0xcc, 0x76, 0xe6, 0x6c,
-	0xec, 0xd4, 0xb2, 0x04, 0x95, 0x78, 0xe0, 0x05, 0x21, 0x55, 0x88, 0x17, 0x5e, 0x11, 0x3c, 0xf0,
-	0xd0, 0x07, 0xd4, 0x47, 0x24, 0x40, 0x82, 0x8a, 0x0a, 0xa9, 0x14, 0x09, 0xf1, 0x84, 0xaa, 0x04,
-	0xd1, 0x77, 0xfe, 0x82, 0x6a, 0xce, 0x7c, 0xb3, 0x3b, 0xf7, 0x9d, 0xdd, 0x6c, 0x1f, 0xfa, 0xe4,
-	0x9d, 0x73, 0xce, 0x77, 0xf9, 0x7d, 0x97, 0x73, 0xf9, 0xc9, 0xf0, 0xea, 0xfb, 0x8c, 0x53, 0xb5,
-	0x4e, 0x75, 0xb3, 0x22, 0x7e, 0x59, 0x36, 0xab, 0xa8, 0xb6, 0xe5, 0x38, 0xde, 0xd8, 0x7b, 0x4d,
-	0x66, 0x3f, 0x29, 0x37, 0x6c, 0x8b, 0x5b, 0xe4, 0x74, 0x6b, 0x69, 0xd9, 0x5f, 0x5a, 0x6e, 0x2f,
-	0x2d, 0xce, 0xa9, 0x96, 0xb3, 0x63, 0x39, 0x95, 0x6d, 0xea, 0x30, 0x4f, 0xae, 0xf2, 0xf8, 0xd2,
-	0x36, 0xe3, 0xf4, 0x52, 0xa5, 0x41, 0x35, 0xdd, 0xa4, 0x5c, 0xb7, 0x4c, 0x4f, 0x55, 0x71, 0x21,
-	0xdb, 0xaa, 0xf8, 0xa9, 0x88, 0xdf, 0x0a, 0xdf, 0x43, 0x99, 0xf9, 0x6c, 0x19, 0x8d, 0x3a, 0x4a,
-	0xc3, 0xd6, 0x55, 0x86, 0xcb, 0xaf, 0x65, 0x2f, 0xd7, 0xcd, 0x6d, 0xab, 0x69, 0xd6, 0x94, 0x3a,
-	0x75, 0xea, 0x0a, 0xb7, 0x14, 0x55, 0x6d, 0x19, 0xba, 0x9c, 0x4f, 0x92, 0xdb, 0x54, 0x7d, 0x97,
-	0xd9, 0x28, 0xf4, 0xed, 0x6c, 0x21, 0xab, 0xc9, 0x93, 0xa4, 0x16, 0xb3, 0xa5, 0x6c, 0xca, 0x99,
-	0x62, 0xe8, 0x3b, 0x3a, 0x67, 0xb6, 0xf2, 0x8e, 0x41, 0x35, 0x07, 0xe5, 0x26, 0x34, 0x4b, 0xb3,
-	0xc4, 0xcf, 0x8a, 0xfb, 0x0b, 0x47, 0xa7, 0x35, 0xcb, 0xd2, 0x0c, 0x56, 0xa1, 0x0d, 0xbd, 0x42,
-	0x4d, 0xd3, 0xe2, 0x22, 0xe4, 0xbe, 0xcc, 0x24, 0xe6, 0x67, 0xc7, 0xd1, 0x2a, 0x8f, 0x2f, 0xb9,
-	0x7f, 0xbc, 0x09, 0x79, 0x1a, 0x8a, 0x6f, 0xbb, 0xe9, 0x7a, 0xc4, 0x38, 0x5d, 0x52, 0x55, 0xab,
-	0x69, 0x72, 0xdd, 0xd4, 0xaa, 0xec, 0xbd, 0x26, 0x73, 0xb8, 0x7c, 0x1f, 0x5e, 0x4a, 0x9c, 0x75,
-	0x1a, 0x96, 0xe9, 0x30, 0x52, 0x86, 0x93, 0x74, 0xdb, 0xb2, 0x39, 0xab, 0x29, 0x2e, 0x02, 0x85,
-	0xee, 0xb8, 0x2b, 0xa6, 0xa4, 0xb3, 0xd2, 0xec, 0x70, 0x75, 0x1c, 0xa7, 0x84, 0xac, 0x98, 0x90,
-	0x37, 0xa1, 0x24, 0xd4, 0xad, 0x31, 0xfe, 0x16, 0xc6, 0x64, 0xcb, 0x0b, 0x09, 0x1a, 0x24, 0x53,
-	0x70, 0x54, 0xa0, 0x5f, 0x5f, 0x15, 0x5a, 0x0a, 0x55, 0xff, 0x93, 0x4c, 0xc0, 0xa0, 0x69, 0x99,
-	0x2a, 0x9b, 0x1a, 0x38, 0x2b, 0xcd, 0x1e, 0xae, 0x7a, 0x1f, 0xf2, 0x8f, 0x25, 0x38, 0x93, 0xaa,
-	0x12, 0xbd, 0xfc, 0x01, 0x8c, 0x59, 0xe1, 0x29, 0xa1, 0x7b, 0x64, 0xa1, 0x5c, 0xce, 0x2c, 0xea,
-	0x72, 0x44, 0xe1, 0xf2, 0xe1, 0x4f, 0xfe, 0x73, 0xe6, 0x50, 0x35, 0xaa, 0x4c, 0xae, 0x23, 0xaa,
-	0x25, 0xc3, 0x48, 0x41, 0x75, 0x17, 0xa0, 0xdd, 0x05, 0x68, 0xfc, 0x42, 0xd9, 0x4b, 0x49, 0xd9,
-	0x6d, 0x99, 0xb2, 0xd7, 0x6a, 0xd8, 0x32, 0xe5, 0x4d, 0xaa, 0x31, 0x94, 0xad, 0x06, 0x24, 0xe5,
-	0xbf, 0xf9, 0x68, 0x93, 0x4c, 0x65, 0xa1, 0x2d, 0xf4, 0x0d, 0x2d, 0x59, 0x0b, 0x61, 0x19, 0x10,
-	0x58, 0x66, 0x3a, 0x62, 0xf1, 0x9c, 0x0b, 0x81, 0xf9, 0x89, 0x04, 0xe7, 0x53, 0xc0, 0x2c, 0x3f,
-	0x59, 0x71, 0x5d, 0xf2, 0xc3, 0x37, 0x01, 0x83, 0xc2, 0x45, 0x2c, 0x09, 0xef, 0x23, 0x12, 0xd4,
-	0x81, 0x9e, 0x83, 0xfa, 0x0f, 0x09, 0x2e, 0x74, 0xf2, 0xe3, 0x9b, 0x16, 0xdb, 0x9f, 0x4a, 0xf0,
-	0x8a, 0x8f, 0x69, 0xdd, 0xcc, 0x08, 0xed, 0x8b, 0x30, 0xe4, 0xed, 0xb4, 0x7a, 0x2d, 0xdc, 0x70,
-	0xb5, 0xbe, 0xc5, 0xf7, 0xef, 0x81, 0x3c, 0xa7, 0xf8, 0x82, 0xe1, 0xfd, 0x1e, 0x8c, 0xea, 0x66,
-	0x42, 0x74, 0xe7, 0x3b, 0x44, 0x37, 0xa2, 0xd5, 0x0b, 0x6e, 0x44, 0x55, 0xff, 0x62, 0x1b, 0x68,
-	0xf7, 0xb0, 0x61, 0xa7, 0xdf, 0xed, 0xfe, 0xd7, 0x40, 0xbb, 0xc7, 0x4c, 0x7d, 0xa3, 0x62, 0xb6,
-	0x0a, 0x67, 0xfd, 0x5d, 0x1a, 0x0d, 0xdf, 0xa3, 0x4e, 0x7d, 0xcb, 0x5a, 0x51, 0xf9, 0x9e, 0x1f,
-	0xb5, 0xb3, 0x30, 0xa2, 0xb7, 0xe7, 0xf0, 0x10, 0x09, 0x0e, 0xb9, 0x55, 0xfd, 0x72, 0x86, 0x1a,
-	0x8c, 0x48, 0x0d, 0xc6, 0xf5, 0xe8, 0x24, 0x26, 0xe1, 0xb5, 0x7c, 0x41, 0x69, 0xcb, 0x61, 0x5c,
-	0xe2, 0x0a, 0xe5, 0x3b, 0xe8, 0x4a, 0x4c, 0x64, 0x95, 0x72, 0x9a, 0x1f, 0xd2, 0x01, 0xc8, 0x59,
-	0x6a, 0x10, 0xd2, 0x43, 0x38, 0xbe, 0xe2, 0x7a, 0x29, 0xda, 0x65, 0x6b, 0xcf, 0xc1, 0x1c, 0x5f,
-	0xec, 0x00, 0x27, 0x28, 0x83, 0x48, 0xc2, 0x7a, 0xe4, 0x1f, 0x62, 0x5e, 0xda, 0x05, 0x16, 0xcf,
-	0x4b, 0xbf, 0xaa, 0xf9, 0x73, 0x3f, 0x7b, 0xc9, 0xc6, 0xb2, 0xb3, 0x57, 0xe8, 0x6b, 0xf6, 0xfa,
-	0x57, 0xd8, 0x15, 0x98, 0xf4, 0x2b, 0x72, 0x8d, 0x3a, 0x9b, 0xee, 0x15, 0x34, 0x70, 0x6a, 0xe9,
-	0x66, 0x8d, 0xed, 0x61, 0xda, 0xbd, 0x0f, 0x59, 0x81, 0xa9, 0xb8, 0x00, 0x62, 0x5f, 0x81, 0x21,
-	0x7f, 0x0c, 0xe3, 0x3c, 0xd3, 0x01, 0x72, 0x4b, 0x45, 0x4b, 0x50, 0xa6, 0xe8, 0xd1, 0x92, 0x61,
-	0x44, 0x3d, 0xea, 0x57, 0x26, 0x7f, 0x2b, 0x21, 0x88, 0x90, 0x8d, 0x44, 0x10, 0x85, 0x9e, 0x40,
-	0xf4, 0x2f, 0x3f, 0x17, 0xe1, 0xa4, 0x1f, 0xee, 0x60, 0x4d, 0x27, 0xe7, 0x66, 0x03, 0xef, 0xc2,
-	0xb8, 0x78, 0xf9, 0xc9, 0x03, 0xf7, 0x8e, 0xd9, 0xeb, 0xd5, 0x54, 0x83, 0x89, 0xb0, 0x69, 0x0c,
-	0xd0, 0x5b, 0x70, 0x2c, 0xd8, 0x84, 0x98, 0x87, 0x6e, 0x7a, 0xb9, 0x1a, 0x52, 0x20, 0xef, 0x23,
-	0xc6, 0x25, 0xc3, 0xf8, 0x1a, 0xfa, 0x96, 0x4c, 0xc3, 0x70, 0xd3, 0xb4, 0xec, 0x1a, 0xb3, 0x59,
-	0x4d, 0x20, 0x1c, 0xaa, 0xb6, 0x07, 0xe4, 0x8f, 0x24, 0x84, 0xd9, 0xb2, 0x9e, 0x0a, 0xb3, 0xf0,
-	0x5c, 0x30, 0xfb, 0x57, 0x13, 0x0f, 0xf0, 0x51, 0xb3, 0xa1, 0x3b, 0x7c, 0x93, 0x99, 0x35, 0xdd,
-	0xd4, 0x82, 0x71, 0xcb, 0xb8, 0x12, 0x4d, 0xc0, 0xa0, 0x78, 0x90, 0x09, 0xeb, 0xc7, 0xab, 0xde,
-	0x87, 0xfc, 0xa1, 0x04, 0xd3, 0xc9, 0x0a, 0xbf, 0xae, 0x50, 0xc8, 0x70, 0x8c, 0x5b, 0x9c, 0x1a,
-	0x68, 0x0c, 0xeb, 0x2e, 0x34, 0x26, 0x6f, 0xa0, 0x53, 0x55, 0xca, 0xd9, 0x86, 0xf7, 0x8a, 0x5c,
-	0x37, 0x1b, 0x4d, 0x1e, 0x68, 0x01, 0x0f, 0x8b, 0x14, 0xc0, 0x42, 0x5e, 0x80, 0x23, 0xbb, 0xba,
-	0x59, 0xb3, 0x76, 0x85, 0xce, 0x42, 0x15, 0xbf, 0xe4, 0x5f, 0x14, 0xe0, 0x74, 0x8a, 0x3a, 0x04,
-	0xf9, 0x02, 0x1c, 0xa9, 0x33, 0x5d, 0xab, 0x73, 0x0c, 0x1a, 0x7e, 0x91, 0x07, 0x70, 0xcc, 0x7d,
-	0x5e, 0x3b, 0xca, 0x8e, 0xee, 0x38, 0xa2, 0x82, 0xba, 0x06, 0x3f, 0x22, 0x14, 0xdc, 0x17, 0xf2,
-	0x64, 0x13, 0x8e, 0x7b, 0xfa, 0x1a, 0x08, 0xbe, 0xd0, 0x43, 0x34, 0x85, 0x06, 0x8c, 0x14, 0x39,
-	0x07, 0xc7, 0x45, 0xe4, 0x5a, 0x1a, 0x0f, 0xc7, 0xc3, 0x49, 0x66, 0xe1, 0x44, 0x83, 0x3a, 0x5c,
-	0xf1, 0x6c, 0x3f, 0xa6, 0x46, 0x93, 0x4d, 0x0d, 0x8a, 0xcd, 0x63, 0xd4, 0x1d, 0x77, 0xf3, 0xed,
-	0x7c, 0xd7, 0x1d, 0x75, 0x1f, 0xc5, 0xa8, 0x28, 0xb4, 0xf8, 0x88, 0xf7, 0x28, 0x6e, 0xb4, 0xeb,
-	0x03, 0xd7, 0xdf, 0x84, 0xa2, 0x61, 0xed, 0x32, 0x87, 0x2b, 0x41, 0x31, 0x05, 0x83, 0x79, 0x54,
-	0x04, 0x73, 0xd2, 0x5b, 0x11, 0x28, 0xae, 0x7b, 0x62, 0x5a, 0x5e, 0x86, 0xb9, 0xa4, 0xd2, 0x7b,
-	0xa8, 0xf3, 0xba, 0x6e, 0xb6, 0x72, 0x95, 0x99, 0x73, 0xf9, 0x8f, 0x03, 0x70, 0x31, 0x97, 0x12,
-	0xcc, 0xf4, 0xdb, 0x30, 0x1a, 0xe6, 0x68, 0x7a, 0x2a, 0x68, 0x35, 0x58, 0xd0, 0xb1, 0x14, 0x24,
-	0x54, 0x34, 0x59, 0x84, 0x49, 0xb5, 0x69, 0xdb, 0xcc, 0xe4, 0xca, 0xae, 0xce, 0xeb, 0x35, 0x9b,
-	0xee, 0x2a, 0x58, 0xac, 0x05, 0x11, 0xa5, 0x53, 0x38, 0xfd, 0x10, 0x67, 0x1f, 0x8a, 0x49, 0xb2,
-	0x00, 0xa7, 0x62, 0x72, 0x36, 0xe5, 0x4c, 0xe4, 0x79, 0xb8, 0x7a, 0x32, 0x22, 0xe5, 0x02, 0x76,
-	0x93, 0xd8, 0xe6, 0x5f, 0x14, 0xb6, 0xa7, 0x32, 0x56, 0x63, 0x35, 0x91, 0xf1, 0xa1, 0xea, 0xb8,
-	0xed, 0xc7, 0xe4, 0x0e, 0x4e, 0xb4, 0x68, 0x94, 0x0d, 0xea, 0xf0, 0x47, 0x8c, 0x53, 0x2f, 0x3d,
-	0x3e, 0x8d, 0x72, 0xc5, 0xdf, 0x71, 0x22, 0xb3, 0xed, 0xd6, 0xb9, 0x17, 0x6a, 0x1d, 0x4c, 0xee,
-	0x16, 0xb6, 0xf0, 0x8a, 0x65, 0x3e, 0x66, 0xb6, 0x7b, 0x5f, 0xd8, 0xb2, 0x5c, 0xf1, 0xd8, 0x89,
-	0x14, 0xdb, 0xa8, 0x8a, 0x30, 0xa4, 0x51, 0x67, 0xa3, 0xb5, 0x57, 0x0d, 0x57, 0x5b, 0xdf, 0xf2,
-	0xaf, 0x25, 0x6c, 0xe5, 0xb8, 0x5a, 0xf4, 0xe7, 0x5b, 0x30, 0xee, 0xbf, 0x4c, 0xd7, 0xa8, 0xb3,
-	0x6e, 0xba, 0x93, 0x3e, 0xa9, 0x13, 0x9b, 0x70, 0x57, 0x0b, 0x2a, 0x49, 0xb5, 0x8c, 0xbb, 0x8c,
-	0xe1, 0xea, 0x01, 0xac, 0xf6, 0xe8, 0x04, 0x99, 0x85, 0x31, 0xf7, 0xef, 0xb2, 0x61, 0xa9, 0xef,
-	0x22, 0xe8, 0x82, 0xc8, 0x75, 0x74, 0x58, 0x9e, 0xc1, 0x67, 0xe3, 0x7d, 0xe6, 0x38, 0x54, 0x63,
-	0x9b, 0xd4, 0x71, 0x74, 0x53, 0xdb, 0x6c, 0x6b, 0xf4, 0xa3, 0x7b, 0x17, 0xdf, 0xef, 0x19, 0x0b,
-	0x11, 0xd8, 0x34, 0x0c, 0xbf, 0xd3, 0x72, 0xd1, 0x03, 0xd4, 0x1e, 0x90, 0x4b, 0xf1, 0x1d, 0xf3,
-	0xae, 0x41, 0x35, 0xff, 0x59, 0x27, 0x7f, 0x20, 0xc5, 0xf7, 0x40, 0x5c, 0x80, 0xfa, 0x29, 0x9c,
-	0xb0, 0x23, 0x73, 0x78, 0xf0, 0x56, 0x3a, 0xf4, 0x46, 0x54, 0x25, 0x5e, 0x5d, 0x63, 0xea, 0xe4,
-	0x4d, 0x2c, 0xb4, 0xf0, 0xfb, 0x2d, 0xc7, 0xd9, 0x35, 0x09, 0x47, 0xdd, 0x5d, 0xc5, 0x7d, 0x87,
-	0x78, 0xc9, 0x39, 0xc2, 0xf7, 0xc4, 0x13, 0x64, 0x1f, 0x8b, 0x33, 0xaa, 0x11, 0x31, 0x7d, 0x1f,
-	0xc6, 0x22, 0xa4, 0x27, 0x42, 0xea, 0xc7, 0x0b, 0x73, 0xe1, 0x67, 0x32, 0x0c, 0x0a, 0xeb, 0xe4,
-	0x73, 0x09, 0xc6, 0x22, 0x34, 0x09, 0x79, 0xa3, 0x83, 0x89, 0x6c, 0x32, 0xb1, 0xf8, 0x66, 0xaf,
-	0xe2, 0x1e, 0x74, 0xf9, 0xf6, 0x07, 0xff, 0xfc, 0xef, 0x87, 0x03, 0x37, 0xc8, 0x35, 0xc1, 0xcf,
-	0xce, 0x07, 0xe8, 0xe9, 0x30, 0xaf, 0x8b, 0x72, 0x95, 0x7d, 0xbc, 0x10, 0x1e, 0x54, 0xf6, 0xc5,
-	0x15, 0xf0, 0x80, 0xfc, 0x59, 0x02, 0x12, 0xd1, 0xbe, 0x64, 0x18, 0xf9, 0x70, 0xa5, 0xd2, 0x89,
-	0xf9, 0x70, 0xa5, 0x53, 0x84, 0x72, 0x59, 0xe0, 0x9a, 0x25, 0x17, 0xf2, 0xe1, 0x22, 0x5f, 0x4a,
-	0xf0, 0x62, 0x1c, 0x05, 0xb2, 0x37, 0x64, 0xb5, 0x37, 0x6f, 0xc2, 0x44, 0x54, 0xf1, 0xce, 0x73,
-	0x6a, 0x41, 0x68, 0x6f, 0x08, 0x68, 0x57, 0xc9, 0x95, 0x7c, 0xd0, 0x50, 0x1c, 0x33, 0x77, 0x40,
-	0xfe, 0x27, 0xc1, 0x54, 0xb8, 0x6e, 0x03, 0x40, 0x57, 0x72, 0xba, 0x98, 0x45, 0xb8, 0x15, 0x57,
-	0x9f, 0x4f, 0x09, 0xc2, 0xbc, 0x25, 0x60, 0x5e, 0x27, 0x57, 0x53, 0x60, 0xea, 0x66, 0x3a, 0x4a,
-	0x45, 0xaf, 0x1d, 0x90, 0x3f, 0x49, 0x30, 0x1e, 0x03, 0x9a, 0xbb, 0x2e, 0x93, 0x79, 0xaf, 0xdc,
-	0x75, 0x99, 0xc2, 0x65, 0x75, 0xac, 0xcb, 0x30, 0x2a, 0x87, 0x7c, 0x2a, 0xc1, 0x68, 0x58, 0x17,
-	0xb9, 0x9e, 0xc7, 0x85, 0xc4, 0xbd, 0xb3, 0x78, 0xa3, 0x17, 0x51, 0xf4, 0x7c, 0x59, 0x78, 0xfe,
-	0x3a, 0xb9, 0x91, 0xcb, 0xf3, 0x40, 0x22, 0x2a, 0xfb, 0xb8, 0x29, 0x1f, 0x90, 0x7f, 0xb5, 0x53,
-	0x12, 0x60, 0x2a, 0x6e, 0xe5, 0xdc, 0xc3, 0xd2, 0xe8, 0x9b, 0xe2, 0xed, 0xde, 0x15, 0x20, 0xb8,
-	0x37, 0x05, 0xb8, 0x6b, 0x64, 0x31, 0x1b, 0x5c, 0x5b, 0xb2, 0xb2, 0x1f, 0x18, 0x3a, 0x20, 0x5f,
-	0x48, 0x70, 0x2a, 0x91, 0xdf, 0x22, 0xb7, 0xbb, 0x08, 0x79, 0x22, 0xc3, 0x56, 0x5c, 0x7a, 0x0e,
-	0x0d, 0xdd, 0xe5, 0x2e, 0x2c, 0x1d, 0x81, 0xf8, 0xa9, 0x04, 0x13, 0x31, 0x2b, 0x6e, 0x47, 0xdd,
-	0xea, 0xae, 0x25, 0x7a, 0x4c, 0x5f, 0x16, 0xa3, 0x26, 0xbf, 0x26, 0xf0, 0xcd, 0x91, 0xd9, 0xbc,
-	0xf8, 0xc8, 0xef, 0xa4, 0x36, 0x87, 0x43, 0x16, 0x73, 0xd6, 0x4f, 0x84, 0x6c, 0x2a, 0x5e, 0xed,
-	0x5a, 0x0e, 0xfd, 0xad, 0x08, 0x7f, 0x5f, 0x25, 0x33, 0x29, 0xfe, 0x6a, 0x28, 0xe0, 0xa6, 0xa0,
-	0xc6, 0xf6, 0x0e, 0xc8, 0x6f, 0x24, 0x18, 0xf1, 0xb5, 0xb8, 0x31, 0x5f, 0xcc, 0x19, 0xb2, 0x9e,
-	0x3c, 0x4e, 0xa0, 0xbc, 0xe4, 0x19, 0xe1, 0xf1, 0xcb, 0xe4, 0x4c, 0x07, 0x8f, 0xc9, 0x1f, 0x24,
-	0x38, 0x11, 0xbd, 0x75, 0x93, 0x9b, 0x79, 0xcc, 0xa6, 0x3c, 0x01, 0x8a, 0xaf, 0xf7, 0x26, 0x9c,
-	0x33, 0xd4, 0x6a, 0xd4, 0xd7, 0xbf, 0x48, 0x30, 0x12, 0xb8, 0x58, 0xe7, 0x3b, 0xfb, 0x3b, 0x5d,
-	0xe0, 0xf3, 0x9d, 0xfd, 0x1d, 0x6f, 0xf7, 0xf2, 0x9c, 0x40, 0xf3, 0x0a, 0x91, 0x53, 0xd0, 0x04,
-	0x1e, 0x23, 0xe4, 0x57, 0x12, 0x1c, 0x16, 0xb5, 0xbe, 0x90, 0xb3, 0x4c, 0x83, 0x3d, 0x79, 0xb9,
-	0x2b, 0x19, 0xf4, 0xee, 0xa2, 0xf0, 0xee, 0x3c, 0x39, 0x97, 0x16, 0x6b, 0xdc, 0x38, 0x45, 0x49,
-	0xff, 0x5e, 0x82, 0x91, 0x00, 0x05, 0x99, 0xef, 0x58, 0x4b, 0xa4, 0x2d, 0x7b, 0x73, 0xf6, 0x8a,
-	0x70, 0xb6, 0x42, 0xe6, 0x33, 0x9d, 0x8d, 0x5d, 0x77, 0x7f, 0x29, 0xc1, 0x51, 0x7f, 0xe7, 0x5b,
-	0xc8, 0xd9, 0x4d, 0x5d, 0x07, 0x36, 0x42, 0x34, 0xca, 0xe7, 0x84, 0xaf, 0xa7, 0xc9, 0x4b, 0x19,
-	0xbe, 0x92, 0x8f, 0x25, 0x18, 0x8b, 0xd0, 0x1b, 0x24, 0xd7, 0x81, 0x9f, 0x4c, 0x12, 0x16, 0x6f,
-	0xf6, 0x24, 0x9b, 0xb7, 0x50, 0x03, 0x4e, 0xfe, 0x5f, 0x82, 0x52, 0x36, 0x2f, 0x43, 0xd6, 0x7b,
-	0xf0, 0x25, 0x99, 0x20, 0x2a, 0x7e, 0xa7, 0x1f, 0xaa, 0x10, 0xe5, 0x75, 0x81, 0xf2, 0x32, 0xb9,
-	0xd4, 0x19, 0x65, 0x14, 0xd1, 0xc7, 0x12, 0x8c, 0x86, 0xff, 0xe5, 0x24, 0x5f, 0x07, 0x24, 0xfe,
-	0x13, 0x4b, 0xbe, 0x8b, 0x5d, 0xf2, 0x7f, 0xb8, 0xc8, 0xf3, 0x02, 0xc4, 0x0c, 0x39, 0x9f, 0x02,
-	0xe2, 0xfd, 0xb0, 0x97, 0xae, 0xe3, 0x61, 0x92, 0x27, 0x9f, 0xe3, 0x89, 0xb4, 0x51, 0x3e, 0xc7,
-	0x93, 0x39, 0xa5, 0x8e, 0x8e, 0x1b, 0x61, 0x2f, 0xdd, 0x93, 0x29, 0xca, 0x41, 0xe4, 0x3b, 0x99,
-	0x52, 0xd8, 0x92, 0x7c, 0x27, 0x53, 0x1a, 0x93, 0xd2, 0xf1, 0x64, 0x8a, 0xf2, 0x22, 0x51, 0x00,
-	0x82, 0x9b, 0xee, 0x1a, 0x40, 0x90, 0x20, 0xef, 0x1a, 0x40, 0x88, 0x0e, 0xef, 0x06, 0x80, 0x10,
-	0x2c, 0x0e, 0xfe, 0xe8, 0xcb, 0x8f, 0xe6, 0xa4, 0xe5, 0xb5, 0x4f, 0x9e, 0x96, 0xa4, 0xcf, 0x9e,
-	0x96, 0xa4, 0x2f, 0x9e, 0x96, 0xa4, 0x9f, 0x3f, 0x2b, 0x1d, 0xfa, 0xec, 0x59, 0xe9, 0xd0, 0xbf,
-	0x9f, 0x95, 0x0e, 0x3d, 0x9a, 0xd7, 0x74, 0x5e, 0x6f, 0x6e, 0x97, 0x55, 0x6b, 0x27, 0xa8, 0xd3,
-	0xb4, 0x6a, 0xac, 0xb2, 0x17, 0x54, 0xcd, 0x9f, 0x34, 0x98, 0xb3, 0x7d, 0x44, 0x1c, 0x77, 0x97,
-	0xbf, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x0b, 0x43, 0xab, 0xe4, 0x27, 0x00, 0x00,
+	// 2482 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x4d, 0x6c, 0xdc, 0xc6,
+	0xf5, 0x37, 0x25, 0x4b, 0x96, 0x9e, 0x6c, 0xc9, 0x1e, 0xcb, 0xb6, 0xb2, 0xb1, 0x65, 0x9b, 0xfe,
+	0x90, 0x6c, 0xff, 0xb5, 0x6b, 0xcb, 0xb1, 0xfc, 0x95, 0xc4, 0x96, 0xe4, 0xcf, 0x3f, 0x64, 0x47,
+	0x59, 0xab, 0x35, 0xe0, 0x16, 0x25, 0x46, 0xdc, 0x31, 0x97, 0x35, 0x45, 0x6e, 0x38, 0x5c, 0x5b,
+	0x8a, 0x20, 0xa0, 0x0d, 0xd0, 0x43, 0x6f, 0x05, 0x82, 0xa2, 0x97, 0x5e, 0x8b, 0xf6, 0xd0, 0x02,
+	0x39, 0x04, 0x39, 0xf4, 0x50, 0xa0, 0x2d, 0xd0, 0x06, 0x0d, 0x0a, 0xa4, 0x29, 0x50, 0xf4, 0x54,
+	0x04, 0x76, 0xd1, 0xdc, 0x7b, 0x6d, 0x0f, 0xc5, 0x0c, 0x1f, 0x77, 0x49, 0x2e, 0xc9, 0xe5, 0xae,
+	0xd7, 0x87, 0x9c, 0xb4, 0xf3, 0xf1, 0xde, 0xbc, 0xdf, 0xfb, 0x9a, 0x79, 0x8f, 0x82, 0x53, 0xef,
+	0x33, 0x8f, 0xea, 0x55, 0x6a, 0xda, 0x25, 0xf9, 0xcb, 0x71, 0x59, 0x49, 0x77, 0x1d, 0xce, 0xfd,
+	0xb9, 0xf7, 0xea, 0xcc, 0xdd, 0x28, 0xd6, 0x5c, 0xc7, 0x73, 0xc8, 0xa1, 0xc6, 0xd6, 0x62, 0xb0,
+	0xb5, 0xd8, 0xdc, 0x5a, 0x38, 0xad, 0x3b, 0x7c, 0xcd, 0xe1, 0xa5, 0x55, 0xca, 0x99, 0x4f, 0x57,
+	0x7a, 0x7a, 0x6e, 0x95, 0x79, 0xf4, 0x5c, 0xa9, 0x46, 0x0d, 0xd3, 0xa6, 0x9e, 0xe9, 0xd8, 0x3e,
+	0xab, 0xc2, 0x6c, 0xf6, 0xa9, 0xf2, 0xa7, 0x26, 0x7f, 0x6b, 0xde, 0x3a, 0xd2, 0xcc, 0x64, 0xd3,
+	0x18, 0x94, 0x6b, 0x35, 0xd7, 0xd4, 0x19, 0x6e, 0xbf, 0x94, 0xbd, 0xdd, 0xb4, 0x57, 0x9d, 0xba,
+	0x5d, 0xd1, 0xaa, 0x94, 0x57, 0x35, 0xcf, 0xd1, 0x74, 0xbd, 0x71, 0xd0, 0xf9, 0x7c, 0x94, 0x9e,
+	0x4b, 0xf5, 0x27, 0xcc, 0x45, 0xa2, 0x37, 0xb2, 0x89, 0x9c, 0xba, 0x97, 0x44, 0x35, 0x97, 0x4d,
+	0xe5, 0x52, 0x8f, 0x69, 0x96, 0xb9, 0x66, 0x7a, 0xcc, 0xd5, 0x1e, 0x5b, 0xd4, 0xe0, 0x48, 0x77,
+	0x32, 0x81, 0xae, 0xf6, 0xc4, 0x28, 0xe9, 0x8e, 0xd0, 0x9e, 0x63, 0x06, 0x7a, 0x1e, 0x37, 0x1c,
+	0xc3, 0x91, 0x3f, 0x4b, 0xe2, 0x17, 0xce, 0x1e, 0x34, 0x1c, 0xc7, 0xb0, 0x58, 0x89, 0xd6, 0xcc,
+	0x12, 0xb5, 0x6d, 0xc7, 0x93, 0xa6, 0x09, 0x78, 0x1f, 0x40, 0x3b, 0xae, 0x71, 0xa3, 0xf4, 0xf4,
+	0x9c, 0xf8, 0xe3, 0x2f, 0xa8, 0x07, 0xa1, 0xf0, 0xae, 0x30, 0xeb, 0x23, 0xe6, 0xd1, 0x79, 0x5d,
+	0x77, 0xea, 0xb6, 0x67, 0xda, 0x46, 0x99, 0xbd, 0x57, 0x67, 0xdc, 0x53, 0xef, 0xc1, 0xeb, 0x89,
+	0xab, 0xbc, 0xe6, 0xd8, 0x9c, 0x91, 0x22, 0xec, 0xa5, 0xab, 0x8e, 0xeb, 0xb1, 0x8a, 0x26, 0x24,
+	0xd6, 0xe8, 0x9a, 0xd8, 0x31, 0xa1, 0x1c, 0x51, 0xa6, 0x87, 0xcb, 0x7b, 0x70, 0x49, 0xd2, 0xca,
+	0x05, 0x75, 0x19, 0x26, 0x25, 0xbb, 0xdb, 0xcc, 0x7b, 0x07, 0x75, 0xb7, 0xe2, 0xab, 0x0e, 0x0f,
+	0x24, 0x13, 0xb0, 0x43, 0x6a, 0xe0, 0xee, 0x0d, 0xc9, 0xa5, 0xbf, 0x1c, 0x0c, 0xc9, 0x38, 0x0c,
+	0xd8, 0x8e, 0xad, 0xb3, 0x89, 0xbe, 0x23, 0xca, 0xf4, 0xf6, 0xb2, 0x3f, 0x50, 0xbf, 0xaf, 0xc0,
+	0xe1, 0x54, 0x96, 0x28, 0xe5, 0x77, 0x60, 0xcc, 0x89, 0x2e, 0x49, 0xde, 0x23, 0xb3, 0xc5, 0x62,
+	0xa6, 0xf3, 0x17, 0x63, 0x0c, 0x17, 0xb6, 0x7f, 0xfa, 0x8f, 0xc3, 0xdb, 0xca, 0x71, 0x66, 0x6a,
+	0x15, 0x51, 0xcd, 0x5b, 0x56, 0x0a, 0xaa, 0x5b, 0x00, 0xcd, 0x68, 0xc1, 0xc3, 0x4f, 0x16, 0x7d,
+	0x93, 0x14, 0x45, 0x68, 0x15, 0xfd, 0x90, 0xc4, 0xd0, 0x2a, 0x2e, 0x53, 0x83, 0x21, 0x6d, 0x39,
+	0x44, 0xa9, 0xfe, 0x29, 0x40, 0x9b, 0x74, 0x54, 0x16, 0xda, 0xfe, 0x9e, 0xa1, 0x25, 0xb7, 0x23,
+	0x58, 0xfa, 0x24, 0x96, 0xa9, 0xb6, 0x58, 0x7c, 0xe1, 0x22, 0x60, 0x7e, 0xa0, 0xc0, 0x89, 0x14,
+	0x30, 0x0b, 0x1b, 0x8b, 0x42, 0xa4, 0x40, 0x7d, 0xe3, 0x30, 0x20, 0x45, 0x44, 0x97, 0xf0, 0x07,
+	0x31, 0xa5, 0xf6, 0x75, 0xad, 0xd4, 0xbf, 0x28, 0x70, 0xb2, 0x9d, 0x1c, 0x5f, 0x37, 0xdd, 0xfe,
+	0x50, 0x81, 0xe3, 0x01, 0xa6, 0xbb, 0x76, 0x86, 0x6a, 0x5f, 0x83, 0x21, 0x3f, 0x23, 0x9b, 0x95,
+	0x68, 0xc0, 0x55, 0x7a, 0xa6, 0xdf, 0x3f, 0x87, 0xec, 0x9c, 0x22, 0x0b, 0xaa, 0xf7, 0x5b, 0x30,
+	0x6a, 0xda, 0x09, 0xda, 0x9d, 0x69, 0xa3, 0xdd, 0x18, 0x57, 0x5f, 0xb9, 0x31, 0x56, 0xbd, 0xd3,
+	0x6d, 0x28, 0xdc, 0xa3, 0x07, 0xf3, 0x5e, 0x87, 0xfb, 0x1f, 0x43, 0xe1, 0xde, 0x72, 0xd4, 0xd7,
+	0x4a, 0x67, 0x37, 0xe0, 0x48, 0x90, 0xa5, 0xf1, 0xe0, 0x3b, 0x94, 0x57, 0x57, 0x9c, 0x45, 0xdd,
+	0x5b, 0x0f, 0xb4, 0x76, 0x04, 0x46, 0xcc, 0xe6, 0x1a, 0x5e, 0x22, 0xe1, 0x29, 0xe1, 0xd5, 0x47,
+	0x33, 0xd8, 0xa0, 0x46, 0x2a, 0xb0, 0xc7, 0x8c, 0x2f, 0xa2, 0x11, 0xce, 0xe6, 0x53, 0x4a, 0x93,
+	0x0e, 0xf5, 0xd2, 0xca, 0x50, 0xbd, 0x89, 0xa2, 0xb4, 0x90, 0xdc, 0xa0, 0x1e, 0xcd, 0x0f, 0x69,
+	0x0b, 0xd4, 0x2c, 0x36, 0x08, 0xe9, 0x21, 0xec, 0x5a, 0x14, 0x52, 0xca, 0x70, 0x59, 0x59, 0xe7,
+	0x68, 0xe3, 0x33, 0x6d, 0xe0, 0x84, 0x69, 0x10, 0x49, 0x94, 0x8f, 0xfa, 0x5d, 0xb4, 0x4b, 0xd3,
+	0xc1, 0x5a, 0xed, 0xd2, 0x2b, 0x6f, 0xfe, 0x22, 0xb0, 0x5e, 0xf2, 0x61, 0xd9, 0xd6, 0xeb, 0xef,
+	0xa9, 0xf5, 0x7a, 0xe7, 0xd8, 0x25, 0x38, 0x10, 0x78, 0xe4, 0x6d, 0xca, 0x97, 0xc5, 0x53, 0x35,
+	0x74, 0x6b, 0x99, 0x76, 0x85, 0xad, 0xa3, 0xd9, 0xfd, 0x81, 0xaa, 0xc1, 0x44, 0x2b, 0x01, 0x62,
+	0x5f, 0x84, 0xa1, 0x60, 0x0e, 0xf5, 0x3c, 0xd5, 0x06, 0x72, 0x83, 0x45, 0x83, 0x50, 0xa5, 0x28,
+	0xd1, 0xbc, 0x65, 0xc5, 0x25, 0xea, 0x95, 0x25, 0x7f, 0xa1, 0x20, 0x88, 0xc8, 0x19, 0x89, 0x20,
+	0xfa, 0xbb, 0x02, 0xd1, 0x3b, 0xfb, 0x9c, 0x81, 0xbd, 0x81, 0xba, 0xc3, 0x3e, 0x9d, 0x6c, 0x9b,
+	0x25, 0x7c, 0x0b, 0xe3, 0xe6, 0x85, 0x8d, 0xfb, 0xe2, 0x8d, 0xd9, 0xed, 0xd3, 0xd4, 0x80, 0xf1,
+	0xe8, 0xd1, 0xa8, 0xa0, 0x77, 0x60, 0x67, 0x38, 0x08, 0xd1, 0x0e, 0x9d, 0xc4, 0x72, 0x39, 0xc2,
+	0x40, 0xdd, 0x44, 0x8c, 0xf3, 0x96, 0xf5, 0x0a, 0xe2, 0x96, 0x1c, 0x84, 0xe1, 0xba, 0xed, 0xb8,
+	0x15, 0xe6, 0xb2, 0x8a, 0x44, 0x38, 0x54, 0x6e, 0x4e, 0xa8, 0x1f, 0x29, 0x08, 0xb3, 0x71, 0x7a,
+	0x2a, 0xcc, 0xfe, 0x97, 0x82, 0xd9, 0x3b, 0x9f, 0xb8, 0x8f, 0x45, 0xcd, 0x92, 0xc9, 0xbd, 0x65,
+	0x66, 0x57, 0x4c, 0xdb, 0x08, 0xeb, 0x2d, 0xe3, 0x49, 0x34, 0x0e, 0x03, 0xb2, 0x70, 0x93, 0xa7,
+	0xef, 0x2a, 0xfb, 0x03, 0xf5, 0x43, 0x05, 0x0e, 0x26, 0x33, 0x7c, 0x55, 0xaa, 0x50, 0x61, 0xa7,
+	0xe7, 0x78, 0xd4, 0xc2, 0xc3, 0xd0, 0xef, 0x22, 0x73, 0xea, 0x12, 0x0a, 0x55, 0xa6, 0x1e, 0x5b,
+	0xf2, 0xab, 0xcd, 0xbb, 0x76, 0xad, 0xee, 0x85, 0x42, 0xc0, 0xc7, 0xa2, 0x84, 0xb0, 0x90, 0xfd,
+	0x30, 0xf8, 0xcc, 0xb4, 0x2b, 0xce, 0x33, 0xc9, 0xb3, 0xbf, 0x8c, 0x23, 0xf5, 0xc7, 0xfd, 0x70,
+	0x28, 0x85, 0x1d, 0x82, 0xdc, 0x0f, 0x83, 0x55, 0x66, 0x1a, 0x55, 0x0f, 0x95, 0x86, 0x23, 0x72,
+	0x1f, 0x76, 0x8a, 0x32, 0x9c, 0x6b, 0x6b, 0x26, 0xe7, 0xd2, 0x83, 0x3a, 0x06, 0x3f, 0x22, 0x19,
+	0xdc, 0x93, 0xf4, 0x64, 0x19, 0x76, 0xf9, 0xfc, 0x6a, 0x08, 0xbe, 0xbf, 0x0b, 0x6d, 0x4a, 0x0e,
+	0xa8, 0x29, 0x72, 0x0c, 0x76, 0x49, 0xcd, 0x35, 0x38, 0x6e, 0x6f, 0x55, 0x27, 0x99, 0x86, 0xdd,
+	0x35, 0xca, 0x3d, 0xcd, 0x3f, 0xfb, 0x29, 0xb5, 0xea, 0x6c, 0x62, 0x40, 0x26, 0x8f, 0x51, 0x31,
+	0x2f, 0xec, 0xcd, 0xbf, 0x29, 0x66, 0x45, 0x51, 0x8c, 0x8c, 0x22, 0x9b, 0x07, 0xfd, 0xa2, 0xb8,
+	0xd6, 0xf4, 0x0f, 0xdc, 0x7f, 0x15, 0x0a, 0x96, 0xf3, 0x8c, 0x71, 0x4f, 0x0b, 0x93, 0x69, 0xa8,
+	0xcc, 0x1d, 0x52, 0x99, 0x07, 0xfc, 0x1d, 0x21, 0xe7, 0xba, 0x23, 0x97, 0xd5, 0x05, 0x38, 0x9d,
+	0xe4, 0x7a, 0x0f, 0x4d, 0xaf, 0x6a, 0xda, 0x0d, 0x5b, 0x65, 0xda, 0x5c, 0xfd, 0x6d, 0x1f, 0x9c,
+	0xc9, 0xc5, 0x04, 0x2d, 0xfd, 0x2e, 0x8c, 0x46, 0x7b, 0x39, 0x5d, 0x39, 0xb4, 0x1e, 0x76, 0xe8,
+	0x16, 0x13, 0x24, 0x78, 0x34, 0x99, 0x83, 0x03, 0x7a, 0xdd, 0x75, 0x99, 0xed, 0x69, 0xcf, 0x4c,
+	0xaf, 0x5a, 0x71, 0xe9, 0x33, 0x0d, 0x9d, 0xb5, 0x5f, 0x6a, 0x69, 0x1f, 0x2e, 0x3f, 0xc4, 0xd5,
+	0x87, 0x72, 0x91, 0xcc, 0xc2, 0xbe, 0x16, 0x3a, 0x97, 0x7a, 0x4c, 0xda, 0x79, 0xb8, 0xbc, 0x37,
+	0x46, 0x25, 0x00, 0x0b, 0x23, 0x36, 0xfb, 0x34, 0x1a, 0x5b, 0xd7, 0x19, 0xab, 0xb0, 0x8a, 0xb4,
+	0xf8, 0x50, 0x79, 0x8f, 0x1b, 0xe8, 0xe4, 0x26, 0x2e, 0x34, 0xda, 0x28, 0x4b, 0x94, 0x7b, 0x8f,
+	0x98, 0x47, 0x7d, 0xf3, 0x04, 0x6d, 0x94, 0x0b, 0x41, 0xc6, 0x89, 0xad, 0x36, 0x43, 0xe7, 0x4e,
+	0x24, 0x74, 0xd0, 0xb8, 0x2b, 0x18, 0xc2, 0x8b, 0x8e, 0xfd, 0x94, 0xb9, 0xe2, 0xbd, 0xb0, 0xe2,
+	0x08, 0xf2, 0x96, 0x1b, 0xa9, 0x25, 0x51, 0x15, 0x60, 0xc8, 0xa0, 0x7c, 0xa9, 0x91, 0xab, 0x86,
+	0xcb, 0x8d, 0xb1, 0xfa, 0x33, 0x05, 0x43, 0xb9, 0x95, 0x2d, 0xca, 0xf3, 0x7f, 0xb0, 0x27, 0xa8,
+	0x4c, 0x6f, 0x53, 0x7e, 0xd7, 0x16, 0x8b, 0x41, 0x53, 0xa7, 0x65, 0x41, 0xec, 0x96, 0xad, 0x24,
+	0xdd, 0xb1, 0x6e, 0x31, 0x86, 0xbb, 0xfb, 0xd0, 0xdb, 0xe3, 0x0b, 0x64, 0x1a, 0xc6, 0xc4, 0xdf,
+	0x05, 0xcb, 0xd1, 0x9f, 0x20, 0xe8, 0x7e, 0x69, 0xeb, 0xf8, 0xb4, 0x3a, 0x85, 0x65, 0xe3, 0x3d,
+	0xc6, 0x39, 0x35, 0xd8, 0x32, 0xe5, 0xdc, 0xb4, 0x8d, 0xe5, 0x26, 0xc7, 0x40, 0xbb, 0xb7, 0xb0,
+	0x7e, 0xcf, 0xd8, 0x88, 0xc0, 0x0e, 0xc2, 0xf0, 0xe3, 0x86, 0x88, 0x3e, 0xa0, 0xe6, 0x84, 0x3a,
+	0xd9, 0x9a, 0x31, 0x6f, 0x59, 0xd4, 0x08, 0xca, 0x3a, 0xf5, 0x03, 0xa5, 0x35, 0x07, 0xe2, 0x06,
+	0xe4, 0x4f, 0x61, 0xb7, 0x1b, 0x5b, 0xc3, 0x8b, 0xb7, 0xd4, 0x26, 0x36, 0xe2, 0x2c, 0xf1, 0xe9,
+	0xda, 0xc2, 0x4e, 0x5d, 0x46, 0x47, 0x8b, 0xd6, 0x6f, 0x39, 0xee, 0xae, 0x03, 0xb0, 0x43, 0x64,
+	0x15, 0x51, 0x87, 0xf8, 0xc6, 0x19, 0xf4, 0xd6, 0x65, 0x09, 0xb2, 0x89, 0xce, 0x19, 0xe7, 0x88,
+	0x98, 0xbe, 0x0d, 0x63, 0xb1, 0xe6, 0x28, 0x42, 0xea, 0x45, 0x85, 0xa9, 0x7e, 0xdc, 0x87, 0xa7,
+	0x3f, 0x30, 0xd7, 0xea, 0x16, 0xf5, 0x18, 0x52, 0x05, 0x80, 0x4e, 0xc2, 0x18, 0x67, 0x76, 0x85,
+	0xb9, 0x5a, 0x0c, 0xd7, 0x2e, 0x7f, 0x7a, 0x11, 0xd1, 0xed, 0x87, 0x41, 0x7f, 0x22, 0x00, 0xe7,
+	0x8f, 0x44, 0x20, 0xb8, 0x4c, 0x67, 0xe6, 0x53, 0xe6, 0x4a, 0x3f, 0x1b, 0x2e, 0x37, 0xc6, 0x64,
+	0x01, 0x86, 0x75, 0x47, 0x24, 0xb0, 0x8d, 0x9a, 0x9f, 0x0b, 0x46, 0x67, 0x4f, 0x24, 0x61, 0xaa,
+	0x3d, 0x31, 0x8a, 0xb2, 0xfd, 0xba, 0xe8, 0x98, 0xf6, 0xca, 0x46, 0x8d, 0x95, 0x87, 0x74, 0xfc,
+	0x25, 0x32, 0x2a, 0xe5, 0x9c, 0x79, 0x78, 0x17, 0xf8, 0x03, 0x21, 0x0d, 0xb6, 0x42, 0xfd, 0xac,
+	0x8f, 0x23, 0x11, 0xb0, 0x6b, 0xbe, 0x93, 0xca, 0xbc, 0x3e, 0x5c, 0x0e, 0x86, 0x64, 0x06, 0xf6,
+	0x9a, 0x5c, 0x0b, 0xa7, 0x55, 0x9d, 0x5a, 0xd6, 0xc4, 0x90, 0xcc, 0x37, 0xbb, 0x4d, 0xde, 0xcc,
+	0x9d, 0x8b, 0xd4, 0xb2, 0xd4, 0xff, 0xf6, 0xa1, 0xaf, 0xb6, 0xa8, 0x2d, 0x23, 0x47, 0x2b, 0x2f,
+	0x97, 0xa3, 0xe7, 0x61, 0x90, 0x7b, 0xd4, 0xab, 0x73, 0xa9, 0xe2, 0xd1, 0xd9, 0x53, 0xed, 0x58,
+	0xe9, 0xde, 0xfa, 0x03, 0x49, 0x50, 0x46, 0x42, 0x91, 0xe6, 0x5d, 0x26, 0x72, 0x8e, 0xe6, 0x32,
+	0xca, 0x1d, 0x1b, 0x4d, 0xb2, 0xd3, 0x9f, 0x2c, 0xcb, 0x39, 0xe1, 0xc3, 0x06, 0xe5, 0x5a, 0x5d,
+	0x3c, 0x16, 0xfc, 0x9b, 0x78, 0x87, 0x41, 0xf9, 0x37, 0xc4, 0xdd, 0x7f, 0x14, 0x76, 0x36, 0x32,
+	0xf8, 0x63, 0x16, 0x5c, 0xc0, 0x23, 0xc1, 0xdc, 0x2d, 0xc6, 0x88, 0x0e, 0xe3, 0x81, 0x81, 0x35,
+	0x5e, 0xb3, 0x4c, 0xbc, 0xb1, 0x27, 0x06, 0xbb, 0xad, 0x98, 0x49, 0xc0, 0xee, 0x81, 0xe0, 0x26,
+	0xef, 0x6d, 0xf5, 0x32, 0xbe, 0x79, 0xc5, 0x68, 0xc5, 0x6d, 0x64, 0x22, 0x21, 0x5f, 0xf8, 0x13,
+	0x44, 0x52, 0xc1, 0x5f, 0x83, 0x7d, 0x31, 0xd2, 0x57, 0x5c, 0xe3, 0xcf, 0xfe, 0xe7, 0x38, 0x0c,
+	0xc8, 0x23, 0xc9, 0x17, 0x0a, 0x8c, 0xc5, 0x3a, 0x91, 0xe4, 0xad, 0x36, 0xfc, 0xb3, 0xfb, 0xf5,
+	0x85, 0xb7, 0xbb, 0x25, 0xf7, 0x51, 0xab, 0xd7, 0x3f, 0xf8, 0xeb, 0x3f, 0x3f, 0xec, 0xbb, 0x42,
+	0x2e, 0xc9, 0x4f, 0x1e, 0x33, 0xa1, 0x2f, 0x45, 0xd1, 0x4f, 0x2c, 0x48, 0x57, 0xda, 0xc4, 0x9a,
+	0x6b, 0xab, 0xb4, 0x29, 0xab, 0xac, 0x2d, 0xf2, 0x7b, 0x05, 0x48, 0x8c, 0xfb, 0xbc, 0x65, 0xe5,
+	0xc3, 0x95, 0xda, 0xb1, 0xcf, 0x87, 0x2b, 0xbd, 0x0b, 0xaf, 0x16, 0x25, 0xae, 0x69, 0x72, 0x32,
+	0x1f, 0x2e, 0xf2, 0x95, 0x02, 0xaf, 0xb5, 0xa2, 0xc0, 0x06, 0x29, 0xb9, 0xd1, 0x9d, 0x34, 0xd1,
+	0x5e, 0x6f, 0xe1, 0xe6, 0x4b, 0x72, 0x41, 0x68, 0x6f, 0x49, 0x68, 0x17, 0xc9, 0x85, 0x7c, 0xd0,
+	0x90, 0x1c, 0x2d, 0xb7, 0x45, 0xfe, 0xa5, 0xc0, 0x44, 0xf4, 0x6a, 0x08, 0x01, 0x5d, 0xcc, 0x29,
+	0x62, 0x56, 0x4f, 0xbb, 0x70, 0xe3, 0xe5, 0x98, 0x20, 0xcc, 0x6b, 0x12, 0xe6, 0x65, 0x72, 0x31,
+	0x05, 0xa6, 0x69, 0xa7, 0xa3, 0xd4, 0xcc, 0xca, 0x16, 0xf9, 0x9d, 0x02, 0x7b, 0x5a, 0x80, 0xe6,
+	0xf6, 0xcb, 0xe4, 0xd6, 0x72, 0x6e, 0xbf, 0x4c, 0x69, 0x17, 0xb7, 0xf5, 0xcb, 0x28, 0x2a, 0x4e,
+	0x3e, 0x53, 0x60, 0x34, 0xca, 0x8b, 0x5c, 0xce, 0x23, 0x42, 0xe2, 0xf3, 0xa4, 0x70, 0xa5, 0x1b,
+	0x52, 0x94, 0x7c, 0x41, 0x4a, 0xfe, 0x26, 0xb9, 0x92, 0x4b, 0xf2, 0x90, 0x21, 0x4a, 0x9b, 0xf8,
+	0xee, 0xd9, 0x22, 0x7f, 0x6b, 0x9a, 0x24, 0xd4, 0x0c, 0xbc, 0x96, 0x33, 0x87, 0xa5, 0x75, 0x48,
+	0x0b, 0xd7, 0xbb, 0x67, 0x80, 0xe0, 0xde, 0x96, 0xe0, 0x2e, 0x91, 0xb9, 0x6c, 0x70, 0x4d, 0xca,
+	0xd2, 0x66, 0x68, 0x6a, 0x8b, 0x7c, 0xa9, 0xc0, 0xbe, 0xc4, 0x16, 0x32, 0xb9, 0xde, 0x81, 0xca,
+	0x13, 0x9b, 0xd8, 0x85, 0xf9, 0x97, 0xe0, 0xd0, 0x99, 0xed, 0xa2, 0xd4, 0x31, 0x88, 0x9f, 0x29,
+	0x30, 0xde, 0x72, 0x8a, 0x88, 0xa8, 0x6b, 0x9d, 0x85, 0x44, 0x97, 0xe6, 0xcb, 0x6a, 0x5a, 0xab,
+	0x67, 0x25, 0xbe, 0xd3, 0x64, 0x3a, 0x2f, 0x3e, 0xf2, 0x4b, 0xa5, 0xd9, 0x26, 0x25, 0x73, 0x39,
+	0xfd, 0x27, 0xd6, 0xcf, 0x2d, 0x5c, 0xec, 0x98, 0x0e, 0xe5, 0x2d, 0x49, 0x79, 0x4f, 0x91, 0xa9,
+	0x14, 0x79, 0x0d, 0x24, 0x10, 0x26, 0xa8, 0xb0, 0xf5, 0x2d, 0xf2, 0x73, 0x05, 0x46, 0x02, 0x2e,
+	0x42, 0xe7, 0x73, 0x39, 0x55, 0xd6, 0x95, 0xc4, 0x09, 0x5d, 0x65, 0x75, 0x4a, 0x4a, 0x7c, 0x94,
+	0x1c, 0x6e, 0x23, 0x31, 0xf9, 0x8d, 0x02, 0xbb, 0xe3, 0x85, 0x2d, 0xb9, 0x9a, 0xe7, 0xd8, 0x94,
+	0x2a, 0xbb, 0xf0, 0x66, 0x77, 0xc4, 0x39, 0x55, 0xad, 0xc7, 0x65, 0xfd, 0x83, 0x02, 0x23, 0xa1,
+	0xda, 0x35, 0xdf, 0xdd, 0xdf, 0xae, 0x46, 0xce, 0x77, 0xf7, 0xb7, 0x2d, 0xa0, 0xd5, 0xd3, 0x12,
+	0xcd, 0x71, 0xa2, 0xa6, 0xa0, 0x09, 0xd5, 0xfb, 0xe4, 0xa7, 0x0a, 0x6c, 0x97, 0xbe, 0x3e, 0x9b,
+	0xd3, 0x4d, 0xc3, 0x31, 0x79, 0xbe, 0x23, 0x1a, 0x94, 0xee, 0x8c, 0x94, 0xee, 0x04, 0x39, 0x96,
+	0xa6, 0x6b, 0x4c, 0x9c, 0xd2, 0xa5, 0x3f, 0x56, 0x60, 0x24, 0xd4, 0xe5, 0xcf, 0x77, 0xad, 0x25,
+	0x7e, 0x19, 0xe8, 0x4e, 0xd8, 0x0b, 0x52, 0xd8, 0x12, 0x99, 0xc9, 0x14, 0xb6, 0xe5, 0xb9, 0xfb,
+	0x13, 0x05, 0x76, 0x04, 0x99, 0x6f, 0x36, 0x67, 0x34, 0x75, 0xac, 0xd8, 0x58, 0x2f, 0x5f, 0x3d,
+	0x26, 0x65, 0x3d, 0x44, 0x5e, 0xcf, 0x90, 0x95, 0x7c, 0xa2, 0xc0, 0x58, 0xac, 0x83, 0x48, 0x72,
+	0x5d, 0xf8, 0xc9, 0x7d, 0xf8, 0xc2, 0xd5, 0xae, 0x68, 0xf3, 0x3a, 0x6a, 0x48, 0xc8, 0x7f, 0x2b,
+	0x30, 0x99, 0xdd, 0xfa, 0x24, 0x77, 0xbb, 0x90, 0x25, 0xb9, 0x07, 0x5b, 0xf8, 0xff, 0x5e, 0xb0,
+	0x42, 0x94, 0x97, 0x25, 0xca, 0xf3, 0xe4, 0x5c, 0x7b, 0x94, 0x71, 0x44, 0x9f, 0x28, 0x30, 0x1a,
+	0xfd, 0xaf, 0xae, 0x7c, 0x11, 0x90, 0xf8, 0x7f, 0x62, 0xf9, 0x1e, 0x76, 0xc9, 0xff, 0x44, 0xa6,
+	0xce, 0x48, 0x10, 0x53, 0xe4, 0x44, 0x0a, 0x88, 0xf7, 0xa3, 0x52, 0x0a, 0xc1, 0xa3, 0x7d, 0xd4,
+	0x7c, 0x82, 0x27, 0x76, 0x66, 0xf3, 0x09, 0x9e, 0xdc, 0xb6, 0x6d, 0x2b, 0xb8, 0x15, 0x95, 0x52,
+	0xdc, 0x4c, 0xf1, 0x36, 0x5f, 0xbe, 0x9b, 0x29, 0xa5, 0x21, 0x99, 0xef, 0x66, 0x4a, 0x6b, 0x56,
+	0xb6, 0xbd, 0x99, 0xe2, 0xad, 0xc7, 0x38, 0x00, 0xf9, 0xf9, 0xa7, 0x63, 0x00, 0xe1, 0x6f, 0x50,
+	0x1d, 0x03, 0x88, 0x7c, 0x71, 0xea, 0x04, 0x80, 0x2f, 0xeb, 0xaf, 0x15, 0x18, 0x8b, 0x35, 0xcc,
+	0xf2, 0x65, 0xa8, 0xe4, 0xe6, 0x64, 0xbe, 0x0c, 0x95, 0xd2, 0xa1, 0x6b, 0x5b, 0x89, 0xf1, 0x98,
+	0xa0, 0xbf, 0x52, 0x60, 0x28, 0x68, 0x1a, 0x91, 0x5c, 0x59, 0x3c, 0xd6, 0x9d, 0x2a, 0xbc, 0xd1,
+	0x19, 0x11, 0xca, 0x39, 0x27, 0xe5, 0x3c, 0x4b, 0x8a, 0x19, 0xb9, 0x5f, 0x10, 0x34, 0x9e, 0xeb,
+	0x7e, 0xad, 0x55, 0x18, 0xf8, 0xde, 0x57, 0x1f, 0x9d, 0x56, 0x16, 0x6e, 0x7f, 0xfa, 0x7c, 0x52,
+	0xf9, 0xfc, 0xf9, 0xa4, 0xf2, 0xe5, 0xf3, 0x49, 0xe5, 0x47, 0x2f, 0x26, 0xb7, 0x7d, 0xfe, 0x62,
+	0x72, 0xdb, 0xdf, 0x5f, 0x4c, 0x6e, 0x7b, 0x34, 0x63, 0x98, 0x5e, 0xb5, 0xbe, 0x5a, 0xd4, 0x9d,
+	0xb5, 0x30, 0x6b, 0xdb, 0xa9, 0xb0, 0xd2, 0x7a, 0xf8, 0x04, 0x6f, 0xa3, 0xc6, 0xf8, 0xea, 0xa0,
+	0x7c, 0x5b, 0x9c, 0xff, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xba, 0x6a, 0xc5, 0xd3, 0xdc, 0x2c,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2373,6 +2678,12 @@ type QueryClient interface {
 	RateLimiterFlags(ctx context.Context, in *QueryRateLimiterFlagsRequest, opts ...grpc.CallOption) (*QueryRateLimiterFlagsResponse, error)
 	// Queries the input data of rate limiter.
 	RateLimiterInput(ctx context.Context, in *QueryRateLimiterInputRequest, opts ...grpc.CallOption) (*QueryRateLimiterInputResponse, error)
+	// Simulates the processing of an inbound on ZetaChain without changing the
+	// state.
+	SimulateInbound(ctx context.Context, in *QuerySimulateInboundRequest, opts ...grpc.CallOption) (*QuerySimulateInboundResponse, error)
+	// Queries the tree of cctxs created from an inbound, including the cctxs
+	// created from the ZEVM execution of other cctxs of the tree.
+	CctxTree(ctx context.Context, in *QueryCctxTreeRequest, opts ...grpc.CallOption) (*QueryCctxTreeResponse, error)
 }
 
 type queryClient struct {
@@ -2581,6 +2892,24 @@ func (c *queryClient) RateLimiterInput(ctx context.Context, in *QueryRateLimiter
 	return out, nil
 }
 
+func (c *queryClient) SimulateInbound(ctx context.Context, in *QuerySimulateInboundRequest, opts ...grpc.CallOption) (*QuerySimulateInboundResponse, error) {
+	out := new(QuerySimulateInboundResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/SimulateInbound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CctxTree(ctx context.Context, in *QueryCctxTreeRequest, opts ...grpc.CallOption) (*QueryCctxTreeResponse, error) {
+	out := new(QueryCctxTreeResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Queries a outbound tracker by index.
@@ -2620,6 +2949,12 @@ type QueryServer interface {
 	RateLimiterFlags(context.Context, *QueryRateLimiterFlagsRequest) (*QueryRateLimiterFlagsResponse, error)
 	// Queries the input data of rate limiter.
 	RateLimiterInput(context.Context, *QueryRateLimiterInputRequest) (*QueryRateLimiterInputResponse, error)
+	// Simulates the processing of an inbound on ZetaChain without changing the
+	// state.
+	SimulateInbound(context.Context, *QuerySimulateInboundRequest) (*QuerySimulateInboundResponse, error)
+	// Queries the tree of cctxs created from an inbound, including the cctxs
+	// created from the ZEVM execution of other cctxs of the tree.
+	CctxTree(context.Context, *QueryCctxTreeRequest) (*QueryCctxTreeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2692,6 +3027,12 @@ func (*UnimplementedQueryServer) RateLimiterFlags(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) RateLimiterInput(ctx context.Context, req *QueryRateLimiterInputRequest) (*QueryRateLimiterInputResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RateLimiterInput not implemented")
 }
+func (*UnimplementedQueryServer) SimulateInbound(ctx context.Context, req *QuerySimulateInboundRequest) (*QuerySimulateInboundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateInbound not implemented")
+}
+func (*UnimplementedQueryServer) CctxTree(ctx context.Context, req *QueryCctxTreeRequest) (*QueryCctxTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CctxTree not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -3093,6 +3434,42 @@ func _Query_RateLimiterInput_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SimulateInbound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateInboundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateInbound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/SimulateInbound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateInbound(ctx, req.(*QuerySimulateInboundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CctxTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCctxTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CctxTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CctxTree(ctx, req.(*QueryCctxTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "zetachain.zetacore.crosschain.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -3185,6 +3562,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RateLimiterInput",
 			Handler:    _Query_RateLimiterInput_Handler,
 		},
+		{
+			MethodName: "SimulateInbound",
+			Handler:    _Query_SimulateInbound_Handler,
+		},
+		{
+			MethodName: "CctxTree",
+			Handler:    _Query_CctxTree_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zetachain/zetacore/crosschain/query.proto",
@@ -4795,49 +5180,267 @@ func (m *QueryInboundTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryZetaAccountingRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateInboundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryZetaAccountingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AbortedZetaAmount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QuerySimulateInboundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetOutboundTrackerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySimulateInboundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChainID != 0 {
-		n += 1 + sovQuery(uint64(m.ChainID))
-	}
-	if m.Nonce != 0 {
+	if m.IsCrossChainCall {
+		i--
+		if m.IsCrossChainCall {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CoinType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CoinType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SenderChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SenderChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateInboundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateInboundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateInboundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReceiverSplitCctxs) > 0 {
+		for iNdEx := len(m.ReceiverSplitCctxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReceiverSplitCctxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.WithdrawFee) > 0 {
+		i -= len(m.WithdrawFee)
+		copy(dAtA[i:], m.WithdrawFee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawFee)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RevertReason) > 0 {
+		i -= len(m.RevertReason)
+		copy(dAtA[i:], m.RevertReason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RevertReason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CrossChainTx != nil {
+		{
+			size, err := m.CrossChainTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCctxTreeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCctxTreeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCctxTreeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InboundHash) > 0 {
+		i -= len(m.InboundHash)
+		copy(dAtA[i:], m.InboundHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InboundHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCctxTreeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCctxTreeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCctxTreeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CrossChainTxs) > 0 {
+		for iNdEx := len(m.CrossChainTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CrossChainTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryZetaAccountingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryZetaAccountingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AbortedZetaAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetOutboundTrackerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainID != 0 {
+		n += 1 + sovQuery(uint64(m.ChainID))
+	}
+	if m.Nonce != 0 {
 		n += 1 + sovQuery(uint64(m.Nonce))
 	}
 	return n
@@ -5458,74 +6061,173 @@ func (m *QueryInboundTrackerResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *QueryZetaAccountingRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryZetaAccountingRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryZetaAccountingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
+func (m *QuerySimulateInboundRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+	var l int
+	_ = l
+	if m.SenderChainId != 0 {
+		n += 1 + sovQuery(uint64(m.SenderChainId))
 	}
-	return nil
-}
-func (m *QueryZetaAccountingResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CoinType != 0 {
+		n += 1 + sovQuery(uint64(m.CoinType))
+	}
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IsCrossChainCall {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuerySimulateInboundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CrossChainTx != nil {
+		l = m.CrossChainTx.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = len(m.RevertReason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	l = len(m.WithdrawFee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.ReceiverSplitCctxs) > 0 {
+		for _, e := range m.ReceiverSplitCctxs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCctxTreeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InboundHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCctxTreeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CrossChainTxs) > 0 {
+		for _, e := range m.CrossChainTxs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryZetaAccountingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryZetaAccountingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryZetaAccountingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryZetaAccountingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
@@ -9585,6 +10287,662 @@ func (m *QueryInboundTrackerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySimulateInboundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateInboundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateInboundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderChainId", wireType)
+			}
+			m.SenderChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SenderChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinType", wireType)
+			}
+			m.CoinType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoinType |= coin.CoinType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsCrossChainCall", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsCrossChainCall = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateInboundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateInboundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateInboundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CrossChainTx == nil {
+				m.CrossChainTx = &CrossChainTx{}
+			}
+			if err := m.CrossChainTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= CctxStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevertReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevertReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverSplitCctxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverSplitCctxs = append(m.ReceiverSplitCctxs, CrossChainTx{})
+			if err := m.ReceiverSplitCctxs[len(m.ReceiverSplitCctxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCctxTreeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCctxTreeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCctxTreeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCctxTreeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCctxTreeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCctxTreeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CrossChainTxs = append(m.CrossChainTxs, CrossChainTx{})
+			if err := m.CrossChainTxs[len(m.CrossChainTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1139,6 +1139,13 @@ type MsgVoteInbound struct {
 	// error_message carries information about the error that caused non-SUCCESS
 	// status of inbound observation
 	ErrorMessage string `protobuf:"bytes,22,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// deadline is the unix timestamp (seconds) after which the inbound must not
+	// be executed, the CCTX is reverted instead. zero means no deadline
+	Deadline uint64 `protobuf:"varint,23,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// receiver_splits are parts of the amount deposited to other receivers on
+	// ZetaChain, each through a child CCTX. The receiver gets the remaining
+	// amount
+	ReceiverSplits []ReceiverSplit `protobuf:"bytes,24,rep,name=receiver_splits,json=receiverSplits,proto3" json:"receiver_splits"`
 }
 
 func (m *MsgVoteInbound) Reset()         { *m = MsgVoteInbound{} }
@@ -1314,6 +1321,67 @@ func (m *MsgVoteInbound) GetErrorMessage() string {
 	return ""
 }
 
+func (m *MsgVoteInbound) GetDeadline() uint64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+func (m *MsgVoteInbound) GetReceiverSplits() []ReceiverSplit {
+	if m != nil {
+		return m.ReceiverSplits
+	}
+	return nil
+}
+
+// ReceiverSplit is a part of the amount of an inbound deposited to another
+// receiver than the inbound receiver
+type ReceiverSplit struct {
+	Receiver string                 `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Amount   cosmossdk_io_math.Uint `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Uint" json:"amount"`
+}
+
+func (m *ReceiverSplit) Reset()         { *m = ReceiverSplit{} }
+func (m *ReceiverSplit) String() string { return proto.CompactTextString(m) }
+func (*ReceiverSplit) ProtoMessage()    {}
+func (*ReceiverSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15f0860550897740, []int{19}
+}
+func (m *ReceiverSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiverSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReceiverSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReceiverSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiverSplit.Merge(m, src)
+}
+func (m *ReceiverSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiverSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiverSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiverSplit proto.InternalMessageInfo
+
+func (m *ReceiverSplit) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
 type MsgVoteInboundResponse struct {
 }
 
@@ -1321,7 +1389,7 @@ func (m *MsgVoteInboundResponse) Reset()         { *m = MsgVoteInboundResponse{}
 func (m *MsgVoteInboundResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteInboundResponse) ProtoMessage()    {}
 func (*MsgVoteInboundResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15f0860550897740, []int{19}
+	return fileDescriptor_15f0860550897740, []int{20}
 }
 func (m *MsgVoteInboundResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1359,7 +1427,7 @@ func (m *MsgAbortStuckCCTX) Reset()         { *m = MsgAbortStuckCCTX{} }
 func (m *MsgAbortStuckCCTX) String() string { return proto.CompactTextString(m) }
 func (*MsgAbortStuckCCTX) ProtoMessage()    {}
 func (*MsgAbortStuckCCTX) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15f0860550897740, []int{20}
+	return fileDescriptor_15f0860550897740, []int{21}
 }
 func (m *MsgAbortStuckCCTX) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1409,7 +1477,7 @@ func (m *MsgAbortStuckCCTXResponse) Reset()         { *m = MsgAbortStuckCCTXResp
 func (m *MsgAbortStuckCCTXResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAbortStuckCCTXResponse) ProtoMessage()    {}
 func (*MsgAbortStuckCCTXResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15f0860550897740, []int{21}
+	return fileDescriptor_15f0860550897740, []int{22}
 }
 func (m *MsgAbortStuckCCTXResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1448,7 +1516,7 @@ func (m *MsgRefundAbortedCCTX) Reset()         { *m = MsgRefundAbortedCCTX{} }
 func (m *MsgRefundAbortedCCTX) String() string { return proto.CompactTextString(m) }
 func (*MsgRefundAbortedCCTX) ProtoMessage()    {}
 func (*MsgRefundAbortedCCTX) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15f0860550897740, []int{22}
+	return fileDescriptor_15f0860550897740, []int{23}
 }
 func (m *MsgRefundAbortedCCTX) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1505,7 +1573,7 @@ func (m *MsgRefundAbortedCCTXResponse) Reset()         { *m = MsgRefundAbortedCC
 func (m *MsgRefundAbortedCCTXResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRefundAbortedCCTXResponse) ProtoMessage()    {}
 func (*MsgRefundAbortedCCTXResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15f0860550897740, []int{23}
+	return fileDescriptor_15f0860550897740, []int{24}
 }
 func (m *MsgRefundAbortedCCTXResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1543,7 +1611,7 @@ func (m *MsgUpdateRateLimiterFlags) Reset()         { *m = MsgUpdateRateLimiterF
 func (m *MsgUpdateRateLimiterFlags) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateRateLimiterFlags) ProtoMessage()    {}
 func (*MsgUpdateRateLimiterFlags) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15f0860550897740, []int{24}
+	return fileDescriptor_15f0860550897740, []int{25}
 }
 func (m *MsgUpdateRateLimiterFlags) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1593,7 +1661,7 @@ func (m *MsgUpdateRateLimiterFlagsResponse) Reset()         { *m = MsgUpdateRate
 func (m *MsgUpdateRateLimiterFlagsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateRateLimiterFlagsResponse) ProtoMessage()    {}
 func (*MsgUpdateRateLimiterFlagsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15f0860550897740, []int{25}
+	return fileDescriptor_15f0860550897740, []int{26}
 }
 func (m *MsgUpdateRateLimiterFlagsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1642,6 +1710,7 @@ func init() {
 	proto.RegisterType((*MsgVoteOutbound)(nil), "zetachain.zetacore.crosschain.MsgVoteOutbound")
 	proto.RegisterType((*MsgVoteOutboundResponse)(nil), "zetachain.zetacore.crosschain.MsgVoteOutboundResponse")
 	proto.RegisterType((*MsgVoteInbound)(nil), "zetachain.zetacore.crosschain.MsgVoteInbound")
+	proto.RegisterType((*ReceiverSplit)(nil), "zetachain.zetacore.crosschain.ReceiverSplit")
 	proto.RegisterType((*MsgVoteInboundResponse)(nil), "zetachain.zetacore.crosschain.MsgVoteInboundResponse")
 	proto.RegisterType((*MsgAbortStuckCCTX)(nil), "zetachain.zetacore.crosschain.MsgAbortStuckCCTX")
 	proto.RegisterType((*MsgAbortStuckCCTXResponse)(nil), "zetachain.zetacore.crosschain.MsgAbortStuckCCTXResponse")
@@ -1656,126 +1725,129 @@ func init() {
 }
 
 var fileDescriptor_15f0860550897740 = []byte{
-	// 1890 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
-	0x15, 0xf7, 0xca, 0x12, 0x45, 0x3e, 0x92, 0xb2, 0xbc, 0xd6, 0x07, 0xbd, 0x8a, 0xa8, 0x8f, 0xd4,
-	0x86, 0x61, 0xd8, 0xa4, 0x23, 0xa7, 0x4a, 0xea, 0x14, 0x6e, 0x2d, 0xb6, 0x76, 0x0d, 0x84, 0xb1,
-	0xb0, 0x91, 0xd3, 0x36, 0x08, 0xba, 0x58, 0xee, 0x8e, 0x56, 0x0b, 0x91, 0x3b, 0xdb, 0x9d, 0x21,
-	0x41, 0x05, 0x05, 0x5a, 0x04, 0x2d, 0x50, 0xa0, 0x40, 0x3f, 0x80, 0xfe, 0x0b, 0x05, 0x7a, 0x29,
-	0x90, 0x63, 0xff, 0x84, 0xa0, 0xe8, 0x21, 0xc7, 0xa2, 0x07, 0xa3, 0xb0, 0x0f, 0xb9, 0xf5, 0xd0,
-	0xbf, 0xa0, 0x98, 0x37, 0xb3, 0x2b, 0x72, 0xf9, 0x29, 0xa6, 0x06, 0x7a, 0x11, 0x77, 0xde, 0xbe,
-	0xdf, 0x7b, 0x6f, 0xde, 0xd7, 0xbc, 0x59, 0xc1, 0xcd, 0x4f, 0x09, 0xb7, 0x9d, 0x13, 0xdb, 0x0f,
-	0xaa, 0xf8, 0x44, 0x23, 0x52, 0x75, 0x22, 0xca, 0x98, 0xa4, 0xf1, 0x6e, 0x25, 0x8c, 0x28, 0xa7,
-	0xfa, 0x66, 0xc2, 0x57, 0x89, 0xf9, 0x2a, 0xe7, 0x7c, 0xc6, 0x8a, 0x47, 0x3d, 0x8a, 0x9c, 0x55,
-	0xf1, 0x24, 0x41, 0xc6, 0xed, 0x21, 0xc2, 0xc3, 0x53, 0xaf, 0x8a, 0x24, 0xa6, 0x7e, 0x14, 0xef,
-	0xcd, 0x51, 0xbc, 0xd4, 0x0f, 0xf0, 0xcf, 0x04, 0x99, 0x61, 0x44, 0xe9, 0x31, 0x53, 0x3f, 0x8a,
-	0x77, 0x7f, 0xfc, 0xe6, 0x22, 0x9b, 0x13, 0xab, 0xe9, 0xb7, 0x7c, 0x4e, 0x22, 0xeb, 0xb8, 0x69,
-	0x7b, 0x31, 0x6e, 0x6f, 0x3c, 0x0e, 0x1f, 0x2d, 0x7c, 0xb6, 0x62, 0x07, 0x19, 0xeb, 0x0e, 0x65,
-	0x2d, 0xca, 0xaa, 0x2d, 0xe6, 0x55, 0x3b, 0x6f, 0x89, 0x1f, 0xf9, 0x62, 0xf7, 0x77, 0x1a, 0xe8,
-	0x75, 0xe6, 0xd5, 0x7d, 0x4f, 0xe8, 0x3b, 0x62, 0xec, 0x71, 0x3b, 0x70, 0x99, 0x5e, 0x82, 0x45,
-	0x27, 0x22, 0x36, 0xa7, 0x51, 0x49, 0xdb, 0xd6, 0x6e, 0xe5, 0xcc, 0x78, 0xa9, 0x5f, 0x87, 0xac,
-	0x94, 0xed, 0xbb, 0xa5, 0xb9, 0x6d, 0xed, 0xd6, 0x65, 0x73, 0x11, 0xd7, 0x4f, 0x5d, 0x7d, 0x1f,
-	0x32, 0x76, 0x8b, 0xb6, 0x03, 0x5e, 0xba, 0x2c, 0x30, 0x07, 0xe5, 0x2f, 0x5e, 0x6c, 0x5d, 0xfa,
-	0xe7, 0x8b, 0xad, 0x35, 0xa9, 0x9c, 0xb9, 0xa7, 0x15, 0x9f, 0x56, 0x5b, 0x36, 0x3f, 0xa9, 0x3c,
-	0xf7, 0x03, 0x6e, 0x2a, 0xee, 0x07, 0x85, 0xcf, 0xbe, 0xfa, 0xfc, 0x76, 0xac, 0x60, 0xf7, 0x0d,
-	0x30, 0x06, 0x0d, 0x32, 0x09, 0x0b, 0x69, 0xc0, 0xc8, 0xee, 0x27, 0x70, 0xad, 0xce, 0xbc, 0xe7,
-	0xa1, 0x2b, 0x5f, 0x3e, 0x72, 0xdd, 0x88, 0xb0, 0x71, 0xf6, 0x6e, 0x02, 0x70, 0xc6, 0xac, 0xb0,
-	0xdd, 0x38, 0x25, 0x67, 0x68, 0x71, 0xce, 0xcc, 0x71, 0xc6, 0x0e, 0x91, 0x90, 0xd2, 0xbd, 0x09,
-	0x1b, 0x43, 0xa4, 0x27, 0xca, 0xff, 0x3a, 0x07, 0x2b, 0x75, 0xe6, 0x3d, 0x72, 0xdd, 0xa7, 0x41,
-	0x83, 0xb6, 0x03, 0xf7, 0x28, 0xb2, 0x9d, 0x53, 0x12, 0xcd, 0xe6, 0xae, 0x75, 0x58, 0xe4, 0x5d,
-	0xeb, 0xc4, 0x66, 0x27, 0xd2, 0x5f, 0x66, 0x86, 0x77, 0x7f, 0x60, 0xb3, 0x13, 0xfd, 0x00, 0x72,
-	0x22, 0xa5, 0x2c, 0x7e, 0x16, 0x92, 0xd2, 0xfc, 0xb6, 0x76, 0x6b, 0x69, 0xef, 0x46, 0x65, 0x48,
-	0x86, 0x87, 0xa7, 0x5e, 0x05, 0x73, 0xaf, 0x46, 0xfd, 0xe0, 0xe8, 0x2c, 0x24, 0x66, 0xd6, 0x51,
-	0x4f, 0xfa, 0x43, 0x58, 0xc0, 0x64, 0x2b, 0x2d, 0x6c, 0x6b, 0xb7, 0xf2, 0x7b, 0xdf, 0x18, 0x85,
-	0x57, 0x19, 0x79, 0x28, 0x7e, 0x0e, 0xe6, 0x4a, 0x9a, 0x29, 0x61, 0xfa, 0x0e, 0x40, 0xa3, 0x49,
-	0x9d, 0x53, 0x69, 0x5f, 0x06, 0xe3, 0x29, 0x5e, 0xe7, 0x90, 0x8a, 0x66, 0x6e, 0x42, 0x96, 0x77,
-	0x2d, 0x3f, 0x70, 0x49, 0xb7, 0xb4, 0x28, 0xb6, 0x86, 0x0c, 0x8b, 0xbc, 0xfb, 0x54, 0x90, 0x52,
-	0x9e, 0x2d, 0xc3, 0x1b, 0xc3, 0x3c, 0x97, 0xb8, 0xb6, 0x0d, 0xeb, 0x75, 0xe6, 0x99, 0xa4, 0x45,
-	0x3b, 0xe4, 0x75, 0x3a, 0x37, 0x65, 0xd6, 0x0e, 0x6c, 0x8d, 0x50, 0x9b, 0x58, 0xf6, 0xa7, 0x39,
-	0xb8, 0x5a, 0x67, 0xde, 0x0f, 0x4f, 0x7c, 0x4e, 0x9a, 0x3e, 0xe3, 0x8f, 0x18, 0x23, 0x7c, 0x8c,
-	0x51, 0x6f, 0x42, 0xd1, 0x16, 0x2c, 0x96, 0x2d, 0xb3, 0x47, 0xe5, 0x5c, 0x01, 0x89, 0x71, 0xbe,
-	0xf6, 0x5a, 0x7e, 0xb9, 0xdf, 0x72, 0x1d, 0xe6, 0x03, 0xbb, 0x25, 0x03, 0x9f, 0x33, 0xf1, 0x59,
-	0x5f, 0x83, 0x0c, 0x3b, 0x6b, 0x35, 0x68, 0x13, 0xc3, 0x99, 0x33, 0xd5, 0x4a, 0x37, 0x20, 0xeb,
-	0x12, 0xc7, 0x6f, 0xd9, 0x4d, 0x86, 0x31, 0x2a, 0x9a, 0xc9, 0x5a, 0xdf, 0x80, 0x9c, 0x67, 0x33,
-	0xd9, 0x41, 0x64, 0x7c, 0xcc, 0xac, 0x67, 0xb3, 0xf7, 0xc5, 0x5a, 0xaf, 0x41, 0xb1, 0xe9, 0xff,
-	0xb4, 0xed, 0xbb, 0x3e, 0x3f, 0xb3, 0x1c, 0x3b, 0x2c, 0x65, 0xa7, 0xaa, 0xd8, 0x42, 0x02, 0xaa,
-	0xd9, 0x61, 0xca, 0x95, 0x16, 0x5c, 0x1f, 0x70, 0x53, 0xec, 0x44, 0xe1, 0x94, 0x4f, 0x23, 0x67,
-	0xef, 0x5e, 0xe2, 0x14, 0xe9, 0xb4, 0x02, 0x12, 0x63, 0xa7, 0x6c, 0x02, 0x38, 0x4e, 0x92, 0x52,
-	0xaa, 0x54, 0x05, 0x05, 0x13, 0x6a, 0xf7, 0x37, 0x73, 0xb0, 0x2a, 0x73, 0xe8, 0x59, 0x9b, 0x7f,
-	0xfd, 0x0c, 0x59, 0x81, 0x85, 0x80, 0x06, 0x0e, 0x41, 0xff, 0xcf, 0x9b, 0x72, 0xd1, 0x9b, 0x37,
-	0xf3, 0x7d, 0x45, 0xf9, 0xff, 0x56, 0x50, 0x0f, 0x61, 0x73, 0xa8, 0x33, 0x12, 0x97, 0x6f, 0x02,
-	0xf8, 0xcc, 0x8a, 0x30, 0xb5, 0x5d, 0xf4, 0x4b, 0xd6, 0xcc, 0xf9, 0x4c, 0xe6, 0xba, 0xbb, 0xcb,
-	0xa0, 0x94, 0x64, 0xfe, 0xeb, 0xf3, 0x67, 0xca, 0xe8, 0x5d, 0xd8, 0x1e, 0xa5, 0x34, 0xa9, 0xb7,
-	0xbf, 0x6b, 0x70, 0xa5, 0xce, 0xbc, 0x8f, 0x28, 0x27, 0x4f, 0x6c, 0x76, 0x18, 0xf9, 0x0e, 0x99,
-	0xd9, 0xa0, 0x50, 0xa0, 0x63, 0x83, 0x70, 0xa1, 0xef, 0x40, 0x21, 0x8c, 0x7c, 0x1a, 0x89, 0xc4,
-	0x3f, 0x26, 0x04, 0x23, 0x31, 0x6f, 0xe6, 0x63, 0xda, 0x63, 0x82, 0x2c, 0x32, 0x54, 0x41, 0xbb,
-	0xd5, 0x20, 0x11, 0x26, 0xc2, 0xbc, 0x99, 0x47, 0xda, 0x07, 0x48, 0xd2, 0x0d, 0xc8, 0xb0, 0x76,
-	0x18, 0x36, 0xcf, 0x64, 0x41, 0x62, 0xa0, 0x14, 0x25, 0xb5, 0xe5, 0xeb, 0xd8, 0xd8, 0x7a, 0x77,
-	0x93, 0xec, 0xf4, 0xdf, 0x99, 0x64, 0xa7, 0xb1, 0x33, 0xc6, 0xec, 0x74, 0x03, 0xb0, 0x16, 0x64,
-	0xfe, 0xc8, 0xe2, 0xc8, 0x0a, 0x02, 0xa6, 0xce, 0xdb, 0xb0, 0x46, 0x1b, 0x8c, 0x44, 0x1d, 0xe2,
-	0x5a, 0x54, 0xc9, 0xea, 0xed, 0x7e, 0x2b, 0xf1, 0xdb, 0x58, 0x11, 0xa2, 0x6a, 0x50, 0x1e, 0x44,
-	0xa9, 0x2c, 0x25, 0xbe, 0x77, 0xc2, 0xd5, 0xd6, 0x37, 0xd2, 0xe8, 0x03, 0xcc, 0x59, 0x64, 0xd1,
-	0xdf, 0x03, 0x63, 0x50, 0x88, 0xe8, 0x3c, 0x6d, 0x46, 0xdc, 0x12, 0xa0, 0x80, 0xf5, 0xb4, 0x80,
-	0x27, 0x36, 0x7b, 0xce, 0x88, 0xab, 0x53, 0xb8, 0x31, 0x08, 0x26, 0xc7, 0xc7, 0xc4, 0xe1, 0x7e,
-	0x87, 0xa0, 0x18, 0x19, 0xc3, 0x3c, 0xba, 0x79, 0x53, 0xf5, 0xa7, 0xd5, 0xc1, 0xfe, 0xf4, 0x34,
-	0xe0, 0xe6, 0x4e, 0x5a, 0xcd, 0xf7, 0x63, 0x49, 0x49, 0x26, 0x1d, 0x4e, 0x56, 0x28, 0x3b, 0x66,
-	0x01, 0x0d, 0x1f, 0x2b, 0x51, 0xb6, 0xd2, 0x9f, 0xc0, 0x52, 0xc7, 0x6e, 0xb6, 0x89, 0x15, 0x11,
-	0x87, 0xf8, 0xa2, 0xd6, 0x64, 0x4a, 0xbc, 0x33, 0xbe, 0x97, 0xfe, 0xe7, 0xc5, 0xd6, 0xea, 0x99,
-	0xdd, 0x6a, 0x3e, 0xd8, 0xed, 0x47, 0xef, 0x9a, 0x45, 0x24, 0x98, 0x6a, 0xad, 0x7f, 0x0f, 0x32,
-	0x8c, 0xdb, 0xbc, 0x2d, 0x3b, 0xfc, 0xd2, 0xde, 0x9d, 0x91, 0xa3, 0x80, 0x1c, 0x58, 0x15, 0xf0,
-	0x43, 0xc4, 0x98, 0x0a, 0xab, 0xdf, 0x80, 0xa5, 0x64, 0xbb, 0xc8, 0xa8, 0x8e, 0x84, 0x62, 0x4c,
-	0xad, 0x09, 0xa2, 0x7e, 0x07, 0xf4, 0x84, 0x4d, 0x8c, 0x4d, 0xb2, 0xa2, 0xb3, 0xe8, 0x8b, 0xe5,
-	0xf8, 0xcd, 0x11, 0x63, 0x1f, 0x60, 0xb3, 0xec, 0x1b, 0x54, 0x72, 0xb3, 0x0d, 0x2a, 0x9f, 0xc0,
-	0x55, 0x87, 0x06, 0xc7, 0x7e, 0xd4, 0xb2, 0xb9, 0x4f, 0x03, 0xab, 0x45, 0x5d, 0x52, 0x2a, 0xa2,
-	0xac, 0x6a, 0x65, 0xec, 0x58, 0x5f, 0xa9, 0xf5, 0xe0, 0xea, 0xd4, 0x25, 0xe6, 0xb2, 0x93, 0xa2,
-	0x8c, 0xac, 0xc5, 0x38, 0x9c, 0x49, 0x2d, 0xfe, 0x25, 0x0b, 0x4b, 0xea, 0x9d, 0x9a, 0x03, 0xc6,
-	0x94, 0xa2, 0x38, 0x8e, 0x49, 0xe0, 0x92, 0x48, 0xd5, 0xa1, 0x5a, 0xe9, 0x37, 0xe1, 0x8a, 0x7c,
-	0xb2, 0x52, 0x87, 0x7b, 0x51, 0x92, 0x6b, 0xaa, 0x33, 0x19, 0x90, 0x55, 0xe1, 0x8e, 0xd4, 0x29,
-	0x93, 0xac, 0x45, 0xa0, 0xe2, 0x67, 0x15, 0xa8, 0x05, 0x29, 0x22, 0xa6, 0xca, 0x40, 0x9d, 0xcf,
-	0xda, 0x99, 0x8b, 0xcc, 0xda, 0x62, 0x53, 0x2d, 0xc2, 0x98, 0xed, 0xc9, 0xa8, 0xe6, 0xcc, 0x78,
-	0x29, 0xba, 0x9e, 0x1f, 0xf4, 0x34, 0x8e, 0x1c, 0xbe, 0xce, 0x2b, 0x1a, 0xf6, 0x8b, 0x7b, 0xb0,
-	0x12, 0xb3, 0xf4, 0x75, 0x09, 0x59, 0xe4, 0xba, 0x7a, 0xd7, 0xdb, 0x1c, 0xfa, 0x86, 0x90, 0x3c,
-	0xb2, 0x9d, 0x0f, 0x21, 0x7d, 0xe9, 0x53, 0x98, 0x2d, 0x7d, 0x36, 0x20, 0xc7, 0xbb, 0x16, 0x8d,
-	0x7c, 0xcf, 0x0f, 0x30, 0x6d, 0x72, 0x66, 0x96, 0x77, 0x9f, 0xe1, 0x5a, 0x9c, 0x00, 0x38, 0x75,
-	0x95, 0x96, 0xf0, 0x85, 0x5c, 0xe8, 0x5b, 0x90, 0x27, 0x1d, 0x12, 0x70, 0x75, 0xd2, 0x5e, 0x41,
-	0xab, 0x00, 0x49, 0x78, 0xd0, 0xea, 0x11, 0x5c, 0xc7, 0xcb, 0x91, 0x43, 0x9b, 0x96, 0x43, 0x03,
-	0x1e, 0xd9, 0x0e, 0xb7, 0x3a, 0x24, 0x62, 0x3e, 0x0d, 0x4a, 0xcb, 0x68, 0xe7, 0xfe, 0x84, 0xd4,
-	0x3c, 0x54, 0xf8, 0x9a, 0x82, 0x7f, 0x24, 0xd1, 0xe6, 0x7a, 0x38, 0xfc, 0x85, 0xfe, 0x63, 0x11,
-	0xf6, 0x0e, 0x89, 0xb8, 0x45, 0x43, 0x91, 0xbd, 0xac, 0x74, 0x15, 0xe7, 0x8c, 0x3b, 0x13, 0x14,
-	0x99, 0x08, 0x7a, 0x26, 0x31, 0x07, 0xf3, 0x22, 0x0b, 0x44, 0xaa, 0xf4, 0x10, 0xf5, 0x3a, 0x14,
-	0x1c, 0xbb, 0xd9, 0x4c, 0x04, 0xeb, 0x28, 0xf8, 0xf6, 0xa4, 0xe2, 0xb2, 0x9b, 0x4d, 0x25, 0xc1,
-	0xcc, 0x3b, 0xe7, 0x0b, 0xfd, 0x2e, 0x5c, 0xf3, 0x99, 0xd5, 0x7b, 0xcb, 0x14, 0x6f, 0x4b, 0xd7,
-	0x70, 0xc0, 0x58, 0xf6, 0x59, 0x4d, 0xbc, 0xc1, 0x24, 0x15, 0x22, 0x7a, 0xda, 0xd7, 0xca, 0xe8,
-	0xf6, 0xd5, 0xa3, 0x57, 0x55, 0x5f, 0xaa, 0x7d, 0x0d, 0xed, 0x12, 0xab, 0xff, 0xa3, 0x2e, 0x21,
-	0xa6, 0x53, 0x12, 0x45, 0x34, 0xb2, 0xe2, 0xd2, 0x58, 0x93, 0xd3, 0x29, 0x12, 0xeb, 0x92, 0x96,
-	0x6a, 0x25, 0x25, 0x58, 0xeb, 0x6f, 0x17, 0x49, 0x27, 0xf9, 0x18, 0xaf, 0x0b, 0x8f, 0x1a, 0x34,
-	0xe2, 0x1f, 0xf2, 0xb6, 0x73, 0x5a, 0xab, 0x1d, 0xfd, 0x68, 0xfc, 0xfd, 0x74, 0xcc, 0xd0, 0x9b,
-	0xd2, 0xba, 0x81, 0x33, 0x76, 0xbf, 0xec, 0x44, 0xf1, 0x2f, 0x35, 0xbc, 0x9d, 0x9a, 0xe4, 0xb8,
-	0x1d, 0xb8, 0xc8, 0x43, 0xdc, 0xaf, 0xa5, 0x5c, 0xf6, 0x22, 0x21, 0x2d, 0x19, 0xdb, 0xe5, 0x34,
-	0x51, 0x94, 0x54, 0x35, 0xb7, 0x0f, 0xbd, 0xe9, 0x0d, 0x58, 0x91, 0x98, 0xf9, 0x67, 0x0d, 0x37,
-	0x21, 0x2f, 0xd9, 0xa6, 0xcd, 0xc9, 0xfb, 0xf2, 0x1b, 0xc7, 0xe3, 0xa6, 0xed, 0x8d, 0xbb, 0xc8,
-	0x3b, 0xa0, 0x0f, 0x7e, 0x12, 0x41, 0x9b, 0xf3, 0x13, 0x73, 0x20, 0xad, 0x46, 0x15, 0xca, 0x72,
-	0x94, 0xa2, 0xa7, 0xb6, 0xf2, 0x26, 0xec, 0x8c, 0xb4, 0x34, 0xde, 0xcf, 0xde, 0xdf, 0x8a, 0x70,
-	0xb9, 0xce, 0x3c, 0xfd, 0xd7, 0x1a, 0xe8, 0x43, 0xee, 0x26, 0x6f, 0x4f, 0x30, 0x6d, 0xe8, 0x10,
-	0x6f, 0x7c, 0x7b, 0x16, 0x54, 0x32, 0xfa, 0xff, 0x4a, 0x83, 0xab, 0x83, 0x1f, 0x29, 0xee, 0x4f,
-	0x25, 0xb3, 0x1f, 0x64, 0xbc, 0x37, 0x03, 0x28, 0xb1, 0xe3, 0xb7, 0x1a, 0xac, 0x0c, 0xbd, 0xd2,
-	0xef, 0x4f, 0x96, 0x3a, 0x0c, 0x67, 0x3c, 0x9c, 0x0d, 0x97, 0x18, 0xf4, 0x07, 0x0d, 0x56, 0x87,
-	0x5f, 0x79, 0xde, 0x99, 0x56, 0x72, 0x3a, 0x52, 0xdf, 0x99, 0x11, 0x98, 0xd8, 0xd4, 0x81, 0x42,
-	0xdf, 0x5d, 0xa7, 0x32, 0x59, 0x60, 0x2f, 0xbf, 0xb1, 0x7f, 0x31, 0xfe, 0xb4, 0xde, 0xe4, 0xe6,
-	0x31, 0xa5, 0xde, 0x98, 0x7f, 0x5a, 0xbd, 0xe9, 0x49, 0x4b, 0x67, 0x90, 0xef, 0x9d, 0xb2, 0xee,
-	0x4e, 0x27, 0x46, 0xb1, 0x1b, 0xdf, 0xbc, 0x10, 0x7b, 0xa2, 0xf4, 0x67, 0xb0, 0x94, 0xfa, 0x80,
-	0x73, 0x6f, 0xb2, 0xa0, 0x7e, 0x84, 0xf1, 0xee, 0x45, 0x11, 0x89, 0xf6, 0xcf, 0x34, 0x58, 0x1e,
-	0xf8, 0x64, 0xb9, 0x37, 0x59, 0x5c, 0x1a, 0x63, 0x3c, 0xb8, 0x38, 0x26, 0x31, 0xe2, 0xe7, 0x70,
-	0x25, 0xfd, 0x95, 0xf7, 0xad, 0xc9, 0xe2, 0x52, 0x10, 0xe3, 0x5b, 0x17, 0x86, 0xf4, 0xc6, 0x20,
-	0x75, 0x2a, 0x4e, 0x11, 0x83, 0x7e, 0xc4, 0x34, 0x31, 0x18, 0x7e, 0x3a, 0x62, 0x4f, 0x1c, 0x3c,
-	0x1a, 0xef, 0x4f, 0x53, 0xbd, 0x29, 0xd0, 0x34, 0x3d, 0x71, 0xe4, 0xf1, 0xa7, 0xff, 0x51, 0x83,
-	0xb5, 0x11, 0x67, 0xdf, 0xbb, 0xd3, 0x46, 0x37, 0x8d, 0x34, 0xbe, 0x3b, 0x2b, 0x32, 0x36, 0xcb,
-	0x58, 0xf8, 0xc5, 0x57, 0x9f, 0xdf, 0xd6, 0x0e, 0x9e, 0x7c, 0xf1, 0xb2, 0xac, 0x7d, 0xf9, 0xb2,
-	0xac, 0xfd, 0xeb, 0x65, 0x59, 0xfb, 0xfd, 0xab, 0xf2, 0xa5, 0x2f, 0x5f, 0x95, 0x2f, 0xfd, 0xe3,
-	0x55, 0xf9, 0xd2, 0xc7, 0x77, 0x3d, 0x9f, 0x9f, 0xb4, 0x1b, 0x15, 0x87, 0xb6, 0xf0, 0xdf, 0x0e,
-	0x77, 0xe5, 0x7f, 0x1b, 0x02, 0xea, 0x92, 0x6a, 0xb7, 0xef, 0x9f, 0x32, 0x67, 0x21, 0x61, 0x8d,
-	0x0c, 0x0e, 0xba, 0xf7, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xb1, 0x68, 0xbd, 0xa1, 0xc2, 0x19,
-	0x00, 0x00,
+	// 1949 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x5b, 0x6f, 0x1b, 0xc7,
+	0xf5, 0xf7, 0xea, 0x4a, 0x1e, 0x8a, 0x92, 0xbc, 0xd6, 0x65, 0xbd, 0x8a, 0x28, 0x89, 0xf9, 0xdb,
+	0x30, 0x0c, 0x9b, 0x74, 0xe4, 0xfc, 0x95, 0xd4, 0x29, 0xdc, 0x5a, 0x6c, 0xed, 0x1a, 0x08, 0x63,
+	0x61, 0x2d, 0xa7, 0x6d, 0x1a, 0x74, 0xb1, 0xdc, 0x1d, 0xad, 0x16, 0x5a, 0xee, 0x6c, 0x77, 0x86,
+	0x04, 0x15, 0x14, 0x68, 0x11, 0xb4, 0x40, 0x81, 0x02, 0xbd, 0x00, 0xfd, 0x0a, 0x2d, 0xfa, 0x98,
+	0xc7, 0x7e, 0x84, 0xa0, 0xe8, 0x43, 0x1e, 0x8b, 0x3e, 0x18, 0x85, 0xfd, 0x90, 0xb7, 0x3e, 0xf4,
+	0x13, 0x14, 0x7b, 0x66, 0x76, 0x45, 0x2e, 0xaf, 0x62, 0x1a, 0xa0, 0x2f, 0xe2, 0xce, 0x99, 0xf3,
+	0x3b, 0x73, 0xe6, 0xdc, 0xe6, 0xcc, 0x08, 0x6e, 0x7e, 0x42, 0xb8, 0x65, 0x9f, 0x5a, 0x5e, 0x50,
+	0xc5, 0x2f, 0x1a, 0x91, 0xaa, 0x1d, 0x51, 0xc6, 0x04, 0x8d, 0x77, 0x2a, 0x61, 0x44, 0x39, 0x55,
+	0xb7, 0x53, 0xbe, 0x4a, 0xc2, 0x57, 0xb9, 0xe0, 0xd3, 0xd7, 0x5c, 0xea, 0x52, 0xe4, 0xac, 0xc6,
+	0x5f, 0x02, 0xa4, 0xdf, 0x1e, 0x20, 0x3c, 0x3c, 0x73, 0xab, 0x48, 0x62, 0xf2, 0x47, 0xf2, 0xde,
+	0x1c, 0xc6, 0x4b, 0xbd, 0x00, 0xff, 0x8c, 0x91, 0x19, 0x46, 0x94, 0x9e, 0x30, 0xf9, 0x23, 0x79,
+	0x0f, 0x46, 0x6f, 0x2e, 0xb2, 0x38, 0x31, 0x7d, 0xaf, 0xe9, 0x71, 0x12, 0x99, 0x27, 0xbe, 0xe5,
+	0x26, 0xb8, 0xfd, 0xd1, 0x38, 0xfc, 0x34, 0xf1, 0xdb, 0x4c, 0x0c, 0xa4, 0x6f, 0xda, 0x94, 0x35,
+	0x29, 0xab, 0x36, 0x99, 0x5b, 0x6d, 0xbf, 0x15, 0xff, 0x88, 0x89, 0xf2, 0x6f, 0x15, 0x50, 0xeb,
+	0xcc, 0xad, 0x7b, 0x6e, 0xbc, 0xde, 0x31, 0x63, 0x8f, 0x5b, 0x81, 0xc3, 0x54, 0x0d, 0x16, 0xed,
+	0x88, 0x58, 0x9c, 0x46, 0x9a, 0xb2, 0xab, 0xdc, 0xca, 0x1b, 0xc9, 0x50, 0xbd, 0x0e, 0x39, 0x21,
+	0xdb, 0x73, 0xb4, 0x99, 0x5d, 0xe5, 0xd6, 0xac, 0xb1, 0x88, 0xe3, 0xa7, 0x8e, 0x7a, 0x00, 0x0b,
+	0x56, 0x93, 0xb6, 0x02, 0xae, 0xcd, 0xc6, 0x98, 0xc3, 0xd2, 0xe7, 0x2f, 0x77, 0xae, 0xfc, 0xe3,
+	0xe5, 0xce, 0x86, 0x58, 0x9c, 0x39, 0x67, 0x15, 0x8f, 0x56, 0x9b, 0x16, 0x3f, 0xad, 0xbc, 0xf0,
+	0x02, 0x6e, 0x48, 0xee, 0x07, 0x4b, 0x9f, 0x7e, 0xf9, 0xd9, 0xed, 0x64, 0x81, 0xf2, 0x1b, 0xa0,
+	0xf7, 0x2b, 0x64, 0x10, 0x16, 0xd2, 0x80, 0x91, 0xf2, 0xc7, 0x70, 0xad, 0xce, 0xdc, 0x17, 0xa1,
+	0x23, 0x26, 0x1f, 0x39, 0x4e, 0x44, 0xd8, 0x28, 0x7d, 0xb7, 0x01, 0x38, 0x63, 0x66, 0xd8, 0x6a,
+	0x9c, 0x91, 0x73, 0xd4, 0x38, 0x6f, 0xe4, 0x39, 0x63, 0x47, 0x48, 0xc8, 0xac, 0xbd, 0x0d, 0x5b,
+	0x03, 0xa4, 0xa7, 0x8b, 0xff, 0x65, 0x06, 0xd6, 0xea, 0xcc, 0x7d, 0xe4, 0x38, 0x4f, 0x83, 0x06,
+	0x6d, 0x05, 0xce, 0x71, 0x64, 0xd9, 0x67, 0x24, 0x9a, 0xce, 0x5c, 0x9b, 0xb0, 0xc8, 0x3b, 0xe6,
+	0xa9, 0xc5, 0x4e, 0x85, 0xbd, 0x8c, 0x05, 0xde, 0xf9, 0x9e, 0xc5, 0x4e, 0xd5, 0x43, 0xc8, 0xc7,
+	0x21, 0x65, 0xf2, 0xf3, 0x90, 0x68, 0x73, 0xbb, 0xca, 0xad, 0xe5, 0xfd, 0x1b, 0x95, 0x01, 0x11,
+	0x1e, 0x9e, 0xb9, 0x15, 0x8c, 0xbd, 0x1a, 0xf5, 0x82, 0xe3, 0xf3, 0x90, 0x18, 0x39, 0x5b, 0x7e,
+	0xa9, 0x0f, 0x61, 0x1e, 0x83, 0x4d, 0x9b, 0xdf, 0x55, 0x6e, 0x15, 0xf6, 0xff, 0x6f, 0x18, 0x5e,
+	0x46, 0xe4, 0x51, 0xfc, 0x73, 0x38, 0xa3, 0x29, 0x86, 0x80, 0xa9, 0x7b, 0x00, 0x0d, 0x9f, 0xda,
+	0x67, 0x42, 0xbf, 0x05, 0xf4, 0x67, 0x3c, 0x9d, 0x47, 0x2a, 0xaa, 0xb9, 0x0d, 0x39, 0xde, 0x31,
+	0xbd, 0xc0, 0x21, 0x1d, 0x6d, 0x31, 0xde, 0x1a, 0x32, 0x2c, 0xf2, 0xce, 0xd3, 0x98, 0x94, 0xb1,
+	0x6c, 0x09, 0xde, 0x18, 0x64, 0xb9, 0xd4, 0xb4, 0x2d, 0xd8, 0xac, 0x33, 0xd7, 0x20, 0x4d, 0xda,
+	0x26, 0x5f, 0xa7, 0x71, 0x33, 0x6a, 0xed, 0xc1, 0xce, 0x90, 0x65, 0x53, 0xcd, 0xfe, 0x38, 0x03,
+	0x57, 0xeb, 0xcc, 0xfd, 0xfe, 0xa9, 0xc7, 0x89, 0xef, 0x31, 0xfe, 0x88, 0x31, 0xc2, 0x47, 0x28,
+	0xf5, 0x26, 0x14, 0xad, 0x98, 0xc5, 0xb4, 0x44, 0xf4, 0xc8, 0x98, 0x5b, 0x42, 0x62, 0x12, 0xaf,
+	0xdd, 0x9a, 0xcf, 0xf6, 0x6a, 0xae, 0xc2, 0x5c, 0x60, 0x35, 0x85, 0xe3, 0xf3, 0x06, 0x7e, 0xab,
+	0x1b, 0xb0, 0xc0, 0xce, 0x9b, 0x0d, 0xea, 0xa3, 0x3b, 0xf3, 0x86, 0x1c, 0xa9, 0x3a, 0xe4, 0x1c,
+	0x62, 0x7b, 0x4d, 0xcb, 0x67, 0xe8, 0xa3, 0xa2, 0x91, 0x8e, 0xd5, 0x2d, 0xc8, 0xbb, 0x16, 0x13,
+	0x15, 0x44, 0xf8, 0xc7, 0xc8, 0xb9, 0x16, 0x7b, 0x3f, 0x1e, 0xab, 0x35, 0x28, 0xfa, 0xde, 0x4f,
+	0x5a, 0x9e, 0xe3, 0xf1, 0x73, 0xd3, 0xb6, 0x42, 0x2d, 0x37, 0x51, 0xc6, 0x2e, 0xa5, 0xa0, 0x9a,
+	0x15, 0x66, 0x4c, 0x69, 0xc2, 0xf5, 0x3e, 0x33, 0x25, 0x46, 0x8c, 0x8d, 0xf2, 0x49, 0x64, 0xef,
+	0xdf, 0x4b, 0x8d, 0x22, 0x8c, 0xb6, 0x84, 0xc4, 0xc4, 0x28, 0xdb, 0x00, 0xb6, 0x9d, 0x86, 0x94,
+	0x4c, 0xd5, 0x98, 0x82, 0x01, 0x55, 0xfe, 0xf5, 0x0c, 0xac, 0x8b, 0x18, 0x7a, 0xd6, 0xe2, 0x5f,
+	0x3d, 0x42, 0xd6, 0x60, 0x3e, 0xa0, 0x81, 0x4d, 0xd0, 0xfe, 0x73, 0x86, 0x18, 0x74, 0xc7, 0xcd,
+	0x5c, 0x4f, 0x52, 0xfe, 0xaf, 0x25, 0xd4, 0x43, 0xd8, 0x1e, 0x68, 0x8c, 0xd4, 0xe4, 0xdb, 0x00,
+	0x1e, 0x33, 0x23, 0x0c, 0x6d, 0x07, 0xed, 0x92, 0x33, 0xf2, 0x1e, 0x13, 0xb1, 0xee, 0x94, 0x19,
+	0x68, 0x69, 0xe4, 0x7f, 0x7d, 0xf6, 0xcc, 0x28, 0x5d, 0x86, 0xdd, 0x61, 0x8b, 0xa6, 0xf9, 0xf6,
+	0x37, 0x05, 0x56, 0xea, 0xcc, 0xfd, 0x90, 0x72, 0xf2, 0xc4, 0x62, 0x47, 0x91, 0x67, 0x93, 0xa9,
+	0x15, 0x0a, 0x63, 0x74, 0xa2, 0x10, 0x0e, 0xd4, 0x3d, 0x58, 0x0a, 0x23, 0x8f, 0x46, 0x71, 0xe0,
+	0x9f, 0x10, 0x82, 0x9e, 0x98, 0x33, 0x0a, 0x09, 0xed, 0x31, 0x41, 0x16, 0xe1, 0xaa, 0xa0, 0xd5,
+	0x6c, 0x90, 0x08, 0x03, 0x61, 0xce, 0x28, 0x20, 0xed, 0x03, 0x24, 0xa9, 0x3a, 0x2c, 0xb0, 0x56,
+	0x18, 0xfa, 0xe7, 0x22, 0x21, 0xd1, 0x51, 0x92, 0x92, 0xd9, 0xf2, 0x75, 0x2c, 0x6c, 0xdd, 0xbb,
+	0x49, 0x77, 0xfa, 0xaf, 0x85, 0x74, 0xa7, 0x89, 0x31, 0x46, 0xec, 0x74, 0x0b, 0x30, 0x17, 0x44,
+	0xfc, 0x88, 0xe4, 0xc8, 0xc5, 0x04, 0x0c, 0x9d, 0xb7, 0x61, 0x83, 0x36, 0x18, 0x89, 0xda, 0xc4,
+	0x31, 0xa9, 0x94, 0xd5, 0x5d, 0xfd, 0xd6, 0x92, 0xd9, 0x64, 0x21, 0x44, 0xd5, 0xa0, 0xd4, 0x8f,
+	0x92, 0x51, 0x4a, 0x3c, 0xf7, 0x94, 0xcb, 0xad, 0x6f, 0x65, 0xd1, 0x87, 0x18, 0xb3, 0xc8, 0xa2,
+	0xbe, 0x07, 0x7a, 0xbf, 0x90, 0xb8, 0xf2, 0xb4, 0x18, 0x71, 0x34, 0x40, 0x01, 0x9b, 0x59, 0x01,
+	0x4f, 0x2c, 0xf6, 0x82, 0x11, 0x47, 0xa5, 0x70, 0xa3, 0x1f, 0x4c, 0x4e, 0x4e, 0x88, 0xcd, 0xbd,
+	0x36, 0x41, 0x31, 0xc2, 0x87, 0x05, 0x34, 0xf3, 0xb6, 0xac, 0x4f, 0xeb, 0xfd, 0xf5, 0xe9, 0x69,
+	0xc0, 0x8d, 0xbd, 0xec, 0x32, 0xdf, 0x4d, 0x24, 0xa5, 0x91, 0x74, 0x34, 0x7e, 0x41, 0x51, 0x31,
+	0x97, 0x50, 0xf1, 0x91, 0x12, 0x45, 0x29, 0xfd, 0x31, 0x2c, 0xb7, 0x2d, 0xbf, 0x45, 0xcc, 0x88,
+	0xd8, 0xc4, 0x8b, 0x73, 0x4d, 0x84, 0xc4, 0x3b, 0xa3, 0x6b, 0xe9, 0xbf, 0x5f, 0xee, 0xac, 0x9f,
+	0x5b, 0x4d, 0xff, 0x41, 0xb9, 0x17, 0x5d, 0x36, 0x8a, 0x48, 0x30, 0xe4, 0x58, 0xfd, 0x0e, 0x2c,
+	0x30, 0x6e, 0xf1, 0x96, 0xa8, 0xf0, 0xcb, 0xfb, 0x77, 0x86, 0xb6, 0x02, 0xa2, 0x61, 0x95, 0xc0,
+	0xe7, 0x88, 0x31, 0x24, 0x56, 0xbd, 0x01, 0xcb, 0xe9, 0x76, 0x91, 0x51, 0x1e, 0x09, 0xc5, 0x84,
+	0x5a, 0x8b, 0x89, 0xea, 0x1d, 0x50, 0x53, 0xb6, 0xb8, 0x6d, 0x12, 0x19, 0x9d, 0x43, 0x5b, 0xac,
+	0x26, 0x33, 0xc7, 0x8c, 0x7d, 0x80, 0xc5, 0xb2, 0xa7, 0x51, 0xc9, 0x4f, 0xd7, 0xa8, 0x7c, 0x0c,
+	0x57, 0x6d, 0x1a, 0x9c, 0x78, 0x51, 0xd3, 0xe2, 0x1e, 0x0d, 0xcc, 0x26, 0x75, 0x88, 0x56, 0x44,
+	0x59, 0xd5, 0xca, 0xc8, 0xb6, 0xbe, 0x52, 0xeb, 0xc2, 0xd5, 0xa9, 0x43, 0x8c, 0x55, 0x3b, 0x43,
+	0x19, 0x9a, 0x8b, 0x89, 0x3b, 0xd3, 0x5c, 0xfc, 0x53, 0x1e, 0x96, 0xe5, 0x9c, 0xec, 0x03, 0x46,
+	0xa4, 0x62, 0x7c, 0x1c, 0x93, 0xc0, 0x21, 0x91, 0xcc, 0x43, 0x39, 0x52, 0x6f, 0xc2, 0x8a, 0xf8,
+	0x32, 0x33, 0x87, 0x7b, 0x51, 0x90, 0x6b, 0xb2, 0x32, 0xe9, 0x90, 0x93, 0xee, 0x8e, 0xe4, 0x29,
+	0x93, 0x8e, 0x63, 0x47, 0x25, 0xdf, 0xd2, 0x51, 0xf3, 0x42, 0x44, 0x42, 0x15, 0x8e, 0xba, 0xe8,
+	0xb5, 0x17, 0x2e, 0xd3, 0x6b, 0xc7, 0x9b, 0x6a, 0x12, 0xc6, 0x2c, 0x57, 0x78, 0x35, 0x6f, 0x24,
+	0xc3, 0xb8, 0xea, 0x79, 0x41, 0x57, 0xe1, 0xc8, 0xe3, 0x74, 0x41, 0xd2, 0xb0, 0x5e, 0xdc, 0x83,
+	0xb5, 0x84, 0xa5, 0xa7, 0x4a, 0x88, 0x24, 0x57, 0xe5, 0x5c, 0x77, 0x71, 0xe8, 0x69, 0x42, 0x0a,
+	0xc8, 0x76, 0xd1, 0x84, 0xf4, 0x84, 0xcf, 0xd2, 0x74, 0xe1, 0xb3, 0x05, 0x79, 0xde, 0x31, 0x69,
+	0xe4, 0xb9, 0x5e, 0x80, 0x61, 0x93, 0x37, 0x72, 0xbc, 0xf3, 0x0c, 0xc7, 0xf1, 0x09, 0x80, 0x5d,
+	0x97, 0xb6, 0x8c, 0x13, 0x62, 0xa0, 0xee, 0x40, 0x81, 0xb4, 0x49, 0xc0, 0xe5, 0x49, 0xbb, 0x82,
+	0x5a, 0x01, 0x92, 0xf0, 0xa0, 0x55, 0x23, 0xb8, 0x8e, 0x97, 0x23, 0x9b, 0xfa, 0xa6, 0x4d, 0x03,
+	0x1e, 0x59, 0x36, 0x37, 0xdb, 0x24, 0x62, 0x1e, 0x0d, 0xb4, 0x55, 0xd4, 0xf3, 0x60, 0x4c, 0x68,
+	0x1e, 0x49, 0x7c, 0x4d, 0xc2, 0x3f, 0x14, 0x68, 0x63, 0x33, 0x1c, 0x3c, 0xa1, 0xfe, 0x30, 0x76,
+	0x7b, 0x9b, 0x44, 0xdc, 0xa4, 0x61, 0x1c, 0xbd, 0x4c, 0xbb, 0x8a, 0x7d, 0xc6, 0x9d, 0x31, 0x0b,
+	0x19, 0x08, 0x7a, 0x26, 0x30, 0x87, 0x73, 0x71, 0x14, 0xc4, 0xa1, 0xd2, 0x45, 0x54, 0xeb, 0xb0,
+	0x64, 0x5b, 0xbe, 0x9f, 0x0a, 0x56, 0x51, 0xf0, 0xed, 0x71, 0xc9, 0x65, 0xf9, 0xbe, 0x94, 0x60,
+	0x14, 0xec, 0x8b, 0x81, 0x7a, 0x17, 0xae, 0x79, 0xcc, 0xec, 0xbe, 0x65, 0xc6, 0xb3, 0xda, 0x35,
+	0x6c, 0x30, 0x56, 0x3d, 0x56, 0x8b, 0x67, 0x30, 0x48, 0x63, 0x11, 0x5d, 0xe5, 0x6b, 0x6d, 0x78,
+	0xf9, 0xea, 0x5a, 0x57, 0x66, 0x5f, 0xa6, 0x7c, 0x0d, 0xac, 0x12, 0xeb, 0xff, 0xa5, 0x2a, 0x11,
+	0x77, 0xa7, 0x24, 0x8a, 0x68, 0x64, 0x26, 0xa9, 0xb1, 0x21, 0xba, 0x53, 0x24, 0xd6, 0x65, 0x7e,
+	0x60, 0xaf, 0x6d, 0x39, 0xbe, 0x17, 0x10, 0x6d, 0x53, 0x44, 0x72, 0x32, 0x56, 0x7f, 0x04, 0x2b,
+	0x69, 0xd2, 0xb2, 0xd0, 0xf7, 0x38, 0xd3, 0xb4, 0xdd, 0xd9, 0x89, 0xdc, 0x27, 0x50, 0xcf, 0x63,
+	0x90, 0x74, 0x5f, 0x9a, 0xff, 0x48, 0x64, 0x99, 0x1a, 0x66, 0x43, 0xb1, 0x07, 0xd4, 0x53, 0x4c,
+	0x94, 0x4c, 0x31, 0xb9, 0xa8, 0x12, 0x33, 0x97, 0xa9, 0x12, 0x65, 0x0d, 0x36, 0x7a, 0x8b, 0x61,
+	0x5a, 0x27, 0x3f, 0xc2, 0xcb, 0xd0, 0xa3, 0x06, 0x8d, 0xf8, 0x73, 0xde, 0xb2, 0xcf, 0x6a, 0xb5,
+	0xe3, 0x1f, 0x8c, 0xbe, 0x7d, 0x8f, 0x68, 0xe9, 0x33, 0x5b, 0xdb, 0xc2, 0x1b, 0x44, 0xaf, 0xec,
+	0x74, 0xe1, 0x5f, 0x28, 0x78, 0xf7, 0x36, 0xc8, 0x49, 0x2b, 0x70, 0x90, 0x87, 0x38, 0x5f, 0x69,
+	0x71, 0x51, 0x69, 0x63, 0x69, 0xe9, 0xa5, 0x44, 0xf4, 0x4a, 0x45, 0x41, 0x95, 0xb7, 0x92, 0x81,
+	0xf7, 0xd8, 0x3e, 0x2d, 0x52, 0x35, 0xff, 0xac, 0xe0, 0x26, 0xc4, 0x13, 0x82, 0x61, 0x71, 0xf2,
+	0xbe, 0x78, 0xc1, 0x79, 0xec, 0x5b, 0xee, 0xa8, 0x67, 0x0a, 0x1b, 0xd4, 0xfe, 0x07, 0x1f, 0xd4,
+	0xb9, 0x30, 0x36, 0xc2, 0xb3, 0xcb, 0xc8, 0x38, 0x5a, 0x8d, 0x32, 0xf4, 0xcc, 0x56, 0xde, 0x84,
+	0xbd, 0xa1, 0x9a, 0x26, 0xfb, 0xd9, 0xff, 0x6b, 0x11, 0x66, 0xeb, 0xcc, 0x55, 0x7f, 0xa5, 0x80,
+	0x3a, 0xe0, 0xe6, 0xf5, 0xf6, 0x18, 0xd5, 0x06, 0x5e, 0x51, 0xf4, 0x6f, 0x4e, 0x83, 0x4a, 0x2f,
+	0x36, 0xbf, 0x54, 0xe0, 0x6a, 0xff, 0x13, 0xcc, 0xfd, 0x89, 0x64, 0xf6, 0x82, 0xf4, 0xf7, 0xa6,
+	0x00, 0xa5, 0x7a, 0xfc, 0x46, 0x81, 0xb5, 0x81, 0x0f, 0x16, 0x07, 0xe3, 0xa5, 0x0e, 0xc2, 0xe9,
+	0x0f, 0xa7, 0xc3, 0xa5, 0x0a, 0xfd, 0x5e, 0x81, 0xf5, 0xc1, 0x17, 0xba, 0x77, 0x26, 0x95, 0x9c,
+	0xf5, 0xd4, 0xb7, 0xa6, 0x04, 0xa6, 0x3a, 0xb5, 0x61, 0xa9, 0xe7, 0x26, 0x57, 0x19, 0x2f, 0xb0,
+	0x9b, 0x5f, 0x3f, 0xb8, 0x1c, 0x7f, 0x76, 0xdd, 0xf4, 0x5e, 0x35, 0xe1, 0xba, 0x09, 0xff, 0xa4,
+	0xeb, 0x66, 0xfb, 0x48, 0x95, 0x41, 0xa1, 0xbb, 0x87, 0xbc, 0x3b, 0x99, 0x18, 0xc9, 0xae, 0xff,
+	0xff, 0xa5, 0xd8, 0xd3, 0x45, 0x7f, 0x0a, 0xcb, 0x99, 0xe7, 0xa9, 0x7b, 0xe3, 0x05, 0xf5, 0x22,
+	0xf4, 0x77, 0x2f, 0x8b, 0x48, 0x57, 0xff, 0x54, 0x81, 0xd5, 0xbe, 0x07, 0xd9, 0xfd, 0xf1, 0xe2,
+	0xb2, 0x18, 0xfd, 0xc1, 0xe5, 0x31, 0xa9, 0x12, 0x3f, 0x83, 0x95, 0xec, 0x1b, 0xf6, 0x5b, 0xe3,
+	0xc5, 0x65, 0x20, 0xfa, 0x37, 0x2e, 0x0d, 0xe9, 0xf6, 0x41, 0xe6, 0x54, 0x9c, 0xc0, 0x07, 0xbd,
+	0x88, 0x49, 0x7c, 0x30, 0xf8, 0x74, 0xc4, 0x9a, 0xd8, 0x7f, 0x34, 0xde, 0x9f, 0x24, 0x7b, 0x33,
+	0xa0, 0x49, 0x6a, 0xe2, 0xd0, 0xe3, 0x4f, 0xfd, 0x83, 0x02, 0x1b, 0x43, 0xce, 0xbe, 0x77, 0x27,
+	0xf5, 0x6e, 0x16, 0xa9, 0x7f, 0x7b, 0x5a, 0x64, 0xa2, 0x96, 0x3e, 0xff, 0xf3, 0x2f, 0x3f, 0xbb,
+	0xad, 0x1c, 0x3e, 0xf9, 0xfc, 0x55, 0x49, 0xf9, 0xe2, 0x55, 0x49, 0xf9, 0xe7, 0xab, 0x92, 0xf2,
+	0xbb, 0xd7, 0xa5, 0x2b, 0x5f, 0xbc, 0x2e, 0x5d, 0xf9, 0xfb, 0xeb, 0xd2, 0x95, 0x8f, 0xee, 0xba,
+	0x1e, 0x3f, 0x6d, 0x35, 0x2a, 0x36, 0x6d, 0xe2, 0x3f, 0x55, 0xee, 0x8a, 0xff, 0xa5, 0x04, 0xd4,
+	0x21, 0xd5, 0x4e, 0xcf, 0xbf, 0x9c, 0xce, 0x43, 0xc2, 0x1a, 0x0b, 0xd8, 0xc6, 0xdf, 0xff, 0x4f,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xbc, 0x05, 0xa3, 0xa0, 0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3084,6 +3156,29 @@ func (m *MsgVoteInbound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReceiverSplits) > 0 {
+		for iNdEx := len(m.ReceiverSplits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReceiverSplits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if m.Deadline != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
 	if len(m.ErrorMessage) > 0 {
 		i -= len(m.ErrorMessage)
 		copy(dAtA[i:], m.ErrorMessage)
@@ -3244,6 +3339,46 @@ func (m *MsgVoteInbound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReceiverSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiverSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiverSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgVoteInboundResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3892,6 +4027,30 @@ func (m *MsgVoteInbound) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovTx(uint64(l))
 	}
+	if m.Deadline != 0 {
+		n += 2 + sovTx(uint64(m.Deadline))
+	}
+	if len(m.ReceiverSplits) > 0 {
+		for _, e := range m.ReceiverSplits {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReceiverSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -6908,6 +7067,175 @@ func (m *MsgVoteInbound) Unmarshal(dAtA []byte) error {
 			}
 			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverSplits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverSplits = append(m.ReceiverSplits, ReceiverSplit{})
+			if err := m.ReceiverSplits[len(m.ReceiverSplits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReceiverSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReceiverSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReceiverSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -32,6 +32,10 @@ func BeginBlocker(ctx sdk.Context, emissionsKeeper keeper.Keeper) {
 		ctx.Logger().Error("Params not found")
 		return
 	}
+
+	// slashing of blamed observers does not depend on the availability of block rewards
+	SlashBlamedObservers(ctx, emissionsKeeper, params)
+
 	blockRewards := params.BlockRewardAmount
 
 	// skip if block rewards are nil or not positive
@@ -160,6 +164,41 @@ func DistributeObserverRewards(
 	return nil
 }
 
+// SlashBlamedObservers slashes the observers blamed for failed TSS ceremonies at least BlameSlashThreshold times
+// within the last BlameSlashWindow blocks. The observers are evaluated once at the end of each window.
+// This is disabled if BlameSlashThreshold is 0.
+func SlashBlamedObservers(ctx sdk.Context, emissionsKeeper keeper.Keeper, params types.Params) {
+	threshold, window := params.BlameSlashThreshold, params.BlameSlashWindow
+	if threshold == 0 || window <= 0 || ctx.BlockHeight()%window != 0 {
+		return
+	}
+
+	stats := emissionsKeeper.GetObserverKeeper().GetBlameStats(ctx, ctx.BlockHeight()-window+1, ctx.BlockHeight())
+
+	var slashed []*types.ObserverEmission
+	for _, stat := range stats {
+		if stat.Total < threshold {
+			// stats are sorted by total blames, no remaining observer reaches the threshold
+			break
+		}
+		if stat.Operator == "" {
+			ctx.Logger().Error("Blamed node has no observer", "pubkey", stat.PubKey, "blames", stat.Total)
+			continue
+		}
+
+		emissionsKeeper.SlashObserverEmission(ctx, stat.Operator, params.ObserverSlashAmount)
+		slashed = append(slashed, &types.ObserverEmission{
+			EmissionType:    types.EmissionType_Slash,
+			ObserverAddress: stat.Operator,
+			Amount:          params.ObserverSlashAmount,
+		})
+	}
+
+	if len(slashed) > 0 {
+		keeper.EmitObserverEmissions(ctx, slashed)
+	}
+}
+
 // DistributeTSSRewards trasferes the allocated rewards to the Undistributed Tss Rewards Pool.
 // This is done so that the reserves factor is properly calculated in the next block
 func DistributeTSSRewards(ctx sdk.Context, amount sdkmath.Int, bankKeeper types.BankKeeper) error {
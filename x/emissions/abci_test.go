@@ -636,6 +636,84 @@ func TestDistributeObserverRewards(t *testing.T) {
 	}
 }
 
+func TestSlashBlamedObservers(t *testing.T) {
+	// setup creates two node accounts, the first one blamed three times and the second one once
+	setup := func(t *testing.T) (*emissionskeeper.Keeper, sdk.Context, []observertypes.NodeAccount) {
+		k, ctx, _, zk := keepertest.EmissionsKeeper(t)
+		ctx = ctx.WithBlockHeight(100)
+
+		nodeAccounts := []observertypes.NodeAccount{*sample.NodeAccount(), *sample.NodeAccount()}
+		for _, nodeAccount := range nodeAccounts {
+			zk.ObserverKeeper.SetNodeAccount(ctx, nodeAccount)
+			k.SetWithdrawableEmission(ctx, emissionstypes.WithdrawableEmissions{
+				Address: nodeAccount.Operator,
+				Amount:  sdkmath.NewInt(100),
+			})
+		}
+		pubKeys := []string{
+			nodeAccounts[0].GranteePubkey.Secp256k1.String(),
+			nodeAccounts[1].GranteePubkey.Secp256k1.String(),
+		}
+
+		for i, nodes := range [][]string{pubKeys, pubKeys[:1], pubKeys[:1]} {
+			zk.ObserverKeeper.SetBlameEvent(ctx, observertypes.BlameEvent{
+				BlameIndex:    sample.ZetaIndex(t),
+				ChainId:       1,
+				FailureReason: "Tss timeout",
+				PubKeys:       nodes,
+				Height:        int64(95 + i),
+			})
+		}
+
+		return k, ctx, nodeAccounts
+	}
+
+	withdrawableEmission := func(t *testing.T, k *emissionskeeper.Keeper, ctx sdk.Context, address string) int64 {
+		emission, found := k.GetWithdrawableEmission(ctx, address)
+		require.True(t, found)
+		return emission.Amount.Int64()
+	}
+
+	t.Run("slash observers blamed at least threshold times at the end of the window", func(t *testing.T) {
+		k, ctx, nodeAccounts := setup(t)
+		params := emissionstypes.DefaultParams()
+		params.ObserverSlashAmount = sdkmath.NewInt(25)
+		params.BlameSlashThreshold = 3
+		params.BlameSlashWindow = 10
+
+		emissions.SlashBlamedObservers(ctx, *k, params)
+
+		require.EqualValues(t, 75, withdrawableEmission(t, k, ctx, nodeAccounts[0].Operator))
+		require.EqualValues(t, 100, withdrawableEmission(t, k, ctx, nodeAccounts[1].Operator))
+	})
+
+	t.Run("no slashing if disabled", func(t *testing.T) {
+		k, ctx, nodeAccounts := setup(t)
+		params := emissionstypes.DefaultParams()
+		params.ObserverSlashAmount = sdkmath.NewInt(25)
+		params.BlameSlashThreshold = 0
+		params.BlameSlashWindow = 10
+
+		emissions.SlashBlamedObservers(ctx, *k, params)
+
+		require.EqualValues(t, 100, withdrawableEmission(t, k, ctx, nodeAccounts[0].Operator))
+		require.EqualValues(t, 100, withdrawableEmission(t, k, ctx, nodeAccounts[1].Operator))
+	})
+
+	t.Run("no slashing before the end of the window", func(t *testing.T) {
+		k, ctx, nodeAccounts := setup(t)
+		params := emissionstypes.DefaultParams()
+		params.ObserverSlashAmount = sdkmath.NewInt(25)
+		params.BlameSlashThreshold = 1
+		params.BlameSlashWindow = 30
+
+		emissions.SlashBlamedObservers(ctx, *k, params)
+
+		require.EqualValues(t, 100, withdrawableEmission(t, k, ctx, nodeAccounts[0].Operator))
+		require.EqualValues(t, 100, withdrawableEmission(t, k, ctx, nodeAccounts[1].Operator))
+	})
+}
+
 // setEmissionsParams sets the emissions params in the store without validation
 func setEmissionsParams(t *testing.T, ctx sdk.Context, k emissionskeeper.Keeper, params emissionstypes.Params) {
 	store := ctx.KVStore(k.GetStoreKey())
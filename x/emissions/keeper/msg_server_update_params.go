@@ -26,6 +26,18 @@ func (k msgServer) UpdateParams(
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the matured ballots must not be deleted before the observer module tracks the participation of their voters
+	liveness := k.GetObserverKeeper().GetLivenessParams(ctx)
+	if liveness.Enabled && msg.Params.BallotMaturityBlocks < liveness.BallotMaturityBlocks {
+		return nil, errors.Wrapf(
+			types.ErrUnableToSetParams,
+			"ballot maturity blocks %d is lower than the observer liveness ballot maturity blocks %d",
+			msg.Params.BallotMaturityBlocks,
+			liveness.BallotMaturityBlocks,
+		)
+	}
+
 	err := k.SetParams(ctx, msg.Params)
 	if err != nil {
 		return nil, errors.Wrap(types.ErrUnableToSetParams, err.Error())
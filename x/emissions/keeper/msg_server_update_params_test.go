@@ -63,6 +63,20 @@ func TestMsgServer_UpdateParams(t *testing.T) {
 			Params:    params,
 		})
 
+		require.ErrorIs(t, err, types.ErrUnableToSetParams)
+	})
+	t.Run("fail if ballot maturity blocks is lower than the observer liveness ballot maturity", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.EmissionsKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		liveness := sample.LivenessParams()
+		zk.ObserverKeeper.SetLivenessParams(ctx, liveness)
+		params := types.DefaultParams()
+		params.BallotMaturityBlocks = liveness.BallotMaturityBlocks - 1
+		_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+			Authority: k.GetAuthority(),
+			Params:    params,
+		})
+
 		require.ErrorIs(t, err, types.ErrUnableToSetParams)
 	})
 }
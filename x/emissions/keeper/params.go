@@ -21,6 +21,15 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params, found bool) {
 	return params, true
 }
 
+// GetBallotMaturityBlocks returns the number of blocks after which the ballots are matured and deleted
+func (k Keeper) GetBallotMaturityBlocks(ctx sdk.Context) int64 {
+	params, found := k.GetParams(ctx)
+	if !found {
+		return int64(types.BallotMaturityBlocks)
+	}
+	return params.BallotMaturityBlocks
+}
+
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	if err := params.Validate(); err != nil {
@@ -25,6 +25,8 @@ type ObserverKeeper interface {
 	GetSupportedChains(ctx sdk.Context) []chains.Chain
 	GetNodeAccount(ctx sdk.Context, address string) (observertypes.NodeAccount, bool)
 	GetAllNodeAccount(ctx sdk.Context) []observertypes.NodeAccount
+	GetBlameStats(ctx sdk.Context, startHeight, endHeight int64) []observertypes.NodeBlameStats
+	GetLivenessParams(ctx sdk.Context) observertypes.LivenessParams
 }
 
 // BankKeeper defines the expected interface needed to retrieve account balances.
@@ -56,4 +56,7 @@ var (
 	//(in addition to BallotMaturityBlocks)
 	// that we use only for pending ballots before deleting them
 	PendingBallotsBufferBlocks = int64(432000) // 10 days(60 * 60 * 24 * 10)
+	// BlameSlashWindow is the number of blocks over which TSS blames are counted for slashing
+	// blame slashing itself is disabled by default with a zero BlameSlashThreshold
+	BlameSlashWindow = int64(43200) // approximately 1 day
 )
@@ -5,6 +5,8 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	"gopkg.in/yaml.v2"
+
+	observertypes "github.com/zeta-chain/node/x/observer/types"
 )
 
 // NewParams creates a new Params instance
@@ -17,6 +19,7 @@ func NewParams() Params {
 		BallotMaturityBlocks:               int64(BallotMaturityBlocks),
 		BlockRewardAmount:                  BlockReward,
 		PendingBallotsDeletionBufferBlocks: PendingBallotsBufferBlocks,
+		BlameSlashWindow:                   BlameSlashWindow,
 	}
 }
 
@@ -45,6 +48,9 @@ func (p Params) Validate() error {
 	if err := validatePendingBallotsBufferBlocks(p.PendingBallotsDeletionBufferBlocks); err != nil {
 		return err
 	}
+	if err := validateBlameSlash(p.BlameSlashThreshold, p.BlameSlashWindow); err != nil {
+		return err
+	}
 	return validateObserverSlashAmount(p.ObserverSlashAmount)
 }
 
@@ -141,6 +147,18 @@ func validatePendingBallotsBufferBlocks(i interface{}) error {
 	return nil
 }
 
+func validateBlameSlash(threshold uint64, window int64) error {
+	if window < 0 {
+		return fmt.Errorf("blame slash window must not be negative")
+	}
+
+	// the window is only used when blame slashing is enabled
+	if threshold > 0 && (window == 0 || window > observertypes.MaxBlameStatsWindow) {
+		return fmt.Errorf("blame slash window must be between 1 and %d blocks", observertypes.MaxBlameStatsWindow)
+	}
+	return nil
+}
+
 func validateBlockRewardsAmount(i interface{}) error {
 	v, ok := i.(sdkmath.LegacyDec)
 	if !ok {
@@ -42,6 +42,12 @@ type Params struct {
 	BallotMaturityBlocks               int64                       `protobuf:"varint,10,opt,name=ballot_maturity_blocks,json=ballotMaturityBlocks,proto3" json:"ballot_maturity_blocks,omitempty"`
 	BlockRewardAmount                  cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=block_reward_amount,json=blockRewardAmount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"block_reward_amount"`
 	PendingBallotsDeletionBufferBlocks int64                       `protobuf:"varint,12,opt,name=pending_ballots_deletion_buffer_blocks,json=pendingBallotsDeletionBufferBlocks,proto3" json:"pending_ballots_deletion_buffer_blocks,omitempty"`
+	// Observers blamed at least this many times for failed TSS ceremonies
+	// within a blame slash window are slashed ObserverSlashAmount, 0 disables it
+	BlameSlashThreshold uint64 `protobuf:"varint,13,opt,name=blame_slash_threshold,json=blameSlashThreshold,proto3" json:"blame_slash_threshold,omitempty"`
+	// Number of blocks in a blame slash window, offenders are evaluated
+	// once at the end of each window
+	BlameSlashWindow int64 `protobuf:"varint,14,opt,name=blame_slash_window,json=blameSlashWindow,proto3" json:"blame_slash_window,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -111,6 +117,20 @@ func (m *Params) GetPendingBallotsDeletionBufferBlocks() int64 {
 	return 0
 }
 
+func (m *Params) GetBlameSlashThreshold() uint64 {
+	if m != nil {
+		return m.BlameSlashThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetBlameSlashWindow() int64 {
+	if m != nil {
+		return m.BlameSlashWindow
+	}
+	return 0
+}
+
 // Deprecated (v20): Do not use. Use Params Instead
 type LegacyParams struct {
 	MaxBondFactor               string                `protobuf:"bytes,1,opt,name=max_bond_factor,json=maxBondFactor,proto3" json:"max_bond_factor,omitempty"`
@@ -230,43 +250,46 @@ func init() {
 }
 
 var fileDescriptor_259272924aec0acf = []byte{
-	// 569 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x1b, 0x96, 0x8d, 0xce, 0x0c, 0xb6, 0x65, 0x7f, 0x14, 0xed, 0x4f, 0x36, 0x15, 0x34,
-	0x0d, 0x04, 0xc9, 0x01, 0x0e, 0x88, 0x13, 0x64, 0x63, 0x12, 0x13, 0x48, 0x23, 0xe5, 0xc4, 0xc5,
-	0x72, 0x12, 0x37, 0xb5, 0x96, 0xd8, 0x95, 0xed, 0x96, 0x96, 0x4f, 0xc1, 0x0d, 0x8e, 0x7c, 0x18,
-	0x0e, 0x3b, 0xee, 0x88, 0x38, 0x4c, 0xa8, 0xfd, 0x22, 0x28, 0xaf, 0x93, 0x6a, 0x88, 0x72, 0x47,
-	0xdc, 0x2c, 0x3f, 0xbf, 0xe7, 0xe9, 0x53, 0xe7, 0xd5, 0x8b, 0xee, 0x7f, 0xa4, 0x9a, 0x24, 0x5d,
-	0xc2, 0x78, 0x00, 0x27, 0x21, 0x69, 0x40, 0x0b, 0xa6, 0x14, 0x13, 0x5c, 0x05, 0x3d, 0x22, 0x49,
-	0xa1, 0xfc, 0x9e, 0x14, 0x5a, 0x38, 0x3b, 0x53, 0xd4, 0xaf, 0x51, 0x7f, 0x8a, 0x6e, 0xad, 0x67,
-	0x22, 0x13, 0x00, 0x06, 0xe5, 0xc9, 0x78, 0x5a, 0x9f, 0x6d, 0xb4, 0x70, 0x06, 0x21, 0x4e, 0x88,
-	0x76, 0x07, 0x24, 0x67, 0x29, 0xd1, 0x42, 0xe2, 0xda, 0x87, 0x7b, 0x54, 0x26, 0x94, 0x6b, 0x92,
-	0x51, 0x77, 0x7e, 0xdf, 0x3a, 0x5c, 0x8c, 0xb6, 0xa7, 0xd0, 0xcb, 0x8a, 0x39, 0x9b, 0x22, 0xce,
-	0x73, 0xb4, 0x23, 0x62, 0x45, 0xe5, 0x80, 0xce, 0x8e, 0x58, 0x80, 0x88, 0xad, 0x9a, 0x99, 0x91,
-	0x70, 0x84, 0x3c, 0xad, 0x14, 0x56, 0x2c, 0xe3, 0x7f, 0xc9, 0xb8, 0x69, 0x6a, 0x68, 0xa5, 0xda,
-	0x00, 0xcd, 0x08, 0x79, 0x8b, 0x36, 0xa6, 0x35, 0x54, 0x4e, 0x54, 0x17, 0x93, 0x42, 0xf4, 0xb9,
-	0x76, 0x17, 0x4b, 0x6f, 0xb8, 0x7b, 0x71, 0xb5, 0xd7, 0xf8, 0x71, 0xb5, 0xb7, 0x91, 0x08, 0x55,
-	0x08, 0xa5, 0xd2, 0x73, 0x9f, 0x89, 0xa0, 0x20, 0xba, 0xeb, 0xbf, 0xe2, 0x3a, 0x5a, 0xab, 0xbd,
-	0xed, 0xd2, 0xfa, 0x02, 0x9c, 0xce, 0x13, 0xb4, 0x19, 0x93, 0x3c, 0x17, 0x1a, 0x17, 0x44, 0xf7,
-	0x25, 0xd3, 0x23, 0x1c, 0xe7, 0x22, 0x39, 0x57, 0x2e, 0xda, 0xb7, 0x0e, 0xe7, 0xa2, 0x75, 0xa3,
-	0xbe, 0xa9, 0xc4, 0x10, 0x34, 0xa7, 0x8d, 0xd6, 0x80, 0xc2, 0x92, 0x7e, 0x20, 0x32, 0xad, 0x6b,
-	0xdc, 0x82, 0x1a, 0x77, 0xab, 0x1a, 0xdb, 0x7f, 0xd6, 0x78, 0x4d, 0x33, 0x92, 0x8c, 0x8e, 0x69,
-	0x12, 0xad, 0x82, 0x3f, 0x02, 0x7b, 0x55, 0x25, 0x42, 0x07, 0x3d, 0xca, 0x53, 0xc6, 0x33, 0x6c,
-	0x7e, 0x54, 0xe1, 0x94, 0xe6, 0x54, 0x97, 0xef, 0x14, 0xf7, 0x3b, 0x1d, 0x2a, 0xeb, 0x6a, 0x4b,
-	0x50, 0xad, 0x55, 0xd1, 0xa1, 0x81, 0x8f, 0x2b, 0x36, 0x04, 0xd4, 0x14, 0x7d, 0x66, 0x7f, 0xf9,
-	0xba, 0xd7, 0x38, 0xb5, 0x9b, 0xd6, 0xca, 0xfc, 0xa9, 0xdd, 0x6c, 0xae, 0x2c, 0xb6, 0xbe, 0xd9,
-	0x68, 0xc9, 0xd4, 0xa8, 0xe6, 0xe3, 0x00, 0x2d, 0x17, 0x64, 0x88, 0x63, 0xc1, 0x53, 0xdc, 0x21,
-	0x89, 0x16, 0xd2, 0xb5, 0xe0, 0x53, 0xdc, 0x2e, 0xc8, 0x30, 0x14, 0x3c, 0x3d, 0x81, 0x4b, 0xe0,
-	0x18, 0xff, 0x8d, 0xbb, 0x51, 0x71, 0x8c, 0x5f, 0xe3, 0xee, 0xa1, 0x3b, 0x64, 0x90, 0x99, 0xaa,
-	0x58, 0xb3, 0x82, 0xba, 0x73, 0x80, 0x2d, 0x91, 0x41, 0x06, 0xad, 0xde, 0xb1, 0x82, 0x3a, 0x0f,
-	0xd0, 0xaa, 0x26, 0x32, 0xa3, 0xda, 0x04, 0x4a, 0xa2, 0x99, 0x70, 0x6d, 0x00, 0x97, 0x8d, 0x50,
-	0x46, 0x46, 0xe5, 0xf5, 0xff, 0x34, 0xc1, 0x4f, 0x91, 0x9b, 0xf6, 0xe1, 0xcf, 0xf2, 0xea, 0x11,
-	0x71, 0x22, 0xb8, 0xd2, 0x84, 0x6b, 0xb7, 0x09, 0xf6, 0xcd, 0x5a, 0x37, 0xcf, 0x79, 0x54, 0xa9,
-	0xff, 0xcc, 0xec, 0x9b, 0x91, 0x0a, 0x4f, 0x2e, 0xc6, 0x9e, 0x75, 0x39, 0xf6, 0xac, 0x9f, 0x63,
-	0xcf, 0xfa, 0x34, 0xf1, 0x1a, 0x97, 0x13, 0xaf, 0xf1, 0x7d, 0xe2, 0x35, 0xde, 0x3f, 0xcc, 0x98,
-	0xee, 0xf6, 0x63, 0x3f, 0x11, 0x05, 0xac, 0xb6, 0x47, 0x66, 0xcb, 0x71, 0x91, 0xd2, 0x60, 0x78,
-	0x6d, 0xc7, 0xe9, 0x51, 0x8f, 0xaa, 0x78, 0x01, 0xf6, 0xd5, 0xe3, 0x5f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xbb, 0x57, 0xb1, 0x6c, 0x10, 0x05, 0x00, 0x00,
+	// 618 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x94, 0x4d, 0x4f, 0xd4, 0x40,
+	0x1c, 0xc6, 0xb7, 0x52, 0x70, 0x19, 0x79, 0x2d, 0x2f, 0x69, 0x78, 0x29, 0x04, 0x0d, 0x41, 0x83,
+	0xbb, 0x89, 0x7a, 0x30, 0x9e, 0xb4, 0x20, 0x89, 0x44, 0x13, 0x2c, 0x24, 0x26, 0x5e, 0x26, 0xd3,
+	0x76, 0xe8, 0x4e, 0xe8, 0xcc, 0x6c, 0x66, 0x66, 0x17, 0xf0, 0x53, 0x78, 0xf4, 0xe8, 0x87, 0xf1,
+	0xc0, 0x91, 0xa3, 0xf1, 0x40, 0x0c, 0x7c, 0x0a, 0x6f, 0xa6, 0xff, 0x99, 0xae, 0x18, 0xf1, 0x6e,
+	0xbc, 0x35, 0xf3, 0xfc, 0x9e, 0xa7, 0xcf, 0xfc, 0xdb, 0x19, 0x74, 0xff, 0x03, 0x35, 0x24, 0xeb,
+	0x10, 0x26, 0xda, 0xf0, 0x24, 0x15, 0x6d, 0x53, 0xce, 0xb4, 0x66, 0x52, 0xe8, 0x76, 0x97, 0x28,
+	0xc2, 0x75, 0xab, 0xab, 0xa4, 0x91, 0xc1, 0xd2, 0x00, 0x6d, 0xd5, 0x68, 0x6b, 0x80, 0x2e, 0xcc,
+	0x16, 0xb2, 0x90, 0x00, 0xb6, 0xab, 0x27, 0xeb, 0x59, 0xfb, 0xe1, 0xa3, 0x91, 0x3d, 0x08, 0x09,
+	0x62, 0xb4, 0xdc, 0x27, 0x25, 0xcb, 0x89, 0x91, 0x0a, 0xd7, 0x3e, 0xdc, 0xa5, 0x2a, 0xa3, 0xc2,
+	0x90, 0x82, 0x86, 0xc3, 0xab, 0xde, 0xc6, 0x68, 0xb2, 0x38, 0x80, 0x5e, 0x3a, 0x66, 0x6f, 0x80,
+	0x04, 0xcf, 0xd1, 0x92, 0x4c, 0x35, 0x55, 0x7d, 0x7a, 0x73, 0xc4, 0x08, 0x44, 0x2c, 0xd4, 0xcc,
+	0x0d, 0x09, 0x5b, 0x28, 0x32, 0x5a, 0x63, 0xcd, 0x0a, 0xf1, 0x97, 0x8c, 0xdb, 0xb6, 0x86, 0xd1,
+	0x7a, 0x1f, 0xa0, 0x1b, 0x42, 0xde, 0xa2, 0xb9, 0x41, 0x0d, 0x5d, 0x12, 0xdd, 0xc1, 0x84, 0xcb,
+	0x9e, 0x30, 0xe1, 0x68, 0xe5, 0x8d, 0x97, 0xcf, 0x2e, 0x56, 0x1a, 0xdf, 0x2e, 0x56, 0xe6, 0x32,
+	0xa9, 0xb9, 0xd4, 0x3a, 0x3f, 0x6a, 0x31, 0xd9, 0xe6, 0xc4, 0x74, 0x5a, 0xaf, 0x84, 0x49, 0x66,
+	0x6a, 0xef, 0x7e, 0x65, 0x7d, 0x01, 0xce, 0xe0, 0x09, 0x9a, 0x4f, 0x49, 0x59, 0x4a, 0x83, 0x39,
+	0x31, 0x3d, 0xc5, 0xcc, 0x29, 0x4e, 0x4b, 0x99, 0x1d, 0xe9, 0x10, 0xad, 0x7a, 0x1b, 0x43, 0xc9,
+	0xac, 0x55, 0xdf, 0x38, 0x31, 0x06, 0x2d, 0xd8, 0x47, 0x33, 0x40, 0x61, 0x45, 0x8f, 0x89, 0xca,
+	0xeb, 0x1a, 0x77, 0xa0, 0xc6, 0x5d, 0x57, 0x63, 0xf1, 0xcf, 0x1a, 0xaf, 0x69, 0x41, 0xb2, 0xd3,
+	0x6d, 0x9a, 0x25, 0xd3, 0xe0, 0x4f, 0xc0, 0xee, 0xaa, 0x24, 0x68, 0xbd, 0x4b, 0x45, 0xce, 0x44,
+	0x81, 0xed, 0x4b, 0x35, 0xce, 0x69, 0x49, 0x4d, 0x35, 0xa7, 0xb4, 0x77, 0x78, 0x48, 0x55, 0x5d,
+	0x6d, 0x0c, 0xaa, 0xad, 0x39, 0x3a, 0xb6, 0xf0, 0xb6, 0x63, 0x63, 0x40, 0x5d, 0xd1, 0x47, 0x68,
+	0x2e, 0x2d, 0x09, 0xa7, 0x6e, 0x5c, 0xa6, 0xa3, 0xa8, 0xee, 0xc8, 0x32, 0x0f, 0xc7, 0x57, 0xbd,
+	0x0d, 0x3f, 0x99, 0x01, 0x11, 0xe6, 0x71, 0x50, 0x4b, 0xc1, 0x26, 0x0a, 0xae, 0x7b, 0x8e, 0x99,
+	0xc8, 0xe5, 0x71, 0x38, 0x01, 0xef, 0x9c, 0xfa, 0x65, 0x78, 0x07, 0xeb, 0xcf, 0xfc, 0x4f, 0x9f,
+	0x57, 0x1a, 0xbb, 0x7e, 0xd3, 0x9b, 0x1a, 0xde, 0xf5, 0x9b, 0xcd, 0xa9, 0xd1, 0xb5, 0x2f, 0x3e,
+	0x1a, 0xb3, 0x1b, 0x75, 0x7f, 0xe0, 0x3a, 0x9a, 0xe4, 0xe4, 0x04, 0xa7, 0x52, 0xe4, 0xf8, 0x90,
+	0x64, 0x46, 0xaa, 0xd0, 0x83, 0x8f, 0x3d, 0xce, 0xc9, 0x49, 0x2c, 0x45, 0xbe, 0x03, 0x8b, 0xc0,
+	0x31, 0xf1, 0x1b, 0x77, 0xcb, 0x71, 0x4c, 0x5c, 0xe3, 0xee, 0xa1, 0x09, 0xd2, 0x2f, 0xec, 0x30,
+	0xb0, 0x61, 0x9c, 0x86, 0x43, 0x80, 0x8d, 0x91, 0x7e, 0x01, 0xfb, 0x3e, 0x60, 0x9c, 0x06, 0x0f,
+	0xd0, 0xb4, 0x21, 0xaa, 0xa0, 0xc6, 0x06, 0x2a, 0x62, 0x98, 0x0c, 0x7d, 0x00, 0x27, 0xad, 0x50,
+	0x45, 0x26, 0xd5, 0xf2, 0xff, 0x74, 0x46, 0x9e, 0xa2, 0x30, 0xef, 0xc1, 0x66, 0x85, 0x1b, 0x22,
+	0xce, 0xa4, 0xd0, 0x86, 0x08, 0x13, 0x36, 0xc1, 0x3e, 0x5f, 0xeb, 0x76, 0x9c, 0x5b, 0x4e, 0xfd,
+	0x67, 0x4e, 0x97, 0xfd, 0xa5, 0xe2, 0x9d, 0xb3, 0xcb, 0xc8, 0x3b, 0xbf, 0x8c, 0xbc, 0xef, 0x97,
+	0x91, 0xf7, 0xf1, 0x2a, 0x6a, 0x9c, 0x5f, 0x45, 0x8d, 0xaf, 0x57, 0x51, 0xe3, 0xfd, 0x66, 0xc1,
+	0x4c, 0xa7, 0x97, 0xb6, 0x32, 0xc9, 0xe1, 0xf2, 0x7c, 0x68, 0xef, 0x51, 0x21, 0x73, 0xda, 0x3e,
+	0xb9, 0x76, 0x8b, 0x9a, 0xd3, 0x2e, 0xd5, 0xe9, 0x08, 0xdc, 0x88, 0x8f, 0x7f, 0x06, 0x00, 0x00,
+	0xff, 0xff, 0x0e, 0xa3, 0xc7, 0x96, 0x72, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -289,6 +312,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BlameSlashWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BlameSlashWindow))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.BlameSlashThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BlameSlashThreshold))
+		i--
+		dAtA[i] = 0x68
+	}
 	if m.PendingBallotsDeletionBufferBlocks != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.PendingBallotsDeletionBufferBlocks))
 		i--
@@ -476,6 +509,12 @@ func (m *Params) Size() (n int) {
 	if m.PendingBallotsDeletionBufferBlocks != 0 {
 		n += 1 + sovParams(uint64(m.PendingBallotsDeletionBufferBlocks))
 	}
+	if m.BlameSlashThreshold != 0 {
+		n += 1 + sovParams(uint64(m.BlameSlashThreshold))
+	}
+	if m.BlameSlashWindow != 0 {
+		n += 1 + sovParams(uint64(m.BlameSlashWindow))
+	}
 	return n
 }
 
@@ -762,6 +801,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameSlashThreshold", wireType)
+			}
+			m.BlameSlashThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlameSlashThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameSlashWindow", wireType)
+			}
+			m.BlameSlashWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlameSlashWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -6,6 +6,8 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v2"
+
+	observertypes "github.com/zeta-chain/node/x/observer/types"
 )
 
 func TestNewParams(t *testing.T) {
@@ -76,6 +78,15 @@ func TestValidateBlockRewardAmount(t *testing.T) {
 	require.NoError(t, validateBlockRewardsAmount(BlockReward))
 }
 
+func TestValidateBlameSlash(t *testing.T) {
+	require.NoError(t, validateBlameSlash(0, 0))
+	require.NoError(t, validateBlameSlash(0, BlameSlashWindow))
+	require.NoError(t, validateBlameSlash(5, BlameSlashWindow))
+	require.Error(t, validateBlameSlash(0, -1))
+	require.Error(t, validateBlameSlash(5, 0))
+	require.Error(t, validateBlameSlash(5, observertypes.MaxBlameStatsWindow+1))
+}
+
 func TestValidate(t *testing.T) {
 	t.Run("should validate", func(t *testing.T) {
 		params := NewParams()
@@ -123,6 +134,13 @@ func TestValidate(t *testing.T) {
 		params.PendingBallotsDeletionBufferBlocks = -100
 		require.Error(t, params.Validate())
 	})
+
+	t.Run("should error if blame slashing is enabled without a window", func(t *testing.T) {
+		params := NewParams()
+		params.BlameSlashThreshold = 5
+		params.BlameSlashWindow = 0
+		require.Error(t, params.Validate())
+	})
 }
 func TestParamsString(t *testing.T) {
 	params := DefaultParams()
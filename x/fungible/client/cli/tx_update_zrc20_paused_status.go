@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -11,6 +12,8 @@ import (
 	"github.com/zeta-chain/node/x/fungible/types"
 )
 
+const flagDirection = "direction"
+
 func CmdPauseZRC20() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "pause-zrc20 [contractAddress1, contractAddress2, ...]",
@@ -30,10 +33,20 @@ func CmdPauseZRC20() *cobra.Command {
 				contractAddressList,
 			)
 
+			directionFlag, err := cmd.Flags().GetString(flagDirection)
+			if err != nil {
+				return err
+			}
+			msg.Direction, err = parsePauseDirection(directionFlag)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(flagDirection, "all", "direction of the transfers: all, deposits or withdrawals")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -58,11 +71,35 @@ func CmdUnpauseZRC20() *cobra.Command {
 				contractAddressList,
 			)
 
+			directionFlag, err := cmd.Flags().GetString(flagDirection)
+			if err != nil {
+				return err
+			}
+			msg.Direction, err = parsePauseDirection(directionFlag)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(flagDirection, "all", "direction of the transfers: all, deposits or withdrawals")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// parsePauseDirection parses the direction of the transfers to pause or unpause
+func parsePauseDirection(direction string) (types.ZRC20PauseDirection, error) {
+	switch direction {
+	case "all":
+		return types.ZRC20PauseDirection_ALL_DIRECTIONS, nil
+	case "deposits":
+		return types.ZRC20PauseDirection_DEPOSITS, nil
+	case "withdrawals":
+		return types.ZRC20PauseDirection_WITHDRAWALS, nil
+	default:
+		return types.ZRC20PauseDirection_ALL_DIRECTIONS, fmt.Errorf("invalid direction %s", direction)
+	}
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"math/big"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/zeta-chain/node/pkg/coin"
+	"github.com/zeta-chain/node/x/fungible/types"
+)
+
+// ZRC20CirculatingSupply returns the supply of the ZRC20 of a foreign coin that is not owned by the protocol
+// The protocol owns the ZRC20 held by the fungible module and the gas stability pool, and for gas coins the liquidity
+// minted into the ZETA/gas pool at the setup of the coin, these amounts are minted without a deposit on the foreign chain
+// The returned supply is zero if the ZRC20 contract is not deployed, it can be negative if the pool liquidity was burnt
+func (k Keeper) ZRC20CirculatingSupply(ctx sdk.Context, foreignCoin types.ForeignCoins) (*big.Int, error) {
+	zrc20Addr := ethcommon.HexToAddress(foreignCoin.Zrc20ContractAddress)
+	acc := k.evmKeeper.GetAccount(ctx, zrc20Addr)
+	if acc == nil || !acc.IsContract() {
+		return big.NewInt(0), nil
+	}
+
+	supply, err := k.TotalSupplyZRC4(ctx, zrc20Addr)
+	if err != nil {
+		return nil, cosmoserrors.Wrapf(err, "failed to query total supply of %s", foreignCoin.Zrc20ContractAddress)
+	}
+	circulating := new(big.Int).Set(supply)
+
+	for _, owner := range []ethcommon.Address{types.ModuleAddressEVM, types.GasStabilityPoolAddressEVM()} {
+		balance, err := k.BalanceOfZRC4(ctx, zrc20Addr, owner)
+		if err != nil {
+			return nil, cosmoserrors.Wrapf(
+				err,
+				"failed to query balance of %s for %s",
+				owner.Hex(),
+				foreignCoin.Zrc20ContractAddress,
+			)
+		}
+		circulating.Sub(circulating, balance)
+	}
+
+	if foreignCoin.CoinType == coin.CoinType_Gas {
+		// #nosec G115 decimals are validated at the deployment of the coin
+		circulating.Sub(circulating, types.GasCoinPoolLiquidity(uint8(foreignCoin.Decimals)))
+	}
+
+	return circulating, nil
+}
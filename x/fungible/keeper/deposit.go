@@ -39,11 +39,16 @@ func (k Keeper) ZRC20DepositAndCallContract(
 	isCrossChainCall bool,
 ) (*evmtypes.MsgEthereumTxResponse, bool, error) {
 	// get ZRC20 contract
-	zrc20Contract, _, err := k.getAndCheckZRC20(ctx, amount, senderChainID, coinType, asset)
+	zrc20Contract, foreignCoin, err := k.getAndCheckZRC20(ctx, amount, senderChainID, coinType, asset)
 	if err != nil {
 		return nil, false, err
 	}
 
+	// deposits paused individually only apply to the transfer of assets, calls can still be processed
+	if coinType != coin.CoinType_NoAssetCall && foreignCoin.DepositsPaused {
+		return nil, false, types.ErrPausedZRC20Deposits
+	}
+
 	// handle the deposit for protocol contract version 2
 	if protocolContractVersion == crosschaintypes.ProtocolContractVersion_V2 {
 		return k.ProcessDeposit(
@@ -322,6 +322,78 @@ func TestKeeper_ZRC20DepositAndCallContract(t *testing.T) {
 		require.ErrorIs(t, err, types.ErrPausedZRC20)
 	})
 
+	t.Run("should fail if coin deposits paused", func(t *testing.T) {
+		// setup gas coin
+		k, ctx, sdkk, _ := keepertest.FungibleKeeper(t)
+		_ = k.GetAuthKeeper().GetModuleAccount(ctx, types.ModuleName)
+
+		chainList := chains.DefaultChainsList()
+		chain := chainList[0].ChainId
+
+		// deploy the system contracts
+		deploySystemContracts(t, ctx, k, sdkk.EvmKeeper)
+		zrc20 := setupGasCoin(t, ctx, k, sdkk.EvmKeeper, chain, "foobar", "foobar")
+
+		// pause the deposits of the coin
+		foreignCoin, found := k.GetForeignCoins(ctx, zrc20.String())
+		require.True(t, found)
+		foreignCoin.DepositsPaused = true
+		k.SetForeignCoins(ctx, foreignCoin)
+
+		to := sample.EthAddress()
+		_, _, err := k.ZRC20DepositAndCallContract(
+			ctx,
+			sample.EthAddress().Bytes(),
+			to,
+			big.NewInt(42),
+			chain,
+			[]byte{},
+			coin.CoinType_Gas,
+			sample.EthAddress().String(),
+			crosschaintypes.ProtocolContractVersion_V2,
+			false,
+		)
+		require.ErrorIs(t, err, types.ErrPausedZRC20Deposits)
+	})
+
+	t.Run("should not fail if coin withdrawals paused", func(t *testing.T) {
+		// setup gas coin
+		k, ctx, sdkk, _ := keepertest.FungibleKeeper(t)
+		_ = k.GetAuthKeeper().GetModuleAccount(ctx, types.ModuleName)
+
+		chainList := chains.DefaultChainsList()
+		chain := chainList[0].ChainId
+
+		// deploy the system contracts
+		deploySystemContracts(t, ctx, k, sdkk.EvmKeeper)
+		zrc20 := setupGasCoin(t, ctx, k, sdkk.EvmKeeper, chain, "foobar", "foobar")
+
+		// pause the withdrawals of the coin
+		foreignCoin, found := k.GetForeignCoins(ctx, zrc20.String())
+		require.True(t, found)
+		foreignCoin.WithdrawalsPaused = true
+		k.SetForeignCoins(ctx, foreignCoin)
+
+		to := sample.EthAddress()
+		_, _, err := k.ZRC20DepositAndCallContract(
+			ctx,
+			sample.EthAddress().Bytes(),
+			to,
+			big.NewInt(42),
+			chain,
+			[]byte{},
+			coin.CoinType_Gas,
+			sample.EthAddress().String(),
+			crosschaintypes.ProtocolContractVersion_V1,
+			false,
+		)
+		require.NoError(t, err)
+
+		balance, err := k.BalanceOfZRC4(ctx, zrc20, to)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(42), balance)
+	})
+
 	t.Run("should fail if liquidity cap reached", func(t *testing.T) {
 		// setup gas coin
 		k, ctx, sdkk, _ := keepertest.FungibleKeeper(t)
@@ -744,9 +744,12 @@ func (k Keeper) CallEVM(
 }
 
 const (
-	AttributeKeyTxNonce    = "txNonce"
-	AttributeKeyTxData     = "txData"
-	AttributeKeyTxGasLimit = "txGasLimit"
+	AttributeKeyTxNonce        = "txNonce"
+	AttributeKeyTxData         = "txData"
+	AttributeKeyTxGasLimit     = "txGasLimit"
+	AttributeKeyCctxIndex      = "cctxIndex"
+	AttributeKeyInboundChainID = "inboundChainId"
+	AttributeKeyInboundHash    = "inboundHash"
 )
 
 // CallEVMWithData performs a smart contract method call using contract data
@@ -878,6 +881,15 @@ func (k Keeper) CallEVMWithData(
 				sdk.NewAttribute(AttributeKeyTxGasLimit, strconv.FormatUint(msg.GasLimit, 10)),
 			)
 
+			// adding the CCTX at the origin of the call to link the synthetic tx to its inbound in rpc methods
+			if origin, ok := types.CCTXOriginFromContext(ctx); ok {
+				attrs = append(attrs,
+					sdk.NewAttribute(AttributeKeyCctxIndex, origin.CctxIndex),
+					sdk.NewAttribute(AttributeKeyInboundChainID, strconv.FormatInt(origin.InboundChainID, 10)),
+					sdk.NewAttribute(AttributeKeyInboundHash, origin.InboundHash),
+				)
+			}
+
 			ctx.EventManager().EmitEvents(sdk.Events{
 				sdk.NewEvent(
 					evmtypes.EventTypeEthereumTx,
@@ -885,6 +885,66 @@ func TestKeeper_CallEVMWithData(t *testing.T) {
 		mockEVMKeeper.AssertExpectations(t)
 	})
 
+	t.Run("apply new message emits the cctx origin of the synthetic tx", func(t *testing.T) {
+		k, ctx := keepertest.FungibleKeeperAllMocks(t)
+
+		mockAuthKeeper := keepertest.GetFungibleAccountMock(t, k)
+		mockEVMKeeper := keepertest.GetFungibleEVMMock(t, k)
+
+		// Set up values
+		fromAddr := sample.EthAddress()
+		origin := types.CCTXOrigin{
+			CctxIndex:      sample.ZetaIndex(t),
+			InboundChainID: 1,
+			InboundHash:    sample.Hash().Hex(),
+		}
+		ctx = types.WithCCTXOrigin(ctx, origin)
+
+		// Set up mocked methods
+		mockAuthKeeper.On(
+			"GetSequence",
+			mock.Anything,
+			sdk.AccAddress(fromAddr.Bytes()),
+		).Return(uint64(1), nil)
+		mockEVMKeeper.MockEVMSuccessCallOnce()
+
+		mockEVMKeeper.On("WithChainID", mock.Anything).Maybe().Return(ctx)
+		mockEVMKeeper.On("ChainID").Maybe().Return(big.NewInt(1))
+		mockEVMKeeper.On("SetBlockBloomTransient", mock.Anything).Maybe()
+		mockEVMKeeper.On("SetLogSizeTransient", mock.Anything).Maybe()
+		mockEVMKeeper.On("GetLogSizeTransient", mock.Anything, mock.Anything).Maybe()
+
+		// Call the method
+		contractAddress := sample.EthAddress()
+		_, err := k.CallEVMWithData(
+			ctx,
+			fromAddr,
+			&contractAddress,
+			sample.Bytes(),
+			true,
+			false,
+			big.NewInt(100),
+			big.NewInt(1000),
+		)
+		require.NoError(t, err)
+
+		// Assert the origin is added to the ethereum tx event
+		var ethTxEvent *sdk.Event
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == evmtypes.EventTypeEthereumTx {
+				ethTxEvent = &event
+			}
+		}
+		require.NotNil(t, ethTxEvent)
+		attrs := make(map[string]string)
+		for _, attr := range ethTxEvent.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+		require.Equal(t, origin.CctxIndex, attrs[fungiblekeeper.AttributeKeyCctxIndex])
+		require.Equal(t, "1", attrs[fungiblekeeper.AttributeKeyInboundChainID])
+		require.Equal(t, origin.InboundHash, attrs[fungiblekeeper.AttributeKeyInboundHash])
+	})
+
 	t.Run("GetSequence failure returns error", func(t *testing.T) {
 		k, ctx := keepertest.FungibleKeeperAllMocks(t)
 
@@ -79,9 +79,7 @@ func (k Keeper) SetupChainGasCoinAndPool(
 	if err != nil {
 		return ethcommon.Address{}, err
 	}
-	amount := big.NewInt(10)
-	// #nosec G115 always in range
-	amount.Exp(amount, big.NewInt(int64(decimals-1)), nil)
+	amount := types.GasCoinPoolLiquidity(decimals)
 	amountAZeta := big.NewInt(1e17)
 
 	_, err = k.DepositZRC20(ctx, zrc20Addr, types.ModuleAddressEVM, amount)
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/node/pkg/coin"
+	"github.com/zeta-chain/node/x/fungible/types"
+)
+
+const (
+	// ForeignCoinsInvariantName is the name of the invariant checking the consistency of the foreign coins
+	ForeignCoinsInvariantName = "foreign-coins"
+)
+
+// RegisterInvariants registers the fungible module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, ForeignCoinsInvariantName, ForeignCoinsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the fungible module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return ForeignCoinsInvariant(k)(ctx)
+	}
+}
+
+// ForeignCoinsInvariant checks that a chain has at most one gas coin, that an asset of a chain is represented by at
+// most one ZRC20 and that the circulating supply of each ZRC20 can be queried
+// The circulating supply is used by the crosschain module to check the custody of the TSS covers the ZRC20
+func ForeignCoinsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		// the EVM queries are run in a cached context to not write state
+		cacheCtx, _ := ctx.CacheContext()
+
+		var (
+			msg        string
+			broken     int
+			gasCoins   = make(map[int64]string)
+			assetCoins = make(map[int64]map[string]string)
+		)
+		for _, fc := range k.GetAllForeignCoins(cacheCtx) {
+			switch fc.CoinType {
+			case coin.CoinType_Gas:
+				if zrc20, found := gasCoins[fc.ForeignChainId]; found {
+					broken++
+					msg += fmt.Sprintf(
+						"\tchain %d has several gas coins: %s and %s\n",
+						fc.ForeignChainId,
+						zrc20,
+						fc.Zrc20ContractAddress,
+					)
+				}
+				gasCoins[fc.ForeignChainId] = fc.Zrc20ContractAddress
+			case coin.CoinType_ERC20:
+				if _, found := assetCoins[fc.ForeignChainId]; !found {
+					assetCoins[fc.ForeignChainId] = make(map[string]string)
+				}
+				asset := strings.ToLower(fc.Asset)
+				if zrc20, found := assetCoins[fc.ForeignChainId][asset]; found {
+					broken++
+					msg += fmt.Sprintf(
+						"\tasset %s of chain %d has several ZRC20: %s and %s\n",
+						fc.Asset,
+						fc.ForeignChainId,
+						zrc20,
+						fc.Zrc20ContractAddress,
+					)
+				}
+				assetCoins[fc.ForeignChainId][asset] = fc.Zrc20ContractAddress
+			}
+
+			if _, err := k.ZRC20CirculatingSupply(cacheCtx, fc); err != nil {
+				broken++
+				msg += fmt.Sprintf("\tcan't get circulating supply of %s: %s\n", fc.Zrc20ContractAddress, err.Error())
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName,
+			ForeignCoinsInvariantName,
+			fmt.Sprintf("%d inconsistent foreign coins found\n%s", broken, msg),
+		), broken != 0
+	}
+}
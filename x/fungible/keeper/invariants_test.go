@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/coin"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/fungible/keeper"
+	"github.com/zeta-chain/node/x/fungible/types"
+)
+
+func TestKeeper_ZRC20CirculatingSupply(t *testing.T) {
+	t.Run("should return zero if the ZRC20 is not deployed", func(t *testing.T) {
+		// arrange
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+		fc := sample.ForeignCoins(t, sample.EthAddress().Hex())
+
+		// act
+		supply, err := k.ZRC20CirculatingSupply(ctx, fc)
+
+		// assert
+		require.NoError(t, err)
+		require.Zero(t, supply.Sign())
+	})
+
+	t.Run("should exclude the pool liquidity and the protocol balances", func(t *testing.T) {
+		// arrange
+		k, ctx, sdkk, _ := keepertest.FungibleKeeper(t)
+		_ = k.GetAuthKeeper().GetModuleAccount(ctx, types.ModuleName)
+		chainID := getValidChainID(t)
+
+		deploySystemContracts(t, ctx, k, sdkk.EvmKeeper)
+		zrc20 := setupGasCoin(t, ctx, k, sdkk.EvmKeeper, chainID, "foobar", "foobar")
+		fc, found := k.GetForeignCoins(ctx, zrc20.Hex())
+		require.True(t, found)
+
+		// only the pool liquidity is minted
+		supply, err := k.ZRC20CirculatingSupply(ctx, fc)
+		require.NoError(t, err)
+		require.Zero(t, supply.Sign())
+
+		// act
+		_, err = k.DepositZRC20(ctx, zrc20, sample.EthAddress(), big.NewInt(100))
+		require.NoError(t, err)
+		_, err = k.DepositZRC20(ctx, zrc20, types.ModuleAddressEVM, big.NewInt(20))
+		require.NoError(t, err)
+		require.NoError(t, k.FundGasStabilityPool(ctx, chainID, big.NewInt(30)))
+		supply, err = k.ZRC20CirculatingSupply(ctx, fc)
+
+		// assert
+		require.NoError(t, err)
+		require.EqualValues(t, int64(100), supply.Int64())
+	})
+}
+
+func TestKeeper_ForeignCoinsInvariant(t *testing.T) {
+	t.Run("should not break for consistent foreign coins", func(t *testing.T) {
+		// arrange
+		k, ctx, sdkk, _ := keepertest.FungibleKeeper(t)
+		_ = k.GetAuthKeeper().GetModuleAccount(ctx, types.ModuleName)
+		chainID := getValidChainID(t)
+
+		deploySystemContracts(t, ctx, k, sdkk.EvmKeeper)
+		setupGasCoin(t, ctx, k, sdkk.EvmKeeper, chainID, "foobar", "foobar")
+		deployZRC20(t, ctx, k, sdkk.EvmKeeper, chainID, "bar", sample.EthAddress().Hex(), "bar")
+
+		// act
+		msg, broken := keeper.ForeignCoinsInvariant(*k)(ctx)
+
+		// assert
+		require.False(t, broken, msg)
+	})
+
+	t.Run("should break if a chain has several gas coins", func(t *testing.T) {
+		// arrange
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+		for i := 0; i < 2; i++ {
+			fc := sample.ForeignCoins(t, sample.EthAddress().Hex())
+			fc.ForeignChainId = 1
+			fc.CoinType = coin.CoinType_Gas
+			k.SetForeignCoins(ctx, fc)
+		}
+
+		// act
+		msg, broken := keeper.ForeignCoinsInvariant(*k)(ctx)
+
+		// assert
+		require.True(t, broken)
+		require.Contains(t, msg, "chain 1 has several gas coins")
+	})
+
+	t.Run("should break if an asset has several ZRC20", func(t *testing.T) {
+		// arrange
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+		asset := sample.EthAddress().Hex()
+		for i := 0; i < 2; i++ {
+			fc := sample.ForeignCoins(t, sample.EthAddress().Hex())
+			fc.ForeignChainId = 1
+			fc.CoinType = coin.CoinType_ERC20
+			fc.Asset = asset
+			k.SetForeignCoins(ctx, fc)
+		}
+
+		// act
+		msg, broken := keeper.ForeignCoinsInvariant(*k)(ctx)
+
+		// assert
+		require.True(t, broken)
+		require.Contains(t, msg, "has several ZRC20")
+	})
+}
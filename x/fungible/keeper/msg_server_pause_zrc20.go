@@ -10,7 +10,10 @@ import (
 	"github.com/zeta-chain/node/x/fungible/types"
 )
 
-// PauseZRC20 pauses a list of ZRC20 tokens
+// PauseZRC20 pauses a list of ZRC20 tokens, either entirely or only their deposits or withdrawals
+// The pause is enforced by zetacore only: the inbounds of a deposit-paused token are reverted and
+// the withdrawals of a withdrawal-paused token are rejected. Observers keep voting on the inbounds
+// so the deposits are refunded, they only don't fast-confirm them.
 // Authorized: admin policy group groupEmergency.
 func (k msgServer) PauseZRC20(
 	goCtx context.Context,
@@ -29,8 +32,8 @@ func (k msgServer) PauseZRC20(
 		if !found {
 			return nil, cosmoserrors.Wrapf(types.ErrForeignCoinNotFound, "foreign coin not found %s", zrc20)
 		}
-		// Set status to paused
-		fc.Paused = true
+		// Set status to paused for the given direction
+		fc.SetPaused(msg.Direction, true)
 		k.SetForeignCoins(ctx, fc)
 	}
 
@@ -39,6 +42,7 @@ func (k msgServer) PauseZRC20(
 			MsgTypeUrl:     sdk.MsgTypeURL(&types.MsgPauseZRC20{}),
 			Zrc20Addresses: msg.Zrc20Addresses,
 			Signer:         msg.Creator,
+			Direction:      msg.Direction,
 		},
 	)
 	if err != nil {
@@ -93,6 +93,46 @@ func TestKeeper_PauseZRC20(t *testing.T) {
 		assertPaused(zrc20C)
 	})
 
+	t.Run("can pause deposits or withdrawals of zrc20", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeperWithMocks(t, keepertest.FungibleMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetFungibleAuthorityMock(t, k)
+
+		zrc20A, zrc20B := sample.EthAddress().String(), sample.EthAddress().String()
+		k.SetForeignCoins(ctx, sample.ForeignCoins(t, zrc20A))
+		k.SetForeignCoins(ctx, sample.ForeignCoins(t, zrc20B))
+
+		// pause deposits of zrc20A
+		msg := types.NewMsgPauseZRC20(admin, []string{zrc20A})
+		msg.Direction = types.ZRC20PauseDirection_DEPOSITS
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+		_, err := msgServer.PauseZRC20(ctx, msg)
+		require.NoError(t, err)
+
+		// pause withdrawals of zrc20B
+		msg = types.NewMsgPauseZRC20(admin, []string{zrc20B})
+		msg.Direction = types.ZRC20PauseDirection_WITHDRAWALS
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+		_, err = msgServer.PauseZRC20(ctx, msg)
+		require.NoError(t, err)
+
+		fcA, found := k.GetForeignCoins(ctx, zrc20A)
+		require.True(t, found)
+		require.False(t, fcA.Paused)
+		require.True(t, fcA.IsDepositPaused())
+		require.False(t, fcA.IsWithdrawalPaused())
+
+		fcB, found := k.GetForeignCoins(ctx, zrc20B)
+		require.True(t, found)
+		require.False(t, fcB.Paused)
+		require.False(t, fcB.IsDepositPaused())
+		require.True(t, fcB.IsWithdrawalPaused())
+	})
+
 	t.Run("should fail if not authorized", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.FungibleKeeperWithMocks(t, keepertest.FungibleMockOptions{
 			UseAuthorityMock: true,
@@ -10,7 +10,7 @@ import (
 	"github.com/zeta-chain/node/x/fungible/types"
 )
 
-// UnpauseZRC20 unpauses the ZRC20 token
+// UnpauseZRC20 unpauses the ZRC20 token, either entirely or only its deposits or withdrawals
 // Authorized: admin policy group groupOperational.
 func (k msgServer) UnpauseZRC20(
 	goCtx context.Context,
@@ -29,8 +29,8 @@ func (k msgServer) UnpauseZRC20(
 		if !found {
 			return nil, cosmoserrors.Wrapf(types.ErrForeignCoinNotFound, "foreign coin not found %s", zrc20)
 		}
-		// Set status to unpaused
-		fc.Paused = false
+		// Set status to unpaused for the given direction
+		fc.SetPaused(msg.Direction, false)
 		k.SetForeignCoins(ctx, fc)
 	}
 
@@ -39,6 +39,7 @@ func (k msgServer) UnpauseZRC20(
 			MsgTypeUrl:     sdk.MsgTypeURL(&types.MsgUnpauseZRC20{}),
 			Zrc20Addresses: msg.Zrc20Addresses,
 			Signer:         msg.Creator,
+			Direction:      msg.Direction,
 		},
 	)
 	if err != nil {
@@ -94,6 +94,49 @@ func TestKeeper_UnpauseZRC20(t *testing.T) {
 		assertUnpaused(zrc20C)
 	})
 
+	t.Run("can unpause deposits or withdrawals of zrc20", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeperWithMocks(t, keepertest.FungibleMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetFungibleAuthorityMock(t, k)
+
+		zrc20A, zrc20B := sample.EthAddress().String(), sample.EthAddress().String()
+		fcA := sample.ForeignCoins(t, zrc20A)
+		fcA.DepositsPaused = true
+		fcA.WithdrawalsPaused = true
+		k.SetForeignCoins(ctx, fcA)
+		fcB := sample.ForeignCoins(t, zrc20B)
+		fcB.Paused = true
+		fcB.DepositsPaused = true
+		k.SetForeignCoins(ctx, fcB)
+
+		// unpause deposits of zrc20A, withdrawals remain paused
+		msg := types.NewMsgUnpauseZRC20(admin, []string{zrc20A})
+		msg.Direction = types.ZRC20PauseDirection_DEPOSITS
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+		_, err := msgServer.UnpauseZRC20(ctx, msg)
+		require.NoError(t, err)
+
+		fcA, found := k.GetForeignCoins(ctx, zrc20A)
+		require.True(t, found)
+		require.False(t, fcA.IsDepositPaused())
+		require.True(t, fcA.IsWithdrawalPaused())
+
+		// unpausing all directions of zrc20B also unpauses its deposits
+		msg = types.NewMsgUnpauseZRC20(admin, []string{zrc20B})
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+		_, err = msgServer.UnpauseZRC20(ctx, msg)
+		require.NoError(t, err)
+
+		fcB, found = k.GetForeignCoins(ctx, zrc20B)
+		require.True(t, found)
+		require.False(t, fcB.IsDepositPaused())
+		require.False(t, fcB.IsWithdrawalPaused())
+	})
+
 	t.Run("should fail if not authorized", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.FungibleKeeperWithMocks(t, keepertest.FungibleMockOptions{
 			UseAuthorityMock: true,
@@ -0,0 +1,82 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	zetasimulation "github.com/zeta-chain/node/testutil/simulation"
+	"github.com/zeta-chain/node/x/fungible/keeper"
+	"github.com/zeta-chain/node/x/fungible/types"
+)
+
+// SimulateMsgPauseZRC20 generates a MsgPauseZRC20 pausing random ZRC20 in a random direction and delivers it
+func SimulateMsgPauseZRC20(k keeper.Keeper) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accounts []simtypes.Account, _ string,
+	) (OperationMsg simtypes.OperationMsg, futureOps []simtypes.FutureOperation, err error) {
+		policyAccount, err := zetasimulation.GetPolicyAccount(ctx, k.GetAuthorityKeeper(), accounts)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgPauseZRC20, err.Error()), nil, nil
+		}
+
+		zrc20Addresses := randomZRC20Addresses(r, ctx, k)
+		if len(zrc20Addresses) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgPauseZRC20, "no foreign coins found"), nil, nil
+		}
+
+		msg := types.MsgPauseZRC20{
+			Creator:        policyAccount.Address.String(),
+			Zrc20Addresses: zrc20Addresses,
+			Direction:      randomPauseDirection(r),
+		}
+
+		err = msg.ValidateBasic()
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgPauseZRC20, "failed to validate basic msg"), nil, err
+		}
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         moduletestutil.MakeTestEncodingConfig().TxConfig,
+			Cdc:           nil,
+			Msg:           &msg,
+			Context:       ctx,
+			SimAccount:    policyAccount,
+			AccountKeeper: k.GetAuthKeeper(),
+			Bankkeeper:    k.GetBankKeeper(),
+			ModuleName:    types.ModuleName,
+		}
+
+		return zetasimulation.GenAndDeliverTxWithRandFees(txCtx, true)
+	}
+}
+
+// randomZRC20Addresses returns the ZRC20 addresses of a random non-empty subset of the foreign coins
+func randomZRC20Addresses(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) []string {
+	foreignCoins := k.GetAllForeignCoins(ctx)
+	if len(foreignCoins) == 0 {
+		return nil
+	}
+
+	perm := r.Perm(len(foreignCoins))[:r.Intn(len(foreignCoins))+1]
+	zrc20Addresses := make([]string, 0, len(perm))
+	for _, i := range perm {
+		zrc20Addresses = append(zrc20Addresses, foreignCoins[i].Zrc20ContractAddress)
+	}
+	return zrc20Addresses
+}
+
+// randomPauseDirection returns a random direction to pause or unpause a ZRC20
+func randomPauseDirection(r *rand.Rand) types.ZRC20PauseDirection {
+	directions := []types.ZRC20PauseDirection{
+		types.ZRC20PauseDirection_ALL_DIRECTIONS,
+		types.ZRC20PauseDirection_DEPOSITS,
+		types.ZRC20PauseDirection_WITHDRAWALS,
+	}
+	return directions[r.Intn(len(directions))]
+}
@@ -0,0 +1,57 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	zetasimulation "github.com/zeta-chain/node/testutil/simulation"
+	"github.com/zeta-chain/node/x/fungible/keeper"
+	"github.com/zeta-chain/node/x/fungible/types"
+)
+
+// SimulateMsgUnpauseZRC20 generates a MsgUnpauseZRC20 unpausing random ZRC20 in a random direction and delivers it
+func SimulateMsgUnpauseZRC20(k keeper.Keeper) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accounts []simtypes.Account, _ string,
+	) (OperationMsg simtypes.OperationMsg, futureOps []simtypes.FutureOperation, err error) {
+		policyAccount, err := zetasimulation.GetPolicyAccount(ctx, k.GetAuthorityKeeper(), accounts)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgUnpauseZRC20, err.Error()), nil, nil
+		}
+
+		zrc20Addresses := randomZRC20Addresses(r, ctx, k)
+		if len(zrc20Addresses) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgUnpauseZRC20, "no foreign coins found"), nil, nil
+		}
+
+		msg := types.MsgUnpauseZRC20{
+			Creator:        policyAccount.Address.String(),
+			Zrc20Addresses: zrc20Addresses,
+			Direction:      randomPauseDirection(r),
+		}
+
+		err = msg.ValidateBasic()
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgUnpauseZRC20, "failed to validate basic msg"), nil, err
+		}
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         moduletestutil.MakeTestEncodingConfig().TxConfig,
+			Cdc:           nil,
+			Msg:           &msg,
+			Context:       ctx,
+			SimAccount:    policyAccount,
+			AccountKeeper: k.GetAuthKeeper(),
+			Bankkeeper:    k.GetBankKeeper(),
+			ModuleName:    types.ModuleName,
+		}
+
+		return zetasimulation.GenAndDeliverTxWithRandFees(txCtx, true)
+	}
+}
@@ -0,0 +1,72 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	zetasimulation "github.com/zeta-chain/node/testutil/simulation"
+	"github.com/zeta-chain/node/x/fungible/keeper"
+	"github.com/zeta-chain/node/x/fungible/types"
+)
+
+// SimulateMsgUpdateZRC20LiquidityCap generates a MsgUpdateZRC20LiquidityCap with a random cap and delivers it
+// A zero cap removes the liquidity cap of the ZRC20
+func SimulateMsgUpdateZRC20LiquidityCap(k keeper.Keeper) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accounts []simtypes.Account, _ string,
+	) (OperationMsg simtypes.OperationMsg, futureOps []simtypes.FutureOperation, err error) {
+		policyAccount, err := zetasimulation.GetPolicyAccount(ctx, k.GetAuthorityKeeper(), accounts)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgUpdateZRC20LiquidityCap, err.Error()), nil, nil
+		}
+
+		foreignCoins := k.GetAllForeignCoins(ctx)
+		if len(foreignCoins) == 0 {
+			return simtypes.NoOpMsg(
+				types.ModuleName,
+				TypeMsgUpdateZRC20LiquidityCap,
+				"no foreign coins found",
+			), nil, nil
+		}
+
+		liquidityCap := sdkmath.ZeroUint()
+		if r.Intn(2) == 0 {
+			liquidityCap = sdkmath.NewUint(r.Uint64())
+		}
+
+		msg := types.MsgUpdateZRC20LiquidityCap{
+			Creator:      policyAccount.Address.String(),
+			Zrc20Address: foreignCoins[r.Intn(len(foreignCoins))].Zrc20ContractAddress,
+			LiquidityCap: liquidityCap,
+		}
+
+		err = msg.ValidateBasic()
+		if err != nil {
+			return simtypes.NoOpMsg(
+				types.ModuleName,
+				TypeMsgUpdateZRC20LiquidityCap,
+				"failed to validate basic msg",
+			), nil, err
+		}
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         moduletestutil.MakeTestEncodingConfig().TxConfig,
+			Cdc:           nil,
+			Msg:           &msg,
+			Context:       ctx,
+			SimAccount:    policyAccount,
+			AccountKeeper: k.GetAuthKeeper(),
+			Bankkeeper:    k.GetBankKeeper(),
+			ModuleName:    types.ModuleName,
+		}
+
+		return zetasimulation.GenAndDeliverTxWithRandFees(txCtx, true)
+	}
+}
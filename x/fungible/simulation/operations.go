@@ -14,7 +14,12 @@ import (
 	"github.com/zeta-chain/node/x/fungible/types"
 )
 
-var TypeMsgDeploySystemContracts = sdk.MsgTypeURL(&types.MsgDeploySystemContracts{})
+var (
+	TypeMsgDeploySystemContracts   = sdk.MsgTypeURL(&types.MsgDeploySystemContracts{})
+	TypeMsgPauseZRC20              = sdk.MsgTypeURL(&types.MsgPauseZRC20{})
+	TypeMsgUnpauseZRC20            = sdk.MsgTypeURL(&types.MsgUnpauseZRC20{})
+	TypeMsgUpdateZRC20LiquidityCap = sdk.MsgTypeURL(&types.MsgUpdateZRC20LiquidityCap{})
+)
 
 // Simulation operation weights constants
 // Operation weights are used by the simulation program to simulate the weight of different operations.
@@ -29,27 +34,66 @@ var TypeMsgDeploySystemContracts = sdk.MsgTypeURL(&types.MsgDeploySystemContract
 // 100 seems to the max weight used,and we should use relative weights
 // to signify the number of each operation in a block.
 const (
-	// #nosec G101 not a hardcoded credential
-	OpWeightMsgDeploySystemContracts      = "op_weight_msg_deploy_system_contracts"
+	OpWeightMsgDeploySystemContracts   = "op_weight_msg_deploy_system_contracts"    // #nosec G101 not a hardcoded credential
+	OpWeightMsgPauseZRC20              = "op_weight_msg_pause_zrc20"                // #nosec G101 not a hardcoded credential
+	OpWeightMsgUnpauseZRC20            = "op_weight_msg_unpause_zrc20"              // #nosec G101 not a hardcoded credential
+	OpWeightMsgUpdateZRC20LiquidityCap = "op_weight_msg_update_zrc20_liquidity_cap" // #nosec G101 not a hardcoded credential
+
 	DefaultWeightMsgDeploySystemContracts = 5
+	// DefaultWeightMsgPauseZRC20 is lower than the unpause weight to keep most of the ZRC20 usable by the deposits
+	DefaultWeightMsgPauseZRC20              = 5
+	DefaultWeightMsgUnpauseZRC20            = 10
+	DefaultWeightMsgUpdateZRC20LiquidityCap = 10
 )
 
 // DeployedSystemContracts Use a flag to ensure that the system contracts are deployed only once
 // https://github.com/zeta-chain/node/issues/3102
 func WeightedOperations(
 	appParams simtypes.AppParams, k keeper.Keeper) simulation.WeightedOperations {
-	var weightMsgDeploySystemContracts int
+	var (
+		weightMsgDeploySystemContracts   int
+		weightMsgPauseZRC20              int
+		weightMsgUnpauseZRC20            int
+		weightMsgUpdateZRC20LiquidityCap int
+	)
 
 	appParams.GetOrGenerate(OpWeightMsgDeploySystemContracts, &weightMsgDeploySystemContracts, nil,
 		func(_ *rand.Rand) {
 			weightMsgDeploySystemContracts = DefaultWeightMsgDeploySystemContracts
 		})
 
+	appParams.GetOrGenerate(OpWeightMsgPauseZRC20, &weightMsgPauseZRC20, nil,
+		func(_ *rand.Rand) {
+			weightMsgPauseZRC20 = DefaultWeightMsgPauseZRC20
+		})
+
+	appParams.GetOrGenerate(OpWeightMsgUnpauseZRC20, &weightMsgUnpauseZRC20, nil,
+		func(_ *rand.Rand) {
+			weightMsgUnpauseZRC20 = DefaultWeightMsgUnpauseZRC20
+		})
+
+	appParams.GetOrGenerate(OpWeightMsgUpdateZRC20LiquidityCap, &weightMsgUpdateZRC20LiquidityCap, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateZRC20LiquidityCap = DefaultWeightMsgUpdateZRC20LiquidityCap
+		})
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgDeploySystemContracts,
 			SimulateMsgDeploySystemContracts(k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgPauseZRC20,
+			SimulateMsgPauseZRC20(k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUnpauseZRC20,
+			SimulateMsgUnpauseZRC20(k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateZRC20LiquidityCap,
+			SimulateMsgUpdateZRC20LiquidityCap(k),
+		),
 	}
 }
 
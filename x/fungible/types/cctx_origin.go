@@ -0,0 +1,27 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// cctxOriginKey is the context key of the CCTX at the origin of the ZEVM calls made by the protocol
+type cctxOriginKey struct{}
+
+// CCTXOrigin describes the CCTX at the origin of a synthetic ZEVM transaction
+// it allows RPC clients to link the transaction back to the inbound
+type CCTXOrigin struct {
+	CctxIndex      string
+	InboundChainID int64
+	InboundHash    string
+}
+
+// WithCCTXOrigin returns a context with the CCTX at the origin of the ZEVM calls made with it
+func WithCCTXOrigin(ctx sdk.Context, origin CCTXOrigin) sdk.Context {
+	return ctx.WithValue(cctxOriginKey{}, origin)
+}
+
+// CCTXOriginFromContext returns the CCTX at the origin of the ZEVM calls made with the context, if any
+func CCTXOriginFromContext(ctx sdk.Context) (CCTXOrigin, bool) {
+	origin, ok := ctx.Value(cctxOriginKey{}).(CCTXOrigin)
+	return origin, ok
+}
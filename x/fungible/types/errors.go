@@ -54,4 +54,7 @@ var (
 	ErrZeroBalance    = cosmoserrors.Register(ModuleName, 1140, "balance is zero")
 	ErrFailedToBurn   = cosmoserrors.Register(ModuleName, 1141, "failed to burn coins")
 	ErrGasLimitNotSet = cosmoserrors.Register(ModuleName, 1142, "gas limit not set")
+
+	ErrPausedZRC20Deposits    = cosmoserrors.Register(ModuleName, 1143, "ZRC20 deposits are paused")
+	ErrPausedZRC20Withdrawals = cosmoserrors.Register(ModuleName, 1144, "ZRC20 withdrawals are paused")
 )
@@ -309,9 +309,10 @@ func (m *EventZRC20WithdrawFeeUpdated) GetNewGasLimit() string {
 }
 
 type EventZRC20Paused struct {
-	MsgTypeUrl     string   `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
-	Zrc20Addresses []string `protobuf:"bytes,2,rep,name=zrc20_addresses,json=zrc20Addresses,proto3" json:"zrc20_addresses,omitempty"`
-	Signer         string   `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+	MsgTypeUrl     string              `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	Zrc20Addresses []string            `protobuf:"bytes,2,rep,name=zrc20_addresses,json=zrc20Addresses,proto3" json:"zrc20_addresses,omitempty"`
+	Signer         string              `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+	Direction      ZRC20PauseDirection `protobuf:"varint,4,opt,name=direction,proto3,enum=zetachain.zetacore.fungible.ZRC20PauseDirection" json:"direction,omitempty"`
 }
 
 func (m *EventZRC20Paused) Reset()         { *m = EventZRC20Paused{} }
@@ -368,10 +369,18 @@ func (m *EventZRC20Paused) GetSigner() string {
 	return ""
 }
 
+func (m *EventZRC20Paused) GetDirection() ZRC20PauseDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return ZRC20PauseDirection_ALL_DIRECTIONS
+}
+
 type EventZRC20Unpaused struct {
-	MsgTypeUrl     string   `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
-	Zrc20Addresses []string `protobuf:"bytes,2,rep,name=zrc20_addresses,json=zrc20Addresses,proto3" json:"zrc20_addresses,omitempty"`
-	Signer         string   `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+	MsgTypeUrl     string              `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	Zrc20Addresses []string            `protobuf:"bytes,2,rep,name=zrc20_addresses,json=zrc20Addresses,proto3" json:"zrc20_addresses,omitempty"`
+	Signer         string              `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+	Direction      ZRC20PauseDirection `protobuf:"varint,4,opt,name=direction,proto3,enum=zetachain.zetacore.fungible.ZRC20PauseDirection" json:"direction,omitempty"`
 }
 
 func (m *EventZRC20Unpaused) Reset()         { *m = EventZRC20Unpaused{} }
@@ -428,6 +437,13 @@ func (m *EventZRC20Unpaused) GetSigner() string {
 	return ""
 }
 
+func (m *EventZRC20Unpaused) GetDirection() ZRC20PauseDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return ZRC20PauseDirection_ALL_DIRECTIONS
+}
+
 type EventSystemContractsDeployed struct {
 	MsgTypeUrl       string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
 	UniswapV2Factory string `protobuf:"bytes,2,opt,name=uniswap_v2_factory,json=uniswapV2Factory,proto3" json:"uniswap_v2_factory,omitempty"`
@@ -749,58 +765,60 @@ func init() {
 }
 
 var fileDescriptor_1e6611815bc2713b = []byte{
-	// 802 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcd, 0x4e, 0xdb, 0x4a,
-	0x14, 0xc6, 0x04, 0x02, 0x19, 0x7e, 0x12, 0xac, 0xe8, 0xca, 0x37, 0x5c, 0x45, 0x28, 0xf7, 0x72,
-	0x6f, 0x2e, 0xf7, 0x36, 0x41, 0xe9, 0x13, 0x14, 0x0a, 0xb4, 0x52, 0x17, 0x55, 0x5a, 0x5a, 0x89,
-	0x8d, 0x35, 0xb1, 0x0f, 0x8e, 0x85, 0x3d, 0x63, 0x79, 0x26, 0x31, 0xe6, 0x29, 0xba, 0xec, 0xae,
-	0x2f, 0xd0, 0x27, 0x68, 0x5f, 0xa0, 0x4b, 0xa4, 0x6e, 0xba, 0xac, 0xe0, 0x25, 0xba, 0xac, 0x66,
-	0x3c, 0x76, 0x6c, 0xfe, 0x14, 0xba, 0xa8, 0xd4, 0x0d, 0x9a, 0x33, 0xf9, 0x66, 0xce, 0x77, 0x3e,
-	0x7f, 0xe7, 0x0c, 0xa8, 0x7d, 0x06, 0x1c, 0x5b, 0x43, 0xec, 0x92, 0xae, 0x5c, 0xd1, 0x10, 0xba,
-	0xc7, 0x23, 0xe2, 0xb8, 0x03, 0x0f, 0xba, 0x30, 0x06, 0xc2, 0x59, 0x27, 0x08, 0x29, 0xa7, 0xfa,
-	0x7a, 0x86, 0xec, 0xa4, 0xc8, 0x4e, 0x8a, 0x6c, 0xfc, 0x75, 0xd7, 0x35, 0xfc, 0x34, 0xb9, 0xa2,
-	0x51, 0x77, 0xa8, 0x43, 0xe5, 0xb2, 0x2b, 0x56, 0x6a, 0xf7, 0xef, 0x1b, 0xce, 0x06, 0x27, 0x4e,
-	0xd7, 0xa2, 0x2e, 0x91, 0x7f, 0x12, 0x5c, 0xeb, 0x83, 0x86, 0x1a, 0x7b, 0x82, 0xd1, 0x8b, 0x98,
-	0x71, 0xf0, 0x77, 0x29, 0xe1, 0x21, 0xb6, 0xf8, 0x61, 0x60, 0x63, 0x0e, 0xb6, 0xbe, 0x81, 0x96,
-	0x7d, 0xe6, 0x98, 0x3c, 0x0e, 0xc0, 0x1c, 0x85, 0x9e, 0xa1, 0x6d, 0x68, 0xed, 0x4a, 0x1f, 0xf9,
-	0xcc, 0x79, 0x19, 0x07, 0x70, 0x18, 0x7a, 0xfa, 0x36, 0xaa, 0x13, 0x88, 0x4c, 0x4b, 0x1d, 0x34,
-	0xb1, 0x6d, 0x87, 0xc0, 0x98, 0x31, 0x2b, 0x91, 0x3a, 0x81, 0x28, 0xbd, 0xf3, 0x51, 0xf2, 0x8b,
-	0x38, 0x41, 0x3d, 0xfb, 0xfa, 0x89, 0x52, 0x72, 0x82, 0x7a, 0xf6, 0xd5, 0x13, 0xbf, 0xa1, 0x32,
-	0x73, 0x1d, 0x02, 0xa1, 0x31, 0x27, 0x31, 0x2a, 0x6a, 0xbd, 0x9f, 0x45, 0xba, 0x24, 0x7f, 0xd4,
-	0xdf, 0xed, 0x6d, 0x3f, 0x86, 0xc0, 0xa3, 0xf1, 0x54, 0xa4, 0x7f, 0x47, 0x8b, 0x52, 0x1b, 0xd3,
-	0xb5, 0x25, 0xd1, 0x52, 0x7f, 0x41, 0xc6, 0x4f, 0x6d, 0xbd, 0x81, 0x16, 0x53, 0x66, 0x8a, 0x51,
-	0x16, 0xeb, 0x3a, 0x9a, 0x23, 0xd8, 0x07, 0xc5, 0x42, 0xae, 0x25, 0xb7, 0xd8, 0x1f, 0x50, 0xcf,
-	0x98, 0x57, 0xdc, 0x64, 0x24, 0xee, 0xb1, 0xc1, 0x72, 0x7d, 0xec, 0x31, 0xa3, 0x2c, 0x53, 0x64,
-	0xb1, 0xbe, 0x83, 0x2a, 0xe2, 0x13, 0x48, 0x86, 0xc6, 0xc2, 0x86, 0xd6, 0x5e, 0xed, 0x6d, 0x76,
-	0x6e, 0x70, 0x42, 0x70, 0xe2, 0x74, 0xe4, 0xb7, 0xda, 0xa5, 0x2e, 0x11, 0xdc, 0x05, 0x97, 0x64,
-	0xa5, 0xd7, 0xd1, 0x3c, 0x84, 0x56, 0x6f, 0xdb, 0x58, 0x94, 0x69, 0x93, 0x40, 0x5f, 0x47, 0x15,
-	0x07, 0x33, 0xd3, 0x73, 0x7d, 0x97, 0x1b, 0x95, 0x24, 0xad, 0x83, 0xd9, 0x33, 0x11, 0xb7, 0xbe,
-	0xcd, 0xa2, 0x3f, 0x26, 0x72, 0xbd, 0x76, 0xf9, 0xd0, 0x0e, 0x71, 0xb4, 0x0f, 0x30, 0xfd, 0xd7,
-	0xbe, 0x43, 0xb8, 0x42, 0x51, 0xa5, 0x1f, 0x2b, 0xea, 0x4f, 0xb4, 0x72, 0x26, 0xea, 0xc8, 0x3c,
-	0x91, 0x28, 0xbd, 0x2c, 0x37, 0x53, 0x37, 0xb4, 0x51, 0x4d, 0xf8, 0x27, 0x52, 0xfc, 0xcd, 0x63,
-	0x00, 0xa5, 0xfd, 0x2a, 0xf5, 0xec, 0x5c, 0x59, 0x02, 0x29, 0xbc, 0x59, 0x40, 0x96, 0x13, 0x24,
-	0x81, 0x28, 0x8f, 0x9c, 0x38, 0x6c, 0x21, 0xef, 0x30, 0xbd, 0x85, 0x56, 0x44, 0xae, 0x89, 0xa6,
-	0x89, 0xda, 0x4b, 0xd4, 0xb3, 0x0f, 0x94, 0xac, 0x02, 0x23, 0xb2, 0x14, 0x75, 0xaf, 0xf4, 0x97,
-	0x08, 0x44, 0x29, 0xa6, 0x35, 0x42, 0xb5, 0x89, 0xf2, 0xcf, 0xf1, 0x88, 0x4d, 0xa5, 0xf6, 0x3f,
-	0xa8, 0x5a, 0x90, 0x03, 0x44, 0x5b, 0x95, 0x04, 0xfd, 0xbc, 0x20, 0x90, 0x6f, 0x90, 0x52, 0xa1,
-	0x41, 0xa2, 0x7c, 0x7f, 0x1c, 0x92, 0xe0, 0xa7, 0x25, 0x7e, 0x9b, 0x5a, 0xad, 0x38, 0x56, 0xd8,
-	0x3d, 0x7a, 0xf4, 0x7f, 0xa4, 0x8f, 0x88, 0xcb, 0x22, 0x1c, 0x98, 0xe3, 0x9e, 0x79, 0x8c, 0x2d,
-	0x4e, 0xc3, 0x58, 0x8d, 0x95, 0x9a, 0xfa, 0xe5, 0x55, 0x6f, 0x3f, 0xd9, 0x17, 0xed, 0x10, 0x09,
-	0x8b, 0x29, 0x1e, 0x49, 0xa0, 0x6f, 0xa1, 0xb5, 0xdc, 0x1d, 0x21, 0x1d, 0xf1, 0x6c, 0x86, 0x54,
-	0xb3, 0x2b, 0xfa, 0x72, 0x5b, 0xdf, 0x44, 0xab, 0x16, 0x25, 0x04, 0xc4, 0x7d, 0xe6, 0x19, 0x8c,
-	0x7d, 0x65, 0xaa, 0x95, 0x6c, 0xf7, 0x08, 0xc6, 0xbe, 0x90, 0x86, 0xc9, 0x9a, 0xb2, 0x01, 0x96,
-	0x5a, 0x8a, 0x15, 0x4a, 0xbd, 0xcd, 0x52, 0xad, 0xcf, 0x1a, 0xaa, 0x4b, 0x69, 0x76, 0x62, 0x0e,
-	0x16, 0xb5, 0xef, 0xd1, 0x7d, 0xff, 0xa2, 0xda, 0x2d, 0x73, 0xb6, 0x6a, 0x5d, 0x19, 0x99, 0x5b,
-	0x68, 0x4d, 0x98, 0x72, 0xa0, 0x72, 0x98, 0x43, 0xcc, 0x86, 0x4a, 0x9b, 0x2a, 0x81, 0x28, 0xcd,
-	0xfd, 0x04, 0xb3, 0xa1, 0xc0, 0x0a, 0x93, 0x17, 0xb1, 0x4a, 0x25, 0xea, 0xd9, 0x05, 0xec, 0xa4,
-	0xaa, 0xf9, 0x42, 0x55, 0x1f, 0x35, 0xb4, 0x2e, 0xab, 0x3a, 0xc0, 0x1c, 0x22, 0x1c, 0xff, 0x5a,
-	0x0f, 0xc9, 0xbb, 0x2b, 0xec, 0xd3, 0xbe, 0x9d, 0x9e, 0xfd, 0xb5, 0x21, 0x20, 0x68, 0xcf, 0x15,
-	0x86, 0xc0, 0xf5, 0x61, 0x52, 0x4a, 0x30, 0xf9, 0x61, 0x72, 0x0b, 0xc3, 0x9d, 0xbd, 0x4f, 0x17,
-	0x4d, 0xed, 0xfc, 0xa2, 0xa9, 0x7d, 0xbd, 0x68, 0x6a, 0x6f, 0x2e, 0x9b, 0x33, 0xe7, 0x97, 0xcd,
-	0x99, 0x2f, 0x97, 0xcd, 0x99, 0xa3, 0xff, 0x1c, 0x97, 0x0f, 0x47, 0x83, 0x8e, 0x45, 0x7d, 0xf9,
-	0xd4, 0x3f, 0x48, 0x5e, 0x7d, 0x42, 0x6d, 0xe8, 0x9e, 0xe6, 0xfe, 0x5f, 0x88, 0x03, 0x60, 0x83,
-	0xb2, 0x7c, 0xf5, 0x1f, 0x7e, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xf0, 0x7d, 0x7a, 0xd5, 0xa2, 0x08,
-	0x00, 0x00,
+	// 845 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcd, 0x6e, 0xeb, 0x44,
+	0x14, 0xae, 0x9b, 0x36, 0x6d, 0xe6, 0xde, 0x26, 0xb9, 0x56, 0x84, 0x4c, 0x8a, 0xa2, 0x2a, 0x70,
+	0x21, 0x5c, 0xc0, 0x89, 0xc2, 0x13, 0xd0, 0xde, 0x1f, 0x90, 0x10, 0x42, 0x81, 0x82, 0xd4, 0x8d,
+	0x35, 0xf1, 0x9c, 0x38, 0xd6, 0xb5, 0x67, 0x2c, 0xcf, 0x24, 0xbe, 0xee, 0x53, 0xb0, 0x64, 0xc7,
+	0x0b, 0xf0, 0x04, 0xb0, 0x46, 0xb0, 0xac, 0xc4, 0x86, 0x25, 0x6a, 0x5f, 0x82, 0x25, 0x9a, 0xf1,
+	0xd8, 0xb1, 0xfb, 0x13, 0xa5, 0x77, 0xd7, 0x4d, 0x34, 0x67, 0xf2, 0x9d, 0x73, 0xbe, 0xf3, 0xf9,
+	0x9c, 0x63, 0xa3, 0xc1, 0x39, 0x08, 0xec, 0xce, 0xb1, 0x4f, 0x87, 0xea, 0xc4, 0x62, 0x18, 0xce,
+	0x16, 0xd4, 0xf3, 0xa7, 0x01, 0x0c, 0x61, 0x09, 0x54, 0x70, 0x3b, 0x8a, 0x99, 0x60, 0xe6, 0x61,
+	0x81, 0xb4, 0x73, 0xa4, 0x9d, 0x23, 0xbb, 0x1f, 0xac, 0x0b, 0x23, 0xde, 0x64, 0x21, 0xba, 0xc3,
+	0x75, 0xa8, 0x19, 0x8b, 0xc1, 0xf7, 0xa8, 0xe3, 0x32, 0x9f, 0xea, 0x9c, 0xdd, 0x8e, 0xc7, 0x3c,
+	0xa6, 0x8e, 0x43, 0x79, 0xd2, 0xb7, 0x1f, 0xde, 0x12, 0x26, 0x7a, 0xed, 0x0d, 0xa5, 0xa7, 0xfa,
+	0xc9, 0x70, 0xfd, 0xdf, 0x0c, 0xd4, 0x7d, 0x21, 0x4b, 0xf8, 0x2e, 0xe5, 0x02, 0xc2, 0x13, 0x46,
+	0x45, 0x8c, 0x5d, 0x71, 0x1a, 0x11, 0x2c, 0x80, 0x98, 0x47, 0xe8, 0x71, 0xc8, 0x3d, 0x47, 0xa4,
+	0x11, 0x38, 0x8b, 0x38, 0xb0, 0x8c, 0x23, 0x63, 0xd0, 0x98, 0xa0, 0x90, 0x7b, 0xdf, 0xa7, 0x11,
+	0x9c, 0xc6, 0x81, 0x39, 0x42, 0x1d, 0x0a, 0x89, 0xe3, 0x6a, 0x47, 0x07, 0x13, 0x12, 0x03, 0xe7,
+	0xd6, 0xb6, 0x42, 0x9a, 0x14, 0x92, 0x3c, 0xe6, 0x17, 0xd9, 0x3f, 0xd2, 0x83, 0x05, 0xe4, 0xa6,
+	0x47, 0x2d, 0xf3, 0x60, 0x01, 0xb9, 0xee, 0xf1, 0x0e, 0xaa, 0x73, 0xdf, 0xa3, 0x10, 0x5b, 0x3b,
+	0x0a, 0xa3, 0xad, 0xfe, 0xaf, 0xdb, 0xc8, 0x54, 0xe4, 0xcf, 0x26, 0x27, 0xe3, 0xd1, 0x73, 0x88,
+	0x02, 0x96, 0x6e, 0x44, 0xfa, 0x5d, 0xb4, 0xaf, 0xb4, 0x71, 0x7c, 0xa2, 0x88, 0xd6, 0x26, 0x7b,
+	0xca, 0xfe, 0x8a, 0x98, 0x5d, 0xb4, 0x9f, 0x33, 0xd3, 0x8c, 0x0a, 0xdb, 0x34, 0xd1, 0x0e, 0xc5,
+	0x21, 0x68, 0x16, 0xea, 0xac, 0xb8, 0xa5, 0xe1, 0x94, 0x05, 0xd6, 0xae, 0xe6, 0xa6, 0x2c, 0x19,
+	0x87, 0x80, 0xeb, 0x87, 0x38, 0xe0, 0x56, 0x5d, 0xa5, 0x28, 0x6c, 0xf3, 0x18, 0x35, 0xe4, 0x23,
+	0x50, 0x0c, 0xad, 0xbd, 0x23, 0x63, 0xd0, 0x1c, 0x3f, 0xb5, 0x6f, 0x69, 0x9d, 0xe8, 0xb5, 0x67,
+	0xab, 0x67, 0x75, 0xc2, 0x7c, 0x2a, 0xb9, 0x4b, 0x2e, 0xd9, 0xc9, 0xec, 0xa0, 0x5d, 0x88, 0xdd,
+	0xf1, 0xc8, 0xda, 0x57, 0x69, 0x33, 0xc3, 0x3c, 0x44, 0x0d, 0x0f, 0x73, 0x27, 0xf0, 0x43, 0x5f,
+	0x58, 0x8d, 0x2c, 0xad, 0x87, 0xf9, 0xd7, 0xd2, 0xee, 0xff, 0xb7, 0x8d, 0xde, 0x5b, 0xc9, 0xf5,
+	0xa3, 0x2f, 0xe6, 0x24, 0xc6, 0xc9, 0x4b, 0x80, 0xcd, 0x9f, 0xf6, 0x1a, 0xe1, 0x2a, 0x45, 0xd5,
+	0xde, 0xae, 0xa8, 0xf7, 0xd1, 0xc1, 0xb9, 0xac, 0xa3, 0xe8, 0x89, 0x4c, 0xe9, 0xc7, 0xea, 0x32,
+	0xef, 0x86, 0x01, 0x6a, 0xcb, 0xfe, 0x49, 0x34, 0x7f, 0x67, 0x06, 0xa0, 0xb5, 0x6f, 0xb2, 0x80,
+	0x94, 0xca, 0x92, 0x48, 0xd9, 0x9b, 0x15, 0x64, 0x3d, 0x43, 0x52, 0x48, 0xca, 0xc8, 0x55, 0x87,
+	0xed, 0x95, 0x3b, 0xcc, 0xec, 0xa3, 0x03, 0x99, 0x6b, 0xa5, 0x69, 0xa6, 0xf6, 0x23, 0x16, 0x90,
+	0x57, 0x5a, 0x56, 0x89, 0x91, 0x59, 0xaa, 0xba, 0x37, 0x26, 0x8f, 0x28, 0x24, 0x39, 0xa6, 0xff,
+	0x87, 0x81, 0xda, 0x2b, 0xe9, 0xbf, 0xc5, 0x0b, 0xbe, 0x91, 0xdc, 0x1f, 0xa1, 0x56, 0x45, 0x0f,
+	0x90, 0x73, 0x55, 0x93, 0xfc, 0xcb, 0x8a, 0x40, 0x79, 0x42, 0x6a, 0x15, 0xfe, 0xdf, 0xa0, 0x06,
+	0xf1, 0x63, 0x70, 0x85, 0xcf, 0xa8, 0x12, 0xb3, 0x39, 0x1e, 0xd9, 0x6b, 0x96, 0x94, 0xbd, 0xe2,
+	0xf7, 0x3c, 0xf7, 0x9b, 0xac, 0x42, 0xf4, 0xff, 0x34, 0xca, 0x13, 0x77, 0x4a, 0xa3, 0x07, 0x5b,
+	0xc9, 0xcf, 0xf9, 0x30, 0x54, 0x17, 0x1f, 0xbf, 0xc7, 0x16, 0xf9, 0x14, 0x99, 0x0b, 0xea, 0xf3,
+	0x04, 0x47, 0xce, 0x72, 0xec, 0xcc, 0xb0, 0x2b, 0x58, 0x9c, 0xea, 0xc5, 0xd7, 0xd6, 0xff, 0xfc,
+	0x30, 0x7e, 0x99, 0xdd, 0xcb, 0x81, 0x4d, 0x24, 0x4b, 0x5d, 0x57, 0x66, 0x98, 0xcf, 0xd0, 0x93,
+	0x52, 0x8c, 0x98, 0x2d, 0x44, 0xb1, 0xe5, 0x5a, 0x45, 0x88, 0x89, 0xba, 0x36, 0x9f, 0xa2, 0xa6,
+	0xcb, 0x28, 0x05, 0x19, 0xcf, 0x39, 0x87, 0x65, 0xa8, 0xdb, 0xfe, 0xa0, 0xb8, 0x3d, 0x83, 0x65,
+	0x28, 0xa5, 0xe6, 0xaa, 0xa6, 0x62, 0xc5, 0xe6, 0x4d, 0xcf, 0x2b, 0xa5, 0xde, 0xd5, 0xf4, 0xfd,
+	0xbf, 0x0d, 0xd4, 0x51, 0xd2, 0x1c, 0xa7, 0x02, 0x5c, 0x46, 0xee, 0xb1, 0x1f, 0x3e, 0x46, 0xed,
+	0x3b, 0xde, 0x04, 0x2d, 0xf7, 0xda, 0x52, 0x7f, 0x86, 0x9e, 0xc8, 0xb1, 0x99, 0xea, 0x1c, 0xce,
+	0x1c, 0xf3, 0xb9, 0xd6, 0xa6, 0x45, 0x21, 0xc9, 0x73, 0x7f, 0x89, 0xf9, 0x5c, 0x62, 0xe5, 0x18,
+	0x56, 0xb1, 0x5a, 0x25, 0x16, 0x90, 0x0a, 0x76, 0x55, 0xd5, 0x6e, 0xa5, 0xaa, 0xdf, 0x0d, 0x74,
+	0xa8, 0xaa, 0x7a, 0x85, 0x05, 0x24, 0x38, 0x7d, 0x58, 0xaf, 0xba, 0x5f, 0xae, 0xb1, 0xcf, 0x37,
+	0xcb, 0xe6, 0xec, 0x6f, 0xac, 0x29, 0x49, 0x7b, 0xa7, 0xb2, 0xa6, 0x6e, 0xae, 0xbb, 0x5a, 0x86,
+	0x29, 0xaf, 0xbb, 0x3b, 0x18, 0x1e, 0xbf, 0xf8, 0xeb, 0xb2, 0x67, 0x5c, 0x5c, 0xf6, 0x8c, 0x7f,
+	0x2f, 0x7b, 0xc6, 0x4f, 0x57, 0xbd, 0xad, 0x8b, 0xab, 0xde, 0xd6, 0x3f, 0x57, 0xbd, 0xad, 0xb3,
+	0x4f, 0x3c, 0x5f, 0xcc, 0x17, 0x53, 0xdb, 0x65, 0xa1, 0xfa, 0x18, 0xf9, 0x2c, 0xfb, 0x2e, 0xa1,
+	0x8c, 0xc0, 0xf0, 0x4d, 0xe9, 0x13, 0x28, 0x8d, 0x80, 0x4f, 0xeb, 0xea, 0xbb, 0xe4, 0xf3, 0xff,
+	0x03, 0x00, 0x00, 0xff, 0xff, 0x87, 0x20, 0xe4, 0xca, 0x75, 0x09, 0x00, 0x00,
 }
 
 func (m *EventSystemContractUpdated) Marshal() (dAtA []byte, err error) {
@@ -1034,6 +1052,11 @@ func (m *EventZRC20Paused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Direction != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Signer) > 0 {
 		i -= len(m.Signer)
 		copy(dAtA[i:], m.Signer)
@@ -1080,6 +1103,11 @@ func (m *EventZRC20Unpaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Direction != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Signer) > 0 {
 		i -= len(m.Signer)
 		copy(dAtA[i:], m.Signer)
@@ -1474,6 +1502,9 @@ func (m *EventZRC20Paused) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.Direction != 0 {
+		n += 1 + sovEvents(uint64(m.Direction))
+	}
 	return n
 }
 
@@ -1497,6 +1528,9 @@ func (m *EventZRC20Unpaused) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.Direction != 0 {
+		n += 1 + sovEvents(uint64(m.Direction))
+	}
 	return n
 }
 
@@ -2521,6 +2555,25 @@ func (m *EventZRC20Paused) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= ZRC20PauseDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2667,6 +2720,25 @@ func (m *EventZRC20Unpaused) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= ZRC20PauseDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -0,0 +1,28 @@
+package types
+
+// IsDepositPaused returns true if the deposits from the foreign chain are paused
+func (m ForeignCoins) IsDepositPaused() bool {
+	return m.Paused || m.DepositsPaused
+}
+
+// IsWithdrawalPaused returns true if the withdrawals to the foreign chain are paused
+func (m ForeignCoins) IsWithdrawalPaused() bool {
+	return m.Paused || m.WithdrawalsPaused
+}
+
+// SetPaused pauses or unpauses the transfers of the given direction
+// Unpausing all the directions also unpauses the deposits and withdrawals paused individually
+func (m *ForeignCoins) SetPaused(direction ZRC20PauseDirection, paused bool) {
+	switch direction {
+	case ZRC20PauseDirection_DEPOSITS:
+		m.DepositsPaused = paused
+	case ZRC20PauseDirection_WITHDRAWALS:
+		m.WithdrawalsPaused = paused
+	default:
+		m.Paused = paused
+		if !paused {
+			m.DepositsPaused = false
+			m.WithdrawalsPaused = false
+		}
+	}
+}
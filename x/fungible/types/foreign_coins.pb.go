@@ -25,6 +25,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ZRC20PauseDirection represents the transfers paused or unpaused for a ZRC20
+type ZRC20PauseDirection int32
+
+const (
+	// all the transfers, including the transfers on ZEVM
+	ZRC20PauseDirection_ALL_DIRECTIONS ZRC20PauseDirection = 0
+	// deposits from the foreign chain only
+	ZRC20PauseDirection_DEPOSITS ZRC20PauseDirection = 1
+	// withdrawals to the foreign chain only
+	ZRC20PauseDirection_WITHDRAWALS ZRC20PauseDirection = 2
+)
+
+var ZRC20PauseDirection_name = map[int32]string{
+	0: "ALL_DIRECTIONS",
+	1: "DEPOSITS",
+	2: "WITHDRAWALS",
+}
+
+var ZRC20PauseDirection_value = map[string]int32{
+	"ALL_DIRECTIONS": 0,
+	"DEPOSITS":       1,
+	"WITHDRAWALS":    2,
+}
+
+func (x ZRC20PauseDirection) String() string {
+	return proto.EnumName(ZRC20PauseDirection_name, int32(x))
+}
+
+func (ZRC20PauseDirection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ef0f11d2d9d015ac, []int{0}
+}
+
 type ForeignCoins struct {
 	// string index = 1;
 	Zrc20ContractAddress string        `protobuf:"bytes,2,opt,name=zrc20_contract_address,json=zrc20ContractAddress,proto3" json:"zrc20_contract_address,omitempty"`
@@ -38,6 +70,10 @@ type ForeignCoins struct {
 	GasLimit     uint64                 `protobuf:"varint,9,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"` // Deprecated: Do not use.
 	Paused       bool                   `protobuf:"varint,10,opt,name=paused,proto3" json:"paused,omitempty"`
 	LiquidityCap cosmossdk_io_math.Uint `protobuf:"bytes,11,opt,name=liquidity_cap,json=liquidityCap,proto3,customtype=cosmossdk.io/math.Uint" json:"liquidity_cap"`
+	// deposits from the foreign chain are paused, the inbounds are reverted
+	DepositsPaused bool `protobuf:"varint,12,opt,name=deposits_paused,json=depositsPaused,proto3" json:"deposits_paused,omitempty"`
+	// withdrawals to the foreign chain are paused
+	WithdrawalsPaused bool `protobuf:"varint,13,opt,name=withdrawals_paused,json=withdrawalsPaused,proto3" json:"withdrawals_paused,omitempty"`
 }
 
 func (m *ForeignCoins) Reset()         { *m = ForeignCoins{} }
@@ -137,7 +173,22 @@ func (m *ForeignCoins) GetPaused() bool {
 	return false
 }
 
+func (m *ForeignCoins) GetDepositsPaused() bool {
+	if m != nil {
+		return m.DepositsPaused
+	}
+	return false
+}
+
+func (m *ForeignCoins) GetWithdrawalsPaused() bool {
+	if m != nil {
+		return m.WithdrawalsPaused
+	}
+	return false
+}
+
 func init() {
+	proto.RegisterEnum("zetachain.zetacore.fungible.ZRC20PauseDirection", ZRC20PauseDirection_name, ZRC20PauseDirection_value)
 	proto.RegisterType((*ForeignCoins)(nil), "zetachain.zetacore.fungible.ForeignCoins")
 }
 
@@ -146,34 +197,42 @@ func init() {
 }
 
 var fileDescriptor_ef0f11d2d9d015ac = []byte{
-	// 432 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x41, 0x6b, 0xdb, 0x30,
-	0x18, 0x8d, 0x9a, 0x34, 0x73, 0xb4, 0xb6, 0x0c, 0x11, 0x82, 0xc8, 0xc0, 0x31, 0x83, 0x0d, 0xc3,
-	0x98, 0x3d, 0xba, 0xfd, 0x81, 0xc5, 0x6c, 0x30, 0xd8, 0xc9, 0x6c, 0x97, 0x5d, 0x8c, 0x22, 0xa9,
-	0x8a, 0x88, 0x2d, 0x69, 0x96, 0x02, 0x4b, 0x7f, 0xc4, 0xd8, 0xcf, 0xea, 0xb1, 0xc7, 0xb1, 0x43,
-	0x19, 0xc9, 0x1f, 0x19, 0x92, 0xdd, 0xf4, 0xd2, 0x8b, 0xf9, 0xde, 0xfb, 0xbe, 0xa7, 0xa7, 0xa7,
-	0xcf, 0x30, 0xbf, 0xe6, 0x8e, 0xd0, 0x35, 0x91, 0xaa, 0xab, 0x74, 0xcb, 0xf3, 0xab, 0xad, 0x12,
-	0x72, 0x55, 0xf3, 0xfc, 0x4a, 0xb7, 0x5c, 0x0a, 0x55, 0x51, 0x2d, 0x95, 0xcd, 0x4c, 0xab, 0x9d,
-	0x46, 0xcf, 0x8f, 0x82, 0xec, 0x5e, 0x90, 0xdd, 0x0b, 0xe6, 0x53, 0xa1, 0x85, 0x0e, 0x73, 0xb9,
-	0xaf, 0x3a, 0xc9, 0xfc, 0xd5, 0x23, 0x1e, 0x66, 0x23, 0x72, 0x7f, 0x6c, 0xf8, 0x74, 0x73, 0x2f,
-	0x7e, 0x0d, 0xe1, 0xd9, 0xa7, 0xce, 0xb2, 0xf0, 0x8e, 0xe8, 0x3d, 0x9c, 0x5d, 0xb7, 0xf4, 0xf2,
-	0x6d, 0x45, 0xb5, 0x72, 0x2d, 0xa1, 0xae, 0x22, 0x8c, 0xb5, 0xdc, 0x5a, 0x7c, 0x92, 0x80, 0x74,
-	0x52, 0x4e, 0x43, 0xb7, 0xe8, 0x9b, 0x1f, 0xba, 0x1e, 0x9a, 0xc2, 0x53, 0x62, 0x2d, 0x77, 0x78,
-	0x18, 0x86, 0x3a, 0x80, 0x52, 0xf8, 0xec, 0x18, 0xc7, 0x5f, 0xa5, 0x92, 0x0c, 0x8f, 0x12, 0x90,
-	0x0e, 0xcb, 0x8b, 0x9e, 0x2f, 0x3c, 0xfd, 0x99, 0xa1, 0x39, 0x8c, 0x18, 0xa7, 0xb2, 0x21, 0xb5,
-	0xc5, 0xa7, 0x09, 0x48, 0xcf, 0xcb, 0x23, 0x46, 0x08, 0x8e, 0x14, 0x69, 0x38, 0x1e, 0x87, 0xa3,
-	0x43, 0x8d, 0x66, 0x70, 0x6c, 0x77, 0xcd, 0x4a, 0xd7, 0xf8, 0x49, 0x60, 0x7b, 0x84, 0x96, 0x70,
-	0xe2, 0xc3, 0x55, 0x6e, 0x67, 0x38, 0x8e, 0x12, 0x90, 0x5e, 0x5c, 0xbe, 0xcc, 0x1e, 0x79, 0x3d,
-	0xb3, 0x11, 0x59, 0x78, 0x05, 0x1f, 0xfa, 0xeb, 0xce, 0xf0, 0x32, 0xa2, 0x7d, 0x85, 0x16, 0x70,
-	0x22, 0x88, 0xad, 0x6a, 0xd9, 0x48, 0x87, 0x27, 0x09, 0x48, 0x47, 0xcb, 0x13, 0x0c, 0xca, 0x48,
-	0x10, 0xfb, 0xc5, 0x73, 0xde, 0xdc, 0x90, 0xad, 0xe5, 0x0c, 0xc3, 0x04, 0xa4, 0x51, 0xd9, 0x23,
-	0x54, 0xc0, 0xf3, 0x5a, 0xfe, 0xd8, 0x4a, 0x26, 0xdd, 0xae, 0xa2, 0xc4, 0xe0, 0xa7, 0xfe, 0x6e,
-	0xcb, 0xf8, 0xe6, 0x6e, 0x31, 0xf8, 0x7b, 0xb7, 0x98, 0x51, 0x6d, 0x1b, 0x6d, 0x2d, 0xdb, 0x64,
-	0x52, 0xe7, 0x0d, 0x71, 0xeb, 0xec, 0x9b, 0x54, 0xae, 0x3c, 0x3b, 0x8a, 0x0a, 0x62, 0x96, 0x1f,
-	0x6f, 0xf6, 0x31, 0xb8, 0xdd, 0xc7, 0xe0, 0xdf, 0x3e, 0x06, 0xbf, 0x0f, 0xf1, 0xe0, 0xf6, 0x10,
-	0x0f, 0xfe, 0x1c, 0xe2, 0xc1, 0xf7, 0xd7, 0x42, 0xba, 0xf5, 0x76, 0x95, 0x51, 0xdd, 0x84, 0x9d,
-	0xbe, 0xe9, 0xd6, 0xab, 0x34, 0xe3, 0xf9, 0xcf, 0x87, 0x1f, 0xc8, 0x47, 0xb7, 0xab, 0x71, 0x58,
-	0xef, 0xbb, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x86, 0x21, 0xde, 0x6c, 0x02, 0x00, 0x00,
+	// 547 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xd1, 0x6e, 0xda, 0x3c,
+	0x18, 0xc5, 0x2d, 0xe5, 0x0f, 0x2e, 0x50, 0x7e, 0x0f, 0xa1, 0x88, 0x49, 0x21, 0x9a, 0xb4, 0x2d,
+	0xda, 0xd4, 0xa4, 0x62, 0x7b, 0x01, 0x08, 0x4c, 0x43, 0x42, 0x6d, 0x15, 0x98, 0x2a, 0xf5, 0x26,
+	0x32, 0x89, 0x1b, 0x2c, 0x92, 0x38, 0x8b, 0x8d, 0x3a, 0xfa, 0x14, 0x7b, 0x88, 0x5d, 0xec, 0x51,
+	0x7a, 0xd9, 0xcb, 0x69, 0x17, 0xd5, 0x04, 0xd2, 0x9e, 0x63, 0x8a, 0x13, 0xd8, 0x2e, 0x7a, 0x13,
+	0x7d, 0xe7, 0x7c, 0xe7, 0xf8, 0xf8, 0x73, 0x3e, 0x68, 0xdd, 0x11, 0x81, 0xbd, 0x05, 0xa6, 0x71,
+	0x5e, 0xb1, 0x94, 0x58, 0x37, 0xab, 0x38, 0xa0, 0xf3, 0x90, 0x58, 0x37, 0x2c, 0x25, 0x34, 0x88,
+	0x5d, 0x8f, 0xd1, 0x98, 0x9b, 0x49, 0xca, 0x04, 0x43, 0xcf, 0xf7, 0x06, 0x73, 0x67, 0x30, 0x77,
+	0x86, 0x4e, 0x2b, 0x60, 0x01, 0x93, 0x3a, 0x2b, 0xab, 0x72, 0x4b, 0xe7, 0xd5, 0x13, 0x19, 0xc9,
+	0x32, 0xb0, 0xb2, 0x63, 0xe5, 0x27, 0xd7, 0xbd, 0xf8, 0x7d, 0x08, 0x6b, 0x1f, 0xf2, 0x48, 0x3b,
+	0x4b, 0x44, 0xef, 0x61, 0xfb, 0x2e, 0xf5, 0x7a, 0x67, 0xae, 0xc7, 0x62, 0x91, 0x62, 0x4f, 0xb8,
+	0xd8, 0xf7, 0x53, 0xc2, 0xb9, 0x7a, 0xa0, 0x03, 0xa3, 0xea, 0xb4, 0x64, 0xd7, 0x2e, 0x9a, 0xfd,
+	0xbc, 0x87, 0x5a, 0xf0, 0x08, 0x73, 0x4e, 0x84, 0x7a, 0x28, 0x45, 0x39, 0x40, 0x06, 0x6c, 0xee,
+	0xc7, 0xc9, 0xae, 0xe2, 0x52, 0x5f, 0x2d, 0xeb, 0xc0, 0x38, 0x74, 0x1a, 0x05, 0x6f, 0x67, 0xf4,
+	0xd8, 0x47, 0x1d, 0xa8, 0xf8, 0xc4, 0xa3, 0x11, 0x0e, 0xb9, 0x7a, 0xa4, 0x03, 0xa3, 0xee, 0xec,
+	0x31, 0x42, 0xb0, 0x1c, 0xe3, 0x88, 0xa8, 0x15, 0x79, 0xb4, 0xac, 0x51, 0x1b, 0x56, 0xf8, 0x3a,
+	0x9a, 0xb3, 0x50, 0xfd, 0x4f, 0xb2, 0x05, 0x42, 0x03, 0x58, 0xcd, 0x86, 0x73, 0xc5, 0x3a, 0x21,
+	0xaa, 0xa2, 0x03, 0xa3, 0xd1, 0x7b, 0x69, 0x3e, 0xf1, 0x7a, 0xc9, 0x32, 0x30, 0xe5, 0x2b, 0x64,
+	0x43, 0xcf, 0xd6, 0x09, 0x71, 0x14, 0xaf, 0xa8, 0x50, 0x17, 0x56, 0x03, 0xcc, 0xdd, 0x90, 0x46,
+	0x54, 0xa8, 0x55, 0x1d, 0x18, 0xe5, 0xc1, 0x81, 0x0a, 0x1c, 0x25, 0xc0, 0x7c, 0x92, 0x71, 0x59,
+	0x78, 0x82, 0x57, 0x9c, 0xf8, 0x2a, 0xd4, 0x81, 0xa1, 0x38, 0x05, 0x42, 0x36, 0xac, 0x87, 0xf4,
+	0xf3, 0x8a, 0xfa, 0x54, 0xac, 0x5d, 0x0f, 0x27, 0xea, 0x71, 0x76, 0xb7, 0x81, 0x76, 0xff, 0xd8,
+	0x2d, 0xfd, 0x7c, 0xec, 0xb6, 0x3d, 0xc6, 0x23, 0xc6, 0xb9, 0xbf, 0x34, 0x29, 0xb3, 0x22, 0x2c,
+	0x16, 0xe6, 0x27, 0x1a, 0x0b, 0xa7, 0xb6, 0x37, 0xd9, 0x38, 0x41, 0xaf, 0xe1, 0x89, 0x4f, 0x12,
+	0xc6, 0xa9, 0xe0, 0x6e, 0x91, 0x52, 0x93, 0x29, 0x8d, 0x1d, 0x7d, 0x99, 0xa7, 0x9d, 0x42, 0x74,
+	0x4b, 0xc5, 0xc2, 0x4f, 0xf1, 0x2d, 0x0e, 0xf7, 0xda, 0xba, 0xd4, 0xfe, 0xff, 0x4f, 0x27, 0x97,
+	0xbf, 0x39, 0x87, 0xcf, 0xae, 0x1d, 0xbb, 0x77, 0x26, 0xe1, 0x90, 0xa6, 0xc4, 0x13, 0x94, 0xc5,
+	0x08, 0xc1, 0x46, 0x7f, 0x32, 0x71, 0x87, 0x63, 0x67, 0x64, 0xcf, 0xc6, 0x17, 0xe7, 0xd3, 0x66,
+	0x09, 0xd5, 0xa0, 0x32, 0x1c, 0x5d, 0x5e, 0x4c, 0xc7, 0xb3, 0x69, 0x13, 0xa0, 0x13, 0x78, 0x7c,
+	0x35, 0x9e, 0x7d, 0x1c, 0x3a, 0xfd, 0xab, 0xfe, 0x64, 0xda, 0x3c, 0xe8, 0x94, 0xbf, 0x7f, 0xd3,
+	0xc0, 0x60, 0x74, 0xbf, 0xd1, 0xc0, 0xc3, 0x46, 0x03, 0xbf, 0x36, 0x1a, 0xf8, 0xba, 0xd5, 0x4a,
+	0x0f, 0x5b, 0xad, 0xf4, 0x63, 0xab, 0x95, 0xae, 0xdf, 0x06, 0x54, 0x2c, 0x56, 0x73, 0xd3, 0x63,
+	0x91, 0xdc, 0xbd, 0xd3, 0x7c, 0x0d, 0x63, 0xe6, 0x13, 0xeb, 0xcb, 0xdf, 0x45, 0xcf, 0x7e, 0x11,
+	0x9f, 0x57, 0xe4, 0x1a, 0xbe, 0xfb, 0x13, 0x00, 0x00, 0xff, 0xff, 0x2f, 0xdb, 0x3e, 0x94, 0x14,
+	0x03, 0x00, 0x00,
 }
 
 func (m *ForeignCoins) Marshal() (dAtA []byte, err error) {
@@ -196,6 +255,26 @@ func (m *ForeignCoins) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WithdrawalsPaused {
+		i--
+		if m.WithdrawalsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.DepositsPaused {
+		i--
+		if m.DepositsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
 	{
 		size := m.LiquidityCap.Size()
 		i -= size
@@ -317,6 +396,12 @@ func (m *ForeignCoins) Size() (n int) {
 	}
 	l = m.LiquidityCap.Size()
 	n += 1 + l + sovForeignCoins(uint64(l))
+	if m.DepositsPaused {
+		n += 2
+	}
+	if m.WithdrawalsPaused {
+		n += 2
+	}
 	return n
 }
 
@@ -613,6 +698,46 @@ func (m *ForeignCoins) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForeignCoins
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DepositsPaused = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForeignCoins
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithdrawalsPaused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipForeignCoins(dAtA[iNdEx:])
@@ -0,0 +1,61 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/x/fungible/types"
+)
+
+func TestForeignCoins_SetPaused(t *testing.T) {
+	t.Run("should pause and unpause deposits only", func(t *testing.T) {
+		fc := types.ForeignCoins{}
+
+		fc.SetPaused(types.ZRC20PauseDirection_DEPOSITS, true)
+		require.False(t, fc.Paused)
+		require.True(t, fc.IsDepositPaused())
+		require.False(t, fc.IsWithdrawalPaused())
+
+		fc.SetPaused(types.ZRC20PauseDirection_DEPOSITS, false)
+		require.False(t, fc.IsDepositPaused())
+	})
+
+	t.Run("should pause and unpause withdrawals only", func(t *testing.T) {
+		fc := types.ForeignCoins{}
+
+		fc.SetPaused(types.ZRC20PauseDirection_WITHDRAWALS, true)
+		require.False(t, fc.Paused)
+		require.False(t, fc.IsDepositPaused())
+		require.True(t, fc.IsWithdrawalPaused())
+
+		fc.SetPaused(types.ZRC20PauseDirection_WITHDRAWALS, false)
+		require.False(t, fc.IsWithdrawalPaused())
+	})
+
+	t.Run("should pause all directions", func(t *testing.T) {
+		fc := types.ForeignCoins{}
+
+		fc.SetPaused(types.ZRC20PauseDirection_ALL_DIRECTIONS, true)
+		require.True(t, fc.Paused)
+		require.True(t, fc.IsDepositPaused())
+		require.True(t, fc.IsWithdrawalPaused())
+	})
+
+	t.Run("should keep the other direction paused when unpausing a paused direction", func(t *testing.T) {
+		fc := types.ForeignCoins{DepositsPaused: true, WithdrawalsPaused: true}
+
+		fc.SetPaused(types.ZRC20PauseDirection_DEPOSITS, false)
+		require.False(t, fc.IsDepositPaused())
+		require.True(t, fc.IsWithdrawalPaused())
+	})
+
+	t.Run("should unpause all directions", func(t *testing.T) {
+		fc := types.ForeignCoins{Paused: true, DepositsPaused: true, WithdrawalsPaused: true}
+
+		fc.SetPaused(types.ZRC20PauseDirection_ALL_DIRECTIONS, false)
+		require.False(t, fc.Paused)
+		require.False(t, fc.IsDepositPaused())
+		require.False(t, fc.IsWithdrawalPaused())
+	})
+}
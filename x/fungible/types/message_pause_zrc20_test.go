@@ -72,6 +72,24 @@ func TestMsgPauseZRC20_ValidateBasic(t *testing.T) {
 			),
 			wantErr: true,
 		},
+		{
+			name: "valid pause message for deposits",
+			msg: &types.MsgPauseZRC20{
+				Creator:        sample.AccAddress(),
+				Zrc20Addresses: []string{sample.EthAddress().String()},
+				Direction:      types.ZRC20PauseDirection_DEPOSITS,
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid direction",
+			msg: &types.MsgPauseZRC20{
+				Creator:        sample.AccAddress(),
+				Zrc20Addresses: []string{sample.EthAddress().String()},
+				Direction:      types.ZRC20PauseDirection(42),
+			},
+			wantErr: true,
+		},
 	}
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
@@ -49,6 +49,10 @@ func (msg *MsgUnpauseZRC20) ValidateBasic() error {
 		return cosmoserrors.Wrap(sdkerrors.ErrInvalidRequest, "no zrc20 to update")
 	}
 
+	if _, ok := ZRC20PauseDirection_name[int32(msg.Direction)]; !ok {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid direction (%d)", msg.Direction)
+	}
+
 	// check if all zrc20 addresses are valid
 	for _, zrc20 := range msg.Zrc20Addresses {
 		if !ethcommon.IsHexAddress(zrc20) {
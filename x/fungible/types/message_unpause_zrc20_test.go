@@ -72,6 +72,24 @@ func TestMsgUnpauseZRC20_ValidateBasic(t *testing.T) {
 			),
 			wantErr: true,
 		},
+		{
+			name: "valid unpause message for withdrawals",
+			msg: &types.MsgUnpauseZRC20{
+				Creator:        sample.AccAddress(),
+				Zrc20Addresses: []string{sample.EthAddress().String()},
+				Direction:      types.ZRC20PauseDirection_WITHDRAWALS,
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid direction",
+			msg: &types.MsgUnpauseZRC20{
+				Creator:        sample.AccAddress(),
+				Zrc20Addresses: []string{sample.EthAddress().String()},
+				Direction:      types.ZRC20PauseDirection(42),
+			},
+			wantErr: true,
+		},
 	}
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
@@ -836,8 +836,9 @@ func (m *MsgUpdateZRC20LiquidityCapResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgUpdateZRC20LiquidityCapResponse proto.InternalMessageInfo
 
 type MsgPauseZRC20 struct {
-	Creator        string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Zrc20Addresses []string `protobuf:"bytes,2,rep,name=zrc20_addresses,json=zrc20Addresses,proto3" json:"zrc20_addresses,omitempty"`
+	Creator        string              `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Zrc20Addresses []string            `protobuf:"bytes,2,rep,name=zrc20_addresses,json=zrc20Addresses,proto3" json:"zrc20_addresses,omitempty"`
+	Direction      ZRC20PauseDirection `protobuf:"varint,3,opt,name=direction,proto3,enum=zetachain.zetacore.fungible.ZRC20PauseDirection" json:"direction,omitempty"`
 }
 
 func (m *MsgPauseZRC20) Reset()         { *m = MsgPauseZRC20{} }
@@ -887,6 +888,13 @@ func (m *MsgPauseZRC20) GetZrc20Addresses() []string {
 	return nil
 }
 
+func (m *MsgPauseZRC20) GetDirection() ZRC20PauseDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return ZRC20PauseDirection_ALL_DIRECTIONS
+}
+
 type MsgPauseZRC20Response struct {
 }
 
@@ -924,8 +932,9 @@ func (m *MsgPauseZRC20Response) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgPauseZRC20Response proto.InternalMessageInfo
 
 type MsgUnpauseZRC20 struct {
-	Creator        string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Zrc20Addresses []string `protobuf:"bytes,2,rep,name=zrc20_addresses,json=zrc20Addresses,proto3" json:"zrc20_addresses,omitempty"`
+	Creator        string              `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Zrc20Addresses []string            `protobuf:"bytes,2,rep,name=zrc20_addresses,json=zrc20Addresses,proto3" json:"zrc20_addresses,omitempty"`
+	Direction      ZRC20PauseDirection `protobuf:"varint,3,opt,name=direction,proto3,enum=zetachain.zetacore.fungible.ZRC20PauseDirection" json:"direction,omitempty"`
 }
 
 func (m *MsgUnpauseZRC20) Reset()         { *m = MsgUnpauseZRC20{} }
@@ -975,6 +984,13 @@ func (m *MsgUnpauseZRC20) GetZrc20Addresses() []string {
 	return nil
 }
 
+func (m *MsgUnpauseZRC20) GetDirection() ZRC20PauseDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return ZRC20PauseDirection_ALL_DIRECTIONS
+}
+
 type MsgUnpauseZRC20Response struct {
 }
 
@@ -1325,84 +1341,86 @@ func init() {
 }
 
 var fileDescriptor_7bea9688d1d01113 = []byte{
-	// 1223 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xdf, 0x6f, 0xdb, 0x44,
-	0x1c, 0xaf, 0x97, 0xfe, 0xfc, 0xd2, 0xb4, 0xc5, 0xea, 0x5a, 0xd7, 0xad, 0xd2, 0x2e, 0x2b, 0xa3,
-	0x14, 0x48, 0xb6, 0xb0, 0x51, 0x81, 0xd8, 0xa6, 0x25, 0xb4, 0x1b, 0xd2, 0x82, 0x90, 0x61, 0x43,
-	0xea, 0x4b, 0x70, 0xed, 0xab, 0x63, 0x35, 0xf1, 0x19, 0x9f, 0xb3, 0x2c, 0xe3, 0x65, 0xc0, 0x1b,
-	0xd2, 0xa4, 0x09, 0xf8, 0x3b, 0xd0, 0xfe, 0x8c, 0x3d, 0xee, 0x11, 0x21, 0x34, 0xa1, 0xf6, 0x61,
-	0xef, 0x3c, 0xf0, 0x8c, 0xee, 0x6c, 0xdf, 0x6c, 0xc7, 0x8e, 0x93, 0x16, 0x5e, 0x5a, 0xdf, 0xe5,
-	0xfb, 0xe3, 0x73, 0xf7, 0xfd, 0xdc, 0xe7, 0x7b, 0x3a, 0xd8, 0x7c, 0x84, 0x5c, 0x55, 0x6b, 0xaa,
-	0xa6, 0x55, 0x66, 0x5f, 0xd8, 0x41, 0xe5, 0xc3, 0x8e, 0x65, 0x98, 0x07, 0x2d, 0x54, 0x76, 0x1f,
-	0x96, 0x6c, 0x07, 0xbb, 0x58, 0x5c, 0xe5, 0x56, 0xa5, 0xc0, 0xaa, 0x14, 0x58, 0xc9, 0x8b, 0x06,
-	0x36, 0x30, 0xb3, 0x2b, 0xd3, 0x2f, 0xcf, 0x45, 0xbe, 0x94, 0x10, 0xd8, 0x3e, 0x32, 0xca, 0x1a,
-	0x36, 0x2d, 0xf6, 0xc7, 0xb7, 0x5b, 0xd6, 0x30, 0x69, 0x63, 0x52, 0x6e, 0x13, 0xa3, 0xfc, 0xe0,
-	0x0a, 0xfd, 0xe7, 0xfd, 0x50, 0x3c, 0x04, 0xa9, 0x4e, 0x8c, 0x7b, 0xb6, 0xae, 0xba, 0xe8, 0xb6,
-	0xea, 0xa2, 0xae, 0xda, 0xbb, 0xad, 0x92, 0xbb, 0x66, 0xdb, 0x74, 0x45, 0x09, 0xa6, 0x34, 0x07,
-	0xa9, 0x2e, 0x76, 0x24, 0x61, 0x43, 0xd8, 0x9a, 0x51, 0x82, 0xa1, 0x58, 0x84, 0xbc, 0x85, 0xba,
-	0x0d, 0x43, 0x25, 0x8d, 0x16, 0x35, 0x95, 0xce, 0x6d, 0x08, 0x5b, 0xe3, 0xca, 0x1b, 0x16, 0xea,
-	0x06, 0xde, 0x1f, 0xcf, 0xfe, 0xf0, 0xea, 0xd9, 0x76, 0xe0, 0x51, 0x2c, 0xc2, 0x46, 0x5a, 0x1e,
-	0x05, 0x11, 0x1b, 0x5b, 0x04, 0x15, 0xab, 0x0c, 0xcb, 0xa7, 0xc8, 0x6e, 0xe1, 0xde, 0x97, 0x3d,
-	0xe2, 0xa2, 0x76, 0x0d, 0x5b, 0xae, 0xa3, 0x6a, 0x2e, 0x49, 0xc7, 0x12, 0xcb, 0xf3, 0xa7, 0xc0,
-	0x12, 0x25, 0x06, 0x09, 0x12, 0x89, 0xdb, 0xb0, 0xd0, 0xb1, 0x4c, 0xd2, 0x55, 0xed, 0xfb, 0x95,
-	0x3d, 0x55, 0x73, 0xb1, 0xd3, 0xf3, 0xa3, 0xf6, 0xcd, 0x8b, 0x8b, 0x30, 0xd1, 0xa5, 0x5b, 0xcb,
-	0x96, 0x38, 0xa3, 0x78, 0x03, 0x71, 0x0b, 0xe6, 0xb9, 0xa5, 0x82, 0x3b, 0x2e, 0x72, 0xa4, 0x1c,
-	0xfb, 0x3d, 0x3e, 0x2d, 0x6e, 0x42, 0x5e, 0xc3, 0x96, 0x85, 0x68, 0xb4, 0xfd, 0xdd, 0xfb, 0x75,
-	0x69, 0x9c, 0xd9, 0x45, 0x27, 0xc5, 0x4b, 0x30, 0x47, 0x22, 0x60, 0xa5, 0x09, 0x66, 0x16, 0x9b,
-	0x2d, 0xfe, 0x23, 0xc0, 0x0a, 0xdf, 0xc7, 0x7d, 0xa5, 0x56, 0xb9, 0xfc, 0xb5, 0xe9, 0x36, 0x75,
-	0x47, 0xed, 0xee, 0x21, 0x34, 0xa0, 0x60, 0x17, 0x21, 0xff, 0xc8, 0xd1, 0x2a, 0x97, 0x1b, 0xaa,
-	0xae, 0x3b, 0x88, 0x10, 0x7f, 0x35, 0xb3, 0x6c, 0xf2, 0x96, 0x37, 0x27, 0xde, 0x81, 0x05, 0x5a,
-	0xd5, 0xae, 0x1f, 0xb1, 0x71, 0x88, 0x90, 0x34, 0x49, 0xed, 0xaa, 0x85, 0xe7, 0x2f, 0xd7, 0xc7,
-	0xfe, 0x78, 0xb9, 0xbe, 0xe4, 0xd1, 0x88, 0xe8, 0x47, 0x25, 0x13, 0x97, 0xdb, 0xaa, 0xdb, 0x2c,
-	0xdd, 0x33, 0x2d, 0x57, 0x99, 0xb3, 0x50, 0x37, 0x0c, 0xa4, 0x1a, 0xe7, 0xc7, 0xd4, 0x50, 0x61,
-	0x06, 0xf0, 0xe7, 0x22, 0x5c, 0x48, 0x5d, 0x37, 0x27, 0xd0, 0x63, 0x01, 0x96, 0xb9, 0x55, 0xb4,
-	0xf8, 0x03, 0xf6, 0xe6, 0x3a, 0xac, 0x52, 0xb0, 0xde, 0x4e, 0x37, 0x34, 0xdf, 0x21, 0xb6, 0x53,
-	0x92, 0x85, 0xba, 0xd1, 0x88, 0xfe, 0xae, 0xc5, 0x70, 0x5e, 0x80, 0xf5, 0x14, 0x04, 0x1c, 0xe5,
-	0xdf, 0xe7, 0x40, 0xe6, 0x14, 0xdd, 0xf3, 0xcf, 0x77, 0x0d, 0x9b, 0x16, 0x5b, 0xd7, 0x00, 0xa0,
-	0x8b, 0x30, 0xb1, 0x4b, 0x4d, 0x02, 0x2a, 0xb2, 0x81, 0xb8, 0x05, 0x0b, 0x87, 0xd8, 0x41, 0xa6,
-	0x61, 0x35, 0x98, 0x10, 0x34, 0x4c, 0x9d, 0x71, 0x31, 0xa7, 0xcc, 0xf9, 0xf3, 0x35, 0x3a, 0xfd,
-	0x99, 0x2e, 0xca, 0x30, 0xad, 0x23, 0xcd, 0x6c, 0xab, 0x2d, 0xc2, 0x58, 0x98, 0x57, 0xf8, 0x58,
-	0x14, 0x61, 0xdc, 0x52, 0xdb, 0xc8, 0xa7, 0x1d, 0xfb, 0x16, 0x97, 0x60, 0x92, 0xf4, 0xda, 0x07,
-	0xb8, 0xe5, 0xb1, 0x40, 0xf1, 0x47, 0x62, 0x15, 0x66, 0xa8, 0xb4, 0x34, 0xdc, 0x9e, 0x8d, 0x58,
-	0x65, 0xe7, 0x2a, 0x6f, 0x95, 0x12, 0xb4, 0xcb, 0x3e, 0x32, 0x4a, 0x4c, 0x83, 0xe8, 0xe2, 0xbe,
-	0xea, 0xd9, 0x48, 0x99, 0xd6, 0xfc, 0x2f, 0x71, 0x15, 0x66, 0x5e, 0xb3, 0x63, 0x9a, 0xc1, 0x9d,
-	0x36, 0x02, 0xe1, 0xa9, 0x41, 0xbe, 0x65, 0x7e, 0xdb, 0x31, 0x75, 0xd3, 0xed, 0x35, 0x34, 0xd5,
-	0x96, 0x66, 0x38, 0x7d, 0x84, 0x01, 0xf4, 0x99, 0xe5, 0x4e, 0x35, 0xd5, 0x8e, 0xd5, 0xe5, 0x06,
-	0x14, 0xd3, 0xf7, 0x9c, 0x0b, 0x83, 0x04, 0x53, 0x41, 0xd9, 0xfd, 0xbd, 0xf7, 0x87, 0x45, 0x0d,
-	0x16, 0xeb, 0xc4, 0x50, 0x50, 0x1b, 0x3f, 0x40, 0x7b, 0xfe, 0xb6, 0x62, 0xd3, 0x3a, 0xe3, 0x91,
-	0x8b, 0x81, 0x2c, 0xc0, 0x5a, 0x52, 0x12, 0xce, 0x9c, 0x9f, 0xc3, 0xa7, 0x3f, 0xe0, 0x55, 0xb5,
-	0xe7, 0x22, 0x0d, 0xeb, 0x83, 0x4e, 0xff, 0x3b, 0xb0, 0x90, 0x42, 0xeb, 0x79, 0x2d, 0xca, 0xe6,
-	0x40, 0xd9, 0x69, 0xc0, 0x46, 0x53, 0x25, 0x4d, 0x5f, 0xd6, 0xe8, 0xc9, 0xac, 0x61, 0x1d, 0xdd,
-	0x51, 0x49, 0x73, 0xc0, 0xc9, 0x8c, 0x63, 0xe2, 0xc8, 0x7f, 0x13, 0x18, 0xe7, 0x43, 0xe7, 0xf7,
-	0x6e, 0xa8, 0x56, 0x67, 0x15, 0xae, 0x3e, 0xbe, 0xe4, 0x86, 0x92, 0x9b, 0x41, 0x7c, 0xd9, 0x64,
-	0x7c, 0x49, 0xc1, 0xcb, 0x97, 0xf5, 0x0d, 0xe4, 0xeb, 0xc4, 0xf8, 0x42, 0xed, 0x10, 0x94, 0x75,
-	0x78, 0xdf, 0x86, 0xf9, 0xc8, 0x42, 0x10, 0x5d, 0x4a, 0x8e, 0x4a, 0x7c, 0x78, 0x29, 0x28, 0x4e,
-	0x89, 0x65, 0x38, 0x1f, 0xc9, 0xc0, 0x53, 0x1f, 0xc0, 0x3c, 0x05, 0x68, 0xd9, 0xff, 0x63, 0xf2,
-	0x15, 0x4f, 0x4e, 0x43, 0x39, 0x78, 0xfa, 0x1f, 0x85, 0xfe, 0x8b, 0xc3, 0x10, 0x5a, 0x7b, 0x13,
-	0xd6, 0xbc, 0xc6, 0xc0, 0x1c, 0xd2, 0xc4, 0x76, 0x85, 0xf5, 0x81, 0x48, 0xcc, 0xe4, 0x03, 0x93,
-	0x70, 0xab, 0xe8, 0x93, 0xdb, 0x27, 0x02, 0x88, 0xd1, 0x52, 0x7e, 0x4e, 0xc5, 0xed, 0x8c, 0x94,
-	0x0b, 0xf4, 0x32, 0x97, 0xa8, 0x97, 0xe3, 0x61, 0xbd, 0x8c, 0x61, 0x5e, 0x8b, 0x9f, 0x04, 0x0a,
-	0x87, 0xa3, 0x35, 0xd9, 0xaf, 0xd5, 0x8e, 0x63, 0x05, 0x2a, 0x55, 0xc7, 0x7a, 0xa7, 0x85, 0x6e,
-	0x11, 0x82, 0xdc, 0xff, 0x56, 0x6d, 0x3c, 0x8a, 0xa7, 0xa4, 0x0a, 0x00, 0x55, 0x7e, 0x9d, 0x83,
-	0x5c, 0x9d, 0x18, 0xe2, 0x13, 0x01, 0xce, 0x27, 0x5f, 0xcd, 0xae, 0x95, 0x06, 0xdc, 0x5b, 0x4b,
-	0x69, 0x97, 0x31, 0xf9, 0xfa, 0xa9, 0xdc, 0xb8, 0x54, 0xff, 0x22, 0xc0, 0x72, 0x5a, 0x0b, 0xdd,
-	0x19, 0x2e, 0x74, 0x9f, 0xa3, 0x7c, 0xf3, 0x94, 0x8e, 0x1c, 0xd5, 0xf7, 0x02, 0xbc, 0xd9, 0xdf,
-	0x24, 0xae, 0x64, 0x85, 0xed, 0x73, 0x91, 0x3f, 0x1a, 0xd9, 0x85, 0x63, 0xf8, 0x49, 0x80, 0xc5,
-	0xc4, 0x2b, 0xd0, 0xd5, 0xac, 0x98, 0x49, 0x5e, 0xf2, 0x27, 0xa7, 0xf1, 0xe2, 0x60, 0x9e, 0x0a,
-	0xb0, 0x94, 0xd2, 0xaf, 0x3e, 0x1c, 0x2e, 0x70, 0xdc, 0x4f, 0xbe, 0x71, 0x3a, 0xbf, 0x04, 0x48,
-	0x7d, 0x17, 0xe8, 0x21, 0x21, 0xc5, 0xfd, 0x86, 0x85, 0x94, 0x76, 0x71, 0x65, 0x64, 0x4e, 0xeb,
-	0x8d, 0x3b, 0x23, 0xc4, 0x0e, 0x3b, 0x66, 0x93, 0x39, 0xa3, 0xbb, 0x89, 0x2d, 0x80, 0x50, 0x6b,
-	0xdb, 0xce, 0x0a, 0xf7, 0xda, 0x56, 0xae, 0x0c, 0x6f, 0xcb, 0xb3, 0x39, 0x30, 0x1b, 0xe9, 0x66,
-	0xef, 0x65, 0xc2, 0x0f, 0x59, 0xcb, 0x57, 0x47, 0xb1, 0xe6, 0x39, 0xa9, 0xa8, 0x25, 0xb7, 0xb0,
-	0x6b, 0xc3, 0x6d, 0x5e, 0xcc, 0x2d, 0x5b, 0xd4, 0x06, 0xf6, 0x2a, 0xf1, 0x3b, 0x98, 0x8f, 0xf7,
-	0xa9, 0xf2, 0x08, 0x55, 0xa4, 0x0e, 0xf2, 0xce, 0x88, 0x0e, 0x11, 0x12, 0xa6, 0x35, 0x9e, 0xcc,
-	0xa0, 0x29, 0x8e, 0xd9, 0x24, 0xcc, 0xe8, 0x3f, 0xfd, 0x25, 0xe2, 0xcf, 0x13, 0xa3, 0x95, 0x28,
-	0x70, 0x1b, 0xb1, 0x44, 0xf1, 0x47, 0x0a, 0x79, 0xe2, 0xf1, 0xab, 0x67, 0xdb, 0x42, 0x75, 0xf7,
-	0xf9, 0x71, 0x41, 0x78, 0x71, 0x5c, 0x10, 0xfe, 0x3a, 0x2e, 0x08, 0x4f, 0x4f, 0x0a, 0x63, 0x2f,
-	0x4e, 0x0a, 0x63, 0xbf, 0x9f, 0x14, 0xc6, 0xf6, 0xdf, 0x35, 0x4c, 0xb7, 0xd9, 0x39, 0x28, 0x69,
-	0xb8, 0xcd, 0xde, 0x64, 0xde, 0xf7, 0x9e, 0x67, 0x2c, 0xac, 0xa3, 0xf2, 0xc3, 0xd0, 0xab, 0x4f,
-	0xcf, 0x46, 0xe4, 0x60, 0x92, 0xbd, 0xc2, 0x7c, 0xf0, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4a,
-	0xb1, 0x50, 0xfb, 0x21, 0x12, 0x00, 0x00,
+	// 1264 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xd6, 0xf9, 0xe5, 0x47, 0xec, 0x84, 0x55, 0x9a, 0x38, 0x9b, 0xc8, 0x49, 0xdd, 0x50,
+	0x42, 0x00, 0x3b, 0x35, 0x2d, 0x11, 0x88, 0xb6, 0xaa, 0xdd, 0xa4, 0x45, 0xaa, 0x2b, 0xb4, 0xd0,
+	0x22, 0xe5, 0x62, 0x6d, 0x76, 0x27, 0xeb, 0x55, 0xec, 0x9d, 0x65, 0x67, 0x5d, 0xd7, 0xe5, 0x52,
+	0xe0, 0x86, 0x54, 0xa9, 0x02, 0xfe, 0x0e, 0x14, 0xf1, 0x57, 0xf4, 0xd8, 0x23, 0x42, 0xa8, 0x42,
+	0xc9, 0xa1, 0x77, 0x0e, 0x9c, 0xd1, 0x8c, 0x77, 0x27, 0xde, 0xf5, 0xfe, 0xb0, 0x13, 0x2e, 0x5c,
+	0x92, 0x9d, 0xf1, 0xfb, 0xde, 0x7c, 0x6f, 0xe6, 0x7b, 0xef, 0x8d, 0x06, 0xd6, 0x9f, 0x22, 0x47,
+	0x51, 0x1b, 0x8a, 0x61, 0x96, 0xd8, 0x17, 0xb6, 0x51, 0xe9, 0xa0, 0x6d, 0xea, 0xc6, 0x7e, 0x13,
+	0x95, 0x9c, 0x27, 0x45, 0xcb, 0xc6, 0x0e, 0x16, 0x97, 0xb9, 0x55, 0xd1, 0xb3, 0x2a, 0x7a, 0x56,
+	0xd2, 0xbc, 0x8e, 0x75, 0xcc, 0xec, 0x4a, 0xf4, 0xab, 0x07, 0x91, 0xae, 0x84, 0x38, 0xb6, 0x0e,
+	0xf5, 0x92, 0x8a, 0x0d, 0x93, 0xfd, 0x71, 0xed, 0x16, 0x55, 0x4c, 0x5a, 0x98, 0x94, 0x5a, 0x44,
+	0x2f, 0x3d, 0xbe, 0x4a, 0xff, 0xb9, 0x3f, 0x94, 0xe2, 0x98, 0x1d, 0x60, 0x1b, 0x19, 0xba, 0x59,
+	0xa7, 0x8e, 0x48, 0x0f, 0x50, 0x38, 0x80, 0x5c, 0x8d, 0xe8, 0x0f, 0x2d, 0x4d, 0x71, 0xd0, 0x5d,
+	0xc5, 0x41, 0x1d, 0xa5, 0x7b, 0x57, 0x21, 0xf7, 0x8d, 0x96, 0xe1, 0x88, 0x39, 0x98, 0x52, 0x6d,
+	0xa4, 0x38, 0xd8, 0xce, 0x09, 0x6b, 0xc2, 0x46, 0x5a, 0xf6, 0x86, 0x62, 0x01, 0x32, 0x26, 0xea,
+	0xd4, 0x75, 0x85, 0xd4, 0x9b, 0xd4, 0x34, 0x77, 0x61, 0x4d, 0xd8, 0x18, 0x97, 0xdf, 0x32, 0x51,
+	0xc7, 0x43, 0x7f, 0x3a, 0xf3, 0xfd, 0x9b, 0xa3, 0x4d, 0x0f, 0x51, 0x28, 0xc0, 0x5a, 0xd4, 0x3a,
+	0x32, 0x22, 0x16, 0x36, 0x09, 0x2a, 0x54, 0x18, 0x97, 0x3b, 0xc8, 0x6a, 0xe2, 0xee, 0x97, 0x5d,
+	0xe2, 0xa0, 0x56, 0x15, 0x9b, 0x8e, 0xad, 0xa8, 0x0e, 0x89, 0xe6, 0x12, 0x58, 0xe7, 0x4f, 0x81,
+	0x2d, 0x14, 0xea, 0xc4, 0x5b, 0x48, 0xdc, 0x84, 0xb9, 0xb6, 0x69, 0x90, 0x8e, 0x62, 0x3d, 0x2a,
+	0xef, 0x2a, 0xaa, 0x83, 0xed, 0xae, 0xeb, 0x75, 0x60, 0x5e, 0x9c, 0x87, 0x89, 0x0e, 0xdd, 0x4a,
+	0x16, 0x62, 0x5a, 0xee, 0x0d, 0xc4, 0x0d, 0x98, 0xe5, 0x96, 0x32, 0x6e, 0x3b, 0xc8, 0xce, 0xa5,
+	0xd8, 0xef, 0xc1, 0x69, 0x71, 0x1d, 0x32, 0x2a, 0x36, 0x4d, 0x44, 0xbd, 0xed, 0xed, 0x3c, 0xaa,
+	0xe5, 0xc6, 0x99, 0x9d, 0x7f, 0x52, 0xbc, 0x02, 0x59, 0xe2, 0x23, 0x9b, 0x9b, 0x60, 0x66, 0x81,
+	0xd9, 0xc2, 0x3f, 0x02, 0x2c, 0xf1, 0x7d, 0xdc, 0x93, 0xab, 0xe5, 0xad, 0xaf, 0x0d, 0xa7, 0xa1,
+	0xd9, 0x4a, 0x67, 0x17, 0xa1, 0x98, 0x03, 0xbb, 0x0c, 0x99, 0xa7, 0xb6, 0x5a, 0xde, 0xaa, 0x2b,
+	0x9a, 0x66, 0x23, 0x42, 0xdc, 0x68, 0x66, 0xd8, 0xe4, 0xed, 0xde, 0x9c, 0x78, 0x0f, 0xe6, 0xe8,
+	0xa9, 0x76, 0x5c, 0x8f, 0xf5, 0x03, 0x84, 0x72, 0x93, 0xd4, 0xae, 0x92, 0x7f, 0xf9, 0x7a, 0x75,
+	0xec, 0x8f, 0xd7, 0xab, 0x0b, 0x3d, 0xdd, 0x11, 0xed, 0xb0, 0x68, 0xe0, 0x52, 0x4b, 0x71, 0x1a,
+	0xc5, 0x87, 0x86, 0xe9, 0xc8, 0x59, 0x13, 0x75, 0xfa, 0x89, 0x54, 0x82, 0xfa, 0x98, 0x1a, 0xca,
+	0x4d, 0x8c, 0x7e, 0x2e, 0xc3, 0xa5, 0xc8, 0xb8, 0xb9, 0x80, 0x9e, 0x09, 0xb0, 0xc8, 0xad, 0xfc,
+	0x87, 0x1f, 0xb3, 0x37, 0x37, 0x60, 0x99, 0x92, 0xed, 0xed, 0x74, 0x5d, 0x75, 0x01, 0x81, 0x9d,
+	0xca, 0x99, 0xa8, 0xe3, 0xf7, 0xe8, 0xee, 0x5a, 0x80, 0xe7, 0x25, 0x58, 0x8d, 0x60, 0xc0, 0x59,
+	0xfe, 0x7d, 0x01, 0x24, 0x2e, 0xd1, 0x5d, 0x37, 0x39, 0xab, 0xd8, 0x30, 0x59, 0x5c, 0x31, 0x44,
+	0xe7, 0x61, 0x62, 0x87, 0x9a, 0x78, 0x52, 0x64, 0x03, 0x71, 0x03, 0xe6, 0x78, 0x62, 0xd3, 0xc4,
+	0xaf, 0x1b, 0x1a, 0xd3, 0x62, 0x4a, 0xce, 0xba, 0xf3, 0x55, 0x3a, 0xfd, 0xb9, 0x26, 0x4a, 0x30,
+	0xad, 0x21, 0xd5, 0x68, 0x29, 0x4d, 0xc2, 0x54, 0x98, 0x91, 0xf9, 0x58, 0x14, 0x61, 0xdc, 0x54,
+	0x5a, 0xc8, 0x95, 0x1d, 0xfb, 0x16, 0x17, 0x60, 0x92, 0x74, 0x5b, 0xfb, 0xb8, 0xd9, 0x53, 0x81,
+	0xec, 0x8e, 0xc4, 0x0a, 0xa4, 0x69, 0x09, 0xa9, 0x3b, 0x5d, 0x0b, 0xb1, 0x93, 0xcd, 0x96, 0xdf,
+	0x29, 0x86, 0x14, 0x3b, 0xeb, 0x50, 0x2f, 0xb2, 0xa2, 0x45, 0x83, 0xfb, 0xaa, 0x6b, 0x21, 0x79,
+	0x5a, 0x75, 0xbf, 0xc4, 0x65, 0x48, 0x9f, 0xaa, 0x63, 0x9a, 0xd1, 0x9d, 0xd6, 0xbd, 0xc2, 0x53,
+	0x85, 0x4c, 0xd3, 0xf8, 0xa6, 0x6d, 0x68, 0x86, 0xd3, 0xad, 0xab, 0x8a, 0x95, 0x4b, 0x73, 0xf9,
+	0x08, 0x31, 0xf2, 0x99, 0xe1, 0xa0, 0xaa, 0x62, 0x05, 0xce, 0xe5, 0x26, 0x14, 0xa2, 0xf7, 0x9c,
+	0x17, 0x86, 0x1c, 0x4c, 0x79, 0xc7, 0xee, 0xee, 0xbd, 0x3b, 0x2c, 0xa8, 0x30, 0x5f, 0x23, 0xba,
+	0x8c, 0x5a, 0xf8, 0x31, 0xda, 0x75, 0xb7, 0x15, 0x1b, 0xe6, 0x39, 0x53, 0x2e, 0x40, 0x32, 0x0f,
+	0x2b, 0x61, 0x8b, 0x70, 0xe5, 0xfc, 0xd4, 0x9f, 0xfd, 0x9e, 0xae, 0x2a, 0x5d, 0x07, 0xa9, 0x58,
+	0x8b, 0xcb, 0xfe, 0xf7, 0x60, 0x2e, 0x42, 0xd6, 0xb3, 0xaa, 0x5f, 0xcd, 0x5e, 0x65, 0xa7, 0x0e,
+	0xeb, 0x0d, 0x85, 0x34, 0xdc, 0xb2, 0x46, 0x33, 0xb3, 0x8a, 0x35, 0x74, 0x4f, 0x21, 0x8d, 0x98,
+	0xcc, 0x0c, 0x72, 0xe2, 0xcc, 0x7f, 0x15, 0x98, 0xe6, 0xfb, 0xf2, 0xf7, 0x7e, 0xdf, 0x59, 0x9d,
+	0xb7, 0x70, 0x0d, 0xe8, 0x25, 0x35, 0x54, 0xb9, 0x89, 0xd3, 0xcb, 0x3a, 0xd3, 0x4b, 0x04, 0x5f,
+	0x1e, 0xd6, 0x91, 0x00, 0x99, 0x1a, 0xd1, 0xbf, 0x50, 0xda, 0x04, 0x25, 0x65, 0xef, 0xbb, 0x30,
+	0xeb, 0x8b, 0x04, 0xd1, 0x58, 0x52, 0xb4, 0xc6, 0xf7, 0xc7, 0x82, 0x88, 0xf8, 0x00, 0xd2, 0x9a,
+	0x61, 0x23, 0xd5, 0x31, 0xb0, 0xc9, 0x22, 0xc9, 0x96, 0xb7, 0x8a, 0x31, 0x77, 0x89, 0x22, 0x5b,
+	0x99, 0x71, 0xb8, 0xe3, 0xe1, 0xe4, 0x53, 0x17, 0x81, 0xc0, 0x16, 0xe1, 0xa2, 0x8f, 0x31, 0x8f,
+	0xe5, 0x37, 0x01, 0x66, 0x69, 0xc8, 0xa6, 0xf5, 0x3f, 0x8a, 0x66, 0xa9, 0x57, 0xf0, 0xfb, 0x38,
+	0xf3, 0x78, 0x7e, 0x10, 0x06, 0xaf, 0x36, 0x43, 0x74, 0x83, 0x5b, 0xb0, 0xd2, 0x6b, 0x5d, 0x0c,
+	0x10, 0xd5, 0x0e, 0x96, 0x58, 0xa7, 0xf2, 0xf9, 0x0c, 0x4f, 0xe9, 0x90, 0x7b, 0xcf, 0x40, 0x43,
+	0x78, 0x2e, 0x80, 0xe8, 0x17, 0xdb, 0x03, 0x5a, 0x7e, 0xcf, 0x99, 0x14, 0x5e, 0x45, 0x4f, 0x85,
+	0x56, 0xf4, 0xf1, 0xfe, 0x8a, 0x1e, 0xe0, 0xbc, 0x12, 0xcc, 0x55, 0x4a, 0x87, 0xb3, 0x35, 0xd8,
+	0xaf, 0x95, 0xb6, 0x6d, 0x7a, 0x75, 0xb4, 0x86, 0xb5, 0x76, 0x13, 0xdd, 0x26, 0x04, 0x39, 0xff,
+	0x6d, 0x3d, 0xec, 0x25, 0x61, 0xc4, 0x52, 0x1e, 0xa1, 0xf2, 0x2f, 0x59, 0x48, 0xd5, 0x88, 0x2e,
+	0x3e, 0x17, 0xe0, 0x62, 0xf8, 0xe5, 0xf1, 0x7a, 0xac, 0xe0, 0xa2, 0xae, 0x8b, 0xd2, 0x8d, 0x33,
+	0xc1, 0x78, 0x33, 0xf9, 0x59, 0x80, 0xc5, 0xa8, 0x26, 0xbf, 0x3d, 0x9c, 0xeb, 0x01, 0xa0, 0x74,
+	0xeb, 0x8c, 0x40, 0xce, 0xea, 0x3b, 0x01, 0xde, 0x1e, 0x6c, 0x63, 0x57, 0x93, 0xdc, 0x0e, 0x40,
+	0xa4, 0x4f, 0x46, 0x86, 0x70, 0x0e, 0x3f, 0x0a, 0x30, 0x1f, 0x7a, 0x49, 0xbb, 0x96, 0xe4, 0x33,
+	0x0c, 0x25, 0x7d, 0x76, 0x16, 0x14, 0x27, 0xf3, 0x42, 0x80, 0x85, 0x88, 0x8e, 0xfa, 0xf1, 0x70,
+	0x8e, 0x83, 0x38, 0xe9, 0xe6, 0xd9, 0x70, 0x21, 0x94, 0x06, 0xae, 0xf8, 0x43, 0x52, 0x0a, 0xe2,
+	0x86, 0xa5, 0x14, 0x75, 0xb5, 0x66, 0x62, 0x8e, 0xea, 0xde, 0xdb, 0x23, 0xf8, 0xee, 0x07, 0x26,
+	0x8b, 0x39, 0xa1, 0xff, 0x8a, 0x4d, 0x80, 0xbe, 0xde, 0xbb, 0x99, 0xe4, 0xee, 0xd4, 0x56, 0x2a,
+	0x0f, 0x6f, 0xcb, 0x57, 0xb3, 0x61, 0xc6, 0xd7, 0x1d, 0x3f, 0x48, 0xa4, 0xdf, 0x67, 0x2d, 0x5d,
+	0x1b, 0xc5, 0x9a, 0xaf, 0x49, 0x8b, 0x5a, 0x78, 0x0b, 0xbb, 0x3e, 0xdc, 0xe6, 0x05, 0x60, 0xc9,
+	0x45, 0x2d, 0xb6, 0x57, 0x89, 0xdf, 0xc2, 0x6c, 0xb0, 0x4f, 0x95, 0x46, 0x38, 0x45, 0x0a, 0x90,
+	0xb6, 0x47, 0x04, 0xf8, 0x44, 0x18, 0xd5, 0x78, 0x12, 0x9d, 0x46, 0x00, 0x93, 0x45, 0x98, 0xd0,
+	0x7f, 0x06, 0x8f, 0x88, 0x3f, 0xa0, 0x8c, 0x76, 0x44, 0x1e, 0x6c, 0xc4, 0x23, 0x0a, 0x3e, 0xa3,
+	0x48, 0x13, 0xcf, 0xde, 0x1c, 0x6d, 0x0a, 0x95, 0x9d, 0x97, 0xc7, 0x79, 0xe1, 0xd5, 0x71, 0x5e,
+	0xf8, 0xeb, 0x38, 0x2f, 0xbc, 0x38, 0xc9, 0x8f, 0xbd, 0x3a, 0xc9, 0x8f, 0xfd, 0x7e, 0x92, 0x1f,
+	0xdb, 0x7b, 0x5f, 0x37, 0x9c, 0x46, 0x7b, 0xbf, 0xa8, 0xe2, 0x16, 0x7b, 0x25, 0xfa, 0xb0, 0xf7,
+	0x60, 0x64, 0x62, 0x0d, 0x95, 0x9e, 0xf4, 0x3d, 0x64, 0x75, 0x2d, 0x44, 0xf6, 0x27, 0xd9, 0x3b,
+	0xd1, 0x47, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xc7, 0xdd, 0x8f, 0xb8, 0xf4, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2533,6 +2551,11 @@ func (m *MsgPauseZRC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Direction != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Zrc20Addresses) > 0 {
 		for iNdEx := len(m.Zrc20Addresses) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Zrc20Addresses[iNdEx])
@@ -2595,6 +2618,11 @@ func (m *MsgUnpauseZRC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Direction != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Zrc20Addresses) > 0 {
 		for iNdEx := len(m.Zrc20Addresses) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Zrc20Addresses[iNdEx])
@@ -3119,6 +3147,9 @@ func (m *MsgPauseZRC20) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if m.Direction != 0 {
+		n += 1 + sovTx(uint64(m.Direction))
+	}
 	return n
 }
 
@@ -3147,6 +3178,9 @@ func (m *MsgUnpauseZRC20) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if m.Direction != 0 {
+		n += 1 + sovTx(uint64(m.Direction))
+	}
 	return n
 }
 
@@ -5113,6 +5147,25 @@ func (m *MsgPauseZRC20) Unmarshal(dAtA []byte) error {
 			}
 			m.Zrc20Addresses = append(m.Zrc20Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= ZRC20PauseDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5277,6 +5330,25 @@ func (m *MsgUnpauseZRC20) Unmarshal(dAtA []byte) error {
 			}
 			m.Zrc20Addresses = append(m.Zrc20Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= ZRC20PauseDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
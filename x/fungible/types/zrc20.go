@@ -1,12 +1,24 @@
 package types
 
-import ethcommon "github.com/ethereum/go-ethereum/common"
+import (
+	"math/big"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
 
 // DefaultLiquidityCap is the default value set for the liquidity cap of a new ZRC20 when deployed
 // for security reason, this value is low. An arbitrary value should be set during the process of deploying a new ZRC20
 // The value is represented in the base unit of the ZRC20, final value is calculated by multiplying this value by 10^decimals
 const DefaultLiquidityCap = uint64(1000)
 
+// GasCoinPoolLiquidity returns the amount of gas ZRC20 minted by the protocol into the ZETA/gas pool
+// when setting up the gas coin of a chain, this amount is not backed by the TSS custody
+func GasCoinPoolLiquidity(decimals uint8) *big.Int {
+	amount := big.NewInt(10)
+	// #nosec G115 always in range
+	return amount.Exp(amount, big.NewInt(int64(decimals-1)), nil)
+}
+
 // ZRC20Data represents the ZRC4 token details used to map
 // the token to a Cosmos Coin
 type ZRC20Data struct {
@@ -10,6 +10,9 @@ import (
 )
 
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.ProcessObserverSetChange(ctx)
+	k.HandleBallotsLiveness(ctx)
+
 	lastBlockObserverCount, found := k.GetLastObserverCount(ctx)
 	if !found {
 		ctx.Logger().Error("LastBlockObserverCount not found at height", ctx.BlockHeight())
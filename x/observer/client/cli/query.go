@@ -34,6 +34,7 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdBlameByIdentifier(),
 		CmdGetAllBlameRecords(),
 		CmdGetBlameByChainAndNonce(),
+		CmdBlameStats(),
 		CmdGetTssAddress(),
 		CmdListTssHistory(),
 		CmdShowTSS(),
@@ -46,6 +47,10 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdShowOperationalFlags(),
 		CmdAllBallots(),
 		CmdBallotListForHeight(),
+		CmdShowObserverSetChange(),
+		CmdListObserverSigningInfos(),
+		CmdShowObserverSigningInfo(),
+		CmdShowLivenessParams(),
 	)
 
 	return cmd
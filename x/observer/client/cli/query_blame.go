@@ -10,6 +10,8 @@ import (
 	"github.com/zeta-chain/node/x/observer/types"
 )
 
+const pubKeyFlag = "pub-key"
+
 func CmdBlameByIdentifier() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "show-blame [blame-identifier]",
@@ -114,3 +116,42 @@ func CmdGetBlameByChainAndNonce() *cobra.Command {
 
 	return cmd
 }
+
+func CmdBlameStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blame-stats [window]",
+		Short: "Query blame statistics per node over the last window blocks",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			window, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pubKey, _ := cmd.Flags().GetString(pubKeyFlag)
+			params := &types.QueryBlameStatsRequest{
+				Window: window,
+				PubKey: pubKey,
+			}
+
+			res, err := queryClient.BlameStats(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(pubKeyFlag, "", "only return statistics for the node with this pubkey")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -34,6 +34,10 @@ func GetTxCmd() *cobra.Command {
 		CmdDisableFastConfirmation(),
 		CmdUpdateGasPriceIncreaseFlags(),
 		CmdUpdateOperationalFlags(),
+		CmdProposeObserverSetChange(),
+		CmdUnjailObserver(),
+		CmdCancelObserverSetChange(),
+		CmdUpdateLivenessParams(),
 	)
 
 	return cmd
@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// observerSetChangeFile is the content of the file describing the observer set change
+type observerSetChangeFile struct {
+	Additions []types.ObserverSetAddition `json:"additions"`
+	Removals  []string                    `json:"removals"`
+}
+
+func CmdProposeObserverSetChange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-observer-set-change [observer-set-change.json] [activation-height]",
+		Short: "command to stage an observer set change via a group proposal",
+		Long: `Stages an observer set change to be applied at the activation height.
+The file lists the observers to add and to remove:
+
+{
+  "additions": [
+    {
+      "observer_address": "zeta1...",
+      "zetaclient_grantee_pubkey": "zetapub1..."
+    }
+  ],
+  "removals": ["zeta1..."]
+}`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			activationHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			file, err := filepath.Abs(args[0])
+			if err != nil {
+				return err
+			}
+			file = filepath.Clean(file)
+			input, err := os.ReadFile(file) // #nosec G304
+			if err != nil {
+				return err
+			}
+			var change observerSetChangeFile
+			err = json.Unmarshal(input, &change)
+			if err != nil {
+				return err
+			}
+
+			eddsa, _ := cmd.Flags().GetBool(eddsaFlag)
+
+			msg := types.NewMsgProposeObserverSetChange(
+				clientCtx.GetFromAddress().String(),
+				change.Additions,
+				change.Removals,
+				activationHeight,
+				eddsa,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(eddsaFlag, false, "Generate an ed25519 key alongside the secp256k1 key")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/zeta-chain/node/x/observer/types"
 )
 
+const eddsaFlag = "eddsa"
+
 func CmdUpdateKeygen() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-keygen [block]",
@@ -27,15 +29,19 @@ func CmdUpdateKeygen() *cobra.Command {
 				return err
 			}
 
+			eddsa, _ := cmd.Flags().GetBool(eddsaFlag)
+
 			msg := types.NewMsgUpdateKeygen(
 				clientCtx.GetFromAddress().String(),
 				argBlock,
+				eddsa,
 			)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().Bool(eddsaFlag, false, "Generate an ed25519 key alongside the secp256k1 key")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
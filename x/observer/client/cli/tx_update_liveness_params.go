@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+const (
+	enabledFlag              = "enabled"
+	windowFlag               = "window"
+	minVotedBallotsFlag      = "min-voted-ballots"
+	jailDurationFlag         = "jail-duration"
+	ballotMaturityBlocksFlag = "ballot-maturity-blocks"
+)
+
+func CmdUpdateLivenessParams() *cobra.Command {
+	defaultParams := types.DefaultLivenessParams()
+
+	cmd := &cobra.Command{
+		Use:   "update-liveness-params",
+		Short: "Broadcast message UpdateLivenessParams",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var livenessParams types.LivenessParams
+
+			flagSet := cmd.Flags()
+			file, _ := flagSet.GetString(fileFlag)
+
+			if file != "" {
+				input, err := os.ReadFile(file) // #nosec G304
+				if err != nil {
+					return err
+				}
+				err = json.Unmarshal(input, &livenessParams)
+				if err != nil {
+					return err
+				}
+			} else {
+				livenessParams.Enabled, _ = flagSet.GetBool(enabledFlag)
+				livenessParams.Window, _ = flagSet.GetInt64(windowFlag)
+				livenessParams.MinVotedBallots, _ = flagSet.GetInt64(minVotedBallotsFlag)
+				livenessParams.JailDuration, _ = flagSet.GetInt64(jailDurationFlag)
+				livenessParams.BallotMaturityBlocks, _ = flagSet.GetInt64(ballotMaturityBlocksFlag)
+			}
+
+			msg := types.NewMsgUpdateLivenessParams(
+				clientCtx.GetFromAddress().String(),
+				livenessParams,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(fileFlag, "", "Path to a JSON file containing LivenessParams")
+	cmd.Flags().Bool(enabledFlag, false, "Enable the tracking and the jailing of the observers missing ballots")
+	cmd.Flags().Int64(windowFlag, defaultParams.Window, "Number of ballots tracked per observer")
+	cmd.Flags().Int64(minVotedBallotsFlag, defaultParams.MinVotedBallots, "Minimum number of ballots voted in the window")
+	cmd.Flags().Int64(jailDurationFlag, defaultParams.JailDuration, "Number of blocks an observer is jailed for")
+	cmd.Flags().Int64(
+		ballotMaturityBlocksFlag,
+		defaultParams.BallotMaturityBlocks,
+		"Number of blocks after which the participation in a ballot is tracked",
+	)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -13,6 +13,8 @@ import (
 	"github.com/zeta-chain/node/x/observer/types"
 )
 
+const eddsaPubkeyFlag = "eddsa-pubkey"
+
 func CmdVoteTSS() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "vote-tss [pubkey] [keygen-block] [status]",
@@ -39,12 +41,21 @@ func CmdVoteTSS() *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgVoteTSS(clientCtx.GetFromAddress().String(), argsPubkey, keygenBlock, status)
+			eddsaPubkey, _ := cmd.Flags().GetString(eddsaPubkeyFlag)
+
+			msg := types.NewMsgVoteTSS(
+				clientCtx.GetFromAddress().String(),
+				argsPubkey,
+				eddsaPubkey,
+				keygenBlock,
+				status,
+			)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(eddsaPubkeyFlag, "", "ed25519 TSS public key, if the keygen generates one")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -131,6 +131,16 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetNonceToCctx(ctx, elem)
 	}
 	k.SetOperationalFlags(ctx, genState.OperationalFlags)
+
+	if genState.ObserverSetChange != nil {
+		k.SetObserverSetChange(ctx, *genState.ObserverSetChange)
+	}
+
+	k.SetLivenessParams(ctx, genState.LivenessParams)
+
+	for _, elem := range genState.ObserverSigningInfos {
+		k.SetObserverSigningInfo(ctx, elem)
+	}
 }
 
 // ExportGenesis returns the observer module's exported genesis.
@@ -187,21 +197,30 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	of, _ := k.GetOperationalFlags(ctx)
 
+	var osc *types.ObserverSetChange
+	change, found := k.GetObserverSetChange(ctx)
+	if found {
+		osc = &change
+	}
+
 	return &types.GenesisState{
-		Ballots:           k.GetAllBallots(ctx),
-		ChainParamsList:   chainParams,
-		Observers:         os,
-		NodeAccountList:   nodeAccounts,
-		CrosschainFlags:   cf,
-		Keygen:            kn,
-		LastObserverCount: oc,
-		Tss:               tss,
-		PendingNonces:     pendingNonces,
-		TssHistory:        k.GetAllTSS(ctx),
-		TssFundMigrators:  k.GetAllTssFundMigrators(ctx),
-		BlameList:         k.GetAllBlame(ctx),
-		ChainNonces:       k.GetAllChainNonces(ctx),
-		NonceToCctx:       k.GetAllNonceToCctx(ctx),
-		OperationalFlags:  of,
+		Ballots:              k.GetAllBallots(ctx),
+		ChainParamsList:      chainParams,
+		Observers:            os,
+		NodeAccountList:      nodeAccounts,
+		CrosschainFlags:      cf,
+		Keygen:               kn,
+		LastObserverCount:    oc,
+		Tss:                  tss,
+		PendingNonces:        pendingNonces,
+		TssHistory:           k.GetAllTSS(ctx),
+		TssFundMigrators:     k.GetAllTssFundMigrators(ctx),
+		BlameList:            k.GetAllBlame(ctx),
+		ChainNonces:          k.GetAllChainNonces(ctx),
+		NonceToCctx:          k.GetAllNonceToCctx(ctx),
+		OperationalFlags:     of,
+		ObserverSetChange:    osc,
+		ObserverSigningInfos: k.GetAllObserverSigningInfo(ctx),
+		LivenessParams:       k.GetLivenessParams(ctx),
 	}
 }
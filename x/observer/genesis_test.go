@@ -39,10 +39,13 @@ func TestGenesis(t *testing.T) {
 				sample.ChainNonces(1),
 				sample.ChainNonces(2),
 			},
-			PendingNonces:    sample.PendingNoncesList(t, "sample", 20),
-			NonceToCctx:      sample.NonceToCctxList(t, "sample", 20),
-			TssHistory:       []types.TSS{sample.Tss()},
-			OperationalFlags: sample.OperationalFlags(),
+			PendingNonces:        sample.PendingNoncesList(t, "sample", 20),
+			NonceToCctx:          sample.NonceToCctxList(t, "sample", 20),
+			TssHistory:           []types.TSS{sample.Tss()},
+			OperationalFlags:     sample.OperationalFlags(),
+			ObserverSetChange:    sample.ObserverSetChange(t),
+			ObserverSigningInfos: []types.ObserverSigningInfo{sample.ObserverSigningInfo()},
+			LivenessParams:       sample.LivenessParams(),
 		}
 
 		// Init and export
@@ -71,3 +71,75 @@ func (k Keeper) GetBlamesByChainAndNonce(
 	}
 	return
 }
+
+// SetBlameEvent stores the blame event keyed by its height and blame index
+func (k Keeper) SetBlameEvent(ctx sdk.Context, event types.BlameEvent) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlameEventKey))
+	b := k.cdc.MustMarshal(&event)
+	store.Set(append(types.BlameEventKeyPrefix(event.Height), []byte(event.BlameIndex)...), b)
+}
+
+// GetBlameEvents returns the blame events finalized between startHeight and endHeight (inclusive)
+func (k Keeper) GetBlameEvents(ctx sdk.Context, startHeight, endHeight int64) (events []types.BlameEvent) {
+	if endHeight < startHeight || endHeight < 0 {
+		return nil
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlameEventKey))
+	iterator := store.Iterator(
+		types.BlameEventKeyPrefix(max(startHeight, 0)),
+		types.BlameEventKeyPrefix(endHeight+1),
+	)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.BlameEvent
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		events = append(events, val)
+	}
+	return
+}
+
+// RecordBlameEvent stores the blame event of a finalized blame and prunes
+// the blame events older than MaxBlameStatsWindow
+func (k Keeper) RecordBlameEvent(ctx sdk.Context, chainID int64, blame types.Blame) {
+	k.SetBlameEvent(ctx, types.NewBlameEvent(chainID, blame, ctx.BlockHeight()))
+
+	pruneHeight := ctx.BlockHeight() - types.MaxBlameStatsWindow
+	if pruneHeight <= 0 {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlameEventKey))
+	iterator := store.Iterator(nil, types.BlameEventKeyPrefix(pruneHeight))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetBlameStats returns the blame statistics per node for the blame events finalized
+// between startHeight and endHeight (inclusive), along with the operator of each node
+func (k Keeper) GetBlameStats(ctx sdk.Context, startHeight, endHeight int64) []types.NodeBlameStats {
+	stats := types.AggregateBlameStats(k.GetBlameEvents(ctx, startHeight, endHeight))
+	if len(stats) == 0 {
+		return stats
+	}
+
+	operators := make(map[string]string)
+	for _, nodeAccount := range k.GetAllNodeAccount(ctx) {
+		if nodeAccount.GranteePubkey != nil {
+			operators[nodeAccount.GranteePubkey.Secp256k1.String()] = nodeAccount.Operator
+		}
+	}
+
+	for i := range stats {
+		stats[i].Operator = operators[stats[i].PubKey]
+	}
+
+	return stats
+}
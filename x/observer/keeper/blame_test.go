@@ -109,3 +109,87 @@ func TestKeeper_BlameAll(t *testing.T) {
 		require.Len(t, rst, 0)
 	})
 }
+
+func TestKeeper_BlameEvents(t *testing.T) {
+	t.Run("should get blame events in height range", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		for height := int64(1); height <= 5; height++ {
+			k.SetBlameEvent(ctx, types.BlameEvent{
+				BlameIndex: sample.ZetaIndex(t),
+				ChainId:    1,
+				PubKeys:    []string{"pk"},
+				Height:     height,
+			})
+		}
+
+		events := k.GetBlameEvents(ctx, 2, 4)
+		require.Len(t, events, 3)
+		for i, event := range events {
+			require.EqualValues(t, i+2, event.Height)
+		}
+
+		require.Len(t, k.GetBlameEvents(ctx, -10, 100), 5)
+		require.Empty(t, k.GetBlameEvents(ctx, 4, 2))
+	})
+
+	t.Run("should record blame event and prune old events", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		k.SetBlameEvent(ctx, types.BlameEvent{BlameIndex: "old", Height: 1})
+		k.SetBlameEvent(ctx, types.BlameEvent{BlameIndex: "recent", Height: 10})
+
+		ctx = ctx.WithBlockHeight(types.MaxBlameStatsWindow + 5)
+		blame := types.Blame{
+			Index:         "new",
+			FailureReason: "Tss timeout",
+			Nodes:         []*types.Node{{PubKey: "pk1"}, {PubKey: "pk2"}},
+		}
+		k.RecordBlameEvent(ctx, 1, blame)
+
+		events := k.GetBlameEvents(ctx, 0, ctx.BlockHeight())
+		require.Len(t, events, 2)
+		require.Equal(t, "recent", events[0].BlameIndex)
+		require.Equal(t, types.NewBlameEvent(1, blame, ctx.BlockHeight()), events[1])
+	})
+
+	t.Run("should get blame stats with node operators", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		nodeAccount := sample.NodeAccount()
+		k.SetNodeAccount(ctx, *nodeAccount)
+		pubKey := nodeAccount.GranteePubkey.Secp256k1.String()
+
+		k.SetBlameEvent(ctx, types.BlameEvent{
+			BlameIndex:    "1",
+			ChainId:       1,
+			FailureReason: "Tss timeout",
+			PubKeys:       []string{pubKey, "unknown"},
+			Height:        1,
+		})
+		k.SetBlameEvent(ctx, types.BlameEvent{
+			BlameIndex:    "2",
+			ChainId:       1,
+			FailureReason: "Tss timeout",
+			PubKeys:       []string{pubKey},
+			Height:        2,
+		})
+
+		stats := k.GetBlameStats(ctx, 0, 2)
+		require.Equal(t, []types.NodeBlameStats{
+			{
+				PubKey:   pubKey,
+				Operator: nodeAccount.Operator,
+				Total:    2,
+				Counts:   []types.BlameCount{{ChainId: 1, FailureReason: "Tss timeout", Count: 2}},
+			},
+			{
+				PubKey: "unknown",
+				Total:  1,
+				Counts: []types.BlameCount{{ChainId: 1, FailureReason: "Tss timeout", Count: 1}},
+			},
+		}, stats)
+
+		require.Len(t, k.GetBlameStats(ctx, 2, 2), 1)
+	})
+}
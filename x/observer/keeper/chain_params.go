@@ -73,3 +73,23 @@ func (k Keeper) GetSupportedChains(ctx sdk.Context) []chains.Chain {
 	}
 	return c
 }
+
+// ReplaceChainParamsObserver replaces the observer in the subsets of observers of the chain params,
+// the observer is removed from the subsets if newObserver is empty.
+// It keeps the subsets within the observer set when an observer is removed or its address is updated.
+func (k Keeper) ReplaceChainParamsObserver(ctx sdk.Context, observer, newObserver string) {
+	cpl, found := k.GetChainParamsList(ctx)
+	if !found {
+		return
+	}
+
+	updated := false
+	for _, cp := range cpl.ChainParams {
+		if cp.ReplaceObserver(observer, newObserver) {
+			updated = true
+		}
+	}
+	if updated {
+		k.SetChainParamsList(ctx, cpl)
+	}
+}
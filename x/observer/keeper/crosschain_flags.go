@@ -66,3 +66,12 @@ func (k Keeper) IsV2ZetaEnabled(ctx sdk.Context) bool {
 	}
 	return flags.IsV2ZetaEnabled
 }
+
+// IsParentRevertInheritanceEnabled returns true if failed child withdraws inherit the revert options of their parent
+func (k Keeper) IsParentRevertInheritanceEnabled(ctx sdk.Context) bool {
+	flags, found := k.GetCrosschainFlags(ctx)
+	if !found {
+		return false
+	}
+	return flags.IsParentRevertInheritanceEnabled
+}
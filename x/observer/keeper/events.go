@@ -63,3 +63,46 @@ func EmitEventAddObserver(
 		ctx.Logger().Error("Error emitting EmitEventAddObserver :", err)
 	}
 }
+
+func EmitEventObserverSetChangeUpdated(ctx sdk.Context, change types.ObserverSetChange) {
+	err := ctx.EventManager().EmitTypedEvents(&types.EventObserverSetChangeUpdated{
+		Status:           change.Status.String(),
+		KeygenHeight:     change.KeygenHeight,
+		ActivationHeight: change.ActivationHeight,
+		TssPubkey:        change.TssPubkey,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventObserverSetChangeUpdated :", err)
+	}
+}
+
+func EmitEventObserverLiveness(ctx sdk.Context, observer, ballotIdentifier string, missedBallots int64) {
+	err := ctx.EventManager().EmitTypedEvents(&types.EventObserverLiveness{
+		ObserverAddress:  observer,
+		BallotIdentifier: ballotIdentifier,
+		MissedBallots:    missedBallots,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventObserverLiveness :", err)
+	}
+}
+
+func EmitEventObserverJailed(ctx sdk.Context, observer string, missedBallots, jailedUntil int64) {
+	err := ctx.EventManager().EmitTypedEvents(&types.EventObserverJailed{
+		ObserverAddress: observer,
+		MissedBallots:   missedBallots,
+		JailedUntil:     jailedUntil,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventObserverJailed :", err)
+	}
+}
+
+func EmitEventObserverUnjailed(ctx sdk.Context, observer string) {
+	err := ctx.EventManager().EmitTypedEvents(&types.EventObserverUnjailed{
+		ObserverAddress: observer,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventObserverUnjailed :", err)
+	}
+}
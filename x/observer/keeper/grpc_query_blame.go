@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"slices"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
@@ -63,3 +64,36 @@ func (k Keeper) BlamesByChainAndNonce(
 		BlameInfo: blameRecords,
 	}, nil
 }
+
+func (k Keeper) BlameStats(
+	goCtx context.Context,
+	request *types.QueryBlameStatsRequest,
+) (*types.QueryBlameStatsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if request.Window <= 0 || request.Window > types.MaxBlameStatsWindow {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"window must be between 1 and %d blocks",
+			types.MaxBlameStatsWindow,
+		)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	endHeight := ctx.BlockHeight()
+	startHeight := max(endHeight-request.Window+1, 0)
+
+	stats := k.GetBlameStats(ctx, startHeight, endHeight)
+	if request.PubKey != "" {
+		stats = slices.DeleteFunc(stats, func(s types.NodeBlameStats) bool {
+			return s.PubKey != request.PubKey
+		})
+	}
+
+	return &types.QueryBlameStatsResponse{
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		Stats:       stats,
+	}, nil
+}
@@ -135,3 +135,69 @@ func TestKeeper_BlamesByChainAndNonce(t *testing.T) {
 		}, res)
 	})
 }
+
+func TestKeeper_BlameStats(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.BlameStats(wctx, nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should error if window is invalid", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.BlameStats(wctx, &types.QueryBlameStatsRequest{Window: 0})
+		require.Nil(t, res)
+		require.Error(t, err)
+
+		res, err = k.BlameStats(wctx, &types.QueryBlameStatsRequest{Window: types.MaxBlameStatsWindow + 1})
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return blame stats within window", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		ctx = ctx.WithBlockHeight(100)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		k.SetBlameEvent(ctx, types.BlameEvent{
+			BlameIndex:    "1",
+			ChainId:       1,
+			FailureReason: "Tss timeout",
+			PubKeys:       []string{"pk1", "pk2"},
+			Height:        50,
+		})
+		k.SetBlameEvent(ctx, types.BlameEvent{
+			BlameIndex:    "2",
+			ChainId:       1,
+			FailureReason: "Tss timeout",
+			PubKeys:       []string{"pk1"},
+			Height:        95,
+		})
+
+		res, err := k.BlameStats(wctx, &types.QueryBlameStatsRequest{Window: 10})
+		require.NoError(t, err)
+		require.Equal(t, &types.QueryBlameStatsResponse{
+			StartHeight: 91,
+			EndHeight:   100,
+			Stats: []types.NodeBlameStats{
+				{
+					PubKey: "pk1",
+					Total:  1,
+					Counts: []types.BlameCount{{ChainId: 1, FailureReason: "Tss timeout", Count: 1}},
+				},
+			},
+		}, res)
+
+		res, err = k.BlameStats(wctx, &types.QueryBlameStatsRequest{Window: 100, PubKey: "pk2"})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, res.StartHeight)
+		require.Len(t, res.Stats, 1)
+		require.Equal(t, "pk2", res.Stats[0].PubKey)
+		require.EqualValues(t, 1, res.Stats[0].Total)
+	})
+}
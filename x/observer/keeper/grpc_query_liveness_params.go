@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func (k Keeper) LivenessParams(
+	c context.Context,
+	req *types.QueryLivenessParamsRequest,
+) (*types.QueryLivenessParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryLivenessParamsResponse{
+		LivenessParams: k.GetLivenessParams(ctx),
+	}, nil
+}
@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestKeeper_LivenessParams(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.LivenessParams(wctx, nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return liveness params", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		// should return the default params if not set
+		res, err := k.LivenessParams(wctx, &types.QueryLivenessParamsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, types.DefaultLivenessParams(), res.LivenessParams)
+
+		// set the value and ensure it's returned by the query
+		params := sample.LivenessParams()
+		k.SetLivenessParams(ctx, params)
+		res, err = k.LivenessParams(wctx, &types.QueryLivenessParamsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, params, res.LivenessParams)
+	})
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// ObserverSetChange returns the latest staged observer set change
+func (k Keeper) ObserverSetChange(
+	goCtx context.Context,
+	req *types.QueryObserverSetChangeRequest,
+) (*types.QueryObserverSetChangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	change, found := k.GetObserverSetChange(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "observer set change not found")
+	}
+
+	return &types.QueryObserverSetChangeResponse{ObserverSetChange: change}, nil
+}
@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestKeeper_ObserverSetChange(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		res, err := k.ObserverSetChange(ctx, nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should error if observer set change not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		res, err := k.ObserverSetChange(ctx, &types.QueryObserverSetChangeRequest{})
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return observer set change", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		change := sample.ObserverSetChange(t)
+		k.SetObserverSetChange(ctx, *change)
+
+		res, err := k.ObserverSetChange(ctx, &types.QueryObserverSetChangeRequest{})
+		require.NoError(t, err)
+		require.Equal(t, *change, res.ObserverSetChange)
+	})
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// ObserverSigningInfo returns the ballot signing info of an observer
+func (k Keeper) ObserverSigningInfo(
+	goCtx context.Context,
+	req *types.QueryObserverSigningInfoRequest,
+) (*types.QueryObserverSigningInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	info, found := k.GetObserverSigningInfo(ctx, req.ObserverAddress)
+	if !found {
+		return nil, status.Error(codes.NotFound, "signing info not found")
+	}
+
+	return &types.QueryObserverSigningInfoResponse{SigningInfo: info}, nil
+}
+
+// ObserverSigningInfos returns the ballot signing info of all observers
+func (k Keeper) ObserverSigningInfos(
+	goCtx context.Context,
+	req *types.QueryObserverSigningInfosRequest,
+) (*types.QueryObserverSigningInfosResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	infos, pageRes, err := k.GetAllObserverSigningInfoPaginated(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryObserverSigningInfosResponse{
+		SigningInfos: infos,
+		Pagination:   pageRes,
+	}, nil
+}
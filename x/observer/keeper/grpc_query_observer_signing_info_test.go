@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestKeeper_ObserverSigningInfo(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		res, err := k.ObserverSigningInfo(ctx, nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should error if signing info not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		res, err := k.ObserverSigningInfo(ctx, &types.QueryObserverSigningInfoRequest{
+			ObserverAddress: sample.AccAddress(),
+		})
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return signing info if found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		info := sample.ObserverSigningInfo()
+		k.SetObserverSigningInfo(ctx, info)
+
+		res, err := k.ObserverSigningInfo(ctx, &types.QueryObserverSigningInfoRequest{
+			ObserverAddress: info.ObserverAddress,
+		})
+		require.NoError(t, err)
+		require.Equal(t, &types.QueryObserverSigningInfoResponse{SigningInfo: info}, res)
+	})
+}
+
+func TestKeeper_ObserverSigningInfos(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		res, err := k.ObserverSigningInfos(ctx, nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return all signing infos", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		infos := []types.ObserverSigningInfo{
+			sample.ObserverSigningInfo(),
+			sample.ObserverSigningInfo(),
+			sample.ObserverSigningInfo(),
+		}
+		for _, info := range infos {
+			k.SetObserverSigningInfo(ctx, info)
+		}
+
+		res, err := k.ObserverSigningInfos(ctx, &types.QueryObserverSigningInfosRequest{
+			Pagination: &query.PageRequest{Limit: 10},
+		})
+		require.NoError(t, err)
+		require.ElementsMatch(t, infos, res.SigningInfos)
+	})
+
+	t.Run("should paginate signing infos", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		for i := 0; i < 3; i++ {
+			k.SetObserverSigningInfo(ctx, sample.ObserverSigningInfo())
+		}
+
+		res, err := k.ObserverSigningInfos(ctx, &types.QueryObserverSigningInfosRequest{
+			Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.SigningInfos, 2)
+		require.Equal(t, uint64(3), res.Pagination.Total)
+	})
+}
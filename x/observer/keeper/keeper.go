@@ -22,6 +22,7 @@ type (
 		lightclientKeeper types.LightclientKeeper
 		bankKeeper        types.BankKeeper
 		authKeeper        types.AccountKeeper
+		emissionsKeeper   types.EmissionsKeeper
 		authority         string
 	}
 )
@@ -80,6 +81,14 @@ func (k Keeper) GetLightclientKeeper() types.LightclientKeeper {
 	return k.lightclientKeeper
 }
 
+func (k Keeper) GetEmissionsKeeper() types.EmissionsKeeper {
+	return k.emissionsKeeper
+}
+
+func (k *Keeper) SetEmissionsKeeper(emissionsKeeper types.EmissionsKeeper) {
+	k.emissionsKeeper = emissionsKeeper
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -0,0 +1,211 @@
+package keeper
+
+import (
+	"slices"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func (k Keeper) SetLivenessParams(ctx sdk.Context, params types.LivenessParams) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&params)
+	store.Set(types.KeyPrefix(types.LivenessParamsKey), b)
+}
+
+// GetLivenessParams returns the liveness params, the default (disabled) params are returned if not set
+func (k Keeper) GetLivenessParams(ctx sdk.Context) types.LivenessParams {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.KeyPrefix(types.LivenessParamsKey))
+	if b == nil {
+		return types.DefaultLivenessParams()
+	}
+	var params types.LivenessParams
+	k.cdc.MustUnmarshal(b, &params)
+	return params
+}
+
+func (k Keeper) SetObserverSigningInfo(ctx sdk.Context, info types.ObserverSigningInfo) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverSigningInfoKey))
+	b := k.cdc.MustMarshal(&info)
+	store.Set([]byte(info.ObserverAddress), b)
+}
+
+func (k Keeper) GetObserverSigningInfo(
+	ctx sdk.Context,
+	observerAddress string,
+) (val types.ObserverSigningInfo, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverSigningInfoKey))
+	b := store.Get([]byte(observerAddress))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+func (k Keeper) GetAllObserverSigningInfo(ctx sdk.Context) (list []types.ObserverSigningInfo) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverSigningInfoKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ObserverSigningInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+func (k Keeper) GetAllObserverSigningInfoPaginated(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) (list []types.ObserverSigningInfo, pageRes *query.PageResponse, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverSigningInfoKey))
+	pageRes, err = query.Paginate(store, pagination, func(_ []byte, value []byte) error {
+		var info types.ObserverSigningInfo
+		if err := k.cdc.Unmarshal(value, &info); err != nil {
+			return err
+		}
+		list = append(list, info)
+		return nil
+	})
+	return
+}
+
+// IsObserverJailed returns true if the observer is jailed for missing ballots
+func (k Keeper) IsObserverJailed(ctx sdk.Context, observerAddress string) bool {
+	info, found := k.GetObserverSigningInfo(ctx, observerAddress)
+	return found && info.Jailed
+}
+
+// GetBallotVoters returns the observers voting on new ballots, jailed observers are excluded
+func (k Keeper) GetBallotVoters(ctx sdk.Context, observers []string) []string {
+	return slices.DeleteFunc(slices.Clone(observers), func(observer string) bool {
+		return k.IsObserverJailed(ctx, observer)
+	})
+}
+
+// HandleBallotsLiveness tracks the participation of the observers in the ballots
+// created BallotMaturityBlocks blocks ago, it is called at the beginning of each block.
+// Observers missing more than Window - MinVotedBallots ballots in the window are jailed.
+// Noop if disabled by the liveness params.
+func (k Keeper) HandleBallotsLiveness(ctx sdk.Context) {
+	params := k.GetLivenessParams(ctx)
+	if !params.Enabled {
+		return
+	}
+
+	list, found := k.GetMaturedBallots(ctx, params.BallotMaturityBlocks)
+	if !found {
+		return
+	}
+	observerSet, found := k.GetObserverSet(ctx)
+	if !found {
+		return
+	}
+
+	for _, ballotIndex := range list.BallotsIndexList {
+		ballot, found := k.GetBallot(ctx, ballotIndex)
+		if !found {
+			continue
+		}
+		for i, voter := range ballot.VoterList {
+			// only the observers are tracked, keygen ballots can include node accounts
+			if !slices.Contains(observerSet.ObserverList, voter) {
+				continue
+			}
+			missed := i >= len(ballot.Votes) || ballot.Votes[i] == types.VoteType_NotYetVoted
+			k.handleObserverBallotLiveness(ctx, params, voter, ballot.BallotIdentifier, missed, observerSet)
+		}
+	}
+}
+
+// handleObserverBallotLiveness records whether the observer missed the ballot and jails it if it missed too many
+func (k Keeper) handleObserverBallotLiveness(
+	ctx sdk.Context,
+	params types.LivenessParams,
+	observer string,
+	ballotIdentifier string,
+	missed bool,
+	observerSet types.ObserverSet,
+) {
+	info, found := k.GetObserverSigningInfo(ctx, observer)
+	if !found {
+		info = types.NewObserverSigningInfo(observer, ctx.BlockHeight(), params.Window)
+	}
+	// ballots are not tracked while the observer is jailed
+	if info.Jailed {
+		return
+	}
+
+	index := info.IndexOffset
+	previouslyMissed := info.GetMissedBallot(index, params.Window)
+	switch {
+	case !previouslyMissed && missed:
+		info.SetMissedBallot(index, params.Window, true)
+		info.MissedBallotsCounter++
+	case previouslyMissed && !missed:
+		info.SetMissedBallot(index, params.Window, false)
+		info.MissedBallotsCounter--
+	}
+	info.IndexOffset++
+
+	if missed {
+		EmitEventObserverLiveness(ctx, observer, ballotIdentifier, info.MissedBallotsCounter)
+	}
+
+	if info.IsLivenessFaulty(params) {
+		if k.canJailObserver(ctx, observerSet, observer) {
+			missedBallots := info.MissedBallotsCounter
+			info.Jailed = true
+			info.JailedUntil = ctx.BlockHeight() + params.JailDuration
+			info.ResetWindow(ctx.BlockHeight(), params.Window)
+			EmitEventObserverJailed(ctx, observer, missedBallots, info.JailedUntil)
+		} else {
+			ctx.Logger().Error("Unable to jail observer, too many observers are jailed",
+				"observer", observer,
+				"missed_ballots", info.MissedBallotsCounter,
+			)
+		}
+	}
+
+	k.SetObserverSigningInfo(ctx, info)
+}
+
+// canJailObserver returns true if the observer can be jailed without exceeding a third of the observer set,
+// nor a third of the observers eligible for any chain the observer is eligible for,
+// so the ballots of each chain keep enough unjailed voters to be finalized.
+func (k Keeper) canJailObserver(ctx sdk.Context, observerSet types.ObserverSet, observer string) bool {
+	if !k.canJailObserverAmong(ctx, observerSet.ObserverList) {
+		return false
+	}
+
+	cpl, found := k.GetChainParamsList(ctx)
+	if !found {
+		return true
+	}
+	for _, cp := range cpl.ChainParams {
+		if !cp.IsSupported || len(cp.Observers) == 0 || !cp.IsObserverEligible(observer) {
+			continue
+		}
+		if !k.canJailObserverAmong(ctx, cp.FilterEligibleObservers(observerSet.ObserverList)) {
+			return false
+		}
+	}
+	return true
+}
+
+// canJailObserverAmong returns true if one more of the observers can be jailed without exceeding a third of them
+func (k Keeper) canJailObserverAmong(ctx sdk.Context, observers []string) bool {
+	jailed := 0
+	for _, observer := range observers {
+		if k.IsObserverJailed(ctx, observer) {
+			jailed++
+		}
+	}
+	return (jailed+1)*types.MaxJailedObserversRatioDenominator <= len(observers)
+}
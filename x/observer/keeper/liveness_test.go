@@ -0,0 +1,239 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/keeper"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestKeeper_GetObserverSigningInfo(t *testing.T) {
+	k, ctx, _, _ := keepertest.ObserverKeeper(t)
+	info := sample.ObserverSigningInfo()
+
+	_, found := k.GetObserverSigningInfo(ctx, info.ObserverAddress)
+	require.False(t, found)
+	require.False(t, k.IsObserverJailed(ctx, info.ObserverAddress))
+
+	k.SetObserverSigningInfo(ctx, info)
+	got, found := k.GetObserverSigningInfo(ctx, info.ObserverAddress)
+	require.True(t, found)
+	require.Equal(t, info, got)
+	require.False(t, k.IsObserverJailed(ctx, info.ObserverAddress))
+
+	info.Jailed = true
+	k.SetObserverSigningInfo(ctx, info)
+	require.True(t, k.IsObserverJailed(ctx, info.ObserverAddress))
+	require.Len(t, k.GetAllObserverSigningInfo(ctx), 1)
+}
+
+func TestKeeper_GetLivenessParams(t *testing.T) {
+	k, ctx, _, _ := keepertest.ObserverKeeper(t)
+	require.Equal(t, types.DefaultLivenessParams(), k.GetLivenessParams(ctx))
+
+	params := sample.LivenessParams()
+	k.SetLivenessParams(ctx, params)
+	require.Equal(t, params, k.GetLivenessParams(ctx))
+}
+
+func TestKeeper_GetBallotVoters(t *testing.T) {
+	k, ctx, _, _ := keepertest.ObserverKeeper(t)
+	observers := sample.ObserverSet(3).ObserverList
+	jailed := types.NewObserverSigningInfo(observers[0], 0, 100)
+	jailed.Jailed = true
+	k.SetObserverSigningInfo(ctx, jailed)
+
+	voters := k.GetBallotVoters(ctx, observers)
+	require.Equal(t, observers[1:], voters)
+	require.Len(t, observers, 3)
+}
+
+// setLivenessBallot sets a ballot matured for the liveness tracking where only the first observer voted
+func setLivenessBallot(t *testing.T, k *keeper.Keeper, ctx sdk.Context, observers []string) types.Ballot {
+	ballot := types.Ballot{
+		BallotIdentifier:     sample.ZetaIndex(t),
+		VoterList:            observers,
+		Votes:                types.CreateVotes(len(observers)),
+		BallotCreationHeight: ctx.BlockHeight() - sample.LivenessParams().BallotMaturityBlocks,
+	}
+	ballot.Votes[0] = types.VoteType_SuccessObservation
+	k.SetBallot(ctx, &ballot)
+	k.AddBallotToList(ctx, ballot)
+	return ballot
+}
+
+// faultyObserverSigningInfo returns a signing info for which missing the next ballot makes the observer faulty
+func faultyObserverSigningInfo(observer string) types.ObserverSigningInfo {
+	params := sample.LivenessParams()
+	info := types.NewObserverSigningInfo(observer, 0, params.Window)
+	info.IndexOffset = params.Window - 1
+	info.MissedBallotsCounter = params.MaxMissedBallots()
+	return info
+}
+
+func TestKeeper_HandleBallotsLiveness(t *testing.T) {
+	t.Run("should do nothing if disabled", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		ctx = ctx.WithBlockHeight(1000)
+		observers := sample.ObserverSet(3).ObserverList
+		k.SetObserverSet(ctx, types.ObserverSet{ObserverList: observers})
+		setLivenessBallot(t, k, ctx, observers)
+
+		k.HandleBallotsLiveness(ctx)
+
+		require.Empty(t, k.GetAllObserverSigningInfo(ctx))
+	})
+
+	t.Run("should do nothing if no matured ballots", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		ctx = ctx.WithBlockHeight(1000)
+		k.SetLivenessParams(ctx, sample.LivenessParams())
+		k.SetObserverSet(ctx, sample.ObserverSet(3))
+
+		k.HandleBallotsLiveness(ctx)
+
+		require.Empty(t, k.GetAllObserverSigningInfo(ctx))
+	})
+
+	t.Run("should track voted and missed ballots", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		ctx = ctx.WithBlockHeight(1000)
+		k.SetLivenessParams(ctx, sample.LivenessParams())
+		observers := sample.ObserverSet(3).ObserverList
+		k.SetObserverSet(ctx, types.ObserverSet{ObserverList: observers})
+		setLivenessBallot(t, k, ctx, observers)
+		setLivenessBallot(t, k, ctx, observers)
+
+		k.HandleBallotsLiveness(ctx)
+
+		voted, found := k.GetObserverSigningInfo(ctx, observers[0])
+		require.True(t, found)
+		require.Equal(t, int64(1000), voted.StartHeight)
+		require.Equal(t, int64(2), voted.IndexOffset)
+		require.Equal(t, int64(0), voted.MissedBallotsCounter)
+
+		missed, found := k.GetObserverSigningInfo(ctx, observers[1])
+		require.True(t, found)
+		require.Equal(t, int64(2), missed.IndexOffset)
+		require.Equal(t, int64(2), missed.MissedBallotsCounter)
+		require.True(t, missed.GetMissedBallot(0, 100))
+		require.True(t, missed.GetMissedBallot(1, 100))
+		require.False(t, missed.Jailed)
+	})
+
+	t.Run("should not track voters not in the observer set", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		ctx = ctx.WithBlockHeight(1000)
+		k.SetLivenessParams(ctx, sample.LivenessParams())
+		observers := sample.ObserverSet(3).ObserverList
+		k.SetObserverSet(ctx, types.ObserverSet{ObserverList: observers[:2]})
+		setLivenessBallot(t, k, ctx, observers)
+
+		k.HandleBallotsLiveness(ctx)
+
+		_, found := k.GetObserverSigningInfo(ctx, observers[2])
+		require.False(t, found)
+	})
+
+	t.Run("should clear the ballot of the window voted on again", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		ctx = ctx.WithBlockHeight(1000)
+		k.SetLivenessParams(ctx, sample.LivenessParams())
+		observers := sample.ObserverSet(3).ObserverList
+		k.SetObserverSet(ctx, types.ObserverSet{ObserverList: observers})
+		info := types.NewObserverSigningInfo(observers[0], 0, 100)
+		info.IndexOffset = 100
+		info.MissedBallotsCounter = 1
+		info.SetMissedBallot(0, 100, true)
+		k.SetObserverSigningInfo(ctx, info)
+		setLivenessBallot(t, k, ctx, observers)
+
+		k.HandleBallotsLiveness(ctx)
+
+		got, found := k.GetObserverSigningInfo(ctx, observers[0])
+		require.True(t, found)
+		require.Equal(t, int64(101), got.IndexOffset)
+		require.Equal(t, int64(0), got.MissedBallotsCounter)
+		require.False(t, got.GetMissedBallot(0, 100))
+	})
+
+	t.Run("should jail observer missing too many ballots", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		ctx = ctx.WithBlockHeight(1000)
+		k.SetLivenessParams(ctx, sample.LivenessParams())
+		observers := sample.ObserverSet(3).ObserverList
+		k.SetObserverSet(ctx, types.ObserverSet{ObserverList: observers})
+		k.SetObserverSigningInfo(ctx, faultyObserverSigningInfo(observers[1]))
+		setLivenessBallot(t, k, ctx, observers)
+
+		k.HandleBallotsLiveness(ctx)
+
+		got, found := k.GetObserverSigningInfo(ctx, observers[1])
+		require.True(t, found)
+		require.True(t, got.Jailed)
+		require.Equal(t, int64(1000+600), got.JailedUntil)
+		require.Equal(t, int64(0), got.IndexOffset)
+		require.Equal(t, int64(0), got.MissedBallotsCounter)
+		require.True(t, k.IsObserverJailed(ctx, observers[1]))
+	})
+
+	t.Run("should not jail more than a third of the observers", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		ctx = ctx.WithBlockHeight(1000)
+		k.SetLivenessParams(ctx, sample.LivenessParams())
+		observers := sample.ObserverSet(3).ObserverList
+		k.SetObserverSet(ctx, types.ObserverSet{ObserverList: observers})
+		k.SetObserverSigningInfo(ctx, faultyObserverSigningInfo(observers[1]))
+		k.SetObserverSigningInfo(ctx, faultyObserverSigningInfo(observers[2]))
+		setLivenessBallot(t, k, ctx, observers)
+
+		k.HandleBallotsLiveness(ctx)
+
+		require.True(t, k.IsObserverJailed(ctx, observers[1]))
+		require.False(t, k.IsObserverJailed(ctx, observers[2]))
+	})
+
+	t.Run("should not jail more than a third of the observers eligible for a chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		ctx = ctx.WithBlockHeight(1000)
+		k.SetLivenessParams(ctx, sample.LivenessParams())
+		observers := sample.ObserverSet(6).ObserverList
+		k.SetObserverSet(ctx, types.ObserverSet{ObserverList: observers})
+		chainParams := sample.ChainParams(chains.Ethereum.ChainId)
+		chainParams.IsSupported = true
+		chainParams.Observers = observers[:3]
+		k.SetChainParamsList(ctx, types.ChainParamsList{ChainParams: []*types.ChainParams{chainParams}})
+		k.SetObserverSigningInfo(ctx, faultyObserverSigningInfo(observers[1]))
+		k.SetObserverSigningInfo(ctx, faultyObserverSigningInfo(observers[2]))
+		setLivenessBallot(t, k, ctx, observers)
+
+		k.HandleBallotsLiveness(ctx)
+
+		require.True(t, k.IsObserverJailed(ctx, observers[1]))
+		require.False(t, k.IsObserverJailed(ctx, observers[2]))
+	})
+
+	t.Run("should not track ballots of jailed observers", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		ctx = ctx.WithBlockHeight(1000)
+		k.SetLivenessParams(ctx, sample.LivenessParams())
+		observers := sample.ObserverSet(3).ObserverList
+		k.SetObserverSet(ctx, types.ObserverSet{ObserverList: observers})
+		jailed := types.NewObserverSigningInfo(observers[1], 0, 100)
+		jailed.Jailed = true
+		k.SetObserverSigningInfo(ctx, jailed)
+		setLivenessBallot(t, k, ctx, observers)
+
+		k.HandleBallotsLiveness(ctx)
+
+		got, found := k.GetObserverSigningInfo(ctx, observers[1])
+		require.True(t, found)
+		require.Equal(t, jailed, got)
+	})
+}
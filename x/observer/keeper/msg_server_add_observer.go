@@ -25,6 +25,10 @@ func (k msgServer) AddObserver(
 	if err != nil {
 		return nil, cosmoserrors.Wrap(authoritytypes.ErrUnauthorized, err.Error())
 	}
+
+	if k.IsObserverSetChangeInProgress(ctx) {
+		return nil, types.ErrObserverSetChangeInProgress
+	}
 	pubkey, err := crypto.NewPubKey(msg.ZetaclientGranteePubkey)
 	if err != nil {
 		return &types.MsgAddObserverResponse{}, cosmoserrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
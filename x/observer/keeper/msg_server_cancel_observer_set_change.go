@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// CancelObserverSetChange rolls back the observer set change in progress: the node accounts added for the change
+// and the TSS generated for it are removed and the previous keygen is restored, so the observer set can be updated again.
+//
+// The change can't be cancelled once the TSS generated for the new observer set is the current TSS,
+// since the funds are migrated to it, the change is then activated at the activation height.
+//
+// Authorized: admin policy.
+func (k msgServer) CancelObserverSetChange(
+	goCtx context.Context,
+	msg *types.MsgCancelObserverSetChange,
+) (*types.MsgCancelObserverSetChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check permission
+	err := k.GetAuthorityKeeper().CheckAuthorization(ctx, msg)
+	if err != nil {
+		return nil, cosmoserrors.Wrap(authoritytypes.ErrUnauthorized, err.Error())
+	}
+
+	change, found := k.GetObserverSetChange(ctx)
+	if !found || !change.IsInProgress() {
+		return nil, types.ErrNoObserverSetChangeInProgress
+	}
+
+	if err := k.rollbackObserverSetChange(ctx, change, "cancelled"); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelObserverSetChangeResponse{}, nil
+}
@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/observer/keeper"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestMsgServer_CancelObserverSetChange(t *testing.T) {
+	t.Run("should error if not authorized", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		msg := types.MsgCancelObserverSetChange{Creator: sample.AccAddress()}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, authoritytypes.ErrUnauthorized)
+
+		// ACT
+		res, err := srv.CancelObserverSetChange(ctx, &msg)
+
+		// ASSERT
+		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
+		require.Nil(t, res)
+	})
+
+	t.Run("should error if no change is in progress", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		setObserverSetChange(t, k, ctx, types.ObserverSetChangeStatus_Activated)
+
+		msg := types.MsgCancelObserverSetChange{Creator: sample.AccAddress()}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, nil)
+
+		// ACT
+		_, err := srv.CancelObserverSetChange(ctx, &msg)
+
+		// ASSERT
+		require.ErrorIs(t, err, types.ErrNoObserverSetChangeInProgress)
+	})
+
+	t.Run("should error if the new TSS is already the current TSS", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		change := setObserverSetChange(t, k, ctx, types.ObserverSetChangeStatus_AwaitingActivation)
+		tss := sample.Tss()
+		change.TssPubkey = tss.TssPubkey
+		k.SetObserverSetChange(ctx, change)
+		k.SetTSS(ctx, tss)
+
+		msg := types.MsgCancelObserverSetChange{Creator: sample.AccAddress()}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, nil)
+
+		// ACT
+		_, err := srv.CancelObserverSetChange(ctx, &msg)
+
+		// ASSERT
+		require.ErrorIs(t, err, types.ErrInvalidObserverSetChange)
+		got, found := k.GetObserverSetChange(ctx)
+		require.True(t, found)
+		require.Equal(t, types.ObserverSetChangeStatus_AwaitingActivation, got.Status)
+	})
+
+	t.Run("should roll back a change awaiting activation", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		change := setObserverSetChange(t, k, ctx, types.ObserverSetChangeStatus_AwaitingActivation)
+		change.TssPubkey = sample.Tss().TssPubkey
+		k.SetObserverSetChange(ctx, change)
+		k.SetTSS(ctx, sample.Tss())
+
+		msg := types.MsgCancelObserverSetChange{Creator: sample.AccAddress()}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, nil)
+
+		// ACT
+		_, err := srv.CancelObserverSetChange(ctx, &msg)
+
+		// ASSERT
+		require.NoError(t, err)
+		requireRolledBack(t, k, ctx, change)
+		require.False(t, k.IsObserverSetChangeInProgress(ctx))
+	})
+
+	t.Run("should roll back a change with a pending keygen", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		change := setObserverSetChange(t, k, ctx, types.ObserverSetChangeStatus_KeygenPending)
+
+		msg := types.MsgCancelObserverSetChange{Creator: sample.AccAddress()}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, nil)
+
+		// ACT
+		_, err := srv.CancelObserverSetChange(ctx, &msg)
+
+		// ASSERT
+		require.NoError(t, err)
+		requireRolledBack(t, k, ctx, change)
+	})
+}
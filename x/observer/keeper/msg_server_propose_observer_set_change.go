@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/zeta-chain/node/pkg/crypto"
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// ProposeObserverSetChange stages a change of the observer set to be applied at the activation height.
+//
+// The node accounts of the added observers are created and a keygen for the new observer set is scheduled
+// ObserverSetChangeKeygenDelay blocks after the proposal. The observer set is only updated at the activation
+// height once the keygen succeeded and the new TSS is the current TSS, the change is rolled back if the keygen fails.
+//
+// The fund migration to the new TSS is manual (MsgMigrateTssFunds and MsgUpdateTssAddress), until then the change
+// awaits activation and the observer set can't be updated; MsgCancelObserverSetChange rolls the change back.
+//
+// Authorized: admin policy.
+func (k msgServer) ProposeObserverSetChange(
+	goCtx context.Context,
+	msg *types.MsgProposeObserverSetChange,
+) (*types.MsgProposeObserverSetChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check permission
+	err := k.GetAuthorityKeeper().CheckAuthorization(ctx, msg)
+	if err != nil {
+		return nil, cosmoserrors.Wrap(authoritytypes.ErrUnauthorized, err.Error())
+	}
+
+	if k.IsObserverSetChangeInProgress(ctx) {
+		return nil, types.ErrObserverSetChangeInProgress
+	}
+
+	keygenHeight := ctx.BlockHeight() + types.ObserverSetChangeKeygenDelay
+	if msg.ActivationHeight <= keygenHeight {
+		return nil, cosmoserrors.Wrapf(
+			types.ErrInvalidObserverSetChange,
+			"activation height %d must be greater than keygen height %d",
+			msg.ActivationHeight,
+			keygenHeight,
+		)
+	}
+
+	observerSet, found := k.GetObserverSet(ctx)
+	if !found {
+		return nil, types.ErrObserverSetNotFound
+	}
+
+	for _, removal := range msg.Removals {
+		if !slices.Contains(observerSet.ObserverList, removal) {
+			return nil, cosmoserrors.Wrapf(types.ErrInvalidObserverSetChange, "observer %s not in observer set", removal)
+		}
+	}
+	for _, addition := range msg.Additions {
+		if slices.Contains(observerSet.ObserverList, addition.ObserverAddress) {
+			return nil, cosmoserrors.Wrapf(
+				types.ErrInvalidObserverSetChange,
+				"observer %s already in observer set",
+				addition.ObserverAddress,
+			)
+		}
+		if _, found := k.GetNodeAccount(ctx, addition.ObserverAddress); found {
+			return nil, cosmoserrors.Wrapf(
+				types.ErrInvalidObserverSetChange,
+				"node account already exists for observer %s",
+				addition.ObserverAddress,
+			)
+		}
+	}
+	if len(observerSet.ObserverList)-len(msg.Removals)+len(msg.Additions) == 0 {
+		return nil, cosmoserrors.Wrap(types.ErrInvalidObserverSetChange, "observer set can't be empty")
+	}
+
+	previousKeygen, found := k.GetKeygen(ctx)
+	if !found {
+		return nil, types.ErrKeygenNotFound
+	}
+
+	// add the node accounts of the new observers so they take part in the keygen
+	for _, addition := range msg.Additions {
+		pubkey, err := crypto.NewPubKey(addition.ZetaclientGranteePubkey)
+		if err != nil {
+			return nil, cosmoserrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+		}
+		granteeAddress, err := crypto.GetAddressFromPubkeyString(addition.ZetaclientGranteePubkey)
+		if err != nil {
+			return nil, cosmoserrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+		}
+		pubkeySet := crypto.PubKeySet{Secp256k1: pubkey, Ed25519: ""}
+		k.SetNodeAccount(ctx, types.NodeAccount{
+			Operator:       addition.ObserverAddress,
+			GranteeAddress: granteeAddress.String(),
+			GranteePubkey:  &pubkeySet,
+			NodeStatus:     types.NodeStatus_Active,
+		})
+	}
+
+	change := types.ObserverSetChange{
+		Additions:        msg.Additions,
+		Removals:         msg.Removals,
+		ProposalHeight:   ctx.BlockHeight(),
+		KeygenHeight:     keygenHeight,
+		ActivationHeight: msg.ActivationHeight,
+		Status:           types.ObserverSetChangeStatus_KeygenPending,
+		PreviousKeygen:   previousKeygen,
+	}
+
+	// schedule the keygen for the new observer set
+	var granteePubKeys []string
+	for _, nodeAccount := range k.GetAllNodeAccount(ctx) {
+		if change.IsRemoved(nodeAccount.Operator) {
+			continue
+		}
+		granteePubKeys = append(granteePubKeys, nodeAccount.GranteePubkey.Secp256k1.String())
+	}
+	keygen := types.Keygen{
+		Status:         types.KeygenStatus_PendingKeygen,
+		GranteePubkeys: granteePubKeys,
+		BlockNumber:    keygenHeight,
+		Eddsa:          msg.Eddsa,
+	}
+	k.SetKeygen(ctx, keygen)
+	EmitEventKeyGenBlockUpdated(ctx, &keygen)
+
+	k.SetObserverSetChange(ctx, change)
+	EmitEventObserverSetChangeUpdated(ctx, change)
+
+	return &types.MsgProposeObserverSetChangeResponse{}, nil
+}
@@ -0,0 +1,237 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/observer/keeper"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestMsgServer_ProposeObserverSetChange(t *testing.T) {
+	t.Run("should error if not authorized", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		msg := types.MsgProposeObserverSetChange{
+			Creator:          sample.AccAddress(),
+			Removals:         []string{sample.AccAddress()},
+			ActivationHeight: 1000,
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, authoritytypes.ErrUnauthorized)
+
+		// ACT
+		res, err := srv.ProposeObserverSetChange(ctx, &msg)
+
+		// ASSERT
+		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
+		require.Nil(t, res)
+	})
+
+	t.Run("should error if a change is in progress", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		k.SetObserverSetChange(ctx, types.ObserverSetChange{Status: types.ObserverSetChangeStatus_KeygenPending})
+
+		msg := types.MsgProposeObserverSetChange{
+			Creator:          sample.AccAddress(),
+			Removals:         []string{sample.AccAddress()},
+			ActivationHeight: 1000,
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, nil)
+
+		// ACT
+		_, err := srv.ProposeObserverSetChange(ctx, &msg)
+
+		// ASSERT
+		require.ErrorIs(t, err, types.ErrObserverSetChangeInProgress)
+	})
+
+	t.Run("should error if activation height is not after keygen height", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		srv := keeper.NewMsgServerImpl(*k)
+		ctx = ctx.WithBlockHeight(10)
+
+		observer := sample.AccAddress()
+		k.SetObserverSet(ctx, types.ObserverSet{ObserverList: []string{observer, sample.AccAddress()}})
+		k.SetKeygen(ctx, types.Keygen{})
+
+		msg := types.MsgProposeObserverSetChange{
+			Creator:          sample.AccAddress(),
+			Removals:         []string{observer},
+			ActivationHeight: 10 + types.ObserverSetChangeKeygenDelay,
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, nil)
+
+		// ACT
+		_, err := srv.ProposeObserverSetChange(ctx, &msg)
+
+		// ASSERT
+		require.ErrorIs(t, err, types.ErrInvalidObserverSetChange)
+	})
+
+	t.Run("should error if removed observer is not in the observer set", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		k.SetObserverSet(ctx, sample.ObserverSet(3))
+		k.SetKeygen(ctx, types.Keygen{})
+
+		msg := types.MsgProposeObserverSetChange{
+			Creator:          sample.AccAddress(),
+			Removals:         []string{sample.AccAddress()},
+			ActivationHeight: 1000,
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, nil)
+
+		// ACT
+		_, err := srv.ProposeObserverSetChange(ctx, &msg)
+
+		// ASSERT
+		require.ErrorIs(t, err, types.ErrInvalidObserverSetChange)
+	})
+
+	t.Run("should error if added observer already has a node account", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		nodeAccount := sample.NodeAccount()
+		k.SetObserverSet(ctx, sample.ObserverSet(3))
+		k.SetNodeAccount(ctx, *nodeAccount)
+		k.SetKeygen(ctx, types.Keygen{})
+
+		msg := types.MsgProposeObserverSetChange{
+			Creator: sample.AccAddress(),
+			Additions: []types.ObserverSetAddition{
+				{ObserverAddress: nodeAccount.Operator, ZetaclientGranteePubkey: sample.PubKeyString()},
+			},
+			ActivationHeight: 1000,
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, nil)
+
+		// ACT
+		_, err := srv.ProposeObserverSetChange(ctx, &msg)
+
+		// ASSERT
+		require.ErrorIs(t, err, types.ErrInvalidObserverSetChange)
+	})
+
+	t.Run("should error if the resulting observer set is empty", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		observer := sample.AccAddress()
+		k.SetObserverSet(ctx, types.ObserverSet{ObserverList: []string{observer}})
+		k.SetKeygen(ctx, types.Keygen{})
+
+		msg := types.MsgProposeObserverSetChange{
+			Creator:          sample.AccAddress(),
+			Removals:         []string{observer},
+			ActivationHeight: 1000,
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, nil)
+
+		// ACT
+		_, err := srv.ProposeObserverSetChange(ctx, &msg)
+
+		// ASSERT
+		require.ErrorIs(t, err, types.ErrInvalidObserverSetChange)
+	})
+
+	t.Run("should stage the change and schedule the keygen", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		srv := keeper.NewMsgServerImpl(*k)
+		ctx = ctx.WithBlockHeight(10)
+
+		kept := sample.NodeAccount()
+		removed := sample.NodeAccount()
+		k.SetObserverSet(ctx, types.ObserverSet{ObserverList: []string{kept.Operator, removed.Operator}})
+		k.SetNodeAccount(ctx, *kept)
+		k.SetNodeAccount(ctx, *removed)
+		previousKeygen := types.Keygen{Status: types.KeygenStatus_KeyGenSuccess, BlockNumber: 5}
+		k.SetKeygen(ctx, previousKeygen)
+
+		addition := types.ObserverSetAddition{
+			ObserverAddress:         sample.AccAddress(),
+			ZetaclientGranteePubkey: sample.PubKeyString(),
+		}
+		msg := types.MsgProposeObserverSetChange{
+			Creator:          sample.AccAddress(),
+			Additions:        []types.ObserverSetAddition{addition},
+			Removals:         []string{removed.Operator},
+			ActivationHeight: 1000,
+			Eddsa:            true,
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, nil)
+
+		// ACT
+		res, err := srv.ProposeObserverSetChange(ctx, &msg)
+
+		// ASSERT
+		require.NoError(t, err)
+		require.Equal(t, &types.MsgProposeObserverSetChangeResponse{}, res)
+
+		keygenHeight := 10 + types.ObserverSetChangeKeygenDelay
+		change, found := k.GetObserverSetChange(ctx)
+		require.True(t, found)
+		require.Equal(t, types.ObserverSetChange{
+			Additions:        []types.ObserverSetAddition{addition},
+			Removals:         []string{removed.Operator},
+			ProposalHeight:   10,
+			KeygenHeight:     keygenHeight,
+			ActivationHeight: 1000,
+			Status:           types.ObserverSetChangeStatus_KeygenPending,
+			PreviousKeygen:   previousKeygen,
+		}, change)
+
+		// the new observer takes part in the keygen but is not yet an observer
+		nodeAccount, found := k.GetNodeAccount(ctx, addition.ObserverAddress)
+		require.True(t, found)
+		require.Equal(t, addition.ZetaclientGranteePubkey, nodeAccount.GranteePubkey.Secp256k1.String())
+		require.False(t, k.IsAddressPartOfObserverSet(ctx, addition.ObserverAddress))
+		require.True(t, k.IsAddressPartOfObserverSet(ctx, removed.Operator))
+
+		keygen, found := k.GetKeygen(ctx)
+		require.True(t, found)
+		require.Equal(t, types.KeygenStatus_PendingKeygen, keygen.Status)
+		require.Equal(t, keygenHeight, keygen.BlockNumber)
+		require.True(t, keygen.Eddsa)
+		require.ElementsMatch(t, []string{
+			kept.GranteePubkey.Secp256k1.String(),
+			addition.ZetaclientGranteePubkey,
+		}, keygen.GranteePubkeys)
+	})
+}
@@ -23,6 +23,10 @@ func (k msgServer) RemoveObserver(
 		return nil, cosmoserrors.Wrap(authoritytypes.ErrUnauthorized, err.Error())
 	}
 
+	if k.IsObserverSetChangeInProgress(ctx) {
+		return nil, types.ErrObserverSetChangeInProgress
+	}
+
 	// We remove it from both the node account list and the observer set to effectively remove it from observing and signing
 	k.RemoveNodeAccount(ctx, msg.ObserverAddress)
 	newCount := k.RemoveObserverFromSet(ctx, msg.ObserverAddress)
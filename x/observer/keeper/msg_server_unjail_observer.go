@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// UnjailObserver unjails an observer jailed for missing ballots once the jail period is over.
+// The observer is added back to the voters of new ballots and a new liveness window is started.
+//
+// Authorized: the jailed observer.
+func (k msgServer) UnjailObserver(
+	goCtx context.Context,
+	msg *types.MsgUnjailObserver,
+) (*types.MsgUnjailObserverResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.IsAddressPartOfObserverSet(ctx, msg.Creator) {
+		return nil, cosmoserrors.Wrapf(types.ErrNotObserver, "observer %s", msg.Creator)
+	}
+
+	info, found := k.GetObserverSigningInfo(ctx, msg.Creator)
+	if !found {
+		return nil, cosmoserrors.Wrapf(types.ErrObserverSigningInfoNotFound, "observer %s", msg.Creator)
+	}
+	if !info.Jailed {
+		return nil, cosmoserrors.Wrapf(types.ErrObserverNotJailed, "observer %s", msg.Creator)
+	}
+	if ctx.BlockHeight() < info.JailedUntil {
+		return nil, cosmoserrors.Wrapf(
+			types.ErrObserverJailPeriodNotOver,
+			"observer %s jailed until height %d",
+			msg.Creator,
+			info.JailedUntil,
+		)
+	}
+
+	info.Jailed = false
+	info.ResetWindow(ctx.BlockHeight(), k.GetLivenessParams(ctx).Window)
+	k.SetObserverSigningInfo(ctx, info)
+	EmitEventObserverUnjailed(ctx, msg.Creator)
+
+	return &types.MsgUnjailObserverResponse{}, nil
+}
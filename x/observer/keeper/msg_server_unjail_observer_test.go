@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/keeper"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestMsgServer_UnjailObserver(t *testing.T) {
+	t.Run("should error if not an observer", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		k.SetObserverSet(ctx, sample.ObserverSet(3))
+
+		// ACT
+		_, err := srv.UnjailObserver(ctx, types.NewMsgUnjailObserver(sample.AccAddress()))
+
+		// ASSERT
+		require.ErrorIs(t, err, types.ErrNotObserver)
+	})
+
+	t.Run("should error if signing info not found", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		observerSet := sample.ObserverSet(3)
+		k.SetObserverSet(ctx, observerSet)
+
+		// ACT
+		_, err := srv.UnjailObserver(ctx, types.NewMsgUnjailObserver(observerSet.ObserverList[0]))
+
+		// ASSERT
+		require.ErrorIs(t, err, types.ErrObserverSigningInfoNotFound)
+	})
+
+	t.Run("should error if observer is not jailed", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		observerSet := sample.ObserverSet(3)
+		k.SetObserverSet(ctx, observerSet)
+		k.SetObserverSigningInfo(ctx, types.NewObserverSigningInfo(observerSet.ObserverList[0], 0, 100))
+
+		// ACT
+		_, err := srv.UnjailObserver(ctx, types.NewMsgUnjailObserver(observerSet.ObserverList[0]))
+
+		// ASSERT
+		require.ErrorIs(t, err, types.ErrObserverNotJailed)
+	})
+
+	t.Run("should error if jail period is not over", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		ctx = ctx.WithBlockHeight(100)
+		observerSet := sample.ObserverSet(3)
+		k.SetObserverSet(ctx, observerSet)
+		info := types.NewObserverSigningInfo(observerSet.ObserverList[0], 0, 100)
+		info.Jailed = true
+		info.JailedUntil = 101
+		k.SetObserverSigningInfo(ctx, info)
+
+		// ACT
+		_, err := srv.UnjailObserver(ctx, types.NewMsgUnjailObserver(observerSet.ObserverList[0]))
+
+		// ASSERT
+		require.ErrorIs(t, err, types.ErrObserverJailPeriodNotOver)
+		require.True(t, k.IsObserverJailed(ctx, observerSet.ObserverList[0]))
+	})
+
+	t.Run("should unjail the observer and start a new window", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		ctx = ctx.WithBlockHeight(100)
+		observerSet := sample.ObserverSet(3)
+		k.SetObserverSet(ctx, observerSet)
+		info := sample.ObserverSigningInfo()
+		info.ObserverAddress = observerSet.ObserverList[0]
+		info.Jailed = true
+		info.JailedUntil = 100
+		k.SetObserverSigningInfo(ctx, info)
+
+		// ACT
+		res, err := srv.UnjailObserver(ctx, types.NewMsgUnjailObserver(observerSet.ObserverList[0]))
+
+		// ASSERT
+		require.NoError(t, err)
+		require.Equal(t, &types.MsgUnjailObserverResponse{}, res)
+
+		got, found := k.GetObserverSigningInfo(ctx, observerSet.ObserverList[0])
+		require.True(t, found)
+		require.False(t, got.Jailed)
+		require.Equal(t, int64(100), got.StartHeight)
+		require.Equal(t, int64(0), got.IndexOffset)
+		require.Equal(t, int64(0), got.MissedBallotsCounter)
+		require.False(t, k.IsObserverJailed(ctx, observerSet.ObserverList[0]))
+	})
+}
@@ -26,6 +26,13 @@ func (k msgServer) UpdateChainParams(
 		return nil, errors.Wrap(authoritytypes.ErrUnauthorized, err.Error())
 	}
 
+	// the observers eligible for the chain must be part of the observer set
+	for _, observer := range msg.ChainParams.Observers {
+		if !k.IsAddressPartOfObserverSet(ctx, observer) {
+			return nil, errors.Wrapf(types.ErrNotObserver, "observer %s is not in the observer set", observer)
+		}
+	}
+
 	// find current chain params list or initialize a new one
 	chainParamsList, found := k.GetChainParamsList(ctx)
 	if !found {
@@ -122,4 +122,55 @@ func TestMsgServer_UpdateChainParams(t *testing.T) {
 
 		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
 	})
+
+	t.Run("can update chain params with observers of the observer set", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+
+		observerSet := sample.ObserverSet(3)
+		k.SetObserverSet(ctx, observerSet)
+		chainParams := sample.ChainParams(chains.ExternalChainList([]chains.Chain{})[0].ChainId)
+		chainParams.Observers = observerSet.ObserverList[:2]
+
+		msg := types.MsgUpdateChainParams{
+			Creator:     admin,
+			ChainParams: chainParams,
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, nil)
+		_, err := srv.UpdateChainParams(sdk.WrapSDKContext(ctx), &msg)
+		require.NoError(t, err)
+
+		chainParamsList, found := k.GetChainParamsList(ctx)
+		require.True(t, found)
+		require.Len(t, chainParamsList.ChainParams, 1)
+		require.Equal(t, chainParams, chainParamsList.ChainParams[0])
+	})
+
+	t.Run("cannot update chain params with observers not in the observer set", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+
+		k.SetObserverSet(ctx, sample.ObserverSet(3))
+		chainParams := sample.ChainParams(chains.ExternalChainList([]chains.Chain{})[0].ChainId)
+		chainParams.Observers = []string{sample.AccAddress()}
+
+		msg := types.MsgUpdateChainParams{
+			Creator:     admin,
+			ChainParams: chainParams,
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, nil)
+		_, err := srv.UpdateChainParams(sdk.WrapSDKContext(ctx), &msg)
+		require.ErrorIs(t, err, types.ErrNotObserver)
+
+		_, found := k.GetChainParamsList(ctx)
+		require.False(t, found)
+	})
 }
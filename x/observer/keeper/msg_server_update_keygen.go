@@ -11,7 +11,8 @@ import (
 )
 
 // UpdateKeygen updates the block height of the keygen and sets the status to
-// "pending keygen".
+// "pending keygen". If eddsa is set, an ed25519 key is generated alongside the
+// secp256k1 key.
 //
 // Authorized: admin policy group 1.
 func (k msgServer) UpdateKeygen(
@@ -26,6 +27,10 @@ func (k msgServer) UpdateKeygen(
 		return nil, errors.Wrap(authoritytypes.ErrUnauthorized, err.Error())
 	}
 
+	if k.IsObserverSetChangeInProgress(ctx) {
+		return nil, types.ErrObserverSetChangeInProgress
+	}
+
 	keygen, found := k.GetKeygen(ctx)
 	if !found {
 		return nil, types.ErrKeygenNotFound
@@ -43,6 +48,7 @@ func (k msgServer) UpdateKeygen(
 	// update keygen
 	keygen.GranteePubkeys = granteePubKeys
 	keygen.BlockNumber = msg.Block
+	keygen.Eddsa = msg.Eddsa
 	keygen.Status = types.KeygenStatus_PendingKeygen
 	k.SetKeygen(ctx, keygen)
 
@@ -51,6 +51,28 @@ func TestMsgServer_UpdateKeygen(t *testing.T) {
 		require.Nil(t, res)
 	})
 
+	t.Run("should error if an observer set change is in progress", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		admin := sample.AccAddress()
+
+		wctx := sdk.WrapSDKContext(ctx)
+		srv := keeper.NewMsgServerImpl(*k)
+		k.SetKeygen(ctx, types.Keygen{})
+		k.SetObserverSetChange(ctx, types.ObserverSetChange{Status: types.ObserverSetChangeStatus_KeygenPending})
+
+		msg := types.MsgUpdateKeygen{
+			Creator: admin,
+			Block:   ctx.BlockHeight() + 100,
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, nil)
+		res, err := srv.UpdateKeygen(wctx, &msg)
+		require.ErrorIs(t, err, types.ErrObserverSetChangeInProgress)
+		require.Nil(t, res)
+	})
+
 	t.Run("should error if msg block too low", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
 			UseAuthorityMock: true,
@@ -98,6 +120,7 @@ func TestMsgServer_UpdateKeygen(t *testing.T) {
 		msg := types.MsgUpdateKeygen{
 			Creator: admin,
 			Block:   ctx.BlockHeight() + 30,
+			Eddsa:   true,
 		}
 		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, nil)
 		res, err := srv.UpdateKeygen(wctx, &msg)
@@ -110,5 +133,6 @@ func TestMsgServer_UpdateKeygen(t *testing.T) {
 		require.Equal(t, granteePubKey.Secp256k1.String(), keygen.GranteePubkeys[0])
 		require.Equal(t, ctx.BlockHeight()+30, keygen.BlockNumber)
 		require.Equal(t, types.KeygenStatus_PendingKeygen, keygen.Status)
+		require.True(t, keygen.Eddsa)
 	})
 }
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// UpdateLivenessParams updates the parameters of the tracking of the participation of the observers in the ballots.
+// The ballots must be tracked before being deleted by the emissions module, so the ballot maturity can't be higher
+// than the emissions ballot maturity. If the window changes, the windows of the observers are restarted.
+//
+// Authorized: admin policy.
+func (k msgServer) UpdateLivenessParams(
+	goCtx context.Context,
+	msg *types.MsgUpdateLivenessParams,
+) (*types.MsgUpdateLivenessParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check permission
+	err := k.GetAuthorityKeeper().CheckAuthorization(ctx, msg)
+	if err != nil {
+		return nil, cosmoserrors.Wrap(authoritytypes.ErrUnauthorized, err.Error())
+	}
+
+	params := msg.LivenessParams
+	emissionsMaturity := k.GetEmissionsKeeper().GetBallotMaturityBlocks(ctx)
+	if params.Enabled && params.BallotMaturityBlocks > emissionsMaturity {
+		return nil, cosmoserrors.Wrapf(
+			types.ErrInvalidLivenessParams,
+			"ballot maturity blocks %d is higher than the emissions ballot maturity blocks %d",
+			params.BallotMaturityBlocks,
+			emissionsMaturity,
+		)
+	}
+
+	if params.Window != k.GetLivenessParams(ctx).Window {
+		for _, info := range k.GetAllObserverSigningInfo(ctx) {
+			info.ResetWindow(ctx.BlockHeight(), params.Window)
+			k.SetObserverSigningInfo(ctx, info)
+		}
+	}
+
+	k.SetLivenessParams(ctx, params)
+
+	return &types.MsgUpdateLivenessParamsResponse{}, nil
+}
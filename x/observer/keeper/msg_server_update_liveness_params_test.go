@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/observer/keeper"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// emissionsKeeperStub returns a fixed ballot maturity
+type emissionsKeeperStub struct {
+	ballotMaturityBlocks int64
+}
+
+func (e emissionsKeeperStub) GetBallotMaturityBlocks(sdk.Context) int64 {
+	return e.ballotMaturityBlocks
+}
+
+func TestMsgServer_UpdateLivenessParams(t *testing.T) {
+	t.Run("can update liveness params", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		k.SetEmissionsKeeper(emissionsKeeperStub{ballotMaturityBlocks: 100})
+		srv := keeper.NewMsgServerImpl(*k)
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+
+		msg := types.NewMsgUpdateLivenessParams(sample.AccAddress(), sample.LivenessParams())
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+
+		// ACT
+		_, err := srv.UpdateLivenessParams(sdk.WrapSDKContext(ctx), msg)
+
+		// ASSERT
+		require.NoError(t, err)
+		require.Equal(t, sample.LivenessParams(), k.GetLivenessParams(ctx))
+	})
+
+	t.Run("should restart the windows of the observers if the window changes", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		ctx = ctx.WithBlockHeight(1000)
+		k.SetEmissionsKeeper(emissionsKeeperStub{ballotMaturityBlocks: 100})
+		srv := keeper.NewMsgServerImpl(*k)
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+
+		k.SetLivenessParams(ctx, sample.LivenessParams())
+		info := sample.ObserverSigningInfo()
+		k.SetObserverSigningInfo(ctx, info)
+
+		params := sample.LivenessParams()
+		params.Window = 200
+		msg := types.NewMsgUpdateLivenessParams(sample.AccAddress(), params)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+
+		// ACT
+		_, err := srv.UpdateLivenessParams(sdk.WrapSDKContext(ctx), msg)
+
+		// ASSERT
+		require.NoError(t, err)
+		got, found := k.GetObserverSigningInfo(ctx, info.ObserverAddress)
+		require.True(t, found)
+		require.Equal(t, int64(1000), got.StartHeight)
+		require.Equal(t, int64(0), got.IndexOffset)
+		require.Equal(t, int64(0), got.MissedBallotsCounter)
+		require.Len(t, got.MissedBallotsBitmap, 25)
+	})
+
+	t.Run("should not restart the windows of the observers if the window is unchanged", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		k.SetEmissionsKeeper(emissionsKeeperStub{ballotMaturityBlocks: 100})
+		srv := keeper.NewMsgServerImpl(*k)
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+
+		k.SetLivenessParams(ctx, sample.LivenessParams())
+		info := sample.ObserverSigningInfo()
+		k.SetObserverSigningInfo(ctx, info)
+
+		params := sample.LivenessParams()
+		params.JailDuration = 1000
+		msg := types.NewMsgUpdateLivenessParams(sample.AccAddress(), params)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+
+		// ACT
+		_, err := srv.UpdateLivenessParams(sdk.WrapSDKContext(ctx), msg)
+
+		// ASSERT
+		require.NoError(t, err)
+		got, found := k.GetObserverSigningInfo(ctx, info.ObserverAddress)
+		require.True(t, found)
+		require.Equal(t, info, got)
+	})
+
+	t.Run("cannot enable liveness with a ballot maturity above the emissions ballot maturity", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		k.SetEmissionsKeeper(emissionsKeeperStub{ballotMaturityBlocks: 5})
+		srv := keeper.NewMsgServerImpl(*k)
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+
+		msg := types.NewMsgUpdateLivenessParams(sample.AccAddress(), sample.LivenessParams())
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+
+		// ACT
+		_, err := srv.UpdateLivenessParams(sdk.WrapSDKContext(ctx), msg)
+
+		// ASSERT
+		require.ErrorIs(t, err, types.ErrInvalidLivenessParams)
+		require.Equal(t, types.DefaultLivenessParams(), k.GetLivenessParams(ctx))
+	})
+
+	t.Run("cannot update liveness params if not authorized", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+
+		msg := types.NewMsgUpdateLivenessParams(sample.AccAddress(), sample.LivenessParams())
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, authoritytypes.ErrUnauthorized)
+
+		// ACT
+		_, err := srv.UpdateLivenessParams(sdk.WrapSDKContext(ctx), msg)
+
+		// ASSERT
+		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
+	})
+}
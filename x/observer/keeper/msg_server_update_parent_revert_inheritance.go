@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// UpdateParentRevertInheritance updates the IsParentRevertInheritanceEnabled flag.
+// The flag is updated by the policy account with the groupOperational policy type.
+func (k msgServer) UpdateParentRevertInheritance(
+	goCtx context.Context,
+	msg *types.MsgUpdateParentRevertInheritance,
+) (*types.MsgUpdateParentRevertInheritanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check permission
+	err := k.GetAuthorityKeeper().CheckAuthorization(ctx, msg)
+	if err != nil {
+		return nil, errors.Wrap(authoritytypes.ErrUnauthorized, err.Error())
+	}
+
+	// check if the value exists,
+	// if not, set the default value for the Inbound and Outbound flags only
+	flags, isFound := k.GetCrosschainFlags(ctx)
+	if !isFound {
+		flags = *types.DefaultCrosschainFlags()
+		flags.GasPriceIncreaseFlags = nil
+	}
+
+	flags.IsParentRevertInheritanceEnabled = msg.IsParentRevertInheritanceEnabled
+
+	k.SetCrosschainFlags(ctx, flags)
+
+	return &types.MsgUpdateParentRevertInheritanceResponse{}, nil
+}
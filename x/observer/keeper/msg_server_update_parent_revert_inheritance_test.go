@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/observer/keeper"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestMsgServer_UpdateParentRevertInheritance(t *testing.T) {
+	t.Run("can enable parent revert inheritance", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		// set initial state with parent revert inheritance disabled
+		k.SetCrosschainFlags(ctx, types.CrosschainFlags{
+			IsInboundEnabled:  true,
+			IsOutboundEnabled: true,
+			IsV2ZetaEnabled:   true,
+		})
+		require.False(t, k.IsParentRevertInheritanceEnabled(ctx))
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+
+		msg := types.MsgUpdateParentRevertInheritance{
+			Creator:                          admin,
+			IsParentRevertInheritanceEnabled: true,
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, nil)
+
+		// ACT
+		_, err := srv.UpdateParentRevertInheritance(sdk.WrapSDKContext(ctx), &msg)
+
+		// ASSERT
+		require.NoError(t, err)
+		require.True(t, k.IsParentRevertInheritanceEnabled(ctx))
+
+		// verify other flags are preserved
+		flags, found := k.GetCrosschainFlags(ctx)
+		require.True(t, found)
+		require.True(t, flags.IsInboundEnabled)
+		require.True(t, flags.IsOutboundEnabled)
+		require.True(t, flags.IsV2ZetaEnabled)
+	})
+
+	t.Run("sets default flags if not found", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+
+		msg := types.MsgUpdateParentRevertInheritance{
+			Creator:                          admin,
+			IsParentRevertInheritanceEnabled: true,
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, nil)
+
+		// ACT
+		_, err := srv.UpdateParentRevertInheritance(sdk.WrapSDKContext(ctx), &msg)
+
+		// ASSERT
+		require.NoError(t, err)
+
+		flags, found := k.GetCrosschainFlags(ctx)
+		require.True(t, found)
+		require.True(t, flags.IsParentRevertInheritanceEnabled)
+		defaultFlags := types.DefaultCrosschainFlags()
+		require.Equal(t, defaultFlags.IsInboundEnabled, flags.IsInboundEnabled)
+		require.Equal(t, defaultFlags.IsOutboundEnabled, flags.IsOutboundEnabled)
+	})
+
+	t.Run("cannot update parent revert inheritance if not authorized", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+
+		msg := types.MsgUpdateParentRevertInheritance{
+			Creator:                          admin,
+			IsParentRevertInheritanceEnabled: true,
+		}
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, &msg, authoritytypes.ErrUnauthorized)
+
+		// ACT
+		_, err := srv.UpdateParentRevertInheritance(sdk.WrapSDKContext(ctx), &msg)
+
+		// ASSERT
+		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
+	})
+}
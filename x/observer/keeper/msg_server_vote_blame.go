@@ -54,5 +54,6 @@ func (k msgServer) VoteBlame(
 
 	// Ballot is finalized: exactly when threshold vote is in.
 	k.SetBlame(ctx, msg.BlameInfo)
+	k.RecordBlameEvent(ctx, msg.ChainId, msg.BlameInfo)
 	return &types.MsgVoteBlameResponse{}, nil
 }
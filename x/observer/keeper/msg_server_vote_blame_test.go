@@ -81,6 +81,9 @@ func TestMsgServer_VoteBlame(t *testing.T) {
 		blame, found := k.GetBlame(ctx, blameInfo.Index)
 		require.True(t, found)
 		require.Equal(t, blameInfo, blame)
+
+		events := k.GetBlameEvents(ctx, ctx.BlockHeight(), ctx.BlockHeight())
+		require.Equal(t, []types.BlameEvent{types.NewBlameEvent(chainId, blameInfo, ctx.BlockHeight())}, events)
 	})
 
 	t.Run("should error if add vote fails", func(t *testing.T) {
@@ -22,7 +22,9 @@ const voteTSSid = "Vote TSS"
 // and the status of the keygen is set to "success".
 //
 // Fails if the keygen does not exist, the keygen has been already
-// completed, or the keygen has failed.
+// completed, or the keygen has failed. A successful vote for the current
+// keygen must carry an ed25519 public key if and only if the keygen
+// requested one.
 //
 // Only node accounts are authorized to broadcast this message.
 func (k msgServer) VoteTSS(goCtx context.Context, msg *types.MsgVoteTSS) (*types.MsgVoteTSSResponse, error) {
@@ -42,6 +44,13 @@ func (k msgServer) VoteTSS(goCtx context.Context, msg *types.MsgVoteTSS) (*types
 		return &types.MsgVoteTSSResponse{}, errorsmod.Wrap(types.ErrKeygenNotFound, voteTSSid)
 	}
 
+	if msg.Status == chains.ReceiveStatus_success && msg.KeygenZetaHeight == keygen.BlockNumber &&
+		keygen.Eddsa != (msg.TssPubkeyEddsa != "") {
+		return &types.MsgVoteTSSResponse{}, errorsmod.Wrapf(
+			types.ErrInvalidTSSPubkeyEdDSA,
+			"%s, keygen eddsa: %t, vote eddsa pubkey: %q", voteTSSid, keygen.Eddsa, msg.TssPubkeyEddsa)
+	}
+
 	// GetBallot checks against the supported chains list before querying for Ballot.
 	ballotCreated := false
 	index := msg.Digest()
@@ -50,7 +59,14 @@ func (k msgServer) VoteTSS(goCtx context.Context, msg *types.MsgVoteTSS) (*types
 		// If ballot does not exist, create a new ballot.
 		var voterList []string
 
+		// observers removed by a pending observer set change don't take part in the keygen
+		change, changeFound := k.GetObserverSetChange(ctx)
+		excludeRemovals := changeFound && change.Status == types.ObserverSetChangeStatus_KeygenPending
+
 		for _, nodeAccount := range k.GetAllNodeAccount(ctx) {
+			if excludeRemovals && change.IsRemoved(nodeAccount.Operator) {
+				continue
+			}
 			voterList = append(voterList, nodeAccount.Operator)
 		}
 
@@ -121,6 +137,7 @@ func (k msgServer) VoteTSS(goCtx context.Context, msg *types.MsgVoteTSS) (*types
 	} else {
 		tss := types.TSS{
 			TssPubkey:           msg.TssPubkey,
+			TssPubkeyEddsa:      msg.TssPubkeyEddsa,
 			TssParticipantList:  keygen.GetGranteePubkeys(),
 			OperatorAddressList: ballot.VoterList,
 			FinalizedZetaHeight: ctx.BlockHeight(),
@@ -128,6 +128,68 @@ func TestMsgServer_VoteTSS(t *testing.T) {
 		require.Equal(t, tss.FinalizedZetaHeight, finalizingHeight)
 	})
 
+	t.Run("can create a new ballot, vote success with eddsa pubkey and finalize", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		// setup state
+		nodeAcc := sample.NodeAccount()
+		keygen := sample.Keygen(t)
+		keygen.Status = types.KeygenStatus_PendingKeygen
+		keygen.BlockNumber = 42
+		keygen.Eddsa = true
+		k.SetNodeAccount(ctx, *nodeAcc)
+		k.SetKeygen(ctx, *keygen)
+		pubkeyEdDSA := sample.PubKeyString()
+
+		// ACT
+		res, err := srv.VoteTSS(ctx, &types.MsgVoteTSS{
+			Creator:          nodeAcc.Operator,
+			TssPubkey:        sample.Tss().TssPubkey,
+			TssPubkeyEddsa:   pubkeyEdDSA,
+			KeygenZetaHeight: 42,
+			Status:           chains.ReceiveStatus_success,
+		})
+
+		// ASSERT
+		require.NoError(t, err)
+		require.True(t, res.KeygenSuccess)
+
+		tss, found := k.GetTSS(ctx)
+		require.True(t, found)
+		require.Equal(t, pubkeyEdDSA, tss.TssPubkeyEddsa)
+	})
+
+	t.Run("fail if eddsa pubkey does not match the keygen", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		// setup state
+		nodeAcc := sample.NodeAccount()
+		keygen := sample.Keygen(t)
+		keygen.Status = types.KeygenStatus_PendingKeygen
+		keygen.BlockNumber = 42
+		keygen.Eddsa = true
+		k.SetNodeAccount(ctx, *nodeAcc)
+		k.SetKeygen(ctx, *keygen)
+
+		// ACT
+		// the keygen requested an ed25519 key but the vote doesn't carry one
+		_, err := srv.VoteTSS(ctx, &types.MsgVoteTSS{
+			Creator:          nodeAcc.Operator,
+			TssPubkey:        sample.Tss().TssPubkey,
+			KeygenZetaHeight: 42,
+			Status:           chains.ReceiveStatus_success,
+		})
+
+		// ASSERT
+		require.ErrorIs(t, err, types.ErrInvalidTSSPubkeyEdDSA)
+		_, found := k.GetTSS(ctx)
+		require.False(t, found)
+	})
+
 	t.Run("can create a new ballot, vote failure and finalize", func(t *testing.T) {
 		// ARRANGE
 		k, ctx, _, _ := keepertest.ObserverKeeper(t)
@@ -784,4 +846,39 @@ func TestMsgServer_VoteTSS(t *testing.T) {
 		require.Equal(t, finalizingHeight, tssQueried.FinalizedZetaHeight)
 		require.Equal(t, tss.TssPubkey, tssQueried.TssPubkey)
 	})
+	t.Run("observers removed by a pending observer set change are not voters", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		// setup state
+		nodeAcc := sample.NodeAccount()
+		removedNodeAcc := sample.NodeAccount()
+		keygen := sample.Keygen(t)
+		keygen.Status = types.KeygenStatus_PendingKeygen
+		keygen.BlockNumber = 42
+		k.SetNodeAccount(ctx, *nodeAcc)
+		k.SetNodeAccount(ctx, *removedNodeAcc)
+		k.SetKeygen(ctx, *keygen)
+		k.SetObserverSetChange(ctx, types.ObserverSetChange{
+			Removals:     []string{removedNodeAcc.Operator},
+			KeygenHeight: 42,
+			Status:       types.ObserverSetChangeStatus_KeygenPending,
+		})
+
+		// ACT
+		// the removed observer is not a voter, so the ballot is finalized with a single vote
+		res, err := srv.VoteTSS(ctx, &types.MsgVoteTSS{
+			Creator:          nodeAcc.Operator,
+			TssPubkey:        sample.Tss().TssPubkey,
+			KeygenZetaHeight: 42,
+			Status:           chains.ReceiveStatus_success,
+		})
+
+		// ASSERT
+		require.NoError(t, err)
+		require.True(t, res.BallotCreated)
+		require.True(t, res.VoteFinalized)
+		require.True(t, res.KeygenSuccess)
+	})
 }
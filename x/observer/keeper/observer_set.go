@@ -59,7 +59,7 @@ func (k Keeper) AddObserverToSet(ctx sdk.Context, address string) (uint64, error
 	return newCount, nil
 }
 
-// RemoveObserverFromSet removes an observer from the observer set.
+// RemoveObserverFromSet removes an observer from the observer set and from the subsets of observers of the chain params.
 // Returns the observer count after the operation
 func (k Keeper) RemoveObserverFromSet(ctx sdk.Context, address string) uint64 {
 	observerSet, found := k.GetObserverSet(ctx)
@@ -70,13 +70,15 @@ func (k Keeper) RemoveObserverFromSet(ctx sdk.Context, address string) uint64 {
 		if addr == address {
 			observerSet.ObserverList = append(observerSet.ObserverList[:i], observerSet.ObserverList[i+1:]...)
 			k.SetObserverSet(ctx, observerSet)
+			k.ReplaceChainParamsObserver(ctx, address, "")
 			break
 		}
 	}
 	return observerSet.LenUint()
 }
 
-// UpdateObserverAddress updates an observer address in the observer set.It makes sure the updated observer set is valid.
+// UpdateObserverAddress updates an observer address in the observer set and in the subsets of observers of the chain params.
+// It makes sure the updated observer set is valid.
 func (k Keeper) UpdateObserverAddress(ctx sdk.Context, oldObserverAddress, newObserverAddress string) error {
 	observerSet, found := k.GetObserverSet(ctx)
 	if !found {
@@ -99,5 +101,6 @@ func (k Keeper) UpdateObserverAddress(ctx sdk.Context, oldObserverAddress, newOb
 		return errors.Wrap(types.ErrUpdateObserver, err.Error())
 	}
 	k.SetObserverSet(ctx, observerSet)
+	k.ReplaceChainParamsObserver(ctx, oldObserverAddress, newObserverAddress)
 	return nil
 }
@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func (k Keeper) SetObserverSetChange(ctx sdk.Context, change types.ObserverSetChange) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&change)
+	store.Set(types.KeyPrefix(types.ObserverSetChangeKey), b)
+}
+
+func (k Keeper) GetObserverSetChange(ctx sdk.Context) (val types.ObserverSetChange, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.KeyPrefix(types.ObserverSetChangeKey))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// IsObserverSetChangeInProgress returns true if a staged observer set change is neither activated nor rolled back
+func (k Keeper) IsObserverSetChangeInProgress(ctx sdk.Context) bool {
+	change, found := k.GetObserverSetChange(ctx)
+	return found && change.IsInProgress()
+}
+
+// ProcessObserverSetChange moves the staged observer set change forward, it is called at the beginning of each block.
+//   - the change is rolled back if the keygen for the new observer set fails
+//     or doesn't complete before the activation height, the TSS generated for it is removed from the history
+//   - the change awaits activation once the keygen succeeds
+//   - the new observer set is activated at the activation height, once the new TSS is the current TSS,
+//     meaning the funds have been migrated
+func (k Keeper) ProcessObserverSetChange(ctx sdk.Context) {
+	change, found := k.GetObserverSetChange(ctx)
+	if !found {
+		return
+	}
+
+	rollback := func(reason string) {
+		if err := k.rollbackObserverSetChange(ctx, change, reason); err != nil {
+			ctx.Logger().Error("Unable to roll back observer set change", "reason", reason, "error", err)
+		}
+	}
+
+	switch change.Status {
+	case types.ObserverSetChangeStatus_KeygenPending:
+		keygen, found := k.GetKeygen(ctx)
+		switch {
+		case found && keygen.Status == types.KeygenStatus_KeyGenFailed:
+			rollback("keygen failed")
+		case found && keygen.Status == types.KeygenStatus_KeyGenSuccess:
+			tss, found := k.getTSSByKeygenHeight(ctx, change.KeygenHeight)
+			if !found {
+				rollback("TSS for the keygen not found")
+				return
+			}
+			change.Status = types.ObserverSetChangeStatus_AwaitingActivation
+			change.TssPubkey = tss.TssPubkey
+			k.SetObserverSetChange(ctx, change)
+			EmitEventObserverSetChangeUpdated(ctx, change)
+		case ctx.BlockHeight() >= change.ActivationHeight:
+			rollback("keygen not completed before the activation height")
+		}
+	case types.ObserverSetChangeStatus_AwaitingActivation:
+		if ctx.BlockHeight() < change.ActivationHeight {
+			return
+		}
+
+		// the funds must be migrated and the TSS updated before the observers of the previous TSS are removed
+		tss, found := k.GetTSS(ctx)
+		if !found || tss.TssPubkey != change.TssPubkey {
+			return
+		}
+
+		// use a cached context to not partially apply the change
+		// the change can't be rolled back since the TSS is migrated, the activation is retried at the next block
+		tmpCtx, commit := ctx.CacheContext()
+		if err := k.activateObserverSetChange(tmpCtx, change); err != nil {
+			ctx.Logger().Error("Unable to activate observer set change", "error", err)
+			return
+		}
+		commit()
+
+		change.Status = types.ObserverSetChangeStatus_Activated
+		k.SetObserverSetChange(ctx, change)
+		EmitEventObserverSetChangeUpdated(ctx, change)
+	}
+}
+
+// activateObserverSetChange updates the observer set and the node accounts with the change
+func (k Keeper) activateObserverSetChange(ctx sdk.Context, change types.ObserverSetChange) error {
+	for _, removal := range change.Removals {
+		k.RemoveNodeAccount(ctx, removal)
+		k.RemoveObserverFromSet(ctx, removal)
+	}
+
+	for _, addition := range change.Additions {
+		if _, err := k.AddObserverToSet(ctx, addition.ObserverAddress); err != nil {
+			return fmt.Errorf("unable to add observer %s: %w", addition.ObserverAddress, err)
+		}
+	}
+
+	observerSet, found := k.GetObserverSet(ctx)
+	if !found {
+		return types.ErrObserverSetNotFound
+	}
+	k.SetLastObserverCount(ctx, &types.LastObserverCount{
+		Count:            observerSet.LenUint(),
+		LastChangeHeight: ctx.BlockHeight(),
+	})
+
+	return nil
+}
+
+// rollbackObserverSetChange removes the node accounts added for the change, removes the TSS generated for the change
+// from the TSS history and restores the previous keygen.
+// The change can't be rolled back once its TSS is the current TSS: the funds are migrated to it,
+// and the node accounts of the added observers are needed to sign with it.
+func (k Keeper) rollbackObserverSetChange(ctx sdk.Context, change types.ObserverSetChange, reason string) error {
+	newTSS, newTSSFound := k.getTSSByKeygenHeight(ctx, change.KeygenHeight)
+	if tss, found := k.GetTSS(ctx); found &&
+		((change.TssPubkey != "" && tss.TssPubkey == change.TssPubkey) ||
+			(newTSSFound && tss.TssPubkey == newTSS.TssPubkey)) {
+		return errors.Wrapf(
+			types.ErrInvalidObserverSetChange,
+			"TSS %s of the new observer set is already the current TSS",
+			tss.TssPubkey,
+		)
+	}
+
+	ctx.Logger().Error("Rolling back observer set change", "reason", reason, "activation_height", change.ActivationHeight)
+
+	for _, addition := range change.Additions {
+		k.RemoveNodeAccount(ctx, addition.ObserverAddress)
+	}
+	if newTSSFound {
+		k.RemoveTSSFromHistory(ctx, newTSS.FinalizedZetaHeight)
+	}
+	k.SetKeygen(ctx, change.PreviousKeygen)
+
+	change.Status = types.ObserverSetChangeStatus_RolledBack
+	k.SetObserverSetChange(ctx, change)
+	EmitEventObserverSetChangeUpdated(ctx, change)
+
+	return nil
+}
+
+// getTSSByKeygenHeight returns the TSS generated by the keygen at the given height
+func (k Keeper) getTSSByKeygenHeight(ctx sdk.Context, keygenHeight int64) (types.TSS, bool) {
+	for _, tss := range k.GetAllTSS(ctx) {
+		if tss.KeyGenZetaHeight == keygenHeight {
+			return tss, true
+		}
+	}
+	return types.TSS{}, false
+}
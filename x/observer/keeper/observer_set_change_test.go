@@ -0,0 +1,250 @@
+package keeper_test
+
+import (
+	"math"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/keeper"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestKeeper_GetObserverSetChange(t *testing.T) {
+	k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+	_, found := k.GetObserverSetChange(ctx)
+	require.False(t, found)
+	require.False(t, k.IsObserverSetChangeInProgress(ctx))
+
+	change := sample.ObserverSetChange(t)
+	k.SetObserverSetChange(ctx, *change)
+
+	got, found := k.GetObserverSetChange(ctx)
+	require.True(t, found)
+	require.Equal(t, *change, got)
+	require.True(t, k.IsObserverSetChangeInProgress(ctx))
+}
+
+// setObserverSetChange sets an observer set of two observers and a change replacing the second one
+func setObserverSetChange(
+	t *testing.T,
+	k *keeper.Keeper,
+	ctx sdk.Context,
+	status types.ObserverSetChangeStatus,
+) types.ObserverSetChange {
+	kept := sample.NodeAccount()
+	removed := sample.NodeAccount()
+	added := sample.NodeAccount()
+	k.SetObserverSet(ctx, types.ObserverSet{ObserverList: []string{kept.Operator, removed.Operator}})
+	k.SetLastObserverCount(ctx, &types.LastObserverCount{Count: 2})
+	k.SetNodeAccount(ctx, *kept)
+	k.SetNodeAccount(ctx, *removed)
+	k.SetNodeAccount(ctx, *added)
+
+	change := types.ObserverSetChange{
+		Additions: []types.ObserverSetAddition{
+			{ObserverAddress: added.Operator, ZetaclientGranteePubkey: added.GranteePubkey.Secp256k1.String()},
+		},
+		Removals:         []string{removed.Operator},
+		ProposalHeight:   10,
+		KeygenHeight:     10 + types.ObserverSetChangeKeygenDelay,
+		ActivationHeight: 1000,
+		Status:           status,
+		PreviousKeygen:   *sample.Keygen(t),
+	}
+	k.SetObserverSetChange(ctx, change)
+	k.SetKeygen(ctx, types.Keygen{
+		Status:      types.KeygenStatus_PendingKeygen,
+		BlockNumber: change.KeygenHeight,
+	})
+
+	return change
+}
+
+// requireRolledBack checks the change has been rolled back
+func requireRolledBack(t *testing.T, k *keeper.Keeper, ctx sdk.Context, change types.ObserverSetChange) {
+	got, found := k.GetObserverSetChange(ctx)
+	require.True(t, found)
+	require.Equal(t, types.ObserverSetChangeStatus_RolledBack, got.Status)
+
+	_, found = k.GetNodeAccount(ctx, change.Additions[0].ObserverAddress)
+	require.False(t, found)
+	_, found = k.GetNodeAccount(ctx, change.Removals[0])
+	require.True(t, found)
+	require.True(t, k.IsAddressPartOfObserverSet(ctx, change.Removals[0]))
+
+	keygen, found := k.GetKeygen(ctx)
+	require.True(t, found)
+	require.Equal(t, change.PreviousKeygen, keygen)
+}
+
+func TestKeeper_ProcessObserverSetChange(t *testing.T) {
+	t.Run("should do nothing if no change", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		k.ProcessObserverSetChange(ctx)
+
+		_, found := k.GetObserverSetChange(ctx)
+		require.False(t, found)
+	})
+
+	t.Run("should do nothing if keygen is pending", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		change := setObserverSetChange(t, k, ctx, types.ObserverSetChangeStatus_KeygenPending)
+		ctx = ctx.WithBlockHeight(change.KeygenHeight)
+
+		k.ProcessObserverSetChange(ctx)
+
+		got, found := k.GetObserverSetChange(ctx)
+		require.True(t, found)
+		require.Equal(t, change, got)
+	})
+
+	t.Run("should roll back if keygen failed", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		change := setObserverSetChange(t, k, ctx, types.ObserverSetChangeStatus_KeygenPending)
+		ctx = ctx.WithBlockHeight(change.KeygenHeight + 1)
+		k.SetKeygen(ctx, types.Keygen{Status: types.KeygenStatus_KeyGenFailed, BlockNumber: math.MaxInt64})
+
+		k.ProcessObserverSetChange(ctx)
+
+		requireRolledBack(t, k, ctx, change)
+	})
+
+	t.Run("should remove the TSS generated for the change from the history on roll back", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		change := setObserverSetChange(t, k, ctx, types.ObserverSetChangeStatus_KeygenPending)
+		ctx = ctx.WithBlockHeight(change.KeygenHeight + 1)
+		current := sample.Tss()
+		k.AppendTss(ctx, current)
+		tss := sample.Tss()
+		tss.KeyGenZetaHeight = change.KeygenHeight
+		tss.FinalizedZetaHeight = current.FinalizedZetaHeight + 1
+		k.SetTSSHistory(ctx, tss)
+		k.SetKeygen(ctx, types.Keygen{Status: types.KeygenStatus_KeyGenFailed, BlockNumber: math.MaxInt64})
+
+		k.ProcessObserverSetChange(ctx)
+
+		requireRolledBack(t, k, ctx, change)
+		_, found := k.CheckIfTssPubkeyHasBeenGenerated(ctx, tss.TssPubkey)
+		require.False(t, found)
+		_, found = k.CheckIfTssPubkeyHasBeenGenerated(ctx, current.TssPubkey)
+		require.True(t, found)
+	})
+
+	t.Run("should roll back if keygen is not completed at activation height", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		change := setObserverSetChange(t, k, ctx, types.ObserverSetChangeStatus_KeygenPending)
+		ctx = ctx.WithBlockHeight(change.ActivationHeight)
+
+		k.ProcessObserverSetChange(ctx)
+
+		requireRolledBack(t, k, ctx, change)
+	})
+
+	t.Run("should await activation if keygen succeeded", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		change := setObserverSetChange(t, k, ctx, types.ObserverSetChangeStatus_KeygenPending)
+		ctx = ctx.WithBlockHeight(change.KeygenHeight + 1)
+		tss := sample.Tss()
+		tss.KeyGenZetaHeight = change.KeygenHeight
+		k.SetTSSHistory(ctx, tss)
+		k.SetKeygen(ctx, types.Keygen{Status: types.KeygenStatus_KeyGenSuccess, BlockNumber: ctx.BlockHeight()})
+
+		k.ProcessObserverSetChange(ctx)
+
+		got, found := k.GetObserverSetChange(ctx)
+		require.True(t, found)
+		require.Equal(t, types.ObserverSetChangeStatus_AwaitingActivation, got.Status)
+		require.Equal(t, tss.TssPubkey, got.TssPubkey)
+		require.True(t, k.IsAddressPartOfObserverSet(ctx, change.Removals[0]))
+	})
+
+	t.Run("should not activate before activation height", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		change := setObserverSetChange(t, k, ctx, types.ObserverSetChangeStatus_AwaitingActivation)
+		tss := sample.Tss()
+		change.TssPubkey = tss.TssPubkey
+		k.SetObserverSetChange(ctx, change)
+		k.SetTSS(ctx, tss)
+		ctx = ctx.WithBlockHeight(change.ActivationHeight - 1)
+
+		k.ProcessObserverSetChange(ctx)
+
+		got, found := k.GetObserverSetChange(ctx)
+		require.True(t, found)
+		require.Equal(t, types.ObserverSetChangeStatus_AwaitingActivation, got.Status)
+	})
+
+	t.Run("should not activate if the new TSS is not the current TSS", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		change := setObserverSetChange(t, k, ctx, types.ObserverSetChangeStatus_AwaitingActivation)
+		change.TssPubkey = sample.Tss().TssPubkey
+		k.SetObserverSetChange(ctx, change)
+		k.SetTSS(ctx, sample.Tss())
+		ctx = ctx.WithBlockHeight(change.ActivationHeight)
+
+		k.ProcessObserverSetChange(ctx)
+
+		got, found := k.GetObserverSetChange(ctx)
+		require.True(t, found)
+		require.Equal(t, types.ObserverSetChangeStatus_AwaitingActivation, got.Status)
+		require.True(t, k.IsAddressPartOfObserverSet(ctx, change.Removals[0]))
+	})
+
+	t.Run("should not roll back if the activation fails once the new TSS is the current TSS", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		change := setObserverSetChange(t, k, ctx, types.ObserverSetChangeStatus_AwaitingActivation)
+		tss := sample.Tss()
+		change.TssPubkey = tss.TssPubkey
+		// adding an observer already in the observer set fails the activation
+		observerSet, found := k.GetObserverSet(ctx)
+		require.True(t, found)
+		change.Additions = append(change.Additions, types.ObserverSetAddition{ObserverAddress: observerSet.ObserverList[0]})
+		k.SetObserverSetChange(ctx, change)
+		k.AppendTss(ctx, tss)
+		ctx = ctx.WithBlockHeight(change.ActivationHeight)
+
+		k.ProcessObserverSetChange(ctx)
+
+		got, found := k.GetObserverSetChange(ctx)
+		require.True(t, found)
+		require.Equal(t, types.ObserverSetChangeStatus_AwaitingActivation, got.Status)
+		require.True(t, k.IsAddressPartOfObserverSet(ctx, change.Removals[0]))
+		_, found = k.GetNodeAccount(ctx, change.Additions[0].ObserverAddress)
+		require.True(t, found)
+		_, found = k.CheckIfTssPubkeyHasBeenGenerated(ctx, tss.TssPubkey)
+		require.True(t, found)
+	})
+
+	t.Run("should activate the new observer set", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		change := setObserverSetChange(t, k, ctx, types.ObserverSetChangeStatus_AwaitingActivation)
+		tss := sample.Tss()
+		change.TssPubkey = tss.TssPubkey
+		k.SetObserverSetChange(ctx, change)
+		k.SetTSS(ctx, tss)
+		ctx = ctx.WithBlockHeight(change.ActivationHeight)
+
+		k.ProcessObserverSetChange(ctx)
+
+		got, found := k.GetObserverSetChange(ctx)
+		require.True(t, found)
+		require.Equal(t, types.ObserverSetChangeStatus_Activated, got.Status)
+		require.False(t, k.IsObserverSetChangeInProgress(ctx))
+
+		require.False(t, k.IsAddressPartOfObserverSet(ctx, change.Removals[0]))
+		_, found = k.GetNodeAccount(ctx, change.Removals[0])
+		require.False(t, found)
+		require.True(t, k.IsAddressPartOfObserverSet(ctx, change.Additions[0].ObserverAddress))
+
+		count, found := k.GetLastObserverCount(ctx)
+		require.True(t, found)
+		require.Equal(t, uint64(2), count.Count)
+		require.Equal(t, change.ActivationHeight, count.LastChangeHeight)
+	})
+}
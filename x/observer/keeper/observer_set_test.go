@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/zeta-chain/node/pkg/chains"
 	keepertest "github.com/zeta-chain/node/testutil/keeper"
 	"github.com/zeta-chain/node/testutil/sample"
 	"github.com/zeta-chain/node/x/observer/types"
@@ -116,6 +117,24 @@ func TestKeeper_RemoveObserverFromSet(t *testing.T) {
 		require.Len(t, osNew.ObserverList, 9)
 	})
 
+	t.Run("remove observer from the observers of the chain params", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		os := sample.ObserverSet(3)
+		k.SetObserverSet(ctx, os)
+		chainParams := sample.ChainParams(chains.Ethereum.ChainId)
+		chainParams.Observers = []string{os.ObserverList[0], os.ObserverList[1]}
+		k.SetChainParamsList(ctx, types.ChainParamsList{ChainParams: []*types.ChainParams{chainParams}})
+
+		// ACT
+		k.RemoveObserverFromSet(ctx, os.ObserverList[0])
+
+		// ASSERT
+		cp, found := k.GetChainParamsByChainID(ctx, chains.Ethereum.ChainId)
+		require.True(t, found)
+		require.Equal(t, []string{os.ObserverList[1]}, cp.Observers)
+	})
+
 	t.Run("returns 0 when observer set not found", func(t *testing.T) {
 		// ARRANGE
 		k, ctx, _, _ := keepertest.ObserverKeeper(t)
@@ -179,6 +198,22 @@ func TestKeeper_UpdateObserverAddress(t *testing.T) {
 		require.True(t, found)
 		require.Equal(t, newObserverAddress, observerSet.ObserverList[len(observerSet.ObserverList)-1])
 	})
+	t.Run("update observer address in the observers of the chain params", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		observerSet := sample.ObserverSet(3)
+		k.SetObserverSet(ctx, observerSet)
+		chainParams := sample.ChainParams(chains.Ethereum.ChainId)
+		chainParams.Observers = []string{observerSet.ObserverList[0], observerSet.ObserverList[1]}
+		k.SetChainParamsList(ctx, types.ChainParamsList{ChainParams: []*types.ChainParams{chainParams}})
+		newObserverAddress := sample.AccAddress()
+
+		err := k.UpdateObserverAddress(ctx, observerSet.ObserverList[1], newObserverAddress)
+		require.NoError(t, err)
+
+		cp, found := k.GetChainParamsByChainID(ctx, chains.Ethereum.ChainId)
+		require.True(t, found)
+		require.Equal(t, []string{observerSet.ObserverList[0], newObserverAddress}, cp.Observers)
+	})
 	t.Run("unable to update observer list observe set not found", func(t *testing.T) {
 		// ARRANGE
 		k, ctx, _, _ := keepertest.ObserverKeeper(t)
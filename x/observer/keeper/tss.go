@@ -32,6 +32,12 @@ func (k Keeper) SetTSSHistory(ctx sdk.Context, tss types.TSS) {
 	store.Set(types.KeyPrefix(fmt.Sprintf("%d", tss.FinalizedZetaHeight)), b)
 }
 
+// RemoveTSSFromHistory removes the TSS finalized at the specified zeta height from the TSS history store
+func (k Keeper) RemoveTSSFromHistory(ctx sdk.Context, finalizedZetaHeight int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TSSHistoryKey))
+	store.Delete(types.KeyPrefix(fmt.Sprintf("%d", finalizedZetaHeight)))
+}
+
 // GetHistoricalTssByFinalizedHeight Returns the TSS address the specified finalized zeta height
 // Finalized zeta height is the zeta block height at which the voting for the generation of a new TSS is finalized
 func (k Keeper) GetHistoricalTssByFinalizedHeight(ctx sdk.Context, finalizedZetaHeight int64) (types.TSS, bool) {
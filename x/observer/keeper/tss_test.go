@@ -31,6 +31,17 @@ func TestKeeper_RemoveTSS(t *testing.T) {
 	require.False(t, found)
 }
 
+func TestKeeper_RemoveTSSFromHistory(t *testing.T) {
+	k, ctx, _, _ := keepertest.ObserverKeeper(t)
+	tss := sample.Tss()
+	k.AppendTss(ctx, tss)
+	k.RemoveTSSFromHistory(ctx, tss.FinalizedZetaHeight)
+	_, found := k.GetHistoricalTssByFinalizedHeight(ctx, tss.FinalizedZetaHeight)
+	require.False(t, found)
+	_, found = k.GetTSS(ctx)
+	require.True(t, found)
+}
+
 func TestKeeper_CheckIfTssPubkeyHasBeenGenerated(t *testing.T) {
 	k, ctx, _, _ := keepertest.ObserverKeeper(t)
 	tss := sample.Tss()
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/pkg/errors"
@@ -107,22 +108,31 @@ func (k Keeper) FindBallot(
 	isNew = false
 	ballot, found := k.GetBallot(ctx, index)
 	if !found {
-		observerSet, _ := k.GetObserverSet(ctx)
-
 		cp, found := k.GetChainParamsByChainID(ctx, chain.ChainId)
 		if !found || cp == nil || !cp.IsSupported {
 			return types.Ballot{}, false, types.ErrSupportedChains
 		}
 
+		// only the observers eligible for the chain vote on the ballot
+		// all the unjailed observers vote if none of the eligible observers can, a ballot without voters is never finalized
+		observerSet, _ := k.GetObserverSet(ctx)
+		voters := k.GetBallotVoters(ctx, cp.FilterEligibleObservers(observerSet.ObserverList))
+		if len(voters) == 0 {
+			voters = k.GetBallotVoters(ctx, observerSet.ObserverList)
+		}
+
 		ballot = types.Ballot{
 			BallotIdentifier:     index,
-			VoterList:            observerSet.ObserverList,
-			Votes:                types.CreateVotes(len(observerSet.ObserverList)),
+			VoterList:            voters,
+			Votes:                types.CreateVotes(len(voters)),
 			ObservationType:      observationType,
 			BallotThreshold:      cp.BallotThreshold,
 			BallotStatus:         types.BallotStatus_BallotInProgress,
 			BallotCreationHeight: ctx.BlockHeight(),
 		}
+		if cp.StakeWeightedVoting {
+			ballot.VoterWeights = k.GetBallotVoterWeights(ctx, voters, cp.MaxVoterWeightPercentage)
+		}
 		isNew = true
 		k.AddBallotToList(ctx, ballot)
 	}
@@ -192,6 +202,39 @@ func (k Keeper) CheckObserverSelfDelegation(ctx sdk.Context, accAddress string)
 	return nil
 }
 
+// GetObserverVotingWeight returns the bonded self delegation of the observer used as the weight of its votes
+// It returns zero if the validator of the observer is not bonded or the self delegation is not found
+func (k Keeper) GetObserverVotingWeight(ctx sdk.Context, accAddress string) sdkmath.Int {
+	selfdelAddr, err := sdk.AccAddressFromBech32(accAddress)
+	if err != nil {
+		return sdkmath.ZeroInt()
+	}
+	valAddress, err := types.GetOperatorAddressFromAccAddress(accAddress)
+	if err != nil {
+		return sdkmath.ZeroInt()
+	}
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddress)
+	if err != nil || validator.Jailed || !validator.IsBonded() {
+		return sdkmath.ZeroInt()
+	}
+	delegation, err := k.stakingKeeper.GetDelegation(ctx, selfdelAddr, valAddress)
+	if err != nil {
+		return sdkmath.ZeroInt()
+	}
+	return validator.TokensFromShares(delegation.Shares).TruncateInt()
+}
+
+// GetBallotVoterWeights returns the weights of the votes of the voters of a stake weighted ballot
+// The weights are capped at maxWeightPercentage of the total weight, nil is returned if the voters have no stake
+// or if the cap can't be met
+func (k Keeper) GetBallotVoterWeights(ctx sdk.Context, voters []string, maxWeightPercentage uint64) []sdkmath.Int {
+	stakes := make([]sdkmath.Int, len(voters))
+	for i, voter := range voters {
+		stakes[i] = k.GetObserverVotingWeight(ctx, voter)
+	}
+	return types.CapVoterWeights(stakes, maxWeightPercentage)
+}
+
 // VoteOnBallot finds a ballot or creates a new one if not found,
 // and casts a vote on it. Then proceed to check if the vote has been finalized.
 // This function holds generic logic for all types of votes.
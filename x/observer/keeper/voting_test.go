@@ -526,6 +526,55 @@ func TestKeeper_IsValidator(t *testing.T) {
 	})
 }
 
+// setObserverStake sets a bonded validator for a new observer with a self delegation of the tokens
+func setObserverStake(
+	t *testing.T,
+	ctx sdk.Context,
+	sdkk keepertest.SDKKeepers,
+	r *rand.Rand,
+	tokens int64,
+	bonded bool,
+) string {
+	validator := sample.Validator(t, r)
+	validator.Tokens = sdkmath.NewInt(tokens)
+	validator.DelegatorShares = sdkmath.LegacyNewDec(tokens)
+	if !bonded {
+		validator.Status = stakingtypes.Unbonded
+	}
+	sdkk.StakingKeeper.SetValidator(ctx, validator)
+	accAddress, err := types.GetAccAddressFromOperatorAddress(validator.OperatorAddress)
+	require.NoError(t, err)
+
+	sdkk.StakingKeeper.SetDelegation(ctx, stakingtypes.Delegation{
+		DelegatorAddress: accAddress.String(),
+		ValidatorAddress: validator.GetOperator(),
+		Shares:           sdkmath.LegacyNewDec(tokens),
+	})
+	return accAddress.String()
+}
+
+func TestKeeper_GetObserverVotingWeight(t *testing.T) {
+	t.Run("should return zero if validator not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		require.True(t, k.GetObserverVotingWeight(ctx, sample.AccAddress()).IsZero())
+	})
+
+	t.Run("should return zero if validator not bonded", func(t *testing.T) {
+		k, ctx, sdkk, _ := keepertest.ObserverKeeper(t)
+		observer := setObserverStake(t, ctx, sdkk, rand.New(rand.NewSource(9)), 100, false)
+
+		require.True(t, k.GetObserverVotingWeight(ctx, observer).IsZero())
+	})
+
+	t.Run("should return the self delegation", func(t *testing.T) {
+		k, ctx, sdkk, _ := keepertest.ObserverKeeper(t)
+		observer := setObserverStake(t, ctx, sdkk, rand.New(rand.NewSource(9)), 100, true)
+
+		require.Equal(t, sdkmath.NewInt(100), k.GetObserverVotingWeight(ctx, observer))
+	})
+}
+
 func TestKeeper_FindBallot(t *testing.T) {
 	t.Run("should err if chain params not found", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.ObserverKeeper(t)
@@ -535,6 +584,133 @@ func TestKeeper_FindBallot(t *testing.T) {
 		}, types.ObservationType_InboundTx)
 		require.Error(t, err)
 	})
+
+	t.Run("should exclude jailed observers from the voters of a new ballot", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		observerSet := sample.ObserverSet(3)
+		k.SetObserverSet(ctx, observerSet)
+		jailed := types.NewObserverSigningInfo(observerSet.ObserverList[1], 0, 100)
+		jailed.Jailed = true
+		k.SetObserverSigningInfo(ctx, jailed)
+		k.SetChainParamsList(ctx, types.ChainParamsList{
+			ChainParams: []*types.ChainParams{
+				{
+					ChainId:     getValidEthChainIDWithIndex(t, 0),
+					IsSupported: true,
+				},
+			},
+		})
+
+		ballot, isNew, err := k.FindBallot(ctx, "index", chains.Chain{
+			ChainId: getValidEthChainIDWithIndex(t, 0),
+		}, types.ObservationType_InboundTx)
+		require.NoError(t, err)
+		require.True(t, isNew)
+		require.Equal(t, []string{observerSet.ObserverList[0], observerSet.ObserverList[2]}, ballot.VoterList)
+		require.Len(t, ballot.Votes, 2)
+
+		// the observer set is not modified
+		got, found := k.GetObserverSet(ctx)
+		require.True(t, found)
+		require.Equal(t, observerSet, got)
+	})
+
+	t.Run("should only include the observers eligible for the chain in the voters of a new ballot", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		observerSet := sample.ObserverSet(4)
+		k.SetObserverSet(ctx, observerSet)
+		k.SetChainParamsList(ctx, types.ChainParamsList{
+			ChainParams: []*types.ChainParams{
+				{
+					ChainId:     getValidEthChainIDWithIndex(t, 0),
+					IsSupported: true,
+					Observers:   []string{observerSet.ObserverList[3], observerSet.ObserverList[1]},
+				},
+			},
+		})
+
+		ballot, isNew, err := k.FindBallot(ctx, "index", chains.Chain{
+			ChainId: getValidEthChainIDWithIndex(t, 0),
+		}, types.ObservationType_InboundTx)
+		require.NoError(t, err)
+		require.True(t, isNew)
+		require.Equal(t, []string{observerSet.ObserverList[1], observerSet.ObserverList[3]}, ballot.VoterList)
+		require.Len(t, ballot.Votes, 2)
+	})
+
+	t.Run("should include all the unjailed observers if none of the eligible observers can vote", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		observerSet := sample.ObserverSet(3)
+		k.SetObserverSet(ctx, observerSet)
+		jailed := types.NewObserverSigningInfo(observerSet.ObserverList[1], 0, 100)
+		jailed.Jailed = true
+		k.SetObserverSigningInfo(ctx, jailed)
+		k.SetChainParamsList(ctx, types.ChainParamsList{
+			ChainParams: []*types.ChainParams{
+				{
+					ChainId:     getValidEthChainIDWithIndex(t, 0),
+					IsSupported: true,
+					Observers:   []string{observerSet.ObserverList[1]},
+				},
+			},
+		})
+
+		ballot, isNew, err := k.FindBallot(ctx, "index", chains.Chain{
+			ChainId: getValidEthChainIDWithIndex(t, 0),
+		}, types.ObservationType_InboundTx)
+		require.NoError(t, err)
+		require.True(t, isNew)
+		require.Equal(t, []string{observerSet.ObserverList[0], observerSet.ObserverList[2]}, ballot.VoterList)
+		require.Len(t, ballot.Votes, 2)
+	})
+
+	t.Run("should record the capped weights of the voters if stake weighted voting", func(t *testing.T) {
+		k, ctx, sdkk, _ := keepertest.ObserverKeeper(t)
+
+		r := rand.New(rand.NewSource(9))
+		observers := []string{
+			setObserverStake(t, ctx, sdkk, r, 10, true),
+			setObserverStake(t, ctx, sdkk, r, 20, true),
+			setObserverStake(t, ctx, sdkk, r, 70, true),
+		}
+		k.SetObserverSet(ctx, types.ObserverSet{ObserverList: observers})
+		chainParams := sample.ChainParams(getValidEthChainIDWithIndex(t, 0))
+		chainParams.IsSupported = true
+		chainParams.StakeWeightedVoting = true
+		chainParams.MaxVoterWeightPercentage = 50
+		k.SetChainParamsList(ctx, types.ChainParamsList{ChainParams: []*types.ChainParams{chainParams}})
+
+		ballot, isNew, err := k.FindBallot(ctx, "index", chains.Chain{
+			ChainId: chainParams.ChainId,
+		}, types.ObservationType_InboundTx)
+		require.NoError(t, err)
+		require.True(t, isNew)
+		require.Equal(t, observers, ballot.VoterList)
+		require.Equal(t, []sdkmath.Int{sdkmath.NewInt(10), sdkmath.NewInt(20), sdkmath.NewInt(30)}, ballot.VoterWeights)
+	})
+
+	t.Run("should not record weights if stake weighted voting is disabled", func(t *testing.T) {
+		k, ctx, sdkk, _ := keepertest.ObserverKeeper(t)
+
+		r := rand.New(rand.NewSource(9))
+		observers := []string{
+			setObserverStake(t, ctx, sdkk, r, 10, true),
+			setObserverStake(t, ctx, sdkk, r, 20, true),
+		}
+		k.SetObserverSet(ctx, types.ObserverSet{ObserverList: observers})
+		chainParams := sample.ChainParams(getValidEthChainIDWithIndex(t, 0))
+		chainParams.IsSupported = true
+		k.SetChainParamsList(ctx, types.ChainParamsList{ChainParams: []*types.ChainParams{chainParams}})
+
+		ballot, _, err := k.FindBallot(ctx, "index", chains.Chain{
+			ChainId: chainParams.ChainId,
+		}, types.ObservationType_InboundTx)
+		require.NoError(t, err)
+		require.False(t, ballot.IsWeighted())
+	})
 }
 
 func TestKeeper_VoteOnBallot(t *testing.T) {
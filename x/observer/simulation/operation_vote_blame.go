@@ -0,0 +1,173 @@
+package simulation
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/zeta-chain/node/testutil/sample"
+	zetasimulation "github.com/zeta-chain/node/testutil/simulation"
+	"github.com/zeta-chain/node/x/observer/keeper"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// operationSimulateVoteBlame generates a MsgVoteBlame and delivers it
+func operationSimulateVoteBlame(
+	k keeper.Keeper,
+	msg types.MsgVoteBlame,
+	simAccount simtypes.Account,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, _ []simtypes.Account, _ string,
+	) (OperationMsg simtypes.OperationMsg, futureOps []simtypes.FutureOperation, err error) {
+		// Fetch the account from the auth keeper which can then be used to fetch spendable coins
+		authAccount := k.GetAuthKeeper().GetAccount(ctx, simAccount.Address)
+		spendable := k.GetBankKeeper().SpendableCoins(ctx, authAccount.GetAddress())
+
+		// Generate a transaction with a random fee and deliver it
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             &msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   k.GetAuthKeeper(),
+			Bankkeeper:      k.GetBankKeeper(),
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		// The vote can fail if the observer has been removed or the ballot is already finalized
+		return zetasimulation.GenAndDeliverTxWithRandFees(txCtx, false)
+	}
+}
+
+// SimulateMsgVoteBlame generates a MsgVoteBlame blaming random node accounts for a failed keysign on an external
+// chain and delivers it, it also schedules future votes for the same ballot
+func SimulateMsgVoteBlame(k keeper.Keeper) simtypes.Operation {
+	observerVotesTransitionMatrix, statePercentageArray, curNumVotesState := zetasimulation.ObserverVotesSimulationMatrix()
+	return func(
+		r *rand.Rand,
+		app *baseapp.BaseApp,
+		ctx sdk.Context,
+		accs []simtypes.Account,
+		chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		externalChain, err := zetasimulation.GetExternalChain(ctx, k, r)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgVoteBlame, err.Error()), nil, nil
+		}
+
+		// Blame a random subset of the node accounts
+		var nodes []*types.Node
+		for _, nodeAccount := range k.GetAllNodeAccount(ctx) {
+			if nodeAccount.GranteePubkey == nil || r.Intn(3) != 0 {
+				continue
+			}
+			nodes = append(nodes, &types.Node{
+				PubKey:         nodeAccount.GranteePubkey.Secp256k1.String(),
+				BlameData:      sample.RandomBytes(r),
+				BlameSignature: sample.RandomBytes(r),
+			})
+		}
+
+		digest := ethcommon.BytesToHash(sample.RandomBytes(r)).Hex()
+		msg := types.MsgVoteBlame{
+			ChainId: externalChain.ChainId,
+			BlameInfo: types.Blame{
+				// #nosec G115 block height is positive
+				Index:         types.GetBlameIndex(externalChain.ChainId, r.Uint64(), digest, uint64(ctx.BlockHeight())),
+				FailureReason: sample.StringRandom(r, 32),
+				Nodes:         nodes,
+			},
+		}
+
+		// Pick a random observer to create the ballot
+		// If this returns an error, it is likely that the entire observer set has been removed
+		simAccount, firstVoter, _, err := zetasimulation.GetRandomAccountAndObserver(r, ctx, k, accs)
+		if err != nil {
+			return simtypes.OperationMsg{}, nil, nil
+		}
+
+		txGen := moduletestutil.MakeTestEncodingConfig().TxConfig
+		account := k.GetAuthKeeper().GetAccount(ctx, simAccount.Address)
+
+		firstMsg := msg
+		firstMsg.Creator = firstVoter
+
+		// THe first vote should always create a new ballot
+		_, found := k.GetBallot(ctx, firstMsg.Digest())
+		if found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgVoteBlame, "ballot already exists"), nil, nil
+		}
+
+		err = firstMsg.ValidateBasic()
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgVoteBlame, "unable to validate first blame vote"), nil, err
+		}
+
+		tx, err := simtestutil.GenSignedMockTx(
+			r,
+			txGen,
+			[]sdk.Msg{&firstMsg},
+			sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)},
+			simtestutil.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgVoteBlame, "unable to generate mock tx"), nil, err
+		}
+
+		// We can return error here as we can guarantee that the first vote will be successful.
+		// Since we query the observer set before adding votes
+		_, _, err = app.SimDeliver(txGen.TxEncoder(), tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgVoteBlame, "unable to deliver tx"), nil, err
+		}
+
+		opMsg := zetasimulation.OperationMessage(&msg)
+
+		observerSet, found := k.GetObserverSet(ctx)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgVoteBlame, "observer set not found"), nil, nil
+		}
+
+		// Pick the observers voting for the blame
+		curNumVotesState = observerVotesTransitionMatrix.NextState(r, curNumVotesState)
+		numVotes := int(math.Ceil(float64(len(observerSet.ObserverList)) * statePercentageArray[curNumVotesState]))
+		whoVotes := r.Perm(len(observerSet.ObserverList))[:numVotes]
+
+		var fops []simtypes.FutureOperation
+		for _, observerIdx := range whoVotes {
+			observerAddress := observerSet.ObserverList[observerIdx]
+			// firstVoter has already voted.
+			if observerAddress == firstVoter {
+				continue
+			}
+			observerAccount, err := zetasimulation.GetObserverAccount(observerAddress, accs)
+			if err != nil {
+				continue
+			}
+			votingMsg := msg
+			votingMsg.Creator = observerAddress
+
+			fops = append(fops, simtypes.FutureOperation{
+				// Submit all subsequent votes in the next block.
+				BlockHeight: int(ctx.BlockHeight() + 1),
+				Op:          operationSimulateVoteBlame(k, votingMsg, observerAccount),
+			})
+		}
+		return opMsg, fops, nil
+	}
+}
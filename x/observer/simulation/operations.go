@@ -16,6 +16,7 @@ var (
 	TypeMsgDisableCCTX                 = sdk.MsgTypeURL(&observertypes.MsgDisableCCTX{})
 	TypeMsgDisableFastConfirmation     = sdk.MsgTypeURL(&observertypes.MsgDisableFastConfirmation{})
 	TypeMsgVoteTSS                     = sdk.MsgTypeURL(&observertypes.MsgVoteTSS{})
+	TypeMsgVoteBlame                   = sdk.MsgTypeURL(&observertypes.MsgVoteBlame{})
 	TypeMsgUpdateKeygen                = sdk.MsgTypeURL(&observertypes.MsgUpdateKeygen{})
 	TypeMsgUpdateObserver              = sdk.MsgTypeURL(&observertypes.MsgUpdateObserver{})
 	TypeMsgUpdateChainParams           = sdk.MsgTypeURL(&observertypes.MsgUpdateChainParams{})
@@ -42,6 +43,7 @@ const (
 	OpWeightMsgTypeMsgDisableCCTX                 = "op_weight_msg_disable_crosschain_flags"        // #nosec G101 not a hardcoded credential
 	OpWeightMsgTypeMsgDisableFastConfirmation     = "op_weight_msg_disable_fast_confirmation"       // #nosec G101 not a hardcoded credential
 	OpWeightMsgTypeMsgVoteTSS                     = "op_weight_msg_vote_tss"                        // #nosec G101 not a hardcoded credential
+	OpWeightMsgTypeMsgVoteBlame                   = "op_weight_msg_vote_blame"                      // #nosec G101 not a hardcoded credential
 	OpWeightMsgTypeMsgUpdateKeygen                = "op_weight_msg_update_keygen"                   // #nosec G101 not a hardcoded credential
 	OpWeightMsgTypeMsgUpdateObserver              = "op_weight_msg_update_observer"                 // #nosec G101 not a hardcoded credential
 	OpWeightMsgTypeMsgUpdateChainParams           = "op_weight_msg_update_chain_params"             // #nosec G101 not a hardcoded credential
@@ -57,6 +59,7 @@ const (
 	DefaultWeightMsgTypeMsgDisableCCTX                 = 10
 	DefaultWeightMsgTypeMsgDisableFastConfirmation     = 10
 	DefaultWeightMsgTypeMsgVoteTSS                     = 10
+	DefaultWeightMsgTypeMsgVoteBlame                   = 10
 	DefaultWeightMsgTypeMsgUpdateKeygen                = 10
 	DefaultWeightMsgTypeMsgUpdateObserver              = 10
 	DefaultWeightMsgTypeMsgUpdateChainParams           = 10
@@ -75,6 +78,7 @@ func WeightedOperations(
 		weightMsgTypeMsgDisableCCTX                 int
 		weightMsgTypeMsgDisableFastConfirmation     int
 		weightMsgTypeMsgVoteTSS                     int
+		weightMsgTypeMsgVoteBlame                   int
 		weightMsgTypeMsgUpdateKeygen                int
 		weightMsgTypeMsgUpdateObserver              int
 		weightMsgTypeMsgUpdateChainParams           int
@@ -104,6 +108,11 @@ func WeightedOperations(
 			weightMsgTypeMsgVoteTSS = DefaultWeightMsgTypeMsgVoteTSS
 		})
 
+	appParams.GetOrGenerate(OpWeightMsgTypeMsgVoteBlame, &weightMsgTypeMsgVoteBlame, nil,
+		func(_ *rand.Rand) {
+			weightMsgTypeMsgVoteBlame = DefaultWeightMsgTypeMsgVoteBlame
+		})
+
 	appParams.GetOrGenerate(OpWeightMsgTypeMsgUpdateKeygen, &weightMsgTypeMsgUpdateKeygen, nil,
 		func(_ *rand.Rand) {
 			weightMsgTypeMsgUpdateKeygen = DefaultWeightMsgTypeMsgUpdateKeygen
@@ -203,5 +212,10 @@ func WeightedOperations(
 			weightMsgTypeMsgVoteTSS,
 			SimulateMsgVoteTSS(k),
 		),
+
+		simulation.NewWeightedOperation(
+			weightMsgTypeMsgVoteBlame,
+			SimulateMsgVoteBlame(k),
+		),
 	}
 }
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"slices"
 
 	cosmoserrors "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -51,16 +52,17 @@ func (m Ballot) IsFinalizingVote() (Ballot, bool) {
 		return m, false
 	}
 	success, failure := sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec()
-	total := sdkmath.LegacyNewDec(int64(len(m.VoterList)))
+	total := sdkmath.LegacyNewDecFromInt(m.GetTotalWeight())
 	if total.IsZero() {
 		return m, false
 	}
-	for _, vote := range m.Votes {
+	for i, vote := range m.Votes {
+		weight := sdkmath.LegacyNewDecFromInt(m.GetVoterWeight(i))
 		if vote == VoteType_SuccessObservation {
-			success = success.Add(sdkmath.LegacyOneDec())
+			success = success.Add(weight)
 		}
 		if vote == VoteType_FailureObservation {
-			failure = failure.Add(sdkmath.LegacyOneDec())
+			failure = failure.Add(weight)
 		}
 	}
 	if failure.IsPositive() {
@@ -79,6 +81,51 @@ func (m Ballot) IsFinalizingVote() (Ballot, bool) {
 	return m, false
 }
 
+// IsWeighted returns true if the votes of the ballot are weighted by the stake of the voters
+func (m Ballot) IsWeighted() bool {
+	return len(m.VoterWeights) > 0
+}
+
+// GetVoterWeight returns the weight of the vote of the voter at the index in the `VoterList`
+// Each vote counts as one if the ballot is not weighted
+func (m Ballot) GetVoterWeight(index int) sdkmath.Int {
+	if !m.IsWeighted() {
+		return sdkmath.OneInt()
+	}
+	if index < 0 || index >= len(m.VoterWeights) || m.VoterWeights[index].IsNil() {
+		return sdkmath.ZeroInt()
+	}
+	return m.VoterWeights[index]
+}
+
+// GetTotalWeight returns the sum of the weights of the voters
+func (m Ballot) GetTotalWeight() sdkmath.Int {
+	if !m.IsWeighted() {
+		return sdkmath.NewInt(int64(len(m.VoterList)))
+	}
+	total := sdkmath.ZeroInt()
+	for i := range m.VoterList {
+		total = total.Add(m.GetVoterWeight(i))
+	}
+	return total
+}
+
+// GetVoterRewardUnits returns the reward units of the vote of the voter at the index in the `VoterList`
+// Each vote is worth one unit if the ballot is not weighted, otherwise the units of the ballot,
+// one per voter, are shared in proportion to the weights of the voters
+func (m Ballot) GetVoterRewardUnits(index int) int64 {
+	if !m.IsWeighted() {
+		return 1
+	}
+	total := m.GetTotalWeight()
+	if total.IsZero() {
+		return 0
+	}
+	return sdkmath.LegacyNewDecFromInt(m.GetVoterWeight(index).MulRaw(int64(len(m.VoterList)))).
+		QuoInt(total).
+		RoundInt64()
+}
+
 func (m Ballot) IsFinalized() bool {
 	return m.BallotStatus != BallotStatus_BallotInProgress
 }
@@ -92,6 +139,7 @@ func CreateVotes(listSize int) []VoteType {
 }
 
 // BuildRewardsDistribution builds the rewards distribution map for the ballot
+// The votes of weighted ballots are counted with the weight recorded in the ballot
 func BuildRewardsDistribution(ballots []Ballot) map[string]int64 {
 	rewardsMap := make(map[string]int64)
 
@@ -109,14 +157,63 @@ func BuildRewardsDistribution(ballots []Ballot) map[string]int64 {
 		}
 
 		for _, address := range m.VoterList {
-			vote := m.Votes[m.GetVoterIndex(address)]
-			if vote == majorityVote {
-				rewardsMap[address]++
+			index := m.GetVoterIndex(address)
+			units := m.GetVoterRewardUnits(index)
+			if m.Votes[index] == majorityVote {
+				rewardsMap[address] += units
 			} else {
 				// NotVoted is always included in else case
-				rewardsMap[address]--
+				rewardsMap[address] -= units
 			}
 		}
 	}
 	return rewardsMap
 }
+
+// CapVoterWeights returns the voting weights of the voters from their stakes, the weight of a voter is capped at
+// maxWeightPercentage of the total weight of the voters, 0 means no cap.
+//
+// The cap is computed by water-filling: the largest stakes are lowered to a common cap C such that
+// C = maxWeightPercentage% of the total capped weight, the stakes below C are kept as is.
+// Returns nil if the total stake is zero or if the cap can't be met (less than 100/maxWeightPercentage voters
+// with stake), the votes then count as one.
+func CapVoterWeights(stakes []sdkmath.Int, maxWeightPercentage uint64) []sdkmath.Int {
+	total := sdkmath.ZeroInt()
+	for _, stake := range stakes {
+		total = total.Add(stake)
+	}
+	if total.IsZero() {
+		return nil
+	}
+	if maxWeightPercentage == 0 || maxWeightPercentage >= 100 {
+		return stakes
+	}
+
+	// sort the stakes in descending order, the k largest stakes are capped
+	sorted := slices.Clone(stakes)
+	slices.SortFunc(sorted, func(a, b sdkmath.Int) int { return b.BigInt().Cmp(a.BigInt()) })
+
+	percentage := sdkmath.NewIntFromUint64(maxWeightPercentage)
+	remaining := total
+	for k, stake := range sorted {
+		// with k capped voters: C = p * (k*C + remaining) / 100  =>  C = p * remaining / (100 - p*k)
+		denominator := sdkmath.NewInt(100).Sub(percentage.MulRaw(int64(k)))
+		if !denominator.IsPositive() {
+			break
+		}
+		maxWeight := percentage.Mul(remaining).Quo(denominator)
+		if stake.LTE(maxWeight) {
+			if maxWeight.IsZero() {
+				break
+			}
+			weights := make([]sdkmath.Int, len(stakes))
+			for i, s := range stakes {
+				weights[i] = sdkmath.MinInt(s, maxWeight)
+			}
+			return weights
+		}
+		remaining = remaining.Sub(stake)
+	}
+
+	return nil
+}
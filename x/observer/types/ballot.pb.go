@@ -91,6 +91,10 @@ type Ballot struct {
 	BallotThreshold      cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=ballot_threshold,json=ballotThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ballot_threshold"`
 	BallotStatus         BallotStatus                `protobuf:"varint,7,opt,name=ballot_status,json=ballotStatus,proto3,enum=zetachain.zetacore.observer.BallotStatus" json:"ballot_status,omitempty"`
 	BallotCreationHeight int64                       `protobuf:"varint,8,opt,name=ballot_creation_height,json=ballotCreationHeight,proto3" json:"ballot_creation_height,omitempty"`
+	// weight of the vote of each voter in the order of voter_list, set if the
+	// ballot is created with stake weighted voting, each vote counts as one
+	// otherwise
+	VoterWeights []cosmossdk_io_math.Int `protobuf:"bytes,9,rep,name=voter_weights,json=voterWeights,proto3,customtype=cosmossdk.io/math.Int" json:"voter_weights"`
 }
 
 func (m *Ballot) Reset()         { *m = Ballot{} }
@@ -232,41 +236,43 @@ func init() {
 }
 
 var fileDescriptor_18c7141b763f2e87 = []byte{
-	// 544 bytes of a gzipped FileDescriptorProto
+	// 573 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x93, 0x36, 0xb4, 0x4b, 0x69, 0xcc, 0x12, 0x45, 0x56, 0x2a, 0xdc, 0x28, 0x08, 0x64,
-	0xd2, 0x62, 0x4b, 0x85, 0x1b, 0xb7, 0x00, 0x11, 0x41, 0x55, 0x00, 0xb7, 0x02, 0x01, 0x07, 0xcb,
-	0xb1, 0x07, 0x7b, 0x85, 0xe3, 0x8d, 0x76, 0x37, 0x55, 0x93, 0xa7, 0xe0, 0x21, 0x38, 0xf0, 0x28,
-	0xbd, 0x20, 0xf5, 0x88, 0x38, 0x54, 0x28, 0x79, 0x11, 0xe4, 0x5d, 0xbb, 0x0d, 0x52, 0x94, 0xdb,
-	0xce, 0xcc, 0xf7, 0xe3, 0x99, 0xf1, 0x20, 0x6b, 0x06, 0xc2, 0x0f, 0x62, 0x9f, 0xa4, 0x8e, 0x7c,
-	0x51, 0x06, 0x0e, 0x1d, 0x72, 0x60, 0x67, 0xc0, 0x9c, 0xa1, 0x9f, 0x24, 0x54, 0xd8, 0x63, 0x46,
-	0x05, 0xc5, 0x7b, 0xd7, 0x48, 0xbb, 0x40, 0xda, 0x05, 0xb2, 0x59, 0x8f, 0x68, 0x44, 0x25, 0xce,
-	0xc9, 0x5e, 0x8a, 0xd2, 0xec, 0xac, 0x13, 0x2f, 0x1e, 0x0a, 0xdb, 0xfe, 0x55, 0x41, 0xd5, 0xae,
-	0xf4, 0xc3, 0x07, 0xe8, 0xae, 0x72, 0xf6, 0x48, 0x08, 0xa9, 0x20, 0x5f, 0x09, 0x30, 0xa3, 0xdc,
-	0xd2, 0xac, 0x6d, 0x57, 0x57, 0x85, 0xfe, 0x75, 0x1e, 0xdf, 0x47, 0xe8, 0x8c, 0x0a, 0x60, 0x5e,
-	0x42, 0xb8, 0x30, 0x2a, 0xad, 0x8a, 0xb5, 0xed, 0x6e, 0xcb, 0xcc, 0x31, 0xe1, 0x02, 0x3f, 0x47,
-	0x9b, 0x59, 0xc0, 0x8d, 0x8d, 0x56, 0xc5, 0xda, 0x3d, 0x7a, 0x68, 0xaf, 0xe9, 0xc2, 0xfe, 0x40,
-	0x05, 0x9c, 0x4e, 0xc7, 0xe0, 0x2a, 0x0e, 0xfe, 0x88, 0x74, 0x55, 0xf3, 0x05, 0xa1, 0xa9, 0x27,
-	0xa6, 0x63, 0x30, 0x36, 0x5b, 0x9a, 0xb5, 0x7b, 0x74, 0xb8, 0x56, 0xe7, 0xed, 0x0d, 0x49, 0xca,
-	0xd5, 0xe8, 0xff, 0x09, 0x3c, 0x40, 0x79, 0x23, 0x9e, 0x88, 0x19, 0xf0, 0x98, 0x26, 0xa1, 0x51,
-	0xcd, 0x1a, 0xec, 0x3e, 0xb8, 0xb8, 0xda, 0x2f, 0xfd, 0xb9, 0xda, 0xdf, 0x0b, 0x28, 0x1f, 0x51,
-	0xce, 0xc3, 0x6f, 0x36, 0xa1, 0xce, 0xc8, 0x17, 0xb1, 0x7d, 0x0c, 0x91, 0x1f, 0x4c, 0x5f, 0x42,
-	0xe0, 0xd6, 0x14, 0xf9, 0xb4, 0xe0, 0xe2, 0x01, 0xba, 0x93, 0xeb, 0x71, 0xe1, 0x8b, 0x09, 0x37,
-	0x6e, 0xc9, 0xaf, 0x7c, 0xbc, 0xf6, 0x2b, 0xd5, 0xb4, 0x4f, 0x24, 0xc1, 0xdd, 0x19, 0x2e, 0x45,
-	0xf8, 0x19, 0x6a, 0xe4, 0x7a, 0x01, 0x03, 0xd5, 0x7c, 0x0c, 0x24, 0x8a, 0x85, 0xb1, 0xd5, 0xd2,
-	0xac, 0x8a, 0x5b, 0x57, 0xd5, 0x17, 0x79, 0xf1, 0xb5, 0xac, 0xbd, 0xd9, 0xd8, 0xd2, 0xf4, 0xb2,
-	0xbb, 0x49, 0xd2, 0x10, 0xce, 0xdb, 0x5f, 0xd0, 0x3d, 0x65, 0x90, 0xad, 0xa1, 0x47, 0x99, 0xc2,
-	0xe0, 0x06, 0xaa, 0xe6, 0x4a, 0x9a, 0x54, 0xca, 0x23, 0x7c, 0x88, 0xb0, 0xd2, 0xe4, 0x9e, 0xe4,
-	0xab, 0x75, 0x96, 0xe5, 0x3a, 0xf3, 0x59, 0xf1, 0x7e, 0x56, 0xc8, 0xe4, 0x3a, 0xef, 0xd1, 0x56,
-	0xb1, 0x2b, 0xdc, 0x40, 0xf8, 0x64, 0x12, 0x04, 0xc0, 0xf9, 0xd2, 0xd8, 0xf5, 0x52, 0x96, 0xef,
-	0xf9, 0x24, 0x99, 0x30, 0x58, 0xce, 0x6b, 0xb8, 0x86, 0x6e, 0x0f, 0xa8, 0xf8, 0x04, 0x22, 0x53,
-	0x08, 0xf5, 0x72, 0x73, 0xe3, 0xe7, 0x0f, 0x53, 0xeb, 0xcc, 0xd0, 0xce, 0xf2, 0x40, 0xf0, 0x23,
-	0xd4, 0x56, 0x71, 0x8f, 0xa4, 0x7e, 0x42, 0x66, 0x10, 0x7a, 0x2b, 0x6d, 0x56, 0xe0, 0x56, 0xda,
-	0xd6, 0x91, 0xae, 0x70, 0xfd, 0xf4, 0x1d, 0xa3, 0x11, 0x03, 0xce, 0x0b, 0xef, 0xee, 0xab, 0x8b,
-	0xb9, 0xa9, 0x5d, 0xce, 0x4d, 0xed, 0xef, 0xdc, 0xd4, 0xbe, 0x2f, 0xcc, 0xd2, 0xe5, 0xc2, 0x2c,
-	0xfd, 0x5e, 0x98, 0xa5, 0xcf, 0x07, 0x11, 0x11, 0xf1, 0x64, 0x68, 0x07, 0x74, 0x24, 0x4f, 0xe8,
-	0x89, 0xba, 0xa6, 0x94, 0x86, 0xe0, 0x9c, 0xdf, 0xdc, 0x52, 0xf6, 0x67, 0xf2, 0x61, 0x55, 0x5e,
-	0xd2, 0xd3, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9f, 0x2f, 0xd2, 0x7f, 0xd4, 0x03, 0x00, 0x00,
+	0x10, 0x8e, 0xf3, 0x47, 0xb2, 0xa4, 0x8d, 0x59, 0x42, 0x64, 0xa5, 0xaa, 0x1b, 0x05, 0x81, 0x4c,
+	0x5a, 0x6c, 0xa9, 0x70, 0xe3, 0x16, 0x20, 0x22, 0xa8, 0x0a, 0xe0, 0x56, 0x54, 0xc0, 0xc1, 0x72,
+	0xec, 0xc5, 0x5e, 0xe1, 0x78, 0xa3, 0xdd, 0x4d, 0x69, 0xf2, 0x14, 0xf0, 0x0e, 0x1c, 0x78, 0x94,
+	0x1e, 0x7b, 0x44, 0x1c, 0x2a, 0x94, 0xbc, 0x08, 0xf2, 0xae, 0xdd, 0x06, 0x11, 0xe5, 0xb6, 0x33,
+	0xf3, 0x7d, 0xdf, 0xcc, 0xec, 0xcc, 0x00, 0x63, 0x8e, 0xb8, 0xeb, 0x85, 0x2e, 0x8e, 0x2d, 0xf1,
+	0x22, 0x14, 0x59, 0x64, 0xc4, 0x10, 0x3d, 0x43, 0xd4, 0x1a, 0xb9, 0x51, 0x44, 0xb8, 0x39, 0xa1,
+	0x84, 0x13, 0xb8, 0x73, 0x8d, 0x34, 0x33, 0xa4, 0x99, 0x21, 0x5b, 0x8d, 0x80, 0x04, 0x44, 0xe0,
+	0xac, 0xe4, 0x25, 0x29, 0xad, 0xee, 0x26, 0xf1, 0xec, 0x21, 0xb1, 0x9d, 0xef, 0x45, 0x50, 0xee,
+	0x89, 0x7c, 0x70, 0x1f, 0xdc, 0x91, 0x99, 0x1d, 0xec, 0xa3, 0x98, 0xe3, 0xcf, 0x18, 0x51, 0x2d,
+	0xdf, 0x56, 0x8c, 0xaa, 0xad, 0xca, 0xc0, 0xe0, 0xda, 0x0f, 0x77, 0x01, 0x38, 0x23, 0x1c, 0x51,
+	0x27, 0xc2, 0x8c, 0x6b, 0x85, 0x76, 0xc1, 0xa8, 0xda, 0x55, 0xe1, 0x39, 0xc2, 0x8c, 0xc3, 0x67,
+	0xa0, 0x94, 0x18, 0x4c, 0x2b, 0xb6, 0x0b, 0xc6, 0xf6, 0xe1, 0x03, 0x73, 0x43, 0x17, 0xe6, 0x7b,
+	0xc2, 0xd1, 0xc9, 0x6c, 0x82, 0x6c, 0xc9, 0x81, 0xa7, 0x40, 0x95, 0x31, 0x97, 0x63, 0x12, 0x3b,
+	0x7c, 0x36, 0x41, 0x5a, 0xa9, 0xad, 0x18, 0xdb, 0x87, 0x07, 0x1b, 0x75, 0xde, 0xdc, 0x90, 0x84,
+	0x5c, 0x9d, 0xfc, 0xeb, 0x80, 0x43, 0x90, 0x36, 0xe2, 0xf0, 0x90, 0x22, 0x16, 0x92, 0xc8, 0xd7,
+	0xca, 0x49, 0x83, 0xbd, 0xfb, 0x17, 0x57, 0x7b, 0xb9, 0xdf, 0x57, 0x7b, 0x3b, 0x1e, 0x61, 0x63,
+	0xc2, 0x98, 0xff, 0xc5, 0xc4, 0xc4, 0x1a, 0xbb, 0x3c, 0x34, 0x8f, 0x50, 0xe0, 0x7a, 0xb3, 0x17,
+	0xc8, 0xb3, 0xeb, 0x92, 0x7c, 0x92, 0x71, 0xe1, 0x10, 0x6c, 0xa5, 0x7a, 0x8c, 0xbb, 0x7c, 0xca,
+	0xb4, 0x5b, 0xa2, 0xca, 0x47, 0x1b, 0xab, 0x94, 0xbf, 0x7d, 0x2c, 0x08, 0x76, 0x6d, 0xb4, 0x62,
+	0xc1, 0xa7, 0xa0, 0x99, 0xea, 0x79, 0x14, 0xc9, 0xe6, 0x43, 0x84, 0x83, 0x90, 0x6b, 0x95, 0xb6,
+	0x62, 0x14, 0xec, 0x86, 0x8c, 0x3e, 0x4f, 0x83, 0xaf, 0x44, 0x0c, 0xf6, 0xc0, 0x96, 0x1c, 0xc5,
+	0x57, 0x61, 0x33, 0xad, 0x9a, 0x4c, 0xa3, 0xb7, 0x9b, 0xb6, 0x74, 0xef, 0xff, 0x96, 0x06, 0x31,
+	0xb7, 0x6b, 0x82, 0x73, 0x2a, 0x29, 0xaf, 0x8b, 0x15, 0x45, 0xcd, 0xdb, 0x25, 0x1c, 0xfb, 0xe8,
+	0xbc, 0xf3, 0x09, 0xdc, 0x95, 0x45, 0x26, 0xa3, 0xec, 0x13, 0x9a, 0xe6, 0x69, 0x82, 0x72, 0x5a,
+	0x8d, 0x22, 0xaa, 0x49, 0x2d, 0x78, 0x00, 0xa0, 0xac, 0x8b, 0x39, 0x82, 0x2f, 0x57, 0x22, 0x2f,
+	0x56, 0x22, 0xfd, 0x6f, 0x36, 0x48, 0x02, 0x89, 0x5c, 0xf7, 0x1d, 0xa8, 0x64, 0xf3, 0x86, 0x4d,
+	0x00, 0x8f, 0xa7, 0x9e, 0x87, 0x18, 0x5b, 0x19, 0x9d, 0x9a, 0x4b, 0xfc, 0x7d, 0x17, 0x47, 0x53,
+	0x8a, 0x56, 0xfd, 0x0a, 0xac, 0x83, 0xdb, 0x43, 0xc2, 0x3f, 0x20, 0x9e, 0x28, 0xf8, 0x6a, 0xbe,
+	0x55, 0xfc, 0xf9, 0x43, 0x57, 0xba, 0x73, 0x50, 0x5b, 0xfd, 0x54, 0xf8, 0x10, 0x74, 0xa4, 0xdd,
+	0xc7, 0xb1, 0x1b, 0xe1, 0x39, 0xf2, 0x9d, 0xb5, 0x69, 0xd6, 0xe0, 0xd6, 0xa6, 0x6d, 0x00, 0x55,
+	0xe2, 0x06, 0xf1, 0x5b, 0x4a, 0x02, 0x8a, 0x18, 0xcb, 0x72, 0xf7, 0x5e, 0x5e, 0x2c, 0x74, 0xe5,
+	0x72, 0xa1, 0x2b, 0x7f, 0x16, 0xba, 0xf2, 0x6d, 0xa9, 0xe7, 0x2e, 0x97, 0x7a, 0xee, 0xd7, 0x52,
+	0xcf, 0x7d, 0xdc, 0x0f, 0x30, 0x0f, 0xa7, 0x23, 0xd3, 0x23, 0x63, 0x71, 0x86, 0x8f, 0xe5, 0x45,
+	0xc6, 0xc4, 0x47, 0xd6, 0xf9, 0xcd, 0x3d, 0x26, 0xdb, 0xcd, 0x46, 0x65, 0x71, 0x8d, 0x4f, 0xfe,
+	0x06, 0x00, 0x00, 0xff, 0xff, 0x2e, 0x3a, 0x72, 0xe7, 0x18, 0x04, 0x00, 0x00,
 }
 
 func (m *Ballot) Marshal() (dAtA []byte, err error) {
@@ -289,6 +295,20 @@ func (m *Ballot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VoterWeights) > 0 {
+		for iNdEx := len(m.VoterWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.VoterWeights[iNdEx].Size()
+				i -= size
+				if _, err := m.VoterWeights[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintBallot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.BallotCreationHeight != 0 {
 		i = encodeVarintBallot(dAtA, i, uint64(m.BallotCreationHeight))
 		i--
@@ -433,6 +453,12 @@ func (m *Ballot) Size() (n int) {
 	if m.BallotCreationHeight != 0 {
 		n += 1 + sovBallot(uint64(m.BallotCreationHeight))
 	}
+	if len(m.VoterWeights) > 0 {
+		for _, e := range m.VoterWeights {
+			l = e.Size()
+			n += 1 + l + sovBallot(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -713,6 +739,42 @@ func (m *Ballot) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterWeights", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBallot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBallot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBallot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.VoterWeights = append(m.VoterWeights, v)
+			if err := m.VoterWeights[len(m.VoterWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBallot(dAtA[iNdEx:])
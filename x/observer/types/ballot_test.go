@@ -1,6 +1,7 @@
 package types
 
 import (
+	"math/rand"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
@@ -414,6 +415,158 @@ func TestBallot_IsFinalizingVote(t *testing.T) {
 	}
 }
 
+func TestBallot_IsFinalizingVote_Weighted(t *testing.T) {
+	t.Run("should finalize once the voted weight reaches the threshold", func(t *testing.T) {
+		ballot := Ballot{
+			BallotStatus:    BallotStatus_BallotInProgress,
+			BallotThreshold: sdkmath.LegacyMustNewDecFromStr("0.66"),
+			VoterList:       []string{"Observer1", "Observer2", "Observer3"},
+			Votes:           CreateVotes(3),
+			VoterWeights:    []sdkmath.Int{sdkmath.NewInt(10), sdkmath.NewInt(20), sdkmath.NewInt(70)},
+		}
+
+		// two of three observers voted but only 30% of the weight
+		ballot.Votes[0] = VoteType_SuccessObservation
+		ballot.Votes[1] = VoteType_SuccessObservation
+		ballot, isFinalizingVote := ballot.IsFinalizingVote()
+		require.False(t, isFinalizingVote)
+
+		ballot.Votes[2] = VoteType_SuccessObservation
+		ballot, isFinalizingVote = ballot.IsFinalizingVote()
+		require.True(t, isFinalizingVote)
+		require.Equal(t, BallotStatus_BallotFinalized_SuccessObservation, ballot.BallotStatus)
+	})
+
+	t.Run("should finalize with the vote of an observer holding most of the weight", func(t *testing.T) {
+		ballot := Ballot{
+			BallotStatus:    BallotStatus_BallotInProgress,
+			BallotThreshold: sdkmath.LegacyMustNewDecFromStr("0.66"),
+			VoterList:       []string{"Observer1", "Observer2", "Observer3"},
+			Votes:           CreateVotes(3),
+			VoterWeights:    []sdkmath.Int{sdkmath.NewInt(10), sdkmath.NewInt(20), sdkmath.NewInt(70)},
+		}
+
+		ballot.Votes[2] = VoteType_FailureObservation
+		ballot, isFinalizingVote := ballot.IsFinalizingVote()
+		require.True(t, isFinalizingVote)
+		require.Equal(t, BallotStatus_BallotFinalized_FailureObservation, ballot.BallotStatus)
+	})
+
+	t.Run("should not finalize if the total weight is zero", func(t *testing.T) {
+		ballot := Ballot{
+			BallotStatus:    BallotStatus_BallotInProgress,
+			BallotThreshold: sdkmath.LegacyMustNewDecFromStr("0.66"),
+			VoterList:       []string{"Observer1"},
+			Votes:           []VoteType{VoteType_SuccessObservation},
+			VoterWeights:    []sdkmath.Int{sdkmath.ZeroInt()},
+		}
+
+		_, isFinalizingVote := ballot.IsFinalizingVote()
+		require.False(t, isFinalizingVote)
+	})
+}
+
+func TestBallot_GetVoterWeight(t *testing.T) {
+	t.Run("should count each vote as one if not weighted", func(t *testing.T) {
+		ballot := Ballot{VoterList: []string{"Observer1", "Observer2"}}
+		require.False(t, ballot.IsWeighted())
+		require.Equal(t, sdkmath.OneInt(), ballot.GetVoterWeight(0))
+		require.Equal(t, sdkmath.NewInt(2), ballot.GetTotalWeight())
+		require.Equal(t, int64(1), ballot.GetVoterRewardUnits(1))
+	})
+
+	t.Run("should return the weights of a weighted ballot", func(t *testing.T) {
+		ballot := Ballot{
+			VoterList:    []string{"Observer1", "Observer2"},
+			VoterWeights: []sdkmath.Int{sdkmath.NewInt(25), sdkmath.NewInt(75)},
+		}
+		require.True(t, ballot.IsWeighted())
+		require.Equal(t, sdkmath.NewInt(75), ballot.GetVoterWeight(1))
+		require.Equal(t, sdkmath.ZeroInt(), ballot.GetVoterWeight(2))
+		require.Equal(t, sdkmath.NewInt(100), ballot.GetTotalWeight())
+		require.Equal(t, int64(0), ballot.GetVoterRewardUnits(0))
+		require.Equal(t, int64(2), ballot.GetVoterRewardUnits(1))
+	})
+}
+
+func TestCapVoterWeights(t *testing.T) {
+	t.Run("should return nil if total stake is zero", func(t *testing.T) {
+		require.Nil(t, CapVoterWeights([]sdkmath.Int{sdkmath.ZeroInt(), sdkmath.ZeroInt()}, 50))
+	})
+
+	t.Run("should return the stakes if no cap", func(t *testing.T) {
+		stakes := []sdkmath.Int{sdkmath.NewInt(10), sdkmath.NewInt(90)}
+		require.Equal(t, stakes, CapVoterWeights(stakes, 0))
+		require.Equal(t, stakes, CapVoterWeights(stakes, 100))
+	})
+
+	t.Run("should cap the weights at the percentage of the total weight", func(t *testing.T) {
+		stakes := []sdkmath.Int{sdkmath.NewInt(10), sdkmath.NewInt(20), sdkmath.NewInt(70)}
+		require.Equal(
+			t,
+			[]sdkmath.Int{sdkmath.NewInt(10), sdkmath.NewInt(20), sdkmath.NewInt(20)},
+			CapVoterWeights(stakes, 40),
+		)
+	})
+
+	t.Run("should cap several voters at the percentage of the total weight", func(t *testing.T) {
+		stakes := []sdkmath.Int{sdkmath.NewInt(90), sdkmath.NewInt(5), sdkmath.NewInt(5)}
+		require.Equal(
+			t,
+			[]sdkmath.Int{sdkmath.NewInt(10), sdkmath.NewInt(5), sdkmath.NewInt(5)},
+			CapVoterWeights(stakes, 50),
+		)
+
+		stakes = []sdkmath.Int{sdkmath.NewInt(50), sdkmath.NewInt(40), sdkmath.NewInt(6), sdkmath.NewInt(4)}
+		require.Equal(
+			t,
+			[]sdkmath.Int{sdkmath.NewInt(9), sdkmath.NewInt(9), sdkmath.NewInt(6), sdkmath.NewInt(4)},
+			CapVoterWeights(stakes, 33),
+		)
+	})
+
+	t.Run("should return nil if the cap can't be met", func(t *testing.T) {
+		// two voters can't have less than 50% each
+		require.Nil(t, CapVoterWeights([]sdkmath.Int{sdkmath.NewInt(90), sdkmath.NewInt(10)}, 40))
+		// a single voter with stake
+		require.Nil(t, CapVoterWeights([]sdkmath.Int{sdkmath.NewInt(90), sdkmath.ZeroInt(), sdkmath.ZeroInt()}, 50))
+	})
+
+	t.Run("no voter should exceed the cap of the total weight", func(t *testing.T) {
+		r := rand.New(rand.NewSource(42))
+		for i := 0; i < 1000; i++ {
+			stakes := make([]sdkmath.Int, 1+r.Intn(10))
+			for j := range stakes {
+				stakes[j] = sdkmath.NewInt(r.Int63n(1_000_000))
+			}
+			maxWeightPercentage := uint64(1 + r.Intn(99))
+
+			weights := CapVoterWeights(stakes, maxWeightPercentage)
+			if weights == nil {
+				continue
+			}
+
+			total := sdkmath.ZeroInt()
+			for j, weight := range weights {
+				require.True(t, weight.LTE(stakes[j]))
+				total = total.Add(weight)
+			}
+			require.True(t, total.IsPositive())
+			for _, weight := range weights {
+				// weight / total <= maxWeightPercentage / 100
+				require.True(
+					t,
+					weight.MulRaw(100).LTE(total.MulRaw(int64(maxWeightPercentage))),
+					"stakes %v, cap %d%%, weights %v",
+					stakes,
+					maxWeightPercentage,
+					weights,
+				)
+			}
+		}
+	})
+}
+
 func Test_BuildRewardsDistribution(t *testing.T) {
 	tt := []struct {
 		name        string
@@ -728,6 +881,33 @@ func Test_BuildRewardsDistribution(t *testing.T) {
 				"Observer2": 1,
 				"Observer3": 1,
 			},
+		},
+		{
+			name: "weighted ballot",
+			ballotList: []Ballot{
+				{
+					VoterList: []string{"Observer1", "Observer2", "Observer3", "Observer4"},
+					Votes: []VoteType{
+						VoteType_SuccessObservation,
+						VoteType_SuccessObservation,
+						VoteType_FailureObservation,
+						VoteType_SuccessObservation,
+					},
+					VoterWeights: []sdkmath.Int{
+						sdkmath.NewInt(10),
+						sdkmath.NewInt(20),
+						sdkmath.NewInt(30),
+						sdkmath.NewInt(40),
+					},
+					BallotStatus: BallotStatus_BallotFinalized_SuccessObservation,
+				},
+			},
+			expectedMap: map[string]int64{
+				"Observer1": 0,
+				"Observer2": 1,
+				"Observer3": -1,
+				"Observer4": 2,
+			},
 		}}
 	for _, test := range tt {
 		t.Run(test.name, func(t *testing.T) {
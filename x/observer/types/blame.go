@@ -0,0 +1,84 @@
+package types
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// MaxBlameStatsWindow is the maximum number of blocks blame statistics can be aggregated over.
+// Blame events older than this are pruned.
+const MaxBlameStatsWindow int64 = 432000 // approximately 10 days
+
+// NewBlameEvent creates a blame event for a blame finalized at the height
+func NewBlameEvent(chainID int64, blame Blame, height int64) BlameEvent {
+	pubKeys := make([]string, 0, len(blame.Nodes))
+	for _, node := range blame.Nodes {
+		if node.PubKey != "" && !slices.Contains(pubKeys, node.PubKey) {
+			pubKeys = append(pubKeys, node.PubKey)
+		}
+	}
+
+	return BlameEvent{
+		BlameIndex:    blame.Index,
+		ChainId:       chainID,
+		FailureReason: blame.FailureReason,
+		PubKeys:       pubKeys,
+		Height:        height,
+	}
+}
+
+// AggregateBlameStats aggregates blame events per node pubkey.
+// The result is sorted by total blames (descending), then by pubkey.
+func AggregateBlameStats(events []BlameEvent) []NodeBlameStats {
+	type countKey struct {
+		chainID int64
+		reason  string
+	}
+
+	var (
+		stats  = make(map[string]*NodeBlameStats)
+		counts = make(map[string]map[countKey]uint64)
+	)
+
+	for _, event := range events {
+		for _, pubKey := range event.PubKeys {
+			if _, ok := stats[pubKey]; !ok {
+				stats[pubKey] = &NodeBlameStats{PubKey: pubKey}
+				counts[pubKey] = make(map[countKey]uint64)
+			}
+
+			stats[pubKey].Total++
+			counts[pubKey][countKey{event.ChainId, event.FailureReason}]++
+		}
+	}
+
+	result := make([]NodeBlameStats, 0, len(stats))
+	for pubKey, s := range stats {
+		for key, count := range counts[pubKey] {
+			s.Counts = append(s.Counts, BlameCount{
+				ChainId:       key.chainID,
+				FailureReason: key.reason,
+				Count:         count,
+			})
+		}
+
+		slices.SortFunc(s.Counts, func(a, b BlameCount) int {
+			return cmp.Or(
+				cmp.Compare(a.ChainId, b.ChainId),
+				strings.Compare(a.FailureReason, b.FailureReason),
+			)
+		})
+
+		result = append(result, *s)
+	}
+
+	slices.SortFunc(result, func(a, b NodeBlameStats) int {
+		return cmp.Or(
+			cmp.Compare(b.Total, a.Total),
+			strings.Compare(a.PubKey, b.PubKey),
+		)
+	})
+
+	return result
+}
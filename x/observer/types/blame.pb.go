@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -142,9 +143,221 @@ func (m *Blame) GetNodes() []*Node {
 	return nil
 }
 
+// BlameEvent is a finalized blame indexed by the height it was finalized at,
+// it's used to aggregate blame statistics over a window of blocks
+type BlameEvent struct {
+	BlameIndex    string   `protobuf:"bytes,1,opt,name=blame_index,json=blameIndex,proto3" json:"blame_index,omitempty"`
+	ChainId       int64    `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	FailureReason string   `protobuf:"bytes,3,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	PubKeys       []string `protobuf:"bytes,4,rep,name=pub_keys,json=pubKeys,proto3" json:"pub_keys,omitempty"`
+	Height        int64    `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *BlameEvent) Reset()         { *m = BlameEvent{} }
+func (m *BlameEvent) String() string { return proto.CompactTextString(m) }
+func (*BlameEvent) ProtoMessage()    {}
+func (*BlameEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43b03381a0168e22, []int{2}
+}
+func (m *BlameEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlameEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlameEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlameEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlameEvent.Merge(m, src)
+}
+func (m *BlameEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlameEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlameEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlameEvent proto.InternalMessageInfo
+
+func (m *BlameEvent) GetBlameIndex() string {
+	if m != nil {
+		return m.BlameIndex
+	}
+	return ""
+}
+
+func (m *BlameEvent) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *BlameEvent) GetFailureReason() string {
+	if m != nil {
+		return m.FailureReason
+	}
+	return ""
+}
+
+func (m *BlameEvent) GetPubKeys() []string {
+	if m != nil {
+		return m.PubKeys
+	}
+	return nil
+}
+
+func (m *BlameEvent) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// BlameCount is the number of blames of a node for a chain and failure reason
+type BlameCount struct {
+	ChainId       int64  `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	FailureReason string `protobuf:"bytes,2,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	Count         uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *BlameCount) Reset()         { *m = BlameCount{} }
+func (m *BlameCount) String() string { return proto.CompactTextString(m) }
+func (*BlameCount) ProtoMessage()    {}
+func (*BlameCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43b03381a0168e22, []int{3}
+}
+func (m *BlameCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlameCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlameCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlameCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlameCount.Merge(m, src)
+}
+func (m *BlameCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlameCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlameCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlameCount proto.InternalMessageInfo
+
+func (m *BlameCount) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *BlameCount) GetFailureReason() string {
+	if m != nil {
+		return m.FailureReason
+	}
+	return ""
+}
+
+func (m *BlameCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// NodeBlameStats aggregates the blames of a node over a window of blocks
+type NodeBlameStats struct {
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// operator of the node account with this pubkey, empty if there is none
+	Operator string       `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Total    uint64       `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Counts   []BlameCount `protobuf:"bytes,4,rep,name=counts,proto3" json:"counts"`
+}
+
+func (m *NodeBlameStats) Reset()         { *m = NodeBlameStats{} }
+func (m *NodeBlameStats) String() string { return proto.CompactTextString(m) }
+func (*NodeBlameStats) ProtoMessage()    {}
+func (*NodeBlameStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43b03381a0168e22, []int{4}
+}
+func (m *NodeBlameStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeBlameStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeBlameStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NodeBlameStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeBlameStats.Merge(m, src)
+}
+func (m *NodeBlameStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeBlameStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeBlameStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeBlameStats proto.InternalMessageInfo
+
+func (m *NodeBlameStats) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *NodeBlameStats) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *NodeBlameStats) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *NodeBlameStats) GetCounts() []BlameCount {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Node)(nil), "zetachain.zetacore.observer.Node")
 	proto.RegisterType((*Blame)(nil), "zetachain.zetacore.observer.Blame")
+	proto.RegisterType((*BlameEvent)(nil), "zetachain.zetacore.observer.BlameEvent")
+	proto.RegisterType((*BlameCount)(nil), "zetachain.zetacore.observer.BlameCount")
+	proto.RegisterType((*NodeBlameStats)(nil), "zetachain.zetacore.observer.NodeBlameStats")
 }
 
 func init() {
@@ -152,26 +365,37 @@ func init() {
 }
 
 var fileDescriptor_43b03381a0168e22 = []byte{
-	// 296 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0x41, 0x4b, 0xc3, 0x30,
-	0x18, 0x86, 0x17, 0x67, 0x27, 0x8d, 0x3a, 0x21, 0x08, 0x16, 0xc5, 0x50, 0x07, 0xb2, 0xa2, 0x98,
-	0x82, 0x1e, 0xbc, 0x0f, 0x3d, 0x09, 0x1e, 0xea, 0xcd, 0x4b, 0x49, 0xd6, 0xcf, 0xae, 0xb8, 0x35,
-	0x25, 0x4d, 0x65, 0x15, 0xfc, 0x0f, 0xfe, 0x2c, 0x8f, 0x3b, 0x7a, 0x94, 0xf6, 0x8f, 0x48, 0xd3,
-	0x55, 0x6f, 0xbb, 0x7d, 0x79, 0xf2, 0xe6, 0x7b, 0xc9, 0x83, 0xc7, 0xef, 0xa0, 0xf9, 0x74, 0xc6,
-	0x93, 0xd4, 0x37, 0x93, 0x54, 0xe0, 0x4b, 0x91, 0x83, 0x7a, 0x03, 0xe5, 0x8b, 0x39, 0x5f, 0x00,
-	0xcb, 0x94, 0xd4, 0x92, 0x9c, 0xfc, 0x05, 0x59, 0x17, 0x64, 0x5d, 0xf0, 0xf8, 0x62, 0xd3, 0x96,
-	0x6e, 0x68, 0x17, 0x8d, 0x62, 0xbc, 0xfd, 0x28, 0x23, 0x20, 0x47, 0x78, 0x27, 0x2b, 0x44, 0xf8,
-	0x0a, 0xa5, 0x83, 0x5c, 0xe4, 0xd9, 0xc1, 0x20, 0x2b, 0xc4, 0x03, 0x94, 0xe4, 0x14, 0x63, 0x53,
-	0x1c, 0x46, 0x5c, 0x73, 0x67, 0xcb, 0x45, 0xde, 0x5e, 0x60, 0x1b, 0x72, 0xc7, 0x35, 0x27, 0x63,
-	0x7c, 0xd0, 0x5e, 0xe7, 0x49, 0x9c, 0x72, 0x5d, 0x28, 0x70, 0xfa, 0x26, 0x33, 0x34, 0xf8, 0xa9,
-	0xa3, 0xa3, 0x0f, 0x6c, 0x4d, 0x1a, 0x42, 0x0e, 0xb1, 0x95, 0xa4, 0x11, 0x2c, 0xd7, 0x3d, 0xed,
-	0x81, 0x9c, 0xe3, 0xe1, 0x0b, 0x4f, 0xe6, 0x85, 0x82, 0x50, 0x01, 0xcf, 0x65, 0x6a, 0xaa, 0xec,
-	0x60, 0x7f, 0x4d, 0x03, 0x03, 0xc9, 0x2d, 0xb6, 0x52, 0x19, 0x41, 0xee, 0xf4, 0xdd, 0xbe, 0xb7,
-	0x7b, 0x7d, 0xc6, 0x36, 0x78, 0x60, 0xcd, 0xc7, 0x82, 0x36, 0x3f, 0xb9, 0xff, 0xaa, 0x28, 0x5a,
-	0x55, 0x14, 0xfd, 0x54, 0x14, 0x7d, 0xd6, 0xb4, 0xb7, 0xaa, 0x69, 0xef, 0xbb, 0xa6, 0xbd, 0xe7,
-	0xcb, 0x38, 0xd1, 0xb3, 0x42, 0xb0, 0xa9, 0x5c, 0x18, 0x5d, 0x57, 0xad, 0xb9, 0xe6, 0x99, 0xbf,
-	0xfc, 0xf7, 0xa6, 0xcb, 0x0c, 0x72, 0x31, 0x30, 0xd6, 0x6e, 0x7e, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0xe4, 0x3c, 0xf5, 0x08, 0xa9, 0x01, 0x00, 0x00,
+	// 467 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x8d, 0x71, 0x9c, 0x8f, 0x09, 0x04, 0x69, 0x15, 0x81, 0x1b, 0x84, 0x1b, 0x22, 0xa1, 0x44,
+	0x20, 0x6c, 0xa9, 0x1c, 0xb8, 0x07, 0x72, 0xa8, 0x90, 0x38, 0x6c, 0x6f, 0x5c, 0xa2, 0x75, 0x3c,
+	0x38, 0x16, 0xa9, 0xd7, 0x5a, 0xaf, 0xab, 0x06, 0x89, 0xff, 0xc0, 0x6f, 0xe0, 0xd7, 0xf4, 0xd8,
+	0x23, 0x27, 0x84, 0x92, 0x3f, 0x82, 0x3c, 0x6b, 0x37, 0x20, 0x85, 0x70, 0xdb, 0x19, 0x3f, 0xbf,
+	0xf7, 0xe6, 0xcd, 0xc0, 0xe4, 0x0b, 0x6a, 0xb1, 0x5c, 0x89, 0x24, 0x0d, 0xe8, 0x25, 0x15, 0x06,
+	0x32, 0xcc, 0x51, 0x5d, 0xa1, 0x0a, 0xc2, 0xb5, 0xb8, 0x44, 0x3f, 0x53, 0x52, 0x4b, 0xf6, 0xe4,
+	0x0e, 0xe8, 0xd7, 0x40, 0xbf, 0x06, 0x0e, 0x07, 0xb1, 0x8c, 0x25, 0xe1, 0x82, 0xf2, 0x65, 0x7e,
+	0x19, 0xbe, 0x38, 0xc6, 0x5d, 0x3f, 0x0c, 0x76, 0x1c, 0x43, 0xf3, 0x83, 0x8c, 0x90, 0x3d, 0x86,
+	0x76, 0x56, 0x84, 0x8b, 0xcf, 0xb8, 0x71, 0xad, 0x91, 0x35, 0xed, 0xf2, 0x56, 0x56, 0x84, 0xef,
+	0x71, 0xc3, 0x9e, 0x02, 0x90, 0x9d, 0x45, 0x24, 0xb4, 0x70, 0xef, 0x8d, 0xac, 0xe9, 0x7d, 0xde,
+	0xa5, 0xce, 0x3b, 0xa1, 0x05, 0x9b, 0xc0, 0x43, 0xf3, 0x39, 0x4f, 0xe2, 0x54, 0xe8, 0x42, 0xa1,
+	0x6b, 0x13, 0xa6, 0x4f, 0xed, 0x8b, 0xba, 0x3b, 0xfe, 0x0a, 0xce, 0xac, 0xec, 0xb0, 0x01, 0x38,
+	0x49, 0x1a, 0xe1, 0x75, 0xa5, 0x63, 0x0a, 0xf6, 0x1c, 0xfa, 0x9f, 0x44, 0xb2, 0x2e, 0x14, 0x2e,
+	0x14, 0x8a, 0x5c, 0xa6, 0x24, 0xd5, 0xe5, 0x0f, 0xaa, 0x2e, 0xa7, 0x26, 0x7b, 0x03, 0x4e, 0x2a,
+	0x23, 0xcc, 0x5d, 0x7b, 0x64, 0x4f, 0x7b, 0x67, 0xcf, 0xfc, 0x23, 0xe9, 0xf8, 0xe5, 0x60, 0xdc,
+	0xe0, 0xc7, 0xdf, 0x2d, 0x00, 0xd2, 0x9f, 0x5f, 0x61, 0xaa, 0xd9, 0x29, 0xf4, 0x8c, 0xed, 0x3f,
+	0xad, 0x98, 0x41, 0xcf, 0xc9, 0xcf, 0x09, 0x74, 0x88, 0x76, 0x91, 0x44, 0xe4, 0xc4, 0xe6, 0x6d,
+	0xaa, 0xcf, 0xa3, 0x03, 0x56, 0xed, 0x43, 0x56, 0x4f, 0xa0, 0x53, 0x25, 0x9a, 0xbb, 0xcd, 0x91,
+	0x3d, 0xed, 0xf2, 0xb6, 0x89, 0x34, 0x67, 0x8f, 0xa0, 0xb5, 0xc2, 0x24, 0x5e, 0x69, 0xd7, 0x21,
+	0xea, 0xaa, 0x1a, 0x47, 0x95, 0xc7, 0xb7, 0xb2, 0x48, 0xf5, 0x5f, 0x16, 0xac, 0xff, 0x59, 0x38,
+	0x98, 0xd6, 0x00, 0x9c, 0x65, 0x49, 0x45, 0x06, 0x9b, 0xdc, 0x14, 0x65, 0x14, 0xfd, 0x32, 0x1a,
+	0x92, 0xba, 0xd0, 0x42, 0xe7, 0xff, 0xde, 0xfe, 0x10, 0x3a, 0x32, 0x43, 0x25, 0xb4, 0x54, 0x95,
+	0xc4, 0x5d, 0x5d, 0xb2, 0x6b, 0xa9, 0xc5, 0xba, 0x66, 0xa7, 0x82, 0xcd, 0xa1, 0x45, 0x32, 0x66,
+	0xe8, 0xde, 0xd9, 0xe4, 0xe8, 0x8a, 0xf6, 0xe3, 0xce, 0x9a, 0x37, 0x3f, 0x4f, 0x1b, 0xbc, 0xfa,
+	0x79, 0x36, 0xbf, 0xd9, 0x7a, 0xd6, 0xed, 0xd6, 0xb3, 0x7e, 0x6d, 0x3d, 0xeb, 0xdb, 0xce, 0x6b,
+	0xdc, 0xee, 0xbc, 0xc6, 0x8f, 0x9d, 0xd7, 0xf8, 0xf8, 0x32, 0x4e, 0xf4, 0xaa, 0x08, 0xfd, 0xa5,
+	0xbc, 0xa4, 0xf3, 0x7e, 0x65, 0x2e, 0xbd, 0x5c, 0x73, 0x70, 0xbd, 0xbf, 0x73, 0xbd, 0xc9, 0x30,
+	0x0f, 0x5b, 0x74, 0xe5, 0xaf, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xec, 0x9b, 0x6c, 0xe4, 0x6f,
+	0x03, 0x00, 0x00,
 }
 
 func (m *Node) Marshal() (dAtA []byte, err error) {
@@ -269,6 +493,158 @@ func (m *Blame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BlameEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlameEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlameEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintBlame(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PubKeys) > 0 {
+		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PubKeys[iNdEx])
+			copy(dAtA[i:], m.PubKeys[iNdEx])
+			i = encodeVarintBlame(dAtA, i, uint64(len(m.PubKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FailureReason) > 0 {
+		i -= len(m.FailureReason)
+		copy(dAtA[i:], m.FailureReason)
+		i = encodeVarintBlame(dAtA, i, uint64(len(m.FailureReason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintBlame(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BlameIndex) > 0 {
+		i -= len(m.BlameIndex)
+		copy(dAtA[i:], m.BlameIndex)
+		i = encodeVarintBlame(dAtA, i, uint64(len(m.BlameIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlameCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlameCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlameCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintBlame(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FailureReason) > 0 {
+		i -= len(m.FailureReason)
+		copy(dAtA[i:], m.FailureReason)
+		i = encodeVarintBlame(dAtA, i, uint64(len(m.FailureReason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintBlame(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeBlameStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeBlameStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeBlameStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Counts) > 0 {
+		for iNdEx := len(m.Counts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Counts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBlame(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Total != 0 {
+		i = encodeVarintBlame(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintBlame(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintBlame(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBlame(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBlame(v)
 	base := offset
@@ -321,16 +697,388 @@ func (m *Blame) Size() (n int) {
 			n += 1 + l + sovBlame(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *BlameEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlameIndex)
+	if l > 0 {
+		n += 1 + l + sovBlame(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovBlame(uint64(m.ChainId))
+	}
+	l = len(m.FailureReason)
+	if l > 0 {
+		n += 1 + l + sovBlame(uint64(l))
+	}
+	if len(m.PubKeys) > 0 {
+		for _, s := range m.PubKeys {
+			l = len(s)
+			n += 1 + l + sovBlame(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovBlame(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *BlameCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovBlame(uint64(m.ChainId))
+	}
+	l = len(m.FailureReason)
+	if l > 0 {
+		n += 1 + l + sovBlame(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovBlame(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *NodeBlameStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovBlame(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovBlame(uint64(l))
+	}
+	if m.Total != 0 {
+		n += 1 + sovBlame(uint64(m.Total))
+	}
+	if len(m.Counts) > 0 {
+		for _, e := range m.Counts {
+			l = e.Size()
+			n += 1 + l + sovBlame(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovBlame(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBlame(x uint64) (n int) {
+	return sovBlame(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Node) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlame
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Node: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Node: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlame
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlameData = append(m.BlameData[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlameData == nil {
+				m.BlameData = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlame
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlameSignature = append(m.BlameSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlameSignature == nil {
+				m.BlameSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlame(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlame
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Blame) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlame
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Blame: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Blame: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlame
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &Node{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlame(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlame
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovBlame(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBlame(x uint64) (n int) {
-	return sovBlame(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Node) Unmarshal(dAtA []byte) error {
+func (m *BlameEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -353,15 +1101,15 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Node: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlameEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Node: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlameEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameIndex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -389,13 +1137,32 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = string(dAtA[iNdEx:postIndex])
+			m.BlameIndex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlameData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBlame
@@ -405,31 +1172,29 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBlame
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBlame
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlameData = append(m.BlameData[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlameData == nil {
-				m.BlameData = []byte{}
-			}
+			m.FailureReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlameSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBlame
@@ -439,26 +1204,163 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBlame
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBlame
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlameSignature = append(m.BlameSignature[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlameSignature == nil {
-				m.BlameSignature = []byte{}
+			m.PubKeys = append(m.PubKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlame(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlame
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlameCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlame
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlameCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlameCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.FailureReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBlame(dAtA[iNdEx:])
@@ -480,7 +1382,7 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Blame) Unmarshal(dAtA []byte) error {
+func (m *NodeBlameStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -503,15 +1405,15 @@ func (m *Blame) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Blame: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeBlameStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Blame: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeBlameStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -539,11 +1441,11 @@ func (m *Blame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.PubKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -571,11 +1473,30 @@ func (m *Blame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailureReason = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -602,8 +1523,8 @@ func (m *Blame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, &Node{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Counts = append(m.Counts, BlameCount{})
+			if err := m.Counts[len(m.Counts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,77 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestNewBlameEvent(t *testing.T) {
+	t.Run("should create blame event with unique pubkeys", func(t *testing.T) {
+		blame := types.Blame{
+			Index:         "1-2-digest-3",
+			FailureReason: "Tss timeout",
+			Nodes: []*types.Node{
+				{PubKey: "pk1"},
+				{PubKey: "pk2"},
+				{PubKey: "pk1"},
+				{PubKey: ""},
+			},
+		}
+
+		event := types.NewBlameEvent(1, blame, 100)
+
+		require.Equal(t, types.BlameEvent{
+			BlameIndex:    "1-2-digest-3",
+			ChainId:       1,
+			FailureReason: "Tss timeout",
+			PubKeys:       []string{"pk1", "pk2"},
+			Height:        100,
+		}, event)
+	})
+}
+
+func TestAggregateBlameStats(t *testing.T) {
+	t.Run("should return empty stats for no events", func(t *testing.T) {
+		require.Empty(t, types.AggregateBlameStats(nil))
+	})
+
+	t.Run("should aggregate blame events per node, chain and failure reason", func(t *testing.T) {
+		events := []types.BlameEvent{
+			{ChainId: 1, FailureReason: "Tss timeout", PubKeys: []string{"pk1", "pk2"}},
+			{ChainId: 1, FailureReason: "Tss timeout", PubKeys: []string{"pk1"}},
+			{ChainId: 2, FailureReason: "hash check failed", PubKeys: []string{"pk1", "pk3"}},
+			{ChainId: 1, FailureReason: "hash check failed", PubKeys: []string{"pk3"}},
+		}
+
+		stats := types.AggregateBlameStats(events)
+
+		require.Equal(t, []types.NodeBlameStats{
+			{
+				PubKey: "pk1",
+				Total:  3,
+				Counts: []types.BlameCount{
+					{ChainId: 1, FailureReason: "Tss timeout", Count: 2},
+					{ChainId: 2, FailureReason: "hash check failed", Count: 1},
+				},
+			},
+			{
+				PubKey: "pk3",
+				Total:  2,
+				Counts: []types.BlameCount{
+					{ChainId: 1, FailureReason: "hash check failed", Count: 1},
+					{ChainId: 2, FailureReason: "hash check failed", Count: 1},
+				},
+			},
+			{
+				PubKey: "pk2",
+				Total:  1,
+				Counts: []types.BlameCount{
+					{ChainId: 1, FailureReason: "Tss timeout", Count: 1},
+				},
+			},
+		}, stats)
+	})
+}
@@ -2,9 +2,11 @@ package types
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	ethchains "github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 
@@ -160,9 +162,65 @@ func (cp ChainParams) Validate() error {
 			cp.StabilityPoolPercentage,
 		)
 	}
+
+	if cp.MaxVoterWeightPercentage > 100 {
+		return errors.Wrapf(
+			ErrParamsMaxVoterWeightPercentage,
+			"max voter weight percentage must be in range [0,100], got: %d",
+			cp.MaxVoterWeightPercentage,
+		)
+	}
+
+	for i, observer := range cp.Observers {
+		if _, err := sdk.AccAddressFromBech32(observer); err != nil {
+			return fmt.Errorf("invalid observer address %s", observer)
+		}
+		if slices.Contains(cp.Observers[:i], observer) {
+			return fmt.Errorf("duplicated observer %s", observer)
+		}
+	}
+
+	// ed25519 signatures are only verified natively by the Solana and TON gateways
+	// Sui is out of scope: its outbounds are always signed with the ECDSA TSS key
+	if _, ok := SignatureScheme_name[int32(cp.SignatureScheme)]; !ok {
+		return fmt.Errorf("invalid SignatureScheme %d", cp.SignatureScheme)
+	}
+	if cp.SignatureScheme == SignatureScheme_EDDSA &&
+		!chains.IsSolanaChain(cp.ChainId, nil) &&
+		!chains.IsTONChain(cp.ChainId, nil) {
+		return fmt.Errorf("SignatureScheme %s not supported for chain %d", cp.SignatureScheme, cp.ChainId)
+	}
 	return nil
 }
 
+// IsObserverEligible returns true if the observer is eligible to vote on the ballots of the chain
+// All the observers are eligible if the chain does not define a subset of observers
+func (cp ChainParams) IsObserverEligible(observer string) bool {
+	return len(cp.Observers) == 0 || slices.Contains(cp.Observers, observer)
+}
+
+// FilterEligibleObservers returns the observers eligible to vote on the ballots of the chain
+func (cp ChainParams) FilterEligibleObservers(observers []string) []string {
+	return slices.DeleteFunc(slices.Clone(observers), func(observer string) bool {
+		return !cp.IsObserverEligible(observer)
+	})
+}
+
+// ReplaceObserver replaces the observer in the subset of observers of the chain, the observer is removed if newObserver is empty
+// Returns true if the subset has been updated, all the observers become eligible if the last observer of the subset is removed
+func (cp *ChainParams) ReplaceObserver(observer, newObserver string) bool {
+	i := slices.Index(cp.Observers, observer)
+	if i < 0 {
+		return false
+	}
+	if newObserver == "" || slices.Contains(cp.Observers, newObserver) {
+		cp.Observers = slices.Delete(cp.Observers, i, i+1)
+	} else {
+		cp.Observers[i] = newObserver
+	}
+	return true
+}
+
 // IsInboundFastConfirmationEnabled returns true if fast inbound confirmation is enabled.
 func (cp ChainParams) IsInboundFastConfirmationEnabled() bool {
 	return cp.ConfirmationParams.FastInboundCount > 0 &&
@@ -488,7 +546,11 @@ func ChainParamsEqual(params1, params2 ChainParams) bool {
 		params1.GatewayAddress == params2.GatewayAddress &&
 		confirmationParamsEqual(params1.ConfirmationParams, params2.ConfirmationParams) &&
 		params1.DisableTssBlockScan == params2.DisableTssBlockScan &&
-		params1.GasPriceMultiplier.Equal(params2.GasPriceMultiplier)
+		params1.GasPriceMultiplier.Equal(params2.GasPriceMultiplier) &&
+		params1.SignatureScheme == params2.SignatureScheme &&
+		params1.StakeWeightedVoting == params2.StakeWeightedVoting &&
+		params1.MaxVoterWeightPercentage == params2.MaxVoterWeightPercentage &&
+		slices.Equal(params1.Observers, params2.Observers)
 }
 
 // confirmationParamsEqual returns true if two confirmation params are equal
@@ -24,6 +24,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SignatureScheme is the TSS key signing the outbounds of a chain
+type SignatureScheme int32
+
+const (
+	// secp256k1 key, the signature is verified by the gateway
+	SignatureScheme_ECDSA SignatureScheme = 0
+	// ed25519 key, the signature is verified natively by the chain
+	SignatureScheme_EDDSA SignatureScheme = 1
+)
+
+var SignatureScheme_name = map[int32]string{
+	0: "ECDSA",
+	1: "EDDSA",
+}
+
+var SignatureScheme_value = map[string]int32{
+	"ECDSA": 0,
+	"EDDSA": 1,
+}
+
+func (x SignatureScheme) String() string {
+	return proto.EnumName(SignatureScheme_name, int32(x))
+}
+
+func (SignatureScheme) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_19623205e7def05d, []int{0}
+}
+
 type ChainParamsList struct {
 	ChainParams []*ChainParams `protobuf:"bytes,1,rep,name=chain_params,json=chainParams,proto3" json:"chain_params,omitempty"`
 }
@@ -93,6 +121,24 @@ type ChainParams struct {
 	// Percentage of unused tokens for outbounds that are are sent to the
 	// stability pool. The value should be between 0 and 100.
 	StabilityPoolPercentage uint64 `protobuf:"varint,21,opt,name=stability_pool_percentage,json=stabilityPoolPercentage,proto3" json:"stability_pool_percentage,omitempty"`
+	// The TSS key signing the outbounds, EDDSA requires a gateway variant
+	// verifying ed25519 signatures and is only supported on Solana and TON
+	SignatureScheme SignatureScheme `protobuf:"varint,22,opt,name=signature_scheme,json=signatureScheme,proto3,enum=zetachain.zetacore.observer.SignatureScheme" json:"signature_scheme,omitempty"`
+	// Weight the votes of the observers on the ballots of the chain by their
+	// bonded self delegation instead of counting one vote per observer
+	StakeWeightedVoting bool `protobuf:"varint,23,opt,name=stake_weighted_voting,json=stakeWeightedVoting,proto3" json:"stake_weighted_voting,omitempty"`
+	// Maximum weight of an observer as a percentage of the total weight of the
+	// ballot voters when stake weighted voting is enabled, 0 means no cap.
+	// The value should be between 0 and 100.
+	MaxVoterWeightPercentage uint64 `protobuf:"varint,24,opt,name=max_voter_weight_percentage,json=maxVoterWeightPercentage,proto3" json:"max_voter_weight_percentage,omitempty"`
+	// Observers eligible to vote on the ballots of the chain, the ballot
+	// threshold is computed over this subset of the observer set.
+	// All the observers of the observer set are eligible if empty.
+	// The observers are removed from the subset when they leave the observer set.
+	// The other observers skip the observation of the chain but still sign its
+	// outbounds (TSS keysign) and track them, so they still need an RPC
+	// endpoint for the chain.
+	Observers []string `protobuf:"bytes,25,rep,name=observers,proto3" json:"observers,omitempty"`
 }
 
 func (m *ChainParams) Reset()         { *m = ChainParams{} }
@@ -233,7 +279,36 @@ func (m *ChainParams) GetStabilityPoolPercentage() uint64 {
 	return 0
 }
 
+func (m *ChainParams) GetSignatureScheme() SignatureScheme {
+	if m != nil {
+		return m.SignatureScheme
+	}
+	return SignatureScheme_ECDSA
+}
+
+func (m *ChainParams) GetStakeWeightedVoting() bool {
+	if m != nil {
+		return m.StakeWeightedVoting
+	}
+	return false
+}
+
+func (m *ChainParams) GetMaxVoterWeightPercentage() uint64 {
+	if m != nil {
+		return m.MaxVoterWeightPercentage
+	}
+	return 0
+}
+
+func (m *ChainParams) GetObservers() []string {
+	if m != nil {
+		return m.Observers
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("zetachain.zetacore.observer.SignatureScheme", SignatureScheme_name, SignatureScheme_value)
 	proto.RegisterType((*ChainParamsList)(nil), "zetachain.zetacore.observer.ChainParamsList")
 	proto.RegisterType((*ChainParams)(nil), "zetachain.zetacore.observer.ChainParams")
 }
@@ -243,52 +318,60 @@ func init() {
 }
 
 var fileDescriptor_19623205e7def05d = []byte{
-	// 719 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x6f, 0xe3, 0x44,
-	0x18, 0xc6, 0xe3, 0x6d, 0x81, 0xec, 0xa4, 0x9b, 0x64, 0xa7, 0x5d, 0xd6, 0x6d, 0xa4, 0x6c, 0x58,
-	0x84, 0xb0, 0x40, 0x38, 0xa8, 0x2b, 0x2e, 0x08, 0x90, 0x68, 0x96, 0xc3, 0x42, 0x81, 0x28, 0xcd,
-	0x5e, 0x40, 0x62, 0x18, 0x8f, 0x07, 0x7b, 0x14, 0x7b, 0x5e, 0x6b, 0x66, 0xdc, 0x36, 0x7c, 0x0a,
-	0x3e, 0x56, 0x25, 0x2e, 0x3d, 0x22, 0x0e, 0x15, 0x6a, 0xbf, 0x08, 0xf2, 0xf8, 0x4f, 0xd3, 0xa6,
-	0xaa, 0x7a, 0xb3, 0x9f, 0xf7, 0xf7, 0x3c, 0x7e, 0x3d, 0x33, 0xef, 0x20, 0xff, 0x4f, 0x6e, 0x28,
-	0x8b, 0xa9, 0x90, 0x63, 0xfb, 0x04, 0x8a, 0x8f, 0x21, 0xd0, 0x5c, 0x1d, 0x73, 0x35, 0xb6, 0x3a,
-	0xc9, 0xa8, 0xa2, 0xa9, 0xf6, 0x33, 0x05, 0x06, 0xf0, 0xa0, 0xe1, 0xfd, 0x9a, 0xf7, 0x6b, 0x7e,
-	0x6f, 0x27, 0x82, 0x08, 0x2c, 0x37, 0x2e, 0x9e, 0x4a, 0xcb, 0xde, 0x17, 0xf7, 0x7e, 0x02, 0xe4,
-	0x1f, 0x42, 0xa5, 0xd4, 0x08, 0xb8, 0xf9, 0xa5, 0x97, 0xbf, 0xa1, 0xde, 0xa4, 0x30, 0x4d, 0xad,
-	0x78, 0x28, 0xb4, 0xc1, 0x3f, 0xa0, 0xad, 0xd5, 0x96, 0x5c, 0x67, 0xb4, 0xe1, 0x75, 0xf6, 0x3d,
-	0xff, 0x9e, 0x9e, 0xfc, 0x95, 0x8c, 0x59, 0x87, 0x5d, 0xbf, 0xbc, 0xfc, 0xbb, 0x8d, 0x3a, 0x2b,
-	0x45, 0xbc, 0x8b, 0xda, 0x65, 0xb8, 0x08, 0xdd, 0xce, 0xc8, 0xf1, 0x36, 0x66, 0xef, 0xd9, 0xf7,
-	0x37, 0x21, 0xf6, 0x50, 0x3f, 0xa2, 0x9a, 0x64, 0x4a, 0x30, 0x4e, 0x8c, 0x60, 0x0b, 0xae, 0xdc,
-	0x47, 0x23, 0xc7, 0xdb, 0x9c, 0x75, 0x23, 0xaa, 0xa7, 0x85, 0x3c, 0xb7, 0x2a, 0xfe, 0x08, 0x75,
-	0x85, 0x0c, 0x20, 0x97, 0x61, 0xcd, 0x6d, 0x58, 0xee, 0x49, 0xa5, 0x56, 0xd8, 0xc7, 0xa8, 0x07,
-	0xb9, 0xb9, 0xc1, 0x6d, 0x96, 0x79, 0xb5, 0x5c, 0x81, 0x9f, 0xa0, 0xa7, 0x27, 0xd4, 0xb0, 0x98,
-	0xe4, 0xe6, 0x14, 0x6a, 0xf4, 0x1d, 0x8b, 0xf6, 0x6c, 0xe1, 0xad, 0x39, 0x85, 0x8a, 0xfd, 0x1a,
-	0xd9, 0xcd, 0x21, 0x06, 0x16, 0x5c, 0x12, 0x06, 0xd2, 0x28, 0xca, 0x0c, 0xa1, 0x61, 0xa8, 0xb8,
-	0xd6, 0x6e, 0x7b, 0xe4, 0x78, 0x8f, 0x67, 0x6e, 0x81, 0xcc, 0x0b, 0x62, 0x52, 0x01, 0xdf, 0x96,
-	0x75, 0xfc, 0x15, 0xda, 0x63, 0x20, 0x25, 0x67, 0x06, 0xd4, 0xba, 0xfb, 0x71, 0xe9, 0x6e, 0x88,
-	0xdb, 0xee, 0x09, 0x1a, 0x72, 0xc5, 0xf6, 0x3f, 0x27, 0x2c, 0xd7, 0x06, 0xc2, 0xe5, 0x7a, 0x02,
-	0xb2, 0x09, 0x03, 0x4b, 0x4d, 0x4a, 0xe8, 0x8e, 0x16, 0x9a, 0x65, 0xd1, 0x2c, 0xe6, 0x61, 0x9e,
-	0x70, 0x22, 0xa4, 0xe1, 0xea, 0x98, 0x26, 0xee, 0x96, 0xdd, 0x14, 0xb7, 0x26, 0x8e, 0x2a, 0xe0,
-	0x4d, 0x55, 0xc7, 0xdf, 0xa0, 0xc1, 0xba, 0x3b, 0x01, 0x58, 0xd0, 0x98, 0xd3, 0xd0, 0x7d, 0x62,
-	0xed, 0xbb, 0xb7, 0xed, 0x87, 0x35, 0x80, 0x7f, 0x42, 0xfd, 0x80, 0x26, 0x09, 0x18, 0x62, 0x62,
-	0xc5, 0x75, 0x0c, 0x49, 0xe8, 0x76, 0x8b, 0xa6, 0x0f, 0x3e, 0x3c, 0xbb, 0x78, 0xd1, 0xfa, 0xf7,
-	0xe2, 0xc5, 0x80, 0x81, 0x4e, 0x41, 0xeb, 0x70, 0xe1, 0x0b, 0x18, 0xa7, 0xd4, 0xc4, 0xfe, 0x21,
-	0x8f, 0x28, 0x5b, 0xbe, 0xe6, 0x6c, 0xd6, 0x2b, 0xcd, 0xf3, 0xda, 0x8b, 0x7f, 0x45, 0xcf, 0x53,
-	0x21, 0x49, 0x7d, 0x12, 0x49, 0xc8, 0x13, 0x1e, 0xd9, 0x83, 0xee, 0xf6, 0x1e, 0x1e, 0xfb, 0x2c,
-	0x15, 0xf2, 0xe7, 0x2a, 0xe2, 0x75, 0x93, 0x80, 0x3f, 0x40, 0x5b, 0x42, 0x13, 0x9d, 0x67, 0x19,
-	0x28, 0xc3, 0x43, 0xb7, 0x3f, 0x72, 0xbc, 0xf6, 0xac, 0x23, 0xf4, 0x51, 0x2d, 0x15, 0x87, 0x2c,
-	0xa2, 0x86, 0x9f, 0xd0, 0x65, 0xb3, 0x07, 0x4f, 0xed, 0x1e, 0x74, 0x2b, 0xb9, 0x5e, 0xf6, 0xdf,
-	0xd1, 0xf6, 0x1d, 0x63, 0xe8, 0xe2, 0x91, 0xe3, 0x75, 0xf6, 0xc7, 0xf7, 0x4f, 0xd7, 0x8a, 0xaf,
-	0x1a, 0x32, 0xcc, 0xd6, 0x34, 0xfc, 0x0a, 0xbd, 0x1f, 0x0a, 0x4d, 0x83, 0x84, 0x13, 0xa3, 0x35,
-	0x09, 0x12, 0x60, 0x0b, 0xa2, 0x19, 0x95, 0xee, 0xb6, 0xed, 0x7b, 0xbb, 0xaa, 0xce, 0xb5, 0x3e,
-	0x28, 0x6a, 0x47, 0x8c, 0x4a, 0xfc, 0x16, 0xed, 0x5c, 0x4f, 0x5d, 0x9a, 0x27, 0x46, 0x64, 0x89,
-	0xe0, 0xca, 0xdd, 0x79, 0xf8, 0xe2, 0xe1, 0x7a, 0x3c, 0x7f, 0x6c, 0xec, 0xf8, 0x4b, 0xb4, 0xab,
-	0x0d, 0x0d, 0x44, 0x22, 0xcc, 0x92, 0x64, 0x00, 0x09, 0xc9, 0xb8, 0x62, 0x5c, 0x1a, 0x1a, 0x71,
-	0xf7, 0x99, 0x1d, 0xad, 0xe7, 0x0d, 0x30, 0x05, 0x48, 0xa6, 0x4d, 0xf9, 0xfb, 0xcd, 0xb6, 0xd3,
-	0x7f, 0x74, 0xf3, 0x0f, 0x09, 0x83, 0x5c, 0x9a, 0x83, 0xef, 0xce, 0x2e, 0x87, 0xce, 0xf9, 0xe5,
-	0xd0, 0xf9, 0xef, 0x72, 0xe8, 0xfc, 0x75, 0x35, 0x6c, 0x9d, 0x5f, 0x0d, 0x5b, 0xff, 0x5c, 0x0d,
-	0x5b, 0xbf, 0x7c, 0x1a, 0x09, 0x13, 0xe7, 0x81, 0xcf, 0x20, 0xb5, 0xf7, 0xdf, 0x67, 0xe5, 0x55,
-	0x28, 0x21, 0xe4, 0xe3, 0xd3, 0xeb, 0x8b, 0xd0, 0x2c, 0x33, 0xae, 0x83, 0x77, 0xed, 0xdd, 0xf7,
-	0xea, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x79, 0x74, 0xf5, 0xcd, 0x97, 0x05, 0x00, 0x00,
+	// 841 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xd1, 0x6e, 0xdb, 0x36,
+	0x14, 0x86, 0xad, 0x26, 0xdd, 0x62, 0x3a, 0xb5, 0x5d, 0x26, 0x69, 0x98, 0x64, 0x70, 0xbd, 0x0e,
+	0x43, 0x85, 0x6e, 0xb3, 0x07, 0x17, 0xbb, 0x19, 0xb6, 0x01, 0x8d, 0xd3, 0x8b, 0x6e, 0xd9, 0x16,
+	0xd8, 0x69, 0x0b, 0x6c, 0xc0, 0x38, 0x9a, 0xe2, 0x24, 0xc2, 0x12, 0x8f, 0x40, 0x52, 0x89, 0xbd,
+	0xa7, 0xd8, 0xcb, 0xec, 0x1d, 0x7a, 0xd9, 0xcb, 0x61, 0x17, 0xc5, 0x90, 0xbc, 0xc8, 0x20, 0x4a,
+	0x72, 0x9c, 0xa4, 0x08, 0x72, 0x47, 0xfd, 0xe7, 0xfb, 0x8f, 0x0e, 0x79, 0x78, 0x40, 0xd4, 0xfb,
+	0x53, 0x58, 0xc6, 0x23, 0x26, 0x55, 0xdf, 0xad, 0x40, 0x8b, 0x3e, 0x4c, 0x8c, 0xd0, 0x27, 0x42,
+	0xf7, 0x9d, 0x4e, 0x53, 0xa6, 0x59, 0x62, 0x7a, 0xa9, 0x06, 0x0b, 0x78, 0x6f, 0xc1, 0xf7, 0x2a,
+	0xbe, 0x57, 0xf1, 0xbb, 0x9b, 0x21, 0x84, 0xe0, 0xb8, 0x7e, 0xbe, 0x2a, 0x2c, 0xbb, 0x5f, 0xdd,
+	0xf8, 0x0b, 0x50, 0x7f, 0x48, 0x9d, 0x30, 0x2b, 0xe1, 0xf2, 0x9f, 0x1e, 0xfd, 0x86, 0x5a, 0xc3,
+	0xdc, 0x74, 0xe4, 0xc4, 0x43, 0x69, 0x2c, 0xfe, 0x01, 0xad, 0x2f, 0x97, 0x44, 0xbc, 0xee, 0x8a,
+	0xdf, 0x18, 0xf8, 0xbd, 0x1b, 0x6a, 0xea, 0x2d, 0xe5, 0x18, 0x35, 0xf8, 0xc5, 0xc7, 0xa3, 0xbf,
+	0x11, 0x6a, 0x2c, 0x05, 0xf1, 0x0e, 0x5a, 0x2b, 0x92, 0xcb, 0x80, 0x34, 0xba, 0x9e, 0xbf, 0x32,
+	0xfa, 0xd0, 0x7d, 0xbf, 0x08, 0xb0, 0x8f, 0xda, 0x21, 0x33, 0x34, 0xd5, 0x92, 0x0b, 0x6a, 0x25,
+	0x9f, 0x0a, 0x4d, 0xee, 0x74, 0x3d, 0x7f, 0x75, 0xd4, 0x0c, 0x99, 0x39, 0xca, 0xe5, 0x63, 0xa7,
+	0xe2, 0x4f, 0x51, 0x53, 0xaa, 0x09, 0x64, 0x2a, 0xa8, 0xb8, 0x15, 0xc7, 0xdd, 0x2b, 0xd5, 0x12,
+	0x7b, 0x8c, 0x5a, 0x90, 0xd9, 0x4b, 0xdc, 0x6a, 0x91, 0xaf, 0x92, 0x4b, 0xf0, 0x09, 0xba, 0x7f,
+	0xca, 0x2c, 0x8f, 0x68, 0x66, 0x67, 0x50, 0xa1, 0x77, 0x1d, 0xda, 0x72, 0x81, 0x97, 0x76, 0x06,
+	0x25, 0xfb, 0x2d, 0x72, 0xcd, 0xa1, 0x16, 0xa6, 0x42, 0x51, 0x0e, 0xca, 0x6a, 0xc6, 0x2d, 0x65,
+	0x41, 0xa0, 0x85, 0x31, 0x64, 0xad, 0xeb, 0xf9, 0xf5, 0x11, 0xc9, 0x91, 0xe3, 0x9c, 0x18, 0x96,
+	0xc0, 0xb3, 0x22, 0x8e, 0xbf, 0x41, 0xbb, 0x1c, 0x94, 0x12, 0xdc, 0x82, 0xbe, 0xee, 0xae, 0x17,
+	0xee, 0x05, 0x71, 0xd5, 0x3d, 0x44, 0x1d, 0xa1, 0xf9, 0xe0, 0x4b, 0xca, 0x33, 0x63, 0x21, 0x98,
+	0x5f, 0xcf, 0x80, 0x5c, 0x86, 0x3d, 0x47, 0x0d, 0x0b, 0xe8, 0x3d, 0x25, 0x2c, 0x8e, 0xc5, 0xf0,
+	0x48, 0x04, 0x59, 0x2c, 0xa8, 0x54, 0x56, 0xe8, 0x13, 0x16, 0x93, 0x75, 0xd7, 0x14, 0x52, 0x11,
+	0xe3, 0x12, 0x78, 0x51, 0xc6, 0xf1, 0x77, 0x68, 0xef, 0xba, 0x3b, 0x06, 0x98, 0xb2, 0x48, 0xb0,
+	0x80, 0xdc, 0x73, 0xf6, 0x9d, 0xab, 0xf6, 0xc3, 0x0a, 0xc0, 0x3f, 0xa1, 0xf6, 0x84, 0xc5, 0x31,
+	0x58, 0x6a, 0x23, 0x2d, 0x4c, 0x04, 0x71, 0x40, 0x9a, 0x79, 0xd1, 0xfb, 0x9f, 0xbc, 0x79, 0xf7,
+	0xb0, 0xf6, 0xef, 0xbb, 0x87, 0x7b, 0x1c, 0x4c, 0x02, 0xc6, 0x04, 0xd3, 0x9e, 0x84, 0x7e, 0xc2,
+	0x6c, 0xd4, 0x3b, 0x14, 0x21, 0xe3, 0xf3, 0x03, 0xc1, 0x47, 0xad, 0xc2, 0x7c, 0x5c, 0x79, 0xf1,
+	0xaf, 0x68, 0x3b, 0x91, 0x8a, 0x56, 0x37, 0x91, 0x06, 0x22, 0x16, 0xa1, 0xbb, 0xe8, 0xa4, 0x75,
+	0xfb, 0xb4, 0x5b, 0x89, 0x54, 0x3f, 0x97, 0x29, 0x0e, 0x16, 0x19, 0xf0, 0xc7, 0x68, 0x5d, 0x1a,
+	0x6a, 0xb2, 0x34, 0x05, 0x6d, 0x45, 0x40, 0xda, 0x5d, 0xcf, 0x5f, 0x1b, 0x35, 0xa4, 0x19, 0x57,
+	0x52, 0x7e, 0xc9, 0x42, 0x66, 0xc5, 0x29, 0x9b, 0x2f, 0x7a, 0x70, 0xdf, 0xf5, 0xa0, 0x59, 0xca,
+	0xd5, 0xb1, 0xff, 0x8e, 0x36, 0xde, 0x33, 0x86, 0x04, 0x77, 0x3d, 0xbf, 0x31, 0xe8, 0xdf, 0x3c,
+	0x5d, 0x4b, 0xbe, 0x72, 0xc8, 0x30, 0xbf, 0xa6, 0xe1, 0xa7, 0xe8, 0x41, 0x20, 0x0d, 0x9b, 0xc4,
+	0x82, 0x5a, 0x63, 0xe8, 0x24, 0x06, 0x3e, 0xa5, 0x86, 0x33, 0x45, 0x36, 0x5c, 0xdd, 0x1b, 0x65,
+	0xf4, 0xd8, 0x98, 0xfd, 0x3c, 0x36, 0xe6, 0x4c, 0xe1, 0x97, 0x68, 0xf3, 0x62, 0xea, 0x92, 0x2c,
+	0xb6, 0x32, 0x8d, 0xa5, 0xd0, 0x64, 0xf3, 0xf6, 0x87, 0x87, 0xab, 0xf1, 0xfc, 0x71, 0x61, 0xc7,
+	0x5f, 0xa3, 0x1d, 0x63, 0xd9, 0x44, 0xc6, 0xd2, 0xce, 0x69, 0x0a, 0x10, 0xd3, 0x54, 0x68, 0x2e,
+	0x94, 0x65, 0xa1, 0x20, 0x5b, 0x6e, 0xb4, 0xb6, 0x17, 0xc0, 0x11, 0x40, 0x7c, 0xb4, 0x08, 0xe3,
+	0xd7, 0xa8, 0x6d, 0x64, 0xa8, 0x98, 0xcd, 0xb4, 0x70, 0x77, 0x2c, 0x11, 0xe4, 0x41, 0xd7, 0xf3,
+	0x9b, 0x83, 0xcf, 0x6f, 0x3c, 0xa6, 0x71, 0x65, 0x1a, 0x3b, 0xcf, 0xa8, 0x65, 0x2e, 0x0b, 0x78,
+	0x80, 0xb6, 0x8c, 0x65, 0x53, 0x41, 0x4f, 0x85, 0x0c, 0x23, 0x2b, 0x02, 0x7a, 0x02, 0x56, 0xaa,
+	0x90, 0x6c, 0x17, 0xe7, 0xe3, 0x82, 0xaf, 0xcb, 0xd8, 0x2b, 0x17, 0xca, 0xe7, 0x3d, 0x61, 0xb3,
+	0x1c, 0x14, 0xba, 0xf4, 0x2d, 0x6f, 0x85, 0xb8, 0xad, 0x90, 0x84, 0xcd, 0x5e, 0xe5, 0x44, 0x61,
+	0x5e, 0xda, 0xcb, 0x47, 0xa8, 0x5e, 0xd5, 0x67, 0xc8, 0x4e, 0x77, 0xc5, 0xaf, 0x8f, 0x2e, 0x84,
+	0xef, 0x57, 0xd7, 0xbc, 0xf6, 0x9d, 0xcb, 0xbd, 0xa4, 0x1c, 0x32, 0x65, 0x9f, 0x3c, 0x46, 0xad,
+	0x2b, 0xdb, 0xc1, 0x75, 0x74, 0xf7, 0xf9, 0xf0, 0x60, 0xfc, 0xac, 0x5d, 0x73, 0xcb, 0x83, 0x7c,
+	0xe9, 0xed, 0x3f, 0x7f, 0x73, 0xd6, 0xf1, 0xde, 0x9e, 0x75, 0xbc, 0xff, 0xce, 0x3a, 0xde, 0x5f,
+	0xe7, 0x9d, 0xda, 0xdb, 0xf3, 0x4e, 0xed, 0x9f, 0xf3, 0x4e, 0xed, 0x97, 0xcf, 0x42, 0x69, 0xa3,
+	0x6c, 0xd2, 0xe3, 0x90, 0xb8, 0x27, 0xe1, 0x8b, 0xe2, 0x75, 0x50, 0x10, 0x88, 0xfe, 0xec, 0xe2,
+	0x6d, 0xb0, 0xf3, 0x54, 0x98, 0xc9, 0x07, 0xee, 0x39, 0x78, 0xfa, 0x7f, 0x00, 0x00, 0x00, 0xff,
+	0xff, 0x7b, 0x0d, 0x8f, 0x50, 0xaa, 0x06, 0x00, 0x00,
 }
 
 func (m *ChainParamsList) Marshal() (dAtA []byte, err error) {
@@ -348,6 +431,43 @@ func (m *ChainParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Observers) > 0 {
+		for iNdEx := len(m.Observers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Observers[iNdEx])
+			copy(dAtA[i:], m.Observers[iNdEx])
+			i = encodeVarintChainParams(dAtA, i, uint64(len(m.Observers[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if m.MaxVoterWeightPercentage != 0 {
+		i = encodeVarintChainParams(dAtA, i, uint64(m.MaxVoterWeightPercentage))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.StakeWeightedVoting {
+		i--
+		if m.StakeWeightedVoting {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.SignatureScheme != 0 {
+		i = encodeVarintChainParams(dAtA, i, uint64(m.SignatureScheme))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
 	if m.StabilityPoolPercentage != 0 {
 		i = encodeVarintChainParams(dAtA, i, uint64(m.StabilityPoolPercentage))
 		i--
@@ -581,6 +701,21 @@ func (m *ChainParams) Size() (n int) {
 	if m.StabilityPoolPercentage != 0 {
 		n += 2 + sovChainParams(uint64(m.StabilityPoolPercentage))
 	}
+	if m.SignatureScheme != 0 {
+		n += 2 + sovChainParams(uint64(m.SignatureScheme))
+	}
+	if m.StakeWeightedVoting {
+		n += 3
+	}
+	if m.MaxVoterWeightPercentage != 0 {
+		n += 2 + sovChainParams(uint64(m.MaxVoterWeightPercentage))
+	}
+	if len(m.Observers) > 0 {
+		for _, s := range m.Observers {
+			l = len(s)
+			n += 2 + l + sovChainParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1161,6 +1296,96 @@ func (m *ChainParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureScheme", wireType)
+			}
+			m.SignatureScheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignatureScheme |= SignatureScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeWeightedVoting", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StakeWeightedVoting = bool(v != 0)
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVoterWeightPercentage", wireType)
+			}
+			m.MaxVoterWeightPercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVoterWeightPercentage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Observers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChainParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChainParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChainParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Observers = append(m.Observers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChainParams(dAtA[iNdEx:])
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	. "gopkg.in/check.v1"
 
+	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/testutil/sample"
 	"github.com/zeta-chain/node/x/observer/types"
 )
@@ -108,6 +109,85 @@ func TestChainParamsList_Validate(t *testing.T) {
 		err := list.Validate()
 		require.ErrorIs(t, err, types.ErrParamsStabilityPoolPercentage)
 	})
+
+	t.Run("should return error if max voter weight percentage is greater than 100", func(t *testing.T) {
+		list := types.GetDefaultChainParams()
+		list.ChainParams[0].MaxVoterWeightPercentage = 101
+		err := list.Validate()
+		require.ErrorIs(t, err, types.ErrParamsMaxVoterWeightPercentage)
+	})
+
+	t.Run("should return error if an observer address is invalid", func(t *testing.T) {
+		list := types.GetDefaultChainParams()
+		list.ChainParams[0].Observers = []string{sample.AccAddress(), "invalid"}
+		err := list.Validate()
+		require.ErrorContains(t, err, "invalid observer address")
+	})
+
+	t.Run("should return error if an observer is duplicated", func(t *testing.T) {
+		list := types.GetDefaultChainParams()
+		observer := sample.AccAddress()
+		list.ChainParams[0].Observers = []string{observer, sample.AccAddress(), observer}
+		err := list.Validate()
+		require.ErrorContains(t, err, "duplicated observer")
+	})
+}
+
+func TestChainParams_IsObserverEligible(t *testing.T) {
+	observers := []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+
+	t.Run("all observers are eligible if no subset", func(t *testing.T) {
+		cp := types.ChainParams{}
+		require.True(t, cp.IsObserverEligible(observers[0]))
+		require.Equal(t, observers, cp.FilterEligibleObservers(observers))
+	})
+
+	t.Run("only the observers of the subset are eligible", func(t *testing.T) {
+		cp := types.ChainParams{Observers: []string{observers[2], observers[0]}}
+		require.True(t, cp.IsObserverEligible(observers[0]))
+		require.False(t, cp.IsObserverEligible(observers[1]))
+		require.Equal(t, []string{observers[0], observers[2]}, cp.FilterEligibleObservers(observers))
+		require.Len(t, observers, 3)
+	})
+}
+
+func TestChainParams_ReplaceObserver(t *testing.T) {
+	observers := []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+
+	t.Run("should not update the subset if the observer is not part of it", func(t *testing.T) {
+		cp := types.ChainParams{Observers: []string{observers[0]}}
+		require.False(t, cp.ReplaceObserver(observers[1], observers[2]))
+		require.Equal(t, []string{observers[0]}, cp.Observers)
+
+		cp = types.ChainParams{}
+		require.False(t, cp.ReplaceObserver(observers[1], ""))
+		require.Empty(t, cp.Observers)
+	})
+
+	t.Run("should replace the observer", func(t *testing.T) {
+		cp := types.ChainParams{Observers: []string{observers[0], observers[1]}}
+		require.True(t, cp.ReplaceObserver(observers[1], observers[2]))
+		require.Equal(t, []string{observers[0], observers[2]}, cp.Observers)
+	})
+
+	t.Run("should remove the observer", func(t *testing.T) {
+		cp := types.ChainParams{Observers: []string{observers[0], observers[1]}}
+		require.True(t, cp.ReplaceObserver(observers[0], ""))
+		require.Equal(t, []string{observers[1]}, cp.Observers)
+	})
+
+	t.Run("should remove the observer if the new observer is already part of the subset", func(t *testing.T) {
+		cp := types.ChainParams{Observers: []string{observers[0], observers[1]}}
+		require.True(t, cp.ReplaceObserver(observers[0], observers[1]))
+		require.Equal(t, []string{observers[1]}, cp.Observers)
+	})
+
+	t.Run("all observers are eligible once the last observer of the subset is removed", func(t *testing.T) {
+		cp := types.ChainParams{Observers: []string{observers[0]}}
+		require.True(t, cp.ReplaceObserver(observers[0], ""))
+		require.Empty(t, cp.Observers)
+		require.True(t, cp.IsObserverEligible(observers[1]))
+	})
 }
 
 type UpdateChainParamsSuite struct {
@@ -228,6 +308,26 @@ func TestChainParamsEqual(t *testing.T) {
 	cp = copyParams(params)
 	cp.GasPriceMultiplier = params.GasPriceMultiplier.Add(sdkmath.LegacySmallestDec())
 	require.False(t, types.ChainParamsEqual(*params, *cp))
+
+	// SignatureScheme matters
+	cp = copyParams(params)
+	cp.SignatureScheme = types.SignatureScheme_EDDSA
+	require.False(t, types.ChainParamsEqual(*params, *cp))
+
+	// StakeWeightedVoting matters
+	cp = copyParams(params)
+	cp.StakeWeightedVoting = !params.StakeWeightedVoting
+	require.False(t, types.ChainParamsEqual(*params, *cp))
+
+	// MaxVoterWeightPercentage matters
+	cp = copyParams(params)
+	cp.MaxVoterWeightPercentage = params.MaxVoterWeightPercentage + 1
+	require.False(t, types.ChainParamsEqual(*params, *cp))
+
+	// Observers matters
+	cp = copyParams(params)
+	cp.Observers = append(cp.Observers, sample.AccAddress())
+	require.False(t, types.ChainParamsEqual(*params, *cp))
 }
 
 func (s *UpdateChainParamsSuite) SetupTest() {
@@ -311,6 +411,27 @@ func (s *UpdateChainParamsSuite) TestBTCParamsInvalid() {
 	require.Error(s.T(), cp.Validate())
 }
 
+func (s *UpdateChainParamsSuite) TestSignatureScheme() {
+	cp := *s.evmParams
+	cp.SignatureScheme = types.SignatureScheme_EDDSA
+	require.ErrorContains(s.T(), cp.Validate(), "not supported")
+
+	cp = *s.evmParams
+	cp.SignatureScheme = types.SignatureScheme(2)
+	require.ErrorContains(s.T(), cp.Validate(), "invalid SignatureScheme")
+
+	cp = *s.evmParams
+	cp.ChainId = chains.SolanaMainnet.ChainId
+	cp.SignatureScheme = types.SignatureScheme_EDDSA
+	require.NoError(s.T(), cp.Validate())
+
+	// Sui outbounds are always signed with the ECDSA TSS key
+	cp = *s.evmParams
+	cp.ChainId = chains.SuiMainnet.ChainId
+	cp.SignatureScheme = types.SignatureScheme_EDDSA
+	require.ErrorContains(s.T(), cp.Validate(), "not supported")
+}
+
 func (s *UpdateChainParamsSuite) TestCoreContractAddresses() {
 	cp := *s.evmParams
 	cp.ZetaTokenContractAddress = "0x123"
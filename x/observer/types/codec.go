@@ -25,6 +25,11 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateOperationalFlags{}, "observer/UpdateOperationalFlags", nil)
 	cdc.RegisterConcrete(&MsgUpdateOperationalChainParams{}, "observer/UpdateOperationalChainParams", nil)
 	cdc.RegisterConcrete(&MsgUpdateV2ZetaFlows{}, "observer/UpdateV2ZetaFlows", nil)
+	cdc.RegisterConcrete(&MsgUpdateParentRevertInheritance{}, "observer/UpdateParentRevertInheritance", nil)
+	cdc.RegisterConcrete(&MsgProposeObserverSetChange{}, "observer/ProposeObserverSetChange", nil)
+	cdc.RegisterConcrete(&MsgUnjailObserver{}, "observer/UnjailObserver", nil)
+	cdc.RegisterConcrete(&MsgCancelObserverSetChange{}, "observer/CancelObserverSetChange", nil)
+	cdc.RegisterConcrete(&MsgUpdateLivenessParams{}, "observer/UpdateLivenessParams", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -46,6 +51,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateOperationalFlags{},
 		&MsgUpdateOperationalChainParams{},
 		&MsgUpdateV2ZetaFlows{},
+		&MsgUpdateParentRevertInheritance{},
+		&MsgProposeObserverSetChange{},
+		&MsgUnjailObserver{},
+		&MsgCancelObserverSetChange{},
+		&MsgUpdateLivenessParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
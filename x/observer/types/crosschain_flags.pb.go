@@ -121,6 +121,10 @@ type CrosschainFlags struct {
 	IsOutboundEnabled     bool                   `protobuf:"varint,2,opt,name=isOutboundEnabled,proto3" json:"isOutboundEnabled,omitempty"`
 	GasPriceIncreaseFlags *GasPriceIncreaseFlags `protobuf:"bytes,3,opt,name=gasPriceIncreaseFlags,proto3" json:"gasPriceIncreaseFlags,omitempty"`
 	IsV2ZetaEnabled       bool                   `protobuf:"varint,4,opt,name=isV2ZetaEnabled,proto3" json:"isV2ZetaEnabled,omitempty"`
+	// Enables a failed withdraw created by the ZEVM execution of another cctx,
+	// without revert options, to be reverted with the revert options of the
+	// parent cctx instead of refunding the calling contract
+	IsParentRevertInheritanceEnabled bool `protobuf:"varint,5,opt,name=isParentRevertInheritanceEnabled,proto3" json:"isParentRevertInheritanceEnabled,omitempty"`
 }
 
 func (m *CrosschainFlags) Reset()         { *m = CrosschainFlags{} }
@@ -184,6 +188,13 @@ func (m *CrosschainFlags) GetIsV2ZetaEnabled() bool {
 	return false
 }
 
+func (m *CrosschainFlags) GetIsParentRevertInheritanceEnabled() bool {
+	if m != nil {
+		return m.IsParentRevertInheritanceEnabled
+	}
+	return false
+}
+
 type LegacyCrosschainFlags struct {
 	IsInboundEnabled      bool                   `protobuf:"varint,1,opt,name=isInboundEnabled,proto3" json:"isInboundEnabled,omitempty"`
 	IsOutboundEnabled     bool                   `protobuf:"varint,2,opt,name=isOutboundEnabled,proto3" json:"isOutboundEnabled,omitempty"`
@@ -255,36 +266,37 @@ func init() {
 }
 
 var fileDescriptor_f617dc4ef266f323 = []byte{
-	// 451 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x53, 0x4d, 0x6f, 0x13, 0x31,
-	0x10, 0x8d, 0xd3, 0x52, 0x55, 0x8e, 0xaa, 0x16, 0x43, 0xc4, 0x52, 0xa4, 0x6d, 0x94, 0x53, 0xc4,
-	0x87, 0x17, 0x2d, 0x17, 0xce, 0x09, 0x05, 0x45, 0x2a, 0x6a, 0xb4, 0x42, 0x1c, 0x7a, 0x41, 0x5e,
-	0xef, 0xd4, 0x6b, 0x29, 0xb5, 0x23, 0xdb, 0x5b, 0x25, 0xfc, 0x0a, 0x8e, 0xfc, 0xa4, 0x1e, 0x7b,
-	0x44, 0x42, 0x02, 0x94, 0x1c, 0xf9, 0x11, 0xa0, 0x78, 0x49, 0x20, 0xc9, 0x82, 0x38, 0xf7, 0xe6,
-	0x9d, 0x37, 0x6f, 0xdf, 0xf3, 0xf3, 0x0c, 0x8e, 0xdf, 0x83, 0x63, 0x3c, 0x67, 0x52, 0x45, 0xfe,
-	0xa4, 0x0d, 0x44, 0x3a, 0xb5, 0x60, 0x2e, 0xc1, 0x44, 0xdc, 0x68, 0x6b, 0x3d, 0xf8, 0xee, 0x7c,
-	0xc8, 0x84, 0xa5, 0x23, 0xa3, 0x9d, 0x26, 0x0f, 0x96, 0x1c, 0xba, 0xe0, 0xd0, 0x05, 0xe7, 0xf0,
-	0xae, 0xd0, 0x42, 0xfb, 0xbe, 0x68, 0x7e, 0x2a, 0x29, 0x87, 0xa1, 0xd0, 0x5a, 0x0c, 0x21, 0xf2,
-	0x5f, 0x69, 0x71, 0x1e, 0x65, 0x85, 0x61, 0x4e, 0x6a, 0x55, 0xe2, 0xed, 0xef, 0x75, 0xdc, 0x7c,
-	0xc5, 0xec, 0xc0, 0x48, 0x0e, 0x7d, 0xc5, 0x0d, 0x30, 0x0b, 0x2f, 0xe7, 0x92, 0xa4, 0x85, 0x1b,
-	0x30, 0xd2, 0x3c, 0x3f, 0x01, 0x25, 0x5c, 0x1e, 0xa0, 0x16, 0xea, 0x6c, 0x25, 0x7f, 0x96, 0x48,
-	0x1f, 0xef, 0x19, 0x70, 0x66, 0xd2, 0x57, 0x0e, 0xcc, 0x25, 0x1b, 0x06, 0xf5, 0x16, 0xea, 0x34,
-	0xe2, 0xfb, 0xb4, 0xd4, 0xa4, 0x0b, 0x4d, 0xfa, 0xe2, 0x97, 0x66, 0x77, 0xf7, 0xea, 0xcb, 0x51,
-	0xed, 0xe3, 0xd7, 0x23, 0x94, 0xac, 0x32, 0xc9, 0x73, 0x7c, 0x4f, 0xac, 0xb9, 0x18, 0x80, 0xe1,
-	0xa0, 0x5c, 0xb0, 0xd5, 0x42, 0x9d, 0xbd, 0xe4, 0x6f, 0x30, 0x79, 0x8a, 0xef, 0xac, 0x43, 0xaf,
-	0xd9, 0x38, 0xd8, 0xf6, 0xac, 0x2a, 0x88, 0x74, 0xf0, 0xfe, 0x05, 0x1b, 0x0f, 0x40, 0x65, 0x52,
-	0x89, 0x1e, 0x77, 0x63, 0x1b, 0xdc, 0xf2, 0xdd, 0xeb, 0x65, 0x72, 0x8a, 0x0f, 0x56, 0x6c, 0x76,
-	0xdf, 0xf4, 0x82, 0x9d, 0xff, 0xbf, 0xe3, 0x06, 0xb9, 0xfd, 0x03, 0xe1, 0xfd, 0xde, 0xf2, 0x6d,
-	0xcb, 0x9c, 0x1f, 0xe2, 0x03, 0x69, 0xfb, 0x2a, 0xd5, 0x85, 0xca, 0x8e, 0x15, 0x4b, 0x87, 0x90,
-	0xf9, 0xb0, 0x77, 0x93, 0x8d, 0x3a, 0x79, 0x8c, 0x6f, 0x4b, 0x7b, 0x5a, 0xb8, 0x95, 0xe6, 0xba,
-	0x6f, 0xde, 0x04, 0x48, 0x8e, 0x9b, 0xa2, 0xea, 0x69, 0x7d, 0xa4, 0x8d, 0x38, 0xa6, 0xff, 0x18,
-	0x27, 0x5a, 0x39, 0x14, 0x49, 0xf5, 0x0f, 0xe7, 0x91, 0x4a, 0xfb, 0x36, 0x3e, 0x03, 0xc7, 0x16,
-	0xae, 0xb6, 0xbd, 0xab, 0xf5, 0x72, 0xfb, 0x33, 0xc2, 0xcd, 0x13, 0x10, 0x8c, 0x4f, 0x6e, 0x60,
-	0x0e, 0xdd, 0xe3, 0xab, 0x69, 0x88, 0xae, 0xa7, 0x21, 0xfa, 0x36, 0x0d, 0xd1, 0x87, 0x59, 0x58,
-	0xbb, 0x9e, 0x85, 0xb5, 0x4f, 0xb3, 0xb0, 0x76, 0xf6, 0x48, 0x48, 0x97, 0x17, 0x29, 0xe5, 0xfa,
-	0xc2, 0xef, 0xfb, 0x93, 0x72, 0xf5, 0x95, 0xce, 0x20, 0x1a, 0xff, 0x5e, 0x7c, 0x37, 0x19, 0x81,
-	0x4d, 0x77, 0xfc, 0x54, 0x3d, 0xfb, 0x19, 0x00, 0x00, 0xff, 0xff, 0x82, 0x51, 0xa4, 0xf8, 0x24,
-	0x04, 0x00, 0x00,
+	// 480 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x94, 0x4b, 0x6f, 0xd3, 0x40,
+	0x10, 0xc7, 0xe3, 0xf4, 0xa1, 0x6a, 0xab, 0xaa, 0x65, 0x21, 0xc2, 0x14, 0xc9, 0xb5, 0x72, 0x8a,
+	0x78, 0xac, 0x91, 0xb9, 0x70, 0x4e, 0x28, 0xc8, 0xa8, 0xa8, 0x91, 0x85, 0x38, 0xf4, 0x82, 0xd6,
+	0xeb, 0xe9, 0x7a, 0xa5, 0x74, 0x37, 0xda, 0x5d, 0x47, 0x09, 0x9f, 0x82, 0x23, 0x1f, 0xa9, 0x12,
+	0x97, 0x1e, 0x91, 0x90, 0x00, 0x25, 0x47, 0xbe, 0x04, 0xca, 0x1a, 0x17, 0xf2, 0xe0, 0x71, 0xe6,
+	0xb6, 0x9e, 0xff, 0xfc, 0x3c, 0xb3, 0xff, 0x1d, 0x0d, 0x8a, 0xdf, 0x82, 0xa5, 0xac, 0xa0, 0x42,
+	0x46, 0xee, 0xa4, 0x34, 0x44, 0x2a, 0x33, 0xa0, 0x47, 0xa0, 0x23, 0xa6, 0x95, 0x31, 0x4e, 0x7c,
+	0x73, 0x3e, 0xa0, 0xdc, 0x90, 0xa1, 0x56, 0x56, 0xe1, 0xbb, 0xd7, 0x0c, 0xa9, 0x19, 0x52, 0x33,
+	0x87, 0xb7, 0xb8, 0xe2, 0xca, 0xe5, 0x45, 0xf3, 0x53, 0x85, 0x1c, 0x06, 0x5c, 0x29, 0x3e, 0x80,
+	0xc8, 0x7d, 0x65, 0xe5, 0x79, 0x94, 0x97, 0x9a, 0x5a, 0xa1, 0x64, 0xa5, 0xb7, 0xbf, 0x35, 0x51,
+	0xeb, 0x39, 0x35, 0x7d, 0x2d, 0x18, 0x24, 0x92, 0x69, 0xa0, 0x06, 0x9e, 0xcd, 0x4b, 0xe2, 0x10,
+	0xed, 0xc2, 0x50, 0xb1, 0xe2, 0x04, 0x24, 0xb7, 0x85, 0xef, 0x85, 0x5e, 0x67, 0x23, 0xfd, 0x35,
+	0x84, 0x13, 0xb4, 0xa7, 0xc1, 0xea, 0x49, 0x22, 0x2d, 0xe8, 0x11, 0x1d, 0xf8, 0xcd, 0xd0, 0xeb,
+	0xec, 0xc6, 0x77, 0x48, 0x55, 0x93, 0xd4, 0x35, 0xc9, 0xd3, 0x1f, 0x35, 0xbb, 0x3b, 0x97, 0x9f,
+	0x8f, 0x1a, 0xef, 0xbf, 0x1c, 0x79, 0xe9, 0x22, 0x89, 0x9f, 0xa0, 0xdb, 0x7c, 0xa9, 0x8b, 0x3e,
+	0x68, 0x06, 0xd2, 0xfa, 0x1b, 0xa1, 0xd7, 0xd9, 0x4b, 0x7f, 0x27, 0xe3, 0x47, 0xe8, 0xe6, 0xb2,
+	0xf4, 0x92, 0x8e, 0xfd, 0x4d, 0x47, 0xad, 0x93, 0x70, 0x07, 0xed, 0x5f, 0xd0, 0x71, 0x1f, 0x64,
+	0x2e, 0x24, 0xef, 0x31, 0x3b, 0x36, 0xfe, 0x96, 0xcb, 0x5e, 0x0e, 0xe3, 0x53, 0x74, 0xb0, 0xd0,
+	0x66, 0xf7, 0x55, 0xcf, 0xdf, 0xfe, 0xf7, 0x3b, 0xae, 0xc0, 0xed, 0x0f, 0x4d, 0xb4, 0xdf, 0xbb,
+	0x7e, 0xdb, 0xca, 0xe7, 0x7b, 0xe8, 0x40, 0x98, 0x44, 0x66, 0xaa, 0x94, 0xf9, 0xb1, 0xa4, 0xd9,
+	0x00, 0x72, 0x67, 0xf6, 0x4e, 0xba, 0x12, 0xc7, 0x0f, 0xd0, 0x0d, 0x61, 0x4e, 0x4b, 0xbb, 0x90,
+	0xdc, 0x74, 0xc9, 0xab, 0x02, 0x2e, 0x50, 0x8b, 0xaf, 0x7b, 0x5a, 0x67, 0xe9, 0x6e, 0x1c, 0x93,
+	0x3f, 0x8c, 0x13, 0x59, 0x3b, 0x14, 0xe9, 0xfa, 0x1f, 0xce, 0x2d, 0x15, 0xe6, 0x75, 0x7c, 0x06,
+	0x96, 0xd6, 0x5d, 0x6d, 0xba, 0xae, 0x96, 0xc3, 0xf8, 0x05, 0x0a, 0x85, 0xe9, 0x53, 0x0d, 0xd2,
+	0xa6, 0x30, 0x02, 0x6d, 0x13, 0x59, 0x80, 0x16, 0x96, 0x4a, 0x06, 0x35, 0xba, 0xe5, 0xd0, 0xbf,
+	0xe6, 0xb5, 0x3f, 0x79, 0xa8, 0x75, 0x02, 0x9c, 0xb2, 0xc9, 0x7f, 0xe8, 0x69, 0xf7, 0xf8, 0x72,
+	0x1a, 0x78, 0x57, 0xd3, 0xc0, 0xfb, 0x3a, 0x0d, 0xbc, 0x77, 0xb3, 0xa0, 0x71, 0x35, 0x0b, 0x1a,
+	0x1f, 0x67, 0x41, 0xe3, 0xec, 0x3e, 0x17, 0xb6, 0x28, 0x33, 0xc2, 0xd4, 0x85, 0xdb, 0x1d, 0x0f,
+	0xab, 0x35, 0x22, 0x55, 0x0e, 0xd1, 0xf8, 0xe7, 0x12, 0xb1, 0x93, 0x21, 0x98, 0x6c, 0xdb, 0x4d,
+	0xe8, 0xe3, 0xef, 0x01, 0x00, 0x00, 0xff, 0xff, 0xca, 0x58, 0x57, 0x17, 0x70, 0x04, 0x00, 0x00,
 }
 
 func (m *GasPriceIncreaseFlags) Marshal() (dAtA []byte, err error) {
@@ -366,6 +378,16 @@ func (m *CrosschainFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IsParentRevertInheritanceEnabled {
+		i--
+		if m.IsParentRevertInheritanceEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.IsV2ZetaEnabled {
 		i--
 		if m.IsV2ZetaEnabled {
@@ -521,6 +543,9 @@ func (m *CrosschainFlags) Size() (n int) {
 	if m.IsV2ZetaEnabled {
 		n += 2
 	}
+	if m.IsParentRevertInheritanceEnabled {
+		n += 2
+	}
 	return n
 }
 
@@ -866,6 +891,26 @@ func (m *CrosschainFlags) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsV2ZetaEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsParentRevertInheritanceEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsParentRevertInheritanceEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCrosschainFlags(dAtA[iNdEx:])
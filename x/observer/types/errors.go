@@ -86,4 +86,31 @@ var (
 		ModuleName,
 		1145,
 		"grantee is not the registered hotkey for the observer")
+	ErrInvalidTSSPubkeyEdDSA = errorsmod.Register(
+		ModuleName,
+		1146,
+		"eddsa pubkey does not match the keygen")
+	ErrObserverSetChangeInProgress = errorsmod.Register(
+		ModuleName,
+		1147,
+		"an observer set change is in progress")
+	ErrInvalidObserverSetChange    = errorsmod.Register(ModuleName, 1148, "invalid observer set change")
+	ErrObserverSigningInfoNotFound = errorsmod.Register(
+		ModuleName,
+		1149,
+		"observer signing info not found")
+	ErrObserverNotJailed         = errorsmod.Register(ModuleName, 1150, "observer is not jailed")
+	ErrObserverJailPeriodNotOver = errorsmod.Register(
+		ModuleName,
+		1151,
+		"observer jail period is not over")
+	ErrParamsMaxVoterWeightPercentage = errorsmod.Register(
+		ModuleName,
+		1152,
+		"max voter weight percentage cannot be more than 100")
+	ErrNoObserverSetChangeInProgress = errorsmod.Register(
+		ModuleName,
+		1153,
+		"no observer set change in progress")
+	ErrInvalidLivenessParams = errorsmod.Register(ModuleName, 1154, "invalid liveness params")
 )
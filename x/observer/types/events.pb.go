@@ -407,6 +407,238 @@ func (m *EventGasPriceIncreaseFlagsUpdated) GetGasPriceIncreaseFlags() *GasPrice
 	return nil
 }
 
+type EventObserverSetChangeUpdated struct {
+	Status           string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	KeygenHeight     int64  `protobuf:"varint,2,opt,name=keygen_height,json=keygenHeight,proto3" json:"keygen_height,omitempty"`
+	ActivationHeight int64  `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	TssPubkey        string `protobuf:"bytes,4,opt,name=tss_pubkey,json=tssPubkey,proto3" json:"tss_pubkey,omitempty"`
+}
+
+func (m *EventObserverSetChangeUpdated) Reset()         { *m = EventObserverSetChangeUpdated{} }
+func (m *EventObserverSetChangeUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventObserverSetChangeUpdated) ProtoMessage()    {}
+func (*EventObserverSetChangeUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_067e682d8234d605, []int{6}
+}
+func (m *EventObserverSetChangeUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventObserverSetChangeUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventObserverSetChangeUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventObserverSetChangeUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventObserverSetChangeUpdated.Merge(m, src)
+}
+func (m *EventObserverSetChangeUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventObserverSetChangeUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventObserverSetChangeUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventObserverSetChangeUpdated proto.InternalMessageInfo
+
+func (m *EventObserverSetChangeUpdated) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *EventObserverSetChangeUpdated) GetKeygenHeight() int64 {
+	if m != nil {
+		return m.KeygenHeight
+	}
+	return 0
+}
+
+func (m *EventObserverSetChangeUpdated) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *EventObserverSetChangeUpdated) GetTssPubkey() string {
+	if m != nil {
+		return m.TssPubkey
+	}
+	return ""
+}
+
+type EventObserverLiveness struct {
+	ObserverAddress  string `protobuf:"bytes,1,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
+	BallotIdentifier string `protobuf:"bytes,2,opt,name=ballot_identifier,json=ballotIdentifier,proto3" json:"ballot_identifier,omitempty"`
+	MissedBallots    int64  `protobuf:"varint,3,opt,name=missed_ballots,json=missedBallots,proto3" json:"missed_ballots,omitempty"`
+}
+
+func (m *EventObserverLiveness) Reset()         { *m = EventObserverLiveness{} }
+func (m *EventObserverLiveness) String() string { return proto.CompactTextString(m) }
+func (*EventObserverLiveness) ProtoMessage()    {}
+func (*EventObserverLiveness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_067e682d8234d605, []int{7}
+}
+func (m *EventObserverLiveness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventObserverLiveness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventObserverLiveness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventObserverLiveness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventObserverLiveness.Merge(m, src)
+}
+func (m *EventObserverLiveness) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventObserverLiveness) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventObserverLiveness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventObserverLiveness proto.InternalMessageInfo
+
+func (m *EventObserverLiveness) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
+	}
+	return ""
+}
+
+func (m *EventObserverLiveness) GetBallotIdentifier() string {
+	if m != nil {
+		return m.BallotIdentifier
+	}
+	return ""
+}
+
+func (m *EventObserverLiveness) GetMissedBallots() int64 {
+	if m != nil {
+		return m.MissedBallots
+	}
+	return 0
+}
+
+type EventObserverJailed struct {
+	ObserverAddress string `protobuf:"bytes,1,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
+	MissedBallots   int64  `protobuf:"varint,2,opt,name=missed_ballots,json=missedBallots,proto3" json:"missed_ballots,omitempty"`
+	JailedUntil     int64  `protobuf:"varint,3,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+}
+
+func (m *EventObserverJailed) Reset()         { *m = EventObserverJailed{} }
+func (m *EventObserverJailed) String() string { return proto.CompactTextString(m) }
+func (*EventObserverJailed) ProtoMessage()    {}
+func (*EventObserverJailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_067e682d8234d605, []int{8}
+}
+func (m *EventObserverJailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventObserverJailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventObserverJailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventObserverJailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventObserverJailed.Merge(m, src)
+}
+func (m *EventObserverJailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventObserverJailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventObserverJailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventObserverJailed proto.InternalMessageInfo
+
+func (m *EventObserverJailed) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
+	}
+	return ""
+}
+
+func (m *EventObserverJailed) GetMissedBallots() int64 {
+	if m != nil {
+		return m.MissedBallots
+	}
+	return 0
+}
+
+func (m *EventObserverJailed) GetJailedUntil() int64 {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return 0
+}
+
+type EventObserverUnjailed struct {
+	ObserverAddress string `protobuf:"bytes,1,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
+}
+
+func (m *EventObserverUnjailed) Reset()         { *m = EventObserverUnjailed{} }
+func (m *EventObserverUnjailed) String() string { return proto.CompactTextString(m) }
+func (*EventObserverUnjailed) ProtoMessage()    {}
+func (*EventObserverUnjailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_067e682d8234d605, []int{9}
+}
+func (m *EventObserverUnjailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventObserverUnjailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventObserverUnjailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventObserverUnjailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventObserverUnjailed.Merge(m, src)
+}
+func (m *EventObserverUnjailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventObserverUnjailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventObserverUnjailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventObserverUnjailed proto.InternalMessageInfo
+
+func (m *EventObserverUnjailed) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventBallotCreated)(nil), "zetachain.zetacore.observer.EventBallotCreated")
 	proto.RegisterType((*EventKeygenBlockUpdated)(nil), "zetachain.zetacore.observer.EventKeygenBlockUpdated")
@@ -414,6 +646,10 @@ func init() {
 	proto.RegisterType((*EventCCTXDisabled)(nil), "zetachain.zetacore.observer.EventCCTXDisabled")
 	proto.RegisterType((*EventCCTXEnabled)(nil), "zetachain.zetacore.observer.EventCCTXEnabled")
 	proto.RegisterType((*EventGasPriceIncreaseFlagsUpdated)(nil), "zetachain.zetacore.observer.EventGasPriceIncreaseFlagsUpdated")
+	proto.RegisterType((*EventObserverSetChangeUpdated)(nil), "zetachain.zetacore.observer.EventObserverSetChangeUpdated")
+	proto.RegisterType((*EventObserverLiveness)(nil), "zetachain.zetacore.observer.EventObserverLiveness")
+	proto.RegisterType((*EventObserverJailed)(nil), "zetachain.zetacore.observer.EventObserverJailed")
+	proto.RegisterType((*EventObserverUnjailed)(nil), "zetachain.zetacore.observer.EventObserverUnjailed")
 }
 
 func init() {
@@ -421,44 +657,55 @@ func init() {
 }
 
 var fileDescriptor_067e682d8234d605 = []byte{
-	// 586 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0x41, 0x6f, 0xd3, 0x4c,
-	0x10, 0x8d, 0xdb, 0x7e, 0x9f, 0x60, 0x53, 0x68, 0x62, 0x51, 0xea, 0x06, 0xc9, 0xb4, 0x91, 0x90,
-	0x4a, 0x0b, 0x8e, 0x14, 0x4e, 0x20, 0x2e, 0x24, 0x84, 0x12, 0x81, 0x68, 0x15, 0xb5, 0x12, 0xe2,
-	0x62, 0xad, 0xed, 0xa9, 0x6d, 0xc5, 0xd9, 0x8d, 0x76, 0xd7, 0x85, 0x70, 0xe7, 0x0a, 0x5c, 0xf9,
-	0x15, 0xfc, 0x0d, 0x8e, 0x3d, 0x72, 0xe0, 0x80, 0x92, 0x3f, 0x82, 0x76, 0xd7, 0x76, 0x82, 0x12,
-	0x45, 0xb9, 0x71, 0x5b, 0xcd, 0xbc, 0xf7, 0xf6, 0xbd, 0x59, 0x7b, 0xd0, 0xc1, 0x47, 0x10, 0xd8,
-	0x8f, 0x70, 0x4c, 0x1a, 0xea, 0x44, 0x19, 0x34, 0xa8, 0xc7, 0x81, 0x5d, 0x02, 0x6b, 0xc0, 0x25,
-	0x10, 0xc1, 0x9d, 0x21, 0xa3, 0x82, 0x9a, 0x77, 0x0a, 0xa4, 0x93, 0x23, 0x9d, 0x1c, 0x59, 0xbb,
-	0x15, 0xd2, 0x90, 0x2a, 0x5c, 0x43, 0x9e, 0x34, 0xa5, 0xd6, 0x5c, 0x26, 0xee, 0x33, 0xca, 0xb9,
-	0x6a, 0xba, 0x17, 0x09, 0x0e, 0xb3, 0x6b, 0x6a, 0x87, 0xcb, 0x38, 0xf9, 0x41, 0x63, 0xeb, 0xbf,
-	0x0c, 0x64, 0x76, 0xa4, 0xc7, 0x16, 0x4e, 0x12, 0x2a, 0xda, 0x0c, 0xb0, 0x80, 0xc0, 0xdc, 0x43,
-	0x9b, 0x03, 0x1e, 0xba, 0x62, 0x34, 0x04, 0x37, 0x65, 0x89, 0x65, 0xec, 0x19, 0x07, 0xd7, 0x7b,
-	0x68, 0xc0, 0xc3, 0xb3, 0xd1, 0x10, 0xce, 0x59, 0x62, 0x1e, 0xa1, 0xaa, 0xa7, 0x28, 0x6e, 0x1c,
-	0x00, 0x11, 0xf1, 0x45, 0x0c, 0xcc, 0x5a, 0x53, 0xb0, 0x8a, 0x6e, 0x74, 0x8b, 0xba, 0x79, 0x1f,
-	0x55, 0xf4, 0xbd, 0x58, 0xc4, 0x94, 0xb8, 0x11, 0xe6, 0x91, 0xb5, 0xae, 0xb0, 0x5b, 0x33, 0xf5,
-	0x97, 0x98, 0x47, 0x52, 0x77, 0x16, 0xaa, 0x62, 0x58, 0x1b, 0x5a, 0x77, 0xa6, 0xd1, 0x96, 0x75,
-	0xf3, 0x2e, 0x2a, 0x67, 0x26, 0xa4, 0x53, 0xeb, 0x3f, 0xed, 0x52, 0x97, 0xa4, 0xd1, 0xfa, 0x27,
-	0x03, 0xed, 0xa8, 0x78, 0xaf, 0x60, 0x14, 0x02, 0x69, 0x25, 0xd4, 0xef, 0x9f, 0x0f, 0x83, 0x15,
-	0x33, 0xee, 0xa3, 0xcd, 0xbe, 0xe2, 0xb9, 0x9e, 0x24, 0x66, 0xf1, 0xca, 0xfd, 0xa9, 0x96, 0x79,
-	0x0f, 0xdd, 0xcc, 0x20, 0xc3, 0xd4, 0xeb, 0xc3, 0x88, 0x67, 0xb9, 0x6e, 0xe8, 0xea, 0xa9, 0x2e,
-	0xd6, 0xbf, 0xad, 0xa1, 0x6d, 0xe5, 0xe3, 0x0d, 0xbc, 0x3f, 0xc9, 0x5e, 0xe0, 0x59, 0x10, 0xac,
-	0xe4, 0xa2, 0x18, 0x1e, 0x30, 0x17, 0x07, 0x01, 0x03, 0xce, 0x33, 0x27, 0x5b, 0x74, 0x2a, 0x25,
-	0xcb, 0xe6, 0x53, 0x54, 0x53, 0x2f, 0x9e, 0xc4, 0x40, 0x84, 0x1b, 0x32, 0x4c, 0x04, 0x40, 0x41,
-	0xd2, 0xce, 0xac, 0x29, 0xe2, 0x58, 0x03, 0x72, 0xf6, 0x13, 0xb4, 0xbb, 0x80, 0xad, 0x73, 0x65,
-	0x4f, 0xb0, 0x33, 0x47, 0xd6, 0x09, 0xcd, 0xc7, 0x68, 0xb7, 0x30, 0x99, 0x60, 0x2e, 0xf4, 0xc4,
-	0x5c, 0x9f, 0xa6, 0x44, 0xa8, 0x77, 0xd9, 0xe8, 0xdd, 0xce, 0x01, 0xaf, 0x31, 0x17, 0x6a, 0x7a,
-	0x6d, 0xd9, 0xad, 0x7f, 0x31, 0x50, 0x55, 0xcd, 0xa6, 0xdd, 0x3e, 0x7b, 0xfb, 0x3c, 0xe6, 0xd8,
-	0x4b, 0x56, 0x9a, 0xcb, 0x21, 0xaa, 0xc4, 0xbc, 0x4b, 0x3c, 0x9a, 0x92, 0xa0, 0x43, 0x14, 0x4b,
-	0xcd, 0xe5, 0x5a, 0x6f, 0xae, 0x6e, 0x3e, 0x40, 0xd5, 0x98, 0x9f, 0xa4, 0xe2, 0x2f, 0xf0, 0xba,
-	0x02, 0xcf, 0x37, 0xea, 0x9f, 0x0d, 0x54, 0x29, 0x1c, 0xe5, 0x12, 0xff, 0xd2, 0xd0, 0x77, 0x03,
-	0xed, 0x2b, 0x43, 0xc7, 0x98, 0x9f, 0xb2, 0xd8, 0x87, 0x2e, 0xf1, 0x19, 0x60, 0x0e, 0x2f, 0xe4,
-	0x6f, 0xbf, 0xfa, 0x07, 0x1d, 0xa1, 0xed, 0x70, 0x91, 0x82, 0xb2, 0x59, 0x6e, 0x36, 0x9d, 0x25,
-	0x0b, 0xca, 0x59, 0x78, 0x77, 0x6f, 0xb1, 0x60, 0xab, 0xf3, 0x63, 0x6c, 0x1b, 0x57, 0x63, 0xdb,
-	0xf8, 0x3d, 0xb6, 0x8d, 0xaf, 0x13, 0xbb, 0x74, 0x35, 0xb1, 0x4b, 0x3f, 0x27, 0x76, 0xe9, 0xdd,
-	0x51, 0x18, 0x8b, 0x28, 0xf5, 0x1c, 0x9f, 0x0e, 0xd4, 0x7a, 0x7a, 0xa8, 0x37, 0x15, 0xa1, 0x01,
-	0x34, 0x3e, 0x4c, 0xf7, 0x94, 0x4c, 0xc1, 0xbd, 0xff, 0xd5, 0x96, 0x7a, 0xf4, 0x27, 0x00, 0x00,
-	0xff, 0xff, 0xfb, 0x7d, 0x42, 0xa2, 0x64, 0x05, 0x00, 0x00,
+	// 754 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x95, 0xcd, 0x4e, 0x1b, 0x49,
+	0x10, 0xc7, 0x19, 0xcc, 0xa2, 0xa5, 0xcd, 0x87, 0x99, 0x5d, 0xc0, 0x78, 0x85, 0x17, 0x66, 0x85,
+	0xc4, 0xc2, 0xae, 0x2d, 0xb1, 0xa7, 0x8d, 0x72, 0x89, 0x1d, 0x02, 0x24, 0x28, 0x20, 0x07, 0x4b,
+	0x51, 0x2e, 0xa3, 0x9e, 0x99, 0x62, 0xa6, 0xf1, 0xb8, 0xdb, 0x9a, 0xee, 0x21, 0x71, 0xee, 0xb9,
+	0xe4, 0x90, 0xe4, 0x14, 0x29, 0x6f, 0x90, 0x5b, 0x5e, 0x23, 0x47, 0x8e, 0x39, 0xe4, 0x10, 0xc1,
+	0x8b, 0x44, 0xfd, 0x31, 0x36, 0xc4, 0x16, 0x32, 0xa7, 0xdc, 0x66, 0xaa, 0xfe, 0x55, 0xfd, 0xab,
+	0xea, 0xae, 0x6e, 0xb4, 0xf1, 0x12, 0x04, 0xf6, 0x23, 0x4c, 0x68, 0x55, 0x7d, 0xb1, 0x04, 0xaa,
+	0xcc, 0xe3, 0x90, 0x9c, 0x41, 0x52, 0x85, 0x33, 0xa0, 0x82, 0x57, 0x3a, 0x09, 0x13, 0xcc, 0xfe,
+	0xa3, 0xa7, 0xac, 0x64, 0xca, 0x4a, 0xa6, 0x2c, 0xfd, 0x1e, 0xb2, 0x90, 0x29, 0x5d, 0x55, 0x7e,
+	0xe9, 0x90, 0xd2, 0xf6, 0x4d, 0xc9, 0xfd, 0x84, 0x71, 0xae, 0x9c, 0xee, 0x49, 0x8c, 0x43, 0xb3,
+	0x4c, 0x69, 0xf3, 0xa6, 0x98, 0xec, 0x43, 0x6b, 0x9d, 0xaf, 0x16, 0xb2, 0x77, 0x24, 0x63, 0x0d,
+	0xc7, 0x31, 0x13, 0xf5, 0x04, 0xb0, 0x80, 0xc0, 0x5e, 0x45, 0xd3, 0x6d, 0x1e, 0xba, 0xa2, 0xdb,
+	0x01, 0x37, 0x4d, 0xe2, 0xa2, 0xb5, 0x6a, 0x6d, 0x4c, 0x35, 0x50, 0x9b, 0x87, 0xc7, 0xdd, 0x0e,
+	0x34, 0x93, 0xd8, 0xde, 0x42, 0xf3, 0x9e, 0x0a, 0x71, 0x49, 0x00, 0x54, 0x90, 0x13, 0x02, 0x49,
+	0x71, 0x5c, 0xc9, 0x0a, 0xda, 0xb1, 0xdf, 0xb3, 0xdb, 0x7f, 0xa3, 0x82, 0x5e, 0x17, 0x0b, 0xc2,
+	0xa8, 0x1b, 0x61, 0x1e, 0x15, 0x73, 0x4a, 0x3b, 0x77, 0xc5, 0xbe, 0x87, 0x79, 0x24, 0xf3, 0x5e,
+	0x95, 0xaa, 0x32, 0x8a, 0x13, 0x3a, 0xef, 0x15, 0x47, 0x5d, 0xda, 0xed, 0x3f, 0x51, 0xde, 0x40,
+	0x48, 0xd2, 0xe2, 0x2f, 0x9a, 0x52, 0x9b, 0x24, 0xa8, 0xf3, 0xca, 0x42, 0x4b, 0xaa, 0xbc, 0x47,
+	0xd0, 0x0d, 0x81, 0xd6, 0x62, 0xe6, 0xb7, 0x9a, 0x9d, 0x60, 0xc4, 0x1a, 0xd7, 0xd0, 0x74, 0x4b,
+	0xc5, 0xb9, 0x9e, 0x0c, 0x34, 0xe5, 0xe5, 0x5b, 0xfd, 0x5c, 0xf6, 0x3a, 0x9a, 0x35, 0x92, 0x4e,
+	0xea, 0xb5, 0xa0, 0xcb, 0x4d, 0x5d, 0x33, 0xda, 0x7a, 0xa4, 0x8d, 0xce, 0x87, 0x71, 0xb4, 0xa0,
+	0x38, 0x1e, 0xc3, 0xf3, 0x43, 0xb3, 0x03, 0xf7, 0x82, 0x60, 0x24, 0x8a, 0x5e, 0xf3, 0x20, 0x71,
+	0x71, 0x10, 0x24, 0xc0, 0xb9, 0x21, 0x99, 0x63, 0xfd, 0x54, 0xd2, 0x6c, 0xdf, 0x45, 0x25, 0xb5,
+	0xe3, 0x31, 0x01, 0x2a, 0xdc, 0x30, 0xc1, 0x54, 0x00, 0xf4, 0x82, 0x34, 0x59, 0xb1, 0xaf, 0xd8,
+	0xd5, 0x82, 0x2c, 0xfa, 0x0e, 0x5a, 0x1e, 0x12, 0xad, 0xeb, 0x32, 0x5b, 0xb0, 0x34, 0x10, 0xac,
+	0x2b, 0xb4, 0xff, 0x47, 0xcb, 0x3d, 0xc8, 0x18, 0x73, 0xa1, 0x3b, 0xe6, 0xfa, 0x2c, 0xa5, 0x42,
+	0xed, 0xcb, 0x44, 0x63, 0x31, 0x13, 0x1c, 0x60, 0x2e, 0x54, 0xf7, 0xea, 0xd2, 0xeb, 0xbc, 0xb5,
+	0xd0, 0xbc, 0xea, 0x4d, 0xbd, 0x7e, 0xfc, 0xf4, 0x3e, 0xe1, 0xd8, 0x8b, 0x47, 0xea, 0xcb, 0x26,
+	0x2a, 0x10, 0xbe, 0x4f, 0x3d, 0x96, 0xd2, 0x60, 0x87, 0xaa, 0x28, 0xd5, 0x97, 0x5f, 0x1b, 0x03,
+	0x76, 0xfb, 0x1f, 0x34, 0x4f, 0xf8, 0x61, 0x2a, 0xae, 0x89, 0x73, 0x4a, 0x3c, 0xe8, 0x70, 0xde,
+	0x58, 0xa8, 0xd0, 0x23, 0xca, 0x52, 0xfc, 0x4c, 0xa0, 0x4f, 0x16, 0x5a, 0x53, 0x40, 0xbb, 0x98,
+	0x1f, 0x25, 0xc4, 0x87, 0x7d, 0xea, 0x27, 0x80, 0x39, 0x3c, 0x90, 0x63, 0x3f, 0xfa, 0x81, 0x8e,
+	0xd0, 0x42, 0x38, 0x2c, 0x83, 0xc2, 0xcc, 0x6f, 0x6f, 0x57, 0x6e, 0xb8, 0xa0, 0x2a, 0x43, 0xd7,
+	0x6e, 0x0c, 0x4f, 0xe8, 0x7c, 0xb4, 0xd0, 0x8a, 0x22, 0xce, 0x4e, 0xfb, 0x13, 0x10, 0xf5, 0x08,
+	0xd3, 0x10, 0x32, 0xda, 0x45, 0x34, 0xc9, 0x05, 0x16, 0x29, 0x37, 0x9c, 0xe6, 0xcf, 0xfe, 0x0b,
+	0x99, 0xd9, 0x71, 0x23, 0x20, 0x61, 0x24, 0x14, 0x5b, 0xae, 0x61, 0x26, 0x71, 0x4f, 0xd9, 0xe4,
+	0x2d, 0x81, 0x7d, 0x41, 0xb2, 0xfb, 0x44, 0x0b, 0x73, 0x4a, 0x58, 0xe8, 0x3b, 0x8c, 0x78, 0x05,
+	0x21, 0xc1, 0xf9, 0xf5, 0x83, 0x3c, 0x25, 0x38, 0xd7, 0x47, 0xd7, 0x79, 0x6f, 0x99, 0xd9, 0xcc,
+	0x50, 0x0f, 0xc8, 0x19, 0x50, 0x39, 0x10, 0xc3, 0x26, 0xcf, 0x1a, 0x3e, 0x79, 0xb7, 0xba, 0x0e,
+	0xd7, 0xd1, 0x6c, 0x9b, 0x70, 0x0e, 0x81, 0xab, 0x5d, 0xdc, 0xa0, 0xcf, 0x68, 0xab, 0xbe, 0x8a,
+	0xb9, 0xf3, 0xda, 0x42, 0xbf, 0x5d, 0x03, 0x7b, 0x88, 0x89, 0x3c, 0x3b, 0xb7, 0xc0, 0x1a, 0x5c,
+	0x69, 0x7c, 0xc8, 0x4a, 0xf2, 0xa2, 0x3b, 0x55, 0xb9, 0xdd, 0x94, 0x0a, 0x12, 0x1b, 0x9c, 0xbc,
+	0xb6, 0x35, 0xa5, 0xc9, 0xa9, 0xfd, 0xd0, 0xa4, 0x26, 0x3d, 0xbd, 0x2d, 0x4d, 0x6d, 0xe7, 0xf3,
+	0x45, 0xd9, 0x3a, 0xbf, 0x28, 0x5b, 0xdf, 0x2e, 0xca, 0xd6, 0xbb, 0xcb, 0xf2, 0xd8, 0xf9, 0x65,
+	0x79, 0xec, 0xcb, 0x65, 0x79, 0xec, 0xd9, 0x56, 0x48, 0x44, 0x94, 0x7a, 0x15, 0x9f, 0xb5, 0xd5,
+	0x9b, 0xf5, 0xaf, 0x7e, 0xbe, 0x28, 0x0b, 0xa0, 0xfa, 0xa2, 0xff, 0x78, 0xc9, 0xa3, 0xcd, 0xbd,
+	0x49, 0xf5, 0x74, 0xfd, 0xf7, 0x3d, 0x00, 0x00, 0xff, 0xff, 0x55, 0x2b, 0xc8, 0xdd, 0x79, 0x07,
+	0x00, 0x00,
 }
 
 func (m *EventBallotCreated) Marshal() (dAtA []byte, err error) {
@@ -761,74 +1008,233 @@ func (m *EventGasPriceIncreaseFlagsUpdated) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventObserverSetChangeUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventBallotCreated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventObserverSetChangeUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventObserverSetChangeUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.BallotIdentifier)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.TssPubkey) > 0 {
+		i -= len(m.TssPubkey)
+		copy(dAtA[i:], m.TssPubkey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TssPubkey)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ObservationHash)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.ActivationHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.ObservationChain)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.KeygenHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.KeygenHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.BallotType)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventKeygenBlockUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventObserverLiveness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventObserverLiveness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventObserverLiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.MissedBallots != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MissedBallots))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.KeygenBlock)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.BallotIdentifier) > 0 {
+		i -= len(m.BallotIdentifier)
+		copy(dAtA[i:], m.BallotIdentifier)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BallotIdentifier)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.KeygenPubkeys)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventNewObserverAdded) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventObserverJailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventObserverJailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventObserverJailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MsgTypeUrl)
+	if m.JailedUntil != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.JailedUntil))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MissedBallots != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MissedBallots))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventObserverUnjailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventObserverUnjailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventObserverUnjailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventBallotCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BallotIdentifier)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObservationHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObservationChain)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BallotType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventKeygenBlockUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.KeygenBlock)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.KeygenPubkeys)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNewObserverAdded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
@@ -905,6 +1311,81 @@ func (m *EventGasPriceIncreaseFlagsUpdated) Size() (n int) {
 	return n
 }
 
+func (m *EventObserverSetChangeUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.KeygenHeight != 0 {
+		n += 1 + sovEvents(uint64(m.KeygenHeight))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ActivationHeight))
+	}
+	l = len(m.TssPubkey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventObserverLiveness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BallotIdentifier)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MissedBallots != 0 {
+		n += 1 + sovEvents(uint64(m.MissedBallots))
+	}
+	return n
+}
+
+func (m *EventObserverJailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MissedBallots != 0 {
+		n += 1 + sovEvents(uint64(m.MissedBallots))
+	}
+	if m.JailedUntil != 0 {
+		n += 1 + sovEvents(uint64(m.JailedUntil))
+	}
+	return n
+}
+
+func (m *EventObserverUnjailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1034,13 +1515,624 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObservationHash = string(dAtA[iNdEx:postIndex])
+			m.ObservationHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObservationChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BallotType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventKeygenBlockUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventKeygenBlockUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeygenBlock = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenPubkeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeygenPubkeys = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNewObserverAdded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNewObserverAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteeAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ZetaclientGranteeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteePubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ZetaclientGranteePubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverLastBlockCount", wireType)
+			}
+			m.ObserverLastBlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObserverLastBlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCCTXDisabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCCTXDisabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCCTXDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsInboundEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsOutboundEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCCTXEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCCTXEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCCTXEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservationChain", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1050,29 +2142,17 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ObservationChain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotType", wireType)
+			m.IsInboundEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1082,24 +2162,12 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BallotType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.IsOutboundEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1121,7 +2189,7 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventGasPriceIncreaseFlagsUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1144,10 +2212,10 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventKeygenBlockUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventGasPriceIncreaseFlagsUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventKeygenBlockUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventGasPriceIncreaseFlagsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1184,9 +2252,9 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeygenBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncreaseFlags", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1196,55 +2264,27 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeygenBlock = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeygenPubkeys", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			if m.GasPriceIncreaseFlags == nil {
+				m.GasPriceIncreaseFlags = &GasPriceIncreaseFlags{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.GasPriceIncreaseFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.KeygenPubkeys = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1267,7 +2307,7 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
+func (m *EventObserverSetChangeUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1290,15 +2330,15 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNewObserverAdded: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventObserverSetChangeUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNewObserverAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventObserverSetChangeUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1326,13 +2366,13 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenHeight", wireType)
 			}
-			var stringLen uint64
+			m.KeygenHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1342,29 +2382,16 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.KeygenHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteeAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
 			}
-			var stringLen uint64
+			m.ActivationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1374,27 +2401,14 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ActivationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ZetaclientGranteeAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteePubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TssPubkey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1422,27 +2436,8 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ZetaclientGranteePubkey = string(dAtA[iNdEx:postIndex])
+			m.TssPubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObserverLastBlockCount", wireType)
-			}
-			m.ObserverLastBlockCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ObserverLastBlockCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1464,7 +2459,7 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCCTXDisabled) Unmarshal(dAtA []byte) error {
+func (m *EventObserverLiveness) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1487,15 +2482,15 @@ func (m *EventCCTXDisabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCCTXDisabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventObserverLiveness: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCCTXDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventObserverLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1523,13 +2518,13 @@ func (m *EventCCTXDisabled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1539,17 +2534,29 @@ func (m *EventCCTXDisabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsInboundEnabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBallots", wireType)
 			}
-			var v int
+			m.MissedBallots = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1559,12 +2566,11 @@ func (m *EventCCTXDisabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MissedBallots |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsOutboundEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1586,7 +2592,7 @@ func (m *EventCCTXDisabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCCTXEnabled) Unmarshal(dAtA []byte) error {
+func (m *EventObserverJailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1609,15 +2615,15 @@ func (m *EventCCTXEnabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCCTXEnabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventObserverJailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCCTXEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventObserverJailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1645,13 +2651,13 @@ func (m *EventCCTXEnabled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBallots", wireType)
 			}
-			var v int
+			m.MissedBallots = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1661,17 +2667,16 @@ func (m *EventCCTXEnabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MissedBallots |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsInboundEnabled = bool(v != 0)
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
 			}
-			var v int
+			m.JailedUntil = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1681,12 +2686,11 @@ func (m *EventCCTXEnabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.JailedUntil |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsOutboundEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1708,7 +2712,7 @@ func (m *EventCCTXEnabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventGasPriceIncreaseFlagsUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventObserverUnjailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1731,15 +2735,15 @@ func (m *EventGasPriceIncreaseFlagsUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventGasPriceIncreaseFlagsUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventObserverUnjailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventGasPriceIncreaseFlagsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventObserverUnjailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1767,43 +2771,7 @@ func (m *EventGasPriceIncreaseFlagsUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncreaseFlags", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.GasPriceIncreaseFlags == nil {
-				m.GasPriceIncreaseFlags = &GasPriceIncreaseFlags{}
-			}
-			if err := m.GasPriceIncreaseFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -67,6 +67,11 @@ type BankKeeper interface {
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 }
 
+// EmissionsKeeper is set once the emissions keeper is created, since the emissions module depends on the observer module
+type EmissionsKeeper interface {
+	GetBallotMaturityBlocks(ctx sdk.Context) int64
+}
+
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
 type AccountKeeper interface {
 	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
@@ -17,6 +18,7 @@ func DefaultGenesis() *GenesisState {
 		Keygen:            nil,
 		LastObserverCount: nil,
 		ChainNonces:       []ChainNonces{},
+		LivenessParams:    DefaultLivenessParams(),
 	}
 }
 
@@ -37,6 +39,15 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	// the observers eligible for a chain must be part of the observer set
+	for _, cp := range gs.ChainParamsList.ChainParams {
+		for _, observer := range cp.Observers {
+			if !slices.Contains(gs.Observers.ObserverList, observer) {
+				return fmt.Errorf("observer %s of chain %d is not in the observer set", observer, cp.ChainId)
+			}
+		}
+	}
+
 	// Check for duplicated index in chainNonces
 	chainNoncesIndexMap := make(map[int64]bool)
 
@@ -47,6 +58,20 @@ func (gs GenesisState) Validate() error {
 		chainNoncesIndexMap[elem.ChainId] = true
 	}
 
+	// Check for duplicated index in observerSigningInfos
+	signingInfoIndexMap := make(map[string]bool)
+
+	for _, elem := range gs.ObserverSigningInfos {
+		if _, ok := signingInfoIndexMap[elem.ObserverAddress]; ok {
+			return fmt.Errorf("duplicated index for observerSigningInfos")
+		}
+		signingInfoIndexMap[elem.ObserverAddress] = true
+	}
+
+	if err := gs.LivenessParams.Validate(); err != nil {
+		return err
+	}
+
 	return gs.Observers.Validate()
 }
 
@@ -24,21 +24,24 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	Ballots           []*Ballot             `protobuf:"bytes,1,rep,name=ballots,proto3" json:"ballots,omitempty"`
-	Observers         ObserverSet           `protobuf:"bytes,2,opt,name=observers,proto3" json:"observers"`
-	NodeAccountList   []*NodeAccount        `protobuf:"bytes,3,rep,name=nodeAccountList,proto3" json:"nodeAccountList,omitempty"`
-	CrosschainFlags   *CrosschainFlags      `protobuf:"bytes,4,opt,name=crosschain_flags,json=crosschainFlags,proto3" json:"crosschain_flags,omitempty"`
-	Keygen            *Keygen               `protobuf:"bytes,6,opt,name=keygen,proto3" json:"keygen,omitempty"`
-	LastObserverCount *LastObserverCount    `protobuf:"bytes,7,opt,name=last_observer_count,json=lastObserverCount,proto3" json:"last_observer_count,omitempty"`
-	ChainParamsList   ChainParamsList       `protobuf:"bytes,8,opt,name=chain_params_list,json=chainParamsList,proto3" json:"chain_params_list"`
-	Tss               *TSS                  `protobuf:"bytes,9,opt,name=tss,proto3" json:"tss,omitempty"`
-	TssHistory        []TSS                 `protobuf:"bytes,10,rep,name=tss_history,json=tssHistory,proto3" json:"tss_history"`
-	TssFundMigrators  []TssFundMigratorInfo `protobuf:"bytes,11,rep,name=tss_fund_migrators,json=tssFundMigrators,proto3" json:"tss_fund_migrators"`
-	BlameList         []Blame               `protobuf:"bytes,12,rep,name=blame_list,json=blameList,proto3" json:"blame_list"`
-	PendingNonces     []PendingNonces       `protobuf:"bytes,13,rep,name=pending_nonces,json=pendingNonces,proto3" json:"pending_nonces"`
-	ChainNonces       []ChainNonces         `protobuf:"bytes,14,rep,name=chain_nonces,json=chainNonces,proto3" json:"chain_nonces"`
-	NonceToCctx       []NonceToCctx         `protobuf:"bytes,15,rep,name=nonce_to_cctx,json=nonceToCctx,proto3" json:"nonce_to_cctx"`
-	OperationalFlags  OperationalFlags      `protobuf:"bytes,16,opt,name=operational_flags,json=operationalFlags,proto3" json:"operational_flags"`
+	Ballots              []*Ballot             `protobuf:"bytes,1,rep,name=ballots,proto3" json:"ballots,omitempty"`
+	Observers            ObserverSet           `protobuf:"bytes,2,opt,name=observers,proto3" json:"observers"`
+	NodeAccountList      []*NodeAccount        `protobuf:"bytes,3,rep,name=nodeAccountList,proto3" json:"nodeAccountList,omitempty"`
+	CrosschainFlags      *CrosschainFlags      `protobuf:"bytes,4,opt,name=crosschain_flags,json=crosschainFlags,proto3" json:"crosschain_flags,omitempty"`
+	Keygen               *Keygen               `protobuf:"bytes,6,opt,name=keygen,proto3" json:"keygen,omitempty"`
+	LastObserverCount    *LastObserverCount    `protobuf:"bytes,7,opt,name=last_observer_count,json=lastObserverCount,proto3" json:"last_observer_count,omitempty"`
+	ChainParamsList      ChainParamsList       `protobuf:"bytes,8,opt,name=chain_params_list,json=chainParamsList,proto3" json:"chain_params_list"`
+	Tss                  *TSS                  `protobuf:"bytes,9,opt,name=tss,proto3" json:"tss,omitempty"`
+	TssHistory           []TSS                 `protobuf:"bytes,10,rep,name=tss_history,json=tssHistory,proto3" json:"tss_history"`
+	TssFundMigrators     []TssFundMigratorInfo `protobuf:"bytes,11,rep,name=tss_fund_migrators,json=tssFundMigrators,proto3" json:"tss_fund_migrators"`
+	BlameList            []Blame               `protobuf:"bytes,12,rep,name=blame_list,json=blameList,proto3" json:"blame_list"`
+	PendingNonces        []PendingNonces       `protobuf:"bytes,13,rep,name=pending_nonces,json=pendingNonces,proto3" json:"pending_nonces"`
+	ChainNonces          []ChainNonces         `protobuf:"bytes,14,rep,name=chain_nonces,json=chainNonces,proto3" json:"chain_nonces"`
+	NonceToCctx          []NonceToCctx         `protobuf:"bytes,15,rep,name=nonce_to_cctx,json=nonceToCctx,proto3" json:"nonce_to_cctx"`
+	OperationalFlags     OperationalFlags      `protobuf:"bytes,16,opt,name=operational_flags,json=operationalFlags,proto3" json:"operational_flags"`
+	ObserverSetChange    *ObserverSetChange    `protobuf:"bytes,17,opt,name=observer_set_change,json=observerSetChange,proto3" json:"observer_set_change,omitempty"`
+	ObserverSigningInfos []ObserverSigningInfo `protobuf:"bytes,18,rep,name=observer_signing_infos,json=observerSigningInfos,proto3" json:"observer_signing_infos"`
+	LivenessParams       LivenessParams        `protobuf:"bytes,19,opt,name=liveness_params,json=livenessParams,proto3" json:"liveness_params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -179,6 +182,27 @@ func (m *GenesisState) GetOperationalFlags() OperationalFlags {
 	return OperationalFlags{}
 }
 
+func (m *GenesisState) GetObserverSetChange() *ObserverSetChange {
+	if m != nil {
+		return m.ObserverSetChange
+	}
+	return nil
+}
+
+func (m *GenesisState) GetObserverSigningInfos() []ObserverSigningInfo {
+	if m != nil {
+		return m.ObserverSigningInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLivenessParams() LivenessParams {
+	if m != nil {
+		return m.LivenessParams
+	}
+	return LivenessParams{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "zetachain.zetacore.observer.GenesisState")
 }
@@ -188,50 +212,56 @@ func init() {
 }
 
 var fileDescriptor_7679b0952a0823f4 = []byte{
-	// 676 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x4e, 0x1b, 0x3d,
-	0x14, 0x4d, 0x3e, 0xf8, 0x02, 0x38, 0x40, 0x82, 0xdb, 0x85, 0x45, 0xa5, 0x14, 0x51, 0x55, 0x4d,
-	0x69, 0x99, 0xa0, 0xb4, 0xbb, 0xaa, 0x8b, 0x82, 0x0a, 0xfd, 0xa1, 0x94, 0x4e, 0x90, 0x2a, 0x75,
-	0xc1, 0xd4, 0x71, 0xcc, 0x30, 0xea, 0xc4, 0x8e, 0xc6, 0x4e, 0x05, 0x7d, 0x8a, 0x3e, 0x16, 0x4b,
-	0x96, 0x5d, 0x55, 0x2d, 0xbc, 0x48, 0x35, 0xd7, 0x36, 0xc9, 0x64, 0x61, 0xb2, 0xb3, 0xae, 0xcf,
-	0x39, 0xba, 0xbe, 0x3e, 0xf7, 0xa0, 0xc7, 0x3f, 0xb8, 0xa6, 0xec, 0x94, 0x26, 0xa2, 0x05, 0x27,
-	0x99, 0xf1, 0x96, 0xec, 0x2a, 0x9e, 0x7d, 0xe7, 0x59, 0x2b, 0xe6, 0x82, 0xab, 0x44, 0x05, 0x83,
-	0x4c, 0x6a, 0x89, 0xef, 0xdd, 0x40, 0x03, 0x07, 0x0d, 0x1c, 0x74, 0xf5, 0x6e, 0x2c, 0x63, 0x09,
-	0xb8, 0x56, 0x7e, 0x32, 0x94, 0xd5, 0xa6, 0x4f, 0xbd, 0x4b, 0xd3, 0x54, 0x6a, 0x8b, 0x7c, 0xe4,
-	0x45, 0xa6, 0xb4, 0xcf, 0x2d, 0x30, 0xf0, 0x01, 0xa1, 0x1e, 0x09, 0x29, 0x18, 0xb7, 0x5d, 0xaf,
-	0xb6, 0xbd, 0xf8, 0x4c, 0x2a, 0x65, 0x48, 0x27, 0x29, 0x8d, 0xd5, 0x34, 0x6d, 0x7f, 0xe3, 0xe7,
-	0x31, 0x17, 0xd3, 0x74, 0x23, 0x64, 0x8f, 0x47, 0x94, 0x31, 0x39, 0x14, 0xee, 0x99, 0x2d, 0x3f,
-	0x5e, 0x30, 0x1e, 0x69, 0x19, 0x31, 0xa6, 0xcf, 0x2c, 0x61, 0xc3, 0x47, 0x70, 0x87, 0xe9, 0x47,
-	0x33, 0xa0, 0x19, 0xed, 0xbb, 0x67, 0x6e, 0xf9, 0xf0, 0x03, 0x2e, 0x7a, 0x89, 0x88, 0x8b, 0xc3,
-	0x7c, 0xe8, 0x63, 0x68, 0xe5, 0x60, 0xcf, 0x6f, 0x81, 0x45, 0x27, 0x43, 0xd1, 0x53, 0x51, 0x3f,
-	0x89, 0x33, 0xaa, 0xa5, 0x6b, 0x7f, 0xd3, 0xfb, 0xd4, 0x01, 0xcf, 0xa8, 0x4e, 0xa4, 0xa0, 0xa9,
-	0x81, 0xaf, 0xff, 0x5d, 0x40, 0x8b, 0x7b, 0xc6, 0xa0, 0x1d, 0x4d, 0x35, 0xc7, 0x2f, 0xd1, 0x9c,
-	0xb1, 0x94, 0x22, 0xe5, 0xb5, 0x99, 0x66, 0xb5, 0xfd, 0x20, 0xf0, 0x38, 0x36, 0xd8, 0x06, 0x6c,
-	0xe8, 0x38, 0x78, 0x1f, 0x2d, 0xb8, 0x3b, 0x45, 0xfe, 0x5b, 0x2b, 0x37, 0xab, 0xed, 0xa6, 0x57,
-	0xe0, 0xa3, 0x3d, 0x74, 0xb8, 0xde, 0x9e, 0xbd, 0xf8, 0x7d, 0xbf, 0x14, 0x8e, 0x04, 0x70, 0x88,
-	0x6a, 0xf9, 0xf7, 0xbf, 0x32, 0xbf, 0xbf, 0x9f, 0x28, 0x4d, 0x66, 0xa0, 0x29, 0xbf, 0xe6, 0xc1,
-	0x88, 0x13, 0x4e, 0x0a, 0xe0, 0xcf, 0xa8, 0x3e, 0x69, 0x58, 0x32, 0x0b, 0x8d, 0x3e, 0xf5, 0x8a,
-	0xee, 0xdc, 0x90, 0x76, 0x73, 0x4e, 0x58, 0x63, 0xc5, 0x02, 0x7e, 0x81, 0x2a, 0xc6, 0xd5, 0xa4,
-	0x02, 0x72, 0xfe, 0xc1, 0xbd, 0x07, 0x68, 0x68, 0x29, 0xf8, 0x18, 0xdd, 0x49, 0xa9, 0xd2, 0x91,
-	0xbb, 0x8f, 0xa0, 0x61, 0x32, 0x07, 0x4a, 0x81, 0x57, 0x69, 0x9f, 0x2a, 0xed, 0xa6, 0xb8, 0x03,
-	0x6f, 0x5e, 0x49, 0x27, 0x4b, 0xf8, 0x18, 0xad, 0x8c, 0x7b, 0x37, 0x4a, 0xf3, 0x59, 0xce, 0x4f,
-	0xf3, 0xec, 0xbc, 0x7e, 0x08, 0xa4, 0x7c, 0x7c, 0xf6, 0x8f, 0x6a, 0xac, 0x58, 0xc6, 0x6d, 0x34,
-	0xa3, 0x95, 0x22, 0x0b, 0xa0, 0xb8, 0xe6, 0x55, 0x3c, 0xea, 0x74, 0xc2, 0x1c, 0x8c, 0xf7, 0x50,
-	0x35, 0xb7, 0xf1, 0x69, 0xa2, 0xb4, 0xcc, 0xce, 0x09, 0x82, 0x9f, 0xbd, 0x95, 0x6b, 0x3b, 0x40,
-	0x5a, 0xa9, 0x37, 0x86, 0x89, 0x7b, 0x08, 0xbb, 0x7d, 0xb8, 0x59, 0x07, 0x45, 0xaa, 0xa0, 0xb7,
-	0xe5, 0xd7, 0x53, 0x6a, 0x77, 0x28, 0x7a, 0x1f, 0x2c, 0xe9, 0xad, 0x38, 0x91, 0x56, 0xbf, 0xae,
-	0x8b, 0x57, 0x79, 0xbb, 0x08, 0x22, 0xd4, 0xcc, 0x6e, 0x11, 0xd4, 0xd7, 0xfd, 0xcb, 0x91, 0xc3,
-	0x9d, 0xab, 0x81, 0x6b, 0x1d, 0xb8, 0x5c, 0xcc, 0x05, 0xb2, 0x04, 0x62, 0x1b, 0x5e, 0xb1, 0x43,
-	0x43, 0x39, 0x00, 0x86, 0x15, 0x5d, 0x1a, 0x8c, 0x17, 0xf1, 0x27, 0xb4, 0x38, 0x9e, 0xdd, 0x64,
-	0x79, 0x8a, 0x5d, 0x81, 0xff, 0x2d, 0x88, 0x56, 0xd9, 0xa8, 0x84, 0x43, 0xb4, 0x54, 0x08, 0x54,
-	0x52, 0x9b, 0x6a, 0xff, 0x04, 0xe3, 0x47, 0x72, 0x87, 0xe9, 0x33, 0xa7, 0x29, 0x46, 0x25, 0xfc,
-	0x15, 0xad, 0x8c, 0x05, 0x91, 0x5d, 0xc1, 0x3a, 0x38, 0x67, 0xd3, 0x9f, 0x15, 0x23, 0x16, 0xac,
-	0x9c, 0xfb, 0x2a, 0x39, 0x51, 0x7f, 0x37, 0x3b, 0xff, 0x7f, 0xbd, 0x12, 0x56, 0x8c, 0xd7, 0xb7,
-	0x5f, 0x5f, 0x5c, 0x35, 0xca, 0x97, 0x57, 0x8d, 0xf2, 0x9f, 0xab, 0x46, 0xf9, 0xe7, 0x75, 0xa3,
-	0x74, 0x79, 0xdd, 0x28, 0xfd, 0xba, 0x6e, 0x94, 0xbe, 0x3c, 0x89, 0x13, 0x7d, 0x3a, 0xec, 0x06,
-	0x4c, 0xf6, 0x21, 0x2d, 0x37, 0x4d, 0x70, 0xe6, 0x91, 0xd1, 0x3a, 0x1b, 0x0b, 0xdb, 0xf3, 0x01,
-	0x57, 0xdd, 0x0a, 0x24, 0xe6, 0xb3, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x75, 0x82, 0x9a, 0xca,
-	0xed, 0x07, 0x00, 0x00,
+	// 772 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4d, 0x4f, 0x1b, 0x3b,
+	0x14, 0x4d, 0x1e, 0xbc, 0x00, 0x4e, 0x20, 0x89, 0x41, 0x4f, 0x16, 0x4f, 0xca, 0x43, 0x3c, 0x55,
+	0x4d, 0xa1, 0x4c, 0x50, 0xda, 0xae, 0xaa, 0x2e, 0x4a, 0x54, 0xe8, 0x07, 0xa5, 0x74, 0x82, 0x54,
+	0x89, 0x05, 0x53, 0x67, 0xe2, 0x4c, 0x46, 0x9d, 0xd8, 0xd1, 0xd8, 0x41, 0xd0, 0xbf, 0xd0, 0x4d,
+	0x7f, 0x16, 0x4b, 0x96, 0x5d, 0x55, 0x15, 0xfc, 0x91, 0x6a, 0xfc, 0x91, 0x64, 0xd2, 0xca, 0x4c,
+	0x77, 0xd6, 0x9d, 0x73, 0xce, 0x5c, 0x5f, 0xdf, 0x73, 0x2f, 0x78, 0xf0, 0x99, 0x08, 0xec, 0xf7,
+	0x71, 0x48, 0x1b, 0xf2, 0xc4, 0x62, 0xd2, 0x60, 0x1d, 0x4e, 0xe2, 0x73, 0x12, 0x37, 0x02, 0x42,
+	0x09, 0x0f, 0xb9, 0x33, 0x8c, 0x99, 0x60, 0xf0, 0xdf, 0x31, 0xd4, 0x31, 0x50, 0xc7, 0x40, 0xd7,
+	0xd7, 0x02, 0x16, 0x30, 0x89, 0x6b, 0x24, 0x27, 0x45, 0x59, 0xaf, 0xdb, 0xd4, 0x3b, 0x38, 0x8a,
+	0x98, 0xd0, 0xc8, 0xfb, 0x56, 0x64, 0x84, 0x07, 0x44, 0x03, 0x1d, 0x1b, 0x50, 0xc6, 0x3d, 0xca,
+	0xa8, 0x4f, 0x74, 0xd6, 0xeb, 0x4d, 0x2b, 0x3e, 0x66, 0x9c, 0x2b, 0x52, 0x2f, 0xc2, 0x01, 0xcf,
+	0x92, 0xf6, 0x27, 0x72, 0x19, 0x10, 0xaa, 0x91, 0x5b, 0x36, 0x64, 0x14, 0x9e, 0x27, 0x05, 0xe4,
+	0x59, 0x32, 0xa7, 0xac, 0x4b, 0x3c, 0xec, 0xfb, 0x6c, 0x44, 0x4d, 0x49, 0x1a, 0x76, 0x3c, 0xf5,
+	0x89, 0x27, 0x98, 0xe7, 0xfb, 0xe2, 0x22, 0x4b, 0x32, 0xe6, 0xa0, 0xb1, 0x4f, 0xb2, 0x60, 0x3d,
+	0x4e, 0x84, 0xe7, 0xf7, 0x31, 0x0d, 0xfe, 0xa0, 0xfa, 0x43, 0x1c, 0xe3, 0x81, 0xb9, 0xf3, 0xae,
+	0x0d, 0x3f, 0x24, 0xb4, 0x1b, 0xd2, 0x20, 0xfd, 0x5e, 0xf7, 0x6c, 0x0c, 0x31, 0x2e, 0xe6, 0xe3,
+	0x3b, 0x60, 0x5e, 0x6f, 0x44, 0xbb, 0xdc, 0x1b, 0x84, 0x41, 0x8c, 0x05, 0x33, 0xb7, 0xde, 0xb1,
+	0xde, 0x7a, 0x48, 0x62, 0x2c, 0x42, 0x46, 0x71, 0xa4, 0xe0, 0x9b, 0x5f, 0x4a, 0xa0, 0x74, 0xa0,
+	0x3c, 0xd0, 0x16, 0x58, 0x10, 0xf8, 0x0c, 0x2c, 0xa8, 0xae, 0xe5, 0x28, 0xbf, 0x31, 0x57, 0x2f,
+	0x36, 0xff, 0x77, 0x2c, 0xa6, 0x70, 0xf6, 0x24, 0xd6, 0x35, 0x1c, 0x78, 0x08, 0x96, 0xcc, 0x37,
+	0x8e, 0xfe, 0xda, 0xc8, 0xd7, 0x8b, 0xcd, 0xba, 0x55, 0xe0, 0x9d, 0x3e, 0xb4, 0x89, 0xd8, 0x9b,
+	0xbf, 0xfa, 0xfe, 0x5f, 0xce, 0x9d, 0x08, 0x40, 0x17, 0x94, 0x93, 0xae, 0x79, 0xae, 0x9a, 0xe6,
+	0x30, 0xe4, 0x02, 0xcd, 0xc9, 0xa4, 0xec, 0x9a, 0x47, 0x13, 0x8e, 0x3b, 0x2b, 0x00, 0x3f, 0x80,
+	0xca, 0xac, 0x27, 0xd0, 0xbc, 0x4c, 0xf4, 0xa1, 0x55, 0xb4, 0x35, 0x26, 0xed, 0x27, 0x1c, 0xb7,
+	0xec, 0xa7, 0x03, 0xf0, 0x29, 0x28, 0x28, 0xe3, 0xa0, 0x82, 0x94, 0xb3, 0x17, 0xee, 0x8d, 0x84,
+	0xba, 0x9a, 0x02, 0xcf, 0xc0, 0x6a, 0x84, 0xb9, 0xf0, 0xc6, 0x7d, 0x29, 0x13, 0x46, 0x0b, 0x52,
+	0xc9, 0xb1, 0x2a, 0x1d, 0x62, 0x2e, 0x4c, 0x15, 0x5b, 0xf2, 0xce, 0xd5, 0x68, 0x36, 0x04, 0xcf,
+	0x40, 0x75, 0xba, 0x77, 0xbd, 0x28, 0xa9, 0xe5, 0x62, 0x96, 0x6b, 0x27, 0xf1, 0x63, 0x49, 0x4a,
+	0xca, 0xa7, 0xdf, 0xa8, 0xec, 0xa7, 0xc3, 0xb0, 0x09, 0xe6, 0x04, 0xe7, 0x68, 0x49, 0x2a, 0x6e,
+	0x58, 0x15, 0x4f, 0xda, 0x6d, 0x37, 0x01, 0xc3, 0x03, 0x50, 0x4c, 0xda, 0xb8, 0x1f, 0x72, 0xc1,
+	0xe2, 0x4b, 0x04, 0xe4, 0xcb, 0xde, 0xc9, 0xd5, 0x19, 0x00, 0xc1, 0xf9, 0x4b, 0xc5, 0x84, 0x5d,
+	0x00, 0x8d, 0x1f, 0xc6, 0x76, 0xe0, 0xa8, 0x28, 0xf5, 0x76, 0xed, 0x7a, 0x9c, 0xef, 0x8f, 0x68,
+	0xf7, 0xad, 0x26, 0xbd, 0xa2, 0x3d, 0xa6, 0xf5, 0x2b, 0x22, 0xfd, 0x29, 0x49, 0x17, 0xc8, 0x29,
+	0xad, 0x6a, 0x57, 0x92, 0xea, 0x9b, 0x76, 0x73, 0x24, 0x70, 0xd3, 0xd5, 0x92, 0xab, 0x3b, 0x70,
+	0x25, 0x3d, 0x17, 0xd0, 0xb2, 0x14, 0xdb, 0xb2, 0x8a, 0x1d, 0x2b, 0xca, 0x91, 0x64, 0x68, 0xd1,
+	0xe5, 0xe1, 0x74, 0x10, 0xbe, 0x07, 0xa5, 0xe9, 0xf5, 0x80, 0x56, 0x32, 0x78, 0x45, 0xbe, 0x6f,
+	0x4a, 0xb4, 0xe8, 0x4f, 0x42, 0xd0, 0x05, 0xcb, 0xa9, 0x39, 0x8c, 0xca, 0x99, 0xfc, 0x47, 0x7d,
+	0x72, 0xc2, 0x5a, 0xbe, 0xb8, 0x30, 0x9a, 0x74, 0x12, 0x82, 0x1f, 0x41, 0x75, 0x6a, 0x10, 0x69,
+	0x0b, 0x56, 0x64, 0xe7, 0xec, 0xd8, 0x67, 0xc5, 0x84, 0x25, 0x2d, 0x67, 0x9e, 0x8a, 0xcd, 0xc4,
+	0x13, 0x37, 0xfd, 0x66, 0xc0, 0xa3, 0x6a, 0x06, 0x37, 0x4d, 0xcd, 0xa3, 0x96, 0x64, 0xb9, 0x55,
+	0x36, 0x1b, 0x82, 0x11, 0xf8, 0x67, 0xa2, 0x1f, 0x06, 0x34, 0x79, 0xca, 0x90, 0xf6, 0x18, 0x47,
+	0x30, 0x43, 0xd3, 0x8d, 0x7f, 0xa1, 0x98, 0x53, 0x4d, 0xb7, 0xc6, 0x7e, 0xfd, 0xc4, 0xe1, 0x29,
+	0x28, 0x9b, 0x3d, 0xab, 0xed, 0x8b, 0x56, 0xe5, 0x4d, 0xb6, 0xed, 0x73, 0x41, 0x73, 0x94, 0x4b,
+	0xf5, 0x1f, 0x56, 0xa2, 0x54, 0xf4, 0xf5, 0xfc, 0xe2, 0xdf, 0x95, 0x82, 0x5b, 0x50, 0xb2, 0x7b,
+	0x2f, 0xae, 0x6e, 0x6a, 0xf9, 0xeb, 0x9b, 0x5a, 0xfe, 0xc7, 0x4d, 0x2d, 0xff, 0xf5, 0xb6, 0x96,
+	0xbb, 0xbe, 0xad, 0xe5, 0xbe, 0xdd, 0xd6, 0x72, 0xa7, 0xdb, 0x41, 0x28, 0xfa, 0xa3, 0x8e, 0xe3,
+	0xb3, 0x81, 0xdc, 0x2b, 0x3b, 0x6a, 0xc5, 0x24, 0xc3, 0xb5, 0x71, 0x31, 0xb5, 0x96, 0x2e, 0x87,
+	0x84, 0x77, 0x0a, 0x72, 0xb7, 0x3c, 0xfa, 0x19, 0x00, 0x00, 0xff, 0xff, 0x06, 0x9f, 0x65, 0xec,
+	0x7a, 0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -254,6 +284,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.LivenessParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if len(m.ObserverSigningInfos) > 0 {
+		for iNdEx := len(m.ObserverSigningInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObserverSigningInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.ObserverSetChange != nil {
+		{
+			size, err := m.ObserverSetChange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	{
 		size, err := m.OperationalFlags.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -536,6 +608,18 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.OperationalFlags.Size()
 	n += 2 + l + sovGenesis(uint64(l))
+	if m.ObserverSetChange != nil {
+		l = m.ObserverSetChange.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ObserverSigningInfos) > 0 {
+		for _, e := range m.ObserverSigningInfos {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.LivenessParams.Size()
+	n += 2 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -1089,6 +1173,109 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverSetChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ObserverSetChange == nil {
+				m.ObserverSetChange = &ObserverSetChange{}
+			}
+			if err := m.ObserverSetChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverSigningInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverSigningInfos = append(m.ObserverSigningInfos, ObserverSigningInfo{})
+			if err := m.ObserverSigningInfos[len(m.ObserverSigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LivenessParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
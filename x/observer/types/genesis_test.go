@@ -22,6 +22,25 @@ func TestGenesisState_Validate(t *testing.T) {
 	chainNonce := sample.ChainNonces(0)
 	gsWithDuplicateChainNonces.ChainNonces = []types.ChainNonces{chainNonce, chainNonce}
 
+	gsWithDuplicateSigningInfos := types.DefaultGenesis()
+	signingInfo := types.NewObserverSigningInfo(sample.AccAddress(), 1, 100)
+	gsWithDuplicateSigningInfos.ObserverSigningInfos = []types.ObserverSigningInfo{signingInfo, signingInfo}
+
+	gsWithInvalidLivenessParams := types.DefaultGenesis()
+	gsWithInvalidLivenessParams.LivenessParams.Enabled = true
+	gsWithInvalidLivenessParams.LivenessParams.Window = 0
+
+	observer := sample.AccAddress()
+	gsWithChainParamsObservers := types.DefaultGenesis()
+	gsWithChainParamsObservers.Observers = types.ObserverSet{ObserverList: []string{observer}}
+	gsWithChainParamsObservers.ChainParamsList = types.GetDefaultChainParams()
+	gsWithChainParamsObservers.ChainParamsList.ChainParams[0].Observers = []string{observer}
+
+	gsWithUnknownChainParamsObserver := types.DefaultGenesis()
+	gsWithUnknownChainParamsObserver.Observers = types.ObserverSet{ObserverList: []string{observer}}
+	gsWithUnknownChainParamsObserver.ChainParamsList = types.GetDefaultChainParams()
+	gsWithUnknownChainParamsObserver.ChainParamsList.ChainParams[0].Observers = []string{sample.AccAddress()}
+
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -42,6 +61,16 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: invalidChainParamsGen,
 			valid:    false,
 		},
+		{
+			desc:     "chain params observers part of the observer set",
+			genState: gsWithChainParamsObservers,
+			valid:    true,
+		},
+		{
+			desc:     "chain params observer not in the observer set",
+			genState: gsWithUnknownChainParamsObserver,
+			valid:    false,
+		},
 		{
 			desc:     "invalid genesis state duplicate node account list",
 			genState: gsWithDuplicateNodeAccountList,
@@ -52,6 +81,16 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: gsWithDuplicateChainNonces,
 			valid:    false,
 		},
+		{
+			desc:     "invalid genesis state duplicate observer signing infos",
+			genState: gsWithDuplicateSigningInfos,
+			valid:    false,
+		},
+		{
+			desc:     "invalid liveness params",
+			genState: gsWithInvalidLivenessParams,
+			valid:    false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -55,6 +55,8 @@ type Keygen struct {
 	Status         KeygenStatus `protobuf:"varint,2,opt,name=status,proto3,enum=zetachain.zetacore.observer.KeygenStatus" json:"status,omitempty"`
 	GranteePubkeys []string     `protobuf:"bytes,3,rep,name=granteePubkeys,proto3" json:"granteePubkeys,omitempty"`
 	BlockNumber    int64        `protobuf:"varint,4,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+	// generate an ed25519 key alongside the secp256k1 key
+	Eddsa bool `protobuf:"varint,5,opt,name=eddsa,proto3" json:"eddsa,omitempty"`
 }
 
 func (m *Keygen) Reset()         { *m = Keygen{} }
@@ -111,6 +113,13 @@ func (m *Keygen) GetBlockNumber() int64 {
 	return 0
 }
 
+func (m *Keygen) GetEddsa() bool {
+	if m != nil {
+		return m.Eddsa
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("zetachain.zetacore.observer.KeygenStatus", KeygenStatus_name, KeygenStatus_value)
 	proto.RegisterType((*Keygen)(nil), "zetachain.zetacore.observer.Keygen")
@@ -121,26 +130,27 @@ func init() {
 }
 
 var fileDescriptor_e9d0b438d0ca0d23 = []byte{
-	// 298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xa8, 0x4a, 0x2d, 0x49,
-	0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x07, 0xb3, 0xf2, 0x8b, 0x52, 0xf5, 0xf3, 0x93, 0x8a, 0x53,
-	0x8b, 0xca, 0x52, 0x8b, 0xf4, 0xb3, 0x53, 0x2b, 0xd3, 0x53, 0xf3, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b,
-	0xf2, 0x85, 0xa4, 0xe1, 0x2a, 0xf5, 0x60, 0x2a, 0xf5, 0x60, 0x2a, 0xa5, 0x44, 0xd2, 0xf3, 0xd3,
-	0xf3, 0xc1, 0xea, 0xf4, 0x41, 0x2c, 0x88, 0x16, 0xa5, 0xa9, 0x8c, 0x5c, 0x6c, 0xde, 0x60, 0x33,
-	0x84, 0x1c, 0xb9, 0xd8, 0x8a, 0x4b, 0x12, 0x4b, 0x4a, 0x8b, 0x25, 0x98, 0x14, 0x18, 0x35, 0xf8,
-	0x8c, 0x34, 0xf5, 0xf0, 0x18, 0xa7, 0x07, 0xd1, 0x14, 0x0c, 0xd6, 0x10, 0x04, 0xd5, 0x28, 0xa4,
-	0xc6, 0xc5, 0x97, 0x5e, 0x94, 0x98, 0x57, 0x92, 0x9a, 0x1a, 0x50, 0x9a, 0x94, 0x9d, 0x5a, 0x59,
-	0x2c, 0xc1, 0xac, 0xc0, 0xac, 0xc1, 0x19, 0x84, 0x26, 0x2a, 0xa4, 0xc0, 0xc5, 0x9d, 0x94, 0x93,
-	0x9f, 0x9c, 0xed, 0x57, 0x9a, 0x9b, 0x94, 0x5a, 0x24, 0xc1, 0xa2, 0xc0, 0xa8, 0xc1, 0x1c, 0x84,
-	0x2c, 0xa4, 0xe5, 0xc3, 0xc5, 0x83, 0x6c, 0x83, 0x90, 0x20, 0x17, 0x6f, 0x40, 0x6a, 0x5e, 0x4a,
-	0x66, 0x5e, 0x3a, 0x44, 0x58, 0x80, 0x01, 0x24, 0xe4, 0x9d, 0x5a, 0xe9, 0x9e, 0x9a, 0x17, 0x5c,
-	0x9a, 0x9c, 0x9c, 0x5a, 0x5c, 0x2c, 0xc0, 0x28, 0x24, 0x00, 0xd6, 0xe5, 0x9e, 0x9a, 0xe7, 0x96,
-	0x98, 0x99, 0x93, 0x9a, 0x22, 0xc0, 0x2c, 0xc5, 0xb2, 0x62, 0x89, 0x1c, 0xa3, 0x93, 0xeb, 0x89,
-	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x69, 0xa7, 0x67, 0x96, 0x64, 0x94, 0x26,
-	0xe9, 0x25, 0xe7, 0xe7, 0x82, 0x43, 0x57, 0x17, 0x12, 0xd0, 0x79, 0xf9, 0x29, 0xa9, 0xfa, 0x15,
-	0x88, 0x60, 0x2e, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x87, 0x99, 0x31, 0x20, 0x00, 0x00,
-	0xff, 0xff, 0x3d, 0x2b, 0xce, 0xa9, 0x92, 0x01, 0x00, 0x00,
+	// 313 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xc1, 0x4a, 0xfb, 0x30,
+	0x1c, 0xc7, 0x9b, 0x7f, 0xb7, 0xf1, 0x37, 0xea, 0xa8, 0x61, 0x87, 0x32, 0x21, 0x14, 0x0f, 0x52,
+	0x15, 0x5b, 0xd0, 0x27, 0x50, 0xd0, 0x1d, 0x26, 0x32, 0xba, 0x9b, 0xb7, 0xa6, 0xfd, 0x91, 0x95,
+	0x6d, 0xc9, 0x48, 0x52, 0xb1, 0x3e, 0x85, 0x0f, 0xe1, 0x41, 0xf0, 0x45, 0x3c, 0xee, 0xe8, 0x51,
+	0xb6, 0x17, 0x91, 0xa5, 0x4e, 0x87, 0x07, 0x6f, 0xbf, 0x7c, 0xf9, 0x7e, 0xbe, 0x81, 0x0f, 0x0e,
+	0x1f, 0xc1, 0xa4, 0xd9, 0x28, 0x2d, 0x44, 0x6c, 0x2f, 0xa9, 0x20, 0x96, 0x4c, 0x83, 0xba, 0x07,
+	0x15, 0x8f, 0xa1, 0xe2, 0x20, 0xa2, 0x99, 0x92, 0x46, 0x92, 0xfd, 0xef, 0x66, 0xb4, 0x6e, 0x46,
+	0xeb, 0x66, 0xb7, 0xc3, 0x25, 0x97, 0xb6, 0x17, 0xaf, 0xae, 0x1a, 0x39, 0x78, 0x45, 0xb8, 0xd5,
+	0xb7, 0x1b, 0xe4, 0x02, 0xb7, 0xb4, 0x49, 0x4d, 0xa9, 0xfd, 0x7f, 0x01, 0x0a, 0xdb, 0x67, 0x47,
+	0xd1, 0x1f, 0x73, 0x51, 0x0d, 0x0d, 0x2d, 0x90, 0x7c, 0x81, 0xe4, 0x10, 0xb7, 0xb9, 0x4a, 0x85,
+	0x01, 0x18, 0x94, 0x6c, 0x0c, 0x95, 0xf6, 0xdd, 0xc0, 0x0d, 0xb7, 0x92, 0x5f, 0x29, 0x09, 0xf0,
+	0x36, 0x9b, 0xc8, 0x6c, 0x7c, 0x5b, 0x4e, 0x19, 0x28, 0xbf, 0x11, 0xa0, 0xd0, 0x4d, 0x36, 0x23,
+	0xd2, 0xc1, 0x4d, 0xc8, 0x73, 0x9d, 0xfa, 0xcd, 0x00, 0x85, 0xff, 0x93, 0xfa, 0x71, 0x7c, 0x83,
+	0x77, 0x36, 0xff, 0x25, 0x7b, 0x78, 0x77, 0x00, 0x22, 0x2f, 0x04, 0xaf, 0x63, 0xcf, 0x59, 0x45,
+	0x7d, 0xa8, 0x7a, 0x20, 0x86, 0x65, 0x96, 0x81, 0xd6, 0x1e, 0x22, 0x9e, 0xa5, 0x7a, 0x20, 0xae,
+	0xd3, 0x62, 0x02, 0xb9, 0xe7, 0x76, 0x1b, 0x2f, 0xcf, 0x14, 0x5d, 0x5e, 0xdd, 0x9d, 0xf0, 0xc2,
+	0x8c, 0x4a, 0x16, 0x65, 0x72, 0x6a, 0xdd, 0x9e, 0xd6, 0x9a, 0x85, 0xcc, 0x21, 0x7e, 0xf8, 0x91,
+	0x6c, 0xaa, 0x19, 0xe8, 0xb7, 0x05, 0x45, 0xf3, 0x05, 0x45, 0x1f, 0x0b, 0x8a, 0x9e, 0x96, 0xd4,
+	0x99, 0x2f, 0xa9, 0xf3, 0xbe, 0xa4, 0x0e, 0x6b, 0x59, 0x93, 0xe7, 0x9f, 0x01, 0x00, 0x00, 0xff,
+	0xff, 0x24, 0xbf, 0x71, 0x43, 0xa8, 0x01, 0x00, 0x00,
 }
 
 func (m *Keygen) Marshal() (dAtA []byte, err error) {
@@ -163,6 +173,16 @@ func (m *Keygen) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Eddsa {
+		i--
+		if m.Eddsa {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.BlockNumber != 0 {
 		i = encodeVarintKeygen(dAtA, i, uint64(m.BlockNumber))
 		i--
@@ -214,6 +234,9 @@ func (m *Keygen) Size() (n int) {
 	if m.BlockNumber != 0 {
 		n += 1 + sovKeygen(uint64(m.BlockNumber))
 	}
+	if m.Eddsa {
+		n += 2
+	}
 	return n
 }
 
@@ -322,6 +345,26 @@ func (m *Keygen) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eddsa", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeygen
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Eddsa = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeygen(dAtA[iNdEx:])
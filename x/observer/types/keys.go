@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -51,6 +52,8 @@ func ChainNoncesKeyPrefix(chainID int64) []byte {
 // Address TODOS for name changes: https://github.com/zeta-chain/node/issues/3098
 const (
 	BlameKey = "Blame-"
+	// BlameEventKey is the key prefix for blame events, keyed by finalization height
+	BlameEventKey = "BlameEvent-value-"
 	// TODO change identifier for VoterKey to BallotKey
 	VoterKey = "Voter-value-"
 
@@ -86,6 +89,15 @@ const (
 	NonceToCctxKeyPrefix = "NonceToCctx-value-"
 
 	OperationalFlagsKey = "OperationalFlags-value-"
+
+	// ObserverSetChangeKey is the key for the last staged observer set change
+	ObserverSetChangeKey = "ObserverSetChange-value-"
+
+	// ObserverSigningInfoKey is the key prefix for the ballot signing info of the observers
+	ObserverSigningInfoKey = "ObserverSigningInfo-value-"
+
+	// LivenessParamsKey is the key for the params of the tracking of the observers participation in the ballots
+	LivenessParamsKey = "LivenessParams-value-"
 )
 
 func GetBlameIndex(chainID int64, nonce uint64, digest string, height uint64) string {
@@ -95,3 +107,9 @@ func GetBlameIndex(chainID int64, nonce uint64, digest string, height uint64) st
 func GetBlamePrefix(chainID int64, nonce int64) string {
 	return fmt.Sprintf("%d-%d", chainID, nonce)
 }
+
+// BlameEventKeyPrefix returns the key prefix of the blame events finalized at the height
+func BlameEventKeyPrefix(height int64) []byte {
+	// #nosec G115 height is never negative
+	return sdk.Uint64ToBigEndian(uint64(height))
+}
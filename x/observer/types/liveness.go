@@ -0,0 +1,102 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+)
+
+const (
+	// MaxLivenessWindow bounds the size of the missed ballots bitmap of the observers
+	MaxLivenessWindow int64 = 100_000
+
+	// MaxJailedObserversRatioDenominator limits the number of jailed observers to a third of the observer set.
+	// Missed ballots from more observers are likely caused by a systemic issue rather than by the observers themselves,
+	// and jailing them would prevent ballots from being finalized.
+	MaxJailedObserversRatioDenominator = 3
+)
+
+// DefaultLivenessParams returns the default liveness params, the tracking and the jailing of the observers
+// are disabled. Once enabled, an observer missing more than half of 1000 ballots is jailed for approximately 12 hours.
+func DefaultLivenessParams() LivenessParams {
+	return LivenessParams{
+		Enabled:              false,
+		Window:               1000,
+		MinVotedBallots:      500,
+		JailDuration:         21600,
+		BallotMaturityBlocks: 100,
+	}
+}
+
+// Validate validates the liveness params, the params can only be left unset while disabled
+func (p LivenessParams) Validate() error {
+	if p.Window < 0 || p.MinVotedBallots < 0 || p.JailDuration < 0 || p.BallotMaturityBlocks < 0 {
+		return cosmoserrors.Wrap(ErrInvalidLivenessParams, "params can't be negative")
+	}
+	if !p.Enabled {
+		return nil
+	}
+
+	switch {
+	case p.Window == 0 || p.Window > MaxLivenessWindow:
+		return cosmoserrors.Wrapf(ErrInvalidLivenessParams, "window must be between 1 and %d", MaxLivenessWindow)
+	case p.MinVotedBallots == 0 || p.MinVotedBallots > p.Window:
+		return cosmoserrors.Wrap(ErrInvalidLivenessParams, "min voted ballots must be between 1 and the window")
+	case p.JailDuration == 0:
+		return cosmoserrors.Wrap(ErrInvalidLivenessParams, "jail duration must be positive")
+	case p.BallotMaturityBlocks == 0:
+		return cosmoserrors.Wrap(ErrInvalidLivenessParams, "ballot maturity blocks must be positive")
+	}
+
+	return nil
+}
+
+// MaxMissedBallots returns the number of ballots an observer can miss in a window without being jailed
+func (p LivenessParams) MaxMissedBallots() int64 {
+	return p.Window - p.MinVotedBallots
+}
+
+// NewObserverSigningInfo returns the signing info of an observer starting to be tracked at the height
+func NewObserverSigningInfo(observerAddress string, startHeight, window int64) ObserverSigningInfo {
+	return ObserverSigningInfo{
+		ObserverAddress:     observerAddress,
+		StartHeight:         startHeight,
+		MissedBallotsBitmap: make([]byte, (window+7)/8),
+	}
+}
+
+// GetMissedBallot returns true if the ballot at the index of the window was missed
+func (s ObserverSigningInfo) GetMissedBallot(index, window int64) bool {
+	i := index % window
+	if i/8 >= int64(len(s.MissedBallotsBitmap)) {
+		return false
+	}
+	return s.MissedBallotsBitmap[i/8]&(1<<(i%8)) != 0
+}
+
+// SetMissedBallot sets whether the ballot at the index of the window was missed
+func (s *ObserverSigningInfo) SetMissedBallot(index, window int64, missed bool) {
+	if int64(len(s.MissedBallotsBitmap)) < (window+7)/8 {
+		bitmap := make([]byte, (window+7)/8)
+		copy(bitmap, s.MissedBallotsBitmap)
+		s.MissedBallotsBitmap = bitmap
+	}
+
+	i := index % window
+	if missed {
+		s.MissedBallotsBitmap[i/8] |= 1 << (i % 8)
+	} else {
+		s.MissedBallotsBitmap[i/8] &^= 1 << (i % 8)
+	}
+}
+
+// ResetWindow starts a new window at the height, clearing the missed ballots
+func (s *ObserverSigningInfo) ResetWindow(height, window int64) {
+	s.StartHeight = height
+	s.IndexOffset = 0
+	s.MissedBallotsCounter = 0
+	s.MissedBallotsBitmap = make([]byte, (window+7)/8)
+}
+
+// IsLivenessFaulty returns true if the observer missed too many ballots in a full window
+func (s ObserverSigningInfo) IsLivenessFaulty(params LivenessParams) bool {
+	return s.IndexOffset >= params.Window && s.MissedBallotsCounter > params.MaxMissedBallots()
+}
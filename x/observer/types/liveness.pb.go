@@ -0,0 +1,888 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: zetachain/zetacore/observer/liveness.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ObserverSigningInfo tracks the participation of an observer in the ballots
+// it is a voter of, over a sliding window of ballots
+type ObserverSigningInfo struct {
+	ObserverAddress string `protobuf:"bytes,1,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
+	// height at which the tracking of the current window started
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// number of ballots tracked since the start height, the position of a
+	// ballot in the window is index_offset modulo the window size
+	IndexOffset int64 `protobuf:"varint,3,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// number of ballots missed in the current window
+	MissedBallotsCounter int64 `protobuf:"varint,4,opt,name=missed_ballots_counter,json=missedBallotsCounter,proto3" json:"missed_ballots_counter,omitempty"`
+	// bit array of the ballots missed in the current window
+	MissedBallotsBitmap []byte `protobuf:"bytes,5,opt,name=missed_ballots_bitmap,json=missedBallotsBitmap,proto3" json:"missed_ballots_bitmap,omitempty"`
+	Jailed              bool   `protobuf:"varint,6,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// height from which the observer can be unjailed
+	JailedUntil int64 `protobuf:"varint,7,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+}
+
+func (m *ObserverSigningInfo) Reset()         { *m = ObserverSigningInfo{} }
+func (m *ObserverSigningInfo) String() string { return proto.CompactTextString(m) }
+func (*ObserverSigningInfo) ProtoMessage()    {}
+func (*ObserverSigningInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_983fb36a74c70e3c, []int{0}
+}
+func (m *ObserverSigningInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObserverSigningInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObserverSigningInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObserverSigningInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObserverSigningInfo.Merge(m, src)
+}
+func (m *ObserverSigningInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObserverSigningInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObserverSigningInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObserverSigningInfo proto.InternalMessageInfo
+
+func (m *ObserverSigningInfo) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
+	}
+	return ""
+}
+
+func (m *ObserverSigningInfo) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *ObserverSigningInfo) GetIndexOffset() int64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *ObserverSigningInfo) GetMissedBallotsCounter() int64 {
+	if m != nil {
+		return m.MissedBallotsCounter
+	}
+	return 0
+}
+
+func (m *ObserverSigningInfo) GetMissedBallotsBitmap() []byte {
+	if m != nil {
+		return m.MissedBallotsBitmap
+	}
+	return nil
+}
+
+func (m *ObserverSigningInfo) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *ObserverSigningInfo) GetJailedUntil() int64 {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return 0
+}
+
+// LivenessParams are the parameters of the tracking of the participation of
+// the observers in the ballots. The tracking and the jailing of the observers
+// are disabled by default.
+type LivenessParams struct {
+	// enables the tracking of the participation and the jailing of the
+	// observers missing too many ballots
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// number of ballots over which the participation of an observer is tracked
+	Window int64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	// minimum number of ballots an observer must vote on in the window, the
+	// observer is jailed if it misses more than window - min_voted_ballots
+	// ballots
+	MinVotedBallots int64 `protobuf:"varint,3,opt,name=min_voted_ballots,json=minVotedBallots,proto3" json:"min_voted_ballots,omitempty"`
+	// number of blocks an observer is jailed for before it can be unjailed
+	JailDuration int64 `protobuf:"varint,4,opt,name=jail_duration,json=jailDuration,proto3" json:"jail_duration,omitempty"`
+	// number of blocks after the creation of a ballot at which the
+	// participation of the voters is tracked, it can't exceed the emissions
+	// ballot maturity blocks since the matured ballots are deleted
+	BallotMaturityBlocks int64 `protobuf:"varint,5,opt,name=ballot_maturity_blocks,json=ballotMaturityBlocks,proto3" json:"ballot_maturity_blocks,omitempty"`
+}
+
+func (m *LivenessParams) Reset()         { *m = LivenessParams{} }
+func (m *LivenessParams) String() string { return proto.CompactTextString(m) }
+func (*LivenessParams) ProtoMessage()    {}
+func (*LivenessParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_983fb36a74c70e3c, []int{1}
+}
+func (m *LivenessParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LivenessParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LivenessParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LivenessParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LivenessParams.Merge(m, src)
+}
+func (m *LivenessParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *LivenessParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_LivenessParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LivenessParams proto.InternalMessageInfo
+
+func (m *LivenessParams) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *LivenessParams) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *LivenessParams) GetMinVotedBallots() int64 {
+	if m != nil {
+		return m.MinVotedBallots
+	}
+	return 0
+}
+
+func (m *LivenessParams) GetJailDuration() int64 {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
+func (m *LivenessParams) GetBallotMaturityBlocks() int64 {
+	if m != nil {
+		return m.BallotMaturityBlocks
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ObserverSigningInfo)(nil), "zetachain.zetacore.observer.ObserverSigningInfo")
+	proto.RegisterType((*LivenessParams)(nil), "zetachain.zetacore.observer.LivenessParams")
+}
+
+func init() {
+	proto.RegisterFile("zetachain/zetacore/observer/liveness.proto", fileDescriptor_983fb36a74c70e3c)
+}
+
+var fileDescriptor_983fb36a74c70e3c = []byte{
+	// 431 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x41, 0x6e, 0x13, 0x31,
+	0x14, 0x86, 0xe3, 0x06, 0x52, 0x30, 0x85, 0x82, 0x5b, 0xaa, 0x91, 0x90, 0x46, 0xa1, 0x6c, 0x42,
+	0x11, 0x89, 0x04, 0x5c, 0x80, 0x00, 0x12, 0x48, 0xa0, 0xa2, 0x41, 0xb0, 0x60, 0x63, 0x79, 0x32,
+	0x2f, 0x99, 0x07, 0x33, 0x76, 0x64, 0xbf, 0x49, 0x5b, 0x4e, 0xc1, 0x3d, 0xb8, 0x08, 0xec, 0xba,
+	0x64, 0x89, 0x92, 0x8b, 0xa0, 0xb1, 0x3d, 0x54, 0x74, 0xe7, 0xf7, 0xfd, 0xbf, 0xed, 0x5f, 0xbf,
+	0x1e, 0x3f, 0xfa, 0x06, 0xa4, 0x66, 0xa5, 0x42, 0x3d, 0xf1, 0x27, 0x63, 0x61, 0x62, 0x72, 0x07,
+	0x76, 0x05, 0x76, 0x52, 0xe1, 0x0a, 0x34, 0x38, 0x37, 0x5e, 0x5a, 0x43, 0x46, 0xdc, 0xfb, 0xe7,
+	0x1d, 0x77, 0xde, 0x71, 0xe7, 0x3d, 0xfc, 0xb1, 0xc5, 0xf7, 0x8e, 0xe3, 0xf0, 0x01, 0x17, 0x1a,
+	0xf5, 0xe2, 0x8d, 0x9e, 0x1b, 0xf1, 0x90, 0xdf, 0xee, 0x3c, 0x52, 0x15, 0x85, 0x05, 0xe7, 0x12,
+	0x36, 0x64, 0xa3, 0xeb, 0xd9, 0x6e, 0xc7, 0x9f, 0x07, 0x2c, 0xee, 0xf3, 0x1d, 0x47, 0xca, 0x92,
+	0x2c, 0x01, 0x17, 0x25, 0x25, 0x5b, 0x43, 0x36, 0xea, 0x67, 0x37, 0x3c, 0x7b, 0xed, 0x51, 0x6b,
+	0x41, 0x5d, 0xc0, 0xa9, 0x34, 0xf3, 0xb9, 0x03, 0x4a, 0xfa, 0xc1, 0xe2, 0xd9, 0xb1, 0x47, 0xe2,
+	0x19, 0x3f, 0xa8, 0xd1, 0x39, 0x28, 0x64, 0xae, 0xaa, 0xca, 0x90, 0x93, 0x33, 0xd3, 0x68, 0x02,
+	0x9b, 0x5c, 0xf1, 0xe6, 0xfd, 0xa0, 0x4e, 0x83, 0xf8, 0x22, 0x68, 0xe2, 0x09, 0xbf, 0x7b, 0xe9,
+	0x56, 0x8e, 0x54, 0xab, 0x65, 0x72, 0x75, 0xc8, 0x46, 0x3b, 0xd9, 0xde, 0x7f, 0x97, 0xa6, 0x5e,
+	0x12, 0x07, 0x7c, 0xf0, 0x45, 0x61, 0x05, 0x45, 0x32, 0x18, 0xb2, 0xd1, 0xb5, 0x2c, 0x4e, 0x6d,
+	0xc8, 0x70, 0x92, 0x8d, 0x26, 0xac, 0x92, 0xed, 0x10, 0x32, 0xb0, 0x8f, 0x2d, 0x3a, 0xfc, 0xc5,
+	0xf8, 0xad, 0xb7, 0xb1, 0xdd, 0xf7, 0xca, 0xaa, 0xda, 0x89, 0x84, 0x6f, 0x83, 0x56, 0x79, 0xfb,
+	0x1c, 0xf3, 0xcf, 0x75, 0x63, 0xfb, 0xcf, 0x09, 0xea, 0xc2, 0x9c, 0xc4, 0x46, 0xe2, 0x24, 0x8e,
+	0xf8, 0x9d, 0x1a, 0xb5, 0x5c, 0x19, 0xba, 0x88, 0x1d, 0x1b, 0xd9, 0xad, 0x51, 0x7f, 0x6a, 0x79,
+	0x4c, 0x2c, 0x1e, 0xf0, 0x9b, 0xed, 0xff, 0xb2, 0x68, 0xac, 0x22, 0x34, 0x3a, 0x96, 0xe1, 0x83,
+	0xbe, 0x8c, 0xac, 0xad, 0x2e, 0x3c, 0x23, 0x6b, 0x45, 0x8d, 0x45, 0x3a, 0x93, 0x79, 0x65, 0x66,
+	0x5f, 0x9d, 0x6f, 0xa1, 0x9f, 0xed, 0x07, 0xf5, 0x5d, 0x14, 0xa7, 0x5e, 0x9b, 0xbe, 0xfa, 0xb9,
+	0x4e, 0xd9, 0xf9, 0x3a, 0x65, 0x7f, 0xd6, 0x29, 0xfb, 0xbe, 0x49, 0x7b, 0xe7, 0x9b, 0xb4, 0xf7,
+	0x7b, 0x93, 0xf6, 0x3e, 0x3f, 0x5a, 0x20, 0x95, 0x4d, 0x3e, 0x9e, 0x99, 0xda, 0x6f, 0xd7, 0xe3,
+	0xb0, 0x68, 0xda, 0x14, 0x30, 0x39, 0xbd, 0x58, 0x33, 0x3a, 0x5b, 0x82, 0xcb, 0x07, 0x7e, 0xc9,
+	0x9e, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xda, 0x64, 0x1c, 0x4c, 0x92, 0x02, 0x00, 0x00,
+}
+
+func (m *ObserverSigningInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObserverSigningInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObserverSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailedUntil != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.JailedUntil))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.MissedBallotsBitmap) > 0 {
+		i -= len(m.MissedBallotsBitmap)
+		copy(dAtA[i:], m.MissedBallotsBitmap)
+		i = encodeVarintLiveness(dAtA, i, uint64(len(m.MissedBallotsBitmap)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MissedBallotsCounter != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.MissedBallotsCounter))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintLiveness(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LivenessParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LivenessParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LivenessParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BallotMaturityBlocks != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.BallotMaturityBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.JailDuration != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.JailDuration))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinVotedBallots != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.MinVotedBallots))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Window != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLiveness(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLiveness(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ObserverSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovLiveness(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovLiveness(uint64(m.StartHeight))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovLiveness(uint64(m.IndexOffset))
+	}
+	if m.MissedBallotsCounter != 0 {
+		n += 1 + sovLiveness(uint64(m.MissedBallotsCounter))
+	}
+	l = len(m.MissedBallotsBitmap)
+	if l > 0 {
+		n += 1 + l + sovLiveness(uint64(l))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.JailedUntil != 0 {
+		n += 1 + sovLiveness(uint64(m.JailedUntil))
+	}
+	return n
+}
+
+func (m *LivenessParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.Window != 0 {
+		n += 1 + sovLiveness(uint64(m.Window))
+	}
+	if m.MinVotedBallots != 0 {
+		n += 1 + sovLiveness(uint64(m.MinVotedBallots))
+	}
+	if m.JailDuration != 0 {
+		n += 1 + sovLiveness(uint64(m.JailDuration))
+	}
+	if m.BallotMaturityBlocks != 0 {
+		n += 1 + sovLiveness(uint64(m.BallotMaturityBlocks))
+	}
+	return n
+}
+
+func sovLiveness(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLiveness(x uint64) (n int) {
+	return sovLiveness(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ObserverSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiveness
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObserverSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObserverSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBallotsCounter", wireType)
+			}
+			m.MissedBallotsCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBallotsCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBallotsBitmap", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedBallotsBitmap = append(m.MissedBallotsBitmap[:0], dAtA[iNdEx:postIndex]...)
+			if m.MissedBallotsBitmap == nil {
+				m.MissedBallotsBitmap = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			m.JailedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiveness(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LivenessParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiveness
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LivenessParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LivenessParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVotedBallots", wireType)
+			}
+			m.MinVotedBallots = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinVotedBallots |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			m.JailDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotMaturityBlocks", wireType)
+			}
+			m.BallotMaturityBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BallotMaturityBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiveness(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLiveness(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLiveness
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLiveness
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLiveness
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLiveness
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLiveness        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLiveness          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLiveness = fmt.Errorf("proto: unexpected end of group")
+)
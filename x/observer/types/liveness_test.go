@@ -0,0 +1,175 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestObserverSigningInfo_MissedBallot(t *testing.T) {
+	t.Run("should set and get missed ballots in the window", func(t *testing.T) {
+		const window = 100
+		info := types.NewObserverSigningInfo(sample.AccAddress(), 10, window)
+		require.False(t, info.GetMissedBallot(0, window))
+		require.False(t, info.GetMissedBallot(9, window))
+
+		info.SetMissedBallot(0, window, true)
+		info.SetMissedBallot(9, window, true)
+		require.True(t, info.GetMissedBallot(0, window))
+		require.True(t, info.GetMissedBallot(9, window))
+		require.False(t, info.GetMissedBallot(8, window))
+
+		// the index wraps around the window
+		require.True(t, info.GetMissedBallot(window, window))
+		info.SetMissedBallot(window+9, window, false)
+		require.False(t, info.GetMissedBallot(9, window))
+	})
+
+	t.Run("should allocate the bitmap if empty", func(t *testing.T) {
+		info := types.ObserverSigningInfo{}
+		require.False(t, info.GetMissedBallot(5, 100))
+
+		info.SetMissedBallot(5, 100, true)
+		require.True(t, info.GetMissedBallot(5, 100))
+	})
+
+	t.Run("should grow the bitmap if the window is increased", func(t *testing.T) {
+		info := types.NewObserverSigningInfo(sample.AccAddress(), 10, 8)
+		info.SetMissedBallot(3, 8, true)
+
+		info.SetMissedBallot(50, 100, true)
+		require.True(t, info.GetMissedBallot(3, 100))
+		require.True(t, info.GetMissedBallot(50, 100))
+	})
+}
+
+func TestObserverSigningInfo_ResetWindow(t *testing.T) {
+	info := types.NewObserverSigningInfo(sample.AccAddress(), 10, 100)
+	info.IndexOffset = 42
+	info.MissedBallotsCounter = 3
+	info.SetMissedBallot(1, 100, true)
+
+	info.ResetWindow(100, 200)
+
+	require.EqualValues(t, 100, info.StartHeight)
+	require.Zero(t, info.IndexOffset)
+	require.Zero(t, info.MissedBallotsCounter)
+	require.Len(t, info.MissedBallotsBitmap, 25)
+	require.False(t, info.GetMissedBallot(1, 200))
+}
+
+func TestObserverSigningInfo_IsLivenessFaulty(t *testing.T) {
+	params := types.LivenessParams{Enabled: true, Window: 100, MinVotedBallots: 60, JailDuration: 10, BallotMaturityBlocks: 10}
+	maxMissed := params.Window - params.MinVotedBallots
+
+	tests := []struct {
+		name        string
+		indexOffset int64
+		missed      int64
+		faulty      bool
+	}{
+		{
+			name:        "window not full",
+			indexOffset: params.Window - 1,
+			missed:      maxMissed + 1,
+			faulty:      false,
+		},
+		{
+			name:        "missed ballots below the maximum",
+			indexOffset: params.Window,
+			missed:      maxMissed,
+			faulty:      false,
+		},
+		{
+			name:        "missed ballots above the maximum",
+			indexOffset: params.Window,
+			missed:      maxMissed + 1,
+			faulty:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := types.ObserverSigningInfo{IndexOffset: tt.indexOffset, MissedBallotsCounter: tt.missed}
+			require.Equal(t, tt.faulty, info.IsLivenessFaulty(params))
+		})
+	}
+}
+
+func TestLivenessParams_Validate(t *testing.T) {
+	enabled := func(update func(*types.LivenessParams)) types.LivenessParams {
+		params := types.DefaultLivenessParams()
+		params.Enabled = true
+		update(&params)
+		return params
+	}
+
+	tests := []struct {
+		name    string
+		params  types.LivenessParams
+		isValid bool
+	}{
+		{
+			name:    "default params",
+			params:  types.DefaultLivenessParams(),
+			isValid: true,
+		},
+		{
+			name:    "disabled empty params",
+			params:  types.LivenessParams{},
+			isValid: true,
+		},
+		{
+			name:    "disabled negative params",
+			params:  types.LivenessParams{JailDuration: -1},
+			isValid: false,
+		},
+		{
+			name:    "enabled default params",
+			params:  enabled(func(*types.LivenessParams) {}),
+			isValid: true,
+		},
+		{
+			name:    "enabled empty window",
+			params:  enabled(func(p *types.LivenessParams) { p.Window = 0 }),
+			isValid: false,
+		},
+		{
+			name:    "enabled window too large",
+			params:  enabled(func(p *types.LivenessParams) { p.Window = types.MaxLivenessWindow + 1 }),
+			isValid: false,
+		},
+		{
+			name:    "enabled no min voted ballots",
+			params:  enabled(func(p *types.LivenessParams) { p.MinVotedBallots = 0 }),
+			isValid: false,
+		},
+		{
+			name:    "enabled min voted ballots above the window",
+			params:  enabled(func(p *types.LivenessParams) { p.MinVotedBallots = p.Window + 1 }),
+			isValid: false,
+		},
+		{
+			name:    "enabled no jail duration",
+			params:  enabled(func(p *types.LivenessParams) { p.JailDuration = 0 }),
+			isValid: false,
+		},
+		{
+			name:    "enabled no ballot maturity",
+			params:  enabled(func(p *types.LivenessParams) { p.BallotMaturityBlocks = 0 }),
+			isValid: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.isValid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidLivenessParams)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelObserverSetChange = "cancel_observer_set_change"
+
+var _ sdk.Msg = &MsgCancelObserverSetChange{}
+
+func NewMsgCancelObserverSetChange(creator string) *MsgCancelObserverSetChange {
+	return &MsgCancelObserverSetChange{
+		Creator: creator,
+	}
+}
+
+func (msg *MsgCancelObserverSetChange) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelObserverSetChange) Type() string {
+	return TypeMsgCancelObserverSetChange
+}
+
+func (msg *MsgCancelObserverSetChange) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelObserverSetChange) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelObserverSetChange) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
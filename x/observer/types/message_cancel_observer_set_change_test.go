@@ -0,0 +1,89 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestMsgCancelObserverSetChange_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgCancelObserverSetChange
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  types.NewMsgCancelObserverSetChange("invalid_address"),
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg:  types.NewMsgCancelObserverSetChange(sample.AccAddress()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgCancelObserverSetChange_GetSigners(t *testing.T) {
+	signer := sample.AccAddress()
+	tests := []struct {
+		name   string
+		msg    *types.MsgCancelObserverSetChange
+		panics bool
+	}{
+		{
+			name:   "valid signer",
+			msg:    types.NewMsgCancelObserverSetChange(signer),
+			panics: false,
+		},
+		{
+			name:   "invalid signer",
+			msg:    types.NewMsgCancelObserverSetChange("invalid"),
+			panics: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.panics {
+				signers := tt.msg.GetSigners()
+				require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(signer)}, signers)
+			} else {
+				require.Panics(t, func() {
+					tt.msg.GetSigners()
+				})
+			}
+		})
+	}
+}
+
+func TestMsgCancelObserverSetChange_Type(t *testing.T) {
+	msg := types.NewMsgCancelObserverSetChange(sample.AccAddress())
+	require.Equal(t, types.TypeMsgCancelObserverSetChange, msg.Type())
+}
+
+func TestMsgCancelObserverSetChange_Route(t *testing.T) {
+	msg := types.NewMsgCancelObserverSetChange(sample.AccAddress())
+	require.Equal(t, types.RouterKey, msg.Route())
+}
+
+func TestMsgCancelObserverSetChange_GetSignBytes(t *testing.T) {
+	msg := types.NewMsgCancelObserverSetChange(sample.AccAddress())
+	require.NotPanics(t, func() {
+		msg.GetSignBytes()
+	})
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgProposeObserverSetChange = "propose_observer_set_change"
+
+var _ sdk.Msg = &MsgProposeObserverSetChange{}
+
+func NewMsgProposeObserverSetChange(
+	creator string,
+	additions []ObserverSetAddition,
+	removals []string,
+	activationHeight int64,
+	eddsa bool,
+) *MsgProposeObserverSetChange {
+	return &MsgProposeObserverSetChange{
+		Creator:          creator,
+		Additions:        additions,
+		Removals:         removals,
+		ActivationHeight: activationHeight,
+		Eddsa:            eddsa,
+	}
+}
+
+func (msg *MsgProposeObserverSetChange) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgProposeObserverSetChange) Type() string {
+	return TypeMsgProposeObserverSetChange
+}
+
+func (msg *MsgProposeObserverSetChange) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgProposeObserverSetChange) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgProposeObserverSetChange) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.Additions) == 0 && len(msg.Removals) == 0 {
+		return cosmoserrors.Wrap(ErrInvalidObserverSetChange, "no observers to add or remove")
+	}
+	if msg.ActivationHeight <= 0 {
+		return cosmoserrors.Wrap(ErrInvalidObserverSetChange, "activation height must be positive")
+	}
+
+	observers := make(map[string]bool, len(msg.Additions)+len(msg.Removals))
+	for _, addition := range msg.Additions {
+		if err := addition.Validate(); err != nil {
+			return err
+		}
+		if observers[addition.ObserverAddress] {
+			return cosmoserrors.Wrapf(ErrInvalidObserverSetChange, "duplicate observer %s", addition.ObserverAddress)
+		}
+		observers[addition.ObserverAddress] = true
+	}
+	for _, removal := range msg.Removals {
+		if _, err := sdk.AccAddressFromBech32(removal); err != nil {
+			return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid observer address (%s)", err)
+		}
+		if observers[removal] {
+			return cosmoserrors.Wrapf(ErrInvalidObserverSetChange, "duplicate observer %s", removal)
+		}
+		observers[removal] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestMsgProposeObserverSetChange_ValidateBasic(t *testing.T) {
+	addition := types.ObserverSetAddition{
+		ObserverAddress:         sample.AccAddress(),
+		ZetaclientGranteePubkey: sample.PubKeyString(),
+	}
+
+	tests := []struct {
+		name string
+		msg  *types.MsgProposeObserverSetChange
+		err  error
+	}{
+		{
+			name: "invalid creator",
+			msg: types.NewMsgProposeObserverSetChange(
+				"invalid_address",
+				[]types.ObserverSetAddition{addition},
+				nil,
+				1000,
+				false,
+			),
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "no additions or removals",
+			msg:  types.NewMsgProposeObserverSetChange(sample.AccAddress(), nil, nil, 1000, false),
+			err:  types.ErrInvalidObserverSetChange,
+		},
+		{
+			name: "invalid activation height",
+			msg: types.NewMsgProposeObserverSetChange(
+				sample.AccAddress(),
+				[]types.ObserverSetAddition{addition},
+				nil,
+				0,
+				false,
+			),
+			err: types.ErrInvalidObserverSetChange,
+		},
+		{
+			name: "invalid addition pubkey",
+			msg: types.NewMsgProposeObserverSetChange(
+				sample.AccAddress(),
+				[]types.ObserverSetAddition{{ObserverAddress: sample.AccAddress(), ZetaclientGranteePubkey: "invalid"}},
+				nil,
+				1000,
+				false,
+			),
+			err: sdkerrors.ErrInvalidPubKey,
+		},
+		{
+			name: "invalid removal address",
+			msg: types.NewMsgProposeObserverSetChange(
+				sample.AccAddress(),
+				nil,
+				[]string{"invalid_address"},
+				1000,
+				false,
+			),
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "observer both added and removed",
+			msg: types.NewMsgProposeObserverSetChange(
+				sample.AccAddress(),
+				[]types.ObserverSetAddition{addition},
+				[]string{addition.ObserverAddress},
+				1000,
+				false,
+			),
+			err: types.ErrInvalidObserverSetChange,
+		},
+		{
+			name: "duplicate removal",
+			msg: types.NewMsgProposeObserverSetChange(
+				sample.AccAddress(),
+				nil,
+				[]string{addition.ObserverAddress, addition.ObserverAddress},
+				1000,
+				false,
+			),
+			err: types.ErrInvalidObserverSetChange,
+		},
+		{
+			name: "valid",
+			msg: types.NewMsgProposeObserverSetChange(
+				sample.AccAddress(),
+				[]types.ObserverSetAddition{addition},
+				[]string{sample.AccAddress()},
+				1000,
+				true,
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgProposeObserverSetChange_GetSigners(t *testing.T) {
+	signer := sample.AccAddress()
+
+	msg := types.MsgProposeObserverSetChange{Creator: signer}
+	require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(signer)}, msg.GetSigners())
+
+	msg = types.MsgProposeObserverSetChange{Creator: "invalid"}
+	require.Panics(t, func() {
+		msg.GetSigners()
+	})
+}
+
+func TestMsgProposeObserverSetChange_Type(t *testing.T) {
+	msg := types.MsgProposeObserverSetChange{
+		Creator: sample.AccAddress(),
+	}
+	require.Equal(t, types.TypeMsgProposeObserverSetChange, msg.Type())
+}
+
+func TestMsgProposeObserverSetChange_Route(t *testing.T) {
+	msg := types.MsgProposeObserverSetChange{
+		Creator: sample.AccAddress(),
+	}
+	require.Equal(t, types.RouterKey, msg.Route())
+}
+
+func TestMsgProposeObserverSetChange_GetSignBytes(t *testing.T) {
+	msg := types.MsgProposeObserverSetChange{
+		Creator: sample.AccAddress(),
+	}
+	require.NotPanics(t, func() {
+		msg.GetSignBytes()
+	})
+}
@@ -0,0 +1,75 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestMsgUnjailObserver_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgUnjailObserver
+		err  error
+	}{
+		{
+			name: "invalid creator",
+			msg:  types.NewMsgUnjailObserver("invalid_address"),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "valid",
+			msg:  types.NewMsgUnjailObserver(sample.AccAddress()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUnjailObserver_GetSigners(t *testing.T) {
+	signer := sample.AccAddress()
+
+	msg := types.MsgUnjailObserver{Creator: signer}
+	require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(signer)}, msg.GetSigners())
+
+	msg = types.MsgUnjailObserver{Creator: "invalid"}
+	require.Panics(t, func() {
+		msg.GetSigners()
+	})
+}
+
+func TestMsgUnjailObserver_Type(t *testing.T) {
+	msg := types.MsgUnjailObserver{
+		Creator: sample.AccAddress(),
+	}
+	require.Equal(t, types.TypeMsgUnjailObserver, msg.Type())
+}
+
+func TestMsgUnjailObserver_Route(t *testing.T) {
+	msg := types.MsgUnjailObserver{
+		Creator: sample.AccAddress(),
+	}
+	require.Equal(t, types.RouterKey, msg.Route())
+}
+
+func TestMsgUnjailObserver_GetSignBytes(t *testing.T) {
+	msg := types.MsgUnjailObserver{
+		Creator: sample.AccAddress(),
+	}
+	require.NotPanics(t, func() {
+		msg.GetSignBytes()
+	})
+}
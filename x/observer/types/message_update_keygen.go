@@ -10,10 +10,11 @@ const TypeMsgUpdateKeygen = "update_keygen"
 
 var _ sdk.Msg = &MsgUpdateKeygen{}
 
-func NewMsgUpdateKeygen(creator string, block int64) *MsgUpdateKeygen {
+func NewMsgUpdateKeygen(creator string, block int64, eddsa bool) *MsgUpdateKeygen {
 	return &MsgUpdateKeygen{
 		Creator: creator,
 		Block:   block,
+		Eddsa:   eddsa,
 	}
 }
 
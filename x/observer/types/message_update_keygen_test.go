@@ -22,6 +22,7 @@ func TestMsgUpdateKeygen_ValidateBasic(t *testing.T) {
 			msg: types.NewMsgUpdateKeygen(
 				"invalid_address",
 				1,
+				false,
 			),
 			err: sdkerrors.ErrInvalidAddress,
 		}, {
@@ -29,6 +30,14 @@ func TestMsgUpdateKeygen_ValidateBasic(t *testing.T) {
 			msg: types.NewMsgUpdateKeygen(
 				sample.AccAddress(),
 				1,
+				false,
+			),
+		}, {
+			name: "valid address with eddsa",
+			msg: types.NewMsgUpdateKeygen(
+				sample.AccAddress(),
+				1,
+				true,
 			),
 		},
 	}
@@ -0,0 +1,99 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestMsgUpdateLivenessParams_ValidateBasic(t *testing.T) {
+	enabled := types.DefaultLivenessParams()
+	enabled.Enabled = true
+
+	invalid := enabled
+	invalid.MinVotedBallots = enabled.Window + 1
+
+	tests := []struct {
+		name string
+		msg  *types.MsgUpdateLivenessParams
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  types.NewMsgUpdateLivenessParams("invalid_address", enabled),
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid params",
+			msg:  types.NewMsgUpdateLivenessParams(sample.AccAddress(), invalid),
+			err:  types.ErrInvalidLivenessParams,
+		}, {
+			name: "valid",
+			msg:  types.NewMsgUpdateLivenessParams(sample.AccAddress(), enabled),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateLivenessParams_GetSigners(t *testing.T) {
+	signer := sample.AccAddress()
+	tests := []struct {
+		name   string
+		msg    *types.MsgUpdateLivenessParams
+		panics bool
+	}{
+		{
+			name:   "valid signer",
+			msg:    types.NewMsgUpdateLivenessParams(signer, types.DefaultLivenessParams()),
+			panics: false,
+		},
+		{
+			name:   "invalid signer",
+			msg:    types.NewMsgUpdateLivenessParams("invalid", types.DefaultLivenessParams()),
+			panics: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.panics {
+				signers := tt.msg.GetSigners()
+				require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(signer)}, signers)
+			} else {
+				require.Panics(t, func() {
+					tt.msg.GetSigners()
+				})
+			}
+		})
+	}
+}
+
+func TestMsgUpdateLivenessParams_Type(t *testing.T) {
+	msg := types.NewMsgUpdateLivenessParams(sample.AccAddress(), types.DefaultLivenessParams())
+	require.Equal(t, types.TypeMsgUpdateLivenessParams, msg.Type())
+}
+
+func TestMsgUpdateLivenessParams_Route(t *testing.T) {
+	msg := types.NewMsgUpdateLivenessParams(sample.AccAddress(), types.DefaultLivenessParams())
+	require.Equal(t, types.RouterKey, msg.Route())
+}
+
+func TestMsgUpdateLivenessParams_GetSignBytes(t *testing.T) {
+	msg := types.NewMsgUpdateLivenessParams(sample.AccAddress(), types.DefaultLivenessParams())
+	require.NotPanics(t, func() {
+		msg.GetSignBytes()
+	})
+}
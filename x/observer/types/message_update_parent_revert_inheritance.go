@@ -0,0 +1,51 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgUpdateParentRevertInheritance = "update_parent_revert_inheritance"
+)
+
+var _ sdk.Msg = &MsgUpdateParentRevertInheritance{}
+
+func NewMsgUpdateParentRevertInheritance(
+	creator string,
+	isParentRevertInheritanceEnabled bool,
+) *MsgUpdateParentRevertInheritance {
+	return &MsgUpdateParentRevertInheritance{
+		Creator:                          creator,
+		IsParentRevertInheritanceEnabled: isParentRevertInheritanceEnabled,
+	}
+}
+
+func (msg *MsgUpdateParentRevertInheritance) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateParentRevertInheritance) Type() string {
+	return TypeMsgUpdateParentRevertInheritance
+}
+
+func (msg *MsgUpdateParentRevertInheritance) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateParentRevertInheritance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateParentRevertInheritance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
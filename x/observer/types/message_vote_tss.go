@@ -4,20 +4,29 @@ import (
 	"fmt"
 
 	cosmoserrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/cosmos"
 )
 
 const TypeMsgVoteTSS = "VoteTSS"
 
 var _ sdk.Msg = &MsgVoteTSS{}
 
-func NewMsgVoteTSS(creator string, pubkey string, keygenZetaHeight int64, status chains.ReceiveStatus) *MsgVoteTSS {
+func NewMsgVoteTSS(
+	creator string,
+	pubkey string,
+	pubkeyEdDSA string,
+	keygenZetaHeight int64,
+	status chains.ReceiveStatus,
+) *MsgVoteTSS {
 	return &MsgVoteTSS{
 		Creator:          creator,
 		TssPubkey:        pubkey,
+		TssPubkeyEddsa:   pubkeyEdDSA,
 		KeygenZetaHeight: keygenZetaHeight,
 		Status:           status,
 	}
@@ -55,10 +64,24 @@ func (msg *MsgVoteTSS) ValidateBasic() error {
 		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid status: %s", msg.Status)
 	}
 
+	// the ed25519 key is optional, it is only generated if requested by the keygen
+	if msg.TssPubkeyEddsa != "" {
+		pubKey, err := cosmos.GetPubKeyFromBech32(cosmos.Bech32PubKeyTypeAccPub, msg.TssPubkeyEddsa)
+		if err != nil {
+			return cosmoserrors.Wrapf(sdkerrors.ErrInvalidPubKey, "invalid eddsa pubkey (%s)", err)
+		}
+		if _, ok := pubKey.(*ed25519.PubKey); !ok {
+			return cosmoserrors.Wrapf(sdkerrors.ErrInvalidPubKey, "eddsa pubkey is not an ed25519 key")
+		}
+	}
+
 	return nil
 }
 
 func (msg *MsgVoteTSS) Digest() string {
 	// We support only 1 keygen at a particular height
+	if msg.TssPubkeyEddsa != "" {
+		return fmt.Sprintf("%d-%s-%s-%s", msg.KeygenZetaHeight, msg.TssPubkey, msg.TssPubkeyEddsa, "tss-keygen")
+	}
 	return fmt.Sprintf("%d-%s-%s", msg.KeygenZetaHeight, msg.TssPubkey, "tss-keygen")
 }
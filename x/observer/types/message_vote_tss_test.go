@@ -20,22 +20,48 @@ func TestMsgVoteTSS_ValidateBasic(t *testing.T) {
 	}{
 		{
 			name: "valid message",
-			msg:  types.NewMsgVoteTSS(sample.AccAddress(), "pubkey", 1, chains.ReceiveStatus_success),
+			msg:  types.NewMsgVoteTSS(sample.AccAddress(), "pubkey", "", 1, chains.ReceiveStatus_success),
 		},
 		{
 			name: "valid message with receive status failed",
-			msg:  types.NewMsgVoteTSS(sample.AccAddress(), "pubkey", 1, chains.ReceiveStatus_failed),
+			msg:  types.NewMsgVoteTSS(sample.AccAddress(), "pubkey", "", 1, chains.ReceiveStatus_failed),
+		},
+		{
+			name: "valid message with eddsa pubkey",
+			msg: types.NewMsgVoteTSS(
+				sample.AccAddress(),
+				"pubkey",
+				sample.PubKeyString(),
+				1,
+				chains.ReceiveStatus_success,
+			),
 		},
 		{
 			name: "invalid creator address",
-			msg:  types.NewMsgVoteTSS("invalid", "pubkey", 1, chains.ReceiveStatus_success),
+			msg:  types.NewMsgVoteTSS("invalid", "pubkey", "", 1, chains.ReceiveStatus_success),
 			err:  sdkerrors.ErrInvalidAddress,
 		},
 		{
 			name: "invalid observation status",
-			msg:  types.NewMsgVoteTSS(sample.AccAddress(), "pubkey", 1, chains.ReceiveStatus_created),
+			msg:  types.NewMsgVoteTSS(sample.AccAddress(), "pubkey", "", 1, chains.ReceiveStatus_created),
 			err:  sdkerrors.ErrInvalidRequest,
 		},
+		{
+			name: "invalid eddsa pubkey",
+			msg:  types.NewMsgVoteTSS(sample.AccAddress(), "pubkey", "invalid", 1, chains.ReceiveStatus_success),
+			err:  sdkerrors.ErrInvalidPubKey,
+		},
+		{
+			name: "eddsa pubkey is not an ed25519 key",
+			msg: types.NewMsgVoteTSS(
+				sample.AccAddress(),
+				"pubkey",
+				sample.Secp256k1PubKeyString(),
+				1,
+				chains.ReceiveStatus_success,
+			),
+			err: sdkerrors.ErrInvalidPubKey,
+		},
 	}
 
 	for _, tt := range tests {
@@ -59,12 +85,12 @@ func TestMsgVoteTSS_GetSigners(t *testing.T) {
 	}{
 		{
 			name:   "valid signer",
-			msg:    types.NewMsgVoteTSS(signer, "pubkey", 1, chains.ReceiveStatus_success),
+			msg:    types.NewMsgVoteTSS(signer, "pubkey", "", 1, chains.ReceiveStatus_success),
 			panics: false,
 		},
 		{
 			name:   "invalid signer",
-			msg:    types.NewMsgVoteTSS("invalid", "pubkey", 1, chains.ReceiveStatus_success),
+			msg:    types.NewMsgVoteTSS("invalid", "pubkey", "", 1, chains.ReceiveStatus_success),
 			panics: true,
 		},
 	}
@@ -84,38 +110,43 @@ func TestMsgVoteTSS_GetSigners(t *testing.T) {
 }
 
 func TestMsgVoteTSS_Type(t *testing.T) {
-	msg := types.NewMsgVoteTSS(sample.AccAddress(), "pubkey", 1, chains.ReceiveStatus_success)
+	msg := types.NewMsgVoteTSS(sample.AccAddress(), "pubkey", "", 1, chains.ReceiveStatus_success)
 	require.Equal(t, types.TypeMsgVoteTSS, msg.Type())
 }
 
 func TestMsgVoteTSS_Route(t *testing.T) {
-	msg := types.NewMsgVoteTSS(sample.AccAddress(), "pubkey", 1, chains.ReceiveStatus_success)
+	msg := types.NewMsgVoteTSS(sample.AccAddress(), "pubkey", "", 1, chains.ReceiveStatus_success)
 	require.Equal(t, types.RouterKey, msg.Route())
 }
 
 func TestMsgVoteTSS_GetSignBytes(t *testing.T) {
-	msg := types.NewMsgVoteTSS(sample.AccAddress(), "pubkey", 1, chains.ReceiveStatus_success)
+	msg := types.NewMsgVoteTSS(sample.AccAddress(), "pubkey", "", 1, chains.ReceiveStatus_success)
 	require.NotPanics(t, func() {
 		msg.GetSignBytes()
 	})
 }
 
 func TestMsgVoteTSS_Digest(t *testing.T) {
-	vote1 := types.NewMsgVoteTSS(sample.AccAddress(), "pubkey", 1, chains.ReceiveStatus_success)
+	vote1 := types.NewMsgVoteTSS(sample.AccAddress(), "pubkey", "", 1, chains.ReceiveStatus_success)
 	require.Equal(t, "1-pubkey-tss-keygen", vote1.Digest())
 
-	vote2 := types.NewMsgVoteTSS(sample.AccAddress(), "pubkey", 1, chains.ReceiveStatus_success)
+	vote2 := types.NewMsgVoteTSS(sample.AccAddress(), "pubkey", "", 1, chains.ReceiveStatus_success)
 	require.Equal(t, "1-pubkey-tss-keygen", vote2.Digest())
 
 	require.Equal(t, vote1.Digest(), vote2.Digest())
 
-	vote3 := types.NewMsgVoteTSS(sample.AccAddress(), "pubkeyNew", 2, chains.ReceiveStatus_success)
+	vote3 := types.NewMsgVoteTSS(sample.AccAddress(), "pubkeyNew", "", 2, chains.ReceiveStatus_success)
 	require.Equal(t, "2-pubkeyNew-tss-keygen", vote3.Digest())
 	// Different pubkey changes digest
 	require.NotEqual(t, vote1.Digest(), vote3.Digest())
 
-	vote4 := types.NewMsgVoteTSS(sample.AccAddress(), "pubkey", 3, chains.ReceiveStatus_success)
+	vote4 := types.NewMsgVoteTSS(sample.AccAddress(), "pubkey", "", 3, chains.ReceiveStatus_success)
 	require.Equal(t, "3-pubkey-tss-keygen", vote4.Digest())
 	// Different height changes digest
 	require.NotEqual(t, vote1.Digest(), vote4.Digest())
+
+	vote5 := types.NewMsgVoteTSS(sample.AccAddress(), "pubkey", "pubkeyEdDSA", 1, chains.ReceiveStatus_success)
+	require.Equal(t, "1-pubkey-pubkeyEdDSA-tss-keygen", vote5.Digest())
+	// Different eddsa pubkey changes digest
+	require.NotEqual(t, vote1.Digest(), vote5.Digest())
 }
@@ -0,0 +1,43 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/zeta-chain/node/pkg/crypto"
+)
+
+// ObserverSetChangeKeygenDelay is the number of blocks between the proposal of an observer set change
+// and the keygen for the new observer set, it leaves time for the zetaclients to prepare the keygen
+const ObserverSetChangeKeygenDelay int64 = 100
+
+// IsInProgress returns true if the observer set change is neither activated nor rolled back
+func (c ObserverSetChange) IsInProgress() bool {
+	return c.Status == ObserverSetChangeStatus_KeygenPending ||
+		c.Status == ObserverSetChangeStatus_AwaitingActivation
+}
+
+// IsRemoved returns true if the observer set change removes the observer
+func (c ObserverSetChange) IsRemoved(observerAddress string) bool {
+	for _, removal := range c.Removals {
+		if removal == observerAddress {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks the observer address and the zetaclient grantee pubkey of the addition
+func (a ObserverSetAddition) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.ObserverAddress); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid observer address (%s)", err)
+	}
+	if _, err := crypto.NewPubKey(a.ZetaclientGranteePubkey); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidPubKey, "invalid zetaclient grantee pubkey (%s)", err)
+	}
+	if _, err := crypto.GetAddressFromPubkeyString(a.ZetaclientGranteePubkey); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidPubKey, "invalid zetaclient grantee pubkey (%s)", err)
+	}
+	return nil
+}
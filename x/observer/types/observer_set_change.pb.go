@@ -0,0 +1,916 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: zetachain/zetacore/observer/observer_set_change.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ObserverSetChangeStatus int32
+
+const (
+	// keygen for the new observer set is scheduled or running
+	ObserverSetChangeStatus_KeygenPending ObserverSetChangeStatus = 0
+	// keygen succeeded, waiting for the activation height and the new TSS to
+	// become the current TSS
+	ObserverSetChangeStatus_AwaitingActivation ObserverSetChangeStatus = 1
+	// the new observer set is active
+	ObserverSetChangeStatus_Activated ObserverSetChangeStatus = 2
+	// keygen failed or did not complete before the activation height, or the
+	// change was cancelled
+	ObserverSetChangeStatus_RolledBack ObserverSetChangeStatus = 3
+)
+
+var ObserverSetChangeStatus_name = map[int32]string{
+	0: "KeygenPending",
+	1: "AwaitingActivation",
+	2: "Activated",
+	3: "RolledBack",
+}
+
+var ObserverSetChangeStatus_value = map[string]int32{
+	"KeygenPending":      0,
+	"AwaitingActivation": 1,
+	"Activated":          2,
+	"RolledBack":         3,
+}
+
+func (x ObserverSetChangeStatus) String() string {
+	return proto.EnumName(ObserverSetChangeStatus_name, int32(x))
+}
+
+func (ObserverSetChangeStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1a3beef17124b65c, []int{0}
+}
+
+// ObserverSetAddition is an observer added by an observer set change
+type ObserverSetAddition struct {
+	ObserverAddress         string `protobuf:"bytes,1,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
+	ZetaclientGranteePubkey string `protobuf:"bytes,2,opt,name=zetaclient_grantee_pubkey,json=zetaclientGranteePubkey,proto3" json:"zetaclient_grantee_pubkey,omitempty"`
+}
+
+func (m *ObserverSetAddition) Reset()         { *m = ObserverSetAddition{} }
+func (m *ObserverSetAddition) String() string { return proto.CompactTextString(m) }
+func (*ObserverSetAddition) ProtoMessage()    {}
+func (*ObserverSetAddition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a3beef17124b65c, []int{0}
+}
+func (m *ObserverSetAddition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObserverSetAddition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObserverSetAddition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObserverSetAddition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObserverSetAddition.Merge(m, src)
+}
+func (m *ObserverSetAddition) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObserverSetAddition) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObserverSetAddition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObserverSetAddition proto.InternalMessageInfo
+
+func (m *ObserverSetAddition) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
+	}
+	return ""
+}
+
+func (m *ObserverSetAddition) GetZetaclientGranteePubkey() string {
+	if m != nil {
+		return m.ZetaclientGranteePubkey
+	}
+	return ""
+}
+
+// ObserverSetChange is a staged change of the observer set.
+// A keygen for the new observer set is scheduled when the change is proposed,
+// and the observer set is only updated once the TSS generated by this keygen
+// is the current TSS and the activation height is reached.
+type ObserverSetChange struct {
+	Additions        []ObserverSetAddition   `protobuf:"bytes,1,rep,name=additions,proto3" json:"additions"`
+	Removals         []string                `protobuf:"bytes,2,rep,name=removals,proto3" json:"removals,omitempty"`
+	ProposalHeight   int64                   `protobuf:"varint,3,opt,name=proposal_height,json=proposalHeight,proto3" json:"proposal_height,omitempty"`
+	KeygenHeight     int64                   `protobuf:"varint,4,opt,name=keygen_height,json=keygenHeight,proto3" json:"keygen_height,omitempty"`
+	ActivationHeight int64                   `protobuf:"varint,5,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	Status           ObserverSetChangeStatus `protobuf:"varint,6,opt,name=status,proto3,enum=zetachain.zetacore.observer.ObserverSetChangeStatus" json:"status,omitempty"`
+	// public key of the TSS generated for the new observer set
+	TssPubkey string `protobuf:"bytes,7,opt,name=tss_pubkey,json=tssPubkey,proto3" json:"tss_pubkey,omitempty"`
+	// keygen replaced by the keygen for the new observer set, restored on
+	// rollback
+	PreviousKeygen Keygen `protobuf:"bytes,8,opt,name=previous_keygen,json=previousKeygen,proto3" json:"previous_keygen"`
+}
+
+func (m *ObserverSetChange) Reset()         { *m = ObserverSetChange{} }
+func (m *ObserverSetChange) String() string { return proto.CompactTextString(m) }
+func (*ObserverSetChange) ProtoMessage()    {}
+func (*ObserverSetChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a3beef17124b65c, []int{1}
+}
+func (m *ObserverSetChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObserverSetChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObserverSetChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObserverSetChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObserverSetChange.Merge(m, src)
+}
+func (m *ObserverSetChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObserverSetChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObserverSetChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObserverSetChange proto.InternalMessageInfo
+
+func (m *ObserverSetChange) GetAdditions() []ObserverSetAddition {
+	if m != nil {
+		return m.Additions
+	}
+	return nil
+}
+
+func (m *ObserverSetChange) GetRemovals() []string {
+	if m != nil {
+		return m.Removals
+	}
+	return nil
+}
+
+func (m *ObserverSetChange) GetProposalHeight() int64 {
+	if m != nil {
+		return m.ProposalHeight
+	}
+	return 0
+}
+
+func (m *ObserverSetChange) GetKeygenHeight() int64 {
+	if m != nil {
+		return m.KeygenHeight
+	}
+	return 0
+}
+
+func (m *ObserverSetChange) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *ObserverSetChange) GetStatus() ObserverSetChangeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ObserverSetChangeStatus_KeygenPending
+}
+
+func (m *ObserverSetChange) GetTssPubkey() string {
+	if m != nil {
+		return m.TssPubkey
+	}
+	return ""
+}
+
+func (m *ObserverSetChange) GetPreviousKeygen() Keygen {
+	if m != nil {
+		return m.PreviousKeygen
+	}
+	return Keygen{}
+}
+
+func init() {
+	proto.RegisterEnum("zetachain.zetacore.observer.ObserverSetChangeStatus", ObserverSetChangeStatus_name, ObserverSetChangeStatus_value)
+	proto.RegisterType((*ObserverSetAddition)(nil), "zetachain.zetacore.observer.ObserverSetAddition")
+	proto.RegisterType((*ObserverSetChange)(nil), "zetachain.zetacore.observer.ObserverSetChange")
+}
+
+func init() {
+	proto.RegisterFile("zetachain/zetacore/observer/observer_set_change.proto", fileDescriptor_1a3beef17124b65c)
+}
+
+var fileDescriptor_1a3beef17124b65c = []byte{
+	// 505 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x8e, 0xd3, 0x30,
+	0x10, 0x86, 0xeb, 0xa6, 0x94, 0xad, 0x97, 0x76, 0x53, 0x83, 0xd8, 0x50, 0x44, 0xa8, 0x76, 0x0f,
+	0x04, 0x56, 0xa4, 0xa8, 0xc0, 0x85, 0x5b, 0x8b, 0x10, 0x48, 0x20, 0xb1, 0xca, 0x72, 0xe2, 0x12,
+	0xb9, 0xcd, 0x28, 0xb1, 0x9a, 0xb5, 0xa3, 0xd8, 0x2d, 0x14, 0xf1, 0x10, 0x3c, 0x04, 0x07, 0x5e,
+	0x83, 0xdb, 0x1e, 0xf7, 0xc8, 0x09, 0xa1, 0xf6, 0x45, 0x50, 0xed, 0x24, 0xe5, 0x00, 0x15, 0x37,
+	0x7b, 0xe6, 0x9b, 0xf9, 0x67, 0x7e, 0x0d, 0x7e, 0xfa, 0x09, 0x14, 0x9d, 0x26, 0x94, 0xf1, 0x81,
+	0x7e, 0x89, 0x1c, 0x06, 0x62, 0x22, 0x21, 0x5f, 0x40, 0x5e, 0x3d, 0x42, 0x09, 0x2a, 0x9c, 0x26,
+	0x94, 0xc7, 0xe0, 0x67, 0xb9, 0x50, 0x82, 0xdc, 0xae, 0xca, 0xfc, 0xb2, 0xcc, 0x2f, 0xe9, 0xde,
+	0x8d, 0x58, 0xc4, 0x42, 0x73, 0x83, 0xcd, 0xcb, 0x94, 0xf4, 0xbc, 0x5d, 0x4a, 0x33, 0x58, 0xc6,
+	0xc0, 0x0d, 0x79, 0xf4, 0x19, 0x5f, 0x7f, 0x5b, 0x24, 0xce, 0x40, 0x8d, 0xa2, 0x88, 0x29, 0x26,
+	0x38, 0xb9, 0x8f, 0xed, 0x6a, 0x20, 0x1a, 0x45, 0x39, 0x48, 0xe9, 0xa0, 0x3e, 0xf2, 0x5a, 0xc1,
+	0x41, 0x19, 0x1f, 0x99, 0x30, 0x79, 0x86, 0x6f, 0x69, 0x8d, 0x94, 0x01, 0x57, 0x61, 0x9c, 0x53,
+	0xae, 0x00, 0xc2, 0x6c, 0x3e, 0x99, 0xc1, 0xd2, 0xa9, 0xeb, 0x9a, 0xc3, 0x2d, 0xf0, 0xd2, 0xe4,
+	0x4f, 0x75, 0xfa, 0xe8, 0xbb, 0x85, 0xbb, 0x7f, 0xc8, 0x3f, 0xd7, 0x6b, 0x93, 0x77, 0xb8, 0x45,
+	0x8b, 0x41, 0x36, 0xaa, 0x96, 0xb7, 0x3f, 0x7c, 0xe4, 0xef, 0x30, 0xc1, 0xff, 0xcb, 0x06, 0xe3,
+	0xc6, 0xc5, 0xcf, 0xbb, 0xb5, 0x60, 0xdb, 0x88, 0xf4, 0xf0, 0x5e, 0x0e, 0xe7, 0x62, 0x41, 0x53,
+	0xe9, 0xd4, 0xfb, 0x96, 0xd7, 0x0a, 0xaa, 0x3f, 0xb9, 0x87, 0x0f, 0xb2, 0x5c, 0x64, 0x42, 0xd2,
+	0x34, 0x4c, 0x80, 0xc5, 0x89, 0x72, 0xac, 0x3e, 0xf2, 0xac, 0xa0, 0x53, 0x86, 0x5f, 0xe9, 0x28,
+	0x39, 0xc6, 0x6d, 0x63, 0x5f, 0x89, 0x35, 0x34, 0x76, 0xcd, 0x04, 0x0b, 0xe8, 0x04, 0x77, 0xe9,
+	0x54, 0xb1, 0x05, 0xdd, 0x08, 0x97, 0xe0, 0x15, 0x0d, 0xda, 0xdb, 0x44, 0x01, 0xbf, 0xc1, 0x4d,
+	0xa9, 0xa8, 0x9a, 0x4b, 0xa7, 0xd9, 0x47, 0x5e, 0x67, 0xf8, 0xe4, 0x7f, 0x37, 0x35, 0x66, 0x9d,
+	0xe9, 0xda, 0xa0, 0xe8, 0x41, 0xee, 0x60, 0xac, 0xa4, 0x2c, 0xdd, 0xbf, 0xaa, 0xdd, 0x6f, 0x29,
+	0x29, 0x8d, 0xdf, 0x24, 0xd8, 0xec, 0x09, 0x0b, 0x26, 0xe6, 0x32, 0x34, 0x23, 0x3b, 0x7b, 0x7d,
+	0xe4, 0xed, 0x0f, 0x8f, 0x77, 0xaa, 0xbe, 0xd6, 0x68, 0x61, 0x69, 0xa7, 0xec, 0x60, 0xa2, 0x0f,
+	0x18, 0x3e, 0xfc, 0xc7, 0x54, 0xa4, 0x8b, 0xdb, 0x06, 0x3a, 0x05, 0x1e, 0x31, 0x1e, 0xdb, 0x35,
+	0x72, 0x13, 0x93, 0xd1, 0x07, 0xca, 0x14, 0xe3, 0xf1, 0xa8, 0xb2, 0xc2, 0x46, 0xa4, 0x8d, 0x5b,
+	0xc5, 0x1f, 0x22, 0xbb, 0x4e, 0x3a, 0x18, 0x07, 0x22, 0x4d, 0x21, 0x1a, 0xd3, 0xe9, 0xcc, 0xb6,
+	0x7a, 0x8d, 0x6f, 0x5f, 0x5d, 0x34, 0x7e, 0x71, 0xb1, 0x72, 0xd1, 0xe5, 0xca, 0x45, 0xbf, 0x56,
+	0x2e, 0xfa, 0xb2, 0x76, 0x6b, 0x97, 0x6b, 0xb7, 0xf6, 0x63, 0xed, 0xd6, 0xde, 0x9f, 0xc4, 0x4c,
+	0x25, 0xf3, 0x89, 0x3f, 0x15, 0xe7, 0xfa, 0xe2, 0x1f, 0x9a, 0xe3, 0xe7, 0x22, 0x82, 0xc1, 0xc7,
+	0xed, 0xe9, 0xab, 0x65, 0x06, 0x72, 0xd2, 0xd4, 0xa7, 0xff, 0xf8, 0x77, 0x00, 0x00, 0x00, 0xff,
+	0xff, 0x4f, 0xb1, 0xd2, 0xc0, 0x90, 0x03, 0x00, 0x00,
+}
+
+func (m *ObserverSetAddition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObserverSetAddition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObserverSetAddition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ZetaclientGranteePubkey) > 0 {
+		i -= len(m.ZetaclientGranteePubkey)
+		copy(dAtA[i:], m.ZetaclientGranteePubkey)
+		i = encodeVarintObserverSetChange(dAtA, i, uint64(len(m.ZetaclientGranteePubkey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintObserverSetChange(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ObserverSetChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObserverSetChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObserverSetChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PreviousKeygen.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintObserverSetChange(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.TssPubkey) > 0 {
+		i -= len(m.TssPubkey)
+		copy(dAtA[i:], m.TssPubkey)
+		i = encodeVarintObserverSetChange(dAtA, i, uint64(len(m.TssPubkey)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Status != 0 {
+		i = encodeVarintObserverSetChange(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintObserverSetChange(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.KeygenHeight != 0 {
+		i = encodeVarintObserverSetChange(dAtA, i, uint64(m.KeygenHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ProposalHeight != 0 {
+		i = encodeVarintObserverSetChange(dAtA, i, uint64(m.ProposalHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Removals) > 0 {
+		for iNdEx := len(m.Removals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Removals[iNdEx])
+			copy(dAtA[i:], m.Removals[iNdEx])
+			i = encodeVarintObserverSetChange(dAtA, i, uint64(len(m.Removals[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Additions) > 0 {
+		for iNdEx := len(m.Additions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Additions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintObserverSetChange(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintObserverSetChange(dAtA []byte, offset int, v uint64) int {
+	offset -= sovObserverSetChange(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ObserverSetAddition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovObserverSetChange(uint64(l))
+	}
+	l = len(m.ZetaclientGranteePubkey)
+	if l > 0 {
+		n += 1 + l + sovObserverSetChange(uint64(l))
+	}
+	return n
+}
+
+func (m *ObserverSetChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Additions) > 0 {
+		for _, e := range m.Additions {
+			l = e.Size()
+			n += 1 + l + sovObserverSetChange(uint64(l))
+		}
+	}
+	if len(m.Removals) > 0 {
+		for _, s := range m.Removals {
+			l = len(s)
+			n += 1 + l + sovObserverSetChange(uint64(l))
+		}
+	}
+	if m.ProposalHeight != 0 {
+		n += 1 + sovObserverSetChange(uint64(m.ProposalHeight))
+	}
+	if m.KeygenHeight != 0 {
+		n += 1 + sovObserverSetChange(uint64(m.KeygenHeight))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovObserverSetChange(uint64(m.ActivationHeight))
+	}
+	if m.Status != 0 {
+		n += 1 + sovObserverSetChange(uint64(m.Status))
+	}
+	l = len(m.TssPubkey)
+	if l > 0 {
+		n += 1 + l + sovObserverSetChange(uint64(l))
+	}
+	l = m.PreviousKeygen.Size()
+	n += 1 + l + sovObserverSetChange(uint64(l))
+	return n
+}
+
+func sovObserverSetChange(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozObserverSetChange(x uint64) (n int) {
+	return sovObserverSetChange(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ObserverSetAddition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObserverSetChange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObserverSetAddition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObserverSetAddition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverSetChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserverSetChange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserverSetChange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteePubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverSetChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserverSetChange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserverSetChange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ZetaclientGranteePubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObserverSetChange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthObserverSetChange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObserverSetChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObserverSetChange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObserverSetChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObserverSetChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Additions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverSetChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObserverSetChange
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserverSetChange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Additions = append(m.Additions, ObserverSetAddition{})
+			if err := m.Additions[len(m.Additions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverSetChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserverSetChange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserverSetChange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Removals = append(m.Removals, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalHeight", wireType)
+			}
+			m.ProposalHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverSetChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenHeight", wireType)
+			}
+			m.KeygenHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverSetChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeygenHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverSetChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverSetChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ObserverSetChangeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TssPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverSetChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserverSetChange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserverSetChange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TssPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousKeygen", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverSetChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObserverSetChange
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserverSetChange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousKeygen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObserverSetChange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthObserverSetChange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipObserverSetChange(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowObserverSetChange
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowObserverSetChange
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowObserverSetChange
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthObserverSetChange
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupObserverSetChange
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthObserverSetChange
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthObserverSetChange        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowObserverSetChange          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupObserverSetChange = fmt.Errorf("proto: unexpected end of group")
+)
@@ -2437,6 +2437,120 @@ func (m *QueryBlameByChainAndNonceResponse) GetBlameInfo() []*Blame {
 	return nil
 }
 
+type QueryBlameStatsRequest struct {
+	// number of blocks to aggregate, ending at the current block
+	Window int64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// optional node pubkey to return the statistics for
+	PubKey string `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+}
+
+func (m *QueryBlameStatsRequest) Reset()         { *m = QueryBlameStatsRequest{} }
+func (m *QueryBlameStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlameStatsRequest) ProtoMessage()    {}
+func (*QueryBlameStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{53}
+}
+func (m *QueryBlameStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlameStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlameStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlameStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlameStatsRequest.Merge(m, src)
+}
+func (m *QueryBlameStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlameStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlameStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlameStatsRequest proto.InternalMessageInfo
+
+func (m *QueryBlameStatsRequest) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *QueryBlameStatsRequest) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+type QueryBlameStatsResponse struct {
+	StartHeight int64            `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   int64            `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	Stats       []NodeBlameStats `protobuf:"bytes,3,rep,name=stats,proto3" json:"stats"`
+}
+
+func (m *QueryBlameStatsResponse) Reset()         { *m = QueryBlameStatsResponse{} }
+func (m *QueryBlameStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlameStatsResponse) ProtoMessage()    {}
+func (*QueryBlameStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{54}
+}
+func (m *QueryBlameStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlameStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlameStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlameStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlameStatsResponse.Merge(m, src)
+}
+func (m *QueryBlameStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlameStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlameStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlameStatsResponse proto.InternalMessageInfo
+
+func (m *QueryBlameStatsResponse) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *QueryBlameStatsResponse) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *QueryBlameStatsResponse) GetStats() []NodeBlameStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
 type QueryBallotListForHeightRequest struct {
 	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
@@ -2445,7 +2559,7 @@ func (m *QueryBallotListForHeightRequest) Reset()         { *m = QueryBallotList
 func (m *QueryBallotListForHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBallotListForHeightRequest) ProtoMessage()    {}
 func (*QueryBallotListForHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{53}
+	return fileDescriptor_25b2aa420449a0c0, []int{55}
 }
 func (m *QueryBallotListForHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2489,7 +2603,7 @@ func (m *QueryBallotListForHeightResponse) Reset()         { *m = QueryBallotLis
 func (m *QueryBallotListForHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBallotListForHeightResponse) ProtoMessage()    {}
 func (*QueryBallotListForHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{54}
+	return fileDescriptor_25b2aa420449a0c0, []int{56}
 }
 func (m *QueryBallotListForHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2525,974 +2639,1451 @@ func (m *QueryBallotListForHeightResponse) GetBallotList() BallotListForHeight {
 	return BallotListForHeight{}
 }
 
-func init() {
-	proto.RegisterType((*QueryBallotsRequest)(nil), "zetachain.zetacore.observer.QueryBallotsRequest")
-	proto.RegisterType((*QueryBallotsResponse)(nil), "zetachain.zetacore.observer.QueryBallotsResponse")
-	proto.RegisterType((*QueryOperationalFlagsRequest)(nil), "zetachain.zetacore.observer.QueryOperationalFlagsRequest")
-	proto.RegisterType((*QueryOperationalFlagsResponse)(nil), "zetachain.zetacore.observer.QueryOperationalFlagsResponse")
-	proto.RegisterType((*QueryTssFundsMigratorInfoAllRequest)(nil), "zetachain.zetacore.observer.QueryTssFundsMigratorInfoAllRequest")
-	proto.RegisterType((*QueryTssFundsMigratorInfoAllResponse)(nil), "zetachain.zetacore.observer.QueryTssFundsMigratorInfoAllResponse")
-	proto.RegisterType((*QueryTssFundsMigratorInfoRequest)(nil), "zetachain.zetacore.observer.QueryTssFundsMigratorInfoRequest")
-	proto.RegisterType((*QueryTssFundsMigratorInfoResponse)(nil), "zetachain.zetacore.observer.QueryTssFundsMigratorInfoResponse")
-	proto.RegisterType((*QueryGetChainNoncesRequest)(nil), "zetachain.zetacore.observer.QueryGetChainNoncesRequest")
-	proto.RegisterType((*QueryGetChainNoncesResponse)(nil), "zetachain.zetacore.observer.QueryGetChainNoncesResponse")
-	proto.RegisterType((*QueryAllChainNoncesRequest)(nil), "zetachain.zetacore.observer.QueryAllChainNoncesRequest")
-	proto.RegisterType((*QueryAllChainNoncesResponse)(nil), "zetachain.zetacore.observer.QueryAllChainNoncesResponse")
-	proto.RegisterType((*QueryAllPendingNoncesRequest)(nil), "zetachain.zetacore.observer.QueryAllPendingNoncesRequest")
-	proto.RegisterType((*QueryAllPendingNoncesResponse)(nil), "zetachain.zetacore.observer.QueryAllPendingNoncesResponse")
-	proto.RegisterType((*QueryPendingNoncesByChainRequest)(nil), "zetachain.zetacore.observer.QueryPendingNoncesByChainRequest")
-	proto.RegisterType((*QueryPendingNoncesByChainResponse)(nil), "zetachain.zetacore.observer.QueryPendingNoncesByChainResponse")
-	proto.RegisterType((*QueryGetTSSRequest)(nil), "zetachain.zetacore.observer.QueryGetTSSRequest")
-	proto.RegisterType((*QueryGetTSSResponse)(nil), "zetachain.zetacore.observer.QueryGetTSSResponse")
-	proto.RegisterType((*QueryGetTssAddressRequest)(nil), "zetachain.zetacore.observer.QueryGetTssAddressRequest")
-	proto.RegisterType((*QueryGetTssAddressResponse)(nil), "zetachain.zetacore.observer.QueryGetTssAddressResponse")
-	proto.RegisterType((*QueryGetTssAddressByFinalizedHeightRequest)(nil), "zetachain.zetacore.observer.QueryGetTssAddressByFinalizedHeightRequest")
-	proto.RegisterType((*QueryGetTssAddressByFinalizedHeightResponse)(nil), "zetachain.zetacore.observer.QueryGetTssAddressByFinalizedHeightResponse")
-	proto.RegisterType((*QueryTssHistoryRequest)(nil), "zetachain.zetacore.observer.QueryTssHistoryRequest")
-	proto.RegisterType((*QueryTssHistoryResponse)(nil), "zetachain.zetacore.observer.QueryTssHistoryResponse")
-	proto.RegisterType((*QueryHasVotedRequest)(nil), "zetachain.zetacore.observer.QueryHasVotedRequest")
-	proto.RegisterType((*QueryHasVotedResponse)(nil), "zetachain.zetacore.observer.QueryHasVotedResponse")
-	proto.RegisterType((*QueryBallotByIdentifierRequest)(nil), "zetachain.zetacore.observer.QueryBallotByIdentifierRequest")
-	proto.RegisterType((*VoterList)(nil), "zetachain.zetacore.observer.VoterList")
-	proto.RegisterType((*QueryBallotByIdentifierResponse)(nil), "zetachain.zetacore.observer.QueryBallotByIdentifierResponse")
-	proto.RegisterType((*QueryObserverSet)(nil), "zetachain.zetacore.observer.QueryObserverSet")
-	proto.RegisterType((*QueryObserverSetResponse)(nil), "zetachain.zetacore.observer.QueryObserverSetResponse")
-	proto.RegisterType((*QuerySupportedChains)(nil), "zetachain.zetacore.observer.QuerySupportedChains")
-	proto.RegisterType((*QuerySupportedChainsResponse)(nil), "zetachain.zetacore.observer.QuerySupportedChainsResponse")
-	proto.RegisterType((*QueryGetChainParamsForChainRequest)(nil), "zetachain.zetacore.observer.QueryGetChainParamsForChainRequest")
-	proto.RegisterType((*QueryGetChainParamsForChainResponse)(nil), "zetachain.zetacore.observer.QueryGetChainParamsForChainResponse")
-	proto.RegisterType((*QueryGetChainParamsRequest)(nil), "zetachain.zetacore.observer.QueryGetChainParamsRequest")
-	proto.RegisterType((*QueryGetChainParamsResponse)(nil), "zetachain.zetacore.observer.QueryGetChainParamsResponse")
-	proto.RegisterType((*QueryGetNodeAccountRequest)(nil), "zetachain.zetacore.observer.QueryGetNodeAccountRequest")
-	proto.RegisterType((*QueryGetNodeAccountResponse)(nil), "zetachain.zetacore.observer.QueryGetNodeAccountResponse")
-	proto.RegisterType((*QueryAllNodeAccountRequest)(nil), "zetachain.zetacore.observer.QueryAllNodeAccountRequest")
-	proto.RegisterType((*QueryAllNodeAccountResponse)(nil), "zetachain.zetacore.observer.QueryAllNodeAccountResponse")
-	proto.RegisterType((*QueryGetCrosschainFlagsRequest)(nil), "zetachain.zetacore.observer.QueryGetCrosschainFlagsRequest")
-	proto.RegisterType((*QueryGetCrosschainFlagsResponse)(nil), "zetachain.zetacore.observer.QueryGetCrosschainFlagsResponse")
-	proto.RegisterType((*QueryGetKeygenRequest)(nil), "zetachain.zetacore.observer.QueryGetKeygenRequest")
-	proto.RegisterType((*QueryGetKeygenResponse)(nil), "zetachain.zetacore.observer.QueryGetKeygenResponse")
-	proto.RegisterType((*QueryShowObserverCountRequest)(nil), "zetachain.zetacore.observer.QueryShowObserverCountRequest")
-	proto.RegisterType((*QueryShowObserverCountResponse)(nil), "zetachain.zetacore.observer.QueryShowObserverCountResponse")
-	proto.RegisterType((*QueryBlameByIdentifierRequest)(nil), "zetachain.zetacore.observer.QueryBlameByIdentifierRequest")
-	proto.RegisterType((*QueryBlameByIdentifierResponse)(nil), "zetachain.zetacore.observer.QueryBlameByIdentifierResponse")
-	proto.RegisterType((*QueryAllBlameRecordsRequest)(nil), "zetachain.zetacore.observer.QueryAllBlameRecordsRequest")
-	proto.RegisterType((*QueryAllBlameRecordsResponse)(nil), "zetachain.zetacore.observer.QueryAllBlameRecordsResponse")
-	proto.RegisterType((*QueryBlameByChainAndNonceRequest)(nil), "zetachain.zetacore.observer.QueryBlameByChainAndNonceRequest")
-	proto.RegisterType((*QueryBlameByChainAndNonceResponse)(nil), "zetachain.zetacore.observer.QueryBlameByChainAndNonceResponse")
-	proto.RegisterType((*QueryBallotListForHeightRequest)(nil), "zetachain.zetacore.observer.QueryBallotListForHeightRequest")
-	proto.RegisterType((*QueryBallotListForHeightResponse)(nil), "zetachain.zetacore.observer.QueryBallotListForHeightResponse")
+type QueryObserverSetChangeRequest struct {
 }
 
-func init() {
-	proto.RegisterFile("zetachain/zetacore/observer/query.proto", fileDescriptor_25b2aa420449a0c0)
+func (m *QueryObserverSetChangeRequest) Reset()         { *m = QueryObserverSetChangeRequest{} }
+func (m *QueryObserverSetChangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryObserverSetChangeRequest) ProtoMessage()    {}
+func (*QueryObserverSetChangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{57}
 }
-
-var fileDescriptor_25b2aa420449a0c0 = []byte{
-	// 2552 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcd, 0x8f, 0xdb, 0xc6,
-	0x15, 0x37, 0xbd, 0xf1, 0x7a, 0x77, 0xd6, 0x1f, 0xda, 0xf1, 0xda, 0xde, 0xd0, 0x8e, 0xbc, 0xa6,
-	0xed, 0x78, 0xbd, 0xf6, 0x8a, 0x5e, 0xd9, 0x89, 0xbf, 0x63, 0x4b, 0xae, 0x77, 0x6d, 0x27, 0xb1,
-	0x5d, 0xc9, 0x6d, 0x00, 0xa3, 0xa9, 0x42, 0x49, 0x23, 0x89, 0x35, 0x97, 0xa3, 0x70, 0xb8, 0x4e,
-	0x94, 0xed, 0x02, 0x69, 0x6f, 0xcd, 0xa1, 0x28, 0xd0, 0xa2, 0xbd, 0x15, 0x3d, 0xb4, 0xc7, 0x02,
-	0x41, 0x80, 0xa0, 0x01, 0x8a, 0x1e, 0x72, 0x6a, 0x0e, 0x05, 0xea, 0xa2, 0x45, 0xd1, 0x53, 0x1b,
-	0xd8, 0x05, 0xfa, 0x6f, 0x14, 0x9c, 0x79, 0x94, 0x48, 0x8a, 0xa4, 0x46, 0xb2, 0x72, 0x92, 0x38,
-	0x9c, 0xf7, 0xe6, 0xf7, 0x7b, 0xf3, 0xf5, 0x7b, 0x33, 0x44, 0x27, 0x3f, 0x22, 0xae, 0x51, 0x6b,
-	0x19, 0xa6, 0xad, 0xf3, 0x7f, 0xd4, 0x21, 0x3a, 0xad, 0x32, 0xe2, 0x3c, 0x21, 0x8e, 0xfe, 0xfe,
-	0x06, 0x71, 0x3a, 0xb9, 0xb6, 0x43, 0x5d, 0x8a, 0x0f, 0x75, 0x2b, 0xe6, 0xfc, 0x8a, 0x39, 0xbf,
-	0xa2, 0xba, 0x54, 0xa3, 0x6c, 0x9d, 0x32, 0xbd, 0x6a, 0x30, 0x22, 0xac, 0xf4, 0x27, 0x2b, 0x55,
-	0xe2, 0x1a, 0x2b, 0x7a, 0xdb, 0x68, 0x9a, 0xb6, 0xe1, 0x9a, 0xd4, 0x16, 0x8e, 0xd4, 0xb9, 0x26,
-	0x6d, 0x52, 0xfe, 0x57, 0xf7, 0xfe, 0x41, 0xe9, 0xe1, 0x26, 0xa5, 0x4d, 0x8b, 0xe8, 0x46, 0xdb,
-	0xd4, 0x0d, 0xdb, 0xa6, 0x2e, 0x37, 0x61, 0xf0, 0x76, 0x31, 0x0d, 0x65, 0xd5, 0xb0, 0x2c, 0xea,
-	0x42, 0xcd, 0x54, 0x3e, 0x55, 0xcb, 0x58, 0x27, 0x50, 0x31, 0x97, 0x56, 0x91, 0x97, 0x57, 0x6c,
-	0x6a, 0xd7, 0x88, 0x0f, 0x21, 0x9f, 0x5a, 0xdf, 0xa1, 0x8c, 0x09, 0xa3, 0x86, 0x65, 0x34, 0xa5,
-	0x60, 0x3f, 0x26, 0x9d, 0x26, 0xb1, 0x65, 0xd0, 0xd8, 0xb4, 0x4e, 0x2a, 0x46, 0xad, 0x46, 0x37,
-	0x6c, 0x9f, 0xe6, 0x52, 0x5a, 0x7d, 0xff, 0x8f, 0x3c, 0xd3, 0xb6, 0xe1, 0x18, 0xeb, 0x3e, 0xea,
-	0xb3, 0x69, 0xf5, 0xdb, 0xc4, 0xae, 0x9b, 0x76, 0x33, 0x1c, 0x9b, 0x13, 0x69, 0x16, 0x2e, 0xf3,
-	0xab, 0x2d, 0xa7, 0x82, 0x6e, 0x13, 0x87, 0xf7, 0xb9, 0x61, 0xa5, 0x70, 0x6c, 0x3f, 0x6e, 0x0a,
-	0xc8, 0x0c, 0x7e, 0x06, 0xd4, 0x6d, 0x3b, 0x94, 0x36, 0x18, 0xfc, 0x40, 0xdd, 0xf3, 0x03, 0xd0,
-	0x56, 0x1a, 0x1b, 0x76, 0x9d, 0x55, 0xd6, 0xcd, 0xa6, 0x63, 0xb8, 0xd4, 0x8f, 0xe2, 0x41, 0x18,
-	0xe2, 0xeb, 0xac, 0xa9, 0x3f, 0x59, 0xf1, 0x7e, 0xc4, 0x0b, 0xed, 0x5d, 0xb4, 0xef, 0xdb, 0xde,
-	0x88, 0x2f, 0xf2, 0x61, 0xc8, 0x4a, 0xe4, 0xfd, 0x0d, 0xc2, 0x5c, 0xbc, 0x8a, 0x50, 0x6f, 0xe8,
-	0xcf, 0x2b, 0x0b, 0xca, 0xe2, 0x4c, 0xfe, 0xd5, 0x9c, 0x70, 0x92, 0xf3, 0xe6, 0x49, 0x4e, 0xcc,
-	0x2e, 0x98, 0x27, 0xb9, 0x07, 0x46, 0x93, 0x80, 0x6d, 0x29, 0x60, 0xa9, 0xfd, 0x56, 0x41, 0x73,
-	0x61, 0xff, 0xac, 0x4d, 0x6d, 0x46, 0xf0, 0x4d, 0xb4, 0x53, 0x8c, 0x7c, 0x36, 0xaf, 0x2c, 0x4c,
-	0x2c, 0xce, 0xe4, 0x8f, 0xe5, 0x52, 0xa6, 0x68, 0x4e, 0x98, 0x17, 0x5f, 0xfa, 0xea, 0xdf, 0x47,
-	0xb6, 0x95, 0x7c, 0x4b, 0xbc, 0x16, 0x42, 0xb9, 0x9d, 0xa3, 0x3c, 0x39, 0x10, 0xa5, 0x40, 0x10,
-	0x82, 0x99, 0x45, 0x87, 0x39, 0xca, 0xfb, 0xbd, 0x6e, 0x5c, 0xf5, 0x66, 0x02, 0x50, 0xd2, 0x7e,
-	0xa4, 0xa0, 0x57, 0x12, 0x2a, 0x00, 0x9f, 0xf7, 0xd0, 0x6c, 0x60, 0x0c, 0x88, 0x79, 0x04, 0x71,
-	0x5b, 0x4e, 0x65, 0x16, 0xf5, 0x08, 0x1c, 0x33, 0x34, 0x52, 0xae, 0x9d, 0x40, 0xc7, 0x38, 0x84,
-	0x87, 0x8c, 0xad, 0x7a, 0x5d, 0xfc, 0x36, 0xf4, 0xf0, 0x1d, 0xbb, 0x41, 0x0b, 0x96, 0xe5, 0x43,
-	0xfd, 0xa9, 0x82, 0x8e, 0xa7, 0xd7, 0x03, 0xc4, 0x0d, 0xb4, 0xaf, 0x7f, 0xb8, 0xf8, 0xbd, 0x71,
-	0x36, 0x15, 0x33, 0xb8, 0x0e, 0x7a, 0x06, 0xd8, 0xb3, 0x6e, 0xa4, 0x55, 0xa6, 0x5d, 0x43, 0x0b,
-	0x89, 0x78, 0xfc, 0xe1, 0xf6, 0x32, 0x9a, 0x12, 0x53, 0xd9, 0xac, 0xf3, 0xa0, 0x4d, 0x94, 0x76,
-	0xf2, 0xe7, 0x3b, 0x75, 0xed, 0x27, 0x0a, 0x3a, 0x9a, 0x62, 0x0f, 0x64, 0xea, 0x08, 0xf7, 0x93,
-	0x81, 0xf8, 0x8f, 0xca, 0x25, 0x13, 0xe5, 0xa2, 0x5d, 0x40, 0x2a, 0x87, 0xb2, 0x46, 0xdc, 0x9b,
-	0x9e, 0xbb, 0x7b, 0x7c, 0x19, 0x91, 0x20, 0x41, 0xd1, 0xa1, 0x58, 0x43, 0x40, 0xff, 0x00, 0xcd,
-	0x04, 0x8a, 0x01, 0xf6, 0x62, 0x2a, 0xec, 0x40, 0x7d, 0x80, 0x1b, 0x74, 0xa1, 0xd5, 0x01, 0x69,
-	0xc1, 0xb2, 0x62, 0x90, 0x8e, 0x6b, 0x76, 0x7f, 0xa1, 0x00, 0xaf, 0x68, 0x33, 0x49, 0xbc, 0x26,
-	0x5e, 0x90, 0xd7, 0xf8, 0x66, 0x7c, 0x03, 0x66, 0x7c, 0xc1, 0xb2, 0x1e, 0x88, 0x4d, 0xe1, 0x9b,
-	0x09, 0xd1, 0x97, 0xfe, 0xca, 0xd1, 0xdf, 0x10, 0x04, 0xe9, 0x1d, 0xb4, 0x27, 0xbc, 0x2d, 0x41,
-	0x9c, 0x96, 0x52, 0xe3, 0x14, 0xf2, 0x05, 0x91, 0xda, 0xdd, 0x0e, 0x16, 0x8e, 0x2f, 0x56, 0xfe,
-	0x0c, 0x0e, 0xb7, 0xd9, 0xe1, 0xfd, 0x22, 0x31, 0xf8, 0x7f, 0x08, 0x13, 0x38, 0xde, 0x3c, 0x25,
-	0x0a, 0xca, 0x18, 0xa2, 0xa0, 0xcd, 0x21, 0xec, 0x4f, 0xbd, 0x87, 0xe5, 0xb2, 0xbf, 0x4a, 0xde,
-	0x87, 0x6d, 0xcf, 0x2f, 0x05, 0x14, 0x17, 0xd1, 0xc4, 0xc3, 0x72, 0x19, 0x9a, 0x5e, 0x48, 0x5f,
-	0x37, 0xca, 0x65, 0x68, 0xd0, 0x33, 0xd1, 0x6e, 0xa1, 0x97, 0xbb, 0x0e, 0x19, 0x2b, 0xd4, 0xeb,
-	0x0e, 0x61, 0xdd, 0xc1, 0xb4, 0x88, 0x32, 0x55, 0xd3, 0xad, 0x51, 0xd3, 0xae, 0x74, 0x83, 0xb4,
-	0x9d, 0x07, 0x69, 0x0f, 0x94, 0xdf, 0x84, 0x58, 0x95, 0x7a, 0x2b, 0x4c, 0xd0, 0x0d, 0xc0, 0xcb,
-	0xa0, 0x09, 0xe2, 0xb6, 0x38, 0xbc, 0xe9, 0x92, 0xf7, 0xd7, 0x2b, 0xa9, 0xba, 0x35, 0xee, 0x6c,
-	0xba, 0xe4, 0xfd, 0xf5, 0x4a, 0xd8, 0x86, 0x39, 0x3f, 0x21, 0x4a, 0xd8, 0x86, 0xa9, 0x7d, 0xa2,
-	0xa0, 0xa5, 0x7e, 0xa7, 0xc5, 0xce, 0xaa, 0x69, 0x1b, 0x96, 0xf9, 0x11, 0xa9, 0xdf, 0x26, 0x66,
-	0xb3, 0xe5, 0xfa, 0x60, 0xf3, 0x68, 0x7f, 0xc3, 0x7f, 0x53, 0xf1, 0x78, 0x57, 0x5a, 0xfc, 0x3d,
-	0x74, 0xeb, 0xbe, 0xee, 0xcb, 0x47, 0xc4, 0x35, 0x84, 0xe9, 0x10, 0x04, 0x6b, 0xe8, 0xb4, 0x14,
-	0x96, 0x17, 0x62, 0xfc, 0x1e, 0x3a, 0xe0, 0x6f, 0x19, 0xb7, 0x4d, 0xe6, 0x52, 0xa7, 0x33, 0xee,
-	0x69, 0xfd, 0x3b, 0x05, 0x1d, 0xec, 0x6b, 0x02, 0x30, 0x17, 0xd0, 0x94, 0xb7, 0x17, 0x59, 0x26,
-	0x73, 0x61, 0x2a, 0xcb, 0x8e, 0xa4, 0x9d, 0x2e, 0x63, 0x6f, 0x99, 0xcc, 0x1d, 0xdf, 0xd4, 0x6d,
-	0x81, 0xfc, 0xba, 0x6d, 0xb0, 0xef, 0x52, 0x97, 0xd4, 0xfd, 0x38, 0x9c, 0x46, 0xb3, 0x42, 0x44,
-	0x55, 0xcc, 0x3a, 0xb1, 0x5d, 0xb3, 0x61, 0x12, 0x07, 0xa2, 0x9c, 0x11, 0x2f, 0xee, 0x74, 0xcb,
-	0xf1, 0x31, 0xb4, 0xfb, 0x09, 0x75, 0x89, 0x53, 0x31, 0x44, 0x77, 0x41, 0xf0, 0x77, 0xf1, 0x42,
-	0xe8, 0x42, 0xed, 0x3c, 0xda, 0x1f, 0x69, 0x09, 0xc2, 0x71, 0x08, 0x4d, 0xb7, 0x0c, 0x56, 0xf1,
-	0x2a, 0x8b, 0xa5, 0x61, 0xaa, 0x34, 0xd5, 0x82, 0x4a, 0xda, 0xdb, 0x28, 0x1b, 0x90, 0x87, 0xc5,
-	0x4e, 0xaf, 0xd5, 0x51, 0x90, 0x6a, 0x2e, 0x9a, 0xf6, 0xfc, 0x3a, 0x3c, 0x88, 0x7d, 0xb0, 0x95,
-	0x7e, 0xd8, 0xb8, 0x88, 0xa6, 0xbd, 0xe7, 0x8a, 0xdb, 0x69, 0x13, 0xce, 0x6b, 0x4f, 0xfe, 0x44,
-	0x6a, 0x6f, 0x79, 0xfe, 0x1f, 0x76, 0xda, 0xa4, 0x34, 0xf5, 0x04, 0xfe, 0x69, 0x7f, 0xd8, 0x8e,
-	0x8e, 0x24, 0xb2, 0x80, 0x28, 0x0c, 0x15, 0xf0, 0x37, 0xd0, 0x24, 0x07, 0xe9, 0x45, 0x7a, 0x82,
-	0x8f, 0xd0, 0x41, 0x88, 0x38, 0xe3, 0x12, 0x58, 0xe1, 0x77, 0x50, 0x46, 0xbc, 0xe5, 0x83, 0x40,
-	0x70, 0x9b, 0xe0, 0xdc, 0xce, 0xa4, 0x6b, 0xd1, 0x9e, 0x11, 0xa7, 0xb8, 0x97, 0x86, 0x0b, 0xf0,
-	0x3d, 0xb4, 0x1b, 0x58, 0x30, 0xd7, 0x70, 0x37, 0xd8, 0xfc, 0x4b, 0xdc, 0xeb, 0x29, 0x09, 0xed,
-	0x5e, 0xe6, 0x06, 0xa5, 0x5d, 0xd5, 0xc0, 0x93, 0x86, 0x51, 0x46, 0xc8, 0x6a, 0xa8, 0x5b, 0x26,
-	0xae, 0x76, 0x11, 0xcd, 0x47, 0xcb, 0xba, 0x51, 0x3c, 0x8c, 0xa6, 0x7d, 0xb7, 0x62, 0x9b, 0x9c,
-	0x2e, 0xf5, 0x0a, 0xb4, 0x03, 0x30, 0xd8, 0xcb, 0x1b, 0xed, 0x36, 0x75, 0x5c, 0x52, 0xe7, 0x8b,
-	0x0e, 0xd3, 0xaa, 0xb0, 0xd7, 0x47, 0xca, 0xbb, 0x5e, 0x8b, 0x68, 0x52, 0xa4, 0x63, 0x30, 0x5d,
-	0x8f, 0xc7, 0xd1, 0x69, 0x3f, 0x6e, 0xe6, 0x20, 0x69, 0xe3, 0xe6, 0x30, 0x65, 0xc1, 0x52, 0xbb,
-	0x8e, 0xb4, 0x90, 0xc2, 0x7b, 0xc0, 0x73, 0xd2, 0x55, 0xea, 0xc8, 0xee, 0x92, 0x0e, 0xc8, 0xfb,
-	0x24, 0x07, 0x80, 0xf5, 0x4d, 0xb4, 0x2b, 0x98, 0xf4, 0xca, 0x6b, 0x45, 0xe1, 0xaf, 0x34, 0x53,
-	0xeb, 0x3d, 0x68, 0x87, 0x23, 0x7a, 0x16, 0xea, 0xc0, 0x1e, 0x69, 0x47, 0x44, 0xab, 0xff, 0x16,
-	0x90, 0xdc, 0x8f, 0x45, 0x72, 0x46, 0x16, 0x09, 0x1f, 0xb0, 0x21, 0x34, 0xf9, 0x1e, 0x9a, 0x7b,
-	0xb4, 0x4e, 0x0a, 0xe2, 0xc8, 0xc0, 0x0f, 0xdd, 0x1c, 0xda, 0x61, 0xda, 0x75, 0xf2, 0x21, 0x4c,
-	0x1a, 0xf1, 0xa0, 0xfd, 0xa0, 0x87, 0x31, 0x64, 0xd3, 0x8b, 0x56, 0xf0, 0xf8, 0x41, 0x2a, 0x5a,
-	0x41, 0x3f, 0x33, 0x76, 0xef, 0x21, 0xa8, 0xa9, 0x63, 0xf0, 0x8d, 0x6b, 0x67, 0xf9, 0x2c, 0xa0,
-	0xa9, 0xe3, 0x28, 0xdd, 0x45, 0x33, 0x81, 0x62, 0x29, 0x4d, 0x1d, 0x62, 0x14, 0x78, 0x18, 0xdf,
-	0x36, 0xb3, 0x00, 0xcb, 0xb8, 0x37, 0x54, 0xba, 0x87, 0x49, 0xa1, 0x0c, 0xfa, 0x63, 0x05, 0xd6,
-	0xc8, 0xb8, 0x2a, 0x40, 0xed, 0x5d, 0x94, 0x89, 0x1e, 0x45, 0xc9, 0x8d, 0xaa, 0xb0, 0x3f, 0x98,
-	0x99, 0x7b, 0x6b, 0xe1, 0x62, 0xed, 0x20, 0xec, 0x50, 0x6b, 0xc4, 0x7d, 0x93, 0x9f, 0x5e, 0xf9,
-	0xd8, 0xbe, 0x03, 0x72, 0x21, 0xf0, 0x02, 0x10, 0x5d, 0x41, 0x93, 0xe2, 0xa0, 0x0b, 0x70, 0xa4,
-	0x1f, 0x52, 0x80, 0x31, 0x98, 0x68, 0x47, 0x40, 0xf9, 0x97, 0x5b, 0xf4, 0x03, 0x7f, 0x31, 0xbb,
-	0x19, 0x18, 0x32, 0x5e, 0x4c, 0xb2, 0x49, 0x35, 0x00, 0xc0, 0xf7, 0xd1, 0x3e, 0xcb, 0x60, 0x6e,
-	0xc5, 0x6f, 0xa3, 0x12, 0x1c, 0xc7, 0xb9, 0x54, 0x34, 0x6f, 0x19, 0xcc, 0x0d, 0x3b, 0x9d, 0xb5,
-	0xa2, 0x45, 0xda, 0x5d, 0xc0, 0x58, 0xb4, 0x8c, 0x75, 0x12, 0xb7, 0xfd, 0x9e, 0x42, 0x19, 0x7e,
-	0xee, 0xd8, 0xbf, 0x6d, 0xed, 0xe5, 0xe5, 0x81, 0xcd, 0xb7, 0xe6, 0xef, 0xe5, 0xfd, 0xbe, 0xba,
-	0xca, 0x08, 0x81, 0x33, 0xbb, 0x41, 0x81, 0x84, 0x96, 0xbe, 0x77, 0x78, 0xd5, 0x4b, 0xd3, 0xa2,
-	0x29, 0xbb, 0x41, 0x35, 0xd2, 0x9b, 0x1d, 0xe2, 0x1d, 0xa9, 0x51, 0xa7, 0x3e, 0xf6, 0xb4, 0xed,
-	0x53, 0xa5, 0x97, 0x1f, 0x86, 0xdb, 0x01, 0x2a, 0x6b, 0x11, 0x2a, 0x13, 0x72, 0x54, 0x60, 0x6c,
-	0xf6, 0x08, 0x8d, 0x6f, 0x0e, 0x96, 0x21, 0x4b, 0x83, 0xf0, 0xf3, 0xa5, 0xb6, 0x60, 0xd7, 0x79,
-	0x1a, 0x34, 0x78, 0xff, 0xf1, 0xd6, 0x57, 0x9e, 0x78, 0x81, 0x6e, 0x17, 0x0f, 0x5a, 0x03, 0x72,
-	0xb7, 0x78, 0xa7, 0x09, 0xdd, 0x3a, 0x31, 0x7c, 0xb7, 0x5e, 0x0a, 0x29, 0x28, 0x6f, 0x6f, 0x58,
-	0xa5, 0x4e, 0x38, 0x2f, 0x39, 0x80, 0x26, 0x43, 0x89, 0x08, 0x3c, 0x69, 0x9b, 0x3e, 0xef, 0x38,
-	0xd3, 0x6e, 0x76, 0x39, 0x03, 0xba, 0x05, 0x54, 0xf9, 0xe0, 0x73, 0xa1, 0x18, 0x77, 0xd0, 0x79,
-	0xa8, 0xda, 0x7d, 0x95, 0xff, 0xeb, 0x22, 0xda, 0xc1, 0x5b, 0xc7, 0x7f, 0x52, 0xd0, 0x94, 0xaf,
-	0x7d, 0xf1, 0x4a, 0xaa, 0xeb, 0x38, 0x45, 0xae, 0xe6, 0x87, 0x31, 0x11, 0xb4, 0xb4, 0xbb, 0x3f,
-	0xfe, 0xfb, 0x7f, 0x7f, 0xbe, 0xfd, 0x5b, 0xb8, 0xc8, 0x8f, 0x82, 0x97, 0xc5, 0xa9, 0x70, 0xf7,
-	0x30, 0xb8, 0xab, 0xba, 0xf5, 0xcd, 0x3e, 0xe9, 0xb9, 0xa5, 0x6f, 0x86, 0xb4, 0xf1, 0x16, 0xfe,
-	0xa7, 0x82, 0x70, 0xbf, 0x7e, 0xc5, 0x57, 0x06, 0xc3, 0x4a, 0xd4, 0xee, 0xea, 0xd5, 0xd1, 0x8c,
-	0x81, 0xdd, 0x2d, 0xce, 0xee, 0x3a, 0xbe, 0x16, 0xcb, 0x0e, 0x28, 0x55, 0x3b, 0x01, 0x56, 0x71,
-	0x44, 0xf1, 0x53, 0x05, 0xed, 0x8b, 0xe9, 0x4c, 0x2c, 0x0d, 0x2e, 0x6e, 0x34, 0xaa, 0xd7, 0x46,
-	0xb4, 0x06, 0x6e, 0x57, 0x39, 0xb7, 0xd7, 0xf1, 0xf9, 0x34, 0x6e, 0xde, 0x58, 0xad, 0x34, 0xa8,
-	0x03, 0x09, 0xb8, 0xbe, 0x29, 0x7e, 0xb7, 0xf0, 0xaf, 0x15, 0x34, 0x13, 0x90, 0xc7, 0x78, 0x79,
-	0x30, 0x98, 0x40, 0x75, 0xf5, 0xb5, 0xa1, 0xaa, 0x77, 0x31, 0x9f, 0xe2, 0x98, 0x8f, 0xe1, 0xa3,
-	0xb1, 0x98, 0xbb, 0x3b, 0x14, 0x23, 0x2e, 0xfe, 0xbd, 0x82, 0xf6, 0x46, 0xd4, 0xb6, 0xcc, 0x9c,
-	0x88, 0x98, 0xa8, 0x97, 0x86, 0x36, 0xe9, 0x82, 0x3d, 0xc3, 0xc1, 0xbe, 0x8a, 0x8f, 0xc7, 0x82,
-	0x65, 0x11, 0x6c, 0xff, 0x51, 0xd0, 0x81, 0x78, 0xe1, 0x8d, 0xaf, 0x0f, 0xc6, 0x90, 0xaa, 0xf9,
-	0xd5, 0x1b, 0xa3, 0x3b, 0x00, 0x2e, 0x45, 0xce, 0xe5, 0x2a, 0xbe, 0x1c, 0xcb, 0xa5, 0x49, 0xdc,
-	0x4a, 0x50, 0x88, 0xf3, 0x11, 0x23, 0xea, 0x6c, 0xfa, 0x8b, 0xfd, 0x16, 0xfe, 0x4c, 0x41, 0x7b,
-	0xc2, 0xcd, 0xe0, 0x0b, 0xc3, 0x02, 0xf3, 0x19, 0x5d, 0x1c, 0xde, 0x10, 0x98, 0x2c, 0x73, 0x26,
-	0x27, 0xf1, 0x09, 0x29, 0x26, 0x1e, 0xe8, 0x90, 0x5e, 0x95, 0x43, 0xdc, 0x2f, 0xce, 0x25, 0x11,
-	0xc7, 0xc8, 0x6d, 0xed, 0x2c, 0x47, 0xbc, 0x84, 0x17, 0x63, 0x11, 0x07, 0xd2, 0x03, 0x7d, 0x93,
-	0x67, 0x24, 0x5b, 0xde, 0xd8, 0xdf, 0x13, 0xf0, 0x54, 0xb0, 0x2c, 0x19, 0xdc, 0xb1, 0x49, 0x85,
-	0x0c, 0xee, 0xf8, 0x34, 0x41, 0x5b, 0xe4, 0xb8, 0x35, 0xbc, 0x30, 0x08, 0x37, 0xfe, 0xa3, 0x82,
-	0xf6, 0x46, 0x14, 0xb4, 0xcc, 0xaa, 0x9f, 0x28, 0xf5, 0x65, 0x56, 0xfd, 0xe4, 0x24, 0x60, 0xc0,
-	0x10, 0x89, 0xe6, 0x07, 0xf8, 0x97, 0x0a, 0x9a, 0x14, 0xba, 0x1b, 0xe7, 0xa5, 0xda, 0x0d, 0x49,
-	0x7f, 0xf5, 0xdc, 0x50, 0x36, 0x00, 0xf1, 0x18, 0x87, 0xf8, 0x0a, 0x3e, 0x14, 0x0b, 0x51, 0xa8,
-	0x7f, 0xfc, 0x67, 0x05, 0xcd, 0xf6, 0xe9, 0x7a, 0x7c, 0x59, 0x62, 0x45, 0x4b, 0x48, 0x17, 0xd4,
-	0x2b, 0x23, 0xd9, 0x02, 0xe6, 0x4b, 0x1c, 0xf3, 0x39, 0xbc, 0x12, 0xc4, 0xdc, 0x7f, 0x81, 0xcc,
-	0x5a, 0xf4, 0x83, 0x48, 0xb2, 0x81, 0xff, 0xa6, 0xa0, 0xd9, 0x3e, 0x4d, 0x2f, 0xc3, 0x24, 0x29,
-	0xa9, 0x90, 0x61, 0x92, 0x98, 0x44, 0x68, 0x37, 0x39, 0x93, 0x6b, 0xf8, 0x4a, 0xfc, 0xd6, 0xc9,
-	0x85, 0x68, 0x54, 0x15, 0x44, 0x32, 0x98, 0x2d, 0x4f, 0xad, 0xe1, 0x35, 0xe2, 0x46, 0xd4, 0x3d,
-	0x96, 0x9b, 0x6f, 0x31, 0x89, 0x87, 0xcc, 0x56, 0x95, 0x90, 0x4a, 0x68, 0x79, 0x4e, 0xe8, 0x0c,
-	0x5e, 0x4a, 0x5c, 0x14, 0x0d, 0xcb, 0xaa, 0x08, 0x0e, 0x0e, 0x00, 0xfd, 0x5a, 0x41, 0xfb, 0xb9,
-	0x33, 0x16, 0x11, 0xe5, 0xf8, 0x9a, 0x74, 0x6c, 0xe3, 0x32, 0x04, 0xf5, 0x8d, 0x51, 0xcd, 0x81,
-	0xcc, 0x6d, 0x4e, 0xa6, 0x88, 0x6f, 0xa4, 0xf7, 0x8e, 0x98, 0xc2, 0x86, 0x5d, 0x17, 0xb7, 0x3d,
-	0x81, 0x9d, 0x4a, 0xdf, 0xe4, 0x25, 0x5b, 0xf8, 0x4b, 0x05, 0xed, 0x0e, 0xdd, 0x12, 0xe0, 0xd7,
-	0xa5, 0x26, 0x6b, 0xdf, 0xf5, 0x8b, 0x7a, 0x61, 0x68, 0x3b, 0x20, 0x73, 0x9d, 0x93, 0xb9, 0x84,
-	0x2f, 0x24, 0xf6, 0x8c, 0xcb, 0x98, 0x2f, 0xa1, 0xf5, 0xcd, 0xe8, 0x15, 0xc8, 0x16, 0xfe, 0xd5,
-	0x76, 0x94, 0x4d, 0xbf, 0xe9, 0xc0, 0x6b, 0x43, 0x82, 0x4b, 0xba, 0xb7, 0x51, 0x6f, 0xbf, 0xb8,
-	0x23, 0xa0, 0x5d, 0xe5, 0xb4, 0xbf, 0x87, 0x1f, 0xc9, 0xd0, 0xae, 0xb4, 0xf8, 0xf5, 0x87, 0x59,
-	0x33, 0x2c, 0x7d, 0x33, 0xf6, 0xe2, 0x68, 0x2b, 0x2e, 0x32, 0x9f, 0x28, 0xfc, 0xaa, 0x0d, 0xeb,
-	0x72, 0xa8, 0xbb, 0x37, 0x77, 0xea, 0x59, 0x79, 0x03, 0xa0, 0xb3, 0xc0, 0xe9, 0xa8, 0x78, 0x3e,
-	0x96, 0x8e, 0x07, 0xe2, 0x37, 0x0a, 0x42, 0xbd, 0x8b, 0x1c, 0x2c, 0xb1, 0x29, 0xf4, 0xdd, 0x2c,
-	0xa9, 0xe7, 0x87, 0x33, 0x02, 0x6c, 0x27, 0x39, 0xb6, 0xa3, 0xf8, 0x48, 0x2c, 0x36, 0xb7, 0x87,
-	0xe9, 0x73, 0x05, 0x65, 0x42, 0xb7, 0x9d, 0x9e, 0xae, 0x90, 0x5b, 0x74, 0xe2, 0xee, 0xb7, 0xd5,
-	0xcb, 0xa3, 0x98, 0x02, 0xe8, 0x25, 0x0e, 0xfa, 0x38, 0xd6, 0x62, 0x41, 0x87, 0x2f, 0xa1, 0xff,
-	0xa2, 0xa0, 0xb9, 0xb8, 0x8b, 0x5f, 0x99, 0x75, 0x2a, 0xe5, 0xbe, 0x59, 0x66, 0x9d, 0x4a, 0xbb,
-	0x6f, 0xd6, 0x5e, 0xe3, 0x1c, 0x74, 0xbc, 0x3c, 0x98, 0x43, 0x50, 0x46, 0x7f, 0xae, 0x84, 0x3e,
-	0x69, 0x18, 0x46, 0x43, 0x87, 0xe3, 0x7f, 0x71, 0x78, 0x43, 0x40, 0x7e, 0x8e, 0x23, 0x5f, 0xc6,
-	0xa7, 0xe3, 0x05, 0x52, 0xcf, 0x22, 0x88, 0xdb, 0x13, 0xa5, 0x01, 0x67, 0xf2, 0xa2, 0x74, 0x34,
-	0xe8, 0xf1, 0xdf, 0x83, 0x0c, 0x10, 0xa5, 0x01, 0xe8, 0x9e, 0x7a, 0x9a, 0x8b, 0xfb, 0xe0, 0x47,
-	0x66, 0xd8, 0xa4, 0x7c, 0x68, 0x24, 0x33, 0x6c, 0xd2, 0xbe, 0x33, 0x1a, 0x90, 0x0e, 0x34, 0xf9,
-	0xfa, 0x1a, 0xb2, 0xc6, 0xff, 0x50, 0xd0, 0xc1, 0x84, 0x4f, 0xb1, 0xf0, 0x8d, 0xd1, 0xd0, 0xf4,
-	0xbe, 0xf6, 0x52, 0x0b, 0x2f, 0xe0, 0x01, 0x28, 0x9d, 0xe7, 0x94, 0x72, 0xf8, 0x4c, 0x12, 0xa5,
-	0x82, 0x65, 0x45, 0x7d, 0x30, 0xfc, 0x85, 0x82, 0x32, 0xd1, 0x4f, 0xd7, 0x64, 0xd6, 0xa3, 0x84,
-	0x2f, 0xec, 0x64, 0xd6, 0xa3, 0xa4, 0x6f, 0xef, 0x06, 0xa4, 0x0c, 0xd1, 0x0f, 0xe9, 0xf0, 0x2f,
-	0x14, 0xb4, 0x13, 0x3e, 0x47, 0xc4, 0x67, 0x65, 0x8f, 0x71, 0xba, 0x40, 0x57, 0x86, 0xb0, 0x00,
-	0x7c, 0xc7, 0x39, 0xbe, 0x2c, 0x3e, 0x9c, 0x72, 0xd8, 0xc3, 0xd4, 0x1d, 0x1f, 0xff, 0xef, 0xd3,
-	0x25, 0xa5, 0x78, 0xeb, 0xab, 0x67, 0x59, 0xe5, 0xe9, 0xb3, 0xac, 0xf2, 0xf5, 0xb3, 0xac, 0xf2,
-	0xb3, 0xe7, 0xd9, 0x6d, 0x4f, 0x9f, 0x67, 0xb7, 0xfd, 0xeb, 0x79, 0x76, 0xdb, 0xa3, 0xd3, 0x4d,
-	0xd3, 0x6d, 0x6d, 0x54, 0x73, 0x35, 0xba, 0x1e, 0x74, 0xe4, 0xe5, 0x72, 0xfa, 0x87, 0x81, 0x4d,
-	0xa3, 0xd3, 0x26, 0xac, 0x3a, 0xc9, 0x3f, 0xef, 0x3c, 0xf7, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x81, 0x4c, 0x71, 0x68, 0x22, 0x2d, 0x00, 0x00,
+func (m *QueryObserverSetChangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryObserverSetChangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryObserverSetChangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryObserverSetChangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryObserverSetChangeRequest.Merge(m, src)
+}
+func (m *QueryObserverSetChangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryObserverSetChangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryObserverSetChangeRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryObserverSetChangeRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Query if a voter has voted for a ballot
-	HasVoted(ctx context.Context, in *QueryHasVotedRequest, opts ...grpc.CallOption) (*QueryHasVotedResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	BallotByIdentifier(ctx context.Context, in *QueryBallotByIdentifierRequest, opts ...grpc.CallOption) (*QueryBallotByIdentifierResponse, error)
-	BallotListForHeight(ctx context.Context, in *QueryBallotListForHeightRequest, opts ...grpc.CallOption) (*QueryBallotListForHeightResponse, error)
-	// Queries a list of ObserversByChainAndType items.
-	ObserverSet(ctx context.Context, in *QueryObserverSet, opts ...grpc.CallOption) (*QueryObserverSetResponse, error)
-	SupportedChains(ctx context.Context, in *QuerySupportedChains, opts ...grpc.CallOption) (*QuerySupportedChainsResponse, error)
-	// Queries a list of GetChainParamsForChain items.
-	GetChainParamsForChain(ctx context.Context, in *QueryGetChainParamsForChainRequest, opts ...grpc.CallOption) (*QueryGetChainParamsForChainResponse, error)
-	// Queries a list of GetChainParams items.
-	GetChainParams(ctx context.Context, in *QueryGetChainParamsRequest, opts ...grpc.CallOption) (*QueryGetChainParamsResponse, error)
-	// Queries a nodeAccount by index.
-	NodeAccount(ctx context.Context, in *QueryGetNodeAccountRequest, opts ...grpc.CallOption) (*QueryGetNodeAccountResponse, error)
-	// Queries a list of nodeAccount items.
-	NodeAccountAll(ctx context.Context, in *QueryAllNodeAccountRequest, opts ...grpc.CallOption) (*QueryAllNodeAccountResponse, error)
-	CrosschainFlags(ctx context.Context, in *QueryGetCrosschainFlagsRequest, opts ...grpc.CallOption) (*QueryGetCrosschainFlagsResponse, error)
-	// Queries a keygen by index.
-	Keygen(ctx context.Context, in *QueryGetKeygenRequest, opts ...grpc.CallOption) (*QueryGetKeygenResponse, error)
-	// Queries a list of ShowObserverCount items.
-	ShowObserverCount(ctx context.Context, in *QueryShowObserverCountRequest, opts ...grpc.CallOption) (*QueryShowObserverCountResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	BlameByIdentifier(ctx context.Context, in *QueryBlameByIdentifierRequest, opts ...grpc.CallOption) (*QueryBlameByIdentifierResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	GetAllBlameRecords(ctx context.Context, in *QueryAllBlameRecordsRequest, opts ...grpc.CallOption) (*QueryAllBlameRecordsResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	BlamesByChainAndNonce(ctx context.Context, in *QueryBlameByChainAndNonceRequest, opts ...grpc.CallOption) (*QueryBlameByChainAndNonceResponse, error)
-	// Queries a list of GetTssAddress items.
-	GetTssAddress(ctx context.Context, in *QueryGetTssAddressRequest, opts ...grpc.CallOption) (*QueryGetTssAddressResponse, error)
-	GetTssAddressByFinalizedHeight(ctx context.Context, in *QueryGetTssAddressByFinalizedHeightRequest, opts ...grpc.CallOption) (*QueryGetTssAddressByFinalizedHeightResponse, error)
-	// Queries a tSS by index.
-	TSS(ctx context.Context, in *QueryGetTSSRequest, opts ...grpc.CallOption) (*QueryGetTSSResponse, error)
-	TssHistory(ctx context.Context, in *QueryTssHistoryRequest, opts ...grpc.CallOption) (*QueryTssHistoryResponse, error)
-	PendingNoncesAll(ctx context.Context, in *QueryAllPendingNoncesRequest, opts ...grpc.CallOption) (*QueryAllPendingNoncesResponse, error)
-	PendingNoncesByChain(ctx context.Context, in *QueryPendingNoncesByChainRequest, opts ...grpc.CallOption) (*QueryPendingNoncesByChainResponse, error)
-	// Queries a chainNonces by index.
-	ChainNonces(ctx context.Context, in *QueryGetChainNoncesRequest, opts ...grpc.CallOption) (*QueryGetChainNoncesResponse, error)
-	// Queries a list of chainNonces items.
-	ChainNoncesAll(ctx context.Context, in *QueryAllChainNoncesRequest, opts ...grpc.CallOption) (*QueryAllChainNoncesResponse, error)
-	// Queries the TssFundMigratorInfo for a specific chain
-	TssFundsMigratorInfo(ctx context.Context, in *QueryTssFundsMigratorInfoRequest, opts ...grpc.CallOption) (*QueryTssFundsMigratorInfoResponse, error)
-	// Queries all TssFundMigratorInfo
-	TssFundsMigratorInfoAll(ctx context.Context, in *QueryTssFundsMigratorInfoAllRequest, opts ...grpc.CallOption) (*QueryTssFundsMigratorInfoAllResponse, error)
-	// Queries operational flags
-	OperationalFlags(ctx context.Context, in *QueryOperationalFlagsRequest, opts ...grpc.CallOption) (*QueryOperationalFlagsResponse, error)
-	// Query all ballots
-	Ballots(ctx context.Context, in *QueryBallotsRequest, opts ...grpc.CallOption) (*QueryBallotsResponse, error)
+type QueryObserverSetChangeResponse struct {
+	ObserverSetChange ObserverSetChange `protobuf:"bytes,1,opt,name=observer_set_change,json=observerSetChange,proto3" json:"observer_set_change"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryObserverSetChangeResponse) Reset()         { *m = QueryObserverSetChangeResponse{} }
+func (m *QueryObserverSetChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryObserverSetChangeResponse) ProtoMessage()    {}
+func (*QueryObserverSetChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{58}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryObserverSetChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) HasVoted(ctx context.Context, in *QueryHasVotedRequest, opts ...grpc.CallOption) (*QueryHasVotedResponse, error) {
-	out := new(QueryHasVotedResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/HasVoted", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryObserverSetChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryObserverSetChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) BallotByIdentifier(ctx context.Context, in *QueryBallotByIdentifierRequest, opts ...grpc.CallOption) (*QueryBallotByIdentifierResponse, error) {
-	out := new(QueryBallotByIdentifierResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BallotByIdentifier", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryObserverSetChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryObserverSetChangeResponse.Merge(m, src)
+}
+func (m *QueryObserverSetChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryObserverSetChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryObserverSetChangeResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) BallotListForHeight(ctx context.Context, in *QueryBallotListForHeightRequest, opts ...grpc.CallOption) (*QueryBallotListForHeightResponse, error) {
-	out := new(QueryBallotListForHeightResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BallotListForHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryObserverSetChangeResponse proto.InternalMessageInfo
+
+func (m *QueryObserverSetChangeResponse) GetObserverSetChange() ObserverSetChange {
+	if m != nil {
+		return m.ObserverSetChange
 	}
-	return out, nil
+	return ObserverSetChange{}
 }
 
-func (c *queryClient) ObserverSet(ctx context.Context, in *QueryObserverSet, opts ...grpc.CallOption) (*QueryObserverSetResponse, error) {
-	out := new(QueryObserverSetResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ObserverSet", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryObserverSigningInfoRequest struct {
+	ObserverAddress string `protobuf:"bytes,1,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
 }
 
-func (c *queryClient) SupportedChains(ctx context.Context, in *QuerySupportedChains, opts ...grpc.CallOption) (*QuerySupportedChainsResponse, error) {
-	out := new(QuerySupportedChainsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/SupportedChains", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryObserverSigningInfoRequest) Reset()         { *m = QueryObserverSigningInfoRequest{} }
+func (m *QueryObserverSigningInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryObserverSigningInfoRequest) ProtoMessage()    {}
+func (*QueryObserverSigningInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{59}
 }
-
-func (c *queryClient) GetChainParamsForChain(ctx context.Context, in *QueryGetChainParamsForChainRequest, opts ...grpc.CallOption) (*QueryGetChainParamsForChainResponse, error) {
-	out := new(QueryGetChainParamsForChainResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetChainParamsForChain", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryObserverSigningInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) GetChainParams(ctx context.Context, in *QueryGetChainParamsRequest, opts ...grpc.CallOption) (*QueryGetChainParamsResponse, error) {
-	out := new(QueryGetChainParamsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetChainParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryObserverSigningInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryObserverSigningInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) NodeAccount(ctx context.Context, in *QueryGetNodeAccountRequest, opts ...grpc.CallOption) (*QueryGetNodeAccountResponse, error) {
-	out := new(QueryGetNodeAccountResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/NodeAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryObserverSigningInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryObserverSigningInfoRequest.Merge(m, src)
 }
-
-func (c *queryClient) NodeAccountAll(ctx context.Context, in *QueryAllNodeAccountRequest, opts ...grpc.CallOption) (*QueryAllNodeAccountResponse, error) {
-	out := new(QueryAllNodeAccountResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/NodeAccountAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryObserverSigningInfoRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) CrosschainFlags(ctx context.Context, in *QueryGetCrosschainFlagsRequest, opts ...grpc.CallOption) (*QueryGetCrosschainFlagsResponse, error) {
-	out := new(QueryGetCrosschainFlagsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/CrosschainFlags", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryObserverSigningInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryObserverSigningInfoRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Keygen(ctx context.Context, in *QueryGetKeygenRequest, opts ...grpc.CallOption) (*QueryGetKeygenResponse, error) {
-	out := new(QueryGetKeygenResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/Keygen", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryObserverSigningInfoRequest proto.InternalMessageInfo
 
-func (c *queryClient) ShowObserverCount(ctx context.Context, in *QueryShowObserverCountRequest, opts ...grpc.CallOption) (*QueryShowObserverCountResponse, error) {
-	out := new(QueryShowObserverCountResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ShowObserverCount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryObserverSigningInfoRequest) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) BlameByIdentifier(ctx context.Context, in *QueryBlameByIdentifierRequest, opts ...grpc.CallOption) (*QueryBlameByIdentifierResponse, error) {
-	out := new(QueryBlameByIdentifierResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BlameByIdentifier", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryObserverSigningInfoResponse struct {
+	SigningInfo ObserverSigningInfo `protobuf:"bytes,1,opt,name=signing_info,json=signingInfo,proto3" json:"signing_info"`
 }
 
-func (c *queryClient) GetAllBlameRecords(ctx context.Context, in *QueryAllBlameRecordsRequest, opts ...grpc.CallOption) (*QueryAllBlameRecordsResponse, error) {
-	out := new(QueryAllBlameRecordsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetAllBlameRecords", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryObserverSigningInfoResponse) Reset()         { *m = QueryObserverSigningInfoResponse{} }
+func (m *QueryObserverSigningInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryObserverSigningInfoResponse) ProtoMessage()    {}
+func (*QueryObserverSigningInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{60}
+}
+func (m *QueryObserverSigningInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryObserverSigningInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryObserverSigningInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryObserverSigningInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryObserverSigningInfoResponse.Merge(m, src)
+}
+func (m *QueryObserverSigningInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryObserverSigningInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryObserverSigningInfoResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) BlamesByChainAndNonce(ctx context.Context, in *QueryBlameByChainAndNonceRequest, opts ...grpc.CallOption) (*QueryBlameByChainAndNonceResponse, error) {
-	out := new(QueryBlameByChainAndNonceResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BlamesByChainAndNonce", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryObserverSigningInfoResponse proto.InternalMessageInfo
+
+func (m *QueryObserverSigningInfoResponse) GetSigningInfo() ObserverSigningInfo {
+	if m != nil {
+		return m.SigningInfo
 	}
-	return out, nil
+	return ObserverSigningInfo{}
 }
 
-func (c *queryClient) GetTssAddress(ctx context.Context, in *QueryGetTssAddressRequest, opts ...grpc.CallOption) (*QueryGetTssAddressResponse, error) {
-	out := new(QueryGetTssAddressResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetTssAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryObserverSigningInfosRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) GetTssAddressByFinalizedHeight(ctx context.Context, in *QueryGetTssAddressByFinalizedHeightRequest, opts ...grpc.CallOption) (*QueryGetTssAddressByFinalizedHeightResponse, error) {
-	out := new(QueryGetTssAddressByFinalizedHeightResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetTssAddressByFinalizedHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryObserverSigningInfosRequest) Reset()         { *m = QueryObserverSigningInfosRequest{} }
+func (m *QueryObserverSigningInfosRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryObserverSigningInfosRequest) ProtoMessage()    {}
+func (*QueryObserverSigningInfosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{61}
+}
+func (m *QueryObserverSigningInfosRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryObserverSigningInfosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryObserverSigningInfosRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryObserverSigningInfosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryObserverSigningInfosRequest.Merge(m, src)
+}
+func (m *QueryObserverSigningInfosRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryObserverSigningInfosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryObserverSigningInfosRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) TSS(ctx context.Context, in *QueryGetTSSRequest, opts ...grpc.CallOption) (*QueryGetTSSResponse, error) {
-	out := new(QueryGetTSSResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/TSS", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryObserverSigningInfosRequest proto.InternalMessageInfo
+
+func (m *QueryObserverSigningInfosRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) TssHistory(ctx context.Context, in *QueryTssHistoryRequest, opts ...grpc.CallOption) (*QueryTssHistoryResponse, error) {
-	out := new(QueryTssHistoryResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/TssHistory", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryObserverSigningInfosResponse struct {
+	SigningInfos []ObserverSigningInfo `protobuf:"bytes,1,rep,name=signing_infos,json=signingInfos,proto3" json:"signing_infos"`
+	Pagination   *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) PendingNoncesAll(ctx context.Context, in *QueryAllPendingNoncesRequest, opts ...grpc.CallOption) (*QueryAllPendingNoncesResponse, error) {
-	out := new(QueryAllPendingNoncesResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/PendingNoncesAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryObserverSigningInfosResponse) Reset()         { *m = QueryObserverSigningInfosResponse{} }
+func (m *QueryObserverSigningInfosResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryObserverSigningInfosResponse) ProtoMessage()    {}
+func (*QueryObserverSigningInfosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{62}
+}
+func (m *QueryObserverSigningInfosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryObserverSigningInfosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryObserverSigningInfosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryObserverSigningInfosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryObserverSigningInfosResponse.Merge(m, src)
+}
+func (m *QueryObserverSigningInfosResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryObserverSigningInfosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryObserverSigningInfosResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) PendingNoncesByChain(ctx context.Context, in *QueryPendingNoncesByChainRequest, opts ...grpc.CallOption) (*QueryPendingNoncesByChainResponse, error) {
-	out := new(QueryPendingNoncesByChainResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/PendingNoncesByChain", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryObserverSigningInfosResponse proto.InternalMessageInfo
+
+func (m *QueryObserverSigningInfosResponse) GetSigningInfos() []ObserverSigningInfo {
+	if m != nil {
+		return m.SigningInfos
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ChainNonces(ctx context.Context, in *QueryGetChainNoncesRequest, opts ...grpc.CallOption) (*QueryGetChainNoncesResponse, error) {
-	out := new(QueryGetChainNoncesResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ChainNonces", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryObserverSigningInfosResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ChainNoncesAll(ctx context.Context, in *QueryAllChainNoncesRequest, opts ...grpc.CallOption) (*QueryAllChainNoncesResponse, error) {
-	out := new(QueryAllChainNoncesResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ChainNoncesAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryLivenessParamsRequest struct {
 }
 
-func (c *queryClient) TssFundsMigratorInfo(ctx context.Context, in *QueryTssFundsMigratorInfoRequest, opts ...grpc.CallOption) (*QueryTssFundsMigratorInfoResponse, error) {
-	out := new(QueryTssFundsMigratorInfoResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/TssFundsMigratorInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLivenessParamsRequest) Reset()         { *m = QueryLivenessParamsRequest{} }
+func (m *QueryLivenessParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLivenessParamsRequest) ProtoMessage()    {}
+func (*QueryLivenessParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{63}
+}
+func (m *QueryLivenessParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLivenessParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLivenessParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryLivenessParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLivenessParamsRequest.Merge(m, src)
+}
+func (m *QueryLivenessParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLivenessParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLivenessParamsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) TssFundsMigratorInfoAll(ctx context.Context, in *QueryTssFundsMigratorInfoAllRequest, opts ...grpc.CallOption) (*QueryTssFundsMigratorInfoAllResponse, error) {
-	out := new(QueryTssFundsMigratorInfoAllResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/TssFundsMigratorInfoAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryLivenessParamsRequest proto.InternalMessageInfo
+
+type QueryLivenessParamsResponse struct {
+	LivenessParams LivenessParams `protobuf:"bytes,1,opt,name=liveness_params,json=livenessParams,proto3" json:"liveness_params"`
 }
 
-func (c *queryClient) OperationalFlags(ctx context.Context, in *QueryOperationalFlagsRequest, opts ...grpc.CallOption) (*QueryOperationalFlagsResponse, error) {
-	out := new(QueryOperationalFlagsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/OperationalFlags", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLivenessParamsResponse) Reset()         { *m = QueryLivenessParamsResponse{} }
+func (m *QueryLivenessParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLivenessParamsResponse) ProtoMessage()    {}
+func (*QueryLivenessParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{64}
+}
+func (m *QueryLivenessParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLivenessParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLivenessParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryLivenessParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLivenessParamsResponse.Merge(m, src)
+}
+func (m *QueryLivenessParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLivenessParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLivenessParamsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Ballots(ctx context.Context, in *QueryBallotsRequest, opts ...grpc.CallOption) (*QueryBallotsResponse, error) {
-	out := new(QueryBallotsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/Ballots", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLivenessParamsResponse proto.InternalMessageInfo
+
+func (m *QueryLivenessParamsResponse) GetLivenessParams() LivenessParams {
+	if m != nil {
+		return m.LivenessParams
 	}
-	return out, nil
+	return LivenessParams{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Query if a voter has voted for a ballot
-	HasVoted(context.Context, *QueryHasVotedRequest) (*QueryHasVotedResponse, error)
+func init() {
+	proto.RegisterType((*QueryBallotsRequest)(nil), "zetachain.zetacore.observer.QueryBallotsRequest")
+	proto.RegisterType((*QueryBallotsResponse)(nil), "zetachain.zetacore.observer.QueryBallotsResponse")
+	proto.RegisterType((*QueryOperationalFlagsRequest)(nil), "zetachain.zetacore.observer.QueryOperationalFlagsRequest")
+	proto.RegisterType((*QueryOperationalFlagsResponse)(nil), "zetachain.zetacore.observer.QueryOperationalFlagsResponse")
+	proto.RegisterType((*QueryTssFundsMigratorInfoAllRequest)(nil), "zetachain.zetacore.observer.QueryTssFundsMigratorInfoAllRequest")
+	proto.RegisterType((*QueryTssFundsMigratorInfoAllResponse)(nil), "zetachain.zetacore.observer.QueryTssFundsMigratorInfoAllResponse")
+	proto.RegisterType((*QueryTssFundsMigratorInfoRequest)(nil), "zetachain.zetacore.observer.QueryTssFundsMigratorInfoRequest")
+	proto.RegisterType((*QueryTssFundsMigratorInfoResponse)(nil), "zetachain.zetacore.observer.QueryTssFundsMigratorInfoResponse")
+	proto.RegisterType((*QueryGetChainNoncesRequest)(nil), "zetachain.zetacore.observer.QueryGetChainNoncesRequest")
+	proto.RegisterType((*QueryGetChainNoncesResponse)(nil), "zetachain.zetacore.observer.QueryGetChainNoncesResponse")
+	proto.RegisterType((*QueryAllChainNoncesRequest)(nil), "zetachain.zetacore.observer.QueryAllChainNoncesRequest")
+	proto.RegisterType((*QueryAllChainNoncesResponse)(nil), "zetachain.zetacore.observer.QueryAllChainNoncesResponse")
+	proto.RegisterType((*QueryAllPendingNoncesRequest)(nil), "zetachain.zetacore.observer.QueryAllPendingNoncesRequest")
+	proto.RegisterType((*QueryAllPendingNoncesResponse)(nil), "zetachain.zetacore.observer.QueryAllPendingNoncesResponse")
+	proto.RegisterType((*QueryPendingNoncesByChainRequest)(nil), "zetachain.zetacore.observer.QueryPendingNoncesByChainRequest")
+	proto.RegisterType((*QueryPendingNoncesByChainResponse)(nil), "zetachain.zetacore.observer.QueryPendingNoncesByChainResponse")
+	proto.RegisterType((*QueryGetTSSRequest)(nil), "zetachain.zetacore.observer.QueryGetTSSRequest")
+	proto.RegisterType((*QueryGetTSSResponse)(nil), "zetachain.zetacore.observer.QueryGetTSSResponse")
+	proto.RegisterType((*QueryGetTssAddressRequest)(nil), "zetachain.zetacore.observer.QueryGetTssAddressRequest")
+	proto.RegisterType((*QueryGetTssAddressResponse)(nil), "zetachain.zetacore.observer.QueryGetTssAddressResponse")
+	proto.RegisterType((*QueryGetTssAddressByFinalizedHeightRequest)(nil), "zetachain.zetacore.observer.QueryGetTssAddressByFinalizedHeightRequest")
+	proto.RegisterType((*QueryGetTssAddressByFinalizedHeightResponse)(nil), "zetachain.zetacore.observer.QueryGetTssAddressByFinalizedHeightResponse")
+	proto.RegisterType((*QueryTssHistoryRequest)(nil), "zetachain.zetacore.observer.QueryTssHistoryRequest")
+	proto.RegisterType((*QueryTssHistoryResponse)(nil), "zetachain.zetacore.observer.QueryTssHistoryResponse")
+	proto.RegisterType((*QueryHasVotedRequest)(nil), "zetachain.zetacore.observer.QueryHasVotedRequest")
+	proto.RegisterType((*QueryHasVotedResponse)(nil), "zetachain.zetacore.observer.QueryHasVotedResponse")
+	proto.RegisterType((*QueryBallotByIdentifierRequest)(nil), "zetachain.zetacore.observer.QueryBallotByIdentifierRequest")
+	proto.RegisterType((*VoterList)(nil), "zetachain.zetacore.observer.VoterList")
+	proto.RegisterType((*QueryBallotByIdentifierResponse)(nil), "zetachain.zetacore.observer.QueryBallotByIdentifierResponse")
+	proto.RegisterType((*QueryObserverSet)(nil), "zetachain.zetacore.observer.QueryObserverSet")
+	proto.RegisterType((*QueryObserverSetResponse)(nil), "zetachain.zetacore.observer.QueryObserverSetResponse")
+	proto.RegisterType((*QuerySupportedChains)(nil), "zetachain.zetacore.observer.QuerySupportedChains")
+	proto.RegisterType((*QuerySupportedChainsResponse)(nil), "zetachain.zetacore.observer.QuerySupportedChainsResponse")
+	proto.RegisterType((*QueryGetChainParamsForChainRequest)(nil), "zetachain.zetacore.observer.QueryGetChainParamsForChainRequest")
+	proto.RegisterType((*QueryGetChainParamsForChainResponse)(nil), "zetachain.zetacore.observer.QueryGetChainParamsForChainResponse")
+	proto.RegisterType((*QueryGetChainParamsRequest)(nil), "zetachain.zetacore.observer.QueryGetChainParamsRequest")
+	proto.RegisterType((*QueryGetChainParamsResponse)(nil), "zetachain.zetacore.observer.QueryGetChainParamsResponse")
+	proto.RegisterType((*QueryGetNodeAccountRequest)(nil), "zetachain.zetacore.observer.QueryGetNodeAccountRequest")
+	proto.RegisterType((*QueryGetNodeAccountResponse)(nil), "zetachain.zetacore.observer.QueryGetNodeAccountResponse")
+	proto.RegisterType((*QueryAllNodeAccountRequest)(nil), "zetachain.zetacore.observer.QueryAllNodeAccountRequest")
+	proto.RegisterType((*QueryAllNodeAccountResponse)(nil), "zetachain.zetacore.observer.QueryAllNodeAccountResponse")
+	proto.RegisterType((*QueryGetCrosschainFlagsRequest)(nil), "zetachain.zetacore.observer.QueryGetCrosschainFlagsRequest")
+	proto.RegisterType((*QueryGetCrosschainFlagsResponse)(nil), "zetachain.zetacore.observer.QueryGetCrosschainFlagsResponse")
+	proto.RegisterType((*QueryGetKeygenRequest)(nil), "zetachain.zetacore.observer.QueryGetKeygenRequest")
+	proto.RegisterType((*QueryGetKeygenResponse)(nil), "zetachain.zetacore.observer.QueryGetKeygenResponse")
+	proto.RegisterType((*QueryShowObserverCountRequest)(nil), "zetachain.zetacore.observer.QueryShowObserverCountRequest")
+	proto.RegisterType((*QueryShowObserverCountResponse)(nil), "zetachain.zetacore.observer.QueryShowObserverCountResponse")
+	proto.RegisterType((*QueryBlameByIdentifierRequest)(nil), "zetachain.zetacore.observer.QueryBlameByIdentifierRequest")
+	proto.RegisterType((*QueryBlameByIdentifierResponse)(nil), "zetachain.zetacore.observer.QueryBlameByIdentifierResponse")
+	proto.RegisterType((*QueryAllBlameRecordsRequest)(nil), "zetachain.zetacore.observer.QueryAllBlameRecordsRequest")
+	proto.RegisterType((*QueryAllBlameRecordsResponse)(nil), "zetachain.zetacore.observer.QueryAllBlameRecordsResponse")
+	proto.RegisterType((*QueryBlameByChainAndNonceRequest)(nil), "zetachain.zetacore.observer.QueryBlameByChainAndNonceRequest")
+	proto.RegisterType((*QueryBlameByChainAndNonceResponse)(nil), "zetachain.zetacore.observer.QueryBlameByChainAndNonceResponse")
+	proto.RegisterType((*QueryBlameStatsRequest)(nil), "zetachain.zetacore.observer.QueryBlameStatsRequest")
+	proto.RegisterType((*QueryBlameStatsResponse)(nil), "zetachain.zetacore.observer.QueryBlameStatsResponse")
+	proto.RegisterType((*QueryBallotListForHeightRequest)(nil), "zetachain.zetacore.observer.QueryBallotListForHeightRequest")
+	proto.RegisterType((*QueryBallotListForHeightResponse)(nil), "zetachain.zetacore.observer.QueryBallotListForHeightResponse")
+	proto.RegisterType((*QueryObserverSetChangeRequest)(nil), "zetachain.zetacore.observer.QueryObserverSetChangeRequest")
+	proto.RegisterType((*QueryObserverSetChangeResponse)(nil), "zetachain.zetacore.observer.QueryObserverSetChangeResponse")
+	proto.RegisterType((*QueryObserverSigningInfoRequest)(nil), "zetachain.zetacore.observer.QueryObserverSigningInfoRequest")
+	proto.RegisterType((*QueryObserverSigningInfoResponse)(nil), "zetachain.zetacore.observer.QueryObserverSigningInfoResponse")
+	proto.RegisterType((*QueryObserverSigningInfosRequest)(nil), "zetachain.zetacore.observer.QueryObserverSigningInfosRequest")
+	proto.RegisterType((*QueryObserverSigningInfosResponse)(nil), "zetachain.zetacore.observer.QueryObserverSigningInfosResponse")
+	proto.RegisterType((*QueryLivenessParamsRequest)(nil), "zetachain.zetacore.observer.QueryLivenessParamsRequest")
+	proto.RegisterType((*QueryLivenessParamsResponse)(nil), "zetachain.zetacore.observer.QueryLivenessParamsResponse")
+}
+
+func init() {
+	proto.RegisterFile("zetachain/zetacore/observer/query.proto", fileDescriptor_25b2aa420449a0c0)
+}
+
+var fileDescriptor_25b2aa420449a0c0 = []byte{
+	// 2948 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5b, 0xdd, 0x6f, 0x1c, 0x57,
+	0x15, 0xcf, 0xc4, 0x8d, 0x63, 0x1f, 0x3b, 0xfe, 0xb8, 0x76, 0x13, 0x77, 0x93, 0x3a, 0xce, 0x24,
+	0x69, 0x1c, 0x27, 0xde, 0xb5, 0x9d, 0xb4, 0xf9, 0x6e, 0x62, 0x9b, 0xd8, 0xf9, 0x6a, 0x12, 0xd6,
+	0x81, 0x8a, 0x40, 0xd9, 0xce, 0xee, 0xde, 0xdd, 0x9d, 0x66, 0x3c, 0xb3, 0x9d, 0x3b, 0x76, 0xba,
+	0x35, 0x96, 0x0a, 0x0f, 0x48, 0xf4, 0x01, 0x21, 0x81, 0xe0, 0x0d, 0x81, 0x54, 0x1e, 0x91, 0x4a,
+	0xa5, 0x8a, 0x4a, 0x08, 0xa4, 0x3e, 0x51, 0x24, 0x1e, 0x82, 0x40, 0x88, 0x27, 0xa8, 0x12, 0x24,
+	0xfe, 0x0c, 0xd0, 0xdc, 0x39, 0x33, 0x3b, 0x33, 0x7b, 0x67, 0xf6, 0xae, 0xb3, 0x3c, 0x79, 0xe7,
+	0xce, 0x3d, 0xe7, 0xfe, 0x7e, 0x67, 0xee, 0x3d, 0xf7, 0x9c, 0x73, 0xaf, 0xe1, 0xc4, 0xfb, 0xd4,
+	0xd1, 0x4a, 0x35, 0x4d, 0x37, 0x73, 0xfc, 0x97, 0x65, 0xd3, 0x9c, 0x55, 0x64, 0xd4, 0xde, 0xa4,
+	0x76, 0xee, 0xdd, 0x0d, 0x6a, 0x37, 0xb2, 0x75, 0xdb, 0x72, 0x2c, 0x72, 0x30, 0xe8, 0x98, 0xf5,
+	0x3b, 0x66, 0xfd, 0x8e, 0x99, 0x99, 0x92, 0xc5, 0xd6, 0x2d, 0x96, 0x2b, 0x6a, 0x8c, 0x7a, 0x52,
+	0xb9, 0xcd, 0xf9, 0x22, 0x75, 0xb4, 0xf9, 0x5c, 0x5d, 0xab, 0xea, 0xa6, 0xe6, 0xe8, 0x96, 0xe9,
+	0x29, 0xca, 0x8c, 0x57, 0xad, 0xaa, 0xc5, 0x7f, 0xe6, 0xdc, 0x5f, 0xd8, 0x7a, 0xa8, 0x6a, 0x59,
+	0x55, 0x83, 0xe6, 0xb4, 0xba, 0x9e, 0xd3, 0x4c, 0xd3, 0x72, 0xb8, 0x08, 0xc3, 0xb7, 0xd3, 0x69,
+	0x28, 0x8b, 0x9a, 0x61, 0x58, 0x0e, 0xf6, 0x4c, 0xe5, 0x53, 0x34, 0xb4, 0x75, 0x8a, 0x1d, 0xb3,
+	0x69, 0x1d, 0x79, 0x7b, 0xc1, 0xb4, 0xcc, 0x12, 0xf5, 0x21, 0x2c, 0xa4, 0xf6, 0xb7, 0x2d, 0xc6,
+	0x3c, 0xa1, 0x8a, 0xa1, 0x55, 0xa5, 0x60, 0x3f, 0xa2, 0x8d, 0x2a, 0xf5, 0x8d, 0x32, 0x93, 0xd6,
+	0xd3, 0xd0, 0x37, 0xa9, 0x49, 0x19, 0x93, 0x41, 0x6e, 0x5a, 0x65, 0x5a, 0xd0, 0x4a, 0x25, 0x6b,
+	0xc3, 0x74, 0x64, 0x74, 0xfb, 0x3f, 0xb0, 0xef, 0xab, 0x32, 0x7d, 0x0b, 0x8c, 0x3a, 0x85, 0x52,
+	0x4d, 0x33, 0xab, 0x1d, 0x18, 0xb3, 0xae, 0xd9, 0xda, 0xba, 0x4f, 0x61, 0x2e, 0xad, 0x7f, 0x9d,
+	0x9a, 0x65, 0xdd, 0xac, 0x46, 0xcd, 0x7f, 0x3c, 0x4d, 0xc2, 0x09, 0x6c, 0x33, 0x9b, 0x8a, 0xbf,
+	0x4e, 0x6d, 0x3e, 0xad, 0x34, 0x23, 0xc5, 0x34, 0xf5, 0x47, 0x55, 0x0f, 0x32, 0xc3, 0x3f, 0x6d,
+	0xfa, 0xd6, 0x6d, 0xcb, 0xaa, 0x30, 0xfc, 0x83, 0x7d, 0xcf, 0xb6, 0x41, 0x5b, 0xa8, 0x6c, 0x98,
+	0x65, 0x56, 0x58, 0xd7, 0xab, 0xb6, 0xe6, 0x58, 0xbe, 0xf1, 0x0f, 0xe0, 0x2a, 0x5a, 0x67, 0xd5,
+	0xdc, 0xe6, 0xbc, 0xfb, 0xc7, 0x7b, 0xa1, 0xbe, 0x05, 0x63, 0x5f, 0x75, 0x17, 0xd5, 0x12, 0x9f,
+	0xe9, 0x2c, 0x4f, 0xdf, 0xdd, 0xa0, 0xcc, 0x21, 0x2b, 0x00, 0xcd, 0xd5, 0x35, 0xa1, 0x4c, 0x29,
+	0xd3, 0x03, 0x0b, 0xaf, 0x64, 0x3d, 0x25, 0x59, 0x77, 0x29, 0x66, 0xbd, 0x05, 0x8c, 0x4b, 0x31,
+	0x7b, 0x5f, 0xab, 0x52, 0x94, 0xcd, 0x87, 0x24, 0xd5, 0x8f, 0x14, 0x18, 0x8f, 0xea, 0x67, 0x75,
+	0xcb, 0x64, 0x94, 0x2c, 0xc3, 0x5e, 0x6f, 0x71, 0xb1, 0x09, 0x65, 0xaa, 0x67, 0x7a, 0x60, 0xe1,
+	0x68, 0x36, 0xc5, 0x0b, 0x64, 0x3d, 0xf1, 0xa5, 0x17, 0xbe, 0xf8, 0xe7, 0xe1, 0x5d, 0x79, 0x5f,
+	0x92, 0xac, 0x46, 0x50, 0xee, 0xe6, 0x28, 0x4f, 0xb4, 0x45, 0xe9, 0x21, 0x88, 0xc0, 0x9c, 0x84,
+	0x43, 0x1c, 0xe5, 0xbd, 0xe6, 0x67, 0x5c, 0x71, 0x17, 0x1b, 0x52, 0x52, 0xbf, 0xab, 0xc0, 0xcb,
+	0x09, 0x1d, 0x90, 0xcf, 0xdb, 0x30, 0x1a, 0x9a, 0x03, 0xde, 0x52, 0x45, 0xbb, 0xcd, 0xa6, 0x32,
+	0x8b, 0x6b, 0x44, 0x8e, 0x23, 0x56, 0xac, 0x5d, 0x3d, 0x0e, 0x47, 0x39, 0x84, 0x07, 0x8c, 0xad,
+	0xb8, 0x9f, 0xf8, 0x0d, 0xfc, 0xc2, 0x37, 0xcd, 0x8a, 0xb5, 0x68, 0x18, 0x3e, 0xd4, 0x1f, 0x2a,
+	0x70, 0x2c, 0xbd, 0x1f, 0x22, 0xae, 0xc0, 0x58, 0xeb, 0x74, 0xf1, 0xbf, 0xc6, 0x5c, 0x2a, 0x66,
+	0x54, 0x1d, 0xd6, 0x8c, 0xb0, 0x47, 0x9d, 0xd8, 0xa8, 0x4c, 0xbd, 0x02, 0x53, 0x89, 0x78, 0xfc,
+	0xe9, 0xf6, 0x12, 0xf4, 0x79, 0x4b, 0x59, 0x2f, 0x73, 0xa3, 0xf5, 0xe4, 0xf7, 0xf2, 0xe7, 0x9b,
+	0x65, 0xf5, 0x07, 0x0a, 0x1c, 0x49, 0x91, 0x47, 0x32, 0x65, 0x20, 0xad, 0x64, 0xd0, 0xfe, 0x3b,
+	0xe5, 0x32, 0x12, 0xe7, 0xa2, 0x9e, 0x83, 0x0c, 0x87, 0xb2, 0x4a, 0x9d, 0x65, 0x57, 0xdd, 0x5d,
+	0xee, 0x46, 0x24, 0x48, 0x58, 0x70, 0x50, 0x28, 0x88, 0xe8, 0xef, 0xc3, 0x40, 0xa8, 0x19, 0x61,
+	0x4f, 0xa7, 0xc2, 0x0e, 0xf5, 0x47, 0xb8, 0x61, 0x15, 0x6a, 0x19, 0x91, 0x2e, 0x1a, 0x86, 0x00,
+	0x69, 0xb7, 0x56, 0xf7, 0x67, 0x0a, 0xf2, 0x8a, 0x0f, 0x93, 0xc4, 0xab, 0xe7, 0x39, 0x79, 0x75,
+	0x6f, 0xc5, 0x57, 0x70, 0xc5, 0x2f, 0x1a, 0xc6, 0x7d, 0x6f, 0x53, 0xf8, 0xff, 0x98, 0xe8, 0x73,
+	0xdf, 0x73, 0xb4, 0x0e, 0x84, 0x46, 0x7a, 0x13, 0x86, 0xa2, 0xdb, 0x12, 0xda, 0x69, 0x26, 0xd5,
+	0x4e, 0x11, 0x5d, 0x68, 0xa9, 0x7d, 0xf5, 0x70, 0x63, 0xf7, 0x6c, 0xe5, 0xaf, 0xe0, 0xe8, 0x98,
+	0x0d, 0xfe, 0x5d, 0x24, 0x26, 0xff, 0x77, 0x70, 0x01, 0x8b, 0xc5, 0x53, 0xac, 0xa0, 0x74, 0xc1,
+	0x0a, 0xea, 0x38, 0x10, 0x7f, 0xe9, 0x3d, 0x58, 0x5b, 0xf3, 0xbd, 0xe4, 0x3d, 0xdc, 0xf6, 0xfc,
+	0x56, 0x44, 0x71, 0x1e, 0x7a, 0x1e, 0xac, 0xad, 0xe1, 0xd0, 0x53, 0xe9, 0x7e, 0x63, 0x6d, 0x0d,
+	0x07, 0x74, 0x45, 0xd4, 0xeb, 0xf0, 0x52, 0xa0, 0x90, 0xb1, 0xc5, 0x72, 0xd9, 0xa6, 0x2c, 0x98,
+	0x4c, 0xd3, 0x30, 0x52, 0xd4, 0x9d, 0x92, 0xa5, 0x9b, 0x85, 0xc0, 0x48, 0xbb, 0xb9, 0x91, 0x86,
+	0xb0, 0x7d, 0x19, 0x6d, 0x95, 0x6f, 0x7a, 0x98, 0xb0, 0x1a, 0x84, 0x37, 0x02, 0x3d, 0xd4, 0xa9,
+	0x71, 0x78, 0xfd, 0x79, 0xf7, 0xa7, 0xdb, 0x52, 0x74, 0x4a, 0x5c, 0x59, 0x7f, 0xde, 0xfd, 0xe9,
+	0xb6, 0xb0, 0x0d, 0x7d, 0xa2, 0xc7, 0x6b, 0x61, 0x1b, 0xba, 0xfa, 0xa1, 0x02, 0x33, 0xad, 0x4a,
+	0x97, 0x1a, 0x2b, 0xba, 0xa9, 0x19, 0xfa, 0xfb, 0xb4, 0x7c, 0x83, 0xea, 0xd5, 0x9a, 0xe3, 0x83,
+	0x5d, 0x80, 0x17, 0x2b, 0xfe, 0x9b, 0x82, 0xcb, 0xbb, 0x50, 0xe3, 0xef, 0xf1, 0xb3, 0x8e, 0x05,
+	0x2f, 0x1f, 0x52, 0x47, 0xf3, 0x44, 0x3b, 0x20, 0x58, 0x82, 0x53, 0x52, 0x58, 0x9e, 0x8b, 0xf1,
+	0xdb, 0xb0, 0xdf, 0xdf, 0x32, 0x6e, 0xe8, 0xcc, 0xb1, 0xec, 0x46, 0xb7, 0x97, 0xf5, 0xaf, 0x14,
+	0x38, 0xd0, 0x32, 0x04, 0x62, 0x5e, 0x84, 0x3e, 0x77, 0x2f, 0x32, 0x74, 0xe6, 0xe0, 0x52, 0x96,
+	0x9d, 0x49, 0x7b, 0x1d, 0xc6, 0xee, 0xe8, 0xcc, 0xe9, 0xde, 0xd2, 0xad, 0x61, 0xf8, 0x75, 0x43,
+	0x63, 0x5f, 0xb7, 0x1c, 0x5a, 0xf6, 0xed, 0x70, 0x0a, 0x46, 0xbd, 0x20, 0xaa, 0xa0, 0x97, 0xa9,
+	0xe9, 0xe8, 0x15, 0x9d, 0xda, 0x68, 0xe5, 0x11, 0xef, 0xc5, 0xcd, 0xa0, 0x9d, 0x1c, 0x85, 0x7d,
+	0x9b, 0x96, 0x43, 0xed, 0x82, 0xe6, 0x7d, 0x2e, 0x34, 0xfe, 0x20, 0x6f, 0xc4, 0x4f, 0xa8, 0x9e,
+	0x85, 0x17, 0x63, 0x23, 0xa1, 0x39, 0x0e, 0x42, 0x7f, 0x4d, 0x63, 0x05, 0xb7, 0xb3, 0xe7, 0x1a,
+	0xfa, 0xf2, 0x7d, 0x35, 0xec, 0xa4, 0xbe, 0x01, 0x93, 0xa1, 0xf0, 0x70, 0xa9, 0xd1, 0x1c, 0x75,
+	0x27, 0x48, 0x55, 0x07, 0xfa, 0x5d, 0xbd, 0x36, 0x37, 0x62, 0x0b, 0x6c, 0xa5, 0x15, 0x36, 0x59,
+	0x82, 0x7e, 0xf7, 0xb9, 0xe0, 0x34, 0xea, 0x94, 0xf3, 0x1a, 0x5a, 0x38, 0x9e, 0xfa, 0xb5, 0x5c,
+	0xfd, 0x0f, 0x1a, 0x75, 0x9a, 0xef, 0xdb, 0xc4, 0x5f, 0xea, 0x6f, 0x77, 0xc3, 0xe1, 0x44, 0x16,
+	0x68, 0x85, 0x8e, 0x0c, 0xfe, 0x3a, 0xf4, 0x72, 0x90, 0xae, 0xa5, 0x7b, 0xf8, 0x0c, 0x6d, 0x87,
+	0x88, 0x33, 0xce, 0xa3, 0x14, 0x79, 0x13, 0x46, 0xbc, 0xb7, 0x7c, 0x12, 0x78, 0xdc, 0x7a, 0x38,
+	0xb7, 0xd3, 0xe9, 0xb1, 0x68, 0x53, 0x88, 0x53, 0x1c, 0xb6, 0xa2, 0x0d, 0xe4, 0x2e, 0xec, 0x43,
+	0x16, 0xcc, 0xd1, 0x9c, 0x0d, 0x36, 0xf1, 0x02, 0xd7, 0x7a, 0x52, 0x22, 0x76, 0x5f, 0xe3, 0x02,
+	0xf9, 0xc1, 0x62, 0xe8, 0x49, 0x25, 0x30, 0xe2, 0x85, 0xd5, 0xd8, 0x77, 0x8d, 0x3a, 0xea, 0x79,
+	0x98, 0x88, 0xb7, 0x05, 0x56, 0x3c, 0x04, 0xfd, 0xbe, 0x5a, 0x6f, 0x9b, 0xec, 0xcf, 0x37, 0x1b,
+	0xd4, 0xfd, 0x38, 0xd9, 0xd7, 0x36, 0xea, 0x75, 0xcb, 0x76, 0x68, 0x99, 0x3b, 0x1d, 0xa6, 0x16,
+	0x71, 0xaf, 0x8f, 0xb5, 0x07, 0x5a, 0x97, 0xa0, 0xd7, 0x4b, 0xc7, 0x70, 0xb9, 0x1e, 0x13, 0xd1,
+	0xa9, 0x3f, 0xaa, 0x66, 0x31, 0x69, 0xe3, 0xe2, 0xb8, 0x64, 0x51, 0x52, 0xbd, 0x0a, 0x6a, 0x24,
+	0xc2, 0xbb, 0xcf, 0x73, 0xd2, 0x15, 0xcb, 0x96, 0xdd, 0x25, 0x6d, 0x0c, 0xef, 0x93, 0x14, 0x20,
+	0xd6, 0xdb, 0x30, 0x18, 0x4e, 0x7a, 0xe5, 0x63, 0x45, 0x4f, 0x5f, 0x7e, 0xa0, 0xd4, 0x7c, 0x50,
+	0x0f, 0xc5, 0xe2, 0x59, 0xec, 0x83, 0x7b, 0xa4, 0x19, 0x0b, 0x5a, 0xfd, 0xb7, 0x88, 0xe4, 0x9e,
+	0x10, 0xc9, 0x69, 0x59, 0x24, 0x7c, 0xc2, 0x46, 0xd0, 0x2c, 0x34, 0xd1, 0xdc, 0xb5, 0xca, 0x74,
+	0xd1, 0xab, 0x34, 0xf8, 0xa6, 0x1b, 0x87, 0x3d, 0xba, 0x59, 0xa6, 0xef, 0xe1, 0xa2, 0xf1, 0x1e,
+	0xd4, 0x77, 0x9a, 0x18, 0x23, 0x32, 0x4d, 0x6b, 0x85, 0xab, 0x16, 0x52, 0xd6, 0x0a, 0xeb, 0x19,
+	0x30, 0x9b, 0x0f, 0xe1, 0x98, 0x5a, 0x80, 0xaf, 0x5b, 0x3b, 0xcb, 0x27, 0xa1, 0x98, 0x5a, 0x44,
+	0xe9, 0x16, 0x0c, 0x84, 0x9a, 0xa5, 0x62, 0xea, 0x08, 0xa3, 0xd0, 0x43, 0xf7, 0xb6, 0x99, 0x29,
+	0x74, 0xe3, 0xee, 0x54, 0x09, 0xea, 0x55, 0x91, 0x0c, 0xfa, 0x03, 0x05, 0x7d, 0xa4, 0xa8, 0x0b,
+	0x52, 0x7b, 0x0b, 0x46, 0xe2, 0xd5, 0x2e, 0xb9, 0x59, 0x15, 0xd5, 0x87, 0x2b, 0x73, 0xb8, 0x14,
+	0x6d, 0x56, 0x0f, 0xe0, 0x0e, 0xb5, 0x4a, 0x9d, 0xdb, 0xbc, 0x40, 0xe6, 0x63, 0xfb, 0x1a, 0x86,
+	0x0b, 0xa1, 0x17, 0x88, 0xe8, 0x12, 0xf4, 0x7a, 0xb5, 0x34, 0xc4, 0x91, 0x5e, 0xa4, 0x40, 0x61,
+	0x14, 0x51, 0x0f, 0x63, 0xe4, 0xbf, 0x56, 0xb3, 0x1e, 0xfb, 0xce, 0x6c, 0x39, 0x34, 0x65, 0x5c,
+	0x9b, 0x4c, 0x26, 0xf5, 0x40, 0x00, 0xdf, 0x86, 0x31, 0x43, 0x63, 0x4e, 0x21, 0xa8, 0x8f, 0x85,
+	0xe7, 0x71, 0x36, 0x15, 0xcd, 0x1d, 0x8d, 0x39, 0x51, 0xa5, 0xa3, 0x46, 0xbc, 0x49, 0xbd, 0x85,
+	0x18, 0x97, 0x0c, 0x6d, 0x9d, 0x8a, 0xb6, 0xdf, 0x93, 0x30, 0xc2, 0x4b, 0x9b, 0xad, 0xdb, 0xd6,
+	0x30, 0x6f, 0x0f, 0x6d, 0xbe, 0x25, 0x7f, 0x2f, 0x6f, 0xd5, 0x15, 0x44, 0x46, 0x80, 0xca, 0xcc,
+	0x8a, 0x85, 0x24, 0xd4, 0xf4, 0xbd, 0xc3, 0xed, 0x9e, 0xef, 0xf7, 0x86, 0x32, 0x2b, 0x96, 0x4a,
+	0x9b, 0xab, 0xc3, 0x7b, 0x47, 0x4b, 0x96, 0x5d, 0xee, 0x7a, 0xda, 0xf6, 0xb1, 0xd2, 0xcc, 0x0f,
+	0xa3, 0xe3, 0x20, 0x95, 0xd5, 0x18, 0x95, 0x1e, 0x39, 0x2a, 0x38, 0x37, 0x9b, 0x84, 0xba, 0xb7,
+	0x06, 0xd7, 0x30, 0x4b, 0x43, 0xf3, 0x73, 0x57, 0xbb, 0x68, 0x96, 0x79, 0x1a, 0xd4, 0x7e, 0xff,
+	0x71, 0xfd, 0x2b, 0x4f, 0xbc, 0x30, 0x6e, 0xf7, 0x1e, 0xd4, 0x0a, 0xe6, 0x6e, 0x62, 0xa5, 0x09,
+	0x9f, 0xb5, 0xa7, 0xf3, 0xcf, 0x7a, 0x13, 0x97, 0x20, 0x7f, 0xe1, 0x06, 0x07, 0xc1, 0x17, 0xdd,
+	0x0f, 0xbd, 0x8f, 0x75, 0xb3, 0x6c, 0x3d, 0x46, 0xc0, 0xf8, 0x44, 0x0e, 0xc0, 0xde, 0xfa, 0x46,
+	0xb1, 0xf0, 0x88, 0x36, 0x30, 0x1c, 0xed, 0xad, 0x6f, 0x14, 0x6f, 0xd3, 0x86, 0xfa, 0x91, 0x1f,
+	0x9a, 0x87, 0x75, 0x21, 0xd2, 0x23, 0x30, 0xc8, 0x1c, 0xcd, 0x76, 0xa2, 0x29, 0xcd, 0x00, 0x6f,
+	0xc3, 0x54, 0xe6, 0x65, 0x00, 0x6a, 0x96, 0xfd, 0x0e, 0x9e, 0x31, 0xfa, 0xa9, 0x89, 0x89, 0x09,
+	0x59, 0x85, 0x3d, 0x6e, 0xe8, 0xc3, 0x26, 0x7a, 0x38, 0xcd, 0x53, 0x6d, 0x1d, 0x6f, 0x13, 0x05,
+	0x7e, 0x7b, 0x4f, 0x5e, 0xbd, 0x10, 0x89, 0x19, 0xdd, 0xdd, 0x70, 0xc5, 0xb2, 0xa3, 0x99, 0xd8,
+	0x7e, 0xe8, 0x8d, 0xe0, 0xc4, 0x27, 0x75, 0xcb, 0xff, 0xd2, 0x22, 0xd1, 0x20, 0x9f, 0x1e, 0xc0,
+	0x48, 0x0d, 0xf3, 0x90, 0xf6, 0x95, 0x30, 0x81, 0x3a, 0x84, 0x0c, 0xc5, 0xe0, 0x55, 0xe0, 0xd5,
+	0x42, 0xe1, 0xd9, 0x32, 0xaf, 0xd7, 0xfb, 0x5e, 0xed, 0xfb, 0xbe, 0x57, 0x13, 0xf4, 0x08, 0xaa,
+	0x75, 0x63, 0x82, 0x82, 0xbf, 0x94, 0x57, 0x6b, 0x51, 0xea, 0x17, 0x1e, 0xad, 0xf8, 0x0b, 0xf5,
+	0x0e, 0x5a, 0x38, 0x10, 0xd1, 0xab, 0xa6, 0x6e, 0x56, 0xc3, 0x75, 0xc7, 0x93, 0x7e, 0xa0, 0xdc,
+	0x92, 0x25, 0x0c, 0xfb, 0xed, 0x7e, 0x7e, 0xb3, 0x8d, 0x46, 0x17, 0x6a, 0x43, 0x5e, 0xdf, 0x80,
+	0x41, 0xe6, 0x35, 0x87, 0x3d, 0xdc, 0x9c, 0x1c, 0xa1, 0xa6, 0x3e, 0xbf, 0xf0, 0xc5, 0x9a, 0x4d,
+	0xea, 0x3b, 0xc9, 0xc3, 0x77, 0xdd, 0xf9, 0xfd, 0xc9, 0x2f, 0xb9, 0x8a, 0x07, 0x43, 0xb2, 0xdf,
+	0x84, 0x7d, 0x61, 0xb2, 0x72, 0x95, 0xe3, 0x64, 0xb6, 0x83, 0x21, 0xb6, 0x5d, 0xac, 0x5d, 0xf9,
+	0x21, 0xee, 0x1d, 0x3c, 0xe8, 0x8a, 0x86, 0xb8, 0x0d, 0xdc, 0x4d, 0xe2, 0x6f, 0x91, 0xe2, 0x43,
+	0x18, 0xf6, 0x0f, 0xc8, 0xa2, 0x51, 0x6e, 0xfa, 0xb2, 0x8f, 0x6a, 0x43, 0x7e, 0x43, 0x46, 0xa4,
+	0x75, 0xe1, 0xbf, 0x39, 0xd8, 0xc3, 0xc7, 0x26, 0xbf, 0x57, 0xa0, 0xcf, 0xcf, 0x9a, 0xc9, 0x7c,
+	0xaa, 0x66, 0x51, 0x2e, 0x9f, 0x59, 0xe8, 0x44, 0xc4, 0x63, 0xa6, 0xde, 0xfa, 0xde, 0x5f, 0xff,
+	0xfd, 0xe3, 0xdd, 0x5f, 0x21, 0x4b, 0xfc, 0x10, 0x69, 0xd6, 0x3b, 0x4f, 0x0a, 0x8e, 0x91, 0x82,
+	0x7c, 0x3d, 0xb7, 0xd5, 0x92, 0xb4, 0x6e, 0xe7, 0xb6, 0x22, 0x59, 0xf5, 0x36, 0xf9, 0xbb, 0x02,
+	0xa4, 0x35, 0xf3, 0x25, 0x97, 0xda, 0xc3, 0x4a, 0xcc, 0xfa, 0x33, 0x97, 0x77, 0x26, 0x8c, 0xec,
+	0xae, 0x73, 0x76, 0x57, 0xc9, 0x15, 0x21, 0x3b, 0xa4, 0x54, 0x6c, 0x84, 0x58, 0x89, 0x88, 0x92,
+	0x27, 0x0a, 0x8c, 0x09, 0x9c, 0x22, 0x91, 0x06, 0x27, 0xf2, 0xea, 0x99, 0x2b, 0x3b, 0x94, 0x46,
+	0x6e, 0x97, 0x39, 0xb7, 0xd7, 0xc8, 0xd9, 0x34, 0x6e, 0xae, 0xcf, 0x2f, 0x54, 0x2c, 0x1b, 0xb7,
+	0xb1, 0xdc, 0x96, 0xf7, 0x77, 0x9b, 0xfc, 0x5c, 0x81, 0x81, 0x90, 0x0b, 0x25, 0xb3, 0xed, 0xc1,
+	0x84, 0xba, 0x67, 0x5e, 0xed, 0xa8, 0x7b, 0x80, 0xf9, 0x24, 0xc7, 0x7c, 0x94, 0x1c, 0x11, 0x62,
+	0x0e, 0x6f, 0x05, 0xe4, 0xd7, 0x0a, 0x0c, 0xc7, 0xf2, 0x74, 0x99, 0x35, 0x11, 0x13, 0xc9, 0x5c,
+	0xe8, 0x58, 0x24, 0x00, 0x7b, 0x9a, 0x83, 0x7d, 0x85, 0x1c, 0x13, 0x82, 0x65, 0x31, 0x6c, 0xff,
+	0x52, 0x60, 0xbf, 0x38, 0x65, 0x27, 0x57, 0xdb, 0x63, 0x48, 0xad, 0x16, 0x64, 0xae, 0xed, 0x5c,
+	0x01, 0x72, 0x59, 0xe2, 0x5c, 0x2e, 0x93, 0x8b, 0x42, 0x2e, 0x55, 0x6f, 0xeb, 0x0d, 0x52, 0x78,
+	0x3e, 0x63, 0xbc, 0x3e, 0x5b, 0x7e, 0x98, 0xb8, 0x4d, 0x3e, 0x51, 0x60, 0x28, 0x3a, 0x0c, 0x39,
+	0xd7, 0x29, 0x30, 0x9f, 0xd1, 0xf9, 0xce, 0x05, 0x91, 0xc9, 0x2c, 0x67, 0x72, 0x82, 0x1c, 0x97,
+	0x62, 0xe2, 0x82, 0x8e, 0x64, 0xba, 0x72, 0x88, 0x5b, 0xd3, 0x7a, 0x49, 0xc4, 0x82, 0x44, 0x5d,
+	0x9d, 0xe3, 0x88, 0x67, 0xc8, 0xb4, 0x10, 0x71, 0xa8, 0xb0, 0x90, 0xdb, 0xe2, 0xb5, 0x8c, 0x6d,
+	0x77, 0xee, 0x0f, 0x85, 0x34, 0x2d, 0x1a, 0x86, 0x0c, 0x6e, 0x61, 0x39, 0x42, 0x06, 0xb7, 0xb8,
+	0xc0, 0xa0, 0x4e, 0x73, 0xdc, 0x2a, 0x99, 0x6a, 0x87, 0x9b, 0xfc, 0x4e, 0x81, 0xe1, 0x58, 0xee,
+	0x2d, 0xe3, 0xf5, 0x13, 0x8b, 0x04, 0x32, 0x5e, 0x3f, 0xb9, 0x7c, 0xd0, 0x66, 0x8a, 0xc4, 0x2b,
+	0x0b, 0xe4, 0xa7, 0x0a, 0xf4, 0x7a, 0x19, 0x3b, 0x59, 0x90, 0x1a, 0x37, 0x52, 0x34, 0xc8, 0x9c,
+	0xe9, 0x48, 0x06, 0x21, 0x1e, 0xe5, 0x10, 0x5f, 0x26, 0x07, 0x85, 0x10, 0xbd, 0xba, 0x01, 0xf9,
+	0xa3, 0x02, 0xa3, 0x2d, 0x15, 0x01, 0x72, 0x51, 0xc2, 0xa3, 0x25, 0x14, 0x1a, 0x32, 0x97, 0x76,
+	0x24, 0x8b, 0x98, 0x2f, 0x70, 0xcc, 0x67, 0xc8, 0x7c, 0x18, 0x73, 0xeb, 0xd5, 0x13, 0x56, 0xb3,
+	0x1e, 0xc7, 0xca, 0x14, 0xe4, 0x2f, 0x0a, 0x8c, 0xb6, 0x54, 0x03, 0x64, 0x98, 0x24, 0x95, 0x23,
+	0x64, 0x98, 0x24, 0x96, 0x1f, 0xd4, 0x65, 0xce, 0xe4, 0x0a, 0xb9, 0x24, 0xde, 0x3a, 0x79, 0x0a,
+	0x1b, 0x8f, 0x0a, 0x62, 0xb5, 0x8f, 0x6d, 0x37, 0x5a, 0x23, 0xab, 0xd4, 0x89, 0xd5, 0x05, 0x88,
+	0xdc, 0x7a, 0x13, 0x94, 0x2c, 0x64, 0xb6, 0xaa, 0x84, 0x22, 0x84, 0xba, 0xc0, 0x09, 0x9d, 0x26,
+	0x33, 0x89, 0x4e, 0x51, 0x33, 0x8c, 0x82, 0xc7, 0xc1, 0x46, 0xa0, 0x5f, 0x2a, 0xf0, 0x22, 0x57,
+	0xc6, 0x62, 0xe9, 0x3c, 0xb9, 0x22, 0x6d, 0x5b, 0x51, 0x6d, 0x21, 0xf3, 0xfa, 0x4e, 0xc5, 0x91,
+	0xcc, 0x0d, 0x4e, 0x66, 0x89, 0x5c, 0x4b, 0xff, 0x3a, 0xde, 0x12, 0xd6, 0xcc, 0xb2, 0x77, 0x4e,
+	0x1c, 0xda, 0xa9, 0x72, 0x5b, 0xbc, 0x65, 0x9b, 0xfc, 0x52, 0x01, 0x68, 0xa6, 0xdd, 0xe4, 0x8c,
+	0x24, 0xb0, 0x70, 0xd9, 0x21, 0x73, 0xb6, 0x33, 0x21, 0x29, 0xdf, 0xe9, 0x71, 0xe0, 0xe9, 0x3f,
+	0xf9, 0x5c, 0x81, 0x7d, 0x91, 0x33, 0x50, 0xf2, 0x9a, 0x94, 0x43, 0x69, 0x39, 0x5c, 0xce, 0x9c,
+	0xeb, 0x58, 0x0e, 0xc1, 0x5e, 0xe5, 0x60, 0x2f, 0x90, 0x73, 0x89, 0xb3, 0xc7, 0x61, 0xcc, 0x0f,
+	0xf3, 0x73, 0x5b, 0xf1, 0x03, 0xde, 0x6d, 0xf2, 0xb3, 0xdd, 0x30, 0x99, 0x7e, 0x8e, 0x4b, 0x56,
+	0x3b, 0x04, 0x97, 0x74, 0x2a, 0x9d, 0xb9, 0xf1, 0xfc, 0x8a, 0x90, 0x76, 0x91, 0xd3, 0xfe, 0x16,
+	0x79, 0x28, 0x43, 0xbb, 0x50, 0xe3, 0x87, 0xbb, 0x7a, 0x49, 0x33, 0x72, 0x5b, 0xc2, 0x63, 0xf1,
+	0x6d, 0x91, 0x65, 0x3e, 0x54, 0xf8, 0x45, 0x02, 0x92, 0x93, 0x43, 0x1d, 0xdc, 0x4b, 0xc8, 0xcc,
+	0xc9, 0x0b, 0x20, 0x9d, 0x29, 0x4e, 0x27, 0x43, 0x26, 0x84, 0x74, 0x5c, 0x10, 0xbf, 0x50, 0x00,
+	0x9a, 0xc7, 0xd4, 0x32, 0xcb, 0xa1, 0xe5, 0xdc, 0x5c, 0x66, 0x39, 0xb4, 0x9e, 0x84, 0xab, 0x27,
+	0x38, 0xb6, 0x23, 0xe4, 0xb0, 0x10, 0x9b, 0xd3, 0xc4, 0xf4, 0xa9, 0x02, 0x23, 0x91, 0xbb, 0x1c,
+	0x6e, 0xec, 0x23, 0xe7, 0x18, 0x45, 0xb7, 0x77, 0x32, 0x17, 0x77, 0x22, 0x8a, 0xa0, 0x67, 0x38,
+	0xe8, 0x63, 0x44, 0x15, 0x82, 0x8e, 0x5e, 0xb1, 0xf9, 0xb3, 0x02, 0xe3, 0xa2, 0x6b, 0x2d, 0x32,
+	0xbe, 0x34, 0xe5, 0x36, 0x8d, 0x8c, 0x2f, 0x4d, 0xbb, 0x4d, 0xa3, 0xbe, 0xca, 0x39, 0xe4, 0xc8,
+	0x6c, 0x7b, 0x0e, 0xe1, 0x50, 0xff, 0x53, 0x25, 0x72, 0x61, 0xab, 0x93, 0x38, 0x3f, 0x6a, 0xff,
+	0xf3, 0x9d, 0x0b, 0x22, 0xf2, 0x33, 0x1c, 0xf9, 0x2c, 0x39, 0x25, 0x0e, 0xe2, 0x9a, 0x12, 0x61,
+	0xdc, 0x6e, 0xe0, 0x1c, 0x52, 0x26, 0x1f, 0x38, 0xef, 0x0c, 0xba, 0xf8, 0xb6, 0x5b, 0x1b, 0xe7,
+	0x1f, 0x82, 0xee, 0x46, 0x78, 0xe3, 0xa2, 0xeb, 0x8c, 0x32, 0xd3, 0x26, 0xe5, 0x1a, 0xa5, 0xcc,
+	0xb4, 0x49, 0xbb, 0x45, 0xd9, 0x26, 0x65, 0xa9, 0x72, 0xff, 0x1a, 0x91, 0x26, 0x7f, 0x53, 0xe0,
+	0x40, 0xc2, 0x45, 0x53, 0x72, 0x6d, 0x67, 0x68, 0x9a, 0x77, 0x59, 0x33, 0x8b, 0xcf, 0xa1, 0x01,
+	0x29, 0x9d, 0xe5, 0x94, 0xb2, 0xe4, 0x74, 0x12, 0xa5, 0x45, 0xc3, 0x88, 0xeb, 0x60, 0xe4, 0x33,
+	0x05, 0x46, 0xe2, 0x17, 0x73, 0x65, 0xfc, 0x51, 0xc2, 0xfd, 0x61, 0x19, 0x7f, 0x94, 0x74, 0xb3,
+	0xb8, 0x4d, 0x5a, 0x13, 0xbf, 0x26, 0x4c, 0x7e, 0xa2, 0xc0, 0x5e, 0xbc, 0x6c, 0x4d, 0xe6, 0x64,
+	0x4b, 0x4d, 0x01, 0xd0, 0xf9, 0x0e, 0x24, 0x10, 0xdf, 0x31, 0x8e, 0x6f, 0x92, 0x1c, 0x4a, 0x29,
+	0x48, 0x31, 0xf2, 0x07, 0x05, 0x46, 0x5b, 0x6a, 0xf7, 0x32, 0xa9, 0x40, 0xd2, 0x39, 0x83, 0x4c,
+	0x2a, 0x90, 0x78, 0x02, 0xd1, 0x66, 0xa6, 0x0b, 0x0e, 0x27, 0x78, 0x31, 0x50, 0x50, 0xbd, 0x96,
+	0x29, 0x06, 0x26, 0x1f, 0x40, 0xc8, 0x14, 0x03, 0x53, 0x0e, 0x1c, 0xda, 0x14, 0x03, 0xc3, 0xe5,
+	0xf9, 0xdc, 0x56, 0xfc, 0xa0, 0x63, 0xdb, 0x8d, 0x41, 0xc7, 0x45, 0x25, 0x7e, 0xb2, 0x33, 0x54,
+	0xac, 0x03, 0x37, 0x94, 0x76, 0xb2, 0xd0, 0x66, 0x07, 0x8e, 0x1c, 0x3a, 0x90, 0xdf, 0x28, 0x30,
+	0x14, 0xad, 0xb7, 0xcb, 0xb8, 0x7e, 0xe1, 0x69, 0x80, 0x8c, 0xeb, 0x17, 0x1f, 0x14, 0xb4, 0xa9,
+	0x19, 0xc6, 0xce, 0x10, 0x32, 0x7b, 0x3e, 0xf8, 0xcf, 0xc7, 0x33, 0xca, 0xd2, 0xf5, 0x2f, 0x9e,
+	0x4e, 0x2a, 0x4f, 0x9e, 0x4e, 0x2a, 0x5f, 0x3e, 0x9d, 0x54, 0x7e, 0xf4, 0x6c, 0x72, 0xd7, 0x93,
+	0x67, 0x93, 0xbb, 0xfe, 0xf1, 0x6c, 0x72, 0xd7, 0xc3, 0x53, 0x55, 0xdd, 0xa9, 0x6d, 0x14, 0xb3,
+	0x25, 0x6b, 0x3d, 0xac, 0xd0, 0xb4, 0xca, 0x34, 0xf7, 0x5e, 0x28, 0x80, 0x6a, 0xd4, 0x29, 0x2b,
+	0xf6, 0xf2, 0x7f, 0xe4, 0x38, 0xf3, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbb, 0x8b, 0x4a, 0x1e,
+	0x6f, 0x35, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Query if a voter has voted for a ballot
+	HasVoted(ctx context.Context, in *QueryHasVotedRequest, opts ...grpc.CallOption) (*QueryHasVotedResponse, error)
 	// Queries a list of VoterByIdentifier items.
-	BallotByIdentifier(context.Context, *QueryBallotByIdentifierRequest) (*QueryBallotByIdentifierResponse, error)
-	BallotListForHeight(context.Context, *QueryBallotListForHeightRequest) (*QueryBallotListForHeightResponse, error)
+	BallotByIdentifier(ctx context.Context, in *QueryBallotByIdentifierRequest, opts ...grpc.CallOption) (*QueryBallotByIdentifierResponse, error)
+	BallotListForHeight(ctx context.Context, in *QueryBallotListForHeightRequest, opts ...grpc.CallOption) (*QueryBallotListForHeightResponse, error)
 	// Queries a list of ObserversByChainAndType items.
-	ObserverSet(context.Context, *QueryObserverSet) (*QueryObserverSetResponse, error)
-	SupportedChains(context.Context, *QuerySupportedChains) (*QuerySupportedChainsResponse, error)
+	ObserverSet(ctx context.Context, in *QueryObserverSet, opts ...grpc.CallOption) (*QueryObserverSetResponse, error)
+	SupportedChains(ctx context.Context, in *QuerySupportedChains, opts ...grpc.CallOption) (*QuerySupportedChainsResponse, error)
 	// Queries a list of GetChainParamsForChain items.
-	GetChainParamsForChain(context.Context, *QueryGetChainParamsForChainRequest) (*QueryGetChainParamsForChainResponse, error)
+	GetChainParamsForChain(ctx context.Context, in *QueryGetChainParamsForChainRequest, opts ...grpc.CallOption) (*QueryGetChainParamsForChainResponse, error)
 	// Queries a list of GetChainParams items.
-	GetChainParams(context.Context, *QueryGetChainParamsRequest) (*QueryGetChainParamsResponse, error)
+	GetChainParams(ctx context.Context, in *QueryGetChainParamsRequest, opts ...grpc.CallOption) (*QueryGetChainParamsResponse, error)
 	// Queries a nodeAccount by index.
-	NodeAccount(context.Context, *QueryGetNodeAccountRequest) (*QueryGetNodeAccountResponse, error)
+	NodeAccount(ctx context.Context, in *QueryGetNodeAccountRequest, opts ...grpc.CallOption) (*QueryGetNodeAccountResponse, error)
 	// Queries a list of nodeAccount items.
-	NodeAccountAll(context.Context, *QueryAllNodeAccountRequest) (*QueryAllNodeAccountResponse, error)
-	CrosschainFlags(context.Context, *QueryGetCrosschainFlagsRequest) (*QueryGetCrosschainFlagsResponse, error)
+	NodeAccountAll(ctx context.Context, in *QueryAllNodeAccountRequest, opts ...grpc.CallOption) (*QueryAllNodeAccountResponse, error)
+	CrosschainFlags(ctx context.Context, in *QueryGetCrosschainFlagsRequest, opts ...grpc.CallOption) (*QueryGetCrosschainFlagsResponse, error)
 	// Queries a keygen by index.
-	Keygen(context.Context, *QueryGetKeygenRequest) (*QueryGetKeygenResponse, error)
+	Keygen(ctx context.Context, in *QueryGetKeygenRequest, opts ...grpc.CallOption) (*QueryGetKeygenResponse, error)
 	// Queries a list of ShowObserverCount items.
-	ShowObserverCount(context.Context, *QueryShowObserverCountRequest) (*QueryShowObserverCountResponse, error)
+	ShowObserverCount(ctx context.Context, in *QueryShowObserverCountRequest, opts ...grpc.CallOption) (*QueryShowObserverCountResponse, error)
 	// Queries a list of VoterByIdentifier items.
-	BlameByIdentifier(context.Context, *QueryBlameByIdentifierRequest) (*QueryBlameByIdentifierResponse, error)
+	BlameByIdentifier(ctx context.Context, in *QueryBlameByIdentifierRequest, opts ...grpc.CallOption) (*QueryBlameByIdentifierResponse, error)
 	// Queries a list of VoterByIdentifier items.
-	GetAllBlameRecords(context.Context, *QueryAllBlameRecordsRequest) (*QueryAllBlameRecordsResponse, error)
+	GetAllBlameRecords(ctx context.Context, in *QueryAllBlameRecordsRequest, opts ...grpc.CallOption) (*QueryAllBlameRecordsResponse, error)
 	// Queries a list of VoterByIdentifier items.
-	BlamesByChainAndNonce(context.Context, *QueryBlameByChainAndNonceRequest) (*QueryBlameByChainAndNonceResponse, error)
+	BlamesByChainAndNonce(ctx context.Context, in *QueryBlameByChainAndNonceRequest, opts ...grpc.CallOption) (*QueryBlameByChainAndNonceResponse, error)
+	// Queries blame statistics per node over a window of recent blocks.
+	BlameStats(ctx context.Context, in *QueryBlameStatsRequest, opts ...grpc.CallOption) (*QueryBlameStatsResponse, error)
 	// Queries a list of GetTssAddress items.
-	GetTssAddress(context.Context, *QueryGetTssAddressRequest) (*QueryGetTssAddressResponse, error)
-	GetTssAddressByFinalizedHeight(context.Context, *QueryGetTssAddressByFinalizedHeightRequest) (*QueryGetTssAddressByFinalizedHeightResponse, error)
+	GetTssAddress(ctx context.Context, in *QueryGetTssAddressRequest, opts ...grpc.CallOption) (*QueryGetTssAddressResponse, error)
+	GetTssAddressByFinalizedHeight(ctx context.Context, in *QueryGetTssAddressByFinalizedHeightRequest, opts ...grpc.CallOption) (*QueryGetTssAddressByFinalizedHeightResponse, error)
 	// Queries a tSS by index.
-	TSS(context.Context, *QueryGetTSSRequest) (*QueryGetTSSResponse, error)
-	TssHistory(context.Context, *QueryTssHistoryRequest) (*QueryTssHistoryResponse, error)
-	PendingNoncesAll(context.Context, *QueryAllPendingNoncesRequest) (*QueryAllPendingNoncesResponse, error)
-	PendingNoncesByChain(context.Context, *QueryPendingNoncesByChainRequest) (*QueryPendingNoncesByChainResponse, error)
+	TSS(ctx context.Context, in *QueryGetTSSRequest, opts ...grpc.CallOption) (*QueryGetTSSResponse, error)
+	TssHistory(ctx context.Context, in *QueryTssHistoryRequest, opts ...grpc.CallOption) (*QueryTssHistoryResponse, error)
+	PendingNoncesAll(ctx context.Context, in *QueryAllPendingNoncesRequest, opts ...grpc.CallOption) (*QueryAllPendingNoncesResponse, error)
+	PendingNoncesByChain(ctx context.Context, in *QueryPendingNoncesByChainRequest, opts ...grpc.CallOption) (*QueryPendingNoncesByChainResponse, error)
 	// Queries a chainNonces by index.
-	ChainNonces(context.Context, *QueryGetChainNoncesRequest) (*QueryGetChainNoncesResponse, error)
+	ChainNonces(ctx context.Context, in *QueryGetChainNoncesRequest, opts ...grpc.CallOption) (*QueryGetChainNoncesResponse, error)
 	// Queries a list of chainNonces items.
-	ChainNoncesAll(context.Context, *QueryAllChainNoncesRequest) (*QueryAllChainNoncesResponse, error)
+	ChainNoncesAll(ctx context.Context, in *QueryAllChainNoncesRequest, opts ...grpc.CallOption) (*QueryAllChainNoncesResponse, error)
 	// Queries the TssFundMigratorInfo for a specific chain
-	TssFundsMigratorInfo(context.Context, *QueryTssFundsMigratorInfoRequest) (*QueryTssFundsMigratorInfoResponse, error)
+	TssFundsMigratorInfo(ctx context.Context, in *QueryTssFundsMigratorInfoRequest, opts ...grpc.CallOption) (*QueryTssFundsMigratorInfoResponse, error)
 	// Queries all TssFundMigratorInfo
-	TssFundsMigratorInfoAll(context.Context, *QueryTssFundsMigratorInfoAllRequest) (*QueryTssFundsMigratorInfoAllResponse, error)
+	TssFundsMigratorInfoAll(ctx context.Context, in *QueryTssFundsMigratorInfoAllRequest, opts ...grpc.CallOption) (*QueryTssFundsMigratorInfoAllResponse, error)
 	// Queries operational flags
-	OperationalFlags(context.Context, *QueryOperationalFlagsRequest) (*QueryOperationalFlagsResponse, error)
+	OperationalFlags(ctx context.Context, in *QueryOperationalFlagsRequest, opts ...grpc.CallOption) (*QueryOperationalFlagsResponse, error)
 	// Query all ballots
-	Ballots(context.Context, *QueryBallotsRequest) (*QueryBallotsResponse, error)
+	Ballots(ctx context.Context, in *QueryBallotsRequest, opts ...grpc.CallOption) (*QueryBallotsResponse, error)
+	// Queries the last staged observer set change.
+	ObserverSetChange(ctx context.Context, in *QueryObserverSetChangeRequest, opts ...grpc.CallOption) (*QueryObserverSetChangeResponse, error)
+	// Queries the ballot signing info of an observer.
+	ObserverSigningInfo(ctx context.Context, in *QueryObserverSigningInfoRequest, opts ...grpc.CallOption) (*QueryObserverSigningInfoResponse, error)
+	// Queries the ballot signing info of all observers.
+	ObserverSigningInfos(ctx context.Context, in *QueryObserverSigningInfosRequest, opts ...grpc.CallOption) (*QueryObserverSigningInfosResponse, error)
+	// Queries the liveness params.
+	LivenessParams(ctx context.Context, in *QueryLivenessParamsRequest, opts ...grpc.CallOption) (*QueryLivenessParamsResponse, error)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (*UnimplementedQueryServer) HasVoted(ctx context.Context, req *QueryHasVotedRequest) (*QueryHasVotedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HasVoted not implemented")
-}
-func (*UnimplementedQueryServer) BallotByIdentifier(ctx context.Context, req *QueryBallotByIdentifierRequest) (*QueryBallotByIdentifierResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BallotByIdentifier not implemented")
-}
-func (*UnimplementedQueryServer) BallotListForHeight(ctx context.Context, req *QueryBallotListForHeightRequest) (*QueryBallotListForHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BallotListForHeight not implemented")
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
-func (*UnimplementedQueryServer) ObserverSet(ctx context.Context, req *QueryObserverSet) (*QueryObserverSetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ObserverSet not implemented")
+
+func (c *queryClient) HasVoted(ctx context.Context, in *QueryHasVotedRequest, opts ...grpc.CallOption) (*QueryHasVotedResponse, error) {
+	out := new(QueryHasVotedResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/HasVoted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedQueryServer) SupportedChains(ctx context.Context, req *QuerySupportedChains) (*QuerySupportedChainsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SupportedChains not implemented")
+
+func (c *queryClient) BallotByIdentifier(ctx context.Context, in *QueryBallotByIdentifierRequest, opts ...grpc.CallOption) (*QueryBallotByIdentifierResponse, error) {
+	out := new(QueryBallotByIdentifierResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BallotByIdentifier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedQueryServer) GetChainParamsForChain(ctx context.Context, req *QueryGetChainParamsForChainRequest) (*QueryGetChainParamsForChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetChainParamsForChain not implemented")
+
+func (c *queryClient) BallotListForHeight(ctx context.Context, in *QueryBallotListForHeightRequest, opts ...grpc.CallOption) (*QueryBallotListForHeightResponse, error) {
+	out := new(QueryBallotListForHeightResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BallotListForHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedQueryServer) GetChainParams(ctx context.Context, req *QueryGetChainParamsRequest) (*QueryGetChainParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetChainParams not implemented")
+
+func (c *queryClient) ObserverSet(ctx context.Context, in *QueryObserverSet, opts ...grpc.CallOption) (*QueryObserverSetResponse, error) {
+	out := new(QueryObserverSetResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ObserverSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedQueryServer) NodeAccount(ctx context.Context, req *QueryGetNodeAccountRequest) (*QueryGetNodeAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NodeAccount not implemented")
+
+func (c *queryClient) SupportedChains(ctx context.Context, in *QuerySupportedChains, opts ...grpc.CallOption) (*QuerySupportedChainsResponse, error) {
+	out := new(QuerySupportedChainsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/SupportedChains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedQueryServer) NodeAccountAll(ctx context.Context, req *QueryAllNodeAccountRequest) (*QueryAllNodeAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NodeAccountAll not implemented")
+
+func (c *queryClient) GetChainParamsForChain(ctx context.Context, in *QueryGetChainParamsForChainRequest, opts ...grpc.CallOption) (*QueryGetChainParamsForChainResponse, error) {
+	out := new(QueryGetChainParamsForChainResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetChainParamsForChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedQueryServer) CrosschainFlags(ctx context.Context, req *QueryGetCrosschainFlagsRequest) (*QueryGetCrosschainFlagsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CrosschainFlags not implemented")
+
+func (c *queryClient) GetChainParams(ctx context.Context, in *QueryGetChainParamsRequest, opts ...grpc.CallOption) (*QueryGetChainParamsResponse, error) {
+	out := new(QueryGetChainParamsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetChainParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedQueryServer) Keygen(ctx context.Context, req *QueryGetKeygenRequest) (*QueryGetKeygenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Keygen not implemented")
+
+func (c *queryClient) NodeAccount(ctx context.Context, in *QueryGetNodeAccountRequest, opts ...grpc.CallOption) (*QueryGetNodeAccountResponse, error) {
+	out := new(QueryGetNodeAccountResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/NodeAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedQueryServer) ShowObserverCount(ctx context.Context, req *QueryShowObserverCountRequest) (*QueryShowObserverCountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShowObserverCount not implemented")
+
+func (c *queryClient) NodeAccountAll(ctx context.Context, in *QueryAllNodeAccountRequest, opts ...grpc.CallOption) (*QueryAllNodeAccountResponse, error) {
+	out := new(QueryAllNodeAccountResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/NodeAccountAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedQueryServer) BlameByIdentifier(ctx context.Context, req *QueryBlameByIdentifierRequest) (*QueryBlameByIdentifierResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BlameByIdentifier not implemented")
+
+func (c *queryClient) CrosschainFlags(ctx context.Context, in *QueryGetCrosschainFlagsRequest, opts ...grpc.CallOption) (*QueryGetCrosschainFlagsResponse, error) {
+	out := new(QueryGetCrosschainFlagsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/CrosschainFlags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedQueryServer) GetAllBlameRecords(ctx context.Context, req *QueryAllBlameRecordsRequest) (*QueryAllBlameRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllBlameRecords not implemented")
-}
-func (*UnimplementedQueryServer) BlamesByChainAndNonce(ctx context.Context, req *QueryBlameByChainAndNonceRequest) (*QueryBlameByChainAndNonceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BlamesByChainAndNonce not implemented")
-}
-func (*UnimplementedQueryServer) GetTssAddress(ctx context.Context, req *QueryGetTssAddressRequest) (*QueryGetTssAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTssAddress not implemented")
-}
-func (*UnimplementedQueryServer) GetTssAddressByFinalizedHeight(ctx context.Context, req *QueryGetTssAddressByFinalizedHeightRequest) (*QueryGetTssAddressByFinalizedHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTssAddressByFinalizedHeight not implemented")
-}
-func (*UnimplementedQueryServer) TSS(ctx context.Context, req *QueryGetTSSRequest) (*QueryGetTSSResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TSS not implemented")
-}
-func (*UnimplementedQueryServer) TssHistory(ctx context.Context, req *QueryTssHistoryRequest) (*QueryTssHistoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TssHistory not implemented")
-}
-func (*UnimplementedQueryServer) PendingNoncesAll(ctx context.Context, req *QueryAllPendingNoncesRequest) (*QueryAllPendingNoncesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PendingNoncesAll not implemented")
-}
-func (*UnimplementedQueryServer) PendingNoncesByChain(ctx context.Context, req *QueryPendingNoncesByChainRequest) (*QueryPendingNoncesByChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PendingNoncesByChain not implemented")
-}
-func (*UnimplementedQueryServer) ChainNonces(ctx context.Context, req *QueryGetChainNoncesRequest) (*QueryGetChainNoncesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChainNonces not implemented")
-}
-func (*UnimplementedQueryServer) ChainNoncesAll(ctx context.Context, req *QueryAllChainNoncesRequest) (*QueryAllChainNoncesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChainNoncesAll not implemented")
-}
-func (*UnimplementedQueryServer) TssFundsMigratorInfo(ctx context.Context, req *QueryTssFundsMigratorInfoRequest) (*QueryTssFundsMigratorInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TssFundsMigratorInfo not implemented")
-}
-func (*UnimplementedQueryServer) TssFundsMigratorInfoAll(ctx context.Context, req *QueryTssFundsMigratorInfoAllRequest) (*QueryTssFundsMigratorInfoAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TssFundsMigratorInfoAll not implemented")
-}
-func (*UnimplementedQueryServer) OperationalFlags(ctx context.Context, req *QueryOperationalFlagsRequest) (*QueryOperationalFlagsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperationalFlags not implemented")
-}
-func (*UnimplementedQueryServer) Ballots(ctx context.Context, req *QueryBallotsRequest) (*QueryBallotsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Ballots not implemented")
-}
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+
+func (c *queryClient) Keygen(ctx context.Context, in *QueryGetKeygenRequest, opts ...grpc.CallOption) (*QueryGetKeygenResponse, error) {
+	out := new(QueryGetKeygenResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/Keygen", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _Query_HasVoted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHasVotedRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ShowObserverCount(ctx context.Context, in *QueryShowObserverCountRequest, opts ...grpc.CallOption) (*QueryShowObserverCountResponse, error) {
+	out := new(QueryShowObserverCountResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ShowObserverCount", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).HasVoted(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/HasVoted",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HasVoted(ctx, req.(*QueryHasVotedRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_BallotByIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBallotByIdentifierRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) BlameByIdentifier(ctx context.Context, in *QueryBlameByIdentifierRequest, opts ...grpc.CallOption) (*QueryBlameByIdentifierResponse, error) {
+	out := new(QueryBlameByIdentifierResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BlameByIdentifier", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).BallotByIdentifier(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/BallotByIdentifier",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BallotByIdentifier(ctx, req.(*QueryBallotByIdentifierRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_BallotListForHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBallotListForHeightRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GetAllBlameRecords(ctx context.Context, in *QueryAllBlameRecordsRequest, opts ...grpc.CallOption) (*QueryAllBlameRecordsResponse, error) {
+	out := new(QueryAllBlameRecordsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetAllBlameRecords", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).BallotListForHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/BallotListForHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BallotListForHeight(ctx, req.(*QueryBallotListForHeightRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ObserverSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryObserverSet)
-	if err := dec(in); err != nil {
+func (c *queryClient) BlamesByChainAndNonce(ctx context.Context, in *QueryBlameByChainAndNonceRequest, opts ...grpc.CallOption) (*QueryBlameByChainAndNonceResponse, error) {
+	out := new(QueryBlameByChainAndNonceResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BlamesByChainAndNonce", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ObserverSet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/ObserverSet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ObserverSet(ctx, req.(*QueryObserverSet))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_SupportedChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySupportedChains)
-	if err := dec(in); err != nil {
+func (c *queryClient) BlameStats(ctx context.Context, in *QueryBlameStatsRequest, opts ...grpc.CallOption) (*QueryBlameStatsResponse, error) {
+	out := new(QueryBlameStatsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BlameStats", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).SupportedChains(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/SupportedChains",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SupportedChains(ctx, req.(*QuerySupportedChains))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GetChainParamsForChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetChainParamsForChainRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GetTssAddress(ctx context.Context, in *QueryGetTssAddressRequest, opts ...grpc.CallOption) (*QueryGetTssAddressResponse, error) {
+	out := new(QueryGetTssAddressResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetTssAddress", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetChainParamsForChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/GetChainParamsForChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetChainParamsForChain(ctx, req.(*QueryGetChainParamsForChainRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GetChainParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetChainParamsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GetTssAddressByFinalizedHeight(ctx context.Context, in *QueryGetTssAddressByFinalizedHeightRequest, opts ...grpc.CallOption) (*QueryGetTssAddressByFinalizedHeightResponse, error) {
+	out := new(QueryGetTssAddressByFinalizedHeightResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetTssAddressByFinalizedHeight", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetChainParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/GetChainParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetChainParams(ctx, req.(*QueryGetChainParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_NodeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetNodeAccountRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) TSS(ctx context.Context, in *QueryGetTSSRequest, opts ...grpc.CallOption) (*QueryGetTSSResponse, error) {
+	out := new(QueryGetTSSResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/TSS", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).NodeAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/NodeAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NodeAccount(ctx, req.(*QueryGetNodeAccountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_NodeAccountAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllNodeAccountRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) TssHistory(ctx context.Context, in *QueryTssHistoryRequest, opts ...grpc.CallOption) (*QueryTssHistoryResponse, error) {
+	out := new(QueryTssHistoryResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/TssHistory", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).NodeAccountAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/NodeAccountAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NodeAccountAll(ctx, req.(*QueryAllNodeAccountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_CrosschainFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetCrosschainFlagsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) PendingNoncesAll(ctx context.Context, in *QueryAllPendingNoncesRequest, opts ...grpc.CallOption) (*QueryAllPendingNoncesResponse, error) {
+	out := new(QueryAllPendingNoncesResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/PendingNoncesAll", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).CrosschainFlags(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/CrosschainFlags",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CrosschainFlags(ctx, req.(*QueryGetCrosschainFlagsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_Keygen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetKeygenRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) PendingNoncesByChain(ctx context.Context, in *QueryPendingNoncesByChainRequest, opts ...grpc.CallOption) (*QueryPendingNoncesByChainResponse, error) {
+	out := new(QueryPendingNoncesByChainResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/PendingNoncesByChain", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Keygen(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/Keygen",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Keygen(ctx, req.(*QueryGetKeygenRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ShowObserverCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryShowObserverCountRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ChainNonces(ctx context.Context, in *QueryGetChainNoncesRequest, opts ...grpc.CallOption) (*QueryGetChainNoncesResponse, error) {
+	out := new(QueryGetChainNoncesResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ChainNonces", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ShowObserverCount(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) ChainNoncesAll(ctx context.Context, in *QueryAllChainNoncesRequest, opts ...grpc.CallOption) (*QueryAllChainNoncesResponse, error) {
+	out := new(QueryAllChainNoncesResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ChainNoncesAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/ShowObserverCount",
+	return out, nil
+}
+
+func (c *queryClient) TssFundsMigratorInfo(ctx context.Context, in *QueryTssFundsMigratorInfoRequest, opts ...grpc.CallOption) (*QueryTssFundsMigratorInfoResponse, error) {
+	out := new(QueryTssFundsMigratorInfoResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/TssFundsMigratorInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ShowObserverCount(ctx, req.(*QueryShowObserverCountRequest))
+	return out, nil
+}
+
+func (c *queryClient) TssFundsMigratorInfoAll(ctx context.Context, in *QueryTssFundsMigratorInfoAllRequest, opts ...grpc.CallOption) (*QueryTssFundsMigratorInfoAllResponse, error) {
+	out := new(QueryTssFundsMigratorInfoAllResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/TssFundsMigratorInfoAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_BlameByIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlameByIdentifierRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) OperationalFlags(ctx context.Context, in *QueryOperationalFlagsRequest, opts ...grpc.CallOption) (*QueryOperationalFlagsResponse, error) {
+	out := new(QueryOperationalFlagsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/OperationalFlags", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).BlameByIdentifier(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) Ballots(ctx context.Context, in *QueryBallotsRequest, opts ...grpc.CallOption) (*QueryBallotsResponse, error) {
+	out := new(QueryBallotsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/Ballots", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/BlameByIdentifier",
+	return out, nil
+}
+
+func (c *queryClient) ObserverSetChange(ctx context.Context, in *QueryObserverSetChangeRequest, opts ...grpc.CallOption) (*QueryObserverSetChangeResponse, error) {
+	out := new(QueryObserverSetChangeResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ObserverSetChange", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BlameByIdentifier(ctx, req.(*QueryBlameByIdentifierRequest))
+	return out, nil
+}
+
+func (c *queryClient) ObserverSigningInfo(ctx context.Context, in *QueryObserverSigningInfoRequest, opts ...grpc.CallOption) (*QueryObserverSigningInfoResponse, error) {
+	out := new(QueryObserverSigningInfoResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ObserverSigningInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GetAllBlameRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllBlameRecordsRequest)
+func (c *queryClient) ObserverSigningInfos(ctx context.Context, in *QueryObserverSigningInfosRequest, opts ...grpc.CallOption) (*QueryObserverSigningInfosResponse, error) {
+	out := new(QueryObserverSigningInfosResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ObserverSigningInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LivenessParams(ctx context.Context, in *QueryLivenessParamsRequest, opts ...grpc.CallOption) (*QueryLivenessParamsResponse, error) {
+	out := new(QueryLivenessParamsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/LivenessParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Query if a voter has voted for a ballot
+	HasVoted(context.Context, *QueryHasVotedRequest) (*QueryHasVotedResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	BallotByIdentifier(context.Context, *QueryBallotByIdentifierRequest) (*QueryBallotByIdentifierResponse, error)
+	BallotListForHeight(context.Context, *QueryBallotListForHeightRequest) (*QueryBallotListForHeightResponse, error)
+	// Queries a list of ObserversByChainAndType items.
+	ObserverSet(context.Context, *QueryObserverSet) (*QueryObserverSetResponse, error)
+	SupportedChains(context.Context, *QuerySupportedChains) (*QuerySupportedChainsResponse, error)
+	// Queries a list of GetChainParamsForChain items.
+	GetChainParamsForChain(context.Context, *QueryGetChainParamsForChainRequest) (*QueryGetChainParamsForChainResponse, error)
+	// Queries a list of GetChainParams items.
+	GetChainParams(context.Context, *QueryGetChainParamsRequest) (*QueryGetChainParamsResponse, error)
+	// Queries a nodeAccount by index.
+	NodeAccount(context.Context, *QueryGetNodeAccountRequest) (*QueryGetNodeAccountResponse, error)
+	// Queries a list of nodeAccount items.
+	NodeAccountAll(context.Context, *QueryAllNodeAccountRequest) (*QueryAllNodeAccountResponse, error)
+	CrosschainFlags(context.Context, *QueryGetCrosschainFlagsRequest) (*QueryGetCrosschainFlagsResponse, error)
+	// Queries a keygen by index.
+	Keygen(context.Context, *QueryGetKeygenRequest) (*QueryGetKeygenResponse, error)
+	// Queries a list of ShowObserverCount items.
+	ShowObserverCount(context.Context, *QueryShowObserverCountRequest) (*QueryShowObserverCountResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	BlameByIdentifier(context.Context, *QueryBlameByIdentifierRequest) (*QueryBlameByIdentifierResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	GetAllBlameRecords(context.Context, *QueryAllBlameRecordsRequest) (*QueryAllBlameRecordsResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	BlamesByChainAndNonce(context.Context, *QueryBlameByChainAndNonceRequest) (*QueryBlameByChainAndNonceResponse, error)
+	// Queries blame statistics per node over a window of recent blocks.
+	BlameStats(context.Context, *QueryBlameStatsRequest) (*QueryBlameStatsResponse, error)
+	// Queries a list of GetTssAddress items.
+	GetTssAddress(context.Context, *QueryGetTssAddressRequest) (*QueryGetTssAddressResponse, error)
+	GetTssAddressByFinalizedHeight(context.Context, *QueryGetTssAddressByFinalizedHeightRequest) (*QueryGetTssAddressByFinalizedHeightResponse, error)
+	// Queries a tSS by index.
+	TSS(context.Context, *QueryGetTSSRequest) (*QueryGetTSSResponse, error)
+	TssHistory(context.Context, *QueryTssHistoryRequest) (*QueryTssHistoryResponse, error)
+	PendingNoncesAll(context.Context, *QueryAllPendingNoncesRequest) (*QueryAllPendingNoncesResponse, error)
+	PendingNoncesByChain(context.Context, *QueryPendingNoncesByChainRequest) (*QueryPendingNoncesByChainResponse, error)
+	// Queries a chainNonces by index.
+	ChainNonces(context.Context, *QueryGetChainNoncesRequest) (*QueryGetChainNoncesResponse, error)
+	// Queries a list of chainNonces items.
+	ChainNoncesAll(context.Context, *QueryAllChainNoncesRequest) (*QueryAllChainNoncesResponse, error)
+	// Queries the TssFundMigratorInfo for a specific chain
+	TssFundsMigratorInfo(context.Context, *QueryTssFundsMigratorInfoRequest) (*QueryTssFundsMigratorInfoResponse, error)
+	// Queries all TssFundMigratorInfo
+	TssFundsMigratorInfoAll(context.Context, *QueryTssFundsMigratorInfoAllRequest) (*QueryTssFundsMigratorInfoAllResponse, error)
+	// Queries operational flags
+	OperationalFlags(context.Context, *QueryOperationalFlagsRequest) (*QueryOperationalFlagsResponse, error)
+	// Query all ballots
+	Ballots(context.Context, *QueryBallotsRequest) (*QueryBallotsResponse, error)
+	// Queries the last staged observer set change.
+	ObserverSetChange(context.Context, *QueryObserverSetChangeRequest) (*QueryObserverSetChangeResponse, error)
+	// Queries the ballot signing info of an observer.
+	ObserverSigningInfo(context.Context, *QueryObserverSigningInfoRequest) (*QueryObserverSigningInfoResponse, error)
+	// Queries the ballot signing info of all observers.
+	ObserverSigningInfos(context.Context, *QueryObserverSigningInfosRequest) (*QueryObserverSigningInfosResponse, error)
+	// Queries the liveness params.
+	LivenessParams(context.Context, *QueryLivenessParamsRequest) (*QueryLivenessParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) HasVoted(ctx context.Context, req *QueryHasVotedRequest) (*QueryHasVotedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HasVoted not implemented")
+}
+func (*UnimplementedQueryServer) BallotByIdentifier(ctx context.Context, req *QueryBallotByIdentifierRequest) (*QueryBallotByIdentifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BallotByIdentifier not implemented")
+}
+func (*UnimplementedQueryServer) BallotListForHeight(ctx context.Context, req *QueryBallotListForHeightRequest) (*QueryBallotListForHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BallotListForHeight not implemented")
+}
+func (*UnimplementedQueryServer) ObserverSet(ctx context.Context, req *QueryObserverSet) (*QueryObserverSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObserverSet not implemented")
+}
+func (*UnimplementedQueryServer) SupportedChains(ctx context.Context, req *QuerySupportedChains) (*QuerySupportedChainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupportedChains not implemented")
+}
+func (*UnimplementedQueryServer) GetChainParamsForChain(ctx context.Context, req *QueryGetChainParamsForChainRequest) (*QueryGetChainParamsForChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChainParamsForChain not implemented")
+}
+func (*UnimplementedQueryServer) GetChainParams(ctx context.Context, req *QueryGetChainParamsRequest) (*QueryGetChainParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChainParams not implemented")
+}
+func (*UnimplementedQueryServer) NodeAccount(ctx context.Context, req *QueryGetNodeAccountRequest) (*QueryGetNodeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodeAccount not implemented")
+}
+func (*UnimplementedQueryServer) NodeAccountAll(ctx context.Context, req *QueryAllNodeAccountRequest) (*QueryAllNodeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodeAccountAll not implemented")
+}
+func (*UnimplementedQueryServer) CrosschainFlags(ctx context.Context, req *QueryGetCrosschainFlagsRequest) (*QueryGetCrosschainFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CrosschainFlags not implemented")
+}
+func (*UnimplementedQueryServer) Keygen(ctx context.Context, req *QueryGetKeygenRequest) (*QueryGetKeygenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Keygen not implemented")
+}
+func (*UnimplementedQueryServer) ShowObserverCount(ctx context.Context, req *QueryShowObserverCountRequest) (*QueryShowObserverCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShowObserverCount not implemented")
+}
+func (*UnimplementedQueryServer) BlameByIdentifier(ctx context.Context, req *QueryBlameByIdentifierRequest) (*QueryBlameByIdentifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlameByIdentifier not implemented")
+}
+func (*UnimplementedQueryServer) GetAllBlameRecords(ctx context.Context, req *QueryAllBlameRecordsRequest) (*QueryAllBlameRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllBlameRecords not implemented")
+}
+func (*UnimplementedQueryServer) BlamesByChainAndNonce(ctx context.Context, req *QueryBlameByChainAndNonceRequest) (*QueryBlameByChainAndNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlamesByChainAndNonce not implemented")
+}
+func (*UnimplementedQueryServer) BlameStats(ctx context.Context, req *QueryBlameStatsRequest) (*QueryBlameStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlameStats not implemented")
+}
+func (*UnimplementedQueryServer) GetTssAddress(ctx context.Context, req *QueryGetTssAddressRequest) (*QueryGetTssAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTssAddress not implemented")
+}
+func (*UnimplementedQueryServer) GetTssAddressByFinalizedHeight(ctx context.Context, req *QueryGetTssAddressByFinalizedHeightRequest) (*QueryGetTssAddressByFinalizedHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTssAddressByFinalizedHeight not implemented")
+}
+func (*UnimplementedQueryServer) TSS(ctx context.Context, req *QueryGetTSSRequest) (*QueryGetTSSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TSS not implemented")
+}
+func (*UnimplementedQueryServer) TssHistory(ctx context.Context, req *QueryTssHistoryRequest) (*QueryTssHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TssHistory not implemented")
+}
+func (*UnimplementedQueryServer) PendingNoncesAll(ctx context.Context, req *QueryAllPendingNoncesRequest) (*QueryAllPendingNoncesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingNoncesAll not implemented")
+}
+func (*UnimplementedQueryServer) PendingNoncesByChain(ctx context.Context, req *QueryPendingNoncesByChainRequest) (*QueryPendingNoncesByChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingNoncesByChain not implemented")
+}
+func (*UnimplementedQueryServer) ChainNonces(ctx context.Context, req *QueryGetChainNoncesRequest) (*QueryGetChainNoncesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainNonces not implemented")
+}
+func (*UnimplementedQueryServer) ChainNoncesAll(ctx context.Context, req *QueryAllChainNoncesRequest) (*QueryAllChainNoncesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainNoncesAll not implemented")
+}
+func (*UnimplementedQueryServer) TssFundsMigratorInfo(ctx context.Context, req *QueryTssFundsMigratorInfoRequest) (*QueryTssFundsMigratorInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TssFundsMigratorInfo not implemented")
+}
+func (*UnimplementedQueryServer) TssFundsMigratorInfoAll(ctx context.Context, req *QueryTssFundsMigratorInfoAllRequest) (*QueryTssFundsMigratorInfoAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TssFundsMigratorInfoAll not implemented")
+}
+func (*UnimplementedQueryServer) OperationalFlags(ctx context.Context, req *QueryOperationalFlagsRequest) (*QueryOperationalFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperationalFlags not implemented")
+}
+func (*UnimplementedQueryServer) Ballots(ctx context.Context, req *QueryBallotsRequest) (*QueryBallotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ballots not implemented")
+}
+func (*UnimplementedQueryServer) ObserverSetChange(ctx context.Context, req *QueryObserverSetChangeRequest) (*QueryObserverSetChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObserverSetChange not implemented")
+}
+func (*UnimplementedQueryServer) ObserverSigningInfo(ctx context.Context, req *QueryObserverSigningInfoRequest) (*QueryObserverSigningInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObserverSigningInfo not implemented")
+}
+func (*UnimplementedQueryServer) ObserverSigningInfos(ctx context.Context, req *QueryObserverSigningInfosRequest) (*QueryObserverSigningInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObserverSigningInfos not implemented")
+}
+func (*UnimplementedQueryServer) LivenessParams(ctx context.Context, req *QueryLivenessParamsRequest) (*QueryLivenessParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LivenessParams not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_HasVoted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHasVotedRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).GetAllBlameRecords(ctx, in)
+		return srv.(QueryServer).HasVoted(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/GetAllBlameRecords",
+		FullMethod: "/zetachain.zetacore.observer.Query/HasVoted",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAllBlameRecords(ctx, req.(*QueryAllBlameRecordsRequest))
+		return srv.(QueryServer).HasVoted(ctx, req.(*QueryHasVotedRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_BlamesByChainAndNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlameByChainAndNonceRequest)
+func _Query_BallotByIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBallotByIdentifierRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).BlamesByChainAndNonce(ctx, in)
+		return srv.(QueryServer).BallotByIdentifier(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/BlamesByChainAndNonce",
+		FullMethod: "/zetachain.zetacore.observer.Query/BallotByIdentifier",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BlamesByChainAndNonce(ctx, req.(*QueryBlameByChainAndNonceRequest))
+		return srv.(QueryServer).BallotByIdentifier(ctx, req.(*QueryBallotByIdentifierRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_GetTssAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetTssAddressRequest)
+func _Query_BallotListForHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBallotListForHeightRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).GetTssAddress(ctx, in)
+		return srv.(QueryServer).BallotListForHeight(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/GetTssAddress",
+		FullMethod: "/zetachain.zetacore.observer.Query/BallotListForHeight",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetTssAddress(ctx, req.(*QueryGetTssAddressRequest))
+		return srv.(QueryServer).BallotListForHeight(ctx, req.(*QueryBallotListForHeightRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_GetTssAddressByFinalizedHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Query_ObserverSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryObserverSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ObserverSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ObserverSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ObserverSet(ctx, req.(*QueryObserverSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupportedChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupportedChains)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupportedChains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/SupportedChains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupportedChains(ctx, req.(*QuerySupportedChains))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetChainParamsForChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetChainParamsForChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetChainParamsForChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/GetChainParamsForChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetChainParamsForChain(ctx, req.(*QueryGetChainParamsForChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetChainParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetChainParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetChainParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/GetChainParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetChainParams(ctx, req.(*QueryGetChainParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NodeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetNodeAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NodeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/NodeAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NodeAccount(ctx, req.(*QueryGetNodeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NodeAccountAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllNodeAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NodeAccountAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/NodeAccountAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NodeAccountAll(ctx, req.(*QueryAllNodeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CrosschainFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCrosschainFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CrosschainFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/CrosschainFlags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CrosschainFlags(ctx, req.(*QueryGetCrosschainFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Keygen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetKeygenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Keygen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/Keygen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Keygen(ctx, req.(*QueryGetKeygenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ShowObserverCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryShowObserverCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ShowObserverCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ShowObserverCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ShowObserverCount(ctx, req.(*QueryShowObserverCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlameByIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlameByIdentifierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlameByIdentifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/BlameByIdentifier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlameByIdentifier(ctx, req.(*QueryBlameByIdentifierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAllBlameRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBlameRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllBlameRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/GetAllBlameRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllBlameRecords(ctx, req.(*QueryAllBlameRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlamesByChainAndNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlameByChainAndNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlamesByChainAndNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/BlamesByChainAndNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlamesByChainAndNonce(ctx, req.(*QueryBlameByChainAndNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlameStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlameStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlameStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/BlameStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlameStats(ctx, req.(*QueryBlameStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTssAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTssAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTssAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/GetTssAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTssAddress(ctx, req.(*QueryGetTssAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTssAddressByFinalizedHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGetTssAddressByFinalizedHeightRequest)
 	if err := dec(in); err != nil {
 		return nil, err
@@ -3690,14 +4281,86 @@ func _Query_Ballots_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "zetachain.zetacore.observer.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "HasVoted",
-			Handler:    _Query_HasVoted_Handler,
-		},
+func _Query_ObserverSetChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryObserverSetChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ObserverSetChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ObserverSetChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ObserverSetChange(ctx, req.(*QueryObserverSetChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ObserverSigningInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryObserverSigningInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ObserverSigningInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ObserverSigningInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ObserverSigningInfo(ctx, req.(*QueryObserverSigningInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ObserverSigningInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryObserverSigningInfosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ObserverSigningInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ObserverSigningInfos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ObserverSigningInfos(ctx, req.(*QueryObserverSigningInfosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LivenessParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLivenessParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LivenessParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/LivenessParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LivenessParams(ctx, req.(*QueryLivenessParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "zetachain.zetacore.observer.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "HasVoted",
+			Handler:    _Query_HasVoted_Handler,
+		},
 		{
 			MethodName: "BallotByIdentifier",
 			Handler:    _Query_BallotByIdentifier_Handler,
@@ -3754,6 +4417,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlamesByChainAndNonce",
 			Handler:    _Query_BlamesByChainAndNonce_Handler,
 		},
+		{
+			MethodName: "BlameStats",
+			Handler:    _Query_BlameStats_Handler,
+		},
 		{
 			MethodName: "GetTssAddress",
 			Handler:    _Query_GetTssAddress_Handler,
@@ -3802,6 +4469,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Ballots",
 			Handler:    _Query_Ballots_Handler,
 		},
+		{
+			MethodName: "ObserverSetChange",
+			Handler:    _Query_ObserverSetChange_Handler,
+		},
+		{
+			MethodName: "ObserverSigningInfo",
+			Handler:    _Query_ObserverSigningInfo_Handler,
+		},
+		{
+			MethodName: "ObserverSigningInfos",
+			Handler:    _Query_ObserverSigningInfos_Handler,
+		},
+		{
+			MethodName: "LivenessParams",
+			Handler:    _Query_LivenessParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zetachain/zetacore/observer/query.proto",
@@ -5612,6 +6295,88 @@ func (m *QueryBlameByChainAndNonceResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBlameStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlameStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlameStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlameStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlameStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlameStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryBallotListForHeightRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5673,172 +6438,277 @@ func (m *QueryBallotListForHeightResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryBallotsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryObserverSetChangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBallotsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Ballots) > 0 {
-		for _, e := range m.Ballots {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryObserverSetChangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOperationalFlagsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryObserverSetChangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOperationalFlagsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryObserverSetChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.OperationalFlags.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTssFundsMigratorInfoAllRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryObserverSetChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTssFundsMigratorInfoAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryObserverSetChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TssFundsMigrators) > 0 {
-		for _, e := range m.TssFundsMigrators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.ObserverSetChange.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTssFundsMigratorInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryObserverSigningInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryObserverSigningInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryObserverSigningInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTssFundsMigratorInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.TssFundsMigrator.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryObserverSigningInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetChainNoncesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryObserverSigningInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryObserverSigningInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+	{
+		size, err := m.SigningInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetChainNoncesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryObserverSigningInfosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryObserverSigningInfosRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryObserverSigningInfosRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ChainNonces.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllChainNoncesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryObserverSigningInfosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryObserverSigningInfosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryObserverSigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.SigningInfos) > 0 {
+		for iNdEx := len(m.SigningInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllChainNoncesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLivenessParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLivenessParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLivenessParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ChainNonces) > 0 {
-		for _, e := range m.ChainNonces {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLivenessParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *QueryLivenessParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLivenessParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LivenessParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllPendingNoncesRequest) Size() (n int) {
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBallotsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5851,14 +6721,14 @@ func (m *QueryAllPendingNoncesRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryAllPendingNoncesResponse) Size() (n int) {
+func (m *QueryBallotsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.PendingNonces) > 0 {
-		for _, e := range m.PendingNonces {
+	if len(m.Ballots) > 0 {
+		for _, e := range m.Ballots {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -5870,30 +6740,27 @@ func (m *QueryAllPendingNoncesResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryPendingNoncesByChainRequest) Size() (n int) {
+func (m *QueryOperationalFlagsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
-	}
 	return n
 }
 
-func (m *QueryPendingNoncesByChainResponse) Size() (n int) {
+func (m *QueryOperationalFlagsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.PendingNonces.Size()
+	l = m.OperationalFlags.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryGetTSSRequest) Size() (n int) {
+func (m *QueryTssFundsMigratorInfoAllRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5902,87 +6769,68 @@ func (m *QueryGetTSSRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryGetTSSResponse) Size() (n int) {
+func (m *QueryTssFundsMigratorInfoAllResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.TSS.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	if len(m.TssFundsMigrators) > 0 {
+		for _, e := range m.TssFundsMigrators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *QueryGetTssAddressRequest) Size() (n int) {
+func (m *QueryTssFundsMigratorInfoRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.BitcoinChainId != 0 {
-		n += 1 + sovQuery(uint64(m.BitcoinChainId))
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
 	}
 	return n
 }
 
-func (m *QueryGetTssAddressResponse) Size() (n int) {
+func (m *QueryTssFundsMigratorInfoResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Eth)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Btc)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Sui)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
+	l = m.TssFundsMigrator.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightRequest) Size() (n int) {
+func (m *QueryGetChainNoncesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.FinalizedZetaHeight != 0 {
-		n += 1 + sovQuery(uint64(m.FinalizedZetaHeight))
-	}
-	if m.BitcoinChainId != 0 {
-		n += 1 + sovQuery(uint64(m.BitcoinChainId))
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
 	}
 	return n
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightResponse) Size() (n int) {
+func (m *QueryGetChainNoncesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Eth)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Btc)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Sui)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
+	l = m.ChainNonces.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryTssHistoryRequest) Size() (n int) {
+func (m *QueryAllChainNoncesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5995,15 +6843,191 @@ func (m *QueryTssHistoryRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryTssHistoryResponse) Size() (n int) {
+func (m *QueryAllChainNoncesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.TssList) > 0 {
-		for _, e := range m.TssList {
-			l = e.Size()
+	if len(m.ChainNonces) > 0 {
+		for _, e := range m.ChainNonces {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPendingNoncesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPendingNoncesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingNonces) > 0 {
+		for _, e := range m.PendingNonces {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingNoncesByChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryPendingNoncesByChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingNonces.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetTSSRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetTSSResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TSS.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetTssAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BitcoinChainId != 0 {
+		n += 1 + sovQuery(uint64(m.BitcoinChainId))
+	}
+	return n
+}
+
+func (m *QueryGetTssAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Eth)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Btc)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Sui)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetTssAddressByFinalizedHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FinalizedZetaHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FinalizedZetaHeight))
+	}
+	if m.BitcoinChainId != 0 {
+		n += 1 + sovQuery(uint64(m.BitcoinChainId))
+	}
+	return n
+}
+
+func (m *QueryGetTssAddressByFinalizedHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Eth)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Btc)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Sui)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTssHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTssHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TssList) > 0 {
+		for _, e := range m.TssList {
+			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
@@ -6399,39 +7423,949 @@ func (m *QueryBlameByChainAndNonceResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryBlameStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlameStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBallotListForHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryBallotListForHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BallotList.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryObserverSetChangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryObserverSetChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObserverSetChange.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryObserverSigningInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryObserverSigningInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SigningInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryObserverSigningInfosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryObserverSigningInfosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SigningInfos) > 0 {
+		for _, e := range m.SigningInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLivenessParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLivenessParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LivenessParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryBallotsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBallotsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBallotsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBallotsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBallotsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBallotsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ballots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ballots = append(m.Ballots, Ballot{})
+			if err := m.Ballots[len(m.Ballots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperationalFlagsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperationalFlagsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperationalFlagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperationalFlagsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperationalFlagsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperationalFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationalFlags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OperationalFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTssFundsMigratorInfoAllRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTssFundsMigratorInfoAllRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTssFundsMigratorInfoAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTssFundsMigratorInfoAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTssFundsMigratorInfoAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTssFundsMigratorInfoAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TssFundsMigrators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TssFundsMigrators = append(m.TssFundsMigrators, TssFundMigratorInfo{})
+			if err := m.TssFundsMigrators[len(m.TssFundsMigrators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTssFundsMigratorInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTssFundsMigratorInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTssFundsMigratorInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTssFundsMigratorInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTssFundsMigratorInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTssFundsMigratorInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TssFundsMigrator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TssFundsMigrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetChainNoncesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetChainNoncesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetChainNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryBallotListForHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryBallotListForHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetChainNoncesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetChainNoncesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetChainNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChainNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.BallotList.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryBallotsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllChainNoncesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6454,10 +8388,10 @@ func (m *QueryBallotsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBallotsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllChainNoncesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBallotsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllChainNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6517,7 +8451,7 @@ func (m *QueryBallotsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBallotsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllChainNoncesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6540,15 +8474,15 @@ func (m *QueryBallotsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBallotsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllChainNoncesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBallotsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllChainNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ballots", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonces", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6575,8 +8509,8 @@ func (m *QueryBallotsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ballots = append(m.Ballots, Ballot{})
-			if err := m.Ballots[len(m.Ballots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChainNonces = append(m.ChainNonces, ChainNonces{})
+			if err := m.ChainNonces[len(m.ChainNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6637,7 +8571,7 @@ func (m *QueryBallotsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperationalFlagsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllPendingNoncesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6660,12 +8594,48 @@ func (m *QueryOperationalFlagsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperationalFlagsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllPendingNoncesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperationalFlagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllPendingNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6687,7 +8657,7 @@ func (m *QueryOperationalFlagsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperationalFlagsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllPendingNoncesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6710,15 +8680,15 @@ func (m *QueryOperationalFlagsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperationalFlagsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllPendingNoncesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperationalFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllPendingNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperationalFlags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingNonces", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6745,7 +8715,44 @@ func (m *QueryOperationalFlagsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OperationalFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingNonces = append(m.PendingNonces, PendingNonces{})
+			if err := m.PendingNonces[len(m.PendingNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6770,7 +8777,7 @@ func (m *QueryOperationalFlagsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTssFundsMigratorInfoAllRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingNoncesByChainRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6793,12 +8800,31 @@ func (m *QueryTssFundsMigratorInfoAllRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTssFundsMigratorInfoAllRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingNoncesByChainRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTssFundsMigratorInfoAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingNoncesByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6820,7 +8846,7 @@ func (m *QueryTssFundsMigratorInfoAllRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTssFundsMigratorInfoAllResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingNoncesByChainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6843,15 +8869,15 @@ func (m *QueryTssFundsMigratorInfoAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTssFundsMigratorInfoAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingNoncesByChainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTssFundsMigratorInfoAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingNoncesByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TssFundsMigrators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingNonces", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6878,8 +8904,7 @@ func (m *QueryTssFundsMigratorInfoAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TssFundsMigrators = append(m.TssFundsMigrators, TssFundMigratorInfo{})
-			if err := m.TssFundsMigrators[len(m.TssFundsMigrators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PendingNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6904,7 +8929,7 @@ func (m *QueryTssFundsMigratorInfoAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTssFundsMigratorInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTSSRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6927,31 +8952,12 @@ func (m *QueryTssFundsMigratorInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTssFundsMigratorInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTSSRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTssFundsMigratorInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTSSRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6973,7 +8979,7 @@ func (m *QueryTssFundsMigratorInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTssFundsMigratorInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTSSResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6996,15 +9002,15 @@ func (m *QueryTssFundsMigratorInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTssFundsMigratorInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTSSResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTssFundsMigratorInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTSSResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TssFundsMigrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TSS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7031,7 +9037,7 @@ func (m *QueryTssFundsMigratorInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TssFundsMigrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TSS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7056,7 +9062,7 @@ func (m *QueryTssFundsMigratorInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetChainNoncesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTssAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7079,17 +9085,17 @@ func (m *QueryGetChainNoncesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetChainNoncesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTssAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetChainNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTssAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BitcoinChainId", wireType)
 			}
-			m.ChainId = 0
+			m.BitcoinChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7099,7 +9105,7 @@ func (m *QueryGetChainNoncesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				m.BitcoinChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7125,7 +9131,7 @@ func (m *QueryGetChainNoncesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetChainNoncesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7148,17 +9154,17 @@ func (m *QueryGetChainNoncesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetChainNoncesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTssAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetChainNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTssAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7168,24 +9174,87 @@ func (m *QueryGetChainNoncesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ChainNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Eth = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Btc", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Btc = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sui", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Sui = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7208,7 +9277,7 @@ func (m *QueryGetChainNoncesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllChainNoncesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTssAddressByFinalizedHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7231,17 +9300,17 @@ func (m *QueryAllChainNoncesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllChainNoncesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllChainNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedZetaHeight", wireType)
 			}
-			var msglen int
+			m.FinalizedZetaHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7251,28 +9320,30 @@ func (m *QueryAllChainNoncesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FinalizedZetaHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BitcoinChainId", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BitcoinChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BitcoinChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7294,7 +9365,7 @@ func (m *QueryAllChainNoncesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllChainNoncesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTssAddressByFinalizedHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7317,17 +9388,17 @@ func (m *QueryAllChainNoncesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllChainNoncesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllChainNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7337,31 +9408,29 @@ func (m *QueryAllChainNoncesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainNonces = append(m.ChainNonces, ChainNonces{})
-			if err := m.ChainNonces[len(m.ChainNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Eth = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Btc", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7371,27 +9440,55 @@ func (m *QueryAllChainNoncesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Btc = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sui", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sui = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7414,7 +9511,7 @@ func (m *QueryAllChainNoncesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPendingNoncesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTssHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7437,10 +9534,10 @@ func (m *QueryAllPendingNoncesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPendingNoncesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTssHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPendingNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTssHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7500,7 +9597,7 @@ func (m *QueryAllPendingNoncesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPendingNoncesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTssHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7523,15 +9620,15 @@ func (m *QueryAllPendingNoncesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPendingNoncesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTssHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPendingNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTssHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TssList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7558,8 +9655,8 @@ func (m *QueryAllPendingNoncesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingNonces = append(m.PendingNonces, PendingNonces{})
-			if err := m.PendingNonces[len(m.PendingNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TssList = append(m.TssList, TSS{})
+			if err := m.TssList[len(m.TssList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7620,7 +9717,7 @@ func (m *QueryAllPendingNoncesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingNoncesByChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHasVotedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7643,17 +9740,17 @@ func (m *QueryPendingNoncesByChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingNoncesByChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHasVotedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingNoncesByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHasVotedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
 			}
-			m.ChainId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7663,66 +9760,29 @@ func (m *QueryPendingNoncesByChainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPendingNoncesByChainResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingNoncesByChainResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingNoncesByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7732,24 +9792,23 @@ func (m *QueryPendingNoncesByChainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PendingNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VoterAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7772,7 +9831,7 @@ func (m *QueryPendingNoncesByChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTSSRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHasVotedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7795,12 +9854,32 @@ func (m *QueryGetTSSRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTSSRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHasVotedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTSSRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHasVotedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasVoted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasVoted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7822,7 +9901,7 @@ func (m *QueryGetTSSRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTSSResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBallotByIdentifierRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7845,17 +9924,17 @@ func (m *QueryGetTSSResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTSSResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBallotByIdentifierRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTSSResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBallotByIdentifierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TSS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7865,24 +9944,23 @@ func (m *QueryGetTSSResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TSS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7905,7 +9983,7 @@ func (m *QueryGetTSSResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTssAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *VoterList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7928,17 +10006,49 @@ func (m *QueryGetTssAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTssAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: VoterList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTssAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VoterList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BitcoinChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
 			}
-			m.BitcoinChainId = 0
+			m.VoteType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7948,7 +10058,7 @@ func (m *QueryGetTssAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BitcoinChainId |= int64(b&0x7F) << shift
+				m.VoteType |= VoteType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7974,7 +10084,7 @@ func (m *QueryGetTssAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBallotByIdentifierResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7997,15 +10107,15 @@ func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTssAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBallotByIdentifierResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTssAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBallotByIdentifierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Eth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8033,13 +10143,13 @@ func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Eth = string(dAtA[iNdEx:postIndex])
+			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Btc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8049,29 +10159,31 @@ func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Btc = string(dAtA[iNdEx:postIndex])
+			m.Voters = append(m.Voters, &VoterList{})
+			if err := m.Voters[len(m.Voters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sui", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationType", wireType)
 			}
-			var stringLen uint64
+			m.ObservationType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8081,24 +10193,30 @@ func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ObservationType |= ObservationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotStatus", wireType)
+			}
+			m.BallotStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BallotStatus |= BallotStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sui = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8120,7 +10238,7 @@ func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTssAddressByFinalizedHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryObserverSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8143,50 +10261,12 @@ func (m *QueryGetTssAddressByFinalizedHeightRequest) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryObserverSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryObserverSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedZetaHeight", wireType)
-			}
-			m.FinalizedZetaHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FinalizedZetaHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BitcoinChainId", wireType)
-			}
-			m.BitcoinChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BitcoinChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8208,7 +10288,7 @@ func (m *QueryGetTssAddressByFinalizedHeightRequest) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryGetTssAddressByFinalizedHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryObserverSetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8231,15 +10311,15 @@ func (m *QueryGetTssAddressByFinalizedHeightResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryObserverSetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryObserverSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Eth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Observers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8267,72 +10347,58 @@ func (m *QueryGetTssAddressByFinalizedHeightResponse) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Eth = string(dAtA[iNdEx:postIndex])
+			m.Observers = append(m.Observers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Btc", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Btc = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sui", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupportedChains) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sui = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupportedChains: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupportedChains: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8354,7 +10420,7 @@ func (m *QueryGetTssAddressByFinalizedHeightResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryTssHistoryRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySupportedChainsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8377,15 +10443,15 @@ func (m *QueryTssHistoryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTssHistoryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySupportedChainsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTssHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySupportedChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8412,10 +10478,8 @@ func (m *QueryTssHistoryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Chains = append(m.Chains, chains.Chain{})
+			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8440,7 +10504,7 @@ func (m *QueryTssHistoryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTssHistoryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetChainParamsForChainRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8463,17 +10527,17 @@ func (m *QueryTssHistoryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTssHistoryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetChainParamsForChainRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTssHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetChainParamsForChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TssList", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8483,29 +10547,64 @@ func (m *QueryTssHistoryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TssList = append(m.TssList, TSS{})
-			if err := m.TssList[len(m.TssList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetChainParamsForChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 2:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetChainParamsForChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetChainParamsForChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8532,10 +10631,10 @@ func (m *QueryTssHistoryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.ChainParams == nil {
+				m.ChainParams = &ChainParams{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChainParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8560,7 +10659,7 @@ func (m *QueryTssHistoryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHasVotedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetChainParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8583,49 +10682,67 @@ func (m *QueryHasVotedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHasVotedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetChainParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHasVotedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetChainParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetChainParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetChainParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoterAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainParams", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8635,23 +10752,27 @@ func (m *QueryHasVotedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VoterAddress = string(dAtA[iNdEx:postIndex])
+			if m.ChainParams == nil {
+				m.ChainParams = &ChainParamsList{}
+			}
+			if err := m.ChainParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8674,7 +10795,7 @@ func (m *QueryHasVotedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHasVotedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetNodeAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8697,17 +10818,17 @@ func (m *QueryHasVotedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHasVotedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetNodeAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHasVotedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetNodeAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasVoted", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8717,12 +10838,24 @@ func (m *QueryHasVotedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasVoted = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8744,7 +10877,7 @@ func (m *QueryHasVotedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBallotByIdentifierRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetNodeAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8767,17 +10900,17 @@ func (m *QueryBallotByIdentifierRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBallotByIdentifierRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetNodeAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBallotByIdentifierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetNodeAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAccount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8787,23 +10920,27 @@ func (m *QueryBallotByIdentifierRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
+			if m.NodeAccount == nil {
+				m.NodeAccount = &NodeAccount{}
+			}
+			if err := m.NodeAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8826,7 +10963,7 @@ func (m *QueryBallotByIdentifierRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VoterList) Unmarshal(dAtA []byte) error {
+func (m *QueryAllNodeAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8849,17 +10986,17 @@ func (m *VoterList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VoterList: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllNodeAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VoterList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllNodeAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoterAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8869,43 +11006,28 @@ func (m *VoterList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VoterAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			m.VoteType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VoteType |= VoteType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8927,7 +11049,7 @@ func (m *VoterList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBallotByIdentifierResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllNodeAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8950,17 +11072,17 @@ func (m *QueryBallotByIdentifierResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBallotByIdentifierResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllNodeAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBallotByIdentifierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllNodeAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAccount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8970,27 +11092,29 @@ func (m *QueryBallotByIdentifierResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
+			m.NodeAccount = append(m.NodeAccount, &NodeAccount{})
+			if err := m.NodeAccount[len(m.NodeAccount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9017,49 +11141,13 @@ func (m *QueryBallotByIdentifierResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voters = append(m.Voters, &VoterList{})
-			if err := m.Voters[len(m.Voters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservationType", wireType)
-			}
-			m.ObservationType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ObservationType |= ObservationType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotStatus", wireType)
-			}
-			m.BallotStatus = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BallotStatus |= BallotStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9081,7 +11169,7 @@ func (m *QueryBallotByIdentifierResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryObserverSet) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCrosschainFlagsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9104,10 +11192,10 @@ func (m *QueryObserverSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryObserverSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCrosschainFlagsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryObserverSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCrosschainFlagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9131,7 +11219,7 @@ func (m *QueryObserverSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryObserverSetResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCrosschainFlagsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9154,17 +11242,17 @@ func (m *QueryObserverSetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryObserverSetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCrosschainFlagsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryObserverSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCrosschainFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Observers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CrosschainFlags", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9174,23 +11262,24 @@ func (m *QueryObserverSetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Observers = append(m.Observers, string(dAtA[iNdEx:postIndex]))
+			if err := m.CrosschainFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9213,7 +11302,7 @@ func (m *QueryObserverSetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySupportedChains) Unmarshal(dAtA []byte) error {
+func (m *QueryGetKeygenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9236,10 +11325,10 @@ func (m *QuerySupportedChains) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupportedChains: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetKeygenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupportedChains: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetKeygenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9263,7 +11352,7 @@ func (m *QuerySupportedChains) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySupportedChainsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetKeygenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9286,15 +11375,15 @@ func (m *QuerySupportedChainsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupportedChainsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetKeygenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupportedChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetKeygenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keygen", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9321,8 +11410,10 @@ func (m *QuerySupportedChainsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chains = append(m.Chains, chains.Chain{})
-			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Keygen == nil {
+				m.Keygen = &Keygen{}
+			}
+			if err := m.Keygen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9347,7 +11438,7 @@ func (m *QuerySupportedChainsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetChainParamsForChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryShowObserverCountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9370,17 +11461,67 @@ func (m *QueryGetChainParamsForChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetChainParamsForChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryShowObserverCountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetChainParamsForChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryShowObserverCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryShowObserverCountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryShowObserverCountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryShowObserverCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObserverCount", wireType)
 			}
-			m.ChainId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9390,11 +11531,28 @@ func (m *QueryGetChainParamsForChainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastObserverCount == nil {
+				m.LastObserverCount = &LastObserverCount{}
+			}
+			if err := m.LastObserverCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9416,7 +11574,7 @@ func (m *QueryGetChainParamsForChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetChainParamsForChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlameByIdentifierRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9439,17 +11597,17 @@ func (m *QueryGetChainParamsForChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetChainParamsForChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlameByIdentifierRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetChainParamsForChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlameByIdentifierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameIdentifier", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9459,27 +11617,23 @@ func (m *QueryGetChainParamsForChainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChainParams == nil {
-				m.ChainParams = &ChainParams{}
-			}
-			if err := m.ChainParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BlameIdentifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9502,7 +11656,7 @@ func (m *QueryGetChainParamsForChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetChainParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBlameByIdentifierResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9525,12 +11679,48 @@ func (m *QueryGetChainParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetChainParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlameByIdentifierResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetChainParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlameByIdentifierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlameInfo == nil {
+				m.BlameInfo = &Blame{}
+			}
+			if err := m.BlameInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9552,7 +11742,7 @@ func (m *QueryGetChainParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetChainParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBlameRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9575,15 +11765,15 @@ func (m *QueryGetChainParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetChainParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBlameRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBlameRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9610,10 +11800,10 @@ func (m *QueryGetChainParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChainParams == nil {
-				m.ChainParams = &ChainParamsList{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.ChainParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9638,7 +11828,7 @@ func (m *QueryGetChainParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetNodeAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBlameRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9648,30 +11838,64 @@ func (m *QueryGetNodeAccountRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBlameRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBlameRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.BlameInfo = append(m.BlameInfo, Blame{})
+			if err := m.BlameInfo[len(m.BlameInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetNodeAccountRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetNodeAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9681,23 +11905,27 @@ func (m *QueryGetNodeAccountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9720,7 +11948,7 @@ func (m *QueryGetNodeAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetNodeAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlameByChainAndNonceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9743,17 +11971,17 @@ func (m *QueryGetNodeAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetNodeAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlameByChainAndNonceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetNodeAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlameByChainAndNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeAccount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9763,28 +11991,30 @@ func (m *QueryGetNodeAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.NodeAccount == nil {
-				m.NodeAccount = &NodeAccount{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			if err := m.NodeAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9806,7 +12036,7 @@ func (m *QueryGetNodeAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllNodeAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBlameByChainAndNonceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9829,15 +12059,15 @@ func (m *QueryAllNodeAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllNodeAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlameByChainAndNonceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllNodeAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlameByChainAndNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9864,10 +12094,8 @@ func (m *QueryAllNodeAccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BlameInfo = append(m.BlameInfo, &Blame{})
+			if err := m.BlameInfo[len(m.BlameInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9892,7 +12120,7 @@ func (m *QueryAllNodeAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllNodeAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlameStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9915,17 +12143,17 @@ func (m *QueryAllNodeAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllNodeAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlameStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllNodeAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlameStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeAccount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			var msglen int
+			m.Window = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9935,31 +12163,16 @@ func (m *QueryAllNodeAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Window |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NodeAccount = append(m.NodeAccount, &NodeAccount{})
-			if err := m.NodeAccount[len(m.NodeAccount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9969,27 +12182,23 @@ func (m *QueryAllNodeAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10012,7 +12221,7 @@ func (m *QueryAllNodeAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCrosschainFlagsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBlameStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10035,65 +12244,53 @@ func (m *QueryGetCrosschainFlagsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCrosschainFlagsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlameStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCrosschainFlagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlameStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetCrosschainFlagsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCrosschainFlagsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCrosschainFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CrosschainFlags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10120,7 +12317,8 @@ func (m *QueryGetCrosschainFlagsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CrosschainFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Stats = append(m.Stats, NodeBlameStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10145,7 +12343,7 @@ func (m *QueryGetCrosschainFlagsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetKeygenRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBallotListForHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10164,16 +12362,35 @@ func (m *QueryGetKeygenRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetKeygenRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetKeygenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBallotListForHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBallotListForHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10195,7 +12412,7 @@ func (m *QueryGetKeygenRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetKeygenResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBallotListForHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10218,15 +12435,15 @@ func (m *QueryGetKeygenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetKeygenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBallotListForHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetKeygenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBallotListForHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keygen", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10253,10 +12470,7 @@ func (m *QueryGetKeygenResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Keygen == nil {
-				m.Keygen = &Keygen{}
-			}
-			if err := m.Keygen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BallotList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10281,7 +12495,7 @@ func (m *QueryGetKeygenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryShowObserverCountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryObserverSetChangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10304,10 +12518,10 @@ func (m *QueryShowObserverCountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryShowObserverCountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryObserverSetChangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryShowObserverCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryObserverSetChangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10331,7 +12545,7 @@ func (m *QueryShowObserverCountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryShowObserverCountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryObserverSetChangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10354,15 +12568,15 @@ func (m *QueryShowObserverCountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryShowObserverCountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryObserverSetChangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryShowObserverCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryObserverSetChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastObserverCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverSetChange", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10389,10 +12603,7 @@ func (m *QueryShowObserverCountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastObserverCount == nil {
-				m.LastObserverCount = &LastObserverCount{}
-			}
-			if err := m.LastObserverCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObserverSetChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10417,7 +12628,7 @@ func (m *QueryShowObserverCountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlameByIdentifierRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryObserverSigningInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10440,15 +12651,15 @@ func (m *QueryBlameByIdentifierRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlameByIdentifierRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryObserverSigningInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlameByIdentifierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryObserverSigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlameIdentifier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10476,7 +12687,7 @@ func (m *QueryBlameByIdentifierRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlameIdentifier = string(dAtA[iNdEx:postIndex])
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10499,7 +12710,7 @@ func (m *QueryBlameByIdentifierRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlameByIdentifierResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryObserverSigningInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10522,15 +12733,15 @@ func (m *QueryBlameByIdentifierResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlameByIdentifierResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryObserverSigningInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlameByIdentifierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryObserverSigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlameInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10557,10 +12768,7 @@ func (m *QueryBlameByIdentifierResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlameInfo == nil {
-				m.BlameInfo = &Blame{}
-			}
-			if err := m.BlameInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10585,7 +12793,7 @@ func (m *QueryBlameByIdentifierResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBlameRecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryObserverSigningInfosRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10608,10 +12816,10 @@ func (m *QueryAllBlameRecordsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBlameRecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryObserverSigningInfosRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBlameRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryObserverSigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10671,7 +12879,7 @@ func (m *QueryAllBlameRecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBlameRecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryObserverSigningInfosResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10694,15 +12902,15 @@ func (m *QueryAllBlameRecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBlameRecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryObserverSigningInfosResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBlameRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryObserverSigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlameInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10729,8 +12937,8 @@ func (m *QueryAllBlameRecordsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlameInfo = append(m.BlameInfo, Blame{})
-			if err := m.BlameInfo[len(m.BlameInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SigningInfos = append(m.SigningInfos, ObserverSigningInfo{})
+			if err := m.SigningInfos[len(m.SigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10791,179 +12999,7 @@ func (m *QueryAllBlameRecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlameByChainAndNonceRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlameByChainAndNonceRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlameByChainAndNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBlameByChainAndNonceResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlameByChainAndNonceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlameByChainAndNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlameInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlameInfo = append(m.BlameInfo, &Blame{})
-			if err := m.BlameInfo[len(m.BlameInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBallotListForHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLivenessParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10986,31 +13022,12 @@ func (m *QueryBallotListForHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBallotListForHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLivenessParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBallotListForHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLivenessParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11032,7 +13049,7 @@ func (m *QueryBallotListForHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBallotListForHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLivenessParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11055,15 +13072,15 @@ func (m *QueryBallotListForHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBallotListForHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLivenessParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBallotListForHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLivenessParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11090,7 +13107,7 @@ func (m *QueryBallotListForHeightResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BallotList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LivenessParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -29,6 +29,8 @@ type TSS struct {
 	OperatorAddressList []string `protobuf:"bytes,5,rep,name=operator_address_list,json=operatorAddressList,proto3" json:"operator_address_list,omitempty"`
 	FinalizedZetaHeight int64    `protobuf:"varint,6,opt,name=finalizedZetaHeight,proto3" json:"finalizedZetaHeight,omitempty"`
 	KeyGenZetaHeight    int64    `protobuf:"varint,7,opt,name=keyGenZetaHeight,proto3" json:"keyGenZetaHeight,omitempty"`
+	// ed25519 public key generated alongside the secp256k1 key, empty if none
+	TssPubkeyEddsa string `protobuf:"bytes,8,opt,name=tss_pubkey_eddsa,json=tssPubkeyEddsa,proto3" json:"tss_pubkey_eddsa,omitempty"`
 }
 
 func (m *TSS) Reset()         { *m = TSS{} }
@@ -99,6 +101,13 @@ func (m *TSS) GetKeyGenZetaHeight() int64 {
 	return 0
 }
 
+func (m *TSS) GetTssPubkeyEddsa() string {
+	if m != nil {
+		return m.TssPubkeyEddsa
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*TSS)(nil), "zetachain.zetacore.observer.TSS")
 }
@@ -108,26 +117,27 @@ func init() {
 }
 
 var fileDescriptor_0d5940b469d46916 = []byte{
-	// 292 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xc1, 0x4a, 0xf3, 0x40,
-	0x14, 0x46, 0x3b, 0xe4, 0xff, 0x2b, 0x9d, 0x95, 0x4c, 0x2b, 0x14, 0xc5, 0xa1, 0x08, 0x42, 0x51,
-	0x4c, 0x8a, 0x3e, 0x81, 0x82, 0xe8, 0xc2, 0x85, 0xb4, 0xae, 0xba, 0x29, 0x93, 0xe4, 0x9a, 0x0c,
-	0xad, 0x99, 0x30, 0xf7, 0x56, 0x4c, 0x9f, 0xc2, 0xc7, 0x72, 0xd9, 0xa5, 0x4b, 0x49, 0x36, 0x3e,
-	0x86, 0x74, 0x42, 0xb4, 0xa0, 0xbb, 0xcb, 0x3d, 0xe7, 0xdb, 0x1c, 0x7e, 0xbc, 0x02, 0x52, 0x51,
-	0xaa, 0x74, 0x16, 0xb8, 0xcb, 0x58, 0x08, 0x4c, 0x88, 0x60, 0x9f, 0xc1, 0x06, 0x84, 0xe8, 0xe7,
-	0xd6, 0x90, 0x11, 0x07, 0xdf, 0x9a, 0xdf, 0x68, 0x7e, 0xa3, 0xed, 0xf7, 0x12, 0x93, 0x18, 0xe7,
-	0x05, 0x9b, 0xab, 0x9e, 0x1c, 0x7d, 0x32, 0xee, 0x3d, 0x4c, 0x26, 0xe2, 0x90, 0x73, 0x42, 0x9c,
-	0xe5, 0xcb, 0x70, 0x0e, 0x45, 0xdf, 0x1b, 0xb0, 0x61, 0x67, 0xdc, 0x21, 0xc4, 0x7b, 0xf7, 0x10,
-	0x23, 0xde, 0x73, 0x58, 0x59, 0xd2, 0x91, 0xce, 0x55, 0x46, 0xb3, 0x85, 0x46, 0xea, 0xff, 0x1b,
-	0x78, 0xc3, 0xce, 0x58, 0x6c, 0xc4, 0x1f, 0x74, 0xa7, 0x91, 0xc4, 0x39, 0xdf, 0x33, 0x39, 0x58,
-	0x45, 0xc6, 0xce, 0x54, 0x1c, 0x5b, 0x40, 0xac, 0x27, 0xff, 0xdd, 0xa4, 0xdb, 0xc0, 0xcb, 0x9a,
-	0xb9, 0xcd, 0x88, 0x77, 0x1f, 0x75, 0xa6, 0x16, 0x7a, 0x05, 0xf1, 0x14, 0x48, 0xdd, 0x82, 0x4e,
-	0x52, 0xea, 0xb7, 0x07, 0x6c, 0xe8, 0x8d, 0xff, 0x42, 0xe2, 0x84, 0xef, 0xce, 0xa1, 0xb8, 0x81,
-	0x6c, 0x4b, 0xdf, 0x71, 0xfa, 0xaf, 0xff, 0xd5, 0xf5, 0x5b, 0x29, 0xd9, 0xba, 0x94, 0xec, 0xa3,
-	0x94, 0xec, 0xb5, 0x92, 0xad, 0x75, 0x25, 0x5b, 0xef, 0x95, 0x6c, 0x4d, 0x4f, 0x13, 0x4d, 0xe9,
-	0x32, 0xf4, 0x23, 0xf3, 0xe4, 0xfa, 0x9e, 0xd5, 0xa9, 0x33, 0x13, 0x43, 0xf0, 0xb2, 0x15, 0xba,
-	0xc8, 0x01, 0xc3, 0xb6, 0x0b, 0x77, 0xf1, 0x15, 0x00, 0x00, 0xff, 0xff, 0xfa, 0x27, 0xf4, 0x48,
-	0x94, 0x01, 0x00, 0x00,
+	// 311 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xc1, 0x4a, 0xc3, 0x40,
+	0x10, 0x86, 0x1b, 0xa3, 0xd5, 0xee, 0x41, 0xca, 0xb6, 0x42, 0x50, 0x0c, 0x45, 0x10, 0x82, 0x62,
+	0x52, 0xf4, 0x09, 0x14, 0x8a, 0x1e, 0x3c, 0x48, 0xeb, 0xa9, 0x97, 0xb0, 0xe9, 0x8e, 0xe9, 0xd2,
+	0x9a, 0x0d, 0x3b, 0x53, 0xb1, 0x7d, 0x0a, 0xf1, 0xa9, 0x3c, 0xf6, 0xe8, 0x51, 0xda, 0x17, 0x91,
+	0x6e, 0x48, 0x5b, 0xd0, 0xdb, 0x30, 0xdf, 0xf7, 0x1f, 0xfe, 0x9f, 0x9d, 0xcf, 0x80, 0xc4, 0x60,
+	0x28, 0x54, 0x16, 0xd9, 0x4b, 0x1b, 0x88, 0x74, 0x82, 0x60, 0xde, 0xc0, 0x44, 0x84, 0x18, 0xe6,
+	0x46, 0x93, 0xe6, 0x27, 0x6b, 0x2d, 0x2c, 0xb5, 0xb0, 0xd4, 0x8e, 0x9b, 0xa9, 0x4e, 0xb5, 0xf5,
+	0xa2, 0xd5, 0x55, 0x44, 0xce, 0x3e, 0x77, 0x98, 0xfb, 0xdc, 0xeb, 0xf1, 0x53, 0xc6, 0x08, 0x31,
+	0xce, 0x27, 0xc9, 0x08, 0xa6, 0x9e, 0xdb, 0x72, 0x82, 0x5a, 0xb7, 0x46, 0x88, 0x4f, 0xf6, 0xc1,
+	0xdb, 0xac, 0x69, 0xb1, 0x30, 0xa4, 0x06, 0x2a, 0x17, 0x19, 0xc5, 0x63, 0x85, 0xe4, 0xed, 0xb6,
+	0xdc, 0xa0, 0xd6, 0xe5, 0x2b, 0x71, 0x83, 0x1e, 0x15, 0x12, 0xbf, 0x66, 0x47, 0x3a, 0x07, 0x23,
+	0x48, 0x9b, 0x58, 0x48, 0x69, 0x00, 0xb1, 0x88, 0xec, 0xd9, 0x48, 0xa3, 0x84, 0xb7, 0x05, 0xb3,
+	0x99, 0x36, 0x6b, 0xbc, 0xa8, 0x4c, 0x8c, 0xd5, 0x0c, 0x64, 0x1f, 0x48, 0x3c, 0x80, 0x4a, 0x87,
+	0xe4, 0x55, 0x5b, 0x4e, 0xe0, 0x76, 0xff, 0x43, 0xfc, 0x82, 0xd5, 0x47, 0x30, 0xbd, 0x87, 0x6c,
+	0x4b, 0xdf, 0xb7, 0xfa, 0x9f, 0x3f, 0x0f, 0x58, 0x7d, 0x53, 0x31, 0x06, 0x29, 0x51, 0x78, 0x07,
+	0xb6, 0xe8, 0xe1, 0xba, 0x68, 0x67, 0xf5, 0xbd, 0xeb, 0xf4, 0x2f, 0x53, 0x45, 0xc3, 0x49, 0x12,
+	0x0e, 0xf4, 0xab, 0x5d, 0xfc, 0xaa, 0x18, 0x3f, 0xd3, 0x12, 0xa2, 0xf7, 0xad, 0xe9, 0xa7, 0x39,
+	0xe0, 0xd7, 0xc2, 0x77, 0xe6, 0x0b, 0xdf, 0xf9, 0x59, 0xf8, 0xce, 0xc7, 0xd2, 0xaf, 0xcc, 0x97,
+	0x7e, 0xe5, 0x7b, 0xe9, 0x57, 0x92, 0xaa, 0x9d, 0xf8, 0xe6, 0x37, 0x00, 0x00, 0xff, 0xff, 0xfd,
+	0xcf, 0x65, 0x4d, 0xbe, 0x01, 0x00, 0x00,
 }
 
 func (m *TSS) Marshal() (dAtA []byte, err error) {
@@ -150,6 +160,13 @@ func (m *TSS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TssPubkeyEddsa) > 0 {
+		i -= len(m.TssPubkeyEddsa)
+		copy(dAtA[i:], m.TssPubkeyEddsa)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.TssPubkeyEddsa)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.KeyGenZetaHeight != 0 {
 		i = encodeVarintTss(dAtA, i, uint64(m.KeyGenZetaHeight))
 		i--
@@ -227,6 +244,10 @@ func (m *TSS) Size() (n int) {
 	if m.KeyGenZetaHeight != 0 {
 		n += 1 + sovTss(uint64(m.KeyGenZetaHeight))
 	}
+	l = len(m.TssPubkeyEddsa)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
 	return n
 }
 
@@ -399,6 +420,38 @@ func (m *TSS) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TssPubkeyEddsa", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TssPubkeyEddsa = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTss(dAtA[iNdEx:])
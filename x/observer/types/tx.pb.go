@@ -884,6 +884,8 @@ var xxx_messageInfo_MsgVoteBlameResponse proto.InternalMessageInfo
 type MsgUpdateKeygen struct {
 	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Block   int64  `protobuf:"varint,2,opt,name=block,proto3" json:"block,omitempty"`
+	// generate an ed25519 key alongside the secp256k1 key
+	Eddsa bool `protobuf:"varint,3,opt,name=eddsa,proto3" json:"eddsa,omitempty"`
 }
 
 func (m *MsgUpdateKeygen) Reset()         { *m = MsgUpdateKeygen{} }
@@ -933,6 +935,13 @@ func (m *MsgUpdateKeygen) GetBlock() int64 {
 	return 0
 }
 
+func (m *MsgUpdateKeygen) GetEddsa() bool {
+	if m != nil {
+		return m.Eddsa
+	}
+	return false
+}
+
 type MsgUpdateKeygenResponse struct {
 }
 
@@ -1078,6 +1087,8 @@ type MsgVoteTSS struct {
 	TssPubkey        string               `protobuf:"bytes,2,opt,name=tss_pubkey,json=tssPubkey,proto3" json:"tss_pubkey,omitempty"`
 	KeygenZetaHeight int64                `protobuf:"varint,3,opt,name=keygen_zeta_height,json=keygenZetaHeight,proto3" json:"keygen_zeta_height,omitempty"`
 	Status           chains.ReceiveStatus `protobuf:"varint,4,opt,name=status,proto3,enum=zetachain.zetacore.pkg.chains.ReceiveStatus" json:"status,omitempty"`
+	// ed25519 public key, required if the keygen generates an ed25519 key
+	TssPubkeyEddsa string `protobuf:"bytes,5,opt,name=tss_pubkey_eddsa,json=tssPubkeyEddsa,proto3" json:"tss_pubkey_eddsa,omitempty"`
 }
 
 func (m *MsgVoteTSS) Reset()         { *m = MsgVoteTSS{} }
@@ -1141,6 +1152,13 @@ func (m *MsgVoteTSS) GetStatus() chains.ReceiveStatus {
 	return chains.ReceiveStatus_created
 }
 
+func (m *MsgVoteTSS) GetTssPubkeyEddsa() string {
+	if m != nil {
+		return m.TssPubkeyEddsa
+	}
+	return ""
+}
+
 type MsgVoteTSSResponse struct {
 	BallotCreated bool `protobuf:"varint,1,opt,name=ballot_created,json=ballotCreated,proto3" json:"ballot_created,omitempty"`
 	VoteFinalized bool `protobuf:"varint,2,opt,name=vote_finalized,json=voteFinalized,proto3" json:"vote_finalized,omitempty"`
@@ -1750,1484 +1768,1495 @@ func (m *MsgUpdateV2ZetaFlowsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateV2ZetaFlowsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateObserver)(nil), "zetachain.zetacore.observer.MsgUpdateObserver")
-	proto.RegisterType((*MsgUpdateObserverResponse)(nil), "zetachain.zetacore.observer.MsgUpdateObserverResponse")
-	proto.RegisterType((*MsgVoteBlockHeader)(nil), "zetachain.zetacore.observer.MsgVoteBlockHeader")
-	proto.RegisterType((*MsgVoteBlockHeaderResponse)(nil), "zetachain.zetacore.observer.MsgVoteBlockHeaderResponse")
-	proto.RegisterType((*MsgUpdateChainParams)(nil), "zetachain.zetacore.observer.MsgUpdateChainParams")
-	proto.RegisterType((*MsgUpdateChainParamsResponse)(nil), "zetachain.zetacore.observer.MsgUpdateChainParamsResponse")
-	proto.RegisterType((*MsgUpdateOperationalChainParams)(nil), "zetachain.zetacore.observer.MsgUpdateOperationalChainParams")
-	proto.RegisterType((*MsgUpdateOperationalChainParamsResponse)(nil), "zetachain.zetacore.observer.MsgUpdateOperationalChainParamsResponse")
-	proto.RegisterType((*MsgRemoveChainParams)(nil), "zetachain.zetacore.observer.MsgRemoveChainParams")
-	proto.RegisterType((*MsgRemoveChainParamsResponse)(nil), "zetachain.zetacore.observer.MsgRemoveChainParamsResponse")
-	proto.RegisterType((*MsgAddObserver)(nil), "zetachain.zetacore.observer.MsgAddObserver")
-	proto.RegisterType((*MsgAddObserverResponse)(nil), "zetachain.zetacore.observer.MsgAddObserverResponse")
-	proto.RegisterType((*MsgRemoveObserver)(nil), "zetachain.zetacore.observer.MsgRemoveObserver")
-	proto.RegisterType((*MsgRemoveObserverResponse)(nil), "zetachain.zetacore.observer.MsgRemoveObserverResponse")
-	proto.RegisterType((*MsgVoteBlame)(nil), "zetachain.zetacore.observer.MsgVoteBlame")
-	proto.RegisterType((*MsgVoteBlameResponse)(nil), "zetachain.zetacore.observer.MsgVoteBlameResponse")
-	proto.RegisterType((*MsgUpdateKeygen)(nil), "zetachain.zetacore.observer.MsgUpdateKeygen")
-	proto.RegisterType((*MsgUpdateKeygenResponse)(nil), "zetachain.zetacore.observer.MsgUpdateKeygenResponse")
-	proto.RegisterType((*MsgResetChainNonces)(nil), "zetachain.zetacore.observer.MsgResetChainNonces")
-	proto.RegisterType((*MsgResetChainNoncesResponse)(nil), "zetachain.zetacore.observer.MsgResetChainNoncesResponse")
-	proto.RegisterType((*MsgVoteTSS)(nil), "zetachain.zetacore.observer.MsgVoteTSS")
-	proto.RegisterType((*MsgVoteTSSResponse)(nil), "zetachain.zetacore.observer.MsgVoteTSSResponse")
-	proto.RegisterType((*MsgEnableCCTX)(nil), "zetachain.zetacore.observer.MsgEnableCCTX")
-	proto.RegisterType((*MsgEnableCCTXResponse)(nil), "zetachain.zetacore.observer.MsgEnableCCTXResponse")
-	proto.RegisterType((*MsgDisableCCTX)(nil), "zetachain.zetacore.observer.MsgDisableCCTX")
-	proto.RegisterType((*MsgDisableCCTXResponse)(nil), "zetachain.zetacore.observer.MsgDisableCCTXResponse")
-	proto.RegisterType((*MsgUpdateGasPriceIncreaseFlags)(nil), "zetachain.zetacore.observer.MsgUpdateGasPriceIncreaseFlags")
-	proto.RegisterType((*MsgUpdateGasPriceIncreaseFlagsResponse)(nil), "zetachain.zetacore.observer.MsgUpdateGasPriceIncreaseFlagsResponse")
-	proto.RegisterType((*MsgUpdateOperationalFlags)(nil), "zetachain.zetacore.observer.MsgUpdateOperationalFlags")
-	proto.RegisterType((*MsgUpdateOperationalFlagsResponse)(nil), "zetachain.zetacore.observer.MsgUpdateOperationalFlagsResponse")
-	proto.RegisterType((*MsgDisableFastConfirmation)(nil), "zetachain.zetacore.observer.MsgDisableFastConfirmation")
-	proto.RegisterType((*MsgDisableFastConfirmationResponse)(nil), "zetachain.zetacore.observer.MsgDisableFastConfirmationResponse")
-	proto.RegisterType((*MsgUpdateV2ZetaFlows)(nil), "zetachain.zetacore.observer.MsgUpdateV2ZetaFlows")
-	proto.RegisterType((*MsgUpdateV2ZetaFlowsResponse)(nil), "zetachain.zetacore.observer.MsgUpdateV2ZetaFlowsResponse")
+// MsgProposeObserverSetChange stages a change of the observer set that is
+// activated once the keygen for the new observer set succeeded and the funds
+// are migrated to the new TSS.
+// The fund migration is manual: the admin has to migrate the funds
+// (MsgMigrateTssFunds) and update the TSS address (MsgUpdateTssAddress),
+// otherwise the change stays awaiting activation until it is cancelled
+// (MsgCancelObserverSetChange).
+type MsgProposeObserverSetChange struct {
+	Creator          string                `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Additions        []ObserverSetAddition `protobuf:"bytes,2,rep,name=additions,proto3" json:"additions"`
+	Removals         []string              `protobuf:"bytes,3,rep,name=removals,proto3" json:"removals,omitempty"`
+	ActivationHeight int64                 `protobuf:"varint,4,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// generate an ed25519 key alongside the secp256k1 key
+	Eddsa bool `protobuf:"varint,5,opt,name=eddsa,proto3" json:"eddsa,omitempty"`
+}
+
+func (m *MsgProposeObserverSetChange) Reset()         { *m = MsgProposeObserverSetChange{} }
+func (m *MsgProposeObserverSetChange) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeObserverSetChange) ProtoMessage()    {}
+func (*MsgProposeObserverSetChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6e3b1d16a4021, []int{34}
+}
+func (m *MsgProposeObserverSetChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func init() {
-	proto.RegisterFile("zetachain/zetacore/observer/tx.proto", fileDescriptor_eda6e3b1d16a4021)
+func (m *MsgProposeObserverSetChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeObserverSetChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-var fileDescriptor_eda6e3b1d16a4021 = []byte{
-	// 1746 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcd, 0x6f, 0xe3, 0xc6,
-	0x15, 0x5f, 0xd6, 0xde, 0xb5, 0xfd, 0x6c, 0x49, 0x36, 0xd7, 0x6b, 0xcb, 0x74, 0x56, 0xd9, 0xba,
-	0x1b, 0x5b, 0x76, 0x76, 0xa5, 0xb5, 0xb6, 0x6d, 0xda, 0x34, 0x48, 0xb1, 0x6b, 0xc7, 0x1f, 0x6d,
-	0x1c, 0x2f, 0x28, 0x67, 0xd1, 0xe6, 0xc2, 0x8e, 0xc8, 0x11, 0xc5, 0x9a, 0xe2, 0x08, 0x1c, 0xca,
-	0x1f, 0x29, 0x50, 0xb4, 0x05, 0x7a, 0x68, 0x80, 0x02, 0x01, 0x7a, 0xeb, 0xa5, 0x40, 0x81, 0x5e,
-	0x7a, 0xca, 0xb1, 0xff, 0x41, 0x73, 0x0c, 0x7a, 0x69, 0x4f, 0x45, 0xb1, 0x7b, 0xc8, 0x9f, 0xd0,
-	0x6b, 0xc1, 0x99, 0xe1, 0x88, 0xa2, 0x68, 0x4a, 0xf2, 0x6e, 0x4f, 0x16, 0xdf, 0xfc, 0x7e, 0xef,
-	0x6b, 0xde, 0xbc, 0x79, 0xa4, 0xe1, 0xfe, 0xa7, 0x38, 0x40, 0x66, 0x0b, 0x39, 0x5e, 0x95, 0xfd,
-	0x22, 0x3e, 0xae, 0x92, 0x06, 0xc5, 0xfe, 0x19, 0xf6, 0xab, 0xc1, 0x45, 0xa5, 0xe3, 0x93, 0x80,
-	0xa8, 0xab, 0x12, 0x55, 0x89, 0x50, 0x95, 0x08, 0xa5, 0x2d, 0xda, 0xc4, 0x26, 0x0c, 0x57, 0x0d,
-	0x7f, 0x71, 0x8a, 0xb6, 0x91, 0xa5, 0xb8, 0xe1, 0xa2, 0x36, 0x16, 0xc0, 0x5a, 0x16, 0xd0, 0xf4,
-	0x09, 0xa5, 0x6c, 0xd1, 0x68, 0xba, 0xc8, 0xa6, 0x82, 0xb3, 0x95, 0xc5, 0x89, 0x7e, 0x08, 0x6c,
-	0x25, 0x53, 0x3f, 0x53, 0xdd, 0x41, 0x3e, 0x6a, 0x47, 0xba, 0x1f, 0x65, 0xe1, 0x3b, 0xd8, 0xb3,
-	0x1c, 0xcf, 0x36, 0x3c, 0xe2, 0x99, 0x38, 0x62, 0xbc, 0x95, 0x99, 0x43, 0x1a, 0xc1, 0x1e, 0x66,
-	0x3a, 0xdd, 0xc1, 0x3e, 0x0a, 0x1c, 0xe2, 0x21, 0x57, 0xc0, 0xbf, 0x93, 0xe9, 0x37, 0xf1, 0x9a,
-	0x8e, 0xdf, 0x66, 0x8c, 0x7e, 0xf7, 0xd3, 0x52, 0xd3, 0x39, 0xb5, 0x79, 0xa4, 0x54, 0xfc, 0x19,
-	0x82, 0xed, 0xf8, 0x84, 0x34, 0xa9, 0xf8, 0x23, 0xb0, 0xcb, 0x26, 0xa1, 0x6d, 0x42, 0xab, 0x6d,
-	0x6a, 0x57, 0xcf, 0xb6, 0xc3, 0x3f, 0x7c, 0x61, 0xed, 0xbf, 0x0a, 0x2c, 0x1c, 0x51, 0xfb, 0xe3,
-	0x8e, 0x85, 0x02, 0x7c, 0x2c, 0x1c, 0x54, 0x8b, 0x30, 0x65, 0xfa, 0x18, 0x05, 0xc4, 0x2f, 0x2a,
-	0xf7, 0x94, 0xf2, 0x8c, 0x1e, 0x3d, 0xaa, 0x8f, 0x60, 0x91, 0xb8, 0x96, 0x11, 0x85, 0x62, 0x20,
-	0xcb, 0xf2, 0x31, 0xa5, 0xc5, 0x6f, 0x30, 0x98, 0x4a, 0x5c, 0x2b, 0x52, 0xf2, 0x84, 0xaf, 0x84,
-	0x0c, 0x0f, 0x9f, 0x0f, 0x32, 0x26, 0x38, 0xc3, 0xc3, 0xe7, 0x49, 0xc6, 0x73, 0xc8, 0x75, 0x99,
-	0x3f, 0x86, 0x8f, 0x11, 0x25, 0x5e, 0x71, 0xf2, 0x9e, 0x52, 0xce, 0xd7, 0xb6, 0x2b, 0x19, 0x75,
-	0x5c, 0x89, 0x94, 0xf0, 0x48, 0x74, 0x46, 0xd4, 0xe7, 0xba, 0xb1, 0xa7, 0x77, 0xe7, 0x7e, 0xf3,
-	0xf5, 0x17, 0x5b, 0x51, 0x24, 0x6b, 0xab, 0xb0, 0x32, 0x10, 0xb8, 0x8e, 0x69, 0x87, 0x78, 0x14,
-	0xaf, 0xfd, 0x53, 0x01, 0xf5, 0x88, 0xda, 0xcf, 0x49, 0x80, 0x9f, 0xba, 0xc4, 0x3c, 0x3d, 0xc0,
-	0xc8, 0xca, 0xcc, 0xcb, 0x0a, 0x4c, 0xf3, 0x6a, 0x74, 0x2c, 0x96, 0x8b, 0x09, 0x7d, 0x8a, 0x3d,
-	0x1f, 0x5a, 0xea, 0x5d, 0x80, 0x46, 0xa8, 0xc3, 0x68, 0x21, 0xda, 0x62, 0x61, 0xcf, 0xe9, 0x33,
-	0x4c, 0x72, 0x80, 0x68, 0x4b, 0x5d, 0x82, 0x5b, 0x2d, 0xec, 0xd8, 0xad, 0x80, 0x85, 0x39, 0xa1,
-	0x8b, 0x27, 0x75, 0x3f, 0x94, 0x87, 0x56, 0x8b, 0x37, 0xef, 0x29, 0xe5, 0xd9, 0xda, 0x66, 0x5a,
-	0xf8, 0x9d, 0x53, 0xb6, 0x91, 0xe1, 0x46, 0x73, 0x17, 0x77, 0x51, 0x80, 0x9e, 0x4e, 0x7e, 0xf9,
-	0xef, 0x37, 0x6f, 0xe8, 0x82, 0x9e, 0x08, 0xfb, 0xe7, 0xa0, 0x0d, 0x06, 0x16, 0xc5, 0xad, 0xbe,
-	0x05, 0xf9, 0x06, 0x72, 0x5d, 0x12, 0x18, 0x0c, 0x8f, 0x2d, 0x16, 0xe7, 0xb4, 0x9e, 0xe3, 0xd2,
-	0x1d, 0x2e, 0x0c, 0x61, 0x67, 0x24, 0xc0, 0x46, 0xd3, 0xf1, 0x90, 0xeb, 0x7c, 0x8a, 0x79, 0xcc,
-	0xd3, 0x7a, 0x2e, 0x94, 0xee, 0x45, 0xc2, 0xb5, 0xcf, 0x14, 0x58, 0x94, 0x39, 0xde, 0x09, 0x3d,
-	0x7f, 0xc6, 0x8a, 0x3d, 0x23, 0x8f, 0x3f, 0x82, 0x59, 0xb3, 0x07, 0x64, 0x6a, 0x67, 0x6b, 0xe5,
-	0xcc, 0x9d, 0x8f, 0x29, 0xd6, 0xe3, 0xe4, 0x44, 0xe0, 0x25, 0x78, 0x23, 0xcd, 0x17, 0xb9, 0xe5,
-	0x7f, 0x99, 0x84, 0x37, 0x7b, 0x05, 0xd1, 0x3b, 0xd0, 0xa3, 0xf9, 0x9d, 0xb1, 0xff, 0x65, 0x98,
-	0xb7, 0x11, 0x35, 0x3a, 0xbe, 0x63, 0x62, 0x23, 0x70, 0xcc, 0x53, 0xec, 0xb3, 0x2a, 0x98, 0xd4,
-	0xf3, 0x36, 0xa2, 0xcf, 0x42, 0xf1, 0x09, 0x93, 0x86, 0x69, 0x75, 0xbc, 0x06, 0xe9, 0x7a, 0x56,
-	0x84, 0x9b, 0x64, 0xb8, 0x9c, 0x90, 0x0a, 0xd8, 0x06, 0x14, 0x48, 0x37, 0xe8, 0xc3, 0xdd, 0xe4,
-	0xfa, 0x22, 0xb1, 0x00, 0x6e, 0xc1, 0xc2, 0x39, 0x0a, 0xcc, 0x96, 0xd1, 0x0d, 0x2e, 0x48, 0x04,
-	0xbd, 0xc5, 0xa0, 0x05, 0xb6, 0xf0, 0x71, 0x70, 0x41, 0x04, 0xf6, 0x3d, 0xd0, 0xa4, 0x52, 0x6a,
-	0xb6, 0xb0, 0xd5, 0x75, 0xb1, 0xe1, 0x78, 0x01, 0xf6, 0xcf, 0x90, 0x5b, 0x9c, 0x62, 0x21, 0x15,
-	0x23, 0x44, 0x5d, 0x00, 0x0e, 0xc5, 0xba, 0xfa, 0x3e, 0xac, 0x0e, 0xb2, 0x5d, 0x42, 0x4e, 0x51,
-	0x58, 0x84, 0xc5, 0x69, 0x46, 0x5f, 0x49, 0xd2, 0x3f, 0x8c, 0x00, 0x6a, 0x13, 0x6e, 0xa7, 0x34,
-	0xc5, 0xe2, 0x0c, 0xdb, 0xfe, 0x6a, 0xf6, 0xf6, 0xc7, 0x78, 0x7c, 0x9b, 0x44, 0xfd, 0xab, 0xe6,
-	0xc0, 0x8a, 0xfa, 0x18, 0x96, 0x2c, 0x87, 0xa2, 0x86, 0x8b, 0x8d, 0x80, 0x52, 0x83, 0x9f, 0x4b,
-	0x6a, 0x22, 0xaf, 0x08, 0xac, 0x80, 0x6f, 0x8b, 0xd5, 0x13, 0x4a, 0xd9, 0xf1, 0xa8, 0x9b, 0x28,
-	0xd9, 0x37, 0x36, 0x61, 0x63, 0x48, 0x99, 0xc8, 0x92, 0xfa, 0x29, 0x2b, 0x7f, 0x1d, 0xb7, 0xc9,
-	0x19, 0x7e, 0xd5, 0x32, 0x4a, 0xad, 0xe6, 0x01, 0xd5, 0xd2, 0xf4, 0x3f, 0x14, 0xc8, 0x1f, 0x51,
-	0xfb, 0x89, 0x65, 0x8d, 0xd0, 0xd4, 0x37, 0x61, 0xfe, 0x8a, 0x86, 0x5e, 0x20, 0x89, 0xde, 0xfc,
-	0x2e, 0xac, 0xb0, 0x2d, 0x70, 0x1d, 0xec, 0x05, 0x86, 0xed, 0x23, 0x2f, 0xc0, 0xd8, 0xe8, 0x74,
-	0x1b, 0xa7, 0xf8, 0x52, 0xb4, 0xf4, 0xe5, 0x1e, 0x60, 0x9f, 0xaf, 0x3f, 0x63, 0xcb, 0xea, 0x36,
-	0xdc, 0x41, 0x96, 0x65, 0x78, 0xc4, 0xc2, 0x06, 0x32, 0x4d, 0xd2, 0xf5, 0x02, 0x83, 0x78, 0xee,
-	0x25, 0xab, 0xf2, 0x69, 0x5d, 0x45, 0x96, 0xf5, 0x11, 0xb1, 0xf0, 0x13, 0xbe, 0x74, 0xec, 0xb9,
-	0x97, 0x89, 0xa0, 0x8b, 0xb0, 0xd4, 0x1f, 0x93, 0x0c, 0xb7, 0xc9, 0x6e, 0x31, 0x9e, 0x8e, 0xd7,
-	0x1a, 0x70, 0xea, 0xa5, 0xd1, 0x6f, 0x47, 0x3a, 0xf1, 0x47, 0x05, 0xe6, 0x64, 0x6f, 0x45, 0x6d,
-	0x7c, 0xbd, 0x76, 0xb1, 0x1f, 0x5e, 0x17, 0xa8, 0x1d, 0x1e, 0xbe, 0x26, 0x61, 0x29, 0x9d, 0xad,
-	0xad, 0x65, 0x9e, 0x00, 0x66, 0x4c, 0x14, 0xfd, 0x0c, 0xe3, 0x1e, 0x7a, 0x4d, 0x92, 0xf0, 0x7c,
-	0x89, 0xd5, 0xa2, 0xf4, 0x4d, 0x3a, 0x7d, 0x0c, 0x05, 0x59, 0xce, 0x3f, 0xc6, 0x97, 0x36, 0xf6,
-	0x32, 0xdc, 0x5e, 0x84, 0x9b, 0xec, 0xc8, 0x08, 0x9f, 0xf9, 0x43, 0xc2, 0xd0, 0x0a, 0x2c, 0x27,
-	0x14, 0x4a, 0x5b, 0x7f, 0x55, 0xe0, 0x36, 0x4b, 0x1f, 0xc5, 0x01, 0x2b, 0xda, 0x8f, 0xd8, 0x20,
-	0x76, 0xbd, 0x3c, 0xad, 0x43, 0x81, 0x2f, 0xb1, 0x69, 0xce, 0x70, 0xc9, 0x39, 0x4b, 0xd6, 0x84,
-	0x9e, 0x33, 0xa5, 0xea, 0x0f, 0xc9, 0x79, 0xd8, 0x7e, 0xe3, 0xb8, 0x96, 0x63, 0xb7, 0xc4, 0x4d,
-	0x9b, 0xef, 0x01, 0x0f, 0x1c, 0xbb, 0x95, 0x88, 0xe3, 0x2e, 0xac, 0xa6, 0xf8, 0x2a, 0x63, 0xf9,
-	0xbb, 0x02, 0x20, 0x12, 0x7a, 0x52, 0xaf, 0x67, 0x84, 0x70, 0x17, 0x20, 0x6c, 0x35, 0xe2, 0x88,
-	0xf0, 0x2a, 0x9b, 0x09, 0x28, 0x15, 0x87, 0xe2, 0x01, 0xa8, 0xa7, 0x2c, 0x4b, 0x46, 0xb8, 0xb1,
-	0x86, 0x18, 0x05, 0x78, 0x24, 0xf3, 0x7c, 0xe5, 0x13, 0x1c, 0xa0, 0x03, 0x3e, 0x14, 0xec, 0xc2,
-	0x2d, 0x1a, 0xa0, 0xa0, 0x4b, 0xc5, 0x4c, 0xf4, 0xe0, 0xaa, 0xa1, 0x40, 0x4c, 0x8a, 0x3a, 0x36,
-	0xb1, 0x73, 0x86, 0xeb, 0x8c, 0xa3, 0x0b, 0x6e, 0x22, 0xd0, 0xdf, 0xf5, 0x66, 0x9d, 0x93, 0x7a,
-	0xfd, 0xff, 0x33, 0x0a, 0x84, 0x30, 0x11, 0x26, 0xed, 0x9a, 0x66, 0x34, 0xff, 0x4d, 0xeb, 0x39,
-	0x2e, 0xad, 0x73, 0xe1, 0xda, 0x6f, 0x15, 0xc8, 0x1d, 0x51, 0xfb, 0x03, 0x2f, 0x6c, 0xc2, 0x3b,
-	0x3b, 0x27, 0x3f, 0xc9, 0x48, 0xec, 0x7d, 0xc8, 0x61, 0x86, 0x3b, 0xe4, 0xb7, 0x63, 0x64, 0xb8,
-	0x4f, 0xa8, 0xae, 0x43, 0x9e, 0x0b, 0x8e, 0xc5, 0xe5, 0x23, 0x0c, 0x27, 0xa4, 0x89, 0x9c, 0x2c,
-	0xc3, 0x9d, 0x3e, 0x37, 0xe4, 0xb6, 0x7f, 0xc6, 0xfb, 0xea, 0x2e, 0xbf, 0x26, 0x86, 0x78, 0xb8,
-	0x0e, 0x79, 0x71, 0x9f, 0xf4, 0xbb, 0x98, 0x90, 0xaa, 0x65, 0x28, 0x08, 0x49, 0xc2, 0xc9, 0xa4,
-	0x38, 0xb5, 0x1f, 0xc6, 0x7c, 0x91, 0x6e, 0xfe, 0x4d, 0x81, 0x92, 0x3c, 0x85, 0xfb, 0x62, 0xca,
-	0x38, 0xf4, 0x42, 0x22, 0xc5, 0x7b, 0xe1, 0xbb, 0x58, 0x86, 0xdb, 0x1e, 0xdc, 0xb1, 0xd3, 0x28,
-	0x62, 0x1a, 0xab, 0x65, 0x36, 0xa3, 0x54, 0x63, 0xa2, 0x39, 0xa5, 0xab, 0x4d, 0x04, 0x55, 0x86,
-	0xf5, 0x6c, 0xcf, 0x7b, 0x13, 0x9b, 0x12, 0x1f, 0xe1, 0x7b, 0x57, 0xf1, 0xb0, 0xf8, 0x7e, 0x06,
-	0x0b, 0xb1, 0x17, 0x36, 0xfe, 0x6a, 0x2a, 0x62, 0x7b, 0x98, 0xfd, 0x8e, 0x91, 0xb0, 0x21, 0xc2,
-	0x9a, 0x27, 0x09, 0x79, 0x22, 0xa2, 0x6f, 0xc1, 0x37, 0xaf, 0x74, 0x53, 0x06, 0x63, 0xb0, 0xb9,
-	0x5c, 0xec, 0xe5, 0x1e, 0xa2, 0x41, 0x7c, 0xae, 0x79, 0x1d, 0x13, 0xc3, 0x7d, 0x58, 0xbb, 0xda,
-	0x80, 0x74, 0xa3, 0x15, 0x9b, 0xd8, 0x9f, 0xd7, 0xc2, 0xce, 0xb3, 0xe7, 0x92, 0xf3, 0xac, 0x6c,
-	0x96, 0xa1, 0xe0, 0x50, 0x0e, 0xe5, 0xe7, 0x25, 0xaa, 0xf2, 0xa4, 0x38, 0x63, 0x1e, 0x8f, 0x59,
-	0x8a, 0x3c, 0xa9, 0xfd, 0x7e, 0x01, 0x26, 0x8e, 0xa8, 0xad, 0x12, 0x98, 0x8d, 0x4f, 0x31, 0x6f,
-	0x67, 0xee, 0x50, 0xff, 0x78, 0xa0, 0x3d, 0x1e, 0x03, 0x2c, 0x1b, 0xdf, 0x05, 0xe4, 0x13, 0x83,
-	0x44, 0x65, 0x98, 0x9a, 0x7e, 0xbc, 0xf6, 0xdd, 0xf1, 0xf0, 0x71, 0xcb, 0x89, 0x17, 0xf1, 0xa1,
-	0x96, 0xfb, 0xf1, 0xc3, 0x2d, 0xa7, 0xbf, 0xef, 0xaa, 0xbf, 0x56, 0x60, 0x61, 0xf0, 0x35, 0x6d,
-	0x7b, 0x34, 0x6d, 0x31, 0x8a, 0xf6, 0xfd, 0xb1, 0x29, 0x7d, 0x3e, 0x0c, 0xce, 0xca, 0xdb, 0xa3,
-	0xe5, 0x72, 0x2c, 0x1f, 0xae, 0x1c, 0x9b, 0x55, 0x07, 0x66, 0x7a, 0xe3, 0xdb, 0xe6, 0x30, 0x3d,
-	0x12, 0xaa, 0x6d, 0x8f, 0x0c, 0x95, 0xa6, 0x7c, 0x98, 0xeb, 0x9b, 0xba, 0x1e, 0x8c, 0x96, 0x39,
-	0x8e, 0xd6, 0xbe, 0x3d, 0x0e, 0x5a, 0xda, 0xfc, 0x05, 0x14, 0x92, 0x9f, 0x34, 0xaa, 0xa3, 0x79,
-	0x2e, 0x09, 0xda, 0x3b, 0x63, 0x12, 0xa4, 0xf1, 0x5f, 0xc2, 0xfc, 0xc0, 0xe4, 0xf7, 0x68, 0xf8,
-	0x56, 0xf5, 0x33, 0xb4, 0xef, 0x8d, 0xcb, 0x90, 0xf6, 0x4d, 0x98, 0x8a, 0xa6, 0xb5, 0x8d, 0x51,
-	0x62, 0x38, 0xa9, 0xd7, 0xb5, 0xea, 0x88, 0x40, 0x69, 0xc4, 0x05, 0x88, 0x0d, 0x2f, 0x5b, 0xc3,
-	0xe8, 0x3d, 0xac, 0x56, 0x1b, 0x1d, 0x2b, 0xad, 0x11, 0x98, 0x8d, 0x4f, 0x22, 0x43, 0x7b, 0x63,
-	0x0c, 0x3c, 0xbc, 0x37, 0xa6, 0xcc, 0x15, 0xea, 0x1f, 0x14, 0x58, 0xbe, 0xea, 0x8e, 0x7a, 0x67,
-	0x44, 0x85, 0x49, 0xa2, 0xf6, 0xc3, 0x6b, 0x12, 0xa5, 0x57, 0x7f, 0x52, 0x60, 0x35, 0x6b, 0xd4,
-	0xf9, 0xc1, 0x68, 0x87, 0x25, 0x95, 0xac, 0xed, 0xbc, 0x02, 0x59, 0x7a, 0xf8, 0xb9, 0x02, 0x4b,
-	0x57, 0xcc, 0x29, 0xa3, 0xb6, 0xec, 0x04, 0x4f, 0x7b, 0xff, 0x7a, 0x3c, 0xe9, 0xd2, 0x9f, 0x15,
-	0x78, 0x23, 0xf3, 0x63, 0xd7, 0x7b, 0x63, 0x1b, 0x88, 0x37, 0xe1, 0xdd, 0x57, 0x61, 0xa7, 0xdc,
-	0x4b, 0xf1, 0x61, 0x64, 0xc4, 0x7b, 0x29, 0x46, 0x19, 0xf5, 0x5e, 0x4a, 0x19, 0x44, 0xb4, 0x9b,
-	0xbf, 0xfa, 0xfa, 0x8b, 0x2d, 0xe5, 0xe9, 0x07, 0x5f, 0xbe, 0x28, 0x29, 0x5f, 0xbd, 0x28, 0x29,
-	0xff, 0x79, 0x51, 0x52, 0x3e, 0x7f, 0x59, 0xba, 0xf1, 0xd5, 0xcb, 0xd2, 0x8d, 0x7f, 0xbd, 0x2c,
-	0xdd, 0xf8, 0xe4, 0x6d, 0xdb, 0x09, 0x5a, 0xdd, 0x46, 0xc5, 0x24, 0x6d, 0xf6, 0x25, 0xfe, 0x21,
-	0xff, 0x28, 0xef, 0x11, 0x0b, 0x57, 0x2f, 0x62, 0xff, 0x4b, 0xb8, 0xec, 0x60, 0xda, 0xb8, 0xc5,
-	0xbe, 0xbb, 0x3f, 0xfe, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9b, 0xbe, 0xdc, 0xff, 0xbb, 0x19,
-	0x00, 0x00,
+func (m *MsgProposeObserverSetChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeObserverSetChange.Merge(m, src)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	AddObserver(ctx context.Context, in *MsgAddObserver, opts ...grpc.CallOption) (*MsgAddObserverResponse, error)
-	RemoveObserver(ctx context.Context, in *MsgRemoveObserver, opts ...grpc.CallOption) (*MsgRemoveObserverResponse, error)
-	UpdateObserver(ctx context.Context, in *MsgUpdateObserver, opts ...grpc.CallOption) (*MsgUpdateObserverResponse, error)
-	UpdateChainParams(ctx context.Context, in *MsgUpdateChainParams, opts ...grpc.CallOption) (*MsgUpdateChainParamsResponse, error)
-	RemoveChainParams(ctx context.Context, in *MsgRemoveChainParams, opts ...grpc.CallOption) (*MsgRemoveChainParamsResponse, error)
-	VoteBlame(ctx context.Context, in *MsgVoteBlame, opts ...grpc.CallOption) (*MsgVoteBlameResponse, error)
-	UpdateKeygen(ctx context.Context, in *MsgUpdateKeygen, opts ...grpc.CallOption) (*MsgUpdateKeygenResponse, error)
-	VoteBlockHeader(ctx context.Context, in *MsgVoteBlockHeader, opts ...grpc.CallOption) (*MsgVoteBlockHeaderResponse, error)
-	ResetChainNonces(ctx context.Context, in *MsgResetChainNonces, opts ...grpc.CallOption) (*MsgResetChainNoncesResponse, error)
-	VoteTSS(ctx context.Context, in *MsgVoteTSS, opts ...grpc.CallOption) (*MsgVoteTSSResponse, error)
-	EnableCCTX(ctx context.Context, in *MsgEnableCCTX, opts ...grpc.CallOption) (*MsgEnableCCTXResponse, error)
-	DisableCCTX(ctx context.Context, in *MsgDisableCCTX, opts ...grpc.CallOption) (*MsgDisableCCTXResponse, error)
-	DisableFastConfirmation(ctx context.Context, in *MsgDisableFastConfirmation, opts ...grpc.CallOption) (*MsgDisableFastConfirmationResponse, error)
-	UpdateGasPriceIncreaseFlags(ctx context.Context, in *MsgUpdateGasPriceIncreaseFlags, opts ...grpc.CallOption) (*MsgUpdateGasPriceIncreaseFlagsResponse, error)
-	UpdateOperationalFlags(ctx context.Context, in *MsgUpdateOperationalFlags, opts ...grpc.CallOption) (*MsgUpdateOperationalFlagsResponse, error)
-	UpdateOperationalChainParams(ctx context.Context, in *MsgUpdateOperationalChainParams, opts ...grpc.CallOption) (*MsgUpdateOperationalChainParamsResponse, error)
-	UpdateV2ZetaFlows(ctx context.Context, in *MsgUpdateV2ZetaFlows, opts ...grpc.CallOption) (*MsgUpdateV2ZetaFlowsResponse, error)
+func (m *MsgProposeObserverSetChange) XXX_Size() int {
+	return m.Size()
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgProposeObserverSetChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeObserverSetChange.DiscardUnknown(m)
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
-}
+var xxx_messageInfo_MsgProposeObserverSetChange proto.InternalMessageInfo
 
-func (c *msgClient) AddObserver(ctx context.Context, in *MsgAddObserver, opts ...grpc.CallOption) (*MsgAddObserverResponse, error) {
-	out := new(MsgAddObserverResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/AddObserver", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgProposeObserverSetChange) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RemoveObserver(ctx context.Context, in *MsgRemoveObserver, opts ...grpc.CallOption) (*MsgRemoveObserverResponse, error) {
-	out := new(MsgRemoveObserverResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/RemoveObserver", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgProposeObserverSetChange) GetAdditions() []ObserverSetAddition {
+	if m != nil {
+		return m.Additions
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) UpdateObserver(ctx context.Context, in *MsgUpdateObserver, opts ...grpc.CallOption) (*MsgUpdateObserverResponse, error) {
-	out := new(MsgUpdateObserverResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/UpdateObserver", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgProposeObserverSetChange) GetRemovals() []string {
+	if m != nil {
+		return m.Removals
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) UpdateChainParams(ctx context.Context, in *MsgUpdateChainParams, opts ...grpc.CallOption) (*MsgUpdateChainParamsResponse, error) {
-	out := new(MsgUpdateChainParamsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/UpdateChainParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgProposeObserverSetChange) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) RemoveChainParams(ctx context.Context, in *MsgRemoveChainParams, opts ...grpc.CallOption) (*MsgRemoveChainParamsResponse, error) {
-	out := new(MsgRemoveChainParamsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/RemoveChainParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgProposeObserverSetChange) GetEddsa() bool {
+	if m != nil {
+		return m.Eddsa
 	}
-	return out, nil
+	return false
 }
 
-func (c *msgClient) VoteBlame(ctx context.Context, in *MsgVoteBlame, opts ...grpc.CallOption) (*MsgVoteBlameResponse, error) {
-	out := new(MsgVoteBlameResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/VoteBlame", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgProposeObserverSetChangeResponse struct {
 }
 
-func (c *msgClient) UpdateKeygen(ctx context.Context, in *MsgUpdateKeygen, opts ...grpc.CallOption) (*MsgUpdateKeygenResponse, error) {
-	out := new(MsgUpdateKeygenResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/UpdateKeygen", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgProposeObserverSetChangeResponse) Reset()         { *m = MsgProposeObserverSetChangeResponse{} }
+func (m *MsgProposeObserverSetChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeObserverSetChangeResponse) ProtoMessage()    {}
+func (*MsgProposeObserverSetChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6e3b1d16a4021, []int{35}
 }
-
-func (c *msgClient) VoteBlockHeader(ctx context.Context, in *MsgVoteBlockHeader, opts ...grpc.CallOption) (*MsgVoteBlockHeaderResponse, error) {
-	out := new(MsgVoteBlockHeaderResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/VoteBlockHeader", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgProposeObserverSetChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) ResetChainNonces(ctx context.Context, in *MsgResetChainNonces, opts ...grpc.CallOption) (*MsgResetChainNoncesResponse, error) {
-	out := new(MsgResetChainNoncesResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/ResetChainNonces", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgProposeObserverSetChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeObserverSetChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgProposeObserverSetChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeObserverSetChangeResponse.Merge(m, src)
+}
+func (m *MsgProposeObserverSetChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeObserverSetChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeObserverSetChangeResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) VoteTSS(ctx context.Context, in *MsgVoteTSS, opts ...grpc.CallOption) (*MsgVoteTSSResponse, error) {
-	out := new(MsgVoteTSSResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/VoteTSS", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgProposeObserverSetChangeResponse proto.InternalMessageInfo
+
+// MsgUnjailObserver unjails an observer jailed for missing ballots, once the
+// jail period is over
+type MsgUnjailObserver struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
-func (c *msgClient) EnableCCTX(ctx context.Context, in *MsgEnableCCTX, opts ...grpc.CallOption) (*MsgEnableCCTXResponse, error) {
-	out := new(MsgEnableCCTXResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/EnableCCTX", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUnjailObserver) Reset()         { *m = MsgUnjailObserver{} }
+func (m *MsgUnjailObserver) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailObserver) ProtoMessage()    {}
+func (*MsgUnjailObserver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6e3b1d16a4021, []int{36}
+}
+func (m *MsgUnjailObserver) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailObserver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailObserver.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUnjailObserver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailObserver.Merge(m, src)
+}
+func (m *MsgUnjailObserver) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailObserver) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailObserver.DiscardUnknown(m)
 }
 
-func (c *msgClient) DisableCCTX(ctx context.Context, in *MsgDisableCCTX, opts ...grpc.CallOption) (*MsgDisableCCTXResponse, error) {
-	out := new(MsgDisableCCTXResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/DisableCCTX", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUnjailObserver proto.InternalMessageInfo
+
+func (m *MsgUnjailObserver) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DisableFastConfirmation(ctx context.Context, in *MsgDisableFastConfirmation, opts ...grpc.CallOption) (*MsgDisableFastConfirmationResponse, error) {
-	out := new(MsgDisableFastConfirmationResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/DisableFastConfirmation", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgUnjailObserverResponse struct {
 }
 
-func (c *msgClient) UpdateGasPriceIncreaseFlags(ctx context.Context, in *MsgUpdateGasPriceIncreaseFlags, opts ...grpc.CallOption) (*MsgUpdateGasPriceIncreaseFlagsResponse, error) {
-	out := new(MsgUpdateGasPriceIncreaseFlagsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/UpdateGasPriceIncreaseFlags", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUnjailObserverResponse) Reset()         { *m = MsgUnjailObserverResponse{} }
+func (m *MsgUnjailObserverResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailObserverResponse) ProtoMessage()    {}
+func (*MsgUnjailObserverResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6e3b1d16a4021, []int{37}
+}
+func (m *MsgUnjailObserverResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailObserverResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailObserverResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUnjailObserverResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailObserverResponse.Merge(m, src)
+}
+func (m *MsgUnjailObserverResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailObserverResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailObserverResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateOperationalFlags(ctx context.Context, in *MsgUpdateOperationalFlags, opts ...grpc.CallOption) (*MsgUpdateOperationalFlagsResponse, error) {
-	out := new(MsgUpdateOperationalFlagsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/UpdateOperationalFlags", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgUnjailObserverResponse proto.InternalMessageInfo
+
+// MsgUpdateParentRevertInheritance updates the isParentRevertInheritanceEnabled
+// crosschain flag
+type MsgUpdateParentRevertInheritance struct {
+	Creator                          string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	IsParentRevertInheritanceEnabled bool   `protobuf:"varint,2,opt,name=isParentRevertInheritanceEnabled,proto3" json:"isParentRevertInheritanceEnabled,omitempty"`
 }
 
-func (c *msgClient) UpdateOperationalChainParams(ctx context.Context, in *MsgUpdateOperationalChainParams, opts ...grpc.CallOption) (*MsgUpdateOperationalChainParamsResponse, error) {
-	out := new(MsgUpdateOperationalChainParamsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/UpdateOperationalChainParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParentRevertInheritance) Reset()         { *m = MsgUpdateParentRevertInheritance{} }
+func (m *MsgUpdateParentRevertInheritance) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParentRevertInheritance) ProtoMessage()    {}
+func (*MsgUpdateParentRevertInheritance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6e3b1d16a4021, []int{38}
+}
+func (m *MsgUpdateParentRevertInheritance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParentRevertInheritance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParentRevertInheritance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateParentRevertInheritance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParentRevertInheritance.Merge(m, src)
+}
+func (m *MsgUpdateParentRevertInheritance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParentRevertInheritance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParentRevertInheritance.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateV2ZetaFlows(ctx context.Context, in *MsgUpdateV2ZetaFlows, opts ...grpc.CallOption) (*MsgUpdateV2ZetaFlowsResponse, error) {
-	out := new(MsgUpdateV2ZetaFlowsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/UpdateV2ZetaFlows", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateParentRevertInheritance proto.InternalMessageInfo
+
+func (m *MsgUpdateParentRevertInheritance) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	AddObserver(context.Context, *MsgAddObserver) (*MsgAddObserverResponse, error)
-	RemoveObserver(context.Context, *MsgRemoveObserver) (*MsgRemoveObserverResponse, error)
-	UpdateObserver(context.Context, *MsgUpdateObserver) (*MsgUpdateObserverResponse, error)
-	UpdateChainParams(context.Context, *MsgUpdateChainParams) (*MsgUpdateChainParamsResponse, error)
-	RemoveChainParams(context.Context, *MsgRemoveChainParams) (*MsgRemoveChainParamsResponse, error)
-	VoteBlame(context.Context, *MsgVoteBlame) (*MsgVoteBlameResponse, error)
-	UpdateKeygen(context.Context, *MsgUpdateKeygen) (*MsgUpdateKeygenResponse, error)
-	VoteBlockHeader(context.Context, *MsgVoteBlockHeader) (*MsgVoteBlockHeaderResponse, error)
-	ResetChainNonces(context.Context, *MsgResetChainNonces) (*MsgResetChainNoncesResponse, error)
-	VoteTSS(context.Context, *MsgVoteTSS) (*MsgVoteTSSResponse, error)
-	EnableCCTX(context.Context, *MsgEnableCCTX) (*MsgEnableCCTXResponse, error)
-	DisableCCTX(context.Context, *MsgDisableCCTX) (*MsgDisableCCTXResponse, error)
-	DisableFastConfirmation(context.Context, *MsgDisableFastConfirmation) (*MsgDisableFastConfirmationResponse, error)
-	UpdateGasPriceIncreaseFlags(context.Context, *MsgUpdateGasPriceIncreaseFlags) (*MsgUpdateGasPriceIncreaseFlagsResponse, error)
-	UpdateOperationalFlags(context.Context, *MsgUpdateOperationalFlags) (*MsgUpdateOperationalFlagsResponse, error)
-	UpdateOperationalChainParams(context.Context, *MsgUpdateOperationalChainParams) (*MsgUpdateOperationalChainParamsResponse, error)
-	UpdateV2ZetaFlows(context.Context, *MsgUpdateV2ZetaFlows) (*MsgUpdateV2ZetaFlowsResponse, error)
+func (m *MsgUpdateParentRevertInheritance) GetIsParentRevertInheritanceEnabled() bool {
+	if m != nil {
+		return m.IsParentRevertInheritanceEnabled
+	}
+	return false
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgUpdateParentRevertInheritanceResponse struct {
 }
 
-func (*UnimplementedMsgServer) AddObserver(ctx context.Context, req *MsgAddObserver) (*MsgAddObserverResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddObserver not implemented")
-}
-func (*UnimplementedMsgServer) RemoveObserver(ctx context.Context, req *MsgRemoveObserver) (*MsgRemoveObserverResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveObserver not implemented")
+func (m *MsgUpdateParentRevertInheritanceResponse) Reset() {
+	*m = MsgUpdateParentRevertInheritanceResponse{}
 }
-func (*UnimplementedMsgServer) UpdateObserver(ctx context.Context, req *MsgUpdateObserver) (*MsgUpdateObserverResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateObserver not implemented")
-}
-func (*UnimplementedMsgServer) UpdateChainParams(ctx context.Context, req *MsgUpdateChainParams) (*MsgUpdateChainParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateChainParams not implemented")
+func (m *MsgUpdateParentRevertInheritanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParentRevertInheritanceResponse) ProtoMessage()    {}
+func (*MsgUpdateParentRevertInheritanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6e3b1d16a4021, []int{39}
 }
-func (*UnimplementedMsgServer) RemoveChainParams(ctx context.Context, req *MsgRemoveChainParams) (*MsgRemoveChainParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveChainParams not implemented")
+func (m *MsgUpdateParentRevertInheritanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) VoteBlame(ctx context.Context, req *MsgVoteBlame) (*MsgVoteBlameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VoteBlame not implemented")
+func (m *MsgUpdateParentRevertInheritanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParentRevertInheritanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateKeygen(ctx context.Context, req *MsgUpdateKeygen) (*MsgUpdateKeygenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateKeygen not implemented")
+func (m *MsgUpdateParentRevertInheritanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParentRevertInheritanceResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) VoteBlockHeader(ctx context.Context, req *MsgVoteBlockHeader) (*MsgVoteBlockHeaderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VoteBlockHeader not implemented")
+func (m *MsgUpdateParentRevertInheritanceResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) ResetChainNonces(ctx context.Context, req *MsgResetChainNonces) (*MsgResetChainNoncesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResetChainNonces not implemented")
+func (m *MsgUpdateParentRevertInheritanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParentRevertInheritanceResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) VoteTSS(ctx context.Context, req *MsgVoteTSS) (*MsgVoteTSSResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VoteTSS not implemented")
+
+var xxx_messageInfo_MsgUpdateParentRevertInheritanceResponse proto.InternalMessageInfo
+
+// MsgCancelObserverSetChange rolls back the observer set change in progress,
+// as long as the TSS generated for the new observer set is not the current TSS
+type MsgCancelObserverSetChange struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 }
-func (*UnimplementedMsgServer) EnableCCTX(ctx context.Context, req *MsgEnableCCTX) (*MsgEnableCCTXResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EnableCCTX not implemented")
+
+func (m *MsgCancelObserverSetChange) Reset()         { *m = MsgCancelObserverSetChange{} }
+func (m *MsgCancelObserverSetChange) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelObserverSetChange) ProtoMessage()    {}
+func (*MsgCancelObserverSetChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6e3b1d16a4021, []int{40}
 }
-func (*UnimplementedMsgServer) DisableCCTX(ctx context.Context, req *MsgDisableCCTX) (*MsgDisableCCTXResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisableCCTX not implemented")
+func (m *MsgCancelObserverSetChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) DisableFastConfirmation(ctx context.Context, req *MsgDisableFastConfirmation) (*MsgDisableFastConfirmationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisableFastConfirmation not implemented")
+func (m *MsgCancelObserverSetChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelObserverSetChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateGasPriceIncreaseFlags(ctx context.Context, req *MsgUpdateGasPriceIncreaseFlags) (*MsgUpdateGasPriceIncreaseFlagsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateGasPriceIncreaseFlags not implemented")
+func (m *MsgCancelObserverSetChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelObserverSetChange.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateOperationalFlags(ctx context.Context, req *MsgUpdateOperationalFlags) (*MsgUpdateOperationalFlagsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateOperationalFlags not implemented")
+func (m *MsgCancelObserverSetChange) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UpdateOperationalChainParams(ctx context.Context, req *MsgUpdateOperationalChainParams) (*MsgUpdateOperationalChainParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateOperationalChainParams not implemented")
+func (m *MsgCancelObserverSetChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelObserverSetChange.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) UpdateV2ZetaFlows(ctx context.Context, req *MsgUpdateV2ZetaFlows) (*MsgUpdateV2ZetaFlowsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateV2ZetaFlows not implemented")
+
+var xxx_messageInfo_MsgCancelObserverSetChange proto.InternalMessageInfo
+
+func (m *MsgCancelObserverSetChange) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+type MsgCancelObserverSetChangeResponse struct {
 }
 
-func _Msg_AddObserver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddObserver)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddObserver(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Msg/AddObserver",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddObserver(ctx, req.(*MsgAddObserver))
+func (m *MsgCancelObserverSetChangeResponse) Reset()         { *m = MsgCancelObserverSetChangeResponse{} }
+func (m *MsgCancelObserverSetChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelObserverSetChangeResponse) ProtoMessage()    {}
+func (*MsgCancelObserverSetChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6e3b1d16a4021, []int{41}
+}
+func (m *MsgCancelObserverSetChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelObserverSetChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelObserverSetChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCancelObserverSetChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelObserverSetChangeResponse.Merge(m, src)
+}
+func (m *MsgCancelObserverSetChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelObserverSetChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelObserverSetChangeResponse.DiscardUnknown(m)
 }
 
-func _Msg_RemoveObserver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveObserver)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveObserver(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Msg/RemoveObserver",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveObserver(ctx, req.(*MsgRemoveObserver))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgCancelObserverSetChangeResponse proto.InternalMessageInfo
+
+// MsgUpdateLivenessParams updates the parameters of the tracking of the
+// participation of the observers in the ballots
+type MsgUpdateLivenessParams struct {
+	Creator        string         `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	LivenessParams LivenessParams `protobuf:"bytes,2,opt,name=liveness_params,json=livenessParams,proto3" json:"liveness_params"`
 }
 
-func _Msg_UpdateObserver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateObserver)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateObserver(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Msg/UpdateObserver",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateObserver(ctx, req.(*MsgUpdateObserver))
+func (m *MsgUpdateLivenessParams) Reset()         { *m = MsgUpdateLivenessParams{} }
+func (m *MsgUpdateLivenessParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateLivenessParams) ProtoMessage()    {}
+func (*MsgUpdateLivenessParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6e3b1d16a4021, []int{42}
+}
+func (m *MsgUpdateLivenessParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateLivenessParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateLivenessParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateLivenessParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateLivenessParams.Merge(m, src)
+}
+func (m *MsgUpdateLivenessParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateLivenessParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateLivenessParams.DiscardUnknown(m)
 }
 
-func _Msg_UpdateChainParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateChainParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateChainParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Msg/UpdateChainParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateChainParams(ctx, req.(*MsgUpdateChainParams))
+var xxx_messageInfo_MsgUpdateLivenessParams proto.InternalMessageInfo
+
+func (m *MsgUpdateLivenessParams) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RemoveChainParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveChainParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveChainParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Msg/RemoveChainParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveChainParams(ctx, req.(*MsgRemoveChainParams))
+func (m *MsgUpdateLivenessParams) GetLivenessParams() LivenessParams {
+	if m != nil {
+		return m.LivenessParams
 	}
-	return interceptor(ctx, in, info, handler)
+	return LivenessParams{}
 }
 
-func _Msg_VoteBlame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgVoteBlame)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).VoteBlame(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Msg/VoteBlame",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).VoteBlame(ctx, req.(*MsgVoteBlame))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgUpdateLivenessParamsResponse struct {
 }
 
-func _Msg_UpdateKeygen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateKeygen)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateKeygen(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Msg/UpdateKeygen",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateKeygen(ctx, req.(*MsgUpdateKeygen))
+func (m *MsgUpdateLivenessParamsResponse) Reset()         { *m = MsgUpdateLivenessParamsResponse{} }
+func (m *MsgUpdateLivenessParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateLivenessParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateLivenessParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6e3b1d16a4021, []int{43}
+}
+func (m *MsgUpdateLivenessParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateLivenessParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateLivenessParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateLivenessParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateLivenessParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateLivenessParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateLivenessParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateLivenessParamsResponse.DiscardUnknown(m)
 }
 
-func _Msg_VoteBlockHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgVoteBlockHeader)
-	if err := dec(in); err != nil {
+var xxx_messageInfo_MsgUpdateLivenessParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateObserver)(nil), "zetachain.zetacore.observer.MsgUpdateObserver")
+	proto.RegisterType((*MsgUpdateObserverResponse)(nil), "zetachain.zetacore.observer.MsgUpdateObserverResponse")
+	proto.RegisterType((*MsgVoteBlockHeader)(nil), "zetachain.zetacore.observer.MsgVoteBlockHeader")
+	proto.RegisterType((*MsgVoteBlockHeaderResponse)(nil), "zetachain.zetacore.observer.MsgVoteBlockHeaderResponse")
+	proto.RegisterType((*MsgUpdateChainParams)(nil), "zetachain.zetacore.observer.MsgUpdateChainParams")
+	proto.RegisterType((*MsgUpdateChainParamsResponse)(nil), "zetachain.zetacore.observer.MsgUpdateChainParamsResponse")
+	proto.RegisterType((*MsgUpdateOperationalChainParams)(nil), "zetachain.zetacore.observer.MsgUpdateOperationalChainParams")
+	proto.RegisterType((*MsgUpdateOperationalChainParamsResponse)(nil), "zetachain.zetacore.observer.MsgUpdateOperationalChainParamsResponse")
+	proto.RegisterType((*MsgRemoveChainParams)(nil), "zetachain.zetacore.observer.MsgRemoveChainParams")
+	proto.RegisterType((*MsgRemoveChainParamsResponse)(nil), "zetachain.zetacore.observer.MsgRemoveChainParamsResponse")
+	proto.RegisterType((*MsgAddObserver)(nil), "zetachain.zetacore.observer.MsgAddObserver")
+	proto.RegisterType((*MsgAddObserverResponse)(nil), "zetachain.zetacore.observer.MsgAddObserverResponse")
+	proto.RegisterType((*MsgRemoveObserver)(nil), "zetachain.zetacore.observer.MsgRemoveObserver")
+	proto.RegisterType((*MsgRemoveObserverResponse)(nil), "zetachain.zetacore.observer.MsgRemoveObserverResponse")
+	proto.RegisterType((*MsgVoteBlame)(nil), "zetachain.zetacore.observer.MsgVoteBlame")
+	proto.RegisterType((*MsgVoteBlameResponse)(nil), "zetachain.zetacore.observer.MsgVoteBlameResponse")
+	proto.RegisterType((*MsgUpdateKeygen)(nil), "zetachain.zetacore.observer.MsgUpdateKeygen")
+	proto.RegisterType((*MsgUpdateKeygenResponse)(nil), "zetachain.zetacore.observer.MsgUpdateKeygenResponse")
+	proto.RegisterType((*MsgResetChainNonces)(nil), "zetachain.zetacore.observer.MsgResetChainNonces")
+	proto.RegisterType((*MsgResetChainNoncesResponse)(nil), "zetachain.zetacore.observer.MsgResetChainNoncesResponse")
+	proto.RegisterType((*MsgVoteTSS)(nil), "zetachain.zetacore.observer.MsgVoteTSS")
+	proto.RegisterType((*MsgVoteTSSResponse)(nil), "zetachain.zetacore.observer.MsgVoteTSSResponse")
+	proto.RegisterType((*MsgEnableCCTX)(nil), "zetachain.zetacore.observer.MsgEnableCCTX")
+	proto.RegisterType((*MsgEnableCCTXResponse)(nil), "zetachain.zetacore.observer.MsgEnableCCTXResponse")
+	proto.RegisterType((*MsgDisableCCTX)(nil), "zetachain.zetacore.observer.MsgDisableCCTX")
+	proto.RegisterType((*MsgDisableCCTXResponse)(nil), "zetachain.zetacore.observer.MsgDisableCCTXResponse")
+	proto.RegisterType((*MsgUpdateGasPriceIncreaseFlags)(nil), "zetachain.zetacore.observer.MsgUpdateGasPriceIncreaseFlags")
+	proto.RegisterType((*MsgUpdateGasPriceIncreaseFlagsResponse)(nil), "zetachain.zetacore.observer.MsgUpdateGasPriceIncreaseFlagsResponse")
+	proto.RegisterType((*MsgUpdateOperationalFlags)(nil), "zetachain.zetacore.observer.MsgUpdateOperationalFlags")
+	proto.RegisterType((*MsgUpdateOperationalFlagsResponse)(nil), "zetachain.zetacore.observer.MsgUpdateOperationalFlagsResponse")
+	proto.RegisterType((*MsgDisableFastConfirmation)(nil), "zetachain.zetacore.observer.MsgDisableFastConfirmation")
+	proto.RegisterType((*MsgDisableFastConfirmationResponse)(nil), "zetachain.zetacore.observer.MsgDisableFastConfirmationResponse")
+	proto.RegisterType((*MsgUpdateV2ZetaFlows)(nil), "zetachain.zetacore.observer.MsgUpdateV2ZetaFlows")
+	proto.RegisterType((*MsgUpdateV2ZetaFlowsResponse)(nil), "zetachain.zetacore.observer.MsgUpdateV2ZetaFlowsResponse")
+	proto.RegisterType((*MsgProposeObserverSetChange)(nil), "zetachain.zetacore.observer.MsgProposeObserverSetChange")
+	proto.RegisterType((*MsgProposeObserverSetChangeResponse)(nil), "zetachain.zetacore.observer.MsgProposeObserverSetChangeResponse")
+	proto.RegisterType((*MsgUnjailObserver)(nil), "zetachain.zetacore.observer.MsgUnjailObserver")
+	proto.RegisterType((*MsgUnjailObserverResponse)(nil), "zetachain.zetacore.observer.MsgUnjailObserverResponse")
+	proto.RegisterType((*MsgUpdateParentRevertInheritance)(nil), "zetachain.zetacore.observer.MsgUpdateParentRevertInheritance")
+	proto.RegisterType((*MsgUpdateParentRevertInheritanceResponse)(nil), "zetachain.zetacore.observer.MsgUpdateParentRevertInheritanceResponse")
+	proto.RegisterType((*MsgCancelObserverSetChange)(nil), "zetachain.zetacore.observer.MsgCancelObserverSetChange")
+	proto.RegisterType((*MsgCancelObserverSetChangeResponse)(nil), "zetachain.zetacore.observer.MsgCancelObserverSetChangeResponse")
+	proto.RegisterType((*MsgUpdateLivenessParams)(nil), "zetachain.zetacore.observer.MsgUpdateLivenessParams")
+	proto.RegisterType((*MsgUpdateLivenessParamsResponse)(nil), "zetachain.zetacore.observer.MsgUpdateLivenessParamsResponse")
+}
+
+func init() {
+	proto.RegisterFile("zetachain/zetacore/observer/tx.proto", fileDescriptor_eda6e3b1d16a4021)
+}
+
+var fileDescriptor_eda6e3b1d16a4021 = []byte{
+	// 2088 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcd, 0x6f, 0xdc, 0xc6,
+	0x15, 0x37, 0x2b, 0xcb, 0x96, 0x9e, 0xa4, 0x95, 0x44, 0xcb, 0xd2, 0x8a, 0x8a, 0x15, 0x45, 0xf1,
+	0xc7, 0x5a, 0xb6, 0xb5, 0xd6, 0xba, 0x6d, 0xda, 0xc4, 0x4d, 0x6b, 0x4b, 0xfe, 0x50, 0x6b, 0xc5,
+	0x02, 0x57, 0x31, 0x5a, 0x5f, 0xd8, 0x11, 0x39, 0xe2, 0x32, 0xa2, 0x38, 0x0b, 0x0e, 0x57, 0x1f,
+	0x29, 0x50, 0xb4, 0x05, 0x7a, 0xa8, 0x4f, 0x01, 0x0a, 0xe4, 0x50, 0xa0, 0x28, 0x50, 0x20, 0x97,
+	0x9e, 0x72, 0xec, 0x9f, 0x90, 0x63, 0xd0, 0x4b, 0x7b, 0x2a, 0x0a, 0xfb, 0x90, 0x43, 0x4f, 0x3d,
+	0x15, 0xe8, 0xa9, 0xe0, 0xcc, 0x70, 0x96, 0xe4, 0x72, 0x49, 0xae, 0x9c, 0x9c, 0x24, 0x3e, 0xfe,
+	0x7e, 0xf3, 0x3e, 0xe6, 0xcd, 0x9b, 0xf7, 0xb8, 0x70, 0xf9, 0x63, 0x1c, 0x20, 0xb3, 0x85, 0x1c,
+	0xaf, 0xce, 0xfe, 0x23, 0x3e, 0xae, 0x93, 0x5d, 0x8a, 0xfd, 0x43, 0xec, 0xd7, 0x83, 0xe3, 0xd5,
+	0xb6, 0x4f, 0x02, 0xa2, 0x2e, 0x48, 0xd4, 0x6a, 0x84, 0x5a, 0x8d, 0x50, 0xda, 0x8c, 0x4d, 0x6c,
+	0xc2, 0x70, 0xf5, 0xf0, 0x3f, 0x4e, 0xd1, 0xae, 0xe5, 0x2d, 0xbc, 0xeb, 0xa2, 0x03, 0x2c, 0x80,
+	0x8d, 0x3c, 0xa0, 0xe9, 0x13, 0x4a, 0xd9, 0x4b, 0x63, 0xcf, 0x45, 0x36, 0x15, 0x9c, 0x95, 0x3c,
+	0x4e, 0xf4, 0x8f, 0xc0, 0x7e, 0xa7, 0x0c, 0xd6, 0xa0, 0x38, 0x30, 0xcc, 0x16, 0xf2, 0xec, 0xc8,
+	0xac, 0xd5, 0x5c, 0xb3, 0x98, 0x45, 0x6d, 0xe4, 0xa3, 0x83, 0xc8, 0xa4, 0xdb, 0x79, 0xf8, 0x36,
+	0xf6, 0x2c, 0xc7, 0xb3, 0x0d, 0x8f, 0x78, 0x26, 0x8e, 0x18, 0x57, 0x72, 0x43, 0x4f, 0x23, 0xd8,
+	0xad, 0x5c, 0xfb, 0xdb, 0xd8, 0x47, 0x81, 0x43, 0x3c, 0xe4, 0x96, 0x09, 0x8d, 0xeb, 0x1c, 0x62,
+	0x0f, 0xcb, 0xa5, 0x73, 0x43, 0x63, 0x12, 0x6f, 0xcf, 0xf1, 0x0f, 0xd8, 0xea, 0x49, 0x57, 0xb3,
+	0x54, 0xb4, 0xf7, 0x6d, 0x1e, 0x15, 0x2a, 0xfe, 0x14, 0x60, 0xdb, 0x3e, 0x21, 0x7b, 0x54, 0xfc,
+	0x11, 0xd8, 0x39, 0x93, 0xd0, 0x03, 0x42, 0xeb, 0x07, 0xd4, 0xae, 0x1f, 0xae, 0x85, 0x7f, 0xf8,
+	0x8b, 0xe5, 0xff, 0x2a, 0x30, 0xbd, 0x45, 0xed, 0x0f, 0xdb, 0x16, 0x0a, 0xf0, 0x53, 0x61, 0xa0,
+	0x5a, 0x85, 0xf3, 0xa6, 0x8f, 0x51, 0x40, 0xfc, 0xaa, 0xb2, 0xa4, 0xd4, 0x46, 0xf5, 0xe8, 0x51,
+	0xbd, 0x0d, 0x33, 0xc4, 0xb5, 0x0c, 0xb9, 0xb9, 0xc8, 0xb2, 0x7c, 0x4c, 0x69, 0xf5, 0x5b, 0x0c,
+	0xa6, 0x12, 0xd7, 0x8a, 0x16, 0xb9, 0xc7, 0xdf, 0x84, 0x0c, 0x0f, 0x1f, 0xf5, 0x32, 0x86, 0x38,
+	0xc3, 0xc3, 0x47, 0x69, 0xc6, 0x33, 0x98, 0xe8, 0x30, 0x7b, 0x0c, 0x1f, 0x23, 0x4a, 0xbc, 0xea,
+	0xd9, 0x25, 0xa5, 0x56, 0x69, 0xac, 0xad, 0xe6, 0x1c, 0x95, 0xd5, 0x68, 0x11, 0xee, 0x89, 0xce,
+	0x88, 0xfa, 0x78, 0x27, 0xf6, 0xf4, 0xee, 0xf8, 0x6f, 0xbe, 0xfa, 0x7c, 0x25, 0xf2, 0x64, 0x79,
+	0x01, 0xe6, 0x7b, 0x1c, 0xd7, 0x31, 0x6d, 0x13, 0x8f, 0xe2, 0xe5, 0xbf, 0x2b, 0xa0, 0x6e, 0x51,
+	0xfb, 0x19, 0x09, 0xf0, 0x7d, 0x97, 0x98, 0xfb, 0x8f, 0x31, 0xb2, 0x72, 0xe3, 0x32, 0x0f, 0x23,
+	0x3c, 0x73, 0x1d, 0x8b, 0xc5, 0x62, 0x48, 0x3f, 0xcf, 0x9e, 0x37, 0x2d, 0xf5, 0x12, 0xc0, 0x6e,
+	0xb8, 0x86, 0xd1, 0x42, 0xb4, 0xc5, 0xdc, 0x1e, 0xd7, 0x47, 0x99, 0xe4, 0x31, 0xa2, 0x2d, 0x75,
+	0x16, 0xce, 0xb5, 0xb0, 0x63, 0xb7, 0x02, 0xe6, 0xe6, 0x90, 0x2e, 0x9e, 0xd4, 0x47, 0xa1, 0x3c,
+	0xd4, 0x5a, 0x1d, 0x5e, 0x52, 0x6a, 0x63, 0x8d, 0xeb, 0x59, 0xee, 0xb7, 0xf7, 0xd9, 0x46, 0x86,
+	0x1b, 0xcd, 0x4d, 0xdc, 0x40, 0x01, 0xba, 0x7f, 0xf6, 0x8b, 0x7f, 0xbe, 0x79, 0x46, 0x17, 0xf4,
+	0x94, 0xdb, 0x1f, 0x81, 0xd6, 0xeb, 0x58, 0xe4, 0xb7, 0x7a, 0x05, 0x2a, 0xbb, 0xc8, 0x75, 0x49,
+	0x60, 0x30, 0x3c, 0xb6, 0x98, 0x9f, 0x23, 0xfa, 0x04, 0x97, 0xae, 0x73, 0x61, 0x08, 0x3b, 0x24,
+	0x01, 0x36, 0xf6, 0x1c, 0x0f, 0xb9, 0xce, 0xc7, 0x98, 0xfb, 0x3c, 0xa2, 0x4f, 0x84, 0xd2, 0x87,
+	0x91, 0x70, 0xf9, 0x85, 0x02, 0x33, 0x32, 0xc6, 0xeb, 0xa1, 0xe5, 0xdb, 0x2c, 0xd9, 0x73, 0xe2,
+	0xf8, 0x63, 0x18, 0x33, 0xbb, 0x40, 0xb6, 0xec, 0x58, 0xa3, 0x96, 0xbb, 0xf3, 0xb1, 0x85, 0xf5,
+	0x38, 0x39, 0xe5, 0xf8, 0x22, 0xbc, 0x91, 0x65, 0x8b, 0xdc, 0xf2, 0xcf, 0xce, 0xc2, 0x9b, 0xdd,
+	0x84, 0xe8, 0x1e, 0xfe, 0x72, 0x76, 0xe7, 0xec, 0x7f, 0x0d, 0xa6, 0x6c, 0x44, 0x8d, 0xb6, 0xef,
+	0x98, 0xd8, 0x08, 0x1c, 0x73, 0x1f, 0xfb, 0x2c, 0x0b, 0xce, 0xea, 0x15, 0x1b, 0xd1, 0xed, 0x50,
+	0xbc, 0xc3, 0xa4, 0x61, 0x58, 0x1d, 0x6f, 0x97, 0x74, 0x3c, 0x2b, 0xc2, 0x9d, 0x65, 0xb8, 0x09,
+	0x21, 0x15, 0xb0, 0x6b, 0x30, 0x49, 0x3a, 0x41, 0x02, 0x37, 0xcc, 0xd7, 0x8b, 0xc4, 0x02, 0xb8,
+	0x02, 0xd3, 0x47, 0x28, 0x30, 0x5b, 0x46, 0x27, 0x38, 0x26, 0x11, 0xf4, 0x1c, 0x83, 0x4e, 0xb2,
+	0x17, 0x1f, 0x06, 0xc7, 0x44, 0x60, 0xef, 0x82, 0x26, 0x17, 0xa5, 0x66, 0x0b, 0x5b, 0x1d, 0x17,
+	0x1b, 0x8e, 0x17, 0x60, 0xff, 0x10, 0xb9, 0xd5, 0xf3, 0xcc, 0xa5, 0x6a, 0x84, 0x68, 0x0a, 0xc0,
+	0xa6, 0x78, 0xaf, 0xbe, 0x0f, 0x0b, 0xbd, 0x6c, 0x97, 0x90, 0x7d, 0x14, 0x26, 0x61, 0x75, 0x84,
+	0xd1, 0xe7, 0xd3, 0xf4, 0x27, 0x11, 0x40, 0xdd, 0x83, 0x0b, 0x19, 0x45, 0xb1, 0x3a, 0xca, 0xb6,
+	0xbf, 0x9e, 0xbf, 0xfd, 0x31, 0x1e, 0xdf, 0x26, 0x91, 0xff, 0xaa, 0xd9, 0xf3, 0x46, 0xbd, 0x03,
+	0xb3, 0x96, 0x43, 0xd1, 0xae, 0x8b, 0x8d, 0x80, 0x52, 0x83, 0x9f, 0x4b, 0x6a, 0x22, 0xaf, 0x0a,
+	0x2c, 0x81, 0x2f, 0x88, 0xb7, 0x3b, 0x94, 0xb2, 0xe3, 0xd1, 0x34, 0x51, 0xba, 0x6e, 0x5c, 0x87,
+	0x6b, 0x05, 0x69, 0x22, 0x53, 0xea, 0x67, 0x2c, 0xfd, 0x75, 0x7c, 0x40, 0x0e, 0xf1, 0xeb, 0xa6,
+	0x51, 0x66, 0x36, 0xf7, 0x2c, 0x2d, 0x55, 0xff, 0x4d, 0x81, 0xca, 0x16, 0xb5, 0xef, 0x59, 0x56,
+	0x89, 0xa2, 0x7e, 0x1d, 0xa6, 0xfa, 0x14, 0xf4, 0x49, 0x92, 0xaa, 0xcd, 0xef, 0xc2, 0x3c, 0xdb,
+	0x02, 0xd7, 0xc1, 0x5e, 0x60, 0xd8, 0x3e, 0xf2, 0x02, 0x8c, 0x8d, 0x76, 0x67, 0x77, 0x1f, 0x9f,
+	0x88, 0x92, 0x3e, 0xd7, 0x05, 0x3c, 0xe2, 0xef, 0xb7, 0xd9, 0x6b, 0x75, 0x0d, 0x2e, 0x22, 0xcb,
+	0x32, 0x3c, 0x62, 0x61, 0x03, 0x99, 0x26, 0xe9, 0x78, 0x81, 0x41, 0x3c, 0xf7, 0x84, 0x65, 0xf9,
+	0x88, 0xae, 0x22, 0xcb, 0xfa, 0x80, 0x58, 0xf8, 0x1e, 0x7f, 0xf5, 0xd4, 0x73, 0x4f, 0x52, 0x4e,
+	0x57, 0x61, 0x36, 0xe9, 0x93, 0x74, 0x77, 0x8f, 0xdd, 0x62, 0x3c, 0x1c, 0x5f, 0xab, 0xc3, 0x99,
+	0x97, 0x46, 0x52, 0x8f, 0x34, 0xe2, 0x0f, 0x0a, 0x8c, 0xcb, 0xda, 0x8a, 0x0e, 0xf0, 0xe9, 0xca,
+	0xc5, 0xa3, 0xf0, 0xba, 0x40, 0x07, 0xe1, 0xe1, 0xdb, 0x23, 0x2c, 0xa4, 0x63, 0x8d, 0xe5, 0xdc,
+	0x13, 0xc0, 0x94, 0x89, 0xa4, 0x1f, 0x65, 0xdc, 0x4d, 0x6f, 0x8f, 0xa4, 0x2c, 0x9f, 0x65, 0xb9,
+	0x28, 0x6d, 0x93, 0x46, 0x63, 0x98, 0x94, 0xe9, 0xfc, 0x13, 0x7c, 0x62, 0x63, 0x2f, 0xc7, 0xec,
+	0x19, 0x18, 0x66, 0x47, 0x46, 0xd8, 0xcc, 0x1f, 0x42, 0x29, 0xb6, 0x2c, 0x8a, 0x98, 0xb1, 0x23,
+	0x3a, 0x7f, 0x48, 0xa9, 0x9f, 0x87, 0xb9, 0x94, 0x1a, 0x69, 0xc1, 0x5f, 0x14, 0xb8, 0xc0, 0x82,
+	0x4a, 0x71, 0xc0, 0x52, 0xf9, 0x03, 0xd6, 0xca, 0x9d, 0x2e, 0x7a, 0x57, 0x61, 0x92, 0xbf, 0x62,
+	0xfd, 0xa0, 0xe1, 0x92, 0x23, 0x66, 0xd5, 0x90, 0x3e, 0x61, 0xca, 0xa5, 0x9f, 0x90, 0xa3, 0xb0,
+	0x28, 0xc7, 0x71, 0x2d, 0xc7, 0x6e, 0x89, 0xfb, 0xb7, 0xd2, 0x05, 0x3e, 0x76, 0xec, 0x56, 0xca,
+	0x8f, 0x4b, 0xb0, 0x90, 0x61, 0xab, 0xf4, 0xe5, 0x3f, 0x0a, 0x80, 0x08, 0xf3, 0x4e, 0xb3, 0x99,
+	0xe3, 0xc2, 0x25, 0x80, 0xb0, 0x00, 0x89, 0x83, 0xc3, 0x73, 0x6f, 0x34, 0xa0, 0x54, 0x1c, 0x95,
+	0x9b, 0xa0, 0xee, 0xb3, 0x28, 0x19, 0xe1, 0x76, 0x1b, 0xa2, 0x41, 0xe0, 0x9e, 0x4c, 0xf1, 0x37,
+	0xcf, 0x71, 0x80, 0x1e, 0xf3, 0x56, 0x61, 0x03, 0xce, 0xd1, 0x00, 0x05, 0x1d, 0x2a, 0x3a, 0xa5,
+	0x9b, 0xfd, 0x5a, 0x05, 0xd1, 0x3f, 0xea, 0xd8, 0xc4, 0xce, 0x21, 0x6e, 0x32, 0x8e, 0x2e, 0xb8,
+	0x61, 0x48, 0xba, 0x26, 0x19, 0x7c, 0x47, 0x87, 0x99, 0x61, 0x15, 0x69, 0xd8, 0x83, 0x8c, 0xad,
+	0xfd, 0x5d, 0xb7, 0x57, 0xda, 0x69, 0x36, 0xbf, 0x99, 0x56, 0x22, 0x84, 0x89, 0x80, 0xd0, 0x8e,
+	0x69, 0x46, 0xfd, 0xe3, 0x88, 0x3e, 0xc1, 0xa5, 0x4d, 0x2e, 0x5c, 0xfe, 0xad, 0x02, 0x13, 0x5b,
+	0xd4, 0x7e, 0xe0, 0x85, 0x45, 0x7c, 0x7d, 0x7d, 0xe7, 0xa7, 0x39, 0x5b, 0x70, 0x19, 0x26, 0x30,
+	0xc3, 0x6d, 0xf2, 0xdb, 0x35, 0x52, 0x9c, 0x10, 0xaa, 0x57, 0xa1, 0xc2, 0x05, 0x4f, 0xc5, 0xe5,
+	0x25, 0x14, 0xa7, 0xa4, 0xa9, 0x98, 0xcc, 0xc1, 0xc5, 0x84, 0x19, 0x32, 0x41, 0x5e, 0xf0, 0xba,
+	0xbc, 0xc1, 0xaf, 0x99, 0x02, 0x0b, 0xaf, 0x42, 0x45, 0xdc, 0x47, 0x49, 0x13, 0x53, 0x52, 0xb5,
+	0x06, 0x93, 0x42, 0x92, 0x32, 0x32, 0x2d, 0xce, 0xac, 0xa7, 0x31, 0x5b, 0xa4, 0x99, 0x7f, 0x55,
+	0x60, 0x51, 0x9e, 0xd7, 0x47, 0xa2, 0x4b, 0xd9, 0xf4, 0x42, 0x22, 0xc5, 0x0f, 0xc3, 0x71, 0x31,
+	0xc7, 0x6c, 0x0f, 0x2e, 0xda, 0x59, 0x14, 0xd1, 0xcd, 0x35, 0x72, 0x8b, 0x59, 0xa6, 0x32, 0x51,
+	0xdc, 0xb2, 0x97, 0x4d, 0x39, 0x55, 0x83, 0xab, 0xf9, 0x96, 0x77, 0x3b, 0x3e, 0x25, 0x3e, 0x02,
+	0x74, 0xaf, 0xf2, 0x22, 0xff, 0x7e, 0x0e, 0xd3, 0xb1, 0xe1, 0x90, 0x4f, 0xcf, 0xc2, 0xb7, 0x5b,
+	0xf9, 0x33, 0x4a, 0x4a, 0x87, 0x70, 0x6b, 0x8a, 0xa4, 0xe4, 0x29, 0x8f, 0xde, 0x86, 0xb7, 0xfa,
+	0x9a, 0x29, 0x9d, 0x31, 0x58, 0x5f, 0x2f, 0xf6, 0xf2, 0x21, 0xa2, 0x41, 0xbc, 0x2f, 0xfa, 0x3a,
+	0x3a, 0x8e, 0xcb, 0xb0, 0xdc, 0x5f, 0x81, 0x34, 0xa3, 0x15, 0xeb, 0xf8, 0x9f, 0x35, 0xc2, 0x1a,
+	0xf5, 0xd0, 0x25, 0x47, 0x79, 0xd1, 0xac, 0xc1, 0xa4, 0x43, 0x39, 0x94, 0x9f, 0x97, 0x28, 0xcb,
+	0xd3, 0xe2, 0x9c, 0x7e, 0x3e, 0xa6, 0x49, 0x5a, 0xf2, 0x3f, 0x85, 0x95, 0xea, 0x6d, 0x9f, 0xb4,
+	0x09, 0x95, 0x97, 0x75, 0x93, 0xd5, 0x6d, 0xcf, 0xce, 0xbb, 0x9c, 0x77, 0x60, 0x14, 0x59, 0x96,
+	0x13, 0xfa, 0x15, 0xee, 0xeb, 0x50, 0x6d, 0xac, 0x71, 0xbb, 0xd4, 0xec, 0xd9, 0xc4, 0xc1, 0x3d,
+	0x41, 0x8c, 0xae, 0x63, 0xb9, 0x90, 0xaa, 0xc1, 0x88, 0x1f, 0xf6, 0x0d, 0xc8, 0x0d, 0x6b, 0xd7,
+	0x50, 0x6d, 0x54, 0x97, 0xcf, 0xea, 0x0d, 0x98, 0x46, 0x66, 0xe0, 0x1c, 0xf2, 0xe6, 0x37, 0x31,
+	0x0e, 0x4e, 0x75, 0x5f, 0x88, 0x6a, 0x2f, 0xaf, 0xdb, 0xe1, 0xfe, 0xd7, 0xed, 0x15, 0x78, 0x3b,
+	0xc7, 0x77, 0x19, 0xa3, 0xf7, 0xf8, 0xf0, 0xef, 0x7d, 0x84, 0x1c, 0xb7, 0xb8, 0x6d, 0xca, 0x1e,
+	0xa0, 0x13, 0x64, 0xb9, 0xf2, 0xa7, 0x0a, 0x2c, 0xc9, 0xed, 0xd9, 0x46, 0x3e, 0xf6, 0x02, 0x1d,
+	0x1f, 0x62, 0x3f, 0xd8, 0xf4, 0x5a, 0xd8, 0x77, 0x02, 0xe4, 0x99, 0x38, 0x77, 0x0c, 0x5c, 0x72,
+	0x68, 0x1f, 0x5a, 0x32, 0x4b, 0x0a, 0x71, 0x29, 0xab, 0x57, 0xa0, 0x56, 0x64, 0x97, 0x74, 0x62,
+	0x83, 0x9d, 0xa9, 0xf5, 0x50, 0xe6, 0x0e, 0x90, 0x40, 0x99, 0x07, 0xa7, 0xcf, 0x2a, 0x52, 0xd7,
+	0x1f, 0x95, 0x58, 0x87, 0xf4, 0x44, 0x7c, 0x4c, 0x2a, 0x9c, 0x17, 0x9e, 0xc3, 0x64, 0xf4, 0xe1,
+	0x29, 0x9a, 0x99, 0x78, 0x21, 0xba, 0x91, 0x9b, 0xb0, 0xc9, 0xf5, 0x45, 0xae, 0x56, 0xdc, 0x84,
+	0x34, 0xe5, 0xc5, 0x5b, 0xb1, 0xe9, 0x38, 0x49, 0x8f, 0x5c, 0x68, 0xfc, 0x7b, 0x16, 0x86, 0xb6,
+	0xa8, 0xad, 0x12, 0x18, 0x8b, 0xcf, 0x1d, 0xf9, 0xa6, 0x24, 0x1b, 0x7a, 0xed, 0xce, 0x00, 0x60,
+	0xd9, 0x6a, 0x1c, 0x43, 0x25, 0xd5, 0xfa, 0xaf, 0x16, 0x2d, 0x93, 0xc4, 0x6b, 0xdf, 0x1d, 0x0c,
+	0x1f, 0xd7, 0x9c, 0xfa, 0x74, 0x56, 0xa8, 0x39, 0x89, 0x2f, 0xd6, 0x9c, 0xfd, 0x85, 0x4a, 0xfd,
+	0xb5, 0x02, 0xd3, 0xbd, 0x1f, 0x56, 0xd6, 0xca, 0xad, 0x16, 0xa3, 0x68, 0xdf, 0x1f, 0x98, 0x92,
+	0xb0, 0xa1, 0x77, 0xba, 0x5d, 0x2b, 0x17, 0xcb, 0x81, 0x6c, 0xe8, 0x3b, 0xe8, 0xaa, 0x0e, 0x8c,
+	0x76, 0x07, 0xae, 0xeb, 0x45, 0xeb, 0x48, 0xa8, 0xb6, 0x56, 0x1a, 0x2a, 0x55, 0xf9, 0x30, 0x9e,
+	0x98, 0x93, 0x6e, 0x96, 0x8b, 0x1c, 0x47, 0x6b, 0xdf, 0x1e, 0x04, 0x2d, 0x75, 0xfe, 0x02, 0x26,
+	0xd3, 0x1f, 0x21, 0xeb, 0xe5, 0x2c, 0x97, 0x04, 0xed, 0x9d, 0x01, 0x09, 0x52, 0xf9, 0x2f, 0x61,
+	0xaa, 0x67, 0x2a, 0xbb, 0x5d, 0xbc, 0x55, 0x49, 0x86, 0xf6, 0xbd, 0x41, 0x19, 0x52, 0xbf, 0x09,
+	0xe7, 0xa3, 0x49, 0xea, 0x5a, 0x19, 0x1f, 0x76, 0x9a, 0x4d, 0xad, 0x5e, 0x12, 0x28, 0x95, 0xb8,
+	0x00, 0xb1, 0x71, 0x61, 0xa5, 0x88, 0xde, 0xc5, 0x6a, 0x8d, 0xf2, 0x58, 0xa9, 0x8d, 0xc0, 0x58,
+	0xbc, 0xf7, 0x2f, 0xac, 0x8d, 0x31, 0x70, 0x71, 0x6d, 0xcc, 0xe8, 0xe4, 0xd5, 0xdf, 0x2b, 0x30,
+	0xd7, 0xaf, 0x2b, 0x7c, 0xa7, 0xe4, 0x82, 0x69, 0xa2, 0xf6, 0xc3, 0x53, 0x12, 0xa5, 0x55, 0x7f,
+	0x52, 0x60, 0x21, 0x6f, 0xb8, 0x78, 0xaf, 0xdc, 0x61, 0xc9, 0x24, 0x6b, 0xeb, 0xaf, 0x41, 0x96,
+	0x16, 0x7e, 0xa2, 0xc0, 0x6c, 0x9f, 0xc9, 0xa0, 0x6c, 0xc9, 0x4e, 0xf1, 0xb4, 0xf7, 0x4f, 0xc7,
+	0x93, 0x26, 0xfd, 0x59, 0x81, 0x37, 0x72, 0x3f, 0x4f, 0xdf, 0x1d, 0x58, 0x41, 0xbc, 0x08, 0x6f,
+	0xbc, 0x0e, 0x3b, 0xe3, 0x5e, 0x8a, 0xb7, 0xff, 0x25, 0xef, 0xa5, 0x18, 0xa5, 0xec, 0xbd, 0x94,
+	0xd1, 0xfa, 0xab, 0x9f, 0x2a, 0x50, 0xed, 0xdb, 0xf7, 0x17, 0x96, 0xa3, 0x7e, 0x4c, 0xed, 0x47,
+	0xa7, 0x65, 0x26, 0xda, 0x85, 0x64, 0xb3, 0x5d, 0xdc, 0x2e, 0x24, 0xf0, 0x25, 0xda, 0x85, 0xcc,
+	0x7e, 0x5c, 0xfd, 0x4c, 0x81, 0x4b, 0xf9, 0xcd, 0xf8, 0x0f, 0xca, 0xc5, 0xbb, 0x0f, 0x5d, 0x7b,
+	0xf0, 0x5a, 0xf4, 0x44, 0xb9, 0xea, 0xd7, 0x70, 0x17, 0x96, 0xab, 0x3e, 0xc4, 0xe2, 0x72, 0x55,
+	0xd0, 0x9c, 0xab, 0x2f, 0x14, 0x98, 0xc9, 0xec, 0xcc, 0x4b, 0x5e, 0xea, 0x49, 0x96, 0x76, 0xf7,
+	0x34, 0xac, 0xc8, 0x18, 0x6d, 0xf8, 0x57, 0x5f, 0x7d, 0xbe, 0xa2, 0xdc, 0x7f, 0xf0, 0xc5, 0xcb,
+	0x45, 0xe5, 0xcb, 0x97, 0x8b, 0xca, 0xbf, 0x5e, 0x2e, 0x2a, 0x9f, 0xbc, 0x5a, 0x3c, 0xf3, 0xe5,
+	0xab, 0xc5, 0x33, 0xff, 0x78, 0xb5, 0x78, 0xe6, 0xf9, 0x0d, 0xdb, 0x09, 0x5a, 0x9d, 0xdd, 0x55,
+	0x93, 0x1c, 0xb0, 0x5f, 0x86, 0x6f, 0xf1, 0x1f, 0x89, 0x3d, 0x62, 0xe1, 0xfa, 0x71, 0xec, 0x77,
+	0xf0, 0x93, 0x36, 0xa6, 0xbb, 0xe7, 0xd8, 0xef, 0xc0, 0x77, 0xfe, 0x1f, 0x00, 0x00, 0xff, 0xff,
+	0x18, 0x2b, 0x64, 0xb3, 0xae, 0x20, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	AddObserver(ctx context.Context, in *MsgAddObserver, opts ...grpc.CallOption) (*MsgAddObserverResponse, error)
+	RemoveObserver(ctx context.Context, in *MsgRemoveObserver, opts ...grpc.CallOption) (*MsgRemoveObserverResponse, error)
+	UpdateObserver(ctx context.Context, in *MsgUpdateObserver, opts ...grpc.CallOption) (*MsgUpdateObserverResponse, error)
+	UpdateChainParams(ctx context.Context, in *MsgUpdateChainParams, opts ...grpc.CallOption) (*MsgUpdateChainParamsResponse, error)
+	RemoveChainParams(ctx context.Context, in *MsgRemoveChainParams, opts ...grpc.CallOption) (*MsgRemoveChainParamsResponse, error)
+	VoteBlame(ctx context.Context, in *MsgVoteBlame, opts ...grpc.CallOption) (*MsgVoteBlameResponse, error)
+	UpdateKeygen(ctx context.Context, in *MsgUpdateKeygen, opts ...grpc.CallOption) (*MsgUpdateKeygenResponse, error)
+	VoteBlockHeader(ctx context.Context, in *MsgVoteBlockHeader, opts ...grpc.CallOption) (*MsgVoteBlockHeaderResponse, error)
+	ResetChainNonces(ctx context.Context, in *MsgResetChainNonces, opts ...grpc.CallOption) (*MsgResetChainNoncesResponse, error)
+	VoteTSS(ctx context.Context, in *MsgVoteTSS, opts ...grpc.CallOption) (*MsgVoteTSSResponse, error)
+	EnableCCTX(ctx context.Context, in *MsgEnableCCTX, opts ...grpc.CallOption) (*MsgEnableCCTXResponse, error)
+	DisableCCTX(ctx context.Context, in *MsgDisableCCTX, opts ...grpc.CallOption) (*MsgDisableCCTXResponse, error)
+	DisableFastConfirmation(ctx context.Context, in *MsgDisableFastConfirmation, opts ...grpc.CallOption) (*MsgDisableFastConfirmationResponse, error)
+	UpdateGasPriceIncreaseFlags(ctx context.Context, in *MsgUpdateGasPriceIncreaseFlags, opts ...grpc.CallOption) (*MsgUpdateGasPriceIncreaseFlagsResponse, error)
+	UpdateOperationalFlags(ctx context.Context, in *MsgUpdateOperationalFlags, opts ...grpc.CallOption) (*MsgUpdateOperationalFlagsResponse, error)
+	UpdateOperationalChainParams(ctx context.Context, in *MsgUpdateOperationalChainParams, opts ...grpc.CallOption) (*MsgUpdateOperationalChainParamsResponse, error)
+	UpdateV2ZetaFlows(ctx context.Context, in *MsgUpdateV2ZetaFlows, opts ...grpc.CallOption) (*MsgUpdateV2ZetaFlowsResponse, error)
+	ProposeObserverSetChange(ctx context.Context, in *MsgProposeObserverSetChange, opts ...grpc.CallOption) (*MsgProposeObserverSetChangeResponse, error)
+	UnjailObserver(ctx context.Context, in *MsgUnjailObserver, opts ...grpc.CallOption) (*MsgUnjailObserverResponse, error)
+	UpdateParentRevertInheritance(ctx context.Context, in *MsgUpdateParentRevertInheritance, opts ...grpc.CallOption) (*MsgUpdateParentRevertInheritanceResponse, error)
+	CancelObserverSetChange(ctx context.Context, in *MsgCancelObserverSetChange, opts ...grpc.CallOption) (*MsgCancelObserverSetChangeResponse, error)
+	UpdateLivenessParams(ctx context.Context, in *MsgUpdateLivenessParams, opts ...grpc.CallOption) (*MsgUpdateLivenessParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) AddObserver(ctx context.Context, in *MsgAddObserver, opts ...grpc.CallOption) (*MsgAddObserverResponse, error) {
+	out := new(MsgAddObserverResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/AddObserver", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).VoteBlockHeader(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Msg/VoteBlockHeader",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).VoteBlockHeader(ctx, req.(*MsgVoteBlockHeader))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_ResetChainNonces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgResetChainNonces)
-	if err := dec(in); err != nil {
+func (c *msgClient) RemoveObserver(ctx context.Context, in *MsgRemoveObserver, opts ...grpc.CallOption) (*MsgRemoveObserverResponse, error) {
+	out := new(MsgRemoveObserverResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/RemoveObserver", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ResetChainNonces(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Msg/ResetChainNonces",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ResetChainNonces(ctx, req.(*MsgResetChainNonces))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_VoteTSS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgVoteTSS)
-	if err := dec(in); err != nil {
+func (c *msgClient) UpdateObserver(ctx context.Context, in *MsgUpdateObserver, opts ...grpc.CallOption) (*MsgUpdateObserverResponse, error) {
+	out := new(MsgUpdateObserverResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/UpdateObserver", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).VoteTSS(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Msg/VoteTSS",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).VoteTSS(ctx, req.(*MsgVoteTSS))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_EnableCCTX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgEnableCCTX)
-	if err := dec(in); err != nil {
+func (c *msgClient) UpdateChainParams(ctx context.Context, in *MsgUpdateChainParams, opts ...grpc.CallOption) (*MsgUpdateChainParamsResponse, error) {
+	out := new(MsgUpdateChainParamsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/UpdateChainParams", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).EnableCCTX(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Msg/EnableCCTX",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).EnableCCTX(ctx, req.(*MsgEnableCCTX))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_DisableCCTX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDisableCCTX)
-	if err := dec(in); err != nil {
+func (c *msgClient) RemoveChainParams(ctx context.Context, in *MsgRemoveChainParams, opts ...grpc.CallOption) (*MsgRemoveChainParamsResponse, error) {
+	out := new(MsgRemoveChainParamsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/RemoveChainParams", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DisableCCTX(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Msg/DisableCCTX",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DisableCCTX(ctx, req.(*MsgDisableCCTX))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_DisableFastConfirmation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDisableFastConfirmation)
-	if err := dec(in); err != nil {
+func (c *msgClient) VoteBlame(ctx context.Context, in *MsgVoteBlame, opts ...grpc.CallOption) (*MsgVoteBlameResponse, error) {
+	out := new(MsgVoteBlameResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/VoteBlame", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DisableFastConfirmation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Msg/DisableFastConfirmation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DisableFastConfirmation(ctx, req.(*MsgDisableFastConfirmation))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_UpdateGasPriceIncreaseFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateGasPriceIncreaseFlags)
-	if err := dec(in); err != nil {
+func (c *msgClient) UpdateKeygen(ctx context.Context, in *MsgUpdateKeygen, opts ...grpc.CallOption) (*MsgUpdateKeygenResponse, error) {
+	out := new(MsgUpdateKeygenResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/UpdateKeygen", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateGasPriceIncreaseFlags(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Msg/UpdateGasPriceIncreaseFlags",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateGasPriceIncreaseFlags(ctx, req.(*MsgUpdateGasPriceIncreaseFlags))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_UpdateOperationalFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateOperationalFlags)
-	if err := dec(in); err != nil {
+func (c *msgClient) VoteBlockHeader(ctx context.Context, in *MsgVoteBlockHeader, opts ...grpc.CallOption) (*MsgVoteBlockHeaderResponse, error) {
+	out := new(MsgVoteBlockHeaderResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/VoteBlockHeader", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateOperationalFlags(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Msg/UpdateOperationalFlags",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateOperationalFlags(ctx, req.(*MsgUpdateOperationalFlags))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_UpdateOperationalChainParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateOperationalChainParams)
-	if err := dec(in); err != nil {
+func (c *msgClient) ResetChainNonces(ctx context.Context, in *MsgResetChainNonces, opts ...grpc.CallOption) (*MsgResetChainNoncesResponse, error) {
+	out := new(MsgResetChainNoncesResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/ResetChainNonces", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateOperationalChainParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Msg/UpdateOperationalChainParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateOperationalChainParams(ctx, req.(*MsgUpdateOperationalChainParams))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_UpdateV2ZetaFlows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateV2ZetaFlows)
-	if err := dec(in); err != nil {
+func (c *msgClient) VoteTSS(ctx context.Context, in *MsgVoteTSS, opts ...grpc.CallOption) (*MsgVoteTSSResponse, error) {
+	out := new(MsgVoteTSSResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/VoteTSS", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateV2ZetaFlows(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Msg/UpdateV2ZetaFlows",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateV2ZetaFlows(ctx, req.(*MsgUpdateV2ZetaFlows))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "zetachain.zetacore.observer.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AddObserver",
-			Handler:    _Msg_AddObserver_Handler,
-		},
-		{
-			MethodName: "RemoveObserver",
-			Handler:    _Msg_RemoveObserver_Handler,
-		},
-		{
-			MethodName: "UpdateObserver",
-			Handler:    _Msg_UpdateObserver_Handler,
-		},
-		{
-			MethodName: "UpdateChainParams",
-			Handler:    _Msg_UpdateChainParams_Handler,
-		},
-		{
-			MethodName: "RemoveChainParams",
-			Handler:    _Msg_RemoveChainParams_Handler,
-		},
-		{
-			MethodName: "VoteBlame",
-			Handler:    _Msg_VoteBlame_Handler,
-		},
-		{
-			MethodName: "UpdateKeygen",
-			Handler:    _Msg_UpdateKeygen_Handler,
-		},
-		{
-			MethodName: "VoteBlockHeader",
-			Handler:    _Msg_VoteBlockHeader_Handler,
-		},
-		{
-			MethodName: "ResetChainNonces",
-			Handler:    _Msg_ResetChainNonces_Handler,
-		},
-		{
-			MethodName: "VoteTSS",
-			Handler:    _Msg_VoteTSS_Handler,
-		},
-		{
-			MethodName: "EnableCCTX",
-			Handler:    _Msg_EnableCCTX_Handler,
-		},
-		{
-			MethodName: "DisableCCTX",
-			Handler:    _Msg_DisableCCTX_Handler,
-		},
-		{
-			MethodName: "DisableFastConfirmation",
-			Handler:    _Msg_DisableFastConfirmation_Handler,
-		},
-		{
-			MethodName: "UpdateGasPriceIncreaseFlags",
-			Handler:    _Msg_UpdateGasPriceIncreaseFlags_Handler,
-		},
-		{
-			MethodName: "UpdateOperationalFlags",
-			Handler:    _Msg_UpdateOperationalFlags_Handler,
-		},
-		{
-			MethodName: "UpdateOperationalChainParams",
-			Handler:    _Msg_UpdateOperationalChainParams_Handler,
-		},
-		{
-			MethodName: "UpdateV2ZetaFlows",
-			Handler:    _Msg_UpdateV2ZetaFlows_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "zetachain/zetacore/observer/tx.proto",
+func (c *msgClient) EnableCCTX(ctx context.Context, in *MsgEnableCCTX, opts ...grpc.CallOption) (*MsgEnableCCTXResponse, error) {
+	out := new(MsgEnableCCTXResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/EnableCCTX", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateObserver) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DisableCCTX(ctx context.Context, in *MsgDisableCCTX, opts ...grpc.CallOption) (*MsgDisableCCTXResponse, error) {
+	out := new(MsgDisableCCTXResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/DisableCCTX", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateObserver) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DisableFastConfirmation(ctx context.Context, in *MsgDisableFastConfirmation, opts ...grpc.CallOption) (*MsgDisableFastConfirmationResponse, error) {
+	out := new(MsgDisableFastConfirmationResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/DisableFastConfirmation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateObserver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UpdateReason != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UpdateReason))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.NewObserverAddress) > 0 {
-		i -= len(m.NewObserverAddress)
-		copy(dAtA[i:], m.NewObserverAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewObserverAddress)))
-		i--
-		dAtA[i] = 0x1a
+func (c *msgClient) UpdateGasPriceIncreaseFlags(ctx context.Context, in *MsgUpdateGasPriceIncreaseFlags, opts ...grpc.CallOption) (*MsgUpdateGasPriceIncreaseFlagsResponse, error) {
+	out := new(MsgUpdateGasPriceIncreaseFlagsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/UpdateGasPriceIncreaseFlags", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.OldObserverAddress) > 0 {
-		i -= len(m.OldObserverAddress)
-		copy(dAtA[i:], m.OldObserverAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OldObserverAddress)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *msgClient) UpdateOperationalFlags(ctx context.Context, in *MsgUpdateOperationalFlags, opts ...grpc.CallOption) (*MsgUpdateOperationalFlagsResponse, error) {
+	out := new(MsgUpdateOperationalFlagsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/UpdateOperationalFlags", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) UpdateOperationalChainParams(ctx context.Context, in *MsgUpdateOperationalChainParams, opts ...grpc.CallOption) (*MsgUpdateOperationalChainParamsResponse, error) {
+	out := new(MsgUpdateOperationalChainParamsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/UpdateOperationalChainParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateObserverResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateV2ZetaFlows(ctx context.Context, in *MsgUpdateV2ZetaFlows, opts ...grpc.CallOption) (*MsgUpdateV2ZetaFlowsResponse, error) {
+	out := new(MsgUpdateV2ZetaFlowsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/UpdateV2ZetaFlows", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateObserverResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ProposeObserverSetChange(ctx context.Context, in *MsgProposeObserverSetChange, opts ...grpc.CallOption) (*MsgProposeObserverSetChangeResponse, error) {
+	out := new(MsgProposeObserverSetChangeResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/ProposeObserverSetChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateObserverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) UnjailObserver(ctx context.Context, in *MsgUnjailObserver, opts ...grpc.CallOption) (*MsgUnjailObserverResponse, error) {
+	out := new(MsgUnjailObserverResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/UnjailObserver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgVoteBlockHeader) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParentRevertInheritance(ctx context.Context, in *MsgUpdateParentRevertInheritance, opts ...grpc.CallOption) (*MsgUpdateParentRevertInheritanceResponse, error) {
+	out := new(MsgUpdateParentRevertInheritanceResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/UpdateParentRevertInheritance", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgVoteBlockHeader) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelObserverSetChange(ctx context.Context, in *MsgCancelObserverSetChange, opts ...grpc.CallOption) (*MsgCancelObserverSetChangeResponse, error) {
+	out := new(MsgCancelObserverSetChangeResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/CancelObserverSetChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgVoteBlockHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) UpdateLivenessParams(ctx context.Context, in *MsgUpdateLivenessParams, opts ...grpc.CallOption) (*MsgUpdateLivenessParamsResponse, error) {
+	out := new(MsgUpdateLivenessParamsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/UpdateLivenessParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x2a
-	if m.Height != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BlockHash)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgVoteBlockHeaderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	AddObserver(context.Context, *MsgAddObserver) (*MsgAddObserverResponse, error)
+	RemoveObserver(context.Context, *MsgRemoveObserver) (*MsgRemoveObserverResponse, error)
+	UpdateObserver(context.Context, *MsgUpdateObserver) (*MsgUpdateObserverResponse, error)
+	UpdateChainParams(context.Context, *MsgUpdateChainParams) (*MsgUpdateChainParamsResponse, error)
+	RemoveChainParams(context.Context, *MsgRemoveChainParams) (*MsgRemoveChainParamsResponse, error)
+	VoteBlame(context.Context, *MsgVoteBlame) (*MsgVoteBlameResponse, error)
+	UpdateKeygen(context.Context, *MsgUpdateKeygen) (*MsgUpdateKeygenResponse, error)
+	VoteBlockHeader(context.Context, *MsgVoteBlockHeader) (*MsgVoteBlockHeaderResponse, error)
+	ResetChainNonces(context.Context, *MsgResetChainNonces) (*MsgResetChainNoncesResponse, error)
+	VoteTSS(context.Context, *MsgVoteTSS) (*MsgVoteTSSResponse, error)
+	EnableCCTX(context.Context, *MsgEnableCCTX) (*MsgEnableCCTXResponse, error)
+	DisableCCTX(context.Context, *MsgDisableCCTX) (*MsgDisableCCTXResponse, error)
+	DisableFastConfirmation(context.Context, *MsgDisableFastConfirmation) (*MsgDisableFastConfirmationResponse, error)
+	UpdateGasPriceIncreaseFlags(context.Context, *MsgUpdateGasPriceIncreaseFlags) (*MsgUpdateGasPriceIncreaseFlagsResponse, error)
+	UpdateOperationalFlags(context.Context, *MsgUpdateOperationalFlags) (*MsgUpdateOperationalFlagsResponse, error)
+	UpdateOperationalChainParams(context.Context, *MsgUpdateOperationalChainParams) (*MsgUpdateOperationalChainParamsResponse, error)
+	UpdateV2ZetaFlows(context.Context, *MsgUpdateV2ZetaFlows) (*MsgUpdateV2ZetaFlowsResponse, error)
+	ProposeObserverSetChange(context.Context, *MsgProposeObserverSetChange) (*MsgProposeObserverSetChangeResponse, error)
+	UnjailObserver(context.Context, *MsgUnjailObserver) (*MsgUnjailObserverResponse, error)
+	UpdateParentRevertInheritance(context.Context, *MsgUpdateParentRevertInheritance) (*MsgUpdateParentRevertInheritanceResponse, error)
+	CancelObserverSetChange(context.Context, *MsgCancelObserverSetChange) (*MsgCancelObserverSetChangeResponse, error)
+	UpdateLivenessParams(context.Context, *MsgUpdateLivenessParams) (*MsgUpdateLivenessParamsResponse, error)
 }
 
-func (m *MsgVoteBlockHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgVoteBlockHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VoteFinalized {
-		i--
-		if m.VoteFinalized {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.BallotCreated {
-		i--
-		if m.BallotCreated {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) AddObserver(ctx context.Context, req *MsgAddObserver) (*MsgAddObserverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddObserver not implemented")
 }
-
-func (m *MsgUpdateChainParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) RemoveObserver(ctx context.Context, req *MsgRemoveObserver) (*MsgRemoveObserverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveObserver not implemented")
+}
+func (*UnimplementedMsgServer) UpdateObserver(ctx context.Context, req *MsgUpdateObserver) (*MsgUpdateObserverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateObserver not implemented")
+}
+func (*UnimplementedMsgServer) UpdateChainParams(ctx context.Context, req *MsgUpdateChainParams) (*MsgUpdateChainParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChainParams not implemented")
+}
+func (*UnimplementedMsgServer) RemoveChainParams(ctx context.Context, req *MsgRemoveChainParams) (*MsgRemoveChainParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveChainParams not implemented")
+}
+func (*UnimplementedMsgServer) VoteBlame(ctx context.Context, req *MsgVoteBlame) (*MsgVoteBlameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteBlame not implemented")
+}
+func (*UnimplementedMsgServer) UpdateKeygen(ctx context.Context, req *MsgUpdateKeygen) (*MsgUpdateKeygenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateKeygen not implemented")
+}
+func (*UnimplementedMsgServer) VoteBlockHeader(ctx context.Context, req *MsgVoteBlockHeader) (*MsgVoteBlockHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteBlockHeader not implemented")
+}
+func (*UnimplementedMsgServer) ResetChainNonces(ctx context.Context, req *MsgResetChainNonces) (*MsgResetChainNoncesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetChainNonces not implemented")
+}
+func (*UnimplementedMsgServer) VoteTSS(ctx context.Context, req *MsgVoteTSS) (*MsgVoteTSSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteTSS not implemented")
+}
+func (*UnimplementedMsgServer) EnableCCTX(ctx context.Context, req *MsgEnableCCTX) (*MsgEnableCCTXResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableCCTX not implemented")
+}
+func (*UnimplementedMsgServer) DisableCCTX(ctx context.Context, req *MsgDisableCCTX) (*MsgDisableCCTXResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableCCTX not implemented")
+}
+func (*UnimplementedMsgServer) DisableFastConfirmation(ctx context.Context, req *MsgDisableFastConfirmation) (*MsgDisableFastConfirmationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableFastConfirmation not implemented")
+}
+func (*UnimplementedMsgServer) UpdateGasPriceIncreaseFlags(ctx context.Context, req *MsgUpdateGasPriceIncreaseFlags) (*MsgUpdateGasPriceIncreaseFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGasPriceIncreaseFlags not implemented")
+}
+func (*UnimplementedMsgServer) UpdateOperationalFlags(ctx context.Context, req *MsgUpdateOperationalFlags) (*MsgUpdateOperationalFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOperationalFlags not implemented")
+}
+func (*UnimplementedMsgServer) UpdateOperationalChainParams(ctx context.Context, req *MsgUpdateOperationalChainParams) (*MsgUpdateOperationalChainParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOperationalChainParams not implemented")
+}
+func (*UnimplementedMsgServer) UpdateV2ZetaFlows(ctx context.Context, req *MsgUpdateV2ZetaFlows) (*MsgUpdateV2ZetaFlowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateV2ZetaFlows not implemented")
+}
+func (*UnimplementedMsgServer) ProposeObserverSetChange(ctx context.Context, req *MsgProposeObserverSetChange) (*MsgProposeObserverSetChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeObserverSetChange not implemented")
+}
+func (*UnimplementedMsgServer) UnjailObserver(ctx context.Context, req *MsgUnjailObserver) (*MsgUnjailObserverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnjailObserver not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParentRevertInheritance(ctx context.Context, req *MsgUpdateParentRevertInheritance) (*MsgUpdateParentRevertInheritanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParentRevertInheritance not implemented")
+}
+func (*UnimplementedMsgServer) CancelObserverSetChange(ctx context.Context, req *MsgCancelObserverSetChange) (*MsgCancelObserverSetChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelObserverSetChange not implemented")
+}
+func (*UnimplementedMsgServer) UpdateLivenessParams(ctx context.Context, req *MsgUpdateLivenessParams) (*MsgUpdateLivenessParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLivenessParams not implemented")
 }
 
-func (m *MsgUpdateChainParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgUpdateChainParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChainParams != nil {
-		{
-			size, err := m.ChainParams.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Msg_AddObserver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddObserver)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).AddObserver(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/AddObserver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddObserver(ctx, req.(*MsgAddObserver))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateChainParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RemoveObserver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveObserver)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateChainParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveObserver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/RemoveObserver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveObserver(ctx, req.(*MsgRemoveObserver))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateChainParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_UpdateObserver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateObserver)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateObserver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/UpdateObserver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateObserver(ctx, req.(*MsgUpdateObserver))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateOperationalChainParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateChainParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateChainParams)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateChainParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/UpdateChainParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateChainParams(ctx, req.(*MsgUpdateChainParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateOperationalChainParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateOperationalChainParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DisableTssBlockScan {
-		i--
-		if m.DisableTssBlockScan {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	{
-		size, err := m.ConfirmationParams.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	if m.OutboundScheduleLookahead != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OutboundScheduleLookahead))
-		i--
-		dAtA[i] = 0x40
+func _Msg_RemoveChainParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveChainParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.OutboundScheduleInterval != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OutboundScheduleInterval))
-		i--
-		dAtA[i] = 0x38
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveChainParams(ctx, in)
 	}
-	if m.WatchUtxoTicker != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.WatchUtxoTicker))
-		i--
-		dAtA[i] = 0x30
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/RemoveChainParams",
 	}
-	if m.OutboundTicker != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OutboundTicker))
-		i--
-		dAtA[i] = 0x28
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveChainParams(ctx, req.(*MsgRemoveChainParams))
 	}
-	if m.InboundTicker != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.InboundTicker))
-		i--
-		dAtA[i] = 0x20
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VoteBlame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteBlame)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.GasPriceTicker != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GasPriceTicker))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(MsgServer).VoteBlame(ctx, in)
 	}
-	if m.ChainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/VoteBlame",
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteBlame(ctx, req.(*MsgVoteBlame))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateOperationalChainParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateKeygen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateKeygen)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateOperationalChainParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateOperationalChainParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateKeygen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/UpdateKeygen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateKeygen(ctx, req.(*MsgUpdateKeygen))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveChainParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_VoteBlockHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteBlockHeader)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRemoveChainParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).VoteBlockHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/VoteBlockHeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteBlockHeader(ctx, req.(*MsgVoteBlockHeader))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveChainParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x10
+func _Msg_ResetChainNonces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetChainNonces)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).ResetChainNonces(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/ResetChainNonces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetChainNonces(ctx, req.(*MsgResetChainNonces))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveChainParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_VoteTSS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteTSS)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).VoteTSS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/VoteTSS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteTSS(ctx, req.(*MsgVoteTSS))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveChainParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_EnableCCTX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEnableCCTX)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EnableCCTX(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/EnableCCTX",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EnableCCTX(ctx, req.(*MsgEnableCCTX))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveChainParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_DisableCCTX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisableCCTX)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisableCCTX(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/DisableCCTX",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisableCCTX(ctx, req.(*MsgDisableCCTX))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddObserver) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DisableFastConfirmation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisableFastConfirmation)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).DisableFastConfirmation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/DisableFastConfirmation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisableFastConfirmation(ctx, req.(*MsgDisableFastConfirmation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddObserver) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgAddObserver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AddNodeAccountOnly {
-		i--
-		if m.AddNodeAccountOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+func _Msg_UpdateGasPriceIncreaseFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGasPriceIncreaseFlags)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ZetaclientGranteePubkey) > 0 {
-		i -= len(m.ZetaclientGranteePubkey)
-		copy(dAtA[i:], m.ZetaclientGranteePubkey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ZetaclientGranteePubkey)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGasPriceIncreaseFlags(ctx, in)
 	}
-	if len(m.ObserverAddress) > 0 {
-		i -= len(m.ObserverAddress)
-		copy(dAtA[i:], m.ObserverAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ObserverAddress)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/UpdateGasPriceIncreaseFlags",
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGasPriceIncreaseFlags(ctx, req.(*MsgUpdateGasPriceIncreaseFlags))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddObserverResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateOperationalFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateOperationalFlags)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAddObserverResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgAddObserverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateOperationalFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/UpdateOperationalFlags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateOperationalFlags(ctx, req.(*MsgUpdateOperationalFlags))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveObserver) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateOperationalChainParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateOperationalChainParams)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRemoveObserver) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRemoveObserver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ObserverAddress) > 0 {
-		i -= len(m.ObserverAddress)
-		copy(dAtA[i:], m.ObserverAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ObserverAddress)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateOperationalChainParams(ctx, in)
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/UpdateOperationalChainParams",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateOperationalChainParams(ctx, req.(*MsgUpdateOperationalChainParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveObserverResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateV2ZetaFlows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateV2ZetaFlows)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRemoveObserverResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRemoveObserverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateV2ZetaFlows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/UpdateV2ZetaFlows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateV2ZetaFlows(ctx, req.(*MsgUpdateV2ZetaFlows))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgVoteBlame) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ProposeObserverSetChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeObserverSetChange)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgVoteBlame) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgVoteBlame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BlameInfo.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeObserverSetChange(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.ChainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/ProposeObserverSetChange",
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeObserverSetChange(ctx, req.(*MsgProposeObserverSetChange))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgVoteBlameResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UnjailObserver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjailObserver)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgVoteBlameResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).UnjailObserver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/UnjailObserver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnjailObserver(ctx, req.(*MsgUnjailObserver))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgVoteBlameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_UpdateParentRevertInheritance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParentRevertInheritance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParentRevertInheritance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/UpdateParentRevertInheritance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParentRevertInheritance(ctx, req.(*MsgUpdateParentRevertInheritance))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateKeygen) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CancelObserverSetChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelObserverSetChange)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).CancelObserverSetChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/CancelObserverSetChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelObserverSetChange(ctx, req.(*MsgCancelObserverSetChange))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateKeygen) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateKeygen) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Block != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Block))
-		i--
-		dAtA[i] = 0x10
+func _Msg_UpdateLivenessParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateLivenessParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateLivenessParams(ctx, in)
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgUpdateKeygenResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/UpdateLivenessParams",
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateKeygenResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateLivenessParams(ctx, req.(*MsgUpdateLivenessParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateKeygenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "zetachain.zetacore.observer.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddObserver",
+			Handler:    _Msg_AddObserver_Handler,
+		},
+		{
+			MethodName: "RemoveObserver",
+			Handler:    _Msg_RemoveObserver_Handler,
+		},
+		{
+			MethodName: "UpdateObserver",
+			Handler:    _Msg_UpdateObserver_Handler,
+		},
+		{
+			MethodName: "UpdateChainParams",
+			Handler:    _Msg_UpdateChainParams_Handler,
+		},
+		{
+			MethodName: "RemoveChainParams",
+			Handler:    _Msg_RemoveChainParams_Handler,
+		},
+		{
+			MethodName: "VoteBlame",
+			Handler:    _Msg_VoteBlame_Handler,
+		},
+		{
+			MethodName: "UpdateKeygen",
+			Handler:    _Msg_UpdateKeygen_Handler,
+		},
+		{
+			MethodName: "VoteBlockHeader",
+			Handler:    _Msg_VoteBlockHeader_Handler,
+		},
+		{
+			MethodName: "ResetChainNonces",
+			Handler:    _Msg_ResetChainNonces_Handler,
+		},
+		{
+			MethodName: "VoteTSS",
+			Handler:    _Msg_VoteTSS_Handler,
+		},
+		{
+			MethodName: "EnableCCTX",
+			Handler:    _Msg_EnableCCTX_Handler,
+		},
+		{
+			MethodName: "DisableCCTX",
+			Handler:    _Msg_DisableCCTX_Handler,
+		},
+		{
+			MethodName: "DisableFastConfirmation",
+			Handler:    _Msg_DisableFastConfirmation_Handler,
+		},
+		{
+			MethodName: "UpdateGasPriceIncreaseFlags",
+			Handler:    _Msg_UpdateGasPriceIncreaseFlags_Handler,
+		},
+		{
+			MethodName: "UpdateOperationalFlags",
+			Handler:    _Msg_UpdateOperationalFlags_Handler,
+		},
+		{
+			MethodName: "UpdateOperationalChainParams",
+			Handler:    _Msg_UpdateOperationalChainParams_Handler,
+		},
+		{
+			MethodName: "UpdateV2ZetaFlows",
+			Handler:    _Msg_UpdateV2ZetaFlows_Handler,
+		},
+		{
+			MethodName: "ProposeObserverSetChange",
+			Handler:    _Msg_ProposeObserverSetChange_Handler,
+		},
+		{
+			MethodName: "UnjailObserver",
+			Handler:    _Msg_UnjailObserver_Handler,
+		},
+		{
+			MethodName: "UpdateParentRevertInheritance",
+			Handler:    _Msg_UpdateParentRevertInheritance_Handler,
+		},
+		{
+			MethodName: "CancelObserverSetChange",
+			Handler:    _Msg_CancelObserverSetChange_Handler,
+		},
+		{
+			MethodName: "UpdateLivenessParams",
+			Handler:    _Msg_UpdateLivenessParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "zetachain/zetacore/observer/tx.proto",
 }
 
-func (m *MsgResetChainNonces) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateObserver) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3237,30 +3266,34 @@ func (m *MsgResetChainNonces) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgResetChainNonces) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateObserver) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgResetChainNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateObserver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChainNonceHigh != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ChainNonceHigh))
+	if m.UpdateReason != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpdateReason))
 		i--
 		dAtA[i] = 0x20
 	}
-	if m.ChainNonceLow != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ChainNonceLow))
+	if len(m.NewObserverAddress) > 0 {
+		i -= len(m.NewObserverAddress)
+		copy(dAtA[i:], m.NewObserverAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewObserverAddress)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if m.ChainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+	if len(m.OldObserverAddress) > 0 {
+		i -= len(m.OldObserverAddress)
+		copy(dAtA[i:], m.OldObserverAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OldObserverAddress)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
@@ -3272,7 +3305,7 @@ func (m *MsgResetChainNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgResetChainNoncesResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateObserverResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3282,12 +3315,12 @@ func (m *MsgResetChainNoncesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgResetChainNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateObserverResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgResetChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateObserverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3295,7 +3328,7 @@ func (m *MsgResetChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgVoteTSS) Marshal() (dAtA []byte, err error) {
+func (m *MsgVoteBlockHeader) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3305,32 +3338,42 @@ func (m *MsgVoteTSS) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgVoteTSS) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgVoteBlockHeader) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgVoteTSS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgVoteBlockHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
 		i--
 		dAtA[i] = 0x20
 	}
-	if m.KeygenZetaHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.KeygenZetaHeight))
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlockHash)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if len(m.TssPubkey) > 0 {
-		i -= len(m.TssPubkey)
-		copy(dAtA[i:], m.TssPubkey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TssPubkey)))
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
@@ -3342,7 +3385,7 @@ func (m *MsgVoteTSS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgVoteTSSResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgVoteBlockHeaderResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3352,26 +3395,16 @@ func (m *MsgVoteTSSResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgVoteTSSResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgVoteBlockHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgVoteTSSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgVoteBlockHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.KeygenSuccess {
-		i--
-		if m.KeygenSuccess {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
 	if m.VoteFinalized {
 		i--
 		if m.VoteFinalized {
@@ -3395,7 +3428,7 @@ func (m *MsgVoteTSSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgEnableCCTX) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateChainParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3405,35 +3438,27 @@ func (m *MsgEnableCCTX) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgEnableCCTX) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateChainParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgEnableCCTX) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateChainParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.EnableOutbound {
-		i--
-		if m.EnableOutbound {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.EnableInbound {
-		i--
-		if m.EnableInbound {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.ChainParams != nil {
+		{
+			size, err := m.ChainParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
@@ -3445,7 +3470,7 @@ func (m *MsgEnableCCTX) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgEnableCCTXResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateChainParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3455,12 +3480,12 @@ func (m *MsgEnableCCTXResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgEnableCCTXResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateChainParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgEnableCCTXResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateChainParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3468,7 +3493,7 @@ func (m *MsgEnableCCTXResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDisableCCTX) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateOperationalChainParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3478,33 +3503,68 @@ func (m *MsgDisableCCTX) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDisableCCTX) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateOperationalChainParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDisableCCTX) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateOperationalChainParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DisableOutbound {
+	if m.DisableTssBlockScan {
 		i--
-		if m.DisableOutbound {
+		if m.DisableTssBlockScan {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x50
 	}
-	if m.DisableInbound {
-		i--
-		if m.DisableInbound {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	{
+		size, err := m.ConfirmationParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.OutboundScheduleLookahead != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OutboundScheduleLookahead))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.OutboundScheduleInterval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OutboundScheduleInterval))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.WatchUtxoTicker != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WatchUtxoTicker))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OutboundTicker != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OutboundTicker))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InboundTicker != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.InboundTicker))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasPriceTicker != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasPriceTicker))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -3518,7 +3578,7 @@ func (m *MsgDisableCCTX) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDisableCCTXResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateOperationalChainParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3528,12 +3588,12 @@ func (m *MsgDisableCCTXResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDisableCCTXResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateOperationalChainParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDisableCCTXResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateOperationalChainParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3541,7 +3601,7 @@ func (m *MsgDisableCCTXResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateGasPriceIncreaseFlags) Marshal() (dAtA []byte, err error) {
+func (m *MsgRemoveChainParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3551,26 +3611,21 @@ func (m *MsgUpdateGasPriceIncreaseFlags) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateGasPriceIncreaseFlags) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRemoveChainParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateGasPriceIncreaseFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRemoveChainParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.GasPriceIncreaseFlags.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
 	}
-	i--
-	dAtA[i] = 0x12
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -3581,7 +3636,7 @@ func (m *MsgUpdateGasPriceIncreaseFlags) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateGasPriceIncreaseFlagsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRemoveChainParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3591,12 +3646,12 @@ func (m *MsgUpdateGasPriceIncreaseFlagsResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateGasPriceIncreaseFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRemoveChainParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateGasPriceIncreaseFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRemoveChainParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3604,7 +3659,7 @@ func (m *MsgUpdateGasPriceIncreaseFlagsResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateOperationalFlags) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddObserver) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3614,26 +3669,40 @@ func (m *MsgUpdateOperationalFlags) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateOperationalFlags) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddObserver) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateOperationalFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddObserver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.OperationalFlags.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.AddNodeAccountOnly {
+		i--
+		if m.AddNodeAccountOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ZetaclientGranteePubkey) > 0 {
+		i -= len(m.ZetaclientGranteePubkey)
+		copy(dAtA[i:], m.ZetaclientGranteePubkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ZetaclientGranteePubkey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -3644,7 +3713,7 @@ func (m *MsgUpdateOperationalFlags) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateOperationalFlagsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddObserverResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3654,12 +3723,12 @@ func (m *MsgUpdateOperationalFlagsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateOperationalFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddObserverResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateOperationalFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddObserverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3667,7 +3736,7 @@ func (m *MsgUpdateOperationalFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDisableFastConfirmation) Marshal() (dAtA []byte, err error) {
+func (m *MsgRemoveObserver) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3677,20 +3746,22 @@ func (m *MsgDisableFastConfirmation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDisableFastConfirmation) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRemoveObserver) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDisableFastConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRemoveObserver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ObserverAddress)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
@@ -3702,7 +3773,7 @@ func (m *MsgDisableFastConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDisableFastConfirmationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRemoveObserverResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3712,12 +3783,12 @@ func (m *MsgDisableFastConfirmationResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgDisableFastConfirmationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRemoveObserverResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDisableFastConfirmationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRemoveObserverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3725,7 +3796,7 @@ func (m *MsgDisableFastConfirmationResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateV2ZetaFlows) Marshal() (dAtA []byte, err error) {
+func (m *MsgVoteBlame) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3735,23 +3806,28 @@ func (m *MsgUpdateV2ZetaFlows) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateV2ZetaFlows) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgVoteBlame) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateV2ZetaFlows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgVoteBlame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IsV2ZetaEnabled {
-		i--
-		if m.IsV2ZetaEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	{
+		size, err := m.BlameInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -3765,7 +3841,7 @@ func (m *MsgUpdateV2ZetaFlows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateV2ZetaFlowsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgVoteBlameResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3775,12 +3851,12 @@ func (m *MsgUpdateV2ZetaFlowsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateV2ZetaFlowsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgVoteBlameResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateV2ZetaFlowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgVoteBlameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3788,533 +3864,2761 @@ func (m *MsgUpdateV2ZetaFlowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateKeygen) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateObserver) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateKeygen) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateKeygen) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OldObserverAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Eddsa {
+		i--
+		if m.Eddsa {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.NewObserverAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Block != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.UpdateReason != 0 {
-		n += 1 + sovTx(uint64(m.UpdateReason))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateObserverResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateKeygenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgVoteBlockHeader) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ChainId != 0 {
-		n += 1 + sovTx(uint64(m.ChainId))
-	}
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovTx(uint64(m.Height))
-	}
-	l = m.Header.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgUpdateKeygenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgVoteBlockHeaderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateKeygenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BallotCreated {
-		n += 2
-	}
-	if m.VoteFinalized {
-		n += 2
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateChainParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ChainParams != nil {
-		l = m.ChainParams.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgResetChainNonces) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateChainParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgResetChainNonces) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateOperationalChainParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgResetChainNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ChainId != 0 {
-		n += 1 + sovTx(uint64(m.ChainId))
-	}
-	if m.GasPriceTicker != 0 {
-		n += 1 + sovTx(uint64(m.GasPriceTicker))
-	}
-	if m.InboundTicker != 0 {
-		n += 1 + sovTx(uint64(m.InboundTicker))
-	}
-	if m.OutboundTicker != 0 {
-		n += 1 + sovTx(uint64(m.OutboundTicker))
-	}
-	if m.WatchUtxoTicker != 0 {
-		n += 1 + sovTx(uint64(m.WatchUtxoTicker))
+	if m.ChainNonceHigh != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainNonceHigh))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.OutboundScheduleInterval != 0 {
-		n += 1 + sovTx(uint64(m.OutboundScheduleInterval))
+	if m.ChainNonceLow != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainNonceLow))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.OutboundScheduleLookahead != 0 {
-		n += 1 + sovTx(uint64(m.OutboundScheduleLookahead))
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.ConfirmationParams.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.DisableTssBlockScan {
-		n += 2
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateOperationalChainParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgResetChainNoncesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveChainParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ChainId != 0 {
-		n += 1 + sovTx(uint64(m.ChainId))
-	}
-	return n
+func (m *MsgResetChainNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveChainParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgResetChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddObserver) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgVoteTSS) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteTSS) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteTSS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.TssPubkeyEddsa) > 0 {
+		i -= len(m.TssPubkeyEddsa)
+		copy(dAtA[i:], m.TssPubkeyEddsa)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TssPubkeyEddsa)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.ObserverAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.ZetaclientGranteePubkey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.KeygenZetaHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.KeygenZetaHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.AddNodeAccountOnly {
-		n += 2
+	if len(m.TssPubkey) > 0 {
+		i -= len(m.TssPubkey)
+		copy(dAtA[i:], m.TssPubkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TssPubkey)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddObserverResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgVoteTSSResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveObserver) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ObserverAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgVoteTSSResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveObserverResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgVoteTSSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
-}
-
-func (m *MsgVoteBlame) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.KeygenSuccess {
+		i--
+		if m.KeygenSuccess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.VoteFinalized {
+		i--
+		if m.VoteFinalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.ChainId != 0 {
-		n += 1 + sovTx(uint64(m.ChainId))
+	if m.BallotCreated {
+		i--
+		if m.BallotCreated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.BlameInfo.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgVoteBlameResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgEnableCCTX) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateKeygen) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgEnableCCTX) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnableCCTX) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.EnableOutbound {
+		i--
+		if m.EnableOutbound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Block != 0 {
-		n += 1 + sovTx(uint64(m.Block))
+	if m.EnableInbound {
+		i--
+		if m.EnableInbound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateKeygenResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgEnableCCTXResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgResetChainNonces) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ChainId != 0 {
-		n += 1 + sovTx(uint64(m.ChainId))
-	}
-	if m.ChainNonceLow != 0 {
-		n += 1 + sovTx(uint64(m.ChainNonceLow))
-	}
-	if m.ChainNonceHigh != 0 {
-		n += 1 + sovTx(uint64(m.ChainNonceHigh))
-	}
-	return n
+func (m *MsgEnableCCTXResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgResetChainNoncesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgEnableCCTXResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgVoteTSS) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TssPubkey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.KeygenZetaHeight != 0 {
-		n += 1 + sovTx(uint64(m.KeygenZetaHeight))
-	}
-	if m.Status != 0 {
-		n += 1 + sovTx(uint64(m.Status))
+func (m *MsgDisableCCTX) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgVoteTSSResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BallotCreated {
-		n += 2
-	}
-	if m.VoteFinalized {
-		n += 2
-	}
-	if m.KeygenSuccess {
-		n += 2
-	}
-	return n
+func (m *MsgDisableCCTX) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgEnableCCTX) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDisableCCTX) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.DisableOutbound {
+		i--
+		if m.DisableOutbound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.EnableInbound {
-		n += 2
+	if m.DisableInbound {
+		i--
+		if m.DisableInbound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.EnableOutbound {
-		n += 2
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgEnableCCTXResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDisableCCTXResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDisableCCTX) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDisableCCTXResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableCCTXResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGasPriceIncreaseFlags) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.DisableInbound {
-		n += 2
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGasPriceIncreaseFlags) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGasPriceIncreaseFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GasPriceIncreaseFlags.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.DisableOutbound {
-		n += 2
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDisableCCTXResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateGasPriceIncreaseFlagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGasPriceIncreaseFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGasPriceIncreaseFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateGasPriceIncreaseFlags) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateOperationalFlags) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateOperationalFlags) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateOperationalFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.OperationalFlags.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.GasPriceIncreaseFlags.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateGasPriceIncreaseFlagsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateOperationalFlagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateOperationalFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateOperationalFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateOperationalFlags) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDisableFastConfirmation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableFastConfirmation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableFastConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.OperationalFlags.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateOperationalFlagsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDisableFastConfirmationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableFastConfirmationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableFastConfirmationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDisableFastConfirmation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateV2ZetaFlows) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateV2ZetaFlows) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateV2ZetaFlows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.IsV2ZetaEnabled {
+		i--
+		if m.IsV2ZetaEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.ChainId != 0 {
-		n += 1 + sovTx(uint64(m.ChainId))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateV2ZetaFlowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateV2ZetaFlowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateV2ZetaFlowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeObserverSetChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeObserverSetChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeObserverSetChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Eddsa {
+		i--
+		if m.Eddsa {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Removals) > 0 {
+		for iNdEx := len(m.Removals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Removals[iNdEx])
+			copy(dAtA[i:], m.Removals[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Removals[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Additions) > 0 {
+		for iNdEx := len(m.Additions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Additions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeObserverSetChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeObserverSetChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeObserverSetChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailObserver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailObserver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailObserver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailObserverResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailObserverResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailObserverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParentRevertInheritance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParentRevertInheritance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParentRevertInheritance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsParentRevertInheritanceEnabled {
+		i--
+		if m.IsParentRevertInheritanceEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParentRevertInheritanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParentRevertInheritanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParentRevertInheritanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelObserverSetChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelObserverSetChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelObserverSetChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelObserverSetChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelObserverSetChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelObserverSetChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateLivenessParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateLivenessParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateLivenessParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LivenessParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateLivenessParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateLivenessParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateLivenessParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateObserver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OldObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UpdateReason != 0 {
+		n += 1 + sovTx(uint64(m.UpdateReason))
+	}
+	return n
+}
+
+func (m *MsgUpdateObserverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVoteBlockHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	l = m.Header.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgVoteBlockHeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BallotCreated {
+		n += 2
+	}
+	if m.VoteFinalized {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateChainParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainParams != nil {
+		l = m.ChainParams.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateChainParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateOperationalChainParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	if m.GasPriceTicker != 0 {
+		n += 1 + sovTx(uint64(m.GasPriceTicker))
+	}
+	if m.InboundTicker != 0 {
+		n += 1 + sovTx(uint64(m.InboundTicker))
+	}
+	if m.OutboundTicker != 0 {
+		n += 1 + sovTx(uint64(m.OutboundTicker))
+	}
+	if m.WatchUtxoTicker != 0 {
+		n += 1 + sovTx(uint64(m.WatchUtxoTicker))
+	}
+	if m.OutboundScheduleInterval != 0 {
+		n += 1 + sovTx(uint64(m.OutboundScheduleInterval))
+	}
+	if m.OutboundScheduleLookahead != 0 {
+		n += 1 + sovTx(uint64(m.OutboundScheduleLookahead))
+	}
+	l = m.ConfirmationParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DisableTssBlockScan {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateOperationalChainParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveChainParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *MsgRemoveChainParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddObserver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ZetaclientGranteePubkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AddNodeAccountOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgAddObserverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveObserver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveObserverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVoteBlame) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	l = m.BlameInfo.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgVoteBlameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateKeygen) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Block != 0 {
+		n += 1 + sovTx(uint64(m.Block))
+	}
+	if m.Eddsa {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateKeygenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResetChainNonces) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	if m.ChainNonceLow != 0 {
+		n += 1 + sovTx(uint64(m.ChainNonceLow))
+	}
+	if m.ChainNonceHigh != 0 {
+		n += 1 + sovTx(uint64(m.ChainNonceHigh))
+	}
+	return n
+}
+
+func (m *MsgResetChainNoncesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVoteTSS) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TssPubkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.KeygenZetaHeight != 0 {
+		n += 1 + sovTx(uint64(m.KeygenZetaHeight))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	l = len(m.TssPubkeyEddsa)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVoteTSSResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BallotCreated {
+		n += 2
+	}
+	if m.VoteFinalized {
+		n += 2
+	}
+	if m.KeygenSuccess {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgEnableCCTX) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EnableInbound {
+		n += 2
+	}
+	if m.EnableOutbound {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgEnableCCTXResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDisableCCTX) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DisableInbound {
+		n += 2
+	}
+	if m.DisableOutbound {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgDisableCCTXResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateGasPriceIncreaseFlags) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.GasPriceIncreaseFlags.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateGasPriceIncreaseFlagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateOperationalFlags) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.OperationalFlags.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateOperationalFlagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDisableFastConfirmation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *MsgDisableFastConfirmationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateV2ZetaFlows) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IsV2ZetaEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateV2ZetaFlowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProposeObserverSetChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Additions) > 0 {
+		for _, e := range m.Additions {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Removals) > 0 {
+		for _, s := range m.Removals {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ActivationHeight))
+	}
+	if m.Eddsa {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgProposeObserverSetChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnjailObserver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnjailObserverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParentRevertInheritance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IsParentRevertInheritanceEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateParentRevertInheritanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelObserverSetChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelObserverSetChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateLivenessParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.LivenessParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateLivenessParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateObserver: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateObserver: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldObserverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewObserverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateReason", wireType)
+			}
+			m.UpdateReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateReason |= ObserverUpdateReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateObserverResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateObserverResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateObserverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteBlockHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteBlockHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteBlockHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteBlockHeaderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteBlockHeaderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteBlockHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotCreated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BallotCreated = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteFinalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VoteFinalized = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateChainParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateChainParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateChainParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChainParams == nil {
+				m.ChainParams = &ChainParams{}
+			}
+			if err := m.ChainParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateChainParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateChainParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateOperationalChainParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateOperationalChainParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateOperationalChainParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceTicker", wireType)
+			}
+			m.GasPriceTicker = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPriceTicker |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundTicker", wireType)
+			}
+			m.InboundTicker = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundTicker |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundTicker", wireType)
+			}
+			m.OutboundTicker = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundTicker |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchUtxoTicker", wireType)
+			}
+			m.WatchUtxoTicker = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WatchUtxoTicker |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundScheduleInterval", wireType)
+			}
+			m.OutboundScheduleInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundScheduleInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundScheduleLookahead", wireType)
+			}
+			m.OutboundScheduleLookahead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundScheduleLookahead |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConfirmationParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableTssBlockScan", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableTssBlockScan = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateOperationalChainParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateOperationalChainParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateOperationalChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveChainParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveChainParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveChainParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgDisableFastConfirmationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUpdateV2ZetaFlows) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.IsV2ZetaEnabled {
-		n += 2
+func (m *MsgRemoveChainParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveChainParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgUpdateV2ZetaFlowsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
+func (m *MsgAddObserver) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4337,10 +6641,10 @@ func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateObserver: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddObserver: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateObserver: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddObserver: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4377,7 +6681,7 @@ func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldObserverAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4405,11 +6709,11 @@ func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OldObserverAddress = string(dAtA[iNdEx:postIndex])
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewObserverAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteePubkey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4437,13 +6741,13 @@ func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewObserverAddress = string(dAtA[iNdEx:postIndex])
+			m.ZetaclientGranteePubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateReason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddNodeAccountOnly", wireType)
 			}
-			m.UpdateReason = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4453,11 +6757,12 @@ func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdateReason |= ObserverUpdateReason(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.AddNodeAccountOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4479,7 +6784,7 @@ func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateObserverResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddObserverResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4502,10 +6807,10 @@ func (m *MsgUpdateObserverResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateObserverResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddObserverResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateObserverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddObserverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4529,7 +6834,7 @@ func (m *MsgUpdateObserverResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteBlockHeader) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveObserver) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4552,10 +6857,10 @@ func (m *MsgVoteBlockHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteBlockHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveObserver: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteBlockHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveObserver: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4591,82 +6896,10 @@ func (m *MsgVoteBlockHeader) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockHash == nil {
-				m.BlockHash = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4676,24 +6909,23 @@ func (m *MsgVoteBlockHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4716,7 +6948,7 @@ func (m *MsgVoteBlockHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteBlockHeaderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveObserverResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4739,52 +6971,12 @@ func (m *MsgVoteBlockHeaderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteBlockHeaderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveObserverResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteBlockHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveObserverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotCreated", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.BallotCreated = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteFinalized", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.VoteFinalized = bool(v != 0)
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4806,7 +6998,7 @@ func (m *MsgVoteBlockHeaderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateChainParams) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteBlame) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4829,10 +7021,10 @@ func (m *MsgUpdateChainParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateChainParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteBlame: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateChainParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteBlame: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4868,8 +7060,27 @@ func (m *MsgUpdateChainParams) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4896,10 +7107,7 @@ func (m *MsgUpdateChainParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChainParams == nil {
-				m.ChainParams = &ChainParams{}
-			}
-			if err := m.ChainParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BlameInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4924,7 +7132,7 @@ func (m *MsgUpdateChainParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateChainParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteBlameResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4947,10 +7155,10 @@ func (m *MsgUpdateChainParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateChainParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteBlameResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteBlameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4974,7 +7182,7 @@ func (m *MsgUpdateChainParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateOperationalChainParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateKeygen) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4997,10 +7205,10 @@ func (m *MsgUpdateOperationalChainParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateOperationalChainParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateKeygen: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateOperationalChainParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateKeygen: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5037,9 +7245,9 @@ func (m *MsgUpdateOperationalChainParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
-			m.ChainId = 0
+			m.Block = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5049,161 +7257,14 @@ func (m *MsgUpdateOperationalChainParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				m.Block |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceTicker", wireType)
-			}
-			m.GasPriceTicker = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GasPriceTicker |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InboundTicker", wireType)
-			}
-			m.InboundTicker = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InboundTicker |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutboundTicker", wireType)
-			}
-			m.OutboundTicker = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OutboundTicker |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WatchUtxoTicker", wireType)
-			}
-			m.WatchUtxoTicker = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WatchUtxoTicker |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutboundScheduleInterval", wireType)
-			}
-			m.OutboundScheduleInterval = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OutboundScheduleInterval |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutboundScheduleLookahead", wireType)
-			}
-			m.OutboundScheduleLookahead = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OutboundScheduleLookahead |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationParams", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ConfirmationParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableTssBlockScan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Eddsa", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -5220,7 +7281,7 @@ func (m *MsgUpdateOperationalChainParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.DisableTssBlockScan = bool(v != 0)
+			m.Eddsa = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5242,7 +7303,7 @@ func (m *MsgUpdateOperationalChainParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateOperationalChainParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateKeygenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5265,10 +7326,10 @@ func (m *MsgUpdateOperationalChainParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateOperationalChainParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateKeygenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateOperationalChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateKeygenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5292,7 +7353,7 @@ func (m *MsgUpdateOperationalChainParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveChainParams) Unmarshal(dAtA []byte) error {
+func (m *MsgResetChainNonces) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5315,17 +7376,68 @@ func (m *MsgRemoveChainParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveChainParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetChainNonces: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveChainParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetChainNonces: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var stringLen uint64
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonceLow", wireType)
+			}
+			m.ChainNonceLow = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5335,29 +7447,16 @@ func (m *MsgRemoveChainParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ChainNonceLow |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonceHigh", wireType)
 			}
-			m.ChainId = 0
+			m.ChainNonceHigh = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5367,7 +7466,7 @@ func (m *MsgRemoveChainParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				m.ChainNonceHigh |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5393,7 +7492,7 @@ func (m *MsgRemoveChainParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveChainParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResetChainNoncesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5416,10 +7515,10 @@ func (m *MsgRemoveChainParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveChainParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetChainNoncesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetChainNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5443,7 +7542,7 @@ func (m *MsgRemoveChainParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddObserver) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteTSS) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5466,10 +7565,10 @@ func (m *MsgAddObserver) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddObserver: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteTSS: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddObserver: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteTSS: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5506,7 +7605,7 @@ func (m *MsgAddObserver) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TssPubkey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5534,13 +7633,13 @@ func (m *MsgAddObserver) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			m.TssPubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteePubkey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenZetaHeight", wireType)
 			}
-			var stringLen uint64
+			m.KeygenZetaHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5550,29 +7649,16 @@ func (m *MsgAddObserver) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.KeygenZetaHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ZetaclientGranteePubkey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddNodeAccountOnly", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var v int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5582,12 +7668,43 @@ func (m *MsgAddObserver) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Status |= chains.ReceiveStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AddNodeAccountOnly = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TssPubkeyEddsa", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TssPubkeyEddsa = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5609,7 +7726,7 @@ func (m *MsgAddObserver) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddObserverResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteTSSResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5632,12 +7749,72 @@ func (m *MsgAddObserverResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddObserverResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteTSSResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddObserverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteTSSResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotCreated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BallotCreated = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteFinalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VoteFinalized = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenSuccess", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeygenSuccess = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5659,7 +7836,7 @@ func (m *MsgAddObserverResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveObserver) Unmarshal(dAtA []byte) error {
+func (m *MsgEnableCCTX) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5682,10 +7859,10 @@ func (m *MsgRemoveObserver) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveObserver: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEnableCCTX: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveObserver: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEnableCCTX: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5721,10 +7898,10 @@ func (m *MsgRemoveObserver) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableInbound", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5734,24 +7911,32 @@ func (m *MsgRemoveObserver) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			m.EnableInbound = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableOutbound", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.EnableOutbound = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5773,7 +7958,7 @@ func (m *MsgRemoveObserver) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveObserverResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgEnableCCTXResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5796,10 +7981,10 @@ func (m *MsgRemoveObserverResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveObserverResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEnableCCTXResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveObserverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEnableCCTXResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5823,7 +8008,7 @@ func (m *MsgRemoveObserverResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteBlame) Unmarshal(dAtA []byte) error {
+func (m *MsgDisableCCTX) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5846,10 +8031,10 @@ func (m *MsgVoteBlame) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteBlame: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisableCCTX: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteBlame: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisableCCTX: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5886,9 +8071,9 @@ func (m *MsgVoteBlame) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableInbound", wireType)
 			}
-			m.ChainId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5898,16 +8083,17 @@ func (m *MsgVoteBlame) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.DisableInbound = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlameInfo", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableOutbound", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5917,25 +8103,12 @@ func (m *MsgVoteBlame) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BlameInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.DisableOutbound = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5957,7 +8130,7 @@ func (m *MsgVoteBlame) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteBlameResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDisableCCTXResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5980,10 +8153,10 @@ func (m *MsgVoteBlameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteBlameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisableCCTXResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteBlameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisableCCTXResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6007,7 +8180,7 @@ func (m *MsgVoteBlameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateKeygen) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateGasPriceIncreaseFlags) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6030,10 +8203,10 @@ func (m *MsgUpdateKeygen) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateKeygen: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateGasPriceIncreaseFlags: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateKeygen: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateGasPriceIncreaseFlags: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6069,10 +8242,10 @@ func (m *MsgUpdateKeygen) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncreaseFlags", wireType)
 			}
-			m.Block = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6082,11 +8255,25 @@ func (m *MsgUpdateKeygen) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Block |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasPriceIncreaseFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6108,7 +8295,7 @@ func (m *MsgUpdateKeygen) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateKeygenResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateGasPriceIncreaseFlagsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6131,10 +8318,10 @@ func (m *MsgUpdateKeygenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateKeygenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateGasPriceIncreaseFlagsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateKeygenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateGasPriceIncreaseFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6158,7 +8345,7 @@ func (m *MsgUpdateKeygenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgResetChainNonces) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateOperationalFlags) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6181,10 +8368,10 @@ func (m *MsgResetChainNonces) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgResetChainNonces: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateOperationalFlags: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgResetChainNonces: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateOperationalFlags: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6220,10 +8407,10 @@ func (m *MsgResetChainNonces) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationalFlags", wireType)
 			}
-			m.ChainId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6233,49 +8420,25 @@ func (m *MsgResetChainNonces) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonceLow", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.ChainNonceLow = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainNonceLow |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonceHigh", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ChainNonceHigh = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainNonceHigh |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.OperationalFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6297,7 +8460,7 @@ func (m *MsgResetChainNonces) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgResetChainNoncesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateOperationalFlagsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6320,10 +8483,10 @@ func (m *MsgResetChainNoncesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgResetChainNoncesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateOperationalFlagsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgResetChainNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateOperationalFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6347,7 +8510,7 @@ func (m *MsgResetChainNoncesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteTSS) Unmarshal(dAtA []byte) error {
+func (m *MsgDisableFastConfirmation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6370,10 +8533,10 @@ func (m *MsgVoteTSS) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteTSS: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisableFastConfirmation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteTSS: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisableFastConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6409,61 +8572,10 @@ func (m *MsgVoteTSS) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TssPubkey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TssPubkey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeygenZetaHeight", wireType)
-			}
-			m.KeygenZetaHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.KeygenZetaHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.Status = 0
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6473,7 +8585,7 @@ func (m *MsgVoteTSS) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= chains.ReceiveStatus(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6499,95 +8611,35 @@ func (m *MsgVoteTSS) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteTSSResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDisableFastConfirmationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteTSSResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteTSSResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotCreated", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.BallotCreated = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteFinalized", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.VoteFinalized = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeygenSuccess", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			m.KeygenSuccess = bool(v != 0)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisableFastConfirmationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisableFastConfirmationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6609,7 +8661,7 @@ func (m *MsgVoteTSSResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEnableCCTX) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateV2ZetaFlows) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6632,10 +8684,10 @@ func (m *MsgEnableCCTX) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEnableCCTX: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateV2ZetaFlows: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEnableCCTX: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateV2ZetaFlows: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6672,27 +8724,7 @@ func (m *MsgEnableCCTX) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableInbound", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.EnableInbound = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableOutbound", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsV2ZetaEnabled", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -6709,7 +8741,7 @@ func (m *MsgEnableCCTX) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.EnableOutbound = bool(v != 0)
+			m.IsV2ZetaEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6731,7 +8763,7 @@ func (m *MsgEnableCCTX) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEnableCCTXResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateV2ZetaFlowsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6754,10 +8786,10 @@ func (m *MsgEnableCCTXResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEnableCCTXResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateV2ZetaFlowsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEnableCCTXResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateV2ZetaFlowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6781,7 +8813,7 @@ func (m *MsgEnableCCTXResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDisableCCTX) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeObserverSetChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6804,10 +8836,10 @@ func (m *MsgDisableCCTX) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisableCCTX: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeObserverSetChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisableCCTX: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeObserverSetChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6843,10 +8875,10 @@ func (m *MsgDisableCCTX) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableInbound", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Additions", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6856,15 +8888,80 @@ func (m *MsgDisableCCTX) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DisableInbound = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Additions = append(m.Additions, ObserverSetAddition{})
+			if err := m.Additions[len(m.Additions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Removals = append(m.Removals, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableOutbound", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eddsa", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -6881,7 +8978,7 @@ func (m *MsgDisableCCTX) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.DisableOutbound = bool(v != 0)
+			m.Eddsa = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6903,7 +9000,7 @@ func (m *MsgDisableCCTX) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDisableCCTXResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeObserverSetChangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6926,10 +9023,10 @@ func (m *MsgDisableCCTXResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisableCCTXResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeObserverSetChangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisableCCTXResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeObserverSetChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6953,7 +9050,7 @@ func (m *MsgDisableCCTXResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateGasPriceIncreaseFlags) Unmarshal(dAtA []byte) error {
+func (m *MsgUnjailObserver) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6976,10 +9073,10 @@ func (m *MsgUpdateGasPriceIncreaseFlags) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateGasPriceIncreaseFlags: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnjailObserver: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateGasPriceIncreaseFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnjailObserver: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7014,39 +9111,6 @@ func (m *MsgUpdateGasPriceIncreaseFlags) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncreaseFlags", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.GasPriceIncreaseFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7068,7 +9132,7 @@ func (m *MsgUpdateGasPriceIncreaseFlags) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateGasPriceIncreaseFlagsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnjailObserverResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7091,10 +9155,10 @@ func (m *MsgUpdateGasPriceIncreaseFlagsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateGasPriceIncreaseFlagsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnjailObserverResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateGasPriceIncreaseFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnjailObserverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7118,7 +9182,7 @@ func (m *MsgUpdateGasPriceIncreaseFlagsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateOperationalFlags) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParentRevertInheritance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7141,10 +9205,10 @@ func (m *MsgUpdateOperationalFlags) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateOperationalFlags: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParentRevertInheritance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateOperationalFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParentRevertInheritance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7180,10 +9244,10 @@ func (m *MsgUpdateOperationalFlags) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperationalFlags", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsParentRevertInheritanceEnabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7193,25 +9257,12 @@ func (m *MsgUpdateOperationalFlags) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.OperationalFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.IsParentRevertInheritanceEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7233,7 +9284,7 @@ func (m *MsgUpdateOperationalFlags) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateOperationalFlagsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParentRevertInheritanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7256,10 +9307,10 @@ func (m *MsgUpdateOperationalFlagsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateOperationalFlagsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParentRevertInheritanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateOperationalFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParentRevertInheritanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7283,7 +9334,7 @@ func (m *MsgUpdateOperationalFlagsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDisableFastConfirmation) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelObserverSetChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7306,10 +9357,10 @@ func (m *MsgDisableFastConfirmation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisableFastConfirmation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelObserverSetChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisableFastConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelObserverSetChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7344,25 +9395,6 @@ func (m *MsgDisableFastConfirmation) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7384,7 +9416,7 @@ func (m *MsgDisableFastConfirmation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDisableFastConfirmationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelObserverSetChangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7407,10 +9439,10 @@ func (m *MsgDisableFastConfirmationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisableFastConfirmationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelObserverSetChangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisableFastConfirmationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelObserverSetChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7434,7 +9466,7 @@ func (m *MsgDisableFastConfirmationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateV2ZetaFlows) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateLivenessParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7457,10 +9489,10 @@ func (m *MsgUpdateV2ZetaFlows) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateV2ZetaFlows: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateLivenessParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateV2ZetaFlows: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateLivenessParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7496,10 +9528,10 @@ func (m *MsgUpdateV2ZetaFlows) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsV2ZetaEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessParams", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7509,12 +9541,25 @@ func (m *MsgUpdateV2ZetaFlows) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsV2ZetaEnabled = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LivenessParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7536,7 +9581,7 @@ func (m *MsgUpdateV2ZetaFlows) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateV2ZetaFlowsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateLivenessParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7559,10 +9604,10 @@ func (m *MsgUpdateV2ZetaFlowsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateV2ZetaFlowsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateLivenessParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateV2ZetaFlowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateLivenessParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
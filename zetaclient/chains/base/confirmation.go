@@ -79,6 +79,11 @@ func (ob *Observer) IsInboundEligibleForFastConfirmation(
 		return false, err
 	}
 
+	// deposits of a paused asset are reverted by zetacore, they are not worth a fast confirmation
+	if fCoins.IsDepositPaused() {
+		return false, nil
+	}
+
 	// ensure the deposit amount does not exceed amount cap
 	fastAmountCap := chains.CalcInboundFastConfirmationAmountCap(chainID, fCoins.LiquidityCap)
 	if msg.Amount.GT(fastAmountCap) {
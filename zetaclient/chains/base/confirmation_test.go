@@ -323,6 +323,7 @@ func Test_IsInboundEligibleForFastConfirmation(t *testing.T) {
 		confParams          observertypes.ConfirmationParams
 		msg                 *crosschaintypes.MsgVoteInbound
 		failForeignCoinsRPC bool
+		depositsPaused      bool
 		eligible            bool
 		errMsg              string
 	}{
@@ -371,6 +372,19 @@ func Test_IsInboundEligibleForFastConfirmation(t *testing.T) {
 			eligible:            false,
 			errMsg:              zrepo.ErrClientGetForeignCoinsForAsset.Error(),
 		},
+		{
+			name:       "not eligible if deposits of the asset are paused",
+			confParams: confParamsEnabled,
+			msg: &crosschaintypes.MsgVoteInbound{
+				SenderChainId:           chain.ChainId,
+				Amount:                  sdkmath.NewUint(fastAmountCap.Uint64()),
+				CoinType:                coin.CoinType_Gas,
+				Asset:                   "",
+				ProtocolContractVersion: crosschaintypes.ProtocolContractVersion_V2,
+			},
+			depositsPaused: true,
+			eligible:       false,
+		},
 		{
 			name:       "not eligible if amount exceeds fast amount cap",
 			confParams: confParamsEnabled,
@@ -401,7 +415,7 @@ func Test_IsInboundEligibleForFastConfirmation(t *testing.T) {
 				ob.zetacore.
 					On("GetForeignCoinsFromAsset", mock.Anything, chain.ChainId, assetAddress).
 					Maybe().
-					Return(fungibletypes.ForeignCoins{LiquidityCap: liquidityCap}, nil)
+					Return(fungibletypes.ForeignCoins{LiquidityCap: liquidityCap, DepositsPaused: tt.depositsPaused}, nil)
 			}
 
 			// ACT
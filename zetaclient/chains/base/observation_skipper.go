@@ -4,17 +4,25 @@ import (
 	"github.com/zeta-chain/node/zetaclient/context"
 )
 
+// IsObserverAssigned returns true if the observer is eligible to vote on the ballots of the chain.
+// An observer not assigned to the chain keeps signing the outbounds (TSS keysign) but doesn't observe the chain.
+func IsObserverAssigned(ob *Observer, app *context.AppContext) bool {
+	return ob.ChainParams().IsObserverEligible(app.Config().AuthzGranter)
+}
+
 // CheckSkipInbound returns true if inbound related observations should be skipped.
 func CheckSkipInbound(ob *Observer, app *context.AppContext) bool {
 	isSupported := ob.ChainParams().IsSupported
+	isAssigned := IsObserverAssigned(ob, app)
 	isInboundEnabled := app.IsInboundObservationEnabled()
 	isMempoolCongested := app.IsMempoolCongested()
 	isMaxFeeExceeded := app.IsMaxFeeExceeded()
 
-	if !isSupported || !isInboundEnabled || isMempoolCongested || isMaxFeeExceeded {
+	if !isSupported || !isAssigned || !isInboundEnabled || isMempoolCongested || isMaxFeeExceeded {
 		ob.Logger().
 			Sampled.Info().
 			Bool("is_supported", isSupported).
+			Bool("is_assigned", isAssigned).
 			Bool("is_enabled", isInboundEnabled).
 			Bool("is_congested", isMempoolCongested).
 			Bool("is_max_fee_exceeded", isMaxFeeExceeded).
@@ -25,6 +33,8 @@ func CheckSkipInbound(ob *Observer, app *context.AppContext) bool {
 }
 
 // CheckSkipOutbound returns true if outbound related observations should be skipped.
+// The observers not assigned to the chain keep tracking the outbounds they sign (the votes are skipped by zrepo),
+// so they still need an RPC endpoint for the chain.
 func CheckSkipOutbound(ob *Observer, app *context.AppContext) bool {
 	isSupported := ob.ChainParams().IsSupported
 	isOutboundEnabled := app.IsOutboundObservationEnabled()
@@ -45,13 +55,15 @@ func CheckSkipOutbound(ob *Observer, app *context.AppContext) bool {
 // CheckSkipGasPrice returns true if gas price observation should be skipped.
 func CheckSkipGasPrice(ob *Observer, app *context.AppContext) bool {
 	isSupported := ob.ChainParams().IsSupported
+	isAssigned := IsObserverAssigned(ob, app)
 	isMempoolCongested := app.IsMempoolCongested()
 	isMaxFeeExceeded := app.IsMaxFeeExceeded()
 
-	if !isSupported || isMempoolCongested || isMaxFeeExceeded {
+	if !isSupported || !isAssigned || isMempoolCongested || isMaxFeeExceeded {
 		ob.Logger().
 			Sampled.Info().
 			Bool("is_supported", isSupported).
+			Bool("is_assigned", isAssigned).
 			Bool("is_congested", isMempoolCongested).
 			Bool("is_max_fee_exceeded", isMaxFeeExceeded).
 			Msg("skip gas price observation")
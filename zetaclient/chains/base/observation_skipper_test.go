@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/testutil/sample"
 	observertypes "github.com/zeta-chain/node/x/observer/types"
 	"github.com/zeta-chain/node/zetaclient/config"
 	"github.com/zeta-chain/node/zetaclient/context"
@@ -16,6 +17,7 @@ import (
 func Test_CheckSkipInbound(t *testing.T) {
 	tests := []struct {
 		name                string
+		isNotAssigned       bool
 		isInboundEnabled    bool
 		isMempoolCongested  bool
 		isMaxFeeExceeded    bool
@@ -29,6 +31,14 @@ func Test_CheckSkipInbound(t *testing.T) {
 			isMaxFeeExceeded:   false,
 			expectedSkip:       false,
 		},
+		{
+			name:               "should skip when observer is not assigned to chain",
+			isNotAssigned:      true,
+			isInboundEnabled:   true,
+			isMempoolCongested: false,
+			isMaxFeeExceeded:   false,
+			expectedSkip:       true,
+		},
 		{
 			name:               "should skip when inbound is disabled",
 			isInboundEnabled:   false,
@@ -59,7 +69,11 @@ func Test_CheckSkipInbound(t *testing.T) {
 			chain := chains.Ethereum
 			ethParams := observertypes.GetDefaultEthMainnetChainParams()
 			ethParams.IsSupported = true
+			if tt.isNotAssigned {
+				ethParams.Observers = []string{sample.AccAddress()}
+			}
 			ob := newTestSuite(t, chains.Ethereum)
+			ob.SetChainParams(*ethParams)
 
 			// mock app context
 			appCtx := mockAppContext(
@@ -84,6 +98,7 @@ func Test_CheckSkipInbound(t *testing.T) {
 func Test_CheckSkipOutbound(t *testing.T) {
 	tests := []struct {
 		name               string
+		isNotAssigned      bool
 		isOutboundEnabled  bool
 		isMempoolCongested bool
 		expectedSkip       bool
@@ -94,6 +109,13 @@ func Test_CheckSkipOutbound(t *testing.T) {
 			isMempoolCongested: false,
 			expectedSkip:       false,
 		},
+		{
+			name:               "should not skip when observer is not assigned to chain",
+			isNotAssigned:      true,
+			isOutboundEnabled:  true,
+			isMempoolCongested: false,
+			expectedSkip:       false,
+		},
 		{
 			name:               "should skip when outbound is disabled",
 			isOutboundEnabled:  false,
@@ -115,7 +137,11 @@ func Test_CheckSkipOutbound(t *testing.T) {
 			chain := chains.Ethereum
 			ethParams := observertypes.GetDefaultEthMainnetChainParams()
 			ethParams.IsSupported = true
+			if tt.isNotAssigned {
+				ethParams.Observers = []string{sample.AccAddress()}
+			}
 			ob := newTestSuite(t, chains.Ethereum)
+			ob.SetChainParams(*ethParams)
 
 			// mock app context
 			appCtx := mockAppContext(t, chain, *ethParams, true, tt.isOutboundEnabled, tt.isMempoolCongested, false)
@@ -132,6 +158,7 @@ func Test_CheckSkipOutbound(t *testing.T) {
 func Test_CheckSkipGasPrice(t *testing.T) {
 	tests := []struct {
 		name               string
+		isNotAssigned      bool
 		isMempoolCongested bool
 		isMaxFeeExceeded   bool
 		expectedSkip       bool
@@ -142,6 +169,13 @@ func Test_CheckSkipGasPrice(t *testing.T) {
 			isMaxFeeExceeded:   false,
 			expectedSkip:       false,
 		},
+		{
+			name:               "should skip when observer is not assigned to chain",
+			isNotAssigned:      true,
+			isMempoolCongested: false,
+			isMaxFeeExceeded:   false,
+			expectedSkip:       true,
+		},
 		{
 			name:               "should skip when mempool is congested",
 			isMempoolCongested: true,
@@ -163,7 +197,11 @@ func Test_CheckSkipGasPrice(t *testing.T) {
 			chain := chains.Ethereum
 			ethParams := observertypes.GetDefaultEthMainnetChainParams()
 			ethParams.IsSupported = true
+			if tt.isNotAssigned {
+				ethParams.Observers = []string{sample.AccAddress()}
+			}
 			ob := newTestSuite(t, chains.Ethereum)
+			ob.SetChainParams(*ethParams)
 
 			// mock app context
 			appCtx := mockAppContext(t, chain, *ethParams, true, true, tt.isMempoolCongested, tt.isMaxFeeExceeded)
@@ -9,6 +9,7 @@ import (
 
 	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
 	"github.com/zeta-chain/node/zetaclient/chains/tssrepo"
 	"github.com/zeta-chain/node/zetaclient/compliance"
 	"github.com/zeta-chain/node/zetaclient/logs"
@@ -39,6 +40,9 @@ type Signer struct {
 	// nextTSSNonce is the next TSS nonce to sign
 	nextTSSNonce uint64
 
+	// signatureScheme is the TSS key signing the outbounds, as set in the chain params
+	signatureScheme observertypes.SignatureScheme
+
 	// mu protects fields from concurrent access
 	// Note: base signer simply provides the mutex. It's the sub-struct's responsibility to use it to be thread-safe
 	mu sync.RWMutex
@@ -194,6 +198,24 @@ func (s *Signer) PassesCompliance(cctx *types.CrossChainTx) bool {
 	return false
 }
 
+// PassesExpiry checks if the outbound of the cctx is not expired and prints a log otherwise.
+// zetacore expires the pending outbound of an inbound exceeding its deadline,
+// the outbound is then cancelled without transferring any funds and the cctx is reverted.
+func (s *Signer) PassesExpiry(cctx *types.CrossChainTx) bool {
+	params := cctx.GetCurrentOutboundParam()
+	if !params.IsExpired {
+		return true
+	}
+
+	s.Logger().Std.Info().
+		Str(logs.FieldCctxIndex, cctx.Index).
+		Uint64(logs.FieldNonce, params.TssNonce).
+		Uint64("inbound_deadline", cctx.InboundParams.Deadline).
+		Msg("outbound expired, cancelling it")
+
+	return false
+}
+
 // SetNextTSSNonce sets the next TSS nonce metrics
 func (s *Signer) SetNextTSSNonce(nonce uint64) {
 	s.mu.Lock()
@@ -206,6 +228,25 @@ func (s *Signer) SetNextTSSNonce(nonce uint64) {
 	}
 }
 
+// SetSignatureScheme sets the TSS key signing the outbounds.
+func (s *Signer) SetSignatureScheme(scheme observertypes.SignatureScheme) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.signatureScheme != scheme {
+		s.signatureScheme = scheme
+		s.logger.Std.Info().Stringer("signature_scheme", scheme).Msg("updated signature scheme")
+	}
+}
+
+// SignatureScheme returns the TSS key signing the outbounds.
+func (s *Signer) SignatureScheme() observertypes.SignatureScheme {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.signatureScheme
+}
+
 // OutboundID returns the outbound ID.
 func OutboundID(index string, receiverChainID int64, nonce uint64) string {
 	return fmt.Sprintf("%s-%d-%d", index, receiverChainID, nonce)
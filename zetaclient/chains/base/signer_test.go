@@ -7,6 +7,7 @@ import (
 
 	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/testutil/sample"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
 	"github.com/zeta-chain/node/zetaclient/config"
 	"github.com/zeta-chain/node/zetaclient/mode"
 	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
@@ -59,6 +60,16 @@ func Test_BeingReportedFlag(t *testing.T) {
 	require.False(t, alreadySet)
 }
 
+func Test_SignatureScheme(t *testing.T) {
+	signer := newSignerTestSuite(t)
+
+	// ECDSA by default
+	require.Equal(t, observertypes.SignatureScheme_ECDSA, signer.SignatureScheme())
+
+	signer.SetSignatureScheme(observertypes.SignatureScheme_EDDSA)
+	require.Equal(t, observertypes.SignatureScheme_EDDSA, signer.SignatureScheme())
+}
+
 func Test_PassesCompliance(t *testing.T) {
 	signer := newSignerTestSuite(t)
 
@@ -82,3 +93,19 @@ func Test_PassesCompliance(t *testing.T) {
 		require.True(t, signer.PassesCompliance(cctx))
 	})
 }
+
+func Test_PassesExpiry(t *testing.T) {
+	signer := newSignerTestSuite(t)
+
+	t.Run("should return false for expired outbound", func(t *testing.T) {
+		cctx := sample.CrossChainTxV2(t, "abcd")
+		cctx.GetCurrentOutboundParam().IsExpired = true
+
+		require.False(t, signer.PassesExpiry(cctx))
+	})
+	t.Run("should return true for non expired outbound", func(t *testing.T) {
+		cctx := sample.CrossChainTxV2(t, "abcd")
+
+		require.True(t, signer.PassesExpiry(cctx))
+	})
+}
@@ -7,13 +7,10 @@ import (
 	"math/big"
 
 	cosmosmath "cosmossdk.io/math"
-	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 
-	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/pkg/coin"
 	"github.com/zeta-chain/node/pkg/constant"
-	"github.com/zeta-chain/node/pkg/crypto"
 	"github.com/zeta-chain/node/pkg/memo"
 	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
 	zetabtc "github.com/zeta-chain/node/zetaclient/chains/bitcoin/common"
@@ -100,13 +97,6 @@ func (event *BTCInboundEvent) Category() clienttypes.InboundCategory {
 // DecodeMemoBytes decodes the contained memo bytes as either standard or legacy memo
 // It updates the event object with the decoded data
 func (event *BTCInboundEvent) DecodeMemoBytes(chainID int64) error {
-	var (
-		err            error
-		isStandardMemo bool
-		memoStd        *memo.InboundMemo
-		receiver       ethcommon.Address
-	)
-
 	// skip decoding if no memo is found, returning error to revert the inbound
 	if bytes.Equal(event.MemoBytes, []byte(noMemoFound)) {
 		event.MemoBytes = []byte{}
@@ -118,45 +108,17 @@ func (event *BTCInboundEvent) DecodeMemoBytes(chainID int64) error {
 		return nil
 	}
 
-	// try to decode the standard memo as the preferred format
-	// then process standard memo or fallback to legacy memo
-	// note: err is guaranteed to be nil when 'isStandardMemo == false',
-	// so a non-nil error indicates the standard memo contains improper data
-	memoStd, isStandardMemo, err = memo.DecodeFromBytes(event.MemoBytes)
+	// decode the standard memo as the preferred format or fallback to legacy memo
+	memoStd, receiver, payload, err := memo.DecodeBitcoinMemo(event.MemoBytes, chainID)
 	if err != nil {
-		return errors.Wrap(err, "standard memo contains improper data")
+		return err
 	}
 
-	if isStandardMemo {
-		// validate the content of the standard memo
-		err = ValidateStandardMemo(*memoStd, chainID)
-		if err != nil {
-			return errors.Wrap(err, "invalid standard memo for bitcoin")
-		}
-
-		event.MemoStd = memoStd
-		receiver = memoStd.Receiver
-	} else {
-		// legacy memo, ensure the it is no less than ZEVM address length (20-byte receiver)
-		// checking upfront is to return more informative error message in the CCTX struct
-		if len(event.MemoBytes) < ethcommon.AddressLength {
-			return errors.New("legacy memo length must be at least 20 bytes")
-		}
-
-		parsedAddress, payload, err := memo.DecodeLegacyMemoHex(hex.EncodeToString(event.MemoBytes))
-		if err != nil { // unreachable code
-			return errors.Wrap(err, "invalid legacy memo")
-		}
-		receiver = parsedAddress
-
-		// update the memo bytes to only contain the data
+	// update the memo bytes to only contain the data for legacy memo
+	event.MemoStd = memoStd
+	if memoStd == nil {
 		event.MemoBytes = payload
 	}
-
-	// ensure the receiver is valid
-	if crypto.IsEmptyAddress(receiver) {
-		return errors.New("got empty receiver address from memo")
-	}
 	event.ToAddress = receiver.Hex()
 
 	return nil
@@ -224,12 +186,7 @@ func (event BTCInboundEvent) RevertOptions() crosschaintypes.RevertOptions {
 		return crosschaintypes.NewEmptyRevertOptions()
 	}
 
-	// 'CallOnRevert' and 'RevertGasLimit' are irrelevant to bitcoin inbound
-	return crosschaintypes.RevertOptions{
-		RevertAddress: event.MemoStd.RevertOptions.RevertAddress,
-		AbortAddress:  event.MemoStd.RevertOptions.AbortAddress,
-		RevertMessage: event.MemoStd.RevertOptions.RevertMessage,
-	}
+	return memo.BitcoinMemoRevertOptions(event.MemoStd)
 }
 
 // IsCrossChainCall returns true if the inbound is a cross-chain call.
@@ -242,21 +199,14 @@ func (event BTCInboundEvent) IsCrossChainCall() bool {
 	return event.MemoStd.OpCode == memo.OpCodeCall || event.MemoStd.OpCode == memo.OpCodeDepositAndCall
 }
 
+// IsArbitraryCall returns true if the standard memo asks for an arbitrary call.
+func (event BTCInboundEvent) IsArbitraryCall() bool {
+	return event.MemoStd != nil && event.MemoStd.IsArbitraryCall
+}
+
 // ValidateStandardMemo validates the standard memo in Bitcoin context
 func ValidateStandardMemo(memoStd memo.InboundMemo, chainID int64) error {
-	// ensure the revert address is a valid and supported BTC address
-	revertAddress := memoStd.RevertOptions.RevertAddress
-	if revertAddress != "" {
-		btcAddress, err := chains.DecodeBtcAddress(revertAddress, chainID)
-		if err != nil {
-			return errors.Wrapf(err, "invalid revert address in memo: %s", revertAddress)
-		}
-		if !chains.IsBtcAddressSupported(btcAddress) {
-			return fmt.Errorf("unsupported revert address in memo: %s", revertAddress)
-		}
-	}
-
-	return nil
+	return memo.ValidateBitcoinRevertAddress(memoStd.RevertOptions.RevertAddress, chainID)
 }
 
 // IsEventProcessable checks if the inbound event is processable
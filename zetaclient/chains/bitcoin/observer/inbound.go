@@ -9,6 +9,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 
+	"github.com/zeta-chain/node/pkg/memo"
 	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
 	"github.com/zeta-chain/node/zetaclient/chains/bitcoin/common"
 	"github.com/zeta-chain/node/zetaclient/config"
@@ -261,6 +262,14 @@ func NewBtcInboundVote(
 		options = append(options, crosschaintypes.WithErrorMessage(event.ErrorMessage))
 	}
 
+	// add deadline and receiver splits if carried by the standard memo
+	if event.MemoStd != nil {
+		options = append(options,
+			crosschaintypes.WithDeadline(event.MemoStd.Deadline),
+			crosschaintypes.WithReceiverSplits(memo.StandardMemoReceiverSplits(event.MemoStd)),
+		)
+	}
+
 	return crosschaintypes.NewMsgVoteInbound(
 		operatorAddress,
 		event.FromAddress,
@@ -277,7 +286,7 @@ func NewBtcInboundVote(
 		"",
 		0,
 		crosschaintypes.ProtocolContractVersion_V2,
-		false, // no arbitrary call for deposit to ZetaChain
+		event.IsArbitraryCall(),
 		event.Status,
 		confirmationMode,
 		options...,
@@ -178,6 +178,18 @@ func (ob *Observer) VoteOutboundIfConfirmed(ctx context.Context, cctx *crosschai
 		receiveStatus = chains.ReceiveStatus_failed
 	}
 
+	// an expired outbound is either cancelled by the signers or was already paid before expiry,
+	// only the cancelled one is failed and reverts the CCTX
+	if params.IsExpired {
+		cancelled, err := ob.isOutboundCancelled(ctx, &params, res)
+		if err != nil {
+			return false, errors.Wrapf(err, "error checking expired outbound %s", res.TxID)
+		}
+		if cancelled {
+			receiveStatus = chains.ReceiveStatus_failed
+		}
+	}
+
 	msg := crosschaintypes.NewMsgVoteOutbound(
 		ob.ZetaRepo().GetOperatorAddress(),
 		cctx.Index,
@@ -379,7 +391,22 @@ func (ob *Observer) checkTssOutboundResult(
 	}
 
 	// differentiate between normal and cancelled cctx
-	if compliance.IsCCTXRestricted(cctx) || params.Amount.Uint64() < constant.BTCWithdrawalDustAmount {
+	switch {
+	case params.IsExpired:
+		// expired outbound can be either cancelled or paid if signed before expiry
+		if ob.checkTSSVoutCancelled(params, rawResult.Vout) == nil {
+			return nil
+		}
+		err = ob.checkTSSVout(params, rawResult.Vout)
+		if err != nil {
+			return errors.Wrapf(
+				err,
+				"checkTssOutboundResult: invalid TSS Vout in expired outbound %s nonce %d",
+				hash,
+				nonce,
+			)
+		}
+	case compliance.IsCCTXRestricted(cctx) || params.Amount.Uint64() < constant.BTCWithdrawalDustAmount:
 		err = ob.checkTSSVoutCancelled(params, rawResult.Vout)
 		if err != nil {
 			return errors.Wrapf(
@@ -389,7 +416,7 @@ func (ob *Observer) checkTssOutboundResult(
 				nonce,
 			)
 		}
-	} else {
+	default:
 		err = ob.checkTSSVout(params, rawResult.Vout)
 		if err != nil {
 			return errors.Wrapf(err, "checkTssOutboundResult: invalid TSS Vout in outbound %s nonce %d", hash, nonce)
@@ -398,6 +425,23 @@ func (ob *Observer) checkTssOutboundResult(
 	return nil
 }
 
+// isOutboundCancelled returns true if the outbound tx pays nothing to the receiver (cancelled outbound)
+func (ob *Observer) isOutboundCancelled(
+	ctx context.Context,
+	params *crosschaintypes.OutboundParams,
+	res *btcjson.GetTransactionResult,
+) (bool, error) {
+	hash, err := chainhash.NewHashFromStr(res.TxID)
+	if err != nil {
+		return false, errors.Wrapf(err, "invalid tx hash %s", res.TxID)
+	}
+	rawResult, err := ob.bitcoinClient.GetRawTransactionResult(ctx, hash, res)
+	if err != nil {
+		return false, errors.Wrapf(err, "error GetRawTransactionResult %s", res.TxID)
+	}
+	return ob.checkTSSVoutCancelled(params, rawResult.Vout) == nil, nil
+}
+
 // checkTSSVin checks vin is valid if:
 //   - The first input is the nonce-mark
 //   - All inputs are from TSS address
@@ -54,7 +54,7 @@ func NewOutboundData(
 	cctx *types.CrossChainTx,
 	height uint64,
 	minRelayFee float64,
-	isRestricted bool,
+	shouldCancel bool,
 	logger zerolog.Logger,
 ) (*OutboundData, error) {
 	if cctx == nil {
@@ -128,7 +128,7 @@ func NewOutboundData(
 	}
 
 	// set the amount to 0 when the tx should be cancelled
-	cancelTx := isRestricted || dustAmount
+	cancelTx := shouldCancel || dustAmount
 	if cancelTx {
 		amount = 0.0
 		amountSats = 0
@@ -34,7 +34,7 @@ func Test_NewOutboundData(t *testing.T) {
 		cctxModifier func(cctx *crosschaintypes.CrossChainTx)
 		height       uint64
 		minRelayFee  float64
-		isRestricted bool
+		shouldCancel bool
 		expected     *OutboundData
 		errMsg       string
 	}{
@@ -186,7 +186,7 @@ func Test_NewOutboundData(t *testing.T) {
 			},
 			height:       101,
 			minRelayFee:  0.00001, // 1000 sat/KB
-			isRestricted: true,
+			shouldCancel: true,
 			expected: &OutboundData{
 				to:          receiver,
 				amount:      0, // should cancel the tx
@@ -231,7 +231,7 @@ func Test_NewOutboundData(t *testing.T) {
 				tt.cctxModifier(tt.cctx)
 			}
 
-			outboundData, err := NewOutboundData(tt.cctx, tt.height, tt.minRelayFee, tt.isRestricted, log.Logger)
+			outboundData, err := NewOutboundData(tt.cctx, tt.height, tt.minRelayFee, tt.shouldCancel, log.Logger)
 			if tt.errMsg != "" {
 				require.Nil(t, outboundData)
 				require.ErrorContains(t, err, tt.errMsg)
@@ -129,11 +129,11 @@ func (signer *Signer) TryProcessOutbound(
 		return
 	}
 
-	// compliance check
-	isRestricted := !signer.PassesCompliance(cctx)
+	// compliance and expiry check, restricted and expired outbounds are cancelled
+	shouldCancel := !signer.PassesCompliance(cctx) || !signer.PassesExpiry(cctx)
 
 	// setup outbound data
-	txData, err := NewOutboundData(cctx, height, minRelayFee, isRestricted, logger)
+	txData, err := NewOutboundData(cctx, height, minRelayFee, shouldCancel, logger)
 	if err != nil {
 		logger.Error().Err(err).Msg("failed to setup Bitcoin outbound data")
 		return
@@ -182,7 +182,7 @@ func (ob *Observer) VoteOutboundIfConfirmed(
 	// cancelled transaction means the outbound is failed
 	// - set amount to CCTX's amount to bypass amount check in zetacore
 	// - set status to failed to revert the CCTX in zetacore
-	if compliance.IsCCTXRestricted(cctx) {
+	if compliance.IsCCTXRestricted(cctx) || ob.isExpiredCancel(cctx, transaction) {
 		receiveValue = cctx.GetCurrentOutboundParam().Amount.BigInt()
 		receiveStatus = chains.ReceiveStatus_failed
 		ob.postVoteOutbound(ctx, cctx.Index, receipt, transaction, receiveValue, receiveStatus, nonce, cointype, logger)
@@ -386,6 +386,19 @@ func parseAndCheckWithdrawnEvent(
 	return nil, errors.New("no ERC20 Withdrawn event found")
 }
 
+// isExpiredCancel returns true if the outbound of an expired CCTX is a cancel tx (zero value self-transfer to TSS).
+// An expired outbound signed before expiry is processed as a normal outbound.
+func (ob *Observer) isExpiredCancel(cctx *crosschaintypes.CrossChainTx, transaction *ethtypes.Transaction) bool {
+	if !cctx.GetCurrentOutboundParam().IsExpired {
+		return false
+	}
+	to := transaction.To()
+	return to != nil &&
+		*to == ob.TSS().PubKey().AddressEVM() &&
+		transaction.Value().Sign() == 0 &&
+		len(transaction.Data()) == 0
+}
+
 // filterTSSOutbound filters the outbounds from TSS address to supplement outbound trackers
 func (ob *Observer) filterTSSOutbound(ctx context.Context, startBlock, toBlock uint64) {
 	// filters the outbounds from TSS address block by block
@@ -370,6 +370,9 @@ func (signer *Signer) SignOutboundFromCCTX(
 	case !signer.PassesCompliance(cctx):
 		// restricted cctx
 		return signer.SignCancel(outboundData)
+	case !signer.PassesExpiry(cctx):
+		// expired cctx
+		return signer.SignCancel(outboundData)
 	case cctx.InboundParams.CoinType == coin.CoinType_Cmd:
 		// admin command
 		to := ethcommon.HexToAddress(cctx.GetCurrentOutboundParam().Receiver)
@@ -24,4 +24,21 @@ type TSSSigner interface {
 		nonce uint64,
 		chainID int64,
 	) ([][65]byte, error)
+
+	// PubKeyEdDSA returns the ed25519 TSS key, false if the TSS has none.
+	PubKeyEdDSA() (tss.PubKeyEdDSA, bool)
+
+	SignEdDSA(_ context.Context,
+		digest []byte,
+		height uint64,
+		nonce uint64,
+		chainID int64,
+	) ([64]byte, error)
+
+	SignBatchEdDSA(_ context.Context,
+		digests [][]byte,
+		height uint64,
+		nonce uint64,
+		chainID int64,
+	) ([][64]byte, error)
 }
@@ -29,6 +29,7 @@ type ZetacoreWriter interface {
 
 	PostVoteTSS(_ context.Context,
 		tssPubKey string,
+		tssPubKeyEdDSA string,
 		keyGenZetaHeight int64,
 		_ chains.ReceiveStatus,
 	) (string, error)
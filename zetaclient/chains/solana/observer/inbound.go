@@ -13,6 +13,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 
+	"github.com/zeta-chain/node/pkg/memo"
 	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
 	"github.com/zeta-chain/node/zetaclient/chains/solana/repo"
 	"github.com/zeta-chain/node/zetaclient/compliance"
@@ -299,6 +300,13 @@ func FilterInboundEvents(
 
 // BuildInboundVoteMsgFromEvent builds a MsgVoteInbound from an inbound event
 func (ob *Observer) BuildInboundVoteMsgFromEvent(event *clienttypes.InboundEvent) *crosschaintypes.MsgVoteInbound {
+	// decode the standard memo carried by the payload, if any
+	if err := event.DecodeStandardMemo(validateAddress); err != nil {
+		ob.Logger().Inbound.Info().Err(err).Str(logs.FieldTx, event.TxHash).Msg("invalid standard memo")
+		event.Status = crosschaintypes.InboundStatus_INVALID_MEMO
+		event.ErrorMessage = err.Error()
+	}
+
 	// check if the event is processable
 	if !ob.IsEventProcessable(*event) {
 		return nil
@@ -336,10 +344,19 @@ func NewSolanaInboundVote(
 	options := []crosschaintypes.InboundVoteOption{
 		crosschaintypes.WithCrossChainCall(event.IsCrossChainCall),
 	}
-	if event.RevertOptions != nil {
+
+	// options carried by the standard memo take precedence over the instruction ones
+	switch {
+	case event.MemoStd != nil:
+		options = append(options, memo.StandardMemoVoteOptions(event.MemoStd)...)
+	case event.RevertOptions != nil:
 		options = append(options, crosschaintypes.WithSOLRevertOptions(*event.RevertOptions))
 	}
 
+	if event.ErrorMessage != "" {
+		options = append(options, crosschaintypes.WithErrorMessage(event.ErrorMessage))
+	}
+
 	return crosschaintypes.NewMsgVoteInbound(
 		operatorAddress,
 		event.Sender,
@@ -356,9 +373,15 @@ func NewSolanaInboundVote(
 		event.Asset,
 		uint64(event.Index),
 		crosschaintypes.ProtocolContractVersion_V2,
-		false, // not used
-		crosschaintypes.InboundStatus_SUCCESS,
+		event.MemoStd != nil && event.MemoStd.IsArbitraryCall,
+		event.Status,
 		crosschaintypes.ConfirmationMode_SAFE,
 		options...,
 	)
 }
+
+// validateAddress checks whether the given string is a valid Solana address
+func validateAddress(address string) error {
+	_, err := solana.PublicKeyFromBase58(address)
+	return err
+}
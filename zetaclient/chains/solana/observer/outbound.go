@@ -1,6 +1,7 @@
 package observer
 
 import (
+	"bytes"
 	"context"
 	stderrors "errors"
 	"fmt"
@@ -291,6 +292,11 @@ func (ob *Observer) CheckFinalizedTx(
 		return nil, false
 	}
 
+	// outbounds of the native-signature gateway variant are authorized by the EdDSA TSS key
+	if ob.isSignedByTSSEdDSA(txResult, inst) {
+		return txResult, true
+	}
+
 	// recover ECDSA signer from instruction
 	signerECDSA, err := inst.Signer()
 	if err != nil {
@@ -310,6 +316,43 @@ func (ob *Observer) CheckFinalizedTx(
 	return txResult, true
 }
 
+// isSignedByTSSEdDSA checks whether the tx contains an ed25519 program instruction
+// verifying the EdDSA TSS signature of the gateway instruction's message hash.
+func (ob *Observer) isSignedByTSSEdDSA(
+	txResult *rpc.GetTransactionResult,
+	inst contracts.OutboundInstruction,
+) bool {
+	pubKey, ok := ob.TSS().PubKeyEdDSA()
+	if !ok {
+		return false
+	}
+
+	tx, err := txResult.Transaction.GetTransaction()
+	if err != nil {
+		return false
+	}
+
+	msgHash := inst.SignedHash()
+
+	for _, compiled := range tx.Message.Instructions {
+		programID, err := tx.Message.Program(compiled.ProgramIDIndex)
+		if err != nil || !programID.Equals(contracts.Ed25519ProgramID) {
+			continue
+		}
+
+		verify, err := contracts.ParseEd25519VerifyInstruction(compiled.Data)
+		if err != nil {
+			continue
+		}
+
+		if verify.PubKey.Equal(pubKey.AsEd25519()) && bytes.Equal(verify.Message, msgHash[:]) && verify.Verify() {
+			return true
+		}
+	}
+
+	return false
+}
+
 // parseInstructionWith attempts to parse an instruction using a list of parsers
 func parseInstructionWith(
 	instruction solana.CompiledInstruction,
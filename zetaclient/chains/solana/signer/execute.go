@@ -51,6 +51,7 @@ func (signer *Signer) prepareExecuteTx(
 		return signer.createOutboundWithFallback(
 			ctx,
 			inst,
+			msg,
 			msgIn,
 			params.CallOptions.GasLimit,
 			msg.AddressLookupTable(),
@@ -45,6 +45,7 @@ func (signer *Signer) prepareExecuteSPLTx(
 		return signer.createOutboundWithFallback(
 			ctx,
 			inst,
+			msg,
 			msgIn,
 			params.CallOptions.GasLimit,
 			msg.AddressLookupTable(),
@@ -51,7 +51,7 @@ func (signer *Signer) prepareIncrementNonceTx(
 			return nil, errors.Wrap(err, "error creating increment nonce instruction")
 		}
 
-		tx, err := signer.signTx(ctx, inst, 0, nil, nil)
+		tx, err := signer.signTx(ctx, inst, msg, 0, nil, nil)
 		if err != nil {
 			return nil, errors.Wrap(err, "error signing increment nonce instruction")
 		}
@@ -66,7 +66,6 @@ func (signer *Signer) createAndSignMsgIncrementNonce(
 	height uint64,
 	cancelTx bool,
 ) (*contracts.MsgIncrementNonce, error) {
-	chain := signer.Chain()
 	// #nosec G115 always positive
 	chainID := uint64(signer.Chain().ChainId)
 	nonce := params.TssNonce
@@ -81,9 +80,9 @@ func (signer *Signer) createAndSignMsgIncrementNonce(
 	msg := contracts.NewMsgIncrementNonce(chainID, nonce, amount)
 	msgHash := msg.Hash()
 
-	// sign the message with TSS to get an ECDSA signature.
+	// sign the message with TSS.
 	// the produced signature is in the [R || S || V] format where V is 0 or 1.
-	signature, err := signer.TSS().Sign(ctx, msgHash[:], height, nonce, chain.ChainId)
+	signature, err := signer.sign(ctx, msgHash, height, nonce)
 	if err != nil {
 		return nil, errors.Wrap(err, "key-sign failed")
 	}
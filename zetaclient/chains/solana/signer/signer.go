@@ -14,6 +14,7 @@ import (
 	"github.com/zeta-chain/node/pkg/coin"
 	contracts "github.com/zeta-chain/node/pkg/contracts/solana"
 	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
 	"github.com/zeta-chain/node/zetaclient/chains/base"
 	"github.com/zeta-chain/node/zetaclient/chains/zrepo"
 	"github.com/zeta-chain/node/zetaclient/keys"
@@ -192,8 +193,18 @@ func (signer *Signer) TryProcessOutbound(
 		return
 	}
 
-	switch coinType {
-	case coin.CoinType_Cmd:
+	switch {
+	case coinType != coin.CoinType_Cmd && !signer.PassesExpiry(cctx):
+		// expired outbound only increments the gateway nonce, the observers vote it as failed
+		incrementNonceTxGetter, err := signer.prepareIncrementNonceTx(ctx, cctx, height, logger)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to sign increment nonce outbound")
+			return
+		}
+
+		outboundGetter = incrementNonceTxGetter
+
+	case coinType == coin.CoinType_Cmd:
 		whitelistTxGetter, err := signer.prepareWhitelistTx(ctx, cctx, height)
 		if err != nil {
 			logger.Error().Err(err).Msg("failed to sign whitelist outbound")
@@ -202,7 +213,7 @@ func (signer *Signer) TryProcessOutbound(
 
 		outboundGetter = whitelistTxGetter
 
-	case coin.CoinType_Gas:
+	case coinType == coin.CoinType_Gas:
 		isRevert := (cctx.CctxStatus.Status == types.CctxStatus_PendingRevert && cctx.RevertOptions.CallOnRevert)
 		if cctx.IsWithdrawAndCall() || isRevert {
 			executeTxGetter, err := signer.prepareExecuteTx(ctx, cctx, height, cancelTx, logger)
@@ -222,7 +233,7 @@ func (signer *Signer) TryProcessOutbound(
 			outboundGetter = withdrawTxGetter
 		}
 
-	case coin.CoinType_ERC20:
+	case coinType == coin.CoinType_ERC20:
 		if cctx.IsWithdrawAndCall() || isRevert {
 			executeSPLTxGetter, err := signer.prepareExecuteSPLTx(ctx, cctx, height, cancelTx, logger)
 			if err != nil {
@@ -240,7 +251,7 @@ func (signer *Signer) TryProcessOutbound(
 
 			outboundGetter = withdrawSPLTxGetter
 		}
-	case coin.CoinType_NoAssetCall:
+	case coinType == coin.CoinType_NoAssetCall:
 		executeTxGetter, err := signer.prepareExecuteTx(ctx, cctx, height, cancelTx, logger)
 		if err != nil {
 			logger.Error().Err(err).Msg("failed to sign execute outbound")
@@ -285,9 +296,11 @@ func (signer *Signer) TryProcessOutbound(
 
 // signTx creates and signs a Solana tx containing the provided instruction with the relayer key.
 // If `addressLookupTable` is non-nil and `addrs` is non-empty, the transaction will include an address lookup table.
+// With the EdDSA signature scheme, the instruction is preceded by an ed25519 program instruction verifying 'msg'.
 func (signer *Signer) signTx(
 	ctx context.Context,
 	inst *sol.GenericInstruction,
+	msg TSSSignedMessage,
 	limit uint64,
 	addressLookupTable *sol.PublicKey,
 	addrs sol.PublicKeySlice,
@@ -306,6 +319,13 @@ func (signer *Signer) signTx(
 		limitInst := computebudget.NewSetComputeUnitLimitInstruction(uint32(limit)).Build()
 		instructions = append(instructions, limitInst)
 	}
+	if signer.SignatureScheme() == observertypes.SignatureScheme_EDDSA {
+		verifyInst, err := signer.createEd25519VerifyInstruction(msg)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to create ed25519 verify instruction")
+		}
+		instructions = append(instructions, verifyInst)
+	}
 	instructions = append(instructions, inst)
 
 	// transaction options
@@ -395,7 +415,7 @@ func (signer *Signer) broadcastOutbound(
 					break
 				}
 
-				fallbackTx, err := signer.signTx(ctx, fallbackInst, 0, nil, nil)
+				fallbackTx, err := signer.signTx(ctx, fallbackInst, outbound.FallbackMsg, 0, nil, nil)
 				if err != nil {
 					logger.Error().Err(err).Fields(lf).Msg("error signing increment nonce instruction")
 					break
@@ -419,13 +439,14 @@ func (signer *Signer) broadcastOutbound(
 func (signer *Signer) createOutboundWithFallback(
 	ctx context.Context,
 	mainInst *sol.GenericInstruction,
+	msg TSSSignedMessage,
 	msgIn *contracts.MsgIncrementNonce,
 	computeLimit uint64,
 	addressLookupTable *sol.PublicKey,
 	addrs sol.PublicKeySlice,
 ) (*Outbound, error) {
 	// Create and sign main transaction
-	tx, err := signer.signTx(ctx, mainInst, computeLimit, addressLookupTable, addrs)
+	tx, err := signer.signTx(ctx, mainInst, msg, computeLimit, addressLookupTable, addrs)
 	if err != nil {
 		return nil, errors.Wrap(err, "error signing main instruction")
 	}
@@ -504,6 +525,12 @@ type SignableMessage[T any] interface {
 	SetSignature([65]byte) T
 }
 
+// TSSSignedMessage is a gateway message carrying the TSS signature.
+type TSSSignedMessage interface {
+	Hash() [32]byte
+	SigRS() [64]byte
+}
+
 // signMsgWithFallback TSS signs solana outbound with fallback increment nonce
 func signMsgWithFallback[T SignableMessage[T]](
 	ctx context.Context,
@@ -515,8 +542,7 @@ func signMsgWithFallback[T SignableMessage[T]](
 	msgHash := msg.Hash()
 	msgInHash := msgIn.Hash()
 
-	signature, err := signer.TSS().
-		SignBatch(ctx, [][]byte{msgHash[:], msgInHash[:]}, height, nonce, signer.Chain().ChainId)
+	signature, err := signer.signBatch(ctx, [][]byte{msgHash[:], msgInHash[:]}, height, nonce)
 	if err != nil {
 		var zero T
 		return zero, nil, errors.Wrap(err, "key-sign failed")
@@ -525,6 +551,56 @@ func signMsgWithFallback[T SignableMessage[T]](
 	return msg.SetSignature(signature[0]), msgIn.SetSignature(signature[1]), nil
 }
 
+// sign signs the message hash with the TSS key of the configured signature scheme.
+func (signer *Signer) sign(ctx context.Context, hash [32]byte, height, nonce uint64) ([65]byte, error) {
+	if signer.SignatureScheme() != observertypes.SignatureScheme_EDDSA {
+		return signer.TSS().Sign(ctx, hash[:], height, nonce, signer.Chain().ChainId)
+	}
+
+	sigs, err := signer.signBatch(ctx, [][]byte{hash[:]}, height, nonce)
+	if err != nil {
+		return [65]byte{}, err
+	}
+
+	return sigs[0], nil
+}
+
+// signBatch signs the message hashes with the TSS key of the configured signature scheme.
+//
+// EdDSA signatures are returned in the [R || S || V] layout with V = 0: the native-signature
+// gateway variant ignores the recovery ID and relies on the preceding ed25519 program instruction.
+func (signer *Signer) signBatch(ctx context.Context, hashes [][]byte, height, nonce uint64) ([][65]byte, error) {
+	chainID := signer.Chain().ChainId
+
+	if signer.SignatureScheme() != observertypes.SignatureScheme_EDDSA {
+		return signer.TSS().SignBatch(ctx, hashes, height, nonce, chainID)
+	}
+
+	sigs, err := signer.TSS().SignBatchEdDSA(ctx, hashes, height, nonce, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([][65]byte, len(sigs))
+	for i, sig := range sigs {
+		copy(result[i][:64], sig[:])
+	}
+
+	return result, nil
+}
+
+// createEd25519VerifyInstruction creates the ed25519 program instruction verifying the EdDSA TSS signature of 'msg'.
+func (signer *Signer) createEd25519VerifyInstruction(msg TSSSignedMessage) (*sol.GenericInstruction, error) {
+	pubKey, ok := signer.TSS().PubKeyEdDSA()
+	if !ok {
+		return nil, errors.New("eddsa TSS pubkey is not available")
+	}
+
+	hash := msg.Hash()
+
+	return contracts.NewEd25519VerifyInstruction(pubKey.AsEd25519(), hash[:], msg.SigRS())
+}
+
 // waitExactGatewayNonce waits for exact given gateway nonce to arrive
 //
 // the reasons are:
@@ -49,7 +49,7 @@ func (signer *Signer) prepareWhitelistTx(
 			return nil, errors.Wrap(err, "error creating whitelist instruction")
 		}
 
-		tx, err := signer.signTx(ctx, inst, 0, nil, nil)
+		tx, err := signer.signTx(ctx, inst, msg, 0, nil, nil)
 		if err != nil {
 			return nil, errors.Wrap(err, "error signing whitelist instruction")
 		}
@@ -65,7 +65,6 @@ func (signer *Signer) createAndSignMsgWhitelist(
 	whitelistCandidate sol.PublicKey,
 	whitelistEntry sol.PublicKey,
 ) (*contracts.MsgWhitelist, error) {
-	chain := signer.Chain()
 	// #nosec G115 always positive
 	chainID := uint64(signer.Chain().ChainId)
 	nonce := params.TssNonce
@@ -74,9 +73,9 @@ func (signer *Signer) createAndSignMsgWhitelist(
 	msg := contracts.NewMsgWhitelist(whitelistCandidate, whitelistEntry, chainID, nonce)
 	msgHash := msg.Hash()
 
-	// sign the message with TSS to get an ECDSA signature.
+	// sign the message with TSS.
 	// the produced signature is in the [R || S || V] format where V is 0 or 1.
-	signature, err := signer.TSS().Sign(ctx, msgHash[:], height, nonce, chain.ChainId)
+	signature, err := signer.sign(ctx, msgHash, height, nonce)
 	if err != nil {
 		return nil, errors.Wrap(err, "key-sign failed")
 	}
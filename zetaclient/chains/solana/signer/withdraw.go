@@ -41,7 +41,7 @@ func (signer *Signer) prepareWithdrawTx(
 			return nil, errors.Wrap(err, "error creating withdraw instruction")
 		}
 
-		return signer.createOutboundWithFallback(ctx, inst, msgIn, 0, nil, nil)
+		return signer.createOutboundWithFallback(ctx, inst, msg, msgIn, 0, nil, nil)
 	}, nil
 }
 
@@ -224,6 +224,7 @@ func (s *Solana) updateChainParams(ctx context.Context) error {
 
 	s.observer.SetChainParams(*params)
 	s.signer.SetGatewayAddress(params.GatewayAddress)
+	s.signer.SetSignatureScheme(params.SignatureScheme)
 
 	return nil
 }
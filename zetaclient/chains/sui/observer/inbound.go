@@ -12,6 +12,7 @@ import (
 
 	"github.com/zeta-chain/node/pkg/coin"
 	"github.com/zeta-chain/node/pkg/contracts/sui"
+	"github.com/zeta-chain/node/pkg/memo"
 	cctypes "github.com/zeta-chain/node/x/crosschain/types"
 	"github.com/zeta-chain/node/zetaclient/chains/sui/client"
 	"github.com/zeta-chain/node/zetaclient/compliance"
@@ -251,6 +252,26 @@ func (ob *Observer) constructInboundVote(
 		asset = string(deposit.CoinType)
 	}
 
+	// the payload of a cross-chain call made without receiver may carry a standard memo,
+	// in which case the receiver and the payload are replaced by the ones carried by the memo
+	var (
+		status  = cctypes.InboundStatus_SUCCESS
+		options = []cctypes.InboundVoteOption{cctypes.WithCrossChainCall(deposit.IsCrossChainCall)}
+		memoStd *memo.InboundMemo
+	)
+	if memo.CarriesStandardMemo(deposit.IsCrossChainCall, deposit.Receiver) {
+		memoStd, err = memo.DecodeStandardMemo(deposit.Payload, sui.ValidateAddress)
+		switch {
+		case err != nil:
+			status = cctypes.InboundStatus_INVALID_MEMO
+			options = append(options, cctypes.WithErrorMessage(err.Error()))
+		case memoStd != nil:
+			deposit.Receiver = memoStd.Receiver
+			deposit.Payload = memoStd.Payload
+			options = append(options, memo.StandardMemoVoteOptions(memoStd)...)
+		}
+	}
+
 	// compliance check, skip restricted tx by returning nil msg
 	if config.ContainRestrictedAddress(deposit.Sender, deposit.Receiver.String()) {
 		compliance.PrintComplianceLog(
@@ -296,9 +317,9 @@ func (ob *Observer) constructInboundVote(
 		asset,
 		event.EventIndex,
 		cctypes.ProtocolContractVersion_V2,
-		false,
-		cctypes.InboundStatus_SUCCESS,
+		memoStd != nil && memoStd.IsArbitraryCall,
+		status,
 		cctypes.ConfirmationMode_SAFE,
-		cctypes.WithCrossChainCall(deposit.IsCrossChainCall),
+		options...,
 	), nil
 }
@@ -149,8 +149,8 @@ func (s *Signer) ProcessCCTX(ctx context.Context, cctx *cctypes.CrossChainTx, ze
 		return nil
 	}
 
-	// broadcast tx according to compliance check result
-	if validReceiver && s.PassesCompliance(cctx) {
+	// broadcast tx according to compliance and expiry check result
+	if validReceiver && s.PassesCompliance(cctx) && s.PassesExpiry(cctx) {
 		txDigest, err = s.broadcastWithdrawalWithFallback(ctx, withdrawTxBuilder, cancelTxBuilder)
 	} else {
 		txDigest, err = s.broadcastCancelTx(ctx, cancelTxBuilder)
@@ -14,6 +14,7 @@ import (
 
 	"github.com/zeta-chain/node/pkg/coin"
 	toncontracts "github.com/zeta-chain/node/pkg/contracts/ton"
+	"github.com/zeta-chain/node/pkg/memo"
 	"github.com/zeta-chain/node/x/crosschain/types"
 	"github.com/zeta-chain/node/zetaclient/chains/ton/encoder"
 	"github.com/zeta-chain/node/zetaclient/compliance"
@@ -143,11 +144,8 @@ func (ob *Observer) addOutboundTracker(ctx context.Context, tx *toncontracts.Tra
 		return err
 	}
 
-	txSigner := auth.Signer
-	tssSigner := ob.TSS().PubKey().AddressEVM()
-
-	if txSigner != tssSigner {
-		logger.Warn().Stringer("signer", txSigner).Msg("skipping transaction; signer is not TSS")
+	if !ob.isSignedByTSS(auth) {
+		logger.Warn().Stringer("signer", auth.Signer).Msg("skipping transaction; signer is not TSS")
 		return nil
 	}
 
@@ -355,6 +353,12 @@ type Inbound struct {
 	message []byte
 
 	isContractCall bool
+
+	// memoStd is the standard memo carried by the call data, if any
+	memoStd *memo.InboundMemo
+
+	status       types.InboundStatus
+	errorMessage string
 }
 
 // newInbound creates a new Inbound from a toncontracts.Transaction.
@@ -399,9 +403,28 @@ func newInbound(tx *toncontracts.Transaction) (*Inbound, error) {
 		return nil, fmt.Errorf("unknown operation: %d", tx.Operation)
 	}
 
+	if memo.CarriesStandardMemo(inbound.isContractCall, inbound.receiver) {
+		inbound.decodeStandardMemo()
+	}
+
 	return inbound, nil
 }
 
+// decodeStandardMemo decodes the standard memo carried by the call data of a call made without receiver, if any.
+// The receiver and the call data are replaced by the ones carried by the memo.
+func (inbound *Inbound) decodeStandardMemo() {
+	memoStd, err := memo.DecodeStandardMemo(inbound.message, validateAddress)
+	switch {
+	case err != nil:
+		inbound.status = types.InboundStatus_INVALID_MEMO
+		inbound.errorMessage = err.Error()
+	case memoStd != nil:
+		inbound.memoStd = memoStd
+		inbound.receiver = memoStd.Receiver
+		inbound.message = memoStd.Payload
+	}
+}
+
 func (inbound *Inbound) isCompliant() bool {
 	return !config.ContainRestrictedAddress(
 		inbound.receiver.Hex(),
@@ -424,11 +447,20 @@ func (inbound *Inbound) intoVoteMessage(
 	)
 
 	var (
-		inboundHash = encoder.EncodeTx(inbound.tx.Transaction)
-		sender      = inbound.sender.ToRaw()
-		receiver    = inbound.receiver.Hex()
+		inboundHash     = encoder.EncodeTx(inbound.tx.Transaction)
+		sender          = inbound.sender.ToRaw()
+		receiver        = inbound.receiver.Hex()
+		isArbitraryCall = inbound.memoStd != nil && inbound.memoStd.IsArbitraryCall
+		options         = []types.InboundVoteOption{types.WithCrossChainCall(inbound.isContractCall)}
 	)
 
+	if inbound.memoStd != nil {
+		options = append(options, memo.StandardMemoVoteOptions(inbound.memoStd)...)
+	}
+	if inbound.errorMessage != "" {
+		options = append(options, types.WithErrorMessage(inbound.errorMessage))
+	}
+
 	return types.NewMsgVoteInbound(
 		operatorAddress,
 		sender,
@@ -445,9 +477,15 @@ func (inbound *Inbound) intoVoteMessage(
 		asset,
 		eventIndex,
 		types.ProtocolContractVersion_V2,
-		false, // not used
-		types.InboundStatus_SUCCESS,
+		isArbitraryCall,
+		inbound.status,
 		types.ConfirmationMode_SAFE,
-		types.WithCrossChainCall(inbound.isContractCall),
+		options...,
 	)
 }
+
+// validateAddress checks whether the given string is a valid TON address
+func validateAddress(address string) error {
+	_, err := ton.ParseAccountID(address)
+	return err
+}
@@ -199,9 +199,8 @@ func (ob *Observer) processOutboundTracker(ctx context.Context,
 		return errors.Wrap(err, "unable to get outbound auth")
 	}
 
-	tssSigner := ob.TSS().PubKey().AddressEVM()
-	if out.Signer != tssSigner {
-		return errors.Errorf("signer mismatch (got %s, want %s)", out.Signer, tssSigner)
+	if !ob.isSignedByTSS(out) {
+		return errors.Errorf("signer mismatch (got %s, want TSS)", out.Signer)
 	}
 
 	if nonce != uint64(out.Seqno) {
@@ -217,3 +216,13 @@ func (ob *Observer) processOutboundTracker(ctx context.Context,
 
 	return nil
 }
+
+// isSignedByTSS checks that the outbound is authorized either by the ECDSA or the EdDSA TSS key.
+func (ob *Observer) isSignedByTSS(auth toncontracts.OutboundAuth) bool {
+	if auth.IsEdDSA() {
+		pubKey, ok := ob.TSS().PubKeyEdDSA()
+		return ok && auth.VerifyEdDSA(pubKey.AsEd25519())
+	}
+
+	return auth.Signer == ob.TSS().PubKey().AddressEVM()
+}
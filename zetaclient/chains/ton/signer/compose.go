@@ -19,6 +19,9 @@ const (
 
 	// ComplianceViolation tx cancelled due to attempt to withdraw to a restricted address
 	ComplianceViolation CancelReason = 2
+
+	// InboundExpired tx cancelled due to the inbound deadline passed while the outbound was pending
+	InboundExpired CancelReason = 3
 )
 
 type outbound struct {
@@ -68,6 +71,11 @@ func (s *Signer) composeOutbound(cctx *cctypes.CrossChainTx) (outbound, error) {
 		)
 	}
 
+	// Cancel outbounds expired by zetacore
+	if !s.PassesExpiry(cctx) {
+		cancelReason = InboundExpired
+	}
+
 	// Restrict masterchain (`-1:...`) withdrawals
 	if recipient.Workchain != 0 {
 		cancelReason = InvalidWorkchain
@@ -10,6 +10,7 @@ import (
 	"github.com/zeta-chain/node/pkg/coin"
 	toncontracts "github.com/zeta-chain/node/pkg/contracts/ton"
 	cctypes "github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
 	"github.com/zeta-chain/node/zetaclient/chains/base"
 	"github.com/zeta-chain/node/zetaclient/chains/ton/rpc"
 	"github.com/zeta-chain/node/zetaclient/chains/zrepo"
@@ -160,6 +161,22 @@ func (s *Signer) SignMessage(ctx context.Context,
 
 	chainID := s.Chain().ChainId
 
+	// the native-signature gateway variant verifies ed25519 signatures with CHKSIGNU
+	if s.SignatureScheme() == observertypes.SignatureScheme_EDDSA {
+		if _, ok := s.TSS().PubKeyEdDSA(); !ok {
+			return errors.New("eddsa TSS pubkey is not available")
+		}
+
+		sig, err := s.TSS().SignEdDSA(ctx, hash[:], zetaHeight, nonce, chainID)
+		if err != nil {
+			return errors.Wrap(err, "unable to sign the message")
+		}
+
+		msg.SetSignatureEdDSA(sig)
+
+		return nil
+	}
+
 	// sig = [65]byte {R, S, V (recovery ID)}
 	sig, err := s.TSS().Sign(ctx, hash[:], zetaHeight, nonce, chainID)
 	if err != nil {
@@ -51,6 +51,35 @@ func TestSigner(t *testing.T) {
 	require.Equal(t, encoder.EncodeTx(increaseSeqnoTx), tracker2.hash)
 }
 
+func TestSignMessageEdDSA(t *testing.T) {
+	// ARRANGE
+	ts := newTestSuite(t)
+	ts.tss.WithEdDSA()
+	ts.baseSigner.SetSignatureScheme(observertypes.SignatureScheme_EDDSA)
+
+	signer := New(ts.baseSigner, ts.rpc, ts.gw)
+
+	msg := &toncontracts.Withdrawal{
+		Recipient: sample.GenerateTONAccountID(),
+		Amount:    tonCoins(t, "1"),
+		Seqno:     2,
+	}
+
+	// ACT
+	err := signer.SignMessage(ts.ctx, msg, 1, 2)
+
+	// ASSERT
+	require.NoError(t, err)
+	require.Equal(t, [65]byte{}, msg.Signature())
+
+	hash, err := msg.Hash()
+	require.NoError(t, err)
+
+	pubKey, ok := ts.tss.PubKeyEdDSA()
+	require.True(t, ok)
+	require.True(t, toncontracts.VerifyEdDSA(hash, msg.SignatureEdDSA(), pubKey.AsEd25519()))
+}
+
 func TestDrySigner(t *testing.T) {
 	// ARRANGE
 	ts := newTestSuite(t)
@@ -80,6 +80,9 @@ func (s *Signer) trackOutbound(
 func outboundFilter(ob outbound) func(tx *toncontracts.Transaction) (found bool) {
 	return func(tx *toncontracts.Transaction) bool {
 		auth, err := tx.OutboundAuth()
-		return err == nil && auth.Seqno == ob.seqno && auth.Sig == ob.message.Signature()
+		return err == nil &&
+			auth.Seqno == ob.seqno &&
+			auth.Sig == ob.message.Signature() &&
+			auth.SigEdDSA == ob.message.SignatureEdDSA()
 	}
 }
@@ -204,6 +204,7 @@ func (t *TON) updateChainParams(ctx context.Context) error {
 	}
 
 	t.signer.SetGatewayAddress(chain.Params().GatewayAddress)
+	t.signer.SetSignatureScheme(chain.Params().SignatureScheme)
 	t.observer.SetChainParams(*chain.Params())
 
 	return nil
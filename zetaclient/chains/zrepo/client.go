@@ -73,6 +73,7 @@ type ZetacoreWriterClient interface {
 
 	PostVoteTSS(_ context.Context,
 		tssPubKey string,
+		tssPubKeyEdDSA string,
 		keyGenZetaHeight int64,
 		_ chains.ReceiveStatus,
 	) (string, error)
@@ -298,6 +298,12 @@ func (repo *ZetaRepo) VoteOutbound(ctx context.Context, logger zerolog.Logger,
 		return "", "", nil
 	}
 
+	// Does not vote if the observer is not assigned to the chain, the ballot would reject the vote.
+	if !repo.isObserverAssigned(ctx) {
+		logger.Info().Msg("skipping outbound vote; observer not assigned to chain")
+		return "", "", nil
+	}
+
 	zhash, ballot, err := repo.client.PostVoteOutbound(ctx, gasLimit, retryGasLimit, msg)
 	if err != nil {
 		err = newClientError(ErrClientVoteOutbound, err)
@@ -319,6 +325,20 @@ func (repo *ZetaRepo) VoteOutbound(ctx context.Context, logger zerolog.Logger,
 // Misc.
 // ------------------------------------------------------------------------------------------------
 
+// isObserverAssigned returns false if the chain params restrict the ballots of the chain to other observers.
+// The observer is considered assigned if the app context isn't available, zetacore then rejects the vote if needed.
+func (repo *ZetaRepo) isObserverAssigned(ctx context.Context) bool {
+	app, err := zctx.FromContext(ctx)
+	if err != nil {
+		return true
+	}
+	chain, err := app.GetChain(repo.connectedChain.ChainId)
+	if err != nil {
+		return true
+	}
+	return chain.Params().IsObserverEligible(app.Config().AuthzGranter)
+}
+
 // WatchNewBlocks subscribes to new block events.
 func (repo *ZetaRepo) WatchNewBlocks(ctx context.Context) (chan cometbft.EventDataNewBlock, error) {
 	ch, err := repo.client.NewBlockSubscriber(ctx)
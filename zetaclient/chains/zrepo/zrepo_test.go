@@ -19,6 +19,8 @@ import (
 	"github.com/zeta-chain/node/testutil/sample"
 	crosschain "github.com/zeta-chain/node/x/crosschain/types"
 	observertypes "github.com/zeta-chain/node/x/observer/types"
+	"github.com/zeta-chain/node/zetaclient/config"
+	zctx "github.com/zeta-chain/node/zetaclient/context"
 	"github.com/zeta-chain/node/zetaclient/mode"
 	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
 )
@@ -222,6 +224,71 @@ func TestVoteInbound(t *testing.T) {
 // Tests of auxiliary functions
 // ------------------------------------------------------------------------------------------------
 
+func TestVoteOutbound(t *testing.T) {
+	const gasLimit = 10000
+	const retryGasLimit = 100000
+
+	// newAppContext returns a context with the app context where the chain is restricted to the given observers
+	newAppContext := func(t *testing.T, granter string, observers []string) context.Context {
+		cfg := config.New(false)
+		cfg.AuthzGranter = granter
+
+		chainParams := mocks.MockChainParams(chains.Ethereum.ChainId, 100)
+		chainParams.Observers = observers
+
+		app := zctx.New(cfg, nil, zerolog.Nop())
+		err := app.Update(
+			[]chains.Chain{chains.Ethereum},
+			nil,
+			map[int64]*observertypes.ChainParams{chains.Ethereum.ChainId: &chainParams},
+			observertypes.CrosschainFlags{},
+			observertypes.OperationalFlags{},
+			0,
+			0,
+		)
+		require.NoError(t, err)
+
+		return zctx.WithAppContext(context.Background(), app)
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		client := mocks.NewZetacoreClient(t)
+		repo := New(client, chains.Ethereum, mode.StandardMode)
+		client.WithPostVoteOutbound("zhash", "ballot")
+
+		observer := sample.AccAddress()
+		ctx := newAppContext(t, observer, []string{observer})
+
+		var buffer bytes.Buffer
+		logger := zerolog.New(&buffer)
+		msg := sample.OutboundVote(t)
+
+		zhash, ballot, err := repo.VoteOutbound(ctx, logger, gasLimit, retryGasLimit, &msg)
+		require.NoError(t, err)
+		require.Equal(t, "zhash", zhash)
+		require.Equal(t, "ballot", ballot)
+		require.Contains(t, buffer.String(), "posted outbound vote")
+	})
+
+	t.Run("observer not assigned to chain", func(t *testing.T) {
+		client := mocks.NewZetacoreClient(t)
+		repo := New(client, chains.Ethereum, mode.StandardMode)
+
+		ctx := newAppContext(t, sample.AccAddress(), []string{sample.AccAddress()})
+
+		var buffer bytes.Buffer
+		logger := zerolog.New(&buffer)
+		msg := sample.OutboundVote(t)
+
+		zhash, ballot, err := repo.VoteOutbound(ctx, logger, gasLimit, retryGasLimit, &msg)
+		require.NoError(t, err)
+		require.Empty(t, zhash)
+		require.Empty(t, ballot)
+		require.Contains(t, buffer.String(), "skipping outbound vote; observer not assigned to chain")
+		client.AssertNotCalled(t, "PostVoteOutbound", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
 func TestCheckCode(t *testing.T) {
 	notFoundErr := grpcstatus.Error(grpccodes.NotFound, "anything")
 	invalidErr := errors.New("not a GRPC error")
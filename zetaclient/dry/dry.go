@@ -60,7 +60,7 @@ func (*ZetacoreClient) PostVoteGasPrice(context.Context, chains.Chain, uint64, s
 	panic(MsgUnreachable)
 }
 
-func (*ZetacoreClient) PostVoteTSS(context.Context, string, int64, chains.ReceiveStatus,
+func (*ZetacoreClient) PostVoteTSS(context.Context, string, string, int64, chains.ReceiveStatus,
 ) (string, error) {
 	panic(MsgUnreachable)
 }
@@ -93,21 +93,40 @@ func (*ZetacoreClient) PostOutboundTracker(context.Context, int64, uint64, strin
 
 // TSSClient is a dry TSS client.
 type TSSClient struct {
-	pubKey tss.PubKey
+	pubKey      tss.PubKey
+	pubKeyEdDSA *tss.PubKeyEdDSA
 }
 
-func NewTSSClient(tssAddress string) (*TSSClient, error) {
+// NewTSSClient creates a dry TSS client, the ed25519 key is optional.
+func NewTSSClient(tssAddress, tssAddressEdDSA string) (*TSSClient, error) {
 	pubKey, err := tss.NewPubKeyFromBech32(tssAddress)
 	if err != nil {
 		return nil, fmt.Errorf("invalid TSS pub key: %w", err)
 	}
-	return &TSSClient{pubKey}, nil
+
+	client := &TSSClient{pubKey: pubKey}
+	if tssAddressEdDSA != "" {
+		pubKeyEdDSA, err := tss.NewPubKeyEdDSAFromBech32(tssAddressEdDSA)
+		if err != nil {
+			return nil, fmt.Errorf("invalid TSS eddsa pub key: %w", err)
+		}
+		client.pubKeyEdDSA = &pubKeyEdDSA
+	}
+
+	return client, nil
 }
 
 func (client *TSSClient) PubKey() tss.PubKey {
 	return client.pubKey
 }
 
+func (client *TSSClient) PubKeyEdDSA() (tss.PubKeyEdDSA, bool) {
+	if client.pubKeyEdDSA == nil {
+		return tss.PubKeyEdDSA{}, false
+	}
+	return *client.pubKeyEdDSA, true
+}
+
 func (*TSSClient) Sign(context.Context, []byte, uint64, uint64, int64) ([65]byte, error) {
 	panic(MsgUnreachable)
 }
@@ -117,6 +136,15 @@ func (*TSSClient) SignBatch(context.Context, [][]byte, uint64, uint64, int64,
 	panic(MsgUnreachable)
 }
 
+func (*TSSClient) SignEdDSA(context.Context, []byte, uint64, uint64, int64) ([64]byte, error) {
+	panic(MsgUnreachable)
+}
+
+func (*TSSClient) SignBatchEdDSA(context.Context, [][]byte, uint64, uint64, int64,
+) ([][64]byte, error) {
+	panic(MsgUnreachable)
+}
+
 func (*TSSClient) IsSignatureCached(int64, [][]byte) bool {
 	panic(MsgUnreachable)
 }
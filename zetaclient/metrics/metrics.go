@@ -51,6 +51,14 @@ var (
 		Help:      "TSS node blame counter per pubkey",
 	}, []string{"pubkey"})
 
+	// TSSNodeBlamePerChainAndReason is a counter that contains the number of tss node blame
+	// per pubkey, chain and failure reason
+	TSSNodeBlamePerChainAndReason = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: ZetaClientNamespace,
+		Name:      "tss_node_blame_by_reason_count",
+		Help:      "TSS node blame counter per pubkey, chain and failure reason",
+	}, []string{"pubkey", "chain", "reason"})
+
 	// RelayerKeyBalance is a gauge that contains the relayer key balance of the chain
 	RelayerKeyBalance = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Namespace: ZetaClientNamespace,
@@ -361,8 +361,9 @@ func (self *chaosZetacoreClient) PostVoteOutbound(
 func (self *chaosZetacoreClient) PostVoteTSS(
 	in0 m2.Context,
 	in1 string,
-	in2 int64,
-	in3 m1.ReceiveStatus,
+	in2 string,
+	in3 int64,
+	in4 m1.ReceiveStatus,
 ) (
 	out0 string,
 	out1 error,
@@ -370,7 +371,7 @@ func (self *chaosZetacoreClient) PostVoteTSS(
 	if err := self.shouldFail("ZetacoreClient", "PostVoteTSS"); err != nil {
 		out1 = err
 	} else {
-		out0, out1 = self.client.PostVoteTSS(in0, in1, in2, in3)
+		out0, out1 = self.client.PostVoteTSS(in0, in1, in2, in3, in4)
 	}
 	return
 }
@@ -1680,6 +1681,14 @@ func (self *chaosTSSClient) PubKey() (
 	return self.client.PubKey()
 }
 
+func (self *chaosTSSClient) PubKeyEdDSA() (
+	out0 m36.PubKeyEdDSA,
+	out1 bool,
+) {
+	// Functions that do not return errors cannot fail.
+	return self.client.PubKeyEdDSA()
+}
+
 func (self *chaosTSSClient) Sign(
 	in0 m2.Context,
 	in1 []uint8,
@@ -1715,3 +1724,39 @@ func (self *chaosTSSClient) SignBatch(
 	}
 	return
 }
+
+func (self *chaosTSSClient) SignBatchEdDSA(
+	in0 m2.Context,
+	in1 [][]uint8,
+	in2 uint64,
+	in3 uint64,
+	in4 int64,
+) (
+	out0 [][64]uint8,
+	out1 error,
+) {
+	if err := self.shouldFail("TSSClient", "SignBatchEdDSA"); err != nil {
+		out1 = err
+	} else {
+		out0, out1 = self.client.SignBatchEdDSA(in0, in1, in2, in3, in4)
+	}
+	return
+}
+
+func (self *chaosTSSClient) SignEdDSA(
+	in0 m2.Context,
+	in1 []uint8,
+	in2 uint64,
+	in3 uint64,
+	in4 int64,
+) (
+	out0 [64]uint8,
+	out1 error,
+) {
+	if err := self.shouldFail("TSSClient", "SignEdDSA"); err != nil {
+		out1 = err
+	} else {
+		out0, out1 = self.client.SignEdDSA(in0, in1, in2, in3, in4)
+	}
+	return
+}
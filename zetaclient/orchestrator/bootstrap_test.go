@@ -12,6 +12,7 @@ import (
 	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/pkg/constant"
 	"github.com/zeta-chain/node/pkg/scheduler"
+	"github.com/zeta-chain/node/testutil/sample"
 	observertypes "github.com/zeta-chain/node/x/observer/types"
 	"github.com/zeta-chain/node/zetaclient/config"
 	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
@@ -120,6 +121,59 @@ func TestBootstrap(t *testing.T) {
 		assert.Contains(t, ts.Log.String(), `"chain":1,"message":"removed observer-signer"`)
 	})
 
+	t.Run("EVM observer not assigned to chain", func(t *testing.T) {
+		t.Parallel()
+
+		// ARRANGE
+		// Given orchestrator
+		ts := newTestSuite(t)
+
+		// Given ETH RPC
+		ethServer := testrpc.NewEVMServer(t)
+		mockEthCalls(ts, ethServer)
+
+		observer := sample.AccAddress()
+		ts.UpdateConfig(func(cfg *config.Config) {
+			clearChainConfigs(cfg)
+
+			cfg.AuthzGranter = observer
+			cfg.EVMChainConfigs[chains.Ethereum.ChainId] = config.EVMConfig{
+				Endpoint: ethServer.Endpoint,
+			}
+		})
+
+		// Mock zetacore calls
+		mockZetacoreCalls(ts)
+
+		// ACT #1
+		// Start the orchestrator and wait for Ethereum observerSigner to bootstrap
+		require.NoError(t, ts.Start(ts.ctx))
+
+		// ASSERT #1
+		check := func() bool {
+			return ts.HasObserverSigner(chains.Ethereum.ChainId)
+		}
+
+		assert.Eventually(t, check, 5*time.Second, 100*time.Millisecond)
+
+		// ACT #2
+		// Assign Ethereum to another observer
+		ethParams := mocks.MockChainParams(chains.Ethereum.ChainId, 100)
+		ethParams.Observers = []string{sample.AccAddress()}
+		ts.MockChainParams(chains.Ethereum, ethParams)
+
+		// ASSERT #2
+		// Ethereum observerSigner is kept, so the observer still joins the TSS keysign
+		check = func() bool {
+			return !ts.HasObserverSigner(chains.Ethereum.ChainId)
+		}
+
+		assert.Never(t, check, 3*constant.ZetaBlockTime, 100*time.Millisecond)
+
+		tasksHaveGroup(t, ts.scheduler.Tasks(), "evm:1")
+		assert.NotContains(t, ts.Log.String(), `"chain":1,"message":"removed observer-signer"`)
+	})
+
 	t.Run("Solana", func(t *testing.T) {
 		t.Parallel()
 
@@ -3,6 +3,7 @@ package mocks
 import (
 	"context"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"errors"
 	"strings"
 	"testing"
@@ -17,10 +18,11 @@ import (
 type test = require.TestingT
 
 type TSS struct {
-	t          test
-	privateKey *ecdsa.PrivateKey
-	fakePubKey *zetatss.PubKey
-	paused     bool
+	t               test
+	privateKey      *ecdsa.PrivateKey
+	privateKeyEdDSA ed25519.PrivateKey
+	fakePubKey      *zetatss.PubKey
+	paused          bool
 }
 
 func NewTSS(t *testing.T) *TSS {
@@ -34,6 +36,16 @@ func NewTSSFromPrivateKey(t test, pk *ecdsa.PrivateKey) *TSS {
 	return &TSS{t: t, privateKey: pk}
 }
 
+// WithEdDSA adds a random ed25519 key to the TSS.
+func (tss *TSS) WithEdDSA() *TSS {
+	_, pk, err := ed25519.GenerateKey(nil)
+	require.NoError(tss.t, err)
+
+	tss.privateKeyEdDSA = pk
+
+	return tss
+}
+
 func (tss *TSS) PubKey() zetatss.PubKey {
 	if tss.fakePubKey != nil {
 		return *tss.fakePubKey
@@ -110,6 +122,42 @@ func (tss *TSS) SignBatch(_ context.Context, digests [][]byte, _, _ uint64, _ in
 	return sigs, nil
 }
 
+func (tss *TSS) PubKeyEdDSA() (zetatss.PubKeyEdDSA, bool) {
+	if tss.privateKeyEdDSA == nil {
+		return zetatss.PubKeyEdDSA{}, false
+	}
+
+	pubKey, err := zetatss.NewPubKeyEdDSA(tss.privateKeyEdDSA.Public().(ed25519.PublicKey))
+	require.NoError(tss.t, err)
+
+	return pubKey, true
+}
+
+func (tss *TSS) SignEdDSA(_ context.Context, digest []byte, _, _ uint64, _ int64) ([64]byte, error) {
+	sigs, err := tss.SignBatchEdDSA(context.Background(), [][]byte{digest}, 0, 0, 0)
+	if err != nil {
+		return [64]byte{}, err
+	}
+
+	return sigs[0], nil
+}
+
+func (tss *TSS) SignBatchEdDSA(_ context.Context, digests [][]byte, _, _ uint64, _ int64) ([][64]byte, error) {
+	switch {
+	case tss.paused:
+		return nil, errors.New("tss is paused")
+	case tss.privateKeyEdDSA == nil:
+		return nil, errors.New("eddsa TSS key is not set")
+	}
+
+	sigs := make([][64]byte, len(digests))
+	for i, digest := range digests {
+		copy(sigs[i][:], ed25519.Sign(tss.privateKeyEdDSA, digest))
+	}
+
+	return sigs, nil
+}
+
 func (tss *TSS) IsSignatureCached(_ int64, _ [][]byte) bool {
 	return false
 }
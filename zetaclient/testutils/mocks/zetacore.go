@@ -989,9 +989,9 @@ func (_m *ZetacoreClient) PostVoteOutbound(_a0 context.Context, gasLimit uint64,
 	return r0, r1, r2
 }
 
-// PostVoteTSS provides a mock function with given fields: _a0, tssPubKey, keyGenZetaHeight, _a3
-func (_m *ZetacoreClient) PostVoteTSS(_a0 context.Context, tssPubKey string, keyGenZetaHeight int64, _a3 chains.ReceiveStatus) (string, error) {
-	ret := _m.Called(_a0, tssPubKey, keyGenZetaHeight, _a3)
+// PostVoteTSS provides a mock function with given fields: _a0, tssPubKey, tssPubKeyEdDSA, keyGenZetaHeight, _a3
+func (_m *ZetacoreClient) PostVoteTSS(_a0 context.Context, tssPubKey string, tssPubKeyEdDSA string, keyGenZetaHeight int64, _a3 chains.ReceiveStatus) (string, error) {
+	ret := _m.Called(_a0, tssPubKey, tssPubKeyEdDSA, keyGenZetaHeight, _a3)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PostVoteTSS")
@@ -999,17 +999,17 @@ func (_m *ZetacoreClient) PostVoteTSS(_a0 context.Context, tssPubKey string, key
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, int64, chains.ReceiveStatus) (string, error)); ok {
-		return rf(_a0, tssPubKey, keyGenZetaHeight, _a3)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, chains.ReceiveStatus) (string, error)); ok {
+		return rf(_a0, tssPubKey, tssPubKeyEdDSA, keyGenZetaHeight, _a3)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, int64, chains.ReceiveStatus) string); ok {
-		r0 = rf(_a0, tssPubKey, keyGenZetaHeight, _a3)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, chains.ReceiveStatus) string); ok {
+		r0 = rf(_a0, tssPubKey, tssPubKeyEdDSA, keyGenZetaHeight, _a3)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, int64, chains.ReceiveStatus) error); ok {
-		r1 = rf(_a0, tssPubKey, keyGenZetaHeight, _a3)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64, chains.ReceiveStatus) error); ok {
+		r1 = rf(_a0, tssPubKey, tssPubKeyEdDSA, keyGenZetaHeight, _a3)
 	} else {
 		r1 = ret.Error(1)
 	}
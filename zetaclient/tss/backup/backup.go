@@ -0,0 +1,153 @@
+// Package backup implements encrypted backups of TSS key shares
+// that can be verified offline against the TSS pubkey.
+package backup
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Version is the current version of the backup format.
+const Version = 1
+
+// scrypt parameters used to derive the encryption key from the backup password
+const (
+	scryptN       = 1 << 15
+	scryptR       = 8
+	scryptP       = 1
+	scryptKeySize = 32
+	saltSize      = 32
+)
+
+// Backup is an encrypted key share. The metadata is authenticated together with
+// the ciphertext, so a backup can't be relabeled as belonging to another TSS pubkey.
+type Backup struct {
+	Version     int    `json:"version"`
+	PubKey      string `json:"pub_key"`
+	Fingerprint string `json:"fingerprint"`
+	Salt        []byte `json:"salt"`
+	Ciphertext  []byte `json:"ciphertext"`
+}
+
+// New verifies the share and creates its backup encrypted with the password.
+func New(share *Share, password string) (*Backup, error) {
+	if password == "" {
+		return nil, errors.New("backup password is empty")
+	}
+
+	if err := share.Verify(share.PubKey); err != nil {
+		return nil, errors.Wrap(err, "key share self-check failed")
+	}
+
+	fingerprint, err := share.Fingerprint()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get fingerprint")
+	}
+
+	b := &Backup{
+		Version:     Version,
+		PubKey:      share.PubKey,
+		Fingerprint: fingerprint,
+		Salt:        make([]byte, saltSize),
+	}
+
+	if _, err := rand.Read(b.Salt); err != nil {
+		return nil, errors.Wrap(err, "unable to generate salt")
+	}
+
+	aead, err := b.cipher(password)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.Wrap(err, "unable to generate nonce")
+	}
+
+	b.Ciphertext = aead.Seal(nonce, nonce, share.raw, b.additionalData())
+
+	return b, nil
+}
+
+// Parse parses a backup file.
+func Parse(content []byte) (*Backup, error) {
+	var b Backup
+	if err := json.Unmarshal(content, &b); err != nil {
+		return nil, errors.Wrap(err, "unable to decode backup")
+	}
+
+	if b.Version != Version {
+		return nil, errors.Errorf("unsupported backup version %d", b.Version)
+	}
+
+	return &b, nil
+}
+
+// Bytes returns the backup file content.
+func (b *Backup) Bytes() ([]byte, error) {
+	return json.MarshalIndent(b, "", "  ")
+}
+
+// Open decrypts the backup with the password and verifies the share against the backup's pubkey and fingerprint.
+func (b *Backup) Open(password string) (*Share, error) {
+	aead, err := b.cipher(password)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b.Ciphertext) < aead.NonceSize() {
+		return nil, errors.New("backup ciphertext is too short")
+	}
+
+	nonce, ciphertext := b.Ciphertext[:aead.NonceSize()], b.Ciphertext[aead.NonceSize():]
+
+	plaintext, err := aead.Open(nil, nonce, ciphertext, b.additionalData())
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to decrypt backup (wrong password or corrupted backup)")
+	}
+
+	share, err := ParseShare(plaintext, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := share.Verify(b.PubKey); err != nil {
+		return nil, errors.Wrap(err, "key share self-check failed")
+	}
+
+	fingerprint, err := share.Fingerprint()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get fingerprint")
+	}
+
+	if fingerprint != b.Fingerprint {
+		return nil, errors.Errorf("fingerprint mismatch (backup %s, share %s)", b.Fingerprint, fingerprint)
+	}
+
+	return share, nil
+}
+
+func (b *Backup) cipher(password string) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(password), b.Salt, scryptN, scryptR, scryptP, scryptKeySize)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to derive key")
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create cipher")
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func (b *Backup) additionalData() []byte {
+	return []byte(fmt.Sprintf("%d:%s:%s", b.Version, b.PubKey, b.Fingerprint))
+}
@@ -0,0 +1,113 @@
+package backup_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/zetaclient/tss/backup"
+)
+
+func TestBackup(t *testing.T) {
+	const password = "backup-password"
+
+	newShare := func(t *testing.T) *backup.Share {
+		s := newTestShares(t, false, 3, 1)[0]
+
+		share, err := backup.ParseShare(s.marshal(t), "")
+		require.NoError(t, err)
+
+		return share
+	}
+
+	t.Run("Roundtrip", func(t *testing.T) {
+		// ARRANGE
+		share := newShare(t)
+
+		expectedFingerprint, err := share.Fingerprint()
+		require.NoError(t, err)
+
+		// ACT
+		b, err := backup.New(share, password)
+		require.NoError(t, err)
+
+		content, err := b.Bytes()
+		require.NoError(t, err)
+
+		parsed, err := backup.Parse(content)
+		require.NoError(t, err)
+
+		restored, err := parsed.Open(password)
+
+		// ASSERT
+		require.NoError(t, err)
+		require.Equal(t, backup.Version, parsed.Version)
+		require.Equal(t, share.PubKey, parsed.PubKey)
+		require.Equal(t, expectedFingerprint, parsed.Fingerprint)
+		require.Equal(t, share.PubKey, restored.PubKey)
+		require.Equal(t, share.LocalPartyKey, restored.LocalPartyKey)
+		require.NotContains(t, string(content), share.LocalPartyKey)
+
+		fingerprint, err := restored.Fingerprint()
+		require.NoError(t, err)
+		require.Equal(t, expectedFingerprint, fingerprint)
+	})
+
+	t.Run("Wrong password", func(t *testing.T) {
+		// ARRANGE
+		b, err := backup.New(newShare(t), password)
+		require.NoError(t, err)
+
+		// ACT
+		_, err = b.Open("wrong")
+
+		// ASSERT
+		require.ErrorContains(t, err, "unable to decrypt backup")
+	})
+
+	t.Run("Empty password", func(t *testing.T) {
+		_, err := backup.New(newShare(t), "")
+		require.ErrorContains(t, err, "backup password is empty")
+	})
+
+	t.Run("Relabeled backup", func(t *testing.T) {
+		// ARRANGE
+		b, err := backup.New(newShare(t), password)
+		require.NoError(t, err)
+
+		other, err := backup.New(newShare(t), password)
+		require.NoError(t, err)
+
+		relabeledPubKey, relabeledFingerprint := *b, *b
+		relabeledPubKey.PubKey = other.PubKey
+		relabeledFingerprint.Fingerprint = other.Fingerprint
+
+		// ACT
+		_, errPubKey := relabeledPubKey.Open(password)
+		_, errFingerprint := relabeledFingerprint.Open(password)
+
+		// ASSERT
+		require.ErrorContains(t, errPubKey, "unable to decrypt backup")
+		require.ErrorContains(t, errFingerprint, "unable to decrypt backup")
+	})
+
+	t.Run("Invalid share", func(t *testing.T) {
+		// ARRANGE
+		s := newTestShares(t, false, 3, 1)[0]
+		s.data.BigXj[0] = s.data.BigXj[1]
+
+		share, err := backup.ParseShare(s.marshal(t), "")
+		require.NoError(t, err)
+
+		// ACT
+		_, err = backup.New(share, password)
+
+		// ASSERT
+		require.ErrorContains(t, err, "key share self-check failed")
+	})
+
+	t.Run("Unsupported version", func(t *testing.T) {
+		_, err := backup.Parse([]byte(`{"version": 2}`))
+		require.ErrorContains(t, err, "unsupported backup version 2")
+	})
+}
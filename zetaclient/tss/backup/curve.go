@@ -0,0 +1,214 @@
+package backup
+
+import (
+	"bytes"
+	"math/big"
+	"slices"
+
+	"filippo.io/edwards25519"
+	"filippo.io/edwards25519/field"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/pkg/errors"
+)
+
+// curve implements the group operations required to verify key shares.
+// Points are returned in the encoding used by the corresponding cosmos pubkey type.
+type curve interface {
+	order() *big.Int
+	encode(p *point) ([]byte, error)
+	scalarBaseMult(k *big.Int) ([]byte, error)
+	combine(points []*point, scalars []*big.Int) ([]byte, error)
+}
+
+// secp256k1Curve is the curve of ECDSA key shares; points are encoded as compressed pubkeys.
+type secp256k1Curve struct{}
+
+func (secp256k1Curve) order() *big.Int {
+	return btcec.S256().N
+}
+
+func (c secp256k1Curve) encode(p *point) ([]byte, error) {
+	j, err := c.toJacobian(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.fromJacobian(j)
+}
+
+func (c secp256k1Curve) scalarBaseMult(k *big.Int) ([]byte, error) {
+	var (
+		s btcec.ModNScalar
+		r btcec.JacobianPoint
+	)
+
+	s.SetByteSlice(c.scalarBytes(k))
+	btcec.ScalarBaseMultNonConst(&s, &r)
+
+	return c.fromJacobian(&r)
+}
+
+func (c secp256k1Curve) combine(points []*point, scalars []*big.Int) ([]byte, error) {
+	var sum btcec.JacobianPoint
+
+	for i, p := range points {
+		j, err := c.toJacobian(p)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			s   btcec.ModNScalar
+			res btcec.JacobianPoint
+		)
+
+		s.SetByteSlice(c.scalarBytes(scalars[i]))
+		btcec.ScalarMultNonConst(&s, j, &res)
+		btcec.AddNonConst(&sum, &res, &sum)
+	}
+
+	return c.fromJacobian(&sum)
+}
+
+func (secp256k1Curve) toJacobian(p *point) (*btcec.JacobianPoint, error) {
+	if p == nil || p.Coords[0] == nil || p.Coords[1] == nil {
+		return nil, errors.New("missing point coordinates")
+	}
+
+	var x, y btcec.FieldVal
+	if !setFieldVal(&x, p.Coords[0]) || !setFieldVal(&y, p.Coords[1]) {
+		return nil, errors.New("point coordinates are out of range")
+	}
+
+	pk := btcec.NewPublicKey(&x, &y)
+	if !pk.IsOnCurve() {
+		return nil, errors.New("point is not on secp256k1")
+	}
+
+	var j btcec.JacobianPoint
+	pk.AsJacobian(&j)
+
+	return &j, nil
+}
+
+func (secp256k1Curve) fromJacobian(j *btcec.JacobianPoint) ([]byte, error) {
+	if (j.X.IsZero() && j.Y.IsZero()) || j.Z.IsZero() {
+		return nil, errors.New("point at infinity")
+	}
+
+	j.ToAffine()
+
+	return btcec.NewPublicKey(&j.X, &j.Y).SerializeCompressed(), nil
+}
+
+func (secp256k1Curve) scalarBytes(k *big.Int) []byte {
+	return new(big.Int).Mod(k, btcec.S256().N).FillBytes(make([]byte, 32))
+}
+
+func setFieldVal(f *btcec.FieldVal, v *big.Int) bool {
+	if v.Sign() < 0 || v.BitLen() > 256 {
+		return false
+	}
+
+	overflow := f.SetByteSlice(v.FillBytes(make([]byte, 32)))
+
+	return !overflow
+}
+
+// ed25519Curve is the curve of EdDSA key shares; points are encoded as ed25519 pubkeys.
+type ed25519Curve struct{}
+
+// ed25519Order is the order of the ed25519 base point: 2^252 + 27742317777372353535851937790883648493.
+var ed25519Order, _ = new(big.Int).SetString(
+	"7237005577332262213973186563042994240857116359379907606001950938285454250989",
+	10,
+)
+
+func (ed25519Curve) order() *big.Int {
+	return ed25519Order
+}
+
+func (c ed25519Curve) encode(p *point) ([]byte, error) {
+	ep, err := c.toPoint(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return ep.Bytes(), nil
+}
+
+func (c ed25519Curve) scalarBaseMult(k *big.Int) ([]byte, error) {
+	s, err := c.toScalar(k)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(edwards25519.Point).ScalarBaseMult(s).Bytes(), nil
+}
+
+func (c ed25519Curve) combine(points []*point, scalars []*big.Int) ([]byte, error) {
+	var (
+		eps = make([]*edwards25519.Point, len(points))
+		ess = make([]*edwards25519.Scalar, len(scalars))
+		err error
+	)
+
+	for i := range points {
+		if eps[i], err = c.toPoint(points[i]); err != nil {
+			return nil, err
+		}
+
+		if ess[i], err = c.toScalar(scalars[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return new(edwards25519.Point).VarTimeMultiScalarMult(ess, eps).Bytes(), nil
+}
+
+// toPoint converts affine coordinates to a point: little-endian y with the sign of x in the top bit.
+func (ed25519Curve) toPoint(p *point) (*edwards25519.Point, error) {
+	if p == nil || p.Coords[0] == nil || p.Coords[1] == nil {
+		return nil, errors.New("missing point coordinates")
+	}
+
+	x, y := p.Coords[0], p.Coords[1]
+	if x.Sign() < 0 || y.Sign() < 0 || x.BitLen() > 255 || y.BitLen() > 255 {
+		return nil, errors.New("point coordinates are out of range")
+	}
+
+	enc := y.FillBytes(make([]byte, 32))
+	slices.Reverse(enc)
+	if x.Bit(0) == 1 {
+		enc[31] |= 0x80
+	}
+
+	ep, err := new(edwards25519.Point).SetBytes(enc)
+	if err != nil {
+		return nil, errors.Wrap(err, "point is not on ed25519")
+	}
+
+	// SetBytes recovers x from y, make sure it's the x we were given
+	var (
+		extX, _, extZ, _ = ep.ExtendedCoordinates()
+		affineX          field.Element
+	)
+
+	affineX.Multiply(extX, new(field.Element).Invert(extZ))
+
+	expectedX := x.FillBytes(make([]byte, 32))
+	slices.Reverse(expectedX)
+
+	if !bytes.Equal(affineX.Bytes(), expectedX) {
+		return nil, errors.New("point is not on ed25519")
+	}
+
+	return ep, nil
+}
+
+func (ed25519Curve) toScalar(k *big.Int) (*edwards25519.Scalar, error) {
+	b := new(big.Int).Mod(k, ed25519Order).FillBytes(make([]byte, 32))
+	slices.Reverse(b)
+
+	return new(edwards25519.Scalar).SetCanonicalBytes(b)
+}
@@ -0,0 +1,242 @@
+package backup
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"slices"
+
+	"github.com/pkg/errors"
+
+	"github.com/zeta-chain/node/pkg/cosmos"
+	"github.com/zeta-chain/node/pkg/crypto"
+)
+
+// Share is the key share of the local party as stored by go-tss in `localstate-<pubkey>.json`.
+type Share struct {
+	PubKey          string   `json:"pub_key"`
+	ParticipantKeys []string `json:"participant_keys"`
+	LocalPartyKey   string   `json:"local_party_key"`
+
+	data localData
+
+	// raw is the plaintext share file content
+	raw []byte
+}
+
+// localData is the subset of tss-lib's LocalPartySaveData (ECDSA or EdDSA)
+// required to check a share against the group public key.
+type localData struct {
+	Xi       *big.Int `json:"Xi"`
+	ShareID  *big.Int `json:"ShareID"`
+	Ks       []*big.Int
+	BigXj    []*point
+	ECDSAPub *point
+	EDDSAPub *point
+}
+
+// point is an affine curve point as serialized by tss-lib.
+type point struct {
+	Coords [2]*big.Int `json:"Coords"`
+}
+
+// ParseShare parses the content of a key share file. The content is decrypted
+// with the TSS password unless it is plain JSON (go-tss encrypts shares when a password is set).
+func ParseShare(content []byte, tssPassword string) (*Share, error) {
+	raw := content
+	if !json.Valid(content) {
+		if tssPassword == "" {
+			return nil, errors.New("key share is encrypted but tss password is empty")
+		}
+
+		var err error
+		if raw, err = crypto.DecryptAES256GCM(content, tssPassword); err != nil {
+			return nil, errors.Wrap(err, "unable to decrypt key share")
+		}
+	}
+
+	var file struct {
+		Share
+		LocalData json.RawMessage `json:"local_data"`
+	}
+
+	if err := json.Unmarshal(raw, &file); err != nil {
+		return nil, errors.Wrap(err, "unable to decode key share")
+	}
+
+	// local_data is base64 encoded bytes, older go-tss versions store it as a JSON object
+	localDataJSON := []byte(file.LocalData)
+	if bytes.HasPrefix(localDataJSON, []byte(`"`)) {
+		if err := json.Unmarshal(file.LocalData, &localDataJSON); err != nil {
+			return nil, errors.Wrap(err, "unable to decode local data")
+		}
+	}
+
+	share := file.Share
+	if err := json.Unmarshal(localDataJSON, &share.data); err != nil {
+		return nil, errors.Wrap(err, "unable to decode local data")
+	}
+
+	share.raw = raw
+
+	return &share, nil
+}
+
+// Content returns the share file content as go-tss stores it:
+// encrypted with the TSS password if it's set, plain JSON otherwise.
+func (s *Share) Content(tssPassword string) ([]byte, error) {
+	if tssPassword == "" {
+		return s.raw, nil
+	}
+
+	return crypto.EncryptAES256GCM(s.raw, tssPassword)
+}
+
+// Fingerprint returns the fingerprint of the share: sha256 of the TSS pubkey and the public share of the local party.
+// It doesn't reveal the secret share and changes whenever the share or the pubkey it belongs to changes.
+func (s *Share) Fingerprint() (string, error) {
+	c, _, err := s.curve()
+	if err != nil {
+		return "", err
+	}
+
+	idx, err := s.partyIndex()
+	if err != nil {
+		return "", err
+	}
+
+	publicShare, err := c.encode(s.data.BigXj[idx])
+	if err != nil {
+		return "", errors.Wrap(err, "invalid public share")
+	}
+
+	h := sha256.New()
+	h.Write([]byte(s.PubKey))
+	h.Write(publicShare)
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Verify performs an offline self-check of the share against the TSS pubkey:
+//   - the share belongs to pubKey;
+//   - the secret share matches the public share of the local party (Xi·G == BigXj[i]);
+//   - the public shares of all parties interpolate to the group public key.
+//
+// Together these prove that the share can take part in a keysign for pubKey
+// without contacting the other parties.
+func (s *Share) Verify(pubKey string) error {
+	if s.PubKey != pubKey {
+		return errors.Errorf("key share belongs to %s, not %s", s.PubKey, pubKey)
+	}
+
+	c, groupPubKey, err := s.curve()
+	if err != nil {
+		return err
+	}
+
+	expected, err := cosmos.GetPubKeyFromBech32(cosmos.Bech32PubKeyTypeAccPub, pubKey)
+	if err != nil {
+		return errors.Wrap(err, "invalid pubkey")
+	}
+
+	actual, err := c.encode(groupPubKey)
+	if err != nil {
+		return errors.Wrap(err, "invalid group pubkey")
+	}
+
+	if !bytes.Equal(actual, expected.Bytes()) {
+		return errors.New("group pubkey of the key share does not match the pubkey")
+	}
+
+	idx, err := s.partyIndex()
+	if err != nil {
+		return err
+	}
+
+	publicShare, err := c.scalarBaseMult(s.data.Xi)
+	if err != nil {
+		return errors.Wrap(err, "invalid secret share")
+	}
+
+	expectedShare, err := c.encode(s.data.BigXj[idx])
+	if err != nil {
+		return errors.Wrap(err, "invalid public share")
+	}
+
+	if !bytes.Equal(publicShare, expectedShare) {
+		return errors.New("secret share does not match the public share")
+	}
+
+	coeffs, err := lagrangeCoefficients(s.data.Ks, c.order())
+	if err != nil {
+		return err
+	}
+
+	interpolated, err := c.combine(s.data.BigXj, coeffs)
+	if err != nil {
+		return errors.Wrap(err, "unable to interpolate public shares")
+	}
+
+	if !bytes.Equal(interpolated, actual) {
+		return errors.New("public shares do not interpolate to the group pubkey")
+	}
+
+	return nil
+}
+
+// curve returns the curve of the share and its group public key.
+func (s *Share) curve() (curve, *point, error) {
+	switch {
+	case s.data.Xi == nil || s.data.ShareID == nil:
+		return nil, nil, errors.New("key share has no secret share")
+	case len(s.data.Ks) == 0 || len(s.data.Ks) != len(s.data.BigXj):
+		return nil, nil, errors.New("key share has inconsistent party data")
+	case s.data.ECDSAPub != nil:
+		return secp256k1Curve{}, s.data.ECDSAPub, nil
+	case s.data.EDDSAPub != nil:
+		return ed25519Curve{}, s.data.EDDSAPub, nil
+	default:
+		return nil, nil, errors.New("key share has no group pubkey")
+	}
+}
+
+// partyIndex returns the index of the local party in the party lists.
+func (s *Share) partyIndex() (int, error) {
+	idx := slices.IndexFunc(s.data.Ks, func(k *big.Int) bool {
+		return k != nil && k.Cmp(s.data.ShareID) == 0
+	})
+	if idx < 0 {
+		return 0, errors.New("local party is not found in the key share")
+	}
+
+	return idx, nil
+}
+
+// lagrangeCoefficients returns the Lagrange coefficients at zero for the given party IDs.
+func lagrangeCoefficients(ks []*big.Int, order *big.Int) ([]*big.Int, error) {
+	coeffs := make([]*big.Int, len(ks))
+
+	for j, kj := range ks {
+		num, den := big.NewInt(1), big.NewInt(1)
+
+		for m, km := range ks {
+			if m == j {
+				continue
+			}
+
+			diff := new(big.Int).Sub(km, kj)
+			if diff.Mod(diff, order).Sign() == 0 {
+				return nil, errors.New("duplicate party ids in the key share")
+			}
+
+			num.Mul(num, km).Mod(num, order)
+			den.Mul(den, diff).Mod(den, order)
+		}
+
+		coeffs[j] = num.Mul(num, den.ModInverse(den, order)).Mod(num, order)
+	}
+
+	return coeffs, nil
+}
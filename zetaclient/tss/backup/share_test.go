@@ -0,0 +1,335 @@
+package backup_test
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"math/big"
+	"slices"
+	"testing"
+
+	"filippo.io/edwards25519"
+	"filippo.io/edwards25519/field"
+	"github.com/btcsuite/btcd/btcec/v2"
+	cosmosed25519 "github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/cosmos"
+	"github.com/zeta-chain/node/pkg/crypto"
+	_ "github.com/zeta-chain/node/pkg/sdkconfig/default"
+	"github.com/zeta-chain/node/zetaclient/tss/backup"
+)
+
+func TestShare(t *testing.T) {
+	for _, eddsa := range []bool{false, true} {
+		name := "ECDSA"
+		if eddsa {
+			name = "EdDSA"
+		}
+
+		t.Run(name, func(t *testing.T) {
+			t.Run("Verify", func(t *testing.T) {
+				// ARRANGE
+				shares := newTestShares(t, eddsa, 4, 2)
+
+				for _, s := range shares {
+					// ACT
+					share, err := backup.ParseShare(s.marshal(t), "")
+					require.NoError(t, err)
+
+					// ASSERT
+					require.NoError(t, share.Verify(s.PubKey))
+				}
+			})
+
+			t.Run("Verify share in legacy format", func(t *testing.T) {
+				// ARRANGE
+				s := newTestShares(t, eddsa, 3, 1)[0]
+
+				// ACT
+				share, err := backup.ParseShare(s.marshalLegacy(t), "")
+				require.NoError(t, err)
+
+				// ASSERT
+				require.NoError(t, share.Verify(s.PubKey))
+			})
+
+			t.Run("Fingerprint", func(t *testing.T) {
+				// ARRANGE
+				shares := newTestShares(t, eddsa, 3, 1)
+
+				fingerprints := make([]string, len(shares))
+				for i, s := range shares {
+					share, err := backup.ParseShare(s.marshal(t), "")
+					require.NoError(t, err)
+
+					// ACT
+					fingerprints[i], err = share.Fingerprint()
+					require.NoError(t, err)
+				}
+
+				// ASSERT
+				// fingerprint is unique per party
+				require.Len(t, slices.Compact(slices.Sorted(slices.Values(fingerprints))), len(shares))
+
+				// and stable across parsing
+				share, err := backup.ParseShare(shares[0].marshal(t), "")
+				require.NoError(t, err)
+
+				fingerprint, err := share.Fingerprint()
+				require.NoError(t, err)
+				require.Equal(t, fingerprints[0], fingerprint)
+			})
+
+			t.Run("Encrypted share", func(t *testing.T) {
+				// ARRANGE
+				const password = "tss-password"
+				s := newTestShares(t, eddsa, 3, 1)[0]
+
+				encrypted, err := crypto.EncryptAES256GCM(s.marshal(t), password)
+				require.NoError(t, err)
+
+				// ACT
+				_, errNoPassword := backup.ParseShare(encrypted, "")
+				_, errWrongPassword := backup.ParseShare(encrypted, "wrong")
+				share, err := backup.ParseShare(encrypted, password)
+
+				// ASSERT
+				require.ErrorContains(t, errNoPassword, "tss password is empty")
+				require.ErrorContains(t, errWrongPassword, "unable to decrypt key share")
+				require.NoError(t, err)
+				require.NoError(t, share.Verify(s.PubKey))
+
+				// re-encrypted share is readable with the same password
+				reencrypted, err := share.Content(password)
+				require.NoError(t, err)
+				require.NotEqual(t, encrypted, reencrypted)
+
+				share, err = backup.ParseShare(reencrypted, password)
+				require.NoError(t, err)
+				require.NoError(t, share.Verify(s.PubKey))
+
+				// and stored as plain JSON without the password
+				plain, err := share.Content("")
+				require.NoError(t, err)
+				require.JSONEq(t, string(s.marshal(t)), string(plain))
+			})
+
+			t.Run("Tampered secret share", func(t *testing.T) {
+				// ARRANGE
+				s := newTestShares(t, eddsa, 3, 1)[0]
+				s.data.Xi = new(big.Int).Add(s.data.Xi, big.NewInt(1))
+
+				share, err := backup.ParseShare(s.marshal(t), "")
+				require.NoError(t, err)
+
+				// ACT
+				err = share.Verify(s.PubKey)
+
+				// ASSERT
+				require.ErrorContains(t, err, "secret share does not match the public share")
+			})
+
+			t.Run("Tampered public share of another party", func(t *testing.T) {
+				// ARRANGE
+				shares := newTestShares(t, eddsa, 3, 1)
+				s := shares[0]
+				s.data.BigXj[1] = s.data.BigXj[2]
+
+				share, err := backup.ParseShare(s.marshal(t), "")
+				require.NoError(t, err)
+
+				// ACT
+				err = share.Verify(s.PubKey)
+
+				// ASSERT
+				require.ErrorContains(t, err, "public shares do not interpolate to the group pubkey")
+			})
+
+			t.Run("Share of another TSS", func(t *testing.T) {
+				// ARRANGE
+				s := newTestShares(t, eddsa, 3, 1)[0]
+				other := newTestShares(t, eddsa, 3, 1)[0]
+				s.PubKey = other.PubKey
+
+				share, err := backup.ParseShare(s.marshal(t), "")
+				require.NoError(t, err)
+
+				// ACT
+				errOtherPubKey := share.Verify(s.PubKey)
+				errWrongPubKey := share.Verify(newTestShares(t, eddsa, 3, 1)[0].PubKey)
+
+				// ASSERT
+				require.ErrorContains(t, errOtherPubKey, "group pubkey of the key share does not match the pubkey")
+				require.ErrorContains(t, errWrongPubKey, "key share belongs to")
+			})
+		})
+	}
+}
+
+// testShare mirrors the key share file written by go-tss.
+type testShare struct {
+	PubKey          string   `json:"pub_key"`
+	ParticipantKeys []string `json:"participant_keys"`
+	LocalPartyKey   string   `json:"local_party_key"`
+
+	data testLocalData
+}
+
+type testLocalData struct {
+	Xi       *big.Int
+	ShareID  *big.Int
+	Ks       []*big.Int
+	BigXj    []*testPoint
+	ECDSAPub *testPoint `json:",omitempty"`
+	EDDSAPub *testPoint `json:",omitempty"`
+}
+
+type testPoint struct {
+	Coords [2]*big.Int
+}
+
+func (s testShare) marshal(t *testing.T) []byte {
+	localData, err := json.Marshal(s.data)
+	require.NoError(t, err)
+
+	return s.marshalWith(t, localData)
+}
+
+func (s testShare) marshalLegacy(t *testing.T) []byte {
+	localData, err := json.Marshal(s.data)
+	require.NoError(t, err)
+
+	return s.marshalWith(t, json.RawMessage(localData))
+}
+
+func (s testShare) marshalWith(t *testing.T, localData any) []byte {
+	content, err := json.Marshal(struct {
+		testShare
+		LocalData any `json:"local_data"`
+	}{s, localData})
+	require.NoError(t, err)
+
+	return content
+}
+
+// newTestShares runs a trusted dealer Shamir sharing of a random key
+// and returns the key shares of all parties in go-tss format.
+func newTestShares(t *testing.T, eddsa bool, parties, threshold int) []testShare {
+	order := btcec.S256().N
+	if eddsa {
+		order, _ = new(big.Int).SetString(
+			"7237005577332262213973186563042994240857116359379907606001950938285454250989",
+			10,
+		)
+	}
+
+	random := func() *big.Int {
+		k, err := rand.Int(rand.Reader, order)
+		require.NoError(t, err)
+		return k
+	}
+
+	// f(x) = a0 + a1*x + ... + at*x^t
+	coeffs := make([]*big.Int, threshold+1)
+	for i := range coeffs {
+		coeffs[i] = random()
+	}
+
+	eval := func(x *big.Int) *big.Int {
+		res := new(big.Int)
+		for i := len(coeffs) - 1; i >= 0; i-- {
+			res.Mul(res, x).Add(res, coeffs[i]).Mod(res, order)
+		}
+		return res
+	}
+
+	baseMult := func(k *big.Int) (*testPoint, cryptotypes.PubKey) {
+		if eddsa {
+			return ed25519BaseMult(t, k)
+		}
+		return secp256k1BaseMult(k)
+	}
+
+	groupPoint, groupPubKey := baseMult(coeffs[0])
+
+	pubKey, err := cosmos.Bech32ifyPubKey(cosmos.Bech32PubKeyTypeAccPub, groupPubKey)
+	require.NoError(t, err)
+
+	var (
+		ks     = make([]*big.Int, parties)
+		xs     = make([]*big.Int, parties)
+		bigXj  = make([]*testPoint, parties)
+		keys   = make([]string, parties)
+		shares = make([]testShare, parties)
+	)
+
+	for i := range ks {
+		ks[i] = random()
+		xs[i] = eval(ks[i])
+		bigXj[i], _ = baseMult(xs[i])
+
+		partyKey, err := cosmos.Bech32ifyPubKey(cosmos.Bech32PubKeyTypeAccPub, secp256k1.GenPrivKey().PubKey())
+		require.NoError(t, err)
+		keys[i] = partyKey
+	}
+
+	for i := range shares {
+		shares[i] = testShare{
+			PubKey:          pubKey,
+			ParticipantKeys: keys,
+			LocalPartyKey:   keys[i],
+			data: testLocalData{
+				Xi:      xs[i],
+				ShareID: ks[i],
+				Ks:      ks,
+				BigXj:   slices.Clone(bigXj),
+			},
+		}
+
+		if eddsa {
+			shares[i].data.EDDSAPub = groupPoint
+		} else {
+			shares[i].data.ECDSAPub = groupPoint
+		}
+	}
+
+	return shares
+}
+
+func secp256k1BaseMult(k *big.Int) (*testPoint, cryptotypes.PubKey) {
+	var (
+		s btcec.ModNScalar
+		r btcec.JacobianPoint
+	)
+
+	s.SetByteSlice(k.Bytes())
+	btcec.ScalarBaseMultNonConst(&s, &r)
+	r.ToAffine()
+
+	pk := btcec.NewPublicKey(&r.X, &r.Y)
+
+	return &testPoint{Coords: [2]*big.Int{pk.X(), pk.Y()}}, &secp256k1.PubKey{Key: pk.SerializeCompressed()}
+}
+
+func ed25519BaseMult(t *testing.T, k *big.Int) (*testPoint, cryptotypes.PubKey) {
+	b := k.FillBytes(make([]byte, 32))
+	slices.Reverse(b)
+
+	s, err := new(edwards25519.Scalar).SetCanonicalBytes(b)
+	require.NoError(t, err)
+
+	p := new(edwards25519.Point).ScalarBaseMult(s)
+
+	x, y, z, _ := p.ExtendedCoordinates()
+	zInv := new(field.Element).Invert(z)
+
+	toBig := func(fe *field.Element) *big.Int {
+		b := new(field.Element).Multiply(fe, zInv).Bytes()
+		slices.Reverse(b)
+		return new(big.Int).SetBytes(b)
+	}
+
+	return &testPoint{Coords: [2]*big.Int{toBig(x), toBig(y)}}, &cosmosed25519.PubKey{Key: p.Bytes()}
+}
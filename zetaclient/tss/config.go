@@ -107,6 +107,11 @@ func ParsePubKeysFromPath(logger zerolog.Logger, tssPath string) ([]PubKey, erro
 
 		bech32 := strings.TrimSuffix(strings.TrimPrefix(filename, prefix), ".json")
 
+		// ed25519 key shares are verified separately
+		if _, err := NewPubKeyEdDSAFromBech32(bech32); err == nil {
+			continue
+		}
+
 		pubKey, err := NewPubKeyFromBech32(bech32)
 		if err != nil {
 			logger.Error().
@@ -3,19 +3,24 @@ package tss
 import (
 	"bytes"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
 	"strings"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
+	cosmosed25519 "github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	eth "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gagliardetto/solana-go"
 	"github.com/pkg/errors"
 	"github.com/zeta-chain/go-tss/keysign"
 
@@ -127,6 +132,24 @@ func (k PubKey) BTCPayToAddrScript(chainID int64) ([]byte, error) {
 	return txscript.PayToAddrScript(tssAddrP2WPKH)
 }
 
+// AddressBTCTaproot returns the Taproot (P2TR) key-path address of the public key.
+// The output key is the BIP-86 tweak of the TSS key, i.e. a key-path only address without script tree.
+//
+// Note: spending from this address requires a BIP-340 Schnorr signature of the tweaked key,
+// which the TSS keysign ceremony does not produce yet; don't send funds to it.
+func (k PubKey) AddressBTCTaproot(chainID int64) (*btcutil.AddressTaproot, error) {
+	return bitcoinP2TR(k.Bytes(true), chainID)
+}
+
+// BTCPayToTaprootScript returns the script for the Bitcoin TSS Taproot address.
+func (k PubKey) BTCPayToTaprootScript(chainID int64) ([]byte, error) {
+	tssAddrP2TR, err := k.AddressBTCTaproot(chainID)
+	if err != nil {
+		return nil, err
+	}
+	return txscript.PayToAddrScript(tssAddrP2TR)
+}
+
 // AddressEVM returns the ethereum address of the public key.
 func (k PubKey) AddressEVM() eth.Address {
 	return crypto.PubkeyToAddress(*k.ecdsaPubKey)
@@ -137,6 +160,58 @@ func (k PubKey) AddressSui() string {
 	return sui.AddressFromPubKeyECDSA(k.ecdsaPubKey)
 }
 
+// PubKeyEdDSA represents TSS ed25519 public key in various formats.
+type PubKeyEdDSA struct {
+	cosmosPubKey cryptotypes.PubKey
+}
+
+// NewPubKeyEdDSAFromBech32 creates a new PubKeyEdDSA from a bech32 address.
+func NewPubKeyEdDSAFromBech32(bech32 string) (PubKeyEdDSA, error) {
+	if bech32 == "" {
+		return PubKeyEdDSA{}, errors.New("empty bech32 address")
+	}
+
+	cosmosPubKey, err := cosmos.GetPubKeyFromBech32(cosmos.Bech32PubKeyTypeAccPub, bech32)
+	if err != nil {
+		return PubKeyEdDSA{}, errors.Wrap(err, "unable to GetPubKeyFromBech32")
+	}
+
+	if _, ok := cosmosPubKey.(*cosmosed25519.PubKey); !ok {
+		return PubKeyEdDSA{}, errors.Errorf("invalid pubkey type %s", cosmosPubKey.Type())
+	}
+
+	return PubKeyEdDSA{cosmosPubKey: cosmosPubKey}, nil
+}
+
+// NewPubKeyEdDSA creates a new PubKeyEdDSA from an ed25519 public key.
+func NewPubKeyEdDSA(pk ed25519.PublicKey) (PubKeyEdDSA, error) {
+	if len(pk) != ed25519.PublicKeySize {
+		return PubKeyEdDSA{}, errors.Errorf("invalid pubkey length %d", len(pk))
+	}
+
+	return PubKeyEdDSA{cosmosPubKey: &cosmosed25519.PubKey{Key: bytes.Clone(pk)}}, nil
+}
+
+// AsEd25519 returns the ed25519 public key.
+func (k PubKeyEdDSA) AsEd25519() ed25519.PublicKey {
+	return ed25519.PublicKey(k.cosmosPubKey.Bytes())
+}
+
+// Bech32String returns the bech32 string of the public key.
+func (k PubKeyEdDSA) Bech32String() string {
+	v, err := cosmos.Bech32ifyPubKey(cosmos.Bech32PubKeyTypeAccPub, k.cosmosPubKey)
+	if err != nil {
+		return "" // not possible
+	}
+
+	return v
+}
+
+// AddressSolana returns Solana address of the public key.
+func (k PubKeyEdDSA) AddressSolana() solana.PublicKey {
+	return solana.PublicKeyFromBytes(k.AsEd25519())
+}
+
 // VerifySignature checks that keysign.Signature is valid and origins from expected TSS public key.
 // Also returns signature as [65]byte (R, S, V)
 func VerifySignature(sig keysign.Signature, pk PubKey, hash []byte) ([65]byte, error) {
@@ -187,9 +262,88 @@ func SignatureToBytes(input keysign.Signature) (sig [65]byte, err error) {
 	return sig, nil
 }
 
+// VerifySignatureEdDSA checks that keysign.Signature is a valid ed25519 signature of msg
+// by the expected TSS public key. Returns signature as [64]byte (R, S) in ed25519 encoding.
+func VerifySignatureEdDSA(sig keysign.Signature, pk PubKeyEdDSA, msg []byte) ([64]byte, error) {
+	actualMsg, err := base64DecodeString(sig.Msg)
+	switch {
+	case err != nil:
+		return [64]byte{}, errors.Wrap(err, "unable to decode message")
+	case !bytes.Equal(msg, actualMsg):
+		return [64]byte{}, errors.Errorf("message mismatch (got 0x%x, want 0x%x)", actualMsg, msg)
+	}
+
+	sigBytes, err := SignatureToBytesEdDSA(sig)
+	if err != nil {
+		return [64]byte{}, errors.Wrap(err, "unable to convert signature to bytes")
+	}
+
+	if !ed25519.Verify(pk.AsEd25519(), msg, sigBytes[:]) {
+		return [64]byte{}, errors.Errorf("invalid ed25519 signature for public key %s", pk.Bech32String())
+	}
+
+	return sigBytes, nil
+}
+
+// SignatureToBytesEdDSA converts keysign.Signature to [64]byte (R, S) in ed25519 encoding.
+//
+// tss-lib returns R and S as big-endian integers while ed25519 encodes both as
+// 32 bytes little-endian, thus each part is left-padded and reversed.
+func SignatureToBytesEdDSA(input keysign.Signature) (sig [64]byte, err error) {
+	r, err := base64DecodeString(input.R)
+	if err != nil {
+		return sig, errors.Wrap(err, "unable to decode R")
+	}
+
+	s, err := base64DecodeString(input.S)
+	if err != nil {
+		return sig, errors.Wrap(err, "unable to decode S")
+	}
+
+	if len(r) > 32 || len(s) > 32 {
+		return sig, errors.Errorf("invalid R or S length (%d, %d)", len(r), len(s))
+	}
+
+	copy(sig[32-len(r):32], r)
+	copy(sig[64-len(s):64], s)
+
+	reverse(sig[:32])
+	reverse(sig[32:])
+
+	return sig, nil
+}
+
+func reverse(b []byte) {
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+}
+
 // apparently go-tss returns res.Signatures in a different order than digests,
 // thus we need to ensure the order AND verify the signatures
 func verifySignatures(digests [][]byte, res keysign.Response, pk PubKey) ([][65]byte, error) {
+	verify := func(sig keysign.Signature, digest []byte) ([65]byte, error) {
+		return VerifySignature(sig, pk, digest)
+	}
+
+	return orderSignatures(digests, res, verify)
+}
+
+// verifySignaturesEdDSA is the ed25519 counterpart of verifySignatures.
+func verifySignaturesEdDSA(digests [][]byte, res keysign.Response, pk PubKeyEdDSA) ([][64]byte, error) {
+	verify := func(sig keysign.Signature, digest []byte) ([64]byte, error) {
+		return VerifySignatureEdDSA(sig, pk, digest)
+	}
+
+	return orderSignatures(digests, res, verify)
+}
+
+// orderSignatures verifies the signatures and returns them in the order of digests.
+func orderSignatures[T any](
+	digests [][]byte,
+	res keysign.Response,
+	verify func(sig keysign.Signature, digest []byte) (T, error),
+) ([]T, error) {
 	switch {
 	case len(digests) == 0:
 		return nil, errors.New("empty digests list")
@@ -197,12 +351,12 @@ func verifySignatures(digests [][]byte, res keysign.Response, pk PubKey) ([][65]
 		return nil, errors.Errorf("length mismatch (got %d, want %d)", len(res.Signatures), len(digests))
 	case len(digests) == 1:
 		// most common case
-		sig, err := VerifySignature(res.Signatures[0], pk, digests[0])
+		sig, err := verify(res.Signatures[0], digests[0])
 		if err != nil {
 			return nil, err
 		}
 
-		return [][65]byte{sig}, nil
+		return []T{sig}, nil
 	}
 
 	// map bas64(digest) => slice index
@@ -211,7 +365,7 @@ func verifySignatures(digests [][]byte, res keysign.Response, pk PubKey) ([][65]
 		cache[base64EncodeString(digest)] = i
 	}
 
-	signatures := make([][65]byte, len(res.Signatures))
+	signatures := make([]T, len(res.Signatures))
 
 	for _, sigResponse := range res.Signatures {
 		i, ok := cache[sigResponse.Msg]
@@ -219,7 +373,7 @@ func verifySignatures(digests [][]byte, res keysign.Response, pk PubKey) ([][65]
 			return nil, errors.Errorf("missing digest %s", sigResponse.Msg)
 		}
 
-		sig, err := VerifySignature(sigResponse, pk, digests[i])
+		sig, err := verify(sigResponse, digests[i])
 		if err != nil {
 			return nil, fmt.Errorf("unable to verify signature: %w (#%d)", err, i)
 		}
@@ -248,3 +402,20 @@ func bitcoinP2WPKH(pkCompressed []byte, chainID int64) (*btcutil.AddressWitnessP
 
 	return btcutil.NewAddressWitnessPubKeyHash(hash, params)
 }
+
+// bitcoinP2TR returns P2TR (pay to taproot) key-path address from the compressed pub key (BIP-86).
+func bitcoinP2TR(pkCompressed []byte, chainID int64) (*btcutil.AddressTaproot, error) {
+	params, err := chains.BitcoinNetParamsFromChainID(chainID)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get btc net params")
+	}
+
+	internalKey, err := btcec.ParsePubKey(pkCompressed)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse pub key")
+	}
+
+	outputKey := txscript.ComputeTaprootKeyNoScript(internalKey)
+
+	return btcutil.NewAddressTaproot(schnorr.SerializePubKey(outputKey), params)
+}
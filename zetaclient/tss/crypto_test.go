@@ -58,6 +58,17 @@ func TestPubKey(t *testing.T) {
 		assert.Equal(t, "0x70e967acfcc17c3941e87562161406d41676fd83", strings.ToLower(addrEVM.Hex()))
 		assert.Equal(t, "bc1qm24wp577nk8aacckv8np465z3dvmu7ry45el6y", addrBTC.String())
 
+		// Taproot key-path address (BIP-86 tweak of the same key)
+		addrTaproot, err := pk.AddressBTCTaproot(chains.BitcoinMainnet.ChainId)
+		require.NoError(t, err)
+		assert.Equal(t, "bc1pyvzxfpctc0ftm9qa8rpl0vy57zt8yw688qyth4e7drgp73sw8kvq6cpsfv", addrTaproot.String())
+
+		expectedTaprootScript, err := txscript.PayToAddrScript(addrTaproot)
+		require.NoError(t, err)
+		taprootScript, err := pk.BTCPayToTaprootScript(chains.BitcoinMainnet.ChainId)
+		require.NoError(t, err)
+		assert.Equal(t, expectedTaprootScript, taprootScript)
+
 		// Check that NewPubKeyFromECDSA works
 		pk2, err := NewPubKeyFromECDSA(*pk.ecdsaPubKey)
 		require.NoError(t, err)
@@ -152,12 +152,12 @@ func (k *keygenCeremony) iteration(ctx context.Context) (shouldRetry bool, err e
 
 	// Now we know that the keygen status is PENDING, and we are the KEYGEN block.
 	// Let's perform TSS Keygen and then post successful/failed vote to zetacore
-	newPubKey, err := k.performKeygen(ctx, keygenTask)
+	newPubKey, newPubKeyEdDSA, err := k.performKeygens(ctx, keygenTask)
 	if err != nil {
 		k.logger.Error().Err(err).Msg("keygen failed; broadcasting failed TSS vote")
 
 		// Vote for failure
-		failedVoteHash, err := k.zetacore.PostVoteTSS(ctx, "", keygenTask.BlockNumber, receiveFailed)
+		failedVoteHash, err := k.zetacore.PostVoteTSS(ctx, "", "", keygenTask.BlockNumber, receiveFailed)
 		if err != nil {
 			return false, errors.Wrap(err, "failed to broadcast failed TSS vote")
 		}
@@ -169,7 +169,13 @@ func (k *keygenCeremony) iteration(ctx context.Context) (shouldRetry bool, err e
 		return true, nil
 	}
 
-	successVoteHash, err := k.zetacore.PostVoteTSS(ctx, newPubKey, keygenTask.BlockNumber, receiveSuccess)
+	successVoteHash, err := k.zetacore.PostVoteTSS(
+		ctx,
+		newPubKey,
+		newPubKeyEdDSA,
+		keygenTask.BlockNumber,
+		receiveSuccess,
+	)
 	if err != nil {
 		return false, errors.Wrap(err, "failed to broadcast successful TSS vote")
 	}
@@ -185,18 +191,52 @@ func (k *keygenCeremony) iteration(ctx context.Context) (shouldRetry bool, err e
 		// signing can fail even if tss keygen is successful
 	}
 
+	if newPubKeyEdDSA != "" {
+		if err = TestKeySignEdDSA(k.tss, newPubKeyEdDSA, k.logger); err != nil {
+			k.logger.Error().Err(err).Msg("failed to test TSS eddsa keygen")
+		}
+	}
+
 	return false, nil
 }
 
+// performKeygens generates the secp256k1 TSS key and, if requested by the keygen task,
+// the ed25519 TSS key. Returns the bech32 public keys (eddsa is empty if not requested) or error.
+func (k *keygenCeremony) performKeygens(
+	ctx context.Context,
+	keygenTask observertypes.Keygen,
+) (pubKey string, pubKeyEdDSA string, err error) {
+	pubKey, err = k.performKeygen(ctx, keygenTask, Algo)
+	if err != nil {
+		return "", "", err
+	}
+
+	if !keygenTask.Eddsa {
+		return pubKey, "", nil
+	}
+
+	pubKeyEdDSA, err = k.performKeygen(ctx, keygenTask, tsscommon.EdDSA)
+	if err != nil {
+		return "", "", errors.Wrap(err, "eddsa keygen failed")
+	}
+
+	return pubKey, pubKeyEdDSA, nil
+}
+
 // performKeygen performs TSS keygen flow via go-tss server. Returns the new TSS public key or error.
 // If fails, then it will post blame data to zetacore and return an error.
-func (k *keygenCeremony) performKeygen(ctx context.Context, keygenTask observertypes.Keygen) (string, error) {
+func (k *keygenCeremony) performKeygen(
+	ctx context.Context,
+	keygenTask observertypes.Keygen,
+	algo tsscommon.Algo,
+) (string, error) {
 	k.logger.Warn().
 		Int64("keygen_block", keygenTask.BlockNumber).
 		Strs("keygen_tss_signers", keygenTask.GranteePubkeys).
+		Str("keygen_algo", string(algo)).
 		Msg("performing a keygen")
 
-	req := keygen.NewRequest(keygenTask.GranteePubkeys, keygenTask.BlockNumber, Version, Algo)
+	req := keygen.NewRequest(keygenTask.GranteePubkeys, keygenTask.BlockNumber, Version, algo)
 
 	res, err := k.tss.Keygen(req)
 	switch {
@@ -220,10 +260,13 @@ func (k *keygenCeremony) performKeygen(ctx context.Context, keygenTask observert
 		Interface("keygen_blame_nodes", res.Blame.BlameNodes).
 		Msg("keygen failed; sending blame data to zetacore")
 
+	chainID := k.zetacore.Chain().ChainId
+
 	// increment blame counter
 	for _, node := range res.Blame.BlameNodes {
 		metrics.TSSNodeBlamePerPubKey.WithLabelValues(node.Pubkey).Inc()
 	}
+	registerBlameReason(metrics.TSSNodeBlamePerChainAndReason, res.Blame, chainID)
 
 	blameDigest, err := digestReq(req)
 	if err != nil {
@@ -231,7 +274,6 @@ func (k *keygenCeremony) performKeygen(ctx context.Context, keygenTask observert
 	}
 
 	blameIndex := fmt.Sprintf("keygen-%s-%d", blameDigest, keygenTask.BlockNumber)
-	chainID := k.zetacore.Chain().ChainId
 
 	zetaHash, err := k.zetacore.PostVoteBlameData(ctx, &res.Blame, chainID, blameIndex)
 	if err != nil {
@@ -339,3 +381,43 @@ func TestKeySign(keySigner KeySigner, tssPubKeyBec32 string, logger zerolog.Logg
 
 	return nil
 }
+
+// TestKeySignEdDSA performs a TSS key-sign test of sample data using the ed25519 TSS key.
+func TestKeySignEdDSA(keySigner KeySigner, tssPubKeyBec32 string, logger zerolog.Logger) error {
+	logger = logger.With().Str(logs.FieldModule, logs.ModNameTssKeySign).Logger()
+
+	tssPubKey, err := NewPubKeyEdDSAFromBech32(tssPubKeyBec32)
+	if err != nil {
+		return errors.Wrap(err, "unable to parse TSS eddsa public key")
+	}
+
+	// go-tss signs 32-byte messages only
+	hashedData := crypto.Keccak256Hash(testKeySignData)
+
+	req := keysign.NewRequest(
+		tssPubKey.Bech32String(),
+		[]string{base64.StdEncoding.EncodeToString(hashedData.Bytes())},
+		10,
+		nil,
+		Version,
+	)
+
+	res, err := keySigner.KeySign(req)
+	switch {
+	case err != nil:
+		return errors.Wrap(err, "key signing request error")
+	case res.Status != tsscommon.Success:
+		logger.Error().Interface("keysign_fail_blame", res.Blame).Msg("eddsa keysign failed")
+		return errors.Errorf("key signing is not successful (status %d)", res.Status)
+	case len(res.Signatures) == 0:
+		return errors.New("signatures list is empty")
+	}
+
+	if _, err = VerifySignatureEdDSA(res.Signatures[0], tssPubKey, hashedData.Bytes()); err != nil {
+		return errors.Wrap(err, "signature verification failed")
+	}
+
+	logger.Info().Msg("passed TSS eddsa key-sign test")
+
+	return nil
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -43,6 +45,7 @@ type Zetacore interface {
 	PostVoteTSS(
 		ctx context.Context,
 		tssPubKey string,
+		tssPubKeyEdDSA string,
 		keyGenZetaHeight int64,
 		status chains.ReceiveStatus,
 	) (string, error)
@@ -62,12 +65,16 @@ type Service struct {
 	tss           KeySigner
 	currentPubKey PubKey
 
+	// currentPubKeyEdDSA is the optional ed25519 TSS key
+	currentPubKeyEdDSA *PubKeyEdDSA
+
 	postBlame bool
 	metrics   *Metrics
 
-	mu          sync.RWMutex
-	sigCache    map[int64]*sigCache
-	rateLimiter *ratelimit.RateLimiter
+	mu            sync.RWMutex
+	sigCache      map[int64]*sigCache
+	sigCacheEdDSA map[int64]*sigCacheEdDSA
+	rateLimiter   *ratelimit.RateLimiter
 
 	logger zerolog.Logger
 }
@@ -75,20 +82,25 @@ type Service struct {
 // sigCache is a LRU cache of "requestHash" -> signatures.
 type sigCache = lru.Cache[string, [][65]byte]
 
+// sigCacheEdDSA is a LRU cache of "requestHash" -> ed25519 signatures.
+type sigCacheEdDSA = lru.Cache[string, [][64]byte]
+
 // signatures per chain
 const sigCacheSize = 512
 
 // Metrics Prometheus metrics for the TSS service.
 type Metrics struct {
-	ActiveMsgsSigns    prometheus.Gauge
-	SignLatency        *prometheus.HistogramVec
-	NodeBlamePerPubKey *prometheus.CounterVec
+	ActiveMsgsSigns            prometheus.Gauge
+	SignLatency                *prometheus.HistogramVec
+	NodeBlamePerPubKey         *prometheus.CounterVec
+	NodeBlamePerChainAndReason *prometheus.CounterVec
 }
 
 type serviceConfig struct {
 	postBlame            bool
 	maxPendingSignatures uint64
 	metrics              *Metrics
+	pubKeyEdDSABech32    string
 }
 
 // Opt Service option.
@@ -114,6 +126,7 @@ func WithMetrics(ctx context.Context, zetacore Zetacore, m *Metrics) Opt {
 		m.ActiveMsgsSigns.Set(0)
 		m.SignLatency.Reset()
 		m.NodeBlamePerPubKey.Reset()
+		m.NodeBlamePerChainAndReason.Reset()
 
 		for _, granteeBech32 := range keygen.GranteePubkeys {
 			m.NodeBlamePerPubKey.WithLabelValues(granteeBech32).Inc()
@@ -136,10 +149,23 @@ func WithRateLimit(maxPendingSignatures uint64) Opt {
 	}
 }
 
+// WithPubKeyEdDSA configures the ed25519 TSS key generated alongside the secp256k1 key.
+// Noop if the key is empty.
+func WithPubKeyEdDSA(pubKeyBech32 string) Opt {
+	return func(cfg *serviceConfig, _ zerolog.Logger) error {
+		cfg.pubKeyEdDSABech32 = pubKeyBech32
+		return nil
+	}
+}
+
 var noopMetrics = Metrics{
 	ActiveMsgsSigns:    prometheus.NewGauge(prometheus.GaugeOpts{Name: "noop"}),
 	SignLatency:        prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: "noop"}, []string{"result"}),
 	NodeBlamePerPubKey: prometheus.NewCounterVec(prometheus.CounterOpts{Name: "noop"}, []string{"pubkey"}),
+	NodeBlamePerChainAndReason: prometheus.NewCounterVec(
+		prometheus.CounterOpts{Name: "noop"},
+		[]string{"pubkey", "chain", "reason"},
+	),
 }
 
 // NewService Service constructor.
@@ -172,20 +198,31 @@ func NewService(
 		return nil, errors.Wrap(err, "invalid tss pub key")
 	}
 
+	var currentPubKeyEdDSA *PubKeyEdDSA
+	if cfg.pubKeyEdDSABech32 != "" {
+		pk, err := NewPubKeyEdDSAFromBech32(cfg.pubKeyEdDSABech32)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid tss eddsa pub key")
+		}
+		currentPubKeyEdDSA = &pk
+	}
+
 	logger.Info().
 		Uint64("to", cfg.maxPendingSignatures).
 		Msg("setting max pending signatures")
 
 	return &Service{
-		tss:           keySigner,
-		currentPubKey: currentPubKey,
-		zetacore:      zetacore,
-		postBlame:     cfg.postBlame,
-		metrics:       cfg.metrics,
-
-		sigCache:    make(map[int64]*sigCache),
-		rateLimiter: ratelimit.New(cfg.maxPendingSignatures),
-		mu:          sync.RWMutex{},
+		tss:                keySigner,
+		currentPubKey:      currentPubKey,
+		currentPubKeyEdDSA: currentPubKeyEdDSA,
+		zetacore:           zetacore,
+		postBlame:          cfg.postBlame,
+		metrics:            cfg.metrics,
+
+		sigCache:      make(map[int64]*sigCache),
+		sigCacheEdDSA: make(map[int64]*sigCacheEdDSA),
+		rateLimiter:   ratelimit.New(cfg.maxPendingSignatures),
+		mu:            sync.RWMutex{},
 
 		logger: logger,
 	}, nil
@@ -196,6 +233,15 @@ func (s *Service) PubKey() PubKey {
 	return s.currentPubKey
 }
 
+// PubKeyEdDSA returns current ed25519 TSS PubKey, if any.
+func (s *Service) PubKeyEdDSA() (PubKeyEdDSA, bool) {
+	if s.currentPubKeyEdDSA == nil {
+		return PubKeyEdDSA{}, false
+	}
+
+	return *s.currentPubKeyEdDSA, true
+}
+
 // Sign signs msg digest (hash). Returns signature in the format of R (32B), S (32B), V (1B).
 func (s *Service) Sign(ctx context.Context, digest []byte, height, nonce uint64, chainID int64) ([65]byte, error) {
 	sigs, err := s.SignBatch(ctx, [][]byte{digest}, height, nonce, chainID)
@@ -217,74 +263,131 @@ func (s *Service) SignBatch(
 		return nil, errors.New("empty digests list")
 	}
 
-	digestsBase64 := make([]string, len(digests))
+	req := newKeysignRequest(s.PubKey().Bech32String(), digests, height)
+
+	cache := getChainCache(s, s.sigCache, chainID)
+	if sigs, ok := cache.Get(must(req.MsgID())); ok {
+		s.logger.Info().
+			Fields(keysignLogFields(req, nonce, chainID)).
+			Msg("signature cache hit")
+		return sigs, nil
+	}
+
+	res, err := s.keysign(ctx, req, digests, nonce, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	sigs, err := verifySignatures(digests, res, s.PubKey())
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to verify signatures")
+	}
+
+	cache.Add(must(req.MsgID()), sigs)
+
+	return sigs, nil
+}
+
+// SignEdDSA signs msg digest with the ed25519 TSS key. Returns signature in the format of R (32B), S (32B).
+func (s *Service) SignEdDSA(ctx context.Context, digest []byte, height, nonce uint64, chainID int64) ([64]byte, error) {
+	sigs, err := s.SignBatchEdDSA(ctx, [][]byte{digest}, height, nonce, chainID)
+	if err != nil {
+		return [64]byte{}, err
+	}
+
+	return sigs[0], nil
+}
+
+// SignBatchEdDSA signs msgs digests with the ed25519 TSS key.
+// Returns list of signatures in the format of R (32B), S (32B).
+//
+// go-tss truncates messages to the curve order size, thus only 32-byte digests are accepted.
+func (s *Service) SignBatchEdDSA(
+	ctx context.Context,
+	digests [][]byte,
+	height, nonce uint64,
+	chainID int64,
+) ([][64]byte, error) {
+	pubKey, ok := s.PubKeyEdDSA()
+	switch {
+	case !ok:
+		return nil, errors.New("eddsa TSS key is not set")
+	case len(digests) == 0:
+		return nil, errors.New("empty digests list")
+	}
+
 	for i, digest := range digests {
-		digestsBase64[i] = base64EncodeString(digest)
+		if len(digest) != 32 {
+			return nil, errors.Errorf("invalid digest length (got %d, want 32) (#%d)", len(digest), i)
+		}
 	}
 
-	// #nosec G115 always in range
-	req := keysign.NewRequest(
-		s.PubKey().Bech32String(),
-		digestsBase64,
-		int64(height),
-		nil,
-		Version,
-	)
+	req := newKeysignRequest(pubKey.Bech32String(), digests, height)
 
-	if sigs, ok := s.getSignatureCached(chainID, req); ok {
+	cache := getChainCache(s, s.sigCacheEdDSA, chainID)
+	if sigs, ok := cache.Get(must(req.MsgID())); ok {
 		s.logger.Info().
 			Fields(keysignLogFields(req, nonce, chainID)).
-			Msg("signature cache hit")
+			Msg("eddsa signature cache hit")
 		return sigs, nil
 	}
 
+	res, err := s.keysign(ctx, req, digests, nonce, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	sigs, err := verifySignaturesEdDSA(digests, res, pubKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to verify eddsa signatures")
+	}
+
+	cache.Add(must(req.MsgID()), sigs)
+
+	return sigs, nil
+}
+
+// keysign performs a key sign and checks the response status.
+func (s *Service) keysign(
+	ctx context.Context,
+	req keysign.Request,
+	digests [][]byte,
+	nonce uint64,
+	chainID int64,
+) (keysign.Response, error) {
 	res, err := s.sign(req, nonce, chainID)
 	switch {
 	case errors.Is(err, ratelimit.ErrThrottled):
 		s.logger.Warn().
 			Fields(keysignLogFields(req, nonce, chainID)).
 			Msg("signature request throttled")
-		return nil, err
+		return res, err
 	case err != nil:
 		// unexpected error (not related to failed key sign)
-		return nil, errors.Wrap(err, "unable to perform a key sign")
+		return res, errors.Wrap(err, "unable to perform a key sign")
 	case res.Status == thorcommon.Fail:
-		return nil, s.blameFailure(ctx, req, res, digests, nonce, chainID)
+		return res, s.blameFailure(ctx, req, res, digests, nonce, chainID)
 	case res.Status != thorcommon.Success:
-		return nil, fmt.Errorf("keysign fail: status %d", res.Status)
+		return res, fmt.Errorf("keysign fail: status %d", res.Status)
 	case len(res.Signatures) == 0:
-		return nil, fmt.Errorf("keysign fail: signature list is empty")
+		return res, fmt.Errorf("keysign fail: signature list is empty")
 	case len(res.Signatures) != len(digests):
-		return nil, fmt.Errorf(
+		return res, fmt.Errorf(
 			"keysign fail: signatures length mismatch (got %d, want %d)",
 			len(res.Signatures),
 			len(digests),
 		)
 	}
 
-	sigs, err := verifySignatures(digests, res, s.PubKey())
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to verify signatures")
-	}
-
-	s.setSignatureCached(chainID, req, sigs)
-
-	return sigs, nil
+	return res, nil
 }
 
 // IsSignatureCached returns true if the signature is cached for given chainID and message ID.
 func (s *Service) IsSignatureCached(chainID int64, digests [][]byte) bool {
-	digestsBase64 := make([]string, len(digests))
-	for i, digest := range digests {
-		digestsBase64[i] = base64EncodeString(digest)
-	}
-
 	// create a dummy request to query the cache (blockHeight doesn't matter here).
-	// #nosec G115 always in range
-	req := keysign.NewRequest(s.PubKey().Bech32String(), digestsBase64, 1, nil, Version)
+	req := newKeysignRequest(s.PubKey().Bech32String(), digests, 1)
 
-	_, found := s.getSignatureCached(chainID, req)
-	return found
+	return getChainCache(s, s.sigCache, chainID).Contains(must(req.MsgID()))
 }
 
 func (s *Service) Stop() {
@@ -354,6 +457,7 @@ func (s *Service) blameFailure(
 	for _, node := range res.Blame.BlameNodes {
 		s.metrics.NodeBlamePerPubKey.WithLabelValues(node.Pubkey).Inc()
 	}
+	registerBlameReason(s.metrics.NodeBlamePerChainAndReason, res.Blame, chainID)
 
 	if !s.postBlame {
 		return errFailure
@@ -388,21 +492,21 @@ func (s *Service) blameFailure(
 	return errFailure
 }
 
-func (s *Service) getSignatureCached(chainID int64, req keysign.Request) ([][65]byte, bool) {
-	key := must(req.MsgID())
-
-	return s.getChainSignatureCache(chainID).Get(key)
-}
-
-func (s *Service) setSignatureCached(chainID int64, req keysign.Request, sigs [][65]byte) {
-	key := must(req.MsgID())
+// newKeysignRequest creates a keysign request for the given TSS key and digests.
+func newKeysignRequest(pubKeyBech32 string, digests [][]byte, height uint64) keysign.Request {
+	digestsBase64 := make([]string, len(digests))
+	for i, digest := range digests {
+		digestsBase64[i] = base64EncodeString(digest)
+	}
 
-	s.getChainSignatureCache(chainID).Add(key, sigs)
+	// #nosec G115 always in range
+	return keysign.NewRequest(pubKeyBech32, digestsBase64, int64(height), nil, Version)
 }
 
-func (s *Service) getChainSignatureCache(chainID int64) *sigCache {
+// getChainCache returns the signature cache of a chain, initializing it if needed.
+func getChainCache[T any](s *Service, caches map[int64]*lru.Cache[string, T], chainID int64) *lru.Cache[string, T] {
 	s.mu.RLock()
-	cache, ok := s.sigCache[chainID]
+	cache, ok := caches[chainID]
 	s.mu.RUnlock()
 
 	if ok {
@@ -413,13 +517,50 @@ func (s *Service) getChainSignatureCache(chainID int64) *sigCache {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	// another goroutine might have initialized the cache
+	if cache, ok = caches[chainID]; ok {
+		return cache
+	}
+
 	// err is always nil in this case
-	cache, _ = lru.New[string, [][65]byte](sigCacheSize)
-	s.sigCache[chainID] = cache
+	cache, _ = lru.New[string, T](sigCacheSize)
+	caches[chainID] = cache
 
 	return cache
 }
 
+// registerBlameReason increments the blame counter of every blamed node
+// for the chain and the normalized failure reason.
+func registerBlameReason(counter *prometheus.CounterVec, b blame.Blame, chainID int64) {
+	var (
+		chain  = strconv.FormatInt(chainID, 10)
+		reason = blameReasonLabel(b.FailReason)
+	)
+
+	for _, node := range b.BlameNodes {
+		counter.WithLabelValues(node.Pubkey, chain, reason).Inc()
+	}
+}
+
+// blameReasonLabel maps go-tss failure reasons to a fixed set of metric labels
+// to keep the metric cardinality bounded.
+func blameReasonLabel(failReason string) string {
+	switch {
+	case failReason == blame.HashCheckFail:
+		return "hash_check_fail"
+	case failReason == blame.TssTimeout:
+		return "timeout"
+	case failReason == blame.TssSyncFail:
+		return "sync_fail"
+	case failReason == blame.TssBrokenMsg:
+		return "broken_msg"
+	case strings.HasPrefix(failReason, strings.TrimSpace(blame.InternalError)):
+		return "internal_error"
+	default:
+		return "other"
+	}
+}
+
 func keysignLogFields(req keysign.Request, nonce uint64, chainID int64) map[string]any {
 	// should match go-tss internals for easy filtering
 	const msgField = "msg_id"
@@ -142,6 +142,12 @@ func Setup(ctx context.Context, p SetupProps, logger zerolog.Logger) (*Service,
 		if err = TestKeySign(tssServer, tssInfo.TssPubkey, logger); err != nil {
 			return nil, errors.Wrap(err, "unable to test key signing")
 		}
+
+		if tssInfo.TssPubkeyEddsa != "" {
+			if err = TestKeySignEdDSA(tssServer, tssInfo.TssPubkeyEddsa, logger); err != nil {
+				return nil, errors.Wrap(err, "unable to test eddsa key signing")
+			}
+		}
 	}
 
 	// 8. Setup TSS zetaclient service (wrapper around go-tss TssServer)
@@ -151,11 +157,13 @@ func Setup(ctx context.Context, p SetupProps, logger zerolog.Logger) (*Service,
 		p.Zetacore,
 		logger,
 		WithPostBlame(p.PostBlame),
+		WithPubKeyEdDSA(tssInfo.TssPubkeyEddsa),
 		WithRateLimit(p.Config.TSSMaxPendingSignatures),
 		WithMetrics(ctx, p.Zetacore, &Metrics{
-			ActiveMsgsSigns:    metrics.NumActiveMsgSigns,
-			SignLatency:        metrics.SignLatency,
-			NodeBlamePerPubKey: metrics.TSSNodeBlamePerPubKey,
+			ActiveMsgsSigns:            metrics.NumActiveMsgSigns,
+			SignLatency:                metrics.SignLatency,
+			NodeBlamePerPubKey:         metrics.TSSNodeBlamePerPubKey,
+			NodeBlamePerChainAndReason: metrics.TSSNodeBlamePerChainAndReason,
 		}),
 	)
 	if err != nil {
@@ -316,6 +324,15 @@ func verifyKeySharesForPubKeys(p SetupProps, history []observertypes.TSS, logger
 		if _, ok := pubKeysSet[tssEntry.TssPubkey]; !ok {
 			return fmt.Errorf("pubkey %q not found in keyshare", tssEntry.TssPubkey)
 		}
+
+		if tssEntry.TssPubkeyEddsa == "" {
+			continue
+		}
+
+		keyShare := filepath.Join(tssPath, fmt.Sprintf("localstate-%s.json", tssEntry.TssPubkeyEddsa))
+		if _, err := os.Stat(keyShare); err != nil {
+			return fmt.Errorf("eddsa pubkey %q not found in keyshare", tssEntry.TssPubkeyEddsa)
+		}
 	}
 
 	return nil
@@ -345,5 +362,12 @@ func validateAddresses(service *Service, btcChainIDs []int64, logger zerolog.Log
 			Msg("BTC address")
 	}
 
+	if pk, ok := service.PubKeyEdDSA(); ok {
+		logger.Info().
+			Str("pubkey", pk.Bech32String()).
+			Stringer("solana_address", pk.AddressSolana()).
+			Msg("EdDSA key")
+	}
+
 	return nil
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/zeta-chain/node/pkg/contracts/solana"
 	"github.com/zeta-chain/node/pkg/crypto"
 	"github.com/zeta-chain/node/pkg/memo"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
 	"github.com/zeta-chain/node/zetaclient/config"
 )
 
@@ -74,6 +75,15 @@ type InboundEvent struct {
 
 	// RevertOptions are optional revert options
 	RevertOptions *solana.RevertOptions
+
+	// MemoStd is the standard memo carried by the payload, if any
+	MemoStd *memo.InboundMemo
+
+	// Status is the status of the inbound observation
+	Status crosschaintypes.InboundStatus
+
+	// ErrorMessage carries the reason of a non-SUCCESS status
+	ErrorMessage string
 }
 
 // DecodeMemo decodes the receiver from the memo bytes
@@ -98,6 +108,26 @@ func (event *InboundEvent) DecodeMemo() error {
 	return nil
 }
 
+// DecodeStandardMemo decodes the standard memo carried by the payload of a cross-chain call
+// made without receiver, if any.
+// The receiver and the payload of the event are replaced by the ones carried by the memo.
+func (event *InboundEvent) DecodeStandardMemo(validateRevertAddress memo.AddressValidator) error {
+	if !memo.CarriesStandardMemo(event.IsCrossChainCall, ethcommon.HexToAddress(event.Receiver)) {
+		return nil
+	}
+
+	memoStd, err := memo.DecodeStandardMemo(event.Memo, validateRevertAddress)
+	if err != nil || memoStd == nil {
+		return err
+	}
+
+	event.MemoStd = memoStd
+	event.Receiver = memoStd.Receiver.Hex()
+	event.Memo = memoStd.Payload
+
+	return nil
+}
+
 // Category returns the category of the inbound event
 func (event *InboundEvent) Category() InboundCategory {
 	// parse memo-specified receiver
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/zeta-chain/node/pkg/constant"
+	"github.com/zeta-chain/node/pkg/memo"
 	"github.com/zeta-chain/node/testutil/sample"
 	"github.com/zeta-chain/node/zetaclient/config"
 	"github.com/zeta-chain/node/zetaclient/types"
@@ -59,6 +60,50 @@ func Test_DecodeMemo(t *testing.T) {
 	}
 }
 
+func Test_DecodeStandardMemoEvent(t *testing.T) {
+	memoReceiver := sample.EthAddress()
+	memoStd := memo.InboundMemo{
+		Header: memo.Header{
+			Version:     0,
+			EncodingFmt: memo.EncodingFmtCompactShort,
+			OpCode:      memo.OpCodeCall,
+		},
+		FieldsV0: memo.FieldsV0{
+			Receiver: memoReceiver,
+			Payload:  []byte("payload"),
+		},
+	}
+	memoBytes, err := memoStd.EncodeToBytes()
+	require.NoError(t, err)
+
+	t.Run("should decode the standard memo of a call made without receiver", func(t *testing.T) {
+		event := &types.InboundEvent{
+			Receiver:         ethcommon.Address{}.Hex(),
+			Memo:             memoBytes,
+			IsCrossChainCall: true,
+		}
+
+		require.NoError(t, event.DecodeStandardMemo(nil))
+		require.NotNil(t, event.MemoStd)
+		require.Equal(t, memoReceiver.Hex(), event.Receiver)
+		require.Equal(t, []byte("payload"), event.Memo)
+	})
+
+	t.Run("should keep the payload of a call made to a receiver as is", func(t *testing.T) {
+		receiver := sample.EthAddress()
+		event := &types.InboundEvent{
+			Receiver:         receiver.Hex(),
+			Memo:             memoBytes,
+			IsCrossChainCall: true,
+		}
+
+		require.NoError(t, event.DecodeStandardMemo(nil))
+		require.Nil(t, event.MemoStd)
+		require.Equal(t, receiver.Hex(), event.Receiver)
+		require.Equal(t, memoBytes, event.Memo)
+	})
+}
+
 func Test_Catetory(t *testing.T) {
 	// setup compliance config
 	cfg := config.Config{
@@ -46,11 +46,12 @@ func (c *Client) PostVoteGasPrice(
 func (c *Client) PostVoteTSS(
 	ctx context.Context,
 	tssPubKey string,
+	tssPubKeyEdDSA string,
 	keyGenZetaHeight int64,
 	status chains.ReceiveStatus,
 ) (string, error) {
 	signerAddress := c.keys.GetOperatorAddress().String()
-	msg := observertypes.NewMsgVoteTSS(signerAddress, tssPubKey, keyGenZetaHeight, status)
+	msg := observertypes.NewMsgVoteTSS(signerAddress, tssPubKey, tssPubKeyEdDSA, keyGenZetaHeight, status)
 
 	authzMsg, authzSigner, err := WrapMessageWithAuthz(msg)
 	if err != nil {
@@ -117,6 +117,7 @@ func TestZetacore_SetTSS(t *testing.T) {
 		hash, err := client.PostVoteTSS(
 			ctx,
 			"zetapub1addwnpepqtadxdyt037h86z60nl98t6zk56mw5zpnm79tsmvspln3hgt5phdc79kvfc",
+			"",
 			9987,
 			chains.ReceiveStatus_success,
 		)